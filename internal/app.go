@@ -41,11 +41,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
+	"path/filepath"
 	"time"
 )
 
@@ -59,10 +59,20 @@ type Application struct {
 	logger     *zap.Logger
 	svc        *service.Service
 	CliArgs    goConfig.Config
+
+	keyDaemonCancel context.CancelFunc
+	keyDaemonDone   chan struct{}
 }
 
 type appHealthCheck struct{}
 
+const (
+	keyDaemonMinSleepDuration = time.Second
+
+	// format of date passed to tasks by command line
+	taskDateFormat = "2006-01-02"
+)
+
 func NewApplication() *Application {
 	return &Application{}
 }
@@ -145,7 +155,17 @@ func (app *Application) Init() {
 			cli.StringFlag{
 				Name:  "date",
 				Value: "",
-				Usage: "task context date, i.e. 2006-01-02T15:04:05Z07:00",
+				Usage: "task context date in YYYY-MM-DD format, i.e. " + taskDateFormat,
+			},
+			cli.StringFlag{
+				Name:  "file",
+				Value: "",
+				Usage: "task input file path, i.e. settlement report for reconciliation or bank status report",
+			},
+			cli.StringFlag{
+				Name:  "payment_system",
+				Value: pkg.PaymentSystemHandlerCardPay,
+				Usage: "payment system handler of settlement report",
 			},
 		),
 	}
@@ -292,6 +312,12 @@ func (app *Application) Stop() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if app.keyDaemonCancel != nil {
+		app.keyDaemonCancel()
+		<-app.keyDaemonDone
+		app.logger.Info("Key daemon stopped")
+	}
+
 	if app.httpServer != nil {
 		if err := app.httpServer.Shutdown(ctx); err != nil {
 			app.logger.Error("Http server shutdown failed", zap.Error(err))
@@ -321,7 +347,7 @@ func (app *Application) TaskProcessVatReports(date string) error {
 		Date: ptypes.TimestampNow(),
 	}
 	if date != "" {
-		date, err := time.Parse("2006-01-02", date)
+		date, err := time.Parse(taskDateFormat, date)
 		if err != nil {
 			return err
 		}
@@ -352,30 +378,187 @@ func (app *Application) TaskRebuildOrderView() error {
 	return app.svc.RebuildOrderView()
 }
 
+func (app *Application) TaskProcessOrderViewProjection() error {
+	count, err := app.svc.ProcessOrderViewProjection()
+	zap.S().Infow("Order view projection processed", "orders", count)
+
+	return err
+}
+
+func (app *Application) TaskCheckOrderViewConsistency() error {
+	mismatched, err := app.svc.CheckOrderViewConsistency()
+	zap.S().Infow("Order view consistency checked", "mismatched", mismatched)
+
+	return err
+}
+
+func (app *Application) TaskVoidExpiredAuthorizations() error {
+	return app.svc.VoidExpiredAuthorizations()
+}
+
+func (app *Application) TaskReleaseExpiredOrderReservations() error {
+	return app.svc.ReleaseExpiredOrderReservations()
+}
+
+func (app *Application) TaskSubscriptionRenewals() error {
+	return app.svc.ProcessSubscriptionRenewals()
+}
+
+func (app *Application) TaskProcessExpiredChargebacks() error {
+	return app.svc.ProcessExpiredChargebacks()
+}
+
+func (app *Application) TaskProcessWebhookDeliveries() error {
+	return app.svc.ProcessWebhookDeliveries()
+}
+
+func (app *Application) TaskReleaseRollingReserves() error {
+	return app.svc.ReleaseRollingReserves()
+}
+
+func (app *Application) TaskProcessOrdersExportJobs() error {
+	count, err := app.svc.ProcessOrdersExportJobs()
+	zap.S().Infow("Orders export jobs processed", "count", count)
+
+	return err
+}
+
+func (app *Application) TaskImportReconciliationReport(date, file, paymentSystem string) error {
+	zap.L().Info("Start to import settlement report", zap.String("file", file))
+
+	content, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		return err
+	}
+
+	req := &grpc.ImportReconciliationReportRequest{
+		PaymentSystemHandler: paymentSystem,
+		FileName:             filepath.Base(file),
+		Content:              content,
+	}
+
+	if date != "" {
+		from, err := time.Parse(taskDateFormat, date)
+
+		if err != nil {
+			return err
+		}
+
+		req.DateFrom = from.Unix()
+		req.DateTo = from.AddDate(0, 0, 1).Add(-time.Second).Unix()
+	}
+
+	rsp := &grpc.ReconciliationRunResponse{}
+	err = app.svc.ImportReconciliationReport(context.TODO(), req, rsp)
+
+	if err != nil {
+		return err
+	}
+
+	if rsp.Status != pkg.ResponseStatusOk {
+		return rsp.Message
+	}
+
+	zap.L().Info(
+		"Settlement report imported",
+		zap.String("run_id", rsp.Item.Id),
+		zap.Int32("unresolved", rsp.Item.Unresolved),
+	)
+
+	return nil
+}
+
+func (app *Application) TaskImportPayoutStatusReport(file string) error {
+	zap.L().Info("Start to import bank status report", zap.String("file", file))
+
+	content, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		return err
+	}
+
+	req := &grpc.ImportPayoutStatusReportRequest{
+		FileName: filepath.Base(file),
+		Content:  content,
+	}
+	rsp := &grpc.ImportPayoutStatusReportResponse{}
+	err = app.svc.ImportPayoutStatusReport(context.TODO(), req, rsp)
+
+	if err != nil {
+		return err
+	}
+
+	if rsp.Status != pkg.ResponseStatusOk {
+		return rsp.Message
+	}
+
+	zap.L().Info(
+		"Bank status report imported",
+		zap.Int32("paid", rsp.Paid),
+		zap.Int32("failed", rsp.Failed),
+		zap.Int32("skipped", rsp.Skipped),
+		zap.Strings("unmatched", rsp.Unmatched),
+	)
+
+	return nil
+}
+
+// KeyDaemonStart starts daemon returning to stock keys which reservation is over.
+// Daemon wakes up when the nearest reservation is over, but not later than restart interval.
 func (app *Application) KeyDaemonStart() {
 	zap.L().Info("Key daemon started", zap.Int64("RestartInterval", app.cfg.KeyDaemonRestartInterval))
 
+	ctx, cancel := context.WithCancel(context.Background())
+	app.keyDaemonCancel = cancel
+	app.keyDaemonDone = make(chan struct{})
+
 	go func() {
+		defer close(app.keyDaemonDone)
+
 		interval := time.Duration(app.cfg.KeyDaemonRestartInterval) * time.Second
-		shutdown := make(chan os.Signal, 1)
-		signal.Notify(shutdown, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
 
 		for {
 			zap.S().Debug("Key daemon working")
 
+			count, err := app.svc.ProcessExpiredKeyReservations()
+			if err != nil {
+				zap.L().Error("Key daemon process failed", zap.Error(err))
+			}
+
+			zap.S().Debugw("Key daemon job finished", "count", count)
+
 			select {
-			case <-shutdown:
+			case <-ctx.Done():
 				zap.S().Info("Key daemon stopping")
 				return
-			default:
-				count, err := app.svc.KeyDaemonProcess()
-				if err != nil {
-					zap.L().Error("Key daemon process failed", zap.Error(err))
-				}
-
-				zap.S().Debugf("Key daemon job finished", "count", count)
-				time.Sleep(interval)
+			case <-time.After(app.getKeyDaemonSleepDuration(interval)):
 			}
 		}
 	}()
 }
+
+func (app *Application) getKeyDaemonSleepDuration(interval time.Duration) time.Duration {
+	next, err := app.svc.GetNextKeyReservationExpiry()
+
+	if err != nil {
+		zap.L().Error("Key daemon next reservation expiry get failed", zap.Error(err))
+		return interval
+	}
+
+	if next.IsZero() {
+		return interval
+	}
+
+	duration := time.Until(next)
+
+	if duration < keyDaemonMinSleepDuration {
+		return keyDaemonMinSleepDuration
+	}
+
+	if duration > interval {
+		return interval
+	}
+
+	return duration
+}
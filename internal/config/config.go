@@ -13,6 +13,7 @@ import (
 type PaymentSystemConfig struct {
 	CardPayApiUrl        string `envconfig:"CARD_PAY_API_URL" required:"true"`
 	CardPayApiSandboxUrl string `envconfig:"CARD_PAY_API_SANDBOX_URL" required:"true"`
+	StripeApiUrl         string `envconfig:"STRIPE_API_URL" default:"https://api.stripe.com"`
 	RedirectUrlSuccess   string `envconfig:"REDIRECT_URL_SUCCESS" default:"https://order.pay.super.com/?result=success"`
 	RedirectUrlFail      string `envconfig:"REDIRECT_URL_FAIL" default:"https://order.pay.super.com/?result=fail"`
 }
@@ -60,6 +61,7 @@ type Config struct {
 	EmailGameCodeTemplate            string `envconfig:"EMAIL_ACTIVATION_CODE_TEMPLATE" default:"p1_verify_letter-2"`
 	EmailSuccessTransactionTemplate  string `envconfig:"EMAIL_SUCCESS_TRANSACTION_TEMPLATE" default:"p1_verify_letter-4"`
 	EmailRefundTransactionTemplate   string `envconfig:"EMAIL_REFUND_TRANSACTION_TEMPLATE" default:"p1_verify_letter-5"`
+	EmailKeysLowStockTemplate        string `envconfig:"EMAIL_KEYS_LOW_STOCK_TEMPLATE" default:"p1_keys_low_stock"`
 
 	RoyaltyReportsUrl string `envconfig:"ROYALTY_REPORTS_URL" default:"https://paysupermgmt.tst.protocol.one/royalty_reports"`
 	PayoutsUrl        string `envconfig:"PAYOUTS_URL" default:"https://paysupermgmt.tst.protocol.one/payout_documents"`
@@ -79,7 +81,46 @@ type Config struct {
 
 	EmailNotificationFinancierRecipient string `envconfig:"EMAIL_NOTIFICATION_FINANCIER_RECIPIENT" required:"true"`
 
-	OrderViewUpdateBatchSize int `envconfig:"ORDER_VIEW_UPDATE_BATCH_SIZE" default:"200"`
+	OrderViewUpdateBatchSize     int   `envconfig:"ORDER_VIEW_UPDATE_BATCH_SIZE" default:"200"`
+	OrderAuthorizationVoidPeriod int64 `envconfig:"ORDER_AUTHORIZATION_VOID_PERIOD" default:"604800"`
+	// time in seconds after that paylink token purchase and promo code use reserved by not finished order are released
+	OrderReservationReleasePeriod int64 `envconfig:"ORDER_RESERVATION_RELEASE_PERIOD" default:"86400"`
+
+	// number of order view change events processed by one iteration of incremental order view projection
+	OrderViewProjectionBatchSize int `envconfig:"ORDER_VIEW_PROJECTION_BATCH_SIZE" default:"500"`
+	// time in seconds that change event must wait before projection, events can be written by replicas not in order of ids
+	OrderViewProjectionDelay   int64 `envconfig:"ORDER_VIEW_PROJECTION_DELAY" default:"5"`
+	OrderViewProjectionLockTtl int64 `envconfig:"ORDER_VIEW_PROJECTION_LOCK_TTL" default:"600"`
+	// number of order view documents compared with full recompute by one consistency check
+	OrderViewConsistencySampleSize int `envconfig:"ORDER_VIEW_CONSISTENCY_SAMPLE_SIZE" default:"100"`
+
+	SubscriptionDunningMaxAttempts   int32 `envconfig:"SUBSCRIPTION_DUNNING_MAX_ATTEMPTS" default:"3"`
+	SubscriptionDunningRetryInterval int64 `envconfig:"SUBSCRIPTION_DUNNING_RETRY_INTERVAL" default:"86400"`
+	SubscriptionRenewalLockTtl       int64 `envconfig:"SUBSCRIPTION_RENEWAL_LOCK_TTL" default:"600"`
+
+	ChargebackEvidencePeriod int64 `envconfig:"CHARGEBACK_EVIDENCE_PERIOD" default:"864000"`
+
+	WebhookMaxAttempts      int   `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"10"`
+	WebhookRetryInterval    int64 `envconfig:"WEBHOOK_RETRY_INTERVAL" default:"60"`
+	WebhookRequestTimeout   int64 `envconfig:"WEBHOOK_REQUEST_TIMEOUT" default:"10"`
+	WebhookProcessBatchSize int   `envconfig:"WEBHOOK_PROCESS_BATCH_SIZE" default:"100"`
+	// time for which delivery is claimed by replica sending it, after that delivery may be sent again by any replica
+	WebhookClaimTtl int64 `envconfig:"WEBHOOK_CLAIM_TTL" default:"60"`
+
+	PayoutBatchDebtorName   string   `envconfig:"PAYOUT_BATCH_DEBTOR_NAME" default:"PaySuper"`
+	PayoutBatchDebtorIban   string   `envconfig:"PAYOUT_BATCH_DEBTOR_IBAN" default:""`
+	PayoutBatchDebtorBic    string   `envconfig:"PAYOUT_BATCH_DEBTOR_BIC" default:""`
+	PayoutBatchCsvColumns   []string `envconfig:"PAYOUT_BATCH_CSV_COLUMNS" default:"end_to_end_id,beneficiary_name,beneficiary_account,beneficiary_bic,amount,currency,remittance_info"`
+	PayoutBatchCsvDelimiter string   `envconfig:"PAYOUT_BATCH_CSV_DELIMITER" default:";"`
+
+	// registration of platform in eu vat one-stop-shop scheme, used in header of oss returns
+	VatOssMemberStateOfIdentification string `envconfig:"VAT_OSS_MEMBER_STATE_OF_IDENTIFICATION" default:""`
+	VatOssVatNumber                   string `envconfig:"VAT_OSS_VAT_NUMBER" default:""`
+
+	// number of orders read from order view by one query of orders export
+	OrdersExportBatchSize int `envconfig:"ORDERS_EXPORT_BATCH_SIZE" default:"1000"`
+	// time in seconds after that processing export job is considered as failed and can be taken by another worker
+	OrdersExportJobTimeout int64 `envconfig:"ORDERS_EXPORT_JOB_TIMEOUT" default:"3600"`
 
 	HelloSignDefaultTemplate    string `envconfig:"HELLO_SIGN_DEFAULT_TEMPLATE" required:"true"`
 	HelloSignAgreementClientId  string `envconfig:"HELLO_SIGN_AGREEMENT_CLIENT_ID" required:"true"`
@@ -90,11 +131,21 @@ type Config struct {
 	PaysuperDocumentSignerEmail string `envconfig:"PAYSUPER_DOCUMENT_SIGNER_EMAIL" required:"true"`
 	PaysuperDocumentSignerName  string `envconfig:"PAYSUPER_DOCUMENT_SIGNER_NAME" required:"true"`
 
-	KeyDaemonRestartInterval int64  `envconfig:"KEY_DAEMON_RESTART_INTERVAL" default:"60"`
-	DashboardProjectsUrl     string `envconfig:"DASHBOARD_PROJECTS_URL" default:"https://paysupermgmt.tst.protocol.one/projects"`
+	KeyDaemonRestartInterval    int64  `envconfig:"KEY_DAEMON_RESTART_INTERVAL" default:"60"`
+	KeyReservationExpiryLockTtl int64  `envconfig:"KEY_RESERVATION_EXPIRY_LOCK_TTL" default:"60"`
+	DashboardProjectsUrl        string `envconfig:"DASHBOARD_PROJECTS_URL" default:"https://paysupermgmt.tst.protocol.one/projects"`
+
+	// master keys of local keys encryption provider in format "id:base64 encoded 32 bytes key",
+	// the first key is active and used to encrypt new keys, others are used to decrypt keys before rotation
+	KeyEncryptionMasterKeys []string `envconfig:"KEY_ENCRYPTION_MASTER_KEYS" required:"true"`
+	KeyEncryptionHashSecret string   `envconfig:"KEY_ENCRYPTION_HASH_SECRET" required:"true"`
 
 	PaylinkMinProducts int `envconfig:"PAYLINK_MIN_PRODUCTS" required:"false" default:"1"`
 	PaylinkMaxProducts int `envconfig:"PAYLINK_MAX_PRODUCTS" required:"false" default:"8"`
+	// secret to sign paylink tokens issued to buyers
+	PaylinkTokenSecret string `envconfig:"PAYLINK_TOKEN_SECRET" required:"true"`
+	// secret to hash bank card numbers to fingerprints used by anti-fraud velocity rules
+	CardFingerprintSecret string `envconfig:"CARD_FINGERPRINT_SECRET" required:"true"`
 
 	CentrifugoOrderChannel string `envconfig:"CENTRIFUGO_ORDER_CHANNEL" default:"paysuper:order#%s"`
 
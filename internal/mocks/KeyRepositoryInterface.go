@@ -4,6 +4,7 @@ package mocks
 
 import billing "github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 import mock "github.com/stretchr/testify/mock"
+import time "time"
 
 // KeyRepositoryInterface is an autogenerated mock type for the KeyRepositoryInterface type
 type KeyRepositoryInterface struct {
@@ -54,16 +55,58 @@ func (_m *KeyRepositoryInterface) CountKeysByProductPlatform(_a0 string, _a1 str
 	return r0, r1
 }
 
-// FindUnfinished provides a mock function with given fields:
-func (_m *KeyRepositoryInterface) FindUnfinished() ([]*billing.Key, error) {
+// DeleteByIds provides a mock function with given fields: _a0
+func (_m *KeyRepositoryInterface) DeleteByIds(_a0 []string) (int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExistsByCode provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KeyRepositoryInterface) ExistsByCode(_a0 string, _a1 string, _a2 string) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireReservation provides a mock function with given fields:
+func (_m *KeyRepositoryInterface) ExpireReservation() (*billing.Key, error) {
 	ret := _m.Called()
 
-	var r0 []*billing.Key
-	if rf, ok := ret.Get(0).(func() []*billing.Key); ok {
+	var r0 *billing.Key
+	if rf, ok := ret.Get(0).(func() *billing.Key); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*billing.Key)
+			r0 = ret.Get(0).(*billing.Key)
 		}
 	}
 
@@ -77,6 +120,52 @@ func (_m *KeyRepositoryInterface) FindUnfinished() ([]*billing.Key, error) {
 	return r0, r1
 }
 
+// FindNotEncryptedWithMasterKey provides a mock function with given fields: _a0, _a1
+func (_m *KeyRepositoryInterface) FindNotEncryptedWithMasterKey(_a0 string, _a1 int) ([]*billing.Key, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*billing.Key
+	if rf, ok := ret.Get(0).(func(string, int) []*billing.Key); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*billing.Key)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUnsold provides a mock function with given fields: _a0, _a1, _a2
+func (_m *KeyRepositoryInterface) FindUnsold(_a0 string, _a1 string, _a2 []string) ([]*billing.Key, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*billing.Key
+	if rf, ok := ret.Get(0).(func(string, string, []string) []*billing.Key); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*billing.Key)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FinishRedeemById provides a mock function with given fields: _a0
 func (_m *KeyRepositoryInterface) FinishRedeemById(_a0 string) (*billing.Key, error) {
 	ret := _m.Called(_a0)
@@ -123,6 +212,62 @@ func (_m *KeyRepositoryInterface) GetById(_a0 string) (*billing.Key, error) {
 	return r0, r1
 }
 
+// GetNextReservationExpiry provides a mock function with given fields:
+func (_m *KeyRepositoryInterface) GetNextReservationExpiry() (time.Time, error) {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockStatistics provides a mock function with given fields:
+func (_m *KeyRepositoryInterface) GetStockStatistics() (int, int, int, error) {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func() int); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 int
+	if rf, ok := ret.Get(2).(func() int); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func() error); ok {
+		r3 = rf()
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // Insert provides a mock function with given fields: _a0
 func (_m *KeyRepositoryInterface) Insert(_a0 *billing.Key) error {
 	ret := _m.Called(_a0)
@@ -159,3 +304,38 @@ func (_m *KeyRepositoryInterface) ReserveKey(_a0 string, _a1 string, _a2 string,
 
 	return r0, r1
 }
+
+// RevokeByIds provides a mock function with given fields: _a0
+func (_m *KeyRepositoryInterface) RevokeByIds(_a0 []string) (int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEncryption provides a mock function with given fields: _a0
+func (_m *KeyRepositoryInterface) UpdateEncryption(_a0 *billing.Key) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*billing.Key) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
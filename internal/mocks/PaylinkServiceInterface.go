@@ -136,20 +136,89 @@ func (_m *PaylinkServiceInterface) GetPaylinkVisits(id string, from int64, to in
 	return r0, r1
 }
 
-// GetUrl provides a mock function with given fields: id, merchantId, urlMask, utmSource, utmMedium, utmCampaign
-func (_m *PaylinkServiceInterface) GetUrl(id string, merchantId string, urlMask string, utmSource string, utmMedium string, utmCampaign string) (string, error) {
-	ret := _m.Called(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign)
+// GetTokenById provides a mock function with given fields: id
+func (_m *PaylinkServiceInterface) GetTokenById(id string) (*paylink.PaylinkToken, error) {
+	ret := _m.Called(id)
+
+	var r0 *paylink.PaylinkToken
+	if rf, ok := ret.Get(0).(func(string) *paylink.PaylinkToken); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*paylink.PaylinkToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTokenPurchases provides a mock function with given fields: ids
+func (_m *PaylinkServiceInterface) GetTokenPurchases(ids []string) (map[string]int32, error) {
+	ret := _m.Called(ids)
+
+	var r0 map[string]int32
+	if rf, ok := ret.Get(0).(func([]string) map[string]int32); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int32)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTokensByPaylink provides a mock function with given fields: paylinkId
+func (_m *PaylinkServiceInterface) GetTokensByPaylink(paylinkId string) ([]*paylink.PaylinkToken, error) {
+	ret := _m.Called(paylinkId)
+
+	var r0 []*paylink.PaylinkToken
+	if rf, ok := ret.Get(0).(func(string) []*paylink.PaylinkToken); ok {
+		r0 = rf(paylinkId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*paylink.PaylinkToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(paylinkId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUrl provides a mock function with given fields: id, merchantId, urlMask, utmSource, utmMedium, utmCampaign, token
+func (_m *PaylinkServiceInterface) GetUrl(id string, merchantId string, urlMask string, utmSource string, utmMedium string, utmCampaign string, token string) (string, error) {
+	ret := _m.Called(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign, token)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string) string); ok {
-		r0 = rf(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string, string) string); ok {
+		r0 = rf(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign, token)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, string, string, string) error); ok {
-		r1 = rf(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign)
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string, string, string) error); ok {
+		r1 = rf(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign, token)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -185,6 +254,20 @@ func (_m *PaylinkServiceInterface) Insert(pl *paylink.Paylink) error {
 	return r0
 }
 
+// InsertToken provides a mock function with given fields: token
+func (_m *PaylinkServiceInterface) InsertToken(token *paylink.PaylinkToken) error {
+	ret := _m.Called(token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*paylink.PaylinkToken) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: pl
 func (_m *PaylinkServiceInterface) Update(pl *paylink.Paylink) error {
 	ret := _m.Called(pl)
@@ -11,6 +11,20 @@ type PaymentSystem struct {
 	mock.Mock
 }
 
+// CapturePayment provides a mock function with given fields: order
+func (_m *PaymentSystem) CapturePayment(order *billing.Order) error {
+	ret := _m.Called(order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*billing.Order) error); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreatePayment provides a mock function with given fields: order, successUrl, failUrl, requisites
 func (_m *PaymentSystem) CreatePayment(order *billing.Order, successUrl string, failUrl string, requisites map[string]string) (string, error) {
 	ret := _m.Called(order, successUrl, failUrl, requisites)
@@ -101,3 +115,17 @@ func (_m *PaymentSystem) ProcessRefund(order *billing.Order, refund *billing.Ref
 
 	return r0
 }
+
+// VoidPayment provides a mock function with given fields: order
+func (_m *PaymentSystem) VoidPayment(order *billing.Order) error {
+	ret := _m.Called(order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*billing.Order) error); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
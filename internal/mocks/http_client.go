@@ -76,6 +76,10 @@ func (h *TransportCardPayOk) RoundTrip(req *http.Request) (*http.Response, error
 		body = []byte(`{"redirect_url": "http://localhost"}`)
 	}
 
+	if req.Method == pkg.CardPayPaths[pkg.PaymentSystemActionCapture].Method {
+		body = []byte(`{"payment_data": {"id": "123", "status": "COMPLETED"}}`)
+	}
+
 	return &http.Response{
 		StatusCode: http.StatusOK,
 		Body:       ioutil.NopCloser(bytes.NewReader(body)),
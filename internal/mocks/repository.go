@@ -14,6 +14,11 @@ type RepositoryServiceOk struct{}
 type RepositoryServiceEmpty struct{}
 type RepositoryServiceError struct{}
 
+type RepositoryServiceOwnCard struct {
+	RepositoryServiceOk
+	Token string
+}
+
 func NewRepositoryServiceOk() repository.RepositoryService {
 	return &RepositoryServiceOk{}
 }
@@ -26,6 +31,10 @@ func NewRepositoryServiceError() repository.RepositoryService {
 	return &RepositoryServiceError{}
 }
 
+func NewRepositoryServiceOwnCard(token string) repository.RepositoryService {
+	return &RepositoryServiceOwnCard{Token: token}
+}
+
 func (r *RepositoryServiceOk) InsertSavedCard(
 	ctx context.Context,
 	in *repository.SavedCardRequest,
@@ -160,3 +169,20 @@ func (r *RepositoryServiceError) FindSavedCardById(
 ) (*entity.SavedCard, error) {
 	return &entity.SavedCard{}, nil
 }
+
+func (r *RepositoryServiceOwnCard) FindSavedCardById(
+	ctx context.Context,
+	in *repository.FindByStringValue,
+	opts ...client.CallOption,
+) (*entity.SavedCard, error) {
+	card, err := r.RepositoryServiceOk.FindSavedCardById(ctx, in, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	card.Id = in.Value
+	card.Token = r.Token
+
+	return card, nil
+}
@@ -0,0 +1,449 @@
+package mocks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	StripeCardNumberDeclined = "4000000000009995"
+
+	stripeFakeCardCountry = "US"
+	stripeFakeCardBrand   = "visa"
+)
+
+// StripeServer is a fake stripe-like payment provider working over http.
+// It keeps created payment intents and refunds in memory and can produce
+// signed webhook notifications about their status changes.
+type StripeServer struct {
+	*httptest.Server
+
+	secretKey         string
+	webhookSecret     string
+	mu                sync.Mutex
+	paymentIntents    map[string]*billing.StripeCallbackPaymentIntent
+	refunds           map[string]*billing.StripeCallbackRefund
+	idempotentReplies map[string][]byte
+}
+
+type stripeFakeNextAction struct {
+	Type          string            `json:"type"`
+	RedirectToUrl map[string]string `json:"redirect_to_url"`
+}
+
+type stripeFakePaymentIntent struct {
+	*billing.StripeCallbackPaymentIntent
+	NextAction *stripeFakeNextAction `json:"next_action,omitempty"`
+}
+
+type stripeFakeError struct {
+	Type        string `json:"type"`
+	Code        string `json:"code,omitempty"`
+	DeclineCode string `json:"decline_code,omitempty"`
+	Message     string `json:"message"`
+}
+
+func NewStripeServer(secretKey, webhookSecret string) *StripeServer {
+	s := &StripeServer{
+		secretKey:         secretKey,
+		webhookSecret:     webhookSecret,
+		paymentIntents:    make(map[string]*billing.StripeCallbackPaymentIntent),
+		refunds:           make(map[string]*billing.StripeCallbackRefund),
+		idempotentReplies: make(map[string][]byte),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pkg.StripePaths[pkg.PaymentSystemActionCreatePayment].Path, s.handlePaymentIntent)
+	mux.HandleFunc(pkg.StripePaths[pkg.PaymentSystemActionRefund].Path, s.handleRefund)
+	mux.HandleFunc(pkg.StripePaths[pkg.PaymentSystemActionCreatePayment].Path+"/", s.handlePaymentIntentStatus)
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+func (s *StripeServer) GetPaymentIntent(id string) *billing.StripeCallbackPaymentIntent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.paymentIntents[id]
+}
+
+func (s *StripeServer) GetRefund(id string) *billing.StripeCallbackRefund {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refunds[id]
+}
+
+// GetPaymentIntentByOrderId returns payment intent created for order with specified identifier
+func (s *StripeServer) GetPaymentIntentByOrderId(orderId string) *billing.StripeCallbackPaymentIntent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range s.paymentIntents {
+		if v.Metadata["order_id"] == orderId {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// PaymentWebhook changes payment intent status according to event type and returns
+// raw notification body with value of signature header for it
+func (s *StripeServer) PaymentWebhook(id, eventType string) ([]byte, string) {
+	s.mu.Lock()
+	intent, ok := s.paymentIntents[id]
+
+	if !ok {
+		s.mu.Unlock()
+		return nil, ""
+	}
+
+	switch eventType {
+	case pkg.StripeEventTypePaymentIntentSucceeded:
+		intent.Status = pkg.StripePaymentIntentStatusSucceeded
+		break
+	case pkg.StripeEventTypePaymentIntentCanceled:
+		intent.Status = pkg.StripePaymentIntentStatusCanceled
+		break
+	case pkg.StripeEventTypePaymentIntentProcessing:
+		intent.Status = pkg.StripePaymentIntentStatusProcessing
+		break
+	case pkg.StripeEventTypePaymentIntentCapturable:
+		intent.Status = pkg.StripePaymentIntentStatusRequiresCapture
+		break
+	case pkg.StripeEventTypePaymentIntentPaymentFailed:
+		intent.Status = pkg.StripePaymentIntentStatusRequiresPaymentMethod
+		intent.LastPaymentError = &billing.StripeCallbackPaymentError{
+			Code:        "card_declined",
+			DeclineCode: "generic_decline",
+			Message:     "Your card was declined.",
+		}
+		break
+	}
+
+	event := &billing.StripePaymentCallback{
+		Id:      s.newId("evt"),
+		Object:  "event",
+		Type:    eventType,
+		Created: time.Now().Unix(),
+		Data:    &billing.StripePaymentCallbackData{Object: intent},
+	}
+	b, _ := json.Marshal(event)
+	s.mu.Unlock()
+
+	return b, s.Sign(b)
+}
+
+// RefundWebhook changes refund status and returns raw notification body with value of signature header for it
+func (s *StripeServer) RefundWebhook(id, status string) ([]byte, string) {
+	s.mu.Lock()
+	refund, ok := s.refunds[id]
+
+	if !ok {
+		s.mu.Unlock()
+		return nil, ""
+	}
+
+	refund.Status = status
+
+	if status == pkg.StripeRefundStatusFailed {
+		refund.FailureReason = "unknown"
+	}
+
+	event := &billing.StripeRefundCallback{
+		Id:      s.newId("evt"),
+		Object:  "event",
+		Type:    pkg.StripeEventTypeChargeRefundUpdated,
+		Created: time.Now().Unix(),
+		Data:    &billing.StripeRefundCallbackData{Object: refund},
+	}
+	b, _ := json.Marshal(event)
+	s.mu.Unlock()
+
+	return b, s.Sign(b)
+}
+
+// Sign returns value of signature header for notification body
+func (s *StripeServer) Sign(body []byte) string {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(s.webhookSecret))
+	mac.Write([]byte(ts + "." + string(body)))
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *StripeServer) handlePaymentIntent(w http.ResponseWriter, r *http.Request) {
+	if !s.checkRequest(w, r) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.Header.Get("Idempotency-Key")
+
+	if b, ok := s.idempotentReplies[key]; ok && key != "" {
+		s.reply(w, http.StatusOK, b)
+		return
+	}
+
+	amount, err := strconv.ParseInt(r.PostForm.Get("amount"), 10, 64)
+
+	if err != nil || amount <= 0 || len(r.PostForm.Get("currency")) != 3 {
+		s.replyError(w, http.StatusBadRequest, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Message: "Invalid amount or currency",
+		})
+		return
+	}
+
+	paymentMethod := r.PostForm.Get("payment_method")
+	offSession := r.PostForm.Get("off_session") == "true"
+
+	if paymentMethod == "" {
+		if offSession {
+			s.replyError(w, http.StatusBadRequest, &stripeFakeError{
+				Type:    "invalid_request_error",
+				Message: "Missing payment method for off session payment",
+			})
+			return
+		}
+
+		if r.PostForm.Get("payment_method_data[card][number]") == StripeCardNumberDeclined {
+			s.replyError(w, http.StatusPaymentRequired, &stripeFakeError{
+				Type:        "card_error",
+				Code:        "card_declined",
+				DeclineCode: "generic_decline",
+				Message:     "Your card was declined.",
+			})
+			return
+		}
+
+		paymentMethod = s.newId("pm")
+	}
+
+	intent := &billing.StripeCallbackPaymentIntent{
+		Id:               s.newId("pi"),
+		Object:           "payment_intent",
+		Amount:           amount,
+		Currency:         r.PostForm.Get("currency"),
+		Status:           pkg.StripePaymentIntentStatusRequiresAction,
+		PaymentMethod:    paymentMethod,
+		SetupFutureUsage: r.PostForm.Get("setup_future_usage"),
+		Metadata:         map[string]string{"order_id": r.PostForm.Get("metadata[order_id]")},
+		Created:          time.Now().Unix(),
+	}
+	intent.Charges = &billing.StripeCallbackCharges{
+		Data: []*billing.StripeCallbackCharge{
+			{
+				Id: s.newId("ch"),
+				PaymentMethodDetails: &billing.StripeCallbackPaymentMethodDetails{
+					Type: "card",
+					Card: &billing.StripeCallbackCard{
+						Brand:   stripeFakeCardBrand,
+						Country: stripeFakeCardCountry,
+						Last4:   s.getLast4(r.PostForm.Get("payment_method_data[card][number]")),
+					},
+				},
+			},
+		},
+	}
+
+	rsp := &stripeFakePaymentIntent{StripeCallbackPaymentIntent: intent}
+
+	if offSession {
+		intent.Status = pkg.StripePaymentIntentStatusSucceeded
+
+		if r.PostForm.Get("capture_method") == pkg.StripeCaptureMethodManual {
+			intent.Status = pkg.StripePaymentIntentStatusRequiresCapture
+		}
+	} else {
+		rsp.NextAction = &stripeFakeNextAction{
+			Type: "redirect_to_url",
+			RedirectToUrl: map[string]string{
+				"url":        s.URL + "/3ds/" + intent.Id,
+				"return_url": r.PostForm.Get("return_url"),
+			},
+		}
+	}
+
+	s.paymentIntents[intent.Id] = intent
+
+	b, _ := json.Marshal(rsp)
+	s.idempotentReplies[key] = b
+	s.reply(w, http.StatusOK, b)
+}
+
+// handlePaymentIntentStatus captures or cancels payment intent authorized with manual capture method
+func (s *StripeServer) handlePaymentIntentStatus(w http.ResponseWriter, r *http.Request) {
+	if !s.checkRequest(w, r) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, pkg.StripePaths[pkg.PaymentSystemActionCreatePayment].Path+"/")
+	parts := strings.Split(path, "/")
+	intent, ok := s.paymentIntents[parts[0]]
+
+	if !ok || len(parts) != 2 {
+		s.replyError(w, http.StatusNotFound, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Code:    "resource_missing",
+			Message: "No such payment_intent",
+		})
+		return
+	}
+
+	switch {
+	case parts[1] == "capture" && intent.Status == pkg.StripePaymentIntentStatusRequiresCapture:
+		intent.Status = pkg.StripePaymentIntentStatusSucceeded
+	case parts[1] == "cancel" && intent.Status != pkg.StripePaymentIntentStatusSucceeded &&
+		intent.Status != pkg.StripePaymentIntentStatusCanceled:
+		intent.Status = pkg.StripePaymentIntentStatusCanceled
+	default:
+		s.replyError(w, http.StatusBadRequest, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Code:    "payment_intent_unexpected_state",
+			Message: "This PaymentIntent's status is " + intent.Status,
+		})
+		return
+	}
+
+	b, _ := json.Marshal(intent)
+	s.reply(w, http.StatusOK, b)
+}
+
+func (s *StripeServer) handleRefund(w http.ResponseWriter, r *http.Request) {
+	if !s.checkRequest(w, r) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.Header.Get("Idempotency-Key")
+
+	if b, ok := s.idempotentReplies[key]; ok && key != "" {
+		s.reply(w, http.StatusOK, b)
+		return
+	}
+
+	intent, ok := s.paymentIntents[r.PostForm.Get("payment_intent")]
+
+	if !ok || intent.Status != pkg.StripePaymentIntentStatusSucceeded {
+		s.replyError(w, http.StatusBadRequest, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Message: "No succeeded payment intent found",
+		})
+		return
+	}
+
+	amount, err := strconv.ParseInt(r.PostForm.Get("amount"), 10, 64)
+	refunded := int64(0)
+
+	for _, v := range s.refunds {
+		if v.PaymentIntent == intent.Id && v.Status != pkg.StripeRefundStatusFailed &&
+			v.Status != pkg.StripeRefundStatusCanceled {
+			refunded += v.Amount
+		}
+	}
+
+	if err != nil || amount <= 0 || amount > intent.Amount-refunded {
+		s.replyError(w, http.StatusBadRequest, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Code:    "amount_too_large",
+			Message: "Refund amount is greater than unrefunded amount on payment intent",
+		})
+		return
+	}
+
+	refund := &billing.StripeCallbackRefund{
+		Id:            s.newId("re"),
+		Object:        "refund",
+		Amount:        amount,
+		Currency:      intent.Currency,
+		Status:        pkg.StripeRefundStatusPending,
+		PaymentIntent: intent.Id,
+		Charge:        intent.Charges.Data[0].Id,
+		Reason:        r.PostForm.Get("reason"),
+		Metadata: map[string]string{
+			"order_id":  r.PostForm.Get("metadata[order_id]"),
+			"refund_id": r.PostForm.Get("metadata[refund_id]"),
+		},
+		Created: time.Now().Unix(),
+	}
+	s.refunds[refund.Id] = refund
+
+	b, _ := json.Marshal(refund)
+	s.idempotentReplies[key] = b
+	s.reply(w, http.StatusOK, b)
+}
+
+func (s *StripeServer) checkRequest(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		s.replyError(w, http.StatusMethodNotAllowed, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Message: "Unrecognized request method",
+		})
+		return false
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+s.secretKey {
+		s.replyError(w, http.StatusUnauthorized, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Message: "Invalid API Key provided",
+		})
+		return false
+	}
+
+	if err := r.ParseForm(); err != nil {
+		s.replyError(w, http.StatusBadRequest, &stripeFakeError{
+			Type:    "invalid_request_error",
+			Message: err.Error(),
+		})
+		return false
+	}
+
+	return true
+}
+
+func (s *StripeServer) reply(w http.ResponseWriter, status int, b []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+func (s *StripeServer) replyError(w http.ResponseWriter, status int, e *stripeFakeError) {
+	b, _ := json.Marshal(map[string]*stripeFakeError{"error": e})
+	s.reply(w, status, b)
+}
+
+func (s *StripeServer) newId(prefix string) string {
+	return fmt.Sprintf("%s_%s", prefix, bson.NewObjectId().Hex())
+}
+
+func (s *StripeServer) getLast4(pan string) string {
+	pan = strings.TrimSpace(pan)
+
+	if len(pan) < 4 {
+		return ""
+	}
+
+	return pan[len(pan)-4:]
+}
@@ -0,0 +1,153 @@
+package pkg
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// XlsxWriter writes records to xlsx workbook with one sheet.
+// Rows of sheet are written to underlying writer as they added, so workbook of any size
+// can be written without holding it in memory.
+type XlsxWriter struct {
+	zw    *zip.Writer
+	sheet io.Writer
+	row   int
+	err   error
+}
+
+func NewXlsxWriter(w io.Writer) (*XlsxWriter, error) {
+	xw := &XlsxWriter{zw: zip.NewWriter(w)}
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+
+	for _, part := range parts {
+		f, err := xw.zw.Create(part.name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := xw.zw.Create("xl/worksheets/sheet1.xml")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.WriteString(sheet, xlsxSheetHeader); err != nil {
+		return nil, err
+	}
+
+	xw.sheet = sheet
+
+	return xw, nil
+}
+
+// Write writes single row to sheet, numeric values are written as number cells,
+// values of any other type are written as string cells
+func (xw *XlsxWriter) Write(record []interface{}) error {
+	if xw.err != nil {
+		return xw.err
+	}
+
+	xw.row++
+
+	b := new(strings.Builder)
+	b.WriteString(`<row r="` + strconv.Itoa(xw.row) + `">`)
+
+	for i, value := range record {
+		ref := xlsxColumnName(i) + strconv.Itoa(xw.row)
+
+		switch v := value.(type) {
+		case float64:
+			b.WriteString(`<c r="` + ref + `"><v>` + strconv.FormatFloat(v, 'f', -1, 64) + `</v></c>`)
+		case int32:
+			b.WriteString(`<c r="` + ref + `"><v>` + strconv.FormatInt(int64(v), 10) + `</v></c>`)
+		case int64:
+			b.WriteString(`<c r="` + ref + `"><v>` + strconv.FormatInt(v, 10) + `</v></c>`)
+		case string:
+			b.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t>`)
+			if xw.err = xml.EscapeText(b, []byte(v)); xw.err != nil {
+				return xw.err
+			}
+			b.WriteString(`</t></is></c>`)
+		}
+	}
+
+	b.WriteString(`</row>`)
+
+	_, xw.err = io.WriteString(xw.sheet, b.String())
+
+	return xw.err
+}
+
+// Flush writes buffered data to underlying writer
+func (xw *XlsxWriter) Flush() error {
+	if xw.err != nil {
+		return xw.err
+	}
+
+	return xw.zw.Flush()
+}
+
+// Close finishes sheet and workbook, underlying writer isn't closed
+func (xw *XlsxWriter) Close() error {
+	if xw.err != nil {
+		return xw.err
+	}
+
+	if _, err := io.WriteString(xw.sheet, xlsxSheetFooter); err != nil {
+		return err
+	}
+
+	return xw.zw.Close()
+}
+
+// xlsxColumnName returns letters name of column by zero based index: A, B, ..., Z, AA, AB, ...
+func xlsxColumnName(index int) string {
+	name := ""
+
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+
+	return name
+}
@@ -5,12 +5,12 @@ import (
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	curPkg "github.com/paysuper/paysuper-currencies/pkg"
 	"github.com/paysuper/paysuper-currencies/pkg/proto/currencies"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"go.uber.org/zap"
 	"time"
 )
@@ -27,6 +27,9 @@ const (
 	accountingEventTypePayment          = "payment"
 	accountingEventTypeRefund           = "refund"
 	accountingEventTypeManualCorrection = "manual-correction"
+	accountingEventTypeChargeback       = "chargeback"
+	accountingEventTypeChargebackWon    = "chargeback-won"
+	accountingEventTypeChargebackLost   = "chargeback-lost"
 )
 
 var (
@@ -96,12 +99,19 @@ var (
 		pkg.AccountingEntryTypeMerchantRollingReserveCreate:        true,
 		pkg.AccountingEntryTypeMerchantRollingReserveRelease:       true,
 		pkg.AccountingEntryTypeMerchantRoyaltyCorrection:           true,
+		pkg.AccountingEntryTypeMerchantChargeback:                  true,
+		pkg.AccountingEntryTypeMerchantChargebackFee:               true,
+		pkg.AccountingEntryTypeMerchantChargebackReversal:          true,
+		pkg.AccountingEntryTypeMerchantChargebackTaxFee:            true,
 	}
 
 	availableAccountingEntriesSourceTypes = map[string]bool{
-		collectionOrder:    true,
-		collectionRefund:   true,
-		collectionMerchant: true,
+		collectionOrder:      true,
+		collectionRefund:     true,
+		collectionMerchant:   true,
+		collectionChargeback: true,
+
+		collectionRoyaltyReportDispute: true,
 	}
 
 	rollingReserveAccountingEntries = map[string]bool{
@@ -113,6 +123,21 @@ var (
 		pkg.AccountingEntryTypeMerchantRollingReserveCreate,
 		pkg.AccountingEntryTypeMerchantRollingReserveRelease,
 	}
+
+	// royaltyReportCorrectionAccountingEntries contains entries which are included to royalty report as corrections.
+	// Corrections with positive amount decrease merchant payout, reversal entries increase it back
+	royaltyReportCorrectionAccountingEntries = []string{
+		pkg.AccountingEntryTypeMerchantRoyaltyCorrection,
+		pkg.AccountingEntryTypeMerchantChargeback,
+		pkg.AccountingEntryTypeMerchantChargebackFee,
+		pkg.AccountingEntryTypeMerchantChargebackReversal,
+		pkg.AccountingEntryTypeMerchantChargebackTaxFee,
+	}
+
+	royaltyReportReversalAccountingEntries = map[string]bool{
+		pkg.AccountingEntryTypeMerchantChargebackReversal: true,
+		pkg.AccountingEntryTypeMerchantChargebackTaxFee:   true,
+	}
 )
 
 type accountingEntry struct {
@@ -122,9 +147,11 @@ type accountingEntry struct {
 	order             *billing.Order
 	refund            *billing.Refund
 	refundOrder       *billing.Order
+	chargeback        *billing.Chargeback
 	merchant          *billing.Merchant
 	country           *billing.Country
 	accountingEntries []interface{}
+	ledgerEntries     []*billing.AccountingEntry
 	req               *grpc.CreateAccountingEntryRequest
 }
 
@@ -238,11 +265,16 @@ func (s *Service) CreateAccountingEntry(
 		rsp.Status = pkg.ResponseStatusSystemError
 		rsp.Message = accountingEntryErrorUnknown
 
+		if err == accountingEntryLedgerAccountNotFound {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = accountingEntryLedgerAccountNotFound
+		}
+
 		return nil
 	}
 
 	if _, ok := rollingReserveAccountingEntries[req.Type]; ok {
-		_, err = s.updateMerchantBalance(handler.merchant.Id)
+		_, err = s.updateMerchantBalance(handler.merchant.Id, req.Currency)
 		if err != nil {
 			rsp.Status = pkg.ResponseStatusSystemError
 			rsp.Message = accountingEntryBalanceUpdateFailed
@@ -259,7 +291,7 @@ func (s *Service) CreateAccountingEntry(
 
 func (s *Service) onPaymentNotify(ctx context.Context, order *billing.Order) error {
 
-	country, err := s.country.GetByIsoCodeA2(order.GetCountry())
+	country, err := s.getCountryForDate(order.GetCountry(), getOrderPaymentDate(order))
 	if err != nil {
 		return err
 	}
@@ -271,11 +303,15 @@ func (s *Service) onPaymentNotify(ctx context.Context, order *billing.Order) err
 		country: country,
 	}
 
-	return s.processEvent(handler, accountingEventTypePayment)
+	if err = s.processEvent(handler, accountingEventTypePayment); err != nil {
+		return err
+	}
+
+	return s.holdPaymentRollingReserve(ctx, order, country)
 }
 
 func (s *Service) onRefundNotify(ctx context.Context, refund *billing.Refund, order *billing.Order) error {
-	country, err := s.country.GetByIsoCodeA2(order.GetCountry())
+	country, err := s.getCountryForDate(order.GetCountry(), getOrderPaymentDate(order))
 
 	if err != nil {
 		return err
@@ -315,6 +351,18 @@ func (s *Service) processEvent(handler *accountingEntry, eventType string) error
 		err = handler.processManualCorrectionEvent()
 		break
 
+	case accountingEventTypeChargeback:
+		err = handler.processChargebackEvent()
+		break
+
+	case accountingEventTypeChargebackWon:
+		err = handler.processChargebackWonEvent()
+		break
+
+	case accountingEventTypeChargebackLost:
+		err = handler.processChargebackLostEvent()
+		break
+
 	default:
 		return accountingEntryUnknownEvent
 	}
@@ -558,7 +606,11 @@ func (h *accountingEntry) processPaymentEvent() error {
 	// calculated in order_view
 
 	// 25. merchantNetRevenue
-	// calculated in order_view
+	// calculated in order_view, but posted to ledger
+	merchantNetRevenue := h.newEntry(pkg.AccountingEntryTypeMerchantNetRevenue)
+	merchantNetRevenue.Amount = merchantGrossRevenue.Amount - merchantTaxFeeCostValue.Amount -
+		merchantTaxFeeCentralBankFx.Amount - psMethodFee.Amount - merchantPsFixedFee.Amount
+	h.addLedgerEntry(merchantNetRevenue)
 
 	// 26. psProfitTotal
 	// calculated in order_view
@@ -604,10 +656,7 @@ func (h *accountingEntry) processRefundEvent() error {
 	// but refund is the return of payment _before_ of the transaction was physically processed by the payment method.
 	// Now, at this moment we can't determine that it is a refund or reversal
 	// But we will be able to determine it after getting a settlement from Cardpay
-	reason := "reversal"
-	if h.refund.IsChargeback {
-		reason = "chargeback"
-	}
+	reason := pkg.UndoReasonReversal
 	moneyBackCostMerchant, err := h.getMoneyBackCostMerchant(reason)
 	if err != nil {
 		return err
@@ -700,7 +749,10 @@ func (h *accountingEntry) processRefundEvent() error {
 	}
 
 	// 6. psMerchantRefundFx
-	// calculated in order_view
+	// calculated in order_view, but posted to ledger
+	psMerchantRefundFx := h.newEntry(pkg.AccountingEntryTypePsMerchantRefundFx)
+	psMerchantRefundFx.Amount = merchantRefund.Amount - realRefund.Amount
+	h.addLedgerEntry(psMerchantRefundFx)
 
 	// 7. merchantRefundFee
 	merchantRefundFee := h.newEntry(pkg.AccountingEntryTypeMerchantRefundFee)
@@ -814,7 +866,11 @@ func (h *accountingEntry) processRefundEvent() error {
 	// calculated in order_view
 
 	// 17. merchantReverseRevenue
-	// calculated in order_view
+	// calculated in order_view, but posted to ledger
+	merchantReverseRevenue := h.newEntry(pkg.AccountingEntryTypeMerchantReverseRevenue)
+	merchantReverseRevenue.Amount = merchantRefund.Amount + merchantRefundFee.Amount + merchantRefundFixedFee.Amount +
+		reverseTaxFeeDelta.Amount - reverseTaxFee.Amount
+	h.addLedgerEntry(merchantReverseRevenue)
 
 	// 18. psRefundProfit
 	// calculated in order_view
@@ -1004,17 +1060,21 @@ func (h *accountingEntry) addEntry(entry *billing.AccountingEntry) error {
 		}
 	}
 
-	entry.Amount = tools.ToPrecise(entry.Amount)
-	entry.OriginalAmount = tools.ToPrecise(entry.OriginalAmount)
-	entry.LocalAmount = tools.ToPrecise(entry.LocalAmount)
-
+	// amounts are kept with full precision while entries of event are calculated from each other
+	// and are rounded once to minor units of currency on saving
 	h.accountingEntries = append(h.accountingEntries, entry)
 
 	return nil
 }
 
 func (h *accountingEntry) saveAccountingEntries() error {
-	err := h.db.Collection(collectionAccountingEntry).Insert(h.accountingEntries...)
+	postings, err := h.getLedgerPostings()
+
+	if err != nil {
+		return err
+	}
+
+	err = h.db.Collection(collectionAccountingEntry).Insert(h.accountingEntries...)
 
 	if err != nil {
 		zap.L().Error(
@@ -1023,42 +1083,73 @@ func (h *accountingEntry) saveAccountingEntries() error {
 			zap.Any("accounting_entries", h.accountingEntries),
 		)
 
+		h.removeAccountingEntries()
+		return err
+	}
+
+	if err = h.saveLedgerPostings(postings); err != nil {
+		h.removeAccountingEntries()
 		return err
 	}
 
-	var ids []string
-	var paylinks = map[string]string{}
+	var orders []*billing.Order
+
 	if h.order != nil {
-		ids = append(ids, h.order.Id)
-		if h.order.Issuer.ReferenceType == pkg.OrderIssuerReferenceTypePaylink && h.order.Issuer.Reference != "" {
-			paylinks[h.order.Issuer.Reference] = h.order.Project.MerchantId
-		}
+		orders = append(orders, h.order)
 	}
 
 	if h.refund != nil && h.refundOrder != nil {
-		ids = append(ids, h.refundOrder.Id)
-		if h.refundOrder.Issuer.ReferenceType == pkg.OrderIssuerReferenceTypePaylink && h.refundOrder.Issuer.Reference != "" {
-			paylinks[h.order.Issuer.Reference] = h.refundOrder.Project.MerchantId
-		}
+		orders = append(orders, h.refundOrder)
 	}
 
-	if len(ids) == 0 {
-		return nil
-	}
+	// order view is updated by projection of change events carrying amounts of saved entries,
+	// paylink stats are updated by projection too after orders of paylink are projected
+	var events []*orderViewEvent
 
-	err = h.Service.updateOrderView(ids)
-	if err != nil {
-		return err
-	}
+	for _, order := range orders {
+		event, err := newOrderViewOrderEvent(orderViewEventSourceAccountingEntry, order)
 
-	for paylinkId, merchantId := range paylinks {
-		err = h.Service.paylinkService.UpdatePaylinkTotalStat(paylinkId, merchantId)
 		if err != nil {
+			zap.L().Error("Order view event creation failed", zap.Error(err), zap.String("order_id", order.Id))
 			return err
 		}
+
+		for _, v := range h.accountingEntries {
+			entry, ok := v.(*billing.AccountingEntry)
+
+			if ok && entry.Source.Id == order.Id && entry.Source.Type == order.Type {
+				event.addEntryAmount(entry, 1)
+			}
+		}
+
+		events = append(events, event)
 	}
 
-	return nil
+	return h.Service.addOrderViewEvents(events...)
+}
+
+// removeAccountingEntries rollback insert of accounting entries of business event, so entries
+// never exist in database without ledger postings
+func (h *accountingEntry) removeAccountingEntries() {
+	var ids []bson.ObjectId
+
+	for _, v := range h.accountingEntries {
+		if entry, ok := v.(*billing.AccountingEntry); ok {
+			ids = append(ids, bson.ObjectIdHex(entry.Id))
+		}
+	}
+
+	query := bson.M{"_id": bson.M{"$in": ids}}
+	_, err := h.db.Collection(collectionAccountingEntry).RemoveAll(query)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+	}
 }
 
 func (h *accountingEntry) newEntry(entryType string) *billing.AccountingEntry {
@@ -1070,7 +1161,14 @@ func (h *accountingEntry) newEntry(entryType string) *billing.AccountingEntry {
 		currency    = ""
 		country     = ""
 	)
-	if h.refund != nil {
+	if h.chargeback != nil {
+		source = &billing.AccountingEntrySource{
+			Id:   h.chargeback.Id,
+			Type: collectionChargeback,
+		}
+		merchantId = h.order.GetMerchantId()
+		currency = h.order.GetMerchantRoyaltyCurrency()
+	} else if h.refund != nil {
 		if h.refundOrder != nil {
 			createdTime = h.refundOrder.PaymentMethodOrderClosedAt
 			merchantId = h.refundOrder.GetMerchantId()
@@ -1178,7 +1276,7 @@ func (h *accountingEntry) getMoneyBackCostMerchant(reason string) (*billing.Mone
 	}
 
 	paymentAt, _ := ptypes.Timestamp(h.order.PaymentMethodOrderClosedAt)
-	refundAt, _ := ptypes.Timestamp(h.refund.CreatedAt)
+	refundAt, _ := ptypes.Timestamp(h.getMoneyBackCreatedAt())
 
 	data := &billing.MoneyBackCostMerchantRequest{
 		MerchantId:     h.order.GetMerchantId(),
@@ -1201,7 +1299,7 @@ func (h *accountingEntry) getMoneyBackCostSystem(reason string) (*billing.MoneyB
 	}
 
 	paymentAt, _ := ptypes.Timestamp(h.order.PaymentMethodOrderClosedAt)
-	refundAt, _ := ptypes.Timestamp(h.refund.CreatedAt)
+	refundAt, _ := ptypes.Timestamp(h.getMoneyBackCreatedAt())
 
 	data := &billing.MoneyBackCostSystemRequest{
 		Name:           name,
@@ -1215,12 +1313,20 @@ func (h *accountingEntry) getMoneyBackCostSystem(reason string) (*billing.MoneyB
 	return h.Service.getMoneyBackCostSystem(data)
 }
 
+func (h *accountingEntry) getMoneyBackCreatedAt() *timestamp.Timestamp {
+	if h.chargeback != nil {
+		return h.chargeback.CreatedAt
+	}
+
+	return h.refund.CreatedAt
+}
+
 func (a Accounting) GetCorrectionsForRoyaltyReport(merchantId, currency string, from, to time.Time) (items []*billing.AccountingEntry, err error) {
 	query := bson.M{
 		"merchant_id": bson.ObjectIdHex(merchantId),
 		"currency":    currency,
 		"created_at":  bson.M{"$gte": from, "$lte": to},
-		"type":        pkg.AccountingEntryTypeMerchantRoyaltyCorrection,
+		"type":        bson.M{"$in": royaltyReportCorrectionAccountingEntries},
 	}
 
 	sorts := "created_at"
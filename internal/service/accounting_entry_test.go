@@ -156,17 +156,17 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_RUB_RUB() {
 		"merchant_method_fee":                       3,
 		"merchant_method_fee_cost_value":            1.8,
 		"ps_markup_merchant_method_fee":             1.2,
-		"merchant_method_fixed_fee":                 1.4688,
+		"merchant_method_fixed_fee":                 1.47,
 		"real_merchant_method_fixed_fee":            1.44,
-		"markup_merchant_method_fixed_fee_fx":       0.0288,
+		"markup_merchant_method_fixed_fee_fx":       0.03,
 		"real_merchant_method_fixed_fee_cost_value": 0.65,
 		"ps_method_fixed_fee_profit":                0.79,
-		"merchant_ps_fixed_fee":                     3.672,
+		"merchant_ps_fixed_fee":                     3.67,
 		"real_merchant_ps_fixed_fee":                3.6,
-		"markup_merchant_ps_fixed_fee":              0.072,
-		"ps_method_profit":                          7.222,
-		"merchant_net_revenue":                      90.328,
-		"ps_profit_total":                           7.222,
+		"markup_merchant_ps_fixed_fee":              0.07,
+		"ps_method_profit":                          7.22,
+		"merchant_net_revenue":                      90.33,
+		"ps_profit_total":                           7.22,
 	}
 
 	refundControlResults := map[string]float64{
@@ -197,7 +197,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_RUB_RUB() {
 	err = suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, false)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	accountingEntries := suite.helperGetAccountingEntries(order.Id, collectionOrder)
@@ -260,23 +260,23 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_RUB() {
 		"ps_gross_revenue_fx_profit":                0.2,
 		"merchant_gross_revenue":                    11.76,
 		"merchant_tax_fee_cost_value":               1.96,
-		"merchant_tax_fee_central_bank_fx":          0.045938,
-		"merchant_tax_fee":                          2.005938,
-		"ps_method_fee":                             0.588,
-		"merchant_method_fee":                       0.294,
+		"merchant_tax_fee_central_bank_fx":          0.05,
+		"merchant_tax_fee":                          2.01,
+		"ps_method_fee":                             0.59,
+		"merchant_method_fee":                       0.29,
 		"merchant_method_fee_cost_value":            0.18,
-		"ps_markup_merchant_method_fee":             0.114,
-		"merchant_method_fixed_fee":                 0.022597,
-		"real_merchant_method_fixed_fee":            0.022154,
-		"markup_merchant_method_fixed_fee_fx":       0.000443,
+		"ps_markup_merchant_method_fee":             0.11,
+		"merchant_method_fixed_fee":                 0.02,
+		"real_merchant_method_fixed_fee":            0.02,
+		"markup_merchant_method_fixed_fee_fx":       0,
 		"real_merchant_method_fixed_fee_cost_value": 0.01,
-		"ps_method_fixed_fee_profit":                0.012154,
-		"merchant_ps_fixed_fee":                     0.056492,
-		"real_merchant_ps_fixed_fee":                0.055385,
-		"markup_merchant_ps_fixed_fee":              0.001107,
-		"ps_method_profit":                          0.454492,
-		"merchant_net_revenue":                      9.10957,
-		"ps_profit_total":                           0.654492,
+		"ps_method_fixed_fee_profit":                0.01,
+		"merchant_ps_fixed_fee":                     0.06,
+		"real_merchant_ps_fixed_fee":                0.06,
+		"markup_merchant_ps_fixed_fee":              0,
+		"ps_method_profit":                          0.46,
+		"merchant_net_revenue":                      9.1,
+		"ps_profit_total":                           0.66,
 	}
 
 	refundControlResults := map[string]float64{
@@ -292,12 +292,12 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_RUB() {
 		"merchant_refund_fixed_fee":            0,
 		"ps_merchant_refund_fixed_fee_fx":      0,
 		"ps_merchant_refund_fixed_fee_profit":  -0.15,
-		"reverse_tax_fee":                      2.005938,
+		"reverse_tax_fee":                      2.01,
 		"reverse_tax_fee_delta":                0,
-		"ps_reverse_tax_fee_delta":             0.001875,
-		"merchant_reverse_tax_fee":             2.005937,
-		"merchant_reverse_revenue":             10.2340625,
-		"ps_refund_profit":                     -1.3159375,
+		"ps_reverse_tax_fee_delta":             0,
+		"merchant_reverse_tax_fee":             2.01,
+		"merchant_reverse_revenue":             10.23,
+		"ps_refund_profit":                     -1.32,
 	}
 
 	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
@@ -307,7 +307,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_RUB() {
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, false)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	orderAccountingEntries := suite.helperGetAccountingEntries(order.Id, collectionOrder)
@@ -372,21 +372,21 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_USD() {
 		"merchant_tax_fee_cost_value":               1.96,
 		"merchant_tax_fee_central_bank_fx":          0,
 		"merchant_tax_fee":                          1.96,
-		"ps_method_fee":                             0.588,
-		"merchant_method_fee":                       0.294,
+		"ps_method_fee":                             0.59,
+		"merchant_method_fee":                       0.29,
 		"merchant_method_fee_cost_value":            0.18,
-		"ps_markup_merchant_method_fee":             0.114,
-		"merchant_method_fixed_fee":                 0.022597,
-		"real_merchant_method_fixed_fee":            0.022154,
-		"markup_merchant_method_fixed_fee_fx":       0.000443,
+		"ps_markup_merchant_method_fee":             0.11,
+		"merchant_method_fixed_fee":                 0.02,
+		"real_merchant_method_fixed_fee":            0.02,
+		"markup_merchant_method_fixed_fee_fx":       0,
 		"real_merchant_method_fixed_fee_cost_value": 0.01,
-		"ps_method_fixed_fee_profit":                0.012154,
-		"merchant_ps_fixed_fee":                     0.056492,
-		"real_merchant_ps_fixed_fee":                0.055385,
-		"markup_merchant_ps_fixed_fee":              0.001107,
-		"ps_method_profit":                          0.454492,
-		"merchant_net_revenue":                      9.155508,
-		"ps_profit_total":                           0.654492,
+		"ps_method_fixed_fee_profit":                0.01,
+		"merchant_ps_fixed_fee":                     0.06,
+		"real_merchant_ps_fixed_fee":                0.06,
+		"markup_merchant_ps_fixed_fee":              0,
+		"ps_method_profit":                          0.46,
+		"merchant_net_revenue":                      9.15,
+		"ps_profit_total":                           0.66,
 	}
 
 	refundControlResults := map[string]float64{
@@ -417,7 +417,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_USD() {
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, false)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	orderAccountingEntries := suite.helperGetAccountingEntries(order.Id, collectionOrder)
@@ -480,23 +480,23 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_EUR() {
 		"ps_gross_revenue_fx_profit":                0.2,
 		"merchant_gross_revenue":                    11.76,
 		"merchant_tax_fee_cost_value":               1.96,
-		"merchant_tax_fee_central_bank_fx":          0.004436,
-		"merchant_tax_fee":                          1.964436,
-		"ps_method_fee":                             0.588,
-		"merchant_method_fee":                       0.294,
+		"merchant_tax_fee_central_bank_fx":          0,
+		"merchant_tax_fee":                          1.96,
+		"ps_method_fee":                             0.59,
+		"merchant_method_fee":                       0.29,
 		"merchant_method_fee_cost_value":            0.18,
-		"ps_markup_merchant_method_fee":             0.114,
-		"merchant_method_fixed_fee":                 0.022597,
-		"real_merchant_method_fixed_fee":            0.022154,
-		"markup_merchant_method_fixed_fee_fx":       0.000443,
+		"ps_markup_merchant_method_fee":             0.11,
+		"merchant_method_fixed_fee":                 0.02,
+		"real_merchant_method_fixed_fee":            0.02,
+		"markup_merchant_method_fixed_fee_fx":       0,
 		"real_merchant_method_fixed_fee_cost_value": 0.01,
-		"ps_method_fixed_fee_profit":                0.012154,
-		"merchant_ps_fixed_fee":                     0.056492,
-		"real_merchant_ps_fixed_fee":                0.055385,
-		"markup_merchant_ps_fixed_fee":              0.001107,
-		"ps_method_profit":                          0.454492,
-		"merchant_net_revenue":                      9.151072,
-		"ps_profit_total":                           0.654492,
+		"ps_method_fixed_fee_profit":                0.01,
+		"merchant_ps_fixed_fee":                     0.06,
+		"real_merchant_ps_fixed_fee":                0.06,
+		"markup_merchant_ps_fixed_fee":              0,
+		"ps_method_profit":                          0.46,
+		"merchant_net_revenue":                      9.15,
+		"ps_profit_total":                           0.66,
 	}
 
 	refundControlResults := map[string]float64{
@@ -512,12 +512,12 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_EUR() {
 		"merchant_refund_fixed_fee":            0,
 		"ps_merchant_refund_fixed_fee_fx":      0,
 		"ps_merchant_refund_fixed_fee_profit":  -0.15,
-		"reverse_tax_fee":                      1.964436,
+		"reverse_tax_fee":                      1.96,
 		"reverse_tax_fee_delta":                0,
-		"ps_reverse_tax_fee_delta":             0.00018,
-		"merchant_reverse_tax_fee":             1.964435,
-		"merchant_reverse_revenue":             10.2755646552,
-		"ps_refund_profit":                     -1.2744353448,
+		"ps_reverse_tax_fee_delta":             0,
+		"merchant_reverse_tax_fee":             1.96,
+		"merchant_reverse_revenue":             10.28,
+		"ps_refund_profit":                     -1.27,
 	}
 
 	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
@@ -527,7 +527,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_RUB_USD_EUR() {
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, false)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	orderAccountingEntries := suite.helperGetAccountingEntries(order.Id, collectionOrder)
@@ -589,271 +589,12 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_PartialRefund_Ok_RUB_
 		"merchant_refund_fixed_fee":            0,
 		"ps_merchant_refund_fixed_fee_fx":      0,
 		"ps_merchant_refund_fixed_fee_profit":  -0.15,
-		"reverse_tax_fee":                      0.982218,
-		"reverse_tax_fee_delta":                0.002128,
-		"ps_reverse_tax_fee_delta":             0,
-		"merchant_reverse_tax_fee":             0.982218,
-		"merchant_reverse_revenue":             5.137782,
-		"ps_refund_profit":                     -0.712218,
-	}
-
-	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
-	assert.NotNil(suite.T(), order)
-
-	suite.paymentSystem.Handler = "mock_ok"
-	err := suite.service.paymentSystem.Update(suite.paymentSystem)
-	assert.NoError(suite.T(), err)
-
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount*0.5, false)
-	assert.NotNil(suite.T(), refund)
-	refundAccountingEntries := suite.helperGetAccountingEntries(refund.CreatedOrderId, collectionRefund)
-	assert.Equal(suite.T(), len(refundAccountingEntries), len(refundControlResults)-7)
-	merchantRoyaltyCurrency := order.GetMerchantRoyaltyCurrency()
-	assert.Equal(suite.T(), merchantRoyaltyCurrency, "USD")
-	for _, entry := range refundAccountingEntries {
-		if !assert.Equal(suite.T(), entry.Amount, refundControlResults[entry.Type]) {
-			fmt.Println(entry.Type, entry.Amount, refundControlResults[entry.Type])
-		}
-		assert.Equal(suite.T(), entry.Currency, merchantRoyaltyCurrency)
-	}
-
-	controlRealRefund := refundControlResults["merchant_reverse_revenue"] + refundControlResults["merchant_reverse_tax_fee"] -
-		refundControlResults["merchant_refund_fixed_fee"] - refundControlResults["merchant_refund_fee"] - refundControlResults["ps_merchant_refund_fx"]
-	assert.Equal(suite.T(), refundControlResults["real_refund"], tools.ToPrecise(controlRealRefund))
-
-	country, err := suite.service.country.GetByIsoCodeA2(orderCountry)
-	assert.NoError(suite.T(), err)
-	err = suite.service.db.Collection(collectionRefund).FindId(bson.ObjectIdHex(refund.Id)).One(&refund)
-	assert.NoError(suite.T(), err)
-	suite.helperCheckRefundView(refund.CreatedOrderId, orderCurrency, merchantRoyaltyCurrency, country.VatCurrency, refundControlResults)
-}
-
-func (suite *AccountingEntryTestSuite) TestAccountingEntry_Chargeback_Ok_RUB_RUB_RUB() {
-	// Order currency RUB
-	// Royalty currency RUB
-	// VAT currency RUB
-
-	req := &grpc.GetMerchantByRequest{
-		MerchantId: suite.projectFixedAmount.MerchantId,
-	}
-	rsp := &grpc.GetMerchantResponse{}
-	err := suite.service.GetMerchantBy(context.TODO(), req, rsp)
-
-	assert.Nil(suite.T(), err)
-	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
-	assert.NotNil(suite.T(), rsp.Item)
-
-	merchant := rsp.Item
-	merchant.Banking.Currency = "RUB"
-	err = suite.service.merchant.Update(merchant)
-	assert.Nil(suite.T(), err)
-
-	orderAmount := float64(100)
-	orderCountry := "RU"
-	orderCurrency := "RUB"
-
-	refundControlResults := map[string]float64{
-		"real_refund":                          120,
-		"real_refund_tax_fee":                  20,
-		"real_refund_fee":                      12,
-		"real_refund_fixed_fee":                0.15,
-		"merchant_refund":                      120,
-		"ps_merchant_refund_fx":                0,
-		"merchant_refund_fee":                  24,
-		"ps_markup_merchant_refund_fee":        12,
-		"merchant_refund_fixed_fee_cost_value": 10.8,
-		"merchant_refund_fixed_fee":            11.016,
-		"ps_merchant_refund_fixed_fee_fx":      0.216,
-		"ps_merchant_refund_fixed_fee_profit":  10.866,
-		"reverse_tax_fee":                      20,
-		"reverse_tax_fee_delta":                0,
-		"ps_reverse_tax_fee_delta":             0,
-		"merchant_reverse_tax_fee":             20,
-		"merchant_reverse_revenue":             135.016,
-		"ps_refund_profit":                     22.866,
-	}
-
-	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
-	assert.NotNil(suite.T(), order)
-
-	suite.paymentSystem.Handler = "mock_ok"
-	err = suite.service.paymentSystem.Update(suite.paymentSystem)
-	assert.NoError(suite.T(), err)
-
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
-	assert.NotNil(suite.T(), refund)
-	refundAccountingEntries := suite.helperGetAccountingEntries(refund.CreatedOrderId, collectionRefund)
-	assert.Equal(suite.T(), len(refundAccountingEntries), len(refundControlResults)-7)
-	merchantRoyaltyCurrency := order.GetMerchantRoyaltyCurrency()
-	assert.Equal(suite.T(), merchantRoyaltyCurrency, "RUB")
-	for _, entry := range refundAccountingEntries {
-		if !assert.Equal(suite.T(), entry.Amount, refundControlResults[entry.Type]) {
-			fmt.Println(entry.Type, entry.Amount, refundControlResults[entry.Type])
-		}
-		assert.Equal(suite.T(), entry.Currency, merchantRoyaltyCurrency)
-	}
-
-	controlRealRefund := refundControlResults["merchant_reverse_revenue"] + refundControlResults["merchant_reverse_tax_fee"] -
-		refundControlResults["merchant_refund_fixed_fee"] - refundControlResults["merchant_refund_fee"] - refundControlResults["ps_merchant_refund_fx"]
-	assert.Equal(suite.T(), refundControlResults["real_refund"], tools.ToPrecise(controlRealRefund))
-
-	country, err := suite.service.country.GetByIsoCodeA2(orderCountry)
-	assert.NoError(suite.T(), err)
-	err = suite.service.db.Collection(collectionRefund).FindId(bson.ObjectIdHex(refund.Id)).One(&refund)
-	assert.NoError(suite.T(), err)
-	suite.helperCheckRefundView(refund.CreatedOrderId, orderCurrency, merchantRoyaltyCurrency, country.VatCurrency, refundControlResults)
-}
-
-func (suite *AccountingEntryTestSuite) TestAccountingEntry_Chargeback_Ok_RUB_USD_RUB() {
-	// Order currency RUB
-	// Royalty currency USD
-	// VAT currency RUB
-
-	orderAmount := float64(650)
-	orderCountry := "RU"
-	orderCurrency := "RUB"
-
-	refundControlResults := map[string]float64{
-		"real_refund":                          12,
-		"real_refund_tax_fee":                  2,
-		"real_refund_fee":                      1.2,
-		"real_refund_fixed_fee":                0.15,
-		"merchant_refund":                      12.24,
-		"ps_merchant_refund_fx":                0.24,
-		"merchant_refund_fee":                  2.448,
-		"ps_markup_merchant_refund_fee":        1.248,
-		"merchant_refund_fixed_fee_cost_value": 0.166154,
-		"merchant_refund_fixed_fee":            0.169477,
-		"ps_merchant_refund_fixed_fee_fx":      0.0033230769,
-		"ps_merchant_refund_fixed_fee_profit":  0.0194769231,
-		"reverse_tax_fee":                      2.005938,
-		"reverse_tax_fee_delta":                0,
-		"ps_reverse_tax_fee_delta":             0.001875,
-		"merchant_reverse_tax_fee":             2.005938,
-		"merchant_reverse_revenue":             12.8515394231,
-		"ps_refund_profit":                     1.2693519231,
-	}
-
-	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
-	assert.NotNil(suite.T(), order)
-
-	suite.paymentSystem.Handler = "mock_ok"
-	err := suite.service.paymentSystem.Update(suite.paymentSystem)
-	assert.NoError(suite.T(), err)
-
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
-	assert.NotNil(suite.T(), refund)
-	refundAccountingEntries := suite.helperGetAccountingEntries(refund.CreatedOrderId, collectionRefund)
-	assert.Equal(suite.T(), len(refundAccountingEntries), len(refundControlResults)-7)
-	merchantRoyaltyCurrency := order.GetMerchantRoyaltyCurrency()
-	assert.Equal(suite.T(), merchantRoyaltyCurrency, "USD")
-	for _, entry := range refundAccountingEntries {
-		if !assert.Equal(suite.T(), entry.Amount, refundControlResults[entry.Type]) {
-			fmt.Println(entry.Type, entry.Amount, refundControlResults[entry.Type])
-		}
-		assert.Equal(suite.T(), entry.Currency, merchantRoyaltyCurrency)
-	}
-
-	controlRealRefund := refundControlResults["merchant_reverse_revenue"] + refundControlResults["merchant_reverse_tax_fee"] -
-		refundControlResults["merchant_refund_fixed_fee"] - refundControlResults["merchant_refund_fee"] - refundControlResults["ps_merchant_refund_fx"]
-	assert.Equal(suite.T(), refundControlResults["real_refund"], tools.ToPrecise(controlRealRefund))
-
-	country, err := suite.service.country.GetByIsoCodeA2(orderCountry)
-	assert.NoError(suite.T(), err)
-	err = suite.service.db.Collection(collectionRefund).FindId(bson.ObjectIdHex(refund.Id)).One(&refund)
-	assert.NoError(suite.T(), err)
-	suite.helperCheckRefundView(refund.CreatedOrderId, orderCurrency, merchantRoyaltyCurrency, country.VatCurrency, refundControlResults)
-}
-
-func (suite *AccountingEntryTestSuite) TestAccountingEntry_Chargeback_Ok_RUB_USD_USD() {
-	// Order currency RUB
-	// Royalty currency USD
-	// VAT currency USD
-
-	orderAmount := float64(650)
-	orderCountry := "US"
-	orderCurrency := "RUB"
-
-	refundControlResults := map[string]float64{
-		"real_refund":                          12,
-		"real_refund_tax_fee":                  2,
-		"real_refund_fee":                      1.2,
-		"real_refund_fixed_fee":                0.15,
-		"merchant_refund":                      12.24,
-		"ps_merchant_refund_fx":                0.24,
-		"merchant_refund_fee":                  2.448,
-		"ps_markup_merchant_refund_fee":        1.248,
-		"merchant_refund_fixed_fee_cost_value": 0.166154,
-		"merchant_refund_fixed_fee":            0.169477,
-		"ps_merchant_refund_fixed_fee_fx":      0.0033230769,
-		"ps_merchant_refund_fixed_fee_profit":  0.0194769231,
-		"reverse_tax_fee":                      1.96,
+		"reverse_tax_fee":                      0.98,
 		"reverse_tax_fee_delta":                0,
 		"ps_reverse_tax_fee_delta":             0,
-		"merchant_reverse_tax_fee":             1.96,
-		"merchant_reverse_revenue":             12.8974769231,
-		"ps_refund_profit":                     1.3474769231,
-	}
-
-	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
-	assert.NotNil(suite.T(), order)
-
-	suite.paymentSystem.Handler = "mock_ok"
-	err := suite.service.paymentSystem.Update(suite.paymentSystem)
-	assert.NoError(suite.T(), err)
-
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
-	assert.NotNil(suite.T(), refund)
-	refundAccountingEntries := suite.helperGetAccountingEntries(refund.CreatedOrderId, collectionRefund)
-	assert.Equal(suite.T(), len(refundAccountingEntries), len(refundControlResults)-7)
-	merchantRoyaltyCurrency := order.GetMerchantRoyaltyCurrency()
-	assert.Equal(suite.T(), merchantRoyaltyCurrency, "USD")
-	for _, entry := range refundAccountingEntries {
-		if !assert.Equal(suite.T(), entry.Amount, refundControlResults[entry.Type]) {
-			fmt.Println(entry.Type, entry.Amount, refundControlResults[entry.Type])
-		}
-		assert.Equal(suite.T(), entry.Currency, merchantRoyaltyCurrency)
-	}
-
-	controlRealRefund := refundControlResults["merchant_reverse_revenue"] + refundControlResults["merchant_reverse_tax_fee"] -
-		refundControlResults["merchant_refund_fixed_fee"] - refundControlResults["merchant_refund_fee"] - refundControlResults["ps_merchant_refund_fx"]
-	assert.Equal(suite.T(), refundControlResults["real_refund"], tools.ToPrecise(controlRealRefund))
-
-	country, err := suite.service.country.GetByIsoCodeA2(orderCountry)
-	assert.NoError(suite.T(), err)
-	err = suite.service.db.Collection(collectionRefund).FindId(bson.ObjectIdHex(refund.Id)).One(&refund)
-	assert.NoError(suite.T(), err)
-	suite.helperCheckRefundView(refund.CreatedOrderId, orderCurrency, merchantRoyaltyCurrency, country.VatCurrency, refundControlResults)
-}
-
-func (suite *AccountingEntryTestSuite) TestAccountingEntry_Chargeback_Ok_RUB_USD_EUR() {
-	// Order currency RUB
-	// Royalty currency USD
-	// VAT currency EUR
-
-	orderAmount := float64(650)
-	orderCountry := "FI"
-	orderCurrency := "RUB"
-
-	refundControlResults := map[string]float64{
-		"real_refund":                          12,
-		"real_refund_tax_fee":                  2,
-		"real_refund_fee":                      1.2,
-		"real_refund_fixed_fee":                0.15,
-		"merchant_refund":                      12.24,
-		"ps_merchant_refund_fx":                0.24,
-		"merchant_refund_fee":                  2.448,
-		"ps_markup_merchant_refund_fee":        1.248,
-		"merchant_refund_fixed_fee_cost_value": 0.166154,
-		"merchant_refund_fixed_fee":            0.169477,
-		"ps_merchant_refund_fixed_fee_fx":      0.003323,
-		"ps_merchant_refund_fixed_fee_profit":  0.019477,
-		"reverse_tax_fee":                      1.964436,
-		"reverse_tax_fee_delta":                0,
-		"ps_reverse_tax_fee_delta":             0.00018,
-		"merchant_reverse_tax_fee":             1.964435,
-		"merchant_reverse_revenue":             12.893042,
-		"ps_refund_profit":                     1.343042,
+		"merchant_reverse_tax_fee":             0.98,
+		"merchant_reverse_revenue":             5.14,
+		"ps_refund_profit":                     -0.71,
 	}
 
 	order := helperCreateAndPayOrder(suite.Suite, suite.service, orderAmount, orderCurrency, orderCountry, suite.projectFixedAmount, suite.paymentMethod)
@@ -863,7 +604,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Chargeback_Ok_RUB_USD
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount*0.5)
 	assert.NotNil(suite.T(), refund)
 	refundAccountingEntries := suite.helperGetAccountingEntries(refund.CreatedOrderId, collectionRefund)
 	assert.Equal(suite.T(), len(refundAccountingEntries), len(refundControlResults)-7)
@@ -899,7 +640,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_CreateAccountingEntry
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	req := &grpc.CreateAccountingEntryRequest{
@@ -950,7 +691,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_CreateAccountingEntry
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	req := &grpc.CreateAccountingEntryRequest{
@@ -1036,7 +777,7 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_CreateAccountingEntry
 	err := suite.service.paymentSystem.Update(suite.paymentSystem)
 	assert.NoError(suite.T(), err)
 
-	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, true)
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 	assert.NotNil(suite.T(), refund)
 
 	refund.OriginalOrder.Id = bson.NewObjectId().Hex()
@@ -1278,3 +1019,362 @@ func (suite *AccountingEntryTestSuite) TestAccountingEntry_Ok_USD_EUR_None() {
 	orderAccountingEntries := suite.helperGetAccountingEntries(order.Id, collectionOrder)
 	assert.Equal(suite.T(), len(orderAccountingEntries), 15)
 }
+
+func (suite *AccountingEntryTestSuite) helperGetLedgerPostings(sourceId, collection string) []*billing.LedgerPosting {
+	var postings []*billing.LedgerPosting
+	err := suite.service.db.Collection(collectionLedgerPosting).
+		Find(bson.M{"source.id": bson.ObjectIdHex(sourceId), "source.type": collection}).All(&postings)
+	assert.NoError(suite.T(), err)
+
+	return postings
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_LedgerPostings_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.projectFixedAmount, suite.paymentMethod)
+	assert.NotNil(suite.T(), order)
+
+	postings := suite.helperGetLedgerPostings(order.Id, collectionOrder)
+	assert.NotEmpty(suite.T(), postings)
+	assert.NoError(suite.T(), checkLedgerPostingsBalance(postings))
+
+	realGrossRevenue := float64(0)
+
+	for _, v := range suite.helperGetAccountingEntries(order.Id, collectionOrder) {
+		if v.Type == pkg.AccountingEntryTypeRealGrossRevenue {
+			realGrossRevenue = v.Amount
+		}
+	}
+
+	transactionId := postings[0].TransactionId
+
+	for _, v := range postings {
+		assert.Equal(suite.T(), transactionId, v.TransactionId)
+		assert.True(suite.T(), v.Debit == 0 || v.Credit == 0)
+
+		if v.EntryType == pkg.AccountingEntryTypeRealGrossRevenue && v.Debit > 0 {
+			assert.Equal(suite.T(), pkg.LedgerAccountPaymentSystemReceivable, v.Account)
+			assert.Equal(suite.T(), realGrossRevenue, v.Debit)
+		}
+	}
+
+	req := &grpc.LedgerTrialBalanceRequest{
+		MerchantId: order.GetMerchantId(),
+		Currency:   order.GetMerchantRoyaltyCurrency(),
+		DateFrom:   time.Now().Add(-time.Hour).Unix(),
+		DateTo:     time.Now().Add(time.Hour).Unix(),
+	}
+	rsp := &grpc.LedgerTrialBalanceResponse{}
+	err := suite.service.GetLedgerTrialBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.True(suite.T(), rsp.Item.IsBalanced)
+	assert.NotEmpty(suite.T(), rsp.Item.Accounts)
+	assert.Equal(suite.T(), rsp.Item.TotalDebit, rsp.Item.TotalCredit)
+
+	for _, v := range rsp.Item.Accounts {
+		if v.Account == pkg.LedgerAccountPaymentSystemReceivable {
+			assert.Equal(suite.T(), realGrossRevenue, v.Debit)
+		}
+	}
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_LedgerPostings_NegativeCorrection_Ok() {
+	merchantId := suite.projectFixedAmount.MerchantId
+	req := &grpc.CreateAccountingEntryRequest{
+		Type:       pkg.AccountingEntryTypeMerchantRoyaltyCorrection,
+		MerchantId: merchantId,
+		Amount:     -10,
+		Currency:   "RUB",
+		Status:     pkg.BalanceTransactionStatusAvailable,
+		Date:       time.Now().Unix(),
+		Reason:     "unit test",
+	}
+	rsp := &grpc.CreateAccountingEntryResponse{}
+	err := suite.service.CreateAccountingEntry(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	postings := suite.helperGetLedgerPostings(merchantId, collectionMerchant)
+	assert.Len(suite.T(), postings, 2)
+
+	for _, v := range postings {
+		assert.Equal(suite.T(), rsp.Item.Id, v.AccountingEntryId)
+
+		if v.Debit > 0 {
+			assert.Equal(suite.T(), pkg.LedgerAccountPsRevenue, v.Account)
+			assert.Equal(suite.T(), float64(10), v.Debit)
+		} else {
+			assert.Equal(suite.T(), pkg.LedgerAccountMerchantPayable, v.Account)
+			assert.Equal(suite.T(), float64(10), v.Credit)
+		}
+	}
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_CheckLedgerPostingsBalance_Unbalanced_Error() {
+	postings := []*billing.LedgerPosting{
+		{Account: pkg.LedgerAccountPaymentSystemReceivable, Debit: 100, Currency: "RUB"},
+		{Account: pkg.LedgerAccountMerchantPayable, Credit: 100, Currency: "RUB"},
+		{Account: pkg.LedgerAccountMerchantPayable, Debit: 10, Currency: "USD"},
+		{Account: pkg.LedgerAccountPsRevenue, Credit: 9.99, Currency: "USD"},
+	}
+
+	err := checkLedgerPostingsBalance(postings)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), accountingEntryLedgerUnbalanced, err)
+
+	postings[3].Credit = 10
+	assert.NoError(suite.T(), checkLedgerPostingsBalance(postings))
+}
+
+func (suite *AccountingEntryTestSuite) helperNewLedgerEntry(
+	source *billing.AccountingEntrySource,
+	entryType string,
+	amount float64,
+) *billing.AccountingEntry {
+	return &billing.AccountingEntry{
+		Id:         bson.NewObjectId().Hex(),
+		Object:     pkg.ObjectTypeBalanceTransaction,
+		Type:       entryType,
+		Source:     source,
+		MerchantId: suite.projectFixedAmount.MerchantId,
+		Amount:     amount,
+		Currency:   "RUB",
+		Status:     pkg.BalanceTransactionStatusAvailable,
+		CreatedAt:  ptypes.TimestampNow(),
+	}
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_GetLedgerPostings_UnbalancedPayment_Error() {
+	source := &billing.AccountingEntrySource{Id: bson.NewObjectId().Hex(), Type: collectionOrder}
+	handler := &accountingEntry{
+		Service: suite.service,
+		ctx:     context.TODO(),
+		accountingEntries: []interface{}{
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeRealGrossRevenue, 100),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeRealTaxFee, 16.67),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypePsGrossRevenueFx, 2),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantTaxFeeCostValue, 16.33),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantTaxFeeCentralBankFx, 0.5),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypePsMethodFee, 3.92),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantMethodFee, 1.96),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantMethodFeeCostValue, 1.5),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantPsFixedFee, 1.25),
+		},
+	}
+	netRevenue := suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantNetRevenue, 75.99)
+	handler.addLedgerEntry(netRevenue)
+
+	postings, err := handler.getLedgerPostings()
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), accountingEntryLedgerUnbalanced, err)
+	assert.Nil(suite.T(), postings)
+
+	netRevenue.Amount = 76
+	postings, err = handler.getLedgerPostings()
+	assert.NoError(suite.T(), err)
+	// cost value is transfer between two accounts, other posted entries are one side of payment
+	assert.Len(suite.T(), postings, 9)
+
+	for _, v := range postings {
+		switch v.EntryType {
+		case pkg.AccountingEntryTypeRealTaxFee, pkg.AccountingEntryTypeMerchantMethodFee:
+			assert.Fail(suite.T(), "informational entry posted to ledger", v.EntryType)
+		case pkg.AccountingEntryTypeMerchantNetRevenue:
+			assert.Empty(suite.T(), v.AccountingEntryId)
+			assert.Equal(suite.T(), pkg.LedgerAccountMerchantPayable, v.Account)
+			assert.Equal(suite.T(), float64(76), v.Credit)
+		}
+	}
+
+	// lost tax entry must break balance of payment
+	handler.accountingEntries = append(handler.accountingEntries[:3], handler.accountingEntries[4:]...)
+	_, err = handler.getLedgerPostings()
+	assert.Equal(suite.T(), accountingEntryLedgerUnbalanced, err)
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_GetLedgerPostings_RoundingRemainder_Ok() {
+	source := &billing.AccountingEntrySource{Id: bson.NewObjectId().Hex(), Type: collectionOrder}
+	handler := &accountingEntry{
+		Service: suite.service,
+		ctx:     context.TODO(),
+		accountingEntries: []interface{}{
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeRealGrossRevenue, 100),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantTaxFeeCostValue, 33.333333333),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypePsMethodFee, 33.333333333),
+		},
+	}
+	handler.addLedgerEntry(suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantNetRevenue, 33.333333334))
+
+	postings, err := handler.getLedgerPostings()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), postings, 4)
+
+	// every entry is rounded down to 33.33, so remainder of rounding is pushed to merchant net amount
+	for _, v := range postings {
+		switch v.EntryType {
+		case pkg.AccountingEntryTypeMerchantTaxFeeCostValue, pkg.AccountingEntryTypePsMethodFee:
+			assert.Equal(suite.T(), 33.33, v.Credit)
+		case pkg.AccountingEntryTypeMerchantNetRevenue:
+			assert.Equal(suite.T(), 33.34, v.Credit)
+		}
+	}
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_SetBSON_WithoutMinorUnits_Ok() {
+	id := bson.NewObjectId()
+	// document saved before amounts were stored in minor units of currency
+	doc := bson.M{
+		"_id":         id,
+		"object":      pkg.ObjectTypeBalanceTransaction,
+		"type":        pkg.AccountingEntryTypeMerchantRoyaltyCorrection,
+		"source":      bson.M{"id": bson.NewObjectId(), "type": collectionMerchant},
+		"merchant_id": bson.ObjectIdHex(suite.projectFixedAmount.MerchantId),
+		"amount":      10.5,
+		"currency":    "RUB",
+		"created_at":  time.Now(),
+	}
+	err := suite.service.db.Collection(collectionAccountingEntry).Insert(doc)
+	assert.NoError(suite.T(), err)
+
+	var entry *billing.AccountingEntry
+	err = suite.service.db.Collection(collectionAccountingEntry).FindId(id).One(&entry)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 10.5, entry.Amount)
+	assert.Zero(suite.T(), entry.LocalAmount)
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_GetLedgerPostings_UnbalancedRefund_Error() {
+	source := &billing.AccountingEntrySource{Id: bson.NewObjectId().Hex(), Type: collectionRefund}
+	handler := &accountingEntry{
+		Service: suite.service,
+		ctx:     context.TODO(),
+		accountingEntries: []interface{}{
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeRealRefund, 100),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantRefund, 102),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantRefundFee, 3),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeReverseTaxFee, 17),
+		},
+	}
+	refundFx := suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypePsMerchantRefundFx, 2)
+	reverseRevenue := suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantReverseRevenue, 87)
+	handler.addLedgerEntry(refundFx)
+	handler.addLedgerEntry(reverseRevenue)
+
+	_, err := handler.getLedgerPostings()
+	assert.Equal(suite.T(), accountingEntryLedgerUnbalanced, err)
+
+	reverseRevenue.Amount = 88
+	postings, err := handler.getLedgerPostings()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), postings, 5)
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_GetLedgerPostings_UnmappedEntry_Error() {
+	source := &billing.AccountingEntrySource{Id: bson.NewObjectId().Hex(), Type: collectionOrder}
+	handler := &accountingEntry{
+		Service: suite.service,
+		ctx:     context.TODO(),
+		accountingEntries: []interface{}{
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeMerchantChargeback, 100),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypePsProfitTotal, 10),
+		},
+	}
+
+	postings, err := handler.getLedgerPostings()
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), accountingEntryLedgerAccountNotFound, err)
+	assert.Nil(suite.T(), postings)
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_SaveAccountingEntries_Unbalanced_Error() {
+	source := &billing.AccountingEntrySource{Id: bson.NewObjectId().Hex(), Type: collectionOrder}
+	handler := &accountingEntry{
+		Service: suite.service,
+		ctx:     context.TODO(),
+		accountingEntries: []interface{}{
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypeRealGrossRevenue, 100),
+			suite.helperNewLedgerEntry(source, pkg.AccountingEntryTypePsMethodFee, 5),
+		},
+	}
+
+	err := handler.saveAccountingEntries()
+	assert.Equal(suite.T(), accountingEntryLedgerUnbalanced, err)
+	assert.Empty(suite.T(), suite.helperGetAccountingEntries(source.Id, collectionOrder))
+	assert.Empty(suite.T(), suite.helperGetLedgerPostings(source.Id, collectionOrder))
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_LedgerPostings_Refund_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.projectFixedAmount, suite.paymentMethod)
+	assert.NotNil(suite.T(), order)
+
+	refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
+	assert.NotNil(suite.T(), refund)
+
+	postings := suite.helperGetLedgerPostings(refund.CreatedOrderId, collectionRefund)
+	assert.NotEmpty(suite.T(), postings)
+	assert.NoError(suite.T(), checkLedgerPostingsBalance(postings))
+
+	hasReverseRevenue := false
+
+	for _, v := range postings {
+		if v.EntryType == pkg.AccountingEntryTypeMerchantReverseRevenue {
+			hasReverseRevenue = true
+			assert.Equal(suite.T(), pkg.LedgerAccountMerchantPayable, v.Account)
+			assert.True(suite.T(), v.Debit > 0)
+		}
+	}
+
+	assert.True(suite.T(), hasReverseRevenue)
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_LedgerPostings_ManualCorrection_Ok() {
+	merchantId := suite.projectFixedAmount.MerchantId
+	req := &grpc.CreateAccountingEntryRequest{
+		Type:       pkg.AccountingEntryTypeRealGrossRevenue,
+		MerchantId: merchantId,
+		Amount:     10,
+		Currency:   "RUB",
+		Status:     pkg.BalanceTransactionStatusAvailable,
+		Date:       time.Now().Unix(),
+		Reason:     "unit test",
+	}
+	rsp := &grpc.CreateAccountingEntryResponse{}
+	err := suite.service.CreateAccountingEntry(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	var postings []*billing.LedgerPosting
+	err = suite.service.db.Collection(collectionLedgerPosting).
+		Find(bson.M{"accounting_entry_id": bson.ObjectIdHex(rsp.Item.Id)}).All(&postings)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), postings, 2)
+	assert.NoError(suite.T(), checkLedgerPostingsBalance(postings))
+
+	for _, v := range postings {
+		if v.Debit > 0 {
+			assert.Equal(suite.T(), pkg.LedgerAccountPaymentSystemReceivable, v.Account)
+		} else {
+			assert.Equal(suite.T(), pkg.LedgerAccountManualAdjustment, v.Account)
+		}
+	}
+
+	req.Type = pkg.AccountingEntryTypePsProfitTotal
+	rsp = &grpc.CreateAccountingEntryResponse{}
+	err = suite.service.CreateAccountingEntry(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), accountingEntryLedgerAccountNotFound, rsp.Message)
+}
+
+func (suite *AccountingEntryTestSuite) TestAccountingEntry_GetLedgerTrialBalance_MerchantNotFound_Error() {
+	req := &grpc.LedgerTrialBalanceRequest{
+		MerchantId: bson.NewObjectId().Hex(),
+		Currency:   "RUB",
+	}
+	rsp := &grpc.LedgerTrialBalanceResponse{}
+	err := suite.service.GetLedgerTrialBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), accountingEntryErrorMerchantNotFound, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	collectionLedgerPosting = "ledger_posting"
+)
+
+var (
+	accountingEntryLedgerUnbalanced      = newBillingServerErrorMsg("ae00018", "ledger postings for accounting entries are unbalanced")
+	accountingEntryLedgerAccountNotFound = newBillingServerErrorMsg("ae00019", "ledger accounts for accounting entry type not found")
+
+	// accountingLedgerAccounts contains accounts to post every accounting entry type of business event.
+	// Entry with both accounts is a transfer between two accounts and balanced by itself.
+	// Entry with one account posts to one side only, so postings of payment or refund are balanced
+	// only if gross amount is equal to sum of tax, fees and net amount of merchant from other entries
+	accountingLedgerAccounts = map[string]*ledgerAccounts{
+		// payment
+		pkg.AccountingEntryTypeRealGrossRevenue:                    {debit: pkg.LedgerAccountPaymentSystemReceivable},
+		pkg.AccountingEntryTypePsGrossRevenueFx:                    {credit: pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeMerchantTaxFeeCostValue:             {credit: pkg.LedgerAccountTaxPayable},
+		pkg.AccountingEntryTypeMerchantTaxFeeCentralBankFx:         {credit: pkg.LedgerAccountTaxPayable},
+		pkg.AccountingEntryTypePsMethodFee:                         {credit: pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeMerchantPsFixedFee:                  {credit: pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeMerchantNetRevenue:                  {credit: pkg.LedgerAccountMerchantPayable},
+		pkg.AccountingEntryTypePsGrossRevenueFxTaxFee:              {pkg.LedgerAccountPsRevenue, pkg.LedgerAccountTaxPayable},
+		pkg.AccountingEntryTypeMerchantMethodFeeCostValue:          {pkg.LedgerAccountPsRevenue, pkg.LedgerAccountPaymentSystemReceivable},
+		pkg.AccountingEntryTypeRealMerchantMethodFixedFeeCostValue: {pkg.LedgerAccountPsRevenue, pkg.LedgerAccountPaymentSystemReceivable},
+		// refund
+		pkg.AccountingEntryTypeRealRefund:             {credit: pkg.LedgerAccountPaymentSystemReceivable},
+		pkg.AccountingEntryTypeMerchantReverseRevenue: {debit: pkg.LedgerAccountMerchantPayable},
+		pkg.AccountingEntryTypeReverseTaxFee:          {debit: pkg.LedgerAccountTaxPayable},
+		pkg.AccountingEntryTypeReverseTaxFeeDelta:     {credit: pkg.LedgerAccountTaxPayable},
+		pkg.AccountingEntryTypeMerchantRefundFee:      {credit: pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeMerchantRefundFixedFee: {credit: pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypePsMerchantRefundFx:     {credit: pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeRealRefundFee:          {pkg.LedgerAccountPsRevenue, pkg.LedgerAccountPaymentSystemReceivable},
+		pkg.AccountingEntryTypeRealRefundFixedFee:     {pkg.LedgerAccountPsRevenue, pkg.LedgerAccountPaymentSystemReceivable},
+		pkg.AccountingEntryTypePsReverseTaxFeeDelta:   {pkg.LedgerAccountPsRevenue, pkg.LedgerAccountTaxPayable},
+		// rolling reserves, corrections and chargebacks
+		pkg.AccountingEntryTypeMerchantRollingReserveCreate:  {pkg.LedgerAccountMerchantPayable, pkg.LedgerAccountMerchantReserve},
+		pkg.AccountingEntryTypeMerchantRollingReserveRelease: {pkg.LedgerAccountMerchantReserve, pkg.LedgerAccountMerchantPayable},
+		pkg.AccountingEntryTypeMerchantRoyaltyCorrection:     {pkg.LedgerAccountMerchantPayable, pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeMerchantChargeback:            {pkg.LedgerAccountMerchantPayable, pkg.LedgerAccountPaymentSystemReceivable},
+		pkg.AccountingEntryTypeMerchantChargebackFee:         {pkg.LedgerAccountMerchantPayable, pkg.LedgerAccountPsRevenue},
+		pkg.AccountingEntryTypeMerchantChargebackReversal:    {pkg.LedgerAccountPaymentSystemReceivable, pkg.LedgerAccountMerchantPayable},
+		pkg.AccountingEntryTypeMerchantChargebackTaxFee:      {pkg.LedgerAccountTaxPayable, pkg.LedgerAccountMerchantPayable},
+	}
+
+	// accountingLedgerInformationalEntries contains entries which break down or duplicate in other exchange rate
+	// amounts of posted entries, so they aren't posted to ledger
+	accountingLedgerInformationalEntries = map[string]bool{
+		pkg.AccountingEntryTypeRealTaxFee:                      true,
+		pkg.AccountingEntryTypeCentralBankTaxFee:               true,
+		pkg.AccountingEntryTypeMerchantMethodFee:               true,
+		pkg.AccountingEntryTypeMerchantMethodFixedFee:          true,
+		pkg.AccountingEntryTypeRealMerchantMethodFixedFee:      true,
+		pkg.AccountingEntryTypeRealMerchantPsFixedFee:          true,
+		pkg.AccountingEntryTypeRealRefundTaxFee:                true,
+		pkg.AccountingEntryTypeMerchantRefund:                  true,
+		pkg.AccountingEntryTypeMerchantRefundFixedFeeCostValue: true,
+	}
+
+	// accountingLedgerBalancingEntries contains one side entries of merchant net amount, which receive
+	// remainder of rounding of other entries of business event to minor units
+	accountingLedgerBalancingEntries = map[string]bool{
+		pkg.AccountingEntryTypeMerchantNetRevenue:     true,
+		pkg.AccountingEntryTypeMerchantReverseRevenue: true,
+	}
+)
+
+// ledgerAccounts contains accounts to debit and to credit, empty account means what entry posts to one side only
+type ledgerAccounts struct {
+	debit  string
+	credit string
+}
+
+type ledgerTrialBalanceQueryResItem struct {
+	Account string `bson:"_id"`
+	Debit   int64  `bson:"debit"`
+	Credit  int64  `bson:"credit"`
+}
+
+func (s *Service) GetLedgerTrialBalance(
+	ctx context.Context,
+	req *grpc.LedgerTrialBalanceRequest,
+	rsp *grpc.LedgerTrialBalanceResponse,
+) error {
+	_, err := s.merchant.GetById(req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = accountingEntryErrorMerchantNotFound
+		return nil
+	}
+
+	item := &billing.LedgerTrialBalance{
+		MerchantId: req.MerchantId,
+		Currency:   req.Currency,
+	}
+	matchQuery := bson.M{
+		"merchant_id": bson.ObjectIdHex(req.MerchantId),
+		"currency":    req.Currency,
+	}
+	dates := bson.M{}
+
+	if req.DateFrom > 0 {
+		from := time.Unix(req.DateFrom, 0)
+		dates["$gte"] = from
+		item.DateFrom, _ = ptypes.TimestampProto(from)
+	}
+
+	if req.DateTo > 0 {
+		to := time.Unix(req.DateTo, 0)
+		dates["$lte"] = to
+		item.DateTo, _ = ptypes.TimestampProto(to)
+	}
+
+	if len(dates) > 0 {
+		matchQuery["created_at"] = dates
+	}
+
+	query := []bson.M{
+		{
+			"$match": matchQuery,
+		},
+		{
+			"$group": bson.M{
+				"_id":    "$account",
+				"debit":  bson.M{"$sum": "$debit_minor"},
+				"credit": bson.M{"$sum": "$credit_minor"},
+			},
+		},
+		{
+			"$sort": bson.M{"_id": 1},
+		},
+	}
+
+	var accounts []*ledgerTrialBalanceQueryResItem
+	err = s.db.Collection(collectionLedgerPosting).Pipe(query).All(&accounts)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionLedgerPosting),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = accountingEntryErrorUnknown
+		return nil
+	}
+
+	var totalDebit, totalCredit int64
+
+	for _, v := range accounts {
+		item.Accounts = append(item.Accounts, &billing.LedgerTrialBalanceAccount{
+			Account: v.Account,
+			Debit:   money.FromMinor(v.Debit, req.Currency),
+			Credit:  money.FromMinor(v.Credit, req.Currency),
+			Balance: money.FromMinor(v.Debit-v.Credit, req.Currency),
+		})
+
+		totalDebit += v.Debit
+		totalCredit += v.Credit
+	}
+
+	item.TotalDebit = money.FromMinor(totalDebit, req.Currency)
+	item.TotalCredit = money.FromMinor(totalCredit, req.Currency)
+	item.IsBalanced = totalDebit == totalCredit
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = item
+
+	return nil
+}
+
+// getLedgerPostings transform accounting entries of business event and amounts calculated for ledger only
+// to postings which are united by one transaction. Entry with negative amount posts to reversed side.
+// Manual correction of entry which posts to one side only is balanced by manual adjustment account
+func (h *accountingEntry) getLedgerPostings() ([]*billing.LedgerPosting, error) {
+	var postings []*billing.LedgerPosting
+	balancing := make(map[string]*billing.LedgerPosting)
+	balances := make(map[string]float64)
+	transactionId := bson.NewObjectId().Hex()
+	entries := append([]*billing.AccountingEntry{}, h.ledgerEntries...)
+
+	for _, v := range h.accountingEntries {
+		if entry, ok := v.(*billing.AccountingEntry); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	for _, entry := range entries {
+		if accountingLedgerInformationalEntries[entry.Type] {
+			continue
+		}
+
+		accounts, ok := accountingLedgerAccounts[entry.Type]
+
+		if !ok {
+			zap.L().Error(
+				accountingEntryLedgerAccountNotFound.Message,
+				zap.String("type", entry.Type),
+				zap.Any(errorFieldEntrySource, entry.Source),
+			)
+			return nil, accountingEntryLedgerAccountNotFound
+		}
+
+		if entry.Amount == 0 {
+			continue
+		}
+
+		debit, credit, amount := accounts.debit, accounts.credit, entry.Amount
+
+		if h.req != nil {
+			if debit == "" {
+				debit = pkg.LedgerAccountManualAdjustment
+			}
+
+			if credit == "" {
+				credit = pkg.LedgerAccountManualAdjustment
+			}
+		}
+
+		if amount < 0 {
+			debit, credit, amount = credit, debit, -amount
+		}
+
+		var posting *billing.LedgerPosting
+
+		if debit != "" {
+			posting = newLedgerPosting(transactionId, entry, debit, amount, 0)
+			postings = append(postings, posting)
+		}
+
+		if credit != "" {
+			posting = newLedgerPosting(transactionId, entry, credit, 0, amount)
+			postings = append(postings, posting)
+		}
+
+		if debit != "" && credit != "" {
+			continue
+		}
+
+		if debit != "" {
+			balances[entry.Currency] += amount
+		} else {
+			balances[entry.Currency] -= amount
+		}
+
+		if accountingLedgerBalancingEntries[entry.Type] {
+			balancing[entry.Currency] = posting
+		}
+	}
+
+	balanceLedgerPostingsRounding(postings, balancing, balances)
+
+	if err := checkLedgerPostingsBalance(postings); err != nil {
+		return nil, err
+	}
+
+	return postings, nil
+}
+
+// addLedgerEntry add amount which isn't stored as accounting entry (it's calculated in order_view),
+// but is required to balance ledger postings of business event
+func (h *accountingEntry) addLedgerEntry(entry *billing.AccountingEntry) {
+	entry.Id = ""
+	h.ledgerEntries = append(h.ledgerEntries, entry)
+}
+
+func (h *accountingEntry) saveLedgerPostings(postings []*billing.LedgerPosting) error {
+	if len(postings) == 0 {
+		return nil
+	}
+
+	items := make([]interface{}, len(postings))
+
+	for i, v := range postings {
+		items[i] = v
+	}
+
+	err := h.db.Collection(collectionLedgerPosting).Insert(items...)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionLedgerPosting),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, postings),
+		)
+
+		// insert of many documents stops on first error, so part of postings can be already inserted
+		query := bson.M{"transaction_id": bson.ObjectIdHex(postings[0].TransactionId)}
+		_, err1 := h.db.Collection(collectionLedgerPosting).RemoveAll(query)
+
+		if err1 != nil {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err1),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionLedgerPosting),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// balanceLedgerPostingsRounding push remainder of rounding of postings to minor units to balancing posting
+// of merchant net amount. Only currencies which are balanced with full precision of entries amounts are changed,
+// so wrong or lost entry still breaks balance of postings
+func balanceLedgerPostingsRounding(
+	postings []*billing.LedgerPosting,
+	balancing map[string]*billing.LedgerPosting,
+	balances map[string]float64,
+) {
+	for currency, posting := range balancing {
+		if money.ToMinor(balances[currency], currency) != 0 {
+			continue
+		}
+
+		var remainder int64
+
+		for _, v := range postings {
+			if v.Currency == currency {
+				remainder += money.ToMinor(v.Debit, currency) - money.ToMinor(v.Credit, currency)
+			}
+		}
+
+		if remainder == 0 {
+			continue
+		}
+
+		if posting.Credit != 0 {
+			posting.Credit = money.FromMinor(money.ToMinor(posting.Credit, currency)+remainder, currency)
+		} else {
+			posting.Debit = money.FromMinor(money.ToMinor(posting.Debit, currency)-remainder, currency)
+		}
+	}
+}
+
+// checkLedgerPostingsBalance check what sum of debit postings is equal to sum of credit postings in every currency
+func checkLedgerPostingsBalance(postings []*billing.LedgerPosting) error {
+	balances := make(map[string]int64)
+
+	for _, v := range postings {
+		balances[v.Currency] += money.ToMinor(v.Debit, v.Currency) - money.ToMinor(v.Credit, v.Currency)
+	}
+
+	for currency, balance := range balances {
+		if balance != 0 {
+			zap.L().Error(
+				accountingEntryLedgerUnbalanced.Message,
+				zap.String("currency", currency),
+				zap.Int64("balance", balance),
+				zap.Any("postings", postings),
+			)
+
+			return accountingEntryLedgerUnbalanced
+		}
+	}
+
+	return nil
+}
+
+func newLedgerPosting(
+	transactionId string,
+	entry *billing.AccountingEntry,
+	account string,
+	debit, credit float64,
+) *billing.LedgerPosting {
+	return &billing.LedgerPosting{
+		Id:                bson.NewObjectId().Hex(),
+		TransactionId:     transactionId,
+		AccountingEntryId: entry.Id,
+		EntryType:         entry.Type,
+		Source:            entry.Source,
+		MerchantId:        entry.MerchantId,
+		Account:           account,
+		Debit:             money.Round(debit, entry.Currency),
+		Credit:            money.Round(credit, entry.Currency),
+		Currency:          entry.Currency,
+		CreatedAt:         entry.CreatedAt,
+	}
+}
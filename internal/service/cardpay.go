@@ -7,8 +7,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
@@ -36,6 +38,8 @@ const (
 	cardPayDateFormat          = "2006-01-02T15:04:05Z"
 	cardPayInitiatorCardholder = "cit"
 
+	cardPayThreeDsChallengeIndicatorMandate = "04"
+
 	cardPayMaxItemNameLength = 50
 )
 
@@ -90,19 +94,23 @@ type CardPayRecurringDataFiling struct {
 }
 
 type CardPayPaymentData struct {
-	Currency   string  `json:"currency"`
-	Amount     float64 `json:"amount"`
-	Descriptor string  `json:"dynamic_descriptor"`
-	Note       string  `json:"note"`
+	Currency                  string  `json:"currency"`
+	Amount                    float64 `json:"amount"`
+	Descriptor                string  `json:"dynamic_descriptor"`
+	Note                      string  `json:"note"`
+	Preauth                   bool    `json:"preauth,omitempty"`
+	ThreeDsChallengeIndicator string  `json:"three_ds_challenge_indicator,omitempty"`
 }
 
 type CardPayRecurringData struct {
-	Currency   string                      `json:"currency"`
-	Amount     float64                     `json:"amount"`
-	Filing     *CardPayRecurringDataFiling `json:"filing,omitempty"`
-	Descriptor string                      `json:"dynamic_descriptor"`
-	Note       string                      `json:"note"`
-	Initiator  string                      `json:"initiator"`
+	Currency                  string                      `json:"currency"`
+	Amount                    float64                     `json:"amount"`
+	Filing                    *CardPayRecurringDataFiling `json:"filing,omitempty"`
+	Descriptor                string                      `json:"dynamic_descriptor"`
+	Note                      string                      `json:"note"`
+	Initiator                 string                      `json:"initiator"`
+	Preauth                   bool                        `json:"preauth,omitempty"`
+	ThreeDsChallengeIndicator string                      `json:"three_ds_challenge_indicator,omitempty"`
 }
 
 type CardPayCustomer struct {
@@ -231,12 +239,31 @@ type CardPayRefundResponse struct {
 	EwalletAccount interface{}                       `json:"ewallet_account,omitempty"`
 }
 
+type CardPayChangeStatusPaymentData struct {
+	StatusTo string `json:"status_to"`
+}
+
+type CardPayChangeStatusRequest struct {
+	Request     *CardPayRequest                 `json:"request"`
+	Operation   string                          `json:"operation"`
+	PaymentData *CardPayChangeStatusPaymentData `json:"payment_data"`
+}
+
+type CardPayChangeStatusResponsePaymentData struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type CardPayChangeStatusResponse struct {
+	PaymentData *CardPayChangeStatusResponsePaymentData `json:"payment_data"`
+}
+
 func (m *CardPayRefundResponse) IsSuccessStatus() bool {
 	v, ok := successRefundResponseStatuses[m.RefundData.Status]
 	return ok && v == true
 }
 
-func newCardPayHandler() PaymentSystem {
+func newCardPayHandler(cfg *config.PaymentSystemConfig) PaymentSystem {
 	return &cardPay{
 		httpClient: &http.Client{
 			Transport: &cardPayTransport{},
@@ -253,6 +280,10 @@ func (h *cardPay) CreatePayment(
 	err := h.auth(order)
 
 	if err != nil {
+		if _, ok := err.(*grpc.ResponseErrorMessage); !ok {
+			err = paymentSystemErrorRequestNotSent
+		}
+
 		return "", err
 	}
 
@@ -271,7 +302,7 @@ func (h *cardPay) CreatePayment(
 	u, err := h.getUrl(order.GetPaymentSystemApiUrl(), action)
 
 	if err != nil {
-		return "", err
+		return "", paymentSystemErrorRequestNotSent
 	}
 
 	order.PrivateStatus = constant.OrderStatusPaymentSystemRejectOnCreate
@@ -288,7 +319,7 @@ func (h *cardPay) CreatePayment(
 			zap.Any("order", order),
 			zap.ByteString(pkg.LogFieldRequest, b),
 		)
-		return "", err
+		return "", paymentSystemErrorRequestNotSent
 	}
 
 	token := h.getToken(order)
@@ -320,6 +351,12 @@ func (h *cardPay) CreatePayment(
 			zap.Any("order", order),
 			zap.ByteString(pkg.LogFieldRequest, b),
 		)
+
+		// client errors mean that payment isn't created, server errors can happen after payment is created
+		if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError {
+			return "", paymentSystemErrorCreateRequestRejected
+		}
+
 		return "", paymentSystemErrorCreateRequestFailed
 	}
 
@@ -446,6 +483,20 @@ func (h *cardPay) ProcessPayment(order *billing.Order, message proto.Message, ra
 	case pkg.CardPayPaymentResponseStatusCompleted:
 		order.PrivateStatus = constant.OrderStatusPaymentSystemComplete
 		break
+	case pkg.CardPayPaymentResponseStatusAuthorized:
+		if order.IsAuthorizationOnly == false {
+			return newBillingServerResponseError(pkg.StatusTemporary, paymentSystemErrorRequestTemporarySkipped)
+		}
+
+		order.PrivateStatus = pkg.OrderStatusPaymentSystemAuthorized
+		order.AuthorizedAt = ts
+		order.Transaction = req.GetId()
+
+		return nil
+	case pkg.CardPayPaymentResponseStatusVoided:
+		order.PrivateStatus = pkg.OrderStatusPaymentSystemVoided
+		order.CanceledAt = ptypes.TimestampNow()
+		break
 	default:
 		return newBillingServerResponseError(pkg.StatusTemporary, paymentSystemErrorRequestTemporarySkipped)
 	}
@@ -589,7 +640,7 @@ func (h *cardPay) refresh(order *billing.Order) error {
 	return nil
 }
 
-func (h *cardPay) getUrl(apiUrl, action string) (string, error) {
+func (h *cardPay) getUrl(apiUrl, action string, pathArgs ...interface{}) (string, error) {
 	u, err := url.ParseRequestURI(apiUrl)
 
 	if err != nil {
@@ -603,6 +654,10 @@ func (h *cardPay) getUrl(apiUrl, action string) (string, error) {
 
 	u.Path = pkg.CardPayPaths[action].Path
 
+	if len(pathArgs) > 0 {
+		u.Path = fmt.Sprintf(u.Path, pathArgs...)
+	}
+
 	return u.String(), nil
 }
 
@@ -705,6 +760,11 @@ func (h *cardPay) getCardPayOrder(
 			Currency:  order.Currency,
 			Amount:    order.TotalPaymentAmount,
 			Initiator: cardPayInitiatorCardholder,
+			Preauth:   order.IsAuthorizationOnly,
+		}
+
+		if order.IsFraudCheck3dsRequired() {
+			cardPayOrder.RecurringData.ThreeDsChallengeIndicator = cardPayThreeDsChallengeIndicatorMandate
 		}
 
 		if okRecurringId == true && recurringId != "" {
@@ -718,6 +778,11 @@ func (h *cardPay) getCardPayOrder(
 		cardPayOrder.PaymentData = &CardPayPaymentData{
 			Currency: order.Currency,
 			Amount:   order.TotalPaymentAmount,
+			Preauth:  order.IsAuthorizationOnly && order.PaymentMethod.IsBankCard(),
+		}
+
+		if order.IsFraudCheck3dsRequired() && order.PaymentMethod.IsBankCard() {
+			cardPayOrder.PaymentData.ThreeDsChallengeIndicator = cardPayThreeDsChallengeIndicatorMandate
 		}
 	}
 
@@ -1025,6 +1090,118 @@ func (h *cardPay) ProcessRefund(
 	return nil
 }
 
+func (h *cardPay) CapturePayment(order *billing.Order) error {
+	return h.changePaymentStatus(
+		order,
+		pkg.PaymentSystemActionCapture,
+		pkg.CardPayPaymentStatusToCapture,
+		paymentSystemErrorCaptureFailed,
+	)
+}
+
+func (h *cardPay) VoidPayment(order *billing.Order) error {
+	return h.changePaymentStatus(
+		order,
+		pkg.PaymentSystemActionVoid,
+		pkg.CardPayPaymentStatusToReverse,
+		paymentSystemErrorVoidFailed,
+	)
+}
+
+func (h *cardPay) changePaymentStatus(
+	order *billing.Order,
+	action, statusTo string,
+	failErr *grpc.ResponseErrorMessage,
+) error {
+	if err := h.auth(order); err != nil {
+		return failErr
+	}
+
+	u, err := h.getUrl(order.GetPaymentSystemApiUrl(), action, order.Transaction)
+
+	if err != nil {
+		return failErr
+	}
+
+	data := &CardPayChangeStatusRequest{
+		Request: &CardPayRequest{
+			Id:   order.Id + "_" + action,
+			Time: time.Now().UTC().Format(cardPayDateFormat),
+		},
+		Operation:   pkg.CardPayPaymentOperationChangeStatus,
+		PaymentData: &CardPayChangeStatusPaymentData{StatusTo: statusTo},
+	}
+
+	b, _ := json.Marshal(data)
+	req, err := http.NewRequest(pkg.CardPayPaths[action].Method, u, bytes.NewBuffer(b))
+
+	if err != nil {
+		zap.L().Error(
+			"cardpay API: create payment status change request failed",
+			zap.Error(err),
+			zap.String("method", pkg.CardPayPaths[action].Method),
+			zap.String("url", u),
+			zap.Any("order", order),
+		)
+		return failErr
+	}
+
+	token := h.getToken(order)
+	auth := strings.Title(token.TokenType) + " " + token.AccessToken
+
+	req.Header.Add(HeaderContentType, MIMEApplicationJSON)
+	req.Header.Add(HeaderAuthorization, auth)
+
+	resp, err := h.httpClient.Do(req)
+
+	if err != nil {
+		zap.L().Error(
+			"cardpay API: send payment status change request failed",
+			zap.Error(err),
+			zap.String("method", pkg.CardPayPaths[action].Method),
+			zap.String("url", u),
+			zap.Any("order", order),
+		)
+		return failErr
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		zap.L().Error(
+			"payment status change response returned with bad http status",
+			zap.Int("status", resp.StatusCode),
+			zap.String("method", pkg.CardPayPaths[action].Method),
+			zap.String("url", u),
+			zap.Any("order", order),
+		)
+		return failErr
+	}
+
+	b, err = ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return failErr
+	}
+
+	rsp := &CardPayChangeStatusResponse{}
+	err = json.Unmarshal(b, rsp)
+
+	if err != nil || rsp.PaymentData == nil || rsp.PaymentData.Status == pkg.CardPayPaymentResponseStatusDeclined {
+		zap.L().Error(
+			"payment status change response contain invalid data",
+			zap.Any("error", err),
+			zap.String("method", pkg.CardPayPaths[action].Method),
+			zap.String("url", u),
+			zap.Any("order", order),
+			zap.ByteString(pkg.LogFieldResponse, b),
+		)
+		return failErr
+	}
+
+	return nil
+}
+
 func (h *CardPayOrderRecurringResponse) IsSuccessStatus() bool {
 	if h.RecurringData == nil {
 		return false
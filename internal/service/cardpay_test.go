@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/internal/mocks"
@@ -14,6 +15,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
+	"net/http"
 	"testing"
 )
 
@@ -134,3 +136,46 @@ func (suite *CardPayTestSuite) TestCardPay_CreatePayment_Mock_Ok() {
 	assert.NoError(suite.T(), err)
 	assert.NotEmpty(suite.T(), url)
 }
+
+func (suite *CardPayTestSuite) TestCardPay_GetCardPayOrder_AuthorizationOnly_Ok() {
+	order := proto.Clone(orderSimpleBankCard).(*billing.Order)
+	order.IsAuthorizationOnly = true
+
+	res, err := suite.handler.getCardPayOrder(
+		order,
+		suite.cfg.GetRedirectUrlSuccess(nil),
+		suite.cfg.GetRedirectUrlFail(nil),
+		bankCardRequisites,
+	)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), res.PaymentData)
+	assert.True(suite.T(), res.PaymentData.Preauth)
+}
+
+func (suite *CardPayTestSuite) TestCardPay_CapturePayment_Mock_Ok() {
+	order := proto.Clone(orderSimpleBankCard).(*billing.Order)
+	order.Transaction = "123"
+
+	suite.handler.httpClient = mocks.NewCardPayHttpClientStatusOk()
+	err := suite.handler.CapturePayment(order)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CardPayTestSuite) TestCardPay_VoidPayment_Mock_Ok() {
+	order := proto.Clone(orderSimpleBankCard).(*billing.Order)
+	order.Transaction = "123"
+
+	suite.handler.httpClient = mocks.NewCardPayHttpClientStatusOk()
+	err := suite.handler.VoidPayment(order)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CardPayTestSuite) TestCardPay_VoidPayment_Mock_Error() {
+	order := proto.Clone(orderSimpleBankCard).(*billing.Order)
+	order.Transaction = "123"
+
+	suite.handler.httpClient = &http.Client{Transport: &mocks.TransportStatusError{}}
+	err := suite.handler.VoidPayment(order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorVoidFailed, err)
+}
@@ -0,0 +1,793 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	collectionChargeback = "chargeback"
+
+	chargebackAccountingEntryReasonMask = "Chargeback by order #%s"
+	chargebackRollingReserveReasonMask  = "Chargeback ratio %.2f%% exceeded threshold %.2f%%"
+
+	chargebackRatioDefaultPeriodDays = 30
+)
+
+var (
+	chargebackErrorUnknown                 = newBillingServerErrorMsg("cb000001", "unknown error. try request later")
+	chargebackErrorNotFound                = newBillingServerErrorMsg("cb000002", "chargeback not found")
+	chargebackErrorOrderNotFound           = newBillingServerErrorMsg("cb000003", "order for chargeback not found")
+	chargebackErrorOrderNotAllowed         = newBillingServerErrorMsg("cb000004", "chargeback allowed only for processed orders")
+	chargebackErrorAlreadyExists           = newBillingServerErrorMsg("cb000005", "chargeback for order already opened")
+	chargebackErrorAmountExceedsOrder      = newBillingServerErrorMsg("cb000006", "chargeback amount exceeds order amount")
+	chargebackErrorStatusChangeNotAllowed  = newBillingServerErrorMsg("cb000007", "chargeback status change not allowed")
+	chargebackErrorEvidenceDueDateExpired  = newBillingServerErrorMsg("cb000008", "evidence due date for chargeback expired")
+	chargebackErrorEvidenceRequired        = newBillingServerErrorMsg("cb000009", "evidence required to submit chargeback representment")
+	chargebackErrorAccountingEntriesFailed = newBillingServerErrorMsg("cb000010", "accounting entries for chargeback can't be created")
+
+	chargebackStatusesOpened = []string{
+		pkg.ChargebackStatusNotification,
+		pkg.ChargebackStatusEvidenceUploaded,
+		pkg.ChargebackStatusRepresentment,
+	}
+
+	chargebackStatusesEvidenceAllowed = map[string]bool{
+		pkg.ChargebackStatusNotification:     true,
+		pkg.ChargebackStatusEvidenceUploaded: true,
+	}
+)
+
+// CreateChargeback register chargeback notification from payment system for processed order.
+// Disputed amount with chargeback fee is held from merchant and merchant rolling reserve is adjusted
+// if merchant chargebacks ratio exceeds threshold from merchant settings
+func (s *Service) CreateChargeback(
+	ctx context.Context,
+	req *grpc.CreateChargebackRequest,
+	rsp *grpc.ChargebackResponse,
+) error {
+	order, err := s.getOrderByUuid(req.OrderId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = chargebackErrorOrderNotFound
+		return nil
+	}
+
+	if order.GetPublicStatus() != constant.OrderPublicStatusProcessed {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorOrderNotAllowed
+		return nil
+	}
+
+	query := bson.M{
+		"order_id": bson.ObjectIdHex(order.Id),
+		"status":   bson.M{"$in": chargebackStatusesOpened},
+	}
+	count, err := s.db.Collection(collectionChargeback).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+		return nil
+	}
+
+	if count > 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorAlreadyExists
+		return nil
+	}
+
+	amount := req.Amount
+
+	if amount <= 0 {
+		amount = order.TotalPaymentAmount
+	}
+
+	if amount > order.TotalPaymentAmount {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorAmountExceedsOrder
+		return nil
+	}
+
+	dueDate := time.Now().Add(time.Duration(s.cfg.ChargebackEvidencePeriod) * time.Second)
+
+	if req.EvidenceDueDate > 0 {
+		dueDate = time.Unix(req.EvidenceDueDate, 0)
+	}
+
+	chargeback := &billing.Chargeback{
+		Id:         bson.NewObjectId().Hex(),
+		OrderId:    order.Id,
+		OrderUuid:  order.Uuid,
+		MerchantId: order.GetMerchantId(),
+		ProjectId:  order.GetProjectId(),
+		ExternalId: req.ExternalId,
+		ReasonCode: req.ReasonCode,
+		Reason:     req.Reason,
+		Amount:     amount,
+		Currency:   order.Currency,
+		Status:     pkg.ChargebackStatusNotification,
+		CreatedAt:  ptypes.TimestampNow(),
+		UpdatedAt:  ptypes.TimestampNow(),
+	}
+	chargeback.EvidenceDueDate, _ = ptypes.TimestampProto(dueDate)
+
+	err = s.db.Collection(collectionChargeback).Insert(chargeback)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, chargeback),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+		return nil
+	}
+
+	err = s.onChargebackNotify(ctx, chargeback, order, accountingEventTypeChargeback)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorAccountingEntriesFailed
+		return nil
+	}
+
+	err = s.adjustChargebackRollingReserve(ctx, order.GetMerchantId())
+
+	if err != nil {
+		zap.L().Error(
+			"Rolling reserve adjustment by chargeback failed",
+			zap.Error(err),
+			zap.String("chargeback_id", chargeback.Id),
+			zap.String("merchant_id", chargeback.MerchantId),
+		)
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = chargeback
+
+	return nil
+}
+
+func (s *Service) GetChargeback(
+	ctx context.Context,
+	req *grpc.ChargebackRequest,
+	rsp *grpc.ChargebackResponse,
+) error {
+	chargeback, err := s.getChargeback(req.ChargebackId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = chargebackErrorNotFound
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = chargeback
+
+	return nil
+}
+
+func (s *Service) ListChargebacks(
+	ctx context.Context,
+	req *grpc.ListChargebacksRequest,
+	rsp *grpc.ListChargebacksResponse,
+) error {
+	query := bson.M{}
+
+	if req.MerchantId != "" {
+		query["merchant_id"] = bson.ObjectIdHex(req.MerchantId)
+	}
+
+	if req.OrderId != "" {
+		query["order_uuid"] = req.OrderId
+	}
+
+	if len(req.Status) > 0 {
+		query["status"] = bson.M{"$in": req.Status}
+	}
+
+	count, err := s.db.Collection(collectionChargeback).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+		return nil
+	}
+
+	limit := int(req.Limit)
+
+	if limit <= 0 {
+		limit = pkg.DatabaseRequestDefaultLimit
+	}
+
+	var items []*billing.Chargeback
+	err = s.db.Collection(collectionChargeback).Find(query).Sort("-_id").
+		Skip(int(req.Offset)).Limit(limit).All(&items)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = items
+
+	return nil
+}
+
+// UploadChargebackEvidence add merchant evidence to chargeback before evidence due date
+func (s *Service) UploadChargebackEvidence(
+	ctx context.Context,
+	req *grpc.UploadChargebackEvidenceRequest,
+	rsp *grpc.ChargebackResponse,
+) error {
+	chargeback, err := s.getChargeback(req.ChargebackId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = chargebackErrorNotFound
+		return nil
+	}
+
+	if _, ok := chargebackStatusesEvidenceAllowed[chargeback.Status]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorStatusChangeNotAllowed
+		return nil
+	}
+
+	if chargeback.IsEvidenceDueDateExpired() {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorEvidenceDueDateExpired
+		return nil
+	}
+
+	chargeback.Evidences = append(chargeback.Evidences, &billing.ChargebackEvidence{
+		Id:        bson.NewObjectId().Hex(),
+		FileName:  req.FileName,
+		FileUrl:   req.FileUrl,
+		Comment:   req.Comment,
+		CreatedAt: ptypes.TimestampNow(),
+	})
+	chargeback.Status = pkg.ChargebackStatusEvidenceUploaded
+
+	if err = s.updateChargeback(chargeback); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = chargeback
+
+	return nil
+}
+
+// SubmitChargebackRepresentment send uploaded merchant evidences to payment system to dispute chargeback
+func (s *Service) SubmitChargebackRepresentment(
+	ctx context.Context,
+	req *grpc.ChargebackRequest,
+	rsp *grpc.ChargebackResponse,
+) error {
+	chargeback, err := s.getChargeback(req.ChargebackId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = chargebackErrorNotFound
+		return nil
+	}
+
+	if _, ok := chargebackStatusesEvidenceAllowed[chargeback.Status]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorStatusChangeNotAllowed
+		return nil
+	}
+
+	if len(chargeback.Evidences) <= 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorEvidenceRequired
+		return nil
+	}
+
+	if chargeback.IsEvidenceDueDateExpired() {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorEvidenceDueDateExpired
+		return nil
+	}
+
+	chargeback.Status = pkg.ChargebackStatusRepresentment
+	chargeback.RepresentmentAt = ptypes.TimestampNow()
+
+	if err = s.updateChargeback(chargeback); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = chargeback
+
+	return nil
+}
+
+// CloseChargeback set final result of chargeback dispute from payment system.
+// Chargeback can be won only after representment, but can be lost at any stage
+func (s *Service) CloseChargeback(
+	ctx context.Context,
+	req *grpc.CloseChargebackRequest,
+	rsp *grpc.ChargebackResponse,
+) error {
+	chargeback, err := s.getChargeback(req.ChargebackId, "")
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = chargebackErrorNotFound
+		return nil
+	}
+
+	if chargeback.IsClosed() || (req.IsWon && chargeback.Status != pkg.ChargebackStatusRepresentment) {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = chargebackErrorStatusChangeNotAllowed
+		return nil
+	}
+
+	err = s.closeChargeback(ctx, chargeback, req.IsWon)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = chargebackErrorUnknown
+
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Message = e
+		}
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = chargeback
+
+	return nil
+}
+
+// ProcessExpiredChargebacks close as lost chargebacks for which merchant doesn't submit representment
+// before evidence due date
+func (s *Service) ProcessExpiredChargebacks() error {
+	query := bson.M{
+		"status":            bson.M{"$in": []string{pkg.ChargebackStatusNotification, pkg.ChargebackStatusEvidenceUploaded}},
+		"evidence_due_date": bson.M{"$lt": time.Now()},
+	}
+
+	var chargebacks []*billing.Chargeback
+	err := s.db.Collection(collectionChargeback).Find(query).All(&chargebacks)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	for _, chargeback := range chargebacks {
+		err = s.closeChargeback(context.TODO(), chargeback, false)
+
+		if err != nil {
+			zap.L().Error(
+				"Expired chargeback closing failed",
+				zap.Error(err),
+				zap.String("chargeback_id", chargeback.Id),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) closeChargeback(ctx context.Context, chargeback *billing.Chargeback, isWon bool) error {
+	order, err := s.getOrderById(chargeback.OrderId)
+
+	if err != nil {
+		return chargebackErrorOrderNotFound
+	}
+
+	event := accountingEventTypeChargebackLost
+	chargeback.Status = pkg.ChargebackStatusLost
+
+	if isWon {
+		event = accountingEventTypeChargebackWon
+		chargeback.Status = pkg.ChargebackStatusWon
+	}
+
+	chargeback.ClosedAt = ptypes.TimestampNow()
+
+	if err = s.onChargebackNotify(ctx, chargeback, order, event); err != nil {
+		return chargebackErrorAccountingEntriesFailed
+	}
+
+	if err = s.updateChargeback(chargeback); err != nil {
+		return err
+	}
+
+	if isWon {
+		return nil
+	}
+
+	order.PrivateStatus = constant.OrderStatusChargeback
+	order.Status = constant.OrderPublicStatusChargeback
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	return s.updateOrder(order)
+}
+
+func (s *Service) onChargebackNotify(
+	ctx context.Context,
+	chargeback *billing.Chargeback,
+	order *billing.Order,
+	event string,
+) error {
+	country, err := s.getCountryForDate(order.GetCountry(), getOrderPaymentDate(order))
+
+	if err == nil {
+		handler := &accountingEntry{
+			Service:    s,
+			chargeback: chargeback,
+			order:      order,
+			ctx:        ctx,
+			country:    country,
+		}
+		err = s.processEvent(handler, event)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			pkg.MethodFinishedWithError,
+			zap.String("method", "onChargebackNotify"),
+			zap.Error(err),
+			zap.String("chargeback_id", chargeback.Id),
+			zap.String("event", event),
+		)
+	}
+
+	return err
+}
+
+// adjustChargebackRollingReserve increase merchant rolling reserve up to percent of gross revenue from merchant
+// settings when ratio of chargebacks to payments for rolling reserve period exceeds merchant threshold.
+// Reserve is never decreased here, it is released by ReleaseRollingReserves task after rolling reserve period
+func (s *Service) adjustChargebackRollingReserve(ctx context.Context, merchantId string) error {
+	merchant, err := s.merchant.GetById(merchantId)
+
+	if err != nil {
+		return err
+	}
+
+	if merchant.RollingReserveChargebackTransactionsThreshold <= 0 || merchant.RollingReserveThreshold <= 0 {
+		return nil
+	}
+
+	days := int(merchant.RollingReserveDays)
+
+	if days <= 0 {
+		days = chargebackRatioDefaultPeriodDays
+	}
+
+	// chargebacks and payments are both counted by accounting entries in merchant payout currency,
+	// so ratio and reserve amount are calculated on the same set of orders
+	from := time.Now().AddDate(0, 0, -days)
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"merchant_id": bson.ObjectIdHex(merchant.Id),
+				"currency":    merchant.GetPayoutCurrency(),
+				"type": bson.M{
+					"$in": []string{pkg.AccountingEntryTypeRealGrossRevenue, pkg.AccountingEntryTypeMerchantChargeback},
+				},
+				"created_at": bson.M{"$gte": from},
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id":    "$type",
+				"count":  bson.M{"$sum": 1},
+				"amount": bson.M{"$sum": "$amount_minor"},
+			},
+		},
+	}
+
+	var items []*struct {
+		Type   string `bson:"_id"`
+		Count  int    `bson:"count"`
+		Amount int64  `bson:"amount"`
+	}
+	err = s.db.Collection(collectionAccountingEntry).Pipe(pipeline).All(&items)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return err
+	}
+
+	var (
+		chargebacks  int
+		revenueCount int
+		revenue      int64
+	)
+
+	for _, item := range items {
+		if item.Type == pkg.AccountingEntryTypeMerchantChargeback {
+			chargebacks = item.Count
+			continue
+		}
+
+		revenueCount = item.Count
+		revenue = item.Amount
+	}
+
+	if revenueCount <= 0 {
+		return nil
+	}
+
+	ratio := float64(chargebacks) / float64(revenueCount) * 100
+
+	if ratio <= merchant.RollingReserveChargebackTransactionsThreshold {
+		return nil
+	}
+
+	reserve, err := s.getRollingReserveForBalance(merchant.Id, merchant.GetPayoutCurrency())
+
+	if err != nil {
+		return err
+	}
+
+	currency := merchant.GetPayoutCurrency()
+	amount := money.New(revenue, currency).Mul(merchant.RollingReserveThreshold/100, money.RoundHalfUp).Amount() -
+		money.ToMinor(reserve, currency)
+
+	if amount <= 0 {
+		return nil
+	}
+
+	handler := &accountingEntry{Service: s, ctx: ctx, merchant: merchant}
+	entry := handler.newEntry(pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	entry.Amount = money.FromMinor(amount, currency)
+	entry.Reason = fmt.Sprintf(chargebackRollingReserveReasonMask, ratio, merchant.RollingReserveChargebackTransactionsThreshold)
+
+	if merchant.RollingReserveDays > 0 {
+		entry.Status = pkg.BalanceTransactionStatusHeld
+		entry.AvailableOn, err = ptypes.TimestampProto(time.Now().AddDate(0, 0, int(merchant.RollingReserveDays)))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = handler.addEntry(entry); err != nil {
+		return err
+	}
+
+	if err = handler.saveAccountingEntries(); err != nil {
+		return err
+	}
+
+	_, err = s.updateMerchantBalance(merchant.Id, currency)
+
+	return err
+}
+
+func (s *Service) getChargeback(id, merchantId string) (*billing.Chargeback, error) {
+	if bson.IsObjectIdHex(id) == false {
+		return nil, chargebackErrorNotFound
+	}
+
+	query := bson.M{"_id": bson.ObjectIdHex(id)}
+
+	if merchantId != "" {
+		if bson.IsObjectIdHex(merchantId) == false {
+			return nil, chargebackErrorNotFound
+		}
+
+		query["merchant_id"] = bson.ObjectIdHex(merchantId)
+	}
+
+	var chargeback *billing.Chargeback
+	err := s.db.Collection(collectionChargeback).Find(query).One(&chargeback)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, chargebackErrorNotFound
+	}
+
+	return chargeback, nil
+}
+
+func (s *Service) updateChargeback(chargeback *billing.Chargeback) error {
+	chargeback.UpdatedAt = ptypes.TimestampNow()
+	err := s.db.Collection(collectionChargeback).UpdateId(bson.ObjectIdHex(chargeback.Id), chargeback)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionChargeback),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, chargeback),
+		)
+	}
+
+	return err
+}
+
+// processChargebackEvent hold disputed amount and chargeback fee from merchant
+func (h *accountingEntry) processChargebackEvent() error {
+	var err error
+	reason := fmt.Sprintf(chargebackAccountingEntryReasonMask, h.order.Id)
+
+	chargeback := h.newEntry(pkg.AccountingEntryTypeMerchantChargeback)
+	chargeback.Amount, err = h.GetExchangePsCurrentMerchant(h.chargeback.Currency, h.chargeback.Amount)
+
+	if err != nil {
+		return err
+	}
+
+	chargeback.OriginalAmount = h.chargeback.Amount
+	chargeback.OriginalCurrency = h.chargeback.Currency
+	chargeback.Reason = reason
+
+	if err = h.addEntry(chargeback); err != nil {
+		return err
+	}
+
+	cost, err := h.getMoneyBackCostMerchant(pkg.UndoReasonChargeback)
+
+	if err != nil {
+		return err
+	}
+
+	fee := h.newEntry(pkg.AccountingEntryTypeMerchantChargebackFee)
+	fee.Reason = reason
+
+	if cost.IsPaidByMerchant {
+		fixedFee, err := h.GetExchangePsCurrentMerchant(cost.FixAmountCurrency, cost.FixAmount)
+
+		if err != nil {
+			return err
+		}
+
+		fee.Amount = chargeback.Amount*cost.Percent + fixedFee
+	}
+
+	return h.addEntry(fee)
+}
+
+// processChargebackWonEvent return held disputed amount to merchant, chargeback fee isn't returned
+func (h *accountingEntry) processChargebackWonEvent() error {
+	chargeback, err := h.getChargebackAccountingEntry(pkg.AccountingEntryTypeMerchantChargeback)
+
+	if err != nil {
+		return err
+	}
+
+	reversal := h.newEntry(pkg.AccountingEntryTypeMerchantChargebackReversal)
+	reversal.Amount = chargeback.Amount
+	reversal.OriginalAmount = chargeback.OriginalAmount
+	reversal.OriginalCurrency = chargeback.OriginalCurrency
+	reversal.Reason = chargeback.Reason
+
+	return h.addEntry(reversal)
+}
+
+// processChargebackLostEvent return to merchant part of order tax proportional to chargeback amount,
+// because held disputed amount includes tax which isn't payable after lost chargeback
+func (h *accountingEntry) processChargebackLostEvent() error {
+	realTaxFee := h.newEntry("")
+	query := bson.M{
+		"object":      pkg.ObjectTypeBalanceTransaction,
+		"type":        pkg.AccountingEntryTypeRealTaxFee,
+		"source.id":   bson.ObjectIdHex(h.order.Id),
+		"source.type": collectionOrder,
+	}
+	err := h.Service.db.Collection(collectionAccountingEntry).Find(query).One(&realTaxFee)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		if err == mgo.ErrNotFound {
+			return accountingEntryOriginalTaxNotFound
+		}
+
+		return err
+	}
+
+	correction := h.chargeback.Amount / h.order.TotalPaymentAmount
+
+	taxFee := h.newEntry(pkg.AccountingEntryTypeMerchantChargebackTaxFee)
+	taxFee.Amount = money.FromFloat(realTaxFee.Amount, realTaxFee.Currency, money.RoundHalfUp).
+		Mul(correction, money.RoundHalfUp).Float64()
+	taxFee.OriginalAmount = money.FromFloat(realTaxFee.OriginalAmount, realTaxFee.OriginalCurrency, money.RoundHalfUp).
+		Mul(correction, money.RoundHalfUp).Float64()
+	taxFee.OriginalCurrency = realTaxFee.OriginalCurrency
+	taxFee.LocalAmount = money.FromFloat(realTaxFee.LocalAmount, realTaxFee.LocalCurrency, money.RoundHalfUp).
+		Mul(correction, money.RoundHalfUp).Float64()
+	taxFee.LocalCurrency = realTaxFee.LocalCurrency
+	taxFee.Reason = fmt.Sprintf(chargebackAccountingEntryReasonMask, h.order.Id)
+
+	return h.addEntry(taxFee)
+}
+
+func (h *accountingEntry) getChargebackAccountingEntry(entryType string) (*billing.AccountingEntry, error) {
+	var entry *billing.AccountingEntry
+	query := bson.M{
+		"type":        entryType,
+		"source.id":   bson.ObjectIdHex(h.chargeback.Id),
+		"source.type": collectionChargeback,
+	}
+	err := h.Service.db.Collection(collectionAccountingEntry).Find(query).One(&entry)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,443 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-redis/redis"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	rabbitmq "gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"testing"
+	"time"
+)
+
+type ChargebackTestSuite struct {
+	suite.Suite
+	service *Service
+	log     *zap.Logger
+	cache   internalPkg.CacheInterface
+
+	project       *billing.Project
+	paymentMethod *billing.PaymentMethod
+	paymentSystem *billing.PaymentSystem
+}
+
+func Test_Chargeback(t *testing.T) {
+	suite.Run(t, new(ChargebackTestSuite))
+}
+
+func (suite *ChargebackTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+
+	m, err := migrate.New(
+		"file://../../migrations/tests",
+		cfg.MongoDsn)
+	assert.NoError(suite.T(), err, "Migrate init failed")
+
+	err = m.Up()
+	if err != nil && err.Error() != "no change" {
+		suite.FailNow("Migrations failed", "%v", err)
+	}
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.log, err = zap.NewProduction()
+
+	if err != nil {
+		suite.FailNow("Logger initialization failed", "%v", err)
+	}
+
+	broker, err := rabbitmq.NewBroker(cfg.BrokerAddress)
+
+	if err != nil {
+		suite.FailNow("Creating RabbitMQ publisher failed", "%v", err)
+	}
+
+	redisClient := database.NewRedis(
+		&redis.Options{
+			Addr:     cfg.RedisHost,
+			Password: cfg.RedisPassword,
+		},
+	)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		mocks.NewGeoIpServiceTestOk(),
+		mocks.NewRepositoryServiceOk(),
+		mocks.NewTaxServiceOkMock(),
+		broker,
+		redisClient,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		broker,
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	_, suite.project, suite.paymentMethod, suite.paymentSystem = helperCreateEntitiesForTests(suite.Suite, suite.service)
+
+	merchant, err := suite.service.merchant.GetById(suite.project.MerchantId)
+	assert.NoError(suite.T(), err)
+
+	merchant.Banking.Currency = "RUB"
+	err = suite.service.merchant.Update(merchant)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChargebackTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_CreateChargeback_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	chargeback := suite.helperCreateChargeback(order, 0)
+
+	assert.Equal(suite.T(), pkg.ChargebackStatusNotification, chargeback.Status)
+	assert.Equal(suite.T(), order.Id, chargeback.OrderId)
+	assert.Equal(suite.T(), order.GetMerchantId(), chargeback.MerchantId)
+	assert.Equal(suite.T(), order.TotalPaymentAmount, chargeback.Amount)
+	assert.Equal(suite.T(), order.Currency, chargeback.Currency)
+	assert.NotNil(suite.T(), chargeback.EvidenceDueDate)
+	assert.False(suite.T(), chargeback.IsEvidenceDueDateExpired())
+
+	entries := suite.helperGetChargebackAccountingEntries(chargeback.Id)
+	assert.Len(suite.T(), entries, 2)
+	assert.Equal(suite.T(), order.TotalPaymentAmount, entries[pkg.AccountingEntryTypeMerchantChargeback].Amount)
+	assert.Equal(suite.T(), "RUB", entries[pkg.AccountingEntryTypeMerchantChargeback].Currency)
+	assert.True(suite.T(), entries[pkg.AccountingEntryTypeMerchantChargebackFee].Amount > 0)
+
+	var postings []*billing.LedgerPosting
+	err := suite.service.db.Collection(collectionLedgerPosting).
+		Find(bson.M{"source.id": bson.ObjectIdHex(chargeback.Id), "source.type": collectionChargeback}).All(&postings)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), postings, 4)
+	assert.NoError(suite.T(), checkLedgerPostingsBalance(postings))
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_CreateChargeback_OrderNotFound_Error() {
+	req := &grpc.CreateChargebackRequest{
+		OrderId:    uuid.New().String(),
+		ExternalId: bson.NewObjectId().Hex(),
+	}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.CreateChargeback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), chargebackErrorOrderNotFound, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_CreateChargeback_AmountExceedsOrder_Error() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	req := &grpc.CreateChargebackRequest{
+		OrderId:    order.Uuid,
+		ExternalId: bson.NewObjectId().Hex(),
+		Amount:     order.TotalPaymentAmount + 1,
+	}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.CreateChargeback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), chargebackErrorAmountExceedsOrder, rsp.Message)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_CreateChargeback_AlreadyExists_Error() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	suite.helperCreateChargeback(order, 0)
+
+	req := &grpc.CreateChargebackRequest{
+		OrderId:    order.Uuid,
+		ExternalId: bson.NewObjectId().Hex(),
+	}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.CreateChargeback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), chargebackErrorAlreadyExists, rsp.Message)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_Won_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	chargeback := suite.helperCreateChargeback(order, 50)
+
+	req := &grpc.UploadChargebackEvidenceRequest{
+		ChargebackId: chargeback.Id,
+		MerchantId:   chargeback.MerchantId,
+		FileName:     "receipt.pdf",
+		FileUrl:      "https://unit.test/receipt.pdf",
+		Comment:      "customer received product",
+	}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.UploadChargebackEvidence(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.ChargebackStatusEvidenceUploaded, rsp.Item.Status)
+	assert.Len(suite.T(), rsp.Item.Evidences, 1)
+	assert.Equal(suite.T(), req.FileUrl, rsp.Item.Evidences[0].FileUrl)
+
+	req1 := &grpc.ChargebackRequest{ChargebackId: chargeback.Id, MerchantId: chargeback.MerchantId}
+	rsp1 := &grpc.ChargebackResponse{}
+	err = suite.service.SubmitChargebackRepresentment(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), pkg.ChargebackStatusRepresentment, rsp1.Item.Status)
+	assert.NotNil(suite.T(), rsp1.Item.RepresentmentAt)
+
+	req2 := &grpc.CloseChargebackRequest{ChargebackId: chargeback.Id, IsWon: true}
+	rsp2 := &grpc.ChargebackResponse{}
+	err = suite.service.CloseChargeback(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.Equal(suite.T(), pkg.ChargebackStatusWon, rsp2.Item.Status)
+	assert.NotNil(suite.T(), rsp2.Item.ClosedAt)
+
+	entries := suite.helperGetChargebackAccountingEntries(chargeback.Id)
+	assert.Len(suite.T(), entries, 3)
+	assert.Equal(suite.T(), float64(50), entries[pkg.AccountingEntryTypeMerchantChargeback].Amount)
+	assert.Equal(
+		suite.T(),
+		entries[pkg.AccountingEntryTypeMerchantChargeback].Amount,
+		entries[pkg.AccountingEntryTypeMerchantChargebackReversal].Amount,
+	)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderPublicStatusProcessed, order.GetPublicStatus())
+
+	rsp2 = &grpc.ChargebackResponse{}
+	err = suite.service.CloseChargeback(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp2.Status)
+	assert.Equal(suite.T(), chargebackErrorStatusChangeNotAllowed, rsp2.Message)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_Lost_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	chargeback := suite.helperCreateChargeback(order, 0)
+
+	req := &grpc.CloseChargebackRequest{ChargebackId: chargeback.Id, IsWon: false}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.CloseChargeback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.ChargebackStatusLost, rsp.Item.Status)
+
+	entries := suite.helperGetChargebackAccountingEntries(chargeback.Id)
+	assert.Len(suite.T(), entries, 3)
+	assert.Contains(suite.T(), entries, pkg.AccountingEntryTypeMerchantChargebackTaxFee)
+	assert.NotContains(suite.T(), entries, pkg.AccountingEntryTypeMerchantChargebackReversal)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderPublicStatusChargeback, order.GetPublicStatus())
+	assert.EqualValues(suite.T(), constant.OrderStatusChargeback, order.PrivateStatus)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_StatusChangeNotAllowed_Error() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	chargeback := suite.helperCreateChargeback(order, 0)
+
+	req := &grpc.ChargebackRequest{ChargebackId: chargeback.Id, MerchantId: chargeback.MerchantId}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.SubmitChargebackRepresentment(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), chargebackErrorEvidenceRequired, rsp.Message)
+
+	req1 := &grpc.CloseChargebackRequest{ChargebackId: chargeback.Id, IsWon: true}
+	rsp1 := &grpc.ChargebackResponse{}
+	err = suite.service.CloseChargeback(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp1.Status)
+	assert.Equal(suite.T(), chargebackErrorStatusChangeNotAllowed, rsp1.Message)
+
+	req2 := &grpc.ChargebackRequest{ChargebackId: chargeback.Id, MerchantId: bson.NewObjectId().Hex()}
+	rsp2 := &grpc.ChargebackResponse{}
+	err = suite.service.GetChargeback(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp2.Status)
+	assert.Equal(suite.T(), chargebackErrorNotFound, rsp2.Message)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_ProcessExpiredChargebacks_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	req := &grpc.CreateChargebackRequest{
+		OrderId:         order.Uuid,
+		ExternalId:      bson.NewObjectId().Hex(),
+		EvidenceDueDate: time.Now().Add(-time.Hour).Unix(),
+	}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.CreateChargeback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.True(suite.T(), rsp.Item.IsEvidenceDueDateExpired())
+
+	req1 := &grpc.UploadChargebackEvidenceRequest{
+		ChargebackId: rsp.Item.Id,
+		MerchantId:   rsp.Item.MerchantId,
+		FileName:     "receipt.pdf",
+		FileUrl:      "https://unit.test/receipt.pdf",
+	}
+	rsp1 := &grpc.ChargebackResponse{}
+	err = suite.service.UploadChargebackEvidence(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp1.Status)
+	assert.Equal(suite.T(), chargebackErrorEvidenceDueDateExpired, rsp1.Message)
+
+	err = suite.service.ProcessExpiredChargebacks()
+	assert.NoError(suite.T(), err)
+
+	chargeback, err := suite.service.getChargeback(rsp.Item.Id, "")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ChargebackStatusLost, chargeback.Status)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_ListChargebacks_Ok() {
+	order1 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order2 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	chargeback := suite.helperCreateChargeback(order1, 0)
+	suite.helperCreateChargeback(order2, 0)
+
+	req := &grpc.ListChargebacksRequest{MerchantId: chargeback.MerchantId}
+	rsp := &grpc.ListChargebacksResponse{}
+	err := suite.service.ListChargebacks(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 2, rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 2)
+
+	req.OrderId = order1.Uuid
+	rsp = &grpc.ListChargebacksResponse{}
+	err = suite.service.ListChargebacks(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 1, rsp.Count)
+	assert.Equal(suite.T(), chargeback.Id, rsp.Items[0].Id)
+
+	req.OrderId = ""
+	req.Status = []string{pkg.ChargebackStatusWon}
+	rsp = &grpc.ListChargebacksResponse{}
+	err = suite.service.ListChargebacks(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), rsp.Count)
+	assert.Empty(suite.T(), rsp.Items)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_RollingReserveAdjusted_Ok() {
+	merchant, err := suite.service.merchant.GetById(suite.project.MerchantId)
+	assert.NoError(suite.T(), err)
+
+	merchant.RollingReserveThreshold = 10
+	merchant.RollingReserveChargebackTransactionsThreshold = 20
+	merchant.RollingReserveDays = 30
+	err = suite.service.merchant.Update(merchant)
+	assert.NoError(suite.T(), err)
+
+	order1 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order2 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	// reserve from each payment net revenue is held automatically
+	reserve, err := suite.service.getRollingReserveForBalance(merchant.Id, merchant.GetPayoutCurrency())
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), reserve > 0)
+	assert.True(suite.T(), reserve < money.Round((order1.TotalPaymentAmount+order2.TotalPaymentAmount)*0.1, merchant.GetPayoutCurrency()))
+
+	suite.helperCreateChargeback(order1, 0)
+
+	reserve, err = suite.service.getRollingReserveForBalance(merchant.Id, merchant.GetPayoutCurrency())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), money.Round((order1.TotalPaymentAmount+order2.TotalPaymentAmount)*0.1, merchant.GetPayoutCurrency()), reserve)
+
+	// reserve already covers required amount and must not be increased by next chargeback
+	suite.helperCreateChargeback(order2, 0)
+
+	reserve1, err := suite.service.getRollingReserveForBalance(merchant.Id, merchant.GetPayoutCurrency())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), reserve, reserve1)
+}
+
+func (suite *ChargebackTestSuite) TestChargeback_RollingReserveThresholdNotExceeded_Ok() {
+	merchant, err := suite.service.merchant.GetById(suite.project.MerchantId)
+	assert.NoError(suite.T(), err)
+
+	merchant.RollingReserveThreshold = 10
+	merchant.RollingReserveChargebackTransactionsThreshold = 60
+	err = suite.service.merchant.Update(merchant)
+	assert.NoError(suite.T(), err)
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	suite.helperCreateChargeback(order, 0)
+
+	reserve, err := suite.service.getRollingReserveForBalance(merchant.Id, merchant.GetPayoutCurrency())
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), reserve)
+}
+
+func (suite *ChargebackTestSuite) helperCreateChargeback(order *billing.Order, amount float64) *billing.Chargeback {
+	req := &grpc.CreateChargebackRequest{
+		OrderId:    order.Uuid,
+		ExternalId: bson.NewObjectId().Hex(),
+		Amount:     amount,
+		ReasonCode: "4853",
+		Reason:     "Cardholder dispute",
+	}
+	rsp := &grpc.ChargebackResponse{}
+	err := suite.service.CreateChargeback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Empty(suite.T(), rsp.Message)
+
+	return rsp.Item
+}
+
+func (suite *ChargebackTestSuite) helperGetChargebackAccountingEntries(id string) map[string]*billing.AccountingEntry {
+	var accountingEntries []*billing.AccountingEntry
+	err := suite.service.db.Collection(collectionAccountingEntry).
+		Find(bson.M{"source.id": bson.ObjectIdHex(id), "source.type": collectionChargeback}).All(&accountingEntries)
+	assert.NoError(suite.T(), err)
+
+	entries := make(map[string]*billing.AccountingEntry)
+
+	for _, v := range accountingEntries {
+		entries[v.Type] = v
+	}
+
+	return entries
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"go.uber.org/zap"
+	"time"
 )
 
 const (
@@ -56,7 +57,7 @@ func (s *Service) GetCountry(
 	req *billing.GetCountryRequest,
 	res *billing.Country,
 ) error {
-	country, err := s.country.GetByIsoCodeA2(req.IsoCode)
+	country, err := s.getCountryForDate(req.IsoCode, time.Now())
 	if err != nil {
 		return err
 	}
@@ -67,6 +68,7 @@ func (s *Service) GetCountry(
 	res.ChangeAllowed = country.ChangeAllowed
 	res.VatEnabled = country.VatEnabled
 	res.VatCurrency = country.VatCurrency
+	res.VatRate = country.VatRate
 	res.PriceGroupId = country.PriceGroupId
 	res.VatThreshold = country.VatThreshold
 	res.VatPeriodMonth = country.VatPeriodMonth
@@ -116,6 +118,7 @@ func (s *Service) UpdateCountry(
 		ChangeAllowed:          req.ChangeAllowed,
 		VatEnabled:             req.VatEnabled,
 		VatCurrency:            req.VatCurrency,
+		VatRate:                req.VatRate,
 		PriceGroupId:           pg.Id,
 		VatThreshold:           threshold,
 		VatPeriodMonth:         req.VatPeriodMonth,
@@ -127,12 +130,26 @@ func (s *Service) UpdateCountry(
 		UpdatedAt:              ptypes.TimestampNow(),
 	}
 
+	vatSettingsChanged := isCountryVatSettingsChanged(country, update)
+
+	if vatSettingsChanged {
+		if err = s.initCountryVatSettingsHistory(country); err != nil {
+			return err
+		}
+	}
+
 	err = s.country.Update(update)
 	if err != nil {
 		zap.S().Errorf("update country failed", "err", err.Error(), "data", update)
 		return err
 	}
 
+	if vatSettingsChanged {
+		if err = s.insertCountryVatSettings(update, time.Now()); err != nil {
+			return err
+		}
+	}
+
 	res.IsoCodeA2 = update.IsoCodeA2
 	res.Region = update.Region
 	res.Currency = update.Currency
@@ -140,6 +157,7 @@ func (s *Service) UpdateCountry(
 	res.ChangeAllowed = update.ChangeAllowed
 	res.VatEnabled = update.VatEnabled
 	res.VatCurrency = update.VatCurrency
+	res.VatRate = update.VatRate
 	res.PriceGroupId = update.PriceGroupId
 	res.VatThreshold = update.VatThreshold
 	res.VatPeriodMonth = update.VatPeriodMonth
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/internal/mocks"
 	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	mongodb "github.com/paysuper/paysuper-database-mongo"
 	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +19,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 	"testing"
+	"time"
 )
 
 type CountryTestSuite struct {
@@ -255,3 +259,109 @@ func (suite *CountryTestSuite) TestCountry_GetCountriesWithVatEnabled_Ok() {
 	err = suite.service.cacher.Get(cacheCountriesWithVatEnabled, c5)
 	assert.EqualError(suite.T(), err, "redis: nil")
 }
+
+func (suite *CountryTestSuite) TestCountry_ScheduleCountryVatSettings_Ok() {
+	effectiveFrom := time.Now().AddDate(0, 1, 0)
+	req := &billing.CountryVatSettings{
+		Country:                "RU",
+		VatEnabled:             true,
+		VatCurrency:            "RUB",
+		VatRate:                0.2,
+		VatThreshold:           &billing.CountryVatThreshold{},
+		VatPeriodMonth:         1,
+		VatDeadlineDays:        20,
+		VatStoreYears:          5,
+		VatCurrencyRatesPolicy: pkg.VatCurrencyRatesPolicyOnDay,
+		VatCurrencyRatesSource: "cbrf",
+	}
+	req.EffectiveFrom, _ = ptypes.TimestampProto(effectiveFrom)
+
+	rsp := &grpc.CountryVatSettingsResponse{}
+	err := suite.service.ScheduleCountryVatSettings(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.NotNil(suite.T(), rsp.Item)
+
+	country, err := suite.service.getCountryForDate("RU", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 3, country.VatPeriodMonth)
+	assert.Zero(suite.T(), country.VatRate)
+
+	country, err = suite.service.getCountryForDate("RU", effectiveFrom.Add(time.Second))
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 1, country.VatPeriodMonth)
+	assert.Equal(suite.T(), 0.2, country.VatRate)
+	assert.EqualValues(suite.T(), 20, country.VatDeadlineDays)
+	assert.Equal(suite.T(), pkg.VatCurrencyRatesPolicyOnDay, country.VatCurrencyRatesPolicy)
+
+	history := &grpc.CountryVatSettingsHistoryResponse{}
+	err = suite.service.GetCountryVatSettingsHistory(context.TODO(), &billing.GetCountryRequest{IsoCode: "RU"}, history)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, history.Status)
+	assert.Len(suite.T(), history.Items, 2)
+	assert.EqualValues(suite.T(), 3, history.Items[0].VatPeriodMonth)
+	assert.EqualValues(suite.T(), 1, history.Items[1].VatPeriodMonth)
+}
+
+func (suite *CountryTestSuite) TestCountry_ScheduleCountryVatSettings_EffectiveDateInPast_Error() {
+	req := &billing.CountryVatSettings{
+		Country:    "RU",
+		VatEnabled: true,
+	}
+	req.EffectiveFrom, _ = ptypes.TimestampProto(time.Now().AddDate(0, 0, -1))
+
+	rsp := &grpc.CountryVatSettingsResponse{}
+	err := suite.service.ScheduleCountryVatSettings(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorCountryVatSettingsEffectiveDateInvalid, rsp.Message)
+}
+
+func (suite *CountryTestSuite) TestCountry_ScheduleCountryVatSettings_CountryNotFound_Error() {
+	req := &billing.CountryVatSettings{
+		Country:    "ZZ",
+		VatEnabled: true,
+	}
+	req.EffectiveFrom, _ = ptypes.TimestampProto(time.Now().AddDate(0, 1, 0))
+
+	rsp := &grpc.CountryVatSettingsResponse{}
+	err := suite.service.ScheduleCountryVatSettings(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), errorCountryNotFound, rsp.Message)
+}
+
+func (suite *CountryTestSuite) TestCountry_UpdateCountry_VatSettingsHistory() {
+	before := time.Now().Add(-time.Second)
+
+	req := &billing.Country{
+		IsoCodeA2:              "RU",
+		Region:                 suite.country.Region,
+		Currency:               suite.country.Currency,
+		PaymentsAllowed:        true,
+		ChangeAllowed:          true,
+		VatEnabled:             true,
+		VatCurrency:            "RUB",
+		VatRate:                0.18,
+		PriceGroupId:           suite.country.PriceGroupId,
+		VatPeriodMonth:         1,
+		VatDeadlineDays:        25,
+		VatStoreYears:          5,
+		VatCurrencyRatesPolicy: "last-day",
+		VatCurrencyRatesSource: "cbrf",
+	}
+	rsp := &billing.Country{}
+	err := suite.service.UpdateCountry(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0.18, rsp.VatRate)
+
+	country, err := suite.service.getCountryForDate("RU", before)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 3, country.VatPeriodMonth)
+	assert.Zero(suite.T(), country.VatRate)
+
+	country, err = suite.service.getCountryForDate("RU", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 1, country.VatPeriodMonth)
+	assert.Equal(suite.T(), 0.18, country.VatRate)
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	collectionCountryVatSettings = "country_vat_settings"
+)
+
+var (
+	errorCountryVatSettingsEffectiveDateInvalid = newBillingServerErrorMsg("cv000001", "effective date of scheduled vat settings must be in future")
+	errorCountryVatSettingsUnknown              = newBillingServerErrorMsg("cv000002", "unknown error. try request later")
+)
+
+// ScheduleCountryVatSettings saves vat settings of country which become effective from date in future.
+// Settings with the same effective date are replaced.
+func (s *Service) ScheduleCountryVatSettings(
+	ctx context.Context,
+	req *billing.CountryVatSettings,
+	rsp *grpc.CountryVatSettingsResponse,
+) error {
+	country, err := s.country.GetByIsoCodeA2(strings.ToUpper(req.Country))
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = errorCountryNotFound
+		return nil
+	}
+
+	effectiveFrom, err := ptypes.Timestamp(req.EffectiveFrom)
+
+	if err != nil || !effectiveFrom.After(time.Now()) {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = errorCountryVatSettingsEffectiveDateInvalid
+		return nil
+	}
+
+	if err = s.initCountryVatSettingsHistory(country); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorCountryVatSettingsUnknown
+		return nil
+	}
+
+	settings := &billing.CountryVatSettings{
+		Id:                     bson.NewObjectId().Hex(),
+		Country:                country.IsoCodeA2,
+		VatEnabled:             req.VatEnabled,
+		VatCurrency:            req.VatCurrency,
+		VatRate:                req.VatRate,
+		VatThreshold:           req.VatThreshold,
+		VatPeriodMonth:         req.VatPeriodMonth,
+		VatDeadlineDays:        req.VatDeadlineDays,
+		VatStoreYears:          req.VatStoreYears,
+		VatCurrencyRatesPolicy: req.VatCurrencyRatesPolicy,
+		VatCurrencyRatesSource: req.VatCurrencyRatesSource,
+		EffectiveFrom:          req.EffectiveFrom,
+		CreatedAt:              ptypes.TimestampNow(),
+	}
+
+	if settings.VatThreshold == nil {
+		settings.VatThreshold = &billing.CountryVatThreshold{}
+	}
+
+	query := bson.M{"country": settings.Country, "effective_from": effectiveFrom}
+
+	var scheduled *billing.CountryVatSettings
+	err = s.db.Collection(collectionCountryVatSettings).Find(query).One(&scheduled)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionCountryVatSettings),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorCountryVatSettingsUnknown
+		return nil
+	}
+
+	if scheduled != nil {
+		settings.Id = scheduled.Id
+	}
+
+	_, err = s.db.Collection(collectionCountryVatSettings).UpsertId(bson.ObjectIdHex(settings.Id), settings)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionCountryVatSettings),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationUpsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, settings),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorCountryVatSettingsUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = settings
+
+	return nil
+}
+
+func (s *Service) GetCountryVatSettingsHistory(
+	ctx context.Context,
+	req *billing.GetCountryRequest,
+	rsp *grpc.CountryVatSettingsHistoryResponse,
+) error {
+	query := bson.M{"country": strings.ToUpper(req.IsoCode)}
+	err := s.db.Collection(collectionCountryVatSettings).Find(query).Sort("effective_from").All(&rsp.Items)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionCountryVatSettings),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorCountryVatSettingsUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// getCountryForDate returns country with vat settings effective at date
+func (s *Service) getCountryForDate(code string, date time.Time) (*billing.Country, error) {
+	country, err := s.country.GetByIsoCodeA2(code)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.applyCountryVatSettings(country, date)
+}
+
+// applyCountryVatSettings returns copy of country with vat settings effective at date,
+// country returned as is if country has no history of vat settings
+func (s *Service) applyCountryVatSettings(country *billing.Country, date time.Time) (*billing.Country, error) {
+	query := bson.M{"country": country.IsoCodeA2, "effective_from": bson.M{"$lte": date}}
+
+	var settings *billing.CountryVatSettings
+	err := s.db.Collection(collectionCountryVatSettings).Find(query).Sort("-effective_from").One(&settings)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return country, nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionCountryVatSettings),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		return nil, err
+	}
+
+	c := *country
+	c.VatEnabled = settings.VatEnabled
+	c.VatCurrency = settings.VatCurrency
+	c.VatRate = settings.VatRate
+	c.VatThreshold = settings.VatThreshold
+	c.VatPeriodMonth = settings.VatPeriodMonth
+	c.VatDeadlineDays = settings.VatDeadlineDays
+	c.VatStoreYears = settings.VatStoreYears
+	c.VatCurrencyRatesPolicy = settings.VatCurrencyRatesPolicy
+	c.VatCurrencyRatesSource = settings.VatCurrencyRatesSource
+
+	if c.VatThreshold == nil {
+		c.VatThreshold = &billing.CountryVatThreshold{}
+	}
+
+	return &c, nil
+}
+
+// initCountryVatSettingsHistory saves current vat settings of country as effective for all past periods
+// if country has no history of vat settings yet
+func (s *Service) initCountryVatSettingsHistory(country *billing.Country) error {
+	query := bson.M{"country": country.IsoCodeA2}
+	n, err := s.db.Collection(collectionCountryVatSettings).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionCountryVatSettings),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	if n > 0 {
+		return nil
+	}
+
+	return s.insertCountryVatSettings(country, time.Unix(0, 0))
+}
+
+func (s *Service) insertCountryVatSettings(country *billing.Country, effectiveFrom time.Time) error {
+	settings := &billing.CountryVatSettings{
+		Id:                     bson.NewObjectId().Hex(),
+		Country:                country.IsoCodeA2,
+		VatEnabled:             country.VatEnabled,
+		VatCurrency:            country.VatCurrency,
+		VatRate:                country.VatRate,
+		VatThreshold:           country.VatThreshold,
+		VatPeriodMonth:         country.VatPeriodMonth,
+		VatDeadlineDays:        country.VatDeadlineDays,
+		VatStoreYears:          country.VatStoreYears,
+		VatCurrencyRatesPolicy: country.VatCurrencyRatesPolicy,
+		VatCurrencyRatesSource: country.VatCurrencyRatesSource,
+		CreatedAt:              ptypes.TimestampNow(),
+	}
+
+	var err error
+	settings.EffectiveFrom, err = ptypes.TimestampProto(effectiveFrom)
+
+	if err != nil {
+		return err
+	}
+
+	err = s.db.Collection(collectionCountryVatSettings).Insert(settings)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionCountryVatSettings),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, settings),
+		)
+	}
+
+	return err
+}
+
+func isCountryVatSettingsChanged(current, update *billing.Country) bool {
+	return current.VatEnabled != update.VatEnabled ||
+		current.VatCurrency != update.VatCurrency ||
+		current.VatRate != update.VatRate ||
+		current.GetVatThreshold().GetYear() != update.GetVatThreshold().GetYear() ||
+		current.GetVatThreshold().GetWorld() != update.GetVatThreshold().GetWorld() ||
+		current.VatPeriodMonth != update.VatPeriodMonth ||
+		current.VatDeadlineDays != update.VatDeadlineDays ||
+		current.VatStoreYears != update.VatStoreYears ||
+		current.VatCurrencyRatesPolicy != update.VatCurrencyRatesPolicy ||
+		current.VatCurrencyRatesSource != update.VatCurrencyRatesSource
+}
+
+// getOrderPaymentDate returns date when order was paid, current date returned for not paid orders
+func getOrderPaymentDate(order *billing.Order) time.Time {
+	if order.PaymentMethodOrderClosedAt != nil {
+		t, err := ptypes.Timestamp(order.PaymentMethodOrderClosedAt)
+
+		if err == nil && t.Unix() > 0 {
+			return t
+		}
+	}
+
+	return time.Now()
+}
@@ -60,6 +60,10 @@ type balanceQueryResItem struct {
 	Amount float64 `bson:"amount"`
 }
 
+type balanceMinorQueryResItem struct {
+	Amount int64 `bson:"amount"`
+}
+
 func contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/ProtocolONE/geoip-service/pkg/proto"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	curPkg "github.com/paysuper/paysuper-currencies/pkg"
+	"github.com/paysuper/paysuper-currencies/pkg/proto/currencies"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	collectionFraudRule = "fraud_rule"
+
+	fraudRuleBinLength = 6
+)
+
+var (
+	fraudRuleErrorProjectNotFound  = newBillingServerErrorMsg("fr000001", "project for fraud rule not found")
+	fraudRuleErrorNotFound         = newBillingServerErrorMsg("fr000002", "fraud rule not found")
+	fraudRuleErrorTypeInvalid      = newBillingServerErrorMsg("fr000003", "fraud rule type is invalid")
+	fraudRuleErrorDecisionInvalid  = newBillingServerErrorMsg("fr000004", "fraud rule decision is invalid")
+	fraudRuleErrorFieldInvalid     = newBillingServerErrorMsg("fr000005", "fraud rule field is invalid for rule type")
+	fraudRuleErrorVelocityInvalid  = newBillingServerErrorMsg("fr000006", "fraud velocity rule must have max count and period")
+	fraudRuleErrorAmountInvalid    = newBillingServerErrorMsg("fr000007", "fraud amount rule must have amount and currency")
+	fraudRuleErrorValuesRequired   = newBillingServerErrorMsg("fr000008", "fraud block list rule must have values")
+	fraudRuleErrorUnknown          = newBillingServerErrorMsg("fr000009", "unknown error. try request later")
+	fraudErrorExchangeFailed       = newBillingServerErrorMsg("fr000010", "currency exchange for fraud amount rule failed")
+	fraudErrorPaymentDeclined      = newBillingServerErrorMsg("fr000011", "payment declined by anti-fraud rules")
+	fraudErrorPayerCountryNotFound = newBillingServerErrorMsg("fr000012", "payer country by ip for fraud rule not found")
+
+	fraudDecisionPriorities = map[string]int{
+		pkg.FraudDecisionAllow:   0,
+		pkg.FraudDecisionReview:  1,
+		pkg.FraudDecision3ds:     2,
+		pkg.FraudDecisionDecline: 3,
+	}
+
+	fraudRuleVelocityFields = map[string]string{
+		pkg.FraudRuleFieldCustomer: "user.id",
+		pkg.FraudRuleFieldCard:     "payment_requisites." + paymentCreateBankCardFieldFingerprint,
+		pkg.FraudRuleFieldIp:       "user.ip",
+		pkg.FraudRuleFieldEmail:    "user.email",
+	}
+
+	fraudRuleBlockListFields = map[string]bool{
+		pkg.FraudRuleFieldCustomer: true,
+		pkg.FraudRuleFieldCard:     true,
+		pkg.FraudRuleFieldIp:       true,
+		pkg.FraudRuleFieldEmail:    true,
+		pkg.FraudRuleFieldCountry:  true,
+		pkg.FraudRuleFieldBin:      true,
+	}
+)
+
+func (s *Service) CreateOrUpdateFraudRule(
+	ctx context.Context,
+	req *billing.FraudRule,
+	rsp *grpc.FraudRuleResponse,
+) error {
+	project, err := s.project.GetById(req.ProjectId)
+
+	if err != nil || project.MerchantId != req.MerchantId {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = fraudRuleErrorProjectNotFound
+		return nil
+	}
+
+	if err := s.validateFraudRule(req); err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+		return nil
+	}
+
+	if req.Id == "" {
+		req.Id = bson.NewObjectId().Hex()
+		req.CreatedAt = ptypes.TimestampNow()
+	} else {
+		rule, err := s.getFraudRule(req.Id, req.MerchantId)
+
+		if err != nil || rule.ProjectId != req.ProjectId {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = fraudRuleErrorNotFound
+			return nil
+		}
+
+		req.CreatedAt = rule.CreatedAt
+	}
+
+	req.UpdatedAt = ptypes.TimestampNow()
+	_, err = s.db.Collection(collectionFraudRule).UpsertId(bson.ObjectIdHex(req.Id), req)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionFraudRule),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, req),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = fraudRuleErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = req
+
+	return nil
+}
+
+func (s *Service) ListFraudRules(
+	ctx context.Context,
+	req *grpc.ListFraudRulesRequest,
+	rsp *grpc.ListFraudRulesResponse,
+) error {
+	project, err := s.project.GetById(req.ProjectId)
+
+	if err != nil || project.MerchantId != req.MerchantId {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = fraudRuleErrorProjectNotFound
+		return nil
+	}
+
+	query := bson.M{"project_id": bson.ObjectIdHex(req.ProjectId)}
+	err = s.db.Collection(collectionFraudRule).Find(query).Sort("_id").All(&rsp.Items)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionFraudRule),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = fraudRuleErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+func (s *Service) DeleteFraudRule(
+	ctx context.Context,
+	req *grpc.FraudRuleRequest,
+	rsp *grpc.FraudRuleResponse,
+) error {
+	rule, err := s.getFraudRule(req.RuleId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = fraudRuleErrorNotFound
+		return nil
+	}
+
+	err = s.db.Collection(collectionFraudRule).RemoveId(bson.ObjectIdHex(rule.Id))
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionFraudRule),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, rule.Id),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = fraudRuleErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = rule
+
+	return nil
+}
+
+func (s *Service) validateFraudRule(rule *billing.FraudRule) error {
+	if _, ok := fraudDecisionPriorities[rule.Decision]; !ok {
+		return fraudRuleErrorDecisionInvalid
+	}
+
+	switch rule.Type {
+	case pkg.FraudRuleTypeVelocity:
+		if _, ok := fraudRuleVelocityFields[rule.Field]; !ok {
+			return fraudRuleErrorFieldInvalid
+		}
+
+		if rule.MaxCount <= 0 || rule.Period <= 0 {
+			return fraudRuleErrorVelocityInvalid
+		}
+	case pkg.FraudRuleTypeAmount:
+		if rule.Amount <= 0 || rule.Currency == "" {
+			return fraudRuleErrorAmountInvalid
+		}
+	case pkg.FraudRuleTypeBlockList:
+		if !fraudRuleBlockListFields[rule.Field] {
+			return fraudRuleErrorFieldInvalid
+		}
+
+		if len(rule.Values) <= 0 {
+			return fraudRuleErrorValuesRequired
+		}
+	case pkg.FraudRuleTypeCountryMismatch:
+		break
+	default:
+		return fraudRuleErrorTypeInvalid
+	}
+
+	return nil
+}
+
+func (s *Service) getFraudRule(id, merchantId string) (*billing.FraudRule, error) {
+	if bson.IsObjectIdHex(id) == false || bson.IsObjectIdHex(merchantId) == false {
+		return nil, fraudRuleErrorNotFound
+	}
+
+	var rule *billing.FraudRule
+	query := bson.M{"_id": bson.ObjectIdHex(id), "merchant_id": bson.ObjectIdHex(merchantId)}
+	err := s.db.Collection(collectionFraudRule).Find(query).One(&rule)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionFraudRule),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, fraudRuleErrorNotFound
+	}
+
+	return rule, nil
+}
+
+// checkOrderFraud evaluate all active fraud rules of order project and return
+// summary score of triggered rules with the most strict decision from them
+func (s *Service) checkOrderFraud(order *billing.Order) (*billing.OrderFraudCheck, error) {
+	var rules []*billing.FraudRule
+	query := bson.M{"project_id": bson.ObjectIdHex(order.Project.Id), "is_active": true}
+	err := s.db.Collection(collectionFraudRule).Find(query).Sort("_id").All(&rules)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionFraudRule),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, fraudRuleErrorUnknown
+	}
+
+	check := &billing.OrderFraudCheck{
+		Decision:  pkg.FraudDecisionAllow,
+		CheckedAt: ptypes.TimestampNow(),
+	}
+
+	for _, rule := range rules {
+		triggered, err := s.isFraudRuleTriggered(rule, order)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !triggered {
+			continue
+		}
+
+		check.Score += rule.Score
+		check.Rules = append(check.Rules, &billing.OrderFraudCheckRule{
+			RuleId:   rule.Id,
+			Name:     rule.Name,
+			Type:     rule.Type,
+			Score:    rule.Score,
+			Decision: rule.Decision,
+		})
+
+		if fraudDecisionPriorities[rule.Decision] > fraudDecisionPriorities[check.Decision] {
+			check.Decision = rule.Decision
+		}
+	}
+
+	return check, nil
+}
+
+func (s *Service) isFraudRuleTriggered(rule *billing.FraudRule, order *billing.Order) (bool, error) {
+	switch rule.Type {
+	case pkg.FraudRuleTypeVelocity:
+		return s.isFraudVelocityExceeded(rule, order)
+	case pkg.FraudRuleTypeCountryMismatch:
+		return s.isFraudCountryMismatch(order)
+	case pkg.FraudRuleTypeAmount:
+		return s.isFraudAmountExceeded(rule, order)
+	case pkg.FraudRuleTypeBlockList:
+		value := getFraudRuleFieldValue(rule.Field, order)
+
+		if value == "" {
+			return false, nil
+		}
+
+		for _, v := range rule.Values {
+			if strings.EqualFold(v, value) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Service) isFraudVelocityExceeded(rule *billing.FraudRule, order *billing.Order) (bool, error) {
+	value := getFraudRuleFieldValue(rule.Field, order)
+
+	// masked card numbers of different cards are equal often, so payments by card are counted by card fingerprint
+	if rule.Field == pkg.FraudRuleFieldCard {
+		value = order.PaymentRequisites[paymentCreateBankCardFieldFingerprint]
+	}
+
+	if value == "" {
+		return false, nil
+	}
+
+	query := bson.M{
+		"_id":                               bson.M{"$ne": bson.ObjectIdHex(order.Id)},
+		"project._id":                       bson.ObjectIdHex(order.Project.Id),
+		fraudRuleVelocityFields[rule.Field]: value,
+		"private_status":                    bson.M{"$ne": constant.OrderStatusNew},
+		"created_at":                        bson.M{"$gte": time.Now().Add(-time.Duration(rule.Period) * time.Second)},
+	}
+	count, err := s.db.Collection(collectionOrder).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return false, fraudRuleErrorUnknown
+	}
+
+	return int32(count) >= rule.MaxCount, nil
+}
+
+func (s *Service) isFraudCountryMismatch(order *billing.Order) (bool, error) {
+	binCountry := order.PaymentRequisites[paymentCreateBankCardFieldIssuerCountryIsoCode]
+
+	if binCountry == "" || order.User == nil || order.User.Ip == "" {
+		return false, nil
+	}
+
+	rsp, err := s.geo.GetIpData(context.TODO(), &proto.GeoIpDataRequest{IP: order.User.Ip})
+
+	// payer country is unknown, so rule is skipped instead of blocking payment
+	if err != nil || rsp.Country == nil {
+		zap.L().Error(
+			fraudErrorPayerCountryNotFound.Message,
+			zap.Error(err),
+			zap.String(errorFieldService, "GeoIpService"),
+			zap.String(errorFieldMethod, "GetIpData"),
+			zap.String("ip", order.User.Ip),
+		)
+		return false, nil
+	}
+
+	return rsp.Country.IsoCode != binCountry, nil
+}
+
+func (s *Service) isFraudAmountExceeded(rule *billing.FraudRule, order *billing.Order) (bool, error) {
+	amount := order.TotalPaymentAmount
+
+	if order.Currency != rule.Currency {
+		req := &currencies.ExchangeCurrencyCurrentCommonRequest{
+			From:     order.Currency,
+			To:       rule.Currency,
+			RateType: curPkg.RateTypePaysuper,
+			Amount:   amount,
+		}
+		rsp, err := s.curService.ExchangeCurrencyCurrentCommon(context.TODO(), req)
+
+		if err != nil {
+			zap.L().Error(
+				pkg.ErrorGrpcServiceCallFailed,
+				zap.Error(err),
+				zap.String(errorFieldService, "CurrencyRatesService"),
+				zap.String(errorFieldMethod, "ExchangeCurrencyCurrentCommon"),
+				zap.Any(errorFieldRequest, req),
+			)
+			return false, fraudErrorExchangeFailed
+		}
+
+		amount = rsp.ExchangedAmount
+	}
+
+	return amount >= rule.Amount, nil
+}
+
+func (s *Service) fraudNotifyMerchant(order *billing.Order) {
+	if order.Project.UrlFraudPayment == "" || order.FraudCheck.Decision == pkg.FraudDecisionAllow {
+		return
+	}
+
+	msg := &billing.FraudNotification{
+		OrderId:    order.Id,
+		ProjectId:  order.Project.Id,
+		Url:        order.Project.UrlFraudPayment,
+		FraudCheck: order.FraudCheck,
+		CreatedAt:  ptypes.TimestampNow(),
+	}
+	err := s.broker.Publish(pkg.FraudNotifyTopicName, msg, amqp.Table{"x-retry-count": int32(0)})
+
+	if err != nil {
+		zap.L().Error(
+			"Publish fraud notification failed",
+			zap.Error(err),
+			zap.String("topic", pkg.FraudNotifyTopicName),
+			zap.String("order_id", order.Id),
+		)
+	}
+}
+
+func getFraudRuleFieldValue(field string, order *billing.Order) string {
+	switch field {
+	case pkg.FraudRuleFieldCard:
+		return order.PaymentRequisites[pkg.PaymentCreateFieldPan]
+	case pkg.FraudRuleFieldBin:
+		pan := order.PaymentRequisites[pkg.PaymentCreateFieldPan]
+
+		if len(pan) < fraudRuleBinLength {
+			return ""
+		}
+
+		return pan[:fraudRuleBinLength]
+	case pkg.FraudRuleFieldCountry:
+		return order.GetCountry()
+	}
+
+	if order.User == nil {
+		return ""
+	}
+
+	switch field {
+	case pkg.FraudRuleFieldCustomer:
+		return order.User.Id
+	case pkg.FraudRuleFieldIp:
+		return order.User.Ip
+	case pkg.FraudRuleFieldEmail:
+		return order.User.Email
+	}
+
+	return ""
+}
+
+// getCardFingerprint return keyed hash of bank card number or payment system token of saved card,
+// which identifies card without storing its number
+func (s *Service) getCardFingerprint(card string) string {
+	h := hmac.New(sha256.New, []byte(s.cfg.CardFingerprintSecret))
+	h.Write([]byte(card))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
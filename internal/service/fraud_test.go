@@ -0,0 +1,465 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-redis/redis"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	rabbitmq "gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"testing"
+	"time"
+)
+
+type FraudTestSuite struct {
+	suite.Suite
+	service *Service
+	log     *zap.Logger
+	cache   internalPkg.CacheInterface
+
+	project       *billing.Project
+	paymentMethod *billing.PaymentMethod
+	paymentSystem *billing.PaymentSystem
+}
+
+func Test_Fraud(t *testing.T) {
+	suite.Run(t, new(FraudTestSuite))
+}
+
+func (suite *FraudTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+
+	m, err := migrate.New(
+		"file://../../migrations/tests",
+		cfg.MongoDsn)
+	assert.NoError(suite.T(), err, "Migrate init failed")
+
+	err = m.Up()
+	if err != nil && err.Error() != "no change" {
+		suite.FailNow("Migrations failed", "%v", err)
+	}
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.log, err = zap.NewProduction()
+
+	if err != nil {
+		suite.FailNow("Logger initialization failed", "%v", err)
+	}
+
+	broker, err := rabbitmq.NewBroker(cfg.BrokerAddress)
+
+	if err != nil {
+		suite.FailNow("Creating RabbitMQ publisher failed", "%v", err)
+	}
+
+	redisClient := database.NewRedis(
+		&redis.Options{
+			Addr:     cfg.RedisHost,
+			Password: cfg.RedisPassword,
+		},
+	)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		mocks.NewGeoIpServiceTestOk(),
+		mocks.NewRepositoryServiceOk(),
+		mocks.NewTaxServiceOkMock(),
+		broker,
+		redisClient,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		broker,
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	_, suite.project, suite.paymentMethod, suite.paymentSystem = helperCreateEntitiesForTests(suite.Suite, suite.service)
+
+	bin := &BinData{
+		Id:                 bson.NewObjectId(),
+		CardBin:            400000,
+		CardBrand:          "MASTERCARD",
+		CardType:           "DEBIT",
+		CardCategory:       "WORLD",
+		BankName:           "ALFA BANK",
+		BankCountryName:    "UNITED STATES",
+		BankCountryIsoCode: "US",
+	}
+	err = db.Collection(collectionBinData).Insert(bin)
+
+	if err != nil {
+		suite.FailNow("Insert BIN test data failed", "%v", err)
+	}
+}
+
+func (suite *FraudTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *FraudTestSuite) TestFraud_CreateOrUpdateFraudRule_Ok() {
+	rule := suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeVelocity,
+		Field:    pkg.FraudRuleFieldEmail,
+		MaxCount: 3,
+		Period:   3600,
+		Score:    10,
+		Decision: pkg.FraudDecisionReview,
+	})
+	assert.True(suite.T(), bson.IsObjectIdHex(rule.Id))
+	assert.NotNil(suite.T(), rule.CreatedAt)
+
+	rule.MaxCount = 5
+	rsp := &grpc.FraudRuleResponse{}
+	err := suite.service.CreateOrUpdateFraudRule(context.TODO(), rule, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rule, err = suite.service.getFraudRule(rule.Id, suite.project.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(5), rule.MaxCount)
+	assert.Equal(suite.T(), pkg.FraudRuleFieldEmail, rule.Field)
+}
+
+func (suite *FraudTestSuite) TestFraud_CreateOrUpdateFraudRule_ProjectNotFound() {
+	req := &billing.FraudRule{
+		MerchantId: bson.NewObjectId().Hex(),
+		ProjectId:  suite.project.Id,
+		Name:       "unit test",
+		Type:       pkg.FraudRuleTypeCountryMismatch,
+		Decision:   pkg.FraudDecisionReview,
+	}
+	rsp := &grpc.FraudRuleResponse{}
+	err := suite.service.CreateOrUpdateFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorProjectNotFound, rsp.Message)
+}
+
+func (suite *FraudTestSuite) TestFraud_CreateOrUpdateFraudRule_ValidationError() {
+	req := &billing.FraudRule{
+		MerchantId: suite.project.MerchantId,
+		ProjectId:  suite.project.Id,
+		Name:       "unit test",
+		Type:       pkg.FraudRuleTypeVelocity,
+		Field:      pkg.FraudRuleFieldCountry,
+		MaxCount:   1,
+		Period:     60,
+		Decision:   pkg.FraudDecisionDecline,
+	}
+	rsp := &grpc.FraudRuleResponse{}
+	err := suite.service.CreateOrUpdateFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorFieldInvalid, rsp.Message)
+
+	req.Field = pkg.FraudRuleFieldIp
+	req.Period = 0
+	rsp = &grpc.FraudRuleResponse{}
+	err = suite.service.CreateOrUpdateFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorVelocityInvalid, rsp.Message)
+
+	req.Type = pkg.FraudRuleTypeBlockList
+	rsp = &grpc.FraudRuleResponse{}
+	err = suite.service.CreateOrUpdateFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorValuesRequired, rsp.Message)
+
+	req.Type = pkg.FraudRuleTypeAmount
+	rsp = &grpc.FraudRuleResponse{}
+	err = suite.service.CreateOrUpdateFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorAmountInvalid, rsp.Message)
+
+	req.Type = "unknown"
+	rsp = &grpc.FraudRuleResponse{}
+	err = suite.service.CreateOrUpdateFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorTypeInvalid, rsp.Message)
+}
+
+func (suite *FraudTestSuite) TestFraud_ListFraudRules_Ok() {
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeCountryMismatch,
+		Score:    10,
+		Decision: pkg.FraudDecisionReview,
+	})
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeBlockList,
+		Field:    pkg.FraudRuleFieldIp,
+		Values:   []string{"127.0.0.2"},
+		Score:    100,
+		Decision: pkg.FraudDecisionDecline,
+	})
+
+	req := &grpc.ListFraudRulesRequest{
+		MerchantId: suite.project.MerchantId,
+		ProjectId:  suite.project.Id,
+	}
+	rsp := &grpc.ListFraudRulesResponse{}
+	err := suite.service.ListFraudRules(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Len(suite.T(), rsp.Items, 2)
+	assert.Equal(suite.T(), pkg.FraudRuleTypeCountryMismatch, rsp.Items[0].Type)
+	assert.Equal(suite.T(), pkg.FraudRuleTypeBlockList, rsp.Items[1].Type)
+}
+
+func (suite *FraudTestSuite) TestFraud_DeleteFraudRule_Ok() {
+	rule := suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeCountryMismatch,
+		Decision: pkg.FraudDecisionReview,
+	})
+
+	req := &grpc.FraudRuleRequest{RuleId: rule.Id, MerchantId: suite.project.MerchantId}
+	rsp := &grpc.FraudRuleResponse{}
+	err := suite.service.DeleteFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rsp = &grpc.FraudRuleResponse{}
+	err = suite.service.DeleteFraudRule(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), fraudRuleErrorNotFound, rsp.Message)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_WithoutRules_Allow() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.NotNil(suite.T(), order.FraudCheck)
+	assert.Equal(suite.T(), pkg.FraudDecisionAllow, order.FraudCheck.Decision)
+	assert.Zero(suite.T(), order.FraudCheck.Score)
+	assert.Empty(suite.T(), order.FraudCheck.Rules)
+	assert.NotNil(suite.T(), order.FraudCheck.CheckedAt)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_BlockList_Decline() {
+	rule := suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeBlockList,
+		Field:    pkg.FraudRuleFieldEmail,
+		Values:   []string{"TEST@unit.unit"},
+		Score:    100,
+		Decision: pkg.FraudDecisionDecline,
+	})
+
+	order := suite.helperCreateOrder(100)
+	rsp := suite.helperCreatePayment(order, "4000000000000002")
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudErrorPaymentDeclined, rsp.Message)
+
+	order, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemDeclined), order.PrivateStatus)
+	assert.Equal(suite.T(), pkg.FraudDecisionDecline, order.FraudCheck.Decision)
+	assert.Equal(suite.T(), int32(100), order.FraudCheck.Score)
+	assert.Len(suite.T(), order.FraudCheck.Rules, 1)
+	assert.Equal(suite.T(), rule.Id, order.FraudCheck.Rules[0].RuleId)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_MostStrictDecision() {
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeAmount,
+		Amount:   50,
+		Currency: "RUB",
+		Score:    10,
+		Decision: pkg.FraudDecisionReview,
+	})
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeCountryMismatch,
+		Score:    20,
+		Decision: pkg.FraudDecision3ds,
+	})
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeBlockList,
+		Field:    pkg.FraudRuleFieldBin,
+		Values:   []string{"555555"},
+		Score:    100,
+		Decision: pkg.FraudDecisionDecline,
+	})
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.Equal(suite.T(), pkg.FraudDecision3ds, order.FraudCheck.Decision)
+	assert.Equal(suite.T(), int32(30), order.FraudCheck.Score)
+	assert.Len(suite.T(), order.FraudCheck.Rules, 2)
+	assert.True(suite.T(), order.IsFraudCheck3dsRequired())
+
+	h := &cardPay{}
+	cpo, err := h.getCardPayOrder(order, "", "", order.PaymentRequisites)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cardPayThreeDsChallengeIndicatorMandate, cpo.PaymentData.ThreeDsChallengeIndicator)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_Velocity() {
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeVelocity,
+		Field:    pkg.FraudRuleFieldEmail,
+		MaxCount: 1,
+		Period:   3600,
+		Score:    50,
+		Decision: pkg.FraudDecisionDecline,
+	})
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.Equal(suite.T(), pkg.FraudDecisionAllow, order.FraudCheck.Decision)
+
+	order = suite.helperCreateOrder(100)
+	rsp := suite.helperCreatePayment(order, "4000000000000002")
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudErrorPaymentDeclined, rsp.Message)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_CardVelocity_ByFingerprint() {
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeVelocity,
+		Field:    pkg.FraudRuleFieldCard,
+		MaxCount: 1,
+		Period:   3600,
+		Score:    50,
+		Decision: pkg.FraudDecisionDecline,
+	})
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.Equal(suite.T(), pkg.FraudDecisionAllow, order.FraudCheck.Decision)
+	assert.NotEmpty(suite.T(), order.PaymentRequisites[paymentCreateBankCardFieldFingerprint])
+
+	// another card with the same masked number isn't counted
+	order = suite.helperCreateOrder(100)
+	rsp := suite.helperCreatePayment(order, "4000004200000002")
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order = suite.helperCreateOrder(100)
+	rsp = suite.helperCreatePayment(order, "4000000000000002")
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), fraudErrorPaymentDeclined, rsp.Message)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_GeoIpFailed_RuleSkipped() {
+	suite.helperCreateFraudRule(&billing.FraudRule{
+		Type:     pkg.FraudRuleTypeCountryMismatch,
+		Score:    100,
+		Decision: pkg.FraudDecisionDecline,
+	})
+
+	order := suite.helperCreateOrder(100)
+	suite.service.geo = mocks.NewGeoIpServiceTestError()
+	rsp := suite.helperCreatePayment(order, "4000000000000002")
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+}
+
+func (suite *FraudTestSuite) TestFraud_PaymentCreateProcess_InactiveRuleSkipped() {
+	rule := &billing.FraudRule{
+		MerchantId: suite.project.MerchantId,
+		ProjectId:  suite.project.Id,
+		Name:       "unit test",
+		Type:       pkg.FraudRuleTypeCountryMismatch,
+		Score:      100,
+		Decision:   pkg.FraudDecisionDecline,
+		IsActive:   false,
+	}
+	rsp := &grpc.FraudRuleResponse{}
+	err := suite.service.CreateOrUpdateFraudRule(context.TODO(), rule, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.Equal(suite.T(), pkg.FraudDecisionAllow, order.FraudCheck.Decision)
+}
+
+func (suite *FraudTestSuite) helperCreateFraudRule(rule *billing.FraudRule) *billing.FraudRule {
+	rule.MerchantId = suite.project.MerchantId
+	rule.ProjectId = suite.project.Id
+	rule.Name = "unit test"
+	rule.IsActive = true
+
+	rsp := &grpc.FraudRuleResponse{}
+	err := suite.service.CreateOrUpdateFraudRule(context.TODO(), rule, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Empty(suite.T(), rsp.Message)
+
+	return rsp.Item
+}
+
+func (suite *FraudTestSuite) helperCreateOrder(amount float64) *billing.Order {
+	req := &billing.OrderCreateRequest{
+		Type:        billing.OrderType_simple,
+		ProjectId:   suite.project.Id,
+		Amount:      amount,
+		Currency:    "RUB",
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+			Address: &billing.OrderBillingAddress{
+				Country: "RU",
+			},
+		},
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp.Item
+}
+
+func (suite *FraudTestSuite) helperCreatePayment(order *billing.Order, pan string) *grpc.PaymentCreateResponse {
+	req := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             pan,
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            time.Now().AddDate(1, 0, 0).Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "MR. CARD HOLDER",
+		},
+		Ip: "127.0.0.1",
+	}
+	rsp := &grpc.PaymentCreateResponse{}
+	err := suite.service.PaymentCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	return rsp
+}
@@ -1,11 +1,10 @@
 package service
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/errors"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
@@ -14,10 +13,14 @@ import (
 	"time"
 )
 
-const collectionKey = "key"
+const (
+	collectionKey         = "key"
+	collectionKeyAuditLog = "key_audit_log"
+
+	keyEncryptionRotateBatchSize = 100
+)
 
 func (s *Service) UploadKeysFile(ctx context.Context, req *grpc.PlatformKeysFileRequest, res *grpc.PlatformKeysFileResponse) error {
-	scanner := bufio.NewScanner(bytes.NewReader(req.File))
 	count, err := s.keyRepository.CountKeysByProductPlatform(req.KeyProductId, req.PlatformId)
 
 	if err != nil {
@@ -28,31 +31,42 @@ func (s *Service) UploadKeysFile(ctx context.Context, req *grpc.PlatformKeysFile
 	}
 
 	res.TotalCount = int32(count)
+	lines, err := parseKeysFile(req.File, req.Format)
 
-	// Process key by line
-	for scanner.Scan() {
-		key := &billing.Key{
-			Id:           bson.NewObjectId().Hex(),
-			Code:         scanner.Text(),
-			KeyProductId: req.KeyProductId,
-			PlatformId:   req.PlatformId,
+	// tell about errors
+	if err != nil {
+		zap.S().Errorf(errors.KeyErrorFileProcess.Message, "err", err.Error())
+		res.Message = errors.KeyErrorFileProcess
+		res.Status = pkg.ResponseStatusBadData
+
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			res.Message = e
 		}
 
-		if err := s.keyRepository.Insert(key); err != nil {
-			zap.S().Errorf(errors.KeyErrorFailedToInsert.Message, "err", err, "key", key)
+		return nil
+	}
+
+	codeHashes := make(map[string]bool)
+
+	// Process key by line
+	for _, line := range lines {
+		result := s.importKeysFileLine(req, line, codeHashes)
+		res.Lines = append(res.Lines, result)
+
+		if result.Status != pkg.KeysFileLineStatusOk {
+			res.ErrorsCount++
 			continue
 		}
 
-		res.TotalCount++
 		res.KeysProcessed++
+
+		if !req.DryRun {
+			res.TotalCount++
+		}
 	}
 
-	// tell about errors
-	if err = scanner.Err(); err != nil {
-		zap.S().Errorf(errors.KeyErrorFileProcess.Message, "err", err.Error())
-		res.Message = errors.KeyErrorFileProcess
-		res.Status = pkg.ResponseStatusBadData
-		return nil
+	if !req.DryRun && res.KeysProcessed > 0 {
+		s.checkKeysLowStock(ctx, req.KeyProductId, req.PlatformId)
 	}
 
 	res.Status = pkg.ResponseStatusOk
@@ -86,6 +100,11 @@ func (s *Service) GetKeyByID(ctx context.Context, req *grpc.KeyForOrderRequest,
 		return nil
 	}
 
+	_ = s.insertKeyAuditLog(req.KeyId, key, pkg.KeyAuditActionRead)
+
+	// key code is available only on redemption
+	key.Code = ""
+	hideKeyEncryptedData(key)
 	res.Key = key
 
 	return nil
@@ -109,6 +128,9 @@ func (s *Service) ReserveKeyForOrder(ctx context.Context, req *grpc.PlatformKeyR
 	}
 
 	zap.S().Infow("[ReserveKeyForOrder] reserved key", "req.order_id", req.OrderId, "key.order_id", key.OrderId, "key.id", key.Id, "key.RedeemedAt", key.RedeemedAt, "key.KeyProductId", key.KeyProductId)
+	_ = s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionReserve)
+
+	s.checkKeysLowStock(ctx, req.KeyProductId, req.PlatformId)
 
 	res.KeyId = key.Id
 	res.Status = pkg.ResponseStatusOk
@@ -126,6 +148,20 @@ func (s *Service) FinishRedeemKeyForOrder(ctx context.Context, req *grpc.KeyForO
 		return nil
 	}
 
+	// key code must not be decrypted if access to it can't be registered
+	if err = s.insertKeyAuditLog(req.KeyId, key, pkg.KeyAuditActionRedeem); err != nil {
+		res.Status = pkg.ResponseStatusSystemError
+		res.Message = errors.KeyErrorFinish
+		return nil
+	}
+
+	if err = s.decryptKeyCode(key); err != nil {
+		zap.S().Errorf(errors.KeyErrorDecrypt.Message, "err", err, "keyId", req.KeyId)
+		res.Status = pkg.ResponseStatusSystemError
+		res.Message = errors.KeyErrorDecrypt
+		return nil
+	}
+
 	res.Key = key
 	res.Status = pkg.ResponseStatusOk
 
@@ -133,7 +169,7 @@ func (s *Service) FinishRedeemKeyForOrder(ctx context.Context, req *grpc.KeyForO
 }
 
 func (s *Service) CancelRedeemKeyForOrder(ctx context.Context, req *grpc.KeyForOrderRequest, res *grpc.EmptyResponseWithStatus) error {
-	_, err := s.keyRepository.CancelById(req.KeyId)
+	key, err := s.keyRepository.CancelById(req.KeyId)
 
 	if err != nil {
 		zap.S().Errorf(errors.KeyErrorCanceled.Message, "err", err, "keyId", req.KeyId)
@@ -142,31 +178,95 @@ func (s *Service) CancelRedeemKeyForOrder(ctx context.Context, req *grpc.KeyForO
 		return nil
 	}
 
+	_ = s.insertKeyAuditLog(req.KeyId, key, pkg.KeyAuditActionCancel)
+
 	res.Status = pkg.ResponseStatusOk
 
 	return nil
 }
 
-func (s *Service) KeyDaemonProcess() (int, error) {
-	counter := 0
-	keys, err := s.keyRepository.FindUnfinished()
-
-	if err != nil {
-		return counter, err
-	}
+// RotateKeysEncryptionKey re-encrypts data keys of all keys with active master key of keys encryption provider.
+// Keys uploaded before encryption was enabled are encrypted too.
+func (s *Service) RotateKeysEncryptionKey(
+	ctx context.Context,
+	req *grpc.EmptyRequest,
+	res *grpc.RotateKeysEncryptionKeyResponse,
+) error {
+	masterKeyId := s.keyEncryption.GetActiveMasterKeyId()
 
-	for _, key := range keys {
-		_, err = s.keyRepository.CancelById(key.Id)
+	for {
+		keys, err := s.keyRepository.FindNotEncryptedWithMasterKey(masterKeyId, keyEncryptionRotateBatchSize)
 
 		if err != nil {
-			zap.S().Errorf(errors.KeyErrorCanceled.Message, "err", err, "keyId", key.Id)
-			continue
+			zap.S().Errorf(errors.KeyErrorRotate.Message, "err", err, "masterKeyId", masterKeyId)
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = errors.KeyErrorRotate
+			return nil
 		}
 
-		counter++
+		if len(keys) <= 0 {
+			break
+		}
+
+		processed := int32(0)
+
+		for _, key := range keys {
+			if err = s.rotateKeyEncryption(key); err != nil {
+				zap.S().Errorf(errors.KeyErrorRotate.Message, "err", err, "keyId", key.Id)
+				continue
+			}
+
+			if err = s.keyRepository.UpdateEncryption(key); err != nil {
+				zap.S().Errorf(errors.KeyErrorRotate.Message, "err", err, "keyId", key.Id)
+				continue
+			}
+
+			_ = s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionRotate)
+			processed++
+		}
+
+		res.KeysProcessed += processed
+
+		// all keys in batch can't be rotated, stop to prevent endless loop
+		if processed <= 0 {
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = errors.KeyErrorRotate
+			return nil
+		}
+	}
+
+	res.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// insertKeyAuditLog appends access to key to audit log, audit log records are never updated or deleted
+func (s *Service) insertKeyAuditLog(keyId string, key *billing.Key, action string) error {
+	log := &billing.KeyAuditLog{
+		Id:        bson.NewObjectId().Hex(),
+		KeyId:     keyId,
+		Action:    action,
+		CreatedAt: ptypes.TimestampNow(),
+	}
+
+	if key != nil {
+		log.KeyProductId = key.KeyProductId
+		log.PlatformId = key.PlatformId
+		log.OrderId = key.OrderId
+	}
+
+	err := s.db.Collection(collectionKeyAuditLog).Insert(log)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionKeyAuditLog),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, log),
+		)
 	}
 
-	return counter, nil
+	return err
 }
 
 type KeyRepositoryInterface interface {
@@ -176,7 +276,15 @@ type KeyRepositoryInterface interface {
 	CancelById(string) (*billing.Key, error)
 	FinishRedeemById(string) (*billing.Key, error)
 	CountKeysByProductPlatform(string, string) (int, error)
-	FindUnfinished() ([]*billing.Key, error)
+	ExpireReservation() (*billing.Key, error)
+	GetNextReservationExpiry() (time.Time, error)
+	GetStockStatistics() (int, int, int, error)
+	FindNotEncryptedWithMasterKey(string, int) ([]*billing.Key, error)
+	UpdateEncryption(*billing.Key) error
+	ExistsByCode(string, string, string) (bool, error)
+	FindUnsold(string, string, []string) ([]*billing.Key, error)
+	RevokeByIds([]string) (int, error)
+	DeleteByIds([]string) (int, error)
 }
 
 func newKeyRepository(svc *Service) *Key {
@@ -212,6 +320,7 @@ func (h *Key) ReserveKey(keyProductId string, platformId string, orderId string,
 		"key_product_id": bson.ObjectIdHex(keyProductId),
 		"platform_id":    platformId,
 		"order_id":       nil,
+		"revoked_at":     nil,
 	}
 	change := mgo.Change{
 		Update: bson.M{
@@ -297,24 +406,193 @@ func (h *Key) CountKeysByProductPlatform(keyProductId string, platformId string)
 		"key_product_id": bson.ObjectIdHex(keyProductId),
 		"platform_id":    platformId,
 		"order_id":       nil,
+		"revoked_at":     nil,
 	}
 
 	return h.svc.db.Collection(collectionKey).Find(query).Count()
 }
 
-func (h *Key) FindUnfinished() ([]*billing.Key, error) {
+// ExpireReservation returns to stock one key which reservation is over and not redeemed.
+// Reservation is cancelled atomically, so key redeemed concurrently is never returned to stock.
+// Key returned as it was before cancellation, nil returned if there are no expired reservations.
+func (h *Key) ExpireReservation() (*billing.Key, error) {
+	key := &billing.Key{}
+	query := bson.M{
+		"reserved_to": bson.M{
+			"$gt":  time.Time{},
+			"$lte": time.Now().UTC(),
+		},
+		"order_id": bson.M{"$ne": nil},
+	}
+	change := mgo.Change{
+		Update: bson.M{
+			"$set": bson.M{
+				"reserved_to": "",
+				"order_id":    nil,
+			},
+		},
+		ReturnNew: false,
+	}
+
+	_, err := h.svc.db.Collection(collectionKey).Find(query).Sort("reserved_to").Apply(change, key)
+
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// GetNextReservationExpiry returns time when the nearest active reservation is over,
+// zero time returned if there are no active reservations.
+func (h *Key) GetNextReservationExpiry() (time.Time, error) {
+	key := &billing.Key{}
+	query := bson.M{
+		"reserved_to": bson.M{"$gt": time.Now().UTC()},
+		"order_id":    bson.M{"$ne": nil},
+	}
+
+	err := h.svc.db.Collection(collectionKey).Find(query).Sort("reserved_to").One(key)
+
+	if err == mgo.ErrNotFound {
+		return time.Time{}, nil
+	}
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return ptypes.Timestamp(key.ReservedTo)
+}
+
+// GetStockStatistics returns number of available, reserved and sold keys
+func (h *Key) GetStockStatistics() (int, int, int, error) {
+	queries := []bson.M{
+		{"order_id": nil, "revoked_at": nil},
+		{"order_id": bson.M{"$ne": nil}, "redeemed_at": time.Time{}},
+		{"redeemed_at": bson.M{"$gt": time.Time{}}},
+	}
+	counts := make([]int, len(queries))
+
+	for i, query := range queries {
+		count, err := h.svc.db.Collection(collectionKey).Find(query).Count()
+
+		if err != nil {
+			return 0, 0, 0, err
+		}
+
+		counts[i] = count
+	}
+
+	return counts[0], counts[1], counts[2], nil
+}
+
+func (h *Key) FindNotEncryptedWithMasterKey(masterKeyId string, limit int) ([]*billing.Key, error) {
 	var keys []*billing.Key
 
+	query := bson.M{"master_key_id": bson.M{"$ne": masterKeyId}}
+
+	if err := h.svc.db.Collection(collectionKey).Find(query).Limit(limit).All(&keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (h *Key) UpdateEncryption(key *billing.Key) error {
+	update := bson.M{
+		"$set": bson.M{
+			"code_hash":          key.CodeHash,
+			"encrypted_code":     key.EncryptedCode,
+			"encrypted_data_key": key.EncryptedDataKey,
+			"master_key_id":      key.MasterKeyId,
+		},
+		"$unset": bson.M{"code": ""},
+	}
+
+	return h.svc.db.Collection(collectionKey).UpdateId(bson.ObjectIdHex(key.Id), update)
+}
+
+func (h *Key) ExistsByCode(platformId string, code string, codeHash string) (bool, error) {
 	query := bson.M{
-		"reserved_to": bson.M{
-			"$gt": time.Time{},
-			"$lt": time.Now().UTC(),
+		"platform_id": platformId,
+		"$or": []bson.M{
+			{"code_hash": codeHash},
+			{"code": code},
 		},
 	}
 
+	count, err := h.svc.db.Collection(collectionKey).Find(query).Count()
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (h *Key) FindUnsold(keyProductId string, platformId string, ids []string) ([]*billing.Key, error) {
+	var keys []*billing.Key
+
+	query := bson.M{
+		"key_product_id": bson.ObjectIdHex(keyProductId),
+		"platform_id":    platformId,
+		"order_id":       nil,
+	}
+
+	if len(ids) > 0 {
+		query["_id"] = bson.M{"$in": keyIdsToObjectIds(ids)}
+	}
+
 	if err := h.svc.db.Collection(collectionKey).Find(query).All(&keys); err != nil {
 		return nil, err
 	}
 
 	return keys, nil
 }
+
+func (h *Key) RevokeByIds(ids []string) (int, error) {
+	query := bson.M{
+		"_id":        bson.M{"$in": keyIdsToObjectIds(ids)},
+		"order_id":   nil,
+		"revoked_at": nil,
+	}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now().UTC()}}
+
+	info, err := h.svc.db.Collection(collectionKey).UpdateAll(query, update)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Updated, nil
+}
+
+func (h *Key) DeleteByIds(ids []string) (int, error) {
+	query := bson.M{
+		"_id":      bson.M{"$in": keyIdsToObjectIds(ids)},
+		"order_id": nil,
+	}
+
+	info, err := h.svc.db.Collection(collectionKey).RemoveAll(query)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Removed, nil
+}
+
+func keyIdsToObjectIds(ids []string) []bson.ObjectId {
+	objectIds := make([]bson.ObjectId, len(ids))
+
+	for i, id := range ids {
+		objectIds[i] = bson.ObjectIdHex(id)
+	}
+
+	return objectIds
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"io"
+	"strings"
+)
+
+const (
+	keyEncryptionDataKeyLength = 32
+
+	keyEncryptionErrorMasterKeysEmpty    = "keys encryption master keys not set"
+	keyEncryptionErrorMasterKeyInvalid   = "keys encryption master key \"%s\" has invalid format"
+	keyEncryptionErrorMasterKeyDuplicate = "keys encryption master key \"%s\" is duplicated"
+	keyEncryptionErrorMasterKeyNotFound  = "keys encryption master key \"%s\" not found"
+	keyEncryptionErrorCipherTextInvalid  = "encrypted data is too short"
+)
+
+// KeyEncryptionProviderInterface is the envelope encryption master keys provider.
+// Master keys never leave the provider, so the local provider can be replaced
+// with external KMS without changes in keys storage.
+type KeyEncryptionProviderInterface interface {
+	// GetActiveMasterKeyId returns identifier of master key used to encrypt new data keys
+	GetActiveMasterKeyId() string
+	// WrapDataKey encrypts data key with active master key and returns encrypted data key and master key identifier
+	WrapDataKey(dataKey []byte) ([]byte, string, error)
+	// UnwrapDataKey decrypts data key with master key by identifier
+	UnwrapDataKey(encryptedDataKey []byte, masterKeyId string) ([]byte, error)
+}
+
+type localKeyEncryptionProvider struct {
+	activeMasterKeyId string
+	masterKeys        map[string][]byte
+}
+
+func newLocalKeyEncryptionProvider(masterKeys []string) (KeyEncryptionProviderInterface, error) {
+	if len(masterKeys) <= 0 {
+		return nil, errors.New(keyEncryptionErrorMasterKeysEmpty)
+	}
+
+	p := &localKeyEncryptionProvider{masterKeys: make(map[string][]byte)}
+
+	for _, v := range masterKeys {
+		parts := strings.SplitN(strings.TrimSpace(v), ":", 2)
+
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf(keyEncryptionErrorMasterKeyInvalid, v)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+
+		if err != nil || len(key) != keyEncryptionDataKeyLength {
+			return nil, fmt.Errorf(keyEncryptionErrorMasterKeyInvalid, parts[0])
+		}
+
+		if _, ok := p.masterKeys[parts[0]]; ok {
+			return nil, fmt.Errorf(keyEncryptionErrorMasterKeyDuplicate, parts[0])
+		}
+
+		if p.activeMasterKeyId == "" {
+			p.activeMasterKeyId = parts[0]
+		}
+
+		p.masterKeys[parts[0]] = key
+	}
+
+	return p, nil
+}
+
+func (p *localKeyEncryptionProvider) GetActiveMasterKeyId() string {
+	return p.activeMasterKeyId
+}
+
+func (p *localKeyEncryptionProvider) WrapDataKey(dataKey []byte) ([]byte, string, error) {
+	encrypted, err := encryptAesGcm(p.masterKeys[p.activeMasterKeyId], dataKey)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return encrypted, p.activeMasterKeyId, nil
+}
+
+func (p *localKeyEncryptionProvider) UnwrapDataKey(encryptedDataKey []byte, masterKeyId string) ([]byte, error) {
+	masterKey, ok := p.masterKeys[masterKeyId]
+
+	if !ok {
+		return nil, fmt.Errorf(keyEncryptionErrorMasterKeyNotFound, masterKeyId)
+	}
+
+	return decryptAesGcm(masterKey, encryptedDataKey)
+}
+
+// encryptKeyCode encrypts key code with new random data key and wraps data key with active master key.
+// Plain key code removed from key after encryption.
+func (s *Service) encryptKeyCode(key *billing.Key) error {
+	dataKey := make([]byte, keyEncryptionDataKeyLength)
+
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return err
+	}
+
+	encryptedCode, err := encryptAesGcm(dataKey, []byte(key.Code))
+
+	if err != nil {
+		return err
+	}
+
+	encryptedDataKey, masterKeyId, err := s.keyEncryption.WrapDataKey(dataKey)
+
+	if err != nil {
+		return err
+	}
+
+	key.CodeHash = s.getKeyCodeHash(key.Code)
+	key.EncryptedCode = encryptedCode
+	key.EncryptedDataKey = encryptedDataKey
+	key.MasterKeyId = masterKeyId
+	key.Code = ""
+
+	return nil
+}
+
+// decryptKeyCode restores plain key code of key and removes encrypted data from key.
+// Keys uploaded before encryption was enabled returned as is.
+func (s *Service) decryptKeyCode(key *billing.Key) error {
+	if len(key.EncryptedCode) <= 0 {
+		return nil
+	}
+
+	dataKey, err := s.keyEncryption.UnwrapDataKey(key.EncryptedDataKey, key.MasterKeyId)
+
+	if err != nil {
+		return err
+	}
+
+	code, err := decryptAesGcm(dataKey, key.EncryptedCode)
+
+	if err != nil {
+		return err
+	}
+
+	key.Code = string(code)
+	hideKeyEncryptedData(key)
+
+	return nil
+}
+
+// rotateKeyEncryption re-wraps data key of key with active master key,
+// keys uploaded before encryption was enabled are encrypted
+func (s *Service) rotateKeyEncryption(key *billing.Key) error {
+	if len(key.EncryptedCode) <= 0 {
+		return s.encryptKeyCode(key)
+	}
+
+	dataKey, err := s.keyEncryption.UnwrapDataKey(key.EncryptedDataKey, key.MasterKeyId)
+
+	if err != nil {
+		return err
+	}
+
+	key.EncryptedDataKey, key.MasterKeyId, err = s.keyEncryption.WrapDataKey(dataKey)
+
+	return err
+}
+
+func (s *Service) getKeyCodeHash(code string) string {
+	h := hmac.New(sha256.New, []byte(s.cfg.KeyEncryptionHashSecret))
+	h.Write([]byte(code))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func hideKeyEncryptedData(key *billing.Key) {
+	key.CodeHash = ""
+	key.EncryptedCode = nil
+	key.EncryptedDataKey = nil
+	key.MasterKeyId = ""
+}
+
+func encryptAesGcm(key, data []byte) ([]byte, error) {
+	gcm, err := newAesGcm(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+func decryptAesGcm(key, data []byte) ([]byte, error) {
+	gcm, err := newAesGcm(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New(keyEncryptionErrorCipherTextInvalid)
+	}
+
+	nonce, cipherText := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, cipherText, nil)
+}
+
+func newAesGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,531 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/errors"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	postmarkSdrPkg "github.com/paysuper/postmark-sender/pkg"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	collectionKeyLowStockNotification = "key_low_stock_notification"
+
+	keysFileCsvCodeColumn = "code"
+	keyCodeMaxLength      = 50
+
+	keysFileLineErrorCodeEmpty       = "key code is empty"
+	keysFileLineErrorCodeTooLong     = "key code is longer than 50 characters"
+	keysFileLineErrorDuplicateInFile = "key code is duplicated in file"
+	keysFileLineErrorDuplicate       = "key code already uploaded for platform"
+)
+
+type keysFileLine struct {
+	number   int32
+	code     string
+	metadata map[string]string
+}
+
+// RevokePlatformKeys makes unsold keys of key product platform unavailable for sale
+func (s *Service) RevokePlatformKeys(
+	ctx context.Context,
+	req *grpc.ManagePlatformKeysRequest,
+	res *grpc.ManagePlatformKeysResponse,
+) error {
+	keys, msg := s.getMerchantUnsoldPlatformKeys(req)
+
+	if msg != nil {
+		res.Status = getManagePlatformKeysErrorStatus(msg)
+		res.Message = msg
+		return nil
+	}
+
+	var ids []string
+	var revoked []*billing.Key
+
+	for _, key := range keys {
+		if key.RevokedAt == nil {
+			ids = append(ids, key.Id)
+			revoked = append(revoked, key)
+		}
+	}
+
+	if len(ids) > 0 {
+		count, err := s.keyRepository.RevokeByIds(ids)
+
+		if err != nil {
+			zap.S().Errorf(errors.KeyErrorRevoke.Message, "err", err, "keyProductId", req.KeyProductId, "platformId", req.PlatformId)
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = errors.KeyErrorRevoke
+			return nil
+		}
+
+		for _, key := range revoked {
+			_ = s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionRevoke)
+		}
+
+		res.KeysProcessed = int32(count)
+		s.checkKeysLowStock(ctx, req.KeyProductId, req.PlatformId)
+	}
+
+	res.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// DeletePlatformKeys removes unsold keys of key product platform, revoked keys are removed too
+func (s *Service) DeletePlatformKeys(
+	ctx context.Context,
+	req *grpc.ManagePlatformKeysRequest,
+	res *grpc.ManagePlatformKeysResponse,
+) error {
+	keys, msg := s.getMerchantUnsoldPlatformKeys(req)
+
+	if msg != nil {
+		res.Status = getManagePlatformKeysErrorStatus(msg)
+		res.Message = msg
+		return nil
+	}
+
+	if len(keys) > 0 {
+		ids := make([]string, len(keys))
+
+		for i, key := range keys {
+			ids[i] = key.Id
+		}
+
+		count, err := s.keyRepository.DeleteByIds(ids)
+
+		if err != nil {
+			zap.S().Errorf(errors.KeyErrorDelete.Message, "err", err, "keyProductId", req.KeyProductId, "platformId", req.PlatformId)
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = errors.KeyErrorDelete
+			return nil
+		}
+
+		for _, key := range keys {
+			_ = s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionDelete)
+		}
+
+		res.KeysProcessed = int32(count)
+		s.checkKeysLowStock(ctx, req.KeyProductId, req.PlatformId)
+	}
+
+	res.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// ExportPlatformKeys returns csv file with codes and metadata of keys of key product platform available for sale.
+// Key codes are decrypted, so each exported key is registered in keys audit log.
+func (s *Service) ExportPlatformKeys(
+	ctx context.Context,
+	req *grpc.ManagePlatformKeysRequest,
+	res *grpc.ExportPlatformKeysResponse,
+) error {
+	keys, msg := s.getMerchantUnsoldPlatformKeys(req)
+
+	if msg != nil {
+		res.Status = getManagePlatformKeysErrorStatus(msg)
+		res.Message = msg
+		return nil
+	}
+
+	var available []*billing.Key
+	columns := make(map[string]bool)
+
+	for _, key := range keys {
+		if key.RevokedAt != nil {
+			continue
+		}
+
+		if err := s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionExport); err != nil {
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = errors.KeyErrorExport
+			return nil
+		}
+
+		if err := s.decryptKeyCode(key); err != nil {
+			zap.S().Errorf(errors.KeyErrorDecrypt.Message, "err", err, "keyId", key.Id)
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = errors.KeyErrorDecrypt
+			return nil
+		}
+
+		for k := range key.Metadata {
+			columns[k] = true
+		}
+
+		available = append(available, key)
+	}
+
+	header := []string{keysFileCsvCodeColumn}
+
+	for k := range columns {
+		if k != keysFileCsvCodeColumn {
+			header = append(header, k)
+		}
+	}
+
+	sort.Strings(header[1:])
+
+	records := [][]string{header}
+
+	for _, key := range available {
+		record := []string{key.Code}
+
+		for _, column := range header[1:] {
+			record = append(record, key.Metadata[column])
+		}
+
+		records = append(records, record)
+	}
+
+	b := new(bytes.Buffer)
+
+	if err := csv.NewWriter(b).WriteAll(records); err != nil {
+		zap.S().Errorf(errors.KeyErrorExport.Message, "err", err, "keyProductId", req.KeyProductId, "platformId", req.PlatformId)
+		res.Status = pkg.ResponseStatusSystemError
+		res.Message = errors.KeyErrorExport
+		return nil
+	}
+
+	res.File = b.Bytes()
+	res.KeysProcessed = int32(len(available))
+	res.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+func (s *Service) getMerchantUnsoldPlatformKeys(req *grpc.ManagePlatformKeysRequest) ([]*billing.Key, *grpc.ResponseErrorMessage) {
+	product, err := s.getKeyProductById(req.KeyProductId)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, keyProductNotFound
+		}
+
+		zap.S().Errorf("Query to find key product by id failed", "err", err.Error(), "data", req)
+		return nil, keyProductRetrieveError
+	}
+
+	if product.MerchantId != req.MerchantId {
+		return nil, keyProductMerchantMismatch
+	}
+
+	keys, err := s.keyRepository.FindUnsold(req.KeyProductId, req.PlatformId, req.KeyIds)
+
+	if err != nil {
+		zap.S().Errorf(errors.KeyErrorNotFound.Message, "err", err, "keyProductId", req.KeyProductId, "platformId", req.PlatformId)
+		return nil, errors.KeyErrorNotFound
+	}
+
+	return keys, nil
+}
+
+func getManagePlatformKeysErrorStatus(msg *grpc.ResponseErrorMessage) int32 {
+	if msg == keyProductRetrieveError {
+		return pkg.ResponseStatusSystemError
+	}
+
+	return pkg.ResponseStatusBadData
+}
+
+// importKeysFileLine checks key code from keys file line and inserts key if it isn't dry run
+func (s *Service) importKeysFileLine(
+	req *grpc.PlatformKeysFileRequest,
+	line *keysFileLine,
+	codeHashes map[string]bool,
+) *grpc.PlatformKeysFileLineResult {
+	result := &grpc.PlatformKeysFileLineResult{Line: line.number, Status: pkg.KeysFileLineStatusOk}
+
+	if line.code == "" {
+		result.Status = pkg.KeysFileLineStatusInvalid
+		result.Message = keysFileLineErrorCodeEmpty
+		return result
+	}
+
+	if utf8.RuneCountInString(line.code) > keyCodeMaxLength {
+		result.Status = pkg.KeysFileLineStatusInvalid
+		result.Message = keysFileLineErrorCodeTooLong
+		return result
+	}
+
+	codeHash := s.getKeyCodeHash(line.code)
+
+	if codeHashes[codeHash] {
+		result.Status = pkg.KeysFileLineStatusDuplicateInFile
+		result.Message = keysFileLineErrorDuplicateInFile
+		return result
+	}
+
+	codeHashes[codeHash] = true
+	exists, err := s.keyRepository.ExistsByCode(req.PlatformId, line.code, codeHash)
+
+	if err != nil {
+		zap.S().Errorf(errors.KeyErrorFailedToInsert.Message, "err", err, "line", line.number)
+		result.Status = pkg.KeysFileLineStatusError
+		result.Message = errors.KeyErrorFailedToInsert.Message
+		return result
+	}
+
+	if exists {
+		result.Status = pkg.KeysFileLineStatusDuplicate
+		result.Message = keysFileLineErrorDuplicate
+		return result
+	}
+
+	if req.DryRun {
+		return result
+	}
+
+	key := &billing.Key{
+		Id:           bson.NewObjectId().Hex(),
+		Code:         line.code,
+		KeyProductId: req.KeyProductId,
+		PlatformId:   req.PlatformId,
+		Metadata:     line.metadata,
+	}
+
+	if err = s.encryptKeyCode(key); err != nil {
+		zap.S().Errorf(errors.KeyErrorEncrypt.Message, "err", err, "keyId", key.Id)
+		result.Status = pkg.KeysFileLineStatusError
+		result.Message = errors.KeyErrorEncrypt.Message
+		return result
+	}
+
+	if err = s.keyRepository.Insert(key); err != nil {
+		zap.S().Errorf(errors.KeyErrorFailedToInsert.Message, "err", err, "keyId", key.Id)
+		result.Status = pkg.KeysFileLineStatusError
+		result.Message = errors.KeyErrorFailedToInsert.Message
+		return result
+	}
+
+	_ = s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionUpload)
+
+	return result
+}
+
+// parseKeysFile reads key codes from text file with one code per line
+// or from csv file with header where all columns except "code" are key metadata
+func parseKeysFile(file []byte, format string) ([]*keysFileLine, error) {
+	var lines []*keysFileLine
+
+	if format != pkg.KeysFileFormatCsv {
+		scanner := bufio.NewScanner(bytes.NewReader(file))
+
+		for scanner.Scan() {
+			lines = append(lines, &keysFileLine{
+				number: int32(len(lines) + 1),
+				code:   strings.TrimSpace(scanner.Text()),
+			})
+		}
+
+		return lines, scanner.Err()
+	}
+
+	reader := csv.NewReader(bytes.NewReader(file))
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+
+	if err == io.EOF {
+		return lines, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	codeColumn := -1
+
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+
+		if strings.ToLower(header[i]) == keysFileCsvCodeColumn {
+			codeColumn = i
+		}
+	}
+
+	if codeColumn < 0 {
+		return nil, errors.KeyErrorCsvCodeColumn
+	}
+
+	for {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line := &keysFileLine{
+			// header is the first line of file
+			number: int32(len(lines) + 2),
+			code:   strings.TrimSpace(record[codeColumn]),
+		}
+
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+
+			if i == codeColumn || header[i] == "" || value == "" {
+				continue
+			}
+
+			if line.metadata == nil {
+				line.metadata = make(map[string]string)
+			}
+
+			line.metadata[header[i]] = value
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// checkKeysLowStock notifies merchant once when count of available keys of key product platform
+// falls to low stock threshold, notification will be sent again after keys stock replenishment
+func (s *Service) checkKeysLowStock(ctx context.Context, keyProductId, platformId string) {
+	product, err := s.getKeyProductById(keyProductId)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.S().Errorw("Query to find key product by id failed", "err", err, "keyProductId", keyProductId)
+		}
+
+		return
+	}
+
+	threshold := int32(0)
+
+	for _, platform := range product.Platforms {
+		if platform.Id == platformId {
+			threshold = platform.LowStockThreshold
+		}
+	}
+
+	if threshold <= 0 {
+		return
+	}
+
+	count, err := s.keyRepository.CountKeysByProductPlatform(keyProductId, platformId)
+
+	if err != nil {
+		zap.S().Errorf(errors.KeyErrorNotFound.Message, "err", err, "keyProductId", keyProductId, "platformId", platformId)
+		return
+	}
+
+	query := bson.M{"key_product_id": bson.ObjectIdHex(keyProductId), "platform_id": platformId}
+
+	if int32(count) > threshold {
+		_, err = s.db.Collection(collectionKeyLowStockNotification).RemoveAll(query)
+
+		if err != nil {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionKeyLowStockNotification),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return
+	}
+
+	notification := bson.M{
+		"_id":            bson.NewObjectId(),
+		"key_product_id": bson.ObjectIdHex(keyProductId),
+		"platform_id":    platformId,
+		"count":          count,
+		"created_at":     time.Now(),
+	}
+	err = s.db.Collection(collectionKeyLowStockNotification).Insert(notification)
+
+	if err != nil {
+		// merchant already notified about low stock of platform keys
+		if mgo.IsDup(err) {
+			return
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionKeyLowStockNotification),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, notification),
+		)
+
+		return
+	}
+
+	s.notifyKeysLowStock(ctx, product, platformId, count, threshold)
+}
+
+func (s *Service) notifyKeysLowStock(ctx context.Context, product *grpc.KeyProduct, platformId string, count int, threshold int32) {
+	merchant, err := s.merchant.GetById(product.MerchantId)
+
+	if err != nil {
+		zap.L().Error("Merchant not found", zap.Error(err), zap.String("merchant_id", product.MerchantId))
+		return
+	}
+
+	productName, _ := product.GetLocalizedName(DefaultLanguage)
+	payload := &postmarkSdrPkg.Payload{
+		TemplateAlias: s.cfg.EmailKeysLowStockTemplate,
+		TemplateModel: map[string]string{
+			"merchant_greeting":   merchant.GetAuthorizedName(),
+			"key_product_id":      product.Id,
+			"key_product_name":    productName,
+			"platform_id":         platformId,
+			"keys_count":          strconv.Itoa(count),
+			"low_stock_threshold": strconv.Itoa(int(threshold)),
+			"projects_url":        s.cfg.DashboardProjectsUrl,
+		},
+		To: merchant.GetAuthorizedEmail(),
+	}
+
+	err = s.postmarkBroker.Publish(postmarkSdrPkg.PostmarkSenderTopicName, payload, amqp.Table{})
+
+	if err != nil {
+		zap.L().Error(
+			"Publication message about keys low stock to queue failed",
+			zap.Error(err),
+			zap.String("key_product_id", product.Id),
+			zap.String("platform_id", platformId),
+		)
+	}
+
+	msg := map[string]interface{}{
+		"id":          product.Id,
+		"platform_id": platformId,
+		"count":       count,
+		"code":        errors.KeyErrorLowStock.Code,
+		"message":     pkg.KeysLowStockMessage,
+	}
+	err = s.centrifugo.Publish(ctx, s.getMerchantCentrifugoChannel(merchant.Id), msg)
+
+	if err != nil {
+		zap.L().Error(
+			"[Centrifugo] Send merchant notification about keys low stock failed",
+			zap.Error(err),
+			zap.Any("msg", msg),
+		)
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	keyReservationExpiryLockKey = "billing:key_reservation_expiry:lock"
+)
+
+var (
+	keysStockGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "billing_keys_stock",
+			Help: "Number of platform keys by stock state: available, reserved or sold",
+		},
+		[]string{"state"},
+	)
+	keysReservationExpiredCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "billing_keys_reservation_expired_total",
+			Help: "Number of platform keys returned to stock after reservation is over",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(keysStockGauge, keysReservationExpiredCounter)
+}
+
+// ProcessExpiredKeyReservations returns to stock keys which reservation ttl is over.
+// Only one replica of service processes reservations at the same time, other replicas
+// skip processing while lock is held. Lock is prolonged after each processed key, so it
+// doesn't expire while many reservations are returned to stock.
+func (s *Service) ProcessExpiredKeyReservations() (int, error) {
+	ttl := time.Duration(s.cfg.KeyReservationExpiryLockTtl) * time.Second
+	lock, err := s.acquireRedisLock(keyReservationExpiryLockKey, ttl)
+
+	if err != nil || lock == "" {
+		return 0, err
+	}
+
+	defer s.releaseRedisLock(keyReservationExpiryLockKey, lock)
+
+	counter := 0
+
+	for {
+		if counter > 0 {
+			ok, err := s.extendRedisLock(keyReservationExpiryLockKey, lock, ttl)
+
+			if err != nil || !ok {
+				s.updateKeysStockMetrics()
+				return counter, err
+			}
+		}
+
+		key, err := s.keyRepository.ExpireReservation()
+
+		if err != nil {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionKey),
+			)
+			return counter, err
+		}
+
+		if key == nil {
+			break
+		}
+
+		_ = s.insertKeyAuditLog(key.Id, key, pkg.KeyAuditActionExpire)
+		s.publishKeyReservationExpired(key)
+		keysReservationExpiredCounter.Inc()
+		counter++
+	}
+
+	s.updateKeysStockMetrics()
+
+	return counter, nil
+}
+
+// GetNextKeyReservationExpiry returns time when the nearest key reservation is over,
+// zero time returned if there are no reserved keys
+func (s *Service) GetNextKeyReservationExpiry() (time.Time, error) {
+	return s.keyRepository.GetNextReservationExpiry()
+}
+
+func (s *Service) publishKeyReservationExpired(key *billing.Key) {
+	msg := &billing.KeyReservationExpired{
+		KeyId:        key.Id,
+		KeyProductId: key.KeyProductId,
+		PlatformId:   key.PlatformId,
+		OrderId:      key.OrderId,
+		ReservedTo:   key.ReservedTo,
+		ExpiredAt:    ptypes.TimestampNow(),
+	}
+	err := s.broker.Publish(pkg.KeyReservationExpiredTopicName, msg, amqp.Table{"x-retry-count": int32(0)})
+
+	if err != nil {
+		zap.L().Error(
+			"Publish key reservation expired event failed",
+			zap.Error(err),
+			zap.String("topic", pkg.KeyReservationExpiredTopicName),
+			zap.String("key_id", key.Id),
+		)
+	}
+}
+
+func (s *Service) updateKeysStockMetrics() {
+	available, reserved, sold, err := s.keyRepository.GetStockStatistics()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionKey),
+		)
+		return
+	}
+
+	keysStockGauge.WithLabelValues(pkg.KeysStockStateAvailable).Set(float64(available))
+	keysStockGauge.WithLabelValues(pkg.KeysStockStateReserved).Set(float64(reserved))
+	keysStockGauge.WithLabelValues(pkg.KeysStockStateSold).Set(float64(sold))
+}
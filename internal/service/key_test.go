@@ -1,7 +1,11 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/csv"
 	"errors"
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
@@ -19,6 +23,7 @@ import (
 	mock2 "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
+	"strings"
 	"testing"
 	"time"
 )
@@ -59,8 +64,8 @@ func (suite *KeyTestSuite) SetupTest() {
 		mocks.NewGeoIpServiceTestOk(),
 		mocks.NewRepositoryServiceOk(),
 		mocks.NewTaxServiceOkMock(),
-		nil,
-		nil,
+		mocks.NewBrokerMockOk(),
+		redisdb,
 		suite.cache,
 		mocks.NewCurrencyServiceMockOk(),
 		mocks.NewDocumentSignerMockOk(),
@@ -74,11 +79,27 @@ func (suite *KeyTestSuite) SetupTest() {
 	}
 
 	idx := mgo.Index{
-		Unique: true,
-		Name:   "udx_key_platform_code",
-		Key:    []string{"platform_id", "code"},
+		Unique:        true,
+		Name:          "udx_key_platform_code",
+		Key:           []string{"platform_id", "code"},
+		PartialFilter: bson.M{"code": bson.M{"$exists": true}},
+	}
+	_ = suite.service.db.Collection(collectionKey).EnsureIndex(idx)
+
+	idx = mgo.Index{
+		Unique:        true,
+		Name:          "udx_key_platform_code_hash",
+		Key:           []string{"platform_id", "code_hash"},
+		PartialFilter: bson.M{"code_hash": bson.M{"$exists": true}},
 	}
 	_ = suite.service.db.Collection(collectionKey).EnsureIndex(idx)
+
+	idx = mgo.Index{
+		Unique: true,
+		Name:   "udx_key_low_stock_notification_product_platform",
+		Key:    []string{"key_product_id", "platform_id"},
+	}
+	_ = suite.service.db.Collection(collectionKeyLowStockNotification).EnsureIndex(idx)
 }
 
 func (suite *KeyTestSuite) TearDownTest() {
@@ -509,48 +530,120 @@ func (suite *KeyTestSuite) TestKey_UploadKeysFile_Error_CountKeysByProductPlatfo
 	assert.Equal(suite.T(), errors2.KeyErrorNotFound, res.Message)
 }
 
-func (suite *KeyTestSuite) TestKey_KeyDaemonProcess_Ok() {
-	keys := []*billing.Key{{Id: bson.NewObjectId().Hex()}}
+func (suite *KeyTestSuite) TestKey_ProcessExpiredKeyReservations_Ok() {
+	key := &billing.Key{Id: bson.NewObjectId().Hex(), OrderId: bson.NewObjectId().Hex()}
+
 	kr := &mocks.KeyRepositoryInterface{}
-	kr.On("FindUnfinished").Return(keys, nil)
-	kr.On("CancelById", keys[0].Id).Return(&billing.Key{}, nil)
+	kr.On("ExpireReservation").Return(key, nil).Once()
+	kr.On("ExpireReservation").Return(nil, nil)
+	kr.On("GetStockStatistics").Return(1, 0, 0, nil)
 	suite.service.keyRepository = kr
 
-	count, err := suite.service.KeyDaemonProcess()
+	count, err := suite.service.ProcessExpiredKeyReservations()
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), 1, count)
+	kr.AssertNumberOfCalls(suite.T(), "ExpireReservation", 2)
+
+	var logs []*billing.KeyAuditLog
+	err = suite.service.db.Collection(collectionKeyAuditLog).Find(bson.M{"key_id": bson.ObjectIdHex(key.Id)}).All(&logs)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), logs, 1)
+	assert.Equal(suite.T(), pkg.KeyAuditActionExpire, logs[0].Action)
+	assert.Equal(suite.T(), key.OrderId, logs[0].OrderId)
 }
 
-func (suite *KeyTestSuite) TestKey_KeyDaemonProcess_Error_FindUnfinished() {
+func (suite *KeyTestSuite) TestKey_ProcessExpiredKeyReservations_Error_ExpireReservation() {
 	kr := &mocks.KeyRepositoryInterface{}
-	kr.On("FindUnfinished").Return(nil, errors.New("not found"))
+	kr.On("ExpireReservation").Return(nil, errors.New("not found"))
 	suite.service.keyRepository = kr
 
-	count, err := suite.service.KeyDaemonProcess()
+	count, err := suite.service.ProcessExpiredKeyReservations()
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), 0, count)
 }
 
-func (suite *KeyTestSuite) TestKey_KeyDaemonProcess_Error_CancelById() {
-	keys := []*billing.Key{{Id: bson.NewObjectId().Hex()}}
+func (suite *KeyTestSuite) TestKey_ProcessExpiredKeyReservations_Locked() {
+	err := suite.service.redis.Set(keyReservationExpiryLockKey, "another_replica", time.Minute).Err()
+	assert.NoError(suite.T(), err)
 
 	kr := &mocks.KeyRepositoryInterface{}
-	kr.On("FindUnfinished").Return(keys, nil)
-	kr.On("CancelById", keys[0].Id).Return(nil, errors.New("not found"))
 	suite.service.keyRepository = kr
 
-	count, _ := suite.service.KeyDaemonProcess()
+	count, err := suite.service.ProcessExpiredKeyReservations()
+	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), 0, count)
+	kr.AssertNotCalled(suite.T(), "ExpireReservation")
+
+	lock, err := suite.service.redis.Get(keyReservationExpiryLockKey).Result()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "another_replica", lock)
 }
 
-func (suite *KeyTestSuite) TestKey_FindUnfinished_Ok() {
+func (suite *KeyTestSuite) TestKey_ProcessExpiredKeyReservations_LockLost_Stopped() {
+	key := &billing.Key{Id: bson.NewObjectId().Hex(), OrderId: bson.NewObjectId().Hex()}
+
+	kr := &mocks.KeyRepositoryInterface{}
+	kr.On("ExpireReservation").
+		Run(func(args mock2.Arguments) {
+			err := suite.service.redis.Set(keyReservationExpiryLockKey, "another_replica", time.Minute).Err()
+			assert.NoError(suite.T(), err)
+		}).
+		Return(key, nil)
+	kr.On("GetStockStatistics").Return(1, 0, 0, nil)
+	suite.service.keyRepository = kr
+
+	count, err := suite.service.ProcessExpiredKeyReservations()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+	kr.AssertNumberOfCalls(suite.T(), "ExpireReservation", 1)
+
+	lock, err := suite.service.redis.Get(keyReservationExpiryLockKey).Result()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "another_replica", lock)
+}
+
+func (suite *KeyTestSuite) TestKey_ExtendRedisLock_Ok() {
+	ttl := time.Duration(suite.service.cfg.KeyReservationExpiryLockTtl) * time.Second
+	lock, err := suite.service.acquireRedisLock(keyReservationExpiryLockKey, ttl)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), lock)
+
+	ok, err := suite.service.extendRedisLock(keyReservationExpiryLockKey, lock, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	expire, err := suite.service.redis.TTL(keyReservationExpiryLockKey).Result()
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), expire > ttl)
+
+	ok, err = suite.service.extendRedisLock(keyReservationExpiryLockKey, "another_replica", time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	suite.service.releaseRedisLock(keyReservationExpiryLockKey, lock)
+}
+
+func (suite *KeyTestSuite) TestKey_ProcessExpiredKeyReservations_ReleaseLock() {
+	count, err := suite.service.ProcessExpiredKeyReservations()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, count)
+
+	exists, err := suite.service.redis.Exists(keyReservationExpiryLockKey).Result()
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 0, exists)
+}
+
+func (suite *KeyTestSuite) TestKey_ExpireReservation_Ok() {
 	reserveExpireTime, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -1))
+	assert.NoError(suite.T(), err)
 	reserveNoExpireTime, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, 1))
+	assert.NoError(suite.T(), err)
 
 	keyReserveExpire := &billing.Key{
 		Id:           bson.NewObjectId().Hex(),
 		PlatformId:   "steam",
 		KeyProductId: bson.NewObjectId().Hex(),
+		OrderId:      bson.NewObjectId().Hex(),
 		Code:         "code1",
 		ReservedTo:   reserveExpireTime,
 	}
@@ -560,13 +653,529 @@ func (suite *KeyTestSuite) TestKey_FindUnfinished_Ok() {
 		Id:           bson.NewObjectId().Hex(),
 		PlatformId:   "gog",
 		KeyProductId: bson.NewObjectId().Hex(),
+		OrderId:      bson.NewObjectId().Hex(),
 		Code:         "code1",
 		ReservedTo:   reserveNoExpireTime,
 	}
 	assert.NoError(suite.T(), suite.service.keyRepository.Insert(keyReserveNoExpire))
 
-	keys, err := suite.service.keyRepository.FindUnfinished()
+	key, err := suite.service.keyRepository.ExpireReservation()
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), key)
+	assert.Equal(suite.T(), keyReserveExpire.Id, key.Id)
+	assert.Equal(suite.T(), keyReserveExpire.OrderId, key.OrderId)
+
+	key, err = suite.service.keyRepository.ExpireReservation()
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), key)
+
+	key, err = suite.service.keyRepository.GetById(keyReserveExpire.Id)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), key.OrderId)
+
+	next, err := suite.service.keyRepository.GetNextReservationExpiry()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), reserveNoExpireTime.Seconds, next.Unix())
+}
+
+func (suite *KeyTestSuite) TestKey_ExpireReservation_Redeemed() {
+	orderId := bson.NewObjectId().Hex()
+	key := &billing.Key{
+		Id:           bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+		KeyProductId: bson.NewObjectId().Hex(),
+		Code:         "code1",
+	}
+	assert.NoError(suite.T(), suite.service.keyRepository.Insert(key))
+
+	_, err := suite.service.keyRepository.ReserveKey(key.KeyProductId, key.PlatformId, orderId, -10)
+	assert.NoError(suite.T(), err)
+	_, err = suite.service.keyRepository.FinishRedeemById(key.Id)
+	assert.NoError(suite.T(), err)
+
+	expired, err := suite.service.keyRepository.ExpireReservation()
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), expired)
+
+	available, reserved, sold, err := suite.service.keyRepository.GetStockStatistics()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, available)
+	assert.Equal(suite.T(), 0, reserved)
+	assert.Equal(suite.T(), 1, sold)
+}
+
+func (suite *KeyTestSuite) TestKey_GetNextReservationExpiry_Empty() {
+	next, err := suite.service.keyRepository.GetNextReservationExpiry()
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), next.IsZero())
+}
+
+func (suite *KeyTestSuite) TestKey_UploadKeysFile_EncryptCodes_Ok() {
+	req := &grpc.PlatformKeysFileRequest{
+		KeyProductId: bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+		File:         []byte("code1\ncode2\ncode1"),
+	}
+	res := grpc.PlatformKeysFileResponse{}
+
+	err := suite.service.UploadKeysFile(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(2), res.KeysProcessed)
+	assert.Equal(suite.T(), int32(2), res.TotalCount)
+
+	var keys []*billing.Key
+	err = suite.service.db.Collection(collectionKey).Find(nil).All(&keys)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), keys, 2)
+
+	for _, key := range keys {
+		assert.Empty(suite.T(), key.Code)
+		assert.NotEmpty(suite.T(), key.CodeHash)
+		assert.NotEmpty(suite.T(), key.EncryptedCode)
+		assert.NotEmpty(suite.T(), key.EncryptedDataKey)
+		assert.Equal(suite.T(), suite.service.keyEncryption.GetActiveMasterKeyId(), key.MasterKeyId)
+		assert.NotContains(suite.T(), string(key.EncryptedCode), "code")
+	}
+
+	count, err := suite.service.db.Collection(collectionKeyAuditLog).
+		Find(bson.M{"action": pkg.KeyAuditActionUpload}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, count)
+}
+
+func (suite *KeyTestSuite) TestKey_GetKeyByID_CodeHidden() {
+	key := suite.helperUploadKey("code1")
+
+	res := grpc.GetKeyForOrderRequestResponse{}
+	err := suite.service.GetKeyByID(context.TODO(), &grpc.KeyForOrderRequest{KeyId: key.Id}, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), key.Id, res.Key.Id)
+	assert.Empty(suite.T(), res.Key.Code)
+	assert.Empty(suite.T(), res.Key.EncryptedCode)
+	assert.Empty(suite.T(), res.Key.EncryptedDataKey)
+
+	count, err := suite.service.db.Collection(collectionKeyAuditLog).
+		Find(bson.M{"key_id": bson.ObjectIdHex(key.Id), "action": pkg.KeyAuditActionRead}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+}
+
+func (suite *KeyTestSuite) TestKey_FinishRedeemKeyForOrder_DecryptCode_Ok() {
+	key := suite.helperUploadKey("code1")
+
+	res := grpc.GetKeyForOrderRequestResponse{}
+	err := suite.service.FinishRedeemKeyForOrder(context.TODO(), &grpc.KeyForOrderRequest{KeyId: key.Id}, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), "code1", res.Key.Code)
+	assert.Empty(suite.T(), res.Key.EncryptedCode)
+	assert.Empty(suite.T(), res.Key.EncryptedDataKey)
+
+	count, err := suite.service.db.Collection(collectionKeyAuditLog).
+		Find(bson.M{"key_id": bson.ObjectIdHex(key.Id), "action": pkg.KeyAuditActionRedeem}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+}
+
+func (suite *KeyTestSuite) TestKey_FinishRedeemKeyForOrder_Error_Decrypt() {
+	key := suite.helperUploadKey("code1")
+
+	provider, err := newLocalKeyEncryptionProvider([]string{suite.helperMasterKey("new")})
+	assert.NoError(suite.T(), err)
+	suite.service.keyEncryption = provider
+
+	res := grpc.GetKeyForOrderRequestResponse{}
+	err = suite.service.FinishRedeemKeyForOrder(context.TODO(), &grpc.KeyForOrderRequest{KeyId: key.Id}, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusSystemError, res.Status)
+	assert.Equal(suite.T(), errors2.KeyErrorDecrypt, res.Message)
+	assert.Nil(suite.T(), res.Key)
+}
+
+func (suite *KeyTestSuite) TestKey_RotateKeysEncryptionKey_Ok() {
+	oldMasterKey := suite.helperMasterKey("old")
+	provider, err := newLocalKeyEncryptionProvider([]string{oldMasterKey})
+	assert.NoError(suite.T(), err)
+	suite.service.keyEncryption = provider
+
+	key := suite.helperUploadKey("code1")
+	legacyKey := &billing.Key{
+		Id:           bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+		KeyProductId: key.KeyProductId,
+		Code:         "code2",
+	}
+	assert.NoError(suite.T(), suite.service.keyRepository.Insert(legacyKey))
+
+	provider, err = newLocalKeyEncryptionProvider([]string{suite.helperMasterKey("new"), oldMasterKey})
+	assert.NoError(suite.T(), err)
+	suite.service.keyEncryption = provider
+
+	res := grpc.RotateKeysEncryptionKeyResponse{}
+	err = suite.service.RotateKeysEncryptionKey(context.TODO(), &grpc.EmptyRequest{}, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(2), res.KeysProcessed)
+
+	codes := map[string]string{key.Id: "code1", legacyKey.Id: "code2"}
+
+	for id, code := range codes {
+		k, err := suite.service.keyRepository.GetById(id)
+		assert.NoError(suite.T(), err)
+		assert.Empty(suite.T(), k.Code)
+		assert.Equal(suite.T(), "new", k.MasterKeyId)
+
+		assert.NoError(suite.T(), suite.service.decryptKeyCode(k))
+		assert.Equal(suite.T(), code, k.Code)
+	}
+
+	res = grpc.RotateKeysEncryptionKeyResponse{}
+	err = suite.service.RotateKeysEncryptionKey(context.TODO(), &grpc.EmptyRequest{}, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(0), res.KeysProcessed)
+}
+
+func (suite *KeyTestSuite) TestKey_RotateKeysEncryptionKey_Error_MasterKeyNotFound() {
+	suite.helperUploadKey("code1")
+
+	provider, err := newLocalKeyEncryptionProvider([]string{suite.helperMasterKey("new")})
+	assert.NoError(suite.T(), err)
+	suite.service.keyEncryption = provider
+
+	res := grpc.RotateKeysEncryptionKeyResponse{}
+	err = suite.service.RotateKeysEncryptionKey(context.TODO(), &grpc.EmptyRequest{}, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusSystemError, res.Status)
+	assert.Equal(suite.T(), errors2.KeyErrorRotate, res.Message)
+	assert.Equal(suite.T(), int32(0), res.KeysProcessed)
+}
+
+func (suite *KeyTestSuite) TestKey_NewLocalKeyEncryptionProvider_Error() {
+	_, err := newLocalKeyEncryptionProvider([]string{})
+	assert.Error(suite.T(), err)
+
+	_, err = newLocalKeyEncryptionProvider([]string{"key"})
+	assert.Error(suite.T(), err)
+
+	_, err = newLocalKeyEncryptionProvider([]string{"key:" + base64.StdEncoding.EncodeToString([]byte("short"))})
+	assert.Error(suite.T(), err)
+
+	_, err = newLocalKeyEncryptionProvider([]string{suite.helperMasterKey("key"), suite.helperMasterKey("key")})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *KeyTestSuite) helperUploadKey(code string) *billing.Key {
+	req := &grpc.PlatformKeysFileRequest{
+		KeyProductId: bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+		File:         []byte(code),
+	}
+	res := grpc.PlatformKeysFileResponse{}
+
+	err := suite.service.UploadKeysFile(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(1), res.KeysProcessed)
+
+	key := &billing.Key{}
+	err = suite.service.db.Collection(collectionKey).
+		Find(bson.M{"key_product_id": bson.ObjectIdHex(req.KeyProductId)}).One(key)
+	assert.NoError(suite.T(), err)
+
+	return key
+}
+
+func (suite *KeyTestSuite) helperMasterKey(id string) string {
+	key := make([]byte, keyEncryptionDataKeyLength)
+	_, err := rand.Read(key)
+	assert.NoError(suite.T(), err)
+
+	return id + ":" + base64.StdEncoding.EncodeToString(key)
+}
+
+func (suite *KeyTestSuite) TestKey_UploadKeysFile_DryRun_Ok() {
+	existing := suite.helperUploadKey("code0")
+
+	req := &grpc.PlatformKeysFileRequest{
+		KeyProductId: existing.KeyProductId,
+		PlatformId:   "steam",
+		File:         []byte("code1\n\ncode1\ncode0\n" + strings.Repeat("a", 51)),
+		DryRun:       true,
+	}
+	res := grpc.PlatformKeysFileResponse{}
+
+	err := suite.service.UploadKeysFile(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(1), res.KeysProcessed)
+	assert.Equal(suite.T(), int32(4), res.ErrorsCount)
+	assert.Equal(suite.T(), int32(1), res.TotalCount)
+	assert.Len(suite.T(), res.Lines, 5)
+
+	statuses := []string{
+		pkg.KeysFileLineStatusOk,
+		pkg.KeysFileLineStatusInvalid,
+		pkg.KeysFileLineStatusDuplicateInFile,
+		pkg.KeysFileLineStatusDuplicate,
+		pkg.KeysFileLineStatusInvalid,
+	}
+
+	for i, status := range statuses {
+		assert.Equal(suite.T(), int32(i+1), res.Lines[i].Line)
+		assert.Equal(suite.T(), status, res.Lines[i].Status)
+	}
+
+	count, err := suite.service.db.Collection(collectionKey).Find(nil).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+}
+
+func (suite *KeyTestSuite) TestKey_UploadKeysFile_Csv_Ok() {
+	req := &grpc.PlatformKeysFileRequest{
+		KeyProductId: bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+		File:         []byte("code,region,batch\nA1,eu,1\nA2,,2\n"),
+		Format:       pkg.KeysFileFormatCsv,
+	}
+	res := grpc.PlatformKeysFileResponse{}
+
+	err := suite.service.UploadKeysFile(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(2), res.KeysProcessed)
+	assert.Len(suite.T(), res.Lines, 2)
+	assert.Equal(suite.T(), int32(2), res.Lines[0].Line)
+	assert.Equal(suite.T(), int32(3), res.Lines[1].Line)
+
+	var keys []*billing.Key
+	err = suite.service.db.Collection(collectionKey).Find(nil).All(&keys)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), keys, 2)
+
+	for _, key := range keys {
+		assert.NoError(suite.T(), suite.service.decryptKeyCode(key))
+
+		if key.Code == "A1" {
+			assert.Equal(suite.T(), map[string]string{"region": "eu", "batch": "1"}, key.Metadata)
+		} else {
+			assert.Equal(suite.T(), "A2", key.Code)
+			assert.Equal(suite.T(), map[string]string{"batch": "2"}, key.Metadata)
+		}
+	}
+}
+
+func (suite *KeyTestSuite) TestKey_UploadKeysFile_Csv_Error_CodeColumnNotFound() {
+	req := &grpc.PlatformKeysFileRequest{
+		KeyProductId: bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+		File:         []byte("key,region\nA1,eu\n"),
+		Format:       pkg.KeysFileFormatCsv,
+	}
+	res := grpc.PlatformKeysFileResponse{}
+
+	err := suite.service.UploadKeysFile(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, res.Status)
+	assert.Equal(suite.T(), errors2.KeyErrorCsvCodeColumn, res.Message)
+}
+
+func (suite *KeyTestSuite) TestKey_RevokePlatformKeys_Ok() {
+	product := suite.helperCreateKeyProduct(0)
+	suite.helperUploadKeys(product.Id, "code1\ncode2\ncode3", "")
+
+	keys, err := suite.service.keyRepository.FindUnsold(product.Id, "steam", nil)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), keys, 3)
+
+	req := &grpc.ManagePlatformKeysRequest{
+		KeyProductId: product.Id,
+		MerchantId:   product.MerchantId,
+		PlatformId:   "steam",
+		KeyIds:       []string{keys[0].Id},
+	}
+	res := grpc.ManagePlatformKeysResponse{}
+
+	err = suite.service.RevokePlatformKeys(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(1), res.KeysProcessed)
+
+	count, err := suite.service.keyRepository.CountKeysByProductPlatform(product.Id, "steam")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, count)
+
+	req.KeyIds = nil
+	res = grpc.ManagePlatformKeysResponse{}
+	err = suite.service.RevokePlatformKeys(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(2), res.KeysProcessed)
+
+	_, err = suite.service.keyRepository.ReserveKey(product.Id, "steam", bson.NewObjectId().Hex(), 3)
+	assert.Error(suite.T(), err)
+
+	auditCount, err := suite.service.db.Collection(collectionKeyAuditLog).
+		Find(bson.M{"action": pkg.KeyAuditActionRevoke}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, auditCount)
+}
+
+func (suite *KeyTestSuite) TestKey_RevokePlatformKeys_Error_MerchantMismatch() {
+	product := suite.helperCreateKeyProduct(0)
+
+	req := &grpc.ManagePlatformKeysRequest{
+		KeyProductId: product.Id,
+		MerchantId:   bson.NewObjectId().Hex(),
+		PlatformId:   "steam",
+	}
+	res := grpc.ManagePlatformKeysResponse{}
+
+	err := suite.service.RevokePlatformKeys(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, res.Status)
+	assert.Equal(suite.T(), keyProductMerchantMismatch, res.Message)
+}
+
+func (suite *KeyTestSuite) TestKey_DeletePlatformKeys_Ok() {
+	product := suite.helperCreateKeyProduct(0)
+	suite.helperUploadKeys(product.Id, "code1\ncode2", "")
+
+	reserved, err := suite.service.keyRepository.ReserveKey(product.Id, "steam", bson.NewObjectId().Hex(), 3)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.ManagePlatformKeysRequest{
+		KeyProductId: product.Id,
+		MerchantId:   product.MerchantId,
+		PlatformId:   "steam",
+	}
+	res := grpc.ManagePlatformKeysResponse{}
+
+	err = suite.service.DeletePlatformKeys(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(1), res.KeysProcessed)
+
+	var keys []*billing.Key
+	err = suite.service.db.Collection(collectionKey).Find(nil).All(&keys)
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), keys, 1)
-	assert.Equal(suite.T(), keyReserveExpire.Id, keys[0].Id)
+	assert.Equal(suite.T(), reserved.Id, keys[0].Id)
+}
+
+func (suite *KeyTestSuite) TestKey_ExportPlatformKeys_Ok() {
+	product := suite.helperCreateKeyProduct(0)
+	suite.helperUploadKeys(product.Id, "code,region\nA1,eu\nA2,us\nA3,\n", pkg.KeysFileFormatCsv)
+
+	keys, err := suite.service.keyRepository.FindUnsold(product.Id, "steam", nil)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), keys, 3)
+
+	for _, key := range keys {
+		assert.NoError(suite.T(), suite.service.decryptKeyCode(key))
+
+		if key.Code == "A2" {
+			_, err = suite.service.keyRepository.RevokeByIds([]string{key.Id})
+			assert.NoError(suite.T(), err)
+		}
+	}
+
+	req := &grpc.ManagePlatformKeysRequest{
+		KeyProductId: product.Id,
+		MerchantId:   product.MerchantId,
+		PlatformId:   "steam",
+	}
+	res := grpc.ExportPlatformKeysResponse{}
+
+	err = suite.service.ExportPlatformKeys(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(2), res.KeysProcessed)
+
+	records, err := csv.NewReader(bytes.NewReader(res.File)).ReadAll()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records, 3)
+	assert.Equal(suite.T(), []string{"code", "region"}, records[0])
+	assert.ElementsMatch(suite.T(), [][]string{{"A1", "eu"}, {"A3", ""}}, records[1:])
+
+	count, err := suite.service.db.Collection(collectionKeyAuditLog).
+		Find(bson.M{"action": pkg.KeyAuditActionExport}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, count)
+}
+
+func (suite *KeyTestSuite) TestKey_LowStock_Notification() {
+	centrifugoMock := &mocks.CentrifugoInterface{}
+	centrifugoMock.On("Publish", mock2.Anything, mock2.Anything, mock2.Anything).Return(nil)
+	suite.service.centrifugo = centrifugoMock
+
+	product := suite.helperCreateKeyProduct(2)
+	suite.helperUploadKeys(product.Id, "code1\ncode2\ncode3", "")
+
+	reserveReq := &grpc.PlatformKeyReserveRequest{
+		KeyProductId: product.Id,
+		MerchantId:   product.MerchantId,
+		PlatformId:   "steam",
+		OrderId:      bson.NewObjectId().Hex(),
+		Ttl:          3,
+	}
+	err := suite.service.ReserveKeyForOrder(context.TODO(), reserveReq, &grpc.PlatformKeyReserveResponse{})
+	assert.NoError(suite.T(), err)
+	centrifugoMock.AssertNumberOfCalls(suite.T(), "Publish", 1)
+
+	reserveReq.OrderId = bson.NewObjectId().Hex()
+	err = suite.service.ReserveKeyForOrder(context.TODO(), reserveReq, &grpc.PlatformKeyReserveResponse{})
+	assert.NoError(suite.T(), err)
+	centrifugoMock.AssertNumberOfCalls(suite.T(), "Publish", 1)
+
+	count, err := suite.service.db.Collection(collectionKeyLowStockNotification).Find(nil).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+
+	suite.helperUploadKeys(product.Id, "code4\ncode5", "")
+
+	count, err = suite.service.db.Collection(collectionKeyLowStockNotification).Find(nil).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, count)
+}
+
+func (suite *KeyTestSuite) helperCreateKeyProduct(lowStockThreshold int32) *grpc.KeyProduct {
+	merchantId := bson.NewObjectId().Hex()
+	err := suite.service.merchant.Insert(&billing.Merchant{Id: merchantId, Banking: &billing.MerchantBanking{Currency: "USD"}})
+	assert.NoError(suite.T(), err)
+
+	product := &grpc.KeyProduct{
+		Id:              bson.NewObjectId().Hex(),
+		MerchantId:      merchantId,
+		ProjectId:       bson.NewObjectId().Hex(),
+		Sku:             "ru_double_yeti",
+		Name:            map[string]string{"en": "Double Yeti"},
+		DefaultCurrency: "USD",
+		Platforms: []*grpc.PlatformPrice{
+			{
+				Id:                "steam",
+				Prices:            []*billing.ProductPrice{{Currency: "USD", Region: "USD", Amount: 10}},
+				LowStockThreshold: lowStockThreshold,
+			},
+		},
+	}
+	err = suite.service.db.Collection(collectionKeyProduct).Insert(product)
+	assert.NoError(suite.T(), err)
+
+	return product
+}
+
+func (suite *KeyTestSuite) helperUploadKeys(keyProductId, file, format string) {
+	req := &grpc.PlatformKeysFileRequest{
+		KeyProductId: keyProductId,
+		PlatformId:   "steam",
+		File:         []byte(file),
+		Format:       format,
+	}
+	res := grpc.PlatformKeysFileResponse{}
+
+	err := suite.service.UploadKeysFile(context.TODO(), req, &res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Equal(suite.T(), int32(0), res.ErrorsCount)
 }
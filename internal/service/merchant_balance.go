@@ -7,6 +7,7 @@ import (
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"go.uber.org/zap"
@@ -19,7 +20,8 @@ const (
 )
 
 var (
-	errorMerchantPayoutCurrencyNotSet = newBillingServerErrorMsg("ba000001", "merchant payout currency not set")
+	errorMerchantPayoutCurrencyNotSet   = newBillingServerErrorMsg("ba000001", "merchant payout currency not set")
+	errorMerchantPayoutCurrencyNotFound = newBillingServerErrorMsg("ba000002", "currency is not payout currency of merchant")
 
 	accountingEntriesForRollingReserve = []string{
 		pkg.AccountingEntryTypeMerchantRollingReserveCreate,
@@ -28,8 +30,8 @@ var (
 )
 
 type reserveQueryResItem struct {
-	Type   string  `bson:"_id"`
-	Amount float64 `bson:"amount"`
+	Type   string `bson:"_id"`
+	Amount int64  `bson:"amount"`
 }
 
 type MerchantBalanceServiceInterface interface {
@@ -47,17 +49,42 @@ func (s *Service) GetMerchantBalance(
 	req *grpc.GetMerchantBalanceRequest,
 	res *grpc.GetMerchantBalanceResponse,
 ) error {
+	merchant, err := s.merchant.GetById(req.MerchantId)
+	if err != nil {
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			res.Status = pkg.ResponseStatusSystemError
+			res.Message = e
+			return nil
+		}
+		return err
+	}
 
-	var err error
-	res.Status = pkg.ResponseStatusOk
+	currencies := merchant.GetAllPayoutCurrencies()
 
-	res.Item, err = s.getMerchantBalance(req.MerchantId)
-	if err == nil {
+	if req.Currency != "" {
+		if !merchant.HasPayoutCurrency(req.Currency) {
+			res.Status = pkg.ResponseStatusBadData
+			res.Message = errorMerchantPayoutCurrencyNotFound
+			return nil
+		}
+
+		currencies = []string{req.Currency}
+	}
+
+	if len(currencies) <= 0 {
+		zap.L().Error(errorMerchantPayoutCurrencyNotSet.Error(), zap.String("merchant_id", merchant.Id))
+		res.Status = pkg.ResponseStatusSystemError
+		res.Message = errorMerchantPayoutCurrencyNotSet
 		return nil
 	}
 
-	if err == mgo.ErrNotFound {
-		res.Item, err = s.updateMerchantBalance(req.MerchantId)
+	for _, currency := range currencies {
+		balance, err := s.getMerchantBalance(merchant.Id, currency)
+
+		if err == mgo.ErrNotFound {
+			balance, err = s.updateMerchantBalance(merchant.Id, currency)
+		}
+
 		if err != nil {
 			if e, ok := err.(*grpc.ResponseErrorMessage); ok {
 				res.Status = pkg.ResponseStatusSystemError
@@ -66,55 +93,55 @@ func (s *Service) GetMerchantBalance(
 			}
 			return err
 		}
-	} else {
-		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
-			res.Status = pkg.ResponseStatusSystemError
-			res.Message = e
-			return nil
-		}
-		return err
+
+		res.Items = append(res.Items, balance)
 	}
 
-	return nil
-}
+	releases, err := s.getRollingReserveReleases(merchant.Id, currencies)
 
-func (s *Service) getMerchantBalance(merchantId string) (*billing.MerchantBalance, error) {
-	merchant, err := s.merchant.GetById(merchantId)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if merchant.GetPayoutCurrency() == "" {
+	res.Status = pkg.ResponseStatusOk
+	res.Item = res.Items[0]
+	res.RollingReserveReleases = releases
+
+	return nil
+}
+
+func (s *Service) getMerchantBalance(merchantId, currency string) (*billing.MerchantBalance, error) {
+	if currency == "" {
 		zap.L().Error(errorMerchantPayoutCurrencyNotSet.Error(), zap.String("merchant_id", merchantId))
 		return nil, errorMerchantPayoutCurrencyNotSet
 	}
 
-	return s.merchantBalance.GetByMerchantIdAndCurrency(merchant.Id, merchant.GetPayoutCurrency())
+	return s.merchantBalance.GetByMerchantIdAndCurrency(merchantId, currency)
 }
 
-func (s *Service) updateMerchantBalance(merchantId string) (*billing.MerchantBalance, error) {
+func (s *Service) updateMerchantBalance(merchantId, currency string) (*billing.MerchantBalance, error) {
 
 	merchant, err := s.merchant.GetById(merchantId)
 	if err != nil {
 		return nil, err
 	}
 
-	if merchant.GetPayoutCurrency() == "" {
+	if currency == "" {
 		zap.L().Error(errorMerchantPayoutCurrencyNotSet.Error(), zap.String("merchant_id", merchantId))
 		return nil, errorMerchantPayoutCurrencyNotSet
 	}
 
-	debit, err := s.royaltyReport.GetBalanceAmount(merchant.Id, merchant.GetPayoutCurrency())
+	debit, err := s.royaltyReport.GetBalanceAmount(merchant.Id, currency)
 	if err != nil {
 		return nil, err
 	}
 
-	credit, err := s.payoutDocument.GetBalanceAmount(merchant.Id, merchant.GetPayoutCurrency())
+	credit, err := s.payoutDocument.GetBalanceAmount(merchant.Id, currency)
 	if err != nil {
 		return nil, err
 	}
 
-	rr, err := s.getRollingReserveForBalance(merchantId, merchant.GetPayoutCurrency())
+	rr, err := s.getRollingReserveForBalance(merchantId, currency)
 	if err != nil {
 		return nil, err
 	}
@@ -122,14 +149,16 @@ func (s *Service) updateMerchantBalance(merchantId string) (*billing.MerchantBal
 	balance := &billing.MerchantBalance{
 		Id:             bson.NewObjectId().Hex(),
 		MerchantId:     merchantId,
-		Currency:       merchant.GetPayoutCurrency(),
+		Currency:       currency,
 		Debit:          debit,
 		Credit:         credit,
 		RollingReserve: rr,
 		CreatedAt:      ptypes.TimestampNow(),
 	}
 
-	balance.Total = balance.Debit - balance.Credit - balance.RollingReserve
+	total := money.ToMinor(balance.Debit, balance.Currency) - money.ToMinor(balance.Credit, balance.Currency) -
+		money.ToMinor(balance.RollingReserve, balance.Currency)
+	balance.Total = money.FromMinor(total, balance.Currency)
 
 	err = s.merchantBalance.Insert(balance)
 	if err != nil {
@@ -176,7 +205,7 @@ func (s *Service) getRollingReserveForBalance(merchantId, currency string) (floa
 			"$match": matchQuery,
 		},
 		{
-			"$group": bson.M{"_id": "$type", "amount": bson.M{"$sum": "$amount"}},
+			"$group": bson.M{"_id": "$type", "amount": bson.M{"$sum": "$amount_minor"}},
 		},
 	}
 
@@ -196,7 +225,7 @@ func (s *Service) getRollingReserveForBalance(merchantId, currency string) (floa
 		return 0, nil
 	}
 
-	result := float64(0)
+	result := int64(0)
 
 	for _, i := range items {
 		// in case of rolling reserve release, result will have negative amount, it is ok
@@ -207,7 +236,7 @@ func (s *Service) getRollingReserveForBalance(merchantId, currency string) (floa
 		}
 	}
 
-	return result, nil
+	return money.FromMinor(result, currency), nil
 }
 
 func (m MerchantBalance) Insert(mb *billing.MerchantBalance) (err error) {
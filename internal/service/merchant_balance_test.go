@@ -157,7 +157,7 @@ func (suite *MerchantBalanceTestSuite) TestMerchantBalance_GetMerchantBalance_Ok
 	count := suite.mbRecordsCount(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.Equal(suite.T(), count, 0)
 
-	mb, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	mb, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), mb.MerchantId, suite.merchant.Id)
 	assert.Equal(suite.T(), mb.Currency, suite.merchant.GetPayoutCurrency())
@@ -193,6 +193,53 @@ func (suite *MerchantBalanceTestSuite) TestMerchantBalance_GetMerchantBalance_Ok
 	assert.Equal(suite.T(), count, 1)
 }
 
+func (suite *MerchantBalanceTestSuite) TestMerchantBalance_GetMerchantBalance_Ok_MultipleCurrencies() {
+	suite.merchant.PayoutCurrencies = []string{"USD", "EUR"}
+	err := suite.service.merchant.Update(suite.merchant)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.GetMerchantBalanceRequest{
+		MerchantId: suite.merchant.Id,
+	}
+
+	res := &grpc.GetMerchantBalanceResponse{}
+
+	err = suite.service.GetMerchantBalance(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Status, pkg.ResponseStatusOk)
+	assert.Len(suite.T(), res.Items, 3)
+	assert.Equal(suite.T(), res.Item, res.Items[0])
+
+	for i, currency := range suite.merchant.GetAllPayoutCurrencies() {
+		assert.Equal(suite.T(), res.Items[i].Currency, currency)
+		assert.Equal(suite.T(), suite.mbRecordsCount(suite.merchant.Id, currency), 1)
+	}
+
+	req.Currency = "EUR"
+	res = &grpc.GetMerchantBalanceResponse{}
+
+	err = suite.service.GetMerchantBalance(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Status, pkg.ResponseStatusOk)
+	assert.Len(suite.T(), res.Items, 1)
+	assert.Equal(suite.T(), res.Item.Currency, "EUR")
+}
+
+func (suite *MerchantBalanceTestSuite) TestMerchantBalance_GetMerchantBalance_Failed_NotPayoutCurrency() {
+	req := &grpc.GetMerchantBalanceRequest{
+		MerchantId: suite.merchant.Id,
+		Currency:   "GBP",
+	}
+
+	res := &grpc.GetMerchantBalanceResponse{}
+
+	err := suite.service.GetMerchantBalance(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Status, pkg.ResponseStatusBadData)
+	assert.Equal(suite.T(), res.Message, errorMerchantPayoutCurrencyNotFound)
+	assert.Equal(suite.T(), suite.mbRecordsCount(suite.merchant.Id, "GBP"), 0)
+}
+
 func (suite *MerchantBalanceTestSuite) TestMerchantBalance_GetMerchantBalance_Failed_MerchantNotFound() {
 	merchantId = bson.NewObjectId().Hex()
 
@@ -236,7 +283,7 @@ func (suite *MerchantBalanceTestSuite) TestMerchantBalance_updateMerchantBalance
 	count := suite.mbRecordsCount(merchantId, "")
 	assert.Equal(suite.T(), count, 0)
 
-	mb, err := suite.service.updateMerchantBalance(merchantId)
+	mb, err := suite.service.updateMerchantBalance(merchantId, "RUB")
 	assert.EqualError(suite.T(), err, "merchant not found")
 	assert.Nil(suite.T(), mb)
 
@@ -248,7 +295,7 @@ func (suite *MerchantBalanceTestSuite) TestMerchantBalance_updateMerchantBalance
 	count := suite.mbRecordsCount(suite.merchant2.Id, suite.merchant2.GetPayoutCurrency())
 	assert.Equal(suite.T(), count, 0)
 
-	mb, err := suite.service.updateMerchantBalance(suite.merchant2.Id)
+	mb, err := suite.service.updateMerchantBalance(suite.merchant2.Id, suite.merchant2.GetPayoutCurrency())
 	assert.EqualError(suite.T(), err, errorMerchantPayoutCurrencyNotSet.Error())
 	assert.Nil(suite.T(), mb)
 
@@ -336,7 +383,7 @@ func (suite *MerchantBalanceTestSuite) TestMerchantBalance_updateMerchantBalance
 	count := suite.mbRecordsCount(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.Equal(suite.T(), count, 0)
 
-	mb, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	mb, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), mb.MerchantId, suite.merchant.Id)
 	assert.Equal(suite.T(), mb.Currency, suite.merchant.GetPayoutCurrency())
@@ -5,6 +5,7 @@ import (
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/internal/mocks"
 	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
@@ -16,15 +17,24 @@ import (
 type PaymentSystemMockOk struct{}
 type PaymentSystemMockError struct{}
 
-func NewPaymentSystemMockOk() PaymentSystem {
+// PaymentSystemMockCreateError rejects payment creation, so next payment route is tried
+type PaymentSystemMockCreateError struct {
+	PaymentSystemMockError
+}
+
+func NewPaymentSystemMockOk(cfg *config.PaymentSystemConfig) PaymentSystem {
 	return &PaymentSystemMockOk{}
 }
 
-func NewPaymentSystemMockError() PaymentSystem {
+func NewPaymentSystemMockError(cfg *config.PaymentSystemConfig) PaymentSystem {
 	return &PaymentSystemMockError{}
 }
 
-func NewCardPayMock() PaymentSystem {
+func NewPaymentSystemMockCreateError(cfg *config.PaymentSystemConfig) PaymentSystem {
+	return &PaymentSystemMockCreateError{}
+}
+
+func NewCardPayMock(cfg *config.PaymentSystemConfig) PaymentSystem {
 	cpMock := &mocks.PaymentSystem{}
 	cpMock.On("CreatePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(
@@ -74,6 +84,8 @@ func NewCardPayMock() PaymentSystem {
 			},
 			nil,
 		)
+	cpMock.On("CapturePayment", mock.Anything).Return(nil)
+	cpMock.On("VoidPayment", mock.Anything).Return(nil)
 	cpMock.On("ProcessRefund", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(
 			func(order *billing.Order, refund *billing.Refund, message proto.Message, raw, signature string) error {
@@ -121,6 +133,10 @@ func (m *PaymentSystemMockError) CreatePayment(order *billing.Order, successUrl,
 	return "", nil
 }
 
+func (m *PaymentSystemMockCreateError) CreatePayment(order *billing.Order, successUrl, failUrl string, requisites map[string]string) (string, error) {
+	return "", paymentSystemErrorCreateRequestRejected
+}
+
 func (m *PaymentSystemMockError) ProcessPayment(order *billing.Order, message proto.Message, raw, signature string) error {
 	return nil
 }
@@ -141,3 +157,19 @@ func (m *PaymentSystemMockError) CreateRefund(order *billing.Order, refund *bill
 func (m *PaymentSystemMockError) ProcessRefund(order *billing.Order, refund *billing.Refund, message proto.Message, raw, signature string) error {
 	return newBillingServerResponseError(pkg.ResponseStatusBadData, paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid)
 }
+
+func (m *PaymentSystemMockOk) CapturePayment(order *billing.Order) error {
+	return nil
+}
+
+func (m *PaymentSystemMockOk) VoidPayment(order *billing.Order) error {
+	return nil
+}
+
+func (m *PaymentSystemMockError) CapturePayment(order *billing.Order) error {
+	return paymentSystemErrorCaptureFailed
+}
+
+func (m *PaymentSystemMockError) VoidPayment(order *billing.Order) error {
+	return paymentSystemErrorVoidFailed
+}
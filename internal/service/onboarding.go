@@ -47,6 +47,7 @@ var (
 	merchantNotificationSettingNotFound       = newBillingServerErrorMsg("mr000022", "setting for create notification for status change not found")
 	merchantTariffsNotFound                   = newBillingServerErrorMsg("mr000023", "tariffs for merchant not found")
 	merchantPayoutCurrencyMissed              = newBillingServerErrorMsg("mr000024", "merchant don't have payout currency")
+	merchantPayoutCurrencyRuleInvalid         = newBillingServerErrorMsg("mr000026", "payout currency rule currency must be one of merchant payout currencies")
 
 	merchantSignAgreementMessage        = map[string]string{"code": "mr000017", "message": "license agreement was signed by merchant"}
 	merchantAgreementReadyToSignMessage = map[string]interface{}{"code": "mr000025", "generated": true, "message": "merchant license agreement ready to sign"}
@@ -541,6 +542,82 @@ func (s *Service) ChangeMerchantManualPayouts(
 	return nil
 }
 
+func (s *Service) ChangeMerchantPayoutCurrencies(
+	ctx context.Context,
+	req *grpc.ChangeMerchantPayoutCurrenciesRequest,
+	rsp *grpc.ChangeMerchantPayoutCurrenciesResponse,
+) error {
+	merchant, err := s.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(req.MerchantId)})
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+
+		return nil
+	}
+
+	if merchant.GetPayoutCurrency() == "" {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = merchantPayoutCurrencyMissed
+
+		return nil
+	}
+
+	var currencies []string
+
+	for _, v := range req.PayoutCurrencies {
+		currency := strings.ToUpper(v)
+
+		if !contains(s.supportedCurrencies, currency) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = merchantErrorCurrencyNotFound
+
+			return nil
+		}
+
+		if currency == merchant.GetPayoutCurrency() || contains(currencies, currency) {
+			continue
+		}
+
+		currencies = append(currencies, currency)
+	}
+
+	for _, rule := range req.PayoutCurrencyRules {
+		rule.Currency = strings.ToUpper(rule.Currency)
+
+		if rule.Currency != merchant.GetPayoutCurrency() && !contains(currencies, rule.Currency) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = merchantPayoutCurrencyRuleInvalid
+
+			return nil
+		}
+
+		for i, v := range rule.OrderCurrencies {
+			rule.OrderCurrencies[i] = strings.ToUpper(v)
+		}
+
+		for i, v := range rule.Countries {
+			rule.Countries[i] = strings.ToUpper(v)
+		}
+	}
+
+	merchant.PayoutCurrencies = currencies
+	merchant.PayoutCurrencyRules = req.PayoutCurrencyRules
+
+	err = s.merchant.Update(merchant)
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = merchantErrorUnknown
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = merchant
+
+	return nil
+}
+
 func (s *Service) SetMerchantS3Agreement(
 	ctx context.Context,
 	req *grpc.SetMerchantS3AgreementRequest,
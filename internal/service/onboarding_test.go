@@ -3998,6 +3998,72 @@ func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantManualPayouts_Mer
 	assert.Equal(suite.T(), merchantErrorNotFound, rsp1.Message)
 }
 
+func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantPayoutCurrencies_Ok() {
+	req := &grpc.ChangeMerchantPayoutCurrenciesRequest{
+		MerchantId:       suite.merchant.Id,
+		PayoutCurrencies: []string{"usd", "EUR", "USD", suite.merchant.GetPayoutCurrency()},
+		PayoutCurrencyRules: []*billing.MerchantPayoutCurrencyRule{
+			{Currency: "eur", Countries: []string{"de", "FR"}},
+			{Currency: "USD", OrderCurrencies: []string{"usd"}},
+		},
+	}
+	rsp := &grpc.ChangeMerchantPayoutCurrenciesResponse{}
+	err := suite.service.ChangeMerchantPayoutCurrencies(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Empty(suite.T(), rsp.Message)
+	assert.Equal(suite.T(), []string{"USD", "EUR"}, rsp.Item.PayoutCurrencies)
+	assert.Equal(suite.T(), []string{suite.merchant.GetPayoutCurrency(), "USD", "EUR"}, rsp.Item.GetAllPayoutCurrencies())
+
+	merchant, err := suite.service.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(suite.merchant.Id)})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), merchant.PayoutCurrencyRules, 2)
+	assert.Equal(suite.T(), "EUR", merchant.PayoutCurrencyRules[0].Currency)
+	assert.Equal(suite.T(), []string{"DE", "FR"}, merchant.PayoutCurrencyRules[0].Countries)
+	assert.Equal(suite.T(), "EUR", merchant.GetPayoutCurrencyForOrder("DE", "RUB"))
+	assert.Equal(suite.T(), "USD", merchant.GetPayoutCurrencyForOrder("US", "USD"))
+	assert.Equal(suite.T(), suite.merchant.GetPayoutCurrency(), merchant.GetPayoutCurrencyForOrder("RU", "RUB"))
+}
+
+func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantPayoutCurrencies_CurrencyNotSupported_Error() {
+	req := &grpc.ChangeMerchantPayoutCurrenciesRequest{
+		MerchantId:       suite.merchant.Id,
+		PayoutCurrencies: []string{"XXX"},
+	}
+	rsp := &grpc.ChangeMerchantPayoutCurrenciesResponse{}
+	err := suite.service.ChangeMerchantPayoutCurrencies(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), merchantErrorCurrencyNotFound, rsp.Message)
+}
+
+func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantPayoutCurrencies_RuleCurrencyInvalid_Error() {
+	req := &grpc.ChangeMerchantPayoutCurrenciesRequest{
+		MerchantId:       suite.merchant.Id,
+		PayoutCurrencies: []string{"USD"},
+		PayoutCurrencyRules: []*billing.MerchantPayoutCurrencyRule{
+			{Currency: "EUR", Countries: []string{"DE"}},
+		},
+	}
+	rsp := &grpc.ChangeMerchantPayoutCurrenciesResponse{}
+	err := suite.service.ChangeMerchantPayoutCurrencies(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), merchantPayoutCurrencyRuleInvalid, rsp.Message)
+}
+
+func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantPayoutCurrencies_MerchantNotFound_Error() {
+	req := &grpc.ChangeMerchantPayoutCurrenciesRequest{
+		MerchantId:       bson.NewObjectId().Hex(),
+		PayoutCurrencies: []string{"USD"},
+	}
+	rsp := &grpc.ChangeMerchantPayoutCurrenciesResponse{}
+	err := suite.service.ChangeMerchantPayoutCurrencies(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), merchantErrorNotFound, rsp.Message)
+}
+
 func (suite *OnboardingTestSuite) TestOnboarding_SetMerchantS3Agreement_AgreementReadyToSign_CentrifigoError() {
 	req := &grpc.OnboardingRequest{
 		User: &billing.MerchantUser{
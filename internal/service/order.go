@@ -16,8 +16,10 @@ import (
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/google/uuid"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/paylink"
 	curPkg "github.com/paysuper/paysuper-currencies/pkg"
 	"github.com/paysuper/paysuper-currencies/pkg/proto/currencies"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
@@ -30,6 +32,7 @@ import (
 	"github.com/ttacon/libphonenumber"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
+	"math"
 	"regexp"
 	"sort"
 	"strconv"
@@ -44,11 +47,13 @@ const (
 	orderErrorPublishNotificationFailed = "publish order notification failed"
 	orderErrorUpdateOrderDataFailed     = "update order data failed"
 
-	paymentCreateBankCardFieldBrand         = "card_brand"
-	paymentCreateBankCardFieldType          = "card_type"
-	paymentCreateBankCardFieldCategory      = "card_category"
-	paymentCreateBankCardFieldIssuerName    = "bank_issuer_name"
-	paymentCreateBankCardFieldIssuerCountry = "bank_issuer_country"
+	paymentCreateBankCardFieldBrand                = "card_brand"
+	paymentCreateBankCardFieldType                 = "card_type"
+	paymentCreateBankCardFieldCategory             = "card_category"
+	paymentCreateBankCardFieldIssuerName           = "bank_issuer_name"
+	paymentCreateBankCardFieldIssuerCountry        = "bank_issuer_country"
+	paymentCreateBankCardFieldIssuerCountryIsoCode = "bank_issuer_country_iso_code"
+	paymentCreateBankCardFieldFingerprint          = "card_fingerprint"
 
 	orderDefaultDescription = "Payment by order # %s"
 
@@ -131,6 +136,13 @@ var (
 	orderErrorNoPlatforms                                     = newBillingServerErrorMsg("fm000062", "no available platforms")
 	orderCountryPaymentRestrictedEmailRequire                 = newBillingServerErrorMsg("fm000063", "payments from your country are not allowed")
 	orderErrorCostsRatesNotFound                              = newBillingServerErrorMsg("fm000064", "settings to calculate commissions not found")
+	orderErrorVirtualCurrencyNotFilled                        = newBillingServerErrorMsg("fm000065", "virtual currency is not filled in project settings")
+	orderErrorVirtualCurrencyFracNotSupported                 = newBillingServerErrorMsg("fm000066", "fractional numbers is not supported for this virtual currency")
+	orderErrorVirtualCurrencyLimits                           = newBillingServerErrorMsg("fm000067", "amount of order is outside of virtual currency purchase limits")
+	orderErrorVirtualCurrencyPrice                            = newBillingServerErrorMsg("fm000068", "can't get virtual currency price")
+	orderErrorPaymentRouteNotFound                            = newBillingServerErrorMsg("fm000069", "payment system for specified payment method, country, currency and amount not found")
+	orderErrorNotAuthorized                                   = newBillingServerErrorMsg("fm000070", "order hasn't authorized payment which can be captured or voided")
+	orderErrorRecurringPaymentRouteNotFound                   = newBillingServerErrorMsg("fm000071", "payment system which saved bank card not available for payment")
 
 	paymentSystemPaymentProcessingSuccessStatus = "PAYMENT_SYSTEM_PROCESSING_SUCCESS"
 )
@@ -147,6 +159,8 @@ type orderCreateRequestProcessorChecked struct {
 	metadata        map[string]string
 	privateMetadata map[string]string
 	user            *billing.OrderUser
+	virtualAmount   float64
+	paylinkToken    *paylink.PaylinkToken
 }
 
 type OrderCreateRequestProcessor struct {
@@ -213,6 +227,19 @@ func (s *Service) OrderCreateByPaylink(
 		return nil
 	}
 
+	token, err := s.checkPaylinkToken(pl, req.Token)
+	if err != nil {
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusBadData
+			if err == errorPaylinkTokenExpired || err == errorPaylinkTokenPurchasesLimitReached {
+				rsp.Status = pkg.ResponseStatusGone
+			}
+			rsp.Message = e
+			return nil
+		}
+		return err
+	}
+
 	oReq := &billing.OrderCreateRequest{
 		ProjectId: pl.ProjectId,
 		PayerIp:   req.PayerIp,
@@ -230,7 +257,19 @@ func (s *Service) OrderCreateByPaylink(
 		UtmCampaign:         req.UtmCampaign,
 	}
 
-	err = s.OrderCreateProcess(ctx, oReq, rsp)
+	if token != nil {
+		oReq.PrivateMetadata[orderPrivateMetadataPaylinkTokenId] = token.Id
+
+		if token.CustomerEmail != "" || token.CustomerCountry != "" {
+			oReq.User = &billing.OrderUser{Email: token.CustomerEmail}
+
+			if token.CustomerCountry != "" {
+				oReq.User.Address = &billing.OrderBillingAddress{Country: token.CustomerCountry}
+			}
+		}
+	}
+
+	err = s.orderCreateProcess(ctx, oReq, token, rsp)
 	if err != nil {
 		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
 			rsp.Status = pkg.ResponseStatusBadData
@@ -248,13 +287,27 @@ func (s *Service) OrderCreateProcess(
 	req *billing.OrderCreateRequest,
 	rsp *grpc.OrderCreateProcessResponse,
 ) error {
+	// paylink token can be attached to order only by OrderCreateByPaylink which verifies signature of token
+	delete(req.PrivateMetadata, orderPrivateMetadataPaylinkTokenId)
+
+	return s.orderCreateProcess(ctx, req, nil, rsp)
+}
+
+// orderCreateProcess creates order by request, discount of signed paylink token is applied
+// to order if verified token passed
+func (s *Service) orderCreateProcess(
+	ctx context.Context,
+	req *billing.OrderCreateRequest,
+	token *paylink.PaylinkToken,
+	rsp *grpc.OrderCreateProcessResponse,
+) error {
 
 	rsp.Status = pkg.ResponseStatusOk
 
 	processor := &OrderCreateRequestProcessor{
 		Service: s,
 		request: req,
-		checked: &orderCreateRequestProcessorChecked{},
+		checked: &orderCreateRequestProcessorChecked{paylinkToken: token},
 	}
 
 	if req.Token != "" {
@@ -389,7 +442,15 @@ func (s *Service) OrderCreateProcess(
 			return nil
 		}
 
-		//processor.ProcessVirtualCurrency()
+		if err := processor.processVirtualCurrency(); err != nil {
+			zap.S().Errorw(pkg.MethodFinishedWithError, "err", err.Error())
+			if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+				rsp.Status = pkg.ResponseStatusBadData
+				rsp.Message = e
+				return nil
+			}
+			return err
+		}
 		break
 	case billing.OrderType_product:
 		if err := processor.processPaylinkProducts(); err != nil {
@@ -654,6 +715,8 @@ func (s *Service) PaymentFormJsonDataProcess(
 		err = s.ProcessOrderProducts(order)
 	} else if order.ProductType == billing.OrderType_key {
 		rsp.Item.Platforms, err = s.ProcessOrderKeyProducts(ctx, order)
+	} else if order.ProductType == billing.OrderTypeVirtualCurrency {
+		err = s.ProcessOrderVirtualCurrency(order)
 	}
 
 	if err != nil {
@@ -757,6 +820,8 @@ func (s *Service) PaymentFormJsonDataProcess(
 	rsp.Item.Amount = order.OrderAmount
 	rsp.Item.TotalAmount = order.TotalPaymentAmount
 	rsp.Item.Items = order.Items
+	rsp.Item.Discount = order.DiscountAmount
+	rsp.Item.PromoCode = order.PromoCode
 	rsp.Item.Email = order.User.Email
 
 	if order.CountryRestriction != nil {
@@ -821,6 +886,8 @@ func (s *Service) PaymentCreateProcess(
 		if _, err = s.ProcessOrderKeyProducts(ctx, order); err == nil {
 			err = processor.reserveKeysForOrder(ctx, order)
 		}
+	} else if order.ProductType == billing.OrderTypeVirtualCurrency {
+		err = s.ProcessOrderVirtualCurrency(order)
 	}
 
 	if err != nil {
@@ -837,40 +904,64 @@ func (s *Service) PaymentCreateProcess(
 		return err
 	}
 
-	settings, err := s.paymentMethod.GetPaymentSettings(
-		processor.checked.paymentMethod,
-		order.Currency,
-		processor.checked.project,
-	)
+	routes, err := s.getPaymentRoutes(order, processor.checked.paymentMethod, processor.checked.project)
 
 	if err != nil {
 		rsp.Status = pkg.ResponseStatusSystemError
+
+		if err == orderErrorPaymentSystemInactive || err == orderErrorPaymentRouteNotFound {
+			rsp.Status = pkg.ResponseStatusBadData
+		}
+
 		rsp.Message = err.(*grpc.ResponseErrorMessage)
 
 		return nil
 	}
 
-	ps, err := s.paymentSystem.GetById(processor.checked.paymentMethod.PaymentSystemId)
-	if err != nil {
-		rsp.Message = orderErrorPaymentSystemInactive
-		rsp.Status = pkg.ResponseStatusBadData
+	if recurringId, ok := order.PaymentRequisites[pkg.PaymentCreateFieldRecurringId]; ok {
+		// saved card can be charged only by payment system which tokenized it
+		routes, err = s.getRecurringPaymentRoutes(routes, recurringId, processor.checked.paymentMethod)
 
-		return nil
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+
+			if err == orderErrorRecurringPaymentRouteNotFound {
+				rsp.Status = pkg.ResponseStatusBadData
+			}
+
+			rsp.Message = err.(*grpc.ResponseErrorMessage)
+
+			return nil
+		}
 	}
 
 	order.PaymentMethod = &billing.PaymentMethodOrder{
 		Id:              processor.checked.paymentMethod.Id,
 		Name:            processor.checked.paymentMethod.Name,
-		Params:          settings,
-		PaymentSystemId: ps.Id,
+		Params:          routes[0].settings,
+		PaymentSystemId: routes[0].paymentSystem.Id,
 		Group:           processor.checked.paymentMethod.Group,
 		ExternalId:      processor.checked.paymentMethod.ExternalId,
-		Handler:         ps.Handler,
+		Handler:         routes[0].paymentSystem.Handler,
 	}
 
 	p1 := &OrderCreateRequestProcessor{Service: s}
 	p1.processOrderVat(order)
 
+	merchant, err := s.merchant.GetById(order.GetMerchantId())
+
+	if err != nil {
+		zap.L().Error(orderErrorMerchantForOrderNotFound.Message, zap.Error(err))
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = orderErrorMerchantForOrderNotFound
+
+		return nil
+	}
+
+	// country of order is final at this moment, so net revenue can be routed to matched payout currency
+	order.Project.MerchantRoyaltyCurrency = merchant.GetPayoutCurrencyForOrder(order.GetCountry(), order.Currency)
+
 	if _, ok := order.PaymentRequisites[pkg.PaymentCreateFieldRecurringId]; ok {
 		req.Data[pkg.PaymentCreateFieldRecurringId] = order.PaymentRequisites[pkg.PaymentCreateFieldRecurringId]
 		delete(order.PaymentRequisites, pkg.PaymentCreateFieldRecurringId)
@@ -901,19 +992,56 @@ func (s *Service) PaymentCreateProcess(
 		return nil
 	}
 
-	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+	order.FraudCheck, err = s.checkOrderFraud(order)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+		return nil
+	}
+
+	s.fraudNotifyMerchant(order)
+
+	if order.FraudCheck.Decision == pkg.FraudDecisionDecline {
+		order.PrivateStatus = constant.OrderStatusPaymentSystemDeclined
+
+		if err := s.updateOrder(order); err != nil {
+			zap.S().Errorw("Order declined by fraud rules saving failed", "err", err.Error(), "order", order)
+		}
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = fraudErrorPaymentDeclined
+		return nil
+	}
+
+	err = s.reserveOrderPaylinkTokenPurchase(order)
+
+	if err == nil {
+		err = s.reserveOrderPromoCodeUse(order)
+
+		if err != nil {
+			s.releaseOrderPaylinkTokenPurchase(order)
+		}
+	}
 
 	if err != nil {
-		zap.S().Errorw(pkg.MethodFinishedWithError, "err", err.Error())
 		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
-			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Status = pkg.ResponseStatusBadData
+
+			if err == errorPaylinkTokenPurchasesLimitReached {
+				rsp.Status = pkg.ResponseStatusGone
+			}
+
 			rsp.Message = e
 			return nil
 		}
-		return err
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = orderErrorUnknown
+		return nil
 	}
 
-	url, err := h.CreatePayment(order, s.cfg.GetRedirectUrlSuccess(nil), s.cfg.GetRedirectUrlFail(nil), req.Data)
+	url, err := s.createPaymentByRoutes(order, routes, req.Data)
 
 	if err != nil {
 		zap.L().Error(
@@ -921,6 +1049,15 @@ func (s *Service) PaymentCreateProcess(
 			zap.Error(err),
 			zap.Any("order", order),
 		)
+
+		if err := s.updateOrder(order); err != nil {
+			zap.S().Errorw("Order payment route attempts saving failed", "err", err.Error(), "order", order)
+		}
+
+		// reservations are released by rejected status of order, payment system can fail without setting it
+		s.releaseOrderPaylinkTokenPurchase(order)
+		s.releaseOrderPromoCodeUse(order)
+
 		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
 			rsp.Status = pkg.ResponseStatusSystemError
 			rsp.Message = e
@@ -983,6 +1120,14 @@ func (s *Service) PaymentCallbackProcess(
 			return errors.New(paymentRequestIncorrect)
 		}
 		break
+	case pkg.PaymentSystemHandlerStripe:
+		data = &billing.StripePaymentCallback{}
+		err := json.Unmarshal(req.Request, data)
+
+		if err != nil || data.(*billing.StripePaymentCallback).GetPaymentIntent() == nil {
+			return errors.New(paymentRequestIncorrect)
+		}
+		break
 	default:
 		return orderErrorPaymentMethodNotFound
 	}
@@ -1039,6 +1184,10 @@ func (s *Service) PaymentCallbackProcess(
 		return err
 	}
 
+	if _, ok := order.PrivateMetadata[subscriptionOrderMetadataKey]; ok {
+		s.processSubscriptionOrderPayment(order)
+	}
+
 	if pErr == nil {
 		err = s.paymentSystemPaymentCallbackComplete(ctx, order)
 
@@ -1048,6 +1197,13 @@ func (s *Service) PaymentCallbackProcess(
 			return nil
 		}
 
+		// authorized and voided payments will not be charged, so they don't create accounting entries
+		if order.PrivateStatus == pkg.OrderStatusPaymentSystemAuthorized ||
+			order.PrivateStatus == pkg.OrderStatusPaymentSystemVoided {
+			rsp.Status = pkg.StatusOK
+			return nil
+		}
+
 		err = s.onPaymentNotify(ctx, order)
 
 		if err != nil {
@@ -1114,6 +1270,8 @@ func (s *Service) PaymentFormLanguageChanged(
 		err = s.ProcessOrderProducts(order)
 	} else if order.ProductType == billing.OrderType_key {
 		_, err = s.ProcessOrderKeyProducts(ctx, order)
+	} else if order.ProductType == billing.OrderTypeVirtualCurrency {
+		err = s.ProcessOrderVirtualCurrency(order)
 	}
 
 	if err != nil {
@@ -1382,6 +1540,8 @@ func (s *Service) ProcessBillingAddress(
 		err = s.ProcessOrderProducts(order)
 	} else if order.ProductType == billing.OrderType_key {
 		_, err = s.ProcessOrderKeyProducts(ctx, order)
+	} else if order.ProductType == billing.OrderTypeVirtualCurrency {
+		err = s.ProcessOrderVirtualCurrency(order)
 	}
 
 	if err != nil {
@@ -1407,16 +1567,22 @@ func (s *Service) ProcessBillingAddress(
 	}
 
 	rsp.Status = pkg.ResponseStatusOk
-	rsp.Item = &grpc.ProcessBillingAddressResponseItem{
+	rsp.Item = getProcessBillingAddressResponseItem(order)
+
+	return nil
+}
+
+func getProcessBillingAddressResponseItem(order *billing.Order) *grpc.ProcessBillingAddressResponseItem {
+	return &grpc.ProcessBillingAddressResponseItem{
 		HasVat:      order.Tax.Amount > 0,
 		Vat:         order.Tax.Amount,
-		Amount:      tools.FormatAmount(order.OrderAmount),
-		TotalAmount: tools.FormatAmount(order.TotalPaymentAmount),
+		Amount:      money.Round(order.OrderAmount, order.Currency),
+		TotalAmount: money.Round(order.TotalPaymentAmount, order.Currency),
 		Currency:    order.Currency,
 		Items:       order.Items,
+		Discount:    order.DiscountAmount,
+		PromoCode:   order.PromoCode,
 	}
-
-	return nil
 }
 
 func (s *Service) saveRecurringCard(order *billing.Order, recurringId string) {
@@ -1444,6 +1610,8 @@ func (s *Service) saveRecurringCard(order *billing.Order, recurringId string) {
 			},
 		)
 	} else {
+		_ = s.saveRecurringPaymentSystem(order, recurringId)
+
 		order.PaymentRequisites["saved"] = "1"
 		err = s.updateOrder(order)
 		if err != nil {
@@ -1480,6 +1648,15 @@ func (s *Service) updateOrder(order *billing.Order) error {
 
 	zap.S().Debug("[updateOrder] updating order success", "order_id", order.Id, "status_changed", statusChanged, "type", order.ProductType)
 
+	if statusChanged {
+		_ = s.addOrderViewOrderEvents(orderViewEventSourceOrderStatus, order)
+	}
+
+	if statusChanged && orderReservedUseReleaseStatuses[ps] {
+		s.releaseOrderPaylinkTokenPurchase(order)
+		s.releaseOrderPromoCodeUse(order)
+	}
+
 	if order.ProductType == billing.OrderType_key {
 		s.orderNotifyKeyProducts(context.TODO(), order)
 	}
@@ -1500,6 +1677,25 @@ func (s *Service) updateOrder(order *billing.Order) error {
 	return nil
 }
 
+// updateOrderPrivateMetadata saves private metadata of order only, so marks of reserved
+// paylink token purchase and promo code use are stored before payment is created
+func (s *Service) updateOrderPrivateMetadata(order *billing.Order) error {
+	update := bson.M{"$set": bson.M{"private_metadata": order.PrivateMetadata}}
+	err := s.db.Collection(collectionOrder).UpdateId(bson.ObjectIdHex(order.Id), update)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, order.Id),
+			zap.Any(pkg.ErrorDatabaseFieldSet, update),
+		)
+	}
+
+	return err
+}
+
 func (s *Service) orderNotifyKeyProducts(ctx context.Context, order *billing.Order) {
 	zap.S().Debug("[orderNotifyKeyProducts] called", "order_id", order.Id, "status", order.GetPublicStatus(), "is product notified: ", order.IsKeyProductNotified)
 
@@ -1572,7 +1768,7 @@ func (s *Service) sendMailWithReceipt(order *billing.Order) {
 	}
 }
 
-func (s *Service) getReceiptModel(name string, price string) *structpb.Value {
+func (s *Service) getReceiptModel(name, price, discount string) *structpb.Value {
 	return &structpb.Value{
 		Kind: &structpb.Value_StructValue{
 			StructValue: &structpb.Struct{
@@ -1583,6 +1779,9 @@ func (s *Service) getReceiptModel(name string, price string) *structpb.Value {
 					"price": {
 						Kind: &structpb.Value_StringValue{StringValue: price},
 					},
+					"discount": {
+						Kind: &structpb.Value_StringValue{StringValue: discount},
+					},
 				},
 			},
 		},
@@ -1621,6 +1820,18 @@ func (s *Service) getPayloadForReceipt(order *billing.Order) *postmarkSdrPkg.Pay
 		To: order.ReceiptEmail,
 	}
 
+	if order.DiscountAmount > 0 {
+		totalDiscount, err := s.formatter.FormatCurrency(DefaultLanguage, order.DiscountAmount, order.Currency)
+		if err != nil {
+			zap.S().Errorw("Error during formatting currency", "price", order.DiscountAmount, "locale", DefaultLanguage, "currency", order.Currency)
+		}
+		payload.TemplateModel["total_discount"] = totalDiscount
+
+		if order.PromoCode != nil {
+			payload.TemplateModel["promo_code"] = order.PromoCode.Code
+		}
+	}
+
 	var items []*structpb.Value
 
 	for _, item := range order.Items {
@@ -1628,7 +1839,17 @@ func (s *Service) getPayloadForReceipt(order *billing.Order) *postmarkSdrPkg.Pay
 		if err != nil {
 			zap.S().Errorw("Error during formatting currency", "price", item.Amount, "locale", "en", "currency", item.Currency)
 		}
-		items = append(items, s.getReceiptModel(item.Name, price))
+
+		discount := ""
+
+		if item.Discount > 0 {
+			discount, err = s.formatter.FormatCurrency("en", item.Discount, item.Currency)
+			if err != nil {
+				zap.S().Errorw("Error during formatting currency", "price", item.Discount, "locale", "en", "currency", item.Currency)
+			}
+		}
+
+		items = append(items, s.getReceiptModel(item.Name, price, discount))
 	}
 
 	payload.TemplateObjectModel = &structpb.Struct{
@@ -1709,9 +1930,19 @@ func (s *Service) orderNotifyMerchant(order *billing.Order) {
 			"err", err.Error(), "order", order, "topic", constant.PayOneTopicNotifyPaymentName,
 		})
 	} else {
-		zap.S().Debug("[orderNotifyMerchant] send notify merchant to rmq failed", "order_id", order.Id)
+		zap.S().Debug("[orderNotifyMerchant] notify merchant sent to rmq", "order_id", order.Id)
 	}
-	order.SetNotificationStatus(order.GetPublicStatus(), err == nil)
+
+	errDelivery := s.createWebhookDelivery(order)
+	if errDelivery != nil {
+		zap.S().Debug("[orderNotifyMerchant] create merchant webhook delivery failed", "order_id", order.Id)
+		s.logError(orderErrorPublishNotificationFailed, []interface{}{
+			"err", errDelivery.Error(), "order", order, "collection", collectionWebhookDelivery,
+		})
+	} else {
+		zap.S().Debug("[orderNotifyMerchant] merchant webhook delivery created", "order_id", order.Id)
+	}
+	order.SetNotificationStatus(order.GetPublicStatus(), err == nil && errDelivery == nil)
 	err = s.db.Collection(collectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
 	if err != nil {
 		zap.S().Debug("[orderNotifyMerchant] notification status update failed", "order_id", order.Id)
@@ -1756,7 +1987,8 @@ func (s *Service) getOrderByUuidToForm(uuid string) (*billing.Order, error) {
 		return nil, orderErrorNotFound
 	}
 
-	if order.HasEndedStatus() == true {
+	// authorized payment isn't final, but order already paid by customer and waits for capture or void
+	if order.HasEndedStatus() == true || order.IsAuthorized() == true {
 		return nil, orderErrorOrderAlreadyComplete
 	}
 
@@ -1792,7 +2024,7 @@ func (s *Service) getBinData(pan string) (data *BinData) {
 
 func (v *OrderCreateRequestProcessor) prepareOrder() (*billing.Order, error) {
 	id := bson.NewObjectId().Hex()
-	amount := tools.FormatAmount(v.checked.amount)
+	amount := money.Round(v.checked.amount, v.checked.currency)
 
 	if (v.request.UrlVerify != "" || v.request.UrlNotify != "") && v.checked.project.AllowDynamicNotifyUrls == false {
 		return nil, orderErrorDynamicNotifyUrlsNotAllowed
@@ -1857,10 +2089,15 @@ func (v *OrderCreateRequestProcessor) prepareOrder() (*billing.Order, error) {
 			PaymentsAllowed: true,
 			ChangeAllowed:   true,
 		},
-		PlatformId:  v.request.PlatformId,
-		ProductType: v.request.Type,
+		PlatformId:            v.request.PlatformId,
+		ProductType:           v.request.Type,
+		VirtualCurrencyAmount: v.checked.virtualAmount,
+		IsAuthorizationOnly:   v.request.IsAuthorizationOnly,
+		DiscountPercent:       v.checked.paylinkToken.GetDiscountPercent(),
 	}
 
+	applyOrderDiscounts(order)
+
 	if order.User == nil {
 		order.User = &billing.OrderUser{
 			Object: pkg.ObjectTypeUser,
@@ -2123,6 +2360,39 @@ func (v *OrderCreateRequestProcessor) processPaylinkProducts() error {
 	return nil
 }
 
+func (v *OrderCreateRequestProcessor) processVirtualCurrency() error {
+	virtualAmount, err := v.getVirtualCurrencyPurchaseAmount(v.checked.project, v.request.Amount)
+
+	if err != nil {
+		return err
+	}
+
+	locale := DefaultLanguage
+
+	if v.checked.user.Locale != "" {
+		locale = v.checked.user.Locale
+	}
+
+	currency, amount, items, err := v.getOrderVirtualCurrencyItems(
+		v.checked.project,
+		v.checked.merchant,
+		virtualAmount,
+		v.checked.user.Address.Country,
+		locale,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	v.checked.virtualAmount = virtualAmount
+	v.checked.currency = currency
+	v.checked.amount = amount
+	v.checked.items = items
+
+	return nil
+}
+
 func (v *OrderCreateRequestProcessor) processProjectOrderId() error {
 	var order *billing.Order
 
@@ -2296,8 +2566,21 @@ func (v *OrderCreateRequestProcessor) processOrderVat(order *billing.Order) {
 	}
 
 	order.Tax.Rate = rsp.Rate.Rate
-	order.Tax.Amount = tools.FormatAmount(order.OrderAmount * order.Tax.Rate)
-	order.TotalPaymentAmount = tools.FormatAmount(order.OrderAmount + order.Tax.Amount)
+
+	// vat rate of country overrides rate of tax service if rate set in vat settings effective now
+	if order.Tax.Type == taxTypeVat && order.GetCountry() != "" {
+		country, err := v.getCountryForDate(order.GetCountry(), time.Now())
+
+		if err == nil && country.VatEnabled && country.VatRate > 0 {
+			order.Tax.Rate = country.VatRate
+		}
+	}
+
+	orderAmount := money.FromFloat(order.OrderAmount, order.Currency, money.RoundHalfUp)
+	taxAmount := orderAmount.Mul(order.Tax.Rate, money.RoundHalfUp)
+
+	order.Tax.Amount = taxAmount.Float64()
+	order.TotalPaymentAmount = money.New(orderAmount.Amount()+taxAmount.Amount(), order.Currency).Float64()
 
 	return
 }
@@ -2729,6 +3012,7 @@ func (v *PaymentCreateProcessor) processPaymentFormData() error {
 			order.PaymentRequisites[pkg.PaymentCreateFieldYear] = storedCard.Expire.Year
 			order.PaymentRequisites[pkg.PaymentCreateFieldHolder] = storedCard.CardHolder
 			order.PaymentRequisites[pkg.PaymentCreateFieldRecurringId] = storedCard.RecurringId
+			order.PaymentRequisites[paymentCreateBankCardFieldFingerprint] = v.service.getCardFingerprint(storedCard.RecurringId)
 		} else {
 			validator := &bankCardValidator{
 				Pan:    v.data[pkg.PaymentCreateFieldPan],
@@ -2744,6 +3028,7 @@ func (v *PaymentCreateProcessor) processPaymentFormData() error {
 
 			order.PaymentRequisites[pkg.PaymentCreateFieldPan] = tools.MaskBankCardNumber(v.data[pkg.PaymentCreateFieldPan])
 			order.PaymentRequisites[pkg.PaymentCreateFieldMonth] = v.data[pkg.PaymentCreateFieldMonth]
+			order.PaymentRequisites[paymentCreateBankCardFieldFingerprint] = v.service.getCardFingerprint(v.data[pkg.PaymentCreateFieldPan])
 
 			if len(v.data[pkg.PaymentCreateFieldYear]) < 3 {
 				v.data[pkg.PaymentCreateFieldYear] = strconv.Itoa(time.Now().UTC().Year())[:2] + v.data[pkg.PaymentCreateFieldYear]
@@ -2760,6 +3045,7 @@ func (v *PaymentCreateProcessor) processPaymentFormData() error {
 			order.PaymentRequisites[paymentCreateBankCardFieldCategory] = bin.CardCategory
 			order.PaymentRequisites[paymentCreateBankCardFieldIssuerName] = bin.BankName
 			order.PaymentRequisites[paymentCreateBankCardFieldIssuerCountry] = bin.BankCountryName
+			order.PaymentRequisites[paymentCreateBankCardFieldIssuerCountryIsoCode] = bin.BankCountryIsoCode
 		}
 	} else {
 		account := ""
@@ -2825,7 +3111,7 @@ func (s *Service) GetOrderKeyProductsAmount(products []*grpc.KeyProduct, group *
 		return 0, orderErrorProductsEmpty
 	}
 
-	sum := float64(0)
+	sum := int64(0)
 
 	for _, p := range products {
 		amount, err := p.GetPriceInCurrencyAndPlatform(group, platformId)
@@ -2834,12 +3120,10 @@ func (s *Service) GetOrderKeyProductsAmount(products []*grpc.KeyProduct, group *
 			return 0, orderErrorNoProductsCommonCurrency
 		}
 
-		sum += amount
+		sum += money.ToMinor(amount, group.Currency)
 	}
 
-	totalAmount := tools.FormatAmount(sum)
-
-	return totalAmount, nil
+	return money.FromMinor(sum, group.Currency), nil
 }
 
 func (s *Service) GetOrderProducts(projectId string, productIds []string) ([]*grpc.Product, error) {
@@ -2877,7 +3161,7 @@ func (s *Service) GetOrderProductsAmount(products []*grpc.Product, group *billin
 		return 0, orderErrorProductsEmpty
 	}
 
-	sum := float64(0)
+	sum := int64(0)
 
 	for _, p := range products {
 		amount, err := p.GetPriceInCurrency(group)
@@ -2886,12 +3170,10 @@ func (s *Service) GetOrderProductsAmount(products []*grpc.Product, group *billin
 			return 0, orderErrorNoProductsCommonCurrency
 		}
 
-		sum += amount
+		sum += money.ToMinor(amount, group.Currency)
 	}
 
-	totalAmount := tools.FormatAmount(sum)
-
-	return totalAmount, nil
+	return money.FromMinor(sum, group.Currency), nil
 }
 
 func (s *Service) GetOrderProductsItems(products []*grpc.Product, language string, group *billing.PriceGroup) ([]*billing.OrderItem, error) {
@@ -3178,13 +3460,15 @@ func (s *Service) ProcessOrderKeyProducts(ctx context.Context, order *billing.Or
 		return nil, err
 	}
 
-	amount = tools.FormatAmount(amount)
+	amount = money.Round(amount, currency)
 
 	order.Currency = currency
 	order.OrderAmount = amount
 	order.TotalPaymentAmount = amount
 	order.Items = items
 
+	applyOrderDiscounts(order)
+
 	return platforms, nil
 }
 
@@ -3220,7 +3504,7 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 
 	if order.BillingAddress != nil && order.BillingAddress.Country != "" {
 		country = order.BillingAddress.Country
-	} else if order.User.Address != nil && order.User.Address.Country != "" {
+	} else if order.User != nil && order.User.Address != nil && order.User.Address.Country != "" {
 		country = order.User.Address.Country
 	}
 
@@ -3308,7 +3592,7 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 		return err
 	}
 
-	amount = tools.FormatAmount(amount)
+	amount = money.Round(amount, currency)
 
 	order.Currency = currency
 	order.OrderAmount = amount
@@ -3316,9 +3600,191 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 
 	order.Items = items
 
+	applyOrderDiscounts(order)
+
+	return nil
+}
+
+func (s *Service) ProcessOrderVirtualCurrency(order *billing.Order) error {
+	if order.ProductType != billing.OrderTypeVirtualCurrency {
+		return nil
+	}
+
+	project, err := s.project.GetById(order.Project.Id)
+	if err != nil {
+		return orderErrorProjectNotFound
+	}
+	if project.IsDeleted() == true {
+		return orderErrorProjectInactive
+	}
+
+	merchant, err := s.merchant.GetById(order.Project.MerchantId)
+	if err != nil {
+		return orderErrorMerchantForOrderNotFound
+	}
+
+	var (
+		country string
+		locale  = DefaultLanguage
+	)
+
+	if order.BillingAddress != nil && order.BillingAddress.Country != "" {
+		country = order.BillingAddress.Country
+	} else if order.User != nil && order.User.Address != nil && order.User.Address.Country != "" {
+		country = order.User.Address.Country
+	}
+
+	if order.User != nil && order.User.Locale != "" {
+		locale = order.User.Locale
+	}
+
+	currency, amount, items, err := s.getOrderVirtualCurrencyItems(project, merchant, order.VirtualCurrencyAmount, country, locale)
+	if err != nil {
+		return err
+	}
+
+	order.Currency = currency
+	order.OrderAmount = amount
+	order.TotalPaymentAmount = amount
+	order.Items = items
+
 	return nil
 }
 
+// getVirtualCurrencyPurchaseAmount checks the requested count of virtual currency units
+// against the project sell count type and purchase limits
+func (s *Service) getVirtualCurrencyPurchaseAmount(project *billing.Project, amount float64) (float64, error) {
+	virtualCurrency := project.VirtualCurrency
+
+	if virtualCurrency == nil || len(virtualCurrency.Prices) <= 0 {
+		return 0, orderErrorVirtualCurrencyNotFilled
+	}
+
+	if virtualCurrency.SellCountType == pkg.ProjectSellCountTypeIntegral {
+		if amount != math.Trunc(amount) {
+			return 0, orderErrorVirtualCurrencyFracNotSupported
+		}
+	} else {
+		amount = tools.FormatAmount(amount)
+	}
+
+	if amount <= 0 ||
+		(virtualCurrency.MinPurchaseValue > 0 && amount < virtualCurrency.MinPurchaseValue) ||
+		(virtualCurrency.MaxPurchaseValue > 0 && amount > virtualCurrency.MaxPurchaseValue) {
+		return 0, orderErrorVirtualCurrencyLimits
+	}
+
+	return amount, nil
+}
+
+// getOrderVirtualCurrencyItems calculates order currency, amount and items for purchase of virtual currency.
+// Price is taken from price group of user country, if project has no price for it then price in merchant
+// payout currency will be converted to currency of user country
+func (s *Service) getOrderVirtualCurrencyItems(
+	project *billing.Project,
+	merchant *billing.Merchant,
+	virtualAmount float64,
+	country, locale string,
+) (string, float64, []*billing.OrderItem, error) {
+	if project.VirtualCurrency == nil || len(project.VirtualCurrency.Prices) <= 0 {
+		return "", 0, nil, orderErrorVirtualCurrencyNotFilled
+	}
+
+	logInfo := "[getOrderVirtualCurrencyItems] %s"
+	defaultCurrency := merchant.GetPayoutCurrency()
+
+	if defaultCurrency == "" {
+		zap.S().Errorw(fmt.Sprintf(logInfo, "merchant payout currency not found"), "project", project.Id)
+		return "", 0, nil, orderErrorNoProductsCommonCurrency
+	}
+
+	defaultPriceGroup, err := s.priceGroup.GetByRegion(defaultCurrency)
+	if err != nil {
+		zap.S().Errorw("Price group not found", "currency", defaultCurrency)
+		return "", 0, nil, priceGroupErrorNotFound
+	}
+
+	priceGroup := defaultPriceGroup
+
+	if country != "" {
+		countryData, err := s.country.GetByIsoCodeA2(country)
+		if err != nil {
+			zap.S().Errorw("Country not found", "country", country)
+			return "", 0, nil, orderErrorUnknown
+		}
+
+		priceGroup, err = s.priceGroup.GetById(countryData.PriceGroupId)
+		if err != nil {
+			zap.S().Errorw("Price group not found", "countryData", countryData)
+			return "", 0, nil, orderErrorUnknown
+		}
+	}
+
+	currency := priceGroup.Currency
+	rate, err := project.GetVirtualCurrencyRate(priceGroup)
+
+	if err != nil {
+		if priceGroup.Id == defaultPriceGroup.Id {
+			return "", 0, nil, orderErrorVirtualCurrencyPrice
+		}
+
+		rate, err = project.GetVirtualCurrencyRate(defaultPriceGroup)
+		if err != nil {
+			return "", 0, nil, orderErrorVirtualCurrencyPrice
+		}
+
+		zap.S().Infow(fmt.Sprintf(logInfo, "try to use default currency for order amount"), "currency", defaultCurrency, "project", project.Id)
+
+		req := &currencies.ExchangeCurrencyCurrentForMerchantRequest{
+			From:       defaultCurrency,
+			To:         currency,
+			MerchantId: merchant.Id,
+			RateType:   curPkg.RateTypeOxr,
+			Amount:     rate,
+		}
+
+		rsp, err := s.curService.ExchangeCurrencyCurrentForMerchant(context.TODO(), req)
+
+		if err != nil {
+			zap.S().Error(
+				pkg.ErrorGrpcServiceCallFailed,
+				zap.Error(err),
+				zap.String(errorFieldService, "CurrencyRatesService"),
+				zap.String(errorFieldMethod, "ExchangeCurrencyCurrentForMerchant"),
+			)
+
+			return "", 0, nil, orderErrorConvertionCurrency
+		}
+
+		rate = rsp.ExchangedAmount
+	}
+
+	name, ok := project.VirtualCurrency.Name[locale]
+
+	if !ok {
+		name, ok = project.VirtualCurrency.Name[DefaultLanguage]
+
+		if !ok {
+			return "", 0, nil, orderErrorNoNameInDefaultLanguage
+		}
+	}
+
+	amount := money.Round(virtualAmount*rate, currency)
+	item := &billing.OrderItem{
+		Id:          project.Id,
+		Object:      billing.OrderTypeVirtualCurrency,
+		Sku:         project.Id,
+		Name:        name,
+		Description: fmt.Sprintf("%s %s", strconv.FormatFloat(virtualAmount, 'f', -1, 64), name),
+		Amount:      amount,
+		Currency:    currency,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+
+	return currency, amount, []*billing.OrderItem{item}, nil
+}
+
 func (s *Service) notifyPaylinkError(ctx context.Context, paylinkId string, err error, req interface{}, order interface{}) {
 	msg := map[string]interface{}{
 		"event":     "error",
@@ -3781,6 +4247,24 @@ func (s *Service) OrderReceipt(
 		}
 
 		items[i] = &billing.OrderReceiptItem{Name: item.Name, Price: price}
+
+		if item.Discount > 0 {
+			items[i].Discount, err = s.formatter.FormatCurrency("en", item.Discount, item.Currency)
+
+			if err != nil {
+				zap.L().Error(
+					orderErrorDuringFormattingCurrency.Message,
+					zap.Float64("price", item.Discount),
+					zap.String("locale", DefaultLanguage),
+					zap.String("currency", item.Currency),
+				)
+
+				rsp.Status = pkg.ResponseStatusSystemError
+				rsp.Message = orderErrorDuringFormattingCurrency
+
+				return nil
+			}
+		}
 	}
 
 	var platformName = ""
@@ -3800,6 +4284,28 @@ func (s *Service) OrderReceipt(
 		PlatformName:    platformName,
 	}
 
+	if order.DiscountAmount > 0 {
+		receipt.TotalDiscount, err = s.formatter.FormatCurrency(DefaultLanguage, order.DiscountAmount, order.Currency)
+
+		if err != nil {
+			zap.L().Error(
+				orderErrorDuringFormattingCurrency.Message,
+				zap.Float64("price", order.DiscountAmount),
+				zap.String("locale", DefaultLanguage),
+				zap.String("currency", order.Currency),
+			)
+
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = orderErrorDuringFormattingCurrency
+
+			return nil
+		}
+
+		if order.PromoCode != nil {
+			receipt.PromoCode = order.PromoCode.Code
+		}
+	}
+
 	rsp.Status = pkg.ResponseStatusOk
 	rsp.Receipt = receipt
 
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"time"
+)
+
+func (s *Service) CaptureOrder(
+	ctx context.Context,
+	req *grpc.OrderAuthorizationRequest,
+	rsp *grpc.OrderAuthorizationResponse,
+) error {
+	order, rspErr := s.getAuthorizedOrder(req.OrderId, req.MerchantId)
+
+	if rspErr != nil {
+		rsp.Status = rspErr.Status
+		rsp.Message = rspErr.Message
+		return nil
+	}
+
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		zap.L().Error(pkg.MethodFinishedWithError, zap.Error(err), zap.String("order_id", order.Id))
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = paymentSystemErrorHandlerNotFound
+		return nil
+	}
+
+	// order will be moved to completed status by payment system callback after capture
+	if err = h.CapturePayment(order); err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = paymentSystemErrorCaptureFailed
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = order
+
+	return nil
+}
+
+func (s *Service) VoidOrder(
+	ctx context.Context,
+	req *grpc.OrderAuthorizationRequest,
+	rsp *grpc.OrderAuthorizationResponse,
+) error {
+	order, rspErr := s.getAuthorizedOrder(req.OrderId, req.MerchantId)
+
+	if rspErr != nil {
+		rsp.Status = rspErr.Status
+		rsp.Message = rspErr.Message
+		return nil
+	}
+
+	if rspErr = s.voidOrder(order); rspErr != nil {
+		rsp.Status = rspErr.Status
+		rsp.Message = rspErr.Message
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = order
+
+	return nil
+}
+
+// VoidExpiredAuthorizations void payments which were authorized but not captured
+// by merchant during authorization void period
+func (s *Service) VoidExpiredAuthorizations() error {
+	query := bson.M{
+		"private_status": pkg.OrderStatusPaymentSystemAuthorized,
+		"authorized_at": bson.M{
+			"$lte": time.Now().Add(-time.Duration(s.cfg.OrderAuthorizationVoidPeriod) * time.Second),
+		},
+	}
+
+	var orders []*billing.Order
+	err := s.db.Collection(collectionOrder).Find(query).All(&orders)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	for _, order := range orders {
+		if err := s.voidOrder(order); err != nil {
+			zap.L().Error(
+				"Void expired authorization failed",
+				zap.String("order_id", order.Id),
+				zap.Any("error", err.Message),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getAuthorizedOrder(uuid, merchantId string) (*billing.Order, *grpc.ResponseError) {
+	order, err := s.getOrderByUuid(uuid)
+
+	if err != nil || order.GetMerchantId() != merchantId {
+		return nil, newBillingServerResponseError(pkg.ResponseStatusNotFound, orderErrorNotFound)
+	}
+
+	if order.IsAuthorized() == false {
+		return nil, newBillingServerResponseError(pkg.ResponseStatusBadData, orderErrorNotAuthorized)
+	}
+
+	return order, nil
+}
+
+func (s *Service) voidOrder(order *billing.Order) *grpc.ResponseError {
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		zap.L().Error(pkg.MethodFinishedWithError, zap.Error(err), zap.String("order_id", order.Id))
+		return newBillingServerResponseError(pkg.ResponseStatusSystemError, paymentSystemErrorHandlerNotFound)
+	}
+
+	if err = h.VoidPayment(order); err != nil {
+		return newBillingServerResponseError(pkg.ResponseStatusBadData, paymentSystemErrorVoidFailed)
+	}
+
+	order.PrivateStatus = pkg.OrderStatusPaymentSystemVoided
+	order.CanceledAt = ptypes.TimestampNow()
+
+	if err = s.updateOrder(order); err != nil {
+		return newBillingServerResponseError(pkg.ResponseStatusSystemError, orderErrorUnknown)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/globalsign/mgo/bson"
 	"github.com/go-redis/redis"
@@ -46,6 +47,7 @@ type OrderTestSuite struct {
 	projectFixedAmount                     *billing.Project
 	projectWithProducts                    *billing.Project
 	projectWithKeyProducts                 *billing.Project
+	projectWithVirtualCurrency             *billing.Project
 	inactiveProject                        *billing.Project
 	projectWithoutPaymentMethods           *billing.Project
 	projectIncorrectPaymentMethodId        *billing.Project
@@ -757,6 +759,31 @@ func (suite *OrderTestSuite) SetupTest() {
 		Status:                   pkg.ProjectStatusDraft,
 		MerchantId:               merchant.Id,
 	}
+	projectWithVirtualCurrency := &billing.Project{
+		Id:                       bson.NewObjectId().Hex(),
+		CallbackCurrency:         "RUB",
+		CallbackProtocol:         "default",
+		LimitsCurrency:           "USD",
+		MaxPaymentAmount:         15000,
+		MinPaymentAmount:         1,
+		Name:                     map[string]string{"en": "test virtual currency project"},
+		IsProductsCheckout:       false,
+		AllowDynamicRedirectUrls: true,
+		SecretKey:                "test virtual currency project secret key",
+		Status:                   pkg.ProjectStatusInProduction,
+		MerchantId:               merchant.Id,
+		VirtualCurrency: &billing.ProjectVirtualCurrency{
+			Name:           map[string]string{"en": "Gold", "ru": "Золото"},
+			SuccessMessage: map[string]string{"en": "Gold purchased"},
+			Prices: []*billing.ProductPrice{
+				{Amount: 0.5, Currency: "USD", Region: "USD"},
+				{Amount: 30, Currency: "RUB", Region: "RUB"},
+			},
+			MinPurchaseValue: 10,
+			MaxPurchaseValue: 1000,
+			SellCountType:    pkg.ProjectSellCountTypeIntegral,
+		},
+	}
 	projectUahLimitCurrency := &billing.Project{
 		Id:                 bson.NewObjectId().Hex(),
 		CallbackCurrency:   "RUB",
@@ -832,6 +859,7 @@ func (suite *OrderTestSuite) SetupTest() {
 		projectEmptyPaymentMethodTerminal,
 		projectUahLimitCurrency,
 		projectWithKeyProducts,
+		projectWithVirtualCurrency,
 	}
 
 	ps4 := &billing.PaymentSystem{
@@ -1483,6 +1511,7 @@ func (suite *OrderTestSuite) SetupTest() {
 	suite.projectFixedAmount = projectFixedAmount
 	suite.projectWithProducts = projectWithProducts
 	suite.projectWithKeyProducts = projectWithKeyProducts
+	suite.projectWithVirtualCurrency = projectWithVirtualCurrency
 	suite.inactiveProject = inactiveProject
 	suite.projectWithoutPaymentMethods = projectWithoutPaymentMethods
 	suite.projectIncorrectPaymentMethodId = projectIncorrectPaymentMethodId
@@ -2862,6 +2891,164 @@ func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_Ok() {
 	assert.Equal(suite.T(), pkg.OrderTypeOrder, rsp.Item.Type)
 }
 
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_VirtualCurrency_Ok() {
+	req := &billing.OrderCreateRequest{
+		Type:        billing.OrderTypeVirtualCurrency,
+		ProjectId:   suite.projectWithVirtualCurrency.Id,
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email:  "test@unit.unit",
+			Ip:     "127.0.0.1",
+			Locale: "ru",
+			Address: &billing.OrderBillingAddress{
+				Country: "RU",
+			},
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), billing.OrderTypeVirtualCurrency, rsp.Item.ProductType)
+	assert.Equal(suite.T(), float64(100), rsp.Item.VirtualCurrencyAmount)
+	assert.Equal(suite.T(), "RUB", rsp.Item.Currency)
+	assert.Equal(suite.T(), float64(3000), rsp.Item.OrderAmount)
+	assert.Len(suite.T(), rsp.Item.Items, 1)
+	assert.Equal(suite.T(), "Золото", rsp.Item.Items[0].Name)
+	assert.Equal(suite.T(), float64(3000), rsp.Item.Items[0].Amount)
+	assert.Equal(suite.T(), "RUB", rsp.Item.Items[0].Currency)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_VirtualCurrency_FractionalAmount_Error() {
+	req := &billing.OrderCreateRequest{
+		Type:      billing.OrderTypeVirtualCurrency,
+		ProjectId: suite.projectWithVirtualCurrency.Id,
+		Amount:    100.5,
+		User: &billing.OrderUser{
+			Email:   "test@unit.unit",
+			Ip:      "127.0.0.1",
+			Address: &billing.OrderBillingAddress{Country: "US"},
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorVirtualCurrencyFracNotSupported, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_VirtualCurrency_Limits_Error() {
+	req := &billing.OrderCreateRequest{
+		Type:      billing.OrderTypeVirtualCurrency,
+		ProjectId: suite.projectWithVirtualCurrency.Id,
+		Amount:    5,
+		User: &billing.OrderUser{
+			Email:   "test@unit.unit",
+			Ip:      "127.0.0.1",
+			Address: &billing.OrderBillingAddress{Country: "US"},
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorVirtualCurrencyLimits, rsp.Message)
+
+	req.Amount = 1001
+	rsp = &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorVirtualCurrencyLimits, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_VirtualCurrency_NotFilled_Error() {
+	req := &billing.OrderCreateRequest{
+		Type:      billing.OrderTypeVirtualCurrency,
+		ProjectId: suite.project.Id,
+		Amount:    100,
+		User: &billing.OrderUser{
+			Email:   "test@unit.unit",
+			Ip:      "127.0.0.1",
+			Address: &billing.OrderBillingAddress{Country: "US"},
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorVirtualCurrencyNotFilled, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessOrderVirtualCurrency_CountryChanged_Ok() {
+	req := &billing.OrderCreateRequest{
+		Type:      billing.OrderTypeVirtualCurrency,
+		ProjectId: suite.projectWithVirtualCurrency.Id,
+		Amount:    100,
+		User: &billing.OrderUser{
+			Email:   "test@unit.unit",
+			Ip:      "127.0.0.1",
+			Address: &billing.OrderBillingAddress{Country: "RU"},
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order := rsp.Item
+	order.BillingAddress = &billing.OrderBillingAddress{Country: "US"}
+
+	err = suite.service.ProcessOrderVirtualCurrency(order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "USD", order.Currency)
+	assert.Equal(suite.T(), float64(50), order.OrderAmount)
+	assert.Equal(suite.T(), float64(50), order.TotalPaymentAmount)
+	assert.Len(suite.T(), order.Items, 1)
+	assert.Equal(suite.T(), "Gold", order.Items[0].Name)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessOrderVirtualCurrency_WithoutUser_Ok() {
+	req := &billing.OrderCreateRequest{
+		Type:      billing.OrderTypeVirtualCurrency,
+		ProjectId: suite.projectWithVirtualCurrency.Id,
+		Amount:    100,
+		User: &billing.OrderUser{
+			Email:   "test@unit.unit",
+			Ip:      "127.0.0.1",
+			Address: &billing.OrderBillingAddress{Country: "RU"},
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order := rsp.Item
+	order.User = nil
+	order.BillingAddress = nil
+
+	err = suite.service.ProcessOrderVirtualCurrency(order)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), order.Currency)
+	assert.True(suite.T(), order.OrderAmount > 0)
+	assert.Len(suite.T(), order.Items, 1)
+}
+
 func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_ProjectInactive_Error() {
 	req := &billing.OrderCreateRequest{
 		Type:          billing.OrderType_simple,
@@ -4295,192 +4482,479 @@ func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CreatePaymentSystemH
 	assert.Equal(suite.T(), paymentSystemErrorHandlerNotFound.Error(), rsp.Message.Message)
 }
 
-func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_FormInputTimeExpired_Error() {
-	req1 := &billing.OrderCreateRequest{
-		Type:        billing.OrderType_simple,
-		ProjectId:   suite.project.Id,
-		Currency:    "RUB",
-		Amount:      100,
-		Account:     "unit test",
-		Description: "unit test",
-		OrderId:     bson.NewObjectId().Hex(),
-		User: &billing.OrderUser{
-			Email: "test@unit.unit",
-			Ip:    "127.0.0.1",
-		},
-	}
-
-	rsp := &grpc.OrderCreateProcessResponse{}
-	err := suite.service.OrderCreateProcess(context.TODO(), req1, rsp)
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_PaymentRouteFailover_Ok() {
+	psErr := suite.insertPaymentSystem(paymentSystemHandlerMockCreateError)
+	suite.setPaymentMethodRoutes(
+		&billing.PaymentMethodRoute{PaymentSystemId: suite.paymentMethod.PaymentSystemId, Priority: 1},
+		&billing.PaymentMethodRoute{PaymentSystemId: psErr.Id, Priority: 0},
+	)
 
-	assert.Nil(suite.T(), err)
-	assert.Equal(suite.T(), rsp.Status, pkg.ResponseStatusOk)
-	rsp1 := rsp.Item
+	order := suite.createOrderForPaymentRoute()
+	rsp := &grpc.PaymentCreateResponse{}
+	err := suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
 
-	var order *billing.Order
-	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(rsp1.Id)).One(&order)
-	assert.NotNil(suite.T(), order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.NotEmpty(suite.T(), rsp.RedirectUrl)
+	assert.Nil(suite.T(), rsp.Message)
 
-	order.ExpireDateToFormInput, err = ptypes.TimestampProto(time.Now().Add(time.Minute * -40))
+	order1, err := suite.service.getOrderById(order.Id)
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.paymentMethod.PaymentSystemId, order1.PaymentMethod.PaymentSystemId)
+	assert.Len(suite.T(), order1.PaymentRouteAttempts, 2)
 
-	err = suite.service.updateOrder(order)
+	assert.Equal(suite.T(), psErr.Id, order1.PaymentRouteAttempts[0].PaymentSystemId)
+	assert.Equal(suite.T(), paymentSystemHandlerMockCreateError, order1.PaymentRouteAttempts[0].Handler)
+	assert.Equal(suite.T(), paymentRouteAttemptStatusFailed, order1.PaymentRouteAttempts[0].Status)
+	assert.Equal(suite.T(), paymentSystemErrorCreateRequestRejected.Error(), order1.PaymentRouteAttempts[0].Error)
 
-	expireYear := time.Now().AddDate(1, 0, 0)
+	assert.Equal(suite.T(), suite.paymentMethod.PaymentSystemId, order1.PaymentRouteAttempts[1].PaymentSystemId)
+	assert.Equal(suite.T(), paymentRouteAttemptStatusOk, order1.PaymentRouteAttempts[1].Status)
+	assert.Empty(suite.T(), order1.PaymentRouteAttempts[1].Error)
+}
 
-	req2 := &grpc.PaymentCreateRequest{
-		Data: map[string]string{
-			pkg.PaymentCreateFieldOrderId:         rsp1.Uuid,
-			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
-			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
-			pkg.PaymentCreateFieldPan:             "4000000000000002",
-			pkg.PaymentCreateFieldCvv:             "123",
-			pkg.PaymentCreateFieldMonth:           "02",
-			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
-			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
-		},
-	}
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_PaymentRouteAllFailed_Error() {
+	psErr1 := suite.insertPaymentSystem(paymentSystemHandlerMockCreateError)
+	psErr2 := suite.insertPaymentSystem(paymentSystemHandlerMockCreateError)
+	suite.setPaymentMethodRoutes(
+		&billing.PaymentMethodRoute{PaymentSystemId: psErr1.Id, Priority: 0},
+		&billing.PaymentMethodRoute{PaymentSystemId: psErr2.Id, Priority: 1},
+	)
+
+	order := suite.createOrderForPaymentRoute()
+	rsp := &grpc.PaymentCreateResponse{}
+	err := suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
 
-	rsp2 := &grpc.PaymentCreateResponse{}
-	err = suite.service.PaymentCreateProcess(context.TODO(), req2, rsp2)
 	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp2.Status)
-	assert.Equal(suite.T(), orderErrorFormInputTimeExpired, rsp2.Message)
-}
+	assert.Equal(suite.T(), pkg.ResponseStatusSystemError, rsp.Status)
+	assert.Empty(suite.T(), rsp.RedirectUrl)
+	assert.Equal(suite.T(), paymentSystemErrorCreateRequestRejected, rsp.Message)
 
-func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Ok() {
-	req := &billing.OrderCreateRequest{
-		ProjectId:   suite.projectWithProducts.Id,
-		Currency:    "RUB",
-		Account:     "unit test",
-		Description: "unit test",
-		OrderId:     bson.NewObjectId().Hex(),
-		Products:    suite.productIds,
-		User: &billing.OrderUser{
-			Email: "test@unit.unit",
-			Ip:    "127.0.0.1",
-		},
-		Type: billing.OrderType_product,
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), order1.PaymentRouteAttempts, 2)
+	assert.Equal(suite.T(), psErr1.Id, order1.PaymentRouteAttempts[0].PaymentSystemId)
+	assert.Equal(suite.T(), psErr2.Id, order1.PaymentRouteAttempts[1].PaymentSystemId)
+
+	for _, v := range order1.PaymentRouteAttempts {
+		assert.Equal(suite.T(), paymentRouteAttemptStatusFailed, v.Status)
 	}
+}
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_PaymentRouteAllFailed_ReservationsReleased() {
+	token := suite.helperCreatePaylinkToken(1, 0)
+	psErr := suite.insertPaymentSystem(paymentSystemHandlerMockCreateError)
+	suite.setPaymentMethodRoutes(&billing.PaymentMethodRoute{PaymentSystemId: psErr.Id, Priority: 0})
 
-	rsp1 := &grpc.OrderCreateProcessResponse{}
-	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp1)
+	order := suite.createOrderForPaymentRoute()
+	order.PrivateMetadata = map[string]string{orderPrivateMetadataPaylinkTokenId: token.Item.Id}
+	err := suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
 
-	assert.Nil(suite.T(), err)
-	assert.Equal(suite.T(), rsp1.Status, pkg.ResponseStatusOk)
-	order := rsp1.Item
+	rsp := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusSystemError, rsp.Status)
 
-	expireYear := time.Now().AddDate(1, 0, 0)
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paylinkTokenPurchaseReleased, order1.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase])
 
-	createPaymentRequest := &grpc.PaymentCreateRequest{
-		Data: map[string]string{
-			pkg.PaymentCreateFieldOrderId:         order.Uuid,
-			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
-			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
-			pkg.PaymentCreateFieldPan:             "4000000000000002",
-			pkg.PaymentCreateFieldCvv:             "123",
-			pkg.PaymentCreateFieldMonth:           "02",
-			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
-			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+	var stored map[string]interface{}
+	err = suite.service.db.Collection(collectionPaylinkTokens).FindId(bson.ObjectIdHex(token.Item.Id)).One(&stored)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 0, stored["purchases_reserved"])
+}
+
+func (suite *OrderTestSuite) TestOrder_IsPaymentRouteFailoverError() {
+	assert.True(suite.T(), isPaymentRouteFailoverError(paymentSystemErrorRequestNotSent))
+	assert.True(suite.T(), isPaymentRouteFailoverError(paymentSystemErrorCreateRequestRejected))
+	assert.True(suite.T(), isPaymentRouteFailoverError(paymentSystemErrorAuthenticateFailed))
+
+	// payment can be already created by payment system after these errors
+	assert.False(suite.T(), isPaymentRouteFailoverError(paymentSystemErrorCreateRequestFailed))
+	assert.False(suite.T(), isPaymentRouteFailoverError(errors.New("read tcp: i/o timeout")))
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_PaymentRouteRules_Ok() {
+	psErr := suite.insertPaymentSystem(paymentSystemHandlerMockCreateError)
+	suite.setPaymentMethodRoutes(
+		&billing.PaymentMethodRoute{PaymentSystemId: psErr.Id, Priority: 0, Countries: []string{"DE"}},
+		&billing.PaymentMethodRoute{PaymentSystemId: psErr.Id, Priority: 0, Currencies: []string{"USD"}},
+		&billing.PaymentMethodRoute{PaymentSystemId: psErr.Id, Priority: 0, MaxAmount: 50},
+		&billing.PaymentMethodRoute{
+			PaymentSystemId: suite.paymentMethod.PaymentSystemId,
+			Priority:        1,
+			Currencies:      []string{"RUB"},
+			MinAmount:       50,
+			ProductionSettings: map[string]*billing.PaymentMethodParams{
+				"RUB": {
+					Currency:       "RUB",
+					TerminalId:     "route_terminal",
+					Secret:         "route_secret",
+					SecretCallback: "route_secret_callback",
+				},
+			},
 		},
-		Ip: "127.0.0.1",
-	}
+	)
 
+	order := suite.createOrderForPaymentRoute()
 	rsp := &grpc.PaymentCreateResponse{}
-	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp)
+	err := suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
 
-	assert.Nil(suite.T(), err)
+	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
 
-	var order1 *billing.Order
-	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
-	suite.NotNil(suite.T(), order1)
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), order1.PaymentRouteAttempts, 1)
+	assert.Equal(suite.T(), paymentRouteAttemptStatusOk, order1.PaymentRouteAttempts[0].Status)
+	assert.Equal(suite.T(), int32(1), order1.PaymentRouteAttempts[0].Priority)
+	assert.Equal(suite.T(), "route_terminal", order1.PaymentMethod.Params.TerminalId)
+	assert.Equal(suite.T(), "route_secret_callback", order1.PaymentMethod.Params.SecretCallback)
+}
 
-	callbackRequest := &billing.CardPayPaymentCallback{
-		PaymentMethod: suite.paymentMethod.ExternalId,
-		CallbackTime:  time.Now().Format("2006-01-02T15:04:05Z"),
-		MerchantOrder: &billing.CardPayMerchantOrder{
-			Id:          order.Id,
-			Description: order.Description,
-			Items: []*billing.CardPayItem{
-				{
-					Name:        order.Items[0].Name,
-					Description: order.Items[0].Name,
-					Count:       1,
-					Price:       order.Items[0].Amount,
-				},
-			},
-		},
-		CardAccount: &billing.CallbackCardPayBankCardAccount{
-			Holder:             order.PaymentRequisites[pkg.PaymentCreateFieldHolder],
-			IssuingCountryCode: "RU",
-			MaskedPan:          order.PaymentRequisites[pkg.PaymentCreateFieldPan],
-			Token:              bson.NewObjectId().Hex(),
-		},
-		Customer: &billing.CardPayCustomer{
-			Email:  order.User.Email,
-			Ip:     order.User.Ip,
-			Id:     order.ProjectAccount,
-			Locale: "Europe/Moscow",
-		},
-		PaymentData: &billing.CallbackCardPayPaymentData{
-			Id:          bson.NewObjectId().Hex(),
-			Amount:      order1.TotalPaymentAmount,
-			Currency:    order1.Currency,
-			Description: order.Description,
-			Is_3D:       true,
-			Rrn:         bson.NewObjectId().Hex(),
-			Status:      pkg.CardPayPaymentResponseStatusCompleted,
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_PaymentRouteNotFound_Error() {
+	suite.setPaymentMethodRoutes(
+		&billing.PaymentMethodRoute{PaymentSystemId: suite.paymentMethod.PaymentSystemId, Countries: []string{"DE"}},
+		&billing.PaymentMethodRoute{PaymentSystemId: bson.NewObjectId().Hex()},
+	)
+
+	order := suite.createOrderForPaymentRoute()
+	rsp := &grpc.PaymentCreateResponse{}
+	err := suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorPaymentRouteNotFound, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_getRecurringPaymentRoutes_Ok() {
+	ps1 := suite.insertPaymentSystem(paymentSystemHandlerMockOk)
+	ps2 := suite.insertPaymentSystem(paymentSystemHandlerMockOk)
+	routes := []*paymentRoute{{paymentSystem: ps1}, {paymentSystem: ps2}}
+
+	order := suite.createOrderForPaymentRoute()
+	order.PaymentMethod = &billing.PaymentMethodOrder{PaymentSystemId: ps2.Id}
+	recurringId := bson.NewObjectId().Hex()
+
+	err := suite.service.saveRecurringPaymentSystem(order, recurringId)
+	assert.NoError(suite.T(), err)
+
+	result, err := suite.service.getRecurringPaymentRoutes(routes, recurringId, suite.paymentMethod)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+	assert.Equal(suite.T(), ps2.Id, result[0].paymentSystem.Id)
+}
+
+func (suite *OrderTestSuite) TestOrder_getRecurringPaymentRoutes_CardSavedBeforeRouting_Ok() {
+	ps1 := suite.insertPaymentSystem(paymentSystemHandlerMockOk)
+	ps2, err := suite.service.paymentSystem.GetById(suite.paymentMethod.PaymentSystemId)
+	assert.NoError(suite.T(), err)
+	routes := []*paymentRoute{{paymentSystem: ps1}, {paymentSystem: ps2}}
+
+	result, err := suite.service.getRecurringPaymentRoutes(routes, bson.NewObjectId().Hex(), suite.paymentMethod)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+	assert.Equal(suite.T(), suite.paymentMethod.PaymentSystemId, result[0].paymentSystem.Id)
+}
+
+func (suite *OrderTestSuite) TestOrder_getRecurringPaymentRoutes_RouteNotAvailable_Error() {
+	ps1 := suite.insertPaymentSystem(paymentSystemHandlerMockOk)
+	ps2 := suite.insertPaymentSystem(paymentSystemHandlerMockOk)
+
+	order := suite.createOrderForPaymentRoute()
+	order.PaymentMethod = &billing.PaymentMethodOrder{PaymentSystemId: ps2.Id}
+	recurringId := bson.NewObjectId().Hex()
+
+	err := suite.service.saveRecurringPaymentSystem(order, recurringId)
+	assert.NoError(suite.T(), err)
+
+	result, err := suite.service.getRecurringPaymentRoutes([]*paymentRoute{{paymentSystem: ps1}}, recurringId, suite.paymentMethod)
+	assert.Equal(suite.T(), orderErrorRecurringPaymentRouteNotFound, err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *OrderTestSuite) createAuthorizedOrder(authorizedAt time.Time) *billing.Order {
+	order := suite.createOrderForPaymentRoute()
+	rsp := &grpc.PaymentCreateResponse{}
+	err := suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+
+	order.IsAuthorizationOnly = true
+	order.PrivateStatus = pkg.OrderStatusPaymentSystemAuthorized
+	order.AuthorizedAt, _ = ptypes.TimestampProto(authorizedAt)
+	order.Transaction = bson.NewObjectId().Hex()
+
+	err = suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	return order
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_Ok() {
+	order := suite.createAuthorizedOrder(time.Now())
+	req := &grpc.OrderAuthorizationRequest{OrderId: order.Uuid, MerchantId: suite.project.MerchantId}
+	rsp := &grpc.OrderAuthorizationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Nil(suite.T(), rsp.Message)
+	assert.Equal(suite.T(), order.Id, rsp.Item.Id)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemAuthorized, order1.PrivateStatus)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_MerchantMismatch_Error() {
+	order := suite.createAuthorizedOrder(time.Now())
+	req := &grpc.OrderAuthorizationRequest{OrderId: order.Uuid, MerchantId: bson.NewObjectId().Hex()}
+	rsp := &grpc.OrderAuthorizationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), orderErrorNotFound, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_NotAuthorized_Error() {
+	order := suite.createOrderForPaymentRoute()
+	req := &grpc.OrderAuthorizationRequest{OrderId: order.Uuid, MerchantId: suite.project.MerchantId}
+	rsp := &grpc.OrderAuthorizationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorNotAuthorized, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_PaymentSystemError() {
+	order := suite.createAuthorizedOrder(time.Now())
+	order.PaymentMethod.PaymentSystemId = suite.insertPaymentSystem(paymentSystemHandlerMockError).Id
+	err := suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.OrderAuthorizationRequest{OrderId: order.Uuid, MerchantId: suite.project.MerchantId}
+	rsp := &grpc.OrderAuthorizationResponse{}
+	err = suite.service.CaptureOrder(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), paymentSystemErrorCaptureFailed, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_VoidOrder_Ok() {
+	order := suite.createAuthorizedOrder(time.Now())
+	req := &grpc.OrderAuthorizationRequest{OrderId: order.Uuid, MerchantId: suite.project.MerchantId}
+	rsp := &grpc.OrderAuthorizationResponse{}
+	err := suite.service.VoidOrder(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemVoided, rsp.Item.PrivateStatus)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemVoided, order1.PrivateStatus)
+	assert.Equal(suite.T(), constant.OrderPublicStatusCanceled, order1.GetPublicStatus())
+	assert.NotNil(suite.T(), order1.CanceledAt)
+
+	rsp = &grpc.OrderAuthorizationResponse{}
+	err = suite.service.VoidOrder(context.TODO(), req, rsp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorNotAuthorized, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_VoidExpiredAuthorizations_Ok() {
+	period := time.Duration(suite.service.cfg.OrderAuthorizationVoidPeriod) * time.Second
+	expired := suite.createAuthorizedOrder(time.Now().Add(-period - time.Hour))
+	actual := suite.createAuthorizedOrder(time.Now())
+
+	err := suite.service.VoidExpiredAuthorizations()
+	assert.NoError(suite.T(), err)
+
+	order, err := suite.service.getOrderById(expired.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemVoided, order.PrivateStatus)
+
+	order, err = suite.service.getOrderById(actual.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemAuthorized, order.PrivateStatus)
+}
+
+func (suite *OrderTestSuite) TestOrder_SortPaymentRoutes_Ok() {
+	ps1 := &billing.PaymentSystem{Id: "ps1"}
+	ps2 := &billing.PaymentSystem{Id: "ps2"}
+	ps3 := &billing.PaymentSystem{Id: "ps3"}
+	ps4 := &billing.PaymentSystem{Id: "ps4"}
+
+	for i := 0; i < 20; i++ {
+		routes := sortPaymentRoutes([]*paymentRoute{
+			{paymentSystem: ps4, priority: 2},
+			{paymentSystem: ps3, priority: 1, weight: 0},
+			{paymentSystem: ps2, priority: 1, weight: 10},
+			{paymentSystem: ps1, priority: 0, weight: 1},
+		})
+
+		assert.Len(suite.T(), routes, 4)
+		assert.Equal(suite.T(), ps1.Id, routes[0].paymentSystem.Id)
+		assert.Equal(suite.T(), ps2.Id, routes[1].paymentSystem.Id)
+		assert.Equal(suite.T(), ps3.Id, routes[2].paymentSystem.Id)
+		assert.Equal(suite.T(), ps4.Id, routes[3].paymentSystem.Id)
+	}
+
+	counts := make(map[string]int)
+
+	for i := 0; i < 1000; i++ {
+		routes := sortPaymentRoutes([]*paymentRoute{
+			{paymentSystem: ps1, weight: 90},
+			{paymentSystem: ps2, weight: 10},
+		})
+		counts[routes[0].paymentSystem.Id]++
+	}
+
+	assert.True(suite.T(), counts[ps1.Id] > counts[ps2.Id])
+	assert.True(suite.T(), counts[ps2.Id] > 0)
+}
+
+func (suite *OrderTestSuite) insertPaymentSystem(handler string) *billing.PaymentSystem {
+	ps := &billing.PaymentSystem{
+		Id:                 bson.NewObjectId().Hex(),
+		Name:               handler,
+		AccountingCurrency: "RUB",
+		AccountingPeriod:   "every-day",
+		IsActive:           true,
+		Handler:            handler,
+	}
+
+	err := suite.service.paymentSystem.Insert(ps)
+	assert.NoError(suite.T(), err)
+
+	return ps
+}
+
+func (suite *OrderTestSuite) setPaymentMethodRoutes(routes ...*billing.PaymentMethodRoute) {
+	suite.paymentMethod.Routes = routes
+	err := suite.service.paymentMethod.Update(suite.paymentMethod)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderTestSuite) createOrderForPaymentRoute() *billing.Order {
+	req := &billing.OrderCreateRequest{
+		Type:        billing.OrderType_simple,
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
 		},
 	}
 
-	buf, err := json.Marshal(callbackRequest)
-	assert.Nil(suite.T(), err)
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
 
-	hash := sha512.New()
-	hash.Write([]byte(string(buf) + order1.PaymentMethod.Params.SecretCallback))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
 
-	callbackData := &grpc.PaymentNotifyRequest{
-		OrderId:   order.Id,
-		Request:   buf,
-		Signature: hex.EncodeToString(hash.Sum(nil)),
+	return rsp.Item
+}
+
+func (suite *OrderTestSuite) getPaymentRouteCreateRequest(order *billing.Order) *grpc.PaymentCreateRequest {
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	return &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+		Ip: "127.0.0.1",
 	}
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_FormInputTimeExpired_Error() {
+	req1 := &billing.OrderCreateRequest{
+		Type:        billing.OrderType_simple,
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req1, rsp)
 
-	callbackResponse := &grpc.PaymentNotifyResponse{}
-	err = suite.service.PaymentCallbackProcess(context.TODO(), callbackData, callbackResponse)
 	assert.Nil(suite.T(), err)
-	assert.Equal(suite.T(), pkg.StatusOK, callbackResponse.Status)
+	assert.Equal(suite.T(), rsp.Status, pkg.ResponseStatusOk)
+	rsp1 := rsp.Item
 
-	var order2 *billing.Order
-	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order2)
-	suite.NotNil(suite.T(), order2)
+	var order *billing.Order
+	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(rsp1.Id)).One(&order)
+	assert.NotNil(suite.T(), order)
 
-	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order2.PrivateStatus)
-	assert.Equal(suite.T(), callbackRequest.GetId(), order2.Transaction)
-	assert.NotNil(suite.T(), order2.PaymentMethod.Card)
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.Brand, "MASTERCARD")
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.Masked, "400000******0002")
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.First6, "400000")
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.Last4, "0002")
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.ExpiryMonth, "02")
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.ExpiryYear, expireYear.Format("2006"))
-	assert.Equal(suite.T(), order2.PaymentMethod.Card.Secure3D, true)
-	assert.NotEmpty(suite.T(), order2.PaymentMethod.Card.Fingerprint)
+	order.ExpireDateToFormInput, err = ptypes.TimestampProto(time.Now().Add(time.Minute * -40))
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.updateOrder(order)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	req2 := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp1.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp2 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp2.Status)
+	assert.Equal(suite.T(), orderErrorFormInputTimeExpired, rsp2.Message)
 }
 
-func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
+func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Ok() {
 	req := &billing.OrderCreateRequest{
 		ProjectId:   suite.projectWithProducts.Id,
 		Currency:    "RUB",
 		Account:     "unit test",
 		Description: "unit test",
 		OrderId:     bson.NewObjectId().Hex(),
+		Products:    suite.productIds,
 		User: &billing.OrderUser{
 			Email: "test@unit.unit",
 			Ip:    "127.0.0.1",
 		},
-		Products: suite.productIds,
-		Type:     billing.OrderType_product,
+		Type: billing.OrderType_product,
 	}
 
 	rsp1 := &grpc.OrderCreateProcessResponse{}
@@ -4502,7 +4976,6 @@ func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
 			pkg.PaymentCreateFieldMonth:           "02",
 			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
 			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
-			pkg.PaymentCreateFieldStoreData:       "1",
 		},
 		Ip: "127.0.0.1",
 	}
@@ -4544,7 +5017,7 @@ func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
 			Id:     order.ProjectAccount,
 			Locale: "Europe/Moscow",
 		},
-		RecurringData: &billing.CardPayCallbackRecurringData{
+		PaymentData: &billing.CallbackCardPayPaymentData{
 			Id:          bson.NewObjectId().Hex(),
 			Amount:      order1.TotalPaymentAmount,
 			Currency:    order1.Currency,
@@ -4552,9 +5025,6 @@ func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
 			Is_3D:       true,
 			Rrn:         bson.NewObjectId().Hex(),
 			Status:      pkg.CardPayPaymentResponseStatusCompleted,
-			Filing: &billing.CardPayCallbackRecurringDataFilling{
-				Id: bson.NewObjectId().Hex(),
-			},
 		},
 	}
 
@@ -4572,7 +5042,6 @@ func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
 
 	callbackResponse := &grpc.PaymentNotifyResponse{}
 	err = suite.service.PaymentCallbackProcess(context.TODO(), callbackData, callbackResponse)
-
 	assert.Nil(suite.T(), err)
 	assert.Equal(suite.T(), pkg.StatusOK, callbackResponse.Status)
 
@@ -4582,16 +5051,21 @@ func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
 
 	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order2.PrivateStatus)
 	assert.Equal(suite.T(), callbackRequest.GetId(), order2.Transaction)
-	assert.Equal(suite.T(), callbackRequest.GetAmount(), order2.TotalPaymentAmount)
-	assert.Equal(suite.T(), callbackRequest.GetCurrency(), order2.Currency)
+	assert.NotNil(suite.T(), order2.PaymentMethod.Card)
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.Brand, "MASTERCARD")
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.Masked, "400000******0002")
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.First6, "400000")
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.Last4, "0002")
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.ExpiryMonth, "02")
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.ExpiryYear, expireYear.Format("2006"))
+	assert.Equal(suite.T(), order2.PaymentMethod.Card.Secure3D, true)
+	assert.NotEmpty(suite.T(), order2.PaymentMethod.Card.Fingerprint)
 }
 
-func (suite *OrderTestSuite) TestOrder_PaymentFormLanguageChanged_Ok() {
+func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_Recurring_Ok() {
 	req := &billing.OrderCreateRequest{
-		Type:        billing.OrderType_simple,
-		ProjectId:   suite.project.Id,
+		ProjectId:   suite.projectWithProducts.Id,
 		Currency:    "RUB",
-		Amount:      100,
 		Account:     "unit test",
 		Description: "unit test",
 		OrderId:     bson.NewObjectId().Hex(),
@@ -4599,14 +5073,134 @@ func (suite *OrderTestSuite) TestOrder_PaymentFormLanguageChanged_Ok() {
 			Email: "test@unit.unit",
 			Ip:    "127.0.0.1",
 		},
+		Products: suite.productIds,
+		Type:     billing.OrderType_product,
 	}
 
-	rsp0 := &grpc.OrderCreateProcessResponse{}
-	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp0)
+	rsp1 := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp1)
 
 	assert.Nil(suite.T(), err)
-	assert.Equal(suite.T(), rsp0.Status, pkg.ResponseStatusOk)
-	rsp := rsp0.Item
+	assert.Equal(suite.T(), rsp1.Status, pkg.ResponseStatusOk)
+	order := rsp1.Item
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+			pkg.PaymentCreateFieldStoreData:       "1",
+		},
+		Ip: "127.0.0.1",
+	}
+
+	rsp := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	var order1 *billing.Order
+	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
+	suite.NotNil(suite.T(), order1)
+
+	callbackRequest := &billing.CardPayPaymentCallback{
+		PaymentMethod: suite.paymentMethod.ExternalId,
+		CallbackTime:  time.Now().Format("2006-01-02T15:04:05Z"),
+		MerchantOrder: &billing.CardPayMerchantOrder{
+			Id:          order.Id,
+			Description: order.Description,
+			Items: []*billing.CardPayItem{
+				{
+					Name:        order.Items[0].Name,
+					Description: order.Items[0].Name,
+					Count:       1,
+					Price:       order.Items[0].Amount,
+				},
+			},
+		},
+		CardAccount: &billing.CallbackCardPayBankCardAccount{
+			Holder:             order.PaymentRequisites[pkg.PaymentCreateFieldHolder],
+			IssuingCountryCode: "RU",
+			MaskedPan:          order.PaymentRequisites[pkg.PaymentCreateFieldPan],
+			Token:              bson.NewObjectId().Hex(),
+		},
+		Customer: &billing.CardPayCustomer{
+			Email:  order.User.Email,
+			Ip:     order.User.Ip,
+			Id:     order.ProjectAccount,
+			Locale: "Europe/Moscow",
+		},
+		RecurringData: &billing.CardPayCallbackRecurringData{
+			Id:          bson.NewObjectId().Hex(),
+			Amount:      order1.TotalPaymentAmount,
+			Currency:    order1.Currency,
+			Description: order.Description,
+			Is_3D:       true,
+			Rrn:         bson.NewObjectId().Hex(),
+			Status:      pkg.CardPayPaymentResponseStatusCompleted,
+			Filing: &billing.CardPayCallbackRecurringDataFilling{
+				Id: bson.NewObjectId().Hex(),
+			},
+		},
+	}
+
+	buf, err := json.Marshal(callbackRequest)
+	assert.Nil(suite.T(), err)
+
+	hash := sha512.New()
+	hash.Write([]byte(string(buf) + order1.PaymentMethod.Params.SecretCallback))
+
+	callbackData := &grpc.PaymentNotifyRequest{
+		OrderId:   order.Id,
+		Request:   buf,
+		Signature: hex.EncodeToString(hash.Sum(nil)),
+	}
+
+	callbackResponse := &grpc.PaymentNotifyResponse{}
+	err = suite.service.PaymentCallbackProcess(context.TODO(), callbackData, callbackResponse)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusOK, callbackResponse.Status)
+
+	var order2 *billing.Order
+	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order2)
+	suite.NotNil(suite.T(), order2)
+
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order2.PrivateStatus)
+	assert.Equal(suite.T(), callbackRequest.GetId(), order2.Transaction)
+	assert.Equal(suite.T(), callbackRequest.GetAmount(), order2.TotalPaymentAmount)
+	assert.Equal(suite.T(), callbackRequest.GetCurrency(), order2.Currency)
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentFormLanguageChanged_Ok() {
+	req := &billing.OrderCreateRequest{
+		Type:        billing.OrderType_simple,
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp0 := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), rsp0.Status, pkg.ResponseStatusOk)
+	rsp := rsp0.Item
 	assert.True(suite.T(), len(rsp.Id) > 0)
 
 	req1 := &grpc.PaymentFormUserChangeLangRequest{
@@ -5754,6 +6348,25 @@ func (suite *OrderTestSuite) TestOrder_IsOrderCanBePaying_HasEndedStatus_Error()
 	assert.Nil(suite.T(), rsp1.Item)
 }
 
+func (suite *OrderTestSuite) TestOrder_IsOrderCanBePaying_AuthorizationStatuses_Error() {
+	for _, status := range []int32{pkg.OrderStatusPaymentSystemAuthorized, pkg.OrderStatusPaymentSystemVoided} {
+		order := suite.createOrderForPaymentRoute()
+		order.PrivateStatus = status
+		err := suite.service.updateOrder(order)
+		assert.NoError(suite.T(), err)
+
+		req := &grpc.IsOrderCanBePayingRequest{
+			OrderId:   order.Uuid,
+			ProjectId: order.GetProjectId(),
+		}
+		rsp := &grpc.IsOrderCanBePayingResponse{}
+		err = suite.service.IsOrderCanBePaying(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status, status)
+		assert.Equal(suite.T(), orderErrorOrderAlreadyComplete, rsp.Message, status)
+	}
+}
+
 func (suite *OrderTestSuite) TestOrder_CreatePayment_ChangeCustomerData_Ok() {
 	req := &billing.OrderCreateRequest{
 		Type:      billing.OrderType_simple,
@@ -7300,6 +7913,293 @@ func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Fail_NotFound() {
 	assert.Nil(suite.T(), rsp.Item)
 }
 
+func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Token_Ok() {
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	fullAmount := rsp.Item.OrderAmount
+
+	token := suite.helperCreatePaylinkToken(1, 10)
+	req.Token = token.Token
+
+	rsp = &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), token.Item.Id, rsp.Item.PrivateMetadata[orderPrivateMetadataPaylinkTokenId])
+	assert.Equal(suite.T(), "token@unit.test", rsp.Item.User.Email)
+	assert.True(suite.T(), rsp.Item.OrderAmount < fullAmount)
+	assert.InDelta(suite.T(), fullAmount*0.9, rsp.Item.OrderAmount, 0.01)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Token_Fail_Required() {
+	suite.paylink1.TokenRequired = true
+	err := suite.service.paylinkService.Update(suite.paylink1)
+	assert.NoError(suite.T(), err)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorPaylinkTokenRequired, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Token_Fail_Invalid() {
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     "invalid_token",
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorPaylinkTokenInvalid, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Token_Fail_AnotherPaylink() {
+	token := suite.helperCreatePaylinkToken(0, 0)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink3.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     token.Token,
+	}
+	suite.paylink3.NoExpiryDate = true
+	err := suite.service.paylinkService.Update(suite.paylink3)
+	assert.NoError(suite.T(), err)
+
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorPaylinkTokenInvalid, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Token_Fail_Expired() {
+	expiresAt, _ := ptypes.TimestampProto(time.Now().Add(-1 * time.Hour))
+	signed, err := suite.service.signPaylinkToken(&paylink.PaylinkToken{
+		Id:        bson.NewObjectId().Hex(),
+		PaylinkId: suite.paylink1.Id,
+		ExpiresAt: expiresAt,
+	})
+	assert.NoError(suite.T(), err)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     signed,
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusGone, rsp.Status)
+	assert.Equal(suite.T(), errorPaylinkTokenExpired, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateByPaylink_Token_Fail_PurchasesLimitReached() {
+	token := suite.helperCreatePaylinkToken(1, 0)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     token.Token,
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	err = suite.service.db.Collection(collectionOrder).UpdateId(
+		bson.ObjectIdHex(rsp.Item.Id),
+		bson.M{"$set": bson.M{"status": constant.OrderPublicStatusProcessed}},
+	)
+	assert.NoError(suite.T(), err)
+
+	rsp = &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusGone, rsp.Status)
+	assert.Equal(suite.T(), errorPaylinkTokenPurchasesLimitReached, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *OrderTestSuite) TestOrder_ReserveOrderPaylinkTokenPurchase_LimitReached() {
+	token := suite.helperCreatePaylinkToken(1, 0)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     token.Token,
+	}
+	rsp1 := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateByPaylink(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+
+	rsp2 := &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp1.Item)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paylinkTokenPurchaseReserved, rsp1.Item.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase])
+
+	// repeated payment attempt of the same order doesn't reserve one more purchase
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp1.Item)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp2.Item)
+	assert.Equal(suite.T(), errorPaylinkTokenPurchasesLimitReached, err)
+
+	err = suite.service.updateOrder(rsp1.Item)
+	assert.NoError(suite.T(), err)
+
+	rsp1.Item.PrivateStatus = constant.OrderStatusPaymentSystemDeclined
+	err = suite.service.updateOrder(rsp1.Item)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paylinkTokenPurchaseReleased, rsp1.Item.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase])
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp2.Item)
+	assert.NoError(suite.T(), err)
+}
+func (suite *OrderTestSuite) TestOrder_ReserveOrderPaylinkTokenPurchase_RejectedRetry_LimitReached() {
+	token := suite.helperCreatePaylinkToken(1, 0)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     token.Token,
+	}
+	rsp1 := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateByPaylink(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+
+	rsp2 := &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp1.Item)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.updateOrder(rsp1.Item)
+	assert.NoError(suite.T(), err)
+
+	rsp1.Item.PrivateStatus = constant.OrderStatusPaymentSystemReject
+	err = suite.service.updateOrder(rsp1.Item)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paylinkTokenPurchaseReleased, rsp1.Item.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase])
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp2.Item)
+	assert.NoError(suite.T(), err)
+
+	// retry of rejected order counts against purchases limit again
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp1.Item)
+	assert.Equal(suite.T(), errorPaylinkTokenPurchasesLimitReached, err)
+	assert.Equal(suite.T(), paylinkTokenPurchaseReleased, rsp1.Item.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase])
+}
+
+func (suite *OrderTestSuite) TestOrder_ReleaseExpiredOrderReservations_Ok() {
+	token := suite.helperCreatePaylinkToken(1, 0)
+
+	req := &billing.OrderCreateByPaylink{
+		PaylinkId: suite.paylink1.Id,
+		PayerIp:   "127.0.0.1",
+		Token:     token.Token,
+	}
+	rsp1 := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateByPaylink(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+
+	rsp2 := &grpc.OrderCreateProcessResponse{}
+	err = suite.service.OrderCreateByPaylink(context.TODO(), req, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp1.Item)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.ReleaseExpiredOrderReservations()
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp2.Item)
+	assert.Equal(suite.T(), errorPaylinkTokenPurchasesLimitReached, err)
+
+	err = suite.service.db.Collection(collectionOrder).UpdateId(
+		bson.ObjectIdHex(rsp1.Item.Id),
+		bson.M{"$set": bson.M{"created_at": time.Now().Add(-time.Duration(suite.service.cfg.OrderReservationReleasePeriod+60) * time.Second)}},
+	)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.ReleaseExpiredOrderReservations()
+	assert.NoError(suite.T(), err)
+
+	order, err := suite.service.getOrderById(rsp1.Item.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paylinkTokenPurchaseReleased, order.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase])
+
+	err = suite.service.reserveOrderPaylinkTokenPurchase(rsp2.Item)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_PaylinkTokenMetadata_Removed() {
+	token := suite.helperCreatePaylinkToken(1, 10)
+
+	req := &billing.OrderCreateRequest{
+		Type:        billing.OrderType_simple,
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+		PrivateMetadata: map[string]string{orderPrivateMetadataPaylinkTokenId: token.Item.Id},
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Empty(suite.T(), rsp.Item.PrivateMetadata[orderPrivateMetadataPaylinkTokenId])
+	assert.Zero(suite.T(), rsp.Item.DiscountPercent)
+	assert.EqualValues(suite.T(), 100, rsp.Item.OrderAmount)
+}
+
+func (suite *OrderTestSuite) helperCreatePaylinkToken(maxPurchases int32, discount float64) *grpc.CreatePaylinkTokenResponse {
+	req := &paylink.CreatePaylinkTokenRequest{
+		PaylinkId:       suite.paylink1.Id,
+		MerchantId:      suite.paylink1.MerchantId,
+		ExpiresAt:       time.Now().Add(1 * time.Hour).Unix(),
+		MaxPurchases:    maxPurchases,
+		CustomerEmail:   "token@unit.test",
+		DiscountPercent: discount,
+	}
+	rsp := &grpc.CreatePaylinkTokenResponse{}
+	err := suite.service.CreatePaylinkToken(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp
+}
+
 func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CostsNotFound_Error() {
 	req := &billing.OrderCreateRequest{
 		Type:        billing.OrderType_simple,
@@ -7339,3 +8239,376 @@ func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CostsNotFound_Error(
 	assert.Empty(suite.T(), rsp.RedirectUrl)
 	assert.Equal(suite.T(), orderErrorCostsRatesNotFound, rsp.Message)
 }
+
+func (suite *OrderTestSuite) createSubscriptionPlan(trialDays int32) *billing.SubscriptionPlan {
+	req := &billing.SubscriptionPlan{
+		MerchantId:    suite.project.MerchantId,
+		ProjectId:     suite.project.Id,
+		Name:          map[string]string{"en": "Monthly subscription"},
+		Prices:        []*billing.ProductPrice{{Amount: 10, Currency: "USD", Region: "USD"}},
+		IntervalUnit:  pkg.SubscriptionIntervalMonth,
+		IntervalCount: 1,
+		TrialDays:     trialDays,
+		IsActive:      true,
+	}
+	rsp := &grpc.SubscriptionPlanResponse{}
+	err := suite.service.CreateOrUpdateSubscriptionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp.Item
+}
+
+func (suite *OrderTestSuite) createSubscriptionOrder() *billing.Order {
+	order := suite.createOrderForPaymentRoute()
+	rsp := &grpc.PaymentCreateResponse{}
+	err := suite.service.PaymentCreateProcess(context.TODO(), suite.getPaymentRouteCreateRequest(order), rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+
+	order.PrivateStatus = constant.OrderStatusPaymentSystemComplete
+	order.PaymentMethodTxnParams[pkg.PaymentCreateFieldPan] = "400000******0002"
+	order.PaymentRequisites["saved"] = "1"
+
+	err = suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	return order
+}
+
+func (suite *OrderTestSuite) createSubscription(trialDays int32) *billing.Subscription {
+	plan := suite.createSubscriptionPlan(trialDays)
+	order := suite.createSubscriptionOrder()
+
+	req := &grpc.CreateSubscriptionRequest{MerchantId: plan.MerchantId, PlanId: plan.Id, OrderId: order.Uuid}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp.Item
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateOrUpdateSubscriptionPlan_Ok() {
+	plan := suite.createSubscriptionPlan(0)
+	assert.True(suite.T(), bson.IsObjectIdHex(plan.Id))
+	assert.NotNil(suite.T(), plan.CreatedAt)
+
+	plan.Name["en"] = "Monthly subscription updated"
+	rsp := &grpc.SubscriptionPlanResponse{}
+	err := suite.service.CreateOrUpdateSubscriptionPlan(context.TODO(), plan, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rsp1 := &grpc.SubscriptionPlanResponse{}
+	req1 := &grpc.GetSubscriptionPlanRequest{PlanId: plan.Id, MerchantId: plan.MerchantId}
+	err = suite.service.GetSubscriptionPlan(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), "Monthly subscription updated", rsp1.Item.Name["en"])
+
+	rsp2 := &grpc.ListSubscriptionPlansResponse{}
+	req2 := &grpc.ListSubscriptionPlansRequest{MerchantId: plan.MerchantId, ProjectId: plan.ProjectId}
+	err = suite.service.ListSubscriptionPlans(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.True(suite.T(), rsp2.Count > 0)
+	assert.NotEmpty(suite.T(), rsp2.Items)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateOrUpdateSubscriptionPlan_DefaultPriceNotFound_Error() {
+	req := &billing.SubscriptionPlan{
+		MerchantId:    suite.project.MerchantId,
+		ProjectId:     suite.project.Id,
+		Name:          map[string]string{"en": "Monthly subscription"},
+		Prices:        []*billing.ProductPrice{{Amount: 650, Currency: "RUB", Region: "RUB"}},
+		IntervalUnit:  pkg.SubscriptionIntervalMonth,
+		IntervalCount: 1,
+	}
+	rsp := &grpc.SubscriptionPlanResponse{}
+	err := suite.service.CreateOrUpdateSubscriptionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorPlanPriceDefaultCurrency, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateOrUpdateSubscriptionPlan_IntervalInvalid_Error() {
+	req := &billing.SubscriptionPlan{
+		MerchantId:    suite.project.MerchantId,
+		ProjectId:     suite.project.Id,
+		Name:          map[string]string{"en": "Monthly subscription"},
+		Prices:        []*billing.ProductPrice{{Amount: 10, Currency: "USD", Region: "USD"}},
+		IntervalUnit:  "century",
+		IntervalCount: 1,
+	}
+	rsp := &grpc.SubscriptionPlanResponse{}
+	err := suite.service.CreateOrUpdateSubscriptionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorPlanIntervalInvalid, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateSubscription_Ok() {
+	subscription := suite.createSubscription(0)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, subscription.Status)
+	assert.Equal(suite.T(), "USD", subscription.Currency)
+	assert.Equal(suite.T(), float64(10), subscription.Amount)
+	assert.NotEmpty(suite.T(), subscription.SavedCardId)
+	assert.Equal(suite.T(), subscription.InitialOrderId, subscription.LastOrderId)
+
+	start, _ := ptypes.Timestamp(subscription.CurrentPeriodStartAt)
+	end, _ := ptypes.Timestamp(subscription.CurrentPeriodEndAt)
+	assert.Equal(suite.T(), start.AddDate(0, 1, 0).Unix(), end.Unix())
+	assert.Equal(suite.T(), subscription.CurrentPeriodEndAt.Seconds, subscription.NextBillingAt.Seconds)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateSubscription_Trial_Ok() {
+	subscription := suite.createSubscription(7)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusTrialing, subscription.Status)
+	assert.NotNil(suite.T(), subscription.TrialEndAt)
+	assert.Equal(suite.T(), subscription.TrialEndAt.Seconds, subscription.NextBillingAt.Seconds)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateSubscription_OrderNotProcessed_Error() {
+	plan := suite.createSubscriptionPlan(0)
+	order := suite.createOrderForPaymentRoute()
+
+	req := &grpc.CreateSubscriptionRequest{MerchantId: plan.MerchantId, PlanId: plan.Id, OrderId: order.Uuid}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorOrderNotProcessed, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateSubscription_SavedCardNotFound_Error() {
+	plan := suite.createSubscriptionPlan(0)
+	order := suite.createSubscriptionOrder()
+	order.PaymentRequisites["saved"] = "0"
+	err := suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.CreateSubscriptionRequest{MerchantId: plan.MerchantId, PlanId: plan.Id, OrderId: order.Uuid}
+	rsp := &grpc.SubscriptionResponse{}
+	err = suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorSavedCardNotFound, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CreateSubscription_MerchantMismatch_Error() {
+	plan := suite.createSubscriptionPlan(0)
+	order := suite.createSubscriptionOrder()
+
+	req := &grpc.CreateSubscriptionRequest{MerchantId: bson.NewObjectId().Hex(), PlanId: plan.Id, OrderId: order.Uuid}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorPlanNotFound, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_PauseResumeCancelSubscription_Ok() {
+	subscription := suite.createSubscription(0)
+	req := &grpc.SubscriptionRequest{SubscriptionId: subscription.Id, MerchantId: subscription.MerchantId}
+
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.PauseSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPaused, rsp.Item.Status)
+	assert.NotNil(suite.T(), rsp.Item.PausedAt)
+
+	rsp = &grpc.SubscriptionResponse{}
+	err = suite.service.PauseSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorStatusChangeNotAllowed, rsp.Message)
+
+	rsp = &grpc.SubscriptionResponse{}
+	err = suite.service.ResumeSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, rsp.Item.Status)
+	assert.Nil(suite.T(), rsp.Item.PausedAt)
+
+	req.Reason = "customer request"
+	rsp = &grpc.SubscriptionResponse{}
+	err = suite.service.CancelSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	subscription, err = suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusCanceled, subscription.Status)
+	assert.Equal(suite.T(), "customer request", subscription.CancelReason)
+	assert.NotNil(suite.T(), subscription.CanceledAt)
+
+	rsp = &grpc.SubscriptionResponse{}
+	err = suite.service.ResumeSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorStatusChangeNotAllowed, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_GetSubscription_NotFound_Error() {
+	req := &grpc.SubscriptionRequest{SubscriptionId: bson.NewObjectId().Hex(), MerchantId: suite.project.MerchantId}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.GetSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorNotFound, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessSubscriptionRenewals_Ok() {
+	subscription := suite.createSubscription(0)
+	subscription.NextBillingAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	err := suite.service.updateSubscription(subscription, "", "")
+	assert.NoError(suite.T(), err)
+
+	suite.service.rep = mocks.NewRepositoryServiceOwnCard(subscription.User.Id)
+	err = suite.service.ProcessSubscriptionRenewals()
+	suite.service.rep = mocks.NewRepositoryServiceOk()
+	assert.NoError(suite.T(), err)
+
+	subscription1, err := suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), subscription.InitialOrderId, subscription1.LastOrderId)
+	assert.EqualValues(suite.T(), 0, subscription1.FailedAttempts)
+	assert.True(suite.T(), subscription1.NextBillingAt.Seconds > time.Now().Unix())
+
+	order, err := suite.service.getOrderById(subscription1.LastOrderId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), subscription.Id, order.PrivateMetadata[subscriptionOrderMetadataKey])
+	assert.Equal(suite.T(), subscription.Amount, order.OrderAmount)
+	assert.Equal(suite.T(), subscription.Currency, order.Currency)
+
+	order.PrivateStatus = constant.OrderStatusPaymentSystemComplete
+	suite.service.processSubscriptionOrderPayment(order)
+
+	subscription2, err := suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, subscription2.Status)
+	assert.Equal(suite.T(), subscription.CurrentPeriodEndAt.Seconds, subscription2.CurrentPeriodStartAt.Seconds)
+	assert.Equal(suite.T(), subscription2.CurrentPeriodEndAt.Seconds, subscription2.NextBillingAt.Seconds)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessSubscriptionRenewals_PaymentFailed_Ok() {
+	subscription := suite.createSubscription(0)
+	subscription.NextBillingAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	err := suite.service.updateSubscription(subscription, "", "")
+	assert.NoError(suite.T(), err)
+
+	suite.service.rep = mocks.NewRepositoryServiceError()
+	err = suite.service.ProcessSubscriptionRenewals()
+	suite.service.rep = mocks.NewRepositoryServiceOk()
+	assert.NoError(suite.T(), err)
+
+	subscription1, err := suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPastDue, subscription1.Status)
+	assert.EqualValues(suite.T(), 1, subscription1.FailedAttempts)
+
+	retryAt := time.Now().Add(time.Duration(suite.service.cfg.SubscriptionDunningRetryInterval) * time.Second)
+	assert.InDelta(suite.T(), retryAt.Unix(), subscription1.NextBillingAt.Seconds, 60)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessSubscriptionOrderPayment_DunningCancel_Ok() {
+	subscription := suite.createSubscription(0)
+	subscription.Status = pkg.SubscriptionStatusPastDue
+	subscription.FailedAttempts = suite.service.cfg.SubscriptionDunningMaxAttempts - 1
+	subscription.LastOrderId = bson.NewObjectId().Hex()
+	err := suite.service.updateSubscription(subscription, "", "")
+	assert.NoError(suite.T(), err)
+
+	order := &billing.Order{
+		Id:              subscription.LastOrderId,
+		PrivateStatus:   constant.OrderStatusPaymentSystemDeclined,
+		PrivateMetadata: map[string]string{subscriptionOrderMetadataKey: subscription.Id},
+	}
+	suite.service.processSubscriptionOrderPayment(order)
+
+	subscription1, err := suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusCanceled, subscription1.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionCancelReasonPaymentFailed, subscription1.CancelReason)
+	assert.NotNil(suite.T(), subscription1.CanceledAt)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessSubscriptionRenewals_Locked_Ok() {
+	subscription := suite.createSubscription(0)
+	subscription.NextBillingAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	err := suite.service.updateSubscription(subscription, "", "")
+	assert.NoError(suite.T(), err)
+
+	lock, err := suite.service.acquireRedisLock(subscriptionRenewalLockKey, time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), lock)
+
+	suite.service.rep = mocks.NewRepositoryServiceOwnCard(subscription.User.Id)
+	err = suite.service.ProcessSubscriptionRenewals()
+	suite.service.rep = mocks.NewRepositoryServiceOk()
+	suite.service.releaseRedisLock(subscriptionRenewalLockKey, lock)
+	assert.NoError(suite.T(), err)
+
+	subscription1, err := suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), subscription.LastOrderId, subscription1.LastOrderId)
+	assert.Equal(suite.T(), subscription.NextBillingAt.Seconds, subscription1.NextBillingAt.Seconds)
+}
+
+func (suite *OrderTestSuite) TestOrder_ProcessSubscriptionRenewals_AlreadyClaimed_Ok() {
+	subscription := suite.createSubscription(0)
+	subscription.NextBillingAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	err := suite.service.updateSubscription(subscription, "", "")
+	assert.NoError(suite.T(), err)
+
+	renewal, err := suite.service.claimSubscriptionRenewal(subscription)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), renewal)
+
+	renewal1, err := suite.service.claimSubscriptionRenewal(subscription)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), renewal1)
+
+	suite.service.rep = mocks.NewRepositoryServiceOwnCard(subscription.User.Id)
+	err = suite.service.ProcessSubscriptionRenewals()
+	suite.service.rep = mocks.NewRepositoryServiceOk()
+	assert.NoError(suite.T(), err)
+
+	subscription1, err := suite.service.getSubscription(subscription.Id, subscription.MerchantId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), subscription.LastOrderId, subscription1.LastOrderId)
+	assert.EqualValues(suite.T(), 0, subscription1.FailedAttempts)
+}
+
+func (suite *OrderTestSuite) TestOrder_SubscriptionNotifyMerchant_WebhookDelivery_Ok() {
+	subscription := suite.createSubscription(0)
+
+	project, err := suite.service.project.GetById(subscription.ProjectId)
+	assert.NoError(suite.T(), err)
+	project.UrlProcessPayment = "http://localhost/subscription"
+	err = suite.service.project.Update(project)
+	assert.NoError(suite.T(), err)
+
+	subscription.Status = pkg.SubscriptionStatusPaused
+	err = suite.service.updateSubscription(subscription, pkg.SubscriptionEventPaused, "")
+	assert.NoError(suite.T(), err)
+
+	var deliveries []*billing.WebhookDelivery
+	query := bson.M{"project_id": bson.ObjectIdHex(subscription.ProjectId), "event": pkg.SubscriptionEventPaused}
+	err = suite.service.db.Collection(collectionWebhookDelivery).Find(query).All(&deliveries)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), deliveries, 1)
+	assert.Equal(suite.T(), project.UrlProcessPayment, deliveries[0].Url)
+	assert.Empty(suite.T(), deliveries[0].OrderId)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusPending, deliveries[0].Status)
+
+	msg := &billing.SubscriptionNotification{}
+	err = json.Unmarshal([]byte(deliveries[0].Payload), msg)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), subscription.Id, msg.Subscription.Id)
+}
@@ -4,10 +4,10 @@ import (
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/paylink"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"go.uber.org/zap"
 	"strings"
 	"time"
@@ -54,10 +54,15 @@ func newOrderView(svc *Service) OrderViewServiceInterface {
 	return s
 }
 
+func (s *Service) updateOrderView(ids []string) error {
+	return s.updateOrderViewInto(ids, collectionOrderView)
+}
+
+// updateOrderViewInto builds order view documents of orders with given ids and merges them into collection.
 // emulate update batching, because aggregarion pipeline, ended with $merge,
 // does not return any documents in result,
 // so, this query cannot be iterated with driver's BatchSize() and Next() methods
-func (s *Service) updateOrderView(ids []string) error {
+func (s *Service) updateOrderViewInto(ids []string, into string) error {
 	batchSize := s.cfg.OrderViewUpdateBatchSize
 	count := len(ids)
 	if count == 0 {
@@ -75,7 +80,7 @@ func (s *Service) updateOrderView(ids []string) error {
 
 	if count > 0 && count <= batchSize {
 		matchQuery := s.getUpdateOrderViewMatchQuery(ids)
-		return s.doUpdateOrderView(matchQuery)
+		return s.doUpdateOrderView(matchQuery, into)
 	}
 
 	var batches [][]string
@@ -86,7 +91,7 @@ func (s *Service) updateOrderView(ids []string) error {
 	batches = append(batches, ids)
 	for _, batchIds := range batches {
 		matchQuery := s.getUpdateOrderViewMatchQuery(batchIds)
-		err := s.doUpdateOrderView(matchQuery)
+		err := s.doUpdateOrderView(matchQuery, into)
 		if err != nil {
 			return err
 		}
@@ -116,7 +121,7 @@ func (s *Service) getUpdateOrderViewMatchQuery(ids []string) bson.M {
 	}
 }
 
-func (s *Service) doUpdateOrderView(match bson.M) error {
+func (s *Service) doUpdateOrderView(match bson.M, into string) error {
 	defer timeTrack(time.Now(), "updateOrderView")
 	orderViewQuery := []bson.M{
 		match,
@@ -3142,7 +3147,7 @@ func (s *Service) doUpdateOrderView(match bson.M) error {
 		},
 		{
 			"$merge": bson.M{
-				"into":        "order_view",
+				"into":        into,
 				"whenMatched": "replace",
 			},
 		},
@@ -3159,11 +3164,22 @@ func (s *Service) doUpdateOrderView(match bson.M) error {
 	return nil
 }
 
+// RebuildOrderView brings order view up to date by projecting all change events logged before the call
 func (s *Service) RebuildOrderView() error {
 
 	zap.L().Info("start rebuilding order view")
 
-	err := s.updateOrderView([]string{})
+	event := &orderViewEvent{}
+	err := s.db.Collection(collectionOrderViewEvent).Find(nil).Sort("-_id").One(event)
+	if err == mgo.ErrNotFound {
+		zap.L().Info("rebuilding order view finished, no change events")
+		return nil
+	}
+
+	if err == nil {
+		err = s.waitOrderViewProjection(event.Id)
+	}
+
 	if err != nil {
 		zap.L().Error("rebuilding order view failed with error", zap.Error(err))
 		return err
@@ -3257,6 +3273,7 @@ func (ow *OrderView) getRoyaltySummaryGroupingQuery(isTotal bool) []bson.M {
 				"net_revenue_total":            bson.M{"$sum": "$net_revenue"},
 				"refund_reverse_revenue_total": bson.M{"$sum": "$refund_reverse_revenue"},
 				"sales_count":                  bson.M{"$sum": bson.M{"$cond": list{bson.M{"$eq": list{"$status", "processed"}}, 1, 0}}},
+				"discount_amount":              bson.M{"$sum": "$discount_amount"},
 			},
 		},
 		{
@@ -3345,6 +3362,13 @@ func (ow *OrderView) GetRoyaltySummary(merchantId, currency string, from, to tim
 						bson.M{"$divide": list{"$items.amount", "$amount_before_vat"}},
 					},
 				},
+				"discount_rate": bson.M{
+					"$cond": list{
+						bson.M{"$eq": []string{"$items", ""}},
+						0,
+						bson.M{"$divide": list{bson.M{"$ifNull": list{"$items.discount", 0}}, "$items.amount"}},
+					},
+				},
 			},
 		},
 		{
@@ -3362,6 +3386,7 @@ func (ow *OrderView) GetRoyaltySummary(merchantId, currency string, from, to tim
 				"net_revenue":              bson.M{"$multiply": list{"$net_revenue", "$correction"}},
 				"refund_reverse_revenue":   bson.M{"$multiply": list{"$refund_reverse_revenue", "$correction"}},
 				"order_amount_without_vat": bson.M{"$multiply": list{"$order_amount_without_vat", "$correction"}},
+				"discount_amount":          bson.M{"$multiply": list{"$purchase_gross_revenue", "$correction", "$discount_rate"}},
 			},
 		},
 		{
@@ -3406,21 +3431,26 @@ func (ow *OrderView) GetRoyaltySummary(merchantId, currency string, from, to tim
 	}
 
 	for _, item := range items {
-		ow.royaltySummaryItemPrecise(item)
+		ow.royaltySummaryItemPrecise(item, currency)
 	}
 
-	ow.royaltySummaryItemPrecise(total)
+	ow.royaltySummaryItemPrecise(total, currency)
 
 	return
 }
 
-func (ow *OrderView) royaltySummaryItemPrecise(item *billing.RoyaltyReportProductSummaryItem) {
-	item.GrossSalesAmount = tools.ToPrecise(item.GrossSalesAmount)
-	item.GrossReturnsAmount = tools.ToPrecise(item.GrossReturnsAmount)
-	item.GrossTotalAmount = tools.ToPrecise(item.GrossTotalAmount)
-	item.TotalFees = tools.ToPrecise(item.TotalFees)
-	item.TotalVat = tools.ToPrecise(item.TotalVat)
-	item.PayoutAmount = tools.ToPrecise(item.PayoutAmount)
+func (ow *OrderView) royaltySummaryItemPrecise(item *billing.RoyaltyReportProductSummaryItem, currency string) {
+	item.GrossSalesAmount = money.Round(item.GrossSalesAmount, currency)
+	item.GrossReturnsAmount = money.Round(item.GrossReturnsAmount, currency)
+	// gross total is calculated from rounded amounts to match sales and returns shown in report
+	item.GrossTotalAmount = money.FromMinor(
+		money.ToMinor(item.GrossSalesAmount, currency)-money.ToMinor(item.GrossReturnsAmount, currency),
+		currency,
+	)
+	item.TotalFees = money.Round(item.TotalFees, currency)
+	item.TotalVat = money.Round(item.TotalVat, currency)
+	item.PayoutAmount = money.Round(item.PayoutAmount, currency)
+	item.DiscountAmount = money.Round(item.DiscountAmount, currency)
 }
 
 func (ow *OrderView) GetOrderBy(id, uuid, merchantId string, receiver interface{}) (interface{}, error) {
@@ -3644,7 +3674,7 @@ func (ow *OrderView) getPaylinkGroupStat(
 }
 
 func (ow *OrderView) paylinkStatItemPrecise(item *paylink.StatCommon) {
-	item.GrossSalesAmount = tools.ToPrecise(item.GrossSalesAmount)
-	item.GrossReturnsAmount = tools.ToPrecise(item.GrossReturnsAmount)
-	item.GrossTotalAmount = tools.ToPrecise(item.GrossTotalAmount)
+	item.GrossSalesAmount = money.Round(item.GrossSalesAmount, item.TransactionsCurrency)
+	item.GrossReturnsAmount = money.Round(item.GrossReturnsAmount, item.TransactionsCurrency)
+	item.GrossTotalAmount = money.Round(item.GrossTotalAmount, item.TransactionsCurrency)
 }
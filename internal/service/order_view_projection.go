@@ -0,0 +1,992 @@
+package service
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"reflect"
+	"time"
+)
+
+const (
+	collectionOrderViewEvent              = "order_view_event"
+	collectionOrderViewCheckpoint         = "order_view_checkpoint"
+	collectionOrderViewConsistencyCompare = "order_view_consistency"
+
+	orderViewEventSourceOrderStatus     = "order_status"
+	orderViewEventSourceAccountingEntry = "accounting_entry"
+	orderViewEventSourceLocalAmount     = "accounting_entry_local_amount"
+	orderViewEventSourceRefund          = "refund"
+
+	orderViewProjectionCheckpointId = "order_view_projection"
+	orderViewProjectionLockKey      = "billing:order_view_projection:lock"
+	orderViewProjectionWaitInterval = time.Second
+
+	// field of order view document with id of the last change event projected to document
+	orderViewFieldProjectedEventId = "projected_event_id"
+)
+
+var (
+	errorOrderViewProjectionTimeout = newBillingServerErrorMsg("ov000001", "order view projection is not finished in time")
+
+	// fields of order copied to order view as is
+	orderViewOrderFields = []string{
+		"uuid",
+		"pm_order_id",
+		"project_order_id",
+		"project",
+		"created_at",
+		"pm_order_close_date",
+		"total_payment_amount",
+		"currency",
+		"user",
+		"billing_address",
+		"payment_method",
+		"country_code",
+		"status",
+		"type",
+		"royalty_report_id",
+		"is_vat_deduction",
+		"issuer",
+		"items",
+	}
+
+	// amount fields of order view built from accounting entries of order, the same as full recompute of order view does
+	orderViewAmountFields = []*orderViewAmountField{
+		{
+			name:  "payment_gross_revenue_local",
+			value: orderViewEntryLocalAmount,
+			types: []string{"real_gross_revenue"},
+		},
+		{
+			name:  "payment_gross_revenue_origin",
+			value: orderViewEntryOriginalAmount,
+			types: []string{"real_gross_revenue"},
+		},
+		{
+			name:  "payment_gross_revenue",
+			value: orderViewEntryAmount,
+			types: []string{"real_gross_revenue"},
+		},
+		{
+			name:  "payment_tax_fee",
+			value: orderViewEntryAmount,
+			types: []string{"real_tax_fee"},
+		},
+		{
+			name:  "payment_tax_fee_local",
+			value: orderViewEntryLocalAmount,
+			types: []string{"real_tax_fee"},
+		},
+		{
+			name:  "payment_tax_fee_origin",
+			value: orderViewEntryOriginalAmount,
+			types: []string{"real_tax_fee"},
+		},
+		{
+			name:  "payment_tax_fee_current_exchange_fee",
+			value: orderViewEntryAmount,
+			types: []string{"central_bank_tax_fee"},
+		},
+		{
+			name:  "payment_gross_revenue_fx",
+			value: orderViewEntryAmount,
+			types: []string{"ps_gross_revenue_fx"},
+		},
+		{
+			name:  "payment_gross_revenue_fx_tax_fee",
+			value: orderViewEntryAmount,
+			types: []string{"ps_gross_revenue_fx_tax_fee"},
+		},
+		{
+			name:  "tax_fee",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_tax_fee_cost_value"},
+		},
+		{
+			name:  "tax_fee_currency_exchange_fee",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_tax_fee_central_bank_fx"},
+		},
+		{
+			name:  "method_fee_total",
+			value: orderViewEntryAmount,
+			types: []string{"ps_method_fee"},
+		},
+		{
+			name:  "method_fee_tariff",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_method_fee"},
+		},
+		{
+			name:  "paysuper_method_fee_tariff_self_cost",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_method_fee_cost_value"},
+		},
+		{
+			name:  "method_fixed_fee_tariff",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_method_fixed_fee"},
+		},
+		{
+			name:  "paysuper_method_fixed_fee_tariff_self_cost",
+			value: orderViewEntryAmount,
+			types: []string{"real_merchant_method_fixed_fee_cost_value"},
+		},
+		{
+			name:  "paysuper_fixed_fee",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_ps_fixed_fee"},
+		},
+		{
+			name:  "payment_refund_gross_revenue_local",
+			value: orderViewEntryLocalAmount,
+			types: []string{"real_refund"},
+		},
+		{
+			name:  "payment_refund_gross_revenue_origin",
+			value: orderViewEntryOriginalAmount,
+			types: []string{"real_refund"},
+		},
+		{
+			name:  "payment_refund_gross_revenue",
+			value: orderViewEntryAmount,
+			types: []string{"real_refund"},
+		},
+		{
+			name:  "payment_refund_tax_fee_local",
+			value: orderViewEntryLocalAmount,
+			types: []string{"real_refund_tax_fee"},
+		},
+		{
+			name:  "payment_refund_tax_fee_origin",
+			value: orderViewEntryOriginalAmount,
+			types: []string{"real_refund_tax_fee"},
+		},
+		{
+			name:  "payment_refund_tax_fee",
+			value: orderViewEntryAmount,
+			types: []string{"real_refund_tax_fee"},
+		},
+		{
+			name:  "payment_refund_fee_tariff",
+			value: orderViewEntryAmount,
+			types: []string{"real_refund_fee"},
+		},
+		{
+			name:  "method_refund_fixed_fee_tariff",
+			value: orderViewEntryAmount,
+			types: []string{"real_refund_fixed_fee"},
+		},
+		{
+			name:  "refund_gross_revenue",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_refund"},
+		},
+		{
+			name:  "method_refund_fee_tariff",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_refund_fee"},
+		},
+		{
+			name:  "paysuper_method_refund_fixed_fee_tariff_self_cost",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_refund_fixed_fee_cost_value"},
+		},
+		{
+			name:  "merchant_refund_fixed_fee_tariff",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_refund_fixed_fee"},
+		},
+		{
+			name:  "refund_tax_fee",
+			value: orderViewEntryAmount,
+			types: []string{"reverse_tax_fee"},
+		},
+		{
+			name:  "refund_tax_fee_currency_exchange_fee",
+			value: orderViewEntryAmount,
+			types: []string{"reverse_tax_fee_delta"},
+		},
+		{
+			name:  "paysuper_refund_tax_fee_currency_exchange_fee",
+			value: orderViewEntryAmount,
+			types: []string{"ps_reverse_tax_fee_delta"},
+		},
+		{
+			name:  "payment_tax_fee_total",
+			value: orderViewEntryAmount,
+			types: []string{"real_tax_fee", "central_bank_tax_fee"},
+		},
+		{
+			name:  "tax_fee_total",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_tax_fee_cost_value", "merchant_tax_fee_central_bank_fx"},
+		},
+		{
+			name:  "refund_tax_fee_total",
+			value: orderViewEntryAmount,
+			types: []string{"reverse_tax_fee", "reverse_tax_fee_delta"},
+		},
+		{
+			name:  "fees_total",
+			value: orderViewEntryAmount,
+			types: []string{"ps_method_fee", "merchant_ps_fixed_fee"},
+		},
+		{
+			name:  "fees_total_local",
+			value: orderViewEntryLocalAmountInCurrency,
+			types: []string{"ps_method_fee", "merchant_ps_fixed_fee"},
+		},
+		{
+			name:  "refund_fees_total",
+			value: orderViewEntryAmount,
+			types: []string{"merchant_refund_fee", "merchant_refund_fixed_fee"},
+		},
+		{
+			name:  "refund_fees_total_local",
+			value: orderViewEntryLocalAmountInCurrency,
+			types: []string{"merchant_refund_fee", "merchant_refund_fixed_fee"},
+		},
+		{
+			name:          "payment_gross_revenue_fx_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"ps_gross_revenue_fx"},
+			negativeTypes: []string{"ps_gross_revenue_fx_tax_fee"},
+		},
+		{
+			name:          "gross_revenue",
+			value:         orderViewEntryAmount,
+			types:         []string{"real_gross_revenue"},
+			negativeTypes: []string{"ps_gross_revenue_fx"},
+		},
+		{
+			name:          "paysuper_method_fee_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_method_fee"},
+			negativeTypes: []string{"merchant_method_fee_cost_value"},
+		},
+		{
+			name:          "paysuper_method_fixed_fee_tariff_fx_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_method_fixed_fee"},
+			negativeTypes: []string{"real_merchant_method_fixed_fee"},
+		},
+		{
+			name:          "paysuper_method_fixed_fee_tariff_total_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"real_merchant_method_fixed_fee"},
+			negativeTypes: []string{"real_merchant_method_fixed_fee_cost_value"},
+		},
+		{
+			name:          "paysuper_fixed_fee_fx_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_ps_fixed_fee"},
+			negativeTypes: []string{"real_merchant_ps_fixed_fee"},
+		},
+		{
+			name:          "refund_gross_revenue_fx",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_refund"},
+			negativeTypes: []string{"real_refund"},
+		},
+		{
+			name:          "paysuper_method_refund_fee_tariff_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_refund_fee"},
+			negativeTypes: []string{"real_refund_fee"},
+		},
+		{
+			name:          "paysuper_method_refund_fixed_fee_tariff_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_refund_fixed_fee"},
+			negativeTypes: []string{"real_refund_fixed_fee"},
+		},
+		{
+			name:          "net_revenue",
+			value:         orderViewEntryAmount,
+			types:         []string{"real_gross_revenue"},
+			negativeTypes: []string{"ps_gross_revenue_fx", "merchant_tax_fee_central_bank_fx", "merchant_tax_fee_cost_value", "ps_method_fee", "merchant_ps_fixed_fee"},
+		},
+		{
+			name:          "paysuper_method_total_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"ps_method_fee", "merchant_ps_fixed_fee"},
+			negativeTypes: []string{"merchant_method_fee_cost_value", "real_merchant_method_fixed_fee_cost_value"},
+		},
+		{
+			name:          "paysuper_total_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"ps_gross_revenue_fx", "ps_method_fee", "merchant_ps_fixed_fee"},
+			negativeTypes: []string{"central_bank_tax_fee", "ps_gross_revenue_fx_tax_fee", "merchant_method_fee_cost_value", "real_merchant_method_fixed_fee_cost_value"},
+		},
+		{
+			name:          "refund_reverse_revenue",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_refund", "merchant_refund_fee", "merchant_refund_fixed_fee", "reverse_tax_fee_delta"},
+			negativeTypes: []string{"reverse_tax_fee"},
+		},
+		{
+			name:          "paysuper_refund_total_profit",
+			value:         orderViewEntryAmount,
+			types:         []string{"merchant_refund_fee", "merchant_refund_fixed_fee", "ps_reverse_tax_fee_delta"},
+			negativeTypes: []string{"real_refund_fixed_fee", "real_refund_fee"},
+		},
+	}
+
+	orderViewProjectionLagGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "billing_order_view_projection_lag_seconds",
+			Help: "Age in seconds of the oldest change event not projected to order view yet",
+		},
+	)
+	orderViewProjectionEventsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "billing_order_view_projection_events_total",
+			Help: "Number of change events projected to order view by event source",
+		},
+		[]string{"source"},
+	)
+	orderViewProjectionOrdersCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "billing_order_view_projection_orders_total",
+			Help: "Number of order view documents updated by incremental projection",
+		},
+	)
+	orderViewConsistencyMismatchCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "billing_order_view_consistency_mismatches_total",
+			Help: "Number of order view documents differed from full recompute on consistency check",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		orderViewProjectionLagGauge,
+		orderViewProjectionEventsCounter,
+		orderViewProjectionOrdersCounter,
+		orderViewConsistencyMismatchCounter,
+	)
+}
+
+// orderViewEvent is a change of order which is applied to order view document of order.
+// Event holds order fields copied to order view and amounts added to amount fields of order view,
+// so projection of event doesn't need to recompute order view document.
+type orderViewEvent struct {
+	Id         bson.ObjectId           `bson:"_id"`
+	OrderId    string                  `bson:"order_id"`
+	Source     string                  `bson:"source"`
+	Order      bson.M                  `bson:"order,omitempty"`
+	Amounts    []*orderViewEventAmount `bson:"amounts,omitempty"`
+	PaylinkId  string                  `bson:"paylink_id,omitempty"`
+	MerchantId string                  `bson:"merchant_id,omitempty"`
+	CreatedAt  time.Time               `bson:"created_at"`
+}
+
+type orderViewEventAmount struct {
+	Field    string  `bson:"field"`
+	Amount   float64 `bson:"amount"`
+	Currency string  `bson:"currency"`
+}
+
+// orderViewAmountField is amount field of order view, amount of field is sum of amounts of accounting entries
+// of types, amounts of entries of negative types are subtracted
+type orderViewAmountField struct {
+	name          string
+	value         func(ae *billing.AccountingEntry) (float64, string)
+	types         []string
+	negativeTypes []string
+}
+
+// orderViewProjectionCheckpoint is a position in log of change events up to that events are projected
+type orderViewProjectionCheckpoint struct {
+	Id          string        `bson:"_id"`
+	LastEventId bson.ObjectId `bson:"last_event_id,omitempty"`
+	UpdatedAt   time.Time     `bson:"updated_at"`
+}
+
+// orderViewEntryAmount returns amount of entry rounded to minor units the same as it's stored in database
+func orderViewEntryAmount(ae *billing.AccountingEntry) (float64, string) {
+	return money.Round(ae.Amount, ae.Currency), ae.Currency
+}
+
+func orderViewEntryLocalAmount(ae *billing.AccountingEntry) (float64, string) {
+	return money.Round(ae.LocalAmount, ae.LocalCurrency), ae.LocalCurrency
+}
+
+func orderViewEntryOriginalAmount(ae *billing.AccountingEntry) (float64, string) {
+	return money.Round(ae.OriginalAmount, ae.OriginalCurrency), ae.OriginalCurrency
+}
+
+// orderViewEntryLocalAmountInCurrency returns local amount with currency of entry as full recompute does for local fees totals
+func orderViewEntryLocalAmountInCurrency(ae *billing.AccountingEntry) (float64, string) {
+	return money.Round(ae.LocalAmount, ae.LocalCurrency), ae.Currency
+}
+
+func newOrderViewEvent(source, orderId string) *orderViewEvent {
+	return &orderViewEvent{OrderId: orderId, Source: source}
+}
+
+// newOrderViewOrderEvent returns change event with fields of order as they are copied to order view by full recompute
+func newOrderViewOrderEvent(source string, order *billing.Order) (*orderViewEvent, error) {
+	raw, err := bson.Marshal(order)
+
+	if err != nil {
+		return nil, err
+	}
+
+	doc := bson.M{}
+
+	if err = bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	event := newOrderViewEvent(source, order.Id)
+	event.Order = bson.M{}
+
+	for _, field := range orderViewOrderFields {
+		if value, ok := doc[field]; ok {
+			event.Order[field] = value
+		}
+	}
+
+	if value, ok := doc["private_amount"]; ok {
+		event.Order["amount_before_vat"] = value
+	}
+
+	if project, ok := doc["project"].(bson.M); ok {
+		if value, ok := project["merchant_id"]; ok {
+			event.Order["merchant_id"] = value
+		}
+	}
+
+	if user, ok := doc["user"]; ok {
+		if user, ok := user.(bson.M); !ok {
+			event.Order["locale"] = ""
+		} else if locale, ok := user["locale"]; ok {
+			event.Order["locale"] = locale
+		}
+	}
+
+	if method, ok := event.Order["payment_method"].(bson.M); ok {
+		delete(method, "params")
+		delete(method, "payment_system_id")
+	}
+
+	if order.Issuer != nil && order.Issuer.ReferenceType == pkg.OrderIssuerReferenceTypePaylink && order.Issuer.Reference != "" {
+		event.PaylinkId = order.Issuer.Reference
+		event.MerchantId = order.GetMerchantId()
+	}
+
+	return event, nil
+}
+
+// addEntryAmount adds amounts of accounting entry multiplied by sign to amounts of event
+func (e *orderViewEvent) addEntryAmount(ae *billing.AccountingEntry, sign float64) {
+	for _, field := range orderViewAmountFields {
+		if !contains(field.types, ae.Type) && !contains(field.negativeTypes, ae.Type) {
+			continue
+		}
+
+		amount, currency := field.value(ae)
+
+		if contains(field.negativeTypes, ae.Type) {
+			amount = -amount
+		}
+
+		e.addAmount(field.name, amount*sign, currency)
+	}
+}
+
+func (e *orderViewEvent) addAmount(field string, amount float64, currency string) {
+	for _, item := range e.Amounts {
+		if item.Field == field {
+			item.Amount += amount
+			item.Currency = currency
+			return
+		}
+	}
+
+	e.Amounts = append(e.Amounts, &orderViewEventAmount{Field: field, Amount: amount, Currency: currency})
+}
+
+// addOrderViewOrderEvents writes change events with fields of orders to log processed by order view projection
+func (s *Service) addOrderViewOrderEvents(source string, orders ...*billing.Order) error {
+	events := make([]*orderViewEvent, 0, len(orders))
+
+	for _, order := range orders {
+		event, err := newOrderViewOrderEvent(source, order)
+
+		if err != nil {
+			zap.L().Error("Order view event creation failed", zap.Error(err), zap.String("order_id", order.Id))
+			return err
+		}
+
+		events = append(events, event)
+	}
+
+	return s.addOrderViewEvents(events...)
+}
+
+// addOrderViewEvents writes change events of orders to log processed by incremental order view projection.
+// Identifiers of events are assigned on write in order of events.
+func (s *Service) addOrderViewEvents(events ...*orderViewEvent) error {
+	var docs []interface{}
+
+	for _, event := range events {
+		if !bson.IsObjectIdHex(event.OrderId) {
+			continue
+		}
+
+		event.Id = bson.NewObjectId()
+		event.CreatedAt = time.Now()
+		docs = append(docs, event)
+	}
+
+	if len(docs) == 0 {
+		return nil
+	}
+
+	err := s.db.Collection(collectionOrderViewEvent).Insert(docs...)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderViewEvent),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, docs),
+		)
+	}
+
+	return err
+}
+
+// addRefundOrderViewEvents writes change events of refund order and original order of refund
+func (s *Service) addRefundOrderViewEvents(refund *billing.Refund) error {
+	ids := []string{refund.CreatedOrderId}
+
+	if refund.OriginalOrder != nil {
+		ids = append(ids, refund.OriginalOrder.Id)
+	}
+
+	var orders []*billing.Order
+
+	for _, id := range ids {
+		if !bson.IsObjectIdHex(id) {
+			continue
+		}
+
+		order, err := s.getOrderById(id)
+
+		if err != nil {
+			return err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return s.addOrderViewOrderEvents(orderViewEventSourceRefund, orders...)
+}
+
+// ProcessOrderViewProjection applies to order view documents change events logged since saved checkpoint.
+// Every document keeps id of the last event applied to it, so event projected before failure isn't applied
+// twice when processing resumes from checkpoint. Events are projected by single replica holding redis lock,
+// lock is prolonged before each batch of events.
+func (s *Service) ProcessOrderViewProjection() (int, error) {
+	ttl := time.Duration(s.cfg.OrderViewProjectionLockTtl) * time.Second
+	lock, err := s.acquireRedisLock(orderViewProjectionLockKey, ttl)
+
+	if err != nil || lock == "" {
+		return 0, err
+	}
+
+	defer s.releaseRedisLock(orderViewProjectionLockKey, lock)
+
+	// events written by different replicas at the same time can be inserted not in order of ids,
+	// so only events older than delay are processed to not move checkpoint over not inserted events
+	border := bson.NewObjectIdWithTime(time.Now().Add(-time.Duration(s.cfg.OrderViewProjectionDelay) * time.Second))
+	counter := 0
+
+	for i := 0; ; i++ {
+		if i > 0 {
+			ok, err := s.extendRedisLock(orderViewProjectionLockKey, lock, ttl)
+
+			if err != nil || !ok {
+				return counter, err
+			}
+		}
+
+		n, more, err := s.projectOrderViewEvents(border)
+		counter += n
+
+		if err != nil {
+			return counter, err
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	orderViewProjectionLagGauge.Set(0)
+
+	return counter, nil
+}
+
+// projectOrderViewEvents applies batch of change events logged after checkpoint and before border to order view
+// and moves checkpoint to the last applied event. Number of updated orders returned and whether more events left.
+func (s *Service) projectOrderViewEvents(border bson.ObjectId) (int, bool, error) {
+	checkpoint, err := s.getOrderViewProjectionCheckpoint()
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	idQuery := bson.M{"$lte": border}
+
+	if checkpoint.LastEventId != "" {
+		idQuery["$gt"] = checkpoint.LastEventId
+	}
+
+	query := bson.M{"_id": idQuery}
+
+	var events []*orderViewEvent
+	err = s.db.Collection(collectionOrderViewEvent).Find(query).Sort("_id").
+		Limit(s.cfg.OrderViewProjectionBatchSize).All(&events)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderViewEvent),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return 0, false, err
+	}
+
+	if len(events) == 0 {
+		return 0, false, nil
+	}
+
+	orderViewProjectionLagGauge.Set(time.Since(events[0].CreatedAt).Seconds())
+
+	orders := make(map[string]bool)
+	paylinks := make(map[string]string)
+
+	for _, event := range events {
+		if err = s.applyOrderViewEvent(event); err != nil {
+			return 0, false, err
+		}
+
+		orders[event.OrderId] = true
+
+		if event.PaylinkId != "" {
+			paylinks[event.PaylinkId] = event.MerchantId
+		}
+	}
+
+	checkpoint.LastEventId = events[len(events)-1].Id
+	checkpoint.UpdatedAt = time.Now()
+
+	if err = s.saveOrderViewProjectionCheckpoint(checkpoint); err != nil {
+		return 0, false, err
+	}
+
+	for _, event := range events {
+		orderViewProjectionEventsCounter.WithLabelValues(event.Source).Inc()
+	}
+
+	orderViewProjectionOrdersCounter.Add(float64(len(orders)))
+
+	// paylink stats are calculated by order view, so they are updated after orders of paylinks are projected
+	for paylinkId, merchantId := range paylinks {
+		if err = s.paylinkService.UpdatePaylinkTotalStat(paylinkId, merchantId); err != nil {
+			zap.L().Error("Paylink total stat update failed", zap.Error(err), zap.String("paylink_id", paylinkId))
+		}
+	}
+
+	return len(orders), len(events) >= s.cfg.OrderViewProjectionBatchSize, nil
+}
+
+// applyOrderViewEvent sets fields of order and increments amount fields of order view document by event.
+// Document is created by event with accounting entries of order only, the same as full recompute creates
+// documents of orders with accounting entries only.
+func (s *Service) applyOrderViewEvent(event *orderViewEvent) error {
+	set := bson.M{orderViewFieldProjectedEventId: event.Id}
+	inc := bson.M{}
+
+	for field, value := range event.Order {
+		set[field] = value
+	}
+
+	for _, amount := range event.Amounts {
+		inc[amount.Field+".amount"] = amount.Amount
+		set[amount.Field+".currency"] = amount.Currency
+
+		// order has accounting entries of either payment or refund, so payout currency is taken
+		// from net revenue of payment or from reverse revenue of refund
+		if amount.Field == "net_revenue" ||
+			(amount.Field == "refund_reverse_revenue" && set["merchant_payout_currency"] == nil) {
+			set["merchant_payout_currency"] = amount.Currency
+		}
+	}
+
+	update := bson.M{"$set": set}
+
+	if len(inc) > 0 {
+		update["$inc"] = inc
+	}
+
+	selector := bson.M{
+		"_id":                          bson.ObjectIdHex(event.OrderId),
+		orderViewFieldProjectedEventId: bson.M{"$not": bson.M{"$gte": event.Id}},
+	}
+
+	var err error
+
+	if len(event.Order) > 0 && len(event.Amounts) > 0 {
+		_, err = s.db.Collection(collectionOrderView).Upsert(selector, update)
+	} else {
+		err = s.db.Collection(collectionOrderView).Update(selector, update)
+	}
+
+	// not found or duplicated document means that order has no accounting entries yet
+	// or event is already applied to document
+	if err == nil || err == mgo.ErrNotFound || mgo.IsDup(err) {
+		return nil
+	}
+
+	zap.L().Error(
+		pkg.ErrorDatabaseQueryFailed,
+		zap.Error(err),
+		zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderView),
+		zap.Any(pkg.ErrorDatabaseFieldQuery, selector),
+		zap.Any(pkg.ErrorDatabaseFieldSet, update),
+	)
+
+	return err
+}
+
+// waitOrderViewProjection processes change events and waits until checkpoint of projection passes event with id,
+// events can be projected by any replica holding lock at the moment
+func (s *Service) waitOrderViewProjection(eventId bson.ObjectId) error {
+	timeout := time.Now().Add(time.Duration(s.cfg.OrderViewProjectionLockTtl+s.cfg.OrderViewProjectionDelay) * time.Second)
+
+	for {
+		if _, err := s.ProcessOrderViewProjection(); err != nil {
+			return err
+		}
+
+		checkpoint, err := s.getOrderViewProjectionCheckpoint()
+
+		if err != nil {
+			return err
+		}
+
+		if checkpoint.LastEventId >= eventId {
+			return nil
+		}
+
+		if time.Now().After(timeout) {
+			zap.L().Error("Order view projection waiting timeout", zap.String("event_id", eventId.Hex()))
+			return errorOrderViewProjectionTimeout
+		}
+
+		time.Sleep(orderViewProjectionWaitInterval)
+	}
+}
+
+// CheckOrderViewConsistency compares random sample of order view documents with documents built by full recompute.
+// Differed documents are rebuilt, ids of them are returned. Orders with change events not projected yet are skipped.
+func (s *Service) CheckOrderViewConsistency() ([]string, error) {
+	pipeline := []bson.M{
+		{"$sample": bson.M{"size": s.cfg.OrderViewConsistencySampleSize}},
+		{"$project": bson.M{"_id": 1}},
+	}
+
+	var sample []bson.M
+	err := s.db.Collection(collectionOrderView).Pipe(pipeline).All(&sample)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderView),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return nil, err
+	}
+
+	if len(sample) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(sample))
+	oids := make([]bson.ObjectId, 0, len(sample))
+
+	for _, item := range sample {
+		if id, ok := item["_id"].(bson.ObjectId); ok {
+			ids = append(ids, id.Hex())
+			oids = append(oids, id)
+		}
+	}
+
+	query := bson.M{"_id": bson.M{"$in": oids}}
+	defer s.db.Collection(collectionOrderViewConsistencyCompare).RemoveAll(query)
+
+	if err = s.updateOrderViewInto(ids, collectionOrderViewConsistencyCompare); err != nil {
+		return nil, err
+	}
+
+	actual, err := s.getOrderViewDocuments(collectionOrderView, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := s.getOrderViewDocuments(collectionOrderViewConsistencyCompare, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.getOrderViewPendingOrders(ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatched []string
+
+	for _, id := range ids {
+		if pending[id] || reflect.DeepEqual(actual[id], expected[id]) {
+			continue
+		}
+
+		mismatched = append(mismatched, id)
+	}
+
+	if len(mismatched) == 0 {
+		return nil, nil
+	}
+
+	orderViewConsistencyMismatchCounter.Add(float64(len(mismatched)))
+	zap.L().Warn(
+		"Order view documents differ from full recompute",
+		zap.Int("sample_size", len(ids)),
+		zap.Strings("order_ids", mismatched),
+	)
+
+	if err = s.updateOrderView(mismatched); err != nil {
+		return mismatched, err
+	}
+
+	return mismatched, nil
+}
+
+func (s *Service) getOrderViewDocuments(collection string, query bson.M) (map[string]bson.M, error) {
+	var docs []bson.M
+	err := s.db.Collection(collection).Find(query).All(&docs)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collection),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	result := make(map[string]bson.M, len(docs))
+
+	for _, doc := range docs {
+		// full recompute doesn't know about projected events, so mark of the last event is not compared
+		delete(doc, orderViewFieldProjectedEventId)
+
+		if id, ok := doc["_id"].(bson.ObjectId); ok {
+			result[id.Hex()] = doc
+		}
+	}
+
+	return result, nil
+}
+
+// getOrderViewPendingOrders returns orders from list which have change events after projection checkpoint
+func (s *Service) getOrderViewPendingOrders(ids []string) (map[string]bool, error) {
+	checkpoint, err := s.getOrderViewProjectionCheckpoint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := bson.M{"order_id": bson.M{"$in": ids}}
+
+	if checkpoint.LastEventId != "" {
+		query["_id"] = bson.M{"$gt": checkpoint.LastEventId}
+	}
+
+	var orderIds []string
+	err = s.db.Collection(collectionOrderViewEvent).Find(query).Distinct("order_id", &orderIds)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderViewEvent),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	pending := make(map[string]bool, len(orderIds))
+
+	for _, id := range orderIds {
+		pending[id] = true
+	}
+
+	return pending, nil
+}
+
+func (s *Service) getOrderViewProjectionCheckpoint() (*orderViewProjectionCheckpoint, error) {
+	checkpoint := &orderViewProjectionCheckpoint{}
+	err := s.db.Collection(collectionOrderViewCheckpoint).FindId(orderViewProjectionCheckpointId).One(checkpoint)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return &orderViewProjectionCheckpoint{Id: orderViewProjectionCheckpointId}, nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderViewCheckpoint),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, orderViewProjectionCheckpointId),
+		)
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+func (s *Service) saveOrderViewProjectionCheckpoint(checkpoint *orderViewProjectionCheckpoint) error {
+	_, err := s.db.Collection(collectionOrderViewCheckpoint).UpsertId(checkpoint.Id, checkpoint)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderViewCheckpoint),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationUpsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, checkpoint),
+		)
+	}
+
+	return err
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/paylink"
 	mongodb "github.com/paysuper/paysuper-database-mongo"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
 	"github.com/paysuper/paysuper-recurring-repository/tools"
 	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
 	"github.com/stretchr/testify/assert"
@@ -435,7 +436,7 @@ func (suite *OrderViewTestSuite) Test_OrderView_GetRoyaltySummary_Ok_SalesAndRef
 	assert.NoError(suite.T(), err)
 
 	for _, order := range orders {
-		refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount, false)
+		refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount)
 		assert.NotNil(suite.T(), refund)
 	}
 
@@ -550,7 +551,7 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 
 	count = 0
 	for count < maxRefunds {
-		refund := helperMakeRefund(suite.Suite, suite.service, orders[count], orders[count].TotalPaymentAmount, false)
+		refund := helperMakeRefund(suite.Suite, suite.service, orders[count], orders[count].TotalPaymentAmount)
 		assert.NotNil(suite.T(), refund)
 		count++
 	}
@@ -574,9 +575,9 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 	assert.Equal(suite.T(), res.Item.ReturnsCount, int32(maxRefunds))
 	assert.Equal(suite.T(), res.Item.Conversion, tools.ToPrecise(float64(maxOrders)/float64(maxVisits+maxOrders)))
 	assert.Equal(suite.T(), res.Item.TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), res.Item.GrossSalesAmount, float64(175.953538))
-	assert.Equal(suite.T(), res.Item.GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), res.Item.GrossTotalAmount, float64(130.574993))
+	assert.Equal(suite.T(), res.Item.GrossSalesAmount, float64(175.96))
+	assert.Equal(suite.T(), res.Item.GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), res.Item.GrossTotalAmount, float64(130.58))
 
 	// stat by country
 
@@ -590,26 +591,26 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 	assert.Equal(suite.T(), stat.Top[0].SalesCount, int32(2))
 	assert.Equal(suite.T(), stat.Top[0].ReturnsCount, int32(0))
 	assert.Equal(suite.T(), stat.Top[0].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(86.976))
+	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(86.98))
 	assert.Equal(suite.T(), stat.Top[0].GrossReturnsAmount, float64(0))
-	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(86.976))
+	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(86.98))
 
 	assert.Equal(suite.T(), stat.Top[1].CountryCode, "RU")
 	assert.Equal(suite.T(), stat.Top[1].TotalTransactions, int32(3))
 	assert.Equal(suite.T(), stat.Top[1].SalesCount, int32(2))
 	assert.Equal(suite.T(), stat.Top[1].ReturnsCount, int32(1))
 	assert.Equal(suite.T(), stat.Top[1].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[1].GrossSalesAmount, float64(88.977538))
-	assert.Equal(suite.T(), stat.Top[1].GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Top[1].GrossTotalAmount, float64(43.598993))
+	assert.Equal(suite.T(), stat.Top[1].GrossSalesAmount, float64(88.98))
+	assert.Equal(suite.T(), stat.Top[1].GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Top[1].GrossTotalAmount, float64(43.6))
 
 	assert.Equal(suite.T(), stat.Total.TotalTransactions, int32(maxOrders+maxRefunds))
 	assert.Equal(suite.T(), stat.Total.SalesCount, int32(maxOrders))
 	assert.Equal(suite.T(), stat.Total.ReturnsCount, int32(maxRefunds))
 	assert.Equal(suite.T(), stat.Total.TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.953538))
-	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.574993))
+	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.96))
+	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.58))
 
 	// stat by referrer
 
@@ -623,26 +624,26 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 	assert.Equal(suite.T(), stat.Top[0].SalesCount, int32(2))
 	assert.Equal(suite.T(), stat.Top[0].ReturnsCount, int32(0))
 	assert.Equal(suite.T(), stat.Top[0].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(86.976))
+	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(86.98))
 	assert.Equal(suite.T(), stat.Top[0].GrossReturnsAmount, float64(0))
-	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(86.976))
+	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(86.98))
 
 	assert.Equal(suite.T(), stat.Top[1].ReferrerHost, "steam.com")
 	assert.Equal(suite.T(), stat.Top[1].TotalTransactions, int32(3))
 	assert.Equal(suite.T(), stat.Top[1].SalesCount, int32(2))
 	assert.Equal(suite.T(), stat.Top[1].ReturnsCount, int32(1))
 	assert.Equal(suite.T(), stat.Top[1].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[1].GrossSalesAmount, float64(88.977538))
-	assert.Equal(suite.T(), stat.Top[1].GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Top[1].GrossTotalAmount, float64(43.598993))
+	assert.Equal(suite.T(), stat.Top[1].GrossSalesAmount, float64(88.98))
+	assert.Equal(suite.T(), stat.Top[1].GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Top[1].GrossTotalAmount, float64(43.6))
 
 	assert.Equal(suite.T(), stat.Total.TotalTransactions, int32(maxOrders+maxRefunds))
 	assert.Equal(suite.T(), stat.Total.SalesCount, int32(maxOrders))
 	assert.Equal(suite.T(), stat.Total.ReturnsCount, int32(maxRefunds))
 	assert.Equal(suite.T(), stat.Total.TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.953538))
-	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.574993))
+	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.96))
+	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.58))
 
 	// stat by date
 
@@ -656,17 +657,17 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 	assert.Equal(suite.T(), stat.Top[0].SalesCount, int32(maxOrders))
 	assert.Equal(suite.T(), stat.Top[0].ReturnsCount, int32(maxRefunds))
 	assert.Equal(suite.T(), stat.Top[0].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(175.953538))
-	assert.Equal(suite.T(), stat.Top[0].GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(130.574993))
+	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(175.96))
+	assert.Equal(suite.T(), stat.Top[0].GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(130.58))
 
 	assert.Equal(suite.T(), stat.Total.TotalTransactions, int32(maxOrders+maxRefunds))
 	assert.Equal(suite.T(), stat.Total.SalesCount, int32(maxOrders))
 	assert.Equal(suite.T(), stat.Total.ReturnsCount, int32(maxRefunds))
 	assert.Equal(suite.T(), stat.Total.TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.953538))
-	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.574993))
+	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.96))
+	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.58))
 
 	// stat by utm
 
@@ -683,9 +684,9 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 	assert.Equal(suite.T(), stat.Top[0].SalesCount, int32(2))
 	assert.Equal(suite.T(), stat.Top[0].ReturnsCount, int32(0))
 	assert.Equal(suite.T(), stat.Top[0].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(86.976))
+	assert.Equal(suite.T(), stat.Top[0].GrossSalesAmount, float64(86.98))
 	assert.Equal(suite.T(), stat.Top[0].GrossReturnsAmount, float64(0))
-	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(86.976))
+	assert.Equal(suite.T(), stat.Top[0].GrossTotalAmount, float64(86.98))
 
 	assert.NotNil(suite.T(), stat.Top[1].Utm)
 	assert.Equal(suite.T(), stat.Top[1].Utm.UtmSource, "yandex")
@@ -695,15 +696,228 @@ func (suite *OrderViewTestSuite) Test_OrderView_PaylinkStat() {
 	assert.Equal(suite.T(), stat.Top[1].SalesCount, int32(2))
 	assert.Equal(suite.T(), stat.Top[1].ReturnsCount, int32(1))
 	assert.Equal(suite.T(), stat.Top[1].TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Top[1].GrossSalesAmount, float64(88.977538))
-	assert.Equal(suite.T(), stat.Top[1].GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Top[1].GrossTotalAmount, float64(43.598993))
+	assert.Equal(suite.T(), stat.Top[1].GrossSalesAmount, float64(88.98))
+	assert.Equal(suite.T(), stat.Top[1].GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Top[1].GrossTotalAmount, float64(43.6))
 
 	assert.Equal(suite.T(), stat.Total.TotalTransactions, int32(maxOrders+maxRefunds))
 	assert.Equal(suite.T(), stat.Total.SalesCount, int32(maxOrders))
 	assert.Equal(suite.T(), stat.Total.ReturnsCount, int32(maxRefunds))
 	assert.Equal(suite.T(), stat.Total.TransactionsCurrency, suite.merchant.Banking.Currency)
-	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.953538))
-	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.378545))
-	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.574993))
+	assert.Equal(suite.T(), stat.Total.GrossSalesAmount, float64(175.96))
+	assert.Equal(suite.T(), stat.Total.GrossReturnsAmount, float64(45.38))
+	assert.Equal(suite.T(), stat.Total.GrossTotalAmount, float64(130.58))
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_ProcessOrderViewProjection_Ok() {
+	suite.service.cfg.OrderViewProjectionDelay = -10
+
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	n, err := suite.service.db.Collection(collectionOrderViewEvent).Find(bson.M{"order_id": order.Id}).Count()
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), n > 0)
+
+	order.PrivateStatus = constant.OrderStatusChargeback
+	err = suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	count, err := suite.service.ProcessOrderViewProjection()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+
+	orderView, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderPublicStatusChargeback, orderView.(*billing.OrderViewPrivate).Status)
+	assert.NotZero(suite.T(), orderView.(*billing.OrderViewPrivate).NetRevenue.Amount)
+
+	checkpoint, err := suite.service.getOrderViewProjectionCheckpoint()
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), checkpoint.LastEventId)
+
+	count, err = suite.service.ProcessOrderViewProjection()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, count)
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_ProcessOrderViewProjection_EventAppliedOnce() {
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	expected, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+
+	event := &orderViewEvent{}
+	err = suite.service.db.Collection(collectionOrderViewEvent).
+		Find(bson.M{"order_id": order.Id, "source": orderViewEventSourceAccountingEntry}).One(event)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), event.Amounts)
+
+	err = suite.service.applyOrderViewEvent(event)
+	assert.NoError(suite.T(), err)
+
+	orderView, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+	assert.Equal(
+		suite.T(),
+		expected.(*billing.OrderViewPrivate).NetRevenue.Amount,
+		orderView.(*billing.OrderViewPrivate).NetRevenue.Amount,
+	)
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_ProcessOrderViewProjection_SkipRecentEvents() {
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	checkpoint, err := suite.service.getOrderViewProjectionCheckpoint()
+	assert.NoError(suite.T(), err)
+
+	order.PrivateStatus = constant.OrderStatusChargeback
+	err = suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	count, err := suite.service.ProcessOrderViewProjection()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, count)
+
+	orderView, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderPublicStatusProcessed, orderView.(*billing.OrderViewPrivate).Status)
+
+	checkpoint2, err := suite.service.getOrderViewProjectionCheckpoint()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), checkpoint.LastEventId, checkpoint2.LastEventId)
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_ProcessOrderViewProjection_Locked() {
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	err := suite.service.redis.Set(orderViewProjectionLockKey, "another_replica", time.Minute).Err()
+	assert.NoError(suite.T(), err)
+
+	defer suite.service.redis.Del(orderViewProjectionLockKey)
+
+	suite.service.cfg.OrderViewProjectionDelay = -10
+	order.PrivateStatus = constant.OrderStatusChargeback
+	err = suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	count, err := suite.service.ProcessOrderViewProjection()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, count)
+
+	lock, err := suite.service.redis.Get(orderViewProjectionLockKey).Result()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "another_replica", lock)
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_RebuildOrderView_Ok() {
+	suite.service.cfg.OrderViewProjectionDelay = -10
+
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	order.PrivateStatus = constant.OrderStatusChargeback
+	err := suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.RebuildOrderView()
+	assert.NoError(suite.T(), err)
+
+	orderView, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderPublicStatusChargeback, orderView.(*billing.OrderViewPrivate).Status)
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_CheckOrderViewConsistency_Ok() {
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	mismatched, err := suite.service.CheckOrderViewConsistency()
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), mismatched)
+
+	err = suite.service.db.Collection(collectionOrderView).
+		UpdateId(bson.ObjectIdHex(order.Id), bson.M{"$set": bson.M{"status": "broken"}})
+	assert.NoError(suite.T(), err)
+
+	mismatched, err = suite.service.CheckOrderViewConsistency()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{order.Id}, mismatched)
+
+	orderView, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), "broken", orderView.(*billing.OrderViewPrivate).Status)
+
+	n, err := suite.service.db.Collection(collectionOrderViewConsistencyCompare).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, n)
+}
+
+func (suite *OrderViewTestSuite) Test_OrderView_CheckOrderViewConsistency_SkipPendingOrders() {
+	order := helperCreateAndPayOrder(
+		suite.Suite,
+		suite.service,
+		100,
+		"USD",
+		"RU",
+		suite.projectFixedAmount,
+		suite.paymentMethod,
+	)
+
+	order.PrivateStatus = constant.OrderStatusChargeback
+	err := suite.service.updateOrder(order)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.db.Collection(collectionOrderView).
+		UpdateId(bson.ObjectIdHex(order.Id), bson.M{"$set": bson.M{"status": "broken"}})
+	assert.NoError(suite.T(), err)
+
+	mismatched, err := suite.service.CheckOrderViewConsistency()
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), mismatched)
 }
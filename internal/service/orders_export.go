@@ -0,0 +1,743 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	collectionOrdersExportJob = "orders_export_job"
+	ordersExportFilesPrefix   = "orders_export"
+
+	ordersExportDownloadChunkSize = 512 * 1024
+
+	ordersExportColumnId                   = "id"
+	ordersExportColumnMerchantId           = "merchant_id"
+	ordersExportColumnProject              = "project"
+	ordersExportColumnCreatedAt            = "created_at"
+	ordersExportColumnTransactionDate      = "transaction_date"
+	ordersExportColumnTransaction          = "transaction"
+	ordersExportColumnStatus               = "status"
+	ordersExportColumnType                 = "type"
+	ordersExportColumnCountry              = "country"
+	ordersExportColumnPaymentMethod        = "payment_method"
+	ordersExportColumnAmount               = "amount"
+	ordersExportColumnCurrency             = "currency"
+	ordersExportColumnGrossRevenue         = "gross_revenue"
+	ordersExportColumnTaxFee               = "tax_fee"
+	ordersExportColumnFeesTotal            = "fees_total"
+	ordersExportColumnNetRevenue           = "net_revenue"
+	ordersExportColumnRefundReverseRevenue = "refund_reverse_revenue"
+	ordersExportColumnRevenueCurrency      = "revenue_currency"
+
+	// prefix of keys of column titles in i18n messages, key is prefix with column name in upper case
+	ordersExportColumnTitleKeyPrefix = "ORDERS_EXPORT_COLUMN_"
+)
+
+var (
+	errorOrdersExportFormatUnknown     = newBillingServerErrorMsg("oe000001", "unknown format of orders export")
+	errorOrdersExportColumnUnknown     = newBillingServerErrorMsg("oe000002", "unknown column of orders export")
+	errorOrdersExportUnknown           = newBillingServerErrorMsg("oe000003", "unknown error. try request later")
+	errorOrdersExportJobNotFound       = newBillingServerErrorMsg("oe000004", "orders export job not found")
+	errorOrdersExportJobNotFinished    = newBillingServerErrorMsg("oe000005", "orders export job is not finished yet")
+	errorOrdersExportJobFileNotCreated = newBillingServerErrorMsg("oe000006", "file of orders export job was not created")
+	errorOrdersExportCursorInvalid     = newBillingServerErrorMsg("oe000007", "cursor of orders export is invalid")
+	errorOrdersExportCursorNotAllowed  = newBillingServerErrorMsg("oe000008", "xlsx orders export can't be continued from cursor")
+
+	ordersExportColumns = []string{
+		ordersExportColumnId,
+		ordersExportColumnMerchantId,
+		ordersExportColumnProject,
+		ordersExportColumnCreatedAt,
+		ordersExportColumnTransactionDate,
+		ordersExportColumnTransaction,
+		ordersExportColumnStatus,
+		ordersExportColumnType,
+		ordersExportColumnCountry,
+		ordersExportColumnPaymentMethod,
+		ordersExportColumnAmount,
+		ordersExportColumnCurrency,
+		ordersExportColumnGrossRevenue,
+		ordersExportColumnTaxFee,
+		ordersExportColumnFeesTotal,
+		ordersExportColumnNetRevenue,
+		ordersExportColumnRefundReverseRevenue,
+		ordersExportColumnRevenueCurrency,
+	}
+)
+
+type ordersExportJob struct {
+	Id         bson.ObjectId             `bson:"_id"`
+	MerchantId string                    `bson:"merchant_id"`
+	Request    *grpc.ExportOrdersRequest `bson:"request"`
+	Status     string                    `bson:"status"`
+	FileId     bson.ObjectId             `bson:"file_id,omitempty"`
+	FileName   string                    `bson:"file_name"`
+	FileSize   int64                     `bson:"file_size"`
+	Rows       int32                     `bson:"rows"`
+	Error      string                    `bson:"error"`
+	CreatedAt  time.Time                 `bson:"created_at"`
+	UpdatedAt  time.Time                 `bson:"updated_at"`
+	FinishedAt time.Time                 `bson:"finished_at,omitempty"`
+}
+
+type ordersExportWriter interface {
+	Write(record []interface{}) error
+	Flush() error
+	Close() error
+}
+
+// ordersExportMessageFormatter is implemented by formatter which translates messages of i18n/messages by locale
+type ordersExportMessageFormatter interface {
+	Translate(locale, key string, substitutions map[string]string) (string, error)
+}
+
+type ordersExportCsvWriter struct {
+	w *csv.Writer
+}
+
+type ordersExporter struct {
+	*Service
+	req     *grpc.ExportOrdersRequest
+	columns []string
+	locale  string
+	query   bson.M
+}
+
+// ExportOrders streams file with orders from order view found by filters of request.
+// Orders are read by batches in order of identifiers, each chunk of file holds cursor of the last order
+// written to chunk, so interrupted csv export can be continued from this cursor with the same request,
+// header isn't written again then. Xlsx workbook can't be continued from cursor.
+func (s *Service) ExportOrders(
+	ctx context.Context,
+	req *grpc.ExportOrdersRequest,
+	stream grpc.BillingService_ExportOrdersStream,
+) error {
+	exporter, msg := s.newOrdersExporter(req)
+
+	if msg != nil {
+		return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusBadData, Message: msg})
+	}
+
+	buf := new(bytes.Buffer)
+
+	_, err := exporter.export(buf, func(cursor string, rows int32) error {
+		if buf.Len() <= 0 {
+			return nil
+		}
+
+		chunk := &grpc.ExportOrdersChunk{
+			Status:  pkg.ResponseStatusOk,
+			Content: make([]byte, buf.Len()),
+			Cursor:  cursor,
+			Rows:    rows,
+		}
+		copy(chunk.Content, buf.Bytes())
+		buf.Reset()
+
+		return stream.Send(chunk)
+	})
+
+	if err != nil {
+		zap.L().Error("Orders export failed", zap.Error(err), zap.Any("request", req))
+		return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusSystemError, Message: errorOrdersExportUnknown})
+	}
+
+	return nil
+}
+
+// CreateOrdersExportJob queues export of orders to file, file is created by orders export task
+// and can be downloaded after job is done.
+func (s *Service) CreateOrdersExportJob(
+	ctx context.Context,
+	req *grpc.ExportOrdersRequest,
+	rsp *grpc.OrdersExportJobResponse,
+) error {
+	if _, msg := s.newOrdersExporter(req); msg != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = msg
+		return nil
+	}
+
+	job := &ordersExportJob{
+		Id:        bson.NewObjectId(),
+		Request:   req,
+		Status:    pkg.OrdersExportJobStatusQueued,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	job.FileName = "orders_" + job.Id.Hex() + "." + req.Format
+
+	if req.Filters != nil && len(req.Filters.Merchant) == 1 {
+		job.MerchantId = req.Filters.Merchant[0]
+	}
+
+	err := s.db.Collection(collectionOrdersExportJob).Insert(job)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrdersExportJob),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, job),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorOrdersExportUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = job.toProto()
+
+	return nil
+}
+
+func (s *Service) GetOrdersExportJob(
+	ctx context.Context,
+	req *grpc.OrdersExportJobRequest,
+	rsp *grpc.OrdersExportJobResponse,
+) error {
+	job, msg := s.getOrdersExportJob(req)
+
+	if msg != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = msg
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = job.toProto()
+
+	return nil
+}
+
+// DownloadOrdersExportJob streams file created by orders export job
+func (s *Service) DownloadOrdersExportJob(
+	ctx context.Context,
+	req *grpc.OrdersExportJobRequest,
+	stream grpc.BillingService_DownloadOrdersExportJobStream,
+) error {
+	job, msg := s.getOrdersExportJob(req)
+
+	if msg != nil {
+		return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusNotFound, Message: msg})
+	}
+
+	if job.Status == pkg.OrdersExportJobStatusFailed {
+		return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusBadData, Message: errorOrdersExportJobFileNotCreated})
+	}
+
+	if job.Status != pkg.OrdersExportJobStatusDone {
+		return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusBadData, Message: errorOrdersExportJobNotFinished})
+	}
+
+	file, err := s.getOrdersExportFiles().OpenId(job.FileId)
+
+	if err != nil {
+		zap.L().Error("Orders export file open failed", zap.Error(err), zap.String("job_id", job.Id.Hex()))
+		return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusSystemError, Message: errorOrdersExportUnknown})
+	}
+
+	defer file.Close()
+
+	buf := make([]byte, ordersExportDownloadChunkSize)
+
+	for {
+		n, err := io.ReadFull(file, buf)
+
+		if n > 0 {
+			chunk := &grpc.ExportOrdersChunk{Status: pkg.ResponseStatusOk, Content: make([]byte, n)}
+			copy(chunk.Content, buf[:n])
+
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+
+		if err != nil {
+			zap.L().Error("Orders export file read failed", zap.Error(err), zap.String("job_id", job.Id.Hex()))
+			return stream.Send(&grpc.ExportOrdersChunk{Status: pkg.ResponseStatusSystemError, Message: errorOrdersExportUnknown})
+		}
+	}
+}
+
+// ProcessOrdersExportJobs creates files of queued orders export jobs.
+// Jobs which processing isn't finished in time are taken again, so job of stopped worker isn't lost.
+func (s *Service) ProcessOrdersExportJobs() (int, error) {
+	counter := 0
+
+	for {
+		job, err := s.takeOrdersExportJob()
+
+		if err != nil {
+			return counter, err
+		}
+
+		if job == nil {
+			return counter, nil
+		}
+
+		if err = s.processOrdersExportJob(job); err != nil {
+			return counter, err
+		}
+
+		counter++
+	}
+}
+
+func (s *Service) takeOrdersExportJob() (*ordersExportJob, error) {
+	timeout := time.Now().Add(-time.Duration(s.cfg.OrdersExportJobTimeout) * time.Second)
+	query := bson.M{
+		"$or": []bson.M{
+			{"status": pkg.OrdersExportJobStatusQueued},
+			{"status": pkg.OrdersExportJobStatusProcessing, "updated_at": bson.M{"$lt": timeout}},
+		},
+	}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"status": pkg.OrdersExportJobStatusProcessing, "updated_at": time.Now()}},
+		ReturnNew: true,
+	}
+
+	job := new(ordersExportJob)
+	_, err := s.db.Collection(collectionOrdersExportJob).Find(query).Sort("created_at").Apply(change, job)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrdersExportJob),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (s *Service) processOrdersExportJob(job *ordersExportJob) error {
+	update := bson.M{"updated_at": time.Now(), "finished_at": time.Now()}
+	exporter, msg := s.newOrdersExporter(job.Request)
+
+	if msg != nil {
+		update["status"] = pkg.OrdersExportJobStatusFailed
+		update["error"] = msg.Message
+
+		return s.updateOrdersExportJob(job, update)
+	}
+
+	files := s.getOrdersExportFiles()
+	file, err := files.Create(job.FileName)
+
+	if err != nil {
+		zap.L().Error("Orders export file create failed", zap.Error(err), zap.String("job_id", job.Id.Hex()))
+		return err
+	}
+
+	rows, err := exporter.export(file, nil)
+
+	if err == nil {
+		err = file.Close()
+	} else {
+		_ = file.Close()
+	}
+
+	if err != nil {
+		zap.L().Error("Orders export job failed", zap.Error(err), zap.String("job_id", job.Id.Hex()))
+
+		if err := files.RemoveId(file.Id()); err != nil {
+			zap.L().Error("Orders export file remove failed", zap.Error(err), zap.String("job_id", job.Id.Hex()))
+		}
+
+		update["status"] = pkg.OrdersExportJobStatusFailed
+		update["error"] = err.Error()
+
+		return s.updateOrdersExportJob(job, update)
+	}
+
+	update["status"] = pkg.OrdersExportJobStatusDone
+	update["file_id"] = file.Id()
+	update["file_size"] = file.Size()
+	update["rows"] = rows
+
+	return s.updateOrdersExportJob(job, update)
+}
+
+func (s *Service) updateOrdersExportJob(job *ordersExportJob, update bson.M) error {
+	err := s.db.Collection(collectionOrdersExportJob).UpdateId(job.Id, bson.M{"$set": update})
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrdersExportJob),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationUpdate),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, update),
+		)
+	}
+
+	return err
+}
+
+func (s *Service) getOrdersExportJob(req *grpc.OrdersExportJobRequest) (*ordersExportJob, *grpc.ResponseErrorMessage) {
+	if !bson.IsObjectIdHex(req.Id) {
+		return nil, errorOrdersExportJobNotFound
+	}
+
+	query := bson.M{"_id": bson.ObjectIdHex(req.Id)}
+
+	if req.MerchantId != "" {
+		query["merchant_id"] = req.MerchantId
+	}
+
+	job := new(ordersExportJob)
+	err := s.db.Collection(collectionOrdersExportJob).Find(query).One(job)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrdersExportJob),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, errorOrdersExportJobNotFound
+	}
+
+	return job, nil
+}
+
+func (s *Service) getOrdersExportFiles() *mgo.GridFS {
+	return s.db.Collection(collectionOrdersExportJob).Database.GridFS(ordersExportFilesPrefix)
+}
+
+func (s *Service) newOrdersExporter(req *grpc.ExportOrdersRequest) (*ordersExporter, *grpc.ResponseErrorMessage) {
+	if req.Format != pkg.OrdersExportFormatCsv && req.Format != pkg.OrdersExportFormatXlsx {
+		return nil, errorOrdersExportFormatUnknown
+	}
+
+	if req.Cursor != "" && !bson.IsObjectIdHex(req.Cursor) {
+		return nil, errorOrdersExportCursorInvalid
+	}
+
+	if req.Cursor != "" && req.Format == pkg.OrdersExportFormatXlsx {
+		return nil, errorOrdersExportCursorNotAllowed
+	}
+
+	columns := req.Columns
+
+	if len(columns) <= 0 {
+		columns = ordersExportColumns
+	}
+
+	for _, column := range columns {
+		if !contains(ordersExportColumns, column) {
+			return nil, errorOrdersExportColumnUnknown
+		}
+	}
+
+	filters := req.Filters
+
+	if filters == nil {
+		filters = &grpc.ListOrdersRequest{}
+	}
+
+	exporter := &ordersExporter{
+		Service: s,
+		req:     req,
+		columns: columns,
+		locale:  req.Locale,
+		query:   s.getOrdersListQuery(filters),
+	}
+
+	if exporter.locale == "" {
+		exporter.locale = DefaultLanguage
+	}
+
+	if req.Cursor != "" {
+		exporter.query["_id"] = bson.M{"$gt": bson.ObjectIdHex(req.Cursor)}
+	}
+
+	return exporter, nil
+}
+
+// export writes file with orders to w, header is written only when export isn't continued from cursor.
+// onBatch is called after each batch of orders is written and flushed to w, and after file is closed.
+// Number of written orders returned.
+func (e *ordersExporter) export(w io.Writer, onBatch func(cursor string, rows int32) error) (int32, error) {
+	fw, err := e.newWriter(w)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if e.req.Cursor == "" {
+		if err = fw.Write(e.getHeader()); err != nil {
+			return 0, err
+		}
+	}
+
+	batchSize := e.cfg.OrdersExportBatchSize
+
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	iter := e.db.Collection(collectionOrderView).Find(e.query).Sort("_id").Batch(batchSize).Iter()
+	total, rows, cursor := int32(0), int32(0), ""
+
+	flush := func() error {
+		if err := fw.Flush(); err != nil {
+			return err
+		}
+
+		if onBatch != nil {
+			if err := onBatch(cursor, rows); err != nil {
+				return err
+			}
+		}
+
+		rows = 0
+		return nil
+	}
+
+	order := new(billing.OrderViewPrivate)
+
+	for iter.Next(order) {
+		if err = fw.Write(e.getRecord(order)); err != nil {
+			_ = iter.Close()
+			return total, err
+		}
+
+		cursor = order.Id
+		rows++
+		total++
+
+		if rows >= int32(batchSize) {
+			if err = flush(); err != nil {
+				_ = iter.Close()
+				return total, err
+			}
+		}
+
+		order = new(billing.OrderViewPrivate)
+	}
+
+	if err = iter.Close(); err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderView),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, e.query),
+		)
+		return total, err
+	}
+
+	if err = fw.Close(); err != nil {
+		return total, err
+	}
+
+	if onBatch != nil {
+		if err = onBatch(cursor, rows); err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+func (e *ordersExporter) newWriter(w io.Writer) (ordersExportWriter, error) {
+	if e.req.Format == pkg.OrdersExportFormatXlsx {
+		return internalPkg.NewXlsxWriter(w)
+	}
+
+	return &ordersExportCsvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (e *ordersExporter) getHeader() []interface{} {
+	header := make([]interface{}, len(e.columns))
+
+	for i, column := range e.columns {
+		header[i] = e.getColumnTitle(column)
+	}
+
+	return header
+}
+
+// getColumnTitle returns title of column translated by formatter, title of default language used
+// for locales without own titles and name of column if title isn't found
+func (e *ordersExporter) getColumnTitle(column string) string {
+	translator, ok := e.formatter.(ordersExportMessageFormatter)
+
+	if !ok {
+		return column
+	}
+
+	key := ordersExportColumnTitleKeyPrefix + strings.ToUpper(column)
+
+	for _, locale := range []string{e.locale, DefaultLanguage} {
+		title, err := translator.Translate(locale, key, nil)
+
+		if err == nil && title != "" && title != key {
+			return title
+		}
+	}
+
+	return column
+}
+
+func (e *ordersExporter) getRecord(order *billing.OrderViewPrivate) []interface{} {
+	record := make([]interface{}, len(e.columns))
+
+	for i, column := range e.columns {
+		record[i] = e.getValue(order, column)
+	}
+
+	return record
+}
+
+func (e *ordersExporter) getValue(order *billing.OrderViewPrivate, column string) interface{} {
+	switch column {
+	case ordersExportColumnId:
+		return order.Uuid
+	case ordersExportColumnMerchantId:
+		return order.MerchantId
+	case ordersExportColumnProject:
+		if order.Project == nil {
+			return ""
+		}
+		if name, ok := order.Project.Name[e.locale]; ok {
+			return name
+		}
+		return order.Project.Name[DefaultLanguage]
+	case ordersExportColumnCreatedAt:
+		return e.formatDate(order.CreatedAt)
+	case ordersExportColumnTransactionDate:
+		return e.formatDate(order.TransactionDate)
+	case ordersExportColumnTransaction:
+		return order.Transaction
+	case ordersExportColumnStatus:
+		return order.Status
+	case ordersExportColumnType:
+		return order.Type
+	case ordersExportColumnCountry:
+		return order.CountryCode
+	case ordersExportColumnPaymentMethod:
+		return order.GetPaymentMethod().GetName()
+	case ordersExportColumnAmount:
+		return order.TotalPaymentAmount
+	case ordersExportColumnCurrency:
+		return order.Currency
+	case ordersExportColumnGrossRevenue:
+		return order.GetGrossRevenue().GetAmount()
+	case ordersExportColumnTaxFee:
+		return order.GetTaxFeeTotal().GetAmount()
+	case ordersExportColumnFeesTotal:
+		return order.GetFeesTotal().GetAmount()
+	case ordersExportColumnNetRevenue:
+		return order.GetNetRevenue().GetAmount()
+	case ordersExportColumnRefundReverseRevenue:
+		return order.GetRefundReverseRevenue().GetAmount()
+	case ordersExportColumnRevenueCurrency:
+		return order.GetNetRevenue().GetCurrency()
+	}
+
+	return ""
+}
+
+func (e *ordersExporter) formatDate(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	t, err := ptypes.Timestamp(ts)
+
+	if err != nil || t.Unix() <= 0 {
+		return ""
+	}
+
+	date, err := e.formatter.FormatDateTime(e.locale, t)
+
+	if err != nil {
+		return t.Format(time.RFC3339)
+	}
+
+	return date
+}
+
+func (w *ordersExportCsvWriter) Write(record []interface{}) error {
+	values := make([]string, len(record))
+
+	for i, value := range record {
+		switch v := value.(type) {
+		case string:
+			values[i] = v
+		case float64:
+			values[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			values[i] = fmt.Sprint(v)
+		}
+	}
+
+	return w.w.Write(values)
+}
+
+func (w *ordersExportCsvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *ordersExportCsvWriter) Close() error {
+	return w.Flush()
+}
+
+func (j *ordersExportJob) toProto() *grpc.OrdersExportJob {
+	item := &grpc.OrdersExportJob{
+		Id:         j.Id.Hex(),
+		MerchantId: j.MerchantId,
+		Status:     j.Status,
+		FileName:   j.FileName,
+		FileSize:   j.FileSize,
+		Rows:       j.Rows,
+		Error:      j.Error,
+	}
+
+	if j.Request != nil {
+		item.Format = j.Request.Format
+	}
+
+	item.CreatedAt, _ = ptypes.TimestampProto(j.CreatedAt)
+
+	if !j.FinishedAt.IsZero() {
+		item.FinishedAt, _ = ptypes.TimestampProto(j.FinishedAt)
+	}
+
+	return item
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	paysuper_i18n "github.com/paysuper/paysuper-i18n"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type OrdersExportTestSuite struct {
+	suite.Suite
+	service    *Service
+	merchantId bson.ObjectId
+	orders     []bson.ObjectId
+}
+
+// ordersExportFormatterMock translates column titles by messages of i18n/messages
+type ordersExportFormatterMock struct {
+	paysuper_i18n.Formatter
+	messages map[string]map[string]string
+}
+
+func (m *ordersExportFormatterMock) Translate(locale, key string, _ map[string]string) (string, error) {
+	if title, ok := m.messages[locale][key]; ok {
+		return title, nil
+	}
+
+	return key, errors.New("message not found")
+}
+
+type ordersExportStreamMock struct {
+	chunks []*grpc.ExportOrdersChunk
+}
+
+func (m *ordersExportStreamMock) SendMsg(interface{}) error { return nil }
+func (m *ordersExportStreamMock) RecvMsg(interface{}) error { return nil }
+func (m *ordersExportStreamMock) Close() error              { return nil }
+
+func (m *ordersExportStreamMock) Send(chunk *grpc.ExportOrdersChunk) error {
+	m.chunks = append(m.chunks, chunk)
+	return nil
+}
+
+func (m *ordersExportStreamMock) content() []byte {
+	b := new(bytes.Buffer)
+
+	for _, chunk := range m.chunks {
+		b.Write(chunk.Content)
+	}
+
+	return b.Bytes()
+}
+
+func Test_OrdersExport(t *testing.T) {
+	suite.Run(t, new(OrdersExportTestSuite))
+}
+
+func (suite *OrdersExportTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+
+	cfg.OrdersExportBatchSize = 2
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		NewCacheRedis(mocks.NewTestRedis()),
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		&ordersExportFormatterMock{
+			Formatter: mocks.NewFormatterOK(),
+			messages: map[string]map[string]string{
+				"en": {"ORDERS_EXPORT_COLUMN_ID": "Order ID", "ORDERS_EXPORT_COLUMN_PROJECT": "Project"},
+				"ru": {
+					"ORDERS_EXPORT_COLUMN_ID":          "ID заказа",
+					"ORDERS_EXPORT_COLUMN_PROJECT":     "Проект",
+					"ORDERS_EXPORT_COLUMN_AMOUNT":      "Сумма",
+					"ORDERS_EXPORT_COLUMN_NET_REVENUE": "Чистая выручка",
+				},
+			},
+		},
+		mocks.NewBrokerMockOk(),
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	suite.merchantId = bson.NewObjectId()
+	suite.orders = nil
+
+	for i, status := range []string{"processed", "processed", "refunded", "processed", "processed"} {
+		id := bson.NewObjectId()
+		order := bson.M{
+			"_id":         id,
+			"uuid":        "order-" + string(rune('a'+i)),
+			"merchant_id": suite.merchantId,
+			"project": bson.M{
+				"_id":         bson.NewObjectId(),
+				"merchant_id": suite.merchantId,
+				"name":        []bson.M{{"lang": "en", "value": "Test project"}, {"lang": "ru", "value": "Тестовый проект"}},
+			},
+			"status":               status,
+			"currency":             "RUB",
+			"total_payment_amount": float64(100 + i),
+			"net_revenue":          bson.M{"amount": float64(90 + i), "currency": "USD"},
+			"created_at":           time.Now(),
+			"pm_order_close_date":  time.Now(),
+		}
+
+		if err := suite.service.db.Collection(collectionOrderView).Insert(order); err != nil {
+			suite.FailNow("Insert order view test data failed", "%v", err)
+		}
+
+		suite.orders = append(suite.orders, id)
+	}
+
+	// order of another merchant must not be exported
+	err = suite.service.db.Collection(collectionOrderView).Insert(bson.M{
+		"_id":         bson.NewObjectId(),
+		"uuid":        "order-other",
+		"merchant_id": bson.NewObjectId(),
+		"project":     bson.M{"_id": bson.NewObjectId(), "merchant_id": bson.NewObjectId()},
+		"status":      "processed",
+		"created_at":  time.Now(),
+	})
+
+	if err != nil {
+		suite.FailNow("Insert order view test data failed", "%v", err)
+	}
+}
+
+func (suite *OrdersExportTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *OrdersExportTestSuite) getRequest(format string) *grpc.ExportOrdersRequest {
+	return &grpc.ExportOrdersRequest{
+		Filters: &grpc.ListOrdersRequest{
+			Merchant: []string{suite.merchantId.Hex()},
+			Status:   []string{"processed"},
+		},
+		Format:  format,
+		Columns: []string{ordersExportColumnId, ordersExportColumnProject, ordersExportColumnAmount, ordersExportColumnNetRevenue},
+		Locale:  "ru",
+	}
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_ExportOrders_Csv_Ok() {
+	stream := &ordersExportStreamMock{}
+	err := suite.service.ExportOrders(context.TODO(), suite.getRequest(pkg.OrdersExportFormatCsv), stream)
+	assert.NoError(suite.T(), err)
+
+	// two full batches of two orders
+	assert.Len(suite.T(), stream.chunks, 2)
+
+	for _, chunk := range stream.chunks {
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, chunk.Status)
+		assert.EqualValues(suite.T(), 2, chunk.Rows)
+	}
+
+	assert.Equal(suite.T(), suite.orders[1].Hex(), stream.chunks[0].Cursor)
+	assert.Equal(suite.T(), suite.orders[4].Hex(), stream.chunks[1].Cursor)
+
+	records, err := csv.NewReader(bytes.NewReader(stream.content())).ReadAll()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records, 5)
+	assert.Equal(suite.T(), []string{"ID заказа", "Проект", "Сумма", "Чистая выручка"}, records[0])
+	assert.Equal(suite.T(), []string{"order-a", "Тестовый проект", "100", "90"}, records[1])
+	assert.Equal(suite.T(), "order-b", records[2][0])
+	assert.Equal(suite.T(), "order-d", records[3][0])
+	assert.Equal(suite.T(), "order-e", records[4][0])
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_ExportOrders_Cursor_Ok() {
+	req := suite.getRequest(pkg.OrdersExportFormatCsv)
+	req.Cursor = suite.orders[1].Hex()
+	req.Locale = "unknown"
+
+	stream := &ordersExportStreamMock{}
+	err := suite.service.ExportOrders(context.TODO(), req, stream)
+	assert.NoError(suite.T(), err)
+
+	// header isn't written again when export is continued
+	records, err := csv.NewReader(bytes.NewReader(stream.content())).ReadAll()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records, 2)
+	assert.Equal(suite.T(), "order-d", records[0][0])
+	assert.Equal(suite.T(), "order-e", records[1][0])
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_ExportOrders_Xlsx_Cursor_Error() {
+	req := suite.getRequest(pkg.OrdersExportFormatXlsx)
+	req.Cursor = suite.orders[1].Hex()
+
+	stream := &ordersExportStreamMock{}
+	err := suite.service.ExportOrders(context.TODO(), req, stream)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), stream.chunks, 1)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, stream.chunks[0].Status)
+	assert.Equal(suite.T(), errorOrdersExportCursorNotAllowed, stream.chunks[0].Message)
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_GetColumnTitle() {
+	exporter, msg := suite.service.newOrdersExporter(suite.getRequest(pkg.OrdersExportFormatCsv))
+	assert.Nil(suite.T(), msg)
+	assert.Equal(suite.T(), "Проект", exporter.getColumnTitle(ordersExportColumnProject))
+
+	exporter.locale = "unknown"
+	assert.Equal(suite.T(), "Project", exporter.getColumnTitle(ordersExportColumnProject))
+
+	// name of column used when title isn't found in any locale
+	assert.Equal(suite.T(), ordersExportColumnStatus, exporter.getColumnTitle(ordersExportColumnStatus))
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_ExportOrders_Xlsx_Ok() {
+	stream := &ordersExportStreamMock{}
+	err := suite.service.ExportOrders(context.TODO(), suite.getRequest(pkg.OrdersExportFormatXlsx), stream)
+	assert.NoError(suite.T(), err)
+
+	content := stream.content()
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	assert.NoError(suite.T(), err)
+
+	var names []string
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+
+	assert.Contains(suite.T(), names, "xl/workbook.xml")
+	assert.Contains(suite.T(), names, "xl/worksheets/sheet1.xml")
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_ExportOrders_ColumnUnknown_Error() {
+	req := suite.getRequest(pkg.OrdersExportFormatCsv)
+	req.Columns = append(req.Columns, "unknown")
+
+	stream := &ordersExportStreamMock{}
+	err := suite.service.ExportOrders(context.TODO(), req, stream)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), stream.chunks, 1)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, stream.chunks[0].Status)
+	assert.Equal(suite.T(), errorOrdersExportColumnUnknown, stream.chunks[0].Message)
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_ExportOrders_FormatUnknown_Error() {
+	stream := &ordersExportStreamMock{}
+	err := suite.service.ExportOrders(context.TODO(), suite.getRequest("pdf"), stream)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), stream.chunks, 1)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, stream.chunks[0].Status)
+	assert.Equal(suite.T(), errorOrdersExportFormatUnknown, stream.chunks[0].Message)
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_Job_Ok() {
+	rsp := &grpc.OrdersExportJobResponse{}
+	err := suite.service.CreateOrdersExportJob(context.TODO(), suite.getRequest(pkg.OrdersExportFormatCsv), rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.OrdersExportJobStatusQueued, rsp.Item.Status)
+	assert.Equal(suite.T(), suite.merchantId.Hex(), rsp.Item.MerchantId)
+
+	req := &grpc.OrdersExportJobRequest{Id: rsp.Item.Id, MerchantId: suite.merchantId.Hex()}
+
+	stream := &ordersExportStreamMock{}
+	err = suite.service.DownloadOrdersExportJob(context.TODO(), req, stream)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), stream.chunks, 1)
+	assert.Equal(suite.T(), errorOrdersExportJobNotFinished, stream.chunks[0].Message)
+
+	count, err := suite.service.ProcessOrdersExportJobs()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+
+	rsp = &grpc.OrdersExportJobResponse{}
+	err = suite.service.GetOrdersExportJob(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.OrdersExportJobStatusDone, rsp.Item.Status)
+	assert.EqualValues(suite.T(), 4, rsp.Item.Rows)
+	assert.True(suite.T(), rsp.Item.FileSize > 0)
+	assert.NotNil(suite.T(), rsp.Item.FinishedAt)
+
+	stream = &ordersExportStreamMock{}
+	err = suite.service.DownloadOrdersExportJob(context.TODO(), req, stream)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), stream.chunks, 1)
+	assert.EqualValues(suite.T(), rsp.Item.FileSize, len(stream.content()))
+
+	records, err := csv.NewReader(bytes.NewReader(stream.content())).ReadAll()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records, 5)
+
+	count, err = suite.service.ProcessOrdersExportJobs()
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), count)
+}
+
+func (suite *OrdersExportTestSuite) TestOrdersExport_GetOrdersExportJob_AnotherMerchant_NotFound() {
+	rsp := &grpc.OrdersExportJobResponse{}
+	err := suite.service.CreateOrdersExportJob(context.TODO(), suite.getRequest(pkg.OrdersExportFormatCsv), rsp)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.OrdersExportJobRequest{Id: rsp.Item.Id, MerchantId: bson.NewObjectId().Hex()}
+	rsp = &grpc.OrdersExportJobResponse{}
+	err = suite.service.GetOrdersExportJob(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), errorOrdersExportJobNotFound, rsp.Message)
+}
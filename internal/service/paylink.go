@@ -28,6 +28,7 @@ type utmQueryParams struct {
 	UtmSource   string `url:"utm_source,omitempty"`
 	UtmMedium   string `url:"utm_medium,omitempty"`
 	UtmCampaign string `url:"utm_campaign,omitempty"`
+	Token       string `url:"token,omitempty"`
 }
 
 type PaylinkServiceInterface interface {
@@ -36,17 +37,22 @@ type PaylinkServiceInterface interface {
 	GetById(id string) (pl *paylink.Paylink, err error)
 	GetByIdAndMerchant(id, merchantId string) (pl *paylink.Paylink, err error)
 	IncrVisits(id string) error
-	GetUrl(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign string) (string, error)
+	GetUrl(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign, token string) (string, error)
 	Delete(id, merchantId string) error
 	Insert(pl *paylink.Paylink) error
 	Update(pl *paylink.Paylink) error
 	UpdatePaylinkTotalStat(id, merchantId string) error
 	GetPaylinkVisits(id string, from, to int64) (int, error)
+	InsertToken(token *paylink.PaylinkToken) error
+	GetTokenById(id string) (*paylink.PaylinkToken, error)
+	GetTokensByPaylink(paylinkId string) ([]*paylink.PaylinkToken, error)
+	GetTokenPurchases(ids []string) (map[string]int32, error)
 }
 
 const (
 	collectionPaylinks      = "paylinks"
 	collectionPaylinkVisits = "paylink_visits"
+	collectionPaylinkTokens = "paylink_tokens"
 
 	cacheKeyPaylink         = "paylink:id:%s"
 	cacheKeyPaylinkMerchant = "paylink:id:%s:merhcant_id:%s"
@@ -63,6 +69,11 @@ var (
 	errorPaylinkProductNotBelongToProject    = newBillingServerErrorMsg("pl000008", "at least one of paylink products is not belongs to project")
 	errorPaylinkStatDataInconsistent         = newBillingServerErrorMsg("pl000009", "paylink stat data inconsistent")
 	errorPaylinkProductNotFoundOrInvalidType = newBillingServerErrorMsg("pl000010", "at least one of paylink products is not found or have type differ from given products_type value")
+	errorPaylinkTokenRequired                = newBillingServerErrorMsg("pl000011", "paylink can be used only with token")
+	errorPaylinkTokenInvalid                 = newBillingServerErrorMsg("pl000012", "paylink token invalid")
+	errorPaylinkTokenExpired                 = newBillingServerErrorMsg("pl000013", "paylink token expired")
+	errorPaylinkTokenExpiresInPast           = newBillingServerErrorMsg("pl000014", "paylink token expiry date in past")
+	errorPaylinkTokenPurchasesLimitReached   = newBillingServerErrorMsg("pl000015", "paylink token purchases limit reached")
 
 	orderViewPaylinkStatFuncMap = map[string]orderViewPaylinkStatFunc{
 		"GetPaylinkStatByCountry":  OrderViewServiceInterface.GetPaylinkStatByCountry,
@@ -184,7 +195,7 @@ func (s *Service) GetPaylinkURL(
 	res *grpc.GetPaylinkUrlResponse,
 ) (err error) {
 
-	res.Url, err = s.paylinkService.GetUrl(req.Id, req.MerchantId, req.UrlMask, req.UtmMedium, req.UtmMedium, req.UtmCampaign)
+	res.Url, err = s.paylinkService.GetUrl(req.Id, req.MerchantId, req.UrlMask, req.UtmMedium, req.UtmMedium, req.UtmCampaign, req.Token)
 	if err != nil {
 		if err == mgo.ErrNotFound {
 			res.Status = pkg.ResponseStatusNotFound
@@ -278,6 +289,7 @@ func (s *Service) CreateOrUpdatePaylink(
 	pl.UpdatedAt = ptypes.TimestampNow()
 	pl.Name = req.Name
 	pl.NoExpiryDate = req.NoExpiryDate
+	pl.TokenRequired = req.TokenRequired
 
 	dbQuery := bson.M{
 		"_id":         bson.ObjectIdHex(pl.ProjectId),
@@ -461,6 +473,16 @@ func (s *Service) GetPaylinkStatTotal(
 	res.Item.Visits = int32(visits)
 	res.Item.UpdateConversion()
 
+	res.Tokens, err = s.getPaylinkTokensWithPurchases(pl.Id)
+	if err != nil {
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			res.Status = pkg.ResponseStatusBadData
+			res.Message = e
+			return nil
+		}
+		return err
+	}
+
 	res.Status = pkg.ResponseStatusOk
 	return nil
 }
@@ -656,7 +678,7 @@ func (p Paylink) IncrVisits(id string) (err error) {
 	return
 }
 
-func (p *Paylink) GetUrl(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign string) (string, error) {
+func (p *Paylink) GetUrl(id, merchantId, urlMask, utmSource, utmMedium, utmCampaign, token string) (string, error) {
 	pl, err := p.GetByIdAndMerchant(id, merchantId)
 	if err != nil {
 		return "", err
@@ -675,6 +697,7 @@ func (p *Paylink) GetUrl(id, merchantId, urlMask, utmSource, utmMedium, utmCampa
 		UtmSource:   utmSource,
 		UtmMedium:   utmMedium,
 		UtmCampaign: utmCampaign,
+		Token:       token,
 	}
 
 	q, err := query.Values(utmQuery)
@@ -755,6 +778,7 @@ func (p Paylink) Update(pl *paylink.Paylink) (err error) {
 		"no_expiry_date": pl.NoExpiryDate,
 		"products_type":  pl.ProductsType,
 		"is_expired":     pl.GetIsExpired(),
+		"token_required": pl.TokenRequired,
 	}}
 	err = p.svc.db.Collection(collectionPaylinks).Update(dbQuery, set)
 	if err != nil {
@@ -888,6 +888,20 @@ func (suite *PaylinkTestSuite) Test_Paylink_GetPaylinkURL_Ok_OnlyRequiredParams(
 	assert.Equal(suite.T(), res.Url, "/paylink/"+suite.paylink1.Id)
 }
 
+func (suite *PaylinkTestSuite) Test_Paylink_GetPaylinkURL_Ok_WithToken() {
+	req := &grpc.GetPaylinkURLRequest{
+		Id:         suite.paylink1.Id,
+		MerchantId: suite.paylink1.MerchantId,
+		Token:      "signed-token",
+	}
+
+	res := &grpc.GetPaylinkUrlResponse{}
+	err := suite.service.GetPaylinkURL(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Status, pkg.ResponseStatusOk)
+	assert.Equal(suite.T(), res.Url, "/paylink/"+suite.paylink1.Id+"?token=signed-token")
+}
+
 func (suite *PaylinkTestSuite) Test_Paylink_GetPaylinkURL_Fail_Deleted() {
 	req := &grpc.GetPaylinkURLRequest{
 		Id:         suite.paylink2.Id,
@@ -1531,3 +1545,104 @@ func (suite *PaylinkTestSuite) Test_Paylink_GetPaylinkVisits_Ok() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), n, 0)
 }
+
+func (suite *PaylinkTestSuite) Test_Paylink_CreatePaylinkToken_Ok() {
+	req := &paylink.CreatePaylinkTokenRequest{
+		PaylinkId:       suite.paylink1.Id,
+		MerchantId:      suite.paylink1.MerchantId,
+		ExpiresAt:       time.Now().Add(24 * time.Hour).Unix(),
+		MaxPurchases:    2,
+		CustomerEmail:   "buyer@unit.test",
+		CustomerCountry: "de",
+		DiscountPercent: 15,
+	}
+
+	res := &grpc.CreatePaylinkTokenResponse{}
+	err := suite.service.CreatePaylinkToken(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.NotEmpty(suite.T(), res.Token)
+	assert.Equal(suite.T(), "DE", res.Item.CustomerCountry)
+
+	token, err := suite.service.paylinkService.GetTokenById(res.Item.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.paylink1.Id, token.PaylinkId)
+	assert.Equal(suite.T(), int32(2), token.MaxPurchases)
+	assert.Equal(suite.T(), "buyer@unit.test", token.CustomerEmail)
+	assert.Equal(suite.T(), float64(15), token.DiscountPercent)
+
+	checked, err := suite.service.checkPaylinkToken(suite.paylink1, res.Token)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Item.Id, checked.Id)
+	assert.Equal(suite.T(), int32(0), checked.Purchases)
+}
+
+func (suite *PaylinkTestSuite) Test_Paylink_CreatePaylinkToken_Fail_ExpiresInPast() {
+	req := &paylink.CreatePaylinkTokenRequest{
+		PaylinkId:  suite.paylink1.Id,
+		MerchantId: suite.paylink1.MerchantId,
+		ExpiresAt:  time.Now().Add(-1 * time.Hour).Unix(),
+	}
+
+	res := &grpc.CreatePaylinkTokenResponse{}
+	err := suite.service.CreatePaylinkToken(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, res.Status)
+	assert.Equal(suite.T(), errorPaylinkTokenExpiresInPast, res.Message)
+	assert.Empty(suite.T(), res.Token)
+}
+
+func (suite *PaylinkTestSuite) Test_Paylink_CreatePaylinkToken_Fail_PaylinkExpired() {
+	req := &paylink.CreatePaylinkTokenRequest{
+		PaylinkId:  suite.paylink3.Id,
+		MerchantId: suite.paylink3.MerchantId,
+		ExpiresAt:  time.Now().Add(1 * time.Hour).Unix(),
+	}
+
+	res := &grpc.CreatePaylinkTokenResponse{}
+	err := suite.service.CreatePaylinkToken(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusGone, res.Status)
+	assert.Equal(suite.T(), errorPaylinkExpired, res.Message)
+}
+
+func (suite *PaylinkTestSuite) Test_Paylink_CreatePaylinkToken_Fail_MerchantMismatch() {
+	req := &paylink.CreatePaylinkTokenRequest{
+		PaylinkId:  suite.paylink1.Id,
+		MerchantId: bson.NewObjectId().Hex(),
+		ExpiresAt:  time.Now().Add(1 * time.Hour).Unix(),
+	}
+
+	res := &grpc.CreatePaylinkTokenResponse{}
+	err := suite.service.CreatePaylinkToken(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, res.Status)
+	assert.Equal(suite.T(), errorPaylinkNotFound, res.Message)
+}
+
+func (suite *PaylinkTestSuite) Test_Paylink_GetPaylinkStatTotal_Ok_Tokens() {
+	tokenReq := &paylink.CreatePaylinkTokenRequest{
+		PaylinkId:    suite.paylink1.Id,
+		MerchantId:   suite.paylink1.MerchantId,
+		ExpiresAt:    time.Now().Add(1 * time.Hour).Unix(),
+		MaxPurchases: 3,
+	}
+	tokenRes := &grpc.CreatePaylinkTokenResponse{}
+	err := suite.service.CreatePaylinkToken(context.TODO(), tokenReq, tokenRes)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, tokenRes.Status)
+
+	req := &grpc.GetPaylinkStatCommonRequest{
+		Id:         suite.paylink1.Id,
+		MerchantId: suite.paylink1.MerchantId,
+	}
+
+	res := &grpc.GetPaylinkStatCommonResponse{}
+	err = suite.service.GetPaylinkStatTotal(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, res.Status)
+	assert.Len(suite.T(), res.Tokens, 1)
+	assert.Equal(suite.T(), tokenRes.Item.Id, res.Tokens[0].Id)
+	assert.Equal(suite.T(), int32(3), res.Tokens[0].MaxPurchases)
+	assert.Equal(suite.T(), int32(0), res.Tokens[0].Purchases)
+}
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/paylink"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	orderPrivateMetadataPaylinkTokenId       = "PaylinkTokenId"
+	orderPrivateMetadataPaylinkTokenPurchase = "PaylinkTokenPurchase"
+
+	paylinkTokenPurchaseReserved = "reserved"
+	paylinkTokenPurchaseReleased = "released"
+)
+
+var (
+	// paylink token purchase and promo code use reserved by order are returned when order gets one of these statuses
+	orderReservedUseReleaseStatuses = map[string]bool{
+		constant.OrderPublicStatusCanceled:   true,
+		constant.OrderPublicStatusRejected:   true,
+		constant.OrderPublicStatusRefunded:   true,
+		constant.OrderPublicStatusChargeback: true,
+	}
+)
+
+// CreatePaylinkToken issues signed token to use paylink by one buyer.
+// Token has own expiry date and can limit number of successful purchases,
+// preset customer data and discount of token are applied to orders created with token.
+func (s *Service) CreatePaylinkToken(
+	ctx context.Context,
+	req *paylink.CreatePaylinkTokenRequest,
+	res *grpc.CreatePaylinkTokenResponse,
+) error {
+	pl, err := s.paylinkService.GetByIdAndMerchant(req.PaylinkId, req.MerchantId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			res.Status = pkg.ResponseStatusNotFound
+			res.Message = errorPaylinkNotFound
+			return nil
+		}
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			res.Status = pkg.ResponseStatusBadData
+			res.Message = e
+			return nil
+		}
+		return err
+	}
+
+	if pl.GetIsExpired() {
+		res.Status = pkg.ResponseStatusGone
+		res.Message = errorPaylinkExpired
+		return nil
+	}
+
+	expiresAt := time.Unix(req.ExpiresAt, 0)
+
+	if time.Now().After(expiresAt) {
+		res.Status = pkg.ResponseStatusBadData
+		res.Message = errorPaylinkTokenExpiresInPast
+		return nil
+	}
+
+	token := &paylink.PaylinkToken{
+		Id:              bson.NewObjectId().Hex(),
+		PaylinkId:       pl.Id,
+		MerchantId:      pl.MerchantId,
+		MaxPurchases:    req.MaxPurchases,
+		CustomerEmail:   req.CustomerEmail,
+		CustomerCountry: strings.ToUpper(req.CustomerCountry),
+		DiscountPercent: req.DiscountPercent,
+		CreatedAt:       ptypes.TimestampNow(),
+	}
+
+	token.ExpiresAt, err = ptypes.TimestampProto(expiresAt)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorTimeConversion,
+			zap.Any(pkg.ErrorTimeConversionMethod, "ptypes.TimestampProto"),
+			zap.Any(pkg.ErrorTimeConversionValue, expiresAt),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	signed, err := s.signPaylinkToken(token)
+	if err != nil {
+		zap.L().Error("Paylink token sign failed", zap.Error(err), zap.String("paylink_id", pl.Id))
+		return err
+	}
+
+	if err = s.paylinkService.InsertToken(token); err != nil {
+		return err
+	}
+
+	res.Item = token
+	res.Token = signed
+	res.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// signPaylinkToken returns token signed with paylink tokens secret.
+// Signed token holds identifier of token, paylink and expiry date only,
+// purchases limit, customer data and discount are stored by billing server.
+func (s *Service) signPaylinkToken(token *paylink.PaylinkToken) (string, error) {
+	expiresAt, err := ptypes.Timestamp(token.ExpiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{"jti": token.Id, "sub": token.PaylinkId, "exp": expiresAt.Unix()}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.cfg.PaylinkTokenSecret))
+}
+
+// checkPaylinkToken verifies signature and expiry date of signed token for paylink
+// and checks that purchases limit of token is not reached. The limit is enforced again on payment
+// by reserveOrderPaylinkTokenPurchase, so parallel orders created by token can't exceed it.
+// Nil token returned if token is not passed and paylink can be used without token.
+func (s *Service) checkPaylinkToken(pl *paylink.Paylink, signed string) (*paylink.PaylinkToken, error) {
+	if signed == "" {
+		if pl.TokenRequired {
+			return nil, errorPaylinkTokenRequired
+		}
+		return nil, nil
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(signed, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errorPaylinkTokenInvalid
+		}
+		return []byte(s.cfg.PaylinkTokenSecret), nil
+	})
+
+	if err != nil {
+		if e, ok := err.(*jwt.ValidationError); ok && e.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, errorPaylinkTokenExpired
+		}
+		return nil, errorPaylinkTokenInvalid
+	}
+
+	id, _ := claims["jti"].(string)
+	paylinkId, _ := claims["sub"].(string)
+
+	if paylinkId != pl.Id || bson.IsObjectIdHex(id) == false {
+		return nil, errorPaylinkTokenInvalid
+	}
+
+	token, err := s.paylinkService.GetTokenById(id)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, errorPaylinkTokenInvalid
+		}
+		return nil, err
+	}
+
+	if token.PaylinkId != pl.Id {
+		return nil, errorPaylinkTokenInvalid
+	}
+
+	if token.MaxPurchases <= 0 {
+		return token, nil
+	}
+
+	purchases, err := s.paylinkService.GetTokenPurchases([]string{token.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	token.Purchases = purchases[token.Id]
+
+	if token.Purchases >= token.MaxPurchases {
+		return nil, errorPaylinkTokenPurchasesLimitReached
+	}
+
+	return token, nil
+}
+
+// reserveOrderPaylinkTokenPurchase counts payment of order by paylink token against purchases limit of token.
+// Counter of token is increased with conditional update, so parallel payments can't exceed the limit.
+// Order keeps mark of reserved purchase, so repeated payment attempts for the same order reserve it once.
+// Purchase released after rejected attempt is reserved again on retry.
+func (s *Service) reserveOrderPaylinkTokenPurchase(order *billing.Order) error {
+	id := order.PrivateMetadata[orderPrivateMetadataPaylinkTokenId]
+
+	if id == "" || order.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase] == paylinkTokenPurchaseReserved {
+		return nil
+	}
+
+	if bson.IsObjectIdHex(id) == false {
+		return errorPaylinkTokenInvalid
+	}
+
+	token, err := s.paylinkService.GetTokenById(id)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return errorPaylinkTokenInvalid
+		}
+		return err
+	}
+
+	if token.MaxPurchases <= 0 {
+		return nil
+	}
+
+	query := bson.M{
+		"_id":                bson.ObjectIdHex(token.Id),
+		"purchases_reserved": bson.M{"$not": bson.M{"$gte": token.MaxPurchases}},
+	}
+	update := bson.M{"$inc": bson.M{"purchases_reserved": 1}}
+	err = s.db.Collection(collectionPaylinkTokens).Update(query, update)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return errorPaylinkTokenPurchasesLimitReached
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPaylinkTokens),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, update),
+		)
+		return err
+	}
+
+	order.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase] = paylinkTokenPurchaseReserved
+
+	if err = s.updateOrderPrivateMetadata(order); err != nil {
+		delete(order.PrivateMetadata, orderPrivateMetadataPaylinkTokenPurchase)
+		s.decrementPaylinkTokenPurchases(token.Id)
+		return err
+	}
+
+	return nil
+}
+
+// releaseOrderPaylinkTokenPurchase returns purchase reserved by order to paylink token.
+// Mark of order is changed with conditional update, so purchase is returned once.
+func (s *Service) releaseOrderPaylinkTokenPurchase(order *billing.Order) {
+	if order.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase] != paylinkTokenPurchaseReserved {
+		return
+	}
+
+	field := "private_metadata." + orderPrivateMetadataPaylinkTokenPurchase
+	query := bson.M{"_id": bson.ObjectIdHex(order.Id), field: paylinkTokenPurchaseReserved}
+	err := s.db.Collection(collectionOrder).Update(query, bson.M{"$set": bson.M{field: paylinkTokenPurchaseReleased}})
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+		return
+	}
+
+	order.PrivateMetadata[orderPrivateMetadataPaylinkTokenPurchase] = paylinkTokenPurchaseReleased
+	s.decrementPaylinkTokenPurchases(order.PrivateMetadata[orderPrivateMetadataPaylinkTokenId])
+}
+
+// ReleaseExpiredOrderReservations returns paylink token purchases and promo code uses reserved by orders
+// which are left in created status longer than release period, so abandoned payment doesn't block
+// single-use token or promo code forever
+func (s *Service) ReleaseExpiredOrderReservations() error {
+	query := bson.M{
+		"private_status": bson.M{"$in": []int32{constant.OrderStatusNew, constant.OrderStatusPaymentSystemCreate}},
+		"created_at": bson.M{
+			"$lte": time.Now().Add(-time.Duration(s.cfg.OrderReservationReleasePeriod) * time.Second),
+		},
+		"$or": []bson.M{
+			{"private_metadata." + orderPrivateMetadataPaylinkTokenPurchase: paylinkTokenPurchaseReserved},
+			{"private_metadata." + orderPrivateMetadataPromoCodeUse: promoCodeUseReserved},
+		},
+	}
+
+	var orders []*billing.Order
+	err := s.db.Collection(collectionOrder).Find(query).All(&orders)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	for _, order := range orders {
+		s.releaseOrderPaylinkTokenPurchase(order)
+		s.releaseOrderPromoCodeUse(order)
+	}
+
+	return nil
+}
+
+func (s *Service) decrementPaylinkTokenPurchases(id string) {
+	err := s.db.Collection(collectionPaylinkTokens).UpdateId(
+		bson.ObjectIdHex(id),
+		bson.M{"$inc": bson.M{"purchases_reserved": -1}},
+	)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPaylinkTokens),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, id),
+		)
+	}
+}
+
+func (s *Service) getPaylinkTokensWithPurchases(paylinkId string) ([]*paylink.PaylinkToken, error) {
+	tokens, err := s.paylinkService.GetTokensByPaylink(paylinkId)
+	if err != nil || len(tokens) <= 0 {
+		return nil, err
+	}
+
+	ids := make([]string, len(tokens))
+
+	for i, token := range tokens {
+		ids[i] = token.Id
+	}
+
+	purchases, err := s.paylinkService.GetTokenPurchases(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		token.Purchases = purchases[token.Id]
+	}
+
+	return tokens, nil
+}
+
+func (p Paylink) InsertToken(token *paylink.PaylinkToken) (err error) {
+	err = p.svc.db.Collection(collectionPaylinkTokens).Insert(token)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPaylinkTokens),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, token),
+		)
+	}
+	return
+}
+
+func (p Paylink) GetTokenById(id string) (token *paylink.PaylinkToken, err error) {
+	query := bson.M{"_id": bson.ObjectIdHex(id)}
+	err = p.svc.db.Collection(collectionPaylinkTokens).Find(query).One(&token)
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPaylinkTokens),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+	}
+	return
+}
+
+func (p Paylink) GetTokensByPaylink(paylinkId string) (tokens []*paylink.PaylinkToken, err error) {
+	query := bson.M{"paylink_id": bson.ObjectIdHex(paylinkId)}
+	err = p.svc.db.Collection(collectionPaylinkTokens).Find(query).Sort("_id").All(&tokens)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPaylinkTokens),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+	}
+	return
+}
+
+// GetTokenPurchases returns number of successful purchases by paylink tokens
+func (p Paylink) GetTokenPurchases(ids []string) (map[string]int32, error) {
+	var items []struct {
+		Id    string `bson:"_id"`
+		Count int32  `bson:"count"`
+	}
+
+	query := []bson.M{
+		{
+			"$match": bson.M{
+				"private_metadata." + orderPrivateMetadataPaylinkTokenId: bson.M{"$in": ids},
+				"status": constant.OrderPublicStatusProcessed,
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id":   "$private_metadata." + orderPrivateMetadataPaylinkTokenId,
+				"count": bson.M{"$sum": 1},
+			},
+		},
+	}
+
+	err := p.svc.db.Collection(collectionOrder).Pipe(query).All(&items)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	purchases := make(map[string]int32, len(items))
+
+	for _, item := range items {
+		purchases[item.Id] = item.Count
+	}
+
+	return purchases, nil
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	paymentRouteAttemptStatusOk     = "ok"
+	paymentRouteAttemptStatusFailed = "failed"
+
+	collectionRecurringPaymentSystem = "recurring_payment_system"
+)
+
+var (
+	paymentRouteRandMu sync.Mutex
+	paymentRouteRand   = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	// errors of payment system after which payment will be created with next payment route.
+	// Payment request wasn't sent or was explicitly rejected by payment system, so customer can't be charged
+	// by two payment systems. Other errors can happen after payment is created, they are never failed over.
+	paymentRouteFailoverErrors = map[string]bool{
+		paymentSystemErrorHandlerNotFound.Code:       true,
+		paymentSystemErrorAuthenticateFailed.Code:    true,
+		paymentSystemErrorRequestNotSent.Code:        true,
+		paymentSystemErrorCreateRequestRejected.Code: true,
+	}
+)
+
+// recurringPaymentSystem links recurring id of saved bank card with payment system which tokenized the card
+type recurringPaymentSystem struct {
+	Id              bson.ObjectId `bson:"_id"`
+	RecurringId     string        `bson:"recurring_id"`
+	PaymentSystemId string        `bson:"payment_system_id"`
+	ProjectId       string        `bson:"project_id"`
+	CreatedAt       time.Time     `bson:"created_at"`
+}
+
+type paymentRoute struct {
+	paymentSystem *billing.PaymentSystem
+	priority      int32
+	weight        int32
+	settings      *billing.PaymentMethodParams
+}
+
+// getPaymentRoutes return list of payment systems which can process payment by order's payment method
+// in order of attempts to create payment. If payment method hasn't routes then payment will be
+// processed by payment system from payment method settings
+func (s *Service) getPaymentRoutes(
+	order *billing.Order,
+	pm *billing.PaymentMethod,
+	project *billing.Project,
+) ([]*paymentRoute, error) {
+	settings, err := s.paymentMethod.GetPaymentSettings(pm, order.Currency, project)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pm.Routes) <= 0 {
+		ps, err := s.paymentSystem.GetById(pm.PaymentSystemId)
+
+		if err != nil {
+			return nil, orderErrorPaymentSystemInactive
+		}
+
+		route := &paymentRoute{paymentSystem: ps, settings: s.getPaymentRouteSettings(ps, settings, nil, project)}
+
+		return []*paymentRoute{route}, nil
+	}
+
+	var routes []*paymentRoute
+
+	for _, v := range pm.Routes {
+		if !isPaymentRouteMatch(v, order) {
+			continue
+		}
+
+		ps, err := s.paymentSystem.GetById(v.PaymentSystemId)
+
+		if err != nil {
+			zap.L().Warn(
+				"Payment system for payment route not found",
+				zap.String("payment_method_id", pm.Id),
+				zap.String("payment_system_id", v.PaymentSystemId),
+			)
+			continue
+		}
+
+		routeSettings := v.TestSettings
+
+		if project.IsProduction() == true {
+			routeSettings = v.ProductionSettings
+		}
+
+		routes = append(routes, &paymentRoute{
+			paymentSystem: ps,
+			priority:      v.Priority,
+			weight:        v.Weight,
+			settings:      s.getPaymentRouteSettings(ps, settings, routeSettings[order.Currency], project),
+		})
+	}
+
+	if len(routes) <= 0 {
+		return nil, orderErrorPaymentRouteNotFound
+	}
+
+	return sortPaymentRoutes(routes), nil
+}
+
+// getPaymentRouteSettings return copy of payment method settings replaced by route settings
+// if route has complete settings for order currency
+func (s *Service) getPaymentRouteSettings(
+	ps *billing.PaymentSystem,
+	pmSettings, routeSettings *billing.PaymentMethodParams,
+	project *billing.Project,
+) *billing.PaymentMethodParams {
+	settings := proto.Clone(pmSettings).(*billing.PaymentMethodParams)
+
+	if routeSettings != nil && routeSettings.IsSettingComplete() {
+		settings.TerminalId = routeSettings.TerminalId
+		settings.Secret = routeSettings.Secret
+		settings.SecretCallback = routeSettings.SecretCallback
+	}
+
+	switch ps.Handler {
+	case pkg.PaymentSystemHandlerStripe:
+		settings.ApiUrl = s.cfg.StripeApiUrl
+	default:
+		settings.ApiUrl = s.cfg.CardPayApiSandboxUrl
+
+		if project.IsProduction() == true {
+			settings.ApiUrl = s.cfg.CardPayApiUrl
+		}
+	}
+
+	return settings
+}
+
+func (s *Service) createPaymentByRoutes(
+	order *billing.Order,
+	routes []*paymentRoute,
+	requisites map[string]string,
+) (string, error) {
+	for i, route := range routes {
+		order.PaymentMethod.PaymentSystemId = route.paymentSystem.Id
+		order.PaymentMethod.Handler = route.paymentSystem.Handler
+		order.PaymentMethod.Params = route.settings
+
+		attempt := &billing.OrderPaymentRouteAttempt{
+			PaymentSystemId: route.paymentSystem.Id,
+			Handler:         route.paymentSystem.Handler,
+			Priority:        route.priority,
+			Status:          paymentRouteAttemptStatusOk,
+			CreatedAt:       ptypes.TimestampNow(),
+		}
+		order.PaymentRouteAttempts = append(order.PaymentRouteAttempts, attempt)
+
+		url, err := s.createPaymentByRoute(order, requisites)
+
+		if err == nil {
+			return url, nil
+		}
+
+		attempt.Status = paymentRouteAttemptStatusFailed
+		attempt.Error = err.Error()
+
+		if i == len(routes)-1 || !isPaymentRouteFailoverError(err) {
+			return "", err
+		}
+
+		zap.L().Warn(
+			"Payment creation failed, try next payment route",
+			zap.Error(err),
+			zap.String("order_id", order.Id),
+			zap.String("payment_system_id", route.paymentSystem.Id),
+		)
+	}
+
+	return "", orderErrorPaymentRouteNotFound
+}
+
+func (s *Service) createPaymentByRoute(order *billing.Order, requisites map[string]string) (string, error) {
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		return "", err
+	}
+
+	return h.CreatePayment(order, s.cfg.GetRedirectUrlSuccess(nil), s.cfg.GetRedirectUrlFail(nil), requisites)
+}
+
+func isPaymentRouteMatch(route *billing.PaymentMethodRoute, order *billing.Order) bool {
+	if len(route.Countries) > 0 && !contains(route.Countries, order.GetCountry()) {
+		return false
+	}
+
+	if len(route.Currencies) > 0 && !contains(route.Currencies, order.Currency) {
+		return false
+	}
+
+	if route.MinAmount > 0 && order.OrderAmount < route.MinAmount {
+		return false
+	}
+
+	if route.MaxAmount > 0 && order.OrderAmount > route.MaxAmount {
+		return false
+	}
+
+	return true
+}
+
+func isPaymentRouteFailoverError(err error) bool {
+	e, ok := err.(*grpc.ResponseErrorMessage)
+
+	if !ok {
+		return false
+	}
+
+	return paymentRouteFailoverErrors[e.Code]
+}
+
+// sortPaymentRoutes sort routes by priority. Routes with the same priority
+// shuffled randomly with probability proportional to route weight
+func sortPaymentRoutes(routes []*paymentRoute) []*paymentRoute {
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].priority < routes[j].priority
+	})
+
+	result := make([]*paymentRoute, 0, len(routes))
+
+	for i := 0; i < len(routes); {
+		j := i
+
+		for j < len(routes) && routes[j].priority == routes[i].priority {
+			j++
+		}
+
+		result = append(result, shufflePaymentRoutesByWeight(routes[i:j])...)
+		i = j
+	}
+
+	return result
+}
+
+func shufflePaymentRoutesByWeight(routes []*paymentRoute) []*paymentRoute {
+	rest := make([]*paymentRoute, len(routes))
+	copy(rest, routes)
+
+	result := make([]*paymentRoute, 0, len(routes))
+
+	paymentRouteRandMu.Lock()
+	defer paymentRouteRandMu.Unlock()
+
+	for len(rest) > 0 {
+		total := 0
+
+		for _, v := range rest {
+			if v.weight > 0 {
+				total += int(v.weight)
+			}
+		}
+
+		idx := 0
+
+		if total > 0 {
+			n := paymentRouteRand.Intn(total)
+
+			for k, v := range rest {
+				if v.weight <= 0 {
+					continue
+				}
+
+				if n < int(v.weight) {
+					idx = k
+					break
+				}
+
+				n -= int(v.weight)
+			}
+		}
+
+		result = append(result, rest[idx])
+		rest = append(rest[:idx], rest[idx+1:]...)
+	}
+
+	return result
+}
+
+// getRecurringPaymentRoutes return routes of payment system which tokenized saved bank card.
+// Cards saved before payment routing was introduced were tokenized by payment system of payment method
+func (s *Service) getRecurringPaymentRoutes(
+	routes []*paymentRoute,
+	recurringId string,
+	pm *billing.PaymentMethod,
+) ([]*paymentRoute, error) {
+	query := bson.M{"recurring_id": recurringId}
+
+	var link *recurringPaymentSystem
+	err := s.db.Collection(collectionRecurringPaymentSystem).Find(query).Sort("-created_at").One(&link)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRecurringPaymentSystem),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, orderErrorUnknown
+	}
+
+	paymentSystemId := pm.PaymentSystemId
+
+	if link != nil {
+		paymentSystemId = link.PaymentSystemId
+	}
+
+	for _, route := range routes {
+		if route.paymentSystem.Id == paymentSystemId {
+			return []*paymentRoute{route}, nil
+		}
+	}
+
+	return nil, orderErrorRecurringPaymentRouteNotFound
+}
+
+func (s *Service) saveRecurringPaymentSystem(order *billing.Order, recurringId string) error {
+	link := &recurringPaymentSystem{
+		Id:              bson.NewObjectId(),
+		RecurringId:     recurringId,
+		PaymentSystemId: order.PaymentMethod.PaymentSystemId,
+		ProjectId:       order.Project.Id,
+		CreatedAt:       time.Now(),
+	}
+	err := s.db.Collection(collectionRecurringPaymentSystem).Insert(link)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRecurringPaymentSystem),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, link),
+		)
+	}
+
+	return err
+}
@@ -11,9 +11,10 @@ import (
 )
 
 const (
-	paymentSystemHandlerMockOk      = "mock_ok"
-	paymentSystemHandlerMockError   = "mock_error"
-	paymentSystemHandlerCardPayMock = "cardpay_mock"
+	paymentSystemHandlerMockOk          = "mock_ok"
+	paymentSystemHandlerMockError       = "mock_error"
+	paymentSystemHandlerMockCreateError = "mock_create_error"
+	paymentSystemHandlerCardPayMock     = "cardpay_mock"
 
 	defaultHttpClientTimeout = 10
 
@@ -36,12 +37,20 @@ var (
 	paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid = newBillingServerErrorMsg("ph000012", "amount or currency from request not match with value in refund")
 	paymentSystemErrorRequestTemporarySkipped                = newBillingServerErrorMsg("ph000013", "notification skipped with temporary status")
 	paymentSystemErrorRecurringFailed                        = newBillingServerErrorMsg("ph000014", "recurring payment failed")
-
-	paymentSystemHandlers = map[string]func() PaymentSystem{
-		pkg.PaymentSystemHandlerCardPay: newCardPayHandler,
-		paymentSystemHandlerMockOk:      NewPaymentSystemMockOk,
-		paymentSystemHandlerMockError:   NewPaymentSystemMockError,
-		paymentSystemHandlerCardPayMock: NewCardPayMock,
+	paymentSystemErrorRequestOrderIdIsInvalid                = newBillingServerErrorMsg("ph000015", "order identifier from request not match with value in order")
+	paymentSystemErrorRequestTransactionIsInvalid            = newBillingServerErrorMsg("ph000016", "transaction identifier from request not match with value in order")
+	paymentSystemErrorCaptureFailed                          = newBillingServerErrorMsg("ph000017", "authorized payment can't be captured. try request later")
+	paymentSystemErrorVoidFailed                             = newBillingServerErrorMsg("ph000018", "authorized payment can't be voided. try request later")
+	paymentSystemErrorRequestNotSent                         = newBillingServerErrorMsg("ph000019", "request isn't sent to payment system")
+	paymentSystemErrorCreateRequestRejected                  = newBillingServerErrorMsg("ph000020", "payment rejected by payment system")
+
+	paymentSystemHandlers = map[string]func(cfg *config.PaymentSystemConfig) PaymentSystem{
+		pkg.PaymentSystemHandlerCardPay:     newCardPayHandler,
+		pkg.PaymentSystemHandlerStripe:      newStripeHandler,
+		paymentSystemHandlerMockOk:          NewPaymentSystemMockOk,
+		paymentSystemHandlerMockError:       NewPaymentSystemMockError,
+		paymentSystemHandlerMockCreateError: NewPaymentSystemMockCreateError,
+		paymentSystemHandlerCardPayMock:     NewCardPayMock,
 	}
 )
 
@@ -52,6 +61,8 @@ type PaymentSystem interface {
 	GetRecurringId(request proto.Message) string
 	CreateRefund(order *billing.Order, refund *billing.Refund) error
 	ProcessRefund(order *billing.Order, refund *billing.Refund, message proto.Message, raw, signature string) error
+	CapturePayment(order *billing.Order) error
+	VoidPayment(order *billing.Order) error
 }
 
 func (s *Service) NewPaymentSystem(
@@ -70,7 +81,7 @@ func (s *Service) NewPaymentSystem(
 		return nil, paymentSystemErrorHandlerNotFound
 	}
 
-	return h(), nil
+	return h(cfg), nil
 }
 
 type PaymentSystemServiceInterface interface {
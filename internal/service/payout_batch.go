@@ -0,0 +1,821 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	collectionPayoutBatch = "payout_batch"
+
+	payoutBatchSepaCurrency         = "EUR"
+	payoutBatchSepaPainNamespace    = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+	payoutBatchRemittanceInfoMaxLen = 140
+
+	payoutBatchCsvColumnEndToEndId         = "end_to_end_id"
+	payoutBatchCsvColumnBatchId            = "batch_id"
+	payoutBatchCsvColumnBeneficiaryName    = "beneficiary_name"
+	payoutBatchCsvColumnBeneficiaryAccount = "beneficiary_account"
+	payoutBatchCsvColumnBeneficiaryBic     = "beneficiary_bic"
+	payoutBatchCsvColumnBankName           = "bank_name"
+	payoutBatchCsvColumnBankAddress        = "bank_address"
+	payoutBatchCsvColumnCorrespondent      = "correspondent_account"
+	payoutBatchCsvColumnAmount             = "amount"
+	payoutBatchCsvColumnCurrency           = "currency"
+	payoutBatchCsvColumnRemittanceInfo     = "remittance_info"
+
+	// transaction status codes of pain.002 report
+	payoutStatusReportTxStatusSettled  = "ACSC"
+	payoutStatusReportTxStatusRejected = "RJCT"
+	// entry status code of camt.054 report
+	payoutStatusReportEntryStatusBooked = "BOOK"
+)
+
+var (
+	payoutBatchErrorFormatUnknown      = newBillingServerErrorMsg("pb000001", "payout batch format not supported")
+	payoutBatchErrorSepaCurrency       = newBillingServerErrorMsg("pb000002", "sepa credit transfer supports only EUR payouts")
+	payoutBatchErrorDocumentsNotFound  = newBillingServerErrorMsg("pb000003", "pending payout documents for batch not found")
+	payoutBatchErrorDestinationInvalid = newBillingServerErrorMsg("pb000004", "payout document hasn't bank account details")
+	payoutBatchErrorCsvColumnUnknown   = newBillingServerErrorMsg("pb000005", "unknown column in csv layout of payout batch")
+	payoutBatchErrorNotFound           = newBillingServerErrorMsg("pb000006", "payout batch not found")
+	payoutBatchErrorUnknown            = newBillingServerErrorMsg("pb000007", "unknown error. try request later")
+	payoutBatchErrorReportInvalid      = newBillingServerErrorMsg("pb000008", "bank status report can't be parsed")
+
+	payoutBatchFileExtensions = map[string]string{
+		pkg.PayoutBatchFormatSepa: "xml",
+		pkg.PayoutBatchFormatCsv:  "csv",
+	}
+
+	payoutBatchCsvColumns = map[string]func(*billing.PayoutBatch, *billing.PayoutDocument) string{
+		payoutBatchCsvColumnEndToEndId: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Id
+		},
+		payoutBatchCsvColumnBatchId: func(b *billing.PayoutBatch, _ *billing.PayoutDocument) string {
+			return b.Id
+		},
+		payoutBatchCsvColumnBeneficiaryName: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.GetCompany().GetName()
+		},
+		payoutBatchCsvColumnBeneficiaryAccount: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Destination.AccountNumber
+		},
+		payoutBatchCsvColumnBeneficiaryBic: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Destination.Swift
+		},
+		payoutBatchCsvColumnBankName: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Destination.Name
+		},
+		payoutBatchCsvColumnBankAddress: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Destination.Address
+		},
+		payoutBatchCsvColumnCorrespondent: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Destination.CorrespondentAccount
+		},
+		payoutBatchCsvColumnAmount: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return money.FromFloat(pd.Balance, pd.Currency, money.RoundHalfUp).String()
+		},
+		payoutBatchCsvColumnCurrency: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return pd.Currency
+		},
+		payoutBatchCsvColumnRemittanceInfo: func(_ *billing.PayoutBatch, pd *billing.PayoutDocument) string {
+			return getPayoutRemittanceInfo(pd)
+		},
+	}
+
+	// ISO 20022 external status reason codes mapped to failure codes of payout document
+	payoutStatusReportFailureCodes = map[string]string{
+		"AC01": "invalid_account_number",
+		"AC03": "invalid_account_number",
+		"AC04": "account_closed",
+		"AC06": "account_frozen",
+		"AG01": "account_restricted",
+		"AG02": "could_not_process",
+		"AM03": "invalid_currency",
+		"AM04": "insufficient_funds",
+		"BE01": "incorrect_account_holder_name",
+		"RC01": "destination_bank_invalid",
+		"RR01": "incorrect_account_holder_name",
+		"MS02": "declined",
+		"MS03": "could_not_process",
+	}
+)
+
+type sepaDocument struct {
+	XMLName xml.Name            `xml:"Document"`
+	Xmlns   string              `xml:"xmlns,attr"`
+	Payment sepaCustomerPayment `xml:"CstmrCdtTrfInitn"`
+}
+
+type sepaCustomerPayment struct {
+	GroupHeader sepaGroupHeader `xml:"GrpHdr"`
+	Payment     sepaPayment     `xml:"PmtInf"`
+}
+
+type sepaGroupHeader struct {
+	MessageId  string    `xml:"MsgId"`
+	CreatedAt  string    `xml:"CreDtTm"`
+	TxCount    int       `xml:"NbOfTxs"`
+	ControlSum string    `xml:"CtrlSum"`
+	Initiator  sepaParty `xml:"InitgPty"`
+}
+
+type sepaPayment struct {
+	PaymentId     string            `xml:"PmtInfId"`
+	Method        string            `xml:"PmtMtd"`
+	TxCount       int               `xml:"NbOfTxs"`
+	ControlSum    string            `xml:"CtrlSum"`
+	ServiceLevel  string            `xml:"PmtTpInf>SvcLvl>Cd"`
+	ExecutionDate string            `xml:"ReqdExctnDt"`
+	Debtor        sepaParty         `xml:"Dbtr"`
+	DebtorAccount string            `xml:"DbtrAcct>Id>IBAN"`
+	DebtorAgent   string            `xml:"DbtrAgt>FinInstnId>BIC"`
+	ChargeBearer  string            `xml:"ChrgBr"`
+	Transactions  []sepaTransaction `xml:"CdtTrfTxInf"`
+}
+
+type sepaParty struct {
+	Name string `xml:"Nm"`
+}
+
+type sepaAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type sepaTransaction struct {
+	EndToEndId     string     `xml:"PmtId>EndToEndId"`
+	Amount         sepaAmount `xml:"Amt>InstdAmt"`
+	CreditorAgent  string     `xml:"CdtrAgt>FinInstnId>BIC"`
+	Creditor       sepaParty  `xml:"Cdtr"`
+	CreditorIban   string     `xml:"CdtrAcct>Id>IBAN"`
+	RemittanceInfo string     `xml:"RmtInf>Ustrd"`
+}
+
+type payoutStatusReportDocument struct {
+	XMLName      xml.Name                      `xml:"Document"`
+	StatusReport *payoutStatusReportPain002    `xml:"CstmrPmtStsRpt"`
+	Notification *payoutStatusReportCamt054Msg `xml:"BkToCstmrDbtCdtNtfctn"`
+}
+
+type payoutStatusReportPain002 struct {
+	OriginalMessageId string                              `xml:"OrgnlGrpInfAndSts>OrgnlMsgId"`
+	GroupStatus       string                              `xml:"OrgnlGrpInfAndSts>GrpSts"`
+	GroupReason       payoutStatusReportReason            `xml:"OrgnlGrpInfAndSts>StsRsnInf"`
+	Transactions      []payoutStatusReportPain002TxStatus `xml:"OrgnlPmtInfAndSts>TxInfAndSts"`
+}
+
+type payoutStatusReportPain002TxStatus struct {
+	StatusId   string                   `xml:"StsId"`
+	EndToEndId string                   `xml:"OrgnlEndToEndId"`
+	Status     string                   `xml:"TxSts"`
+	Reason     payoutStatusReportReason `xml:"StsRsnInf"`
+}
+
+type payoutStatusReportReason struct {
+	Code           string `xml:"Rsn>Cd"`
+	AdditionalInfo string `xml:"AddtlInf"`
+}
+
+type payoutStatusReportCamt054Msg struct {
+	Notifications []struct {
+		Entries []payoutStatusReportCamt054Entry `xml:"Ntry"`
+	} `xml:"Ntfctn"`
+}
+
+type payoutStatusReportCamt054Entry struct {
+	Reference    string `xml:"AcctSvcrRef"`
+	Status       string `xml:"Sts"`
+	IsReversal   bool   `xml:"RvslInd"`
+	Transactions []struct {
+		EndToEndId string                   `xml:"Refs>EndToEndId"`
+		Reference  string                   `xml:"Refs>AcctSvcrRef"`
+		Return     payoutStatusReportReason `xml:"RtrInf"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+type payoutStatusReportItem struct {
+	endToEndId     string
+	isPaid         bool
+	reference      string
+	failureCode    string
+	failureMessage string
+}
+
+// CreatePayoutBatch collect pending payout documents in currency to bank transfer file (SEPA pain.001 or csv)
+// and move them to in progress status until bank status report will be imported
+func (s *Service) CreatePayoutBatch(
+	ctx context.Context,
+	req *grpc.CreatePayoutBatchRequest,
+	rsp *grpc.PayoutBatchResponse,
+) error {
+	ext, ok := payoutBatchFileExtensions[req.Format]
+
+	if !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = payoutBatchErrorFormatUnknown
+		return nil
+	}
+
+	currency := strings.ToUpper(req.Currency)
+
+	if req.Format == pkg.PayoutBatchFormatSepa && currency != payoutBatchSepaCurrency {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = payoutBatchErrorSepaCurrency
+		return nil
+	}
+
+	query := bson.M{
+		"status":   pkg.PayoutDocumentStatusPending,
+		"currency": currency,
+		"batch_id": bson.M{"$in": []interface{}{"", nil}},
+	}
+
+	if len(req.PayoutDocumentIds) > 0 {
+		var ids []bson.ObjectId
+
+		for _, id := range req.PayoutDocumentIds {
+			if bson.IsObjectIdHex(id) {
+				ids = append(ids, bson.ObjectIdHex(id))
+			}
+		}
+
+		query["_id"] = bson.M{"$in": ids}
+	}
+
+	documents, err := s.payoutDocument.FindByQuery(query, []string{"_id"}, 0, 0)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutBatchErrorUnknown
+		return nil
+	}
+
+	if len(documents) <= 0 {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = payoutBatchErrorDocumentsNotFound
+		return nil
+	}
+
+	batch := &billing.PayoutBatch{
+		Id:        bson.NewObjectId().Hex(),
+		Format:    req.Format,
+		Currency:  currency,
+		CreatedAt: ptypes.TimestampNow(),
+	}
+
+	for _, pd := range documents {
+		if pd.Destination == nil || pd.Destination.AccountNumber == "" ||
+			(req.Format == pkg.PayoutBatchFormatSepa && pd.Destination.Swift == "") {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = newBillingServerErrorMsg(
+				payoutBatchErrorDestinationInvalid.Code,
+				payoutBatchErrorDestinationInvalid.Message,
+				pd.Id,
+			)
+			return nil
+		}
+	}
+
+	documents, err = s.claimPayoutDocuments(batch.Id, documents)
+
+	if err != nil {
+		s.releasePayoutDocuments(batch.Id, nil, req.Ip)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutBatchErrorUnknown
+		return nil
+	}
+
+	if len(documents) <= 0 {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = payoutBatchErrorDocumentsNotFound
+		return nil
+	}
+
+	var total int64
+
+	for _, pd := range documents {
+		batch.PayoutDocumentIds = append(batch.PayoutDocumentIds, pd.Id)
+		total += money.ToMinor(pd.Balance, currency)
+	}
+
+	batch.DocumentsCount = int32(len(documents))
+	batch.TotalAmount = money.FromMinor(total, currency)
+	batch.FileName = fmt.Sprintf("payouts_%s.%s", batch.Id, ext)
+
+	if req.Format == pkg.PayoutBatchFormatSepa {
+		batch.Content, err = s.getPayoutBatchSepaContent(batch, documents)
+	} else {
+		batch.Content, err = s.getPayoutBatchCsvContent(batch, documents)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			"Payout batch file generation failed",
+			zap.Error(err),
+			zap.String("batch_id", batch.Id),
+			zap.String("format", batch.Format),
+		)
+
+		s.releasePayoutDocuments(batch.Id, nil, req.Ip)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutBatchErrorUnknown
+
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = e
+		}
+
+		return nil
+	}
+
+	hash := sha256.Sum256(batch.Content)
+	batch.Checksum = hex.EncodeToString(hash[:])
+
+	err = s.db.Collection(collectionPayoutBatch).Insert(batch)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPayoutBatch),
+			zap.String("batch_id", batch.Id),
+		)
+
+		s.releasePayoutDocuments(batch.Id, nil, req.Ip)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutBatchErrorUnknown
+		return nil
+	}
+
+	var updated []*billing.PayoutDocument
+
+	for _, pd := range documents {
+		err = s.payoutDocument.Update(pd, req.Ip, payoutChangeSourceAdmin)
+
+		if err != nil {
+			s.removePayoutBatch(batch.Id)
+			s.releasePayoutDocuments(batch.Id, updated, req.Ip)
+
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = payoutBatchErrorUnknown
+			return nil
+		}
+
+		updated = append(updated, pd)
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = batch
+
+	return nil
+}
+
+// claimPayoutDocuments move each pending payout document to batch with conditional update, so document
+// can't be included to two batches created at the same time. Documents claimed by another batch are skipped
+func (s *Service) claimPayoutDocuments(
+	batchId string,
+	documents []*billing.PayoutDocument,
+) ([]*billing.PayoutDocument, error) {
+	var claimed []*billing.PayoutDocument
+
+	for _, pd := range documents {
+		query := bson.M{
+			"_id":      bson.ObjectIdHex(pd.Id),
+			"status":   pkg.PayoutDocumentStatusPending,
+			"batch_id": bson.M{"$in": []interface{}{"", nil}},
+		}
+		set := bson.M{
+			"$set": bson.M{
+				"status":     pkg.PayoutDocumentStatusInProgress,
+				"batch_id":   batchId,
+				"updated_at": time.Now(),
+			},
+		}
+		claim := new(billing.PayoutDocument)
+		_, err := s.db.Collection(collectionPayoutDocuments).Find(query).
+			Apply(mgo.Change{Update: set, ReturnNew: true}, claim)
+
+		if err == mgo.ErrNotFound {
+			continue
+		}
+
+		if err != nil {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionPayoutDocuments),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+				zap.Any(pkg.ErrorDatabaseFieldSet, set),
+			)
+			return nil, err
+		}
+
+		claimed = append(claimed, claim)
+	}
+
+	return claimed, nil
+}
+
+// releasePayoutDocuments return documents claimed by batch to pending status when batch creation failed.
+// Documents which changes already written to history get reverting change too
+func (s *Service) releasePayoutDocuments(batchId string, updated []*billing.PayoutDocument, ip string) {
+	query := bson.M{"batch_id": batchId, "status": pkg.PayoutDocumentStatusInProgress}
+	set := bson.M{
+		"$set": bson.M{
+			"status":     pkg.PayoutDocumentStatusPending,
+			"batch_id":   "",
+			"updated_at": time.Now(),
+		},
+	}
+	_, err := s.db.Collection(collectionPayoutDocuments).UpdateAll(query, set)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPayoutDocuments),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, set),
+		)
+		return
+	}
+
+	for _, pd := range updated {
+		pd.Status = pkg.PayoutDocumentStatusPending
+		pd.BatchId = ""
+		pd.UpdatedAt = ptypes.TimestampNow()
+
+		_ = s.payoutDocument.Update(pd, ip, payoutChangeSourceAdmin)
+	}
+}
+
+func (s *Service) removePayoutBatch(batchId string) {
+	err := s.db.Collection(collectionPayoutBatch).RemoveId(bson.ObjectIdHex(batchId))
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPayoutBatch),
+			zap.String("batch_id", batchId),
+		)
+	}
+}
+
+func (s *Service) GetPayoutBatch(
+	ctx context.Context,
+	req *grpc.GetPayoutBatchRequest,
+	rsp *grpc.PayoutBatchResponse,
+) error {
+	batch, err := s.getPayoutBatch(req.BatchId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = batch
+
+	return nil
+}
+
+// ImportPayoutStatusReport parse bank status report (camt.054 debit notification or pain.002 payment
+// status report) and mark payout documents from report as paid or failed
+func (s *Service) ImportPayoutStatusReport(
+	ctx context.Context,
+	req *grpc.ImportPayoutStatusReportRequest,
+	rsp *grpc.ImportPayoutStatusReportResponse,
+) error {
+	items, err := s.parsePayoutStatusReport(req.Content)
+
+	if err != nil {
+		zap.L().Error(
+			"Bank status report parsing failed",
+			zap.Error(err),
+			zap.String("file_name", req.FileName),
+		)
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = payoutBatchErrorReportInvalid
+		return nil
+	}
+
+	for _, item := range items {
+		if !bson.IsObjectIdHex(item.endToEndId) {
+			rsp.Unmatched = append(rsp.Unmatched, item.endToEndId)
+			continue
+		}
+
+		pd, err := s.payoutDocument.GetById(item.endToEndId)
+
+		if err != nil {
+			if err != mgo.ErrNotFound {
+				rsp.Status = pkg.ResponseStatusSystemError
+				rsp.Message = payoutBatchErrorUnknown
+				return nil
+			}
+
+			rsp.Unmatched = append(rsp.Unmatched, item.endToEndId)
+			continue
+		}
+
+		if pd.Status != pkg.PayoutDocumentStatusInProgress && pd.Status != pkg.PayoutDocumentStatusPending {
+			rsp.Skipped++
+			continue
+		}
+
+		updateReq := &grpc.UpdatePayoutDocumentRequest{
+			PayoutDocumentId: pd.Id,
+			Status:           pkg.PayoutDocumentStatusPaid,
+			Transaction:      item.reference,
+			Ip:               req.Ip,
+		}
+
+		if !item.isPaid {
+			updateReq.Status = pkg.PayoutDocumentStatusFailed
+			updateReq.Transaction = ""
+			updateReq.FailureCode = item.failureCode
+			updateReq.FailureMessage = item.failureMessage
+			updateReq.FailureTransaction = item.reference
+		}
+
+		updateRsp := &grpc.PayoutDocumentResponse{}
+		err = s.UpdatePayoutDocument(ctx, updateReq, updateRsp)
+
+		if err != nil || updateRsp.Status != pkg.ResponseStatusOk {
+			zap.L().Error(
+				"Payout document status update by bank report failed",
+				zap.Error(err),
+				zap.String("payout_document_id", pd.Id),
+				zap.Any("response", updateRsp),
+			)
+
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = payoutBatchErrorUnknown
+			return nil
+		}
+
+		if item.isPaid {
+			rsp.Paid++
+		} else {
+			rsp.Failed++
+		}
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+func (s *Service) getPayoutBatch(id string) (*billing.PayoutBatch, error) {
+	if !bson.IsObjectIdHex(id) {
+		return nil, payoutBatchErrorNotFound
+	}
+
+	var batch *billing.PayoutBatch
+	err := s.db.Collection(collectionPayoutBatch).FindId(bson.ObjectIdHex(id)).One(&batch)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionPayoutBatch),
+				zap.String("batch_id", id),
+			)
+		}
+
+		return nil, payoutBatchErrorNotFound
+	}
+
+	return batch, nil
+}
+
+func (s *Service) getPayoutBatchSepaContent(
+	batch *billing.PayoutBatch,
+	documents []*billing.PayoutDocument,
+) ([]byte, error) {
+	createdAt, err := ptypes.Timestamp(batch.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	controlSum := money.New(money.ToMinor(batch.TotalAmount, batch.Currency), batch.Currency).String()
+	doc := &sepaDocument{
+		Xmlns: payoutBatchSepaPainNamespace,
+		Payment: sepaCustomerPayment{
+			GroupHeader: sepaGroupHeader{
+				MessageId:  batch.Id,
+				CreatedAt:  createdAt.UTC().Format("2006-01-02T15:04:05"),
+				TxCount:    len(documents),
+				ControlSum: controlSum,
+				Initiator:  sepaParty{Name: s.cfg.PayoutBatchDebtorName},
+			},
+			Payment: sepaPayment{
+				PaymentId:     batch.Id,
+				Method:        "TRF",
+				TxCount:       len(documents),
+				ControlSum:    controlSum,
+				ServiceLevel:  "SEPA",
+				ExecutionDate: createdAt.UTC().Format("2006-01-02"),
+				Debtor:        sepaParty{Name: s.cfg.PayoutBatchDebtorName},
+				DebtorAccount: s.cfg.PayoutBatchDebtorIban,
+				DebtorAgent:   s.cfg.PayoutBatchDebtorBic,
+				ChargeBearer:  "SLEV",
+			},
+		},
+	}
+
+	for _, pd := range documents {
+		tx := sepaTransaction{
+			EndToEndId: pd.Id,
+			Amount: sepaAmount{
+				Currency: batch.Currency,
+				Value:    money.FromFloat(pd.Balance, batch.Currency, money.RoundHalfUp).String(),
+			},
+			CreditorAgent:  pd.Destination.Swift,
+			Creditor:       sepaParty{Name: pd.GetCompany().GetName()},
+			CreditorIban:   strings.Replace(pd.Destination.AccountNumber, " ", "", -1),
+			RemittanceInfo: getPayoutRemittanceInfo(pd),
+		}
+		doc.Payment.Payment.Transactions = append(doc.Payment.Payment.Transactions, tx)
+	}
+
+	b, err := xml.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+func (s *Service) getPayoutBatchCsvContent(
+	batch *billing.PayoutBatch,
+	documents []*billing.PayoutDocument,
+) ([]byte, error) {
+	var columns []func(*billing.PayoutBatch, *billing.PayoutDocument) string
+
+	for _, name := range s.cfg.PayoutBatchCsvColumns {
+		fn, ok := payoutBatchCsvColumns[name]
+
+		if !ok {
+			return nil, newBillingServerErrorMsg(
+				payoutBatchErrorCsvColumnUnknown.Code,
+				payoutBatchErrorCsvColumnUnknown.Message,
+				name,
+			)
+		}
+
+		columns = append(columns, fn)
+	}
+
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+
+	if s.cfg.PayoutBatchCsvDelimiter != "" {
+		w.Comma = []rune(s.cfg.PayoutBatchCsvDelimiter)[0]
+	}
+
+	if err := w.Write(s.cfg.PayoutBatchCsvColumns); err != nil {
+		return nil, err
+	}
+
+	for _, pd := range documents {
+		record := make([]string, len(columns))
+
+		for i, fn := range columns {
+			record[i] = fn(batch, pd)
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+func (s *Service) parsePayoutStatusReport(content []byte) ([]*payoutStatusReportItem, error) {
+	doc := new(payoutStatusReportDocument)
+	err := xml.Unmarshal(content, doc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*payoutStatusReportItem
+
+	switch {
+	case doc.StatusReport != nil:
+		report := doc.StatusReport
+
+		// whole batch was rejected by bank, so status report hasn't statuses of separate transactions
+		if len(report.Transactions) <= 0 && report.GroupStatus == payoutStatusReportTxStatusRejected {
+			batch, err := s.getPayoutBatch(report.OriginalMessageId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, id := range batch.PayoutDocumentIds {
+				items = append(items, newPayoutStatusReportFailedItem(id, report.OriginalMessageId, report.GroupReason))
+			}
+		}
+
+		for _, tx := range report.Transactions {
+			switch tx.Status {
+			case payoutStatusReportTxStatusSettled:
+				items = append(items, &payoutStatusReportItem{endToEndId: tx.EndToEndId, isPaid: true, reference: tx.StatusId})
+			case payoutStatusReportTxStatusRejected:
+				items = append(items, newPayoutStatusReportFailedItem(tx.EndToEndId, tx.StatusId, tx.Reason))
+			}
+		}
+	case doc.Notification != nil:
+		for _, ntf := range doc.Notification.Notifications {
+			for _, entry := range ntf.Entries {
+				if entry.Status != payoutStatusReportEntryStatusBooked {
+					continue
+				}
+
+				for _, tx := range entry.Transactions {
+					reference := tx.Reference
+
+					if reference == "" {
+						reference = entry.Reference
+					}
+
+					if entry.IsReversal || tx.Return.Code != "" {
+						items = append(items, newPayoutStatusReportFailedItem(tx.EndToEndId, reference, tx.Return))
+						continue
+					}
+
+					items = append(items, &payoutStatusReportItem{endToEndId: tx.EndToEndId, isPaid: true, reference: reference})
+				}
+			}
+		}
+	default:
+		return nil, payoutBatchErrorReportInvalid
+	}
+
+	return items, nil
+}
+
+func newPayoutStatusReportFailedItem(
+	endToEndId, reference string,
+	reason payoutStatusReportReason,
+) *payoutStatusReportItem {
+	item := &payoutStatusReportItem{
+		endToEndId:     endToEndId,
+		reference:      reference,
+		failureCode:    "declined",
+		failureMessage: reason.AdditionalInfo,
+	}
+
+	if code, ok := payoutStatusReportFailureCodes[reason.Code]; ok {
+		item.failureCode = code
+	}
+
+	if item.failureMessage == "" && reason.Code != "" {
+		item.failureMessage = "bank reason code " + reason.Code
+	}
+
+	return item
+}
+
+func getPayoutRemittanceInfo(pd *billing.PayoutDocument) string {
+	info := "Payout " + pd.Id
+
+	if pd.MerchantAgreementNumber != "" {
+		info += " by agreement " + pd.MerchantAgreementNumber
+	}
+
+	if len(info) > payoutBatchRemittanceInfoMaxLen {
+		info = info[:payoutBatchRemittanceInfoMaxLen]
+	}
+
+	return info
+}
@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"strings"
+	"testing"
+)
+
+type PayoutBatchTestSuite struct {
+	suite.Suite
+	service *Service
+	cache   internalPkg.CacheInterface
+
+	merchant *billing.Merchant
+	report1  *billing.RoyaltyReport
+	report2  *billing.RoyaltyReport
+	payout1  *billing.PayoutDocument
+	payout2  *billing.PayoutDocument
+}
+
+func Test_PayoutBatch(t *testing.T) {
+	suite.Run(t, new(PayoutBatchTestSuite))
+}
+
+func (suite *PayoutBatchTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+	cfg.PayoutBatchDebtorIban = "DE89370400440532013000"
+	cfg.PayoutBatchDebtorBic = "COBADEFFXXX"
+
+	db, err := mongodb.NewDatabase()
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.merchant = &billing.Merchant{
+		Id: bson.NewObjectId().Hex(),
+		User: &billing.MerchantUser{
+			Id:    uuid.New().String(),
+			Email: "test@unit.test",
+		},
+		Company: &billing.MerchantCompanyInfo{
+			Name:    "Unit test",
+			Country: "DE",
+			Zip:     "10115",
+			City:    "Berlin",
+		},
+		Banking: &billing.MerchantBanking{
+			Currency:      "EUR",
+			Name:          "Bank name",
+			AccountNumber: "FR1420041010050500013M02606",
+			Swift:         "PSSTFRPPXXX",
+		},
+		AgreementNumber: "UT-001",
+		Status:          pkg.MerchantStatusDraft,
+		IsSigned:        true,
+		PaymentMethods:  map[string]*billing.MerchantPaymentMethod{},
+	}
+
+	suite.report1 = suite.helperGetRoyaltyReport()
+	suite.report2 = suite.helperGetRoyaltyReport()
+	suite.payout1 = suite.helperGetPayoutDocument(suite.report1, 1234.56)
+	suite.payout2 = suite.helperGetPayoutDocument(suite.report2, 100.1)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		mocks.NewBrokerMockOk(),
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	if err := suite.service.merchant.Insert(suite.merchant); err != nil {
+		suite.FailNow("Insert merchant test data failed", "%v", err)
+	}
+
+	for _, r := range []*billing.RoyaltyReport{suite.report1, suite.report2} {
+		if err := suite.service.db.Collection(collectionRoyaltyReport).Insert(r); err != nil {
+			suite.FailNow("Insert royalty report test data failed", "%v", err)
+		}
+	}
+
+	for _, p := range []*billing.PayoutDocument{suite.payout1, suite.payout2} {
+		if err := suite.service.payoutDocument.Insert(p, "127.0.0.1", payoutChangeSourceAdmin); err != nil {
+			suite.FailNow("Insert payout test data failed", "%v", err)
+		}
+	}
+}
+
+func (suite *PayoutBatchTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *PayoutBatchTestSuite) helperGetRoyaltyReport() *billing.RoyaltyReport {
+	return &billing.RoyaltyReport{
+		Id:         bson.NewObjectId().Hex(),
+		MerchantId: suite.merchant.Id,
+		Totals: &billing.RoyaltyReportTotals{
+			TransactionsCount: 10,
+			PayoutAmount:      1000,
+		},
+		Status:         pkg.RoyaltyReportStatusAccepted,
+		CreatedAt:      ptypes.TimestampNow(),
+		PeriodFrom:     ptypes.TimestampNow(),
+		PeriodTo:       ptypes.TimestampNow(),
+		AcceptExpireAt: ptypes.TimestampNow(),
+		Currency:       suite.merchant.GetPayoutCurrency(),
+	}
+}
+
+func (suite *PayoutBatchTestSuite) helperGetPayoutDocument(
+	report *billing.RoyaltyReport,
+	amount float64,
+) *billing.PayoutDocument {
+	return &billing.PayoutDocument{
+		Id:                      bson.NewObjectId().Hex(),
+		MerchantId:              suite.merchant.Id,
+		SourceId:                []string{report.Id},
+		TotalFees:               amount,
+		Balance:                 amount,
+		Currency:                "EUR",
+		Status:                  pkg.PayoutDocumentStatusPending,
+		Destination:             suite.merchant.Banking,
+		Company:                 suite.merchant.Company,
+		MerchantAgreementNumber: suite.merchant.AgreementNumber,
+		CreatedAt:               ptypes.TimestampNow(),
+		UpdatedAt:               ptypes.TimestampNow(),
+		ArrivalDate:             ptypes.TimestampNow(),
+	}
+}
+
+func (suite *PayoutBatchTestSuite) helperCreateBatch(format string) *billing.PayoutBatch {
+	req := &grpc.CreatePayoutBatchRequest{Format: format, Currency: "EUR", Ip: "127.0.0.1"}
+	rsp := &grpc.PayoutBatchResponse{}
+	err := suite.service.CreatePayoutBatch(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp.Item
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_CreatePayoutBatch_Sepa_Ok() {
+	batch := suite.helperCreateBatch(pkg.PayoutBatchFormatSepa)
+	assert.NotNil(suite.T(), batch)
+	assert.EqualValues(suite.T(), 2, batch.DocumentsCount)
+	assert.Equal(suite.T(), 1334.66, batch.TotalAmount)
+	assert.Equal(suite.T(), []string{suite.payout1.Id, suite.payout2.Id}, batch.PayoutDocumentIds)
+
+	hash := sha256.Sum256(batch.Content)
+	assert.Equal(suite.T(), hex.EncodeToString(hash[:]), batch.Checksum)
+
+	content := string(batch.Content)
+	assert.Contains(suite.T(), content, "<MsgId>"+batch.Id+"</MsgId>")
+	assert.Contains(suite.T(), content, "<CtrlSum>1334.66</CtrlSum>")
+	assert.Contains(suite.T(), content, "<EndToEndId>"+suite.payout1.Id+"</EndToEndId>")
+	assert.Contains(suite.T(), content, `<InstdAmt Ccy="EUR">100.10</InstdAmt>`)
+	assert.Contains(suite.T(), content, "<IBAN>FR1420041010050500013M02606</IBAN>")
+
+	pd, err := suite.service.payoutDocument.GetById(suite.payout1.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusInProgress, pd.Status)
+	assert.Equal(suite.T(), batch.Id, pd.BatchId)
+
+	rsp := &grpc.PayoutBatchResponse{}
+	err = suite.service.GetPayoutBatch(context.TODO(), &grpc.GetPayoutBatchRequest{BatchId: batch.Id}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), batch.Checksum, rsp.Item.Checksum)
+	assert.Equal(suite.T(), batch.Content, rsp.Item.Content)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_CreatePayoutBatch_Csv_Ok() {
+	suite.service.cfg.PayoutBatchCsvColumns = []string{"end_to_end_id", "beneficiary_account", "amount", "currency"}
+
+	batch := suite.helperCreateBatch(pkg.PayoutBatchFormatCsv)
+	lines := strings.Split(strings.TrimSpace(string(batch.Content)), "\n")
+	assert.Len(suite.T(), lines, 3)
+	assert.Equal(suite.T(), "end_to_end_id;beneficiary_account;amount;currency", lines[0])
+	assert.Equal(suite.T(), suite.payout1.Id+";FR1420041010050500013M02606;1234.56;EUR", lines[1])
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_CreatePayoutBatch_AlreadyBatched_Error() {
+	suite.helperCreateBatch(pkg.PayoutBatchFormatSepa)
+
+	req := &grpc.CreatePayoutBatchRequest{Format: pkg.PayoutBatchFormatCsv, Currency: "EUR"}
+	rsp := &grpc.PayoutBatchResponse{}
+	err := suite.service.CreatePayoutBatch(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), payoutBatchErrorDocumentsNotFound, rsp.Message)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_CreatePayoutBatch_SepaCurrency_Error() {
+	req := &grpc.CreatePayoutBatchRequest{Format: pkg.PayoutBatchFormatSepa, Currency: "USD"}
+	rsp := &grpc.PayoutBatchResponse{}
+	err := suite.service.CreatePayoutBatch(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), payoutBatchErrorSepaCurrency, rsp.Message)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_CreatePayoutBatch_CsvColumnUnknown_Error() {
+	suite.service.cfg.PayoutBatchCsvColumns = []string{"end_to_end_id", "unknown"}
+
+	req := &grpc.CreatePayoutBatchRequest{Format: pkg.PayoutBatchFormatCsv, Currency: "EUR"}
+	rsp := &grpc.PayoutBatchResponse{}
+	err := suite.service.CreatePayoutBatch(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), payoutBatchErrorCsvColumnUnknown.Code, rsp.Message.Code)
+	assert.Equal(suite.T(), "unknown", rsp.Message.Details)
+
+	pd, err := suite.service.payoutDocument.GetById(suite.payout1.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusPending, pd.Status)
+	assert.Empty(suite.T(), pd.BatchId)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_ClaimPayoutDocuments_ClaimedByAnotherBatch_Skipped() {
+	err := suite.service.db.Collection(collectionPayoutDocuments).UpdateId(
+		bson.ObjectIdHex(suite.payout2.Id),
+		bson.M{"$set": bson.M{"status": pkg.PayoutDocumentStatusInProgress, "batch_id": bson.NewObjectId().Hex()}},
+	)
+	assert.NoError(suite.T(), err)
+
+	batchId := bson.NewObjectId().Hex()
+	claimed, err := suite.service.claimPayoutDocuments(batchId, []*billing.PayoutDocument{suite.payout1, suite.payout2})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), claimed, 1)
+	assert.Equal(suite.T(), suite.payout1.Id, claimed[0].Id)
+	assert.Equal(suite.T(), batchId, claimed[0].BatchId)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusInProgress, claimed[0].Status)
+
+	claimed, err = suite.service.claimPayoutDocuments(bson.NewObjectId().Hex(), []*billing.PayoutDocument{suite.payout1})
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), claimed)
+
+	suite.service.releasePayoutDocuments(batchId, nil, "127.0.0.1")
+
+	var pd *billing.PayoutDocument
+	err = suite.service.db.Collection(collectionPayoutDocuments).FindId(bson.ObjectIdHex(suite.payout1.Id)).One(&pd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusPending, pd.Status)
+	assert.Empty(suite.T(), pd.BatchId)
+
+	err = suite.service.db.Collection(collectionPayoutDocuments).FindId(bson.ObjectIdHex(suite.payout2.Id)).One(&pd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusInProgress, pd.Status)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_ImportPayoutStatusReport_Pain002_Ok() {
+	batch := suite.helperCreateBatch(pkg.PayoutBatchFormatSepa)
+	report := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.002.001.03">
+  <CstmrPmtStsRpt>
+    <OrgnlGrpInfAndSts><OrgnlMsgId>%s</OrgnlMsgId><GrpSts>PART</GrpSts></OrgnlGrpInfAndSts>
+    <OrgnlPmtInfAndSts>
+      <TxInfAndSts><StsId>S1</StsId><OrgnlEndToEndId>%s</OrgnlEndToEndId><TxSts>ACSC</TxSts></TxInfAndSts>
+      <TxInfAndSts>
+        <StsId>S2</StsId><OrgnlEndToEndId>%s</OrgnlEndToEndId><TxSts>RJCT</TxSts>
+        <StsRsnInf><Rsn><Cd>AC04</Cd></Rsn></StsRsnInf>
+      </TxInfAndSts>
+      <TxInfAndSts><StsId>S3</StsId><OrgnlEndToEndId>UNKNOWN</OrgnlEndToEndId><TxSts>ACSC</TxSts></TxInfAndSts>
+    </OrgnlPmtInfAndSts>
+  </CstmrPmtStsRpt>
+</Document>`, batch.Id, suite.payout1.Id, suite.payout2.Id)
+
+	req := &grpc.ImportPayoutStatusReportRequest{FileName: "pain002.xml", Content: []byte(report)}
+	rsp := &grpc.ImportPayoutStatusReportResponse{}
+	err := suite.service.ImportPayoutStatusReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 1, rsp.Paid)
+	assert.EqualValues(suite.T(), 1, rsp.Failed)
+	assert.Equal(suite.T(), []string{"UNKNOWN"}, rsp.Unmatched)
+
+	pd, err := suite.service.payoutDocument.GetById(suite.payout1.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusPaid, pd.Status)
+	assert.Equal(suite.T(), "S1", pd.Transaction)
+	assert.NotNil(suite.T(), pd.PaidAt)
+
+	pd, err = suite.service.payoutDocument.GetById(suite.payout2.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusFailed, pd.Status)
+	assert.Equal(suite.T(), "account_closed", pd.FailureCode)
+	assert.Equal(suite.T(), "S2", pd.FailureTransaction)
+
+	rsp = &grpc.ImportPayoutStatusReportResponse{}
+	err = suite.service.ImportPayoutStatusReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 0, rsp.Paid)
+	assert.EqualValues(suite.T(), 2, rsp.Skipped)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_ImportPayoutStatusReport_Pain002_GroupRejected_Ok() {
+	batch := suite.helperCreateBatch(pkg.PayoutBatchFormatSepa)
+	report := fmt.Sprintf(`<Document>
+  <CstmrPmtStsRpt>
+    <OrgnlGrpInfAndSts>
+      <OrgnlMsgId>%s</OrgnlMsgId><GrpSts>RJCT</GrpSts>
+      <StsRsnInf><Rsn><Cd>AM04</Cd></Rsn><AddtlInf>Not enough funds</AddtlInf></StsRsnInf>
+    </OrgnlGrpInfAndSts>
+  </CstmrPmtStsRpt>
+</Document>`, batch.Id)
+
+	req := &grpc.ImportPayoutStatusReportRequest{FileName: "pain002.xml", Content: []byte(report)}
+	rsp := &grpc.ImportPayoutStatusReportResponse{}
+	err := suite.service.ImportPayoutStatusReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 2, rsp.Failed)
+
+	pd, err := suite.service.payoutDocument.GetById(suite.payout2.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusFailed, pd.Status)
+	assert.Equal(suite.T(), "insufficient_funds", pd.FailureCode)
+	assert.Equal(suite.T(), "Not enough funds", pd.FailureMessage)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_ImportPayoutStatusReport_Camt054_Ok() {
+	suite.helperCreateBatch(pkg.PayoutBatchFormatSepa)
+	report := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.054.001.02">
+  <BkToCstmrDbtCdtNtfctn>
+    <Ntfctn>
+      <Ntry>
+        <AcctSvcrRef>BANKREF1</AcctSvcrRef><Sts>BOOK</Sts>
+        <NtryDtls><TxDtls><Refs><EndToEndId>%s</EndToEndId></Refs></TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <AcctSvcrRef>BANKREF2</AcctSvcrRef><Sts>BOOK</Sts><RvslInd>true</RvslInd>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>%s</EndToEndId></Refs>
+          <RtrInf><Rsn><Cd>AC01</Cd></Rsn></RtrInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+    </Ntfctn>
+  </BkToCstmrDbtCdtNtfctn>
+</Document>`, suite.payout1.Id, suite.payout2.Id)
+
+	req := &grpc.ImportPayoutStatusReportRequest{FileName: "camt054.xml", Content: []byte(report)}
+	rsp := &grpc.ImportPayoutStatusReportResponse{}
+	err := suite.service.ImportPayoutStatusReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 1, rsp.Paid)
+	assert.EqualValues(suite.T(), 1, rsp.Failed)
+
+	pd, err := suite.service.payoutDocument.GetById(suite.payout1.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusPaid, pd.Status)
+	assert.Equal(suite.T(), "BANKREF1", pd.Transaction)
+
+	pd, err = suite.service.payoutDocument.GetById(suite.payout2.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PayoutDocumentStatusFailed, pd.Status)
+	assert.Equal(suite.T(), "invalid_account_number", pd.FailureCode)
+	assert.Equal(suite.T(), "BANKREF2", pd.FailureTransaction)
+}
+
+func (suite *PayoutBatchTestSuite) TestPayoutBatch_ImportPayoutStatusReport_ReportInvalid_Error() {
+	req := &grpc.ImportPayoutStatusReportRequest{FileName: "report.xml", Content: []byte("<Document><Unknown/></Document>")}
+	rsp := &grpc.ImportPayoutStatusReportResponse{}
+	err := suite.service.ImportPayoutStatusReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), payoutBatchErrorReportInvalid, rsp.Message)
+}
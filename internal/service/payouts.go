@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jinzhu/now"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	reporterConst "github.com/paysuper/paysuper-reporter/pkg"
@@ -51,6 +52,7 @@ var (
 	errorPayoutStatusChangeIsForbidden = newBillingServerErrorMsg("po000014", "status change is forbidden")
 	errorPayoutManualPayoutsDisabled   = newBillingServerErrorMsg("po000015", "manual payouts disabled")
 	errorPayoutAutoPayoutsDisabled     = newBillingServerErrorMsg("po000016", "auto payouts disabled")
+	errorPayoutCurrencyNotFound        = newBillingServerErrorMsg("po000017", "currency is not payout currency of merchant")
 
 	statusForUpdateBalance = map[string]bool{
 		pkg.PayoutDocumentStatusPending: true,
@@ -68,6 +70,7 @@ var (
 
 	payoutDocumentStatusActive = []string{
 		pkg.PayoutDocumentStatusPending,
+		pkg.PayoutDocumentStatusInProgress,
 		pkg.PayoutDocumentStatusPaid,
 	}
 )
@@ -137,7 +140,19 @@ func (s *Service) createPayoutDocument(
 	pd.Company = merchant.Company
 	pd.MerchantAgreementNumber = merchant.AgreementNumber
 
-	reports, err := s.getPayoutDocumentSources(merchant)
+	currency := req.Currency
+
+	if currency == "" {
+		currency = merchant.GetPayoutCurrency()
+	}
+
+	if !merchant.HasPayoutCurrency(currency) {
+		res.Status = pkg.ResponseStatusBadData
+		res.Message = errorPayoutCurrencyNotFound
+		return nil
+	}
+
+	reports, err := s.getPayoutDocumentSources(merchant, currency)
 
 	if err != nil {
 		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
@@ -150,11 +165,16 @@ func (s *Service) createPayoutDocument(
 
 	pd.Currency = reports[0].Currency
 
-	var times []time.Time
+	var (
+		times     []time.Time
+		totalFees int64
+		balance   int64
+	)
 
 	for _, r := range reports {
-		pd.TotalFees += r.Totals.PayoutAmount - r.Totals.CorrectionAmount
-		pd.Balance += r.Totals.PayoutAmount - r.Totals.CorrectionAmount - r.Totals.RollingReserveAmount
+		payoutAmount := money.ToMinor(r.Totals.PayoutAmount, pd.Currency) - money.ToMinor(r.Totals.CorrectionAmount, pd.Currency)
+		totalFees += payoutAmount
+		balance += payoutAmount - money.ToMinor(r.Totals.RollingReserveAmount, pd.Currency)
 		pd.TotalTransactions += r.Totals.TransactionsCount
 		pd.SourceId = append(pd.SourceId, r.Id)
 
@@ -179,20 +199,26 @@ func (s *Service) createPayoutDocument(
 		times = append(times, from, to)
 	}
 
+	pd.TotalFees = money.FromMinor(totalFees, pd.Currency)
+	pd.Balance = money.FromMinor(balance, pd.Currency)
+
 	if pd.Balance <= 0 {
 		res.Status = pkg.ResponseStatusBadData
 		res.Message = errorPayoutAmountInvalid
 		return nil
 	}
 
-	balance, err := s.getMerchantBalance(merchant.Id)
+	merchantBalance, err := s.getMerchantBalance(merchant.Id, pd.Currency)
 	if err != nil {
 		res.Status = pkg.ResponseStatusSystemError
 		res.Message = errorPayoutBalanceError
 		return nil
 	}
 
-	if pd.Balance > (balance.Debit - balance.Credit) {
+	available := money.ToMinor(merchantBalance.Debit, merchantBalance.Currency) -
+		money.ToMinor(merchantBalance.Credit, merchantBalance.Currency)
+
+	if balance > available {
 		res.Status = pkg.ResponseStatusBadData
 		res.Message = errorPayoutNotEnoughBalance
 		return nil
@@ -328,29 +354,37 @@ func (s *Service) AutoCreatePayoutDocuments(context.Context, *grpc.EmptyRequest,
 
 	for _, m := range merchants {
 		req.MerchantId = m.Id
-		err = s.createPayoutDocument(ctx, m, req, res)
-		if err != nil {
-			if err == errorPayoutSourcesNotFound {
-				continue
+
+		for _, currency := range m.GetAllPayoutCurrencies() {
+			req.Currency = currency
+			res.Reset()
+
+			err = s.createPayoutDocument(ctx, m, req, res)
+			if err != nil {
+				if err == errorPayoutSourcesNotFound {
+					continue
+				}
+				zap.L().Error(
+					"auto createPayoutDocument failed with error",
+					zap.Error(err),
+					zap.String("merchantId", m.Id),
+					zap.String("currency", currency),
+				)
+				return err
 			}
-			zap.L().Error(
-				"auto createPayoutDocument failed with error",
-				zap.Error(err),
-				zap.String("merchantId", m.Id),
-			)
-			return err
-		}
-		if res.Status != pkg.ResponseStatusOk {
-			if res.Message == errorPayoutAmountInvalid {
-				continue
+			if res.Status != pkg.ResponseStatusOk {
+				if res.Message == errorPayoutAmountInvalid || res.Message == errorPayoutSourcesNotFound {
+					continue
+				}
+				zap.L().Error(
+					"auto createPayoutDocument failed in response",
+					zap.Int32("code", res.Status),
+					zap.Any("message", res.Message),
+					zap.String("merchantId", m.Id),
+					zap.String("currency", currency),
+				)
+				return err
 			}
-			zap.L().Error(
-				"auto createPayoutDocument failed in response",
-				zap.Int32("code", res.Status),
-				zap.Any("message", res.Message),
-				zap.String("merchantId", m.Id),
-			)
-			return err
 		}
 	}
 
@@ -494,7 +528,7 @@ func (s *Service) UpdatePayoutDocument(
 	}
 
 	if needBalanceUpdate == true {
-		_, err = s.updateMerchantBalance(pd.MerchantId)
+		_, err = s.updateMerchantBalance(pd.MerchantId, pd.Currency)
 		if err != nil {
 			res.Status = pkg.ResponseStatusSystemError
 			res.Message = errorPayoutUpdateBalance
@@ -654,8 +688,11 @@ func (s *Service) PayoutDocumentPdfUploaded(
 	return nil
 }
 
-func (s *Service) getPayoutDocumentSources(merchant *billing.Merchant) ([]*billing.RoyaltyReport, error) {
-	result, err := s.royaltyReport.GetNonPayoutReports(merchant.Id, merchant.GetPayoutCurrency())
+func (s *Service) getPayoutDocumentSources(
+	merchant *billing.Merchant,
+	currency string,
+) ([]*billing.RoyaltyReport, error) {
+	result, err := s.royaltyReport.GetNonPayoutReports(merchant.Id, currency)
 
 	if err != nil && err != mgo.ErrNotFound {
 		return nil, err
@@ -854,12 +891,12 @@ func (h *PayoutDocument) GetBalanceAmount(merchantId, currency string) (float64,
 		{
 			"$group": bson.M{
 				"_id":    "$currency",
-				"amount": bson.M{"$sum": "$total_fees"},
+				"amount": bson.M{"$sum": "$total_fees_minor"},
 			},
 		},
 	}
 
-	res := &balanceQueryResItem{}
+	res := &balanceMinorQueryResItem{}
 
 	err := h.svc.db.Collection(collectionPayoutDocuments).Pipe(query).One(&res)
 	if err != nil && err != mgo.ErrNotFound {
@@ -872,7 +909,7 @@ func (h *PayoutDocument) GetBalanceAmount(merchantId, currency string) (float64,
 		return 0, err
 	}
 
-	return res.Amount, nil
+	return money.FromMinor(res.Amount, currency), nil
 }
 
 func (h *PayoutDocument) GetLast(merchantId, currency string) (pd *billing.PayoutDocument, err error) {
@@ -464,7 +464,7 @@ func (suite *PayoutsTestSuite) helperInsertPayoutDocuments(data []*billing.Payou
 func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Ok_NoPayoutsYet() {
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report6})
 
-	reports, err := suite.service.getPayoutDocumentSources(suite.merchant)
+	reports, err := suite.service.getPayoutDocumentSources(suite.merchant, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), reports, 2)
 }
@@ -472,20 +472,20 @@ func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Ok_NoPayouts
 func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Ok_FilteringByCurrency() {
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report5, suite.report6})
 
-	reports, err := suite.service.getPayoutDocumentSources(suite.merchant)
+	reports, err := suite.service.getPayoutDocumentSources(suite.merchant, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), reports, 2)
 }
 
 func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Fail_NotFound() {
-	reports, err := suite.service.getPayoutDocumentSources(suite.merchant)
+	reports, err := suite.service.getPayoutDocumentSources(suite.merchant, suite.merchant.GetPayoutCurrency())
 	assert.EqualError(suite.T(), err, errorPayoutSourcesNotFound.Error())
 	assert.Len(suite.T(), reports, 0)
 }
 
 func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Fail_MerchantNotFound() {
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report6})
-	reports, err := suite.service.getPayoutDocumentSources(&billing.Merchant{Id: bson.NewObjectId().Hex()})
+	reports, err := suite.service.getPayoutDocumentSources(&billing.Merchant{Id: bson.NewObjectId().Hex()}, "RUB")
 	assert.EqualError(suite.T(), err, errorPayoutSourcesNotFound.Error())
 	assert.Len(suite.T(), reports, 0)
 }
@@ -493,7 +493,7 @@ func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Fail_Merchan
 func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Fail_HasPendingReports() {
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report3})
 
-	reports, err := suite.service.getPayoutDocumentSources(suite.merchant)
+	reports, err := suite.service.getPayoutDocumentSources(suite.merchant, suite.merchant.GetPayoutCurrency())
 	assert.EqualError(suite.T(), err, errorPayoutSourcesPending.Error())
 	assert.Len(suite.T(), reports, 0)
 }
@@ -501,7 +501,7 @@ func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Fail_HasPend
 func (suite *PayoutsTestSuite) TestPayouts_getPayoutDocumentSources_Fail_HasDisputingReports() {
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report7})
 
-	reports, err := suite.service.getPayoutDocumentSources(suite.merchant)
+	reports, err := suite.service.getPayoutDocumentSources(suite.merchant, suite.merchant.GetPayoutCurrency())
 	assert.EqualError(suite.T(), err, errorPayoutSourcesDispute.Error())
 	assert.Len(suite.T(), reports, 0)
 }
@@ -513,7 +513,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Ok_Pending() {
 
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report2})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req := &grpc.CreatePayoutDocumentRequest{
@@ -544,7 +544,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Ok_SkipByAmount(
 
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report2})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req := &grpc.CreatePayoutDocumentRequest{
@@ -577,7 +577,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Ok_SkipByRolling
 
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report2})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req1 := &grpc.CreatePayoutDocumentRequest{
@@ -618,6 +618,55 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_NoSources
 	assert.Equal(suite.T(), res.Message, errorPayoutSourcesNotFound)
 }
 
+func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Ok_AdditionalPayoutCurrency() {
+	reporting := &reportingMocks.ReporterService{}
+	reporting.On("CreateFile", mock2.Anything, mock2.Anything).Return(nil, nil)
+	suite.service.reporterService = reporting
+
+	suite.merchant.PayoutCurrencies = []string{"USD"}
+	err := suite.service.merchant.Update(suite.merchant)
+	assert.NoError(suite.T(), err)
+
+	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report5})
+
+	_, err = suite.service.updateMerchantBalance(suite.merchant.Id, "USD")
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.CreatePayoutDocumentRequest{
+		MerchantId:  suite.merchant.Id,
+		Description: "test payout",
+		Ip:          "127.0.0.1",
+		Currency:    "USD",
+	}
+
+	res := &grpc.PayoutDocumentResponse{}
+
+	err = suite.service.CreatePayoutDocument(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Status, pkg.ResponseStatusOk)
+	assert.Equal(suite.T(), res.Item.Currency, "USD")
+	assert.Equal(suite.T(), res.Item.Balance, suite.report5.Totals.PayoutAmount)
+	assert.Equal(suite.T(), res.Item.SourceId, []string{suite.report5.Id})
+}
+
+func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_NotPayoutCurrency() {
+	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report5})
+
+	req := &grpc.CreatePayoutDocumentRequest{
+		MerchantId:  suite.merchant.Id,
+		Description: "test payout",
+		Ip:          "127.0.0.1",
+		Currency:    "USD",
+	}
+
+	res := &grpc.PayoutDocumentResponse{}
+
+	err := suite.service.CreatePayoutDocument(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Status, pkg.ResponseStatusBadData)
+	assert.Equal(suite.T(), res.Message, errorPayoutCurrencyNotFound)
+}
+
 func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_MerchantNotFound() {
 
 	req := &grpc.CreatePayoutDocumentRequest{
@@ -636,7 +685,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_MerchantN
 func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_ZeroAmount() {
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report4})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req := &grpc.CreatePayoutDocumentRequest{
@@ -675,7 +724,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_NotEnough
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1})
 	suite.helperInsertPayoutDocuments([]*billing.PayoutDocument{suite.payout2})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req := &grpc.GetMerchantBalanceRequest{
@@ -716,7 +765,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_InsertErr
 
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report2})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req := &grpc.CreatePayoutDocumentRequest{
@@ -741,7 +790,7 @@ func (suite *PayoutsTestSuite) TestPayouts_CreatePayoutDocument_Failed_InsertErr
 
 	suite.helperInsertRoyaltyReports([]*billing.RoyaltyReport{suite.report1, suite.report2})
 
-	_, err := suite.service.updateMerchantBalance(suite.merchant.Id)
+	_, err := suite.service.updateMerchantBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
 	assert.NoError(suite.T(), err)
 
 	req := &grpc.CreatePayoutDocumentRequest{
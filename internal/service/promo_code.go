@@ -0,0 +1,726 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	collectionPromoCode     = "promo_code"
+	collectionPromoCodeUses = "promo_code_uses"
+
+	orderPrivateMetadataPromoCodeUse      = "PromoCodeUse"
+	orderPrivateMetadataPromoCodeCustomer = "PromoCodeCustomer"
+
+	promoCodeUseReserved = "reserved"
+	promoCodeUseReleased = "released"
+)
+
+var (
+	promoCodeErrorNotFound                 = newBillingServerErrorMsg("pc000001", "promo code not found")
+	promoCodeErrorProjectNotFound          = newBillingServerErrorMsg("pc000002", "project for promo code not found")
+	promoCodeErrorTypeInvalid              = newBillingServerErrorMsg("pc000003", "promo code type is invalid")
+	promoCodeErrorPercentInvalid           = newBillingServerErrorMsg("pc000004", "percent promo code must have discount percent greater than 0 and less than 100")
+	promoCodeErrorAmountsInvalid           = newBillingServerErrorMsg("pc000005", "fixed promo code must have positive discount amounts by currencies")
+	promoCodeErrorPeriodInvalid            = newBillingServerErrorMsg("pc000006", "promo code validity period is invalid")
+	promoCodeErrorAlreadyExists            = newBillingServerErrorMsg("pc000007", "promo code with same code already exists")
+	promoCodeErrorUnknown                  = newBillingServerErrorMsg("pc000008", "unknown error. try request later")
+	promoCodeErrorNotStarted               = newBillingServerErrorMsg("pc000009", "promo code is not valid yet")
+	promoCodeErrorExpired                  = newBillingServerErrorMsg("pc000010", "promo code is expired")
+	promoCodeErrorUsesLimitReached         = newBillingServerErrorMsg("pc000011", "promo code usage limit is reached")
+	promoCodeErrorCustomerUsesLimitReached = newBillingServerErrorMsg("pc000012", "promo code usage limit for customer is reached")
+	promoCodeErrorNotApplicable            = newBillingServerErrorMsg("pc000013", "promo code can't be applied to order")
+	promoCodeErrorCustomerUnknown          = newBillingServerErrorMsg("pc000014", "promo code with usage limit for customer requires customer identifier or email")
+)
+
+func (s *Service) CreateOrUpdatePromoCode(
+	ctx context.Context,
+	req *billing.PromoCode,
+	rsp *grpc.PromoCodeResponse,
+) error {
+	if req.ProjectId != "" {
+		project, err := s.project.GetById(req.ProjectId)
+
+		if err != nil || project.MerchantId != req.MerchantId {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = promoCodeErrorProjectNotFound
+			return nil
+		}
+	}
+
+	if err := s.validatePromoCode(req); err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+		return nil
+	}
+
+	req.Code = strings.ToUpper(req.Code)
+
+	if req.Id == "" {
+		req.Id = bson.NewObjectId().Hex()
+		req.CreatedAt = ptypes.TimestampNow()
+	} else {
+		pc, err := s.getPromoCode(req.Id, req.MerchantId)
+
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = promoCodeErrorNotFound
+			return nil
+		}
+
+		req.CreatedAt = pc.CreatedAt
+	}
+
+	query := bson.M{
+		"_id":         bson.M{"$ne": bson.ObjectIdHex(req.Id)},
+		"merchant_id": bson.ObjectIdHex(req.MerchantId),
+		"code":        req.Code,
+	}
+	count, err := s.db.Collection(collectionPromoCode).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCode),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = promoCodeErrorUnknown
+		return nil
+	}
+
+	if count > 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = promoCodeErrorAlreadyExists
+		return nil
+	}
+
+	req.UpdatedAt = ptypes.TimestampNow()
+	req.Uses = 0
+	_, err = s.db.Collection(collectionPromoCode).UpsertId(bson.ObjectIdHex(req.Id), req)
+
+	if err != nil {
+		if mgo.IsDup(err) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = promoCodeErrorAlreadyExists
+			return nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCode),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, req),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = promoCodeErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = req
+
+	return nil
+}
+
+func (s *Service) ListPromoCodes(
+	ctx context.Context,
+	req *grpc.ListPromoCodesRequest,
+	rsp *grpc.ListPromoCodesResponse,
+) error {
+	query := bson.M{"merchant_id": bson.ObjectIdHex(req.MerchantId)}
+
+	if req.ProjectId != "" {
+		query["project_id"] = bson.ObjectIdHex(req.ProjectId)
+	}
+
+	err := s.db.Collection(collectionPromoCode).Find(query).Sort("_id").All(&rsp.Items)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCode),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = promoCodeErrorUnknown
+		return nil
+	}
+
+	if len(rsp.Items) > 0 {
+		ids := make([]string, len(rsp.Items))
+
+		for i, pc := range rsp.Items {
+			ids[i] = pc.Id
+		}
+
+		uses, err := s.getPromoCodeUses(ids, "", "")
+
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = promoCodeErrorUnknown
+			return nil
+		}
+
+		for _, pc := range rsp.Items {
+			pc.Uses = uses[pc.Id]
+		}
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// PaymentFormApplyPromoCode applies promo code to products or key products order on payment form.
+// Order amount, items amounts and VAT are recalculated with discount of promo code.
+func (s *Service) PaymentFormApplyPromoCode(
+	ctx context.Context,
+	req *grpc.PaymentFormApplyPromoCodeRequest,
+	rsp *grpc.ProcessBillingAddressResponse,
+) error {
+	order, err := s.getOrderByUuidToForm(req.OrderId)
+
+	if err != nil {
+		zap.S().Errorw(pkg.MethodFinishedWithError, "err", err.Error())
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = e
+			return nil
+		}
+		return err
+	}
+
+	if order.ProductType != billing.OrderType_product && order.ProductType != billing.OrderType_key {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = promoCodeErrorNotApplicable
+		return nil
+	}
+
+	pc, err := s.getPromoCodeByCode(order.GetMerchantId(), order.GetProjectId(), req.Code)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = promoCodeErrorNotFound
+		return nil
+	}
+
+	if err = s.checkPromoCodeUsable(pc, order); err != nil {
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = e
+			return nil
+		}
+		return err
+	}
+
+	// use of previous promo code is returned if payment of order was already attempted
+	s.releaseOrderPromoCodeUse(order)
+
+	order.PromoCode = &billing.OrderPromoCode{
+		Id:          pc.Id,
+		Code:        pc.Code,
+		Type:        pc.Type,
+		Percent:     pc.Percent,
+		Amounts:     pc.Amounts,
+		ProductIds:  pc.ProductIds,
+		PlatformIds: pc.PlatformIds,
+	}
+
+	if order.ProductType == billing.OrderType_product {
+		err = s.ProcessOrderProducts(order)
+	} else {
+		_, err = s.ProcessOrderKeyProducts(ctx, order)
+	}
+
+	if err != nil {
+		zap.S().Errorw(pkg.MethodFinishedWithError, "err", err.Error())
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = e
+			return nil
+		}
+		return err
+	}
+
+	// promo code is removed from order if it can't be applied to products, platform or currency of order
+	if order.PromoCode == nil || order.PromoCode.Discount <= 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = promoCodeErrorNotApplicable
+		return nil
+	}
+
+	processor := &OrderCreateRequestProcessor{Service: s}
+	processor.processOrderVat(order)
+
+	err = s.updateOrder(order)
+
+	if err != nil {
+		zap.S().Errorw(pkg.MethodFinishedWithError, "err", err.Error())
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = e
+			return nil
+		}
+		return err
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = getProcessBillingAddressResponseItem(order)
+
+	return nil
+}
+
+func (s *Service) validatePromoCode(pc *billing.PromoCode) error {
+	switch pc.Type {
+	case pkg.PromoCodeTypePercent:
+		if pc.Percent <= 0 || pc.Percent >= 100 {
+			return promoCodeErrorPercentInvalid
+		}
+	case pkg.PromoCodeTypeFixed:
+		if len(pc.Amounts) <= 0 {
+			return promoCodeErrorAmountsInvalid
+		}
+
+		for _, amount := range pc.Amounts {
+			if amount <= 0 {
+				return promoCodeErrorAmountsInvalid
+			}
+		}
+	default:
+		return promoCodeErrorTypeInvalid
+	}
+
+	if pc.ValidFrom != nil && pc.ValidTo != nil && pc.ValidTo.Seconds <= pc.ValidFrom.Seconds {
+		return promoCodeErrorPeriodInvalid
+	}
+
+	return nil
+}
+
+func (s *Service) getPromoCode(id, merchantId string) (*billing.PromoCode, error) {
+	if bson.IsObjectIdHex(id) == false || bson.IsObjectIdHex(merchantId) == false {
+		return nil, promoCodeErrorNotFound
+	}
+
+	var pc *billing.PromoCode
+	query := bson.M{"_id": bson.ObjectIdHex(id), "merchant_id": bson.ObjectIdHex(merchantId)}
+	err := s.db.Collection(collectionPromoCode).Find(query).One(&pc)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCode),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, promoCodeErrorNotFound
+	}
+
+	return pc, nil
+}
+
+// getPromoCodeByCode returns active promo code of project or promo code of merchant valid for all projects
+func (s *Service) getPromoCodeByCode(merchantId, projectId, code string) (*billing.PromoCode, error) {
+	var pc *billing.PromoCode
+	query := bson.M{
+		"merchant_id": bson.ObjectIdHex(merchantId),
+		"code":        strings.ToUpper(code),
+		"is_active":   true,
+		"$or": []bson.M{
+			{"project_id": bson.ObjectIdHex(projectId)},
+			{"project_id": bson.M{"$exists": false}},
+		},
+	}
+	err := s.db.Collection(collectionPromoCode).Find(query).One(&pc)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCode),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, promoCodeErrorNotFound
+	}
+
+	return pc, nil
+}
+
+// checkPromoCodeUsable checks validity period and usage limits of promo code.
+// Only paid orders are counted, so refunded orders return uses to promo code.
+// Limits are enforced again on payment by reserveOrderPromoCodeUse, so parallel payments can't exceed them.
+func (s *Service) checkPromoCodeUsable(pc *billing.PromoCode, order *billing.Order) error {
+	now := time.Now()
+
+	if pc.ValidFrom != nil && now.Unix() < pc.ValidFrom.Seconds {
+		return promoCodeErrorNotStarted
+	}
+
+	if pc.ValidTo != nil && now.Unix() > pc.ValidTo.Seconds {
+		return promoCodeErrorExpired
+	}
+
+	if pc.MaxUses > 0 {
+		uses, err := s.getPromoCodeUses([]string{pc.Id}, "", "")
+
+		if err != nil {
+			return promoCodeErrorUnknown
+		}
+
+		if uses[pc.Id] >= pc.MaxUses {
+			return promoCodeErrorUsesLimitReached
+		}
+	}
+
+	if pc.MaxUsesPerCustomer > 0 {
+		field, customer := getPromoCodeCustomer(order.User)
+
+		if customer == "" {
+			return promoCodeErrorCustomerUnknown
+		}
+
+		uses, err := s.getPromoCodeUses([]string{pc.Id}, field, customer)
+
+		if err != nil {
+			return promoCodeErrorUnknown
+		}
+
+		if uses[pc.Id] >= pc.MaxUsesPerCustomer {
+			return promoCodeErrorCustomerUsesLimitReached
+		}
+	}
+
+	return nil
+}
+
+// getPromoCodeUses returns number of paid orders by promo codes, orders of one customer
+// are counted only if order field and identifier of customer passed
+func (s *Service) getPromoCodeUses(ids []string, customerField, customerId string) (map[string]int32, error) {
+	var items []struct {
+		Id    string `bson:"_id"`
+		Count int32  `bson:"count"`
+	}
+
+	match := bson.M{
+		"promo_code.id": bson.M{"$in": ids},
+		"status":        constant.OrderPublicStatusProcessed,
+	}
+
+	if customerId != "" {
+		match[customerField] = customerId
+	}
+
+	query := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{"_id": "$promo_code.id", "count": bson.M{"$sum": 1}}},
+	}
+
+	err := s.db.Collection(collectionOrder).Pipe(query).All(&items)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	uses := make(map[string]int32, len(items))
+
+	for _, item := range items {
+		uses[item.Id] = item.Count
+	}
+
+	return uses, nil
+}
+
+// getPromoCodeCustomer returns order field and identifier of customer to count promo code uses per customer.
+// Email of payer is used if customer isn't identified by project, empty identifier returned if order has neither.
+func getPromoCodeCustomer(user *billing.OrderUser) (string, string) {
+	if user == nil {
+		return "", ""
+	}
+
+	if user.Id != "" {
+		return "user.id", user.Id
+	}
+
+	if user.Email != "" {
+		return "user.email", user.Email
+	}
+
+	return "", ""
+}
+
+// reserveOrderPromoCodeUse counts payment of order against usage limits of promo code applied to order.
+// Counters of promo code are increased with conditional update, so parallel payments can't exceed the limits.
+// Order keeps mark of reserved use, so repeated payment attempts for the same order reserve it once.
+func (s *Service) reserveOrderPromoCodeUse(order *billing.Order) error {
+	if order.PromoCode == nil || order.PrivateMetadata[orderPrivateMetadataPromoCodeUse] == promoCodeUseReserved {
+		return nil
+	}
+
+	pc, err := s.getPromoCode(order.PromoCode.Id, order.GetMerchantId())
+
+	if err != nil {
+		return err
+	}
+
+	customer := ""
+
+	if field, id := getPromoCodeCustomer(order.User); id != "" {
+		customer = field + ":" + id
+	} else if pc.MaxUsesPerCustomer > 0 {
+		return promoCodeErrorCustomerUnknown
+	}
+
+	if err = s.incrementPromoCodeUses(pc.Id, "", pc.MaxUses); err != nil {
+		if err == mgo.ErrNotFound {
+			return promoCodeErrorUsesLimitReached
+		}
+		return promoCodeErrorUnknown
+	}
+
+	if customer != "" {
+		if err = s.incrementPromoCodeUses(pc.Id, customer, pc.MaxUsesPerCustomer); err != nil {
+			s.decrementPromoCodeUses(pc.Id, "")
+
+			if err == mgo.ErrNotFound {
+				return promoCodeErrorCustomerUsesLimitReached
+			}
+			return promoCodeErrorUnknown
+		}
+	}
+
+	if order.PrivateMetadata == nil {
+		order.PrivateMetadata = make(map[string]string)
+	}
+
+	order.PrivateMetadata[orderPrivateMetadataPromoCodeUse] = promoCodeUseReserved
+	order.PrivateMetadata[orderPrivateMetadataPromoCodeCustomer] = customer
+
+	if err = s.updateOrderPrivateMetadata(order); err != nil {
+		delete(order.PrivateMetadata, orderPrivateMetadataPromoCodeUse)
+		delete(order.PrivateMetadata, orderPrivateMetadataPromoCodeCustomer)
+		s.decrementPromoCodeUses(pc.Id, "")
+
+		if customer != "" {
+			s.decrementPromoCodeUses(pc.Id, customer)
+		}
+
+		return promoCodeErrorUnknown
+	}
+
+	return nil
+}
+
+// releaseOrderPromoCodeUse returns use reserved by order to promo code.
+// Mark of order is changed with conditional update, so use is returned once.
+func (s *Service) releaseOrderPromoCodeUse(order *billing.Order) {
+	if order.PromoCode == nil || order.PrivateMetadata[orderPrivateMetadataPromoCodeUse] != promoCodeUseReserved {
+		return
+	}
+
+	field := "private_metadata." + orderPrivateMetadataPromoCodeUse
+	query := bson.M{"_id": bson.ObjectIdHex(order.Id), field: promoCodeUseReserved}
+	err := s.db.Collection(collectionOrder).Update(query, bson.M{"$set": bson.M{field: promoCodeUseReleased}})
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+		return
+	}
+
+	order.PrivateMetadata[orderPrivateMetadataPromoCodeUse] = promoCodeUseReleased
+	s.decrementPromoCodeUses(order.PromoCode.Id, "")
+
+	if customer := order.PrivateMetadata[orderPrivateMetadataPromoCodeCustomer]; customer != "" {
+		s.decrementPromoCodeUses(order.PromoCode.Id, customer)
+	}
+}
+
+// incrementPromoCodeUses increases counter of promo code uses by all customers or by one customer
+// if counter is less than limit. Counter document is created on first use, unique index of counters
+// rejects creation of second document when limit is reached, mgo.ErrNotFound returned then.
+func (s *Service) incrementPromoCodeUses(id, customer string, limit int32) error {
+	query := bson.M{"promo_code_id": bson.ObjectIdHex(id), "customer": customer}
+
+	if limit > 0 {
+		query["uses"] = bson.M{"$lt": limit}
+	}
+
+	_, err := s.db.Collection(collectionPromoCodeUses).Upsert(query, bson.M{"$inc": bson.M{"uses": 1}})
+
+	if err != nil {
+		if mgo.IsDup(err) {
+			return mgo.ErrNotFound
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCodeUses),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+	}
+
+	return err
+}
+
+func (s *Service) decrementPromoCodeUses(id, customer string) {
+	query := bson.M{"promo_code_id": bson.ObjectIdHex(id), "customer": customer, "uses": bson.M{"$gt": 0}}
+	err := s.db.Collection(collectionPromoCodeUses).Update(query, bson.M{"$inc": bson.M{"uses": -1}})
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionPromoCodeUses),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+	}
+}
+
+// applyOrderDiscounts reduces amount of order and amounts of order items by discount of signed paylink token
+// and by discount of promo code applied to order. Order amount and items amounts must be full prices before call.
+// Promo code is removed from order if it can't be applied to recalculated order.
+func applyOrderDiscounts(order *billing.Order) {
+	order.DiscountAmount = 0
+
+	for _, item := range order.Items {
+		item.Discount = 0
+	}
+
+	if order.DiscountPercent <= 0 && order.PromoCode == nil {
+		return
+	}
+
+	amount := order.OrderAmount
+	tokenRate := order.DiscountPercent / 100
+	discount := amount * tokenRate
+	promoRate := float64(0)
+
+	if order.PromoCode != nil {
+		base := (amount - discount) * getPromoCodeItemsShare(order.PromoCode, order)
+		promoDiscount, ok := getPromoCodeDiscount(order.PromoCode, order, base)
+
+		if !ok {
+			zap.L().Info(
+				"Promo code can't be applied to order and removed",
+				zap.String("order_uuid", order.Uuid),
+				zap.String("promo_code", order.PromoCode.Code),
+			)
+			order.PromoCode = nil
+		} else {
+			order.PromoCode.Discount = money.Round(promoDiscount, order.Currency)
+			promoRate = promoDiscount / base
+			discount += promoDiscount
+		}
+	}
+
+	order.DiscountAmount = money.Round(discount, order.Currency)
+	order.OrderAmount = money.Round(amount-order.DiscountAmount, order.Currency)
+	order.TotalPaymentAmount = order.OrderAmount
+
+	for _, item := range order.Items {
+		itemDiscount := item.Amount * tokenRate
+
+		if order.PromoCode != nil && isPromoCodeItem(order.PromoCode, item) {
+			itemDiscount += (item.Amount - itemDiscount) * promoRate
+		}
+
+		item.Discount = money.Round(itemDiscount, item.Currency)
+		item.Amount = money.Round(item.Amount-item.Discount, item.Currency)
+	}
+}
+
+// getPromoCodeDiscount returns discount of promo code for order amount of discounted products,
+// discount can't be calculated if order platform or currency is not supported by promo code
+// or discount is not less than discounted amount
+func getPromoCodeDiscount(pc *billing.OrderPromoCode, order *billing.Order, base float64) (float64, bool) {
+	if base <= 0 {
+		return 0, false
+	}
+
+	if len(pc.PlatformIds) > 0 && !contains(pc.PlatformIds, order.PlatformId) {
+		return 0, false
+	}
+
+	var discount float64
+
+	if pc.Type == pkg.PromoCodeTypePercent {
+		discount = base * pc.Percent / 100
+	} else {
+		amount, ok := pc.Amounts[order.Currency]
+
+		if !ok {
+			return 0, false
+		}
+
+		discount = amount
+	}
+
+	if discount <= 0 || discount >= base {
+		return 0, false
+	}
+
+	return discount, true
+}
+
+// getPromoCodeItemsShare returns share of products discounted by promo code in order amount.
+// Items prices are used because items can have other currency than order if order amount was converted.
+func getPromoCodeItemsShare(pc *billing.OrderPromoCode, order *billing.Order) float64 {
+	if len(pc.ProductIds) <= 0 {
+		return 1
+	}
+
+	var total, discounted float64
+
+	for _, item := range order.Items {
+		total += item.Amount
+
+		if isPromoCodeItem(pc, item) {
+			discounted += item.Amount
+		}
+	}
+
+	if total <= 0 {
+		return 0
+	}
+
+	return discounted / total
+}
+
+func isPromoCodeItem(pc *billing.OrderPromoCode, item *billing.OrderItem) bool {
+	return len(pc.ProductIds) <= 0 || contains(pc.ProductIds, item.Id)
+}
@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-redis/redis"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	rabbitmq "gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"testing"
+	"time"
+)
+
+type PromoCodeTestSuite struct {
+	suite.Suite
+	service *Service
+	log     *zap.Logger
+	cache   internalPkg.CacheInterface
+
+	merchant            *billing.Merchant
+	projectWithProducts *billing.Project
+	productIds          []string
+}
+
+func Test_PromoCode(t *testing.T) {
+	suite.Run(t, new(PromoCodeTestSuite))
+}
+
+func (suite *PromoCodeTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+
+	m, err := migrate.New(
+		"file://../../migrations/tests",
+		cfg.MongoDsn)
+	assert.NoError(suite.T(), err, "Migrate init failed")
+
+	err = m.Up()
+	if err != nil && err.Error() != "no change" {
+		suite.FailNow("Migrations failed", "%v", err)
+	}
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.log, err = zap.NewProduction()
+
+	if err != nil {
+		suite.FailNow("Logger initialization failed", "%v", err)
+	}
+
+	broker, err := rabbitmq.NewBroker(cfg.BrokerAddress)
+
+	if err != nil {
+		suite.FailNow("Creating RabbitMQ publisher failed", "%v", err)
+	}
+
+	redisClient := database.NewRedis(
+		&redis.Options{
+			Addr:     cfg.RedisHost,
+			Password: cfg.RedisPassword,
+		},
+	)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		mocks.NewGeoIpServiceTestOk(),
+		mocks.NewRepositoryServiceOk(),
+		mocks.NewTaxServiceOkMock(),
+		broker,
+		redisClient,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		mocks.NewBrokerMockOk(),
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	idx := mgo.Index{Name: "idx_promo_code_uses_promo_code_customer", Key: []string{"promo_code_id", "customer"}, Unique: true}
+	_ = suite.service.db.Collection(collectionPromoCodeUses).EnsureIndex(idx)
+
+	suite.merchant, _, _, _ = helperCreateEntitiesForTests(suite.Suite, suite.service)
+
+	suite.projectWithProducts = &billing.Project{
+		Id:                       bson.NewObjectId().Hex(),
+		CallbackCurrency:         "RUB",
+		CallbackProtocol:         "default",
+		LimitsCurrency:           "USD",
+		MaxPaymentAmount:         15000,
+		MinPaymentAmount:         1,
+		Name:                     map[string]string{"en": "test project 1"},
+		IsProductsCheckout:       true,
+		AllowDynamicRedirectUrls: true,
+		SecretKey:                "test project 1 secret key",
+		Status:                   pkg.ProjectStatusDraft,
+		MerchantId:               suite.merchant.Id,
+	}
+
+	if err := suite.service.project.Insert(suite.projectWithProducts); err != nil {
+		suite.FailNow("Insert project test data failed", "%v", err)
+	}
+
+	for _, product := range createProductsForProject(suite.Suite, suite.service, suite.projectWithProducts, 2) {
+		suite.productIds = append(suite.productIds, product.Id)
+	}
+}
+
+func (suite *PromoCodeTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_CreateOrUpdatePromoCode_Ok() {
+	pc := suite.helperCreatePromoCode(&billing.PromoCode{Code: "summer10", Type: pkg.PromoCodeTypePercent, Percent: 10})
+	assert.NotEmpty(suite.T(), pc.Id)
+	assert.Equal(suite.T(), "SUMMER10", pc.Code)
+
+	rsp := &grpc.ListPromoCodesResponse{}
+	err := suite.service.ListPromoCodes(context.TODO(), &grpc.ListPromoCodesRequest{MerchantId: pc.MerchantId}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Len(suite.T(), rsp.Items, 1)
+	assert.Equal(suite.T(), pc.Id, rsp.Items[0].Id)
+	assert.EqualValues(suite.T(), 0, rsp.Items[0].Uses)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_CreateOrUpdatePromoCode_ValidationError() {
+	req := &billing.PromoCode{
+		MerchantId: suite.projectWithProducts.MerchantId,
+		ProjectId:  suite.projectWithProducts.Id,
+		Code:       "FREE",
+		Type:       pkg.PromoCodeTypePercent,
+		Percent:    100,
+		IsActive:   true,
+	}
+	rsp := &grpc.PromoCodeResponse{}
+	err := suite.service.CreateOrUpdatePromoCode(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorPercentInvalid, rsp.Message)
+
+	req.Type = pkg.PromoCodeTypeFixed
+	rsp = &grpc.PromoCodeResponse{}
+	err = suite.service.CreateOrUpdatePromoCode(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorAmountsInvalid, rsp.Message)
+
+	req.ProjectId = bson.NewObjectId().Hex()
+	rsp = &grpc.PromoCodeResponse{}
+	err = suite.service.CreateOrUpdatePromoCode(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorProjectNotFound, rsp.Message)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_CreateOrUpdatePromoCode_AlreadyExists() {
+	suite.helperCreatePromoCode(&billing.PromoCode{Code: "SUMMER10", Type: pkg.PromoCodeTypePercent, Percent: 10})
+
+	req := &billing.PromoCode{
+		MerchantId: suite.projectWithProducts.MerchantId,
+		Code:       "summer10",
+		Type:       pkg.PromoCodeTypePercent,
+		Percent:    20,
+	}
+	rsp := &grpc.PromoCodeResponse{}
+	err := suite.service.CreateOrUpdatePromoCode(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorAlreadyExists, rsp.Message)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_PaymentFormApplyPromoCode_Percent_Ok() {
+	pc := suite.helperCreatePromoCode(&billing.PromoCode{Code: "SUMMER10", Type: pkg.PromoCodeTypePercent, Percent: 10})
+	order := suite.helperCreateProductsOrder()
+
+	rsp := suite.helperApplyPromoCode(order, "summer10")
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.InDelta(suite.T(), order.OrderAmount*0.9, rsp.Item.Amount, 0.01)
+	assert.InDelta(suite.T(), order.OrderAmount*0.1, rsp.Item.Discount, 0.01)
+	assert.Equal(suite.T(), pc.Id, rsp.Item.PromoCode.Id)
+	assert.Equal(suite.T(), rsp.Item.Discount, rsp.Item.PromoCode.Discount)
+
+	for i, item := range rsp.Item.Items {
+		assert.True(suite.T(), item.Discount > 0)
+		assert.InDelta(suite.T(), order.Items[i].Amount, item.Amount+item.Discount, 0.01)
+	}
+
+	order1, err := suite.service.getOrderByUuid(order.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rsp.Item.Amount, order1.OrderAmount)
+	assert.Equal(suite.T(), rsp.Item.Discount, order1.DiscountAmount)
+	assert.Equal(suite.T(), pc.Code, order1.PromoCode.Code)
+
+	req1 := &grpc.ProcessBillingAddressRequest{OrderId: order.Uuid, Country: "RU"}
+	rsp1 := &grpc.ProcessBillingAddressResponse{}
+	err = suite.service.ProcessBillingAddress(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), rsp.Item.Discount, rsp1.Item.Discount)
+	assert.NotNil(suite.T(), rsp1.Item.PromoCode)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_PaymentFormApplyPromoCode_ProductRestriction_Ok() {
+	suite.helperCreatePromoCode(&billing.PromoCode{
+		Code:       "SUMMER10",
+		Type:       pkg.PromoCodeTypePercent,
+		Percent:    10,
+		ProductIds: []string{suite.productIds[0]},
+	})
+	order := suite.helperCreateProductsOrder()
+
+	rsp := suite.helperApplyPromoCode(order, "SUMMER10")
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	for _, item := range rsp.Item.Items {
+		if item.Id == suite.productIds[0] {
+			assert.True(suite.T(), item.Discount > 0)
+		} else {
+			assert.Zero(suite.T(), item.Discount)
+		}
+	}
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_PaymentFormApplyPromoCode_Fixed_CurrencyNotSupported() {
+	suite.helperCreatePromoCode(&billing.PromoCode{
+		Code:    "MINUS5",
+		Type:    pkg.PromoCodeTypeFixed,
+		Amounts: map[string]float64{"XXX": 5},
+	})
+	order := suite.helperCreateProductsOrder()
+
+	rsp := suite.helperApplyPromoCode(order, "MINUS5")
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorNotApplicable, rsp.Message)
+
+	order1, err := suite.service.getOrderByUuid(order.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), order1.PromoCode)
+	assert.Equal(suite.T(), order.OrderAmount, order1.OrderAmount)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_PaymentFormApplyPromoCode_NotFound() {
+	order := suite.helperCreateProductsOrder()
+
+	rsp := suite.helperApplyPromoCode(order, "UNKNOWN")
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorNotFound, rsp.Message)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_PaymentFormApplyPromoCode_Expired() {
+	validTo, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	suite.helperCreatePromoCode(&billing.PromoCode{
+		Code:    "SUMMER10",
+		Type:    pkg.PromoCodeTypePercent,
+		Percent: 10,
+		ValidTo: validTo,
+	})
+	order := suite.helperCreateProductsOrder()
+
+	rsp := suite.helperApplyPromoCode(order, "SUMMER10")
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorExpired, rsp.Message)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_PaymentFormApplyPromoCode_UsesLimitReached() {
+	suite.helperCreatePromoCode(&billing.PromoCode{Code: "SUMMER10", Type: pkg.PromoCodeTypePercent, Percent: 10, MaxUses: 1})
+	order := suite.helperCreateProductsOrder()
+
+	rsp := suite.helperApplyPromoCode(order, "SUMMER10")
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	err := suite.service.db.Collection(collectionOrder).UpdateId(
+		bson.ObjectIdHex(order.Id),
+		bson.M{"$set": bson.M{"status": constant.OrderPublicStatusProcessed}},
+	)
+	assert.NoError(suite.T(), err)
+
+	order = suite.helperCreateProductsOrder()
+
+	rsp = suite.helperApplyPromoCode(order, "SUMMER10")
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), promoCodeErrorUsesLimitReached, rsp.Message)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_ApplyOrderDiscounts_TokenAndPromoCode() {
+	order := &billing.Order{
+		Currency:        "USD",
+		OrderAmount:     100,
+		DiscountPercent: 10,
+		PromoCode: &billing.OrderPromoCode{
+			Code:    "MINUS9",
+			Type:    pkg.PromoCodeTypeFixed,
+			Amounts: map[string]float64{"USD": 9},
+		},
+		Items: []*billing.OrderItem{
+			{Id: "1", Amount: 60, Currency: "USD"},
+			{Id: "2", Amount: 40, Currency: "USD"},
+		},
+	}
+
+	applyOrderDiscounts(order)
+	assert.Equal(suite.T(), float64(19), order.DiscountAmount)
+	assert.Equal(suite.T(), float64(81), order.OrderAmount)
+	assert.Equal(suite.T(), float64(81), order.TotalPaymentAmount)
+	assert.Equal(suite.T(), float64(9), order.PromoCode.Discount)
+	assert.Equal(suite.T(), float64(48.6), order.Items[0].Amount)
+	assert.Equal(suite.T(), float64(11.4), order.Items[0].Discount)
+	assert.Equal(suite.T(), float64(32.4), order.Items[1].Amount)
+
+	order.Currency = "EUR"
+	order.OrderAmount = 100
+	order.Items[0].Amount = 60
+	order.Items[1].Amount = 40
+
+	applyOrderDiscounts(order)
+	assert.Nil(suite.T(), order.PromoCode)
+	assert.Equal(suite.T(), float64(10), order.DiscountAmount)
+	assert.Equal(suite.T(), float64(90), order.OrderAmount)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_ReserveOrderPromoCodeUse_UsesLimitReached() {
+	suite.helperCreatePromoCode(&billing.PromoCode{Code: "SUMMER10", Type: pkg.PromoCodeTypePercent, Percent: 10, MaxUses: 1})
+	order1 := suite.helperCreateProductsOrder()
+	order2 := suite.helperCreateProductsOrder()
+
+	for _, order := range []*billing.Order{order1, order2} {
+		rsp := suite.helperApplyPromoCode(order, "SUMMER10")
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	}
+
+	order1, err := suite.service.getOrderByUuid(order1.Uuid)
+	assert.NoError(suite.T(), err)
+	order2, err = suite.service.getOrderByUuid(order2.Uuid)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.reserveOrderPromoCodeUse(order1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), promoCodeUseReserved, order1.PrivateMetadata[orderPrivateMetadataPromoCodeUse])
+
+	// repeated payment attempt of the same order doesn't reserve one more use
+	err = suite.service.reserveOrderPromoCodeUse(order1)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.reserveOrderPromoCodeUse(order2)
+	assert.Equal(suite.T(), promoCodeErrorUsesLimitReached, err)
+
+	order1.PrivateStatus = constant.OrderStatusPaymentSystemDeclined
+	err = suite.service.updateOrder(order1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), promoCodeUseReleased, order1.PrivateMetadata[orderPrivateMetadataPromoCodeUse])
+
+	err = suite.service.reserveOrderPromoCodeUse(order2)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_ReserveOrderPromoCodeUse_CustomerByEmail() {
+	pc := suite.helperCreatePromoCode(&billing.PromoCode{
+		Code:               "SUMMER10",
+		Type:               pkg.PromoCodeTypePercent,
+		Percent:            10,
+		MaxUsesPerCustomer: 1,
+	})
+	order1 := suite.helperCreateProductsOrder()
+	order2 := suite.helperCreateProductsOrder()
+
+	for _, order := range []*billing.Order{order1, order2} {
+		assert.Empty(suite.T(), order.User.Id)
+		order.PromoCode = &billing.OrderPromoCode{Id: pc.Id, Code: pc.Code}
+	}
+
+	err := suite.service.reserveOrderPromoCodeUse(order1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "user.email:test@unit.unit", order1.PrivateMetadata[orderPrivateMetadataPromoCodeCustomer])
+
+	err = suite.service.reserveOrderPromoCodeUse(order2)
+	assert.Equal(suite.T(), promoCodeErrorCustomerUsesLimitReached, err)
+
+	// use by all customers is returned when use by customer can't be reserved
+	var uses struct {
+		Uses int32 `bson:"uses"`
+	}
+	query := bson.M{"promo_code_id": bson.ObjectIdHex(pc.Id), "customer": ""}
+	err = suite.service.db.Collection(collectionPromoCodeUses).Find(query).One(&uses)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 1, uses.Uses)
+}
+
+func (suite *PromoCodeTestSuite) TestPromoCode_CheckPromoCodeUsable_CustomerUnknown() {
+	pc := &billing.PromoCode{Id: bson.NewObjectId().Hex(), MaxUsesPerCustomer: 1}
+
+	err := suite.service.checkPromoCodeUsable(pc, &billing.Order{User: &billing.OrderUser{Ip: "127.0.0.1"}})
+	assert.Equal(suite.T(), promoCodeErrorCustomerUnknown, err)
+
+	err = suite.service.checkPromoCodeUsable(pc, &billing.Order{User: &billing.OrderUser{Email: "test@unit.unit"}})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PromoCodeTestSuite) helperCreatePromoCode(pc *billing.PromoCode) *billing.PromoCode {
+	pc.MerchantId = suite.projectWithProducts.MerchantId
+	pc.ProjectId = suite.projectWithProducts.Id
+	pc.IsActive = true
+
+	rsp := &grpc.PromoCodeResponse{}
+	err := suite.service.CreateOrUpdatePromoCode(context.TODO(), pc, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp.Item
+}
+
+func (suite *PromoCodeTestSuite) helperCreateProductsOrder() *billing.Order {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.projectWithProducts.Id,
+		Currency:    "RUB",
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+		Products: suite.productIds,
+		Type:     billing.OrderType_product,
+	}
+	rsp := &grpc.OrderCreateProcessResponse{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	return rsp.Item
+}
+
+func (suite *PromoCodeTestSuite) helperApplyPromoCode(order *billing.Order, code string) *grpc.ProcessBillingAddressResponse {
+	req := &grpc.PaymentFormApplyPromoCodeRequest{OrderId: order.Uuid, Code: code}
+	rsp := &grpc.ProcessBillingAddressResponse{}
+	err := suite.service.PaymentFormApplyPromoCode(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	return rsp
+}
@@ -0,0 +1,556 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	collectionReconciliationRun  = "reconciliation_run"
+	collectionReconciliationLine = "reconciliation_line"
+
+	reconciliationReportColumnType          = "type"
+	reconciliationReportColumnTransactionId = "transaction_id"
+	reconciliationReportColumnAmount        = "amount"
+	reconciliationReportColumnCurrency      = "currency"
+)
+
+var (
+	reconciliationErrorPaymentSystemUnknown  = newBillingServerErrorMsg("rc000001", "payment system handler for reconciliation not supported")
+	reconciliationErrorReportInvalid         = newBillingServerErrorMsg("rc000002", "settlement report can't be parsed")
+	reconciliationErrorReportColumnsNotFound = newBillingServerErrorMsg("rc000003", "settlement report hasn't required columns")
+	reconciliationErrorReportLineInvalid     = newBillingServerErrorMsg("rc000004", "settlement report contains invalid line")
+	reconciliationErrorReportEmpty           = newBillingServerErrorMsg("rc000005", "settlement report hasn't transactions")
+	reconciliationErrorUnknown               = newBillingServerErrorMsg("rc000006", "unknown error. try request later")
+	reconciliationErrorRunNotFound           = newBillingServerErrorMsg("rc000007", "reconciliation run not found")
+	reconciliationErrorLineNotFound          = newBillingServerErrorMsg("rc000008", "reconciliation line not found")
+	reconciliationErrorLineAlreadyResolved   = newBillingServerErrorMsg("rc000009", "reconciliation line already resolved")
+
+	reconciliationReportRequiredColumns = []string{
+		reconciliationReportColumnType,
+		reconciliationReportColumnTransactionId,
+		reconciliationReportColumnAmount,
+		reconciliationReportColumnCurrency,
+	}
+
+	reconciliationLineTypes = map[string]bool{
+		pkg.ReconciliationLineTypePayment: true,
+		pkg.ReconciliationLineTypeRefund:  true,
+	}
+
+	// orders in these statuses were charged by payment system and must be present in settlement report
+	reconciliationSettledOrderStatuses = []string{
+		constant.OrderPublicStatusProcessed,
+		constant.OrderPublicStatusRefunded,
+		constant.OrderPublicStatusChargeback,
+	}
+)
+
+// ImportReconciliationReport parse payment system settlement report in CSV format and match every
+// transaction of report with orders and refunds. If report period was set then paid orders
+// which are absent in report will be marked as missing
+func (s *Service) ImportReconciliationReport(
+	ctx context.Context,
+	req *grpc.ImportReconciliationReportRequest,
+	rsp *grpc.ReconciliationRunResponse,
+) error {
+	if _, ok := paymentSystemHandlers[req.PaymentSystemHandler]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = reconciliationErrorPaymentSystemUnknown
+		return nil
+	}
+
+	lines, err := parseReconciliationReport(req.Content)
+
+	if err != nil {
+		zap.L().Error(
+			"Settlement report parsing failed",
+			zap.Error(err),
+			zap.String("file_name", req.FileName),
+		)
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+		return nil
+	}
+
+	run := &billing.ReconciliationRun{
+		Id:                   bson.NewObjectId().Hex(),
+		PaymentSystemHandler: req.PaymentSystemHandler,
+		FileName:             req.FileName,
+		CreatedAt:            ptypes.TimestampNow(),
+	}
+	settled := make(map[string]bool)
+
+	for _, line := range lines {
+		line.Id = bson.NewObjectId().Hex()
+		line.RunId = run.Id
+		line.CreatedAt = run.CreatedAt
+
+		if err = s.reconcileLine(run, line); err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = reconciliationErrorUnknown
+			return nil
+		}
+
+		if line.Type == pkg.ReconciliationLineTypePayment {
+			settled[line.ExternalId] = true
+		}
+	}
+
+	if req.DateFrom > 0 && req.DateTo > 0 {
+		run.DateFrom, _ = ptypes.TimestampProto(time.Unix(req.DateFrom, 0))
+		run.DateTo, _ = ptypes.TimestampProto(time.Unix(req.DateTo, 0))
+
+		missing, err := s.getReconciliationMissingLines(run, settled, len(lines))
+
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = reconciliationErrorUnknown
+			return nil
+		}
+
+		lines = append(lines, missing...)
+	}
+
+	items := make([]interface{}, len(lines))
+
+	for i, line := range lines {
+		items[i] = line
+
+		switch line.Status {
+		case pkg.ReconciliationLineStatusMatched:
+			run.Matched++
+			break
+		case pkg.ReconciliationLineStatusAmountMismatch, pkg.ReconciliationLineStatusCurrencyMismatch:
+			run.Mismatched++
+			break
+		case pkg.ReconciliationLineStatusOrphan:
+			run.Orphans++
+			break
+		case pkg.ReconciliationLineStatusMissing:
+			run.Missing++
+			break
+		}
+
+		if !line.IsResolved {
+			run.Unresolved++
+		}
+	}
+
+	run.LinesTotal = int32(len(lines))
+	err = s.db.Collection(collectionReconciliationLine).Insert(items...)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationLine),
+			zap.String("run_id", run.Id),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	err = s.db.Collection(collectionReconciliationRun).Insert(run)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationRun),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, run),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = run
+
+	return nil
+}
+
+func (s *Service) ListReconciliationRuns(
+	ctx context.Context,
+	req *grpc.ListReconciliationRunsRequest,
+	rsp *grpc.ListReconciliationRunsResponse,
+) error {
+	query := bson.M{}
+
+	if req.PaymentSystemHandler != "" {
+		query["payment_system_handler"] = req.PaymentSystemHandler
+	}
+
+	count, err := s.db.Collection(collectionReconciliationRun).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationRun),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	var items []*billing.ReconciliationRun
+	err = s.db.Collection(collectionReconciliationRun).Find(query).Sort("-_id").
+		Skip(int(req.Offset)).Limit(getReconciliationLimit(req.Limit)).All(&items)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationRun),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = items
+
+	return nil
+}
+
+func (s *Service) ListReconciliationLines(
+	ctx context.Context,
+	req *grpc.ListReconciliationLinesRequest,
+	rsp *grpc.ListReconciliationLinesResponse,
+) error {
+	if bson.IsObjectIdHex(req.RunId) == false {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = reconciliationErrorRunNotFound
+		return nil
+	}
+
+	query := bson.M{"run_id": bson.ObjectIdHex(req.RunId)}
+
+	if len(req.Status) > 0 {
+		query["status"] = bson.M{"$in": req.Status}
+	}
+
+	if req.UnresolvedOnly {
+		query["is_resolved"] = false
+	}
+
+	count, err := s.db.Collection(collectionReconciliationLine).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationLine),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	var items []*billing.ReconciliationLine
+	err = s.db.Collection(collectionReconciliationLine).Find(query).Sort("line_number").
+		Skip(int(req.Offset)).Limit(getReconciliationLimit(req.Limit)).All(&items)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationLine),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = items
+
+	return nil
+}
+
+func (s *Service) ResolveReconciliationLine(
+	ctx context.Context,
+	req *grpc.ResolveReconciliationLineRequest,
+	rsp *grpc.ReconciliationLineResponse,
+) error {
+	if bson.IsObjectIdHex(req.LineId) == false {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = reconciliationErrorLineNotFound
+		return nil
+	}
+
+	var line *billing.ReconciliationLine
+	err := s.db.Collection(collectionReconciliationLine).FindId(bson.ObjectIdHex(req.LineId)).One(&line)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationLine),
+				zap.String(pkg.ErrorDatabaseFieldDocumentId, req.LineId),
+			)
+		}
+
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = reconciliationErrorLineNotFound
+		return nil
+	}
+
+	if line.IsResolved {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = reconciliationErrorLineAlreadyResolved
+		return nil
+	}
+
+	line.IsResolved = true
+	line.Resolution = req.Resolution
+	line.ResolvedAt = ptypes.TimestampNow()
+
+	err = s.db.Collection(collectionReconciliationLine).UpdateId(bson.ObjectIdHex(line.Id), line)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationLine),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, line),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = reconciliationErrorUnknown
+		return nil
+	}
+
+	update := bson.M{"$inc": bson.M{"unresolved": -1}}
+	err = s.db.Collection(collectionReconciliationRun).UpdateId(bson.ObjectIdHex(line.RunId), update)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionReconciliationRun),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, line.RunId),
+			zap.Any(pkg.ErrorDatabaseFieldSet, update),
+		)
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = line
+
+	return nil
+}
+
+// reconcileLine find order or refund for settlement report line and compare their amounts and currencies
+func (s *Service) reconcileLine(run *billing.ReconciliationRun, line *billing.ReconciliationLine) error {
+	var (
+		query      bson.M
+		collection string
+	)
+
+	if line.Type == pkg.ReconciliationLineTypePayment {
+		collection = collectionOrder
+		query = bson.M{"pm_order_id": line.ExternalId, "payment_method.handler": run.PaymentSystemHandler}
+
+		var order *billing.Order
+		err := s.db.Collection(collection).Find(query).One(&order)
+
+		if err == nil {
+			line.OrderId = order.Id
+			line.ExpectedAmount = order.TotalPaymentAmount
+			line.ExpectedCurrency = order.Currency
+		} else if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collection),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+			return err
+		}
+	} else {
+		collection = collectionRefund
+		query = bson.M{"external_id": line.ExternalId}
+
+		var refund *billing.Refund
+		err := s.db.Collection(collection).Find(query).One(&refund)
+
+		if err == nil {
+			line.RefundId = refund.Id
+			line.OrderId = refund.OriginalOrder.Id
+			line.ExpectedAmount = refund.Amount
+			line.ExpectedCurrency = refund.Currency
+		} else if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collection),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+			return err
+		}
+	}
+
+	switch {
+	case line.OrderId == "":
+		line.Status = pkg.ReconciliationLineStatusOrphan
+		break
+	case line.Currency != line.ExpectedCurrency:
+		line.Status = pkg.ReconciliationLineStatusCurrencyMismatch
+		break
+	case money.ToMinor(line.Amount, line.Currency) != money.ToMinor(line.ExpectedAmount, line.ExpectedCurrency):
+		line.Status = pkg.ReconciliationLineStatusAmountMismatch
+		break
+	default:
+		line.Status = pkg.ReconciliationLineStatusMatched
+		line.IsResolved = true
+	}
+
+	return nil
+}
+
+// getReconciliationMissingLines return lines for paid orders of run period which are absent in settlement report
+func (s *Service) getReconciliationMissingLines(
+	run *billing.ReconciliationRun,
+	settled map[string]bool,
+	lastLineNumber int,
+) ([]*billing.ReconciliationLine, error) {
+	from, _ := ptypes.Timestamp(run.DateFrom)
+	to, _ := ptypes.Timestamp(run.DateTo)
+
+	query := bson.M{
+		"payment_method.handler": run.PaymentSystemHandler,
+		"status":                 bson.M{"$in": reconciliationSettledOrderStatuses},
+		"pm_order_close_date":    bson.M{"$gte": from, "$lte": to},
+	}
+
+	var orders []*billing.Order
+	err := s.db.Collection(collectionOrder).Find(query).Sort("pm_order_close_date").All(&orders)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrder),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	var lines []*billing.ReconciliationLine
+
+	for _, order := range orders {
+		if settled[order.Transaction] {
+			continue
+		}
+
+		lastLineNumber++
+		lines = append(lines, &billing.ReconciliationLine{
+			Id:               bson.NewObjectId().Hex(),
+			RunId:            run.Id,
+			LineNumber:       int32(lastLineNumber),
+			Type:             pkg.ReconciliationLineTypePayment,
+			ExternalId:       order.Transaction,
+			OrderId:          order.Id,
+			ExpectedAmount:   order.TotalPaymentAmount,
+			ExpectedCurrency: order.Currency,
+			Status:           pkg.ReconciliationLineStatusMissing,
+			CreatedAt:        run.CreatedAt,
+		})
+	}
+
+	return lines, nil
+}
+
+// parseReconciliationReport parse settlement report in CSV format with header in first line.
+// Report must contain type, transaction_id, amount and currency columns, other columns are ignored
+func parseReconciliationReport(content []byte) ([]*billing.ReconciliationLine, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+
+	if err != nil {
+		return nil, reconciliationErrorReportInvalid
+	}
+
+	if len(records) < 2 {
+		return nil, reconciliationErrorReportEmpty
+	}
+
+	columns := make(map[string]int)
+
+	for i, v := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(v))] = i
+	}
+
+	for _, v := range reconciliationReportRequiredColumns {
+		if _, ok := columns[v]; !ok {
+			return nil, reconciliationErrorReportColumnsNotFound
+		}
+	}
+
+	var lines []*billing.ReconciliationLine
+
+	for i, record := range records[1:] {
+		if len(record) < len(records[0]) {
+			return nil, reconciliationErrorReportLineInvalid
+		}
+
+		line := &billing.ReconciliationLine{
+			LineNumber: int32(i + 1),
+			Type:       strings.ToLower(strings.TrimSpace(record[columns[reconciliationReportColumnType]])),
+			ExternalId: strings.TrimSpace(record[columns[reconciliationReportColumnTransactionId]]),
+			Currency:   strings.ToUpper(strings.TrimSpace(record[columns[reconciliationReportColumnCurrency]])),
+		}
+
+		line.Amount, err = strconv.ParseFloat(strings.TrimSpace(record[columns[reconciliationReportColumnAmount]]), 64)
+
+		if err != nil || line.ExternalId == "" || !reconciliationLineTypes[line.Type] {
+			return nil, reconciliationErrorReportLineInvalid
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+func getReconciliationLimit(limit int32) int {
+	if limit <= 0 {
+		return pkg.DatabaseRequestDefaultLimit
+	}
+
+	return int(limit)
+}
@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-redis/redis"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	rabbitmq "gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"testing"
+	"time"
+)
+
+type ReconciliationTestSuite struct {
+	suite.Suite
+	service *Service
+	log     *zap.Logger
+	cache   internalPkg.CacheInterface
+
+	project       *billing.Project
+	paymentMethod *billing.PaymentMethod
+	paymentSystem *billing.PaymentSystem
+}
+
+func Test_Reconciliation(t *testing.T) {
+	suite.Run(t, new(ReconciliationTestSuite))
+}
+
+func (suite *ReconciliationTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+
+	m, err := migrate.New(
+		"file://../../migrations/tests",
+		cfg.MongoDsn)
+	assert.NoError(suite.T(), err, "Migrate init failed")
+
+	err = m.Up()
+	if err != nil && err.Error() != "no change" {
+		suite.FailNow("Migrations failed", "%v", err)
+	}
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.log, err = zap.NewProduction()
+
+	if err != nil {
+		suite.FailNow("Logger initialization failed", "%v", err)
+	}
+
+	broker, err := rabbitmq.NewBroker(cfg.BrokerAddress)
+
+	if err != nil {
+		suite.FailNow("Creating RabbitMQ publisher failed", "%v", err)
+	}
+
+	redisClient := database.NewRedis(
+		&redis.Options{
+			Addr:     cfg.RedisHost,
+			Password: cfg.RedisPassword,
+		},
+	)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		mocks.NewGeoIpServiceTestOk(),
+		mocks.NewRepositoryServiceOk(),
+		mocks.NewTaxServiceOkMock(),
+		broker,
+		redisClient,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		broker,
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	_, suite.project, suite.paymentMethod, suite.paymentSystem = helperCreateEntitiesForTests(suite.Suite, suite.service)
+}
+
+func (suite *ReconciliationTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ImportReconciliationReport_Ok() {
+	order1 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order2 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order3 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order4 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	suite.paymentSystem.Handler = paymentSystemHandlerMockOk
+	err := suite.service.paymentSystem.Update(suite.paymentSystem)
+	assert.NoError(suite.T(), err)
+
+	refund := helperMakeRefund(suite.Suite, suite.service, order2, order2.TotalPaymentAmount)
+	assert.NotEmpty(suite.T(), refund.ExternalId)
+
+	report := "Type,Transaction_Id,Amount,Currency,Comment\n" +
+		fmt.Sprintf("payment,%s,%.2f,%s,matched\n", order1.Transaction, order1.TotalPaymentAmount, order1.Currency) +
+		fmt.Sprintf("payment,%s,%.2f,%s,matched\n", order2.Transaction, order2.TotalPaymentAmount, order2.Currency) +
+		fmt.Sprintf("payment,%s,%.2f,%s,amount\n", order3.Transaction, order3.TotalPaymentAmount+1, order3.Currency) +
+		fmt.Sprintf("refund,%s,%.2f,%s,matched\n", refund.ExternalId, refund.Amount, refund.Currency) +
+		"payment,unknown_transaction,10.00,USD,orphan\n"
+
+	req := &grpc.ImportReconciliationReportRequest{
+		PaymentSystemHandler: order1.PaymentMethod.Handler,
+		FileName:             "settlement.csv",
+		Content:              []byte(report),
+		DateFrom:             time.Now().Add(-time.Hour).Unix(),
+		DateTo:               time.Now().Add(time.Hour).Unix(),
+	}
+	rsp := &grpc.ReconciliationRunResponse{}
+	err = suite.service.ImportReconciliationReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Empty(suite.T(), rsp.Message)
+	assert.NotNil(suite.T(), rsp.Item)
+	assert.Equal(suite.T(), "settlement.csv", rsp.Item.FileName)
+	assert.EqualValues(suite.T(), 6, rsp.Item.LinesTotal)
+	assert.EqualValues(suite.T(), 3, rsp.Item.Matched)
+	assert.EqualValues(suite.T(), 1, rsp.Item.Mismatched)
+	assert.EqualValues(suite.T(), 1, rsp.Item.Orphans)
+	assert.EqualValues(suite.T(), 1, rsp.Item.Missing)
+	assert.EqualValues(suite.T(), 3, rsp.Item.Unresolved)
+
+	req1 := &grpc.ListReconciliationLinesRequest{RunId: rsp.Item.Id}
+	rsp1 := &grpc.ListReconciliationLinesResponse{}
+	err = suite.service.ListReconciliationLines(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.EqualValues(suite.T(), 6, rsp1.Count)
+	assert.Len(suite.T(), rsp1.Items, 6)
+
+	assert.Equal(suite.T(), pkg.ReconciliationLineStatusMatched, rsp1.Items[0].Status)
+	assert.Equal(suite.T(), order1.Id, rsp1.Items[0].OrderId)
+	assert.True(suite.T(), rsp1.Items[0].IsResolved)
+
+	assert.Equal(suite.T(), pkg.ReconciliationLineStatusAmountMismatch, rsp1.Items[2].Status)
+	assert.Equal(suite.T(), order3.Id, rsp1.Items[2].OrderId)
+	assert.Equal(suite.T(), order3.TotalPaymentAmount, rsp1.Items[2].ExpectedAmount)
+	assert.False(suite.T(), rsp1.Items[2].IsResolved)
+
+	assert.Equal(suite.T(), pkg.ReconciliationLineStatusMatched, rsp1.Items[3].Status)
+	assert.Equal(suite.T(), refund.Id, rsp1.Items[3].RefundId)
+	assert.Equal(suite.T(), order2.Id, rsp1.Items[3].OrderId)
+
+	assert.Equal(suite.T(), pkg.ReconciliationLineStatusOrphan, rsp1.Items[4].Status)
+	assert.Empty(suite.T(), rsp1.Items[4].OrderId)
+
+	assert.Equal(suite.T(), pkg.ReconciliationLineStatusMissing, rsp1.Items[5].Status)
+	assert.Equal(suite.T(), order4.Id, rsp1.Items[5].OrderId)
+	assert.Equal(suite.T(), order4.Transaction, rsp1.Items[5].ExternalId)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ImportReconciliationReport_CurrencyMismatch_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	report := fmt.Sprintf("type,transaction_id,amount,currency\npayment,%s,%.2f,EUR", order.Transaction, order.TotalPaymentAmount)
+
+	req := &grpc.ImportReconciliationReportRequest{
+		PaymentSystemHandler: order.PaymentMethod.Handler,
+		Content:              []byte(report),
+	}
+	rsp := &grpc.ReconciliationRunResponse{}
+	err := suite.service.ImportReconciliationReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 1, rsp.Item.LinesTotal)
+	assert.EqualValues(suite.T(), 1, rsp.Item.Mismatched)
+	assert.EqualValues(suite.T(), 0, rsp.Item.Missing)
+	assert.Nil(suite.T(), rsp.Item.DateFrom)
+
+	req1 := &grpc.ListReconciliationLinesRequest{
+		RunId:  rsp.Item.Id,
+		Status: []string{pkg.ReconciliationLineStatusCurrencyMismatch},
+	}
+	rsp1 := &grpc.ListReconciliationLinesResponse{}
+	err = suite.service.ListReconciliationLines(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Len(suite.T(), rsp1.Items, 1)
+	assert.Equal(suite.T(), "EUR", rsp1.Items[0].Currency)
+	assert.Equal(suite.T(), order.Currency, rsp1.Items[0].ExpectedCurrency)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ImportReconciliationReport_PaymentSystemUnknown_Error() {
+	req := &grpc.ImportReconciliationReportRequest{
+		PaymentSystemHandler: "unknown",
+		Content:              []byte("type,transaction_id,amount,currency\npayment,1,10,USD"),
+	}
+	rsp := &grpc.ReconciliationRunResponse{}
+	err := suite.service.ImportReconciliationReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), reconciliationErrorPaymentSystemUnknown, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ImportReconciliationReport_ReportInvalid_Error() {
+	reports := map[string]*grpc.ResponseErrorMessage{
+		"":                                    reconciliationErrorReportEmpty,
+		"type,transaction_id,amount,currency": reconciliationErrorReportEmpty,
+		"type,transaction_id,amount\npayment,1,10":                reconciliationErrorReportColumnsNotFound,
+		"type,transaction_id,amount,currency\npayment,1,abc,USD":  reconciliationErrorReportLineInvalid,
+		"type,transaction_id,amount,currency\npayout,1,10,USD":    reconciliationErrorReportLineInvalid,
+		"type,transaction_id,amount,currency\npayment,,10,USD":    reconciliationErrorReportLineInvalid,
+		"type,transaction_id,amount,currency\npayment,1,10":       reconciliationErrorReportLineInvalid,
+		"type,transaction_id,amount,currency\npayment,\"1,10,USD": reconciliationErrorReportInvalid,
+	}
+
+	for report, message := range reports {
+		req := &grpc.ImportReconciliationReportRequest{
+			PaymentSystemHandler: pkg.PaymentSystemHandlerCardPay,
+			Content:              []byte(report),
+		}
+		rsp := &grpc.ReconciliationRunResponse{}
+		err := suite.service.ImportReconciliationReport(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status, report)
+		assert.Equal(suite.T(), message, rsp.Message, report)
+	}
+
+	count, err := suite.service.db.Collection(collectionReconciliationRun).Count()
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), count)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ListReconciliationRuns_Ok() {
+	report := "type,transaction_id,amount,currency\npayment,1,10,USD"
+
+	for i := 0; i < 3; i++ {
+		req := &grpc.ImportReconciliationReportRequest{
+			PaymentSystemHandler: pkg.PaymentSystemHandlerCardPay,
+			FileName:             fmt.Sprintf("settlement_%d.csv", i),
+			Content:              []byte(report),
+		}
+		rsp := &grpc.ReconciliationRunResponse{}
+		err := suite.service.ImportReconciliationReport(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	}
+
+	req := &grpc.ListReconciliationRunsRequest{
+		PaymentSystemHandler: pkg.PaymentSystemHandlerCardPay,
+		Limit:                2,
+	}
+	rsp := &grpc.ListReconciliationRunsResponse{}
+	err := suite.service.ListReconciliationRuns(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 3, rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 2)
+	assert.Equal(suite.T(), "settlement_2.csv", rsp.Items[0].FileName)
+
+	req.PaymentSystemHandler = paymentSystemHandlerMockOk
+	rsp = &grpc.ListReconciliationRunsResponse{}
+	err = suite.service.ListReconciliationRuns(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Zero(suite.T(), rsp.Count)
+	assert.Empty(suite.T(), rsp.Items)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ListReconciliationLines_RunIdInvalid_Error() {
+	req := &grpc.ListReconciliationLinesRequest{RunId: "invalid"}
+	rsp := &grpc.ListReconciliationLinesResponse{}
+	err := suite.service.ListReconciliationLines(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), reconciliationErrorRunNotFound, rsp.Message)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ResolveReconciliationLine_Ok() {
+	req := &grpc.ImportReconciliationReportRequest{
+		PaymentSystemHandler: pkg.PaymentSystemHandlerCardPay,
+		Content:              []byte("type,transaction_id,amount,currency\npayment,1,10,USD\npayment,2,10,USD"),
+	}
+	rsp := &grpc.ReconciliationRunResponse{}
+	err := suite.service.ImportReconciliationReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.EqualValues(suite.T(), 2, rsp.Item.Unresolved)
+
+	req1 := &grpc.ListReconciliationLinesRequest{RunId: rsp.Item.Id, UnresolvedOnly: true}
+	rsp1 := &grpc.ListReconciliationLinesResponse{}
+	err = suite.service.ListReconciliationLines(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), rsp1.Items, 2)
+
+	req2 := &grpc.ResolveReconciliationLineRequest{
+		LineId:     rsp1.Items[0].Id,
+		Resolution: "transaction was made in test mode",
+	}
+	rsp2 := &grpc.ReconciliationLineResponse{}
+	err = suite.service.ResolveReconciliationLine(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.True(suite.T(), rsp2.Item.IsResolved)
+	assert.Equal(suite.T(), req2.Resolution, rsp2.Item.Resolution)
+	assert.NotNil(suite.T(), rsp2.Item.ResolvedAt)
+
+	var run *billing.ReconciliationRun
+	err = suite.service.db.Collection(collectionReconciliationRun).FindId(bson.ObjectIdHex(rsp.Item.Id)).One(&run)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), 1, run.Unresolved)
+
+	rsp1 = &grpc.ListReconciliationLinesResponse{}
+	err = suite.service.ListReconciliationLines(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), rsp1.Items, 1)
+	assert.NotEqual(suite.T(), req2.LineId, rsp1.Items[0].Id)
+
+	rsp2 = &grpc.ReconciliationLineResponse{}
+	err = suite.service.ResolveReconciliationLine(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp2.Status)
+	assert.Equal(suite.T(), reconciliationErrorLineAlreadyResolved, rsp2.Message)
+}
+
+func (suite *ReconciliationTestSuite) TestReconciliation_ResolveReconciliationLine_NotFound_Error() {
+	for _, id := range []string{"invalid", bson.NewObjectId().Hex()} {
+		req := &grpc.ResolveReconciliationLineRequest{LineId: id}
+		rsp := &grpc.ReconciliationLineResponse{}
+		err := suite.service.ResolveReconciliationLine(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+		assert.Equal(suite.T(), reconciliationErrorLineNotFound, rsp.Message)
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	// compare-and-delete, so replica never releases lock acquired by another replica after own lock expired
+	redisLockReleaseScript = `if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`
+	// compare-and-expire, so replica never prolongs lock acquired by another replica after own lock expired
+	redisLockExtendScript = `if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) else return 0 end`
+)
+
+// acquireRedisLock take lock with key for ttl, so only one replica of service do some background job
+// at the same time. Empty string returned if lock is held by another replica, otherwise value of lock
+// which must be passed to releaseRedisLock
+func (s *Service) acquireRedisLock(key string, ttl time.Duration) (string, error) {
+	lock := bson.NewObjectId().Hex()
+	ok, err := s.redis.SetNX(key, lock, ttl).Result()
+
+	if err != nil {
+		zap.L().Error("Redis lock failed", zap.Error(err), zap.String("key", key))
+		return "", err
+	}
+
+	if !ok {
+		return "", nil
+	}
+
+	return lock, nil
+}
+
+func (s *Service) releaseRedisLock(key, lock string) {
+	err := s.redis.Eval(redisLockReleaseScript, []string{key}, lock).Err()
+
+	if err != nil {
+		zap.L().Error("Redis unlock failed", zap.Error(err), zap.String("key", key))
+	}
+}
+
+// extendRedisLock prolong lock for ttl while long background job is in progress.
+// False returned if lock is already expired or held by another replica, job must be stopped then
+func (s *Service) extendRedisLock(key, lock string, ttl time.Duration) (bool, error) {
+	res, err := s.redis.Eval(redisLockExtendScript, []string{key}, lock, ttl.Nanoseconds()/int64(time.Millisecond)).Int64()
+
+	if err != nil {
+		zap.L().Error("Redis lock extend failed", zap.Error(err), zap.String("key", key))
+		return false, err
+	}
+
+	return res == 1, nil
+}
@@ -11,10 +11,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"go.uber.org/zap"
 	"time"
 )
@@ -26,13 +26,14 @@ const (
 )
 
 var (
-	refundErrorUnknown            = newBillingServerErrorMsg("rf000001", "refund can't be create. try request later")
-	refundErrorNotAllowed         = newBillingServerErrorMsg("rf000002", "create refund for order not allowed")
-	refundErrorAlreadyRefunded    = newBillingServerErrorMsg("rf000003", "amount by order was fully refunded")
-	refundErrorPaymentAmountLess  = newBillingServerErrorMsg("rf000004", "refund unavailable, because payment amount less than total refunds amount")
-	refundErrorNotFound           = newBillingServerErrorMsg("rf000005", "refund with specified data not found")
-	refundErrorOrderNotFound      = newBillingServerErrorMsg("rf000006", "information about payment for refund with specified data not found")
-	refundErrorCostsRatesNotFound = newBillingServerErrorMsg("rf000007", "settings to calculate commissions not found")
+	refundErrorUnknown              = newBillingServerErrorMsg("rf000001", "refund can't be create. try request later")
+	refundErrorNotAllowed           = newBillingServerErrorMsg("rf000002", "create refund for order not allowed")
+	refundErrorAlreadyRefunded      = newBillingServerErrorMsg("rf000003", "amount by order was fully refunded")
+	refundErrorPaymentAmountLess    = newBillingServerErrorMsg("rf000004", "refund unavailable, because payment amount less than total refunds amount")
+	refundErrorNotFound             = newBillingServerErrorMsg("rf000005", "refund with specified data not found")
+	refundErrorOrderNotFound        = newBillingServerErrorMsg("rf000006", "information about payment for refund with specified data not found")
+	refundErrorCostsRatesNotFound   = newBillingServerErrorMsg("rf000007", "settings to calculate commissions not found")
+	refundErrorChargebackNotAllowed = newBillingServerErrorMsg("rf000008", "chargeback can't be created as refund, use chargeback api")
 )
 
 type createRefundChecked struct {
@@ -97,6 +98,8 @@ func (s *Service) CreateRefund(
 		return nil
 	}
 
+	_ = s.addRefundOrderViewEvents(refund)
+
 	rsp.Status = pkg.ResponseStatusOk
 	rsp.Item = refund
 
@@ -187,6 +190,26 @@ func (s *Service) ProcessRefundCallback(
 
 		refundId = data.(*billing.CardPayRefundCallback).MerchantOrder.Id
 		break
+	case pkg.PaymentSystemHandlerStripe:
+		data = &billing.StripeRefundCallback{}
+		err := json.Unmarshal(req.Body, data)
+
+		if err != nil || data.(*billing.StripeRefundCallback).GetRefund() == nil {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Error = callbackRequestIncorrect
+
+			return nil
+		}
+
+		refundId = data.(*billing.StripeRefundCallback).GetRefund().Metadata[stripeMetadataFieldRefundId]
+
+		if !bson.IsObjectIdHex(refundId) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Error = callbackRequestIncorrect
+
+			return nil
+		}
+		break
 	default:
 		rsp.Status = pkg.ResponseStatusBadData
 		rsp.Error = callbackHandlerIncorrect
@@ -264,6 +287,8 @@ func (s *Service) ProcessRefundCallback(
 		return nil
 	}
 
+	_ = s.addRefundOrderViewEvents(refund)
+
 	if pErr == nil {
 		err = s.onRefundNotify(ctx, refund, order)
 
@@ -286,13 +311,8 @@ func (s *Service) ProcessRefundCallback(
 		refundedAmount, _ := processor.getRefundedAmount(order)
 
 		if refundedAmount == order.TotalPaymentAmount {
-			if refund.IsChargeback == true {
-				order.PrivateStatus = constant.OrderStatusChargeback
-				order.Status = constant.OrderPublicStatusChargeback
-			} else {
-				order.PrivateStatus = constant.OrderStatusRefund
-				order.Status = constant.OrderPublicStatusRefunded
-			}
+			order.PrivateStatus = constant.OrderStatusRefund
+			order.Status = constant.OrderPublicStatusRefunded
 
 			order.UpdatedAt = ptypes.TimestampNow()
 			order.RefundedAt = ptypes.TimestampNow()
@@ -331,7 +351,7 @@ func (s *Service) createOrderByRefund(order *billing.Order, refund *billing.Refu
 		return "", refundErrorUnknown
 	}
 
-	country, err := s.country.GetByIsoCodeA2(order.GetCountry())
+	country, err := s.getCountryForDate(order.GetCountry(), time.Now())
 	if err != nil {
 		zap.S().Error(
 			"country not found",
@@ -373,11 +393,6 @@ func (s *Service) createOrderByRefund(order *billing.Order, refund *billing.Refu
 	refundOrder.PrivateStatus = constant.OrderStatusRefund
 	refundOrder.Status = constant.OrderPublicStatusRefunded
 
-	if refund.IsChargeback {
-		refundOrder.PrivateStatus = constant.OrderStatusChargeback
-		refundOrder.Status = constant.OrderPublicStatusChargeback
-	}
-
 	refundOrder.CreatedAt = ptypes.TimestampNow()
 	refundOrder.UpdatedAt = ptypes.TimestampNow()
 	refundOrder.RefundedAt = ptypes.TimestampNow()
@@ -394,10 +409,12 @@ func (s *Service) createOrderByRefund(order *billing.Order, refund *billing.Refu
 	refundOrder.ParentPaymentAt = refundOrder.PaymentMethodOrderClosedAt
 	refundOrder.PaymentMethodOrderClosedAt = ptypes.TimestampNow()
 
-	refundOrder.TotalPaymentAmount = refund.Amount
+	totalAmount := money.FromFloat(refund.Amount, refundOrder.Currency, money.RoundHalfUp)
+	taxAmount := totalAmount.Mul(refundOrder.Tax.Rate/(1+refundOrder.Tax.Rate), money.RoundHalfUp)
 
-	refundOrder.Tax.Amount = tools.FormatAmount(refund.Amount / (1 + refundOrder.Tax.Rate) * refundOrder.Tax.Rate)
-	refundOrder.OrderAmount = tools.FormatAmount(refundOrder.TotalPaymentAmount - refundOrder.Tax.Amount)
+	refundOrder.TotalPaymentAmount = totalAmount.Float64()
+	refundOrder.Tax.Amount = taxAmount.Float64()
+	refundOrder.OrderAmount = money.New(totalAmount.Amount()-taxAmount.Amount(), refundOrder.Currency).Float64()
 	refundOrder.ReceiptId = uuid.New().String()
 
 	err = s.db.Collection(collectionOrder).Insert(refundOrder)
@@ -417,6 +434,11 @@ func (s *Service) createOrderByRefund(order *billing.Order, refund *billing.Refu
 }
 
 func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
+	// chargebacks are processed by CreateChargeback with own accounting entries and rolling reserve
+	if p.request.IsChargeback {
+		return nil, newBillingServerResponseError(pkg.ResponseStatusBadData, refundErrorChargebackNotAllowed)
+	}
+
 	err := p.processOrder()
 
 	if err != nil {
@@ -453,12 +475,6 @@ func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
 			Zip:     order.GetPostalCode(),
 			State:   order.GetState(),
 		},
-		IsChargeback: p.request.IsChargeback,
-	}
-
-	if refund.IsChargeback == true {
-		refund.Amount = p.checked.order.TotalPaymentAmount
-		refund.IsChargeback = p.request.IsChargeback
 	}
 
 	if order.Tax != nil {
@@ -476,6 +492,8 @@ func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
 		return nil, newBillingServerResponseError(pkg.ResponseStatusBadData, orderErrorUnknown)
 	}
 
+	_ = p.service.addRefundOrderViewEvents(refund)
+
 	return refund, nil
 }
 
@@ -572,10 +590,6 @@ func (p *createRefundProcessor) hasMoneyBackCosts(order *billing.Order) bool {
 	refundAt := time.Now()
 	reason := pkg.UndoReasonReversal
 
-	if p.request.IsChargeback {
-		reason = pkg.UndoReasonChargeback
-	}
-
 	data := &billing.MoneyBackCostSystemRequest{
 		Name:           methodName,
 		PayoutCurrency: order.GetMerchantRoyaltyCurrency(),
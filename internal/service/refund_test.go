@@ -2474,185 +2474,20 @@ func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_OrderFullyRefunde
 	assert.Equal(suite.T(), int32(constant.OrderStatusRefund), order.PrivateStatus)
 }
 
-func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_Chargeback_Ok() {
-	req := &billing.OrderCreateRequest{
-		Type:        billing.OrderType_simple,
-		ProjectId:   suite.project.Id,
-		Currency:    "RUB",
-		Amount:      100,
-		Account:     "unit test",
-		Description: "unit test",
-		OrderId:     bson.NewObjectId().Hex(),
-		User: &billing.OrderUser{
-			Email: "some_email@unit.com",
-			Ip:    "127.0.0.1",
-			Phone: "123456789",
-		},
-	}
-
-	rsp0 := &grpc.OrderCreateProcessResponse{}
-	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp0)
-	assert.Nil(suite.T(), err)
-	assert.Equal(suite.T(), rsp0.Status, pkg.ResponseStatusOk)
-	rsp := rsp0.Item
-
-	expireYear := time.Now().AddDate(1, 0, 0)
-
-	createPaymentRequest := &grpc.PaymentCreateRequest{
-		Data: map[string]string{
-			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
-			pkg.PaymentCreateFieldPaymentMethodId: suite.pmBankCard.Id,
-			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
-			pkg.PaymentCreateFieldPan:             "4000000000000002",
-			pkg.PaymentCreateFieldCvv:             "123",
-			pkg.PaymentCreateFieldMonth:           "02",
-			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
-			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
-		},
-	}
-
-	rsp1 := &grpc.PaymentCreateResponse{}
-	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
-	assert.NoError(suite.T(), err)
-
-	var order *billing.Order
-	err = suite.service.db.Collection(collectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
-	assert.NotNil(suite.T(), order)
-
-	order.PrivateStatus = constant.OrderStatusPaymentSystemComplete
-	order.Tax = &billing.OrderTax{
-		Type:     taxTypeVat,
-		Rate:     20,
-		Amount:   20,
-		Currency: "RUB",
-	}
-	order.PaymentMethod.Params.Currency = "USD"
-	order.PaymentMethodOrderClosedAt, _ = ptypes.TimestampProto(time.Now().Add(-30 * time.Minute))
-	err = suite.service.updateOrder(order)
-
-	ae := &billing.AccountingEntry{
-		Id:     bson.NewObjectId().Hex(),
-		Object: pkg.ObjectTypeBalanceTransaction,
-		Type:   pkg.AccountingEntryTypeMerchantTaxFeeCostValue,
-		Source: &billing.AccountingEntrySource{
-			Id:   order.Id,
-			Type: collectionOrder,
-		},
-		MerchantId: order.GetMerchantId(),
-		Status:     pkg.BalanceTransactionStatusAvailable,
-		CreatedAt:  ptypes.TimestampNow(),
-		Country:    order.GetCountry(),
-		Currency:   order.GetMerchantRoyaltyCurrency(),
-	}
-
-	ae2 := &billing.AccountingEntry{
-		Id:     bson.NewObjectId().Hex(),
-		Object: pkg.ObjectTypeBalanceTransaction,
-		Type:   pkg.AccountingEntryTypeMerchantTaxFeeCentralBankFx,
-		Source: &billing.AccountingEntrySource{
-			Id:   order.Id,
-			Type: collectionOrder,
-		},
-		MerchantId: order.GetMerchantId(),
-		Status:     pkg.BalanceTransactionStatusAvailable,
-		CreatedAt:  ptypes.TimestampNow(),
-		Country:    order.GetCountry(),
-		Currency:   order.GetMerchantRoyaltyCurrency(),
-	}
-
-	ae3 := &billing.AccountingEntry{
-		Id:     bson.NewObjectId().Hex(),
-		Object: pkg.ObjectTypeBalanceTransaction,
-		Type:   pkg.AccountingEntryTypeRealTaxFee,
-		Source: &billing.AccountingEntrySource{
-			Id:   order.Id,
-			Type: collectionOrder,
-		},
-		MerchantId: order.GetMerchantId(),
-		Status:     pkg.BalanceTransactionStatusAvailable,
-		CreatedAt:  ptypes.TimestampNow(),
-		Country:    order.GetCountry(),
-		Currency:   order.GetMerchantRoyaltyCurrency(),
-	}
-
-	accountingEntries := []interface{}{ae, ae2, ae3}
-	err = suite.service.db.Collection(collectionAccountingEntry).Insert(accountingEntries...)
-	assert.NoError(suite.T(), err)
-
-	req2 := &grpc.CreateRefundRequest{
-		OrderId:      rsp.Uuid,
+func (suite *RefundTestSuite) TestRefund_CreateRefund_Chargeback_Error() {
+	req := &grpc.CreateRefundRequest{
+		OrderId:      uuid.New().String(),
 		Amount:       10,
 		CreatorId:    bson.NewObjectId().Hex(),
 		Reason:       "unit test",
 		IsChargeback: true,
 	}
-	rsp2 := &grpc.CreateRefundResponse{}
-	err = suite.service.CreateRefund(context.TODO(), req2, rsp2)
-	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
-	assert.Empty(suite.T(), rsp2.Message)
-
-	err = suite.service.updateOrder(order)
-
-	refundReq := &billing.CardPayRefundCallback{
-		MerchantOrder: &billing.CardPayMerchantOrder{
-			Id: rsp2.Item.Id,
-		},
-		PaymentMethod: order.PaymentMethod.Group,
-		PaymentData: &billing.CardPayRefundCallbackPaymentData{
-			Id:              rsp2.Item.Id,
-			RemainingAmount: 90,
-		},
-		RefundData: &billing.CardPayRefundCallbackRefundData{
-			Amount:   10,
-			Created:  time.Now().Format(cardPayDateFormat),
-			Id:       bson.NewObjectId().Hex(),
-			Currency: rsp2.Item.Currency,
-			Status:   pkg.CardPayPaymentResponseStatusCompleted,
-			AuthCode: bson.NewObjectId().Hex(),
-			Is_3D:    true,
-			Rrn:      bson.NewObjectId().Hex(),
-		},
-		CallbackTime: time.Now().Format(cardPayDateFormat),
-		Customer: &billing.CardPayCustomer{
-			Email: order.User.Email,
-			Id:    order.User.Email,
-		},
-	}
-
-	b, err := json.Marshal(refundReq)
-	assert.NoError(suite.T(), err)
-
-	hash := sha512.New()
-	hash.Write([]byte(string(b) + order.PaymentMethod.Params.SecretCallback))
-
-	req3 := &grpc.CallbackRequest{
-		Handler:   pkg.PaymentSystemHandlerCardPay,
-		Body:      b,
-		Signature: hex.EncodeToString(hash.Sum(nil)),
-	}
-	rsp3 := &grpc.PaymentNotifyResponse{}
-	err = suite.service.ProcessRefundCallback(context.TODO(), req3, rsp3)
+	rsp := &grpc.CreateRefundResponse{}
+	err := suite.service.CreateRefund(context.TODO(), req, rsp)
 	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp3.Status)
-	assert.Empty(suite.T(), rsp3.Error)
-
-	var refund *billing.Refund
-	err = suite.service.db.Collection(collectionRefund).FindId(bson.ObjectIdHex(rsp2.Item.Id)).One(&refund)
-	assert.NotNil(suite.T(), refund)
-	assert.Equal(suite.T(), pkg.RefundStatusCompleted, refund.Status)
-	assert.True(suite.T(), refund.IsChargeback)
-
-	order, err = suite.service.getOrderById(rsp2.Item.OriginalOrder.Id)
-	assert.NoError(suite.T(), err)
-	assert.NotNil(suite.T(), order)
-	assert.EqualValues(suite.T(), constant.OrderStatusChargeback, order.PrivateStatus)
-	assert.Equal(suite.T(), refund.Amount, order.TotalPaymentAmount)
-
-	err = suite.service.db.Collection(collectionAccountingEntry).
-		Find(bson.M{"source.id": bson.ObjectIdHex(refund.CreatedOrderId), "source.type": collectionRefund}).All(&accountingEntries)
-	assert.NoError(suite.T(), err)
-	assert.NotEmpty(suite.T(), accountingEntries)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), refundErrorChargebackNotAllowed, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
 }
 
 func (suite *RefundTestSuite) TestRefund_CreateRefund_NotHasCostsRates() {
@@ -141,6 +141,40 @@ func (s *Service) getOrdersList(
 	source string,
 	receiver interface{},
 ) (int, interface{}, error) {
+	query := s.getOrdersListQuery(req)
+
+	count, err := s.db.Collection(source).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, source),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		return 0, nil, err
+	}
+
+	err = s.db.Collection(source).Find(query).Sort(req.Sort...).Limit(int(req.Limit)).
+		Skip(int(req.Offset)).All(&receiver)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, source),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		return 0, nil, err
+	}
+
+	return count, receiver, nil
+}
+
+// getOrdersListQuery returns query to find orders by filters of request
+func (s *Service) getOrdersListQuery(req *grpc.ListOrdersRequest) bson.M {
 	query := make(bson.M)
 
 	if len(req.Merchant) > 0 {
@@ -242,32 +276,5 @@ func (s *Service) getOrdersList(
 		}
 	}
 
-	count, err := s.db.Collection(source).Find(query).Count()
-
-	if err != nil {
-		zap.L().Error(
-			pkg.ErrorDatabaseQueryFailed,
-			zap.Error(err),
-			zap.String(pkg.ErrorDatabaseFieldCollection, source),
-			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
-		)
-
-		return 0, nil, err
-	}
-
-	err = s.db.Collection(source).Find(query).Sort(req.Sort...).Limit(int(req.Limit)).
-		Skip(int(req.Offset)).All(&receiver)
-
-	if err != nil {
-		zap.L().Error(
-			pkg.ErrorDatabaseQueryFailed,
-			zap.Error(err),
-			zap.String(pkg.ErrorDatabaseFieldCollection, source),
-			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
-		)
-
-		return 0, nil, err
-	}
-
-	return count, receiver, nil
+	return query
 }
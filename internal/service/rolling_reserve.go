@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	rollingReservePaymentReasonMask = "Rolling reserve %.2f%% of net revenue held for %d days"
+	rollingReserveReleaseReasonMask = "Release of rolling reserve %s"
+
+	rollingReserveReleaseDateFormat = "2006-01-02"
+)
+
+type rollingReserveReleaseQueryResItem struct {
+	Id struct {
+		Currency string `bson:"currency"`
+		Date     string `bson:"date"`
+	} `bson:"_id"`
+	Amount int64 `bson:"amount"`
+}
+
+// holdPaymentRollingReserve withholds percent of payment net revenue from merchant settings into rolling reserve.
+// Reserve is held until rolling reserve period of merchant is passed and released by ReleaseRollingReserves task
+func (s *Service) holdPaymentRollingReserve(ctx context.Context, order *billing.Order, country *billing.Country) error {
+	merchant, err := s.merchant.GetById(order.GetMerchantId())
+
+	if err != nil {
+		return err
+	}
+
+	if merchant.RollingReserveThreshold <= 0 || merchant.RollingReserveDays <= 0 {
+		return nil
+	}
+
+	ov, err := s.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+
+	if err != nil {
+		return err
+	}
+
+	netRevenue := ov.(*billing.OrderViewPrivate).NetRevenue
+
+	if netRevenue == nil || netRevenue.Amount <= 0 {
+		return nil
+	}
+
+	amount := money.FromFloat(netRevenue.Amount, netRevenue.Currency, money.RoundHalfUp).
+		Mul(merchant.RollingReserveThreshold/100, money.RoundHalfUp)
+
+	if amount.Amount() <= 0 {
+		return nil
+	}
+
+	handler := &accountingEntry{Service: s, ctx: ctx, order: order, country: country}
+	entry := handler.newEntry(pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	entry.Amount = amount.Float64()
+	entry.Currency = amount.Currency()
+	entry.Status = pkg.BalanceTransactionStatusHeld
+	entry.Reason = fmt.Sprintf(rollingReservePaymentReasonMask, merchant.RollingReserveThreshold, merchant.RollingReserveDays)
+
+	createdAt, err := ptypes.Timestamp(entry.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	entry.AvailableOn, err = ptypes.TimestampProto(createdAt.AddDate(0, 0, int(merchant.RollingReserveDays)))
+
+	if err != nil {
+		return err
+	}
+
+	if err = handler.addEntry(entry); err != nil {
+		return err
+	}
+
+	if err = handler.saveAccountingEntries(); err != nil {
+		return err
+	}
+
+	_, err = s.updateMerchantBalance(merchant.Id, entry.Currency)
+
+	return err
+}
+
+// ReleaseRollingReserves creates release entries for all held rolling reserves which hold period is passed
+func (s *Service) ReleaseRollingReserves() error {
+	query := bson.M{
+		"type":         pkg.AccountingEntryTypeMerchantRollingReserveCreate,
+		"status":       pkg.BalanceTransactionStatusHeld,
+		"available_on": bson.M{"$lte": time.Now()},
+	}
+
+	var entries []*billing.AccountingEntry
+	err := s.db.Collection(collectionAccountingEntry).Find(query).Sort("available_on").All(&entries)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	balances := make(map[string]map[string]bool)
+
+	for _, entry := range entries {
+		err = s.releaseRollingReserve(context.TODO(), entry)
+
+		if err != nil {
+			zap.L().Error(
+				"Rolling reserve release failed",
+				zap.Error(err),
+				zap.String("accounting_entry_id", entry.Id),
+			)
+			continue
+		}
+
+		if _, ok := balances[entry.MerchantId]; !ok {
+			balances[entry.MerchantId] = make(map[string]bool)
+		}
+
+		balances[entry.MerchantId][entry.Currency] = true
+	}
+
+	for merchantId, currencies := range balances {
+		for currency := range currencies {
+			if _, err = s.updateMerchantBalance(merchantId, currency); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) releaseRollingReserve(ctx context.Context, entry *billing.AccountingEntry) error {
+	query := bson.M{"_id": bson.ObjectIdHex(entry.Id), "status": pkg.BalanceTransactionStatusHeld}
+	set := bson.M{"$set": bson.M{"status": pkg.BalanceTransactionStatusReleased}}
+	err := s.db.Collection(collectionAccountingEntry).Update(query, set)
+
+	if err != nil {
+		// reserve was released by other process
+		if err == mgo.ErrNotFound {
+			return nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, set),
+		)
+		return err
+	}
+
+	release := &billing.AccountingEntry{
+		Id:               bson.NewObjectId().Hex(),
+		Object:           entry.Object,
+		Type:             pkg.AccountingEntryTypeMerchantRollingReserveRelease,
+		Source:           entry.Source,
+		MerchantId:       entry.MerchantId,
+		Amount:           entry.Amount,
+		Currency:         entry.Currency,
+		Reason:           fmt.Sprintf(rollingReserveReleaseReasonMask, entry.Id),
+		Status:           pkg.BalanceTransactionStatusAvailable,
+		Country:          entry.Country,
+		OriginalAmount:   entry.OriginalAmount,
+		OriginalCurrency: entry.OriginalCurrency,
+		LocalAmount:      entry.LocalAmount,
+		LocalCurrency:    entry.LocalCurrency,
+		CreatedAt:        ptypes.TimestampNow(),
+	}
+
+	handler := &accountingEntry{Service: s, ctx: ctx, accountingEntries: []interface{}{release}}
+
+	if err = handler.saveAccountingEntries(); err != nil {
+		query = bson.M{"_id": bson.ObjectIdHex(entry.Id)}
+		set = bson.M{"$set": bson.M{"status": pkg.BalanceTransactionStatusHeld}}
+
+		if err1 := s.db.Collection(collectionAccountingEntry).Update(query, set); err1 != nil {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err1),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+				zap.Any(pkg.ErrorDatabaseFieldSet, set),
+			)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// getRollingReserveReleases returns amounts of held rolling reserves grouped by currency and date of release
+func (s *Service) getRollingReserveReleases(
+	merchantId string,
+	currencies []string,
+) ([]*billing.MerchantBalanceRollingReserveRelease, error) {
+	query := []bson.M{
+		{
+			"$match": bson.M{
+				"merchant_id": bson.ObjectIdHex(merchantId),
+				"currency":    bson.M{"$in": currencies},
+				"type":        pkg.AccountingEntryTypeMerchantRollingReserveCreate,
+				"status":      pkg.BalanceTransactionStatusHeld,
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id": bson.M{
+					"currency": "$currency",
+					"date":     bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": "$available_on"}},
+				},
+				"amount": bson.M{"$sum": "$amount_minor"},
+			},
+		},
+		{
+			"$sort": bson.M{"_id.date": 1},
+		},
+	}
+
+	var items []*rollingReserveReleaseQueryResItem
+	err := s.db.Collection(collectionAccountingEntry).Pipe(query).All(&items)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionAccountingEntry),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	var releases []*billing.MerchantBalanceRollingReserveRelease
+
+	for _, item := range items {
+		date, err := time.Parse(rollingReserveReleaseDateFormat, item.Id.Date)
+
+		if err != nil {
+			zap.L().Error(
+				pkg.ErrorTimeConversion,
+				zap.Any(pkg.ErrorTimeConversionMethod, "time.Parse"),
+				zap.Any(pkg.ErrorTimeConversionValue, item.Id.Date),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		release := &billing.MerchantBalanceRollingReserveRelease{
+			Currency: item.Id.Currency,
+			Amount:   money.FromMinor(item.Amount, item.Id.Currency),
+		}
+		release.ReleaseDate, err = ptypes.TimestampProto(date)
+
+		if err != nil {
+			return nil, err
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-redis/redis"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	rabbitmq "gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"testing"
+	"time"
+)
+
+type RollingReserveTestSuite struct {
+	suite.Suite
+	service *Service
+	log     *zap.Logger
+	cache   internalPkg.CacheInterface
+
+	merchant      *billing.Merchant
+	project       *billing.Project
+	paymentMethod *billing.PaymentMethod
+}
+
+func Test_RollingReserve(t *testing.T) {
+	suite.Run(t, new(RollingReserveTestSuite))
+}
+
+func (suite *RollingReserveTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+
+	m, err := migrate.New(
+		"file://../../migrations/tests",
+		cfg.MongoDsn)
+	assert.NoError(suite.T(), err, "Migrate init failed")
+
+	err = m.Up()
+	if err != nil && err.Error() != "no change" {
+		suite.FailNow("Migrations failed", "%v", err)
+	}
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.log, err = zap.NewProduction()
+
+	if err != nil {
+		suite.FailNow("Logger initialization failed", "%v", err)
+	}
+
+	broker, err := rabbitmq.NewBroker(cfg.BrokerAddress)
+
+	if err != nil {
+		suite.FailNow("Creating RabbitMQ publisher failed", "%v", err)
+	}
+
+	redisClient := database.NewRedis(
+		&redis.Options{
+			Addr:     cfg.RedisHost,
+			Password: cfg.RedisPassword,
+		},
+	)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		mocks.NewGeoIpServiceTestOk(),
+		mocks.NewRepositoryServiceOk(),
+		mocks.NewTaxServiceOkMock(),
+		broker,
+		redisClient,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		broker,
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	_, suite.project, suite.paymentMethod, _ = helperCreateEntitiesForTests(suite.Suite, suite.service)
+
+	suite.merchant, err = suite.service.merchant.GetById(suite.project.MerchantId)
+	assert.NoError(suite.T(), err)
+
+	suite.merchant.Banking.Currency = "RUB"
+	suite.merchant.RollingReserveThreshold = 10
+	suite.merchant.RollingReserveDays = 30
+	err = suite.service.merchant.Update(suite.merchant)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *RollingReserveTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *RollingReserveTestSuite) TestRollingReserve_HoldPaymentRollingReserve_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	ov, err := suite.service.orderView.GetOrderBy(order.Id, "", "", new(billing.OrderViewPrivate))
+	assert.NoError(suite.T(), err)
+	netRevenue := ov.(*billing.OrderViewPrivate).NetRevenue
+
+	entries := suite.helperGetReserveEntries(order.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	assert.Len(suite.T(), entries, 1)
+
+	entry := entries[0]
+	assert.Equal(suite.T(), pkg.BalanceTransactionStatusHeld, entry.Status)
+	assert.Equal(suite.T(), netRevenue.Currency, entry.Currency)
+	assert.Equal(suite.T(), money.Round(netRevenue.Amount*0.1, entry.Currency), entry.Amount)
+
+	createdAt, err := ptypes.Timestamp(entry.CreatedAt)
+	assert.NoError(suite.T(), err)
+	availableOn, err := ptypes.Timestamp(entry.AvailableOn)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), createdAt.AddDate(0, 0, 30).Unix(), availableOn.Unix())
+
+	reserve, err := suite.service.getRollingReserveForBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), entry.Amount, reserve)
+}
+
+func (suite *RollingReserveTestSuite) TestRollingReserve_HoldPaymentRollingReserve_NoReservePeriod() {
+	suite.merchant.RollingReserveDays = 0
+	err := suite.service.merchant.Update(suite.merchant)
+	assert.NoError(suite.T(), err)
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	entries := suite.helperGetReserveEntries(order.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	assert.Empty(suite.T(), entries)
+}
+
+func (suite *RollingReserveTestSuite) TestRollingReserve_ReleaseRollingReserves_Ok() {
+	order1 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order2 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	entries := suite.helperGetReserveEntries(order1.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	assert.Len(suite.T(), entries, 1)
+
+	query := bson.M{"_id": bson.ObjectIdHex(entries[0].Id)}
+	set := bson.M{"$set": bson.M{"available_on": time.Now().Add(-time.Hour)}}
+	err := suite.service.db.Collection(collectionAccountingEntry).Update(query, set)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.ReleaseRollingReserves()
+	assert.NoError(suite.T(), err)
+
+	created := suite.helperGetReserveEntries(order1.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	assert.Equal(suite.T(), pkg.BalanceTransactionStatusReleased, created[0].Status)
+
+	released := suite.helperGetReserveEntries(order1.Id, pkg.AccountingEntryTypeMerchantRollingReserveRelease)
+	assert.Len(suite.T(), released, 1)
+	assert.Equal(suite.T(), created[0].Amount, released[0].Amount)
+	assert.Equal(suite.T(), created[0].Currency, released[0].Currency)
+	assert.Equal(suite.T(), pkg.BalanceTransactionStatusAvailable, released[0].Status)
+
+	// reserve of second order is not due yet
+	held := suite.helperGetReserveEntries(order2.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)
+	assert.Equal(suite.T(), pkg.BalanceTransactionStatusHeld, held[0].Status)
+	assert.Empty(suite.T(), suite.helperGetReserveEntries(order2.Id, pkg.AccountingEntryTypeMerchantRollingReserveRelease))
+
+	reserve, err := suite.service.getRollingReserveForBalance(suite.merchant.Id, suite.merchant.GetPayoutCurrency())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), held[0].Amount, reserve)
+
+	// reserve must not be released twice
+	err = suite.service.ReleaseRollingReserves()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), suite.helperGetReserveEntries(order1.Id, pkg.AccountingEntryTypeMerchantRollingReserveRelease), 1)
+}
+
+func (suite *RollingReserveTestSuite) TestRollingReserve_GetMerchantBalance_UpcomingReleases() {
+	order1 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	order2 := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	entry1 := suite.helperGetReserveEntries(order1.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)[0]
+	entry2 := suite.helperGetReserveEntries(order2.Id, pkg.AccountingEntryTypeMerchantRollingReserveCreate)[0]
+
+	req := &grpc.GetMerchantBalanceRequest{MerchantId: suite.merchant.Id}
+	rsp := &grpc.GetMerchantBalanceResponse{}
+	err := suite.service.GetMerchantBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.NotEmpty(suite.T(), rsp.RollingReserveReleases)
+
+	var total float64
+
+	for _, release := range rsp.RollingReserveReleases {
+		assert.Equal(suite.T(), suite.merchant.GetPayoutCurrency(), release.Currency)
+		assert.NotNil(suite.T(), release.ReleaseDate)
+		total += release.Amount
+	}
+
+	assert.Equal(suite.T(), money.Round(entry1.Amount+entry2.Amount, entry1.Currency), money.Round(total, entry1.Currency))
+}
+
+func (suite *RollingReserveTestSuite) helperGetReserveEntries(orderId, entryType string) []*billing.AccountingEntry {
+	var entries []*billing.AccountingEntry
+	query := bson.M{
+		"source.id":   bson.ObjectIdHex(orderId),
+		"source.type": collectionOrder,
+		"type":        entryType,
+	}
+	err := suite.service.db.Collection(collectionAccountingEntry).Find(query).All(&entries)
+	assert.NoError(suite.T(), err)
+
+	return entries
+}
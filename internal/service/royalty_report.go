@@ -15,10 +15,10 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jinzhu/now"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	reporterConst "github.com/paysuper/paysuper-reporter/pkg"
 	reporterProto "github.com/paysuper/paysuper-reporter/pkg/proto"
 	postmarkSdrPkg "github.com/paysuper/postmark-sender/pkg"
@@ -202,7 +202,7 @@ func (s *Service) AutoAcceptRoyaltyReports(
 			return err
 		}
 
-		_, err = s.updateMerchantBalance(report.MerchantId)
+		_, err = s.updateMerchantBalance(report.MerchantId, report.Currency)
 		if err != nil {
 			return err
 		}
@@ -306,9 +306,23 @@ func (s *Service) MerchantReviewRoyaltyReport(
 		report.Status = pkg.RoyaltyReportStatusAccepted
 		report.AcceptedAt = ptypes.TimestampNow()
 	} else {
+		dispute, err := s.newRoyaltyReportDispute(report, req.DisputeReason, req.DisputeItems)
+		if err != nil {
+			if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+				rsp.Status = pkg.ResponseStatusBadData
+				rsp.Message = e
+				return nil
+			}
+
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = royaltyReportEntryErrorUnknown
+			return nil
+		}
+
 		report.Status = pkg.RoyaltyReportStatusDispute
 		report.DisputeReason = req.DisputeReason
 		report.DisputeStartedAt = ptypes.TimestampNow()
+		report.DisputeId = dispute.Id
 	}
 
 	report.UpdatedAt = ptypes.TimestampNow()
@@ -324,7 +338,7 @@ func (s *Service) MerchantReviewRoyaltyReport(
 	}
 
 	if req.IsAccepted {
-		_, err = s.updateMerchantBalance(report.MerchantId)
+		_, err = s.updateMerchantBalance(report.MerchantId, report.Currency)
 		if err != nil {
 			rsp.Status = pkg.ResponseStatusSystemError
 			rsp.Message = royaltyReportUpdateBalanceError
@@ -382,6 +396,7 @@ func (s *Service) ChangeRoyaltyReport(
 	}
 
 	hasChanges := false
+	isDisputeResolved := false
 
 	if report.Status == pkg.RoyaltyReportStatusDispute && req.Correction != nil {
 
@@ -463,6 +478,7 @@ func (s *Service) ChangeRoyaltyReport(
 	if req.Status != "" && req.Status != report.Status {
 		if report.Status == pkg.RoyaltyReportStatusDispute {
 			report.DisputeClosedAt = ptypes.TimestampNow()
+			isDisputeResolved = true
 		}
 
 		if req.Status == pkg.RoyaltyReportStatusAccepted {
@@ -481,7 +497,13 @@ func (s *Service) ChangeRoyaltyReport(
 
 	report.UpdatedAt = ptypes.TimestampNow()
 
-	err = s.royaltyReport.Update(report, req.Ip, pkg.RoyaltyReportChangeSourceAdmin)
+	// report leaving the dispute is regenerated as new version with all corrections made during the dispute
+	if isDisputeResolved {
+		err = s.resolveRoyaltyReportDispute(ctx, report, "", req.Ip)
+	} else {
+		err = s.royaltyReport.Update(report, req.Ip, pkg.RoyaltyReportChangeSourceAdmin)
+	}
+
 	if err != nil {
 		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
 			rsp.Status = pkg.ResponseStatusSystemError
@@ -493,7 +515,7 @@ func (s *Service) ChangeRoyaltyReport(
 
 	s.sendRoyaltyReportNotification(ctx, report)
 
-	_, err = s.updateMerchantBalance(report.MerchantId)
+	_, err = s.updateMerchantBalance(report.MerchantId, report.Currency)
 	if err != nil {
 		return err
 	}
@@ -581,16 +603,26 @@ func (h *royaltyHandler) getRoyaltyReportCorrections(merchantId, currency string
 		return
 	}
 
+	var totalMinor int64
+
 	for _, e := range accountingEntries {
+		amount := e.Amount
+
+		if _, ok := royaltyReportReversalAccountingEntries[e.Type]; ok {
+			amount = -amount
+		}
+
 		entries = append(entries, &billing.RoyaltyReportCorrectionItem{
 			AccountingEntryId: e.Id,
-			Amount:            e.Amount,
+			Amount:            amount,
 			Reason:            e.Reason,
 			EntryDate:         e.CreatedAt,
 		})
-		total += e.Amount
+		totalMinor += money.ToMinor(amount, currency)
 	}
 
+	total = money.FromMinor(totalMinor, currency)
+
 	return
 }
 
@@ -604,16 +636,27 @@ func (h *royaltyHandler) getRoyaltyReportRollingReserves(merchantId, currency st
 		return
 	}
 
+	var totalMinor int64
+
 	for _, e := range accountingEntries {
+		amount := e.Amount
+
+		// released reserve returns to merchant payout
+		if e.Type == pkg.AccountingEntryTypeMerchantRollingReserveRelease {
+			amount = -amount
+		}
+
 		entries = append(entries, &billing.RoyaltyReportCorrectionItem{
 			AccountingEntryId: e.Id,
-			Amount:            e.Amount,
+			Amount:            amount,
 			Reason:            e.Reason,
 			EntryDate:         e.CreatedAt,
 		})
-		total += e.Amount
+		totalMinor += money.ToMinor(amount, currency)
 	}
 
+	total = money.FromMinor(totalMinor, currency)
+
 	return
 }
 
@@ -625,71 +668,129 @@ func (h *royaltyHandler) createMerchantRoyaltyReport(ctx context.Context, mercha
 		return err
 	}
 
-	isExists, err := h.royaltyReport.CheckReportExists(merchant.Id, merchant.GetPayoutCurrency(), h.from, h.to)
-	if isExists {
-		return royaltyReportErrorAlreadyExists
-	}
+	created := 0
+	primaryExists := false
 
-	summaryItems, summaryTotal, err := h.orderView.GetRoyaltySummary(merchant.Id, merchant.GetPayoutCurrency(), h.from, h.to)
-	if err != nil {
-		return err
-	}
+	for _, currency := range merchant.GetAllPayoutCurrencies() {
+		isPrimary := currency == merchant.GetPayoutCurrency()
 
-	corrections, correctionsTotal, err := h.getRoyaltyReportCorrections(merchant.Id, merchant.GetPayoutCurrency())
-	if err != nil {
-		return err
+		isExists, err := h.royaltyReport.CheckReportExists(merchant.Id, currency, h.from, h.to)
+		if err != nil {
+			return err
+		}
+
+		if isExists {
+			if isPrimary {
+				primaryExists = true
+			}
+			continue
+		}
+
+		isCreated, err := h.createMerchantRoyaltyReportInCurrency(ctx, merchant, currency, isPrimary)
+		if err != nil {
+			return err
+		}
+
+		if isCreated {
+			created++
+		}
 	}
 
-	reserves, reservesTotal, err := h.getRoyaltyReportRollingReserves(merchant.Id, merchant.GetPayoutCurrency())
-	if err != nil {
-		return err
+	if primaryExists && created == 0 {
+		return royaltyReportErrorAlreadyExists
 	}
 
+	zap.L().Info("generating royalty report for merchant finished", zap.String("merchant_id", merchantId.Hex()))
+
+	return nil
+}
+
+// createMerchantRoyaltyReportInCurrency creates royalty report for one of merchant payout currencies.
+// Report for additional payout currency will not be created if there were no operations in this currency
+func (h *royaltyHandler) createMerchantRoyaltyReportInCurrency(
+	ctx context.Context,
+	merchant *billing.Merchant,
+	currency string,
+	isPrimary bool,
+) (bool, error) {
 	report := &billing.RoyaltyReport{
 		Id:         bson.NewObjectId().Hex(),
-		MerchantId: merchantId.Hex(),
-		Currency:   merchant.GetPayoutCurrency(),
+		MerchantId: merchant.Id,
+		Currency:   currency,
 		Status:     pkg.RoyaltyReportStatusPending,
 		CreatedAt:  ptypes.TimestampNow(),
-		Totals: &billing.RoyaltyReportTotals{
-			TransactionsCount:    summaryTotal.TotalTransactions,
-			FeeAmount:            summaryTotal.TotalFees,
-			VatAmount:            summaryTotal.TotalVat,
-			PayoutAmount:         summaryTotal.PayoutAmount,
-			CorrectionAmount:     tools.ToPrecise(correctionsTotal),
-			RollingReserveAmount: tools.ToPrecise(reservesTotal),
-		},
-		Summary: &billing.RoyaltyReportSummary{
-			ProductsItems:   summaryItems,
-			ProductsTotal:   summaryTotal,
-			Corrections:     corrections,
-			RollingReserves: reserves,
-		},
+		Version:    1,
+	}
+
+	err := h.calculateRoyaltyReport(report)
+	if err != nil {
+		return false, err
+	}
+
+	if !isPrimary && report.Totals.TransactionsCount == 0 && len(report.Summary.Corrections) == 0 &&
+		len(report.Summary.RollingReserves) == 0 {
+		return false, nil
 	}
 
 	report.PeriodFrom, err = ptypes.TimestampProto(h.from)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	report.PeriodTo, err = ptypes.TimestampProto(h.to)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	report.AcceptExpireAt, err = ptypes.TimestampProto(time.Now().Add(time.Duration(h.cfg.RoyaltyReportAcceptTimeout) * time.Second))
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	err = h.royaltyReport.Insert(report, "", pkg.RoyaltyReportChangeSourceAuto)
+	if err != nil {
+		return false, err
+	}
 
 	err = h.Service.renderRoyaltyReport(ctx, report, merchant)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// calculateRoyaltyReport fills totals and summary of report by merchant operations in report currency for the period
+func (h *royaltyHandler) calculateRoyaltyReport(report *billing.RoyaltyReport) error {
+	summaryItems, summaryTotal, err := h.orderView.GetRoyaltySummary(report.MerchantId, report.Currency, h.from, h.to)
 	if err != nil {
 		return err
 	}
 
-	zap.L().Info("generating royalty report for merchant finished", zap.String("merchant_id", merchantId.Hex()))
+	corrections, correctionsTotal, err := h.getRoyaltyReportCorrections(report.MerchantId, report.Currency)
+	if err != nil {
+		return err
+	}
+
+	reserves, reservesTotal, err := h.getRoyaltyReportRollingReserves(report.MerchantId, report.Currency)
+	if err != nil {
+		return err
+	}
+
+	report.Totals = &billing.RoyaltyReportTotals{
+		TransactionsCount:    summaryTotal.TotalTransactions,
+		FeeAmount:            summaryTotal.TotalFees,
+		VatAmount:            summaryTotal.TotalVat,
+		PayoutAmount:         summaryTotal.PayoutAmount,
+		CorrectionAmount:     correctionsTotal,
+		RollingReserveAmount: reservesTotal,
+	}
+	report.Summary = &billing.RoyaltyReportSummary{
+		ProductsItems:   summaryItems,
+		ProductsTotal:   summaryTotal,
+		Corrections:     corrections,
+		RollingReserves: reserves,
+	}
 
 	return nil
 }
@@ -969,7 +1070,7 @@ func (r *RoyaltyReport) GetBalanceAmount(merchantId, currency string) (float64,
 		return 0, err
 	}
 
-	return res.Amount, nil
+	return money.Round(res.Amount, currency), nil
 }
 
 func (r *RoyaltyReport) CheckReportExists(merchantId, currency string, from, to time.Time) (bool, error) {
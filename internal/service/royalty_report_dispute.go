@@ -0,0 +1,526 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	collectionRoyaltyReportDispute = "royalty_report_dispute"
+	collectionRoyaltyReportVersion = "royalty_report_version"
+)
+
+var (
+	royaltyReportErrorDisputeItemTypeInvalid    = newBillingServerErrorMsg("rr00011", "unknown type of disputed royalty report line")
+	royaltyReportErrorDisputeOrderNotFound      = newBillingServerErrorMsg("rr00012", "disputed order not found in royalty report")
+	royaltyReportErrorDisputeSummaryNotFound    = newBillingServerErrorMsg("rr00013", "disputed summary line not found in royalty report")
+	royaltyReportErrorDisputeNotFound           = newBillingServerErrorMsg("rr00014", "open dispute for royalty report not found")
+	royaltyReportErrorDisputeItemNotFound       = newBillingServerErrorMsg("rr00015", "disputed line with specified identifier not found")
+	royaltyReportErrorCorrectionTypeInvalid     = newBillingServerErrorMsg("rr00016", "unknown royalty report correction type")
+	royaltyReportErrorDisputeReasonRequired     = newBillingServerErrorMsg("rr00017", "dispute reason or disputed lines required")
+	royaltyReportErrorDisputeItemReasonRequired = newBillingServerErrorMsg("rr00018", "reason of disputed line required")
+
+	royaltyReportCorrectionTypes = map[string]bool{
+		pkg.RoyaltyReportCorrectionTypeFee:          true,
+		pkg.RoyaltyReportCorrectionTypeVat:          true,
+		pkg.RoyaltyReportCorrectionTypeRefund:       true,
+		pkg.RoyaltyReportCorrectionTypeChargeback:   true,
+		pkg.RoyaltyReportCorrectionTypeExchangeRate: true,
+		pkg.RoyaltyReportCorrectionTypeOther:        true,
+	}
+)
+
+func (s *Service) GetRoyaltyReportDispute(
+	ctx context.Context,
+	req *grpc.GetRoyaltyReportRequest,
+	rsp *grpc.RoyaltyReportDisputeResponse,
+) error {
+	report, err := s.royaltyReport.GetById(req.ReportId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = royaltyReportErrorReportNotFound
+			return nil
+		}
+		return err
+	}
+
+	if report.DisputeId == "" {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = royaltyReportErrorDisputeNotFound
+		return nil
+	}
+
+	dispute, err := s.getRoyaltyReportDispute(report.DisputeId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = royaltyReportErrorDisputeNotFound
+			return nil
+		}
+		return err
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = dispute
+
+	return nil
+}
+
+// AddRoyaltyReportDisputeCorrection creates merchant royalty correction entry as answer to royalty report dispute.
+// Correction is dated by the end of report period and will be included into report when dispute will be resolved
+func (s *Service) AddRoyaltyReportDisputeCorrection(
+	ctx context.Context,
+	req *grpc.AddRoyaltyReportDisputeCorrectionRequest,
+	rsp *grpc.RoyaltyReportDisputeResponse,
+) error {
+	if _, ok := royaltyReportCorrectionTypes[req.Type]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = royaltyReportErrorCorrectionTypeInvalid
+		return nil
+	}
+
+	if req.Reason == "" {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = royaltyReportErrorCorrectionReasonRequired
+		return nil
+	}
+
+	if req.Amount == 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = royaltyReportErrorCorrectionAmountRequired
+		return nil
+	}
+
+	report, dispute, msg, err := s.getOpenRoyaltyReportDispute(req.ReportId)
+	if err != nil {
+		return err
+	}
+
+	if msg != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = msg
+		return nil
+	}
+
+	if req.ItemId != "" && dispute.GetItem(req.ItemId) == nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = royaltyReportErrorDisputeItemNotFound
+		return nil
+	}
+
+	merchant, err := s.merchant.GetById(report.MerchantId)
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = accountingEntryErrorMerchantNotFound
+		return nil
+	}
+
+	country, err := s.country.GetByIsoCodeA2(merchant.Company.Country)
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = accountingEntryErrorCountryNotFound
+		return nil
+	}
+
+	to, err := ptypes.Timestamp(report.PeriodTo)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorTimeConversion,
+			zap.Any(pkg.ErrorTimeConversionMethod, "ptypes.Timestamp"),
+			zap.Any(pkg.ErrorTimeConversionValue, report.PeriodTo),
+			zap.Error(err),
+		)
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = royaltyReportEntryErrorUnknown
+		return nil
+	}
+
+	handler := &accountingEntry{Service: s, ctx: ctx, merchant: merchant, country: country}
+	entry := handler.newEntry(pkg.AccountingEntryTypeMerchantRoyaltyCorrection)
+	entry.Source = &billing.AccountingEntrySource{Id: dispute.Id, Type: collectionRoyaltyReportDispute}
+	entry.Amount = req.Amount
+	entry.Currency = report.Currency
+	entry.Reason = req.Reason
+	entry.CreatedAt, err = ptypes.TimestampProto(to.Add(-1 * time.Second))
+
+	if err == nil {
+		err = handler.addEntry(entry)
+	}
+
+	if err == nil {
+		err = handler.saveAccountingEntries()
+	}
+
+	if err != nil {
+		zap.L().Error(
+			"create royalty report dispute correction failed",
+			zap.Error(err),
+			zap.Any("request", req),
+		)
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = royaltyReportEntryErrorUnknown
+		return nil
+	}
+
+	dispute.Corrections = append(dispute.Corrections, &billing.RoyaltyReportDisputeCorrection{
+		AccountingEntryId: entry.Id,
+		ItemId:            req.ItemId,
+		Type:              req.Type,
+		Amount:            entry.Amount,
+		Currency:          entry.Currency,
+		Reason:            entry.Reason,
+		CreatedAt:         ptypes.TimestampNow(),
+	})
+	dispute.UpdatedAt = ptypes.TimestampNow()
+
+	if err = s.updateRoyaltyReportDispute(dispute); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = royaltyReportEntryErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = dispute
+
+	return nil
+}
+
+func (s *Service) ResolveRoyaltyReportDispute(
+	ctx context.Context,
+	req *grpc.ResolveRoyaltyReportDisputeRequest,
+	rsp *grpc.RoyaltyReportDisputeResponse,
+) error {
+	report, dispute, msg, err := s.getOpenRoyaltyReportDispute(req.ReportId)
+	if err != nil {
+		return err
+	}
+
+	if msg != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = msg
+		return nil
+	}
+
+	err = s.resolveRoyaltyReportDispute(ctx, report, req.Resolution, req.Ip)
+	if err != nil {
+		if e, ok := err.(*grpc.ResponseErrorMessage); ok {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = e
+			return nil
+		}
+		return err
+	}
+
+	s.sendRoyaltyReportNotification(ctx, report)
+
+	_, err = s.updateMerchantBalance(report.MerchantId, report.Currency)
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = royaltyReportUpdateBalanceError
+		return nil
+	}
+
+	dispute, err = s.getRoyaltyReportDispute(dispute.Id)
+	if err != nil {
+		return err
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = dispute
+
+	return nil
+}
+
+func (s *Service) ListRoyaltyReportVersions(
+	ctx context.Context,
+	req *grpc.GetRoyaltyReportRequest,
+	rsp *grpc.ListRoyaltyReportVersionsResponse,
+) error {
+	_, err := s.royaltyReport.GetById(req.ReportId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = royaltyReportErrorReportNotFound
+			return nil
+		}
+		return err
+	}
+
+	query := bson.M{"royalty_report_id": bson.ObjectIdHex(req.ReportId)}
+	err = s.db.Collection(collectionRoyaltyReportVersion).Find(query).Sort("version").All(&rsp.Items)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRoyaltyReportVersion),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = royaltyReportEntryErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+// newRoyaltyReportDispute validates lines disputed by merchant and creates open dispute for current report version
+func (s *Service) newRoyaltyReportDispute(
+	report *billing.RoyaltyReport,
+	reason string,
+	items []*billing.RoyaltyReportDisputeItem,
+) (*billing.RoyaltyReportDispute, error) {
+	if reason == "" && len(items) <= 0 {
+		return nil, royaltyReportErrorDisputeReasonRequired
+	}
+
+	from, err := ptypes.Timestamp(report.PeriodFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := ptypes.Timestamp(report.PeriodTo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.Reason == "" {
+			return nil, royaltyReportErrorDisputeItemReasonRequired
+		}
+
+		switch item.Type {
+		case pkg.RoyaltyReportDisputeItemTypeOrder:
+			if item.OrderId == "" {
+				return nil, royaltyReportErrorDisputeOrderNotFound
+			}
+
+			query := bson.M{
+				"uuid":                item.OrderId,
+				"merchant_id":         bson.ObjectIdHex(report.MerchantId),
+				"pm_order_close_date": bson.M{"$gte": from, "$lte": to},
+				"status":              bson.M{"$in": orderStatusForRoyaltyReports},
+			}
+			count, err := s.db.Collection(collectionOrderView).Find(query).Count()
+
+			if err != nil {
+				zap.L().Error(
+					pkg.ErrorDatabaseQueryFailed,
+					zap.Error(err),
+					zap.String(pkg.ErrorDatabaseFieldCollection, collectionOrderView),
+					zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+				)
+				return nil, err
+			}
+
+			if count <= 0 {
+				return nil, royaltyReportErrorDisputeOrderNotFound
+			}
+		case pkg.RoyaltyReportDisputeItemTypeSummary:
+			if report.GetSummaryItem(item.Product, item.Region) == nil {
+				return nil, royaltyReportErrorDisputeSummaryNotFound
+			}
+		default:
+			return nil, royaltyReportErrorDisputeItemTypeInvalid
+		}
+
+		item.Id = bson.NewObjectId().Hex()
+	}
+
+	dispute := &billing.RoyaltyReportDispute{
+		Id:              bson.NewObjectId().Hex(),
+		RoyaltyReportId: report.Id,
+		MerchantId:      report.MerchantId,
+		ReportVersion:   report.GetCurrentVersion(),
+		Status:          pkg.RoyaltyReportDisputeStatusOpen,
+		Reason:          reason,
+		Items:           items,
+		CreatedAt:       ptypes.TimestampNow(),
+		UpdatedAt:       ptypes.TimestampNow(),
+	}
+
+	err = s.db.Collection(collectionRoyaltyReportDispute).Insert(dispute)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRoyaltyReportDispute),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, dispute),
+		)
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+// resolveRoyaltyReportDispute saves current state of report into version history and regenerates report
+// for the same period as new version, so all corrections made during the dispute are included into report
+func (s *Service) resolveRoyaltyReportDispute(
+	ctx context.Context,
+	report *billing.RoyaltyReport,
+	resolution, ip string,
+) error {
+	merchant, err := s.merchant.GetById(report.MerchantId)
+	if err != nil {
+		return err
+	}
+
+	previous, err := s.royaltyReport.GetById(report.Id)
+	if err != nil {
+		return err
+	}
+
+	version := &billing.RoyaltyReportVersion{
+		Id:              bson.NewObjectId().Hex(),
+		RoyaltyReportId: report.Id,
+		Version:         previous.GetCurrentVersion(),
+		DisputeId:       report.DisputeId,
+		Report:          previous,
+		CreatedAt:       ptypes.TimestampNow(),
+	}
+
+	err = s.db.Collection(collectionRoyaltyReportVersion).Insert(version)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRoyaltyReportVersion),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, version),
+		)
+		return err
+	}
+
+	from, err := ptypes.Timestamp(report.PeriodFrom)
+	if err != nil {
+		return err
+	}
+
+	to, err := ptypes.Timestamp(report.PeriodTo)
+	if err != nil {
+		return err
+	}
+
+	handler := &royaltyHandler{
+		Service: s,
+		from:    from,
+		to:      to,
+	}
+
+	if err = handler.calculateRoyaltyReport(report); err != nil {
+		return err
+	}
+
+	report.Version = version.Version + 1
+	report.Status = pkg.RoyaltyReportStatusPending
+	report.DisputeClosedAt = ptypes.TimestampNow()
+	report.UpdatedAt = ptypes.TimestampNow()
+	report.AcceptExpireAt, err = ptypes.TimestampProto(time.Now().Add(time.Duration(s.cfg.RoyaltyReportAcceptTimeout) * time.Second))
+
+	if err != nil {
+		return err
+	}
+
+	if err = s.royaltyReport.Update(report, ip, pkg.RoyaltyReportChangeSourceAdmin); err != nil {
+		return err
+	}
+
+	if report.DisputeId != "" {
+		dispute, err := s.getRoyaltyReportDispute(report.DisputeId)
+		if err != nil {
+			return err
+		}
+
+		if dispute.Status == pkg.RoyaltyReportDisputeStatusOpen {
+			dispute.Status = pkg.RoyaltyReportDisputeStatusResolved
+			dispute.Resolution = resolution
+			dispute.ResolvedAt = ptypes.TimestampNow()
+			dispute.UpdatedAt = ptypes.TimestampNow()
+
+			if err = s.updateRoyaltyReportDispute(dispute); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.renderRoyaltyReport(ctx, report, merchant)
+}
+
+func (s *Service) getOpenRoyaltyReportDispute(reportId string) (
+	*billing.RoyaltyReport,
+	*billing.RoyaltyReportDispute,
+	*grpc.ResponseErrorMessage,
+	error,
+) {
+	report, err := s.royaltyReport.GetById(reportId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, nil, royaltyReportErrorReportNotFound, nil
+		}
+		return nil, nil, nil, err
+	}
+
+	if report.Status != pkg.RoyaltyReportStatusDispute || report.DisputeId == "" {
+		return nil, nil, royaltyReportErrorDisputeNotFound, nil
+	}
+
+	dispute, err := s.getRoyaltyReportDispute(report.DisputeId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, nil, royaltyReportErrorDisputeNotFound, nil
+		}
+		return nil, nil, nil, err
+	}
+
+	if dispute.Status != pkg.RoyaltyReportDisputeStatusOpen {
+		return nil, nil, royaltyReportErrorDisputeNotFound, nil
+	}
+
+	return report, dispute, nil, nil
+}
+
+func (s *Service) getRoyaltyReportDispute(id string) (*billing.RoyaltyReportDispute, error) {
+	dispute := new(billing.RoyaltyReportDispute)
+	err := s.db.Collection(collectionRoyaltyReportDispute).FindId(bson.ObjectIdHex(id)).One(dispute)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRoyaltyReportDispute),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, id),
+		)
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+func (s *Service) updateRoyaltyReportDispute(dispute *billing.RoyaltyReportDispute) error {
+	err := s.db.Collection(collectionRoyaltyReportDispute).UpdateId(bson.ObjectIdHex(dispute.Id), dispute)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionRoyaltyReportDispute),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationUpdate),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, dispute),
+		)
+	}
+
+	return err
+}
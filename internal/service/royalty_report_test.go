@@ -1122,3 +1122,223 @@ func (suite *RoyaltyReportTestSuite) TestRoyaltyReport_GetRoyaltyReport_Ok() {
 	assert.NotEmpty(suite.T(), rsp1.Item)
 	assert.Equal(suite.T(), rsp1.Item, report)
 }
+
+func (suite *RoyaltyReportTestSuite) TestRoyaltyReport_MerchantReviewRoyaltyReport_DisputeItems_Ok() {
+	order := suite.createOrder(suite.project)
+	report := suite.helperCreateRoyaltyReport()
+	assert.NotEmpty(suite.T(), report.Summary.ProductsItems)
+	assert.EqualValues(suite.T(), 1, report.Version)
+
+	summaryItem := report.Summary.ProductsItems[0]
+	req := &grpc.MerchantReviewRoyaltyReportRequest{
+		ReportId:   report.Id,
+		IsAccepted: false,
+		Ip:         "127.0.0.1",
+		DisputeItems: []*billing.RoyaltyReportDisputeItem{
+			{
+				Type:    pkg.RoyaltyReportDisputeItemTypeOrder,
+				OrderId: order.Uuid,
+				Reason:  "order fee is too high",
+			},
+			{
+				Type:           pkg.RoyaltyReportDisputeItemTypeSummary,
+				Product:        summaryItem.Product,
+				Region:         summaryItem.Region,
+				Reason:         "payout amount is incorrect",
+				ExpectedAmount: summaryItem.PayoutAmount + 10,
+			},
+		},
+	}
+	rsp := &grpc.ResponseError{}
+	err := suite.service.MerchantReviewRoyaltyReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	err = suite.service.db.Collection(collectionRoyaltyReport).FindId(bson.ObjectIdHex(report.Id)).One(&report)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RoyaltyReportStatusDispute, report.Status)
+	assert.NotEmpty(suite.T(), report.DisputeId)
+
+	req1 := &grpc.GetRoyaltyReportRequest{ReportId: report.Id}
+	rsp1 := &grpc.RoyaltyReportDisputeResponse{}
+	err = suite.service.GetRoyaltyReportDispute(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), report.DisputeId, rsp1.Item.Id)
+	assert.Equal(suite.T(), pkg.RoyaltyReportDisputeStatusOpen, rsp1.Item.Status)
+	assert.EqualValues(suite.T(), 1, rsp1.Item.ReportVersion)
+	assert.Len(suite.T(), rsp1.Item.Items, 2)
+	assert.NotEmpty(suite.T(), rsp1.Item.Items[0].Id)
+	assert.Equal(suite.T(), order.Uuid, rsp1.Item.Items[0].OrderId)
+	assert.Equal(suite.T(), summaryItem.PayoutAmount+10, rsp1.Item.Items[1].ExpectedAmount)
+}
+
+func (suite *RoyaltyReportTestSuite) TestRoyaltyReport_MerchantReviewRoyaltyReport_DisputeOrderNotFound_Error() {
+	suite.createOrder(suite.project)
+	report := suite.helperCreateRoyaltyReport()
+
+	req := &grpc.MerchantReviewRoyaltyReportRequest{
+		ReportId:   report.Id,
+		IsAccepted: false,
+		Ip:         "127.0.0.1",
+		DisputeItems: []*billing.RoyaltyReportDisputeItem{
+			{
+				Type:    pkg.RoyaltyReportDisputeItemTypeOrder,
+				OrderId: bson.NewObjectId().Hex(),
+				Reason:  "unknown order",
+			},
+		},
+	}
+	rsp := &grpc.ResponseError{}
+	err := suite.service.MerchantReviewRoyaltyReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), royaltyReportErrorDisputeOrderNotFound, rsp.Message)
+
+	err = suite.service.db.Collection(collectionRoyaltyReport).FindId(bson.ObjectIdHex(report.Id)).One(&report)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RoyaltyReportStatusPending, report.Status)
+	assert.Empty(suite.T(), report.DisputeId)
+}
+
+func (suite *RoyaltyReportTestSuite) TestRoyaltyReport_AddRoyaltyReportDisputeCorrection_DisputeNotFound_Error() {
+	suite.createOrder(suite.project)
+	report := suite.helperCreateRoyaltyReport()
+
+	req := &grpc.AddRoyaltyReportDisputeCorrectionRequest{
+		ReportId: report.Id,
+		Type:     pkg.RoyaltyReportCorrectionTypeFee,
+		Amount:   10,
+		Reason:   "unit-test",
+	}
+	rsp := &grpc.RoyaltyReportDisputeResponse{}
+	err := suite.service.AddRoyaltyReportDisputeCorrection(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), royaltyReportErrorDisputeNotFound, rsp.Message)
+}
+
+func (suite *RoyaltyReportTestSuite) TestRoyaltyReport_AddRoyaltyReportDisputeCorrection_TypeInvalid_Error() {
+	req := &grpc.AddRoyaltyReportDisputeCorrectionRequest{
+		ReportId: bson.NewObjectId().Hex(),
+		Type:     "unknown",
+		Amount:   10,
+		Reason:   "unit-test",
+	}
+	rsp := &grpc.RoyaltyReportDisputeResponse{}
+	err := suite.service.AddRoyaltyReportDisputeCorrection(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), royaltyReportErrorCorrectionTypeInvalid, rsp.Message)
+}
+
+func (suite *RoyaltyReportTestSuite) TestRoyaltyReport_ResolveRoyaltyReportDispute_Ok() {
+	order := suite.createOrder(suite.project)
+	report := suite.helperCreateRoyaltyReport()
+	payoutAmount := report.Totals.PayoutAmount
+
+	req := &grpc.MerchantReviewRoyaltyReportRequest{
+		ReportId:   report.Id,
+		IsAccepted: false,
+		Ip:         "127.0.0.1",
+		DisputeItems: []*billing.RoyaltyReportDisputeItem{
+			{
+				Type:    pkg.RoyaltyReportDisputeItemTypeOrder,
+				OrderId: order.Uuid,
+				Reason:  "order fee is too high",
+			},
+		},
+	}
+	rsp := &grpc.ResponseError{}
+	err := suite.service.MerchantReviewRoyaltyReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rsp1 := &grpc.RoyaltyReportDisputeResponse{}
+	err = suite.service.GetRoyaltyReportDispute(context.TODO(), &grpc.GetRoyaltyReportRequest{ReportId: report.Id}, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+
+	req2 := &grpc.AddRoyaltyReportDisputeCorrectionRequest{
+		ReportId: report.Id,
+		ItemId:   rsp1.Item.Items[0].Id,
+		Type:     pkg.RoyaltyReportCorrectionTypeFee,
+		Amount:   10,
+		Reason:   "fee refund",
+		Ip:       "127.0.0.1",
+	}
+	rsp2 := &grpc.RoyaltyReportDisputeResponse{}
+	err = suite.service.AddRoyaltyReportDisputeCorrection(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.Len(suite.T(), rsp2.Item.Corrections, 1)
+
+	correction := rsp2.Item.Corrections[0]
+	assert.Equal(suite.T(), req2.ItemId, correction.ItemId)
+	assert.Equal(suite.T(), pkg.RoyaltyReportCorrectionTypeFee, correction.Type)
+	assert.Equal(suite.T(), report.Currency, correction.Currency)
+
+	entry := new(billing.AccountingEntry)
+	err = suite.service.db.Collection(collectionAccountingEntry).FindId(bson.ObjectIdHex(correction.AccountingEntryId)).One(entry)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.AccountingEntryTypeMerchantRoyaltyCorrection, entry.Type)
+	assert.Equal(suite.T(), collectionRoyaltyReportDispute, entry.Source.Type)
+	assert.Equal(suite.T(), rsp2.Item.Id, entry.Source.Id)
+
+	req3 := &grpc.ResolveRoyaltyReportDisputeRequest{
+		ReportId:   report.Id,
+		Resolution: "fee corrected",
+		Ip:         "127.0.0.1",
+	}
+	rsp3 := &grpc.RoyaltyReportDisputeResponse{}
+	err = suite.service.ResolveRoyaltyReportDispute(context.TODO(), req3, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp3.Status)
+	assert.Equal(suite.T(), pkg.RoyaltyReportDisputeStatusResolved, rsp3.Item.Status)
+	assert.Equal(suite.T(), req3.Resolution, rsp3.Item.Resolution)
+	assert.NotNil(suite.T(), rsp3.Item.ResolvedAt)
+
+	err = suite.service.db.Collection(collectionRoyaltyReport).FindId(bson.ObjectIdHex(report.Id)).One(&report)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RoyaltyReportStatusPending, report.Status)
+	assert.EqualValues(suite.T(), 2, report.Version)
+	assert.Len(suite.T(), report.Summary.Corrections, 1)
+	assert.Equal(suite.T(), float64(10), report.Totals.CorrectionAmount)
+	assert.Equal(suite.T(), payoutAmount, report.Totals.PayoutAmount)
+
+	rsp4 := &grpc.ListRoyaltyReportVersionsResponse{}
+	err = suite.service.ListRoyaltyReportVersions(context.TODO(), &grpc.GetRoyaltyReportRequest{ReportId: report.Id}, rsp4)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp4.Status)
+	assert.Len(suite.T(), rsp4.Items, 1)
+	assert.EqualValues(suite.T(), 1, rsp4.Items[0].Version)
+	assert.Equal(suite.T(), rsp3.Item.Id, rsp4.Items[0].DisputeId)
+	assert.Equal(suite.T(), pkg.RoyaltyReportStatusDispute, rsp4.Items[0].Report.Status)
+	assert.Empty(suite.T(), rsp4.Items[0].Report.Summary.Corrections)
+
+	// resolved dispute can't be resolved twice
+	rsp5 := &grpc.RoyaltyReportDisputeResponse{}
+	err = suite.service.ResolveRoyaltyReportDispute(context.TODO(), req3, rsp5)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp5.Status)
+	assert.Equal(suite.T(), royaltyReportErrorDisputeNotFound, rsp5.Message)
+}
+
+func (suite *RoyaltyReportTestSuite) helperCreateRoyaltyReport() *billing.RoyaltyReport {
+	err := suite.service.updateOrderView([]string{})
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.CreateRoyaltyReportRequest{}
+	rsp := &grpc.CreateRoyaltyReportRequest{}
+	err = suite.service.CreateRoyaltyReport(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), rsp.Merchants)
+
+	report := new(billing.RoyaltyReport)
+	err = suite.service.db.Collection(collectionRoyaltyReport).
+		Find(bson.M{"merchant_id": bson.ObjectIdHex(suite.project.MerchantId)}).One(&report)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RoyaltyReportStatusPending, report.Status)
+
+	return report
+}
@@ -32,9 +32,10 @@ const (
 
 	errorBbNotFoundMessage = "not found"
 
-	HeaderContentType   = "Content-Type"
-	HeaderAuthorization = "Authorization"
-	HeaderContentLength = "Content-Length"
+	HeaderContentType    = "Content-Type"
+	HeaderAuthorization  = "Authorization"
+	HeaderContentLength  = "Content-Length"
+	HeaderIdempotencyKey = "Idempotency-Key"
 
 	MIMEApplicationForm = "application/x-www-form-urlencoded"
 	MIMEApplicationJSON = "application/json"
@@ -87,6 +88,7 @@ type Service struct {
 	documentSigner             documentSignerProto.DocumentSignerService
 	merchantTariffRates        MerchantTariffRatesInterface
 	keyRepository              KeyRepositoryInterface
+	keyEncryption              KeyEncryptionProviderInterface
 	dashboardRepository        DashboardRepositoryInterface
 	orderRepository            OrderRepositoryInterface
 	centrifugo                 CentrifugoInterface
@@ -173,6 +175,13 @@ func (s *Service) Init() (err error) {
 	s.centrifugo = newCentrifugo(s)
 	s.paylinkService = newPaylinkService(s)
 
+	s.keyEncryption, err = newLocalKeyEncryptionProvider(s.cfg.KeyEncryptionMasterKeys)
+
+	if err != nil {
+		zap.L().Error("Keys encryption provider initialization failed", zap.Error(err))
+		return err
+	}
+
 	sCurr, err := s.curService.GetSupportedCurrencies(context.TODO(), &currencies.EmptyRequest{})
 	if err != nil {
 		zap.S().Error(
@@ -0,0 +1,763 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	stripeRequestFieldAmount           = "amount"
+	stripeRequestFieldCurrency         = "currency"
+	stripeRequestFieldDescription      = "description"
+	stripeRequestFieldConfirm          = "confirm"
+	stripeRequestFieldReturnUrl        = "return_url"
+	stripeRequestFieldPaymentMethod    = "payment_method"
+	stripeRequestFieldOffSession       = "off_session"
+	stripeRequestFieldSetupFutureUsage = "setup_future_usage"
+	stripeRequestFieldCaptureMethod    = "capture_method"
+	stripeRequestFieldPaymentIntent    = "payment_intent"
+	stripeRequestFieldReason           = "reason"
+	stripeRequestFieldMetadataOrderId  = "metadata[order_id]"
+	stripeRequestFieldMetadataRefundId = "metadata[refund_id]"
+	stripeRequestFieldCardType         = "payment_method_data[type]"
+	stripeRequestFieldCardNumber       = "payment_method_data[card][number]"
+	stripeRequestFieldCardExpMonth     = "payment_method_data[card][exp_month]"
+	stripeRequestFieldCardExpYear      = "payment_method_data[card][exp_year]"
+	stripeRequestFieldCardCvc          = "payment_method_data[card][cvc]"
+	stripeRequestFieldBillingName      = "payment_method_data[billing_details][name]"
+	stripeRequestFieldBillingEmail     = "payment_method_data[billing_details][email]"
+	stripeRequestFieldThreeDSecure     = "payment_method_options[card][request_three_d_secure]"
+
+	stripeMetadataFieldOrderId  = "order_id"
+	stripeMetadataFieldRefundId = "refund_id"
+
+	stripePaymentMethodTypeCard           = "card"
+	stripeNextActionTypeRedirectToUrl     = "redirect_to_url"
+	stripeRefundReasonRequestedByCustomer = "requested_by_customer"
+
+	stripeSignatureFieldTimestamp = "t"
+	stripeSignatureFieldV1        = "v1"
+
+	// maximal difference in seconds between webhook signature time and current time
+	stripeSignatureTolerance = 300
+)
+
+var (
+	// currencies which amount sent to stripe as is, without multiplying to 100
+	stripeZeroDecimalCurrencies = map[string]bool{
+		"BIF": true,
+		"CLP": true,
+		"DJF": true,
+		"GNF": true,
+		"JPY": true,
+		"KMF": true,
+		"KRW": true,
+		"MGA": true,
+		"PYG": true,
+		"RWF": true,
+		"UGX": true,
+		"VND": true,
+		"VUV": true,
+		"XAF": true,
+		"XOF": true,
+		"XPF": true,
+	}
+
+	successStripeRefundResponseStatuses = map[string]bool{
+		pkg.StripeRefundStatusPending:   true,
+		pkg.StripeRefundStatusSucceeded: true,
+	}
+)
+
+type stripe struct {
+	httpClient *http.Client
+	apiUrl     string
+}
+
+type stripeTransport struct {
+	Transport http.RoundTripper
+}
+
+type stripeContextKey struct {
+	name string
+}
+
+type StripeRedirectToUrl struct {
+	Url       string `json:"url"`
+	ReturnUrl string `json:"return_url"`
+}
+
+type StripeNextAction struct {
+	Type          string               `json:"type"`
+	RedirectToUrl *StripeRedirectToUrl `json:"redirect_to_url"`
+}
+
+type StripePaymentIntentResponse struct {
+	Id               string                              `json:"id"`
+	Status           string                              `json:"status"`
+	Amount           int64                               `json:"amount"`
+	Currency         string                              `json:"currency"`
+	PaymentMethod    string                              `json:"payment_method"`
+	NextAction       *StripeNextAction                   `json:"next_action"`
+	LastPaymentError *billing.StripeCallbackPaymentError `json:"last_payment_error"`
+}
+
+type StripeRefundResponse struct {
+	Id            string `json:"id"`
+	Status        string `json:"status"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+	PaymentIntent string `json:"payment_intent"`
+	FailureReason string `json:"failure_reason"`
+}
+
+func (m *StripeRefundResponse) IsSuccessStatus() bool {
+	v, ok := successStripeRefundResponseStatuses[m.Status]
+	return ok && v == true
+}
+
+func newStripeHandler(cfg *config.PaymentSystemConfig) PaymentSystem {
+	return &stripe{
+		httpClient: &http.Client{
+			Transport: &stripeTransport{},
+			Timeout:   defaultHttpClientTimeout * time.Second,
+		},
+		apiUrl: cfg.StripeApiUrl,
+	}
+}
+
+func (h *stripe) CreatePayment(
+	order *billing.Order,
+	successUrl, failUrl string,
+	requisites map[string]string,
+) (string, error) {
+	data, action, err := h.getPaymentIntentRequest(order, successUrl, requisites)
+
+	if err != nil {
+		return "", err
+	}
+
+	order.PrivateStatus = constant.OrderStatusPaymentSystemRejectOnCreate
+
+	b, status, err := h.request(order, action, order.Id, data)
+
+	if err != nil {
+		return "", err
+	}
+
+	if status != http.StatusOK {
+		zap.L().Error(
+			"payment response returned with bad http status",
+			zap.Int("status", status),
+			zap.String(pkg.LogFieldHandler, pkg.PaymentSystemHandlerStripe),
+			zap.Any("order", order),
+			zap.ByteString(pkg.LogFieldResponse, b),
+		)
+
+		if action == pkg.PaymentSystemActionRecurringPayment {
+			return "", paymentSystemErrorRecurringFailed
+		}
+
+		// client errors mean that payment intent isn't created, server errors can happen after it's created
+		if status >= http.StatusBadRequest && status < http.StatusInternalServerError {
+			return "", paymentSystemErrorCreateRequestRejected
+		}
+
+		return "", paymentSystemErrorCreateRequestFailed
+	}
+
+	rsp := &StripePaymentIntentResponse{}
+	err = json.Unmarshal(b, rsp)
+
+	if err != nil {
+		zap.L().Error(
+			"payment response contain invalid json",
+			zap.Error(err),
+			zap.String(pkg.LogFieldHandler, pkg.PaymentSystemHandlerStripe),
+			zap.Any("order", order),
+			zap.ByteString(pkg.LogFieldResponse, b),
+		)
+		return "", err
+	}
+
+	redirectUrl := successUrl
+
+	switch rsp.Status {
+	case pkg.StripePaymentIntentStatusRequiresAction:
+		if rsp.NextAction == nil || rsp.NextAction.Type != stripeNextActionTypeRedirectToUrl ||
+			rsp.NextAction.RedirectToUrl == nil || rsp.NextAction.RedirectToUrl.Url == "" {
+			return "", paymentSystemErrorCreateRequestFailed
+		}
+
+		redirectUrl = rsp.NextAction.RedirectToUrl.Url
+		break
+	case pkg.StripePaymentIntentStatusSucceeded, pkg.StripePaymentIntentStatusProcessing,
+		pkg.StripePaymentIntentStatusRequiresCapture:
+		break
+	default:
+		if action == pkg.PaymentSystemActionRecurringPayment {
+			return "", paymentSystemErrorRecurringFailed
+		}
+
+		// payment intent is failed or canceled and customer isn't charged
+		return "", paymentSystemErrorCreateRequestRejected
+	}
+
+	order.PrivateStatus = constant.OrderStatusPaymentSystemCreate
+
+	return redirectUrl, nil
+}
+
+func (h *stripe) ProcessPayment(order *billing.Order, message proto.Message, raw, signature string) error {
+	req := message.(*billing.StripePaymentCallback)
+	order.PrivateStatus = constant.OrderStatusPaymentSystemReject
+	err := h.checkCallbackRequestSignature(order, raw, signature)
+
+	if err != nil {
+		return err
+	}
+
+	if !req.IsPaymentAllowedEvent() {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestStatusIsInvalid)
+	}
+
+	intent := req.GetPaymentIntent()
+
+	if intent.Metadata[stripeMetadataFieldOrderId] != order.Id {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestOrderIdIsInvalid)
+	}
+
+	if req.Created <= 0 {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestTimeFieldIsInvalid)
+	}
+
+	ts, err := ptypes.TimestampProto(time.Unix(req.Created, 0))
+
+	if err != nil {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestTimeFieldIsInvalid)
+	}
+
+	if order.PaymentMethod.ExternalId != constant.PaymentSystemGroupAliasBankCard {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestPaymentMethodIsInvalid)
+	}
+
+	if intent.Amount != h.getAmountInMinorUnits(order.TotalPaymentAmount, order.Currency) ||
+		strings.ToUpper(intent.Currency) != order.Currency {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestAmountOrCurrencyIsInvalid)
+	}
+
+	order.PaymentMethodTxnParams = req.GetBankCardTxnParams()
+
+	switch req.Type {
+	case pkg.StripeEventTypePaymentIntentPaymentFailed:
+		order.PrivateStatus = constant.OrderStatusPaymentSystemDeclined
+		break
+	case pkg.StripeEventTypePaymentIntentCanceled:
+		order.PrivateStatus = constant.OrderStatusPaymentSystemCanceled
+
+		if order.IsAuthorizationOnly == true {
+			order.PrivateStatus = pkg.OrderStatusPaymentSystemVoided
+		}
+
+		order.CanceledAt = ptypes.TimestampNow()
+		break
+	case pkg.StripeEventTypePaymentIntentCapturable:
+		if order.IsAuthorizationOnly == false || intent.Status != pkg.StripePaymentIntentStatusRequiresCapture {
+			return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestStatusIsInvalid)
+		}
+
+		order.PrivateStatus = pkg.OrderStatusPaymentSystemAuthorized
+		order.AuthorizedAt = ts
+		order.Transaction = intent.Id
+
+		return nil
+	case pkg.StripeEventTypePaymentIntentSucceeded:
+		order.PrivateStatus = constant.OrderStatusPaymentSystemComplete
+		break
+	default:
+		return newBillingServerResponseError(pkg.StatusTemporary, paymentSystemErrorRequestTemporarySkipped)
+	}
+
+	order.Transaction = intent.Id
+	order.PaymentMethodOrderClosedAt = ts
+
+	return nil
+}
+
+func (h *stripe) IsRecurringCallback(request proto.Message) bool {
+	req := request.(*billing.StripePaymentCallback)
+	return req.Type == pkg.StripeEventTypePaymentIntentSucceeded && req.IsRecurring()
+}
+
+func (h *stripe) GetRecurringId(request proto.Message) string {
+	return request.(*billing.StripePaymentCallback).GetPaymentIntent().PaymentMethod
+}
+
+func (h *stripe) CreateRefund(order *billing.Order, refund *billing.Refund) error {
+	data := url.Values{
+		stripeRequestFieldPaymentIntent:    []string{order.Transaction},
+		stripeRequestFieldAmount:           []string{h.formatAmount(refund.Amount, refund.Currency)},
+		stripeRequestFieldReason:           []string{stripeRefundReasonRequestedByCustomer},
+		stripeRequestFieldMetadataRefundId: []string{refund.Id},
+		stripeRequestFieldMetadataOrderId:  []string{order.Id},
+	}
+
+	refund.Status = pkg.RefundStatusRejected
+	b, status, err := h.request(order, pkg.PaymentSystemActionRefund, refund.Id, data)
+
+	if err != nil {
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	if status != http.StatusOK {
+		zap.L().Error(
+			"refund response returned with bad http status",
+			zap.Int("status", status),
+			zap.String(pkg.LogFieldHandler, pkg.PaymentSystemHandlerStripe),
+			zap.ByteString(pkg.LogFieldResponse, b),
+			zap.Any("refund", refund),
+		)
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	rsp := &StripeRefundResponse{}
+	err = json.Unmarshal(b, rsp)
+
+	if err != nil {
+		zap.L().Error(
+			"refund response contain invalid json",
+			zap.Error(err),
+			zap.String(pkg.LogFieldHandler, pkg.PaymentSystemHandlerStripe),
+			zap.ByteString(pkg.LogFieldResponse, b),
+			zap.Any("refund", refund),
+		)
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	if rsp.IsSuccessStatus() == false {
+		return errors.New(pkg.PaymentSystemErrorCreateRefundRejected)
+	}
+
+	refund.Status = pkg.RefundStatusInProgress
+	refund.ExternalId = rsp.Id
+
+	return nil
+}
+
+func (h *stripe) ProcessRefund(
+	order *billing.Order,
+	refund *billing.Refund,
+	message proto.Message,
+	raw, signature string,
+) error {
+	req := message.(*billing.StripeRefundCallback)
+	refund.Status = pkg.RefundStatusRejected
+
+	err := h.checkCallbackRequestSignature(order, raw, signature)
+
+	if err != nil {
+		err.(*grpc.ResponseError).Status = pkg.ResponseStatusBadData
+		return err
+	}
+
+	if !req.IsRefundAllowedEvent() {
+		return newBillingServerResponseError(pkg.ResponseStatusBadData, paymentSystemErrorRequestStatusIsInvalid)
+	}
+
+	data := req.GetRefund()
+
+	if data.PaymentIntent != order.Transaction {
+		return newBillingServerResponseError(pkg.ResponseStatusBadData, paymentSystemErrorRequestTransactionIsInvalid)
+	}
+
+	if data.Amount != h.getAmountInMinorUnits(refund.Amount, refund.Currency) ||
+		strings.ToUpper(data.Currency) != refund.Currency {
+		return newBillingServerResponseError(pkg.ResponseStatusBadData, paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid)
+	}
+
+	switch data.Status {
+	case pkg.StripeRefundStatusFailed:
+		refund.Status = pkg.RefundStatusPaymentSystemDeclined
+		break
+	case pkg.StripeRefundStatusCanceled:
+		refund.Status = pkg.RefundStatusPaymentSystemCanceled
+		break
+	case pkg.StripeRefundStatusSucceeded:
+		refund.Status = pkg.RefundStatusCompleted
+		break
+	default:
+		return newBillingServerResponseError(pkg.ResponseStatusTemporary, paymentSystemErrorRequestTemporarySkipped)
+	}
+
+	refund.ExternalId = data.Id
+	refund.UpdatedAt = ptypes.TimestampNow()
+
+	return nil
+}
+
+func (h *stripe) CapturePayment(order *billing.Order) error {
+	return h.changePaymentIntentStatus(
+		order,
+		pkg.PaymentSystemActionCapture,
+		pkg.StripePaymentIntentStatusSucceeded,
+		paymentSystemErrorCaptureFailed,
+	)
+}
+
+func (h *stripe) VoidPayment(order *billing.Order) error {
+	return h.changePaymentIntentStatus(
+		order,
+		pkg.PaymentSystemActionVoid,
+		pkg.StripePaymentIntentStatusCanceled,
+		paymentSystemErrorVoidFailed,
+	)
+}
+
+func (h *stripe) changePaymentIntentStatus(
+	order *billing.Order,
+	action, expectedStatus string,
+	failErr *grpc.ResponseErrorMessage,
+) error {
+	b, status, err := h.request(order, action, order.Id+"_"+action, url.Values{}, order.Transaction)
+
+	if err != nil {
+		return failErr
+	}
+
+	if status != http.StatusOK {
+		zap.L().Error(
+			"payment intent status change response returned with bad http status",
+			zap.Int("status", status),
+			zap.String("action", action),
+			zap.String(pkg.LogFieldHandler, pkg.PaymentSystemHandlerStripe),
+			zap.Any("order", order),
+			zap.ByteString(pkg.LogFieldResponse, b),
+		)
+		return failErr
+	}
+
+	rsp := &StripePaymentIntentResponse{}
+	err = json.Unmarshal(b, rsp)
+
+	if err != nil || rsp.Status != expectedStatus {
+		zap.L().Error(
+			"payment intent status change response contain invalid data",
+			zap.Any("error", err),
+			zap.String("action", action),
+			zap.String(pkg.LogFieldHandler, pkg.PaymentSystemHandlerStripe),
+			zap.Any("order", order),
+			zap.ByteString(pkg.LogFieldResponse, b),
+		)
+		return failErr
+	}
+
+	return nil
+}
+
+func (h *stripe) getPaymentIntentRequest(
+	order *billing.Order,
+	successUrl string,
+	requisites map[string]string,
+) (url.Values, string, error) {
+	data := url.Values{
+		stripeRequestFieldAmount:          []string{h.formatAmount(order.TotalPaymentAmount, order.Currency)},
+		stripeRequestFieldCurrency:        []string{strings.ToLower(order.Currency)},
+		stripeRequestFieldDescription:     []string{order.Description},
+		stripeRequestFieldConfirm:         []string{"true"},
+		stripeRequestFieldMetadataOrderId: []string{order.Id},
+	}
+
+	if order.IsAuthorizationOnly == true {
+		data.Set(stripeRequestFieldCaptureMethod, pkg.StripeCaptureMethodManual)
+	}
+
+	recurringId, ok := requisites[pkg.PaymentCreateFieldRecurringId]
+
+	if ok && recurringId != "" {
+		data.Set(stripeRequestFieldPaymentMethod, recurringId)
+		data.Set(stripeRequestFieldOffSession, "true")
+
+		return data, pkg.PaymentSystemActionRecurringPayment, nil
+	}
+
+	if order.PaymentMethod.ExternalId != constant.PaymentSystemGroupAliasBankCard {
+		zap.L().Error(
+			"stripe API: requested create payment for unknown payment method",
+			zap.Any("order", order),
+		)
+		return nil, "", paymentSystemErrorUnknownPaymentMethod
+	}
+
+	data.Set(stripeRequestFieldReturnUrl, successUrl)
+	data.Set(stripeRequestFieldCardType, stripePaymentMethodTypeCard)
+	data.Set(stripeRequestFieldCardNumber, requisites[pkg.PaymentCreateFieldPan])
+	data.Set(stripeRequestFieldCardExpMonth, requisites[pkg.PaymentCreateFieldMonth])
+	data.Set(stripeRequestFieldCardExpYear, requisites[pkg.PaymentCreateFieldYear])
+	data.Set(stripeRequestFieldCardCvc, requisites[pkg.PaymentCreateFieldCvv])
+	data.Set(stripeRequestFieldBillingName, strings.ToUpper(requisites[pkg.PaymentCreateFieldHolder]))
+
+	if order.User != nil && order.User.Email != "" {
+		data.Set(stripeRequestFieldBillingEmail, order.User.Email)
+	}
+
+	if storeData, ok := requisites[pkg.PaymentCreateFieldStoreData]; ok && storeData == "1" {
+		data.Set(stripeRequestFieldSetupFutureUsage, pkg.StripeSetupFutureUsageOffSession)
+	}
+
+	if order.IsFraudCheck3dsRequired() {
+		data.Set(stripeRequestFieldThreeDSecure, pkg.StripeRequestThreeDSecureAny)
+	}
+
+	return data, pkg.PaymentSystemActionCreatePayment, nil
+}
+
+func (h *stripe) request(
+	order *billing.Order,
+	action, idempotencyKey string,
+	data url.Values,
+	pathArgs ...interface{},
+) ([]byte, int, error) {
+	u, err := h.getUrl(h.apiUrl, action, pathArgs...)
+
+	if err != nil {
+		return nil, 0, paymentSystemErrorRequestNotSent
+	}
+
+	method := pkg.StripePaths[action].Method
+	req, err := http.NewRequest(method, u, strings.NewReader(data.Encode()))
+
+	if err != nil {
+		zap.L().Error(
+			"stripe API: create request failed",
+			zap.Error(err),
+			zap.String("method", method),
+			zap.String("url", u),
+			zap.Any("order", order),
+		)
+		return nil, 0, paymentSystemErrorRequestNotSent
+	}
+
+	req.Header.Add(HeaderContentType, MIMEApplicationForm)
+	req.Header.Add(HeaderAuthorization, "Bearer "+order.PaymentMethod.Params.Secret)
+	req.Header.Add(HeaderIdempotencyKey, action+"_"+idempotencyKey)
+
+	rsp, err := h.httpClient.Do(req)
+
+	if err != nil {
+		zap.L().Error(
+			"stripe API: send request failed",
+			zap.Error(err),
+			zap.String("method", method),
+			zap.String("url", u),
+			zap.Any("order", order),
+		)
+		return nil, 0, err
+	}
+
+	b, err := ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+
+	if err != nil {
+		zap.L().Error(
+			"stripe API: reading response failed",
+			zap.Error(err),
+			zap.String("method", method),
+			zap.String("url", u),
+			zap.Any("order", order),
+		)
+		return nil, 0, err
+	}
+
+	return b, rsp.StatusCode, nil
+}
+
+func (h *stripe) getUrl(apiUrl, action string, pathArgs ...interface{}) (string, error) {
+	u, err := url.ParseRequestURI(apiUrl)
+
+	if err != nil {
+		zap.L().Error(
+			"stripe API: api url is invalid",
+			zap.Error(err),
+			zap.String("url", apiUrl),
+		)
+		return "", err
+	}
+
+	u.Path = pkg.StripePaths[action].Path
+
+	if len(pathArgs) > 0 {
+		u.Path = fmt.Sprintf(u.Path, pathArgs...)
+	}
+
+	return u.String(), nil
+}
+
+func (h *stripe) getAmountInMinorUnits(amount float64, currency string) int64 {
+	if _, ok := stripeZeroDecimalCurrencies[strings.ToUpper(currency)]; ok {
+		return int64(math.Round(amount))
+	}
+
+	return int64(math.Round(amount * 100))
+}
+
+func (h *stripe) formatAmount(amount float64, currency string) string {
+	return strconv.FormatInt(h.getAmountInMinorUnits(amount, currency), 10)
+}
+
+// checkCallbackRequestSignature validate signature header in format "t=<timestamp>,v1=<signature>",
+// where signature is hex encoded HMAC-SHA256 of string "<timestamp>.<raw request body>"
+func (h *stripe) checkCallbackRequestSignature(order *billing.Order, raw, signature string) error {
+	var timestamp string
+	var signatures []string
+
+	for _, part := range strings.Split(signature, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case stripeSignatureFieldTimestamp:
+			timestamp = kv[1]
+			break
+		case stripeSignatureFieldV1:
+			signatures = append(signatures, kv[1])
+			break
+		}
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+
+	if err != nil || len(signatures) <= 0 {
+		zap.L().Error(
+			"stripe API: payment callback signature is malformed",
+			zap.String("signature", signature),
+			zap.Any("order", order),
+		)
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestSignatureIsInvalid)
+	}
+
+	expected := getStripeSignature(order.PaymentMethod.Params.SecretCallback, timestamp, raw)
+	isValid := false
+
+	for _, v := range signatures {
+		if hmac.Equal([]byte(v), []byte(expected)) {
+			isValid = true
+			break
+		}
+	}
+
+	if !isValid {
+		zap.L().Error(
+			"stripe API: payment callback signature is invalid",
+			zap.Any("order", order),
+		)
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestSignatureIsInvalid)
+	}
+
+	if math.Abs(float64(time.Now().Unix()-ts)) > stripeSignatureTolerance {
+		return newBillingServerResponseError(pkg.StatusErrorValidation, paymentSystemErrorRequestTimeFieldIsInvalid)
+	}
+
+	return nil
+}
+
+func getStripeSignature(secret, timestamp, raw string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + raw))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (t *stripeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := context.WithValue(req.Context(), &stripeContextKey{name: "StripeRequestStart"}, time.Now())
+	req = req.WithContext(ctx)
+
+	var reqBody []byte
+
+	if req.Body != nil {
+		reqBody, _ = ioutil.ReadAll(req.Body)
+	}
+	req.Body = ioutil.NopCloser(bytes.NewBuffer(reqBody))
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.log(req.URL.Path, req.Header, reqBody, resp)
+
+	return resp, err
+}
+
+func (t *stripeTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
+
+func (t *stripeTransport) log(reqUrl string, reqHeader http.Header, reqBody []byte, rsp *http.Response) {
+	var rspBody []byte
+
+	if rsp.Body != nil {
+		rspBody, _ = ioutil.ReadAll(rsp.Body)
+	}
+	rsp.Body = ioutil.NopCloser(bytes.NewBuffer(rspBody))
+
+	request := string(reqBody)
+	data, err := url.ParseQuery(request)
+
+	if err == nil {
+		if pan := data.Get(stripeRequestFieldCardNumber); pan != "" {
+			data.Set(stripeRequestFieldCardNumber, tools.MaskBankCardNumber(pan))
+		}
+
+		if data.Get(stripeRequestFieldCardCvc) != "" {
+			data.Set(stripeRequestFieldCardCvc, "***")
+		}
+
+		request = data.Encode()
+	}
+
+	headers := make(http.Header)
+
+	for k, v := range reqHeader {
+		if k != HeaderAuthorization {
+			headers[k] = v
+		}
+	}
+
+	zap.L().Info(
+		reqUrl,
+		zap.Any("request_headers", headers),
+		zap.String("request_body", request),
+		zap.Int("response_status", rsp.StatusCode),
+		zap.Any("response_headers", rsp.Header),
+		zap.ByteString("response_body", rspBody),
+	)
+}
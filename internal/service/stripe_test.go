@@ -0,0 +1,497 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"strings"
+	"testing"
+)
+
+const (
+	stripeTestSecretKey     = "sk_test_secret_key"
+	stripeTestWebhookSecret = "whsec_callback_secret_key"
+	stripeTestSuccessUrl    = "http://localhost/success"
+	stripeTestFailUrl       = "http://localhost/fail"
+)
+
+type StripeTestSuite struct {
+	suite.Suite
+
+	server      *mocks.StripeServer
+	handler     PaymentSystem
+	order       *billing.Order
+	logObserver *zap.Logger
+	zapRecorder *observer.ObservedLogs
+}
+
+func Test_Stripe(t *testing.T) {
+	suite.Run(t, new(StripeTestSuite))
+}
+
+func (suite *StripeTestSuite) SetupTest() {
+	var core zapcore.Core
+
+	lvl := zap.NewAtomicLevel()
+	core, suite.zapRecorder = observer.New(lvl)
+	suite.logObserver = zap.New(core)
+	zap.ReplaceGlobals(suite.logObserver)
+
+	suite.server = mocks.NewStripeServer(stripeTestSecretKey, stripeTestWebhookSecret)
+	suite.handler = newStripeHandler(&config.PaymentSystemConfig{StripeApiUrl: suite.server.URL})
+
+	suite.order = &billing.Order{
+		Id: bson.NewObjectId().Hex(),
+		Project: &billing.ProjectOrder{
+			Id:         bson.NewObjectId().Hex(),
+			Name:       map[string]string{"en": "Project Name"},
+			UrlSuccess: stripeTestSuccessUrl,
+			UrlFail:    stripeTestFailUrl,
+		},
+		Description:        fmt.Sprintf(orderDefaultDescription, bson.NewObjectId().Hex()),
+		PrivateStatus:      constant.OrderStatusNew,
+		CreatedAt:          ptypes.TimestampNow(),
+		Items:              []*billing.OrderItem{},
+		TotalPaymentAmount: 10.2,
+		Currency:           "USD",
+		User: &billing.OrderUser{
+			Id:     bson.NewObjectId().Hex(),
+			Object: "user",
+			Email:  "test@unit.test",
+			Ip:     "127.0.0.1",
+			Locale: "en",
+		},
+		PaymentMethod: &billing.PaymentMethodOrder{
+			Id:         bson.NewObjectId().Hex(),
+			Name:       "Bank card",
+			Handler:    pkg.PaymentSystemHandlerStripe,
+			ExternalId: constant.PaymentSystemGroupAliasBankCard,
+			Params: &billing.PaymentMethodParams{
+				Currency:       "USD",
+				Secret:         stripeTestSecretKey,
+				SecretCallback: stripeTestWebhookSecret,
+			},
+			PaymentSystemId: bson.NewObjectId().Hex(),
+			Group:           constant.PaymentSystemGroupAliasBankCard,
+		},
+	}
+}
+
+func (suite *StripeTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *StripeTestSuite) createPayment(requisites map[string]string) *billing.StripeCallbackPaymentIntent {
+	_, err := suite.handler.CreatePayment(suite.order, stripeTestSuccessUrl, stripeTestFailUrl, requisites)
+	assert.NoError(suite.T(), err)
+
+	intent := suite.server.GetPaymentIntentByOrderId(suite.order.Id)
+	assert.NotNil(suite.T(), intent)
+
+	return intent
+}
+
+func (suite *StripeTestSuite) processPayment(intentId, eventType string) (*billing.StripePaymentCallback, error) {
+	raw, signature := suite.server.PaymentWebhook(intentId, eventType)
+	assert.NotEmpty(suite.T(), raw)
+
+	req := &billing.StripePaymentCallback{}
+	err := json.Unmarshal(raw, req)
+	assert.NoError(suite.T(), err)
+
+	return req, suite.handler.ProcessPayment(suite.order, req, string(raw), signature)
+}
+
+func (suite *StripeTestSuite) getRefund() *billing.Refund {
+	return &billing.Refund{
+		Id: bson.NewObjectId().Hex(),
+		OriginalOrder: &billing.RefundOrder{
+			Id:   suite.order.Id,
+			Uuid: suite.order.Uuid,
+		},
+		Amount:    5.1,
+		Currency:  suite.order.Currency,
+		Reason:    "unit test",
+		Status:    pkg.RefundStatusCreated,
+		CreatedAt: ptypes.TimestampNow(),
+	}
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_Ok() {
+	url, err := suite.handler.CreatePayment(suite.order, stripeTestSuccessUrl, stripeTestFailUrl, bankCardRequisites)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), strings.HasPrefix(url, suite.server.URL+"/3ds/"))
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemCreate, suite.order.PrivateStatus)
+
+	intent := suite.server.GetPaymentIntentByOrderId(suite.order.Id)
+	assert.NotNil(suite.T(), intent)
+	assert.EqualValues(suite.T(), 1020, intent.Amount)
+	assert.Equal(suite.T(), "usd", intent.Currency)
+	assert.Equal(suite.T(), pkg.StripePaymentIntentStatusRequiresAction, intent.Status)
+	assert.Empty(suite.T(), intent.SetupFutureUsage)
+
+	messages := suite.zapRecorder.All()
+	assert.NotEmpty(suite.T(), messages)
+
+	for _, v := range messages {
+		for _, f := range v.Context {
+			if f.Key != "request_body" {
+				continue
+			}
+
+			assert.NotContains(suite.T(), f.String, bankCardRequisites[pkg.PaymentCreateFieldPan])
+			assert.NotContains(suite.T(), f.String, bankCardRequisites[pkg.PaymentCreateFieldCvv])
+		}
+	}
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_ZeroDecimalCurrency_Ok() {
+	suite.order.TotalPaymentAmount = 1200
+	suite.order.Currency = "JPY"
+
+	intent := suite.createPayment(bankCardRequisites)
+	assert.EqualValues(suite.T(), 1200, intent.Amount)
+	assert.Equal(suite.T(), "jpy", intent.Currency)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_AuthenticationFailed_Error() {
+	suite.order.PaymentMethod.Params.Secret = "sk_test_unknown"
+
+	url, err := suite.handler.CreatePayment(suite.order, stripeTestSuccessUrl, stripeTestFailUrl, bankCardRequisites)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorCreateRequestRejected, err)
+	assert.Empty(suite.T(), url)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemRejectOnCreate, suite.order.PrivateStatus)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_CardDeclined_Error() {
+	requisites := map[string]string{
+		pkg.PaymentCreateFieldPan:    mocks.StripeCardNumberDeclined,
+		pkg.PaymentCreateFieldMonth:  "12",
+		pkg.PaymentCreateFieldYear:   "2030",
+		pkg.PaymentCreateFieldHolder: "Mr. Card Holder",
+		pkg.PaymentCreateFieldCvv:    "000",
+	}
+
+	url, err := suite.handler.CreatePayment(suite.order, stripeTestSuccessUrl, stripeTestFailUrl, requisites)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorCreateRequestRejected, err)
+	assert.Empty(suite.T(), url)
+	assert.Nil(suite.T(), suite.server.GetPaymentIntentByOrderId(suite.order.Id))
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_UnknownPaymentMethod_Error() {
+	suite.order.PaymentMethod.ExternalId = constant.PaymentSystemGroupAliasQiwi
+
+	url, err := suite.handler.CreatePayment(suite.order, stripeTestSuccessUrl, stripeTestFailUrl, bankCardRequisites)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorUnknownPaymentMethod, err)
+	assert.Empty(suite.T(), url)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_Recurring_Ok() {
+	requisites := map[string]string{pkg.PaymentCreateFieldRecurringId: "pm_saved_card"}
+
+	url, err := suite.handler.CreatePayment(suite.order, stripeTestSuccessUrl, stripeTestFailUrl, requisites)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stripeTestSuccessUrl, url)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemCreate, suite.order.PrivateStatus)
+
+	intent := suite.server.GetPaymentIntentByOrderId(suite.order.Id)
+	assert.NotNil(suite.T(), intent)
+	assert.Equal(suite.T(), "pm_saved_card", intent.PaymentMethod)
+	assert.Equal(suite.T(), pkg.StripePaymentIntentStatusSucceeded, intent.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Ok() {
+	intent := suite.createPayment(bankCardRequisites)
+
+	req, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, suite.order.PrivateStatus)
+	assert.Equal(suite.T(), intent.Id, suite.order.Transaction)
+	assert.NotNil(suite.T(), suite.order.PaymentMethodOrderClosedAt)
+	assert.Equal(suite.T(), "US", suite.order.PaymentMethodTxnParams[pkg.TxnParamsFieldBankCardEmissionCountry])
+	assert.Equal(suite.T(), intent.PaymentMethod, suite.order.PaymentMethodTxnParams[pkg.TxnParamsFieldBankCardToken])
+	assert.False(suite.T(), suite.handler.IsRecurringCallback(req))
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Recurring_Ok() {
+	requisites := make(map[string]string)
+
+	for k, v := range bankCardRequisites {
+		requisites[k] = v
+	}
+
+	requisites[pkg.PaymentCreateFieldStoreData] = "1"
+	intent := suite.createPayment(requisites)
+	assert.Equal(suite.T(), pkg.StripeSetupFutureUsageOffSession, intent.SetupFutureUsage)
+
+	req, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), suite.handler.IsRecurringCallback(req))
+	assert.Equal(suite.T(), intent.PaymentMethod, suite.handler.GetRecurringId(req))
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Declined_Ok() {
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentPaymentFailed)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemDeclined, suite.order.PrivateStatus)
+	assert.Equal(suite.T(), "generic_decline", suite.order.PaymentMethodTxnParams[pkg.TxnParamsFieldDeclineCode])
+	assert.NotEmpty(suite.T(), suite.order.PaymentMethodTxnParams[pkg.TxnParamsFieldDeclineReason])
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Canceled_Ok() {
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCanceled)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemCanceled, suite.order.PrivateStatus)
+	assert.NotNil(suite.T(), suite.order.CanceledAt)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Processing_Temporary() {
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentProcessing)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusTemporary, err.(*grpc.ResponseError).Status)
+	assert.Equal(suite.T(), paymentSystemErrorRequestTemporarySkipped, err.(*grpc.ResponseError).Message)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Authorized_Ok() {
+	suite.order.IsAuthorizationOnly = true
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCapturable)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemAuthorized, suite.order.PrivateStatus)
+	assert.Equal(suite.T(), intent.Id, suite.order.Transaction)
+	assert.NotNil(suite.T(), suite.order.AuthorizedAt)
+	assert.Nil(suite.T(), suite.order.PaymentMethodOrderClosedAt)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_AuthorizedWithoutAuthorizationOnly_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCapturable)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestStatusIsInvalid, err.(*grpc.ResponseError).Message)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemReject, suite.order.PrivateStatus)
+}
+
+func (suite *StripeTestSuite) TestStripe_CapturePayment_Ok() {
+	suite.order.IsAuthorizationOnly = true
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCapturable)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.CapturePayment(suite.order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StripePaymentIntentStatusSucceeded, suite.server.GetPaymentIntent(intent.Id).Status)
+
+	_, err = suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, suite.order.PrivateStatus)
+}
+
+func (suite *StripeTestSuite) TestStripe_CapturePayment_NotAuthorized_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	suite.order.Transaction = intent.Id
+
+	err := suite.handler.CapturePayment(suite.order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorCaptureFailed, err)
+	assert.Equal(suite.T(), pkg.StripePaymentIntentStatusRequiresAction, suite.server.GetPaymentIntent(intent.Id).Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_VoidPayment_Ok() {
+	suite.order.IsAuthorizationOnly = true
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCapturable)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.VoidPayment(suite.order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StripePaymentIntentStatusCanceled, suite.server.GetPaymentIntent(intent.Id).Status)
+
+	_, err = suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCanceled)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemVoided, suite.order.PrivateStatus)
+}
+
+func (suite *StripeTestSuite) TestStripe_VoidPayment_AlreadyCaptured_Error() {
+	suite.order.IsAuthorizationOnly = true
+	intent := suite.createPayment(bankCardRequisites)
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentCapturable)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.CapturePayment(suite.order)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.VoidPayment(suite.order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorVoidFailed, err)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_SignatureInvalid_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	raw, _ := suite.server.PaymentWebhook(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+
+	req := &billing.StripePaymentCallback{}
+	err := json.Unmarshal(raw, req)
+	assert.NoError(suite.T(), err)
+
+	signature := fmt.Sprintf("t=%d,v1=%s", req.Created, getStripeSignature("unknown_secret", fmt.Sprint(req.Created), string(raw)))
+	err = suite.handler.ProcessPayment(suite.order, req, string(raw), signature)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusErrorValidation, err.(*grpc.ResponseError).Status)
+	assert.Equal(suite.T(), paymentSystemErrorRequestSignatureIsInvalid, err.(*grpc.ResponseError).Message)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemReject, suite.order.PrivateStatus)
+
+	err = suite.handler.ProcessPayment(suite.order, req, string(raw), "malformed")
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestSignatureIsInvalid, err.(*grpc.ResponseError).Message)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_SignatureExpired_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	raw, _ := suite.server.PaymentWebhook(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+
+	req := &billing.StripePaymentCallback{}
+	err := json.Unmarshal(raw, req)
+	assert.NoError(suite.T(), err)
+
+	ts := fmt.Sprint(req.Created - stripeSignatureTolerance - 60)
+	signature := "t=" + ts + ",v1=" + getStripeSignature(stripeTestWebhookSecret, ts, string(raw))
+	err = suite.handler.ProcessPayment(suite.order, req, string(raw), signature)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestTimeFieldIsInvalid, err.(*grpc.ResponseError).Message)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_AmountMismatch_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	suite.order.TotalPaymentAmount = 11
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestAmountOrCurrencyIsInvalid, err.(*grpc.ResponseError).Message)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemReject, suite.order.PrivateStatus)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_OrderMismatch_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	suite.order.Id = bson.NewObjectId().Hex()
+
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestOrderIdIsInvalid, err.(*grpc.ResponseError).Message)
+}
+
+func (suite *StripeTestSuite) TestStripe_Refund_Ok() {
+	intent := suite.createPayment(bankCardRequisites)
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+
+	refund := suite.getRefund()
+	err = suite.handler.CreateRefund(suite.order, refund)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusInProgress, refund.Status)
+	assert.NotEmpty(suite.T(), refund.ExternalId)
+
+	stripeRefund := suite.server.GetRefund(refund.ExternalId)
+	assert.NotNil(suite.T(), stripeRefund)
+	assert.EqualValues(suite.T(), 510, stripeRefund.Amount)
+	assert.Equal(suite.T(), refund.Id, stripeRefund.Metadata[stripeMetadataFieldRefundId])
+
+	raw, signature := suite.server.RefundWebhook(refund.ExternalId, pkg.StripeRefundStatusSucceeded)
+	req := &billing.StripeRefundCallback{}
+	err = json.Unmarshal(raw, req)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.ProcessRefund(suite.order, refund, req, string(raw), signature)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusCompleted, refund.Status)
+	assert.Equal(suite.T(), stripeRefund.Id, refund.ExternalId)
+	assert.NotNil(suite.T(), refund.UpdatedAt)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessRefund_Declined_Ok() {
+	intent := suite.createPayment(bankCardRequisites)
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+
+	refund := suite.getRefund()
+	err = suite.handler.CreateRefund(suite.order, refund)
+	assert.NoError(suite.T(), err)
+
+	raw, signature := suite.server.RefundWebhook(refund.ExternalId, pkg.StripeRefundStatusFailed)
+	req := &billing.StripeRefundCallback{}
+	err = json.Unmarshal(raw, req)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.ProcessRefund(suite.order, refund, req, string(raw), signature)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusPaymentSystemDeclined, refund.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessRefund_SignatureInvalid_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+
+	refund := suite.getRefund()
+	err = suite.handler.CreateRefund(suite.order, refund)
+	assert.NoError(suite.T(), err)
+
+	raw, _ := suite.server.RefundWebhook(refund.ExternalId, pkg.StripeRefundStatusSucceeded)
+	req := &billing.StripeRefundCallback{}
+	err = json.Unmarshal(raw, req)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.ProcessRefund(suite.order, refund, req, string(raw), "t=1,v1=0000")
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, err.(*grpc.ResponseError).Status)
+	assert.Equal(suite.T(), paymentSystemErrorRequestSignatureIsInvalid, err.(*grpc.ResponseError).Message)
+	assert.Equal(suite.T(), pkg.RefundStatusRejected, refund.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreateRefund_AmountExceeded_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	_, err := suite.processPayment(intent.Id, pkg.StripeEventTypePaymentIntentSucceeded)
+	assert.NoError(suite.T(), err)
+
+	refund := suite.getRefund()
+	refund.Amount = suite.order.TotalPaymentAmount + 1
+
+	err = suite.handler.CreateRefund(suite.order, refund)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PaymentSystemErrorCreateRefundFailed, err.Error())
+	assert.Equal(suite.T(), pkg.RefundStatusRejected, refund.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreateRefund_PaymentNotCompleted_Error() {
+	intent := suite.createPayment(bankCardRequisites)
+	suite.order.Transaction = intent.Id
+
+	err := suite.handler.CreateRefund(suite.order, suite.getRefund())
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PaymentSystemErrorCreateRefundFailed, err.Error())
+}
@@ -0,0 +1,915 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	repo "github.com/paysuper/paysuper-recurring-repository/pkg/proto/repository"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	collectionSubscriptionPlan    = "subscription_plan"
+	collectionSubscription        = "subscription"
+	collectionSubscriptionRenewal = "subscription_renewal"
+
+	subscriptionRenewalLockKey = "billing:subscription_renewal:lock"
+
+	subscriptionOrderMetadataKey = "SubscriptionId"
+	subscriptionOrderDescription = "Subscription renewal #%s"
+)
+
+var (
+	subscriptionErrorPlanNotFound              = newBillingServerErrorMsg("sb000001", "subscription plan not found")
+	subscriptionErrorPlanNameRequired          = newBillingServerErrorMsg("sb000002", "subscription plan name in default language is required")
+	subscriptionErrorPlanPriceDefaultCurrency  = newBillingServerErrorMsg("sb000003", "subscription plan must have price in merchant payout currency")
+	subscriptionErrorPlanIntervalInvalid       = newBillingServerErrorMsg("sb000004", "subscription plan interval is invalid")
+	subscriptionErrorPlanInactive              = newBillingServerErrorMsg("sb000005", "subscription plan is inactive")
+	subscriptionErrorProjectNotFound           = newBillingServerErrorMsg("sb000006", "project for subscription plan not found")
+	subscriptionErrorUnknown                   = newBillingServerErrorMsg("sb000007", "unknown error. try request later")
+	subscriptionErrorNotFound                  = newBillingServerErrorMsg("sb000008", "subscription not found")
+	subscriptionErrorOrderNotFound             = newBillingServerErrorMsg("sb000009", "order for subscription not found")
+	subscriptionErrorOrderNotProcessed         = newBillingServerErrorMsg("sb000010", "order for subscription must be successfully paid")
+	subscriptionErrorSavedCardNotFound         = newBillingServerErrorMsg("sb000011", "bank card from order for subscription wasn't saved")
+	subscriptionErrorPriceNotFound             = newBillingServerErrorMsg("sb000012", "subscription plan hasn't price for customer region")
+	subscriptionErrorStatusChangeNotAllowed    = newBillingServerErrorMsg("sb000013", "subscription status change not allowed")
+	subscriptionErrorRenewalOrderCreateFailed  = newBillingServerErrorMsg("sb000014", "order for subscription renewal can't be created")
+	subscriptionErrorRenewalPaymentCreateFails = newBillingServerErrorMsg("sb000015", "payment for subscription renewal can't be created")
+
+	subscriptionPlanIntervals = map[string]bool{
+		pkg.SubscriptionIntervalDay:   true,
+		pkg.SubscriptionIntervalWeek:  true,
+		pkg.SubscriptionIntervalMonth: true,
+		pkg.SubscriptionIntervalYear:  true,
+	}
+)
+
+func (s *Service) CreateOrUpdateSubscriptionPlan(
+	ctx context.Context,
+	req *billing.SubscriptionPlan,
+	rsp *grpc.SubscriptionPlanResponse,
+) error {
+	project, err := s.project.GetById(req.ProjectId)
+
+	if err != nil || project.MerchantId != req.MerchantId {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorProjectNotFound
+		return nil
+	}
+
+	if _, ok := req.Name[DefaultLanguage]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPlanNameRequired
+		return nil
+	}
+
+	if !subscriptionPlanIntervals[req.IntervalUnit] || req.IntervalCount <= 0 || req.TrialDays < 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPlanIntervalInvalid
+		return nil
+	}
+
+	merchant, err := s.merchant.GetById(req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorProjectNotFound
+		return nil
+	}
+
+	hasDefaultPrice := false
+
+	for _, v := range req.Prices {
+		if v.Region == merchant.GetPayoutCurrency() && v.Currency == merchant.GetPayoutCurrency() {
+			hasDefaultPrice = true
+			break
+		}
+	}
+
+	if !hasDefaultPrice {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPlanPriceDefaultCurrency
+		return nil
+	}
+
+	if req.Id == "" {
+		req.Id = bson.NewObjectId().Hex()
+		req.CreatedAt = ptypes.TimestampNow()
+	} else {
+		plan, err := s.getSubscriptionPlan(req.Id, req.MerchantId)
+
+		if err != nil || plan.ProjectId != req.ProjectId {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = subscriptionErrorPlanNotFound
+			return nil
+		}
+
+		req.CreatedAt = plan.CreatedAt
+	}
+
+	req.UpdatedAt = ptypes.TimestampNow()
+	_, err = s.db.Collection(collectionSubscriptionPlan).UpsertId(bson.ObjectIdHex(req.Id), req)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscriptionPlan),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, req),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = req
+
+	return nil
+}
+
+func (s *Service) GetSubscriptionPlan(
+	ctx context.Context,
+	req *grpc.GetSubscriptionPlanRequest,
+	rsp *grpc.SubscriptionPlanResponse,
+) error {
+	plan, err := s.getSubscriptionPlan(req.PlanId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorPlanNotFound
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = plan
+
+	return nil
+}
+
+func (s *Service) ListSubscriptionPlans(
+	ctx context.Context,
+	req *grpc.ListSubscriptionPlansRequest,
+	rsp *grpc.ListSubscriptionPlansResponse,
+) error {
+	query := bson.M{"merchant_id": bson.ObjectIdHex(req.MerchantId)}
+
+	if req.ProjectId != "" {
+		query["project_id"] = bson.ObjectIdHex(req.ProjectId)
+	}
+
+	limit := int(req.Limit)
+
+	if limit <= 0 {
+		limit = pkg.DatabaseRequestDefaultLimit
+	}
+
+	count, err := s.db.Collection(collectionSubscriptionPlan).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscriptionPlan),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	var plans []*billing.SubscriptionPlan
+	err = s.db.Collection(collectionSubscriptionPlan).Find(query).
+		Sort("-_id").Skip(int(req.Offset)).Limit(limit).All(&plans)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscriptionPlan),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = plans
+
+	return nil
+}
+
+// CreateSubscription subscribe customer from successfully paid order to subscription plan.
+// Order must be paid by bank card which was saved for recurring payments. If plan hasn't
+// trial period then paid order is considered as payment for the first billing period
+func (s *Service) CreateSubscription(
+	ctx context.Context,
+	req *grpc.CreateSubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	plan, err := s.getSubscriptionPlan(req.PlanId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorPlanNotFound
+		return nil
+	}
+
+	if plan.IsActive == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPlanInactive
+		return nil
+	}
+
+	order, err := s.getOrderByUuid(req.OrderId)
+
+	if err != nil || order.GetMerchantId() != plan.MerchantId || order.Project.Id != plan.ProjectId {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorOrderNotFound
+		return nil
+	}
+
+	if order.GetPublicStatus() != constant.OrderPublicStatusProcessed {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorOrderNotProcessed
+		return nil
+	}
+
+	savedCardId, err := s.getSubscriptionSavedCardId(ctx, order)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorSavedCardNotFound
+		return nil
+	}
+
+	priceGroup, amount, err := s.getSubscriptionPrice(plan, order)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPriceNotFound
+		return nil
+	}
+
+	now := time.Now()
+	periodEnd := plan.AddInterval(now)
+	status := pkg.SubscriptionStatusActive
+
+	subscription := &billing.Subscription{
+		Id:              bson.NewObjectId().Hex(),
+		PlanId:          plan.Id,
+		MerchantId:      plan.MerchantId,
+		ProjectId:       plan.ProjectId,
+		CustomerId:      order.User.Id,
+		User:            order.User,
+		PaymentMethodId: order.PaymentMethod.Id,
+		SavedCardId:     savedCardId,
+		PriceGroupId:    priceGroup.Id,
+		Currency:        priceGroup.Currency,
+		Amount:          amount,
+		InitialOrderId:  order.Id,
+		LastOrderId:     order.Id,
+		CreatedAt:       ptypes.TimestampNow(),
+	}
+
+	if plan.TrialDays > 0 {
+		periodEnd = now.AddDate(0, 0, int(plan.TrialDays))
+		status = pkg.SubscriptionStatusTrialing
+		subscription.TrialEndAt, _ = ptypes.TimestampProto(periodEnd)
+	}
+
+	subscription.Status = status
+	subscription.CurrentPeriodStartAt, _ = ptypes.TimestampProto(now)
+	subscription.CurrentPeriodEndAt, _ = ptypes.TimestampProto(periodEnd)
+	subscription.NextBillingAt = subscription.CurrentPeriodEndAt
+	subscription.UpdatedAt = subscription.CreatedAt
+
+	err = s.db.Collection(collectionSubscription).Insert(subscription)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscription),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, subscription),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	s.subscriptionNotifyMerchant(subscription, pkg.SubscriptionEventCreated, order.Id)
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+func (s *Service) GetSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorNotFound
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+func (s *Service) PauseSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorNotFound
+		return nil
+	}
+
+	if subscription.IsRenewable() == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorStatusChangeNotAllowed
+		return nil
+	}
+
+	subscription.Status = pkg.SubscriptionStatusPaused
+	subscription.PausedAt = ptypes.TimestampNow()
+
+	if err = s.updateSubscription(subscription, pkg.SubscriptionEventPaused, ""); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+// ResumeSubscription resume paused subscription. If billing date passed while subscription
+// was paused then customer will be charged on next subscription renewals processing
+func (s *Service) ResumeSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorNotFound
+		return nil
+	}
+
+	if subscription.Status != pkg.SubscriptionStatusPaused {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorStatusChangeNotAllowed
+		return nil
+	}
+
+	subscription.Status = pkg.SubscriptionStatusActive
+	subscription.PausedAt = nil
+
+	if subscription.TrialEndAt != nil {
+		if t, err := ptypes.Timestamp(subscription.TrialEndAt); err == nil && t.After(time.Now()) {
+			subscription.Status = pkg.SubscriptionStatusTrialing
+		}
+	}
+
+	if subscription.FailedAttempts > 0 {
+		subscription.Status = pkg.SubscriptionStatusPastDue
+	}
+
+	if err = s.updateSubscription(subscription, pkg.SubscriptionEventResumed, ""); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+func (s *Service) CancelSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorNotFound
+		return nil
+	}
+
+	if subscription.Status == pkg.SubscriptionStatusCanceled {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorStatusChangeNotAllowed
+		return nil
+	}
+
+	subscription.Status = pkg.SubscriptionStatusCanceled
+	subscription.CanceledAt = ptypes.TimestampNow()
+	subscription.CancelReason = req.Reason
+
+	if err = s.updateSubscription(subscription, pkg.SubscriptionEventCanceled, ""); err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+// subscriptionRenewal marks that charge of subscription for billing date was started, identifier
+// is built from subscription id and billing date, so only one charge can be started for every date
+type subscriptionRenewal struct {
+	Id             string        `bson:"_id"`
+	SubscriptionId bson.ObjectId `bson:"subscription_id"`
+	BillingAt      time.Time     `bson:"billing_at"`
+	OrderId        string        `bson:"order_id"`
+	CreatedAt      time.Time     `bson:"created_at"`
+}
+
+// ProcessSubscriptionRenewals charge saved bank cards of customers which subscriptions
+// reached next billing date, including retries of failed charges.
+// Only one replica of service processes renewals at the same time, other replicas
+// skip processing while lock is held.
+func (s *Service) ProcessSubscriptionRenewals() error {
+	lock, err := s.acquireRedisLock(
+		subscriptionRenewalLockKey,
+		time.Duration(s.cfg.SubscriptionRenewalLockTtl)*time.Second,
+	)
+
+	if err != nil || lock == "" {
+		return err
+	}
+
+	defer s.releaseRedisLock(subscriptionRenewalLockKey, lock)
+
+	query := bson.M{
+		"status": bson.M{"$in": []string{
+			pkg.SubscriptionStatusTrialing,
+			pkg.SubscriptionStatusActive,
+			pkg.SubscriptionStatusPastDue,
+		}},
+		"next_billing_at": bson.M{"$lte": time.Now()},
+	}
+
+	var subscriptions []*billing.Subscription
+	err = s.db.Collection(collectionSubscription).Find(query).All(&subscriptions)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscription),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if err := s.renewSubscription(subscription); err != nil {
+			zap.L().Error(
+				"Subscription renewal failed",
+				zap.Error(err),
+				zap.String("subscription_id", subscription.Id),
+			)
+		}
+	}
+
+	return nil
+}
+
+// renewSubscription create order for next billing period and charge saved bank card by it.
+// Result of charge will be received with payment system callback for created order
+func (s *Service) renewSubscription(subscription *billing.Subscription) error {
+	renewal, err := s.claimSubscriptionRenewal(subscription)
+
+	if err != nil || renewal == nil {
+		return err
+	}
+
+	orderReq := &billing.OrderCreateRequest{
+		Type:        billing.OrderType_simple,
+		ProjectId:   subscription.ProjectId,
+		Currency:    subscription.Currency,
+		Amount:      subscription.Amount,
+		Description: fmt.Sprintf(subscriptionOrderDescription, subscription.Id),
+		User:        protobuf.Clone(subscription.User).(*billing.OrderUser),
+		PrivateMetadata: map[string]string{
+			subscriptionOrderMetadataKey: subscription.Id,
+		},
+	}
+	orderRsp := &grpc.OrderCreateProcessResponse{}
+	err = s.OrderCreateProcess(context.TODO(), orderReq, orderRsp)
+
+	if err != nil || orderRsp.Status != pkg.ResponseStatusOk {
+		zap.L().Error(
+			subscriptionErrorRenewalOrderCreateFailed.Message,
+			zap.Any("error", err),
+			zap.Any("message", orderRsp.Message),
+			zap.String("subscription_id", subscription.Id),
+		)
+		return s.failSubscriptionPayment(subscription, "")
+	}
+
+	order := orderRsp.Item
+	subscription.LastOrderId = order.Id
+	s.setSubscriptionRenewalOrder(renewal, order.Id)
+
+	paymentReq := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: subscription.PaymentMethodId,
+			pkg.PaymentCreateFieldEmail:           subscription.User.Email,
+			pkg.PaymentCreateFieldStoredCardId:    subscription.SavedCardId,
+		},
+		Ip: subscription.User.Ip,
+	}
+
+	if subscription.User.Address != nil {
+		paymentReq.Data[pkg.PaymentCreateFieldUserCountry] = subscription.User.Address.Country
+		paymentReq.Data[pkg.PaymentCreateFieldUserZip] = subscription.User.Address.PostalCode
+	}
+
+	paymentRsp := &grpc.PaymentCreateResponse{}
+	err = s.PaymentCreateProcess(context.TODO(), paymentReq, paymentRsp)
+
+	if err != nil || paymentRsp.Status != pkg.ResponseStatusOk {
+		zap.L().Error(
+			subscriptionErrorRenewalPaymentCreateFails.Message,
+			zap.Any("error", err),
+			zap.Any("message", paymentRsp.Message),
+			zap.String("subscription_id", subscription.Id),
+			zap.String("order_id", order.Id),
+		)
+		return s.failSubscriptionPayment(subscription, order.Id)
+	}
+
+	// protect customer from second charge while waiting for result of the current one
+	subscription.NextBillingAt, _ = ptypes.TimestampProto(time.Now().Add(s.getSubscriptionRetryInterval(1)))
+
+	return s.updateSubscription(subscription, "", "")
+}
+
+// claimSubscriptionRenewal atomically mark current billing date of subscription as charged.
+// Nil returned if charge for this billing date was already started by another process
+func (s *Service) claimSubscriptionRenewal(subscription *billing.Subscription) (*subscriptionRenewal, error) {
+	billingAt, err := ptypes.Timestamp(subscription.NextBillingAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	renewal := &subscriptionRenewal{
+		Id:             fmt.Sprintf("%s_%d", subscription.Id, billingAt.Unix()),
+		SubscriptionId: bson.ObjectIdHex(subscription.Id),
+		BillingAt:      billingAt,
+		CreatedAt:      time.Now(),
+	}
+	err = s.db.Collection(collectionSubscriptionRenewal).Insert(renewal)
+
+	if err != nil {
+		if mgo.IsDup(err) {
+			zap.L().Info(
+				"Subscription renewal for billing date already started",
+				zap.String("subscription_id", subscription.Id),
+				zap.Time("billing_at", billingAt),
+			)
+			return nil, nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscriptionRenewal),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.Any(pkg.ErrorDatabaseFieldDocument, renewal),
+		)
+		return nil, err
+	}
+
+	return renewal, nil
+}
+
+func (s *Service) setSubscriptionRenewalOrder(renewal *subscriptionRenewal, orderId string) {
+	renewal.OrderId = orderId
+	err := s.db.Collection(collectionSubscriptionRenewal).UpdateId(renewal.Id, bson.M{"$set": bson.M{"order_id": orderId}})
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscriptionRenewal),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationUpdate),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, renewal.Id),
+		)
+	}
+}
+
+// processSubscriptionOrderPayment update subscription by result of payment for renewal order
+func (s *Service) processSubscriptionOrderPayment(order *billing.Order) {
+	id, ok := order.PrivateMetadata[subscriptionOrderMetadataKey]
+
+	if !ok || bson.IsObjectIdHex(id) == false {
+		return
+	}
+
+	var subscription *billing.Subscription
+	err := s.db.Collection(collectionSubscription).FindId(bson.ObjectIdHex(id)).One(&subscription)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscription),
+			zap.String("subscription_id", id),
+		)
+		return
+	}
+
+	if subscription.LastOrderId != order.Id || subscription.Status == pkg.SubscriptionStatusCanceled {
+		return
+	}
+
+	switch order.PrivateStatus {
+	case constant.OrderStatusPaymentSystemComplete:
+		err = s.extendSubscription(subscription, order.Id)
+		break
+	case constant.OrderStatusPaymentSystemDeclined,
+		constant.OrderStatusPaymentSystemCanceled,
+		constant.OrderStatusPaymentSystemReject:
+		err = s.failSubscriptionPayment(subscription, order.Id)
+		break
+	}
+
+	if err != nil {
+		zap.L().Error(
+			"Subscription update by renewal order payment failed",
+			zap.Error(err),
+			zap.String("subscription_id", subscription.Id),
+			zap.String("order_id", order.Id),
+		)
+	}
+}
+
+func (s *Service) extendSubscription(subscription *billing.Subscription, orderId string) error {
+	plan, err := s.getSubscriptionPlan(subscription.PlanId, subscription.MerchantId)
+
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	if subscription.CurrentPeriodEndAt != nil {
+		start, _ = ptypes.Timestamp(subscription.CurrentPeriodEndAt)
+	}
+
+	subscription.CurrentPeriodStartAt, _ = ptypes.TimestampProto(start)
+	subscription.CurrentPeriodEndAt, _ = ptypes.TimestampProto(plan.AddInterval(start))
+	subscription.NextBillingAt = subscription.CurrentPeriodEndAt
+	subscription.FailedAttempts = 0
+
+	if subscription.Status != pkg.SubscriptionStatusPaused {
+		subscription.Status = pkg.SubscriptionStatusActive
+	}
+
+	return s.updateSubscription(subscription, pkg.SubscriptionEventRenewed, orderId)
+}
+
+// failSubscriptionPayment schedule next attempt to charge customer. Delay between attempts
+// increase with every failed attempt. Subscription will be canceled after last failed attempt
+func (s *Service) failSubscriptionPayment(subscription *billing.Subscription, orderId string) error {
+	subscription.FailedAttempts++
+
+	if subscription.FailedAttempts >= s.cfg.SubscriptionDunningMaxAttempts {
+		s.subscriptionNotifyMerchant(subscription, pkg.SubscriptionEventPaymentFailed, orderId)
+
+		subscription.Status = pkg.SubscriptionStatusCanceled
+		subscription.CanceledAt = ptypes.TimestampNow()
+		subscription.CancelReason = pkg.SubscriptionCancelReasonPaymentFailed
+
+		return s.updateSubscription(subscription, pkg.SubscriptionEventCanceled, orderId)
+	}
+
+	if subscription.Status != pkg.SubscriptionStatusPaused {
+		subscription.Status = pkg.SubscriptionStatusPastDue
+	}
+
+	retryAt := time.Now().Add(s.getSubscriptionRetryInterval(subscription.FailedAttempts))
+	subscription.NextBillingAt, _ = ptypes.TimestampProto(retryAt)
+
+	return s.updateSubscription(subscription, pkg.SubscriptionEventPaymentFailed, orderId)
+}
+
+func (s *Service) getSubscriptionRetryInterval(attempt int32) time.Duration {
+	return time.Duration(s.cfg.SubscriptionDunningRetryInterval*int64(attempt)) * time.Second
+}
+
+func (s *Service) getSubscriptionPlan(id, merchantId string) (*billing.SubscriptionPlan, error) {
+	if bson.IsObjectIdHex(id) == false || bson.IsObjectIdHex(merchantId) == false {
+		return nil, subscriptionErrorPlanNotFound
+	}
+
+	var plan *billing.SubscriptionPlan
+	query := bson.M{"_id": bson.ObjectIdHex(id), "merchant_id": bson.ObjectIdHex(merchantId)}
+	err := s.db.Collection(collectionSubscriptionPlan).Find(query).One(&plan)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscriptionPlan),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, subscriptionErrorPlanNotFound
+	}
+
+	return plan, nil
+}
+
+func (s *Service) getSubscription(id, merchantId string) (*billing.Subscription, error) {
+	if bson.IsObjectIdHex(id) == false || bson.IsObjectIdHex(merchantId) == false {
+		return nil, subscriptionErrorNotFound
+	}
+
+	var subscription *billing.Subscription
+	query := bson.M{"_id": bson.ObjectIdHex(id), "merchant_id": bson.ObjectIdHex(merchantId)}
+	err := s.db.Collection(collectionSubscription).Find(query).One(&subscription)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscription),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, subscriptionErrorNotFound
+	}
+
+	return subscription, nil
+}
+
+// getSubscriptionSavedCardId return identifier of bank card which was saved by customer on order payment
+func (s *Service) getSubscriptionSavedCardId(ctx context.Context, order *billing.Order) (string, error) {
+	if order.PaymentMethod == nil || order.PaymentMethod.IsBankCard() == false ||
+		order.PaymentRequisites["saved"] != "1" {
+		return "", subscriptionErrorSavedCardNotFound
+	}
+
+	rsp, err := s.rep.FindSavedCards(ctx, &repo.SavedCardRequest{Token: order.User.Id})
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorGrpcServiceCallFailed,
+			zap.Error(err),
+			zap.String(errorFieldService, "RepositoryService"),
+			zap.String(errorFieldMethod, "FindSavedCards"),
+		)
+		return "", subscriptionErrorSavedCardNotFound
+	}
+
+	for _, v := range rsp.SavedCards {
+		if v.IsActive && v.MaskedPan == order.PaymentMethodTxnParams[pkg.PaymentCreateFieldPan] {
+			return v.Id, nil
+		}
+	}
+
+	return "", subscriptionErrorSavedCardNotFound
+}
+
+// getSubscriptionPrice return plan price for price group of customer country.
+// If plan hasn't price for this group then price in merchant payout currency will be used
+func (s *Service) getSubscriptionPrice(
+	plan *billing.SubscriptionPlan,
+	order *billing.Order,
+) (*billing.PriceGroup, float64, error) {
+	merchant, err := s.merchant.GetById(plan.MerchantId)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defaultPriceGroup, err := s.priceGroup.GetByRegion(merchant.GetPayoutCurrency())
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if country := order.GetCountry(); country != "" {
+		countryData, err := s.country.GetByIsoCodeA2(country)
+
+		if err == nil {
+			priceGroup, err := s.priceGroup.GetById(countryData.PriceGroupId)
+
+			if err == nil {
+				if amount, err := plan.GetPriceInPriceGroup(priceGroup); err == nil {
+					return priceGroup, amount, nil
+				}
+			}
+		}
+	}
+
+	amount, err := plan.GetPriceInPriceGroup(defaultPriceGroup)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return defaultPriceGroup, amount, nil
+}
+
+func (s *Service) updateSubscription(subscription *billing.Subscription, event, orderId string) error {
+	subscription.UpdatedAt = ptypes.TimestampNow()
+	err := s.db.Collection(collectionSubscription).UpdateId(bson.ObjectIdHex(subscription.Id), subscription)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionSubscription),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, subscription),
+		)
+		return err
+	}
+
+	if event != "" {
+		s.subscriptionNotifyMerchant(subscription, event, orderId)
+	}
+
+	return nil
+}
+
+func (s *Service) subscriptionNotifyMerchant(subscription *billing.Subscription, event, orderId string) {
+	msg := &billing.SubscriptionNotification{
+		Event:        event,
+		Subscription: subscription,
+		OrderId:      orderId,
+		CreatedAt:    ptypes.TimestampNow(),
+	}
+	err := s.broker.Publish(pkg.SubscriptionNotifyTopicName, msg, amqp.Table{"x-retry-count": int32(0)})
+
+	if err != nil {
+		zap.L().Error(
+			"Publish subscription notification failed",
+			zap.Error(err),
+			zap.String("topic", pkg.SubscriptionNotifyTopicName),
+			zap.String("event", event),
+			zap.String("subscription_id", subscription.Id),
+		)
+	}
+
+	err = s.createSubscriptionWebhookDelivery(msg)
+
+	if err != nil {
+		zap.L().Error(
+			"Subscription webhook delivery create failed",
+			zap.Error(err),
+			zap.String("event", event),
+			zap.String("subscription_id", subscription.Id),
+		)
+	}
+}
@@ -791,16 +791,17 @@ func helperPayOrder(
 	assert.IsType(suite.T(), &billing.Order{}, order)
 	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order.PrivateStatus)
 
+	helperProjectOrderView(suite, service)
+
 	return order
 }
 
-func helperMakeRefund(suite suite.Suite, service *Service, order *billing.Order, amount float64, isChargeback bool) *billing.Refund {
+func helperMakeRefund(suite suite.Suite, service *Service, order *billing.Order, amount float64) *billing.Refund {
 	req2 := &grpc.CreateRefundRequest{
-		OrderId:      order.Uuid,
-		Amount:       amount,
-		CreatorId:    bson.NewObjectId().Hex(),
-		Reason:       "unit test",
-		IsChargeback: isChargeback,
+		OrderId:   order.Uuid,
+		Amount:    amount,
+		CreatorId: bson.NewObjectId().Hex(),
+		Reason:    "unit test",
 	}
 	rsp2 := &grpc.CreateRefundResponse{}
 	err := service.CreateRefund(context.TODO(), req2, rsp2)
@@ -859,9 +860,23 @@ func helperMakeRefund(suite suite.Suite, service *Service, order *billing.Order,
 	assert.NotNil(suite.T(), refund)
 	assert.Equal(suite.T(), pkg.RefundStatusCompleted, refund.Status)
 
+	helperProjectOrderView(suite, service)
+
 	return refund
 }
 
+// helperProjectOrderView applies all logged change events to order view without delay of scheduled projection
+func helperProjectOrderView(suite suite.Suite, service *Service) {
+	for {
+		_, more, err := service.projectOrderViewEvents(bson.NewObjectId())
+		assert.NoError(suite.T(), err)
+
+		if err != nil || !more {
+			return
+		}
+	}
+}
+
 func createProductsForProject(
 	suite suite.Suite,
 	service *Service,
@@ -1117,6 +1132,8 @@ func helperCreateAndPayOrder2(
 	assert.IsType(suite.T(), &billing.Order{}, order)
 	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order.PrivateStatus)
 
+	helperProjectOrderView(suite, service)
+
 	return order
 }
 
@@ -8,11 +8,11 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jinzhu/now"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	curPkg "github.com/paysuper/paysuper-currencies/pkg"
 	"github.com/paysuper/paysuper-currencies/pkg/proto/currencies"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"go.uber.org/zap"
 	"time"
 )
@@ -84,7 +84,7 @@ func (s *Service) calcAnnualTurnover(ctx context.Context, countryCode string) er
 	)
 
 	if countryCode != "" {
-		country, err := s.country.GetByIsoCodeA2(countryCode)
+		country, err := s.getCountryForDate(countryCode, time.Now())
 		if err != nil {
 			return errorCountryNotFound
 		}
@@ -123,6 +123,20 @@ func (s *Service) calcAnnualTurnover(ctx context.Context, countryCode string) er
 			}
 		}
 		break
+	case pkg.VatCurrencyRatesPolicyMidMonth:
+		for from.Unix() <= to.Unix() {
+			monthTo := now.New(from).EndOfMonth()
+			if monthTo.Unix() > to.Unix() {
+				monthTo = to
+			}
+			amnt, err := s.getTurnover(ctx, from, monthTo, countryCode, targetCurrency, currencyPolicy, ratesType, ratesSource)
+			if err != nil {
+				return err
+			}
+			amount += amnt
+			from = now.New(from).BeginningOfMonth().AddDate(0, 1, 0)
+		}
+		break
 	default:
 		err = errorTurnoversCurrencyRatesPolicyNotSupported
 		return err
@@ -131,7 +145,7 @@ func (s *Service) calcAnnualTurnover(ctx context.Context, countryCode string) er
 	at := &billing.AnnualTurnover{
 		Year:     int32(year.Year()),
 		Country:  countryCode,
-		Amount:   tools.FormatAmount(amount),
+		Amount:   money.Round(amount, targetCurrency),
 		Currency: targetCurrency,
 	}
 
@@ -171,7 +185,7 @@ func (s *Service) getTurnover(ctx context.Context, from, to time.Time, countryCo
 	case pkg.VatCurrencyRatesPolicyOnDay:
 		query = append(query, bson.M{"$group": bson.M{"_id": "$local_currency", "amount": bson.M{"$sum": "$local_amount"}}})
 		break
-	case pkg.VatCurrencyRatesPolicyLastDay:
+	case pkg.VatCurrencyRatesPolicyLastDay, pkg.VatCurrencyRatesPolicyMidMonth:
 		query = append(query, bson.M{"$group": bson.M{"_id": "$original_currency", "amount": bson.M{"$sum": "$original_amount"}}})
 		break
 	default:
@@ -197,7 +211,7 @@ func (s *Service) getTurnover(ctx context.Context, from, to time.Time, countryCo
 		return
 	}
 
-	toTimestamp, err := ptypes.TimestampProto(to)
+	toTimestamp, err := ptypes.TimestampProto(getTurnoverRatesTime(currencyPolicy, from, to))
 	if err != nil {
 		err = errorTurnoversExchangeFailed
 		return
@@ -238,6 +252,23 @@ func (s *Service) getTurnover(ctx context.Context, from, to time.Time, countryCo
 	return
 }
 
+// getTurnoverRatesTime returns time of rates to exchange turnover for period from-to.
+// Turnover for mid-month policy calculated by months, so rates of the middle of month of period are used,
+// rates of period end are used while rates of the middle of current month are not known yet
+func getTurnoverRatesTime(currencyPolicy string, from, to time.Time) time.Time {
+	if currencyPolicy != pkg.VatCurrencyRatesPolicyMidMonth {
+		return to
+	}
+
+	ratesTime := getVatMidMonthRatesTime(from)
+
+	if ratesTime.Unix() > to.Unix() {
+		return to
+	}
+
+	return ratesTime
+}
+
 func newTurnoverService(svc *Service) *Turnover {
 	s := &Turnover{svc: svc}
 	return s
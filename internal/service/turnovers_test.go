@@ -10,6 +10,7 @@ import (
 	"github.com/paysuper/paysuper-billing-server/internal/mocks"
 	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	curPkg "github.com/paysuper/paysuper-currencies/pkg"
@@ -214,6 +215,47 @@ func (suite *TurnoversTestSuite) TestTurnovers_calcAnnualTurnover() {
 	assert.Equal(suite.T(), at.Amount, ref2)
 }
 
+func (suite *TurnoversTestSuite) TestTurnovers_calcAnnualTurnover_MidMonth() {
+	countryCode := "RU"
+
+	country, err := suite.service.country.GetByIsoCodeA2(countryCode)
+	assert.NoError(suite.T(), err)
+	country.VatCurrencyRatesPolicy = pkg.VatCurrencyRatesPolicyMidMonth
+	err = suite.service.country.Update(country)
+	assert.NoError(suite.T(), err)
+
+	suite.fillAccountingEntries(countryCode, 10)
+
+	err = suite.service.calcAnnualTurnover(context.TODO(), countryCode)
+	assert.NoError(suite.T(), err)
+
+	at, err := suite.service.turnover.Get(countryCode, time.Now().Year())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), at.Country, countryCode)
+	assert.Equal(suite.T(), at.Currency, "RUB")
+	ref := suite.getTurnoverReference(now.BeginningOfYear(), now.EndOfDay(), countryCode, "RUB", pkg.VatCurrencyRatesPolicyMidMonth)
+	assert.Equal(suite.T(), at.Amount, money.Round(ref, "RUB"))
+}
+
+func (suite *TurnoversTestSuite) TestTurnovers_getTurnoverRatesTime() {
+	from, err := time.Parse(time.RFC3339, "2019-06-01T00:00:00Z")
+	assert.NoError(suite.T(), err)
+	to, err := time.Parse(time.RFC3339, "2019-06-30T23:59:59Z")
+	assert.NoError(suite.T(), err)
+
+	ratesTime := getTurnoverRatesTime(pkg.VatCurrencyRatesPolicyOnDay, from, to)
+	assert.Equal(suite.T(), to, ratesTime)
+
+	ratesTime = getTurnoverRatesTime(pkg.VatCurrencyRatesPolicyMidMonth, from, to)
+	assert.Equal(suite.T(), "2019-06-15T23:59:59Z", ratesTime.Format(time.RFC3339))
+
+	// rates of the middle of current month are not known yet
+	to, err = time.Parse(time.RFC3339, "2019-06-10T23:59:59Z")
+	assert.NoError(suite.T(), err)
+	ratesTime = getTurnoverRatesTime(pkg.VatCurrencyRatesPolicyMidMonth, from, to)
+	assert.Equal(suite.T(), to, ratesTime)
+}
+
 func (suite *TurnoversTestSuite) TestTurnovers_CalcAnnualTurnovers() {
 	countryCode := "RU"
 
@@ -319,7 +361,7 @@ func (suite *TurnoversTestSuite) getTurnoverReference(from, to time.Time, countr
 	case pkg.VatCurrencyRatesPolicyOnDay:
 		query = append(query, bson.M{"$group": bson.M{"_id": "$local_currency", "amount": bson.M{"$sum": "$local_amount"}}})
 		break
-	case pkg.VatCurrencyRatesPolicyLastDay:
+	case pkg.VatCurrencyRatesPolicyLastDay, pkg.VatCurrencyRatesPolicyMidMonth:
 		query = append(query, bson.M{"$group": bson.M{"_id": "$original_currency", "amount": bson.M{"$sum": "$original_amount"}}})
 		break
 	default:
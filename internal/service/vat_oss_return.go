@@ -0,0 +1,565 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/now"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	curPkg "github.com/paysuper/paysuper-currencies/pkg"
+	"github.com/paysuper/paysuper-currencies/pkg/proto/currencies"
+	"go.uber.org/zap"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	collectionVatOssReturn = "vat_oss_return"
+
+	vatOssReturnCurrency    = "EUR"
+	vatOssReturnRatesSource = "cbeu"
+	// namespace of internal xml layout of return, see vatOssReturnXmlDocument
+	vatOssReturnXmlNamespace = "urn:paysuper:billing:vat-oss-return:v1"
+	vatOssReturnSupplyType   = "SERVICES"
+	vatOssReturnVatRateType  = "STANDARD"
+)
+
+var (
+	errorVatOssReturnPeriodInvalid      = newBillingServerErrorMsg("vo000001", "eu vat oss return period is invalid")
+	errorVatOssReturnPeriodNotFinished  = newBillingServerErrorMsg("vo000002", "eu vat oss return can be exported only after quarter is over")
+	errorVatOssReturnAlreadyExists      = newBillingServerErrorMsg("vo000003", "eu vat oss return for quarter already exported")
+	errorVatOssReturnReportsNotClosed   = newBillingServerErrorMsg("vo000004", "vat reports for quarter are not closed yet")
+	errorVatOssReturnReportsNotFound    = newBillingServerErrorMsg("vo000005", "vat reports to pay for quarter not found")
+	errorVatOssReturnNotFound           = newBillingServerErrorMsg("vo000006", "eu vat oss return not found")
+	errorVatOssReturnUnknown            = newBillingServerErrorMsg("vo000007", "unknown error. try request later")
+	errorVatOssReturnReportCrossQuarter = newBillingServerErrorMsg("vo000008", "vat report period crosses quarter boundary")
+
+	// member states of eu, returns are filed for vat reports of these countries only
+	vatOssReturnCountries = []string{
+		"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+		"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+	}
+
+	// vat identification prefixes of member states which differ from iso country codes
+	vatOssReturnMemberStateCodes = map[string]string{
+		"GR": "EL",
+	}
+
+	vatOssReturnReportClosedStatuses = []string{
+		pkg.VatReportStatusNeedToPay,
+		pkg.VatReportStatusPaid,
+		pkg.VatReportStatusOverdue,
+	}
+
+	vatOssReturnReportOpenStatuses = []string{
+		pkg.VatReportStatusThreshold,
+		pkg.VatReportStatusPending,
+	}
+
+	vatOssReturnCsvColumns = []string{
+		"member_state_of_consumption",
+		"supply_type",
+		"vat_rate_type",
+		"vat_rate",
+		"taxable_amount",
+		"vat_amount",
+		"currency",
+	}
+)
+
+// vatOssReturnXmlDocument is internal xml layout of eu vat oss return. It doesn't follow schema of eu
+// or of any member state, return is converted to format of tax authority of member state of identification
+// before filing.
+type vatOssReturnXmlDocument struct {
+	XMLName        xml.Name                `xml:"OSSReturn"`
+	Xmlns          string                  `xml:"xmlns,attr"`
+	Header         vatOssReturnXmlHeader   `xml:"Header"`
+	Supplies       []vatOssReturnXmlSupply `xml:"Supplies>Supply"`
+	TotalVatAmount string                  `xml:"TotalVATAmount"`
+}
+
+type vatOssReturnXmlHeader struct {
+	ReturnReference             string `xml:"ReturnReference"`
+	MemberStateOfIdentification string `xml:"MemberStateOfIdentification"`
+	VatNumber                   string `xml:"VATIdentificationNumber"`
+	Year                        int32  `xml:"Period>Year"`
+	Quarter                     string `xml:"Period>Quarter"`
+	Currency                    string `xml:"Currency"`
+	CreatedAt                   string `xml:"CreationDateTime"`
+}
+
+type vatOssReturnXmlSupply struct {
+	MemberStateOfConsumption string `xml:"MemberStateOfConsumption"`
+	SupplyType               string `xml:"SupplyType"`
+	VatRateType              string `xml:"VATRateType"`
+	VatRate                  string `xml:"VATRate"`
+	TaxableAmount            string `xml:"TaxableAmount"`
+	VatAmount                string `xml:"VATAmount"`
+}
+
+// CreateVatOssReturn aggregates vat reports of eu countries for quarter to single eu vat one-stop-shop return
+// in EUR and exports it in internal xml and csv layouts. Vat reports included to return are locked by return,
+// return is removed if reports can't be locked.
+// Return can't be exported while any vat report of eu country crosses boundary of quarter,
+// because such report can't be split between returns of two quarters.
+func (s *Service) CreateVatOssReturn(
+	ctx context.Context,
+	req *grpc.VatOssReturnRequest,
+	rsp *grpc.VatOssReturnResponse,
+) error {
+	from, to, err := getVatOssReturnPeriod(req.Year, req.Quarter)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = errorVatOssReturnPeriodInvalid
+		return nil
+	}
+
+	if time.Now().Before(to) {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = errorVatOssReturnPeriodNotFinished
+		return nil
+	}
+
+	_, err = s.getVatOssReturn(req.Year, req.Quarter)
+
+	if err == nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = errorVatOssReturnAlreadyExists
+		return nil
+	}
+
+	if err != mgo.ErrNotFound {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorVatOssReturnUnknown
+		return nil
+	}
+
+	query := bson.M{
+		"country":   bson.M{"$in": vatOssReturnCountries},
+		"date_from": bson.M{"$lte": to},
+		"date_to":   bson.M{"$gte": from},
+	}
+
+	var reports []*billing.VatReport
+	err = s.db.Collection(collectionVatReports).Find(query).Sort("country", "date_from").All(&reports)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatReports),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorVatOssReturnUnknown
+		return nil
+	}
+
+	ossReturn := &billing.VatOssReturn{
+		Id:        bson.NewObjectId().Hex(),
+		Year:      req.Year,
+		Quarter:   req.Quarter,
+		Currency:  vatOssReturnCurrency,
+		CreatedAt: ptypes.TimestampNow(),
+	}
+	ossReturn.DateFrom, _ = ptypes.TimestampProto(from)
+	ossReturn.DateTo, _ = ptypes.TimestampProto(to)
+
+	items := make(map[string]*billing.VatOssReturnItem)
+
+	for _, report := range reports {
+		isOpen := contains(vatOssReturnReportOpenStatuses, report.Status)
+
+		if !isOpen && !contains(vatOssReturnReportClosedStatuses, report.Status) {
+			continue
+		}
+
+		reportFrom, err := ptypes.Timestamp(report.DateFrom)
+
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = errorVatOssReturnUnknown
+			return nil
+		}
+
+		reportTo, err := ptypes.Timestamp(report.DateTo)
+
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = errorVatOssReturnUnknown
+			return nil
+		}
+
+		if reportFrom.Before(from) || reportTo.After(to) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = errorVatOssReturnReportCrossQuarter
+			return nil
+		}
+
+		if isOpen {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = errorVatOssReturnReportsNotClosed
+			return nil
+		}
+
+		key := report.Country + strconv.FormatFloat(report.VatRate, 'f', -1, 64)
+		item, ok := items[key]
+
+		if !ok {
+			item = &billing.VatOssReturnItem{
+				Country:       report.Country,
+				VatRate:       report.VatRate,
+				LocalCurrency: report.Currency,
+			}
+			items[key] = item
+			ossReturn.Items = append(ossReturn.Items, item)
+		}
+
+		item.LocalTaxableAmount += report.GrossRevenue - report.VatAmount
+		item.LocalVatAmount += report.VatAmount - report.DeductionAmount + report.CorrectionAmount
+		item.VatReportIds = append(item.VatReportIds, report.Id)
+		ossReturn.VatReportIds = append(ossReturn.VatReportIds, report.Id)
+	}
+
+	if len(ossReturn.Items) <= 0 {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = errorVatOssReturnReportsNotFound
+		return nil
+	}
+
+	sort.Slice(ossReturn.Items, func(i, j int) bool {
+		if ossReturn.Items[i].Country == ossReturn.Items[j].Country {
+			return ossReturn.Items[i].VatRate > ossReturn.Items[j].VatRate
+		}
+		return ossReturn.Items[i].Country < ossReturn.Items[j].Country
+	})
+
+	for _, item := range ossReturn.Items {
+		item.LocalTaxableAmount = money.Round(item.LocalTaxableAmount, item.LocalCurrency)
+		item.LocalVatAmount = money.Round(item.LocalVatAmount, item.LocalCurrency)
+		item.TaxableAmount = item.LocalTaxableAmount
+		item.VatAmount = item.LocalVatAmount
+
+		if item.LocalCurrency != vatOssReturnCurrency {
+			item.TaxableAmount, err = s.exchangeVatOssReturnAmount(ctx, item.LocalCurrency, item.LocalTaxableAmount, ossReturn)
+
+			if err == nil {
+				item.VatAmount, err = s.exchangeVatOssReturnAmount(ctx, item.LocalCurrency, item.LocalVatAmount, ossReturn)
+			}
+
+			if err != nil {
+				rsp.Status = pkg.ResponseStatusSystemError
+				rsp.Message = errorVatOssReturnUnknown
+				return nil
+			}
+		}
+
+		item.TaxableAmount = money.Round(item.TaxableAmount, vatOssReturnCurrency)
+		item.VatAmount = money.Round(item.VatAmount, vatOssReturnCurrency)
+		ossReturn.TotalTaxableAmount += item.TaxableAmount
+		ossReturn.TotalVatAmount += item.VatAmount
+	}
+
+	ossReturn.TotalTaxableAmount = money.Round(ossReturn.TotalTaxableAmount, vatOssReturnCurrency)
+	ossReturn.TotalVatAmount = money.Round(ossReturn.TotalVatAmount, vatOssReturnCurrency)
+
+	xmlContent, err := s.getVatOssReturnXmlContent(ossReturn)
+
+	if err == nil {
+		ossReturn.Files = append(ossReturn.Files, newVatOssReturnFile(ossReturn, pkg.VatOssReturnFormatXml, xmlContent))
+
+		var csvContent []byte
+		csvContent, err = getVatOssReturnCsvContent(ossReturn)
+
+		if err == nil {
+			ossReturn.Files = append(ossReturn.Files, newVatOssReturnFile(ossReturn, pkg.VatOssReturnFormatCsv, csvContent))
+		}
+	}
+
+	if err != nil {
+		zap.L().Error("Eu vat oss return file generation failed", zap.Error(err), zap.String("oss_return_id", ossReturn.Id))
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorVatOssReturnUnknown
+		return nil
+	}
+
+	err = s.db.Collection(collectionVatOssReturn).Insert(ossReturn)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatOssReturn),
+			zap.String(pkg.ErrorDatabaseFieldOperation, pkg.ErrorDatabaseFieldOperationInsert),
+			zap.String("oss_return_id", ossReturn.Id),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorVatOssReturnUnknown
+
+		if mgo.IsDup(err) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = errorVatOssReturnAlreadyExists
+		}
+
+		return nil
+	}
+
+	ids := make([]bson.ObjectId, len(ossReturn.VatReportIds))
+
+	for i, id := range ossReturn.VatReportIds {
+		ids[i] = bson.ObjectIdHex(id)
+	}
+
+	selector := bson.M{"_id": bson.M{"$in": ids}}
+	update := bson.M{"$set": bson.M{"oss_return_id": ossReturn.Id, "updated_at": time.Now()}}
+	_, err = s.db.Collection(collectionVatReports).UpdateAll(selector, update)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatReports),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, selector),
+		)
+
+		s.removeVatOssReturn(ossReturn)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorVatOssReturnUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = ossReturn
+
+	return nil
+}
+
+// removeVatOssReturn rollback export of return which reports are not locked, so return never exists
+// while its reports can be changed. Reports locked before failure are unlocked.
+func (s *Service) removeVatOssReturn(ossReturn *billing.VatOssReturn) {
+	selector := bson.M{"oss_return_id": ossReturn.Id}
+	update := bson.M{"$set": bson.M{"oss_return_id": "", "updated_at": time.Now()}}
+	_, err := s.db.Collection(collectionVatReports).UpdateAll(selector, update)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatReports),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, selector),
+		)
+	}
+
+	err = s.db.Collection(collectionVatOssReturn).RemoveId(bson.ObjectIdHex(ossReturn.Id))
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatOssReturn),
+			zap.String(pkg.ErrorDatabaseFieldDocumentId, ossReturn.Id),
+		)
+	}
+}
+
+func (s *Service) GetVatOssReturn(
+	ctx context.Context,
+	req *grpc.VatOssReturnRequest,
+	rsp *grpc.VatOssReturnResponse,
+) error {
+	ossReturn, err := s.getVatOssReturn(req.Year, req.Quarter)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorVatOssReturnUnknown
+
+		if err == mgo.ErrNotFound {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = errorVatOssReturnNotFound
+		}
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = ossReturn
+
+	return nil
+}
+
+func (s *Service) getVatOssReturn(year, quarter int32) (*billing.VatOssReturn, error) {
+	query := bson.M{"year": year, "quarter": quarter}
+
+	var ossReturn *billing.VatOssReturn
+	err := s.db.Collection(collectionVatOssReturn).Find(query).One(&ossReturn)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatOssReturn),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+	}
+
+	return ossReturn, err
+}
+
+// exchangeVatOssReturnAmount converts amount to EUR by ECB rates of the last day of return period
+func (s *Service) exchangeVatOssReturnAmount(
+	ctx context.Context,
+	currency string,
+	amount float64,
+	ossReturn *billing.VatOssReturn,
+) (float64, error) {
+	req := &currencies.ExchangeCurrencyByDateCommonRequest{
+		From:     currency,
+		To:       vatOssReturnCurrency,
+		RateType: curPkg.RateTypeCentralbanks,
+		Source:   vatOssReturnRatesSource,
+		Amount:   amount,
+		Datetime: ossReturn.DateTo,
+	}
+
+	rsp, err := s.curService.ExchangeCurrencyByDateCommon(ctx, req)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorGrpcServiceCallFailed,
+			zap.Error(err),
+			zap.String(errorFieldService, "CurrencyRatesService"),
+			zap.String(errorFieldMethod, "ExchangeCurrencyByDateCommon"),
+			zap.Any(errorFieldRequest, req),
+		)
+
+		return 0, err
+	}
+
+	return rsp.ExchangedAmount, nil
+}
+
+func (s *Service) getVatOssReturnXmlContent(ossReturn *billing.VatOssReturn) ([]byte, error) {
+	createdAt, err := ptypes.Timestamp(ossReturn.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &vatOssReturnXmlDocument{
+		Xmlns: vatOssReturnXmlNamespace,
+		Header: vatOssReturnXmlHeader{
+			ReturnReference:             ossReturn.Id,
+			MemberStateOfIdentification: s.cfg.VatOssMemberStateOfIdentification,
+			VatNumber:                   s.cfg.VatOssVatNumber,
+			Year:                        ossReturn.Year,
+			Quarter:                     fmt.Sprintf("Q%d", ossReturn.Quarter),
+			Currency:                    ossReturn.Currency,
+			CreatedAt:                   createdAt.UTC().Format("2006-01-02T15:04:05"),
+		},
+		TotalVatAmount: formatVatOssReturnAmount(ossReturn.TotalVatAmount),
+	}
+
+	for _, item := range ossReturn.Items {
+		doc.Supplies = append(doc.Supplies, vatOssReturnXmlSupply{
+			MemberStateOfConsumption: getVatOssReturnMemberStateCode(item.Country),
+			SupplyType:               vatOssReturnSupplyType,
+			VatRateType:              vatOssReturnVatRateType,
+			VatRate:                  formatVatOssReturnRate(item.VatRate),
+			TaxableAmount:            formatVatOssReturnAmount(item.TaxableAmount),
+			VatAmount:                formatVatOssReturnAmount(item.VatAmount),
+		})
+	}
+
+	b, err := xml.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+func getVatOssReturnCsvContent(ossReturn *billing.VatOssReturn) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+
+	if err := w.Write(vatOssReturnCsvColumns); err != nil {
+		return nil, err
+	}
+
+	for _, item := range ossReturn.Items {
+		record := []string{
+			getVatOssReturnMemberStateCode(item.Country),
+			vatOssReturnSupplyType,
+			vatOssReturnVatRateType,
+			formatVatOssReturnRate(item.VatRate),
+			formatVatOssReturnAmount(item.TaxableAmount),
+			formatVatOssReturnAmount(item.VatAmount),
+			ossReturn.Currency,
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+func newVatOssReturnFile(ossReturn *billing.VatOssReturn, format string, content []byte) *billing.VatOssReturnFile {
+	hash := sha256.Sum256(content)
+
+	return &billing.VatOssReturnFile{
+		Format:   format,
+		FileName: fmt.Sprintf("oss_return_%d_q%d.%s", ossReturn.Year, ossReturn.Quarter, format),
+		Content:  content,
+		Checksum: hex.EncodeToString(hash[:]),
+	}
+}
+
+// getVatOssReturnPeriod returns first and last moments of quarter of year
+func getVatOssReturnPeriod(year, quarter int32) (from, to time.Time, err error) {
+	if year <= 0 || quarter < 1 || quarter > 4 {
+		err = errorVatOssReturnPeriodInvalid
+		return
+	}
+
+	from = time.Date(int(year), time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	to = now.New(from).EndOfQuarter()
+
+	return
+}
+
+func getVatOssReturnMemberStateCode(country string) string {
+	if code, ok := vatOssReturnMemberStateCodes[country]; ok {
+		return code
+	}
+	return country
+}
+
+func formatVatOssReturnAmount(amount float64) string {
+	return money.FromFloat(amount, vatOssReturnCurrency, money.RoundHalfUp).String()
+}
+
+// formatVatOssReturnRate returns vat rate in percents
+func formatVatOssReturnRate(rate float64) string {
+	return strconv.FormatFloat(rate*100, 'f', 2, 64)
+}
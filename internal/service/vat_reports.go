@@ -8,11 +8,11 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/jinzhu/now"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	curPkg "github.com/paysuper/paysuper-currencies/pkg"
 	"github.com/paysuper/paysuper-currencies/pkg/proto/currencies"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	tax_service "github.com/paysuper/paysuper-tax-service/proto"
 	postmarkSdrPkg "github.com/paysuper/postmark-sender/pkg"
 	"github.com/streadway/amqp"
@@ -27,6 +27,9 @@ const (
 	VatPeriodEvery2Month = 2
 	VatPeriodEvery3Month = 3
 
+	// day of month which central bank rates are used by mid-month currency rates policy
+	vatCurrencyRatesMidMonthDay = 15
+
 	errorMsgVatReportTaxServiceGetRateFailed   = "tax service get rate error"
 	errorMsgVatReportTurnoverNotFound          = "turnover not found"
 	errorMsgVatReportRatesPolicyNotImplemented = "selected currency rates policy not implemented yet"
@@ -43,6 +46,7 @@ var (
 	errorVatReportNotFound                      = newBillingServerErrorMsg("vr000007", "vat report not found")
 	errorVatReportInternal                      = newBillingServerErrorMsg("vr000008", "vat report internal error")
 	errorVatReportStatusIsTheSame               = newBillingServerErrorMsg("vr000009", "vat report status is the same")
+	errorVatReportLockedByOssReturn             = newBillingServerErrorMsg("vr000010", "vat report is locked by exported eu vat oss return")
 
 	VatReportOnStatusNotifyToCentrifugo = []string{
 		pkg.VatReportStatusNeedToPay,
@@ -89,11 +93,11 @@ type vatReportQueryResItem struct {
 
 type vatReportProcessor struct {
 	*Service
-	ctx                context.Context
-	date               time.Time
-	ts                 *timestamp.Timestamp
-	countries          []*billing.Country
-	orderViewUpdateIds map[string]bool
+	ctx       context.Context
+	date      time.Time
+	countries []*billing.Country
+	// changes of local amounts of accounting entries by orders which must be projected to order view
+	orderViewEvents map[string]*orderViewEvent
 }
 
 func NewVatReportProcessor(s *Service, ctx context.Context, date *timestamp.Timestamp) (*vatReportProcessor, error) {
@@ -102,22 +106,17 @@ func NewVatReportProcessor(s *Service, ctx context.Context, date *timestamp.Time
 		return nil, err
 	}
 	eod := now.New(ts).EndOfDay()
-	eodTimestamp, err := ptypes.TimestampProto(eod)
-	if err != nil {
-		return nil, err
-	}
 	countries, err := s.country.GetCountriesWithVatEnabled()
 	if err != nil {
 		return nil, err
 	}
 
 	processor := &vatReportProcessor{
-		Service:            s,
-		ctx:                ctx,
-		date:               eod,
-		ts:                 eodTimestamp,
-		countries:          countries.Countries,
-		orderViewUpdateIds: make(map[string]bool),
+		Service:         s,
+		ctx:             ctx,
+		date:            eod,
+		countries:       countries.Countries,
+		orderViewEvents: make(map[string]*orderViewEvent),
 	}
 
 	return processor, nil
@@ -382,6 +381,12 @@ func (s *Service) UpdateVatReportStatus(
 		return nil
 	}
 
+	if vr.OssReturnId != "" {
+		res.Status = pkg.ResponseStatusBadData
+		res.Message = errorVatReportLockedByOssReturn
+		return nil
+	}
+
 	vr.Status = req.Status
 	if vr.Status == pkg.VatReportStatusPaid {
 		vr.PaidAt = ptypes.TimestampNow()
@@ -391,6 +396,12 @@ func (s *Service) UpdateVatReportStatus(
 	if err != nil {
 		res.Status = pkg.ResponseStatusSystemError
 		res.Message = errorVatReportStatusChangeFailed
+
+		if err == errorVatReportLockedByOssReturn {
+			res.Status = pkg.ResponseStatusBadData
+			res.Message = errorVatReportLockedByOssReturn
+		}
+
 		return nil
 	}
 
@@ -401,10 +412,19 @@ func (s *Service) insertVatReport(vr *billing.VatReport) error {
 	return s.db.Collection(collectionVatReports).Insert(vr)
 }
 
+// updateVatReport saves vat report, report locked by exported eu vat oss return is never changed
 func (s *Service) updateVatReport(ctx context.Context, vr *billing.VatReport) error {
+	if vr.OssReturnId != "" {
+		return errorVatReportLockedByOssReturn
+	}
+
 	vr.UpdatedAt = ptypes.TimestampNow()
-	err := s.db.Collection(collectionVatReports).UpdateId(bson.ObjectIdHex(vr.Id), vr)
+	selector := bson.M{"_id": bson.ObjectIdHex(vr.Id), "oss_return_id": bson.M{"$in": []interface{}{"", nil}}}
+	err := s.db.Collection(collectionVatReports).Update(selector, vr)
 	if err != nil {
+		if err == mgo.ErrNotFound {
+			return errorVatReportLockedByOssReturn
+		}
 		return err
 	}
 
@@ -481,7 +501,8 @@ func (h *vatReportProcessor) ProcessVatReportsStatus(ctx context.Context) error
 	currentUnixTime := time.Now().Unix()
 
 	query := bson.M{
-		"status": bson.M{"$in": []string{pkg.VatReportStatusThreshold, pkg.VatReportStatusNeedToPay}},
+		"status":        bson.M{"$in": []string{pkg.VatReportStatusThreshold, pkg.VatReportStatusNeedToPay}},
+		"oss_return_id": bson.M{"$in": []interface{}{"", nil}},
 	}
 
 	var reports []*billing.VatReport
@@ -525,6 +546,17 @@ func (h *vatReportProcessor) ProcessVatReportsStatus(ctx context.Context) error
 			continue
 		}
 
+		reportDateTo, err := ptypes.Timestamp(report.DateTo)
+		if err != nil {
+			return err
+		}
+
+		// threshold of vat settings effective in period of report
+		country, err = h.Service.applyCountryVatSettings(country, reportDateTo)
+		if err != nil {
+			return err
+		}
+
 		noThreshold := country.VatThreshold.Year == 0 && country.VatThreshold.World == 0
 
 		thresholdExceeded := (country.VatThreshold.Year > 0 && report.CountryAnnualTurnover >= country.VatThreshold.Year) ||
@@ -556,10 +588,25 @@ func (h *vatReportProcessor) getCountry(countryCode string) *billing.Country {
 
 func (h *vatReportProcessor) ProcessVatReports(ctx context.Context) error {
 	for _, c := range h.countries {
-		err := h.processVatReportForPeriod(ctx, c)
+		dates, err := h.getOpenPeriodsDates(c)
 		if err != nil {
 			return err
 		}
+
+		for _, date := range dates {
+			country, err := h.Service.applyCountryVatSettings(c, date)
+			if err != nil {
+				return err
+			}
+			if !country.VatEnabled {
+				continue
+			}
+
+			err = h.processVatReportForPeriod(ctx, country, date)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -567,60 +614,183 @@ func (h *vatReportProcessor) ProcessVatReports(ctx context.Context) error {
 
 func (h *vatReportProcessor) ProcessAccountingEntries() error {
 	for _, c := range h.countries {
-		err := h.processAccountingEntriesForPeriod(c)
+		dates, err := h.getOpenPeriodsDates(c)
 		if err != nil {
 			return err
 		}
+
+		for _, date := range dates {
+			country, err := h.Service.applyCountryVatSettings(c, date)
+			if err != nil {
+				return err
+			}
+			if !country.VatEnabled {
+				continue
+			}
+
+			err = h.processAccountingEntriesForPeriod(country, date)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// getOpenPeriodsDates returns dates of vat periods of country to process, the current period is always the last one.
+// Previous periods, which reports are not closed yet, are processed again for policies with currency rates
+// of date other than the date of operation, because such rates can become known after the period is over.
+func (h *vatReportProcessor) getOpenPeriodsDates(country *billing.Country) ([]time.Time, error) {
+	var dates []time.Time
+
+	if country.VatCurrencyRatesPolicy == pkg.VatCurrencyRatesPolicyOnDay {
+		return append(dates, h.date), nil
+	}
+
+	from, _, err := h.Service.getVatReportTimeForDate(country.VatPeriodMonth, h.date)
+	if err != nil {
+		return append(dates, h.date), nil
+	}
+
+	query := bson.M{
+		"country": country.IsoCodeA2,
+		"status":  pkg.VatReportStatusThreshold,
+		"date_to": bson.M{"$lt": from},
+	}
+
+	var reports []*billing.VatReport
+	err = h.Service.db.Collection(collectionVatReports).Find(query).Sort("date_from").All(&reports)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatReports),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	for _, report := range reports {
+		to, err := ptypes.Timestamp(report.DateTo)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, to)
+	}
+
+	return append(dates, h.date), nil
+}
+
+// getRatesTime returns time of central bank rates to exchange amount of operation made at date
+// in vat period ended at periodTo according to currency rates policy of country.
+// Time of processing returned for rates which are not known yet, amounts exchanged by this rates are approximate.
+func (h *vatReportProcessor) getRatesTime(country *billing.Country, date, periodTo time.Time) time.Time {
+	ratesTime := h.date
+
+	switch country.VatCurrencyRatesPolicy {
+	case pkg.VatCurrencyRatesPolicyOnDay:
+		ratesTime = date
+		break
+	case pkg.VatCurrencyRatesPolicyLastDay:
+		ratesTime = periodTo
+		break
+	case pkg.VatCurrencyRatesPolicyMidMonth:
+		ratesTime = getVatMidMonthRatesTime(date)
+		break
+	}
+
+	if ratesTime.Unix() > h.date.Unix() {
+		return h.date
+	}
+
+	return ratesTime
+}
+
+// getVatMidMonthRatesTime returns time of central bank rates used by mid-month policy for operations in month of date
+func getVatMidMonthRatesTime(date time.Time) time.Time {
+	return now.New(now.New(date).BeginningOfMonth().AddDate(0, 0, vatCurrencyRatesMidMonthDay-1)).EndOfDay()
+}
+
+// UpdateOrderView writes changes of local amounts of accounting entries to order view projection and waits
+// until they are projected, because annual turnovers and vat reports are calculated by order view
 func (h *vatReportProcessor) UpdateOrderView() error {
-	if len(h.orderViewUpdateIds) == 0 {
+	if len(h.orderViewEvents) == 0 {
 		return nil
 	}
 
-	ids := make([]string, 0, len(h.orderViewUpdateIds))
-	for k := range h.orderViewUpdateIds {
-		ids = append(ids, k)
+	events := make([]*orderViewEvent, 0, len(h.orderViewEvents))
+	for _, event := range h.orderViewEvents {
+		events = append(events, event)
 	}
 
-	err := h.Service.updateOrderView(ids)
+	err := h.Service.addOrderViewEvents(events...)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	h.orderViewEvents = make(map[string]*orderViewEvent)
+
+	return h.Service.waitOrderViewProjection(events[len(events)-1].Id)
+}
+
+// addOrderViewLocalAmountChange adds to change event of order difference of amounts of order view
+// made by change of local amount of accounting entry
+func (h *vatReportProcessor) addOrderViewLocalAmountChange(ae *billing.AccountingEntry, previous float64) {
+	if ae.Source.Type != pkg.OrderTypeOrder && ae.Source.Type != pkg.OrderTypeRefund {
+		return
+	}
+
+	event, ok := h.orderViewEvents[ae.Source.Id]
+
+	if !ok {
+		event = newOrderViewEvent(orderViewEventSourceLocalAmount, ae.Source.Id)
+		h.orderViewEvents[ae.Source.Id] = event
+	}
+
+	old := *ae
+	old.LocalAmount = previous
+
+	event.addEntryAmount(ae, 1)
+	event.addEntryAmount(&old, -1)
 }
 
-func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, country *billing.Country) error {
+func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, country *billing.Country, date time.Time) error {
 
-	from, to, err := h.Service.getVatReportTimeForDate(country.VatPeriodMonth, h.date)
+	from, to, err := h.Service.getVatReportTimeForDate(country.VatPeriodMonth, date)
 	if err != nil {
 		zap.S().Warnw("generating vat report failed", "country", country.IsoCodeA2, "err", err.Error())
 		return err
 	}
 
+	locked, err := h.isVatReportPeriodLocked(country.IsoCodeA2, from, to)
+	if err != nil || locked {
+		return err
+	}
+
 	zap.S().Infow("generating vat report",
 		"country", country.IsoCodeA2,
 		"from", from.Format(time.RFC3339),
 		"to", to.Format(time.RFC3339),
 	)
 
-	req := &tax_service.GetRateRequest{
-		IpData: &tax_service.GeoIdentity{
-			Country: country.IsoCodeA2,
-		},
-		UserData: &tax_service.GeoIdentity{},
-	}
+	rate := country.VatRate
 
-	rsp, err := h.Service.tax.GetRate(h.ctx, req)
-	if err != nil {
-		zap.L().Error(errorMsgVatReportTaxServiceGetRateFailed, zap.Error(err))
-		return err
-	}
+	if rate <= 0 {
+		req := &tax_service.GetRateRequest{
+			IpData: &tax_service.GeoIdentity{
+				Country: country.IsoCodeA2,
+			},
+			UserData: &tax_service.GeoIdentity{},
+		}
 
-	rate := rsp.Rate.Rate
+		rsp, err := h.Service.tax.GetRate(h.ctx, req)
+		if err != nil {
+			zap.L().Error(errorMsgVatReportTaxServiceGetRateFailed, zap.Error(err))
+			return err
+		}
+
+		rate = rsp.Rate.Rate
+	}
 
 	report := &billing.VatReport{
 		Id:               bson.NewObjectId().Hex(),
@@ -656,7 +826,7 @@ func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, coun
 		)
 		return nil
 	}
-	report.CountryAnnualTurnover = tools.FormatAmount(countryTurnover.Amount)
+	report.CountryAnnualTurnover = money.Round(countryTurnover.Amount, countryTurnover.Currency)
 
 	worldTurnover, err := h.Service.turnover.Get("", from.Year())
 	if err != nil {
@@ -672,17 +842,27 @@ func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, coun
 		targetCurrency = country.Currency
 	}
 	if worldTurnover.Currency != targetCurrency {
-		report.WorldAnnualTurnover, err = h.exchangeAmount(worldTurnover.Currency, targetCurrency, worldTurnover.Amount, country.VatCurrencyRatesSource)
+		report.WorldAnnualTurnover, err = h.exchangeAmount(
+			worldTurnover.Currency,
+			targetCurrency,
+			worldTurnover.Amount,
+			country.VatCurrencyRatesSource,
+			h.getRatesTime(country, h.date, to),
+		)
 		if err != nil {
 			return err
 		}
 	}
 
-	report.WorldAnnualTurnover = tools.FormatAmount(report.WorldAnnualTurnover)
+	report.WorldAnnualTurnover = money.Round(report.WorldAnnualTurnover, targetCurrency)
 
-	isLastDayOfPeriod := h.date.Unix() == to.Unix()
+	// amounts are approximate until rates for operations made in the last day of period become known
+	lastRatesTime := to
+	if country.VatCurrencyRatesPolicy == pkg.VatCurrencyRatesPolicyMidMonth {
+		lastRatesTime = getVatMidMonthRatesTime(to)
+	}
 	isCurrencyRatesPolicyOnDay := country.VatCurrencyRatesPolicy == pkg.VatCurrencyRatesPolicyOnDay
-	report.AmountsApproximate = !(isCurrencyRatesPolicyOnDay || (!isCurrencyRatesPolicyOnDay && isLastDayOfPeriod))
+	report.AmountsApproximate = !isCurrencyRatesPolicyOnDay && lastRatesTime.Unix() > h.date.Unix()
 
 	matchQuery := bson.M{
 		"pm_order_close_date": bson.M{
@@ -723,11 +903,20 @@ func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, coun
 		return err
 	}
 
+	currency := report.Currency
+	var feesAmount int64
+
 	if len(res) == 1 {
 		report.TransactionsCount = res[0].Count
-		report.GrossRevenue = tools.FormatAmount(res[0].PaymentGrossRevenueLocal - res[0].PaymentRefundGrossRevenueLocal)
-		report.VatAmount = tools.FormatAmount(res[0].PaymentTaxFeeLocal - res[0].PaymentRefundTaxFeeLocal)
-		report.FeesAmount = res[0].PaymentFeesTotal + res[0].PaymentRefundFeesTotal
+		report.GrossRevenue = money.FromMinor(
+			money.ToMinor(res[0].PaymentGrossRevenueLocal, currency)-money.ToMinor(res[0].PaymentRefundGrossRevenueLocal, currency),
+			currency,
+		)
+		report.VatAmount = money.FromMinor(
+			money.ToMinor(res[0].PaymentTaxFeeLocal, currency)-money.ToMinor(res[0].PaymentRefundTaxFeeLocal, currency),
+			currency,
+		)
+		feesAmount = money.ToMinor(res[0].PaymentFeesTotal, currency) + money.ToMinor(res[0].PaymentRefundFeesTotal, currency)
 	}
 
 	matchQuery["is_vat_deduction"] = true
@@ -744,11 +933,11 @@ func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, coun
 
 	if len(res) == 1 {
 		report.TransactionsCount += res[0].Count
-		report.DeductionAmount = tools.FormatAmount(res[0].PaymentRefundTaxFeeLocal)
-		report.FeesAmount += res[0].PaymentFeesTotal + res[0].PaymentRefundFeesTotal
+		report.DeductionAmount = money.Round(res[0].PaymentRefundTaxFeeLocal, currency)
+		feesAmount += money.ToMinor(res[0].PaymentFeesTotal, currency) + money.ToMinor(res[0].PaymentRefundFeesTotal, currency)
 	}
 
-	report.FeesAmount = tools.FormatAmount(report.FeesAmount)
+	report.FeesAmount = money.FromMinor(feesAmount, currency)
 
 	// todo: implement calculation of correction amount after CP settlements support
 
@@ -776,7 +965,7 @@ func (h *vatReportProcessor) processVatReportForPeriod(ctx context.Context, coun
 
 }
 
-func (h *vatReportProcessor) processAccountingEntriesForPeriod(country *billing.Country) error {
+func (h *vatReportProcessor) processAccountingEntriesForPeriod(country *billing.Country, date time.Time) error {
 	if !country.VatEnabled {
 		return errorVatReportNotEnabledForCountry
 	}
@@ -794,17 +983,22 @@ func (h *vatReportProcessor) processAccountingEntriesForPeriod(country *billing.
 		return nil
 	}
 
-	from, to, err := h.Service.getVatReportTimeForDate(country.VatPeriodMonth, h.date)
+	from, to, err := h.Service.getVatReportTimeForDate(country.VatPeriodMonth, date)
 	if err != nil {
 		zap.L().Error(
 			errorMsgVatReportCantGetTimeForDate,
 			zap.Error(err),
 			zap.String("country", country.IsoCodeA2),
-			zap.Time("date", h.date),
+			zap.Time("date", date),
 		)
 		return nil
 	}
 
+	locked, err := h.isVatReportPeriodLocked(country.IsoCodeA2, from, to)
+	if err != nil || locked {
+		return err
+	}
+
 	query := bson.M{
 		"created_at": bson.M{
 			"$gte": now.New(from).BeginningOfDay(),
@@ -845,26 +1039,60 @@ func (h *vatReportProcessor) processAccountingEntriesForPeriod(country *billing.
 		if ae.Type == pkg.AccountingEntryTypeRealTaxFee {
 			continue
 		}
-		amount := ae.LocalAmount
-		if ae.LocalCurrency != ae.OriginalCurrency {
-			amount, err = h.exchangeAmount(ae.OriginalCurrency, ae.LocalCurrency, ae.OriginalAmount, country.VatCurrencyRatesSource)
-			if err != nil {
-				return err
-			}
+
+		createdAt, err := ptypes.Timestamp(ae.CreatedAt)
+		if err != nil {
+			return err
 		}
+		ratesTime := h.getRatesTime(country, createdAt, to)
+
+		previous := ae.LocalAmount
+		amount := ae.LocalAmount
+
 		if ae.Type == pkg.AccountingEntryTypeCentralBankTaxFee {
+			// central bank tax fee is the difference between real tax fee exchanged by central bank rates
+			// of country policy and real tax fee exchanged by rates of operation day. It's calculated from
+			// real tax fee only, so repeated processing of period gives the same amount.
 			realTaxFee, ok := aesRealTaxFee[ae.Source.Id]
-			if ok {
-				ae.LocalAmount = ae.LocalAmount - realTaxFee.LocalAmount
+			if !ok {
+				continue
 			}
+			amount = realTaxFee.LocalAmount
+			if realTaxFee.LocalCurrency != realTaxFee.OriginalCurrency {
+				amount, err = h.exchangeAmount(
+					realTaxFee.OriginalCurrency,
+					realTaxFee.LocalCurrency,
+					realTaxFee.OriginalAmount,
+					country.VatCurrencyRatesSource,
+					ratesTime,
+				)
+				if err != nil {
+					return err
+				}
+			}
+			amount = money.Round(amount-realTaxFee.LocalAmount, realTaxFee.LocalCurrency)
+		} else if ae.LocalCurrency != ae.OriginalCurrency {
+			amount, err = h.exchangeAmount(
+				ae.OriginalCurrency,
+				ae.LocalCurrency,
+				ae.OriginalAmount,
+				country.VatCurrencyRatesSource,
+				ratesTime,
+			)
+			if err != nil {
+				return err
+			}
+			amount = money.Round(amount, ae.LocalCurrency)
 		}
+
 		if amount == ae.LocalAmount {
 			continue
 		}
 
+		ae.LocalAmount = amount
 		bulk.Update(bson.M{"_id": bson.ObjectIdHex(ae.Id)}, ae)
 
-		h.orderViewUpdateIds[ae.Source.Id] = true
+		h.addOrderViewLocalAmountChange(ae, previous)
 	}
 
 	bulkResult, err := bulk.Run()
@@ -883,14 +1111,47 @@ func (h *vatReportProcessor) processAccountingEntriesForPeriod(country *billing.
 	return nil
 }
 
-func (h *vatReportProcessor) exchangeAmount(from, to string, amount float64, source string) (float64, error) {
+// isVatReportPeriodLocked checks that vat report of country for period is locked by exported eu vat oss return,
+// report and accounting entries of such period are not recalculated anymore
+func (h *vatReportProcessor) isVatReportPeriodLocked(country string, from, to time.Time) (bool, error) {
+	query := bson.M{
+		"country":       country,
+		"date_from":     from,
+		"date_to":       to,
+		"oss_return_id": bson.M{"$nin": []interface{}{"", nil}},
+	}
+
+	count, err := h.Service.db.Collection(collectionVatReports).Find(query).Count()
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionVatReports),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return false, err
+	}
+
+	if count > 0 {
+		zap.S().Infow("vat report period is locked by eu vat oss return", "country", country, "from", from, "to", to)
+	}
+
+	return count > 0, nil
+}
+
+func (h *vatReportProcessor) exchangeAmount(from, to string, amount float64, source string, date time.Time) (float64, error) {
+	ts, err := ptypes.TimestampProto(date)
+	if err != nil {
+		return 0, errorVatReportCurrencyExchangeFailed
+	}
+
 	req := &currencies.ExchangeCurrencyByDateCommonRequest{
 		From:     from,
 		To:       to,
 		RateType: curPkg.RateTypeCentralbanks,
 		Source:   source,
 		Amount:   amount,
-		Datetime: h.ts,
+		Datetime: ts,
 	}
 
 	rsp, err := h.Service.curService.ExchangeCurrencyByDateCommon(h.ctx, req)
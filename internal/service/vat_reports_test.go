@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/go-redis/redis"
 	"github.com/golang-migrate/migrate/v4"
@@ -205,6 +208,50 @@ func (suite *VatReportsTestSuite) TestVatReports_getVatReportTimeForDate() {
 	assert.Equal(suite.T(), to.Format(time.RFC3339), "2019-06-30T23:59:59Z")
 }
 
+func (suite *VatReportsTestSuite) TestVatReports_getVatMidMonthRatesTime() {
+	t, err := time.Parse(time.RFC3339, "2019-06-29T11:45:26.371Z")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), getVatMidMonthRatesTime(t).Format(time.RFC3339), "2019-06-15T23:59:59Z")
+
+	t, err = time.Parse(time.RFC3339, "2019-02-01T00:00:00Z")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), getVatMidMonthRatesTime(t).Format(time.RFC3339), "2019-02-15T23:59:59Z")
+}
+
+func (suite *VatReportsTestSuite) TestVatReports_getRatesTime() {
+	date, err := time.Parse(time.RFC3339, "2019-06-20T23:59:59.999999999Z")
+	assert.NoError(suite.T(), err)
+
+	handler := &vatReportProcessor{Service: suite.service, date: date}
+	country := &billing.Country{VatPeriodMonth: 3}
+
+	operationDate, err := time.Parse(time.RFC3339, "2019-05-20T10:00:00Z")
+	assert.NoError(suite.T(), err)
+	_, periodTo, err := suite.service.getVatReportTimeForDate(country.VatPeriodMonth, date)
+	assert.NoError(suite.T(), err)
+
+	country.VatCurrencyRatesPolicy = pkg.VatCurrencyRatesPolicyOnDay
+	assert.Equal(suite.T(), handler.getRatesTime(country, operationDate, periodTo), operationDate)
+
+	// rates of the last day of period are not known yet
+	country.VatCurrencyRatesPolicy = pkg.VatCurrencyRatesPolicyLastDay
+	assert.Equal(suite.T(), handler.getRatesTime(country, operationDate, periodTo), date)
+
+	country.VatCurrencyRatesPolicy = pkg.VatCurrencyRatesPolicyMidMonth
+	ratesTime := handler.getRatesTime(country, operationDate, periodTo)
+	assert.Equal(suite.T(), ratesTime.Format(time.RFC3339), "2019-05-15T23:59:59Z")
+
+	operationDate, err = time.Parse(time.RFC3339, "2019-06-10T10:00:00Z")
+	assert.NoError(suite.T(), err)
+	ratesTime = handler.getRatesTime(country, operationDate, periodTo)
+	assert.Equal(suite.T(), ratesTime.Format(time.RFC3339), "2019-06-15T23:59:59Z")
+
+	// rates of the middle of month are not known yet
+	handler.date, err = time.Parse(time.RFC3339, "2019-06-12T23:59:59.999999999Z")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), handler.getRatesTime(country, operationDate, periodTo), handler.date)
+}
+
 func (suite *VatReportsTestSuite) TestVatReports_ProcessVatReports() {
 	amounts := []float64{100, 10}
 	currencies := []string{"RUB", "USD"}
@@ -234,7 +281,7 @@ func (suite *VatReportsTestSuite) TestVatReports_ProcessVatReports() {
 	assert.NoError(suite.T(), err)
 
 	for _, order := range orders {
-		refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount*0.5, false)
+		refund := helperMakeRefund(suite.Suite, suite.service, order, order.TotalPaymentAmount*0.5)
 		assert.NotNil(suite.T(), refund)
 	}
 
@@ -285,6 +332,25 @@ func (suite *VatReportsTestSuite) TestVatReports_ProcessVatReports() {
 	assert.Equal(suite.T(), report.Status, pkg.VatReportStatusThreshold)
 
 	assert.NoError(suite.T(), err)
+
+	// threshold periods are processed every day, central bank tax fees must not change on repeated processing
+	query := bson.M{"type": pkg.AccountingEntryTypeCentralBankTaxFee}
+	var expected []*billing.AccountingEntry
+	err = suite.service.db.Collection(collectionAccountingEntry).Find(query).Sort("_id").All(&expected)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), expected)
+
+	err = suite.service.ProcessVatReports(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	var actual []*billing.AccountingEntry
+	err = suite.service.db.Collection(collectionAccountingEntry).Find(query).Sort("_id").All(&actual)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), actual, len(expected))
+
+	for i, ae := range actual {
+		assert.Equal(suite.T(), expected[i].LocalAmount, ae.LocalAmount)
+	}
 }
 
 func (suite *VatReportsTestSuite) TestVatReports_PaymentDateSet() {
@@ -343,3 +409,210 @@ func (suite *VatReportsTestSuite) TestVatReports_PaymentDateSet() {
 	assert.Equal(suite.T(), vr.Status, pkg.VatReportStatusPaid)
 	assert.GreaterOrEqual(suite.T(), nowTimestamp, vr.PaidAt.Seconds)
 }
+
+func (suite *VatReportsTestSuite) TestVatReports_CreateVatOssReturn_Ok() {
+	reportFi1 := suite.helperInsertVatReport("FI", pkg.VatReportStatusNeedToPay, "2019-07-01T00:00:00Z", "2019-07-31T23:59:59Z", 124, 24)
+	reportFi2 := suite.helperInsertVatReport("FI", pkg.VatReportStatusPaid, "2019-08-01T00:00:00Z", "2019-08-31T23:59:59Z", 248, 48)
+	reportGr := suite.helperInsertVatReport("GR", pkg.VatReportStatusOverdue, "2019-07-01T00:00:00Z", "2019-09-30T23:59:59Z", 124, 24)
+	suite.helperInsertVatReport("DE", pkg.VatReportStatusExpired, "2019-07-01T00:00:00Z", "2019-09-30T23:59:59Z", 119, 19)
+	suite.helperInsertVatReport("RU", pkg.VatReportStatusNeedToPay, "2019-07-01T00:00:00Z", "2019-09-30T23:59:59Z", 120, 20)
+
+	req := &grpc.VatOssReturnRequest{Year: 2019, Quarter: 3}
+	rsp := &grpc.VatOssReturnResponse{}
+	err := suite.service.CreateVatOssReturn(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.NotNil(suite.T(), rsp.Item)
+	assert.Equal(suite.T(), "EUR", rsp.Item.Currency)
+	assert.Len(suite.T(), rsp.Item.Items, 2)
+	assert.ElementsMatch(suite.T(), []string{reportFi1.Id, reportFi2.Id, reportGr.Id}, rsp.Item.VatReportIds)
+
+	item := rsp.Item.Items[0]
+	assert.Equal(suite.T(), "FI", item.Country)
+	assert.Equal(suite.T(), float64(300), item.TaxableAmount)
+	assert.Equal(suite.T(), float64(72), item.VatAmount)
+	assert.Equal(suite.T(), "GR", rsp.Item.Items[1].Country)
+	assert.Equal(suite.T(), float64(400), rsp.Item.TotalTaxableAmount)
+	assert.Equal(suite.T(), float64(96), rsp.Item.TotalVatAmount)
+
+	assert.Len(suite.T(), rsp.Item.Files, 2)
+	assert.Equal(suite.T(), pkg.VatOssReturnFormatXml, rsp.Item.Files[0].Format)
+	assert.Contains(suite.T(), string(rsp.Item.Files[0].Content), "<MemberStateOfConsumption>EL</MemberStateOfConsumption>")
+	assert.Contains(suite.T(), string(rsp.Item.Files[0].Content), "<TotalVATAmount>96.00</TotalVATAmount>")
+	assert.Equal(suite.T(), pkg.VatOssReturnFormatCsv, rsp.Item.Files[1].Format)
+	assert.Contains(suite.T(), string(rsp.Item.Files[1].Content), "FI,SERVICES,STANDARD,24.00,300.00,72.00,EUR")
+
+	for _, file := range rsp.Item.Files {
+		hash := sha256.Sum256(file.Content)
+		assert.Equal(suite.T(), hex.EncodeToString(hash[:]), file.Checksum)
+	}
+
+	var vr *billing.VatReport
+	err = suite.service.db.Collection(collectionVatReports).FindId(bson.ObjectIdHex(reportFi1.Id)).One(&vr)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rsp.Item.Id, vr.OssReturnId)
+
+	rsp1 := &grpc.VatOssReturnResponse{}
+	err = suite.service.GetVatOssReturn(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), rsp.Item.Id, rsp1.Item.Id)
+	assert.Equal(suite.T(), rsp.Item.Files[0].Checksum, rsp1.Item.Files[0].Checksum)
+
+	rsp2 := &grpc.VatOssReturnResponse{}
+	err = suite.service.CreateVatOssReturn(context.TODO(), req, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp2.Status)
+	assert.Equal(suite.T(), errorVatOssReturnAlreadyExists, rsp2.Message)
+
+	ci := &mocks.CentrifugoInterface{}
+	ci.On("Publish", mock2.Anything, mock2.Anything, mock2.Anything).Return(nil)
+	suite.service.centrifugo = ci
+
+	rsp3 := &grpc.ResponseError{}
+	statusReq := &grpc.UpdateVatReportStatusRequest{Id: reportFi1.Id, Status: pkg.VatReportStatusCanceled}
+	err = suite.service.UpdateVatReportStatus(context.TODO(), statusReq, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp3.Status)
+	assert.Equal(suite.T(), errorVatReportLockedByOssReturn, rsp3.Message)
+
+	statusReq.Status = pkg.VatReportStatusPaid
+	err = suite.service.UpdateVatReportStatus(context.TODO(), statusReq, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp3.Status)
+	assert.Equal(suite.T(), errorVatReportLockedByOssReturn, rsp3.Message)
+
+	// stale copy of report read before locking must not overwrite locked report
+	reportFi1.Status = pkg.VatReportStatusOverdue
+	err = suite.service.updateVatReport(context.TODO(), reportFi1)
+	assert.Equal(suite.T(), errorVatReportLockedByOssReturn, err)
+
+	err = suite.service.db.Collection(collectionVatReports).FindId(bson.ObjectIdHex(reportFi1.Id)).One(&vr)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.VatReportStatusNeedToPay, vr.Status)
+	assert.Equal(suite.T(), rsp.Item.Id, vr.OssReturnId)
+
+	handler := &vatReportProcessor{Service: suite.service}
+	from, err := ptypes.Timestamp(reportFi1.DateFrom)
+	assert.NoError(suite.T(), err)
+	to, err := ptypes.Timestamp(reportFi1.DateTo)
+	assert.NoError(suite.T(), err)
+	locked, err := handler.isVatReportPeriodLocked("FI", from, to)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), locked)
+	locked, err = handler.isVatReportPeriodLocked("FI", from.AddDate(0, 3, 0), to.AddDate(0, 3, 0))
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), locked)
+}
+
+func (suite *VatReportsTestSuite) TestVatReports_CreateVatOssReturn_ReportCrossQuarter() {
+	suite.helperInsertVatReport("FI", pkg.VatReportStatusNeedToPay, "2019-07-01T00:00:00Z", "2019-08-31T23:59:59Z", 124, 24)
+	suite.helperInsertVatReport("FI", pkg.VatReportStatusNeedToPay, "2019-09-01T00:00:00Z", "2019-10-31T23:59:59Z", 124, 24)
+
+	rsp := &grpc.VatOssReturnResponse{}
+	err := suite.service.CreateVatOssReturn(context.TODO(), &grpc.VatOssReturnRequest{Year: 2019, Quarter: 3}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorVatOssReturnReportCrossQuarter, rsp.Message)
+}
+func (suite *VatReportsTestSuite) TestVatReports_RemoveVatOssReturn_ReportsUnlocked() {
+	report := suite.helperInsertVatReport("FI", pkg.VatReportStatusNeedToPay, "2019-07-01T00:00:00Z", "2019-07-31T23:59:59Z", 124, 24)
+
+	req := &grpc.VatOssReturnRequest{Year: 2019, Quarter: 3}
+	rsp := &grpc.VatOssReturnResponse{}
+	err := suite.service.CreateVatOssReturn(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	suite.service.removeVatOssReturn(rsp.Item)
+
+	_, err = suite.service.getVatOssReturn(2019, 3)
+	assert.Equal(suite.T(), mgo.ErrNotFound, err)
+
+	var vr *billing.VatReport
+	err = suite.service.db.Collection(collectionVatReports).FindId(bson.ObjectIdHex(report.Id)).One(&vr)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), vr.OssReturnId)
+
+	rsp1 := &grpc.VatOssReturnResponse{}
+	err = suite.service.CreateVatOssReturn(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+}
+
+func (suite *VatReportsTestSuite) TestVatReports_CreateVatOssReturn_ReportsNotClosed() {
+	suite.helperInsertVatReport("FI", pkg.VatReportStatusNeedToPay, "2019-07-01T00:00:00Z", "2019-07-31T23:59:59Z", 124, 24)
+	suite.helperInsertVatReport("FI", pkg.VatReportStatusThreshold, "2019-08-01T00:00:00Z", "2019-08-31T23:59:59Z", 124, 24)
+
+	rsp := &grpc.VatOssReturnResponse{}
+	err := suite.service.CreateVatOssReturn(context.TODO(), &grpc.VatOssReturnRequest{Year: 2019, Quarter: 3}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorVatOssReturnReportsNotClosed, rsp.Message)
+}
+
+func (suite *VatReportsTestSuite) TestVatReports_CreateVatOssReturn_ReportsNotFound() {
+	suite.helperInsertVatReport("RU", pkg.VatReportStatusNeedToPay, "2019-07-01T00:00:00Z", "2019-09-30T23:59:59Z", 120, 20)
+
+	rsp := &grpc.VatOssReturnResponse{}
+	err := suite.service.CreateVatOssReturn(context.TODO(), &grpc.VatOssReturnRequest{Year: 2019, Quarter: 3}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), errorVatOssReturnReportsNotFound, rsp.Message)
+
+	rsp1 := &grpc.VatOssReturnResponse{}
+	err = suite.service.GetVatOssReturn(context.TODO(), &grpc.VatOssReturnRequest{Year: 2019, Quarter: 3}, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp1.Status)
+	assert.Equal(suite.T(), errorVatOssReturnNotFound, rsp1.Message)
+}
+
+func (suite *VatReportsTestSuite) TestVatReports_CreateVatOssReturn_PeriodNotFinished() {
+	quarter := int32((time.Now().Month()-1)/3 + 1)
+	req := &grpc.VatOssReturnRequest{Year: int32(time.Now().Year()), Quarter: quarter}
+
+	rsp := &grpc.VatOssReturnResponse{}
+	err := suite.service.CreateVatOssReturn(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorVatOssReturnPeriodNotFinished, rsp.Message)
+
+	req.Quarter = 5
+	err = suite.service.CreateVatOssReturn(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), errorVatOssReturnPeriodInvalid, rsp.Message)
+}
+
+func (suite *VatReportsTestSuite) helperInsertVatReport(
+	country, status, from, to string,
+	grossRevenue, vatAmount float64,
+) *billing.VatReport {
+	dateFrom, err := time.Parse(time.RFC3339, from)
+	assert.NoError(suite.T(), err)
+	dateTo, err := time.Parse(time.RFC3339, to)
+	assert.NoError(suite.T(), err)
+
+	report := &billing.VatReport{
+		Id:           bson.NewObjectId().Hex(),
+		Country:      country,
+		VatRate:      0.24,
+		Currency:     "EUR",
+		Status:       status,
+		GrossRevenue: grossRevenue,
+		VatAmount:    vatAmount,
+		PayUntilDate: ptypes.TimestampNow(),
+		CreatedAt:    ptypes.TimestampNow(),
+		UpdatedAt:    ptypes.TimestampNow(),
+	}
+
+	report.DateFrom, err = ptypes.TimestampProto(dateFrom)
+	assert.NoError(suite.T(), err)
+	report.DateTo, err = ptypes.TimestampProto(dateTo)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.insertVatReport(report)
+	assert.NoError(suite.T(), err)
+
+	return report
+}
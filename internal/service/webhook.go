@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	collectionWebhookDelivery = "webhook_delivery"
+
+	webhookResponseBodyMaxLength = 4096
+)
+
+var (
+	webhookErrorDeliveryNotFound = newBillingServerErrorMsg("wh000001", "webhook delivery not found")
+	webhookErrorProjectNotFound  = newBillingServerErrorMsg("wh000002", "project for webhook delivery not found")
+	webhookErrorUnknown          = newBillingServerErrorMsg("wh000003", "unknown error. try request later")
+)
+
+func (s *Service) ListWebhookDeliveries(
+	ctx context.Context,
+	req *grpc.ListWebhookDeliveriesRequest,
+	rsp *grpc.ListWebhookDeliveriesResponse,
+) error {
+	query := bson.M{"merchant_id": bson.ObjectIdHex(req.MerchantId)}
+
+	if req.ProjectId != "" {
+		query["project_id"] = bson.ObjectIdHex(req.ProjectId)
+	}
+
+	if req.OrderId != "" {
+		query["order_id"] = bson.ObjectIdHex(req.OrderId)
+	}
+
+	if len(req.Status) > 0 {
+		query["status"] = bson.M{"$in": req.Status}
+	}
+
+	count, err := s.db.Collection(collectionWebhookDelivery).Find(query).Count()
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = webhookErrorUnknown
+		return nil
+	}
+
+	limit := int(req.Limit)
+
+	if limit <= 0 {
+		limit = pkg.DatabaseRequestDefaultLimit
+	}
+
+	var items []*billing.WebhookDelivery
+	err = s.db.Collection(collectionWebhookDelivery).Find(query).Sort("-_id").
+		Skip(int(req.Offset)).Limit(limit).All(&items)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = webhookErrorUnknown
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = items
+
+	return nil
+}
+
+// ResendWebhookDelivery immediately send webhook to merchant again regardless of its current delivery status
+func (s *Service) ResendWebhookDelivery(
+	ctx context.Context,
+	req *grpc.WebhookDeliveryRequest,
+	rsp *grpc.WebhookDeliveryResponse,
+) error {
+	delivery, err := s.getWebhookDelivery(req.DeliveryId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = webhookErrorDeliveryNotFound
+		return nil
+	}
+
+	err = s.sendWebhookDelivery(delivery, true)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.(*grpc.ResponseErrorMessage)
+
+		if err == webhookErrorProjectNotFound {
+			rsp.Status = pkg.ResponseStatusNotFound
+		}
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = delivery
+
+	return nil
+}
+
+// ProcessWebhookDeliveries send pending webhooks for which time of next delivery attempt has come
+func (s *Service) ProcessWebhookDeliveries() error {
+	query := bson.M{
+		"status":          pkg.WebhookDeliveryStatusPending,
+		"next_attempt_at": bson.M{"$lte": time.Now()},
+	}
+
+	var deliveries []*billing.WebhookDelivery
+	err := s.db.Collection(collectionWebhookDelivery).Find(query).Sort("next_attempt_at").
+		Limit(s.cfg.WebhookProcessBatchSize).All(&deliveries)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		ok, err := s.claimWebhookDelivery(delivery)
+
+		if err != nil || !ok {
+			continue
+		}
+
+		err = s.sendWebhookDelivery(delivery, false)
+
+		if err != nil {
+			zap.L().Error(
+				"Webhook delivery processing failed",
+				zap.Error(err),
+				zap.String("delivery_id", delivery.Id),
+			)
+		}
+	}
+
+	return nil
+}
+
+// claimWebhookDelivery move time of next delivery attempt forward by claim ttl if it still has come,
+// so other replicas which selected the same pending delivery don't send it at the same time.
+// Delivery isn't claimed if another replica has claimed or sent it already
+func (s *Service) claimWebhookDelivery(delivery *billing.WebhookDelivery) (bool, error) {
+	query := bson.M{
+		"_id":             bson.ObjectIdHex(delivery.Id),
+		"status":          pkg.WebhookDeliveryStatusPending,
+		"next_attempt_at": bson.M{"$lte": time.Now()},
+	}
+	change := mgo.Change{
+		Update: bson.M{
+			"$set": bson.M{
+				"next_attempt_at": time.Now().Add(time.Duration(s.cfg.WebhookClaimTtl) * time.Second),
+				"updated_at":      time.Now(),
+			},
+		},
+		ReturnNew: true,
+	}
+	_, err := s.db.Collection(collectionWebhookDelivery).Find(query).Apply(change, delivery)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return false, nil
+		}
+
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Service) createWebhookDelivery(order *billing.Order) error {
+	url := getOrderWebhookUrl(order)
+
+	if url == "" || order.Project.CallbackProtocol == pkg.ProjectCallbackProtocolEmpty {
+		return nil
+	}
+
+	payload, err := json.Marshal(order)
+
+	if err != nil {
+		return err
+	}
+
+	delivery := &billing.WebhookDelivery{
+		Id:            bson.NewObjectId().Hex(),
+		MerchantId:    order.GetMerchantId(),
+		ProjectId:     order.Project.Id,
+		OrderId:       order.Id,
+		Event:         order.GetPublicStatus(),
+		Url:           url,
+		Payload:       string(payload),
+		Status:        pkg.WebhookDeliveryStatusPending,
+		NextAttemptAt: ptypes.TimestampNow(),
+		CreatedAt:     ptypes.TimestampNow(),
+		UpdatedAt:     ptypes.TimestampNow(),
+	}
+
+	return s.insertWebhookDelivery(delivery)
+}
+
+// createSubscriptionWebhookDelivery schedule notification of merchant about subscription event
+// to url for payment notifications of subscription project
+func (s *Service) createSubscriptionWebhookDelivery(msg *billing.SubscriptionNotification) error {
+	project, err := s.project.GetById(msg.Subscription.ProjectId)
+
+	if err != nil {
+		return webhookErrorProjectNotFound
+	}
+
+	if project.UrlProcessPayment == "" || project.CallbackProtocol == pkg.ProjectCallbackProtocolEmpty {
+		return nil
+	}
+
+	payload, err := json.Marshal(msg)
+
+	if err != nil {
+		return err
+	}
+
+	delivery := &billing.WebhookDelivery{
+		Id:            bson.NewObjectId().Hex(),
+		MerchantId:    msg.Subscription.MerchantId,
+		ProjectId:     msg.Subscription.ProjectId,
+		OrderId:       msg.OrderId,
+		Event:         msg.Event,
+		Url:           project.UrlProcessPayment,
+		Payload:       string(payload),
+		Status:        pkg.WebhookDeliveryStatusPending,
+		NextAttemptAt: ptypes.TimestampNow(),
+		CreatedAt:     ptypes.TimestampNow(),
+		UpdatedAt:     ptypes.TimestampNow(),
+	}
+
+	return s.insertWebhookDelivery(delivery)
+}
+
+func (s *Service) insertWebhookDelivery(delivery *billing.WebhookDelivery) error {
+	err := s.db.Collection(collectionWebhookDelivery).Insert(delivery)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, delivery),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// sendWebhookDelivery make one delivery attempt and reschedule next attempt with exponential backoff
+// if merchant didn't accept webhook
+func (s *Service) sendWebhookDelivery(delivery *billing.WebhookDelivery, isManual bool) error {
+	project, err := s.project.GetById(delivery.ProjectId)
+
+	if err != nil {
+		return webhookErrorProjectNotFound
+	}
+
+	attempt := &billing.WebhookDeliveryAttempt{
+		IsManual:  isManual,
+		CreatedAt: ptypes.TimestampNow(),
+	}
+	req, err := http.NewRequest(http.MethodPost, delivery.Url, strings.NewReader(delivery.Payload))
+
+	if err == nil {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		req.Header.Set(HeaderContentType, MIMEApplicationJSON)
+		req.Header.Set(pkg.WebhookHeaderTimestamp, timestamp)
+		req.Header.Set(pkg.WebhookHeaderSignature, getWebhookSignature(project.SecretKey, timestamp, delivery.Payload))
+		req.Header.Set(pkg.WebhookHeaderEvent, delivery.Event)
+		req.Header.Set(pkg.WebhookHeaderDeliveryId, delivery.Id)
+
+		client := &http.Client{Timeout: time.Duration(s.cfg.WebhookRequestTimeout) * time.Second}
+		rsp, err := client.Do(req)
+
+		if err == nil {
+			body, _ := ioutil.ReadAll(io.LimitReader(rsp.Body, webhookResponseBodyMaxLength))
+			_ = rsp.Body.Close()
+
+			attempt.HttpStatus = int32(rsp.StatusCode)
+			attempt.ResponseBody = string(body)
+		} else {
+			attempt.Error = err.Error()
+		}
+	} else {
+		attempt.Error = err.Error()
+	}
+
+	delivery.Attempts = append(delivery.Attempts, attempt)
+
+	if attempt.HttpStatus >= http.StatusOK && attempt.HttpStatus < http.StatusMultipleChoices {
+		delivery.Status = pkg.WebhookDeliveryStatusDelivered
+		delivery.DeliveredAt = ptypes.TimestampNow()
+		delivery.NextAttemptAt = nil
+	} else if delivery.Status == pkg.WebhookDeliveryStatusPending {
+		if len(delivery.Attempts) >= s.cfg.WebhookMaxAttempts {
+			delivery.Status = pkg.WebhookDeliveryStatusFailed
+			delivery.NextAttemptAt = nil
+		} else {
+			delay := time.Duration(s.cfg.WebhookRetryInterval) * time.Second << uint(len(delivery.Attempts)-1)
+			delivery.NextAttemptAt, _ = ptypes.TimestampProto(time.Now().Add(delay))
+		}
+	}
+
+	delivery.UpdatedAt = ptypes.TimestampNow()
+	err = s.db.Collection(collectionWebhookDelivery).UpdateId(bson.ObjectIdHex(delivery.Id), delivery)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, delivery),
+		)
+		return webhookErrorUnknown
+	}
+
+	return nil
+}
+
+func (s *Service) getWebhookDelivery(id, merchantId string) (*billing.WebhookDelivery, error) {
+	if bson.IsObjectIdHex(id) == false || bson.IsObjectIdHex(merchantId) == false {
+		return nil, webhookErrorDeliveryNotFound
+	}
+
+	var delivery *billing.WebhookDelivery
+	query := bson.M{"_id": bson.ObjectIdHex(id), "merchant_id": bson.ObjectIdHex(merchantId)}
+	err := s.db.Collection(collectionWebhookDelivery).Find(query).One(&delivery)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			zap.L().Error(
+				pkg.ErrorDatabaseQueryFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionWebhookDelivery),
+				zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			)
+		}
+
+		return nil, webhookErrorDeliveryNotFound
+	}
+
+	return delivery, nil
+}
+
+func getOrderWebhookUrl(order *billing.Order) string {
+	url := ""
+
+	switch order.GetPublicStatus() {
+	case constant.OrderPublicStatusRefunded:
+		url = order.Project.UrlRefundPayment
+	case constant.OrderPublicStatusChargeback:
+		url = order.Project.UrlChargebackPayment
+	case constant.OrderPublicStatusCanceled:
+		url = order.Project.UrlCancelPayment
+	}
+
+	if url == "" {
+		url = order.Project.UrlProcessPayment
+	}
+
+	return url
+}
+
+func getWebhookSignature(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
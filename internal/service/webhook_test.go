@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-redis/redis"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mocks"
+	internalPkg "github.com/paysuper/paysuper-billing-server/internal/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	mongodb "github.com/paysuper/paysuper-database-mongo"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	reportingMocks "github.com/paysuper/paysuper-reporter/pkg/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	rabbitmq "gopkg.in/ProtocolONE/rabbitmq.v1/pkg"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type WebhookTestSuite struct {
+	suite.Suite
+	service *Service
+	log     *zap.Logger
+	cache   internalPkg.CacheInterface
+
+	project       *billing.Project
+	paymentMethod *billing.PaymentMethod
+	paymentSystem *billing.PaymentSystem
+
+	server      *httptest.Server
+	httpStatus  int
+	request     *http.Request
+	requestBody []byte
+}
+
+func Test_Webhook(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+func (suite *WebhookTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		suite.FailNow("Config load failed", "%v", err)
+	}
+	cfg.CardPayApiUrl = "https://sandbox.cardpay.com"
+
+	m, err := migrate.New(
+		"file://../../migrations/tests",
+		cfg.MongoDsn)
+	assert.NoError(suite.T(), err, "Migrate init failed")
+
+	err = m.Up()
+	if err != nil && err.Error() != "no change" {
+		suite.FailNow("Migrations failed", "%v", err)
+	}
+
+	db, err := mongodb.NewDatabase()
+	if err != nil {
+		suite.FailNow("Database connection failed", "%v", err)
+	}
+
+	suite.log, err = zap.NewProduction()
+
+	if err != nil {
+		suite.FailNow("Logger initialization failed", "%v", err)
+	}
+
+	broker, err := rabbitmq.NewBroker(cfg.BrokerAddress)
+
+	if err != nil {
+		suite.FailNow("Creating RabbitMQ publisher failed", "%v", err)
+	}
+
+	redisClient := database.NewRedis(
+		&redis.Options{
+			Addr:     cfg.RedisHost,
+			Password: cfg.RedisPassword,
+		},
+	)
+
+	redisdb := mocks.NewTestRedis()
+	suite.cache = NewCacheRedis(redisdb)
+	suite.service = NewBillingService(
+		db,
+		cfg,
+		mocks.NewGeoIpServiceTestOk(),
+		mocks.NewRepositoryServiceOk(),
+		mocks.NewTaxServiceOkMock(),
+		broker,
+		redisClient,
+		suite.cache,
+		mocks.NewCurrencyServiceMockOk(),
+		mocks.NewDocumentSignerMockOk(),
+		&reportingMocks.ReporterService{},
+		mocks.NewFormatterOK(),
+		broker,
+	)
+
+	if err := suite.service.Init(); err != nil {
+		suite.FailNow("Billing service initialization failed", "%v", err)
+	}
+
+	_, suite.project, suite.paymentMethod, suite.paymentSystem = helperCreateEntitiesForTests(suite.Suite, suite.service)
+
+	suite.httpStatus = http.StatusOK
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.request = r
+		suite.requestBody, _ = ioutil.ReadAll(r.Body)
+
+		w.WriteHeader(suite.httpStatus)
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	suite.project.UrlProcessPayment = suite.server.URL + "/process"
+	suite.project.UrlRefundPayment = suite.server.URL + "/refund"
+	err = suite.service.project.Update(suite.project)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *WebhookTestSuite) TearDownTest() {
+	suite.server.Close()
+
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *WebhookTestSuite) TestWebhook_orderNotifyMerchant_CreateDelivery() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.True(suite.T(), order.GetNotificationStatus(constant.OrderPublicStatusProcessed))
+
+	deliveries := suite.helperGetWebhookDeliveries(order.Id)
+	assert.Len(suite.T(), deliveries, 1)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusPending, deliveries[0].Status)
+	assert.Equal(suite.T(), constant.OrderPublicStatusProcessed, deliveries[0].Event)
+	assert.Equal(suite.T(), suite.project.UrlProcessPayment, deliveries[0].Url)
+	assert.Equal(suite.T(), suite.project.MerchantId, deliveries[0].MerchantId)
+	assert.NotEmpty(suite.T(), deliveries[0].Payload)
+	assert.Empty(suite.T(), deliveries[0].Attempts)
+	assert.NotNil(suite.T(), deliveries[0].NextAttemptAt)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_orderNotifyMerchant_WithoutUrl() {
+	suite.project.UrlProcessPayment = ""
+	err := suite.service.project.Update(suite.project)
+	assert.NoError(suite.T(), err)
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	assert.True(suite.T(), order.GetNotificationStatus(constant.OrderPublicStatusProcessed))
+	assert.Empty(suite.T(), suite.helperGetWebhookDeliveries(order.Id))
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ProcessWebhookDeliveries_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	err := suite.service.ProcessWebhookDeliveries()
+	assert.NoError(suite.T(), err)
+
+	deliveries := suite.helperGetWebhookDeliveries(order.Id)
+	assert.Len(suite.T(), deliveries, 1)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+	assert.NotNil(suite.T(), deliveries[0].DeliveredAt)
+	assert.Nil(suite.T(), deliveries[0].NextAttemptAt)
+	assert.Len(suite.T(), deliveries[0].Attempts, 1)
+	assert.Equal(suite.T(), int32(http.StatusOK), deliveries[0].Attempts[0].HttpStatus)
+	assert.Equal(suite.T(), "ok", deliveries[0].Attempts[0].ResponseBody)
+	assert.False(suite.T(), deliveries[0].Attempts[0].IsManual)
+
+	assert.NotNil(suite.T(), suite.request)
+	assert.Equal(suite.T(), "/process", suite.request.URL.Path)
+	assert.Equal(suite.T(), deliveries[0].Payload, string(suite.requestBody))
+	assert.Equal(suite.T(), deliveries[0].Id, suite.request.Header.Get(pkg.WebhookHeaderDeliveryId))
+	assert.Equal(suite.T(), constant.OrderPublicStatusProcessed, suite.request.Header.Get(pkg.WebhookHeaderEvent))
+
+	timestamp := suite.request.Header.Get(pkg.WebhookHeaderTimestamp)
+	assert.NotEmpty(suite.T(), timestamp)
+	assert.Equal(
+		suite.T(),
+		getWebhookSignature(suite.project.SecretKey, timestamp, string(suite.requestBody)),
+		suite.request.Header.Get(pkg.WebhookHeaderSignature),
+	)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ProcessWebhookDeliveries_RetryWithBackoff() {
+	suite.httpStatus = http.StatusInternalServerError
+	suite.service.cfg.WebhookMaxAttempts = 2
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	err := suite.service.ProcessWebhookDeliveries()
+	assert.NoError(suite.T(), err)
+
+	deliveries := suite.helperGetWebhookDeliveries(order.Id)
+	assert.Len(suite.T(), deliveries, 1)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusPending, deliveries[0].Status)
+	assert.Len(suite.T(), deliveries[0].Attempts, 1)
+	assert.Equal(suite.T(), int32(http.StatusInternalServerError), deliveries[0].Attempts[0].HttpStatus)
+
+	next, err := ptypes.Timestamp(deliveries[0].NextAttemptAt)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), next.After(time.Now()))
+
+	// delivery isn't processed again before next attempt time
+	err = suite.service.ProcessWebhookDeliveries()
+	assert.NoError(suite.T(), err)
+	deliveries = suite.helperGetWebhookDeliveries(order.Id)
+	assert.Len(suite.T(), deliveries[0].Attempts, 1)
+
+	deliveries[0].NextAttemptAt = ptypes.TimestampNow()
+	err = suite.service.db.Collection(collectionWebhookDelivery).UpdateId(bson.ObjectIdHex(deliveries[0].Id), deliveries[0])
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.ProcessWebhookDeliveries()
+	assert.NoError(suite.T(), err)
+
+	deliveries = suite.helperGetWebhookDeliveries(order.Id)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusFailed, deliveries[0].Status)
+	assert.Len(suite.T(), deliveries[0].Attempts, 2)
+	assert.Nil(suite.T(), deliveries[0].NextAttemptAt)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ProcessWebhookDeliveries_ClaimedByAnotherReplica() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	deliveries := suite.helperGetWebhookDeliveries(order.Id)
+	assert.Len(suite.T(), deliveries, 1)
+
+	ok, err := suite.service.claimWebhookDelivery(deliveries[0])
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	ok, err = suite.service.claimWebhookDelivery(suite.helperGetWebhookDeliveries(order.Id)[0])
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	err = suite.service.ProcessWebhookDeliveries()
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), suite.request)
+
+	deliveries = suite.helperGetWebhookDeliveries(order.Id)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusPending, deliveries[0].Status)
+	assert.Empty(suite.T(), deliveries[0].Attempts)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ResendWebhookDelivery_Ok() {
+	suite.httpStatus = http.StatusBadRequest
+	suite.service.cfg.WebhookMaxAttempts = 1
+
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	err := suite.service.ProcessWebhookDeliveries()
+	assert.NoError(suite.T(), err)
+
+	deliveries := suite.helperGetWebhookDeliveries(order.Id)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusFailed, deliveries[0].Status)
+
+	suite.httpStatus = http.StatusOK
+	req := &grpc.WebhookDeliveryRequest{DeliveryId: deliveries[0].Id, MerchantId: suite.project.MerchantId}
+	rsp := &grpc.WebhookDeliveryResponse{}
+	err = suite.service.ResendWebhookDelivery(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusDelivered, rsp.Item.Status)
+	assert.Len(suite.T(), rsp.Item.Attempts, 2)
+	assert.True(suite.T(), rsp.Item.Attempts[1].IsManual)
+	assert.Equal(suite.T(), int32(http.StatusOK), rsp.Item.Attempts[1].HttpStatus)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ResendWebhookDelivery_NotFound() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	deliveries := suite.helperGetWebhookDeliveries(order.Id)
+	assert.Len(suite.T(), deliveries, 1)
+
+	req := &grpc.WebhookDeliveryRequest{DeliveryId: deliveries[0].Id, MerchantId: bson.NewObjectId().Hex()}
+	rsp := &grpc.WebhookDeliveryResponse{}
+	err := suite.service.ResendWebhookDelivery(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), webhookErrorDeliveryNotFound, rsp.Message)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ListWebhookDeliveries_Ok() {
+	order := helperCreateAndPayOrder(suite.Suite, suite.service, 100, "RUB", "RU", suite.project, suite.paymentMethod)
+	helperCreateAndPayOrder(suite.Suite, suite.service, 200, "RUB", "RU", suite.project, suite.paymentMethod)
+
+	req := &grpc.ListWebhookDeliveriesRequest{MerchantId: suite.project.MerchantId}
+	rsp := &grpc.ListWebhookDeliveriesResponse{}
+	err := suite.service.ListWebhookDeliveries(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(2), rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 2)
+
+	req.OrderId = order.Id
+	rsp = &grpc.ListWebhookDeliveriesResponse{}
+	err = suite.service.ListWebhookDeliveries(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(1), rsp.Count)
+	assert.Equal(suite.T(), order.Id, rsp.Items[0].OrderId)
+
+	req.OrderId = ""
+	req.Status = []string{pkg.WebhookDeliveryStatusDelivered}
+	rsp = &grpc.ListWebhookDeliveriesResponse{}
+	err = suite.service.ListWebhookDeliveries(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(0), rsp.Count)
+	assert.Empty(suite.T(), rsp.Items)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_getOrderWebhookUrl() {
+	order := &billing.Order{
+		PrivateStatus: constant.OrderStatusRefund,
+		Project: &billing.ProjectOrder{
+			UrlProcessPayment: "http://localhost/process",
+			UrlRefundPayment:  "http://localhost/refund",
+		},
+	}
+	assert.Equal(suite.T(), "http://localhost/refund", getOrderWebhookUrl(order))
+
+	order.PrivateStatus = constant.OrderStatusChargeback
+	assert.Equal(suite.T(), "http://localhost/process", getOrderWebhookUrl(order))
+
+	order.PrivateStatus = constant.OrderStatusProjectComplete
+	assert.Equal(suite.T(), "http://localhost/process", getOrderWebhookUrl(order))
+}
+
+func (suite *WebhookTestSuite) helperGetWebhookDeliveries(orderId string) []*billing.WebhookDelivery {
+	var deliveries []*billing.WebhookDelivery
+	err := suite.service.db.Collection(collectionWebhookDelivery).
+		Find(bson.M{"order_id": bson.ObjectIdHex(orderId)}).Sort("_id").All(&deliveries)
+	assert.NoError(suite.T(), err)
+
+	return deliveries
+}
@@ -34,6 +34,43 @@ func main() {
 
 		case "rebuild_order_view":
 			err = app.TaskRebuildOrderView()
+
+		case "order_view_projection":
+			err = app.TaskProcessOrderViewProjection()
+
+		case "order_view_consistency_check":
+			err = app.TaskCheckOrderViewConsistency()
+
+		case "void_authorizations":
+			err = app.TaskVoidExpiredAuthorizations()
+
+		case "order_reservations_release":
+			err = app.TaskReleaseExpiredOrderReservations()
+
+		case "subscription_renewals":
+			err = app.TaskSubscriptionRenewals()
+
+		case "chargebacks_expire":
+			err = app.TaskProcessExpiredChargebacks()
+
+		case "webhooks_deliver":
+			err = app.TaskProcessWebhookDeliveries()
+
+		case "release_rolling_reserves":
+			err = app.TaskReleaseRollingReserves()
+
+		case "orders_export":
+			err = app.TaskProcessOrdersExportJobs()
+
+		case "reconciliation_import":
+			err = app.TaskImportReconciliationReport(
+				date,
+				app.CliArgs.Get("file").String(""),
+				app.CliArgs.Get("payment_system").String(""),
+			)
+
+		case "payout_status_import":
+			err = app.TaskImportPayoutStatusReport(app.CliArgs.Get("file").String(""))
 		}
 
 		if err != nil {
@@ -20,6 +20,35 @@ const (
 	CardPayPaymentResponseStatusAuthorized = "AUTHORIZED"
 	CardPayPaymentResponseStatusCompleted  = "COMPLETED"
 	CardPayPaymentResponseStatusCancelled  = "CANCELLED"
+	CardPayPaymentResponseStatusVoided     = "VOIDED"
+
+	CardPayPaymentOperationChangeStatus = "CHANGE_STATUS"
+	CardPayPaymentStatusToCapture       = "capture"
+	CardPayPaymentStatusToReverse       = "reverse"
+
+	StripePaymentIntentStatusSucceeded             = "succeeded"
+	StripePaymentIntentStatusProcessing            = "processing"
+	StripePaymentIntentStatusRequiresAction        = "requires_action"
+	StripePaymentIntentStatusRequiresPaymentMethod = "requires_payment_method"
+	StripePaymentIntentStatusCanceled              = "canceled"
+	StripePaymentIntentStatusRequiresCapture       = "requires_capture"
+
+	StripeRefundStatusSucceeded = "succeeded"
+	StripeRefundStatusPending   = "pending"
+	StripeRefundStatusFailed    = "failed"
+	StripeRefundStatusCanceled  = "canceled"
+
+	StripeEventTypePaymentIntentSucceeded     = "payment_intent.succeeded"
+	StripeEventTypePaymentIntentPaymentFailed = "payment_intent.payment_failed"
+	StripeEventTypePaymentIntentCanceled      = "payment_intent.canceled"
+	StripeEventTypePaymentIntentProcessing    = "payment_intent.processing"
+	StripeEventTypePaymentIntentCapturable    = "payment_intent.amount_capturable_updated"
+	StripeEventTypeChargeRefundUpdated        = "charge.refund.updated"
+	StripeEventTypeChargeRefunded             = "charge.refunded"
+
+	StripeSetupFutureUsageOffSession = "off_session"
+	StripeCaptureMethodManual        = "manual"
+	StripeRequestThreeDSecureAny     = "any"
 
 	PaymentCreateFieldOrderId         = "order_id"
 	PaymentCreateFieldPaymentMethodId = "payment_method_id"
@@ -82,6 +111,7 @@ const (
 	PaymentSystemErrorCreateRefundRejected = "refund create request rejected"
 
 	PaymentSystemHandlerCardPay = "cardpay"
+	PaymentSystemHandlerStripe  = "stripe"
 
 	MerchantAgreementTypeESign = 2
 
@@ -164,8 +194,21 @@ const (
 	AccountingEntryTypeMerchantRollingReserveCreate    = "merchant_rolling_reserve_create"
 	AccountingEntryTypeMerchantRollingReserveRelease   = "merchant_rolling_reserve_release"
 	AccountingEntryTypeMerchantRoyaltyCorrection       = "merchant_royalty_correction"
+	AccountingEntryTypeMerchantChargeback              = "merchant_chargeback"
+	AccountingEntryTypeMerchantChargebackFee           = "merchant_chargeback_fee"
+	AccountingEntryTypeMerchantChargebackReversal      = "merchant_chargeback_reversal"
+	AccountingEntryTypeMerchantChargebackTaxFee        = "merchant_chargeback_tax_fee"
+
+	LedgerAccountPaymentSystemReceivable = "ps_receivable"
+	LedgerAccountMerchantPayable         = "merchant_payable"
+	LedgerAccountPsRevenue               = "ps_revenue"
+	LedgerAccountTaxPayable              = "tax_payable"
+	LedgerAccountMerchantReserve         = "merchant_reserve"
+	LedgerAccountManualAdjustment        = "manual_adjustment"
 
 	BalanceTransactionStatusAvailable = "available"
+	BalanceTransactionStatusHeld      = "held"
+	BalanceTransactionStatusReleased  = "released"
 
 	ErrorTimeConversion       = "Time conversion error"
 	ErrorTimeConversionValue  = "value"
@@ -241,6 +284,12 @@ const (
 	OrderTypeOrder  = "order"
 	OrderTypeRefund = "refund"
 
+	// private statuses of two-phase payments, numbered after statuses from recurring repository
+	OrderStatusPaymentSystemAuthorized = int32(20)
+	OrderStatusPaymentSystemVoided     = int32(21)
+
+	OrderPublicStatusAuthorized = "authorized"
+
 	PaymentCreateBankCardFieldBrand         = "card_brand"
 	PaymentCreateBankCardFieldType          = "card_type"
 	PaymentCreateBankCardFieldCategory      = "card_category"
@@ -260,9 +309,51 @@ const (
 	RoyaltyReportChangeSourceMerchant = "merchant"
 	RoyaltyReportChangeSourceAdmin    = "admin"
 
+	RoyaltyReportDisputeStatusOpen     = "open"
+	RoyaltyReportDisputeStatusResolved = "resolved"
+
+	RoyaltyReportDisputeItemTypeOrder   = "order"
+	RoyaltyReportDisputeItemTypeSummary = "summary"
+
+	RoyaltyReportCorrectionTypeFee          = "fee"
+	RoyaltyReportCorrectionTypeVat          = "vat"
+	RoyaltyReportCorrectionTypeRefund       = "refund"
+	RoyaltyReportCorrectionTypeChargeback   = "chargeback"
+	RoyaltyReportCorrectionTypeExchangeRate = "exchange_rate"
+	RoyaltyReportCorrectionTypeOther        = "other"
+
+	KeyAuditActionUpload  = "upload"
+	KeyAuditActionRead    = "read"
+	KeyAuditActionReserve = "reserve"
+	KeyAuditActionRedeem  = "redeem"
+	KeyAuditActionCancel  = "cancel"
+	KeyAuditActionExpire  = "expire"
+	KeyAuditActionRotate  = "rotate"
+	KeyAuditActionRevoke  = "revoke"
+	KeyAuditActionDelete  = "delete"
+	KeyAuditActionExport  = "export"
+
+	KeysFileFormatText = "text"
+	KeysFileFormatCsv  = "csv"
+
+	KeysFileLineStatusOk              = "ok"
+	KeysFileLineStatusInvalid         = "invalid"
+	KeysFileLineStatusDuplicateInFile = "duplicate_in_file"
+	KeysFileLineStatusDuplicate       = "duplicate"
+	KeysFileLineStatusError           = "error"
+
+	KeysLowStockMessage = "Keys stock is running low"
+
+	KeyReservationExpiredTopicName = "key.reservation.expired"
+
+	KeysStockStateAvailable = "available"
+	KeysStockStateReserved  = "reserved"
+	KeysStockStateSold      = "sold"
+
 	VatCurrencyRatesPolicyOnDay    = "on-day"
 	VatCurrencyRatesPolicyLastDay  = "last-day"
 	VatCurrencyRatesPolicyAvgMonth = "avg-month"
+	VatCurrencyRatesPolicyMidMonth = "mid-month"
 
 	VatReportStatusThreshold = "threshold"
 	VatReportStatusExpired   = "expired"
@@ -291,11 +382,12 @@ const (
 	DashboardPeriodPreviousYear    = "previous_year"
 	DashboardPeriodTwoYearsAgo     = "two_years_ago"
 
-	PayoutDocumentStatusSkip     = "skip"
-	PayoutDocumentStatusPending  = "pending"
-	PayoutDocumentStatusPaid     = "paid"
-	PayoutDocumentStatusCanceled = "canceled"
-	PayoutDocumentStatusFailed   = "failed"
+	PayoutDocumentStatusSkip       = "skip"
+	PayoutDocumentStatusPending    = "pending"
+	PayoutDocumentStatusInProgress = "in_progress"
+	PayoutDocumentStatusPaid       = "paid"
+	PayoutDocumentStatusCanceled   = "canceled"
+	PayoutDocumentStatusFailed     = "failed"
 
 	OrderIssuerReferenceTypePaylink = "paylink"
 
@@ -311,6 +403,90 @@ const (
 	PaymentSystemActionCreatePayment    = "create_payment"
 	PaymentSystemActionRecurringPayment = "recurring_payment"
 	PaymentSystemActionRefund           = "refund"
+	PaymentSystemActionCapture          = "capture"
+	PaymentSystemActionVoid             = "void"
+
+	SubscriptionStatusTrialing = "trialing"
+	SubscriptionStatusActive   = "active"
+	SubscriptionStatusPastDue  = "past_due"
+	SubscriptionStatusPaused   = "paused"
+	SubscriptionStatusCanceled = "canceled"
+
+	SubscriptionIntervalDay   = "day"
+	SubscriptionIntervalWeek  = "week"
+	SubscriptionIntervalMonth = "month"
+	SubscriptionIntervalYear  = "year"
+
+	SubscriptionEventCreated       = "subscription.created"
+	SubscriptionEventRenewed       = "subscription.renewed"
+	SubscriptionEventPaymentFailed = "subscription.payment_failed"
+	SubscriptionEventPaused        = "subscription.paused"
+	SubscriptionEventResumed       = "subscription.resumed"
+	SubscriptionEventCanceled      = "subscription.canceled"
+
+	SubscriptionCancelReasonPaymentFailed = "payment_failed"
+
+	SubscriptionNotifyTopicName = "notify_subscription"
+
+	ReconciliationLineTypePayment = "payment"
+	ReconciliationLineTypeRefund  = "refund"
+
+	ReconciliationLineStatusMatched          = "matched"
+	ReconciliationLineStatusAmountMismatch   = "amount_mismatch"
+	ReconciliationLineStatusCurrencyMismatch = "currency_mismatch"
+	ReconciliationLineStatusOrphan           = "orphan"
+	ReconciliationLineStatusMissing          = "missing"
+
+	ChargebackStatusNotification     = "notification"
+	ChargebackStatusEvidenceUploaded = "evidence_uploaded"
+	ChargebackStatusRepresentment    = "representment"
+	ChargebackStatusWon              = "won"
+	ChargebackStatusLost             = "lost"
+
+	FraudRuleTypeVelocity        = "velocity"
+	FraudRuleTypeCountryMismatch = "country_mismatch"
+	FraudRuleTypeAmount          = "amount"
+	FraudRuleTypeBlockList       = "block_list"
+
+	FraudRuleFieldCustomer = "customer"
+	FraudRuleFieldCard     = "card"
+	FraudRuleFieldIp       = "ip"
+	FraudRuleFieldEmail    = "email"
+	FraudRuleFieldCountry  = "country"
+	FraudRuleFieldBin      = "bin"
+
+	FraudDecisionAllow   = "allow"
+	FraudDecisionReview  = "review"
+	FraudDecision3ds     = "3ds"
+	FraudDecisionDecline = "decline"
+
+	FraudNotifyTopicName = "notify_fraud"
+
+	PromoCodeTypePercent = "percent"
+	PromoCodeTypeFixed   = "fixed"
+
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusFailed    = "failed"
+
+	WebhookHeaderSignature  = "X-PaySuper-Signature"
+	WebhookHeaderTimestamp  = "X-PaySuper-Timestamp"
+	WebhookHeaderEvent      = "X-PaySuper-Event"
+	WebhookHeaderDeliveryId = "X-PaySuper-Delivery-Id"
+
+	PayoutBatchFormatSepa = "sepa"
+	PayoutBatchFormatCsv  = "csv"
+
+	VatOssReturnFormatXml = "xml"
+	VatOssReturnFormatCsv = "csv"
+
+	OrdersExportFormatCsv  = "csv"
+	OrdersExportFormatXlsx = "xlsx"
+
+	OrdersExportJobStatusQueued     = "queued"
+	OrdersExportJobStatusProcessing = "processing"
+	OrdersExportJobStatusDone       = "done"
+	OrdersExportJobStatusFailed     = "failed"
 )
 
 var (
@@ -390,5 +566,36 @@ var (
 			Path:   "/api/refunds",
 			Method: http.MethodPost,
 		},
+		PaymentSystemActionCapture: {
+			Path:   "/api/payments/%s",
+			Method: http.MethodPatch,
+		},
+		PaymentSystemActionVoid: {
+			Path:   "/api/payments/%s",
+			Method: http.MethodPatch,
+		},
+	}
+
+	StripePaths = map[string]*Path{
+		PaymentSystemActionCreatePayment: {
+			Path:   "/v1/payment_intents",
+			Method: http.MethodPost,
+		},
+		PaymentSystemActionRecurringPayment: {
+			Path:   "/v1/payment_intents",
+			Method: http.MethodPost,
+		},
+		PaymentSystemActionRefund: {
+			Path:   "/v1/refunds",
+			Method: http.MethodPost,
+		},
+		PaymentSystemActionCapture: {
+			Path:   "/v1/payment_intents/%s/capture",
+			Method: http.MethodPost,
+		},
+		PaymentSystemActionVoid: {
+			Path:   "/v1/payment_intents/%s/cancel",
+			Method: http.MethodPost,
+		},
 	}
 )
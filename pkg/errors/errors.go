@@ -21,4 +21,12 @@ var (
 	KeyErrorCanceled       = newBillingServerErrorMsg("ks000004", "unable to cancel key")
 	KeyErrorFinish         = newBillingServerErrorMsg("ks000005", "unable to finish key")
 	KeyErrorReserve        = newBillingServerErrorMsg("ks000006", "unable to reserve key")
+	KeyErrorEncrypt        = newBillingServerErrorMsg("ks000007", "unable to encrypt key")
+	KeyErrorDecrypt        = newBillingServerErrorMsg("ks000008", "unable to decrypt key")
+	KeyErrorRotate         = newBillingServerErrorMsg("ks000009", "unable to rotate keys encryption key")
+	KeyErrorRevoke         = newBillingServerErrorMsg("ks000010", "unable to revoke keys")
+	KeyErrorDelete         = newBillingServerErrorMsg("ks000011", "unable to delete keys")
+	KeyErrorExport         = newBillingServerErrorMsg("ks000012", "unable to export keys")
+	KeyErrorLowStock       = newBillingServerErrorMsg("ks000013", "keys stock is running low")
+	KeyErrorCsvCodeColumn  = newBillingServerErrorMsg("ks000014", "column \"code\" not found in csv file header")
 )
@@ -14,6 +14,36 @@ type BillingService struct {
 	mock.Mock
 }
 
+// AddRoyaltyReportDisputeCorrection provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) AddRoyaltyReportDisputeCorrection(ctx context.Context, in *grpc.AddRoyaltyReportDisputeCorrectionRequest, opts ...client.CallOption) (*grpc.RoyaltyReportDisputeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.RoyaltyReportDisputeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.AddRoyaltyReportDisputeCorrectionRequest, ...client.CallOption) *grpc.RoyaltyReportDisputeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.RoyaltyReportDisputeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.AddRoyaltyReportDisputeCorrectionRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AutoAcceptRoyaltyReports provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) AutoAcceptRoyaltyReports(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -134,6 +164,66 @@ func (_m *BillingService) CancelRedeemKeyForOrder(ctx context.Context, in *grpc.
 	return r0, r1
 }
 
+// CancelSubscription provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CancelSubscription(ctx context.Context, in *grpc.SubscriptionRequest, opts ...client.CallOption) (*grpc.SubscriptionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.SubscriptionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) *grpc.SubscriptionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.SubscriptionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CaptureOrder provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CaptureOrder(ctx context.Context, in *grpc.OrderAuthorizationRequest, opts ...client.CallOption) (*grpc.OrderAuthorizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.OrderAuthorizationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.OrderAuthorizationRequest, ...client.CallOption) *grpc.OrderAuthorizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.OrderAuthorizationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.OrderAuthorizationRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ChangeCodeInOrder provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) ChangeCodeInOrder(ctx context.Context, in *grpc.ChangeCodeInOrderRequest, opts ...client.CallOption) (*grpc.ChangeCodeInOrderResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -284,6 +374,36 @@ func (_m *BillingService) ChangeMerchantPaymentMethod(ctx context.Context, in *g
 	return r0, r1
 }
 
+// ChangeMerchantPayoutCurrencies provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ChangeMerchantPayoutCurrencies(ctx context.Context, in *grpc.ChangeMerchantPayoutCurrenciesRequest, opts ...client.CallOption) (*grpc.ChangeMerchantPayoutCurrenciesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ChangeMerchantPayoutCurrenciesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ChangeMerchantPayoutCurrenciesRequest, ...client.CallOption) *grpc.ChangeMerchantPayoutCurrenciesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ChangeMerchantPayoutCurrenciesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ChangeMerchantPayoutCurrenciesRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ChangeMerchantStatus provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) ChangeMerchantStatus(ctx context.Context, in *grpc.MerchantChangeStatusRequest, opts ...client.CallOption) (*grpc.ChangeMerchantStatusResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -434,6 +554,36 @@ func (_m *BillingService) CheckSkuAndKeyProject(ctx context.Context, in *grpc.Ch
 	return r0, r1
 }
 
+// CloseChargeback provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CloseChargeback(ctx context.Context, in *grpc.CloseChargebackRequest, opts ...client.CallOption) (*grpc.ChargebackResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ChargebackResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CloseChargebackRequest, ...client.CallOption) *grpc.ChargebackResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ChargebackResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CloseChargebackRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConfirmUserEmail provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) ConfirmUserEmail(ctx context.Context, in *grpc.ConfirmUserEmailRequest, opts ...client.CallOption) (*grpc.CheckProjectRequestSignatureResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -494,6 +644,36 @@ func (_m *BillingService) CreateAccountingEntry(ctx context.Context, in *grpc.Cr
 	return r0, r1
 }
 
+// CreateChargeback provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateChargeback(ctx context.Context, in *grpc.CreateChargebackRequest, opts ...client.CallOption) (*grpc.ChargebackResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ChargebackResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreateChargebackRequest, ...client.CallOption) *grpc.ChargebackResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ChargebackResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreateChargebackRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateNotification provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) CreateNotification(ctx context.Context, in *grpc.NotificationRequest, opts ...client.CallOption) (*grpc.CreateNotificationResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -524,6 +704,36 @@ func (_m *BillingService) CreateNotification(ctx context.Context, in *grpc.Notif
 	return r0, r1
 }
 
+// CreateOrUpdateFraudRule provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateOrUpdateFraudRule(ctx context.Context, in *billing.FraudRule, opts ...client.CallOption) (*grpc.FraudRuleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.FraudRuleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.FraudRule, ...client.CallOption) *grpc.FraudRuleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.FraudRuleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.FraudRule, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateOrUpdateKeyProduct provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) CreateOrUpdateKeyProduct(ctx context.Context, in *grpc.CreateOrUpdateKeyProductRequest, opts ...client.CallOption) (*grpc.KeyProductResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -704,8 +914,8 @@ func (_m *BillingService) CreateOrUpdateProduct(ctx context.Context, in *grpc.Pr
 	return r0, r1
 }
 
-// CreateOrUpdateUserProfile provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) CreateOrUpdateUserProfile(ctx context.Context, in *grpc.UserProfile, opts ...client.CallOption) (*grpc.GetUserProfileResponse, error) {
+// CreateOrUpdatePromoCode provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateOrUpdatePromoCode(ctx context.Context, in *billing.PromoCode, opts ...client.CallOption) (*grpc.PromoCodeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -715,17 +925,17 @@ func (_m *BillingService) CreateOrUpdateUserProfile(ctx context.Context, in *grp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetUserProfileResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.UserProfile, ...client.CallOption) *grpc.GetUserProfileResponse); ok {
+	var r0 *grpc.PromoCodeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PromoCode, ...client.CallOption) *grpc.PromoCodeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetUserProfileResponse)
+			r0 = ret.Get(0).(*grpc.PromoCodeResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.UserProfile, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PromoCode, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -734,8 +944,8 @@ func (_m *BillingService) CreateOrUpdateUserProfile(ctx context.Context, in *grp
 	return r0, r1
 }
 
-// CreatePageReview provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) CreatePageReview(ctx context.Context, in *grpc.CreatePageReviewRequest, opts ...client.CallOption) (*grpc.CheckProjectRequestSignatureResponse, error) {
+// CreateOrUpdateSubscriptionPlan provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateOrUpdateSubscriptionPlan(ctx context.Context, in *billing.SubscriptionPlan, opts ...client.CallOption) (*grpc.SubscriptionPlanResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -745,17 +955,17 @@ func (_m *BillingService) CreatePageReview(ctx context.Context, in *grpc.CreateP
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.CheckProjectRequestSignatureResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreatePageReviewRequest, ...client.CallOption) *grpc.CheckProjectRequestSignatureResponse); ok {
+	var r0 *grpc.SubscriptionPlanResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.SubscriptionPlan, ...client.CallOption) *grpc.SubscriptionPlanResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.CheckProjectRequestSignatureResponse)
+			r0 = ret.Get(0).(*grpc.SubscriptionPlanResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreatePageReviewRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.SubscriptionPlan, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -764,8 +974,8 @@ func (_m *BillingService) CreatePageReview(ctx context.Context, in *grpc.CreateP
 	return r0, r1
 }
 
-// CreatePayoutDocument provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) CreatePayoutDocument(ctx context.Context, in *grpc.CreatePayoutDocumentRequest, opts ...client.CallOption) (*grpc.PayoutDocumentResponse, error) {
+// CreateOrUpdateUserProfile provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateOrUpdateUserProfile(ctx context.Context, in *grpc.UserProfile, opts ...client.CallOption) (*grpc.GetUserProfileResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -775,17 +985,17 @@ func (_m *BillingService) CreatePayoutDocument(ctx context.Context, in *grpc.Cre
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PayoutDocumentResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreatePayoutDocumentRequest, ...client.CallOption) *grpc.PayoutDocumentResponse); ok {
+	var r0 *grpc.GetUserProfileResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.UserProfile, ...client.CallOption) *grpc.GetUserProfileResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PayoutDocumentResponse)
+			r0 = ret.Get(0).(*grpc.GetUserProfileResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreatePayoutDocumentRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.UserProfile, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -794,8 +1004,8 @@ func (_m *BillingService) CreatePayoutDocument(ctx context.Context, in *grpc.Cre
 	return r0, r1
 }
 
-// CreateRefund provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) CreateRefund(ctx context.Context, in *grpc.CreateRefundRequest, opts ...client.CallOption) (*grpc.CreateRefundResponse, error) {
+// CreateOrdersExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateOrdersExportJob(ctx context.Context, in *grpc.ExportOrdersRequest, opts ...client.CallOption) (*grpc.OrdersExportJobResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -805,17 +1015,17 @@ func (_m *BillingService) CreateRefund(ctx context.Context, in *grpc.CreateRefun
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.CreateRefundResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreateRefundRequest, ...client.CallOption) *grpc.CreateRefundResponse); ok {
+	var r0 *grpc.OrdersExportJobResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ExportOrdersRequest, ...client.CallOption) *grpc.OrdersExportJobResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.CreateRefundResponse)
+			r0 = ret.Get(0).(*grpc.OrdersExportJobResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreateRefundRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ExportOrdersRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -824,8 +1034,8 @@ func (_m *BillingService) CreateRefund(ctx context.Context, in *grpc.CreateRefun
 	return r0, r1
 }
 
-// CreateRoyaltyReport provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) CreateRoyaltyReport(ctx context.Context, in *grpc.CreateRoyaltyReportRequest, opts ...client.CallOption) (*grpc.CreateRoyaltyReportRequest, error) {
+// CreatePageReview provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreatePageReview(ctx context.Context, in *grpc.CreatePageReviewRequest, opts ...client.CallOption) (*grpc.CheckProjectRequestSignatureResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -835,17 +1045,17 @@ func (_m *BillingService) CreateRoyaltyReport(ctx context.Context, in *grpc.Crea
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.CreateRoyaltyReportRequest
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreateRoyaltyReportRequest, ...client.CallOption) *grpc.CreateRoyaltyReportRequest); ok {
+	var r0 *grpc.CheckProjectRequestSignatureResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreatePageReviewRequest, ...client.CallOption) *grpc.CheckProjectRequestSignatureResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.CreateRoyaltyReportRequest)
+			r0 = ret.Get(0).(*grpc.CheckProjectRequestSignatureResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreateRoyaltyReportRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreatePageReviewRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -854,8 +1064,8 @@ func (_m *BillingService) CreateRoyaltyReport(ctx context.Context, in *grpc.Crea
 	return r0, r1
 }
 
-// CreateToken provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) CreateToken(ctx context.Context, in *grpc.TokenRequest, opts ...client.CallOption) (*grpc.TokenResponse, error) {
+// CreatePaylinkToken provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreatePaylinkToken(ctx context.Context, in *paylink.CreatePaylinkTokenRequest, opts ...client.CallOption) (*grpc.CreatePaylinkTokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -865,17 +1075,17 @@ func (_m *BillingService) CreateToken(ctx context.Context, in *grpc.TokenRequest
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.TokenResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.TokenRequest, ...client.CallOption) *grpc.TokenResponse); ok {
+	var r0 *grpc.CreatePaylinkTokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *paylink.CreatePaylinkTokenRequest, ...client.CallOption) *grpc.CreatePaylinkTokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.TokenResponse)
+			r0 = ret.Get(0).(*grpc.CreatePaylinkTokenResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.TokenRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *paylink.CreatePaylinkTokenRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -884,8 +1094,8 @@ func (_m *BillingService) CreateToken(ctx context.Context, in *grpc.TokenRequest
 	return r0, r1
 }
 
-// DeleteKeyProduct provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeleteKeyProduct(ctx context.Context, in *grpc.RequestKeyProductMerchant, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
+// CreatePayoutBatch provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreatePayoutBatch(ctx context.Context, in *grpc.CreatePayoutBatchRequest, opts ...client.CallOption) (*grpc.PayoutBatchResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -895,17 +1105,17 @@ func (_m *BillingService) DeleteKeyProduct(ctx context.Context, in *grpc.Request
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponseWithStatus
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
+	var r0 *grpc.PayoutBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreatePayoutBatchRequest, ...client.CallOption) *grpc.PayoutBatchResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
+			r0 = ret.Get(0).(*grpc.PayoutBatchResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreatePayoutBatchRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -914,8 +1124,8 @@ func (_m *BillingService) DeleteKeyProduct(ctx context.Context, in *grpc.Request
 	return r0, r1
 }
 
-// DeleteMoneyBackCostMerchant provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeleteMoneyBackCostMerchant(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
+// CreatePayoutDocument provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreatePayoutDocument(ctx context.Context, in *grpc.CreatePayoutDocumentRequest, opts ...client.CallOption) (*grpc.PayoutDocumentResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -925,17 +1135,17 @@ func (_m *BillingService) DeleteMoneyBackCostMerchant(ctx context.Context, in *b
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ResponseError
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
+	var r0 *grpc.PayoutDocumentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreatePayoutDocumentRequest, ...client.CallOption) *grpc.PayoutDocumentResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ResponseError)
+			r0 = ret.Get(0).(*grpc.PayoutDocumentResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreatePayoutDocumentRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -944,8 +1154,8 @@ func (_m *BillingService) DeleteMoneyBackCostMerchant(ctx context.Context, in *b
 	return r0, r1
 }
 
-// DeleteMoneyBackCostSystem provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeleteMoneyBackCostSystem(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
+// CreateRefund provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateRefund(ctx context.Context, in *grpc.CreateRefundRequest, opts ...client.CallOption) (*grpc.CreateRefundResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -955,17 +1165,17 @@ func (_m *BillingService) DeleteMoneyBackCostSystem(ctx context.Context, in *bil
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ResponseError
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
+	var r0 *grpc.CreateRefundResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreateRefundRequest, ...client.CallOption) *grpc.CreateRefundResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ResponseError)
+			r0 = ret.Get(0).(*grpc.CreateRefundResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreateRefundRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -974,8 +1184,8 @@ func (_m *BillingService) DeleteMoneyBackCostSystem(ctx context.Context, in *bil
 	return r0, r1
 }
 
-// DeletePaylink provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeletePaylink(ctx context.Context, in *grpc.PaylinkRequest, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
+// CreateRoyaltyReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateRoyaltyReport(ctx context.Context, in *grpc.CreateRoyaltyReportRequest, opts ...client.CallOption) (*grpc.CreateRoyaltyReportRequest, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -985,17 +1195,17 @@ func (_m *BillingService) DeletePaylink(ctx context.Context, in *grpc.PaylinkReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponseWithStatus
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
+	var r0 *grpc.CreateRoyaltyReportRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreateRoyaltyReportRequest, ...client.CallOption) *grpc.CreateRoyaltyReportRequest); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
+			r0 = ret.Get(0).(*grpc.CreateRoyaltyReportRequest)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreateRoyaltyReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1004,8 +1214,8 @@ func (_m *BillingService) DeletePaylink(ctx context.Context, in *grpc.PaylinkReq
 	return r0, r1
 }
 
-// DeletePaymentChannelCostMerchant provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeletePaymentChannelCostMerchant(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
+// CreateSubscription provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateSubscription(ctx context.Context, in *grpc.CreateSubscriptionRequest, opts ...client.CallOption) (*grpc.SubscriptionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1015,17 +1225,17 @@ func (_m *BillingService) DeletePaymentChannelCostMerchant(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ResponseError
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
+	var r0 *grpc.SubscriptionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CreateSubscriptionRequest, ...client.CallOption) *grpc.SubscriptionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ResponseError)
+			r0 = ret.Get(0).(*grpc.SubscriptionResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CreateSubscriptionRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1034,8 +1244,8 @@ func (_m *BillingService) DeletePaymentChannelCostMerchant(ctx context.Context,
 	return r0, r1
 }
 
-// DeletePaymentChannelCostSystem provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeletePaymentChannelCostSystem(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
+// CreateToken provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateToken(ctx context.Context, in *grpc.TokenRequest, opts ...client.CallOption) (*grpc.TokenResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1045,17 +1255,17 @@ func (_m *BillingService) DeletePaymentChannelCostSystem(ctx context.Context, in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ResponseError
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
+	var r0 *grpc.TokenResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.TokenRequest, ...client.CallOption) *grpc.TokenResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ResponseError)
+			r0 = ret.Get(0).(*grpc.TokenResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.TokenRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1064,8 +1274,8 @@ func (_m *BillingService) DeletePaymentChannelCostSystem(ctx context.Context, in
 	return r0, r1
 }
 
-// DeletePaymentMethodProductionSettings provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeletePaymentMethodProductionSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.ChangePaymentMethodParamsResponse, error) {
+// CreateVatOssReturn provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) CreateVatOssReturn(ctx context.Context, in *grpc.VatOssReturnRequest, opts ...client.CallOption) (*grpc.VatOssReturnResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1075,17 +1285,17 @@ func (_m *BillingService) DeletePaymentMethodProductionSettings(ctx context.Cont
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ChangePaymentMethodParamsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.ChangePaymentMethodParamsResponse); ok {
+	var r0 *grpc.VatOssReturnResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.VatOssReturnRequest, ...client.CallOption) *grpc.VatOssReturnResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ChangePaymentMethodParamsResponse)
+			r0 = ret.Get(0).(*grpc.VatOssReturnResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.VatOssReturnRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1094,8 +1304,8 @@ func (_m *BillingService) DeletePaymentMethodProductionSettings(ctx context.Cont
 	return r0, r1
 }
 
-// DeletePaymentMethodTestSettings provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeletePaymentMethodTestSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.ChangePaymentMethodParamsResponse, error) {
+// DeleteFraudRule provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteFraudRule(ctx context.Context, in *grpc.FraudRuleRequest, opts ...client.CallOption) (*grpc.FraudRuleResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1105,17 +1315,17 @@ func (_m *BillingService) DeletePaymentMethodTestSettings(ctx context.Context, i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ChangePaymentMethodParamsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.ChangePaymentMethodParamsResponse); ok {
+	var r0 *grpc.FraudRuleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.FraudRuleRequest, ...client.CallOption) *grpc.FraudRuleResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ChangePaymentMethodParamsResponse)
+			r0 = ret.Get(0).(*grpc.FraudRuleResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.FraudRuleRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1124,8 +1334,8 @@ func (_m *BillingService) DeletePaymentMethodTestSettings(ctx context.Context, i
 	return r0, r1
 }
 
-// DeleteProduct provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeleteProduct(ctx context.Context, in *grpc.RequestProduct, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
+// DeleteKeyProduct provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteKeyProduct(ctx context.Context, in *grpc.RequestKeyProductMerchant, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1135,17 +1345,17 @@ func (_m *BillingService) DeleteProduct(ctx context.Context, in *grpc.RequestPro
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) *grpc.EmptyResponse); ok {
+	var r0 *grpc.EmptyResponseWithStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponse)
+			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1154,8 +1364,8 @@ func (_m *BillingService) DeleteProduct(ctx context.Context, in *grpc.RequestPro
 	return r0, r1
 }
 
-// DeleteProject provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeleteProject(ctx context.Context, in *grpc.GetProjectRequest, opts ...client.CallOption) (*grpc.ChangeProjectResponse, error) {
+// DeleteMoneyBackCostMerchant provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteMoneyBackCostMerchant(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1165,17 +1375,17 @@ func (_m *BillingService) DeleteProject(ctx context.Context, in *grpc.GetProject
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ChangeProjectResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) *grpc.ChangeProjectResponse); ok {
+	var r0 *grpc.ResponseError
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ChangeProjectResponse)
+			r0 = ret.Get(0).(*grpc.ResponseError)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1184,8 +1394,8 @@ func (_m *BillingService) DeleteProject(ctx context.Context, in *grpc.GetProject
 	return r0, r1
 }
 
-// DeleteSavedCard provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) DeleteSavedCard(ctx context.Context, in *grpc.DeleteSavedCardRequest, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
+// DeleteMoneyBackCostSystem provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteMoneyBackCostSystem(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1195,17 +1405,17 @@ func (_m *BillingService) DeleteSavedCard(ctx context.Context, in *grpc.DeleteSa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponseWithStatus
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.DeleteSavedCardRequest, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
+	var r0 *grpc.ResponseError
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
+			r0 = ret.Get(0).(*grpc.ResponseError)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.DeleteSavedCardRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1214,8 +1424,8 @@ func (_m *BillingService) DeleteSavedCard(ctx context.Context, in *grpc.DeleteSa
 	return r0, r1
 }
 
-// FindAllOrders provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) FindAllOrders(ctx context.Context, in *grpc.ListOrdersRequest, opts ...client.CallOption) (*grpc.ListOrdersResponse, error) {
+// DeletePaylink provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeletePaylink(ctx context.Context, in *grpc.PaylinkRequest, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1225,17 +1435,17 @@ func (_m *BillingService) FindAllOrders(ctx context.Context, in *grpc.ListOrders
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListOrdersResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) *grpc.ListOrdersResponse); ok {
+	var r0 *grpc.EmptyResponseWithStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListOrdersResponse)
+			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1244,8 +1454,8 @@ func (_m *BillingService) FindAllOrders(ctx context.Context, in *grpc.ListOrders
 	return r0, r1
 }
 
-// FindAllOrdersPrivate provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) FindAllOrdersPrivate(ctx context.Context, in *grpc.ListOrdersRequest, opts ...client.CallOption) (*grpc.ListOrdersPrivateResponse, error) {
+// DeletePaymentChannelCostMerchant provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeletePaymentChannelCostMerchant(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1255,17 +1465,17 @@ func (_m *BillingService) FindAllOrdersPrivate(ctx context.Context, in *grpc.Lis
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListOrdersPrivateResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) *grpc.ListOrdersPrivateResponse); ok {
+	var r0 *grpc.ResponseError
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListOrdersPrivateResponse)
+			r0 = ret.Get(0).(*grpc.ResponseError)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1274,8 +1484,8 @@ func (_m *BillingService) FindAllOrdersPrivate(ctx context.Context, in *grpc.Lis
 	return r0, r1
 }
 
-// FindAllOrdersPublic provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) FindAllOrdersPublic(ctx context.Context, in *grpc.ListOrdersRequest, opts ...client.CallOption) (*grpc.ListOrdersPublicResponse, error) {
+// DeletePaymentChannelCostSystem provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeletePaymentChannelCostSystem(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1285,17 +1495,17 @@ func (_m *BillingService) FindAllOrdersPublic(ctx context.Context, in *grpc.List
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListOrdersPublicResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) *grpc.ListOrdersPublicResponse); ok {
+	var r0 *grpc.ResponseError
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) *grpc.ResponseError); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListOrdersPublicResponse)
+			r0 = ret.Get(0).(*grpc.ResponseError)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentCostDeleteRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1304,8 +1514,8 @@ func (_m *BillingService) FindAllOrdersPublic(ctx context.Context, in *grpc.List
 	return r0, r1
 }
 
-// FindByZipCode provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) FindByZipCode(ctx context.Context, in *grpc.FindByZipCodeRequest, opts ...client.CallOption) (*grpc.FindByZipCodeResponse, error) {
+// DeletePaymentMethodProductionSettings provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeletePaymentMethodProductionSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.ChangePaymentMethodParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1315,17 +1525,17 @@ func (_m *BillingService) FindByZipCode(ctx context.Context, in *grpc.FindByZipC
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.FindByZipCodeResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.FindByZipCodeRequest, ...client.CallOption) *grpc.FindByZipCodeResponse); ok {
+	var r0 *grpc.ChangePaymentMethodParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.ChangePaymentMethodParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.FindByZipCodeResponse)
+			r0 = ret.Get(0).(*grpc.ChangePaymentMethodParamsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.FindByZipCodeRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1334,8 +1544,8 @@ func (_m *BillingService) FindByZipCode(ctx context.Context, in *grpc.FindByZipC
 	return r0, r1
 }
 
-// FinishRedeemKeyForOrder provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) FinishRedeemKeyForOrder(ctx context.Context, in *grpc.KeyForOrderRequest, opts ...client.CallOption) (*grpc.GetKeyForOrderRequestResponse, error) {
+// DeletePaymentMethodTestSettings provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeletePaymentMethodTestSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.ChangePaymentMethodParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1345,17 +1555,17 @@ func (_m *BillingService) FinishRedeemKeyForOrder(ctx context.Context, in *grpc.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetKeyForOrderRequestResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) *grpc.GetKeyForOrderRequestResponse); ok {
+	var r0 *grpc.ChangePaymentMethodParamsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.ChangePaymentMethodParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetKeyForOrderRequestResponse)
+			r0 = ret.Get(0).(*grpc.ChangePaymentMethodParamsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1364,8 +1574,8 @@ func (_m *BillingService) FinishRedeemKeyForOrder(ctx context.Context, in *grpc.
 	return r0, r1
 }
 
-// GetAllMoneyBackCostMerchant provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetAllMoneyBackCostMerchant(ctx context.Context, in *billing.MoneyBackCostMerchantListRequest, opts ...client.CallOption) (*grpc.MoneyBackCostMerchantListResponse, error) {
+// DeletePlatformKeys provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeletePlatformKeys(ctx context.Context, in *grpc.ManagePlatformKeysRequest, opts ...client.CallOption) (*grpc.ManagePlatformKeysResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1375,17 +1585,17 @@ func (_m *BillingService) GetAllMoneyBackCostMerchant(ctx context.Context, in *b
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.MoneyBackCostMerchantListResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.MoneyBackCostMerchantListRequest, ...client.CallOption) *grpc.MoneyBackCostMerchantListResponse); ok {
+	var r0 *grpc.ManagePlatformKeysResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ManagePlatformKeysRequest, ...client.CallOption) *grpc.ManagePlatformKeysResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.MoneyBackCostMerchantListResponse)
+			r0 = ret.Get(0).(*grpc.ManagePlatformKeysResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.MoneyBackCostMerchantListRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ManagePlatformKeysRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1394,8 +1604,8 @@ func (_m *BillingService) GetAllMoneyBackCostMerchant(ctx context.Context, in *b
 	return r0, r1
 }
 
-// GetAllMoneyBackCostSystem provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetAllMoneyBackCostSystem(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.MoneyBackCostSystemListResponse, error) {
+// DeleteProduct provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteProduct(ctx context.Context, in *grpc.RequestProduct, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1405,17 +1615,17 @@ func (_m *BillingService) GetAllMoneyBackCostSystem(ctx context.Context, in *grp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.MoneyBackCostSystemListResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.MoneyBackCostSystemListResponse); ok {
+	var r0 *grpc.EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) *grpc.EmptyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.MoneyBackCostSystemListResponse)
+			r0 = ret.Get(0).(*grpc.EmptyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1424,8 +1634,8 @@ func (_m *BillingService) GetAllMoneyBackCostSystem(ctx context.Context, in *grp
 	return r0, r1
 }
 
-// GetAllPaymentChannelCostMerchant provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetAllPaymentChannelCostMerchant(ctx context.Context, in *billing.PaymentChannelCostMerchantListRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostMerchantListResponse, error) {
+// DeleteProject provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteProject(ctx context.Context, in *grpc.GetProjectRequest, opts ...client.CallOption) (*grpc.ChangeProjectResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1435,17 +1645,17 @@ func (_m *BillingService) GetAllPaymentChannelCostMerchant(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentChannelCostMerchantListResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentChannelCostMerchantListRequest, ...client.CallOption) *grpc.PaymentChannelCostMerchantListResponse); ok {
+	var r0 *grpc.ChangeProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) *grpc.ChangeProjectResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentChannelCostMerchantListResponse)
+			r0 = ret.Get(0).(*grpc.ChangeProjectResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentChannelCostMerchantListRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1454,8 +1664,8 @@ func (_m *BillingService) GetAllPaymentChannelCostMerchant(ctx context.Context,
 	return r0, r1
 }
 
-// GetAllPaymentChannelCostSystem provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetAllPaymentChannelCostSystem(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostSystemListResponse, error) {
+// DeleteSavedCard provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DeleteSavedCard(ctx context.Context, in *grpc.DeleteSavedCardRequest, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1465,17 +1675,17 @@ func (_m *BillingService) GetAllPaymentChannelCostSystem(ctx context.Context, in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentChannelCostSystemListResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.PaymentChannelCostSystemListResponse); ok {
+	var r0 *grpc.EmptyResponseWithStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.DeleteSavedCardRequest, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentChannelCostSystemListResponse)
+			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.DeleteSavedCardRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1484,8 +1694,8 @@ func (_m *BillingService) GetAllPaymentChannelCostSystem(ctx context.Context, in
 	return r0, r1
 }
 
-// GetAvailableKeysCount provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetAvailableKeysCount(ctx context.Context, in *grpc.GetPlatformKeyCountRequest, opts ...client.CallOption) (*grpc.GetPlatformKeyCountResponse, error) {
+// DownloadOrdersExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) DownloadOrdersExportJob(ctx context.Context, in *grpc.OrdersExportJobRequest, opts ...client.CallOption) (grpc.BillingService_DownloadOrdersExportJobService, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1495,17 +1705,17 @@ func (_m *BillingService) GetAvailableKeysCount(ctx context.Context, in *grpc.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPlatformKeyCountResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPlatformKeyCountRequest, ...client.CallOption) *grpc.GetPlatformKeyCountResponse); ok {
+	var r0 grpc.BillingService_DownloadOrdersExportJobService
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.OrdersExportJobRequest, ...client.CallOption) grpc.BillingService_DownloadOrdersExportJobService); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPlatformKeyCountResponse)
+			r0 = ret.Get(0).(grpc.BillingService_DownloadOrdersExportJobService)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPlatformKeyCountRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.OrdersExportJobRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1514,8 +1724,8 @@ func (_m *BillingService) GetAvailableKeysCount(ctx context.Context, in *grpc.Ge
 	return r0, r1
 }
 
-// GetCountriesList provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetCountriesList(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*billing.CountriesList, error) {
+// ExportOrders provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ExportOrders(ctx context.Context, in *grpc.ExportOrdersRequest, opts ...client.CallOption) (grpc.BillingService_ExportOrdersService, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1525,17 +1735,17 @@ func (_m *BillingService) GetCountriesList(ctx context.Context, in *grpc.EmptyRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *billing.CountriesList
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *billing.CountriesList); ok {
+	var r0 grpc.BillingService_ExportOrdersService
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ExportOrdersRequest, ...client.CallOption) grpc.BillingService_ExportOrdersService); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*billing.CountriesList)
+			r0 = ret.Get(0).(grpc.BillingService_ExportOrdersService)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ExportOrdersRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1544,8 +1754,8 @@ func (_m *BillingService) GetCountriesList(ctx context.Context, in *grpc.EmptyRe
 	return r0, r1
 }
 
-// GetCountry provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetCountry(ctx context.Context, in *billing.GetCountryRequest, opts ...client.CallOption) (*billing.Country, error) {
+// ExportPlatformKeys provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ExportPlatformKeys(ctx context.Context, in *grpc.ManagePlatformKeysRequest, opts ...client.CallOption) (*grpc.ExportPlatformKeysResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1555,17 +1765,17 @@ func (_m *BillingService) GetCountry(ctx context.Context, in *billing.GetCountry
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *billing.Country
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.GetCountryRequest, ...client.CallOption) *billing.Country); ok {
+	var r0 *grpc.ExportPlatformKeysResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ManagePlatformKeysRequest, ...client.CallOption) *grpc.ExportPlatformKeysResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*billing.Country)
+			r0 = ret.Get(0).(*grpc.ExportPlatformKeysResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.GetCountryRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ManagePlatformKeysRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1574,8 +1784,8 @@ func (_m *BillingService) GetCountry(ctx context.Context, in *billing.GetCountry
 	return r0, r1
 }
 
-// GetDashboardBaseReport provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetDashboardBaseReport(ctx context.Context, in *grpc.GetDashboardBaseReportRequest, opts ...client.CallOption) (*grpc.GetDashboardBaseReportResponse, error) {
+// FindAllOrders provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) FindAllOrders(ctx context.Context, in *grpc.ListOrdersRequest, opts ...client.CallOption) (*grpc.ListOrdersResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1585,17 +1795,17 @@ func (_m *BillingService) GetDashboardBaseReport(ctx context.Context, in *grpc.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetDashboardBaseReportResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetDashboardBaseReportRequest, ...client.CallOption) *grpc.GetDashboardBaseReportResponse); ok {
+	var r0 *grpc.ListOrdersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) *grpc.ListOrdersResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetDashboardBaseReportResponse)
+			r0 = ret.Get(0).(*grpc.ListOrdersResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetDashboardBaseReportRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1604,8 +1814,8 @@ func (_m *BillingService) GetDashboardBaseReport(ctx context.Context, in *grpc.G
 	return r0, r1
 }
 
-// GetDashboardMainReport provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetDashboardMainReport(ctx context.Context, in *grpc.GetDashboardMainRequest, opts ...client.CallOption) (*grpc.GetDashboardMainResponse, error) {
+// FindAllOrdersPrivate provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) FindAllOrdersPrivate(ctx context.Context, in *grpc.ListOrdersRequest, opts ...client.CallOption) (*grpc.ListOrdersPrivateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1615,17 +1825,17 @@ func (_m *BillingService) GetDashboardMainReport(ctx context.Context, in *grpc.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetDashboardMainResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) *grpc.GetDashboardMainResponse); ok {
+	var r0 *grpc.ListOrdersPrivateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) *grpc.ListOrdersPrivateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetDashboardMainResponse)
+			r0 = ret.Get(0).(*grpc.ListOrdersPrivateResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1634,8 +1844,8 @@ func (_m *BillingService) GetDashboardMainReport(ctx context.Context, in *grpc.G
 	return r0, r1
 }
 
-// GetDashboardRevenueDynamicsReport provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetDashboardRevenueDynamicsReport(ctx context.Context, in *grpc.GetDashboardMainRequest, opts ...client.CallOption) (*grpc.GetDashboardRevenueDynamicsReportResponse, error) {
+// FindAllOrdersPublic provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) FindAllOrdersPublic(ctx context.Context, in *grpc.ListOrdersRequest, opts ...client.CallOption) (*grpc.ListOrdersPublicResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1645,17 +1855,17 @@ func (_m *BillingService) GetDashboardRevenueDynamicsReport(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetDashboardRevenueDynamicsReportResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) *grpc.GetDashboardRevenueDynamicsReportResponse); ok {
+	var r0 *grpc.ListOrdersPublicResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) *grpc.ListOrdersPublicResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetDashboardRevenueDynamicsReportResponse)
+			r0 = ret.Get(0).(*grpc.ListOrdersPublicResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListOrdersRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1664,8 +1874,8 @@ func (_m *BillingService) GetDashboardRevenueDynamicsReport(ctx context.Context,
 	return r0, r1
 }
 
-// GetKeyByID provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetKeyByID(ctx context.Context, in *grpc.KeyForOrderRequest, opts ...client.CallOption) (*grpc.GetKeyForOrderRequestResponse, error) {
+// FindByZipCode provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) FindByZipCode(ctx context.Context, in *grpc.FindByZipCodeRequest, opts ...client.CallOption) (*grpc.FindByZipCodeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1675,17 +1885,17 @@ func (_m *BillingService) GetKeyByID(ctx context.Context, in *grpc.KeyForOrderRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetKeyForOrderRequestResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) *grpc.GetKeyForOrderRequestResponse); ok {
+	var r0 *grpc.FindByZipCodeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.FindByZipCodeRequest, ...client.CallOption) *grpc.FindByZipCodeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetKeyForOrderRequestResponse)
+			r0 = ret.Get(0).(*grpc.FindByZipCodeResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.FindByZipCodeRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1694,8 +1904,8 @@ func (_m *BillingService) GetKeyByID(ctx context.Context, in *grpc.KeyForOrderRe
 	return r0, r1
 }
 
-// GetKeyProduct provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetKeyProduct(ctx context.Context, in *grpc.RequestKeyProductMerchant, opts ...client.CallOption) (*grpc.KeyProductResponse, error) {
+// FinishRedeemKeyForOrder provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) FinishRedeemKeyForOrder(ctx context.Context, in *grpc.KeyForOrderRequest, opts ...client.CallOption) (*grpc.GetKeyForOrderRequestResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1705,17 +1915,17 @@ func (_m *BillingService) GetKeyProduct(ctx context.Context, in *grpc.RequestKey
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.KeyProductResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) *grpc.KeyProductResponse); ok {
+	var r0 *grpc.GetKeyForOrderRequestResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) *grpc.GetKeyForOrderRequestResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.KeyProductResponse)
+			r0 = ret.Get(0).(*grpc.GetKeyForOrderRequestResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1724,8 +1934,8 @@ func (_m *BillingService) GetKeyProduct(ctx context.Context, in *grpc.RequestKey
 	return r0, r1
 }
 
-// GetKeyProductInfo provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetKeyProductInfo(ctx context.Context, in *grpc.GetKeyProductInfoRequest, opts ...client.CallOption) (*grpc.GetKeyProductInfoResponse, error) {
+// GetAllMoneyBackCostMerchant provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetAllMoneyBackCostMerchant(ctx context.Context, in *billing.MoneyBackCostMerchantListRequest, opts ...client.CallOption) (*grpc.MoneyBackCostMerchantListResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1735,8 +1945,428 @@ func (_m *BillingService) GetKeyProductInfo(ctx context.Context, in *grpc.GetKey
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetKeyProductInfoResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetKeyProductInfoRequest, ...client.CallOption) *grpc.GetKeyProductInfoResponse); ok {
+	var r0 *grpc.MoneyBackCostMerchantListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.MoneyBackCostMerchantListRequest, ...client.CallOption) *grpc.MoneyBackCostMerchantListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.MoneyBackCostMerchantListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.MoneyBackCostMerchantListRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllMoneyBackCostSystem provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetAllMoneyBackCostSystem(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.MoneyBackCostSystemListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.MoneyBackCostSystemListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.MoneyBackCostSystemListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.MoneyBackCostSystemListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPaymentChannelCostMerchant provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetAllPaymentChannelCostMerchant(ctx context.Context, in *billing.PaymentChannelCostMerchantListRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostMerchantListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.PaymentChannelCostMerchantListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentChannelCostMerchantListRequest, ...client.CallOption) *grpc.PaymentChannelCostMerchantListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.PaymentChannelCostMerchantListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentChannelCostMerchantListRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPaymentChannelCostSystem provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetAllPaymentChannelCostSystem(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostSystemListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.PaymentChannelCostSystemListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.PaymentChannelCostSystemListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.PaymentChannelCostSystemListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAvailableKeysCount provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetAvailableKeysCount(ctx context.Context, in *grpc.GetPlatformKeyCountRequest, opts ...client.CallOption) (*grpc.GetPlatformKeyCountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPlatformKeyCountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPlatformKeyCountRequest, ...client.CallOption) *grpc.GetPlatformKeyCountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPlatformKeyCountResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPlatformKeyCountRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChargeback provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetChargeback(ctx context.Context, in *grpc.ChargebackRequest, opts ...client.CallOption) (*grpc.ChargebackResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ChargebackResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ChargebackRequest, ...client.CallOption) *grpc.ChargebackResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ChargebackResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ChargebackRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCountriesList provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetCountriesList(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*billing.CountriesList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *billing.CountriesList
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *billing.CountriesList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*billing.CountriesList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCountry provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetCountry(ctx context.Context, in *billing.GetCountryRequest, opts ...client.CallOption) (*billing.Country, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *billing.Country
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.GetCountryRequest, ...client.CallOption) *billing.Country); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*billing.Country)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.GetCountryRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCountryVatSettingsHistory provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetCountryVatSettingsHistory(ctx context.Context, in *billing.GetCountryRequest, opts ...client.CallOption) (*grpc.CountryVatSettingsHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.CountryVatSettingsHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.GetCountryRequest, ...client.CallOption) *grpc.CountryVatSettingsHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.CountryVatSettingsHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.GetCountryRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDashboardBaseReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetDashboardBaseReport(ctx context.Context, in *grpc.GetDashboardBaseReportRequest, opts ...client.CallOption) (*grpc.GetDashboardBaseReportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetDashboardBaseReportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetDashboardBaseReportRequest, ...client.CallOption) *grpc.GetDashboardBaseReportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetDashboardBaseReportResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetDashboardBaseReportRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDashboardMainReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetDashboardMainReport(ctx context.Context, in *grpc.GetDashboardMainRequest, opts ...client.CallOption) (*grpc.GetDashboardMainResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetDashboardMainResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) *grpc.GetDashboardMainResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetDashboardMainResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDashboardRevenueDynamicsReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetDashboardRevenueDynamicsReport(ctx context.Context, in *grpc.GetDashboardMainRequest, opts ...client.CallOption) (*grpc.GetDashboardRevenueDynamicsReportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetDashboardRevenueDynamicsReportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) *grpc.GetDashboardRevenueDynamicsReportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetDashboardRevenueDynamicsReportResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetDashboardMainRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyByID provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetKeyByID(ctx context.Context, in *grpc.KeyForOrderRequest, opts ...client.CallOption) (*grpc.GetKeyForOrderRequestResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetKeyForOrderRequestResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) *grpc.GetKeyForOrderRequestResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetKeyForOrderRequestResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.KeyForOrderRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyProduct provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetKeyProduct(ctx context.Context, in *grpc.RequestKeyProductMerchant, opts ...client.CallOption) (*grpc.KeyProductResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.KeyProductResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) *grpc.KeyProductResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.KeyProductResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestKeyProductMerchant, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyProductInfo provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetKeyProductInfo(ctx context.Context, in *grpc.GetKeyProductInfoRequest, opts ...client.CallOption) (*grpc.GetKeyProductInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetKeyProductInfoResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetKeyProductInfoRequest, ...client.CallOption) *grpc.GetKeyProductInfoResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1745,7 +2375,607 @@ func (_m *BillingService) GetKeyProductInfo(ctx context.Context, in *grpc.GetKey
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetKeyProductInfoRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetKeyProductInfoRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyProducts provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetKeyProducts(ctx context.Context, in *grpc.ListKeyProductsRequest, opts ...client.CallOption) (*grpc.ListKeyProductsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ListKeyProductsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListKeyProductsRequest, ...client.CallOption) *grpc.ListKeyProductsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ListKeyProductsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListKeyProductsRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyProductsForOrder provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetKeyProductsForOrder(ctx context.Context, in *grpc.GetKeyProductsForOrderRequest, opts ...client.CallOption) (*grpc.ListKeyProductsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ListKeyProductsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetKeyProductsForOrderRequest, ...client.CallOption) *grpc.ListKeyProductsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ListKeyProductsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetKeyProductsForOrderRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLedgerTrialBalance provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetLedgerTrialBalance(ctx context.Context, in *grpc.LedgerTrialBalanceRequest, opts ...client.CallOption) (*grpc.LedgerTrialBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.LedgerTrialBalanceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.LedgerTrialBalanceRequest, ...client.CallOption) *grpc.LedgerTrialBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.LedgerTrialBalanceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.LedgerTrialBalanceRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMerchantAgreementSignUrl provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMerchantAgreementSignUrl(ctx context.Context, in *grpc.GetMerchantAgreementSignUrlRequest, opts ...client.CallOption) (*grpc.GetMerchantAgreementSignUrlResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetMerchantAgreementSignUrlResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantAgreementSignUrlRequest, ...client.CallOption) *grpc.GetMerchantAgreementSignUrlResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetMerchantAgreementSignUrlResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantAgreementSignUrlRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMerchantBalance provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMerchantBalance(ctx context.Context, in *grpc.GetMerchantBalanceRequest, opts ...client.CallOption) (*grpc.GetMerchantBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetMerchantBalanceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantBalanceRequest, ...client.CallOption) *grpc.GetMerchantBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetMerchantBalanceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantBalanceRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMerchantBy provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMerchantBy(ctx context.Context, in *grpc.GetMerchantByRequest, opts ...client.CallOption) (*grpc.GetMerchantResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetMerchantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantByRequest, ...client.CallOption) *grpc.GetMerchantResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetMerchantResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantByRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMerchantOnboardingCompleteData provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMerchantOnboardingCompleteData(ctx context.Context, in *grpc.SetMerchantS3AgreementRequest, opts ...client.CallOption) (*grpc.GetMerchantOnboardingCompleteDataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetMerchantOnboardingCompleteDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.SetMerchantS3AgreementRequest, ...client.CallOption) *grpc.GetMerchantOnboardingCompleteDataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetMerchantOnboardingCompleteDataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.SetMerchantS3AgreementRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMerchantPaymentMethod provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMerchantPaymentMethod(ctx context.Context, in *grpc.GetMerchantPaymentMethodRequest, opts ...client.CallOption) (*grpc.GetMerchantPaymentMethodResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetMerchantPaymentMethodResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantPaymentMethodRequest, ...client.CallOption) *grpc.GetMerchantPaymentMethodResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetMerchantPaymentMethodResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantPaymentMethodRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMerchantTariffRates provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMerchantTariffRates(ctx context.Context, in *grpc.GetMerchantTariffRatesRequest, opts ...client.CallOption) (*grpc.GetMerchantTariffRatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetMerchantTariffRatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantTariffRatesRequest, ...client.CallOption) *grpc.GetMerchantTariffRatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetMerchantTariffRatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantTariffRatesRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMoneyBackCostMerchant provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMoneyBackCostMerchant(ctx context.Context, in *billing.MoneyBackCostMerchantRequest, opts ...client.CallOption) (*grpc.MoneyBackCostMerchantResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.MoneyBackCostMerchantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.MoneyBackCostMerchantRequest, ...client.CallOption) *grpc.MoneyBackCostMerchantResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.MoneyBackCostMerchantResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.MoneyBackCostMerchantRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMoneyBackCostSystem provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetMoneyBackCostSystem(ctx context.Context, in *billing.MoneyBackCostSystemRequest, opts ...client.CallOption) (*grpc.MoneyBackCostSystemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.MoneyBackCostSystemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.MoneyBackCostSystemRequest, ...client.CallOption) *grpc.MoneyBackCostSystemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.MoneyBackCostSystemResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.MoneyBackCostSystemRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotification provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetNotification(ctx context.Context, in *grpc.GetNotificationRequest, opts ...client.CallOption) (*billing.Notification, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *billing.Notification
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) *billing.Notification); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*billing.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderPrivate provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetOrderPrivate(ctx context.Context, in *grpc.GetOrderRequest, opts ...client.CallOption) (*grpc.GetOrderPrivateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetOrderPrivateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) *grpc.GetOrderPrivateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetOrderPrivateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderPublic provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetOrderPublic(ctx context.Context, in *grpc.GetOrderRequest, opts ...client.CallOption) (*grpc.GetOrderPublicResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetOrderPublicResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) *grpc.GetOrderPublicResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetOrderPublicResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrdersExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetOrdersExportJob(ctx context.Context, in *grpc.OrdersExportJobRequest, opts ...client.CallOption) (*grpc.OrdersExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.OrdersExportJobResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.OrdersExportJobRequest, ...client.CallOption) *grpc.OrdersExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.OrdersExportJobResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.OrdersExportJobRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaylink provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylink(ctx context.Context, in *grpc.PaylinkRequest, opts ...client.CallOption) (*grpc.GetPaylinkResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPaylinkResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) *grpc.GetPaylinkResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPaylinkResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaylinkStatByCountry provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinkStatByCountry(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPaylinkStatCommonGroupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaylinkStatByDate provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinkStatByDate(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPaylinkStatCommonGroupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaylinkStatByReferrer provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinkStatByReferrer(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPaylinkStatCommonGroupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaylinkStatByUtm provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinkStatByUtm(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPaylinkStatCommonGroupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1754,8 +2984,8 @@ func (_m *BillingService) GetKeyProductInfo(ctx context.Context, in *grpc.GetKey
 	return r0, r1
 }
 
-// GetKeyProducts provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetKeyProducts(ctx context.Context, in *grpc.ListKeyProductsRequest, opts ...client.CallOption) (*grpc.ListKeyProductsResponse, error) {
+// GetPaylinkStatTotal provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinkStatTotal(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1765,17 +2995,17 @@ func (_m *BillingService) GetKeyProducts(ctx context.Context, in *grpc.ListKeyPr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListKeyProductsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListKeyProductsRequest, ...client.CallOption) *grpc.ListKeyProductsResponse); ok {
+	var r0 *grpc.GetPaylinkStatCommonResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListKeyProductsResponse)
+			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListKeyProductsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1784,8 +3014,8 @@ func (_m *BillingService) GetKeyProducts(ctx context.Context, in *grpc.ListKeyPr
 	return r0, r1
 }
 
-// GetKeyProductsForOrder provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetKeyProductsForOrder(ctx context.Context, in *grpc.GetKeyProductsForOrderRequest, opts ...client.CallOption) (*grpc.ListKeyProductsResponse, error) {
+// GetPaylinkURL provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinkURL(ctx context.Context, in *grpc.GetPaylinkURLRequest, opts ...client.CallOption) (*grpc.GetPaylinkUrlResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1795,17 +3025,17 @@ func (_m *BillingService) GetKeyProductsForOrder(ctx context.Context, in *grpc.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListKeyProductsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetKeyProductsForOrderRequest, ...client.CallOption) *grpc.ListKeyProductsResponse); ok {
+	var r0 *grpc.GetPaylinkUrlResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkURLRequest, ...client.CallOption) *grpc.GetPaylinkUrlResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListKeyProductsResponse)
+			r0 = ret.Get(0).(*grpc.GetPaylinkUrlResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetKeyProductsForOrderRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkURLRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1814,8 +3044,8 @@ func (_m *BillingService) GetKeyProductsForOrder(ctx context.Context, in *grpc.G
 	return r0, r1
 }
 
-// GetMerchantAgreementSignUrl provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMerchantAgreementSignUrl(ctx context.Context, in *grpc.GetMerchantAgreementSignUrlRequest, opts ...client.CallOption) (*grpc.GetMerchantAgreementSignUrlResponse, error) {
+// GetPaylinks provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaylinks(ctx context.Context, in *grpc.GetPaylinksRequest, opts ...client.CallOption) (*grpc.GetPaylinksResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1825,17 +3055,17 @@ func (_m *BillingService) GetMerchantAgreementSignUrl(ctx context.Context, in *g
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetMerchantAgreementSignUrlResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantAgreementSignUrlRequest, ...client.CallOption) *grpc.GetMerchantAgreementSignUrlResponse); ok {
+	var r0 *grpc.GetPaylinksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinksRequest, ...client.CallOption) *grpc.GetPaylinksResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetMerchantAgreementSignUrlResponse)
+			r0 = ret.Get(0).(*grpc.GetPaylinksResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantAgreementSignUrlRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinksRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1844,8 +3074,8 @@ func (_m *BillingService) GetMerchantAgreementSignUrl(ctx context.Context, in *g
 	return r0, r1
 }
 
-// GetMerchantBalance provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMerchantBalance(ctx context.Context, in *grpc.GetMerchantBalanceRequest, opts ...client.CallOption) (*grpc.GetMerchantBalanceResponse, error) {
+// GetPaymentChannelCostMerchant provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaymentChannelCostMerchant(ctx context.Context, in *billing.PaymentChannelCostMerchantRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostMerchantResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1855,17 +3085,17 @@ func (_m *BillingService) GetMerchantBalance(ctx context.Context, in *grpc.GetMe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetMerchantBalanceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantBalanceRequest, ...client.CallOption) *grpc.GetMerchantBalanceResponse); ok {
+	var r0 *grpc.PaymentChannelCostMerchantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentChannelCostMerchantRequest, ...client.CallOption) *grpc.PaymentChannelCostMerchantResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetMerchantBalanceResponse)
+			r0 = ret.Get(0).(*grpc.PaymentChannelCostMerchantResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantBalanceRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentChannelCostMerchantRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1874,8 +3104,8 @@ func (_m *BillingService) GetMerchantBalance(ctx context.Context, in *grpc.GetMe
 	return r0, r1
 }
 
-// GetMerchantBy provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMerchantBy(ctx context.Context, in *grpc.GetMerchantByRequest, opts ...client.CallOption) (*grpc.GetMerchantResponse, error) {
+// GetPaymentChannelCostSystem provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaymentChannelCostSystem(ctx context.Context, in *billing.PaymentChannelCostSystemRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostSystemResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1885,17 +3115,17 @@ func (_m *BillingService) GetMerchantBy(ctx context.Context, in *grpc.GetMerchan
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetMerchantResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantByRequest, ...client.CallOption) *grpc.GetMerchantResponse); ok {
+	var r0 *grpc.PaymentChannelCostSystemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentChannelCostSystemRequest, ...client.CallOption) *grpc.PaymentChannelCostSystemResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetMerchantResponse)
+			r0 = ret.Get(0).(*grpc.PaymentChannelCostSystemResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantByRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentChannelCostSystemRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1904,8 +3134,8 @@ func (_m *BillingService) GetMerchantBy(ctx context.Context, in *grpc.GetMerchan
 	return r0, r1
 }
 
-// GetMerchantOnboardingCompleteData provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMerchantOnboardingCompleteData(ctx context.Context, in *grpc.SetMerchantS3AgreementRequest, opts ...client.CallOption) (*grpc.GetMerchantOnboardingCompleteDataResponse, error) {
+// GetPaymentMethodProductionSettings provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaymentMethodProductionSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.GetPaymentMethodSettingsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1915,17 +3145,197 @@ func (_m *BillingService) GetMerchantOnboardingCompleteData(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetMerchantOnboardingCompleteDataResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.SetMerchantS3AgreementRequest, ...client.CallOption) *grpc.GetMerchantOnboardingCompleteDataResponse); ok {
+	var r0 *grpc.GetPaymentMethodSettingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.GetPaymentMethodSettingsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetMerchantOnboardingCompleteDataResponse)
+			r0 = ret.Get(0).(*grpc.GetPaymentMethodSettingsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.SetMerchantS3AgreementRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaymentMethodTestSettings provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPaymentMethodTestSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.GetPaymentMethodSettingsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPaymentMethodSettingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.GetPaymentMethodSettingsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPaymentMethodSettingsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoutBatch provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPayoutBatch(ctx context.Context, in *grpc.GetPayoutBatchRequest, opts ...client.CallOption) (*grpc.PayoutBatchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.PayoutBatchResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutBatchRequest, ...client.CallOption) *grpc.PayoutBatchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.PayoutBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutBatchRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoutDocument provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPayoutDocument(ctx context.Context, in *grpc.GetPayoutDocumentRequest, opts ...client.CallOption) (*grpc.PayoutDocumentResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.PayoutDocumentResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) *grpc.PayoutDocumentResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.PayoutDocumentResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoutDocumentRoyaltyReports provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPayoutDocumentRoyaltyReports(ctx context.Context, in *grpc.GetPayoutDocumentRequest, opts ...client.CallOption) (*grpc.ListRoyaltyReportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ListRoyaltyReportsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) *grpc.ListRoyaltyReportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ListRoyaltyReportsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoutDocuments provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPayoutDocuments(ctx context.Context, in *grpc.GetPayoutDocumentsRequest, opts ...client.CallOption) (*grpc.GetPayoutDocumentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.GetPayoutDocumentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutDocumentsRequest, ...client.CallOption) *grpc.GetPayoutDocumentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.GetPayoutDocumentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutDocumentsRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlatforms provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPlatforms(ctx context.Context, in *grpc.ListPlatformsRequest, opts ...client.CallOption) (*grpc.ListPlatformsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ListPlatformsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListPlatformsRequest, ...client.CallOption) *grpc.ListPlatformsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ListPlatformsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListPlatformsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1934,8 +3344,8 @@ func (_m *BillingService) GetMerchantOnboardingCompleteData(ctx context.Context,
 	return r0, r1
 }
 
-// GetMerchantPaymentMethod provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMerchantPaymentMethod(ctx context.Context, in *grpc.GetMerchantPaymentMethodRequest, opts ...client.CallOption) (*grpc.GetMerchantPaymentMethodResponse, error) {
+// GetPriceGroup provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPriceGroup(ctx context.Context, in *billing.GetPriceGroupRequest, opts ...client.CallOption) (*billing.PriceGroup, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1945,17 +3355,17 @@ func (_m *BillingService) GetMerchantPaymentMethod(ctx context.Context, in *grpc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetMerchantPaymentMethodResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantPaymentMethodRequest, ...client.CallOption) *grpc.GetMerchantPaymentMethodResponse); ok {
+	var r0 *billing.PriceGroup
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.GetPriceGroupRequest, ...client.CallOption) *billing.PriceGroup); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetMerchantPaymentMethodResponse)
+			r0 = ret.Get(0).(*billing.PriceGroup)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantPaymentMethodRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.GetPriceGroupRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1964,8 +3374,8 @@ func (_m *BillingService) GetMerchantPaymentMethod(ctx context.Context, in *grpc
 	return r0, r1
 }
 
-// GetMerchantTariffRates provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMerchantTariffRates(ctx context.Context, in *grpc.GetMerchantTariffRatesRequest, opts ...client.CallOption) (*grpc.GetMerchantTariffRatesResponse, error) {
+// GetPriceGroupByCountry provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPriceGroupByCountry(ctx context.Context, in *grpc.PriceGroupByCountryRequest, opts ...client.CallOption) (*billing.PriceGroup, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1975,17 +3385,17 @@ func (_m *BillingService) GetMerchantTariffRates(ctx context.Context, in *grpc.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetMerchantTariffRatesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetMerchantTariffRatesRequest, ...client.CallOption) *grpc.GetMerchantTariffRatesResponse); ok {
+	var r0 *billing.PriceGroup
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PriceGroupByCountryRequest, ...client.CallOption) *billing.PriceGroup); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetMerchantTariffRatesResponse)
+			r0 = ret.Get(0).(*billing.PriceGroup)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetMerchantTariffRatesRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PriceGroupByCountryRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1994,8 +3404,8 @@ func (_m *BillingService) GetMerchantTariffRates(ctx context.Context, in *grpc.G
 	return r0, r1
 }
 
-// GetMoneyBackCostMerchant provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMoneyBackCostMerchant(ctx context.Context, in *billing.MoneyBackCostMerchantRequest, opts ...client.CallOption) (*grpc.MoneyBackCostMerchantResponse, error) {
+// GetPriceGroupByRegion provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPriceGroupByRegion(ctx context.Context, in *grpc.GetPriceGroupByRegionRequest, opts ...client.CallOption) (*grpc.GetPriceGroupByRegionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2005,17 +3415,17 @@ func (_m *BillingService) GetMoneyBackCostMerchant(ctx context.Context, in *bill
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.MoneyBackCostMerchantResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.MoneyBackCostMerchantRequest, ...client.CallOption) *grpc.MoneyBackCostMerchantResponse); ok {
+	var r0 *grpc.GetPriceGroupByRegionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPriceGroupByRegionRequest, ...client.CallOption) *grpc.GetPriceGroupByRegionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.MoneyBackCostMerchantResponse)
+			r0 = ret.Get(0).(*grpc.GetPriceGroupByRegionResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.MoneyBackCostMerchantRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPriceGroupByRegionRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2024,8 +3434,8 @@ func (_m *BillingService) GetMoneyBackCostMerchant(ctx context.Context, in *bill
 	return r0, r1
 }
 
-// GetMoneyBackCostSystem provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetMoneyBackCostSystem(ctx context.Context, in *billing.MoneyBackCostSystemRequest, opts ...client.CallOption) (*grpc.MoneyBackCostSystemResponse, error) {
+// GetPriceGroupCurrencies provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPriceGroupCurrencies(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.PriceGroupCurrenciesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2035,17 +3445,17 @@ func (_m *BillingService) GetMoneyBackCostSystem(ctx context.Context, in *billin
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.MoneyBackCostSystemResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.MoneyBackCostSystemRequest, ...client.CallOption) *grpc.MoneyBackCostSystemResponse); ok {
+	var r0 *grpc.PriceGroupCurrenciesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.PriceGroupCurrenciesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.MoneyBackCostSystemResponse)
+			r0 = ret.Get(0).(*grpc.PriceGroupCurrenciesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.MoneyBackCostSystemRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2054,8 +3464,8 @@ func (_m *BillingService) GetMoneyBackCostSystem(ctx context.Context, in *billin
 	return r0, r1
 }
 
-// GetNotification provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetNotification(ctx context.Context, in *grpc.GetNotificationRequest, opts ...client.CallOption) (*billing.Notification, error) {
+// GetPriceGroupCurrencyByRegion provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetPriceGroupCurrencyByRegion(ctx context.Context, in *grpc.PriceGroupByRegionRequest, opts ...client.CallOption) (*grpc.PriceGroupCurrenciesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2065,17 +3475,17 @@ func (_m *BillingService) GetNotification(ctx context.Context, in *grpc.GetNotif
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *billing.Notification
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) *billing.Notification); ok {
+	var r0 *grpc.PriceGroupCurrenciesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PriceGroupByRegionRequest, ...client.CallOption) *grpc.PriceGroupCurrenciesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*billing.Notification)
+			r0 = ret.Get(0).(*grpc.PriceGroupCurrenciesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PriceGroupByRegionRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2084,8 +3494,8 @@ func (_m *BillingService) GetNotification(ctx context.Context, in *grpc.GetNotif
 	return r0, r1
 }
 
-// GetOrderPrivate provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetOrderPrivate(ctx context.Context, in *grpc.GetOrderRequest, opts ...client.CallOption) (*grpc.GetOrderPrivateResponse, error) {
+// GetProduct provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetProduct(ctx context.Context, in *grpc.RequestProduct, opts ...client.CallOption) (*grpc.GetProductResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2095,17 +3505,17 @@ func (_m *BillingService) GetOrderPrivate(ctx context.Context, in *grpc.GetOrder
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetOrderPrivateResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) *grpc.GetOrderPrivateResponse); ok {
+	var r0 *grpc.GetProductResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) *grpc.GetProductResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetOrderPrivateResponse)
+			r0 = ret.Get(0).(*grpc.GetProductResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2114,8 +3524,8 @@ func (_m *BillingService) GetOrderPrivate(ctx context.Context, in *grpc.GetOrder
 	return r0, r1
 }
 
-// GetOrderPublic provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetOrderPublic(ctx context.Context, in *grpc.GetOrderRequest, opts ...client.CallOption) (*grpc.GetOrderPublicResponse, error) {
+// GetProductPrices provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetProductPrices(ctx context.Context, in *grpc.RequestProduct, opts ...client.CallOption) (*grpc.ProductPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2125,17 +3535,17 @@ func (_m *BillingService) GetOrderPublic(ctx context.Context, in *grpc.GetOrderR
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetOrderPublicResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) *grpc.GetOrderPublicResponse); ok {
+	var r0 *grpc.ProductPricesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) *grpc.ProductPricesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetOrderPublicResponse)
+			r0 = ret.Get(0).(*grpc.ProductPricesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetOrderRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2144,8 +3554,8 @@ func (_m *BillingService) GetOrderPublic(ctx context.Context, in *grpc.GetOrderR
 	return r0, r1
 }
 
-// GetPaylink provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylink(ctx context.Context, in *grpc.PaylinkRequest, opts ...client.CallOption) (*grpc.GetPaylinkResponse, error) {
+// GetProductsForOrder provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetProductsForOrder(ctx context.Context, in *grpc.GetProductsForOrderRequest, opts ...client.CallOption) (*grpc.ListProductsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2155,17 +3565,17 @@ func (_m *BillingService) GetPaylink(ctx context.Context, in *grpc.PaylinkReques
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) *grpc.GetPaylinkResponse); ok {
+	var r0 *grpc.ListProductsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetProductsForOrderRequest, ...client.CallOption) *grpc.ListProductsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkResponse)
+			r0 = ret.Get(0).(*grpc.ListProductsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaylinkRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetProductsForOrderRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2174,8 +3584,8 @@ func (_m *BillingService) GetPaylink(ctx context.Context, in *grpc.PaylinkReques
 	return r0, r1
 }
 
-// GetPaylinkStatByCountry provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinkStatByCountry(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+// GetProject provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetProject(ctx context.Context, in *grpc.GetProjectRequest, opts ...client.CallOption) (*grpc.ChangeProjectResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2185,17 +3595,17 @@ func (_m *BillingService) GetPaylinkStatByCountry(ctx context.Context, in *grpc.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkStatCommonGroupResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+	var r0 *grpc.ChangeProjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) *grpc.ChangeProjectResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+			r0 = ret.Get(0).(*grpc.ChangeProjectResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2204,8 +3614,8 @@ func (_m *BillingService) GetPaylinkStatByCountry(ctx context.Context, in *grpc.
 	return r0, r1
 }
 
-// GetPaylinkStatByDate provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinkStatByDate(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+// GetRecommendedPriceByConversion provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetRecommendedPriceByConversion(ctx context.Context, in *grpc.RecommendedPriceRequest, opts ...client.CallOption) (*grpc.RecommendedPriceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2215,17 +3625,17 @@ func (_m *BillingService) GetPaylinkStatByDate(ctx context.Context, in *grpc.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkStatCommonGroupResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+	var r0 *grpc.RecommendedPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) *grpc.RecommendedPriceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+			r0 = ret.Get(0).(*grpc.RecommendedPriceResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2234,8 +3644,8 @@ func (_m *BillingService) GetPaylinkStatByDate(ctx context.Context, in *grpc.Get
 	return r0, r1
 }
 
-// GetPaylinkStatByReferrer provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinkStatByReferrer(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+// GetRecommendedPriceByPriceGroup provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetRecommendedPriceByPriceGroup(ctx context.Context, in *grpc.RecommendedPriceRequest, opts ...client.CallOption) (*grpc.RecommendedPriceResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2245,17 +3655,17 @@ func (_m *BillingService) GetPaylinkStatByReferrer(ctx context.Context, in *grpc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkStatCommonGroupResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+	var r0 *grpc.RecommendedPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) *grpc.RecommendedPriceResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+			r0 = ret.Get(0).(*grpc.RecommendedPriceResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2264,8 +3674,8 @@ func (_m *BillingService) GetPaylinkStatByReferrer(ctx context.Context, in *grpc
 	return r0, r1
 }
 
-// GetPaylinkStatByUtm provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinkStatByUtm(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonGroupResponse, error) {
+// GetRecommendedPriceTable provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetRecommendedPriceTable(ctx context.Context, in *grpc.RecommendedPriceTableRequest, opts ...client.CallOption) (*grpc.RecommendedPriceTableResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2275,17 +3685,17 @@ func (_m *BillingService) GetPaylinkStatByUtm(ctx context.Context, in *grpc.GetP
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkStatCommonGroupResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonGroupResponse); ok {
+	var r0 *grpc.RecommendedPriceTableResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RecommendedPriceTableRequest, ...client.CallOption) *grpc.RecommendedPriceTableResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonGroupResponse)
+			r0 = ret.Get(0).(*grpc.RecommendedPriceTableResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RecommendedPriceTableRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2294,8 +3704,8 @@ func (_m *BillingService) GetPaylinkStatByUtm(ctx context.Context, in *grpc.GetP
 	return r0, r1
 }
 
-// GetPaylinkStatTotal provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinkStatTotal(ctx context.Context, in *grpc.GetPaylinkStatCommonRequest, opts ...client.CallOption) (*grpc.GetPaylinkStatCommonResponse, error) {
+// GetRefund provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetRefund(ctx context.Context, in *grpc.GetRefundRequest, opts ...client.CallOption) (*grpc.CreateRefundResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2305,17 +3715,17 @@ func (_m *BillingService) GetPaylinkStatTotal(ctx context.Context, in *grpc.GetP
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkStatCommonResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) *grpc.GetPaylinkStatCommonResponse); ok {
+	var r0 *grpc.CreateRefundResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetRefundRequest, ...client.CallOption) *grpc.CreateRefundResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkStatCommonResponse)
+			r0 = ret.Get(0).(*grpc.CreateRefundResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkStatCommonRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetRefundRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2324,8 +3734,8 @@ func (_m *BillingService) GetPaylinkStatTotal(ctx context.Context, in *grpc.GetP
 	return r0, r1
 }
 
-// GetPaylinkURL provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinkURL(ctx context.Context, in *grpc.GetPaylinkURLRequest, opts ...client.CallOption) (*grpc.GetPaylinkUrlResponse, error) {
+// GetRoyaltyReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetRoyaltyReport(ctx context.Context, in *grpc.GetRoyaltyReportRequest, opts ...client.CallOption) (*grpc.GetRoyaltyReportResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2335,17 +3745,17 @@ func (_m *BillingService) GetPaylinkURL(ctx context.Context, in *grpc.GetPaylink
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinkUrlResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinkURLRequest, ...client.CallOption) *grpc.GetPaylinkUrlResponse); ok {
+	var r0 *grpc.GetRoyaltyReportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) *grpc.GetRoyaltyReportResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinkUrlResponse)
+			r0 = ret.Get(0).(*grpc.GetRoyaltyReportResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinkURLRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2354,8 +3764,8 @@ func (_m *BillingService) GetPaylinkURL(ctx context.Context, in *grpc.GetPaylink
 	return r0, r1
 }
 
-// GetPaylinks provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaylinks(ctx context.Context, in *grpc.GetPaylinksRequest, opts ...client.CallOption) (*grpc.GetPaylinksResponse, error) {
+// GetRoyaltyReportDispute provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetRoyaltyReportDispute(ctx context.Context, in *grpc.GetRoyaltyReportRequest, opts ...client.CallOption) (*grpc.RoyaltyReportDisputeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2365,17 +3775,17 @@ func (_m *BillingService) GetPaylinks(ctx context.Context, in *grpc.GetPaylinksR
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaylinksResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaylinksRequest, ...client.CallOption) *grpc.GetPaylinksResponse); ok {
+	var r0 *grpc.RoyaltyReportDisputeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) *grpc.RoyaltyReportDisputeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaylinksResponse)
+			r0 = ret.Get(0).(*grpc.RoyaltyReportDisputeResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaylinksRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2384,8 +3794,8 @@ func (_m *BillingService) GetPaylinks(ctx context.Context, in *grpc.GetPaylinksR
 	return r0, r1
 }
 
-// GetPaymentChannelCostMerchant provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaymentChannelCostMerchant(ctx context.Context, in *billing.PaymentChannelCostMerchantRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostMerchantResponse, error) {
+// GetSubscription provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetSubscription(ctx context.Context, in *grpc.SubscriptionRequest, opts ...client.CallOption) (*grpc.SubscriptionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2395,17 +3805,17 @@ func (_m *BillingService) GetPaymentChannelCostMerchant(ctx context.Context, in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentChannelCostMerchantResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentChannelCostMerchantRequest, ...client.CallOption) *grpc.PaymentChannelCostMerchantResponse); ok {
+	var r0 *grpc.SubscriptionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) *grpc.SubscriptionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentChannelCostMerchantResponse)
+			r0 = ret.Get(0).(*grpc.SubscriptionResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentChannelCostMerchantRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2414,8 +3824,8 @@ func (_m *BillingService) GetPaymentChannelCostMerchant(ctx context.Context, in
 	return r0, r1
 }
 
-// GetPaymentChannelCostSystem provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaymentChannelCostSystem(ctx context.Context, in *billing.PaymentChannelCostSystemRequest, opts ...client.CallOption) (*grpc.PaymentChannelCostSystemResponse, error) {
+// GetSubscriptionPlan provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetSubscriptionPlan(ctx context.Context, in *grpc.GetSubscriptionPlanRequest, opts ...client.CallOption) (*grpc.SubscriptionPlanResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2425,17 +3835,17 @@ func (_m *BillingService) GetPaymentChannelCostSystem(ctx context.Context, in *b
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentChannelCostSystemResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.PaymentChannelCostSystemRequest, ...client.CallOption) *grpc.PaymentChannelCostSystemResponse); ok {
+	var r0 *grpc.SubscriptionPlanResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetSubscriptionPlanRequest, ...client.CallOption) *grpc.SubscriptionPlanResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentChannelCostSystemResponse)
+			r0 = ret.Get(0).(*grpc.SubscriptionPlanResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.PaymentChannelCostSystemRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetSubscriptionPlanRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2444,8 +3854,8 @@ func (_m *BillingService) GetPaymentChannelCostSystem(ctx context.Context, in *b
 	return r0, r1
 }
 
-// GetPaymentMethodProductionSettings provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaymentMethodProductionSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.GetPaymentMethodSettingsResponse, error) {
+// GetUserProfile provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetUserProfile(ctx context.Context, in *grpc.GetUserProfileRequest, opts ...client.CallOption) (*grpc.GetUserProfileResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2455,17 +3865,17 @@ func (_m *BillingService) GetPaymentMethodProductionSettings(ctx context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaymentMethodSettingsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.GetPaymentMethodSettingsResponse); ok {
+	var r0 *grpc.GetUserProfileResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetUserProfileRequest, ...client.CallOption) *grpc.GetUserProfileResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaymentMethodSettingsResponse)
+			r0 = ret.Get(0).(*grpc.GetUserProfileResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetUserProfileRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2474,8 +3884,8 @@ func (_m *BillingService) GetPaymentMethodProductionSettings(ctx context.Context
 	return r0, r1
 }
 
-// GetPaymentMethodTestSettings provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPaymentMethodTestSettings(ctx context.Context, in *grpc.GetPaymentMethodSettingsRequest, opts ...client.CallOption) (*grpc.GetPaymentMethodSettingsResponse, error) {
+// GetVatOssReturn provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetVatOssReturn(ctx context.Context, in *grpc.VatOssReturnRequest, opts ...client.CallOption) (*grpc.VatOssReturnResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2485,17 +3895,17 @@ func (_m *BillingService) GetPaymentMethodTestSettings(ctx context.Context, in *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPaymentMethodSettingsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) *grpc.GetPaymentMethodSettingsResponse); ok {
+	var r0 *grpc.VatOssReturnResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.VatOssReturnRequest, ...client.CallOption) *grpc.VatOssReturnResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPaymentMethodSettingsResponse)
+			r0 = ret.Get(0).(*grpc.VatOssReturnResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPaymentMethodSettingsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.VatOssReturnRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2504,8 +3914,8 @@ func (_m *BillingService) GetPaymentMethodTestSettings(ctx context.Context, in *
 	return r0, r1
 }
 
-// GetPayoutDocument provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPayoutDocument(ctx context.Context, in *grpc.GetPayoutDocumentRequest, opts ...client.CallOption) (*grpc.PayoutDocumentResponse, error) {
+// GetVatReportTransactions provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetVatReportTransactions(ctx context.Context, in *grpc.VatTransactionsRequest, opts ...client.CallOption) (*grpc.TransactionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2515,17 +3925,17 @@ func (_m *BillingService) GetPayoutDocument(ctx context.Context, in *grpc.GetPay
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PayoutDocumentResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) *grpc.PayoutDocumentResponse); ok {
+	var r0 *grpc.TransactionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.VatTransactionsRequest, ...client.CallOption) *grpc.TransactionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PayoutDocumentResponse)
+			r0 = ret.Get(0).(*grpc.TransactionsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.VatTransactionsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2534,8 +3944,8 @@ func (_m *BillingService) GetPayoutDocument(ctx context.Context, in *grpc.GetPay
 	return r0, r1
 }
 
-// GetPayoutDocumentRoyaltyReports provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPayoutDocumentRoyaltyReports(ctx context.Context, in *grpc.GetPayoutDocumentRequest, opts ...client.CallOption) (*grpc.ListRoyaltyReportsResponse, error) {
+// GetVatReportsDashboard provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetVatReportsDashboard(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.VatReportsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2545,17 +3955,17 @@ func (_m *BillingService) GetPayoutDocumentRoyaltyReports(ctx context.Context, i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListRoyaltyReportsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) *grpc.ListRoyaltyReportsResponse); ok {
+	var r0 *grpc.VatReportsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.VatReportsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListRoyaltyReportsResponse)
+			r0 = ret.Get(0).(*grpc.VatReportsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutDocumentRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2564,8 +3974,8 @@ func (_m *BillingService) GetPayoutDocumentRoyaltyReports(ctx context.Context, i
 	return r0, r1
 }
 
-// GetPayoutDocuments provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPayoutDocuments(ctx context.Context, in *grpc.GetPayoutDocumentsRequest, opts ...client.CallOption) (*grpc.GetPayoutDocumentsResponse, error) {
+// GetVatReportsForCountry provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) GetVatReportsForCountry(ctx context.Context, in *grpc.VatReportsRequest, opts ...client.CallOption) (*grpc.VatReportsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2575,17 +3985,17 @@ func (_m *BillingService) GetPayoutDocuments(ctx context.Context, in *grpc.GetPa
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPayoutDocumentsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPayoutDocumentsRequest, ...client.CallOption) *grpc.GetPayoutDocumentsResponse); ok {
+	var r0 *grpc.VatReportsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.VatReportsRequest, ...client.CallOption) *grpc.VatReportsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPayoutDocumentsResponse)
+			r0 = ret.Get(0).(*grpc.VatReportsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPayoutDocumentsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.VatReportsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2594,8 +4004,8 @@ func (_m *BillingService) GetPayoutDocuments(ctx context.Context, in *grpc.GetPa
 	return r0, r1
 }
 
-// GetPlatforms provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPlatforms(ctx context.Context, in *grpc.ListPlatformsRequest, opts ...client.CallOption) (*grpc.ListPlatformsResponse, error) {
+// ImportPayoutStatusReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ImportPayoutStatusReport(ctx context.Context, in *grpc.ImportPayoutStatusReportRequest, opts ...client.CallOption) (*grpc.ImportPayoutStatusReportResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2605,17 +4015,17 @@ func (_m *BillingService) GetPlatforms(ctx context.Context, in *grpc.ListPlatfor
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListPlatformsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListPlatformsRequest, ...client.CallOption) *grpc.ListPlatformsResponse); ok {
+	var r0 *grpc.ImportPayoutStatusReportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ImportPayoutStatusReportRequest, ...client.CallOption) *grpc.ImportPayoutStatusReportResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListPlatformsResponse)
+			r0 = ret.Get(0).(*grpc.ImportPayoutStatusReportResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListPlatformsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ImportPayoutStatusReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2624,8 +4034,8 @@ func (_m *BillingService) GetPlatforms(ctx context.Context, in *grpc.ListPlatfor
 	return r0, r1
 }
 
-// GetPriceGroup provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPriceGroup(ctx context.Context, in *billing.GetPriceGroupRequest, opts ...client.CallOption) (*billing.PriceGroup, error) {
+// ImportReconciliationReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ImportReconciliationReport(ctx context.Context, in *grpc.ImportReconciliationReportRequest, opts ...client.CallOption) (*grpc.ReconciliationRunResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2635,17 +4045,17 @@ func (_m *BillingService) GetPriceGroup(ctx context.Context, in *billing.GetPric
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *billing.PriceGroup
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.GetPriceGroupRequest, ...client.CallOption) *billing.PriceGroup); ok {
+	var r0 *grpc.ReconciliationRunResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ImportReconciliationReportRequest, ...client.CallOption) *grpc.ReconciliationRunResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*billing.PriceGroup)
+			r0 = ret.Get(0).(*grpc.ReconciliationRunResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.GetPriceGroupRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ImportReconciliationReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2654,8 +4064,8 @@ func (_m *BillingService) GetPriceGroup(ctx context.Context, in *billing.GetPric
 	return r0, r1
 }
 
-// GetPriceGroupByCountry provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPriceGroupByCountry(ctx context.Context, in *grpc.PriceGroupByCountryRequest, opts ...client.CallOption) (*billing.PriceGroup, error) {
+// IncrPaylinkVisits provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) IncrPaylinkVisits(ctx context.Context, in *grpc.PaylinkRequestById, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2665,17 +4075,17 @@ func (_m *BillingService) GetPriceGroupByCountry(ctx context.Context, in *grpc.P
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *billing.PriceGroup
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PriceGroupByCountryRequest, ...client.CallOption) *billing.PriceGroup); ok {
+	var r0 *grpc.EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaylinkRequestById, ...client.CallOption) *grpc.EmptyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*billing.PriceGroup)
+			r0 = ret.Get(0).(*grpc.EmptyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PriceGroupByCountryRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaylinkRequestById, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2684,8 +4094,8 @@ func (_m *BillingService) GetPriceGroupByCountry(ctx context.Context, in *grpc.P
 	return r0, r1
 }
 
-// GetPriceGroupByRegion provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPriceGroupByRegion(ctx context.Context, in *grpc.GetPriceGroupByRegionRequest, opts ...client.CallOption) (*grpc.GetPriceGroupByRegionResponse, error) {
+// IsOrderCanBePaying provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) IsOrderCanBePaying(ctx context.Context, in *grpc.IsOrderCanBePayingRequest, opts ...client.CallOption) (*grpc.IsOrderCanBePayingResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2695,17 +4105,17 @@ func (_m *BillingService) GetPriceGroupByRegion(ctx context.Context, in *grpc.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetPriceGroupByRegionResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetPriceGroupByRegionRequest, ...client.CallOption) *grpc.GetPriceGroupByRegionResponse); ok {
+	var r0 *grpc.IsOrderCanBePayingResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.IsOrderCanBePayingRequest, ...client.CallOption) *grpc.IsOrderCanBePayingResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetPriceGroupByRegionResponse)
+			r0 = ret.Get(0).(*grpc.IsOrderCanBePayingResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetPriceGroupByRegionRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.IsOrderCanBePayingRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2714,8 +4124,8 @@ func (_m *BillingService) GetPriceGroupByRegion(ctx context.Context, in *grpc.Ge
 	return r0, r1
 }
 
-// GetPriceGroupCurrencies provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPriceGroupCurrencies(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.PriceGroupCurrenciesResponse, error) {
+// ListChargebacks provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListChargebacks(ctx context.Context, in *grpc.ListChargebacksRequest, opts ...client.CallOption) (*grpc.ListChargebacksResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2725,17 +4135,17 @@ func (_m *BillingService) GetPriceGroupCurrencies(ctx context.Context, in *grpc.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PriceGroupCurrenciesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.PriceGroupCurrenciesResponse); ok {
+	var r0 *grpc.ListChargebacksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListChargebacksRequest, ...client.CallOption) *grpc.ListChargebacksResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PriceGroupCurrenciesResponse)
+			r0 = ret.Get(0).(*grpc.ListChargebacksResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListChargebacksRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2744,8 +4154,8 @@ func (_m *BillingService) GetPriceGroupCurrencies(ctx context.Context, in *grpc.
 	return r0, r1
 }
 
-// GetPriceGroupCurrencyByRegion provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetPriceGroupCurrencyByRegion(ctx context.Context, in *grpc.PriceGroupByRegionRequest, opts ...client.CallOption) (*grpc.PriceGroupCurrenciesResponse, error) {
+// ListFraudRules provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListFraudRules(ctx context.Context, in *grpc.ListFraudRulesRequest, opts ...client.CallOption) (*grpc.ListFraudRulesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2755,17 +4165,17 @@ func (_m *BillingService) GetPriceGroupCurrencyByRegion(ctx context.Context, in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PriceGroupCurrenciesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PriceGroupByRegionRequest, ...client.CallOption) *grpc.PriceGroupCurrenciesResponse); ok {
+	var r0 *grpc.ListFraudRulesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListFraudRulesRequest, ...client.CallOption) *grpc.ListFraudRulesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PriceGroupCurrenciesResponse)
+			r0 = ret.Get(0).(*grpc.ListFraudRulesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PriceGroupByRegionRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListFraudRulesRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2774,8 +4184,8 @@ func (_m *BillingService) GetPriceGroupCurrencyByRegion(ctx context.Context, in
 	return r0, r1
 }
 
-// GetProduct provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetProduct(ctx context.Context, in *grpc.RequestProduct, opts ...client.CallOption) (*grpc.GetProductResponse, error) {
+// ListMerchantPaymentMethods provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListMerchantPaymentMethods(ctx context.Context, in *grpc.ListMerchantPaymentMethodsRequest, opts ...client.CallOption) (*grpc.ListingMerchantPaymentMethod, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2785,17 +4195,17 @@ func (_m *BillingService) GetProduct(ctx context.Context, in *grpc.RequestProduc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetProductResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) *grpc.GetProductResponse); ok {
+	var r0 *grpc.ListingMerchantPaymentMethod
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListMerchantPaymentMethodsRequest, ...client.CallOption) *grpc.ListingMerchantPaymentMethod); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetProductResponse)
+			r0 = ret.Get(0).(*grpc.ListingMerchantPaymentMethod)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListMerchantPaymentMethodsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2804,8 +4214,8 @@ func (_m *BillingService) GetProduct(ctx context.Context, in *grpc.RequestProduc
 	return r0, r1
 }
 
-// GetProductPrices provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetProductPrices(ctx context.Context, in *grpc.RequestProduct, opts ...client.CallOption) (*grpc.ProductPricesResponse, error) {
+// ListMerchants provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListMerchants(ctx context.Context, in *grpc.MerchantListingRequest, opts ...client.CallOption) (*grpc.MerchantListingResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2815,17 +4225,17 @@ func (_m *BillingService) GetProductPrices(ctx context.Context, in *grpc.Request
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ProductPricesResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) *grpc.ProductPricesResponse); ok {
+	var r0 *grpc.MerchantListingResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.MerchantListingRequest, ...client.CallOption) *grpc.MerchantListingResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ProductPricesResponse)
+			r0 = ret.Get(0).(*grpc.MerchantListingResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RequestProduct, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.MerchantListingRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2834,8 +4244,8 @@ func (_m *BillingService) GetProductPrices(ctx context.Context, in *grpc.Request
 	return r0, r1
 }
 
-// GetProductsForOrder provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetProductsForOrder(ctx context.Context, in *grpc.GetProductsForOrderRequest, opts ...client.CallOption) (*grpc.ListProductsResponse, error) {
+// ListNotifications provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListNotifications(ctx context.Context, in *grpc.ListingNotificationRequest, opts ...client.CallOption) (*grpc.Notifications, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2845,17 +4255,17 @@ func (_m *BillingService) GetProductsForOrder(ctx context.Context, in *grpc.GetP
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListProductsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetProductsForOrderRequest, ...client.CallOption) *grpc.ListProductsResponse); ok {
+	var r0 *grpc.Notifications
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListingNotificationRequest, ...client.CallOption) *grpc.Notifications); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListProductsResponse)
+			r0 = ret.Get(0).(*grpc.Notifications)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetProductsForOrderRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListingNotificationRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2864,8 +4274,8 @@ func (_m *BillingService) GetProductsForOrder(ctx context.Context, in *grpc.GetP
 	return r0, r1
 }
 
-// GetProject provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetProject(ctx context.Context, in *grpc.GetProjectRequest, opts ...client.CallOption) (*grpc.ChangeProjectResponse, error) {
+// ListProducts provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListProducts(ctx context.Context, in *grpc.ListProductsRequest, opts ...client.CallOption) (*grpc.ListProductsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2875,17 +4285,17 @@ func (_m *BillingService) GetProject(ctx context.Context, in *grpc.GetProjectReq
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ChangeProjectResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) *grpc.ChangeProjectResponse); ok {
+	var r0 *grpc.ListProductsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListProductsRequest, ...client.CallOption) *grpc.ListProductsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ChangeProjectResponse)
+			r0 = ret.Get(0).(*grpc.ListProductsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetProjectRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListProductsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2894,8 +4304,8 @@ func (_m *BillingService) GetProject(ctx context.Context, in *grpc.GetProjectReq
 	return r0, r1
 }
 
-// GetRecommendedPriceByConversion provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetRecommendedPriceByConversion(ctx context.Context, in *grpc.RecommendedPriceRequest, opts ...client.CallOption) (*grpc.RecommendedPriceResponse, error) {
+// ListProjects provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListProjects(ctx context.Context, in *grpc.ListProjectsRequest, opts ...client.CallOption) (*grpc.ListProjectsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2905,17 +4315,17 @@ func (_m *BillingService) GetRecommendedPriceByConversion(ctx context.Context, i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.RecommendedPriceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) *grpc.RecommendedPriceResponse); ok {
+	var r0 *grpc.ListProjectsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListProjectsRequest, ...client.CallOption) *grpc.ListProjectsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.RecommendedPriceResponse)
+			r0 = ret.Get(0).(*grpc.ListProjectsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListProjectsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2924,8 +4334,8 @@ func (_m *BillingService) GetRecommendedPriceByConversion(ctx context.Context, i
 	return r0, r1
 }
 
-// GetRecommendedPriceByPriceGroup provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetRecommendedPriceByPriceGroup(ctx context.Context, in *grpc.RecommendedPriceRequest, opts ...client.CallOption) (*grpc.RecommendedPriceResponse, error) {
+// ListPromoCodes provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListPromoCodes(ctx context.Context, in *grpc.ListPromoCodesRequest, opts ...client.CallOption) (*grpc.ListPromoCodesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2935,17 +4345,17 @@ func (_m *BillingService) GetRecommendedPriceByPriceGroup(ctx context.Context, i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.RecommendedPriceResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) *grpc.RecommendedPriceResponse); ok {
+	var r0 *grpc.ListPromoCodesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListPromoCodesRequest, ...client.CallOption) *grpc.ListPromoCodesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.RecommendedPriceResponse)
+			r0 = ret.Get(0).(*grpc.ListPromoCodesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RecommendedPriceRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListPromoCodesRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2954,8 +4364,8 @@ func (_m *BillingService) GetRecommendedPriceByPriceGroup(ctx context.Context, i
 	return r0, r1
 }
 
-// GetRecommendedPriceTable provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetRecommendedPriceTable(ctx context.Context, in *grpc.RecommendedPriceTableRequest, opts ...client.CallOption) (*grpc.RecommendedPriceTableResponse, error) {
+// ListReconciliationLines provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListReconciliationLines(ctx context.Context, in *grpc.ListReconciliationLinesRequest, opts ...client.CallOption) (*grpc.ListReconciliationLinesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2965,17 +4375,17 @@ func (_m *BillingService) GetRecommendedPriceTable(ctx context.Context, in *grpc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.RecommendedPriceTableResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RecommendedPriceTableRequest, ...client.CallOption) *grpc.RecommendedPriceTableResponse); ok {
+	var r0 *grpc.ListReconciliationLinesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListReconciliationLinesRequest, ...client.CallOption) *grpc.ListReconciliationLinesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.RecommendedPriceTableResponse)
+			r0 = ret.Get(0).(*grpc.ListReconciliationLinesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RecommendedPriceTableRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListReconciliationLinesRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2984,8 +4394,8 @@ func (_m *BillingService) GetRecommendedPriceTable(ctx context.Context, in *grpc
 	return r0, r1
 }
 
-// GetRefund provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetRefund(ctx context.Context, in *grpc.GetRefundRequest, opts ...client.CallOption) (*grpc.CreateRefundResponse, error) {
+// ListReconciliationRuns provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListReconciliationRuns(ctx context.Context, in *grpc.ListReconciliationRunsRequest, opts ...client.CallOption) (*grpc.ListReconciliationRunsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2995,17 +4405,17 @@ func (_m *BillingService) GetRefund(ctx context.Context, in *grpc.GetRefundReque
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.CreateRefundResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetRefundRequest, ...client.CallOption) *grpc.CreateRefundResponse); ok {
+	var r0 *grpc.ListReconciliationRunsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListReconciliationRunsRequest, ...client.CallOption) *grpc.ListReconciliationRunsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.CreateRefundResponse)
+			r0 = ret.Get(0).(*grpc.ListReconciliationRunsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetRefundRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListReconciliationRunsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3014,8 +4424,8 @@ func (_m *BillingService) GetRefund(ctx context.Context, in *grpc.GetRefundReque
 	return r0, r1
 }
 
-// GetRoyaltyReport provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetRoyaltyReport(ctx context.Context, in *grpc.GetRoyaltyReportRequest, opts ...client.CallOption) (*grpc.GetRoyaltyReportResponse, error) {
+// ListRefunds provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListRefunds(ctx context.Context, in *grpc.ListRefundsRequest, opts ...client.CallOption) (*grpc.ListRefundsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3025,17 +4435,17 @@ func (_m *BillingService) GetRoyaltyReport(ctx context.Context, in *grpc.GetRoya
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetRoyaltyReportResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) *grpc.GetRoyaltyReportResponse); ok {
+	var r0 *grpc.ListRefundsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListRefundsRequest, ...client.CallOption) *grpc.ListRefundsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetRoyaltyReportResponse)
+			r0 = ret.Get(0).(*grpc.ListRefundsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListRefundsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3044,8 +4454,8 @@ func (_m *BillingService) GetRoyaltyReport(ctx context.Context, in *grpc.GetRoya
 	return r0, r1
 }
 
-// GetUserProfile provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetUserProfile(ctx context.Context, in *grpc.GetUserProfileRequest, opts ...client.CallOption) (*grpc.GetUserProfileResponse, error) {
+// ListRoyaltyReportOrders provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListRoyaltyReportOrders(ctx context.Context, in *grpc.ListRoyaltyReportOrdersRequest, opts ...client.CallOption) (*grpc.TransactionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3055,17 +4465,17 @@ func (_m *BillingService) GetUserProfile(ctx context.Context, in *grpc.GetUserPr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.GetUserProfileResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetUserProfileRequest, ...client.CallOption) *grpc.GetUserProfileResponse); ok {
+	var r0 *grpc.TransactionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListRoyaltyReportOrdersRequest, ...client.CallOption) *grpc.TransactionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.GetUserProfileResponse)
+			r0 = ret.Get(0).(*grpc.TransactionsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetUserProfileRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListRoyaltyReportOrdersRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3074,8 +4484,8 @@ func (_m *BillingService) GetUserProfile(ctx context.Context, in *grpc.GetUserPr
 	return r0, r1
 }
 
-// GetVatReportTransactions provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetVatReportTransactions(ctx context.Context, in *grpc.VatTransactionsRequest, opts ...client.CallOption) (*grpc.TransactionsResponse, error) {
+// ListRoyaltyReportVersions provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListRoyaltyReportVersions(ctx context.Context, in *grpc.GetRoyaltyReportRequest, opts ...client.CallOption) (*grpc.ListRoyaltyReportVersionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3085,17 +4495,17 @@ func (_m *BillingService) GetVatReportTransactions(ctx context.Context, in *grpc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.TransactionsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.VatTransactionsRequest, ...client.CallOption) *grpc.TransactionsResponse); ok {
+	var r0 *grpc.ListRoyaltyReportVersionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) *grpc.ListRoyaltyReportVersionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.TransactionsResponse)
+			r0 = ret.Get(0).(*grpc.ListRoyaltyReportVersionsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.VatTransactionsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetRoyaltyReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3104,8 +4514,8 @@ func (_m *BillingService) GetVatReportTransactions(ctx context.Context, in *grpc
 	return r0, r1
 }
 
-// GetVatReportsDashboard provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetVatReportsDashboard(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.VatReportsResponse, error) {
+// ListRoyaltyReports provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListRoyaltyReports(ctx context.Context, in *grpc.ListRoyaltyReportsRequest, opts ...client.CallOption) (*grpc.ListRoyaltyReportsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3115,17 +4525,17 @@ func (_m *BillingService) GetVatReportsDashboard(ctx context.Context, in *grpc.E
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.VatReportsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.VatReportsResponse); ok {
+	var r0 *grpc.ListRoyaltyReportsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListRoyaltyReportsRequest, ...client.CallOption) *grpc.ListRoyaltyReportsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.VatReportsResponse)
+			r0 = ret.Get(0).(*grpc.ListRoyaltyReportsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListRoyaltyReportsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3134,8 +4544,8 @@ func (_m *BillingService) GetVatReportsDashboard(ctx context.Context, in *grpc.E
 	return r0, r1
 }
 
-// GetVatReportsForCountry provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) GetVatReportsForCountry(ctx context.Context, in *grpc.VatReportsRequest, opts ...client.CallOption) (*grpc.VatReportsResponse, error) {
+// ListSubscriptionPlans provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListSubscriptionPlans(ctx context.Context, in *grpc.ListSubscriptionPlansRequest, opts ...client.CallOption) (*grpc.ListSubscriptionPlansResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3145,17 +4555,17 @@ func (_m *BillingService) GetVatReportsForCountry(ctx context.Context, in *grpc.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.VatReportsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.VatReportsRequest, ...client.CallOption) *grpc.VatReportsResponse); ok {
+	var r0 *grpc.ListSubscriptionPlansResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListSubscriptionPlansRequest, ...client.CallOption) *grpc.ListSubscriptionPlansResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.VatReportsResponse)
+			r0 = ret.Get(0).(*grpc.ListSubscriptionPlansResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.VatReportsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListSubscriptionPlansRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3164,8 +4574,8 @@ func (_m *BillingService) GetVatReportsForCountry(ctx context.Context, in *grpc.
 	return r0, r1
 }
 
-// IncrPaylinkVisits provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) IncrPaylinkVisits(ctx context.Context, in *grpc.PaylinkRequestById, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
+// ListWebhookDeliveries provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ListWebhookDeliveries(ctx context.Context, in *grpc.ListWebhookDeliveriesRequest, opts ...client.CallOption) (*grpc.ListWebhookDeliveriesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3175,17 +4585,17 @@ func (_m *BillingService) IncrPaylinkVisits(ctx context.Context, in *grpc.Paylin
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaylinkRequestById, ...client.CallOption) *grpc.EmptyResponse); ok {
+	var r0 *grpc.ListWebhookDeliveriesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListWebhookDeliveriesRequest, ...client.CallOption) *grpc.ListWebhookDeliveriesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponse)
+			r0 = ret.Get(0).(*grpc.ListWebhookDeliveriesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaylinkRequestById, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListWebhookDeliveriesRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3194,8 +4604,8 @@ func (_m *BillingService) IncrPaylinkVisits(ctx context.Context, in *grpc.Paylin
 	return r0, r1
 }
 
-// IsOrderCanBePaying provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) IsOrderCanBePaying(ctx context.Context, in *grpc.IsOrderCanBePayingRequest, opts ...client.CallOption) (*grpc.IsOrderCanBePayingResponse, error) {
+// MarkNotificationAsRead provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) MarkNotificationAsRead(ctx context.Context, in *grpc.GetNotificationRequest, opts ...client.CallOption) (*billing.Notification, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3205,17 +4615,17 @@ func (_m *BillingService) IsOrderCanBePaying(ctx context.Context, in *grpc.IsOrd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.IsOrderCanBePayingResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.IsOrderCanBePayingRequest, ...client.CallOption) *grpc.IsOrderCanBePayingResponse); ok {
+	var r0 *billing.Notification
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) *billing.Notification); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.IsOrderCanBePayingResponse)
+			r0 = ret.Get(0).(*billing.Notification)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.IsOrderCanBePayingRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3224,8 +4634,8 @@ func (_m *BillingService) IsOrderCanBePaying(ctx context.Context, in *grpc.IsOrd
 	return r0, r1
 }
 
-// ListMerchantPaymentMethods provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListMerchantPaymentMethods(ctx context.Context, in *grpc.ListMerchantPaymentMethodsRequest, opts ...client.CallOption) (*grpc.ListingMerchantPaymentMethod, error) {
+// MerchantReviewRoyaltyReport provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) MerchantReviewRoyaltyReport(ctx context.Context, in *grpc.MerchantReviewRoyaltyReportRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3235,17 +4645,17 @@ func (_m *BillingService) ListMerchantPaymentMethods(ctx context.Context, in *gr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListingMerchantPaymentMethod
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListMerchantPaymentMethodsRequest, ...client.CallOption) *grpc.ListingMerchantPaymentMethod); ok {
+	var r0 *grpc.ResponseError
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.MerchantReviewRoyaltyReportRequest, ...client.CallOption) *grpc.ResponseError); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListingMerchantPaymentMethod)
+			r0 = ret.Get(0).(*grpc.ResponseError)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListMerchantPaymentMethodsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.MerchantReviewRoyaltyReportRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3254,8 +4664,8 @@ func (_m *BillingService) ListMerchantPaymentMethods(ctx context.Context, in *gr
 	return r0, r1
 }
 
-// ListMerchants provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListMerchants(ctx context.Context, in *grpc.MerchantListingRequest, opts ...client.CallOption) (*grpc.MerchantListingResponse, error) {
+// OrderCreateByPaylink provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) OrderCreateByPaylink(ctx context.Context, in *billing.OrderCreateByPaylink, opts ...client.CallOption) (*grpc.OrderCreateProcessResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3265,17 +4675,17 @@ func (_m *BillingService) ListMerchants(ctx context.Context, in *grpc.MerchantLi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.MerchantListingResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.MerchantListingRequest, ...client.CallOption) *grpc.MerchantListingResponse); ok {
+	var r0 *grpc.OrderCreateProcessResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.OrderCreateByPaylink, ...client.CallOption) *grpc.OrderCreateProcessResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.MerchantListingResponse)
+			r0 = ret.Get(0).(*grpc.OrderCreateProcessResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.MerchantListingRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.OrderCreateByPaylink, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3284,8 +4694,8 @@ func (_m *BillingService) ListMerchants(ctx context.Context, in *grpc.MerchantLi
 	return r0, r1
 }
 
-// ListNotifications provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListNotifications(ctx context.Context, in *grpc.ListingNotificationRequest, opts ...client.CallOption) (*grpc.Notifications, error) {
+// OrderCreateProcess provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) OrderCreateProcess(ctx context.Context, in *billing.OrderCreateRequest, opts ...client.CallOption) (*grpc.OrderCreateProcessResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3295,17 +4705,17 @@ func (_m *BillingService) ListNotifications(ctx context.Context, in *grpc.Listin
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.Notifications
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListingNotificationRequest, ...client.CallOption) *grpc.Notifications); ok {
+	var r0 *grpc.OrderCreateProcessResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.OrderCreateRequest, ...client.CallOption) *grpc.OrderCreateProcessResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.Notifications)
+			r0 = ret.Get(0).(*grpc.OrderCreateProcessResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListingNotificationRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.OrderCreateRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3314,8 +4724,8 @@ func (_m *BillingService) ListNotifications(ctx context.Context, in *grpc.Listin
 	return r0, r1
 }
 
-// ListProducts provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListProducts(ctx context.Context, in *grpc.ListProductsRequest, opts ...client.CallOption) (*grpc.ListProductsResponse, error) {
+// OrderReceipt provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) OrderReceipt(ctx context.Context, in *grpc.OrderReceiptRequest, opts ...client.CallOption) (*grpc.OrderReceiptResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3325,17 +4735,17 @@ func (_m *BillingService) ListProducts(ctx context.Context, in *grpc.ListProduct
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListProductsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListProductsRequest, ...client.CallOption) *grpc.ListProductsResponse); ok {
+	var r0 *grpc.OrderReceiptResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.OrderReceiptRequest, ...client.CallOption) *grpc.OrderReceiptResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListProductsResponse)
+			r0 = ret.Get(0).(*grpc.OrderReceiptResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListProductsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.OrderReceiptRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3344,8 +4754,8 @@ func (_m *BillingService) ListProducts(ctx context.Context, in *grpc.ListProduct
 	return r0, r1
 }
 
-// ListProjects provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListProjects(ctx context.Context, in *grpc.ListProjectsRequest, opts ...client.CallOption) (*grpc.ListProjectsResponse, error) {
+// PauseSubscription provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PauseSubscription(ctx context.Context, in *grpc.SubscriptionRequest, opts ...client.CallOption) (*grpc.SubscriptionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3355,17 +4765,17 @@ func (_m *BillingService) ListProjects(ctx context.Context, in *grpc.ListProject
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListProjectsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListProjectsRequest, ...client.CallOption) *grpc.ListProjectsResponse); ok {
+	var r0 *grpc.SubscriptionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) *grpc.SubscriptionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListProjectsResponse)
+			r0 = ret.Get(0).(*grpc.SubscriptionResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListProjectsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3374,8 +4784,8 @@ func (_m *BillingService) ListProjects(ctx context.Context, in *grpc.ListProject
 	return r0, r1
 }
 
-// ListRefunds provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListRefunds(ctx context.Context, in *grpc.ListRefundsRequest, opts ...client.CallOption) (*grpc.ListRefundsResponse, error) {
+// PaymentCallbackProcess provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentCallbackProcess(ctx context.Context, in *grpc.PaymentNotifyRequest, opts ...client.CallOption) (*grpc.PaymentNotifyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3385,17 +4795,17 @@ func (_m *BillingService) ListRefunds(ctx context.Context, in *grpc.ListRefundsR
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListRefundsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListRefundsRequest, ...client.CallOption) *grpc.ListRefundsResponse); ok {
+	var r0 *grpc.PaymentNotifyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentNotifyRequest, ...client.CallOption) *grpc.PaymentNotifyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListRefundsResponse)
+			r0 = ret.Get(0).(*grpc.PaymentNotifyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListRefundsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentNotifyRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3404,8 +4814,8 @@ func (_m *BillingService) ListRefunds(ctx context.Context, in *grpc.ListRefundsR
 	return r0, r1
 }
 
-// ListRoyaltyReportOrders provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListRoyaltyReportOrders(ctx context.Context, in *grpc.ListRoyaltyReportOrdersRequest, opts ...client.CallOption) (*grpc.TransactionsResponse, error) {
+// PaymentCreateProcess provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentCreateProcess(ctx context.Context, in *grpc.PaymentCreateRequest, opts ...client.CallOption) (*grpc.PaymentCreateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3415,17 +4825,17 @@ func (_m *BillingService) ListRoyaltyReportOrders(ctx context.Context, in *grpc.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.TransactionsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListRoyaltyReportOrdersRequest, ...client.CallOption) *grpc.TransactionsResponse); ok {
+	var r0 *grpc.PaymentCreateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentCreateRequest, ...client.CallOption) *grpc.PaymentCreateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.TransactionsResponse)
+			r0 = ret.Get(0).(*grpc.PaymentCreateResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListRoyaltyReportOrdersRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentCreateRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3434,8 +4844,8 @@ func (_m *BillingService) ListRoyaltyReportOrders(ctx context.Context, in *grpc.
 	return r0, r1
 }
 
-// ListRoyaltyReports provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ListRoyaltyReports(ctx context.Context, in *grpc.ListRoyaltyReportsRequest, opts ...client.CallOption) (*grpc.ListRoyaltyReportsResponse, error) {
+// PaymentFormApplyPromoCode provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentFormApplyPromoCode(ctx context.Context, in *grpc.PaymentFormApplyPromoCodeRequest, opts ...client.CallOption) (*grpc.ProcessBillingAddressResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3445,17 +4855,17 @@ func (_m *BillingService) ListRoyaltyReports(ctx context.Context, in *grpc.ListR
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ListRoyaltyReportsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ListRoyaltyReportsRequest, ...client.CallOption) *grpc.ListRoyaltyReportsResponse); ok {
+	var r0 *grpc.ProcessBillingAddressResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormApplyPromoCodeRequest, ...client.CallOption) *grpc.ProcessBillingAddressResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ListRoyaltyReportsResponse)
+			r0 = ret.Get(0).(*grpc.ProcessBillingAddressResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ListRoyaltyReportsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormApplyPromoCodeRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3464,8 +4874,8 @@ func (_m *BillingService) ListRoyaltyReports(ctx context.Context, in *grpc.ListR
 	return r0, r1
 }
 
-// MarkNotificationAsRead provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) MarkNotificationAsRead(ctx context.Context, in *grpc.GetNotificationRequest, opts ...client.CallOption) (*billing.Notification, error) {
+// PaymentFormJsonDataProcess provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentFormJsonDataProcess(ctx context.Context, in *grpc.PaymentFormJsonDataRequest, opts ...client.CallOption) (*grpc.PaymentFormJsonDataResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3475,17 +4885,17 @@ func (_m *BillingService) MarkNotificationAsRead(ctx context.Context, in *grpc.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *billing.Notification
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) *billing.Notification); ok {
+	var r0 *grpc.PaymentFormJsonDataResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormJsonDataRequest, ...client.CallOption) *grpc.PaymentFormJsonDataResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*billing.Notification)
+			r0 = ret.Get(0).(*grpc.PaymentFormJsonDataResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.GetNotificationRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormJsonDataRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3494,8 +4904,8 @@ func (_m *BillingService) MarkNotificationAsRead(ctx context.Context, in *grpc.G
 	return r0, r1
 }
 
-// MerchantReviewRoyaltyReport provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) MerchantReviewRoyaltyReport(ctx context.Context, in *grpc.MerchantReviewRoyaltyReportRequest, opts ...client.CallOption) (*grpc.ResponseError, error) {
+// PaymentFormLanguageChanged provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentFormLanguageChanged(ctx context.Context, in *grpc.PaymentFormUserChangeLangRequest, opts ...client.CallOption) (*grpc.PaymentFormDataChangeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3505,17 +4915,17 @@ func (_m *BillingService) MerchantReviewRoyaltyReport(ctx context.Context, in *g
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ResponseError
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.MerchantReviewRoyaltyReportRequest, ...client.CallOption) *grpc.ResponseError); ok {
+	var r0 *grpc.PaymentFormDataChangeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormUserChangeLangRequest, ...client.CallOption) *grpc.PaymentFormDataChangeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ResponseError)
+			r0 = ret.Get(0).(*grpc.PaymentFormDataChangeResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.MerchantReviewRoyaltyReportRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormUserChangeLangRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3524,8 +4934,8 @@ func (_m *BillingService) MerchantReviewRoyaltyReport(ctx context.Context, in *g
 	return r0, r1
 }
 
-// OrderCreateByPaylink provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) OrderCreateByPaylink(ctx context.Context, in *billing.OrderCreateByPaylink, opts ...client.CallOption) (*grpc.OrderCreateProcessResponse, error) {
+// PaymentFormPaymentAccountChanged provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentFormPaymentAccountChanged(ctx context.Context, in *grpc.PaymentFormUserChangePaymentAccountRequest, opts ...client.CallOption) (*grpc.PaymentFormDataChangeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3535,17 +4945,17 @@ func (_m *BillingService) OrderCreateByPaylink(ctx context.Context, in *billing.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.OrderCreateProcessResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.OrderCreateByPaylink, ...client.CallOption) *grpc.OrderCreateProcessResponse); ok {
+	var r0 *grpc.PaymentFormDataChangeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormUserChangePaymentAccountRequest, ...client.CallOption) *grpc.PaymentFormDataChangeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.OrderCreateProcessResponse)
+			r0 = ret.Get(0).(*grpc.PaymentFormDataChangeResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.OrderCreateByPaylink, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormUserChangePaymentAccountRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3554,8 +4964,8 @@ func (_m *BillingService) OrderCreateByPaylink(ctx context.Context, in *billing.
 	return r0, r1
 }
 
-// OrderCreateProcess provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) OrderCreateProcess(ctx context.Context, in *billing.OrderCreateRequest, opts ...client.CallOption) (*grpc.OrderCreateProcessResponse, error) {
+// PaymentFormPlatformChanged provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PaymentFormPlatformChanged(ctx context.Context, in *grpc.PaymentFormUserChangePlatformRequest, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3565,17 +4975,17 @@ func (_m *BillingService) OrderCreateProcess(ctx context.Context, in *billing.Or
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.OrderCreateProcessResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *billing.OrderCreateRequest, ...client.CallOption) *grpc.OrderCreateProcessResponse); ok {
+	var r0 *grpc.EmptyResponseWithStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormUserChangePlatformRequest, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.OrderCreateProcessResponse)
+			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *billing.OrderCreateRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormUserChangePlatformRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3584,8 +4994,8 @@ func (_m *BillingService) OrderCreateProcess(ctx context.Context, in *billing.Or
 	return r0, r1
 }
 
-// OrderReceipt provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) OrderReceipt(ctx context.Context, in *grpc.OrderReceiptRequest, opts ...client.CallOption) (*grpc.OrderReceiptResponse, error) {
+// PayoutDocumentPdfUploaded provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PayoutDocumentPdfUploaded(ctx context.Context, in *grpc.PayoutDocumentPdfUploadedRequest, opts ...client.CallOption) (*grpc.PayoutDocumentPdfUploadedResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3595,17 +5005,17 @@ func (_m *BillingService) OrderReceipt(ctx context.Context, in *grpc.OrderReceip
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.OrderReceiptResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.OrderReceiptRequest, ...client.CallOption) *grpc.OrderReceiptResponse); ok {
+	var r0 *grpc.PayoutDocumentPdfUploadedResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PayoutDocumentPdfUploadedRequest, ...client.CallOption) *grpc.PayoutDocumentPdfUploadedResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.OrderReceiptResponse)
+			r0 = ret.Get(0).(*grpc.PayoutDocumentPdfUploadedResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.OrderReceiptRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PayoutDocumentPdfUploadedRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3614,8 +5024,8 @@ func (_m *BillingService) OrderReceipt(ctx context.Context, in *grpc.OrderReceip
 	return r0, r1
 }
 
-// PaymentCallbackProcess provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PaymentCallbackProcess(ctx context.Context, in *grpc.PaymentNotifyRequest, opts ...client.CallOption) (*grpc.PaymentNotifyResponse, error) {
+// ProcessBillingAddress provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ProcessBillingAddress(ctx context.Context, in *grpc.ProcessBillingAddressRequest, opts ...client.CallOption) (*grpc.ProcessBillingAddressResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3625,17 +5035,17 @@ func (_m *BillingService) PaymentCallbackProcess(ctx context.Context, in *grpc.P
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentNotifyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentNotifyRequest, ...client.CallOption) *grpc.PaymentNotifyResponse); ok {
+	var r0 *grpc.ProcessBillingAddressResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ProcessBillingAddressRequest, ...client.CallOption) *grpc.ProcessBillingAddressResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentNotifyResponse)
+			r0 = ret.Get(0).(*grpc.ProcessBillingAddressResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentNotifyRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ProcessBillingAddressRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3644,8 +5054,8 @@ func (_m *BillingService) PaymentCallbackProcess(ctx context.Context, in *grpc.P
 	return r0, r1
 }
 
-// PaymentCreateProcess provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PaymentCreateProcess(ctx context.Context, in *grpc.PaymentCreateRequest, opts ...client.CallOption) (*grpc.PaymentCreateResponse, error) {
+// ProcessRefundCallback provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ProcessRefundCallback(ctx context.Context, in *grpc.CallbackRequest, opts ...client.CallOption) (*grpc.PaymentNotifyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3655,17 +5065,17 @@ func (_m *BillingService) PaymentCreateProcess(ctx context.Context, in *grpc.Pay
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentCreateResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentCreateRequest, ...client.CallOption) *grpc.PaymentCreateResponse); ok {
+	var r0 *grpc.PaymentNotifyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CallbackRequest, ...client.CallOption) *grpc.PaymentNotifyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentCreateResponse)
+			r0 = ret.Get(0).(*grpc.PaymentNotifyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentCreateRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CallbackRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3674,8 +5084,8 @@ func (_m *BillingService) PaymentCreateProcess(ctx context.Context, in *grpc.Pay
 	return r0, r1
 }
 
-// PaymentFormJsonDataProcess provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PaymentFormJsonDataProcess(ctx context.Context, in *grpc.PaymentFormJsonDataRequest, opts ...client.CallOption) (*grpc.PaymentFormJsonDataResponse, error) {
+// ProcessVatReports provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ProcessVatReports(ctx context.Context, in *grpc.ProcessVatReportsRequest, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3685,17 +5095,17 @@ func (_m *BillingService) PaymentFormJsonDataProcess(ctx context.Context, in *gr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentFormJsonDataResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormJsonDataRequest, ...client.CallOption) *grpc.PaymentFormJsonDataResponse); ok {
+	var r0 *grpc.EmptyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ProcessVatReportsRequest, ...client.CallOption) *grpc.EmptyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentFormJsonDataResponse)
+			r0 = ret.Get(0).(*grpc.EmptyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormJsonDataRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ProcessVatReportsRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3704,8 +5114,8 @@ func (_m *BillingService) PaymentFormJsonDataProcess(ctx context.Context, in *gr
 	return r0, r1
 }
 
-// PaymentFormLanguageChanged provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PaymentFormLanguageChanged(ctx context.Context, in *grpc.PaymentFormUserChangeLangRequest, opts ...client.CallOption) (*grpc.PaymentFormDataChangeResponse, error) {
+// PublishKeyProduct provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) PublishKeyProduct(ctx context.Context, in *grpc.PublishKeyProductRequest, opts ...client.CallOption) (*grpc.KeyProductResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3715,17 +5125,17 @@ func (_m *BillingService) PaymentFormLanguageChanged(ctx context.Context, in *gr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentFormDataChangeResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormUserChangeLangRequest, ...client.CallOption) *grpc.PaymentFormDataChangeResponse); ok {
+	var r0 *grpc.KeyProductResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PublishKeyProductRequest, ...client.CallOption) *grpc.KeyProductResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentFormDataChangeResponse)
+			r0 = ret.Get(0).(*grpc.KeyProductResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormUserChangeLangRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PublishKeyProductRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3734,8 +5144,8 @@ func (_m *BillingService) PaymentFormLanguageChanged(ctx context.Context, in *gr
 	return r0, r1
 }
 
-// PaymentFormPaymentAccountChanged provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PaymentFormPaymentAccountChanged(ctx context.Context, in *grpc.PaymentFormUserChangePaymentAccountRequest, opts ...client.CallOption) (*grpc.PaymentFormDataChangeResponse, error) {
+// ResendWebhookDelivery provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ResendWebhookDelivery(ctx context.Context, in *grpc.WebhookDeliveryRequest, opts ...client.CallOption) (*grpc.WebhookDeliveryResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3745,17 +5155,17 @@ func (_m *BillingService) PaymentFormPaymentAccountChanged(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentFormDataChangeResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormUserChangePaymentAccountRequest, ...client.CallOption) *grpc.PaymentFormDataChangeResponse); ok {
+	var r0 *grpc.WebhookDeliveryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.WebhookDeliveryRequest, ...client.CallOption) *grpc.WebhookDeliveryResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentFormDataChangeResponse)
+			r0 = ret.Get(0).(*grpc.WebhookDeliveryResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormUserChangePaymentAccountRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.WebhookDeliveryRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3764,8 +5174,8 @@ func (_m *BillingService) PaymentFormPaymentAccountChanged(ctx context.Context,
 	return r0, r1
 }
 
-// PaymentFormPlatformChanged provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PaymentFormPlatformChanged(ctx context.Context, in *grpc.PaymentFormUserChangePlatformRequest, opts ...client.CallOption) (*grpc.EmptyResponseWithStatus, error) {
+// ReserveKeyForOrder provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ReserveKeyForOrder(ctx context.Context, in *grpc.PlatformKeyReserveRequest, opts ...client.CallOption) (*grpc.PlatformKeyReserveResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3775,17 +5185,17 @@ func (_m *BillingService) PaymentFormPlatformChanged(ctx context.Context, in *gr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponseWithStatus
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PaymentFormUserChangePlatformRequest, ...client.CallOption) *grpc.EmptyResponseWithStatus); ok {
+	var r0 *grpc.PlatformKeyReserveResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PlatformKeyReserveRequest, ...client.CallOption) *grpc.PlatformKeyReserveResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponseWithStatus)
+			r0 = ret.Get(0).(*grpc.PlatformKeyReserveResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PaymentFormUserChangePlatformRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PlatformKeyReserveRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3794,8 +5204,8 @@ func (_m *BillingService) PaymentFormPlatformChanged(ctx context.Context, in *gr
 	return r0, r1
 }
 
-// PayoutDocumentPdfUploaded provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PayoutDocumentPdfUploaded(ctx context.Context, in *grpc.PayoutDocumentPdfUploadedRequest, opts ...client.CallOption) (*grpc.PayoutDocumentPdfUploadedResponse, error) {
+// ResolveReconciliationLine provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ResolveReconciliationLine(ctx context.Context, in *grpc.ResolveReconciliationLineRequest, opts ...client.CallOption) (*grpc.ReconciliationLineResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3805,17 +5215,17 @@ func (_m *BillingService) PayoutDocumentPdfUploaded(ctx context.Context, in *grp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PayoutDocumentPdfUploadedResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PayoutDocumentPdfUploadedRequest, ...client.CallOption) *grpc.PayoutDocumentPdfUploadedResponse); ok {
+	var r0 *grpc.ReconciliationLineResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ResolveReconciliationLineRequest, ...client.CallOption) *grpc.ReconciliationLineResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PayoutDocumentPdfUploadedResponse)
+			r0 = ret.Get(0).(*grpc.ReconciliationLineResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PayoutDocumentPdfUploadedRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ResolveReconciliationLineRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3824,8 +5234,8 @@ func (_m *BillingService) PayoutDocumentPdfUploaded(ctx context.Context, in *grp
 	return r0, r1
 }
 
-// ProcessBillingAddress provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ProcessBillingAddress(ctx context.Context, in *grpc.ProcessBillingAddressRequest, opts ...client.CallOption) (*grpc.ProcessBillingAddressResponse, error) {
+// ResolveRoyaltyReportDispute provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ResolveRoyaltyReportDispute(ctx context.Context, in *grpc.ResolveRoyaltyReportDisputeRequest, opts ...client.CallOption) (*grpc.RoyaltyReportDisputeResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3835,17 +5245,17 @@ func (_m *BillingService) ProcessBillingAddress(ctx context.Context, in *grpc.Pr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.ProcessBillingAddressResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ProcessBillingAddressRequest, ...client.CallOption) *grpc.ProcessBillingAddressResponse); ok {
+	var r0 *grpc.RoyaltyReportDisputeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ResolveRoyaltyReportDisputeRequest, ...client.CallOption) *grpc.RoyaltyReportDisputeResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.ProcessBillingAddressResponse)
+			r0 = ret.Get(0).(*grpc.RoyaltyReportDisputeResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ProcessBillingAddressRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ResolveRoyaltyReportDisputeRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3854,8 +5264,8 @@ func (_m *BillingService) ProcessBillingAddress(ctx context.Context, in *grpc.Pr
 	return r0, r1
 }
 
-// ProcessRefundCallback provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ProcessRefundCallback(ctx context.Context, in *grpc.CallbackRequest, opts ...client.CallOption) (*grpc.PaymentNotifyResponse, error) {
+// ResumeSubscription provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ResumeSubscription(ctx context.Context, in *grpc.SubscriptionRequest, opts ...client.CallOption) (*grpc.SubscriptionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3865,17 +5275,17 @@ func (_m *BillingService) ProcessRefundCallback(ctx context.Context, in *grpc.Ca
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PaymentNotifyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.CallbackRequest, ...client.CallOption) *grpc.PaymentNotifyResponse); ok {
+	var r0 *grpc.SubscriptionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) *grpc.SubscriptionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PaymentNotifyResponse)
+			r0 = ret.Get(0).(*grpc.SubscriptionResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.CallbackRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.SubscriptionRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3884,8 +5294,8 @@ func (_m *BillingService) ProcessRefundCallback(ctx context.Context, in *grpc.Ca
 	return r0, r1
 }
 
-// ProcessVatReports provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ProcessVatReports(ctx context.Context, in *grpc.ProcessVatReportsRequest, opts ...client.CallOption) (*grpc.EmptyResponse, error) {
+// RevokePlatformKeys provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) RevokePlatformKeys(ctx context.Context, in *grpc.ManagePlatformKeysRequest, opts ...client.CallOption) (*grpc.ManagePlatformKeysResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3895,17 +5305,17 @@ func (_m *BillingService) ProcessVatReports(ctx context.Context, in *grpc.Proces
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.EmptyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ProcessVatReportsRequest, ...client.CallOption) *grpc.EmptyResponse); ok {
+	var r0 *grpc.ManagePlatformKeysResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ManagePlatformKeysRequest, ...client.CallOption) *grpc.ManagePlatformKeysResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.EmptyResponse)
+			r0 = ret.Get(0).(*grpc.ManagePlatformKeysResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ProcessVatReportsRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ManagePlatformKeysRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3914,8 +5324,8 @@ func (_m *BillingService) ProcessVatReports(ctx context.Context, in *grpc.Proces
 	return r0, r1
 }
 
-// PublishKeyProduct provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) PublishKeyProduct(ctx context.Context, in *grpc.PublishKeyProductRequest, opts ...client.CallOption) (*grpc.KeyProductResponse, error) {
+// RotateKeysEncryptionKey provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) RotateKeysEncryptionKey(ctx context.Context, in *grpc.EmptyRequest, opts ...client.CallOption) (*grpc.RotateKeysEncryptionKeyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3925,17 +5335,17 @@ func (_m *BillingService) PublishKeyProduct(ctx context.Context, in *grpc.Publis
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.KeyProductResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PublishKeyProductRequest, ...client.CallOption) *grpc.KeyProductResponse); ok {
+	var r0 *grpc.RotateKeysEncryptionKeyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) *grpc.RotateKeysEncryptionKeyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.KeyProductResponse)
+			r0 = ret.Get(0).(*grpc.RotateKeysEncryptionKeyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PublishKeyProductRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.EmptyRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3944,8 +5354,8 @@ func (_m *BillingService) PublishKeyProduct(ctx context.Context, in *grpc.Publis
 	return r0, r1
 }
 
-// ReserveKeyForOrder provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) ReserveKeyForOrder(ctx context.Context, in *grpc.PlatformKeyReserveRequest, opts ...client.CallOption) (*grpc.PlatformKeyReserveResponse, error) {
+// RoyaltyReportPdfUploaded provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) RoyaltyReportPdfUploaded(ctx context.Context, in *grpc.RoyaltyReportPdfUploadedRequest, opts ...client.CallOption) (*grpc.RoyaltyReportPdfUploadedResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3955,17 +5365,17 @@ func (_m *BillingService) ReserveKeyForOrder(ctx context.Context, in *grpc.Platf
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.PlatformKeyReserveResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.PlatformKeyReserveRequest, ...client.CallOption) *grpc.PlatformKeyReserveResponse); ok {
+	var r0 *grpc.RoyaltyReportPdfUploadedResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RoyaltyReportPdfUploadedRequest, ...client.CallOption) *grpc.RoyaltyReportPdfUploadedResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.PlatformKeyReserveResponse)
+			r0 = ret.Get(0).(*grpc.RoyaltyReportPdfUploadedResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.PlatformKeyReserveRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RoyaltyReportPdfUploadedRequest, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -3974,8 +5384,8 @@ func (_m *BillingService) ReserveKeyForOrder(ctx context.Context, in *grpc.Platf
 	return r0, r1
 }
 
-// RoyaltyReportPdfUploaded provides a mock function with given fields: ctx, in, opts
-func (_m *BillingService) RoyaltyReportPdfUploaded(ctx context.Context, in *grpc.RoyaltyReportPdfUploadedRequest, opts ...client.CallOption) (*grpc.RoyaltyReportPdfUploadedResponse, error) {
+// ScheduleCountryVatSettings provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) ScheduleCountryVatSettings(ctx context.Context, in *billing.CountryVatSettings, opts ...client.CallOption) (*grpc.CountryVatSettingsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -3985,17 +5395,17 @@ func (_m *BillingService) RoyaltyReportPdfUploaded(ctx context.Context, in *grpc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *grpc.RoyaltyReportPdfUploadedResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *grpc.RoyaltyReportPdfUploadedRequest, ...client.CallOption) *grpc.RoyaltyReportPdfUploadedResponse); ok {
+	var r0 *grpc.CountryVatSettingsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.CountryVatSettings, ...client.CallOption) *grpc.CountryVatSettingsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*grpc.RoyaltyReportPdfUploadedResponse)
+			r0 = ret.Get(0).(*grpc.CountryVatSettingsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *grpc.RoyaltyReportPdfUploadedRequest, ...client.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.CountryVatSettings, ...client.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -4244,6 +5654,36 @@ func (_m *BillingService) SetUserNotifySales(ctx context.Context, in *grpc.SetUs
 	return r0, r1
 }
 
+// SubmitChargebackRepresentment provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) SubmitChargebackRepresentment(ctx context.Context, in *grpc.ChargebackRequest, opts ...client.CallOption) (*grpc.ChargebackResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ChargebackResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.ChargebackRequest, ...client.CallOption) *grpc.ChargebackResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ChargebackResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.ChargebackRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnPublishKeyProduct provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) UnPublishKeyProduct(ctx context.Context, in *grpc.UnPublishKeyProductRequest, opts ...client.CallOption) (*grpc.KeyProductResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -4484,6 +5924,36 @@ func (_m *BillingService) UpdateVatReportStatus(ctx context.Context, in *grpc.Up
 	return r0, r1
 }
 
+// UploadChargebackEvidence provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) UploadChargebackEvidence(ctx context.Context, in *grpc.UploadChargebackEvidenceRequest, opts ...client.CallOption) (*grpc.ChargebackResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.ChargebackResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.UploadChargebackEvidenceRequest, ...client.CallOption) *grpc.ChargebackResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.ChargebackResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.UploadChargebackEvidenceRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UploadKeysFile provides a mock function with given fields: ctx, in, opts
 func (_m *BillingService) UploadKeysFile(ctx context.Context, in *grpc.PlatformKeysFileRequest, opts ...client.CallOption) (*grpc.PlatformKeysFileResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -4513,3 +5983,33 @@ func (_m *BillingService) UploadKeysFile(ctx context.Context, in *grpc.PlatformK
 
 	return r0, r1
 }
+
+// VoidOrder provides a mock function with given fields: ctx, in, opts
+func (_m *BillingService) VoidOrder(ctx context.Context, in *grpc.OrderAuthorizationRequest, opts ...client.CallOption) (*grpc.OrderAuthorizationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *grpc.OrderAuthorizationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *grpc.OrderAuthorizationRequest, ...client.CallOption) *grpc.OrderAuthorizationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc.OrderAuthorizationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *grpc.OrderAuthorizationRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
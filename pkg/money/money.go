@@ -0,0 +1,274 @@
+// Package money implements exact money amounts stored as integer count of currency minor units.
+// Amounts in the rest of the system are kept as float64 for API compatibility, so conversion between
+// float64 and minor units always goes through the shortest decimal representation of the float to
+// avoid binary rounding artifacts like 1.005 -> 1.00499999.
+package money
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds half away from zero (commercial rounding), default for all calculations
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds half to the nearest even digit (bankers rounding)
+	RoundHalfEven
+	// RoundDown truncates towards zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+
+	defaultScale = 2
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("money: currencies of operands are different")
+
+	// currencyScales contains ISO 4217 exponents of currencies which have minor unit different from cents
+	currencyScales = map[string]int{
+		"BIF": 0,
+		"CLP": 0,
+		"DJF": 0,
+		"GNF": 0,
+		"ISK": 0,
+		"JPY": 0,
+		"KMF": 0,
+		"KRW": 0,
+		"PYG": 0,
+		"RWF": 0,
+		"UGX": 0,
+		"UYI": 0,
+		"VND": 0,
+		"VUV": 0,
+		"XAF": 0,
+		"XOF": 0,
+		"XPF": 0,
+		"BHD": 3,
+		"IQD": 3,
+		"JOD": 3,
+		"KWD": 3,
+		"LYD": 3,
+		"OMR": 3,
+		"TND": 3,
+		"CLF": 4,
+		"UYW": 4,
+	}
+)
+
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// Scale returns count of digits after decimal point in amounts of currency
+func Scale(currency string) int {
+	if scale, ok := currencyScales[strings.ToUpper(currency)]; ok {
+		return scale
+	}
+
+	return defaultScale
+}
+
+// New creates money from amount in minor units of currency
+func New(amount int64, currency string) Money {
+	return Money{amount: amount, currency: strings.ToUpper(currency)}
+}
+
+// FromFloat creates money from amount in major units of currency rounding it to currency scale
+func FromFloat(amount float64, currency string, mode RoundingMode) Money {
+	return New(roundRat(floatToRat(amount), Scale(currency), mode), currency)
+}
+
+// ToMinor converts amount in major units to minor units of currency with half up rounding
+func ToMinor(amount float64, currency string) int64 {
+	return FromFloat(amount, currency, RoundHalfUp).Amount()
+}
+
+// FromMinor converts amount in minor units to major units of currency
+func FromMinor(amount int64, currency string) float64 {
+	return New(amount, currency).Float64()
+}
+
+// Round rounds amount in major units to scale of currency with half up rounding
+func Round(amount float64, currency string) float64 {
+	return FromFloat(amount, currency, RoundHalfUp).Float64()
+}
+
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+func (m Money) Currency() string {
+	return m.currency
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Float64 returns amount in major units of currency
+func (m Money) Float64() float64 {
+	scale := Scale(m.currency)
+
+	if scale == 0 {
+		return float64(m.amount)
+	}
+
+	f, _ := strconv.ParseFloat(m.String(), 64)
+	return f
+}
+
+// String returns amount in major units formatted with currency scale, for example "10.05"
+func (m Money) String() string {
+	scale := Scale(m.currency)
+	amount := m.amount
+	sign := ""
+
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	str := strconv.FormatInt(amount, 10)
+
+	if scale == 0 {
+		return sign + str
+	}
+
+	if len(str) <= scale {
+		str = strings.Repeat("0", scale-len(str)+1) + str
+	}
+
+	return sign + str[:len(str)-scale] + "." + str[len(str)-scale:]
+}
+
+func (m Money) Neg() Money {
+	return New(-m.amount, m.currency)
+}
+
+func (m Money) Add(o Money) (Money, error) {
+	if m.currency != o.currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	return New(m.amount+o.amount, m.currency), nil
+}
+
+func (m Money) Sub(o Money) (Money, error) {
+	if m.currency != o.currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	return New(m.amount-o.amount, m.currency), nil
+}
+
+// Mul multiplies money to factor (percent of fee, tax rate, etc) and rounds result to currency scale
+func (m Money) Mul(factor float64, mode RoundingMode) Money {
+	r := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), floatToRat(factor))
+	return New(roundRat(r, 0, mode), m.currency)
+}
+
+// Convert exchanges money to other currency by rate and rounds result to scale of target currency
+func (m Money) Convert(currency string, rate float64, mode RoundingMode) Money {
+	r := new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(Scale(m.currency)))
+	r.Mul(r, floatToRat(rate))
+
+	return New(roundRat(r, Scale(currency), mode), currency)
+}
+
+// Allocate splits money to parts proportional to ratios without loss of minor units,
+// remainder is distributed one by one starting from the first part
+func (m Money) Allocate(ratios ...int64) []Money {
+	var total int64
+
+	for _, v := range ratios {
+		total += v
+	}
+
+	result := make([]Money, len(ratios))
+
+	if total == 0 {
+		for i := range result {
+			result[i] = New(0, m.currency)
+		}
+		return result
+	}
+
+	remainder := m.amount
+
+	for i, v := range ratios {
+		share := m.amount * v / total
+		result[i] = New(share, m.currency)
+		remainder -= share
+	}
+
+	for i := 0; remainder != 0 && i < len(result); i++ {
+		if remainder > 0 {
+			result[i].amount++
+			remainder--
+		} else {
+			result[i].amount--
+			remainder++
+		}
+	}
+
+	return result
+}
+
+func floatToRat(f float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+
+	if !ok {
+		return new(big.Rat)
+	}
+
+	return r
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundRat returns r * 10^scale rounded to integer with rounding mode
+func roundRat(r *big.Rat, scale int, mode RoundingMode) int64 {
+	v := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale)))
+
+	num := v.Num()
+	den := v.Denom()
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if rem.Sign() == 0 {
+		return quo.Int64()
+	}
+
+	sign := int64(num.Sign())
+	// compare doubled remainder with denominator to find out position relative to half
+	cmp := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(den)
+	result := quo.Int64()
+
+	switch mode {
+	case RoundDown:
+		return result
+	case RoundUp:
+		return result + sign
+	case RoundHalfEven:
+		if cmp > 0 || (cmp == 0 && result%2 != 0) {
+			return result + sign
+		}
+	default:
+		if cmp >= 0 {
+			return result + sign
+		}
+	}
+
+	return result
+}
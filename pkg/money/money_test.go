@@ -0,0 +1,109 @@
+package money
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type MoneyTestSuite struct {
+	suite.Suite
+}
+
+func Test_Money(t *testing.T) {
+	suite.Run(t, new(MoneyTestSuite))
+}
+
+func (suite *MoneyTestSuite) TestMoney_Scale() {
+	assert.Equal(suite.T(), 2, Scale("USD"))
+	assert.Equal(suite.T(), 2, Scale("rub"))
+	assert.Equal(suite.T(), 0, Scale("JPY"))
+	assert.Equal(suite.T(), 3, Scale("BHD"))
+	assert.Equal(suite.T(), 2, Scale("unknown"))
+}
+
+func (suite *MoneyTestSuite) TestMoney_FromFloat_RoundingModes() {
+	assert.Equal(suite.T(), int64(101), FromFloat(1.005, "USD", RoundHalfUp).Amount())
+	assert.Equal(suite.T(), int64(100), FromFloat(1.005, "USD", RoundHalfEven).Amount())
+	assert.Equal(suite.T(), int64(102), FromFloat(1.015, "USD", RoundHalfEven).Amount())
+	assert.Equal(suite.T(), int64(100), FromFloat(1.009, "USD", RoundDown).Amount())
+	assert.Equal(suite.T(), int64(101), FromFloat(1.001, "USD", RoundUp).Amount())
+	assert.Equal(suite.T(), int64(-101), FromFloat(-1.005, "USD", RoundHalfUp).Amount())
+	assert.Equal(suite.T(), int64(-101), FromFloat(-1.001, "USD", RoundUp).Amount())
+
+	assert.Equal(suite.T(), int64(1235), FromFloat(1234.5, "JPY", RoundHalfUp).Amount())
+	assert.Equal(suite.T(), int64(1234), FromFloat(1234.5, "JPY", RoundHalfEven).Amount())
+	assert.Equal(suite.T(), int64(1235), FromFloat(1.2345, "BHD", RoundHalfUp).Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_Float64() {
+	assert.Equal(suite.T(), 0.3, FromFloat(0.1+0.2, "USD", RoundHalfUp).Float64())
+	assert.Equal(suite.T(), 1.05, New(105, "USD").Float64())
+	assert.Equal(suite.T(), -0.05, New(-5, "USD").Float64())
+	assert.Equal(suite.T(), float64(105), New(105, "JPY").Float64())
+	assert.Equal(suite.T(), 0.105, New(105, "KWD").Float64())
+
+	assert.Equal(suite.T(), "0.05", New(5, "EUR").String())
+	assert.Equal(suite.T(), "-10.00", New(-1000, "EUR").String())
+	assert.Equal(suite.T(), "0.001", New(1, "BHD").String())
+}
+
+func (suite *MoneyTestSuite) TestMoney_Round() {
+	assert.Equal(suite.T(), 3.67, Round(3.672, "RUB"))
+	assert.Equal(suite.T(), 1.47, Round(1.4688, "EUR"))
+	assert.Equal(suite.T(), 0.3, Round(0.1+0.2, "USD"))
+	assert.Equal(suite.T(), float64(1469), Round(1468.8, "JPY"))
+	assert.Equal(suite.T(), int64(30), ToMinor(0.1+0.2, "USD"))
+	assert.Equal(suite.T(), 0.3, FromMinor(30, "USD"))
+}
+
+func (suite *MoneyTestSuite) TestMoney_AddSub() {
+	m, err := New(1000, "USD").Add(New(5, "USD"))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1005), m.Amount())
+
+	m, err = m.Sub(New(2005, "USD"))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(-1000), m.Amount())
+	assert.True(suite.T(), m.IsNegative())
+	assert.Equal(suite.T(), int64(1000), m.Neg().Amount())
+
+	_, err = m.Add(New(1, "EUR"))
+	assert.Equal(suite.T(), ErrCurrencyMismatch, err)
+
+	_, err = m.Sub(New(1, "EUR"))
+	assert.Equal(suite.T(), ErrCurrencyMismatch, err)
+}
+
+func (suite *MoneyTestSuite) TestMoney_Mul() {
+	assert.Equal(suite.T(), int64(367), New(12000, "RUB").Mul(0.0306, RoundHalfUp).Amount())
+	assert.Equal(suite.T(), int64(2000), New(12000, "RUB").Mul(1.0/6.0, RoundHalfUp).Amount())
+	assert.Equal(suite.T(), int64(1), New(25, "USD").Mul(0.05, RoundHalfUp).Amount())
+	assert.Equal(suite.T(), int64(1), New(25, "USD").Mul(0.05, RoundDown).Amount())
+	assert.Equal(suite.T(), int64(2), New(25, "USD").Mul(0.07, RoundUp).Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_Convert() {
+	m := New(10000, "USD").Convert("JPY", 108.555, RoundHalfUp)
+	assert.Equal(suite.T(), "JPY", m.Currency())
+	assert.Equal(suite.T(), int64(10856), m.Amount())
+
+	m = New(10856, "JPY").Convert("BHD", 0.003476, RoundHalfUp)
+	assert.Equal(suite.T(), int64(37735), m.Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_Allocate() {
+	parts := New(100, "USD").Allocate(1, 1, 1)
+	assert.Len(suite.T(), parts, 3)
+	assert.Equal(suite.T(), int64(34), parts[0].Amount())
+	assert.Equal(suite.T(), int64(33), parts[1].Amount())
+	assert.Equal(suite.T(), int64(33), parts[2].Amount())
+
+	parts = New(-100, "USD").Allocate(1, 1, 1)
+	assert.Equal(suite.T(), int64(-34), parts[0].Amount())
+	assert.Equal(suite.T(), int64(-33), parts[2].Amount())
+
+	parts = New(100, "USD").Allocate(0, 0)
+	assert.Equal(suite.T(), int64(0), parts[0].Amount())
+	assert.Equal(suite.T(), int64(0), parts[1].Amount())
+}
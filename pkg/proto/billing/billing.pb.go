@@ -141,7 +141,9 @@ type OrderCreateRequest struct {
 	//@inject_tag: bson:"utm_medium" json:"utm_medium"
 	UtmMedium string `protobuf:"bytes,37,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium" bson:"utm_medium"`
 	//@inject_tag: bson:"utm_campaign" json:"utm_campaign"
-	UtmCampaign          string   `protobuf:"bytes,38,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign" bson:"utm_campaign"`
+	UtmCampaign string `protobuf:"bytes,38,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign" bson:"utm_campaign"`
+	// @inject_tag: json:"is_authorization_only"
+	IsAuthorizationOnly  bool     `protobuf:"varint,39,opt,name=is_authorization_only,json=isAuthorizationOnly,proto3" json:"is_authorization_only"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -438,6 +440,13 @@ func (m *OrderCreateRequest) GetUtmCampaign() string {
 	return ""
 }
 
+func (m *OrderCreateRequest) GetIsAuthorizationOnly() bool {
+	if m != nil {
+		return m.IsAuthorizationOnly
+	}
+	return false
+}
+
 type Project struct {
 	// @inject_tag: json:"id" validate:"omitempty,hexadecimal,len=24"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24"`
@@ -1813,10 +1822,14 @@ type Merchant struct {
 	// @inject_tag: json:"-"
 	Tariff *MerchantTariff `protobuf:"bytes,53,opt,name=tariff,proto3" json:"-"`
 	// @inject_tag: json:"manual_payouts_enabled"
-	ManualPayoutsEnabled bool     `protobuf:"varint,54,opt,name=manual_payouts_enabled,json=manualPayoutsEnabled,proto3" json:"manual_payouts_enabled"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	ManualPayoutsEnabled bool `protobuf:"varint,54,opt,name=manual_payouts_enabled,json=manualPayoutsEnabled,proto3" json:"manual_payouts_enabled"`
+	// @inject_tag: json:"payout_currencies"
+	PayoutCurrencies []string `protobuf:"bytes,55,rep,name=payout_currencies,json=payoutCurrencies,proto3" json:"payout_currencies"`
+	// @inject_tag: json:"payout_currency_rules"
+	PayoutCurrencyRules  []*MerchantPayoutCurrencyRule `protobuf:"bytes,56,rep,name=payout_currency_rules,json=payoutCurrencyRules,proto3" json:"payout_currency_rules"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *Merchant) Reset()         { *m = Merchant{} }
@@ -2117,6 +2130,78 @@ func (m *Merchant) GetManualPayoutsEnabled() bool {
 	return false
 }
 
+func (m *Merchant) GetPayoutCurrencies() []string {
+	if m != nil {
+		return m.PayoutCurrencies
+	}
+	return nil
+}
+
+func (m *Merchant) GetPayoutCurrencyRules() []*MerchantPayoutCurrencyRule {
+	if m != nil {
+		return m.PayoutCurrencyRules
+	}
+	return nil
+}
+
+type MerchantPayoutCurrencyRule struct {
+	// @inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"countries" validate:"omitempty,dive,alpha,len=2"
+	Countries []string `protobuf:"bytes,2,rep,name=countries,proto3" json:"countries" validate:"omitempty,dive,alpha,len=2"`
+	// @inject_tag: json:"order_currencies" validate:"omitempty,dive,alpha,len=3"
+	OrderCurrencies      []string `protobuf:"bytes,3,rep,name=order_currencies,json=orderCurrencies,proto3" json:"order_currencies" validate:"omitempty,dive,alpha,len=3"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantPayoutCurrencyRule) Reset()         { *m = MerchantPayoutCurrencyRule{} }
+func (m *MerchantPayoutCurrencyRule) String() string { return proto.CompactTextString(m) }
+func (*MerchantPayoutCurrencyRule) ProtoMessage()    {}
+func (*MerchantPayoutCurrencyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{16}
+}
+
+func (m *MerchantPayoutCurrencyRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantPayoutCurrencyRule.Unmarshal(m, b)
+}
+func (m *MerchantPayoutCurrencyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantPayoutCurrencyRule.Marshal(b, m, deterministic)
+}
+func (m *MerchantPayoutCurrencyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPayoutCurrencyRule.Merge(m, src)
+}
+func (m *MerchantPayoutCurrencyRule) XXX_Size() int {
+	return xxx_messageInfo_MerchantPayoutCurrencyRule.Size(m)
+}
+func (m *MerchantPayoutCurrencyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantPayoutCurrencyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantPayoutCurrencyRule proto.InternalMessageInfo
+
+func (m *MerchantPayoutCurrencyRule) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *MerchantPayoutCurrencyRule) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *MerchantPayoutCurrencyRule) GetOrderCurrencies() []string {
+	if m != nil {
+		return m.OrderCurrencies
+	}
+	return nil
+}
+
 type SystemNotificationStatuses struct {
 	From                 int32    `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
 	To                   int32    `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
@@ -2129,7 +2214,7 @@ func (m *SystemNotificationStatuses) Reset()         { *m = SystemNotificationSt
 func (m *SystemNotificationStatuses) String() string { return proto.CompactTextString(m) }
 func (*SystemNotificationStatuses) ProtoMessage()    {}
 func (*SystemNotificationStatuses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{16}
+	return fileDescriptor_958db8ba491a6b57, []int{17}
 }
 
 func (m *SystemNotificationStatuses) XXX_Unmarshal(b []byte) error {
@@ -2188,7 +2273,7 @@ func (m *Notification) Reset()         { *m = Notification{} }
 func (m *Notification) String() string { return proto.CompactTextString(m) }
 func (*Notification) ProtoMessage()    {}
 func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{17}
+	return fileDescriptor_958db8ba491a6b57, []int{18}
 }
 
 func (m *Notification) XXX_Unmarshal(b []byte) error {
@@ -2285,7 +2370,7 @@ func (m *OrderPlatformFee) Reset()         { *m = OrderPlatformFee{} }
 func (m *OrderPlatformFee) String() string { return proto.CompactTextString(m) }
 func (*OrderPlatformFee) ProtoMessage()    {}
 func (*OrderPlatformFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{18}
+	return fileDescriptor_958db8ba491a6b57, []int{19}
 }
 
 func (m *OrderPlatformFee) XXX_Unmarshal(b []byte) error {
@@ -2341,7 +2426,7 @@ func (m *OrderTax) Reset()         { *m = OrderTax{} }
 func (m *OrderTax) String() string { return proto.CompactTextString(m) }
 func (*OrderTax) ProtoMessage()    {}
 func (*OrderTax) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{19}
+	return fileDescriptor_958db8ba491a6b57, []int{20}
 }
 
 func (m *OrderTax) XXX_Unmarshal(b []byte) error {
@@ -2406,7 +2491,7 @@ func (m *OrderBillingAddress) Reset()         { *m = OrderBillingAddress{} }
 func (m *OrderBillingAddress) String() string { return proto.CompactTextString(m) }
 func (*OrderBillingAddress) ProtoMessage()    {}
 func (*OrderBillingAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{20}
+	return fileDescriptor_958db8ba491a6b57, []int{21}
 }
 
 func (m *OrderBillingAddress) XXX_Unmarshal(b []byte) error {
@@ -2495,7 +2580,7 @@ func (m *OrderUser) Reset()         { *m = OrderUser{} }
 func (m *OrderUser) String() string { return proto.CompactTextString(m) }
 func (*OrderUser) ProtoMessage()    {}
 func (*OrderUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{21}
+	return fileDescriptor_958db8ba491a6b57, []int{22}
 }
 
 func (m *OrderUser) XXX_Unmarshal(b []byte) error {
@@ -2739,7 +2824,23 @@ type Order struct {
 	// @inject_tag: json:"-"
 	IsKeyProductNotified bool `protobuf:"varint,72,opt,name=is_key_product_notified,json=isKeyProductNotified,proto3" json:"-"`
 	// @inject_tag: json:"receipt_id" bson:"receipt_id"
-	ReceiptId            string   `protobuf:"bytes,73,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id" bson:"receipt_id"`
+	ReceiptId string `protobuf:"bytes,73,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id" bson:"receipt_id"`
+	// @inject_tag: json:"virtual_currency_amount" bson:"virtual_currency_amount"
+	VirtualCurrencyAmount float64 `protobuf:"fixed64,74,opt,name=virtual_currency_amount,json=virtualCurrencyAmount,proto3" json:"virtual_currency_amount" bson:"virtual_currency_amount"`
+	// @inject_tag: json:"-" bson:"payment_route_attempts"
+	PaymentRouteAttempts []*OrderPaymentRouteAttempt `protobuf:"bytes,75,rep,name=payment_route_attempts,json=paymentRouteAttempts,proto3" json:"-" bson:"payment_route_attempts"`
+	// @inject_tag: json:"is_authorization_only" bson:"is_authorization_only"
+	IsAuthorizationOnly bool `protobuf:"varint,76,opt,name=is_authorization_only,json=isAuthorizationOnly,proto3" json:"is_authorization_only" bson:"is_authorization_only"`
+	// @inject_tag: json:"authorized_at" bson:"authorized_at"
+	AuthorizedAt *timestamp.Timestamp `protobuf:"bytes,77,opt,name=authorized_at,json=authorizedAt,proto3" json:"authorized_at" bson:"authorized_at"`
+	// @inject_tag: json:"fraud_check" bson:"fraud_check"
+	FraudCheck *OrderFraudCheck `protobuf:"bytes,78,opt,name=fraud_check,json=fraudCheck,proto3" json:"fraud_check" bson:"fraud_check"`
+	// @inject_tag: json:"discount_percent" bson:"discount_percent"
+	DiscountPercent float64 `protobuf:"fixed64,79,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent" bson:"discount_percent"`
+	// @inject_tag: json:"promo_code" bson:"promo_code"
+	PromoCode *OrderPromoCode `protobuf:"bytes,80,opt,name=promo_code,json=promoCode,proto3" json:"promo_code" bson:"promo_code"`
+	// @inject_tag: json:"discount_amount" bson:"discount_amount"
+	DiscountAmount       float64  `protobuf:"fixed64,81,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount" bson:"discount_amount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -2749,7 +2850,7 @@ func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{22}
+	return fileDescriptor_958db8ba491a6b57, []int{23}
 }
 
 func (m *Order) XXX_Unmarshal(b []byte) error {
@@ -3183,6 +3284,62 @@ func (m *Order) GetReceiptId() string {
 	return ""
 }
 
+func (m *Order) GetVirtualCurrencyAmount() float64 {
+	if m != nil {
+		return m.VirtualCurrencyAmount
+	}
+	return 0
+}
+
+func (m *Order) GetPaymentRouteAttempts() []*OrderPaymentRouteAttempt {
+	if m != nil {
+		return m.PaymentRouteAttempts
+	}
+	return nil
+}
+
+func (m *Order) GetIsAuthorizationOnly() bool {
+	if m != nil {
+		return m.IsAuthorizationOnly
+	}
+	return false
+}
+
+func (m *Order) GetAuthorizedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.AuthorizedAt
+	}
+	return nil
+}
+
+func (m *Order) GetFraudCheck() *OrderFraudCheck {
+	if m != nil {
+		return m.FraudCheck
+	}
+	return nil
+}
+
+func (m *Order) GetDiscountPercent() float64 {
+	if m != nil {
+		return m.DiscountPercent
+	}
+	return 0
+}
+
+func (m *Order) GetPromoCode() *OrderPromoCode {
+	if m != nil {
+		return m.PromoCode
+	}
+	return nil
+}
+
+func (m *Order) GetDiscountAmount() float64 {
+	if m != nil {
+		return m.DiscountAmount
+	}
+	return 0
+}
+
 type CountryRestriction struct {
 	//@inject_tag: json:"iso_code_a2" bson:"iso_code_a2" validate:"alpha,len=2"
 	IsoCodeA2 string `protobuf:"bytes,1,opt,name=iso_code_a2,json=isoCodeA2,proto3" json:"iso_code_a2" bson:"iso_code_a2" validate:"alpha,len=2"`
@@ -3199,7 +3356,7 @@ func (m *CountryRestriction) Reset()         { *m = CountryRestriction{} }
 func (m *CountryRestriction) String() string { return proto.CompactTextString(m) }
 func (*CountryRestriction) ProtoMessage()    {}
 func (*CountryRestriction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{23}
+	return fileDescriptor_958db8ba491a6b57, []int{24}
 }
 
 func (m *CountryRestriction) XXX_Unmarshal(b []byte) error {
@@ -3269,7 +3426,9 @@ type OrderItem struct {
 	//@inject_tag: validate:"omitempty,min=3" json:"platform_id" bson:"platform_id"
 	PlatformId string `protobuf:"bytes,13,opt,name=platform_id,json=platformId,proto3" json:"platform_id" validate:"omitempty,min=3" bson:"platform_id"`
 	//@inject_tag: validate:"omitempty,min=5" json:"code" bson:"code"
-	Code                 string   `protobuf:"bytes,14,opt,name=code,proto3" json:"code" validate:"omitempty,min=5" bson:"code"`
+	Code string `protobuf:"bytes,14,opt,name=code,proto3" json:"code" validate:"omitempty,min=5" bson:"code"`
+	//@inject_tag: json:"discount" bson:"discount"
+	Discount             float64  `protobuf:"fixed64,15,opt,name=discount,proto3" json:"discount" bson:"discount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3279,7 +3438,7 @@ func (m *OrderItem) Reset()         { *m = OrderItem{} }
 func (m *OrderItem) String() string { return proto.CompactTextString(m) }
 func (*OrderItem) ProtoMessage()    {}
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{24}
+	return fileDescriptor_958db8ba491a6b57, []int{25}
 }
 
 func (m *OrderItem) XXX_Unmarshal(b []byte) error {
@@ -3398,6 +3557,116 @@ func (m *OrderItem) GetCode() string {
 	return ""
 }
 
+func (m *OrderItem) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+type OrderPromoCode struct {
+	//@inject_tag: json:"id" bson:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"id"`
+	//@inject_tag: json:"code" bson:"code"
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" bson:"code"`
+	//@inject_tag: json:"type" bson:"type"
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type" bson:"type"`
+	//@inject_tag: json:"percent" bson:"percent"
+	Percent float64 `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent" bson:"percent"`
+	//@inject_tag: json:"amounts" bson:"amounts"
+	Amounts map[string]float64 `protobuf:"bytes,5,rep,name=amounts,proto3" json:"amounts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3" bson:"amounts"`
+	//@inject_tag: json:"product_ids" bson:"product_ids"
+	ProductIds []string `protobuf:"bytes,6,rep,name=product_ids,json=productIds,proto3" json:"product_ids" bson:"product_ids"`
+	//@inject_tag: json:"platform_ids" bson:"platform_ids"
+	PlatformIds []string `protobuf:"bytes,7,rep,name=platform_ids,json=platformIds,proto3" json:"platform_ids" bson:"platform_ids"`
+	//@inject_tag: json:"discount" bson:"discount"
+	Discount             float64  `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount" bson:"discount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderPromoCode) Reset()         { *m = OrderPromoCode{} }
+func (m *OrderPromoCode) String() string { return proto.CompactTextString(m) }
+func (*OrderPromoCode) ProtoMessage()    {}
+func (*OrderPromoCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{26}
+}
+
+func (m *OrderPromoCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderPromoCode.Unmarshal(m, b)
+}
+func (m *OrderPromoCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderPromoCode.Marshal(b, m, deterministic)
+}
+func (m *OrderPromoCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderPromoCode.Merge(m, src)
+}
+func (m *OrderPromoCode) XXX_Size() int {
+	return xxx_messageInfo_OrderPromoCode.Size(m)
+}
+func (m *OrderPromoCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderPromoCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderPromoCode proto.InternalMessageInfo
+
+func (m *OrderPromoCode) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderPromoCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *OrderPromoCode) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OrderPromoCode) GetPercent() float64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *OrderPromoCode) GetAmounts() map[string]float64 {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+func (m *OrderPromoCode) GetProductIds() []string {
+	if m != nil {
+		return m.ProductIds
+	}
+	return nil
+}
+
+func (m *OrderPromoCode) GetPlatformIds() []string {
+	if m != nil {
+		return m.PlatformIds
+	}
+	return nil
+}
+
+func (m *OrderPromoCode) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
 type OrderPaginate struct {
 	// @inject_tag: json:"count"
 	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
@@ -3412,7 +3681,7 @@ func (m *OrderPaginate) Reset()         { *m = OrderPaginate{} }
 func (m *OrderPaginate) String() string { return proto.CompactTextString(m) }
 func (*OrderPaginate) ProtoMessage()    {}
 func (*OrderPaginate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{25}
+	return fileDescriptor_958db8ba491a6b57, []int{27}
 }
 
 func (m *OrderPaginate) XXX_Unmarshal(b []byte) error {
@@ -3479,7 +3748,7 @@ func (m *PaymentMethodOrder) Reset()         { *m = PaymentMethodOrder{} }
 func (m *PaymentMethodOrder) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodOrder) ProtoMessage()    {}
 func (*PaymentMethodOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{26}
+	return fileDescriptor_958db8ba491a6b57, []int{28}
 }
 
 func (m *PaymentMethodOrder) XXX_Unmarshal(b []byte) error {
@@ -3577,6 +3846,91 @@ func (m *PaymentMethodOrder) GetHandler() string {
 	return ""
 }
 
+type OrderPaymentRouteAttempt struct {
+	// @inject_tag: json:"payment_system_id" bson:"payment_system_id"
+	PaymentSystemId string `protobuf:"bytes,1,opt,name=payment_system_id,json=paymentSystemId,proto3" json:"payment_system_id" bson:"payment_system_id"`
+	// @inject_tag: json:"handler" bson:"handler"
+	Handler string `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler" bson:"handler"`
+	// @inject_tag: json:"priority" bson:"priority"
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority" bson:"priority"`
+	// @inject_tag: json:"status" bson:"status"
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status" bson:"status"`
+	// @inject_tag: json:"error" bson:"error"
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error" bson:"error"`
+	// @inject_tag: json:"created_at" bson:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderPaymentRouteAttempt) Reset()         { *m = OrderPaymentRouteAttempt{} }
+func (m *OrderPaymentRouteAttempt) String() string { return proto.CompactTextString(m) }
+func (*OrderPaymentRouteAttempt) ProtoMessage()    {}
+func (*OrderPaymentRouteAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{29}
+}
+
+func (m *OrderPaymentRouteAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderPaymentRouteAttempt.Unmarshal(m, b)
+}
+func (m *OrderPaymentRouteAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderPaymentRouteAttempt.Marshal(b, m, deterministic)
+}
+func (m *OrderPaymentRouteAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderPaymentRouteAttempt.Merge(m, src)
+}
+func (m *OrderPaymentRouteAttempt) XXX_Size() int {
+	return xxx_messageInfo_OrderPaymentRouteAttempt.Size(m)
+}
+func (m *OrderPaymentRouteAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderPaymentRouteAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderPaymentRouteAttempt proto.InternalMessageInfo
+
+func (m *OrderPaymentRouteAttempt) GetPaymentSystemId() string {
+	if m != nil {
+		return m.PaymentSystemId
+	}
+	return ""
+}
+
+func (m *OrderPaymentRouteAttempt) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *OrderPaymentRouteAttempt) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *OrderPaymentRouteAttempt) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderPaymentRouteAttempt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *OrderPaymentRouteAttempt) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
 type PaymentMethodParams struct {
 	// @inject_tag: bson:"currency" json:"currency" validate:"required,alpha,len=3"
 	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
@@ -3597,7 +3951,7 @@ func (m *PaymentMethodParams) Reset()         { *m = PaymentMethodParams{} }
 func (m *PaymentMethodParams) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodParams) ProtoMessage()    {}
 func (*PaymentMethodParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{27}
+	return fileDescriptor_958db8ba491a6b57, []int{30}
 }
 
 func (m *PaymentMethodParams) XXX_Unmarshal(b []byte) error {
@@ -3680,7 +4034,7 @@ func (m *PaymentSystem) Reset()         { *m = PaymentSystem{} }
 func (m *PaymentSystem) String() string { return proto.CompactTextString(m) }
 func (*PaymentSystem) ProtoMessage()    {}
 func (*PaymentSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{28}
+	return fileDescriptor_958db8ba491a6b57, []int{31}
 }
 
 func (m *PaymentSystem) XXX_Unmarshal(b []byte) error {
@@ -3790,7 +4144,7 @@ func (m *PaymentMethodCard) Reset()         { *m = PaymentMethodCard{} }
 func (m *PaymentMethodCard) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodCard) ProtoMessage()    {}
 func (*PaymentMethodCard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{29}
+	return fileDescriptor_958db8ba491a6b57, []int{32}
 }
 
 func (m *PaymentMethodCard) XXX_Unmarshal(b []byte) error {
@@ -3881,7 +4235,7 @@ func (m *PaymentMethodWallet) Reset()         { *m = PaymentMethodWallet{} }
 func (m *PaymentMethodWallet) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodWallet) ProtoMessage()    {}
 func (*PaymentMethodWallet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{30}
+	return fileDescriptor_958db8ba491a6b57, []int{33}
 }
 
 func (m *PaymentMethodWallet) XXX_Unmarshal(b []byte) error {
@@ -3930,7 +4284,7 @@ func (m *PaymentMethodCrypto) Reset()         { *m = PaymentMethodCrypto{} }
 func (m *PaymentMethodCrypto) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodCrypto) ProtoMessage()    {}
 func (*PaymentMethodCrypto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{31}
+	return fileDescriptor_958db8ba491a6b57, []int{34}
 }
 
 func (m *PaymentMethodCrypto) XXX_Unmarshal(b []byte) error {
@@ -3985,7 +4339,7 @@ func (m *ProjectPaymentMethod) Reset()         { *m = ProjectPaymentMethod{} }
 func (m *ProjectPaymentMethod) String() string { return proto.CompactTextString(m) }
 func (*ProjectPaymentMethod) ProtoMessage()    {}
 func (*ProjectPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{32}
+	return fileDescriptor_958db8ba491a6b57, []int{35}
 }
 
 func (m *ProjectPaymentMethod) XXX_Unmarshal(b []byte) error {
@@ -4069,17 +4423,19 @@ type PaymentMethod struct {
 	// @inject_tag: bson:"created_at" json:"max_payment_amount" validate:"omitempty"
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"max_payment_amount" bson:"created_at" validate:"omitempty"`
 	// @inject_tag: bson:"updated_at" json:"max_payment_amount" validate:"omitempty"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"max_payment_amount" bson:"updated_at" validate:"omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"max_payment_amount" bson:"updated_at" validate:"omitempty"`
+	// @inject_tag: bson:"routes" json:"routes" validate:"omitempty,dive"
+	Routes               []*PaymentMethodRoute `protobuf:"bytes,17,rep,name=routes,proto3" json:"routes" bson:"routes" validate:"omitempty,dive"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *PaymentMethod) Reset()         { *m = PaymentMethod{} }
 func (m *PaymentMethod) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethod) ProtoMessage()    {}
 func (*PaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{33}
+	return fileDescriptor_958db8ba491a6b57, []int{36}
 }
 
 func (m *PaymentMethod) XXX_Unmarshal(b []byte) error {
@@ -4198,13 +4554,132 @@ func (m *PaymentMethod) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
-type Commission struct {
-	// @inject_tag: bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	// @inject_tag: bson:"pm_id"
-	PaymentMethodId string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty" bson:"pm_id"`
-	// @inject_tag: bson:"project_id"
-	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" bson:"project_id"`
+func (m *PaymentMethod) GetRoutes() []*PaymentMethodRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type PaymentMethodRoute struct {
+	// @inject_tag: bson:"payment_system_id" json:"payment_system_id" validate:"required,hexadecimal,len=24"
+	PaymentSystemId string `protobuf:"bytes,1,opt,name=payment_system_id,json=paymentSystemId,proto3" json:"payment_system_id" bson:"payment_system_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: bson:"priority" json:"priority" validate:"omitempty,numeric,gte=0"
+	Priority int32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority" bson:"priority" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: bson:"weight" json:"weight" validate:"omitempty,numeric,gte=0"
+	Weight int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight" bson:"weight" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: bson:"countries" json:"countries" validate:"omitempty,dive,alpha,len=2"
+	Countries []string `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries" bson:"countries" validate:"omitempty,dive,alpha,len=2"`
+	// @inject_tag: bson:"currencies" json:"currencies" validate:"omitempty,dive,alpha,len=3"
+	Currencies []string `protobuf:"bytes,5,rep,name=currencies,proto3" json:"currencies" bson:"currencies" validate:"omitempty,dive,alpha,len=3"`
+	// @inject_tag: bson:"min_amount" json:"min_amount" validate:"omitempty,numeric,gte=0"
+	MinAmount float64 `protobuf:"fixed64,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount" bson:"min_amount" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: bson:"max_amount" json:"max_amount" validate:"omitempty,numeric,gte=0"
+	MaxAmount float64 `protobuf:"fixed64,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount" bson:"max_amount" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: bson:"test_settings" json:"test_settings" validate:"omitempty"
+	TestSettings map[string]*PaymentMethodParams `protobuf:"bytes,8,rep,name=test_settings,json=testSettings,proto3" json:"test_settings" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" bson:"test_settings" validate:"omitempty"`
+	// @inject_tag: bson:"production_settings" json:"production_settings" validate:"omitempty"
+	ProductionSettings   map[string]*PaymentMethodParams `protobuf:"bytes,9,rep,name=production_settings,json=productionSettings,proto3" json:"production_settings" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" bson:"production_settings" validate:"omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                           `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentMethodRoute) Reset()         { *m = PaymentMethodRoute{} }
+func (m *PaymentMethodRoute) String() string { return proto.CompactTextString(m) }
+func (*PaymentMethodRoute) ProtoMessage()    {}
+func (*PaymentMethodRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{37}
+}
+
+func (m *PaymentMethodRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentMethodRoute.Unmarshal(m, b)
+}
+func (m *PaymentMethodRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentMethodRoute.Marshal(b, m, deterministic)
+}
+func (m *PaymentMethodRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentMethodRoute.Merge(m, src)
+}
+func (m *PaymentMethodRoute) XXX_Size() int {
+	return xxx_messageInfo_PaymentMethodRoute.Size(m)
+}
+func (m *PaymentMethodRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentMethodRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentMethodRoute proto.InternalMessageInfo
+
+func (m *PaymentMethodRoute) GetPaymentSystemId() string {
+	if m != nil {
+		return m.PaymentSystemId
+	}
+	return ""
+}
+
+func (m *PaymentMethodRoute) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *PaymentMethodRoute) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *PaymentMethodRoute) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *PaymentMethodRoute) GetCurrencies() []string {
+	if m != nil {
+		return m.Currencies
+	}
+	return nil
+}
+
+func (m *PaymentMethodRoute) GetMinAmount() float64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *PaymentMethodRoute) GetMaxAmount() float64 {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return 0
+}
+
+func (m *PaymentMethodRoute) GetTestSettings() map[string]*PaymentMethodParams {
+	if m != nil {
+		return m.TestSettings
+	}
+	return nil
+}
+
+func (m *PaymentMethodRoute) GetProductionSettings() map[string]*PaymentMethodParams {
+	if m != nil {
+		return m.ProductionSettings
+	}
+	return nil
+}
+
+type Commission struct {
+	// @inject_tag: bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// @inject_tag: bson:"pm_id"
+	PaymentMethodId string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty" bson:"pm_id"`
+	// @inject_tag: bson:"project_id"
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" bson:"project_id"`
 	// @inject_tag: bson:"pm_commission"
 	PaymentMethodCommission float64 `protobuf:"fixed64,4,opt,name=payment_method_commission,json=paymentMethodCommission,proto3" json:"payment_method_commission,omitempty" bson:"pm_commission"`
 	// @inject_tag: bson:"psp_commission"
@@ -4226,7 +4701,7 @@ func (m *Commission) Reset()         { *m = Commission{} }
 func (m *Commission) String() string { return proto.CompactTextString(m) }
 func (*Commission) ProtoMessage()    {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{34}
+	return fileDescriptor_958db8ba491a6b57, []int{38}
 }
 
 func (m *Commission) XXX_Unmarshal(b []byte) error {
@@ -4324,7 +4799,7 @@ func (m *CardExpire) Reset()         { *m = CardExpire{} }
 func (m *CardExpire) String() string { return proto.CompactTextString(m) }
 func (*CardExpire) ProtoMessage()    {}
 func (*CardExpire) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{35}
+	return fileDescriptor_958db8ba491a6b57, []int{39}
 }
 
 func (m *CardExpire) XXX_Unmarshal(b []byte) error {
@@ -4373,7 +4848,7 @@ func (m *SavedCard) Reset()         { *m = SavedCard{} }
 func (m *SavedCard) String() string { return proto.CompactTextString(m) }
 func (*SavedCard) ProtoMessage()    {}
 func (*SavedCard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{36}
+	return fileDescriptor_958db8ba491a6b57, []int{40}
 }
 
 func (m *SavedCard) XXX_Unmarshal(b []byte) error {
@@ -4442,7 +4917,7 @@ func (m *PaymentFormPaymentMethod) Reset()         { *m = PaymentFormPaymentMeth
 func (m *PaymentFormPaymentMethod) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormPaymentMethod) ProtoMessage()    {}
 func (*PaymentFormPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{37}
+	return fileDescriptor_958db8ba491a6b57, []int{41}
 }
 
 func (m *PaymentFormPaymentMethod) XXX_Unmarshal(b []byte) error {
@@ -4530,7 +5005,7 @@ func (m *MerchantPaymentMethodPerTransactionCommission) String() string {
 }
 func (*MerchantPaymentMethodPerTransactionCommission) ProtoMessage() {}
 func (*MerchantPaymentMethodPerTransactionCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{38}
+	return fileDescriptor_958db8ba491a6b57, []int{42}
 }
 
 func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Unmarshal(b []byte) error {
@@ -4579,7 +5054,7 @@ func (m *MerchantPaymentMethodCommissions) Reset()         { *m = MerchantPaymen
 func (m *MerchantPaymentMethodCommissions) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodCommissions) ProtoMessage()    {}
 func (*MerchantPaymentMethodCommissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{39}
+	return fileDescriptor_958db8ba491a6b57, []int{43}
 }
 
 func (m *MerchantPaymentMethodCommissions) XXX_Unmarshal(b []byte) error {
@@ -4632,7 +5107,7 @@ func (m *MerchantPaymentMethodIntegration) Reset()         { *m = MerchantPaymen
 func (m *MerchantPaymentMethodIntegration) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodIntegration) ProtoMessage()    {}
 func (*MerchantPaymentMethodIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{40}
+	return fileDescriptor_958db8ba491a6b57, []int{44}
 }
 
 func (m *MerchantPaymentMethodIntegration) XXX_Unmarshal(b []byte) error {
@@ -4695,7 +5170,7 @@ func (m *MerchantPaymentMethodIdentification) Reset()         { *m = MerchantPay
 func (m *MerchantPaymentMethodIdentification) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodIdentification) ProtoMessage()    {}
 func (*MerchantPaymentMethodIdentification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{41}
+	return fileDescriptor_958db8ba491a6b57, []int{45}
 }
 
 func (m *MerchantPaymentMethodIdentification) XXX_Unmarshal(b []byte) error {
@@ -4744,7 +5219,7 @@ func (m *MerchantPaymentMethod) Reset()         { *m = MerchantPaymentMethod{} }
 func (m *MerchantPaymentMethod) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethod) ProtoMessage()    {}
 func (*MerchantPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{42}
+	return fileDescriptor_958db8ba491a6b57, []int{46}
 }
 
 func (m *MerchantPaymentMethod) XXX_Unmarshal(b []byte) error {
@@ -4806,7 +5281,7 @@ func (m *RefundPayerData) Reset()         { *m = RefundPayerData{} }
 func (m *RefundPayerData) String() string { return proto.CompactTextString(m) }
 func (*RefundPayerData) ProtoMessage()    {}
 func (*RefundPayerData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{43}
+	return fileDescriptor_958db8ba491a6b57, []int{47}
 }
 
 func (m *RefundPayerData) XXX_Unmarshal(b []byte) error {
@@ -4860,7 +5335,7 @@ func (m *RefundOrder) Reset()         { *m = RefundOrder{} }
 func (m *RefundOrder) String() string { return proto.CompactTextString(m) }
 func (*RefundOrder) ProtoMessage()    {}
 func (*RefundOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{44}
+	return fileDescriptor_958db8ba491a6b57, []int{48}
 }
 
 func (m *RefundOrder) XXX_Unmarshal(b []byte) error {
@@ -4919,7 +5394,7 @@ func (m *Refund) Reset()         { *m = Refund{} }
 func (m *Refund) String() string { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()    {}
 func (*Refund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{45}
+	return fileDescriptor_958db8ba491a6b57, []int{49}
 }
 
 func (m *Refund) XXX_Unmarshal(b []byte) error {
@@ -5058,7 +5533,7 @@ func (m *MerchantPaymentMethodHistory) Reset()         { *m = MerchantPaymentMet
 func (m *MerchantPaymentMethodHistory) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodHistory) ProtoMessage()    {}
 func (*MerchantPaymentMethodHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{46}
+	return fileDescriptor_958db8ba491a6b57, []int{50}
 }
 
 func (m *MerchantPaymentMethodHistory) XXX_Unmarshal(b []byte) error {
@@ -5130,7 +5605,7 @@ func (m *CustomerIdentity) Reset()         { *m = CustomerIdentity{} }
 func (m *CustomerIdentity) String() string { return proto.CompactTextString(m) }
 func (*CustomerIdentity) ProtoMessage()    {}
 func (*CustomerIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{47}
+	return fileDescriptor_958db8ba491a6b57, []int{51}
 }
 
 func (m *CustomerIdentity) XXX_Unmarshal(b []byte) error {
@@ -5205,7 +5680,7 @@ func (m *CustomerIpHistory) Reset()         { *m = CustomerIpHistory{} }
 func (m *CustomerIpHistory) String() string { return proto.CompactTextString(m) }
 func (*CustomerIpHistory) ProtoMessage()    {}
 func (*CustomerIpHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{48}
+	return fileDescriptor_958db8ba491a6b57, []int{52}
 }
 
 func (m *CustomerIpHistory) XXX_Unmarshal(b []byte) error {
@@ -5255,7 +5730,7 @@ func (m *CustomerAddressHistory) Reset()         { *m = CustomerAddressHistory{}
 func (m *CustomerAddressHistory) String() string { return proto.CompactTextString(m) }
 func (*CustomerAddressHistory) ProtoMessage()    {}
 func (*CustomerAddressHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{49}
+	return fileDescriptor_958db8ba491a6b57, []int{53}
 }
 
 func (m *CustomerAddressHistory) XXX_Unmarshal(b []byte) error {
@@ -5323,7 +5798,7 @@ func (m *CustomerStringValueHistory) Reset()         { *m = CustomerStringValueH
 func (m *CustomerStringValueHistory) String() string { return proto.CompactTextString(m) }
 func (*CustomerStringValueHistory) ProtoMessage()    {}
 func (*CustomerStringValueHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{50}
+	return fileDescriptor_958db8ba491a6b57, []int{54}
 }
 
 func (m *CustomerStringValueHistory) XXX_Unmarshal(b []byte) error {
@@ -5405,7 +5880,7 @@ func (m *Customer) Reset()         { *m = Customer{} }
 func (m *Customer) String() string { return proto.CompactTextString(m) }
 func (*Customer) ProtoMessage()    {}
 func (*Customer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{51}
+	return fileDescriptor_958db8ba491a6b57, []int{55}
 }
 
 func (m *Customer) XXX_Unmarshal(b []byte) error {
@@ -5614,7 +6089,7 @@ func (m *TokenUserEmailValue) Reset()         { *m = TokenUserEmailValue{} }
 func (m *TokenUserEmailValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserEmailValue) ProtoMessage()    {}
 func (*TokenUserEmailValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{52}
+	return fileDescriptor_958db8ba491a6b57, []int{56}
 }
 
 func (m *TokenUserEmailValue) XXX_Unmarshal(b []byte) error {
@@ -5662,7 +6137,7 @@ func (m *TokenUserPhoneValue) Reset()         { *m = TokenUserPhoneValue{} }
 func (m *TokenUserPhoneValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserPhoneValue) ProtoMessage()    {}
 func (*TokenUserPhoneValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{53}
+	return fileDescriptor_958db8ba491a6b57, []int{57}
 }
 
 func (m *TokenUserPhoneValue) XXX_Unmarshal(b []byte) error {
@@ -5709,7 +6184,7 @@ func (m *TokenUserIpValue) Reset()         { *m = TokenUserIpValue{} }
 func (m *TokenUserIpValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserIpValue) ProtoMessage()    {}
 func (*TokenUserIpValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{54}
+	return fileDescriptor_958db8ba491a6b57, []int{58}
 }
 
 func (m *TokenUserIpValue) XXX_Unmarshal(b []byte) error {
@@ -5749,7 +6224,7 @@ func (m *TokenUserLocaleValue) Reset()         { *m = TokenUserLocaleValue{} }
 func (m *TokenUserLocaleValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserLocaleValue) ProtoMessage()    {}
 func (*TokenUserLocaleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{55}
+	return fileDescriptor_958db8ba491a6b57, []int{59}
 }
 
 func (m *TokenUserLocaleValue) XXX_Unmarshal(b []byte) error {
@@ -5788,7 +6263,7 @@ func (m *TokenUserValue) Reset()         { *m = TokenUserValue{} }
 func (m *TokenUserValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserValue) ProtoMessage()    {}
 func (*TokenUserValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{56}
+	return fileDescriptor_958db8ba491a6b57, []int{60}
 }
 
 func (m *TokenUserValue) XXX_Unmarshal(b []byte) error {
@@ -5839,7 +6314,7 @@ func (m *TokenUser) Reset()         { *m = TokenUser{} }
 func (m *TokenUser) String() string { return proto.CompactTextString(m) }
 func (*TokenUser) ProtoMessage()    {}
 func (*TokenUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{57}
+	return fileDescriptor_958db8ba491a6b57, []int{61}
 }
 
 func (m *TokenUser) XXX_Unmarshal(b []byte) error {
@@ -5944,7 +6419,7 @@ func (m *TokenSettingsReturnUrl) Reset()         { *m = TokenSettingsReturnUrl{}
 func (m *TokenSettingsReturnUrl) String() string { return proto.CompactTextString(m) }
 func (*TokenSettingsReturnUrl) ProtoMessage()    {}
 func (*TokenSettingsReturnUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{58}
+	return fileDescriptor_958db8ba491a6b57, []int{62}
 }
 
 func (m *TokenSettingsReturnUrl) XXX_Unmarshal(b []byte) error {
@@ -5995,7 +6470,7 @@ func (m *TokenSettingsItem) Reset()         { *m = TokenSettingsItem{} }
 func (m *TokenSettingsItem) String() string { return proto.CompactTextString(m) }
 func (*TokenSettingsItem) ProtoMessage()    {}
 func (*TokenSettingsItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{59}
+	return fileDescriptor_958db8ba491a6b57, []int{63}
 }
 
 func (m *TokenSettingsItem) XXX_Unmarshal(b []byte) error {
@@ -6067,7 +6542,7 @@ func (m *TokenSettings) Reset()         { *m = TokenSettings{} }
 func (m *TokenSettings) String() string { return proto.CompactTextString(m) }
 func (*TokenSettings) ProtoMessage()    {}
 func (*TokenSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{60}
+	return fileDescriptor_958db8ba491a6b57, []int{64}
 }
 
 func (m *TokenSettings) XXX_Unmarshal(b []byte) error {
@@ -6184,7 +6659,7 @@ func (m *OrderIssuer) Reset()         { *m = OrderIssuer{} }
 func (m *OrderIssuer) String() string { return proto.CompactTextString(m) }
 func (*OrderIssuer) ProtoMessage()    {}
 func (*OrderIssuer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{61}
+	return fileDescriptor_958db8ba491a6b57, []int{65}
 }
 
 func (m *OrderIssuer) XXX_Unmarshal(b []byte) error {
@@ -6283,7 +6758,7 @@ func (m *OrderNotificationRefund) Reset()         { *m = OrderNotificationRefund
 func (m *OrderNotificationRefund) String() string { return proto.CompactTextString(m) }
 func (*OrderNotificationRefund) ProtoMessage()    {}
 func (*OrderNotificationRefund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{62}
+	return fileDescriptor_958db8ba491a6b57, []int{66}
 }
 
 func (m *OrderNotificationRefund) XXX_Unmarshal(b []byte) error {
@@ -6358,7 +6833,7 @@ func (m *GetCountryRequest) Reset()         { *m = GetCountryRequest{} }
 func (m *GetCountryRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCountryRequest) ProtoMessage()    {}
 func (*GetCountryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{63}
+	return fileDescriptor_958db8ba491a6b57, []int{67}
 }
 
 func (m *GetCountryRequest) XXX_Unmarshal(b []byte) error {
@@ -6400,7 +6875,7 @@ func (m *CountryVatThreshold) Reset()         { *m = CountryVatThreshold{} }
 func (m *CountryVatThreshold) String() string { return proto.CompactTextString(m) }
 func (*CountryVatThreshold) ProtoMessage()    {}
 func (*CountryVatThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{64}
+	return fileDescriptor_958db8ba491a6b57, []int{68}
 }
 
 func (m *CountryVatThreshold) XXX_Unmarshal(b []byte) error {
@@ -6471,7 +6946,9 @@ type Country struct {
 	//@inject_tag: json:"updated_at" bson:"updated_at"
 	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" bson:"updated_at"`
 	//@inject_tag: json:"payer_tariff_region" bson:"payer_tariff_region"
-	PayerTariffRegion    string   `protobuf:"bytes,18,opt,name=payer_tariff_region,json=payerTariffRegion,proto3" json:"payer_tariff_region" bson:"payer_tariff_region"`
+	PayerTariffRegion string `protobuf:"bytes,18,opt,name=payer_tariff_region,json=payerTariffRegion,proto3" json:"payer_tariff_region" bson:"payer_tariff_region"`
+	// @inject_tag: json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"
+	VatRate              float64  `protobuf:"fixed64,19,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -6481,7 +6958,7 @@ func (m *Country) Reset()         { *m = Country{} }
 func (m *Country) String() string { return proto.CompactTextString(m) }
 func (*Country) ProtoMessage()    {}
 func (*Country) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{65}
+	return fileDescriptor_958db8ba491a6b57, []int{69}
 }
 
 func (m *Country) XXX_Unmarshal(b []byte) error {
@@ -6628,6 +7105,163 @@ func (m *Country) GetPayerTariffRegion() string {
 	return ""
 }
 
+func (m *Country) GetVatRate() float64 {
+	if m != nil {
+		return m.VatRate
+	}
+	return 0
+}
+
+// vat settings of country effective from date, history of settings is used to process
+// vat reports, turnovers and accounting entries of past periods by settings applied in that periods
+type CountryVatSettings struct {
+	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"country" bson:"country" validate:"required,alpha,len=2"
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country" bson:"country" validate:"required,alpha,len=2"`
+	//@inject_tag: json:"vat_enabled" bson:"vat_enabled"
+	VatEnabled bool `protobuf:"varint,3,opt,name=vat_enabled,json=vatEnabled,proto3" json:"vat_enabled" bson:"vat_enabled"`
+	//@inject_tag: json:"vat_currency" bson:"vat_currency" validate:"omitempty,alpha,len=3"
+	VatCurrency string `protobuf:"bytes,4,opt,name=vat_currency,json=vatCurrency,proto3" json:"vat_currency" bson:"vat_currency" validate:"omitempty,alpha,len=3"`
+	// @inject_tag: json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"
+	VatRate float64 `protobuf:"fixed64,5,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"`
+	// @inject_tag: json:"vat_threshold" bson:"vat_threshold" validate:"omitempty,dive"
+	VatThreshold *CountryVatThreshold `protobuf:"bytes,6,opt,name=vat_threshold,json=vatThreshold,proto3" json:"vat_threshold" bson:"vat_threshold" validate:"omitempty,dive"`
+	// @inject_tag: json:"vat_period_month" bson:"vat_period_month" validate:"numeric,gte=0,lte=12"
+	VatPeriodMonth int32 `protobuf:"varint,7,opt,name=vat_period_month,json=vatPeriodMonth,proto3" json:"vat_period_month" bson:"vat_period_month" validate:"numeric,gte=0,lte=12"`
+	// @inject_tag: json:"vat_deadline_days" bson:"vat_deadline_days" validate:"numeric,gte=0"
+	VatDeadlineDays int32 `protobuf:"varint,8,opt,name=vat_deadline_days,json=vatDeadlineDays,proto3" json:"vat_deadline_days" bson:"vat_deadline_days" validate:"numeric,gte=0"`
+	// @inject_tag: json:"vat_store_years" bson:"vat_store_years" validate:"numeric,gte=0"
+	VatStoreYears int32 `protobuf:"varint,9,opt,name=vat_store_years,json=vatStoreYears,proto3" json:"vat_store_years" bson:"vat_store_years" validate:"numeric,gte=0"`
+	// @inject_tag: json:"vat_currency_rates_policy" bson:"vat_currency_rates_policy" validate:"omitempty,oneof=on-day last-day mid-month"
+	VatCurrencyRatesPolicy string `protobuf:"bytes,10,opt,name=vat_currency_rates_policy,json=vatCurrencyRatesPolicy,proto3" json:"vat_currency_rates_policy" bson:"vat_currency_rates_policy" validate:"omitempty,oneof=on-day last-day mid-month"`
+	// @inject_tag: json:"vat_currency_rates_source" bson:"vat_currency_rates_source" validate:"omitempty,alpha"
+	VatCurrencyRatesSource string `protobuf:"bytes,11,opt,name=vat_currency_rates_source,json=vatCurrencyRatesSource,proto3" json:"vat_currency_rates_source" bson:"vat_currency_rates_source" validate:"omitempty,alpha"`
+	//@inject_tag: json:"effective_from" bson:"effective_from"
+	EffectiveFrom *timestamp.Timestamp `protobuf:"bytes,12,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from" bson:"effective_from"`
+	//@inject_tag: json:"created_at" bson:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CountryVatSettings) Reset()         { *m = CountryVatSettings{} }
+func (m *CountryVatSettings) String() string { return proto.CompactTextString(m) }
+func (*CountryVatSettings) ProtoMessage()    {}
+func (*CountryVatSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{70}
+}
+
+func (m *CountryVatSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountryVatSettings.Unmarshal(m, b)
+}
+func (m *CountryVatSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountryVatSettings.Marshal(b, m, deterministic)
+}
+func (m *CountryVatSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountryVatSettings.Merge(m, src)
+}
+func (m *CountryVatSettings) XXX_Size() int {
+	return xxx_messageInfo_CountryVatSettings.Size(m)
+}
+func (m *CountryVatSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountryVatSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountryVatSettings proto.InternalMessageInfo
+
+func (m *CountryVatSettings) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CountryVatSettings) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *CountryVatSettings) GetVatEnabled() bool {
+	if m != nil {
+		return m.VatEnabled
+	}
+	return false
+}
+
+func (m *CountryVatSettings) GetVatCurrency() string {
+	if m != nil {
+		return m.VatCurrency
+	}
+	return ""
+}
+
+func (m *CountryVatSettings) GetVatRate() float64 {
+	if m != nil {
+		return m.VatRate
+	}
+	return 0
+}
+
+func (m *CountryVatSettings) GetVatThreshold() *CountryVatThreshold {
+	if m != nil {
+		return m.VatThreshold
+	}
+	return nil
+}
+
+func (m *CountryVatSettings) GetVatPeriodMonth() int32 {
+	if m != nil {
+		return m.VatPeriodMonth
+	}
+	return 0
+}
+
+func (m *CountryVatSettings) GetVatDeadlineDays() int32 {
+	if m != nil {
+		return m.VatDeadlineDays
+	}
+	return 0
+}
+
+func (m *CountryVatSettings) GetVatStoreYears() int32 {
+	if m != nil {
+		return m.VatStoreYears
+	}
+	return 0
+}
+
+func (m *CountryVatSettings) GetVatCurrencyRatesPolicy() string {
+	if m != nil {
+		return m.VatCurrencyRatesPolicy
+	}
+	return ""
+}
+
+func (m *CountryVatSettings) GetVatCurrencyRatesSource() string {
+	if m != nil {
+		return m.VatCurrencyRatesSource
+	}
+	return ""
+}
+
+func (m *CountryVatSettings) GetEffectiveFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+func (m *CountryVatSettings) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
 type CountriesList struct {
 	Countries            []*Country `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -6639,7 +7273,7 @@ func (m *CountriesList) Reset()         { *m = CountriesList{} }
 func (m *CountriesList) String() string { return proto.CompactTextString(m) }
 func (*CountriesList) ProtoMessage()    {}
 func (*CountriesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{66}
+	return fileDescriptor_958db8ba491a6b57, []int{71}
 }
 
 func (m *CountriesList) XXX_Unmarshal(b []byte) error {
@@ -6679,7 +7313,7 @@ func (m *GetPriceGroupRequest) Reset()         { *m = GetPriceGroupRequest{} }
 func (m *GetPriceGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPriceGroupRequest) ProtoMessage()    {}
 func (*GetPriceGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{67}
+	return fileDescriptor_958db8ba491a6b57, []int{72}
 }
 
 func (m *GetPriceGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -6733,7 +7367,7 @@ func (m *PriceGroup) Reset()         { *m = PriceGroup{} }
 func (m *PriceGroup) String() string { return proto.CompactTextString(m) }
 func (*PriceGroup) ProtoMessage()    {}
 func (*PriceGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{68}
+	return fileDescriptor_958db8ba491a6b57, []int{73}
 }
 
 func (m *PriceGroup) XXX_Unmarshal(b []byte) error {
@@ -6824,7 +7458,7 @@ func (m *ZipCodeState) Reset()         { *m = ZipCodeState{} }
 func (m *ZipCodeState) String() string { return proto.CompactTextString(m) }
 func (*ZipCodeState) ProtoMessage()    {}
 func (*ZipCodeState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{69}
+	return fileDescriptor_958db8ba491a6b57, []int{74}
 }
 
 func (m *ZipCodeState) XXX_Unmarshal(b []byte) error {
@@ -6874,7 +7508,7 @@ func (m *ZipCode) Reset()         { *m = ZipCode{} }
 func (m *ZipCode) String() string { return proto.CompactTextString(m) }
 func (*ZipCode) ProtoMessage()    {}
 func (*ZipCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{70}
+	return fileDescriptor_958db8ba491a6b57, []int{75}
 }
 
 func (m *ZipCode) XXX_Unmarshal(b []byte) error {
@@ -6960,7 +7594,7 @@ func (m *PaymentChannelCostSystem) Reset()         { *m = PaymentChannelCostSyst
 func (m *PaymentChannelCostSystem) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostSystem) ProtoMessage()    {}
 func (*PaymentChannelCostSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{71}
+	return fileDescriptor_958db8ba491a6b57, []int{76}
 }
 
 func (m *PaymentChannelCostSystem) XXX_Unmarshal(b []byte) error {
@@ -7067,7 +7701,7 @@ func (m *PaymentChannelCostSystemRequest) Reset()         { *m = PaymentChannelC
 func (m *PaymentChannelCostSystemRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostSystemRequest) ProtoMessage()    {}
 func (*PaymentChannelCostSystemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{72}
+	return fileDescriptor_958db8ba491a6b57, []int{77}
 }
 
 func (m *PaymentChannelCostSystemRequest) XXX_Unmarshal(b []byte) error {
@@ -7120,7 +7754,7 @@ func (m *PaymentChannelCostSystemList) Reset()         { *m = PaymentChannelCost
 func (m *PaymentChannelCostSystemList) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostSystemList) ProtoMessage()    {}
 func (*PaymentChannelCostSystemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{73}
+	return fileDescriptor_958db8ba491a6b57, []int{78}
 }
 
 func (m *PaymentChannelCostSystemList) XXX_Unmarshal(b []byte) error {
@@ -7190,7 +7824,7 @@ func (m *PaymentChannelCostMerchant) Reset()         { *m = PaymentChannelCostMe
 func (m *PaymentChannelCostMerchant) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostMerchant) ProtoMessage()    {}
 func (*PaymentChannelCostMerchant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{74}
+	return fileDescriptor_958db8ba491a6b57, []int{79}
 }
 
 func (m *PaymentChannelCostMerchant) XXX_Unmarshal(b []byte) error {
@@ -7345,7 +7979,7 @@ func (m *PaymentChannelCostMerchantRequest) Reset()         { *m = PaymentChanne
 func (m *PaymentChannelCostMerchantRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostMerchantRequest) ProtoMessage()    {}
 func (*PaymentChannelCostMerchantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{75}
+	return fileDescriptor_958db8ba491a6b57, []int{80}
 }
 
 func (m *PaymentChannelCostMerchantRequest) XXX_Unmarshal(b []byte) error {
@@ -7419,7 +8053,7 @@ func (m *PaymentChannelCostMerchantList) Reset()         { *m = PaymentChannelCo
 func (m *PaymentChannelCostMerchantList) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostMerchantList) ProtoMessage()    {}
 func (*PaymentChannelCostMerchantList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{76}
+	return fileDescriptor_958db8ba491a6b57, []int{81}
 }
 
 func (m *PaymentChannelCostMerchantList) XXX_Unmarshal(b []byte) error {
@@ -7459,7 +8093,7 @@ func (m *PaymentChannelCostMerchantListRequest) Reset()         { *m = PaymentCh
 func (m *PaymentChannelCostMerchantListRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentChannelCostMerchantListRequest) ProtoMessage()    {}
 func (*PaymentChannelCostMerchantListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{77}
+	return fileDescriptor_958db8ba491a6b57, []int{82}
 }
 
 func (m *PaymentChannelCostMerchantListRequest) XXX_Unmarshal(b []byte) error {
@@ -7523,7 +8157,7 @@ func (m *MoneyBackCostSystem) Reset()         { *m = MoneyBackCostSystem{} }
 func (m *MoneyBackCostSystem) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostSystem) ProtoMessage()    {}
 func (*MoneyBackCostSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{78}
+	return fileDescriptor_958db8ba491a6b57, []int{83}
 }
 
 func (m *MoneyBackCostSystem) XXX_Unmarshal(b []byte) error {
@@ -7659,7 +8293,7 @@ func (m *MoneyBackCostSystemRequest) Reset()         { *m = MoneyBackCostSystemR
 func (m *MoneyBackCostSystemRequest) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostSystemRequest) ProtoMessage()    {}
 func (*MoneyBackCostSystemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{79}
+	return fileDescriptor_958db8ba491a6b57, []int{84}
 }
 
 func (m *MoneyBackCostSystemRequest) XXX_Unmarshal(b []byte) error {
@@ -7740,7 +8374,7 @@ func (m *MoneyBackCostSystemList) Reset()         { *m = MoneyBackCostSystemList
 func (m *MoneyBackCostSystemList) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostSystemList) ProtoMessage()    {}
 func (*MoneyBackCostSystemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{80}
+	return fileDescriptor_958db8ba491a6b57, []int{85}
 }
 
 func (m *MoneyBackCostSystemList) XXX_Unmarshal(b []byte) error {
@@ -7810,7 +8444,7 @@ func (m *MoneyBackCostMerchant) Reset()         { *m = MoneyBackCostMerchant{} }
 func (m *MoneyBackCostMerchant) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostMerchant) ProtoMessage()    {}
 func (*MoneyBackCostMerchant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{81}
+	return fileDescriptor_958db8ba491a6b57, []int{86}
 }
 
 func (m *MoneyBackCostMerchant) XXX_Unmarshal(b []byte) error {
@@ -7969,7 +8603,7 @@ func (m *MoneyBackCostMerchantRequest) Reset()         { *m = MoneyBackCostMerch
 func (m *MoneyBackCostMerchantRequest) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostMerchantRequest) ProtoMessage()    {}
 func (*MoneyBackCostMerchantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{82}
+	return fileDescriptor_958db8ba491a6b57, []int{87}
 }
 
 func (m *MoneyBackCostMerchantRequest) XXX_Unmarshal(b []byte) error {
@@ -8058,7 +8692,7 @@ func (m *PaymentCostDeleteRequest) Reset()         { *m = PaymentCostDeleteReque
 func (m *PaymentCostDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentCostDeleteRequest) ProtoMessage()    {}
 func (*PaymentCostDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{83}
+	return fileDescriptor_958db8ba491a6b57, []int{88}
 }
 
 func (m *PaymentCostDeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -8097,7 +8731,7 @@ func (m *MoneyBackCostMerchantList) Reset()         { *m = MoneyBackCostMerchant
 func (m *MoneyBackCostMerchantList) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostMerchantList) ProtoMessage()    {}
 func (*MoneyBackCostMerchantList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{84}
+	return fileDescriptor_958db8ba491a6b57, []int{89}
 }
 
 func (m *MoneyBackCostMerchantList) XXX_Unmarshal(b []byte) error {
@@ -8137,7 +8771,7 @@ func (m *MoneyBackCostMerchantListRequest) Reset()         { *m = MoneyBackCostM
 func (m *MoneyBackCostMerchantListRequest) String() string { return proto.CompactTextString(m) }
 func (*MoneyBackCostMerchantListRequest) ProtoMessage()    {}
 func (*MoneyBackCostMerchantListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{85}
+	return fileDescriptor_958db8ba491a6b57, []int{90}
 }
 
 func (m *MoneyBackCostMerchantListRequest) XXX_Unmarshal(b []byte) error {
@@ -8189,7 +8823,7 @@ func (m *PayoutCostSystem) Reset()         { *m = PayoutCostSystem{} }
 func (m *PayoutCostSystem) String() string { return proto.CompactTextString(m) }
 func (*PayoutCostSystem) ProtoMessage()    {}
 func (*PayoutCostSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{86}
+	return fileDescriptor_958db8ba491a6b57, []int{91}
 }
 
 func (m *PayoutCostSystem) XXX_Unmarshal(b []byte) error {
@@ -8271,7 +8905,7 @@ func (m *AccountingEntrySource) Reset()         { *m = AccountingEntrySource{} }
 func (m *AccountingEntrySource) String() string { return proto.CompactTextString(m) }
 func (*AccountingEntrySource) ProtoMessage()    {}
 func (*AccountingEntrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{87}
+	return fileDescriptor_958db8ba491a6b57, []int{92}
 }
 
 func (m *AccountingEntrySource) XXX_Unmarshal(b []byte) error {
@@ -8332,7 +8966,7 @@ func (m *AccountingEntry) Reset()         { *m = AccountingEntry{} }
 func (m *AccountingEntry) String() string { return proto.CompactTextString(m) }
 func (*AccountingEntry) ProtoMessage()    {}
 func (*AccountingEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{88}
+	return fileDescriptor_958db8ba491a6b57, []int{93}
 }
 
 func (m *AccountingEntry) XXX_Unmarshal(b []byte) error {
@@ -8465,6 +9099,295 @@ func (m *AccountingEntry) GetAvailableOn() *timestamp.Timestamp {
 	return nil
 }
 
+type LedgerPosting struct {
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId        string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountingEntryId    string                 `protobuf:"bytes,3,opt,name=accounting_entry_id,json=accountingEntryId,proto3" json:"accounting_entry_id,omitempty"`
+	EntryType            string                 `protobuf:"bytes,4,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Source               *AccountingEntrySource `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	MerchantId           string                 `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Account              string                 `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
+	Debit                float64                `protobuf:"fixed64,8,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit               float64                `protobuf:"fixed64,9,opt,name=credit,proto3" json:"credit,omitempty"`
+	Currency             string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt            *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *LedgerPosting) Reset()         { *m = LedgerPosting{} }
+func (m *LedgerPosting) String() string { return proto.CompactTextString(m) }
+func (*LedgerPosting) ProtoMessage()    {}
+func (*LedgerPosting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{94}
+}
+
+func (m *LedgerPosting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerPosting.Unmarshal(m, b)
+}
+func (m *LedgerPosting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerPosting.Marshal(b, m, deterministic)
+}
+func (m *LedgerPosting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerPosting.Merge(m, src)
+}
+func (m *LedgerPosting) XXX_Size() int {
+	return xxx_messageInfo_LedgerPosting.Size(m)
+}
+func (m *LedgerPosting) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerPosting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerPosting proto.InternalMessageInfo
+
+func (m *LedgerPosting) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetAccountingEntryId() string {
+	if m != nil {
+		return m.AccountingEntryId
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetEntryType() string {
+	if m != nil {
+		return m.EntryType
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetSource() *AccountingEntrySource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *LedgerPosting) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetDebit() float64 {
+	if m != nil {
+		return m.Debit
+	}
+	return 0
+}
+
+func (m *LedgerPosting) GetCredit() float64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
+func (m *LedgerPosting) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *LedgerPosting) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type LedgerTrialBalanceAccount struct {
+	//@inject_tag: json:"account" bson:"_id"
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account" bson:"_id"`
+	//@inject_tag: json:"debit" bson:"debit"
+	Debit float64 `protobuf:"fixed64,2,opt,name=debit,proto3" json:"debit" bson:"debit"`
+	//@inject_tag: json:"credit" bson:"credit"
+	Credit float64 `protobuf:"fixed64,3,opt,name=credit,proto3" json:"credit" bson:"credit"`
+	//@inject_tag: json:"balance" bson:"-"
+	Balance              float64  `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance" bson:"-"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *LedgerTrialBalanceAccount) Reset()         { *m = LedgerTrialBalanceAccount{} }
+func (m *LedgerTrialBalanceAccount) String() string { return proto.CompactTextString(m) }
+func (*LedgerTrialBalanceAccount) ProtoMessage()    {}
+func (*LedgerTrialBalanceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{95}
+}
+
+func (m *LedgerTrialBalanceAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerTrialBalanceAccount.Unmarshal(m, b)
+}
+func (m *LedgerTrialBalanceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerTrialBalanceAccount.Marshal(b, m, deterministic)
+}
+func (m *LedgerTrialBalanceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerTrialBalanceAccount.Merge(m, src)
+}
+func (m *LedgerTrialBalanceAccount) XXX_Size() int {
+	return xxx_messageInfo_LedgerTrialBalanceAccount.Size(m)
+}
+func (m *LedgerTrialBalanceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerTrialBalanceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerTrialBalanceAccount proto.InternalMessageInfo
+
+func (m *LedgerTrialBalanceAccount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *LedgerTrialBalanceAccount) GetDebit() float64 {
+	if m != nil {
+		return m.Debit
+	}
+	return 0
+}
+
+func (m *LedgerTrialBalanceAccount) GetCredit() float64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
+func (m *LedgerTrialBalanceAccount) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type LedgerTrialBalance struct {
+	//@inject_tag: json:"merchant_id"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"date_from"
+	DateFrom *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from"`
+	//@inject_tag: json:"date_to"
+	DateTo *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to"`
+	//@inject_tag: json:"accounts"
+	Accounts []*LedgerTrialBalanceAccount `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts"`
+	//@inject_tag: json:"total_debit"
+	TotalDebit float64 `protobuf:"fixed64,6,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit"`
+	//@inject_tag: json:"total_credit"
+	TotalCredit float64 `protobuf:"fixed64,7,opt,name=total_credit,json=totalCredit,proto3" json:"total_credit"`
+	//@inject_tag: json:"is_balanced"
+	IsBalanced           bool     `protobuf:"varint,8,opt,name=is_balanced,json=isBalanced,proto3" json:"is_balanced"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *LedgerTrialBalance) Reset()         { *m = LedgerTrialBalance{} }
+func (m *LedgerTrialBalance) String() string { return proto.CompactTextString(m) }
+func (*LedgerTrialBalance) ProtoMessage()    {}
+func (*LedgerTrialBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{96}
+}
+
+func (m *LedgerTrialBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerTrialBalance.Unmarshal(m, b)
+}
+func (m *LedgerTrialBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerTrialBalance.Marshal(b, m, deterministic)
+}
+func (m *LedgerTrialBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerTrialBalance.Merge(m, src)
+}
+func (m *LedgerTrialBalance) XXX_Size() int {
+	return xxx_messageInfo_LedgerTrialBalance.Size(m)
+}
+func (m *LedgerTrialBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerTrialBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerTrialBalance proto.InternalMessageInfo
+
+func (m *LedgerTrialBalance) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *LedgerTrialBalance) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *LedgerTrialBalance) GetDateFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateFrom
+	}
+	return nil
+}
+
+func (m *LedgerTrialBalance) GetDateTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateTo
+	}
+	return nil
+}
+
+func (m *LedgerTrialBalance) GetAccounts() []*LedgerTrialBalanceAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *LedgerTrialBalance) GetTotalDebit() float64 {
+	if m != nil {
+		return m.TotalDebit
+	}
+	return 0
+}
+
+func (m *LedgerTrialBalance) GetTotalCredit() float64 {
+	if m != nil {
+		return m.TotalCredit
+	}
+	return 0
+}
+
+func (m *LedgerTrialBalance) GetIsBalanced() bool {
+	if m != nil {
+		return m.IsBalanced
+	}
+	return false
+}
+
 type RoyaltyReportTotals struct {
 	//@inject_tag: bson:"transactions_count"
 	TransactionsCount int32 `protobuf:"varint,2,opt,name=transactions_count,json=transactionsCount,proto3" json:"transactions_count,omitempty" bson:"transactions_count"`
@@ -8487,7 +9410,7 @@ func (m *RoyaltyReportTotals) Reset()         { *m = RoyaltyReportTotals{} }
 func (m *RoyaltyReportTotals) String() string { return proto.CompactTextString(m) }
 func (*RoyaltyReportTotals) ProtoMessage()    {}
 func (*RoyaltyReportTotals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{89}
+	return fileDescriptor_958db8ba491a6b57, []int{97}
 }
 
 func (m *RoyaltyReportTotals) XXX_Unmarshal(b []byte) error {
@@ -8572,7 +9495,9 @@ type RoyaltyReportProductSummaryItem struct {
 	//@inject_tag: bson:"total_vat" json:"total_vat"
 	TotalVat float64 `protobuf:"fixed64,10,opt,name=total_vat,json=totalVat,proto3" json:"total_vat" bson:"total_vat"`
 	//@inject_tag: bson:"payout_amount" json:"payout_amount"
-	PayoutAmount         float64  `protobuf:"fixed64,11,opt,name=payout_amount,json=payoutAmount,proto3" json:"payout_amount" bson:"payout_amount"`
+	PayoutAmount float64 `protobuf:"fixed64,11,opt,name=payout_amount,json=payoutAmount,proto3" json:"payout_amount" bson:"payout_amount"`
+	//@inject_tag: bson:"discount_amount" json:"discount_amount"
+	DiscountAmount       float64  `protobuf:"fixed64,12,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount" bson:"discount_amount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -8582,7 +9507,7 @@ func (m *RoyaltyReportProductSummaryItem) Reset()         { *m = RoyaltyReportPr
 func (m *RoyaltyReportProductSummaryItem) String() string { return proto.CompactTextString(m) }
 func (*RoyaltyReportProductSummaryItem) ProtoMessage()    {}
 func (*RoyaltyReportProductSummaryItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{90}
+	return fileDescriptor_958db8ba491a6b57, []int{98}
 }
 
 func (m *RoyaltyReportProductSummaryItem) XXX_Unmarshal(b []byte) error {
@@ -8680,6 +9605,13 @@ func (m *RoyaltyReportProductSummaryItem) GetPayoutAmount() float64 {
 	return 0
 }
 
+func (m *RoyaltyReportProductSummaryItem) GetDiscountAmount() float64 {
+	if m != nil {
+		return m.DiscountAmount
+	}
+	return 0
+}
+
 type RoyaltyReportCorrectionItem struct {
 	//@inject_tag: bson:"accounting_entry_id" json:"accounting_entry_id"
 	AccountingEntryId string `protobuf:"bytes,1,opt,name=accounting_entry_id,json=accountingEntryId,proto3" json:"accounting_entry_id" bson:"accounting_entry_id"`
@@ -8700,7 +9632,7 @@ func (m *RoyaltyReportCorrectionItem) Reset()         { *m = RoyaltyReportCorrec
 func (m *RoyaltyReportCorrectionItem) String() string { return proto.CompactTextString(m) }
 func (*RoyaltyReportCorrectionItem) ProtoMessage()    {}
 func (*RoyaltyReportCorrectionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{91}
+	return fileDescriptor_958db8ba491a6b57, []int{99}
 }
 
 func (m *RoyaltyReportCorrectionItem) XXX_Unmarshal(b []byte) error {
@@ -8774,7 +9706,7 @@ func (m *RoyaltyReportSummary) Reset()         { *m = RoyaltyReportSummary{} }
 func (m *RoyaltyReportSummary) String() string { return proto.CompactTextString(m) }
 func (*RoyaltyReportSummary) ProtoMessage()    {}
 func (*RoyaltyReportSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{92}
+	return fileDescriptor_958db8ba491a6b57, []int{100}
 }
 
 func (m *RoyaltyReportSummary) XXX_Unmarshal(b []byte) error {
@@ -8859,7 +9791,11 @@ type RoyaltyReport struct {
 	//@inject_tag: bson:"is_auto_accepted" json:"is_auto_accepted"
 	IsAutoAccepted bool `protobuf:"varint,17,opt,name=is_auto_accepted,json=isAutoAccepted,proto3" json:"is_auto_accepted" bson:"is_auto_accepted"`
 	//@inject_tag: bson:"payout_document_id" json:"payout_document_id"
-	PayoutDocumentId     string   `protobuf:"bytes,18,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id" bson:"payout_document_id"`
+	PayoutDocumentId string `protobuf:"bytes,18,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id" bson:"payout_document_id"`
+	//@inject_tag: bson:"version" json:"version"
+	Version int32 `protobuf:"varint,19,opt,name=version,proto3" json:"version" bson:"version"`
+	//@inject_tag: bson:"dispute_id" json:"dispute_id"
+	DisputeId            string   `protobuf:"bytes,20,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id" bson:"dispute_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -8869,7 +9805,7 @@ func (m *RoyaltyReport) Reset()         { *m = RoyaltyReport{} }
 func (m *RoyaltyReport) String() string { return proto.CompactTextString(m) }
 func (*RoyaltyReport) ProtoMessage()    {}
 func (*RoyaltyReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{93}
+	return fileDescriptor_958db8ba491a6b57, []int{101}
 }
 
 func (m *RoyaltyReport) XXX_Unmarshal(b []byte) error {
@@ -9016,6 +9952,20 @@ func (m *RoyaltyReport) GetPayoutDocumentId() string {
 	return ""
 }
 
+func (m *RoyaltyReport) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RoyaltyReport) GetDisputeId() string {
+	if m != nil {
+		return m.DisputeId
+	}
+	return ""
+}
+
 type RoyaltyReportChanges struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RoyaltyReportId      string               `protobuf:"bytes,2,opt,name=royalty_report_id,json=royaltyReportId,proto3" json:"royalty_report_id,omitempty"`
@@ -9032,7 +9982,7 @@ func (m *RoyaltyReportChanges) Reset()         { *m = RoyaltyReportChanges{} }
 func (m *RoyaltyReportChanges) String() string { return proto.CompactTextString(m) }
 func (*RoyaltyReportChanges) ProtoMessage()    {}
 func (*RoyaltyReportChanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{94}
+	return fileDescriptor_958db8ba491a6b57, []int{102}
 }
 
 func (m *RoyaltyReportChanges) XXX_Unmarshal(b []byte) error {
@@ -9095,3475 +10045,6505 @@ func (m *RoyaltyReportChanges) GetCreatedAt() *timestamp.Timestamp {
 	return nil
 }
 
-type VatTransaction struct {
-	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
-	//@inject_tag: json:"order_id" bson:"order_id" validate:"required,hexadecimal,len=24"
-	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id" bson:"order_id" validate:"required,hexadecimal,len=24"`
-	//@inject_tag: json:"transaction_id" bson:"transaction_id" validate:"required"
-	TransactionId string `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id" bson:"transaction_id" validate:"required"`
-	//@inject_tag: json:"transaction_type" bson:"transaction_type" validate:"required,oneof=payment refund chargeback"
-	TransactionType string `protobuf:"bytes,4,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type" bson:"transaction_type" validate:"required,oneof=payment refund chargeback"`
-	// @inject_tag: json:"transaction_amount" bson:"transaction_amount" validate:"numeric,gte=0"
-	TransactionAmount float64 `protobuf:"fixed64,5,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount" bson:"transaction_amount" validate:"numeric,gte=0"`
-	//@inject_tag: json:"transaction_currency" bson:"transaction_currency" validate:"required,alpha,len=3"
-	TransactionCurrency string `protobuf:"bytes,6,opt,name=transaction_currency,json=transactionCurrency,proto3" json:"transaction_currency" bson:"transaction_currency" validate:"required,alpha,len=3"`
-	// @inject_tag: json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"
-	VatAmount float64 `protobuf:"fixed64,7,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"`
-	//@inject_tag: json:"vat_currency" bson:"vat_currency" validate:"required,alpha,len=3"
-	VatCurrency string `protobuf:"bytes,8,opt,name=vat_currency,json=vatCurrency,proto3" json:"vat_currency" bson:"vat_currency" validate:"required,alpha,len=3"`
-	// @inject_tag: json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"
-	FeesAmount float64 `protobuf:"fixed64,9,opt,name=fees_amount,json=feesAmount,proto3" json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"`
-	//@inject_tag: json:"fees_currency" bson:"fees_currency" validate:"required,alpha,len=3"
-	FeesCurrency string `protobuf:"bytes,10,opt,name=fees_currency,json=feesCurrency,proto3" json:"fees_currency" bson:"fees_currency" validate:"required,alpha,len=3"`
-	// @inject_tag: json:"local_transaction_amount" bson:"local_transaction_amount" validate:"omitempty,numeric,gte=0"
-	LocalTransactionAmount float64 `protobuf:"fixed64,11,opt,name=local_transaction_amount,json=localTransactionAmount,proto3" json:"local_transaction_amount" bson:"local_transaction_amount" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: json:"local_vat_amount" bson:"local_vat_amount" validate:"omitempty,numeric,gte=0"
-	LocalVatAmount float64 `protobuf:"fixed64,12,opt,name=local_vat_amount,json=localVatAmount,proto3" json:"local_vat_amount" bson:"local_vat_amount" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: json:"local_fees_amount" bson:"local_fees_amount" validate:"omitempty,numeric,gte=0"
-	LocalFeesAmount float64 `protobuf:"fixed64,13,opt,name=local_fees_amount,json=localFeesAmount,proto3" json:"local_fees_amount" bson:"local_fees_amount" validate:"omitempty,numeric,gte=0"`
-	//@inject_tag: json:"local_currency" bson:"local_currency" validate:"required,alpha,len=3"
-	LocalCurrency string `protobuf:"bytes,14,opt,name=local_currency,json=localCurrency,proto3" json:"local_currency" bson:"local_currency" validate:"required,alpha,len=3"`
-	//@inject_tag: json:"local_amounts_approximate" bson:"local_amounts_alocal_amounts_approximatepproximated" validate:"required,alpha,len=3"
-	LocalAmountsApproximate bool `protobuf:"varint,15,opt,name=local_amounts_approximate,json=localAmountsApproximate,proto3" json:"local_amounts_approximate" bson:"local_amounts_alocal_amounts_approximatepproximated" validate:"required,alpha,len=3"`
-	//@inject_tag: json:"billing_address_criteria" bson:"billing_address_criteria" validate:"required,oneof=ip bin language customer form"
-	BillingAddressCriteria string `protobuf:"bytes,16,opt,name=billing_address_criteria,json=billingAddressCriteria,proto3" json:"billing_address_criteria" bson:"billing_address_criteria" validate:"required,oneof=ip bin language customer form"`
-	//@inject_tag: json:"billing_address" bson:"billing_address" validate:"required,dive"
-	BillingAddress *OrderBillingAddress `protobuf:"bytes,17,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address" bson:"billing_address" validate:"required,dive"`
-	//@inject_tag: json:"user_id" bson:"user_id" validate:"omitempty,hexadecimal,len=24"
-	UserId string `protobuf:"bytes,18,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id" validate:"omitempty,hexadecimal,len=24"`
-	//@inject_tag: json:"payment_method" bson:"payment_method" validate:"required"
-	PaymentMethod string `protobuf:"bytes,19,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method" bson:"payment_method" validate:"required"`
-	//@inject_tag: json:"is_deduction" bson:"is_deduction"
-	IsDeduction bool `protobuf:"varint,20,opt,name=is_deduction,json=isDeduction,proto3" json:"is_deduction" bson:"is_deduction"`
-	//@inject_tag: json:"country" bson:"country" validate:"required,alpha,len=2"
-	Country string `protobuf:"bytes,21,opt,name=country,proto3" json:"country" bson:"country" validate:"required,alpha,len=2"`
-	//@inject_tag: json:"date_time" bson:"date_time"
-	DateTime             *timestamp.Timestamp `protobuf:"bytes,22,opt,name=date_time,json=dateTime,proto3" json:"date_time" bson:"date_time"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+type RoyaltyReportDisputeItem struct {
+	//@inject_tag: bson:"id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"id"`
+	// type of disputed line - order or summary
+	//@inject_tag: bson:"type" json:"type" validate:"required,oneof=order summary"
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type" bson:"type" validate:"required,oneof=order summary"`
+	// public identifier (uuid) of disputed order, required for lines with type order
+	//@inject_tag: bson:"order_id" json:"order_id"
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id" bson:"order_id"`
+	// product and region of disputed summary line, required for lines with type summary
+	//@inject_tag: bson:"product" json:"product"
+	Product string `protobuf:"bytes,4,opt,name=product,proto3" json:"product" bson:"product"`
+	//@inject_tag: bson:"region" json:"region"
+	Region string `protobuf:"bytes,5,opt,name=region,proto3" json:"region" bson:"region"`
+	//@inject_tag: bson:"reason" json:"reason"
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason" bson:"reason"`
+	// amount which merchant expects to see in report for disputed line
+	//@inject_tag: bson:"expected_amount" json:"expected_amount"
+	ExpectedAmount       float64  `protobuf:"fixed64,7,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount" bson:"expected_amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatTransaction) Reset()         { *m = VatTransaction{} }
-func (m *VatTransaction) String() string { return proto.CompactTextString(m) }
-func (*VatTransaction) ProtoMessage()    {}
-func (*VatTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{95}
+func (m *RoyaltyReportDisputeItem) Reset()         { *m = RoyaltyReportDisputeItem{} }
+func (m *RoyaltyReportDisputeItem) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportDisputeItem) ProtoMessage()    {}
+func (*RoyaltyReportDisputeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{103}
 }
 
-func (m *VatTransaction) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VatTransaction.Unmarshal(m, b)
+func (m *RoyaltyReportDisputeItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportDisputeItem.Unmarshal(m, b)
 }
-func (m *VatTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VatTransaction.Marshal(b, m, deterministic)
+func (m *RoyaltyReportDisputeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportDisputeItem.Marshal(b, m, deterministic)
 }
-func (m *VatTransaction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VatTransaction.Merge(m, src)
+func (m *RoyaltyReportDisputeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportDisputeItem.Merge(m, src)
 }
-func (m *VatTransaction) XXX_Size() int {
-	return xxx_messageInfo_VatTransaction.Size(m)
+func (m *RoyaltyReportDisputeItem) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportDisputeItem.Size(m)
 }
-func (m *VatTransaction) XXX_DiscardUnknown() {
-	xxx_messageInfo_VatTransaction.DiscardUnknown(m)
+func (m *RoyaltyReportDisputeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportDisputeItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VatTransaction proto.InternalMessageInfo
+var xxx_messageInfo_RoyaltyReportDisputeItem proto.InternalMessageInfo
 
-func (m *VatTransaction) GetId() string {
+func (m *RoyaltyReportDisputeItem) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetOrderId() string {
+func (m *RoyaltyReportDisputeItem) GetType() string {
 	if m != nil {
-		return m.OrderId
+		return m.Type
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetTransactionId() string {
+func (m *RoyaltyReportDisputeItem) GetOrderId() string {
 	if m != nil {
-		return m.TransactionId
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetTransactionType() string {
+func (m *RoyaltyReportDisputeItem) GetProduct() string {
 	if m != nil {
-		return m.TransactionType
+		return m.Product
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetTransactionAmount() float64 {
+func (m *RoyaltyReportDisputeItem) GetRegion() string {
 	if m != nil {
-		return m.TransactionAmount
+		return m.Region
 	}
-	return 0
+	return ""
 }
 
-func (m *VatTransaction) GetTransactionCurrency() string {
+func (m *RoyaltyReportDisputeItem) GetReason() string {
 	if m != nil {
-		return m.TransactionCurrency
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetVatAmount() float64 {
+func (m *RoyaltyReportDisputeItem) GetExpectedAmount() float64 {
 	if m != nil {
-		return m.VatAmount
+		return m.ExpectedAmount
 	}
 	return 0
 }
 
-func (m *VatTransaction) GetVatCurrency() string {
-	if m != nil {
-		return m.VatCurrency
-	}
-	return ""
+type RoyaltyReportDisputeCorrection struct {
+	//@inject_tag: json:"accounting_entry_id"
+	AccountingEntryId string `protobuf:"bytes,1,opt,name=accounting_entry_id,json=accountingEntryId,proto3" json:"accounting_entry_id"`
+	//@inject_tag: json:"item_id"
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id"`
+	//@inject_tag: json:"type"
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	//@inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"reason"
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatTransaction) GetFeesAmount() float64 {
-	if m != nil {
-		return m.FeesAmount
-	}
-	return 0
+func (m *RoyaltyReportDisputeCorrection) Reset()         { *m = RoyaltyReportDisputeCorrection{} }
+func (m *RoyaltyReportDisputeCorrection) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportDisputeCorrection) ProtoMessage()    {}
+func (*RoyaltyReportDisputeCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{104}
 }
 
-func (m *VatTransaction) GetFeesCurrency() string {
-	if m != nil {
-		return m.FeesCurrency
-	}
-	return ""
+func (m *RoyaltyReportDisputeCorrection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportDisputeCorrection.Unmarshal(m, b)
 }
-
-func (m *VatTransaction) GetLocalTransactionAmount() float64 {
-	if m != nil {
-		return m.LocalTransactionAmount
-	}
-	return 0
+func (m *RoyaltyReportDisputeCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportDisputeCorrection.Marshal(b, m, deterministic)
 }
-
-func (m *VatTransaction) GetLocalVatAmount() float64 {
-	if m != nil {
-		return m.LocalVatAmount
-	}
-	return 0
+func (m *RoyaltyReportDisputeCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportDisputeCorrection.Merge(m, src)
 }
-
-func (m *VatTransaction) GetLocalFeesAmount() float64 {
-	if m != nil {
-		return m.LocalFeesAmount
-	}
-	return 0
+func (m *RoyaltyReportDisputeCorrection) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportDisputeCorrection.Size(m)
 }
-
-func (m *VatTransaction) GetLocalCurrency() string {
-	if m != nil {
-		return m.LocalCurrency
-	}
-	return ""
+func (m *RoyaltyReportDisputeCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportDisputeCorrection.DiscardUnknown(m)
 }
 
-func (m *VatTransaction) GetLocalAmountsApproximate() bool {
-	if m != nil {
-		return m.LocalAmountsApproximate
-	}
-	return false
-}
+var xxx_messageInfo_RoyaltyReportDisputeCorrection proto.InternalMessageInfo
 
-func (m *VatTransaction) GetBillingAddressCriteria() string {
+func (m *RoyaltyReportDisputeCorrection) GetAccountingEntryId() string {
 	if m != nil {
-		return m.BillingAddressCriteria
+		return m.AccountingEntryId
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetBillingAddress() *OrderBillingAddress {
+func (m *RoyaltyReportDisputeCorrection) GetItemId() string {
 	if m != nil {
-		return m.BillingAddress
+		return m.ItemId
 	}
-	return nil
+	return ""
 }
 
-func (m *VatTransaction) GetUserId() string {
+func (m *RoyaltyReportDisputeCorrection) GetType() string {
 	if m != nil {
-		return m.UserId
+		return m.Type
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetPaymentMethod() string {
+func (m *RoyaltyReportDisputeCorrection) GetAmount() float64 {
 	if m != nil {
-		return m.PaymentMethod
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *VatTransaction) GetIsDeduction() bool {
+func (m *RoyaltyReportDisputeCorrection) GetCurrency() string {
 	if m != nil {
-		return m.IsDeduction
+		return m.Currency
 	}
-	return false
+	return ""
 }
 
-func (m *VatTransaction) GetCountry() string {
+func (m *RoyaltyReportDisputeCorrection) GetReason() string {
 	if m != nil {
-		return m.Country
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *VatTransaction) GetDateTime() *timestamp.Timestamp {
+func (m *RoyaltyReportDisputeCorrection) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.DateTime
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type VatReport struct {
-	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
-	//@inject_tag: json:"country" bson:"country" validate:"required,alpha,len=2"
-	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country" bson:"country" validate:"required,alpha,len=2"`
-	// @inject_tag: json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"
-	VatRate float64 `protobuf:"fixed64,3,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"`
-	//@inject_tag: json:"currency" bson:"currency" validate:"required,alpha,len=3"
-	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
-	// @inject_tag: json:"transactions_count" bson:"transactions_count" validate:"numeric,gte=0"
-	TransactionsCount int32 `protobuf:"varint,5,opt,name=transactions_count,json=transactionsCount,proto3" json:"transactions_count" bson:"transactions_count" validate:"numeric,gte=0"`
-	// @inject_tag: json:"gross_revenue" bson:"gross_revenue" validate:"numeric,gte=0"
-	GrossRevenue float64 `protobuf:"fixed64,6,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue" validate:"numeric,gte=0"`
-	// @inject_tag: json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"
-	VatAmount float64 `protobuf:"fixed64,7,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"`
-	// @inject_tag: json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"
-	FeesAmount float64 `protobuf:"fixed64,8,opt,name=fees_amount,json=feesAmount,proto3" json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"`
-	// @inject_tag: json:"deduction_amount" bson:"deduction_amount" validate:"numeric,gte=0"
-	DeductionAmount float64 `protobuf:"fixed64,9,opt,name=deduction_amount,json=deductionAmount,proto3" json:"deduction_amount" bson:"deduction_amount" validate:"numeric,gte=0"`
-	// @inject_tag: json:"correction_amount" bson:"correction_amount" validate:"numeric,lte=0"
-	CorrectionAmount float64 `protobuf:"fixed64,10,opt,name=correction_amount,json=correctionAmount,proto3" json:"correction_amount" bson:"correction_amount" validate:"numeric,lte=0"`
-	//@inject_tag: json:"status" bson:"status" validate:"required,alpha,oneof=threshold expired pending need_to_pay paid overdue canceled"
-	Status string `protobuf:"bytes,11,opt,name=status,proto3" json:"status" bson:"status" validate:"required,alpha,oneof=threshold expired pending need_to_pay paid overdue canceled"`
-	// @inject_tag: json:"country_annual_turnover" bson:"country_annual_turnover" validate:"numeric,gte=0"
-	CountryAnnualTurnover float64 `protobuf:"fixed64,12,opt,name=country_annual_turnover,json=countryAnnualTurnover,proto3" json:"country_annual_turnover" bson:"country_annual_turnover" validate:"numeric,gte=0"`
-	// @inject_tag: json:"world_annual_turnover" bson:"world_annual_turnover" validate:"numeric,gte=0"
-	WorldAnnualTurnover float64 `protobuf:"fixed64,13,opt,name=world_annual_turnover,json=worldAnnualTurnover,proto3" json:"world_annual_turnover" bson:"world_annual_turnover" validate:"numeric,gte=0"`
-	//@inject_tag: json:"amounts_approximate" bson:"amounts_approximate"
-	AmountsApproximate bool `protobuf:"varint,14,opt,name=amounts_approximate,json=amountsApproximate,proto3" json:"amounts_approximate" bson:"amounts_approximate"`
-	//@inject_tag: json:"date_from" bson:"date_from"
-	DateFrom *timestamp.Timestamp `protobuf:"bytes,15,opt,name=date_from,json=dateFrom,proto3" json:"date_from" bson:"date_from"`
-	//@inject_tag: json:"date_to" bson:"date_to"
-	DateTo *timestamp.Timestamp `protobuf:"bytes,16,opt,name=date_to,json=dateTo,proto3" json:"date_to" bson:"date_to"`
-	//@inject_tag: json:"pay_until_date" bson:"pay_until_date"
-	PayUntilDate *timestamp.Timestamp `protobuf:"bytes,17,opt,name=pay_until_date,json=payUntilDate,proto3" json:"pay_until_date" bson:"pay_until_date"`
-	//@inject_tag: json:"created_at" bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
-	//@inject_tag: json:"updated_at" bson:"updated_at"
-	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" bson:"updated_at"`
-	//@inject_tag: json:"paid_at" bson:"paid_at"
-	PaidAt               *timestamp.Timestamp `protobuf:"bytes,20,opt,name=paid_at,json=paidAt,proto3" json:"paid_at" bson:"paid_at"`
+type RoyaltyReportDispute struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"royalty_report_id"
+	RoyaltyReportId string `protobuf:"bytes,2,opt,name=royalty_report_id,json=royaltyReportId,proto3" json:"royalty_report_id"`
+	//@inject_tag: json:"merchant_id"
+	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id"`
+	// version of royalty report which was disputed
+	//@inject_tag: json:"report_version"
+	ReportVersion int32 `protobuf:"varint,4,opt,name=report_version,json=reportVersion,proto3" json:"report_version"`
+	//@inject_tag: json:"status"
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status"`
+	//@inject_tag: json:"reason"
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	//@inject_tag: json:"items"
+	Items []*RoyaltyReportDisputeItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items"`
+	//@inject_tag: json:"corrections"
+	Corrections []*RoyaltyReportDisputeCorrection `protobuf:"bytes,8,rep,name=corrections,proto3" json:"corrections"`
+	//@inject_tag: json:"resolution"
+	Resolution string `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	//@inject_tag: json:"resolved_at"
+	ResolvedAt           *timestamp.Timestamp `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatReport) Reset()         { *m = VatReport{} }
-func (m *VatReport) String() string { return proto.CompactTextString(m) }
-func (*VatReport) ProtoMessage()    {}
-func (*VatReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{96}
+func (m *RoyaltyReportDispute) Reset()         { *m = RoyaltyReportDispute{} }
+func (m *RoyaltyReportDispute) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportDispute) ProtoMessage()    {}
+func (*RoyaltyReportDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{105}
 }
 
-func (m *VatReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VatReport.Unmarshal(m, b)
+func (m *RoyaltyReportDispute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportDispute.Unmarshal(m, b)
 }
-func (m *VatReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VatReport.Marshal(b, m, deterministic)
+func (m *RoyaltyReportDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportDispute.Marshal(b, m, deterministic)
 }
-func (m *VatReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VatReport.Merge(m, src)
+func (m *RoyaltyReportDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportDispute.Merge(m, src)
 }
-func (m *VatReport) XXX_Size() int {
-	return xxx_messageInfo_VatReport.Size(m)
+func (m *RoyaltyReportDispute) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportDispute.Size(m)
 }
-func (m *VatReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_VatReport.DiscardUnknown(m)
+func (m *RoyaltyReportDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportDispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VatReport proto.InternalMessageInfo
+var xxx_messageInfo_RoyaltyReportDispute proto.InternalMessageInfo
 
-func (m *VatReport) GetId() string {
+func (m *RoyaltyReportDispute) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *VatReport) GetCountry() string {
+func (m *RoyaltyReportDispute) GetRoyaltyReportId() string {
 	if m != nil {
-		return m.Country
+		return m.RoyaltyReportId
 	}
 	return ""
 }
 
-func (m *VatReport) GetVatRate() float64 {
+func (m *RoyaltyReportDispute) GetMerchantId() string {
 	if m != nil {
-		return m.VatRate
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *RoyaltyReportDispute) GetReportVersion() int32 {
+	if m != nil {
+		return m.ReportVersion
 	}
 	return 0
 }
 
-func (m *VatReport) GetCurrency() string {
+func (m *RoyaltyReportDispute) GetStatus() string {
 	if m != nil {
-		return m.Currency
+		return m.Status
 	}
 	return ""
 }
 
-func (m *VatReport) GetTransactionsCount() int32 {
+func (m *RoyaltyReportDispute) GetReason() string {
 	if m != nil {
-		return m.TransactionsCount
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-func (m *VatReport) GetGrossRevenue() float64 {
+func (m *RoyaltyReportDispute) GetItems() []*RoyaltyReportDisputeItem {
 	if m != nil {
-		return m.GrossRevenue
+		return m.Items
 	}
-	return 0
+	return nil
 }
 
-func (m *VatReport) GetVatAmount() float64 {
+func (m *RoyaltyReportDispute) GetCorrections() []*RoyaltyReportDisputeCorrection {
 	if m != nil {
-		return m.VatAmount
+		return m.Corrections
 	}
-	return 0
+	return nil
 }
 
-func (m *VatReport) GetFeesAmount() float64 {
+func (m *RoyaltyReportDispute) GetResolution() string {
 	if m != nil {
-		return m.FeesAmount
+		return m.Resolution
 	}
-	return 0
+	return ""
 }
 
-func (m *VatReport) GetDeductionAmount() float64 {
+func (m *RoyaltyReportDispute) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.DeductionAmount
+		return m.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *VatReport) GetCorrectionAmount() float64 {
+func (m *RoyaltyReportDispute) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.CorrectionAmount
+		return m.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *VatReport) GetStatus() string {
+func (m *RoyaltyReportDispute) GetResolvedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Status
+		return m.ResolvedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *VatReport) GetCountryAnnualTurnover() float64 {
-	if m != nil {
-		return m.CountryAnnualTurnover
-	}
-	return 0
+type RoyaltyReportVersion struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"royalty_report_id"
+	RoyaltyReportId string `protobuf:"bytes,2,opt,name=royalty_report_id,json=royaltyReportId,proto3" json:"royalty_report_id"`
+	//@inject_tag: json:"version"
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+	// dispute which resolution replaced this version of report
+	//@inject_tag: json:"dispute_id"
+	DisputeId string `protobuf:"bytes,4,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id"`
+	//@inject_tag: json:"report"
+	Report *RoyaltyReport `protobuf:"bytes,5,opt,name=report,proto3" json:"report"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatReport) GetWorldAnnualTurnover() float64 {
-	if m != nil {
-		return m.WorldAnnualTurnover
-	}
-	return 0
+func (m *RoyaltyReportVersion) Reset()         { *m = RoyaltyReportVersion{} }
+func (m *RoyaltyReportVersion) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportVersion) ProtoMessage()    {}
+func (*RoyaltyReportVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{106}
 }
 
-func (m *VatReport) GetAmountsApproximate() bool {
-	if m != nil {
-		return m.AmountsApproximate
-	}
-	return false
+func (m *RoyaltyReportVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportVersion.Unmarshal(m, b)
+}
+func (m *RoyaltyReportVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportVersion.Marshal(b, m, deterministic)
+}
+func (m *RoyaltyReportVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportVersion.Merge(m, src)
+}
+func (m *RoyaltyReportVersion) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportVersion.Size(m)
+}
+func (m *RoyaltyReportVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportVersion.DiscardUnknown(m)
 }
 
-func (m *VatReport) GetDateFrom() *timestamp.Timestamp {
+var xxx_messageInfo_RoyaltyReportVersion proto.InternalMessageInfo
+
+func (m *RoyaltyReportVersion) GetId() string {
 	if m != nil {
-		return m.DateFrom
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *VatReport) GetDateTo() *timestamp.Timestamp {
+func (m *RoyaltyReportVersion) GetRoyaltyReportId() string {
 	if m != nil {
-		return m.DateTo
+		return m.RoyaltyReportId
 	}
-	return nil
+	return ""
 }
 
-func (m *VatReport) GetPayUntilDate() *timestamp.Timestamp {
+func (m *RoyaltyReportVersion) GetVersion() int32 {
 	if m != nil {
-		return m.PayUntilDate
+		return m.Version
 	}
-	return nil
+	return 0
 }
 
-func (m *VatReport) GetCreatedAt() *timestamp.Timestamp {
+func (m *RoyaltyReportVersion) GetDisputeId() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.DisputeId
 	}
-	return nil
+	return ""
 }
 
-func (m *VatReport) GetUpdatedAt() *timestamp.Timestamp {
+func (m *RoyaltyReportVersion) GetReport() *RoyaltyReport {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Report
 	}
 	return nil
 }
 
-func (m *VatReport) GetPaidAt() *timestamp.Timestamp {
+func (m *RoyaltyReportVersion) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaidAt
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type AnnualTurnover struct {
-	//@inject_tag: json:"year" bson:"year" validate:"required,numeric,gte=2019"
-	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year" bson:"year" validate:"required,numeric,gte=2019"`
-	//@inject_tag: json:"country" bson:"country" validate:"omitempty,alpha,len=2"
-	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country" bson:"country" validate:"omitempty,alpha,len=2"`
-	// @inject_tag: json:"amount" bson:"amount" validate:"numeric,gte=0"
-	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount" bson:"amount" validate:"numeric,gte=0"`
-	//@inject_tag: json:"currency" bson:"currency" validate:"required,alpha,len=3"
-	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type VatTransaction struct {
+	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"order_id" bson:"order_id" validate:"required,hexadecimal,len=24"
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id" bson:"order_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"transaction_id" bson:"transaction_id" validate:"required"
+	TransactionId string `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id" bson:"transaction_id" validate:"required"`
+	//@inject_tag: json:"transaction_type" bson:"transaction_type" validate:"required,oneof=payment refund chargeback"
+	TransactionType string `protobuf:"bytes,4,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type" bson:"transaction_type" validate:"required,oneof=payment refund chargeback"`
+	// @inject_tag: json:"transaction_amount" bson:"transaction_amount" validate:"numeric,gte=0"
+	TransactionAmount float64 `protobuf:"fixed64,5,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount" bson:"transaction_amount" validate:"numeric,gte=0"`
+	//@inject_tag: json:"transaction_currency" bson:"transaction_currency" validate:"required,alpha,len=3"
+	TransactionCurrency string `protobuf:"bytes,6,opt,name=transaction_currency,json=transactionCurrency,proto3" json:"transaction_currency" bson:"transaction_currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"
+	VatAmount float64 `protobuf:"fixed64,7,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"`
+	//@inject_tag: json:"vat_currency" bson:"vat_currency" validate:"required,alpha,len=3"
+	VatCurrency string `protobuf:"bytes,8,opt,name=vat_currency,json=vatCurrency,proto3" json:"vat_currency" bson:"vat_currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"
+	FeesAmount float64 `protobuf:"fixed64,9,opt,name=fees_amount,json=feesAmount,proto3" json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"`
+	//@inject_tag: json:"fees_currency" bson:"fees_currency" validate:"required,alpha,len=3"
+	FeesCurrency string `protobuf:"bytes,10,opt,name=fees_currency,json=feesCurrency,proto3" json:"fees_currency" bson:"fees_currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"local_transaction_amount" bson:"local_transaction_amount" validate:"omitempty,numeric,gte=0"
+	LocalTransactionAmount float64 `protobuf:"fixed64,11,opt,name=local_transaction_amount,json=localTransactionAmount,proto3" json:"local_transaction_amount" bson:"local_transaction_amount" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"local_vat_amount" bson:"local_vat_amount" validate:"omitempty,numeric,gte=0"
+	LocalVatAmount float64 `protobuf:"fixed64,12,opt,name=local_vat_amount,json=localVatAmount,proto3" json:"local_vat_amount" bson:"local_vat_amount" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"local_fees_amount" bson:"local_fees_amount" validate:"omitempty,numeric,gte=0"
+	LocalFeesAmount float64 `protobuf:"fixed64,13,opt,name=local_fees_amount,json=localFeesAmount,proto3" json:"local_fees_amount" bson:"local_fees_amount" validate:"omitempty,numeric,gte=0"`
+	//@inject_tag: json:"local_currency" bson:"local_currency" validate:"required,alpha,len=3"
+	LocalCurrency string `protobuf:"bytes,14,opt,name=local_currency,json=localCurrency,proto3" json:"local_currency" bson:"local_currency" validate:"required,alpha,len=3"`
+	//@inject_tag: json:"local_amounts_approximate" bson:"local_amounts_alocal_amounts_approximatepproximated" validate:"required,alpha,len=3"
+	LocalAmountsApproximate bool `protobuf:"varint,15,opt,name=local_amounts_approximate,json=localAmountsApproximate,proto3" json:"local_amounts_approximate" bson:"local_amounts_alocal_amounts_approximatepproximated" validate:"required,alpha,len=3"`
+	//@inject_tag: json:"billing_address_criteria" bson:"billing_address_criteria" validate:"required,oneof=ip bin language customer form"
+	BillingAddressCriteria string `protobuf:"bytes,16,opt,name=billing_address_criteria,json=billingAddressCriteria,proto3" json:"billing_address_criteria" bson:"billing_address_criteria" validate:"required,oneof=ip bin language customer form"`
+	//@inject_tag: json:"billing_address" bson:"billing_address" validate:"required,dive"
+	BillingAddress *OrderBillingAddress `protobuf:"bytes,17,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address" bson:"billing_address" validate:"required,dive"`
+	//@inject_tag: json:"user_id" bson:"user_id" validate:"omitempty,hexadecimal,len=24"
+	UserId string `protobuf:"bytes,18,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"payment_method" bson:"payment_method" validate:"required"
+	PaymentMethod string `protobuf:"bytes,19,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method" bson:"payment_method" validate:"required"`
+	//@inject_tag: json:"is_deduction" bson:"is_deduction"
+	IsDeduction bool `protobuf:"varint,20,opt,name=is_deduction,json=isDeduction,proto3" json:"is_deduction" bson:"is_deduction"`
+	//@inject_tag: json:"country" bson:"country" validate:"required,alpha,len=2"
+	Country string `protobuf:"bytes,21,opt,name=country,proto3" json:"country" bson:"country" validate:"required,alpha,len=2"`
+	//@inject_tag: json:"date_time" bson:"date_time"
+	DateTime             *timestamp.Timestamp `protobuf:"bytes,22,opt,name=date_time,json=dateTime,proto3" json:"date_time" bson:"date_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *AnnualTurnover) Reset()         { *m = AnnualTurnover{} }
-func (m *AnnualTurnover) String() string { return proto.CompactTextString(m) }
-func (*AnnualTurnover) ProtoMessage()    {}
-func (*AnnualTurnover) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{97}
+func (m *VatTransaction) Reset()         { *m = VatTransaction{} }
+func (m *VatTransaction) String() string { return proto.CompactTextString(m) }
+func (*VatTransaction) ProtoMessage()    {}
+func (*VatTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{107}
 }
 
-func (m *AnnualTurnover) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AnnualTurnover.Unmarshal(m, b)
+func (m *VatTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatTransaction.Unmarshal(m, b)
 }
-func (m *AnnualTurnover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AnnualTurnover.Marshal(b, m, deterministic)
+func (m *VatTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatTransaction.Marshal(b, m, deterministic)
 }
-func (m *AnnualTurnover) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AnnualTurnover.Merge(m, src)
+func (m *VatTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatTransaction.Merge(m, src)
 }
-func (m *AnnualTurnover) XXX_Size() int {
-	return xxx_messageInfo_AnnualTurnover.Size(m)
+func (m *VatTransaction) XXX_Size() int {
+	return xxx_messageInfo_VatTransaction.Size(m)
 }
-func (m *AnnualTurnover) XXX_DiscardUnknown() {
-	xxx_messageInfo_AnnualTurnover.DiscardUnknown(m)
+func (m *VatTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatTransaction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AnnualTurnover proto.InternalMessageInfo
+var xxx_messageInfo_VatTransaction proto.InternalMessageInfo
 
-func (m *AnnualTurnover) GetYear() int32 {
+func (m *VatTransaction) GetId() string {
 	if m != nil {
-		return m.Year
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *AnnualTurnover) GetCountry() string {
+func (m *VatTransaction) GetOrderId() string {
 	if m != nil {
-		return m.Country
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *AnnualTurnover) GetAmount() float64 {
+func (m *VatTransaction) GetTransactionId() string {
 	if m != nil {
-		return m.Amount
+		return m.TransactionId
 	}
-	return 0
+	return ""
 }
 
-func (m *AnnualTurnover) GetCurrency() string {
+func (m *VatTransaction) GetTransactionType() string {
 	if m != nil {
-		return m.Currency
+		return m.TransactionType
 	}
 	return ""
 }
 
-type OrderViewMoney struct {
-	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *VatTransaction) GetTransactionAmount() float64 {
+	if m != nil {
+		return m.TransactionAmount
+	}
+	return 0
 }
 
-func (m *OrderViewMoney) Reset()         { *m = OrderViewMoney{} }
-func (m *OrderViewMoney) String() string { return proto.CompactTextString(m) }
-func (*OrderViewMoney) ProtoMessage()    {}
-func (*OrderViewMoney) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{98}
+func (m *VatTransaction) GetTransactionCurrency() string {
+	if m != nil {
+		return m.TransactionCurrency
+	}
+	return ""
 }
 
-func (m *OrderViewMoney) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderViewMoney.Unmarshal(m, b)
+func (m *VatTransaction) GetVatAmount() float64 {
+	if m != nil {
+		return m.VatAmount
+	}
+	return 0
 }
-func (m *OrderViewMoney) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderViewMoney.Marshal(b, m, deterministic)
+
+func (m *VatTransaction) GetVatCurrency() string {
+	if m != nil {
+		return m.VatCurrency
+	}
+	return ""
 }
-func (m *OrderViewMoney) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderViewMoney.Merge(m, src)
+
+func (m *VatTransaction) GetFeesAmount() float64 {
+	if m != nil {
+		return m.FeesAmount
+	}
+	return 0
 }
-func (m *OrderViewMoney) XXX_Size() int {
-	return xxx_messageInfo_OrderViewMoney.Size(m)
+
+func (m *VatTransaction) GetFeesCurrency() string {
+	if m != nil {
+		return m.FeesCurrency
+	}
+	return ""
 }
-func (m *OrderViewMoney) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderViewMoney.DiscardUnknown(m)
+
+func (m *VatTransaction) GetLocalTransactionAmount() float64 {
+	if m != nil {
+		return m.LocalTransactionAmount
+	}
+	return 0
 }
 
-var xxx_messageInfo_OrderViewMoney proto.InternalMessageInfo
+func (m *VatTransaction) GetLocalVatAmount() float64 {
+	if m != nil {
+		return m.LocalVatAmount
+	}
+	return 0
+}
 
-func (m *OrderViewMoney) GetAmount() float64 {
+func (m *VatTransaction) GetLocalFeesAmount() float64 {
 	if m != nil {
-		return m.Amount
+		return m.LocalFeesAmount
 	}
 	return 0
 }
 
-func (m *OrderViewMoney) GetCurrency() string {
+func (m *VatTransaction) GetLocalCurrency() string {
 	if m != nil {
-		return m.Currency
+		return m.LocalCurrency
 	}
 	return ""
 }
 
-type OrderViewPublic struct {
-	// @inject_tag: json:"-" bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"-" bson:"_id"`
-	// @inject_tag: json:"uuid" bson:"uuid"
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid" bson:"uuid"`
-	// @inject_tag: json:"total_payment_amount" bson:"total_payment_amount"
-	TotalPaymentAmount float64 `protobuf:"fixed64,3,opt,name=total_payment_amount,json=totalPaymentAmount,proto3" json:"total_payment_amount" bson:"total_payment_amount"`
-	// @inject_tag: json:"currency" bson:"currency"
-	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency"`
-	// @inject_tag: json:"project" bson:"project"
-	Project *ProjectOrder `protobuf:"bytes,5,opt,name=project,proto3" json:"project" bson:"project"`
-	// @inject_tag: bson:"created_at" bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at" bson:"created_at"`
-	// @inject_tag: json:"transaction" bson:"pm_order_id"
-	Transaction string `protobuf:"bytes,7,opt,name=transaction,proto3" json:"transaction" bson:"pm_order_id"`
-	// @inject_tag: json:"payment_method" bson:"payment_method"
-	PaymentMethod *PaymentMethodOrder `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method" bson:"payment_method"`
-	// @inject_tag: json:"country_code" bson:"country_code"
-	CountryCode string `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code" bson:"country_code"`
-	// @inject_tag: json:"merchant_id" bson:"merchant_id"
-	MerchantId string `protobuf:"bytes,10,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" bson:"merchant_id"`
-	// @inject_tag: json:"locale" bson:"locale"
-	Locale string `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale" bson:"locale"`
-	// @inject_tag: json:"status" bson:"status"
-	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status" bson:"status"`
-	// @inject_tag: json:"transaction_date" bson:"pm_order_close_date"
-	TransactionDate *timestamp.Timestamp `protobuf:"bytes,13,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date" bson:"pm_order_close_date"`
-	// @inject_tag: json:"user" bson:"user"
-	User *OrderUser `protobuf:"bytes,14,opt,name=user,proto3" json:"user" bson:"user"`
-	// @inject_tag: json:"billing_address" bson:"billing_address"
-	BillingAddress *OrderBillingAddress `protobuf:"bytes,15,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address" bson:"billing_address"`
-	// @inject_tag: json:"type" bson:"type"
-	Type string `protobuf:"bytes,16,opt,name=type,proto3" json:"type" bson:"type"`
-	// @inject_tag: json:"is_vat_deduction" bson:"is_vat_deduction"
-	IsVatDeduction bool `protobuf:"varint,17,opt,name=is_vat_deduction,json=isVatDeduction,proto3" json:"is_vat_deduction" bson:"is_vat_deduction"`
-	// @inject_tag: json:"gross_revenue" bson:"gross_revenue"
-	GrossRevenue *OrderViewMoney `protobuf:"bytes,18,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue"`
-	// @inject_tag: json:"tax_fee" bson:"tax_fee"
-	TaxFee *OrderViewMoney `protobuf:"bytes,19,opt,name=tax_fee,json=taxFee,proto3" json:"tax_fee" bson:"tax_fee"`
-	// @inject_tag: json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"
-	TaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,20,opt,name=tax_fee_currency_exchange_fee,json=taxFeeCurrencyExchangeFee,proto3" json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"`
-	// @inject_tag: json:"tax_fee_total" bson:"tax_fee_total"
-	TaxFeeTotal *OrderViewMoney `protobuf:"bytes,21,opt,name=tax_fee_total,json=taxFeeTotal,proto3" json:"tax_fee_total" bson:"tax_fee_total"`
-	// @inject_tag: json:"method_fee_total" bson:"method_fee_total"
-	MethodFeeTotal *OrderViewMoney `protobuf:"bytes,22,opt,name=method_fee_total,json=methodFeeTotal,proto3" json:"method_fee_total" bson:"method_fee_total"`
-	// @inject_tag: json:"method_fee_tariff" bson:"method_fee_tariff"
-	MethodFeeTariff *OrderViewMoney `protobuf:"bytes,23,opt,name=method_fee_tariff,json=methodFeeTariff,proto3" json:"method_fee_tariff" bson:"method_fee_tariff"`
-	// @inject_tag: json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"
-	MethodFixedFeeTariff *OrderViewMoney `protobuf:"bytes,24,opt,name=method_fixed_fee_tariff,json=methodFixedFeeTariff,proto3" json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"`
-	// @inject_tag: json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"
-	PaysuperFixedFee *OrderViewMoney `protobuf:"bytes,25,opt,name=paysuper_fixed_fee,json=paysuperFixedFee,proto3" json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"`
-	// @inject_tag: json:"fees_total" bson:"fees_total"
-	FeesTotal *OrderViewMoney `protobuf:"bytes,26,opt,name=fees_total,json=feesTotal,proto3" json:"fees_total" bson:"fees_total"`
-	// @inject_tag: json:"fees_total_local" bson:"fees_total_local"
-	FeesTotalLocal *OrderViewMoney `protobuf:"bytes,27,opt,name=fees_total_local,json=feesTotalLocal,proto3" json:"fees_total_local" bson:"fees_total_local"`
-	// @inject_tag: json:"net_revenue" bson:"net_revenue"
-	NetRevenue *OrderViewMoney `protobuf:"bytes,28,opt,name=net_revenue,json=netRevenue,proto3" json:"net_revenue" bson:"net_revenue"`
-	// @inject_tag: json:"refund_gross_revenue" bson:"refund_gross_revenue"
-	RefundGrossRevenue *OrderViewMoney `protobuf:"bytes,29,opt,name=refund_gross_revenue,json=refundGrossRevenue,proto3" json:"refund_gross_revenue" bson:"refund_gross_revenue"`
-	// @inject_tag: json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"
-	MethodRefundFeeTariff *OrderViewMoney `protobuf:"bytes,30,opt,name=method_refund_fee_tariff,json=methodRefundFeeTariff,proto3" json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"`
-	// @inject_tag: json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"
-	MerchantRefundFixedFeeTariff *OrderViewMoney `protobuf:"bytes,31,opt,name=merchant_refund_fixed_fee_tariff,json=merchantRefundFixedFeeTariff,proto3" json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"`
-	// @inject_tag: json:"refund_tax_fee" bson:"refund_tax_fee"
-	RefundTaxFee *OrderViewMoney `protobuf:"bytes,32,opt,name=refund_tax_fee,json=refundTaxFee,proto3" json:"refund_tax_fee" bson:"refund_tax_fee"`
-	// @inject_tag: json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"
-	RefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,33,opt,name=refund_tax_fee_currency_exchange_fee,json=refundTaxFeeCurrencyExchangeFee,proto3" json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"`
-	// @inject_tag: json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"
-	PaysuperRefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,34,opt,name=paysuper_refund_tax_fee_currency_exchange_fee,json=paysuperRefundTaxFeeCurrencyExchangeFee,proto3" json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"`
-	// @inject_tag: json:"refund_reverse_revenue" bson:"refund_reverse_revenue"
-	RefundReverseRevenue *OrderViewMoney `protobuf:"bytes,35,opt,name=refund_reverse_revenue,json=refundReverseRevenue,proto3" json:"refund_reverse_revenue" bson:"refund_reverse_revenue"`
-	// @inject_tag: json:"refund_fees_total" bson:"refund_fees_total"
-	RefundFeesTotal *OrderViewMoney `protobuf:"bytes,36,opt,name=refund_fees_total,json=refundFeesTotal,proto3" json:"refund_fees_total" bson:"refund_fees_total"`
-	// @inject_tag: json:"refund_fees_total_local" bson:"refund_fees_total_local"
-	RefundFeesTotalLocal *OrderViewMoney `protobuf:"bytes,37,opt,name=refund_fees_total_local,json=refundFeesTotalLocal,proto3" json:"refund_fees_total_local" bson:"refund_fees_total_local"`
-	Issuer               *OrderIssuer    `protobuf:"bytes,38,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	// @inject_tag: json:"items" bson:"items"
-	Items []*OrderItem `protobuf:"bytes,39,rep,name=items,proto3" json:"items" bson:"items"`
-	//@inject_tag: json:"merchant_payout_currency" bson:"merchant_payout_currency"
-	MerchantPayoutCurrency string   `protobuf:"bytes,40,opt,name=merchant_payout_currency,json=merchantPayoutCurrency,proto3" json:"merchant_payout_currency" bson:"merchant_payout_currency"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized       []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache          int32    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *OrderViewPublic) Reset()         { *m = OrderViewPublic{} }
-func (m *OrderViewPublic) String() string { return proto.CompactTextString(m) }
-func (*OrderViewPublic) ProtoMessage()    {}
-func (*OrderViewPublic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{99}
-}
-
-func (m *OrderViewPublic) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderViewPublic.Unmarshal(m, b)
-}
-func (m *OrderViewPublic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderViewPublic.Marshal(b, m, deterministic)
-}
-func (m *OrderViewPublic) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderViewPublic.Merge(m, src)
-}
-func (m *OrderViewPublic) XXX_Size() int {
-	return xxx_messageInfo_OrderViewPublic.Size(m)
-}
-func (m *OrderViewPublic) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderViewPublic.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OrderViewPublic proto.InternalMessageInfo
-
-func (m *OrderViewPublic) GetId() string {
+func (m *VatTransaction) GetLocalAmountsApproximate() bool {
 	if m != nil {
-		return m.Id
+		return m.LocalAmountsApproximate
 	}
-	return ""
+	return false
 }
 
-func (m *OrderViewPublic) GetUuid() string {
+func (m *VatTransaction) GetBillingAddressCriteria() string {
 	if m != nil {
-		return m.Uuid
+		return m.BillingAddressCriteria
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetTotalPaymentAmount() float64 {
+func (m *VatTransaction) GetBillingAddress() *OrderBillingAddress {
 	if m != nil {
-		return m.TotalPaymentAmount
+		return m.BillingAddress
 	}
-	return 0
+	return nil
 }
 
-func (m *OrderViewPublic) GetCurrency() string {
+func (m *VatTransaction) GetUserId() string {
 	if m != nil {
-		return m.Currency
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetProject() *ProjectOrder {
+func (m *VatTransaction) GetPaymentMethod() string {
 	if m != nil {
-		return m.Project
+		return m.PaymentMethod
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPublic) GetCreatedAt() *timestamp.Timestamp {
+func (m *VatTransaction) GetIsDeduction() bool {
 	if m != nil {
-		return m.CreatedAt
+		return m.IsDeduction
 	}
-	return nil
+	return false
 }
 
-func (m *OrderViewPublic) GetTransaction() string {
+func (m *VatTransaction) GetCountry() string {
 	if m != nil {
-		return m.Transaction
+		return m.Country
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetPaymentMethod() *PaymentMethodOrder {
+func (m *VatTransaction) GetDateTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaymentMethod
+		return m.DateTime
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetCountryCode() string {
+type VatReport struct {
+	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"country" bson:"country" validate:"required,alpha,len=2"
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country" bson:"country" validate:"required,alpha,len=2"`
+	// @inject_tag: json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"
+	VatRate float64 `protobuf:"fixed64,3,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate" bson:"vat_rate" validate:"numeric,gte=0,lte=1"`
+	//@inject_tag: json:"currency" bson:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"transactions_count" bson:"transactions_count" validate:"numeric,gte=0"
+	TransactionsCount int32 `protobuf:"varint,5,opt,name=transactions_count,json=transactionsCount,proto3" json:"transactions_count" bson:"transactions_count" validate:"numeric,gte=0"`
+	// @inject_tag: json:"gross_revenue" bson:"gross_revenue" validate:"numeric,gte=0"
+	GrossRevenue float64 `protobuf:"fixed64,6,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue" validate:"numeric,gte=0"`
+	// @inject_tag: json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"
+	VatAmount float64 `protobuf:"fixed64,7,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount" bson:"vat_amount" validate:"numeric,gte=0"`
+	// @inject_tag: json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"
+	FeesAmount float64 `protobuf:"fixed64,8,opt,name=fees_amount,json=feesAmount,proto3" json:"fees_amount" bson:"fees_amount" validate:"numeric,gte=0"`
+	// @inject_tag: json:"deduction_amount" bson:"deduction_amount" validate:"numeric,gte=0"
+	DeductionAmount float64 `protobuf:"fixed64,9,opt,name=deduction_amount,json=deductionAmount,proto3" json:"deduction_amount" bson:"deduction_amount" validate:"numeric,gte=0"`
+	// @inject_tag: json:"correction_amount" bson:"correction_amount" validate:"numeric,lte=0"
+	CorrectionAmount float64 `protobuf:"fixed64,10,opt,name=correction_amount,json=correctionAmount,proto3" json:"correction_amount" bson:"correction_amount" validate:"numeric,lte=0"`
+	//@inject_tag: json:"status" bson:"status" validate:"required,alpha,oneof=threshold expired pending need_to_pay paid overdue canceled"
+	Status string `protobuf:"bytes,11,opt,name=status,proto3" json:"status" bson:"status" validate:"required,alpha,oneof=threshold expired pending need_to_pay paid overdue canceled"`
+	// @inject_tag: json:"country_annual_turnover" bson:"country_annual_turnover" validate:"numeric,gte=0"
+	CountryAnnualTurnover float64 `protobuf:"fixed64,12,opt,name=country_annual_turnover,json=countryAnnualTurnover,proto3" json:"country_annual_turnover" bson:"country_annual_turnover" validate:"numeric,gte=0"`
+	// @inject_tag: json:"world_annual_turnover" bson:"world_annual_turnover" validate:"numeric,gte=0"
+	WorldAnnualTurnover float64 `protobuf:"fixed64,13,opt,name=world_annual_turnover,json=worldAnnualTurnover,proto3" json:"world_annual_turnover" bson:"world_annual_turnover" validate:"numeric,gte=0"`
+	//@inject_tag: json:"amounts_approximate" bson:"amounts_approximate"
+	AmountsApproximate bool `protobuf:"varint,14,opt,name=amounts_approximate,json=amountsApproximate,proto3" json:"amounts_approximate" bson:"amounts_approximate"`
+	//@inject_tag: json:"date_from" bson:"date_from"
+	DateFrom *timestamp.Timestamp `protobuf:"bytes,15,opt,name=date_from,json=dateFrom,proto3" json:"date_from" bson:"date_from"`
+	//@inject_tag: json:"date_to" bson:"date_to"
+	DateTo *timestamp.Timestamp `protobuf:"bytes,16,opt,name=date_to,json=dateTo,proto3" json:"date_to" bson:"date_to"`
+	//@inject_tag: json:"pay_until_date" bson:"pay_until_date"
+	PayUntilDate *timestamp.Timestamp `protobuf:"bytes,17,opt,name=pay_until_date,json=payUntilDate,proto3" json:"pay_until_date" bson:"pay_until_date"`
+	//@inject_tag: json:"created_at" bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
+	//@inject_tag: json:"updated_at" bson:"updated_at"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" bson:"updated_at"`
+	//@inject_tag: json:"paid_at" bson:"paid_at"
+	PaidAt *timestamp.Timestamp `protobuf:"bytes,20,opt,name=paid_at,json=paidAt,proto3" json:"paid_at" bson:"paid_at"`
+	//@inject_tag: json:"oss_return_id" bson:"oss_return_id"
+	OssReturnId          string   `protobuf:"bytes,21,opt,name=oss_return_id,json=ossReturnId,proto3" json:"oss_return_id" bson:"oss_return_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatReport) Reset()         { *m = VatReport{} }
+func (m *VatReport) String() string { return proto.CompactTextString(m) }
+func (*VatReport) ProtoMessage()    {}
+func (*VatReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{108}
+}
+
+func (m *VatReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatReport.Unmarshal(m, b)
+}
+func (m *VatReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatReport.Marshal(b, m, deterministic)
+}
+func (m *VatReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatReport.Merge(m, src)
+}
+func (m *VatReport) XXX_Size() int {
+	return xxx_messageInfo_VatReport.Size(m)
+}
+func (m *VatReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatReport proto.InternalMessageInfo
+
+func (m *VatReport) GetId() string {
 	if m != nil {
-		return m.CountryCode
+		return m.Id
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetMerchantId() string {
+func (m *VatReport) GetCountry() string {
 	if m != nil {
-		return m.MerchantId
+		return m.Country
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetLocale() string {
+func (m *VatReport) GetVatRate() float64 {
 	if m != nil {
-		return m.Locale
+		return m.VatRate
 	}
-	return ""
+	return 0
 }
 
-func (m *OrderViewPublic) GetStatus() string {
+func (m *VatReport) GetCurrency() string {
 	if m != nil {
-		return m.Status
+		return m.Currency
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetTransactionDate() *timestamp.Timestamp {
+func (m *VatReport) GetTransactionsCount() int32 {
 	if m != nil {
-		return m.TransactionDate
+		return m.TransactionsCount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetUser() *OrderUser {
+func (m *VatReport) GetGrossRevenue() float64 {
 	if m != nil {
-		return m.User
+		return m.GrossRevenue
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetBillingAddress() *OrderBillingAddress {
+func (m *VatReport) GetVatAmount() float64 {
 	if m != nil {
-		return m.BillingAddress
+		return m.VatAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetType() string {
+func (m *VatReport) GetFeesAmount() float64 {
 	if m != nil {
-		return m.Type
+		return m.FeesAmount
+	}
+	return 0
+}
+
+func (m *VatReport) GetDeductionAmount() float64 {
+	if m != nil {
+		return m.DeductionAmount
+	}
+	return 0
+}
+
+func (m *VatReport) GetCorrectionAmount() float64 {
+	if m != nil {
+		return m.CorrectionAmount
+	}
+	return 0
+}
+
+func (m *VatReport) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
 	return ""
 }
 
-func (m *OrderViewPublic) GetIsVatDeduction() bool {
+func (m *VatReport) GetCountryAnnualTurnover() float64 {
 	if m != nil {
-		return m.IsVatDeduction
+		return m.CountryAnnualTurnover
+	}
+	return 0
+}
+
+func (m *VatReport) GetWorldAnnualTurnover() float64 {
+	if m != nil {
+		return m.WorldAnnualTurnover
+	}
+	return 0
+}
+
+func (m *VatReport) GetAmountsApproximate() bool {
+	if m != nil {
+		return m.AmountsApproximate
 	}
 	return false
 }
 
-func (m *OrderViewPublic) GetGrossRevenue() *OrderViewMoney {
+func (m *VatReport) GetDateFrom() *timestamp.Timestamp {
 	if m != nil {
-		return m.GrossRevenue
+		return m.DateFrom
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetTaxFee() *OrderViewMoney {
+func (m *VatReport) GetDateTo() *timestamp.Timestamp {
 	if m != nil {
-		return m.TaxFee
+		return m.DateTo
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+func (m *VatReport) GetPayUntilDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.TaxFeeCurrencyExchangeFee
+		return m.PayUntilDate
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetTaxFeeTotal() *OrderViewMoney {
+func (m *VatReport) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.TaxFeeTotal
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetMethodFeeTotal() *OrderViewMoney {
+func (m *VatReport) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MethodFeeTotal
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetMethodFeeTariff() *OrderViewMoney {
+func (m *VatReport) GetPaidAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MethodFeeTariff
+		return m.PaidAt
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetMethodFixedFeeTariff() *OrderViewMoney {
+func (m *VatReport) GetOssReturnId() string {
 	if m != nil {
-		return m.MethodFixedFeeTariff
+		return m.OssReturnId
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPublic) GetPaysuperFixedFee() *OrderViewMoney {
+type VatOssReturnItem struct {
+	//@inject_tag: json:"country" bson:"country"
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country" bson:"country"`
+	//@inject_tag: json:"vat_rate" bson:"vat_rate"
+	VatRate float64 `protobuf:"fixed64,2,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate" bson:"vat_rate"`
+	//@inject_tag: json:"taxable_amount" bson:"taxable_amount"
+	TaxableAmount float64 `protobuf:"fixed64,3,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount" bson:"taxable_amount"`
+	//@inject_tag: json:"vat_amount" bson:"vat_amount"
+	VatAmount float64 `protobuf:"fixed64,4,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount" bson:"vat_amount"`
+	//@inject_tag: json:"local_currency" bson:"local_currency"
+	LocalCurrency string `protobuf:"bytes,5,opt,name=local_currency,json=localCurrency,proto3" json:"local_currency" bson:"local_currency"`
+	//@inject_tag: json:"local_taxable_amount" bson:"local_taxable_amount"
+	LocalTaxableAmount float64 `protobuf:"fixed64,6,opt,name=local_taxable_amount,json=localTaxableAmount,proto3" json:"local_taxable_amount" bson:"local_taxable_amount"`
+	//@inject_tag: json:"local_vat_amount" bson:"local_vat_amount"
+	LocalVatAmount float64 `protobuf:"fixed64,7,opt,name=local_vat_amount,json=localVatAmount,proto3" json:"local_vat_amount" bson:"local_vat_amount"`
+	//@inject_tag: json:"vat_report_ids" bson:"vat_report_ids"
+	VatReportIds         []string `protobuf:"bytes,8,rep,name=vat_report_ids,json=vatReportIds,proto3" json:"vat_report_ids" bson:"vat_report_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatOssReturnItem) Reset()         { *m = VatOssReturnItem{} }
+func (m *VatOssReturnItem) String() string { return proto.CompactTextString(m) }
+func (*VatOssReturnItem) ProtoMessage()    {}
+func (*VatOssReturnItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{109}
+}
+
+func (m *VatOssReturnItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatOssReturnItem.Unmarshal(m, b)
+}
+func (m *VatOssReturnItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatOssReturnItem.Marshal(b, m, deterministic)
+}
+func (m *VatOssReturnItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatOssReturnItem.Merge(m, src)
+}
+func (m *VatOssReturnItem) XXX_Size() int {
+	return xxx_messageInfo_VatOssReturnItem.Size(m)
+}
+func (m *VatOssReturnItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatOssReturnItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatOssReturnItem proto.InternalMessageInfo
+
+func (m *VatOssReturnItem) GetCountry() string {
 	if m != nil {
-		return m.PaysuperFixedFee
+		return m.Country
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPublic) GetFeesTotal() *OrderViewMoney {
+func (m *VatOssReturnItem) GetVatRate() float64 {
 	if m != nil {
-		return m.FeesTotal
+		return m.VatRate
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetFeesTotalLocal() *OrderViewMoney {
+func (m *VatOssReturnItem) GetTaxableAmount() float64 {
 	if m != nil {
-		return m.FeesTotalLocal
+		return m.TaxableAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetNetRevenue() *OrderViewMoney {
+func (m *VatOssReturnItem) GetVatAmount() float64 {
 	if m != nil {
-		return m.NetRevenue
+		return m.VatAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetRefundGrossRevenue() *OrderViewMoney {
+func (m *VatOssReturnItem) GetLocalCurrency() string {
 	if m != nil {
-		return m.RefundGrossRevenue
+		return m.LocalCurrency
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPublic) GetMethodRefundFeeTariff() *OrderViewMoney {
+func (m *VatOssReturnItem) GetLocalTaxableAmount() float64 {
 	if m != nil {
-		return m.MethodRefundFeeTariff
+		return m.LocalTaxableAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetMerchantRefundFixedFeeTariff() *OrderViewMoney {
+func (m *VatOssReturnItem) GetLocalVatAmount() float64 {
 	if m != nil {
-		return m.MerchantRefundFixedFeeTariff
+		return m.LocalVatAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPublic) GetRefundTaxFee() *OrderViewMoney {
+func (m *VatOssReturnItem) GetVatReportIds() []string {
 	if m != nil {
-		return m.RefundTaxFee
+		return m.VatReportIds
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+type VatOssReturnFile struct {
+	//@inject_tag: json:"format" bson:"format"
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format" bson:"format"`
+	//@inject_tag: json:"file_name" bson:"file_name"
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name" bson:"file_name"`
+	//@inject_tag: json:"content" bson:"content"
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content" bson:"content"`
+	//@inject_tag: json:"checksum" bson:"checksum"
+	Checksum             string   `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum" bson:"checksum"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatOssReturnFile) Reset()         { *m = VatOssReturnFile{} }
+func (m *VatOssReturnFile) String() string { return proto.CompactTextString(m) }
+func (*VatOssReturnFile) ProtoMessage()    {}
+func (*VatOssReturnFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{110}
+}
+
+func (m *VatOssReturnFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatOssReturnFile.Unmarshal(m, b)
+}
+func (m *VatOssReturnFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatOssReturnFile.Marshal(b, m, deterministic)
+}
+func (m *VatOssReturnFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatOssReturnFile.Merge(m, src)
+}
+func (m *VatOssReturnFile) XXX_Size() int {
+	return xxx_messageInfo_VatOssReturnFile.Size(m)
+}
+func (m *VatOssReturnFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatOssReturnFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatOssReturnFile proto.InternalMessageInfo
+
+func (m *VatOssReturnFile) GetFormat() string {
 	if m != nil {
-		return m.RefundTaxFeeCurrencyExchangeFee
+		return m.Format
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPublic) GetPaysuperRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+func (m *VatOssReturnFile) GetFileName() string {
 	if m != nil {
-		return m.PaysuperRefundTaxFeeCurrencyExchangeFee
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *VatOssReturnFile) GetContent() []byte {
+	if m != nil {
+		return m.Content
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetRefundReverseRevenue() *OrderViewMoney {
+func (m *VatOssReturnFile) GetChecksum() string {
 	if m != nil {
-		return m.RefundReverseRevenue
+		return m.Checksum
+	}
+	return ""
+}
+
+type VatOssReturn struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"year"
+	Year int32 `protobuf:"varint,2,opt,name=year,proto3" json:"year"`
+	//@inject_tag: json:"quarter"
+	Quarter int32 `protobuf:"varint,3,opt,name=quarter,proto3" json:"quarter"`
+	//@inject_tag: json:"date_from"
+	DateFrom *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from"`
+	//@inject_tag: json:"date_to"
+	DateTo *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"items"
+	Items []*VatOssReturnItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items"`
+	//@inject_tag: json:"total_taxable_amount"
+	TotalTaxableAmount float64 `protobuf:"fixed64,8,opt,name=total_taxable_amount,json=totalTaxableAmount,proto3" json:"total_taxable_amount"`
+	//@inject_tag: json:"total_vat_amount"
+	TotalVatAmount float64 `protobuf:"fixed64,9,opt,name=total_vat_amount,json=totalVatAmount,proto3" json:"total_vat_amount"`
+	//@inject_tag: json:"vat_report_ids"
+	VatReportIds []string `protobuf:"bytes,10,rep,name=vat_report_ids,json=vatReportIds,proto3" json:"vat_report_ids"`
+	//@inject_tag: json:"files"
+	Files []*VatOssReturnFile `protobuf:"bytes,11,rep,name=files,proto3" json:"files"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatOssReturn) Reset()         { *m = VatOssReturn{} }
+func (m *VatOssReturn) String() string { return proto.CompactTextString(m) }
+func (*VatOssReturn) ProtoMessage()    {}
+func (*VatOssReturn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{111}
+}
+
+func (m *VatOssReturn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatOssReturn.Unmarshal(m, b)
+}
+func (m *VatOssReturn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatOssReturn.Marshal(b, m, deterministic)
+}
+func (m *VatOssReturn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatOssReturn.Merge(m, src)
+}
+func (m *VatOssReturn) XXX_Size() int {
+	return xxx_messageInfo_VatOssReturn.Size(m)
+}
+func (m *VatOssReturn) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatOssReturn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatOssReturn proto.InternalMessageInfo
+
+func (m *VatOssReturn) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VatOssReturn) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *VatOssReturn) GetQuarter() int32 {
+	if m != nil {
+		return m.Quarter
+	}
+	return 0
+}
+
+func (m *VatOssReturn) GetDateFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateFrom
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetRefundFeesTotal() *OrderViewMoney {
+func (m *VatOssReturn) GetDateTo() *timestamp.Timestamp {
 	if m != nil {
-		return m.RefundFeesTotal
+		return m.DateTo
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetRefundFeesTotalLocal() *OrderViewMoney {
+func (m *VatOssReturn) GetCurrency() string {
 	if m != nil {
-		return m.RefundFeesTotalLocal
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *VatOssReturn) GetItems() []*VatOssReturnItem {
+	if m != nil {
+		return m.Items
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetIssuer() *OrderIssuer {
+func (m *VatOssReturn) GetTotalTaxableAmount() float64 {
 	if m != nil {
-		return m.Issuer
+		return m.TotalTaxableAmount
+	}
+	return 0
+}
+
+func (m *VatOssReturn) GetTotalVatAmount() float64 {
+	if m != nil {
+		return m.TotalVatAmount
+	}
+	return 0
+}
+
+func (m *VatOssReturn) GetVatReportIds() []string {
+	if m != nil {
+		return m.VatReportIds
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetItems() []*OrderItem {
+func (m *VatOssReturn) GetFiles() []*VatOssReturnFile {
 	if m != nil {
-		return m.Items
+		return m.Files
 	}
 	return nil
 }
 
-func (m *OrderViewPublic) GetMerchantPayoutCurrency() string {
+func (m *VatOssReturn) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MerchantPayoutCurrency
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type OrderViewPrivate struct {
-	// @inject_tag: json:"-" bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"-" bson:"_id"`
-	// @inject_tag: json:"uuid" bson:"uuid"
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid" bson:"uuid"`
-	// @inject_tag: json:"total_payment_amount" bson:"total_payment_amount"
-	TotalPaymentAmount float64 `protobuf:"fixed64,3,opt,name=total_payment_amount,json=totalPaymentAmount,proto3" json:"total_payment_amount" bson:"total_payment_amount"`
-	// @inject_tag: json:"currency" bson:"currency"
-	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency"`
-	// @inject_tag: json:"project" bson:"project"
-	Project *ProjectOrder `protobuf:"bytes,5,opt,name=project,proto3" json:"project" bson:"project"`
-	// @inject_tag: json:"created_at" bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
-	// @inject_tag: json:"transaction" bson:"pm_order_id"
-	Transaction string `protobuf:"bytes,7,opt,name=transaction,proto3" json:"transaction" bson:"pm_order_id"`
-	// @inject_tag: json:"payment_method" bson:"payment_method"
-	PaymentMethod *PaymentMethodOrder `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method" bson:"payment_method"`
-	// @inject_tag: json:"country_code" bson:"country_code"
-	CountryCode string `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code" bson:"country_code"`
-	// @inject_tag: json:"merchant_id" bson:"merchant_id"
-	MerchantId string `protobuf:"bytes,10,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" bson:"merchant_id"`
-	// @inject_tag: json:"locale" bson:"locale"
-	Locale string `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale" bson:"locale"`
-	// @inject_tag: json:"status" bson:"status"
-	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status" bson:"status"`
-	// @inject_tag: json:"transaction_date" bson:"pm_order_close_date"
-	TransactionDate *timestamp.Timestamp `protobuf:"bytes,13,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date" bson:"pm_order_close_date"`
-	// @inject_tag: json:"user" bson:"user"
-	User *OrderUser `protobuf:"bytes,14,opt,name=user,proto3" json:"user" bson:"user"`
-	// @inject_tag: json:"billing_address" bson:"billing_address"
-	BillingAddress *OrderBillingAddress `protobuf:"bytes,15,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address" bson:"billing_address"`
-	// @inject_tag: json:"type" bson:"type"
-	Type string `protobuf:"bytes,16,opt,name=type,proto3" json:"type" bson:"type"`
-	// @inject_tag: json:"is_vat_deduction" bson:"is_vat_deduction"
-	IsVatDeduction bool `protobuf:"varint,17,opt,name=is_vat_deduction,json=isVatDeduction,proto3" json:"is_vat_deduction" bson:"is_vat_deduction"`
-	// @inject_tag: json:"payment_gross_revenue_local" bson:"payment_gross_revenue_local"
-	PaymentGrossRevenueLocal *OrderViewMoney `protobuf:"bytes,18,opt,name=payment_gross_revenue_local,json=paymentGrossRevenueLocal,proto3" json:"payment_gross_revenue_local" bson:"payment_gross_revenue_local"`
-	// @inject_tag: json:"payment_gross_revenue_origin" bson:"payment_gross_revenue_origin"
-	PaymentGrossRevenueOrigin *OrderViewMoney `protobuf:"bytes,19,opt,name=payment_gross_revenue_origin,json=paymentGrossRevenueOrigin,proto3" json:"payment_gross_revenue_origin" bson:"payment_gross_revenue_origin"`
-	// @inject_tag: json:"payment_gross_revenue" bson:"payment_gross_revenue"
-	PaymentGrossRevenue *OrderViewMoney `protobuf:"bytes,20,opt,name=payment_gross_revenue,json=paymentGrossRevenue,proto3" json:"payment_gross_revenue" bson:"payment_gross_revenue"`
-	// @inject_tag: json:"payment_tax_fee" bson:"payment_tax_fee"
-	PaymentTaxFee *OrderViewMoney `protobuf:"bytes,21,opt,name=payment_tax_fee,json=paymentTaxFee,proto3" json:"payment_tax_fee" bson:"payment_tax_fee"`
-	// @inject_tag: json:"payment_tax_fee_local" bson:"payment_tax_fee_local"
-	PaymentTaxFeeLocal *OrderViewMoney `protobuf:"bytes,22,opt,name=payment_tax_fee_local,json=paymentTaxFeeLocal,proto3" json:"payment_tax_fee_local" bson:"payment_tax_fee_local"`
-	// @inject_tag: json:"payment_tax_fee_origin" bson:"payment_tax_fee_origin"
-	PaymentTaxFeeOrigin *OrderViewMoney `protobuf:"bytes,23,opt,name=payment_tax_fee_origin,json=paymentTaxFeeOrigin,proto3" json:"payment_tax_fee_origin" bson:"payment_tax_fee_origin"`
-	// @inject_tag: json:"payment_tax_fee_currency_exchange_fee" bson:"payment_tax_fee_currency_exchange_fee"
-	PaymentTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,24,opt,name=payment_tax_fee_currency_exchange_fee,json=paymentTaxFeeCurrencyExchangeFee,proto3" json:"payment_tax_fee_currency_exchange_fee" bson:"payment_tax_fee_currency_exchange_fee"`
-	// @inject_tag: json:"payment_tax_fee_total" bson:"payment_tax_fee_total"
-	PaymentTaxFeeTotal *OrderViewMoney `protobuf:"bytes,25,opt,name=payment_tax_fee_total,json=paymentTaxFeeTotal,proto3" json:"payment_tax_fee_total" bson:"payment_tax_fee_total"`
-	// @inject_tag: json:"payment_gross_revenue_fx" bson:"payment_gross_revenue_fx"
-	PaymentGrossRevenueFx *OrderViewMoney `protobuf:"bytes,26,opt,name=payment_gross_revenue_fx,json=paymentGrossRevenueFx,proto3" json:"payment_gross_revenue_fx" bson:"payment_gross_revenue_fx"`
-	// @inject_tag: json:"payment_gross_revenue_fx_tax_fee" bson:"payment_gross_revenue_fx_tax_fee"
-	PaymentGrossRevenueFxTaxFee *OrderViewMoney `protobuf:"bytes,27,opt,name=payment_gross_revenue_fx_tax_fee,json=paymentGrossRevenueFxTaxFee,proto3" json:"payment_gross_revenue_fx_tax_fee" bson:"payment_gross_revenue_fx_tax_fee"`
-	// @inject_tag: json:"payment_gross_revenue_fx_profit" bson:"payment_gross_revenue_fx_profit"
-	PaymentGrossRevenueFxProfit *OrderViewMoney `protobuf:"bytes,28,opt,name=payment_gross_revenue_fx_profit,json=paymentGrossRevenueFxProfit,proto3" json:"payment_gross_revenue_fx_profit" bson:"payment_gross_revenue_fx_profit"`
+type AnnualTurnover struct {
+	//@inject_tag: json:"year" bson:"year" validate:"required,numeric,gte=2019"
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year" bson:"year" validate:"required,numeric,gte=2019"`
+	//@inject_tag: json:"country" bson:"country" validate:"omitempty,alpha,len=2"
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country" bson:"country" validate:"omitempty,alpha,len=2"`
+	// @inject_tag: json:"amount" bson:"amount" validate:"numeric,gte=0"
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount" bson:"amount" validate:"numeric,gte=0"`
+	//@inject_tag: json:"currency" bson:"currency" validate:"required,alpha,len=3"
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *AnnualTurnover) Reset()         { *m = AnnualTurnover{} }
+func (m *AnnualTurnover) String() string { return proto.CompactTextString(m) }
+func (*AnnualTurnover) ProtoMessage()    {}
+func (*AnnualTurnover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{112}
+}
+
+func (m *AnnualTurnover) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnualTurnover.Unmarshal(m, b)
+}
+func (m *AnnualTurnover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnualTurnover.Marshal(b, m, deterministic)
+}
+func (m *AnnualTurnover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnualTurnover.Merge(m, src)
+}
+func (m *AnnualTurnover) XXX_Size() int {
+	return xxx_messageInfo_AnnualTurnover.Size(m)
+}
+func (m *AnnualTurnover) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnualTurnover.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnualTurnover proto.InternalMessageInfo
+
+func (m *AnnualTurnover) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *AnnualTurnover) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *AnnualTurnover) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AnnualTurnover) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type OrderViewMoney struct {
+	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderViewMoney) Reset()         { *m = OrderViewMoney{} }
+func (m *OrderViewMoney) String() string { return proto.CompactTextString(m) }
+func (*OrderViewMoney) ProtoMessage()    {}
+func (*OrderViewMoney) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{113}
+}
+
+func (m *OrderViewMoney) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderViewMoney.Unmarshal(m, b)
+}
+func (m *OrderViewMoney) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderViewMoney.Marshal(b, m, deterministic)
+}
+func (m *OrderViewMoney) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderViewMoney.Merge(m, src)
+}
+func (m *OrderViewMoney) XXX_Size() int {
+	return xxx_messageInfo_OrderViewMoney.Size(m)
+}
+func (m *OrderViewMoney) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderViewMoney.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderViewMoney proto.InternalMessageInfo
+
+func (m *OrderViewMoney) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderViewMoney) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type OrderViewPublic struct {
+	// @inject_tag: json:"-" bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"-" bson:"_id"`
+	// @inject_tag: json:"uuid" bson:"uuid"
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid" bson:"uuid"`
+	// @inject_tag: json:"total_payment_amount" bson:"total_payment_amount"
+	TotalPaymentAmount float64 `protobuf:"fixed64,3,opt,name=total_payment_amount,json=totalPaymentAmount,proto3" json:"total_payment_amount" bson:"total_payment_amount"`
+	// @inject_tag: json:"currency" bson:"currency"
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency"`
+	// @inject_tag: json:"project" bson:"project"
+	Project *ProjectOrder `protobuf:"bytes,5,opt,name=project,proto3" json:"project" bson:"project"`
+	// @inject_tag: bson:"created_at" bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at" bson:"created_at"`
+	// @inject_tag: json:"transaction" bson:"pm_order_id"
+	Transaction string `protobuf:"bytes,7,opt,name=transaction,proto3" json:"transaction" bson:"pm_order_id"`
+	// @inject_tag: json:"payment_method" bson:"payment_method"
+	PaymentMethod *PaymentMethodOrder `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method" bson:"payment_method"`
+	// @inject_tag: json:"country_code" bson:"country_code"
+	CountryCode string `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code" bson:"country_code"`
+	// @inject_tag: json:"merchant_id" bson:"merchant_id"
+	MerchantId string `protobuf:"bytes,10,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" bson:"merchant_id"`
+	// @inject_tag: json:"locale" bson:"locale"
+	Locale string `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale" bson:"locale"`
+	// @inject_tag: json:"status" bson:"status"
+	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status" bson:"status"`
+	// @inject_tag: json:"transaction_date" bson:"pm_order_close_date"
+	TransactionDate *timestamp.Timestamp `protobuf:"bytes,13,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date" bson:"pm_order_close_date"`
+	// @inject_tag: json:"user" bson:"user"
+	User *OrderUser `protobuf:"bytes,14,opt,name=user,proto3" json:"user" bson:"user"`
+	// @inject_tag: json:"billing_address" bson:"billing_address"
+	BillingAddress *OrderBillingAddress `protobuf:"bytes,15,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address" bson:"billing_address"`
+	// @inject_tag: json:"type" bson:"type"
+	Type string `protobuf:"bytes,16,opt,name=type,proto3" json:"type" bson:"type"`
+	// @inject_tag: json:"is_vat_deduction" bson:"is_vat_deduction"
+	IsVatDeduction bool `protobuf:"varint,17,opt,name=is_vat_deduction,json=isVatDeduction,proto3" json:"is_vat_deduction" bson:"is_vat_deduction"`
 	// @inject_tag: json:"gross_revenue" bson:"gross_revenue"
-	GrossRevenue *OrderViewMoney `protobuf:"bytes,29,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue"`
+	GrossRevenue *OrderViewMoney `protobuf:"bytes,18,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue"`
 	// @inject_tag: json:"tax_fee" bson:"tax_fee"
-	TaxFee *OrderViewMoney `protobuf:"bytes,30,opt,name=tax_fee,json=taxFee,proto3" json:"tax_fee" bson:"tax_fee"`
+	TaxFee *OrderViewMoney `protobuf:"bytes,19,opt,name=tax_fee,json=taxFee,proto3" json:"tax_fee" bson:"tax_fee"`
 	// @inject_tag: json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"
-	TaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,31,opt,name=tax_fee_currency_exchange_fee,json=taxFeeCurrencyExchangeFee,proto3" json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"`
+	TaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,20,opt,name=tax_fee_currency_exchange_fee,json=taxFeeCurrencyExchangeFee,proto3" json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"`
 	// @inject_tag: json:"tax_fee_total" bson:"tax_fee_total"
-	TaxFeeTotal *OrderViewMoney `protobuf:"bytes,32,opt,name=tax_fee_total,json=taxFeeTotal,proto3" json:"tax_fee_total" bson:"tax_fee_total"`
+	TaxFeeTotal *OrderViewMoney `protobuf:"bytes,21,opt,name=tax_fee_total,json=taxFeeTotal,proto3" json:"tax_fee_total" bson:"tax_fee_total"`
 	// @inject_tag: json:"method_fee_total" bson:"method_fee_total"
-	MethodFeeTotal *OrderViewMoney `protobuf:"bytes,33,opt,name=method_fee_total,json=methodFeeTotal,proto3" json:"method_fee_total" bson:"method_fee_total"`
+	MethodFeeTotal *OrderViewMoney `protobuf:"bytes,22,opt,name=method_fee_total,json=methodFeeTotal,proto3" json:"method_fee_total" bson:"method_fee_total"`
 	// @inject_tag: json:"method_fee_tariff" bson:"method_fee_tariff"
-	MethodFeeTariff *OrderViewMoney `protobuf:"bytes,34,opt,name=method_fee_tariff,json=methodFeeTariff,proto3" json:"method_fee_tariff" bson:"method_fee_tariff"`
-	// @inject_tag: json:"paysuper_method_fee_tariff_self_cost" bson:"paysuper_method_fee_tariff_self_cost"
-	PaysuperMethodFeeTariffSelfCost *OrderViewMoney `protobuf:"bytes,35,opt,name=paysuper_method_fee_tariff_self_cost,json=paysuperMethodFeeTariffSelfCost,proto3" json:"paysuper_method_fee_tariff_self_cost" bson:"paysuper_method_fee_tariff_self_cost"`
-	// @inject_tag: json:"paysuper_method_fee_profit" bson:"paysuper_method_fee_profit"
-	PaysuperMethodFeeProfit *OrderViewMoney `protobuf:"bytes,36,opt,name=paysuper_method_fee_profit,json=paysuperMethodFeeProfit,proto3" json:"paysuper_method_fee_profit" bson:"paysuper_method_fee_profit"`
+	MethodFeeTariff *OrderViewMoney `protobuf:"bytes,23,opt,name=method_fee_tariff,json=methodFeeTariff,proto3" json:"method_fee_tariff" bson:"method_fee_tariff"`
 	// @inject_tag: json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"
-	MethodFixedFeeTariff *OrderViewMoney `protobuf:"bytes,37,opt,name=method_fixed_fee_tariff,json=methodFixedFeeTariff,proto3" json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"`
-	// @inject_tag: json:"paysuper_method_fixed_fee_tariff_fx_profit" bson:"paysuper_method_fixed_fee_tariff_fx_profit"
-	PaysuperMethodFixedFeeTariffFxProfit *OrderViewMoney `protobuf:"bytes,38,opt,name=paysuper_method_fixed_fee_tariff_fx_profit,json=paysuperMethodFixedFeeTariffFxProfit,proto3" json:"paysuper_method_fixed_fee_tariff_fx_profit" bson:"paysuper_method_fixed_fee_tariff_fx_profit"`
-	// @inject_tag: json:"paysuper_method_fixed_fee_tariff_self_cost" bson:"paysuper_method_fixed_fee_tariff_self_cost"
-	PaysuperMethodFixedFeeTariffSelfCost *OrderViewMoney `protobuf:"bytes,39,opt,name=paysuper_method_fixed_fee_tariff_self_cost,json=paysuperMethodFixedFeeTariffSelfCost,proto3" json:"paysuper_method_fixed_fee_tariff_self_cost" bson:"paysuper_method_fixed_fee_tariff_self_cost"`
-	// @inject_tag: json:"paysuper_method_fixed_fee_tariff_total_profit" bson:"paysuper_method_fixed_fee_tariff_total_profit"
-	PaysuperMethodFixedFeeTariffTotalProfit *OrderViewMoney `protobuf:"bytes,40,opt,name=paysuper_method_fixed_fee_tariff_total_profit,json=paysuperMethodFixedFeeTariffTotalProfit,proto3" json:"paysuper_method_fixed_fee_tariff_total_profit" bson:"paysuper_method_fixed_fee_tariff_total_profit"`
+	MethodFixedFeeTariff *OrderViewMoney `protobuf:"bytes,24,opt,name=method_fixed_fee_tariff,json=methodFixedFeeTariff,proto3" json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"`
 	// @inject_tag: json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"
-	PaysuperFixedFee *OrderViewMoney `protobuf:"bytes,41,opt,name=paysuper_fixed_fee,json=paysuperFixedFee,proto3" json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"`
-	// @inject_tag: json:"paysuper_fixed_fee_fx_profit" bson:"paysuper_fixed_fee_fx_profit"
-	PaysuperFixedFeeFxProfit *OrderViewMoney `protobuf:"bytes,42,opt,name=paysuper_fixed_fee_fx_profit,json=paysuperFixedFeeFxProfit,proto3" json:"paysuper_fixed_fee_fx_profit" bson:"paysuper_fixed_fee_fx_profit"`
+	PaysuperFixedFee *OrderViewMoney `protobuf:"bytes,25,opt,name=paysuper_fixed_fee,json=paysuperFixedFee,proto3" json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"`
 	// @inject_tag: json:"fees_total" bson:"fees_total"
-	FeesTotal *OrderViewMoney `protobuf:"bytes,43,opt,name=fees_total,json=feesTotal,proto3" json:"fees_total" bson:"fees_total"`
+	FeesTotal *OrderViewMoney `protobuf:"bytes,26,opt,name=fees_total,json=feesTotal,proto3" json:"fees_total" bson:"fees_total"`
 	// @inject_tag: json:"fees_total_local" bson:"fees_total_local"
-	FeesTotalLocal *OrderViewMoney `protobuf:"bytes,44,opt,name=fees_total_local,json=feesTotalLocal,proto3" json:"fees_total_local" bson:"fees_total_local"`
+	FeesTotalLocal *OrderViewMoney `protobuf:"bytes,27,opt,name=fees_total_local,json=feesTotalLocal,proto3" json:"fees_total_local" bson:"fees_total_local"`
 	// @inject_tag: json:"net_revenue" bson:"net_revenue"
-	NetRevenue *OrderViewMoney `protobuf:"bytes,45,opt,name=net_revenue,json=netRevenue,proto3" json:"net_revenue" bson:"net_revenue"`
-	// @inject_tag: json:"paysuper_method_total_profit" bson:"paysuper_method_total_profit"
-	PaysuperMethodTotalProfit *OrderViewMoney `protobuf:"bytes,46,opt,name=paysuper_method_total_profit,json=paysuperMethodTotalProfit,proto3" json:"paysuper_method_total_profit" bson:"paysuper_method_total_profit"`
-	// @inject_tag: json:"paysuper_total_profit" bson:"paysuper_total_profit"
-	PaysuperTotalProfit *OrderViewMoney `protobuf:"bytes,47,opt,name=paysuper_total_profit,json=paysuperTotalProfit,proto3" json:"paysuper_total_profit" bson:"paysuper_total_profit"`
-	// @inject_tag: json:"payment_refund_gross_revenue_local" bson:"payment_refund_gross_revenue_local"
-	PaymentRefundGrossRevenueLocal *OrderViewMoney `protobuf:"bytes,48,opt,name=payment_refund_gross_revenue_local,json=paymentRefundGrossRevenueLocal,proto3" json:"payment_refund_gross_revenue_local" bson:"payment_refund_gross_revenue_local"`
-	// @inject_tag: json:"payment_refund_gross_revenue_origin" bson:"payment_refund_gross_revenue_origin"
-	PaymentRefundGrossRevenueOrigin *OrderViewMoney `protobuf:"bytes,49,opt,name=payment_refund_gross_revenue_origin,json=paymentRefundGrossRevenueOrigin,proto3" json:"payment_refund_gross_revenue_origin" bson:"payment_refund_gross_revenue_origin"`
-	// @inject_tag: json:"payment_refund_gross_revenue" bson:"payment_refund_gross_revenue"
-	PaymentRefundGrossRevenue *OrderViewMoney `protobuf:"bytes,50,opt,name=payment_refund_gross_revenue,json=paymentRefundGrossRevenue,proto3" json:"payment_refund_gross_revenue" bson:"payment_refund_gross_revenue"`
-	// @inject_tag: json:"payment_refund_tax_fee" bson:"payment_refund_tax_fee"
-	PaymentRefundTaxFee *OrderViewMoney `protobuf:"bytes,51,opt,name=payment_refund_tax_fee,json=paymentRefundTaxFee,proto3" json:"payment_refund_tax_fee" bson:"payment_refund_tax_fee"`
-	// @inject_tag: json:"payment_refund_tax_fee_local" bson:"payment_refund_tax_fee_local"
-	PaymentRefundTaxFeeLocal *OrderViewMoney `protobuf:"bytes,52,opt,name=payment_refund_tax_fee_local,json=paymentRefundTaxFeeLocal,proto3" json:"payment_refund_tax_fee_local" bson:"payment_refund_tax_fee_local"`
-	// @inject_tag: json:"payment_refund_tax_fee_origin" bson:"payment_refund_tax_fee_origin"
-	PaymentRefundTaxFeeOrigin *OrderViewMoney `protobuf:"bytes,53,opt,name=payment_refund_tax_fee_origin,json=paymentRefundTaxFeeOrigin,proto3" json:"payment_refund_tax_fee_origin" bson:"payment_refund_tax_fee_origin"`
-	// @inject_tag: json:"payment_refund_fee_tariff" bson:"payment_refund_fee_tariff"
-	PaymentRefundFeeTariff *OrderViewMoney `protobuf:"bytes,54,opt,name=payment_refund_fee_tariff,json=paymentRefundFeeTariff,proto3" json:"payment_refund_fee_tariff" bson:"payment_refund_fee_tariff"`
-	// @inject_tag: json:"method_refund_fixed_fee_tariff" bson:"method_refund_fixed_fee_tariff"
-	MethodRefundFixedFeeTariff *OrderViewMoney `protobuf:"bytes,55,opt,name=method_refund_fixed_fee_tariff,json=methodRefundFixedFeeTariff,proto3" json:"method_refund_fixed_fee_tariff" bson:"method_refund_fixed_fee_tariff"`
+	NetRevenue *OrderViewMoney `protobuf:"bytes,28,opt,name=net_revenue,json=netRevenue,proto3" json:"net_revenue" bson:"net_revenue"`
 	// @inject_tag: json:"refund_gross_revenue" bson:"refund_gross_revenue"
-	RefundGrossRevenue *OrderViewMoney `protobuf:"bytes,56,opt,name=refund_gross_revenue,json=refundGrossRevenue,proto3" json:"refund_gross_revenue" bson:"refund_gross_revenue"`
-	// @inject_tag: json:"refund_gross_revenue_fx" bson:"refund_gross_revenue_fx"
-	RefundGrossRevenueFx *OrderViewMoney `protobuf:"bytes,57,opt,name=refund_gross_revenue_fx,json=refundGrossRevenueFx,proto3" json:"refund_gross_revenue_fx" bson:"refund_gross_revenue_fx"`
+	RefundGrossRevenue *OrderViewMoney `protobuf:"bytes,29,opt,name=refund_gross_revenue,json=refundGrossRevenue,proto3" json:"refund_gross_revenue" bson:"refund_gross_revenue"`
 	// @inject_tag: json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"
-	MethodRefundFeeTariff *OrderViewMoney `protobuf:"bytes,58,opt,name=method_refund_fee_tariff,json=methodRefundFeeTariff,proto3" json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"`
-	// @inject_tag: json:"paysuper_method_refund_fee_tariff_profit" bson:"paysuper_method_refund_fee_tariff_profit"
-	PaysuperMethodRefundFeeTariffProfit *OrderViewMoney `protobuf:"bytes,59,opt,name=paysuper_method_refund_fee_tariff_profit,json=paysuperMethodRefundFeeTariffProfit,proto3" json:"paysuper_method_refund_fee_tariff_profit" bson:"paysuper_method_refund_fee_tariff_profit"`
-	// @inject_tag: json:"paysuper_method_refund_fixed_fee_tariff_self_cost" bson:"paysuper_method_refund_fixed_fee_tariff_self_cost"
-	PaysuperMethodRefundFixedFeeTariffSelfCost *OrderViewMoney `protobuf:"bytes,60,opt,name=paysuper_method_refund_fixed_fee_tariff_self_cost,json=paysuperMethodRefundFixedFeeTariffSelfCost,proto3" json:"paysuper_method_refund_fixed_fee_tariff_self_cost" bson:"paysuper_method_refund_fixed_fee_tariff_self_cost"`
+	MethodRefundFeeTariff *OrderViewMoney `protobuf:"bytes,30,opt,name=method_refund_fee_tariff,json=methodRefundFeeTariff,proto3" json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"`
 	// @inject_tag: json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"
-	MerchantRefundFixedFeeTariff *OrderViewMoney `protobuf:"bytes,61,opt,name=merchant_refund_fixed_fee_tariff,json=merchantRefundFixedFeeTariff,proto3" json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"`
-	// @inject_tag: json:"paysuper_method_refund_fixed_fee_tariff_profit" bson:"paysuper_method_refund_fixed_fee_tariff_profit"
-	PaysuperMethodRefundFixedFeeTariffProfit *OrderViewMoney `protobuf:"bytes,62,opt,name=paysuper_method_refund_fixed_fee_tariff_profit,json=paysuperMethodRefundFixedFeeTariffProfit,proto3" json:"paysuper_method_refund_fixed_fee_tariff_profit" bson:"paysuper_method_refund_fixed_fee_tariff_profit"`
+	MerchantRefundFixedFeeTariff *OrderViewMoney `protobuf:"bytes,31,opt,name=merchant_refund_fixed_fee_tariff,json=merchantRefundFixedFeeTariff,proto3" json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"`
 	// @inject_tag: json:"refund_tax_fee" bson:"refund_tax_fee"
-	RefundTaxFee *OrderViewMoney `protobuf:"bytes,63,opt,name=refund_tax_fee,json=refundTaxFee,proto3" json:"refund_tax_fee" bson:"refund_tax_fee"`
+	RefundTaxFee *OrderViewMoney `protobuf:"bytes,32,opt,name=refund_tax_fee,json=refundTaxFee,proto3" json:"refund_tax_fee" bson:"refund_tax_fee"`
 	// @inject_tag: json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"
-	RefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,64,opt,name=refund_tax_fee_currency_exchange_fee,json=refundTaxFeeCurrencyExchangeFee,proto3" json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"`
+	RefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,33,opt,name=refund_tax_fee_currency_exchange_fee,json=refundTaxFeeCurrencyExchangeFee,proto3" json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"`
 	// @inject_tag: json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"
-	PaysuperRefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,65,opt,name=paysuper_refund_tax_fee_currency_exchange_fee,json=paysuperRefundTaxFeeCurrencyExchangeFee,proto3" json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"`
-	// @inject_tag: json:"refund_tax_fee_total" bson:"refund_tax_fee_total"
-	RefundTaxFeeTotal *OrderViewMoney `protobuf:"bytes,66,opt,name=refund_tax_fee_total,json=refundTaxFeeTotal,proto3" json:"refund_tax_fee_total" bson:"refund_tax_fee_total"`
+	PaysuperRefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,34,opt,name=paysuper_refund_tax_fee_currency_exchange_fee,json=paysuperRefundTaxFeeCurrencyExchangeFee,proto3" json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"`
 	// @inject_tag: json:"refund_reverse_revenue" bson:"refund_reverse_revenue"
-	RefundReverseRevenue *OrderViewMoney `protobuf:"bytes,67,opt,name=refund_reverse_revenue,json=refundReverseRevenue,proto3" json:"refund_reverse_revenue" bson:"refund_reverse_revenue"`
+	RefundReverseRevenue *OrderViewMoney `protobuf:"bytes,35,opt,name=refund_reverse_revenue,json=refundReverseRevenue,proto3" json:"refund_reverse_revenue" bson:"refund_reverse_revenue"`
 	// @inject_tag: json:"refund_fees_total" bson:"refund_fees_total"
-	RefundFeesTotal *OrderViewMoney `protobuf:"bytes,68,opt,name=refund_fees_total,json=refundFeesTotal,proto3" json:"refund_fees_total" bson:"refund_fees_total"`
+	RefundFeesTotal *OrderViewMoney `protobuf:"bytes,36,opt,name=refund_fees_total,json=refundFeesTotal,proto3" json:"refund_fees_total" bson:"refund_fees_total"`
 	// @inject_tag: json:"refund_fees_total_local" bson:"refund_fees_total_local"
-	RefundFeesTotalLocal *OrderViewMoney `protobuf:"bytes,69,opt,name=refund_fees_total_local,json=refundFeesTotalLocal,proto3" json:"refund_fees_total_local" bson:"refund_fees_total_local"`
-	// @inject_tag: json:"paysuper_refund_total_profit" bson:"paysuper_refund_total_profit"
-	PaysuperRefundTotalProfit *OrderViewMoney `protobuf:"bytes,70,opt,name=paysuper_refund_total_profit,json=paysuperRefundTotalProfit,proto3" json:"paysuper_refund_total_profit" bson:"paysuper_refund_total_profit"`
-	//@inject_tag: json:"issuer" bson:"issuer"
-	Issuer *OrderIssuer `protobuf:"bytes,71,opt,name=issuer,proto3" json:"issuer" bson:"issuer"`
+	RefundFeesTotalLocal *OrderViewMoney `protobuf:"bytes,37,opt,name=refund_fees_total_local,json=refundFeesTotalLocal,proto3" json:"refund_fees_total_local" bson:"refund_fees_total_local"`
+	Issuer               *OrderIssuer    `protobuf:"bytes,38,opt,name=issuer,proto3" json:"issuer,omitempty"`
 	// @inject_tag: json:"items" bson:"items"
-	Items []*OrderItem `protobuf:"bytes,72,rep,name=items,proto3" json:"items" bson:"items"`
+	Items []*OrderItem `protobuf:"bytes,39,rep,name=items,proto3" json:"items" bson:"items"`
 	//@inject_tag: json:"merchant_payout_currency" bson:"merchant_payout_currency"
-	MerchantPayoutCurrency string   `protobuf:"bytes,73,opt,name=merchant_payout_currency,json=merchantPayoutCurrency,proto3" json:"merchant_payout_currency" bson:"merchant_payout_currency"`
+	MerchantPayoutCurrency string   `protobuf:"bytes,40,opt,name=merchant_payout_currency,json=merchantPayoutCurrency,proto3" json:"merchant_payout_currency" bson:"merchant_payout_currency"`
 	XXX_NoUnkeyedLiteral   struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized       []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache          int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderViewPrivate) Reset()         { *m = OrderViewPrivate{} }
-func (m *OrderViewPrivate) String() string { return proto.CompactTextString(m) }
-func (*OrderViewPrivate) ProtoMessage()    {}
-func (*OrderViewPrivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{100}
+func (m *OrderViewPublic) Reset()         { *m = OrderViewPublic{} }
+func (m *OrderViewPublic) String() string { return proto.CompactTextString(m) }
+func (*OrderViewPublic) ProtoMessage()    {}
+func (*OrderViewPublic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{114}
 }
 
-func (m *OrderViewPrivate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderViewPrivate.Unmarshal(m, b)
+func (m *OrderViewPublic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderViewPublic.Unmarshal(m, b)
 }
-func (m *OrderViewPrivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderViewPrivate.Marshal(b, m, deterministic)
+func (m *OrderViewPublic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderViewPublic.Marshal(b, m, deterministic)
 }
-func (m *OrderViewPrivate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderViewPrivate.Merge(m, src)
+func (m *OrderViewPublic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderViewPublic.Merge(m, src)
 }
-func (m *OrderViewPrivate) XXX_Size() int {
-	return xxx_messageInfo_OrderViewPrivate.Size(m)
+func (m *OrderViewPublic) XXX_Size() int {
+	return xxx_messageInfo_OrderViewPublic.Size(m)
 }
-func (m *OrderViewPrivate) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderViewPrivate.DiscardUnknown(m)
+func (m *OrderViewPublic) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderViewPublic.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderViewPrivate proto.InternalMessageInfo
+var xxx_messageInfo_OrderViewPublic proto.InternalMessageInfo
 
-func (m *OrderViewPrivate) GetId() string {
+func (m *OrderViewPublic) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetUuid() string {
+func (m *OrderViewPublic) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetTotalPaymentAmount() float64 {
+	if m != nil {
+		return m.TotalPaymentAmount
+	}
+	return 0
+}
+
+func (m *OrderViewPublic) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetProject() *ProjectOrder {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetTransaction() string {
+	if m != nil {
+		return m.Transaction
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetPaymentMethod() *PaymentMethodOrder {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetTransactionDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.TransactionDate
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetUser() *OrderUser {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetBillingAddress() *OrderBillingAddress {
+	if m != nil {
+		return m.BillingAddress
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OrderViewPublic) GetIsVatDeduction() bool {
+	if m != nil {
+		return m.IsVatDeduction
+	}
+	return false
+}
+
+func (m *OrderViewPublic) GetGrossRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.GrossRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.TaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.TaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetTaxFeeTotal() *OrderViewMoney {
+	if m != nil {
+		return m.TaxFeeTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetMethodFeeTotal() *OrderViewMoney {
+	if m != nil {
+		return m.MethodFeeTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetMethodFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetMethodFixedFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodFixedFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetPaysuperFixedFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperFixedFee
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetFeesTotal() *OrderViewMoney {
+	if m != nil {
+		return m.FeesTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetFeesTotalLocal() *OrderViewMoney {
+	if m != nil {
+		return m.FeesTotalLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetNetRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.NetRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetRefundGrossRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.RefundGrossRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetMethodRefundFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodRefundFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetMerchantRefundFixedFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MerchantRefundFixedFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetRefundTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.RefundTaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.RefundTaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetPaysuperRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperRefundTaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetRefundReverseRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.RefundReverseRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetRefundFeesTotal() *OrderViewMoney {
+	if m != nil {
+		return m.RefundFeesTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetRefundFeesTotalLocal() *OrderViewMoney {
+	if m != nil {
+		return m.RefundFeesTotalLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetIssuer() *OrderIssuer {
+	if m != nil {
+		return m.Issuer
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *OrderViewPublic) GetMerchantPayoutCurrency() string {
+	if m != nil {
+		return m.MerchantPayoutCurrency
+	}
+	return ""
+}
+
+type OrderViewPrivate struct {
+	// @inject_tag: json:"-" bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"-" bson:"_id"`
+	// @inject_tag: json:"uuid" bson:"uuid"
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid" bson:"uuid"`
+	// @inject_tag: json:"total_payment_amount" bson:"total_payment_amount"
+	TotalPaymentAmount float64 `protobuf:"fixed64,3,opt,name=total_payment_amount,json=totalPaymentAmount,proto3" json:"total_payment_amount" bson:"total_payment_amount"`
+	// @inject_tag: json:"currency" bson:"currency"
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency" bson:"currency"`
+	// @inject_tag: json:"project" bson:"project"
+	Project *ProjectOrder `protobuf:"bytes,5,opt,name=project,proto3" json:"project" bson:"project"`
+	// @inject_tag: json:"created_at" bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
+	// @inject_tag: json:"transaction" bson:"pm_order_id"
+	Transaction string `protobuf:"bytes,7,opt,name=transaction,proto3" json:"transaction" bson:"pm_order_id"`
+	// @inject_tag: json:"payment_method" bson:"payment_method"
+	PaymentMethod *PaymentMethodOrder `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method" bson:"payment_method"`
+	// @inject_tag: json:"country_code" bson:"country_code"
+	CountryCode string `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code" bson:"country_code"`
+	// @inject_tag: json:"merchant_id" bson:"merchant_id"
+	MerchantId string `protobuf:"bytes,10,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" bson:"merchant_id"`
+	// @inject_tag: json:"locale" bson:"locale"
+	Locale string `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale" bson:"locale"`
+	// @inject_tag: json:"status" bson:"status"
+	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status" bson:"status"`
+	// @inject_tag: json:"transaction_date" bson:"pm_order_close_date"
+	TransactionDate *timestamp.Timestamp `protobuf:"bytes,13,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date" bson:"pm_order_close_date"`
+	// @inject_tag: json:"user" bson:"user"
+	User *OrderUser `protobuf:"bytes,14,opt,name=user,proto3" json:"user" bson:"user"`
+	// @inject_tag: json:"billing_address" bson:"billing_address"
+	BillingAddress *OrderBillingAddress `protobuf:"bytes,15,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address" bson:"billing_address"`
+	// @inject_tag: json:"type" bson:"type"
+	Type string `protobuf:"bytes,16,opt,name=type,proto3" json:"type" bson:"type"`
+	// @inject_tag: json:"is_vat_deduction" bson:"is_vat_deduction"
+	IsVatDeduction bool `protobuf:"varint,17,opt,name=is_vat_deduction,json=isVatDeduction,proto3" json:"is_vat_deduction" bson:"is_vat_deduction"`
+	// @inject_tag: json:"payment_gross_revenue_local" bson:"payment_gross_revenue_local"
+	PaymentGrossRevenueLocal *OrderViewMoney `protobuf:"bytes,18,opt,name=payment_gross_revenue_local,json=paymentGrossRevenueLocal,proto3" json:"payment_gross_revenue_local" bson:"payment_gross_revenue_local"`
+	// @inject_tag: json:"payment_gross_revenue_origin" bson:"payment_gross_revenue_origin"
+	PaymentGrossRevenueOrigin *OrderViewMoney `protobuf:"bytes,19,opt,name=payment_gross_revenue_origin,json=paymentGrossRevenueOrigin,proto3" json:"payment_gross_revenue_origin" bson:"payment_gross_revenue_origin"`
+	// @inject_tag: json:"payment_gross_revenue" bson:"payment_gross_revenue"
+	PaymentGrossRevenue *OrderViewMoney `protobuf:"bytes,20,opt,name=payment_gross_revenue,json=paymentGrossRevenue,proto3" json:"payment_gross_revenue" bson:"payment_gross_revenue"`
+	// @inject_tag: json:"payment_tax_fee" bson:"payment_tax_fee"
+	PaymentTaxFee *OrderViewMoney `protobuf:"bytes,21,opt,name=payment_tax_fee,json=paymentTaxFee,proto3" json:"payment_tax_fee" bson:"payment_tax_fee"`
+	// @inject_tag: json:"payment_tax_fee_local" bson:"payment_tax_fee_local"
+	PaymentTaxFeeLocal *OrderViewMoney `protobuf:"bytes,22,opt,name=payment_tax_fee_local,json=paymentTaxFeeLocal,proto3" json:"payment_tax_fee_local" bson:"payment_tax_fee_local"`
+	// @inject_tag: json:"payment_tax_fee_origin" bson:"payment_tax_fee_origin"
+	PaymentTaxFeeOrigin *OrderViewMoney `protobuf:"bytes,23,opt,name=payment_tax_fee_origin,json=paymentTaxFeeOrigin,proto3" json:"payment_tax_fee_origin" bson:"payment_tax_fee_origin"`
+	// @inject_tag: json:"payment_tax_fee_currency_exchange_fee" bson:"payment_tax_fee_currency_exchange_fee"
+	PaymentTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,24,opt,name=payment_tax_fee_currency_exchange_fee,json=paymentTaxFeeCurrencyExchangeFee,proto3" json:"payment_tax_fee_currency_exchange_fee" bson:"payment_tax_fee_currency_exchange_fee"`
+	// @inject_tag: json:"payment_tax_fee_total" bson:"payment_tax_fee_total"
+	PaymentTaxFeeTotal *OrderViewMoney `protobuf:"bytes,25,opt,name=payment_tax_fee_total,json=paymentTaxFeeTotal,proto3" json:"payment_tax_fee_total" bson:"payment_tax_fee_total"`
+	// @inject_tag: json:"payment_gross_revenue_fx" bson:"payment_gross_revenue_fx"
+	PaymentGrossRevenueFx *OrderViewMoney `protobuf:"bytes,26,opt,name=payment_gross_revenue_fx,json=paymentGrossRevenueFx,proto3" json:"payment_gross_revenue_fx" bson:"payment_gross_revenue_fx"`
+	// @inject_tag: json:"payment_gross_revenue_fx_tax_fee" bson:"payment_gross_revenue_fx_tax_fee"
+	PaymentGrossRevenueFxTaxFee *OrderViewMoney `protobuf:"bytes,27,opt,name=payment_gross_revenue_fx_tax_fee,json=paymentGrossRevenueFxTaxFee,proto3" json:"payment_gross_revenue_fx_tax_fee" bson:"payment_gross_revenue_fx_tax_fee"`
+	// @inject_tag: json:"payment_gross_revenue_fx_profit" bson:"payment_gross_revenue_fx_profit"
+	PaymentGrossRevenueFxProfit *OrderViewMoney `protobuf:"bytes,28,opt,name=payment_gross_revenue_fx_profit,json=paymentGrossRevenueFxProfit,proto3" json:"payment_gross_revenue_fx_profit" bson:"payment_gross_revenue_fx_profit"`
+	// @inject_tag: json:"gross_revenue" bson:"gross_revenue"
+	GrossRevenue *OrderViewMoney `protobuf:"bytes,29,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue"`
+	// @inject_tag: json:"tax_fee" bson:"tax_fee"
+	TaxFee *OrderViewMoney `protobuf:"bytes,30,opt,name=tax_fee,json=taxFee,proto3" json:"tax_fee" bson:"tax_fee"`
+	// @inject_tag: json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"
+	TaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,31,opt,name=tax_fee_currency_exchange_fee,json=taxFeeCurrencyExchangeFee,proto3" json:"tax_fee_currency_exchange_fee" bson:"tax_fee_currency_exchange_fee"`
+	// @inject_tag: json:"tax_fee_total" bson:"tax_fee_total"
+	TaxFeeTotal *OrderViewMoney `protobuf:"bytes,32,opt,name=tax_fee_total,json=taxFeeTotal,proto3" json:"tax_fee_total" bson:"tax_fee_total"`
+	// @inject_tag: json:"method_fee_total" bson:"method_fee_total"
+	MethodFeeTotal *OrderViewMoney `protobuf:"bytes,33,opt,name=method_fee_total,json=methodFeeTotal,proto3" json:"method_fee_total" bson:"method_fee_total"`
+	// @inject_tag: json:"method_fee_tariff" bson:"method_fee_tariff"
+	MethodFeeTariff *OrderViewMoney `protobuf:"bytes,34,opt,name=method_fee_tariff,json=methodFeeTariff,proto3" json:"method_fee_tariff" bson:"method_fee_tariff"`
+	// @inject_tag: json:"paysuper_method_fee_tariff_self_cost" bson:"paysuper_method_fee_tariff_self_cost"
+	PaysuperMethodFeeTariffSelfCost *OrderViewMoney `protobuf:"bytes,35,opt,name=paysuper_method_fee_tariff_self_cost,json=paysuperMethodFeeTariffSelfCost,proto3" json:"paysuper_method_fee_tariff_self_cost" bson:"paysuper_method_fee_tariff_self_cost"`
+	// @inject_tag: json:"paysuper_method_fee_profit" bson:"paysuper_method_fee_profit"
+	PaysuperMethodFeeProfit *OrderViewMoney `protobuf:"bytes,36,opt,name=paysuper_method_fee_profit,json=paysuperMethodFeeProfit,proto3" json:"paysuper_method_fee_profit" bson:"paysuper_method_fee_profit"`
+	// @inject_tag: json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"
+	MethodFixedFeeTariff *OrderViewMoney `protobuf:"bytes,37,opt,name=method_fixed_fee_tariff,json=methodFixedFeeTariff,proto3" json:"method_fixed_fee_tariff" bson:"method_fixed_fee_tariff"`
+	// @inject_tag: json:"paysuper_method_fixed_fee_tariff_fx_profit" bson:"paysuper_method_fixed_fee_tariff_fx_profit"
+	PaysuperMethodFixedFeeTariffFxProfit *OrderViewMoney `protobuf:"bytes,38,opt,name=paysuper_method_fixed_fee_tariff_fx_profit,json=paysuperMethodFixedFeeTariffFxProfit,proto3" json:"paysuper_method_fixed_fee_tariff_fx_profit" bson:"paysuper_method_fixed_fee_tariff_fx_profit"`
+	// @inject_tag: json:"paysuper_method_fixed_fee_tariff_self_cost" bson:"paysuper_method_fixed_fee_tariff_self_cost"
+	PaysuperMethodFixedFeeTariffSelfCost *OrderViewMoney `protobuf:"bytes,39,opt,name=paysuper_method_fixed_fee_tariff_self_cost,json=paysuperMethodFixedFeeTariffSelfCost,proto3" json:"paysuper_method_fixed_fee_tariff_self_cost" bson:"paysuper_method_fixed_fee_tariff_self_cost"`
+	// @inject_tag: json:"paysuper_method_fixed_fee_tariff_total_profit" bson:"paysuper_method_fixed_fee_tariff_total_profit"
+	PaysuperMethodFixedFeeTariffTotalProfit *OrderViewMoney `protobuf:"bytes,40,opt,name=paysuper_method_fixed_fee_tariff_total_profit,json=paysuperMethodFixedFeeTariffTotalProfit,proto3" json:"paysuper_method_fixed_fee_tariff_total_profit" bson:"paysuper_method_fixed_fee_tariff_total_profit"`
+	// @inject_tag: json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"
+	PaysuperFixedFee *OrderViewMoney `protobuf:"bytes,41,opt,name=paysuper_fixed_fee,json=paysuperFixedFee,proto3" json:"paysuper_fixed_fee" bson:"paysuper_fixed_fee"`
+	// @inject_tag: json:"paysuper_fixed_fee_fx_profit" bson:"paysuper_fixed_fee_fx_profit"
+	PaysuperFixedFeeFxProfit *OrderViewMoney `protobuf:"bytes,42,opt,name=paysuper_fixed_fee_fx_profit,json=paysuperFixedFeeFxProfit,proto3" json:"paysuper_fixed_fee_fx_profit" bson:"paysuper_fixed_fee_fx_profit"`
+	// @inject_tag: json:"fees_total" bson:"fees_total"
+	FeesTotal *OrderViewMoney `protobuf:"bytes,43,opt,name=fees_total,json=feesTotal,proto3" json:"fees_total" bson:"fees_total"`
+	// @inject_tag: json:"fees_total_local" bson:"fees_total_local"
+	FeesTotalLocal *OrderViewMoney `protobuf:"bytes,44,opt,name=fees_total_local,json=feesTotalLocal,proto3" json:"fees_total_local" bson:"fees_total_local"`
+	// @inject_tag: json:"net_revenue" bson:"net_revenue"
+	NetRevenue *OrderViewMoney `protobuf:"bytes,45,opt,name=net_revenue,json=netRevenue,proto3" json:"net_revenue" bson:"net_revenue"`
+	// @inject_tag: json:"paysuper_method_total_profit" bson:"paysuper_method_total_profit"
+	PaysuperMethodTotalProfit *OrderViewMoney `protobuf:"bytes,46,opt,name=paysuper_method_total_profit,json=paysuperMethodTotalProfit,proto3" json:"paysuper_method_total_profit" bson:"paysuper_method_total_profit"`
+	// @inject_tag: json:"paysuper_total_profit" bson:"paysuper_total_profit"
+	PaysuperTotalProfit *OrderViewMoney `protobuf:"bytes,47,opt,name=paysuper_total_profit,json=paysuperTotalProfit,proto3" json:"paysuper_total_profit" bson:"paysuper_total_profit"`
+	// @inject_tag: json:"payment_refund_gross_revenue_local" bson:"payment_refund_gross_revenue_local"
+	PaymentRefundGrossRevenueLocal *OrderViewMoney `protobuf:"bytes,48,opt,name=payment_refund_gross_revenue_local,json=paymentRefundGrossRevenueLocal,proto3" json:"payment_refund_gross_revenue_local" bson:"payment_refund_gross_revenue_local"`
+	// @inject_tag: json:"payment_refund_gross_revenue_origin" bson:"payment_refund_gross_revenue_origin"
+	PaymentRefundGrossRevenueOrigin *OrderViewMoney `protobuf:"bytes,49,opt,name=payment_refund_gross_revenue_origin,json=paymentRefundGrossRevenueOrigin,proto3" json:"payment_refund_gross_revenue_origin" bson:"payment_refund_gross_revenue_origin"`
+	// @inject_tag: json:"payment_refund_gross_revenue" bson:"payment_refund_gross_revenue"
+	PaymentRefundGrossRevenue *OrderViewMoney `protobuf:"bytes,50,opt,name=payment_refund_gross_revenue,json=paymentRefundGrossRevenue,proto3" json:"payment_refund_gross_revenue" bson:"payment_refund_gross_revenue"`
+	// @inject_tag: json:"payment_refund_tax_fee" bson:"payment_refund_tax_fee"
+	PaymentRefundTaxFee *OrderViewMoney `protobuf:"bytes,51,opt,name=payment_refund_tax_fee,json=paymentRefundTaxFee,proto3" json:"payment_refund_tax_fee" bson:"payment_refund_tax_fee"`
+	// @inject_tag: json:"payment_refund_tax_fee_local" bson:"payment_refund_tax_fee_local"
+	PaymentRefundTaxFeeLocal *OrderViewMoney `protobuf:"bytes,52,opt,name=payment_refund_tax_fee_local,json=paymentRefundTaxFeeLocal,proto3" json:"payment_refund_tax_fee_local" bson:"payment_refund_tax_fee_local"`
+	// @inject_tag: json:"payment_refund_tax_fee_origin" bson:"payment_refund_tax_fee_origin"
+	PaymentRefundTaxFeeOrigin *OrderViewMoney `protobuf:"bytes,53,opt,name=payment_refund_tax_fee_origin,json=paymentRefundTaxFeeOrigin,proto3" json:"payment_refund_tax_fee_origin" bson:"payment_refund_tax_fee_origin"`
+	// @inject_tag: json:"payment_refund_fee_tariff" bson:"payment_refund_fee_tariff"
+	PaymentRefundFeeTariff *OrderViewMoney `protobuf:"bytes,54,opt,name=payment_refund_fee_tariff,json=paymentRefundFeeTariff,proto3" json:"payment_refund_fee_tariff" bson:"payment_refund_fee_tariff"`
+	// @inject_tag: json:"method_refund_fixed_fee_tariff" bson:"method_refund_fixed_fee_tariff"
+	MethodRefundFixedFeeTariff *OrderViewMoney `protobuf:"bytes,55,opt,name=method_refund_fixed_fee_tariff,json=methodRefundFixedFeeTariff,proto3" json:"method_refund_fixed_fee_tariff" bson:"method_refund_fixed_fee_tariff"`
+	// @inject_tag: json:"refund_gross_revenue" bson:"refund_gross_revenue"
+	RefundGrossRevenue *OrderViewMoney `protobuf:"bytes,56,opt,name=refund_gross_revenue,json=refundGrossRevenue,proto3" json:"refund_gross_revenue" bson:"refund_gross_revenue"`
+	// @inject_tag: json:"refund_gross_revenue_fx" bson:"refund_gross_revenue_fx"
+	RefundGrossRevenueFx *OrderViewMoney `protobuf:"bytes,57,opt,name=refund_gross_revenue_fx,json=refundGrossRevenueFx,proto3" json:"refund_gross_revenue_fx" bson:"refund_gross_revenue_fx"`
+	// @inject_tag: json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"
+	MethodRefundFeeTariff *OrderViewMoney `protobuf:"bytes,58,opt,name=method_refund_fee_tariff,json=methodRefundFeeTariff,proto3" json:"method_refund_fee_tariff" bson:"method_refund_fee_tariff"`
+	// @inject_tag: json:"paysuper_method_refund_fee_tariff_profit" bson:"paysuper_method_refund_fee_tariff_profit"
+	PaysuperMethodRefundFeeTariffProfit *OrderViewMoney `protobuf:"bytes,59,opt,name=paysuper_method_refund_fee_tariff_profit,json=paysuperMethodRefundFeeTariffProfit,proto3" json:"paysuper_method_refund_fee_tariff_profit" bson:"paysuper_method_refund_fee_tariff_profit"`
+	// @inject_tag: json:"paysuper_method_refund_fixed_fee_tariff_self_cost" bson:"paysuper_method_refund_fixed_fee_tariff_self_cost"
+	PaysuperMethodRefundFixedFeeTariffSelfCost *OrderViewMoney `protobuf:"bytes,60,opt,name=paysuper_method_refund_fixed_fee_tariff_self_cost,json=paysuperMethodRefundFixedFeeTariffSelfCost,proto3" json:"paysuper_method_refund_fixed_fee_tariff_self_cost" bson:"paysuper_method_refund_fixed_fee_tariff_self_cost"`
+	// @inject_tag: json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"
+	MerchantRefundFixedFeeTariff *OrderViewMoney `protobuf:"bytes,61,opt,name=merchant_refund_fixed_fee_tariff,json=merchantRefundFixedFeeTariff,proto3" json:"merchant_refund_fixed_fee_tariff" bson:"merchant_refund_fixed_fee_tariff"`
+	// @inject_tag: json:"paysuper_method_refund_fixed_fee_tariff_profit" bson:"paysuper_method_refund_fixed_fee_tariff_profit"
+	PaysuperMethodRefundFixedFeeTariffProfit *OrderViewMoney `protobuf:"bytes,62,opt,name=paysuper_method_refund_fixed_fee_tariff_profit,json=paysuperMethodRefundFixedFeeTariffProfit,proto3" json:"paysuper_method_refund_fixed_fee_tariff_profit" bson:"paysuper_method_refund_fixed_fee_tariff_profit"`
+	// @inject_tag: json:"refund_tax_fee" bson:"refund_tax_fee"
+	RefundTaxFee *OrderViewMoney `protobuf:"bytes,63,opt,name=refund_tax_fee,json=refundTaxFee,proto3" json:"refund_tax_fee" bson:"refund_tax_fee"`
+	// @inject_tag: json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"
+	RefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,64,opt,name=refund_tax_fee_currency_exchange_fee,json=refundTaxFeeCurrencyExchangeFee,proto3" json:"refund_tax_fee_currency_exchange_fee" bson:"refund_tax_fee_currency_exchange_fee"`
+	// @inject_tag: json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"
+	PaysuperRefundTaxFeeCurrencyExchangeFee *OrderViewMoney `protobuf:"bytes,65,opt,name=paysuper_refund_tax_fee_currency_exchange_fee,json=paysuperRefundTaxFeeCurrencyExchangeFee,proto3" json:"paysuper_refund_tax_fee_currency_exchange_fee" bson:"paysuper_refund_tax_fee_currency_exchange_fee"`
+	// @inject_tag: json:"refund_tax_fee_total" bson:"refund_tax_fee_total"
+	RefundTaxFeeTotal *OrderViewMoney `protobuf:"bytes,66,opt,name=refund_tax_fee_total,json=refundTaxFeeTotal,proto3" json:"refund_tax_fee_total" bson:"refund_tax_fee_total"`
+	// @inject_tag: json:"refund_reverse_revenue" bson:"refund_reverse_revenue"
+	RefundReverseRevenue *OrderViewMoney `protobuf:"bytes,67,opt,name=refund_reverse_revenue,json=refundReverseRevenue,proto3" json:"refund_reverse_revenue" bson:"refund_reverse_revenue"`
+	// @inject_tag: json:"refund_fees_total" bson:"refund_fees_total"
+	RefundFeesTotal *OrderViewMoney `protobuf:"bytes,68,opt,name=refund_fees_total,json=refundFeesTotal,proto3" json:"refund_fees_total" bson:"refund_fees_total"`
+	// @inject_tag: json:"refund_fees_total_local" bson:"refund_fees_total_local"
+	RefundFeesTotalLocal *OrderViewMoney `protobuf:"bytes,69,opt,name=refund_fees_total_local,json=refundFeesTotalLocal,proto3" json:"refund_fees_total_local" bson:"refund_fees_total_local"`
+	// @inject_tag: json:"paysuper_refund_total_profit" bson:"paysuper_refund_total_profit"
+	PaysuperRefundTotalProfit *OrderViewMoney `protobuf:"bytes,70,opt,name=paysuper_refund_total_profit,json=paysuperRefundTotalProfit,proto3" json:"paysuper_refund_total_profit" bson:"paysuper_refund_total_profit"`
+	//@inject_tag: json:"issuer" bson:"issuer"
+	Issuer *OrderIssuer `protobuf:"bytes,71,opt,name=issuer,proto3" json:"issuer" bson:"issuer"`
+	// @inject_tag: json:"items" bson:"items"
+	Items []*OrderItem `protobuf:"bytes,72,rep,name=items,proto3" json:"items" bson:"items"`
+	//@inject_tag: json:"merchant_payout_currency" bson:"merchant_payout_currency"
+	MerchantPayoutCurrency string   `protobuf:"bytes,73,opt,name=merchant_payout_currency,json=merchantPayoutCurrency,proto3" json:"merchant_payout_currency" bson:"merchant_payout_currency"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized       []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache          int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderViewPrivate) Reset()         { *m = OrderViewPrivate{} }
+func (m *OrderViewPrivate) String() string { return proto.CompactTextString(m) }
+func (*OrderViewPrivate) ProtoMessage()    {}
+func (*OrderViewPrivate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{115}
+}
+
+func (m *OrderViewPrivate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderViewPrivate.Unmarshal(m, b)
+}
+func (m *OrderViewPrivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderViewPrivate.Marshal(b, m, deterministic)
+}
+func (m *OrderViewPrivate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderViewPrivate.Merge(m, src)
+}
+func (m *OrderViewPrivate) XXX_Size() int {
+	return xxx_messageInfo_OrderViewPrivate.Size(m)
+}
+func (m *OrderViewPrivate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderViewPrivate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderViewPrivate proto.InternalMessageInfo
+
+func (m *OrderViewPrivate) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetTotalPaymentAmount() float64 {
+	if m != nil {
+		return m.TotalPaymentAmount
+	}
+	return 0
+}
+
+func (m *OrderViewPrivate) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetProject() *ProjectOrder {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetTransaction() string {
+	if m != nil {
+		return m.Transaction
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetPaymentMethod() *PaymentMethodOrder {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetTransactionDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.TransactionDate
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetUser() *OrderUser {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetBillingAddress() *OrderBillingAddress {
+	if m != nil {
+		return m.BillingAddress
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OrderViewPrivate) GetIsVatDeduction() bool {
+	if m != nil {
+		return m.IsVatDeduction
+	}
+	return false
+}
+
+func (m *OrderViewPrivate) GetPaymentGrossRevenueLocal() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentGrossRevenueLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentGrossRevenueOrigin() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentGrossRevenueOrigin
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentGrossRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentGrossRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentTaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentTaxFeeLocal() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentTaxFeeLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentTaxFeeOrigin() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentTaxFeeOrigin
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentTaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentTaxFeeTotal() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentTaxFeeTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentGrossRevenueFx() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentGrossRevenueFx
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentGrossRevenueFxTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentGrossRevenueFxTaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentGrossRevenueFxProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentGrossRevenueFxProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetGrossRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.GrossRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.TaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.TaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetTaxFeeTotal() *OrderViewMoney {
+	if m != nil {
+		return m.TaxFeeTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMethodFeeTotal() *OrderViewMoney {
+	if m != nil {
+		return m.MethodFeeTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMethodFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodFeeTariffSelfCost() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodFeeTariffSelfCost
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodFeeProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodFeeProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMethodFixedFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodFixedFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodFixedFeeTariffFxProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodFixedFeeTariffFxProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodFixedFeeTariffSelfCost() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodFixedFeeTariffSelfCost
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodFixedFeeTariffTotalProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodFixedFeeTariffTotalProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperFixedFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperFixedFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperFixedFeeFxProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperFixedFeeFxProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetFeesTotal() *OrderViewMoney {
+	if m != nil {
+		return m.FeesTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetFeesTotalLocal() *OrderViewMoney {
+	if m != nil {
+		return m.FeesTotalLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetNetRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.NetRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodTotalProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodTotalProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperTotalProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperTotalProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundGrossRevenueLocal() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundGrossRevenueLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundGrossRevenueOrigin() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundGrossRevenueOrigin
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundGrossRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundGrossRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundTaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundTaxFeeLocal() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundTaxFeeLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundTaxFeeOrigin() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundTaxFeeOrigin
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaymentRefundFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.PaymentRefundFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMethodRefundFixedFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodRefundFixedFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundGrossRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.RefundGrossRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundGrossRevenueFx() *OrderViewMoney {
+	if m != nil {
+		return m.RefundGrossRevenueFx
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMethodRefundFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MethodRefundFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodRefundFeeTariffProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodRefundFeeTariffProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodRefundFixedFeeTariffSelfCost() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodRefundFixedFeeTariffSelfCost
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMerchantRefundFixedFeeTariff() *OrderViewMoney {
+	if m != nil {
+		return m.MerchantRefundFixedFeeTariff
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperMethodRefundFixedFeeTariffProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperMethodRefundFixedFeeTariffProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundTaxFee() *OrderViewMoney {
+	if m != nil {
+		return m.RefundTaxFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.RefundTaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperRefundTaxFeeCurrencyExchangeFee
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundTaxFeeTotal() *OrderViewMoney {
+	if m != nil {
+		return m.RefundTaxFeeTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundReverseRevenue() *OrderViewMoney {
+	if m != nil {
+		return m.RefundReverseRevenue
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundFeesTotal() *OrderViewMoney {
+	if m != nil {
+		return m.RefundFeesTotal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetRefundFeesTotalLocal() *OrderViewMoney {
+	if m != nil {
+		return m.RefundFeesTotalLocal
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetPaysuperRefundTotalProfit() *OrderViewMoney {
+	if m != nil {
+		return m.PaysuperRefundTotalProfit
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetIssuer() *OrderIssuer {
+	if m != nil {
+		return m.Issuer
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *OrderViewPrivate) GetMerchantPayoutCurrency() string {
+	if m != nil {
+		return m.MerchantPayoutCurrency
+	}
+	return ""
+}
+
+type RecommendedPrice struct {
+	// @inject_tag: json:"region"
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region"`
+	// @inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency"`
+	// @inject_tag: json:"amount"
+	Amount               float64  `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RecommendedPrice) Reset()         { *m = RecommendedPrice{} }
+func (m *RecommendedPrice) String() string { return proto.CompactTextString(m) }
+func (*RecommendedPrice) ProtoMessage()    {}
+func (*RecommendedPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{116}
+}
+
+func (m *RecommendedPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendedPrice.Unmarshal(m, b)
+}
+func (m *RecommendedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendedPrice.Marshal(b, m, deterministic)
+}
+func (m *RecommendedPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendedPrice.Merge(m, src)
+}
+func (m *RecommendedPrice) XXX_Size() int {
+	return xxx_messageInfo_RecommendedPrice.Size(m)
+}
+func (m *RecommendedPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendedPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendedPrice proto.InternalMessageInfo
+
+func (m *RecommendedPrice) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *RecommendedPrice) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *RecommendedPrice) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type PriceTable struct {
+	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"currency" bson:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"ranges"
+	Ranges               []*PriceTableRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceTable) Reset()         { *m = PriceTable{} }
+func (m *PriceTable) String() string { return proto.CompactTextString(m) }
+func (*PriceTable) ProtoMessage()    {}
+func (*PriceTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{117}
+}
+
+func (m *PriceTable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceTable.Unmarshal(m, b)
+}
+func (m *PriceTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceTable.Marshal(b, m, deterministic)
+}
+func (m *PriceTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceTable.Merge(m, src)
+}
+func (m *PriceTable) XXX_Size() int {
+	return xxx_messageInfo_PriceTable.Size(m)
+}
+func (m *PriceTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceTable proto.InternalMessageInfo
+
+func (m *PriceTable) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PriceTable) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *PriceTable) GetRanges() []*PriceTableRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+type PriceTableRange struct {
+	//@inject_tag: json:"position" bson:"position"
+	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position" bson:"position"`
+	// @inject_tag: json:"from"
+	From float64 `protobuf:"fixed64,3,opt,name=from,proto3" json:"from"`
+	// @inject_tag: json:"to"
+	To                   float64  `protobuf:"fixed64,4,opt,name=to,proto3" json:"to"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceTableRange) Reset()         { *m = PriceTableRange{} }
+func (m *PriceTableRange) String() string { return proto.CompactTextString(m) }
+func (*PriceTableRange) ProtoMessage()    {}
+func (*PriceTableRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{118}
+}
+
+func (m *PriceTableRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceTableRange.Unmarshal(m, b)
+}
+func (m *PriceTableRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceTableRange.Marshal(b, m, deterministic)
+}
+func (m *PriceTableRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceTableRange.Merge(m, src)
+}
+func (m *PriceTableRange) XXX_Size() int {
+	return xxx_messageInfo_PriceTableRange.Size(m)
+}
+func (m *PriceTableRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceTableRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceTableRange proto.InternalMessageInfo
+
+func (m *PriceTableRange) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *PriceTableRange) GetFrom() float64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *PriceTableRange) GetTo() float64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type Id struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *Id) Reset()         { *m = Id{} }
+func (m *Id) String() string { return proto.CompactTextString(m) }
+func (*Id) ProtoMessage()    {}
+func (*Id) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{119}
+}
+
+func (m *Id) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Id.Unmarshal(m, b)
+}
+func (m *Id) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Id.Marshal(b, m, deterministic)
+}
+func (m *Id) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Id.Merge(m, src)
+}
+func (m *Id) XXX_Size() int {
+	return xxx_messageInfo_Id.Size(m)
+}
+func (m *Id) XXX_DiscardUnknown() {
+	xxx_messageInfo_Id.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Id proto.InternalMessageInfo
+
+func (m *Id) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RangeInt struct {
+	//@inject_tag: json:"from"
+	From int32 `protobuf:"varint,1,opt,name=from,proto3" json:"from"`
+	//@inject_tag: json:"to"
+	To                   int32    `protobuf:"varint,2,opt,name=to,proto3" json:"to"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RangeInt) Reset()         { *m = RangeInt{} }
+func (m *RangeInt) String() string { return proto.CompactTextString(m) }
+func (*RangeInt) ProtoMessage()    {}
+func (*RangeInt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{120}
+}
+
+func (m *RangeInt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeInt.Unmarshal(m, b)
+}
+func (m *RangeInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeInt.Marshal(b, m, deterministic)
+}
+func (m *RangeInt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeInt.Merge(m, src)
+}
+func (m *RangeInt) XXX_Size() int {
+	return xxx_messageInfo_RangeInt.Size(m)
+}
+func (m *RangeInt) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeInt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeInt proto.InternalMessageInfo
+
+func (m *RangeInt) GetFrom() int32 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *RangeInt) GetTo() int32 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type MerchantTariffRatesPayment struct {
+	//@inject_tag: json:"min_amount" bson:"min_amount"
+	MinAmount float64 `protobuf:"fixed64,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount" bson:"min_amount"`
+	//@inject_tag: json:"max_amount" bson:"max_amount"
+	MaxAmount float64 `protobuf:"fixed64,2,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount" bson:"max_amount"`
+	//@inject_tag: json:"method_name" bson:"method_name"
+	MethodName string `protobuf:"bytes,3,opt,name=method_name,json=methodName,proto3" json:"method_name" bson:"method_name"`
+	//@inject_tag: json:"method_percent_fee" bson:"method_percent_fee"
+	MethodPercentFee float64 `protobuf:"fixed64,4,opt,name=method_percent_fee,json=methodPercentFee,proto3" json:"method_percent_fee" bson:"method_percent_fee"`
+	//@inject_tag: json:"method_fixed_fee" bson:"method_fixed_fee"
+	MethodFixedFee float64 `protobuf:"fixed64,5,opt,name=method_fixed_fee,json=methodFixedFee,proto3" json:"method_fixed_fee" bson:"method_fixed_fee"`
+	//@inject_tag: json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"
+	MethodFixedFeeCurrency string `protobuf:"bytes,6,opt,name=method_fixed_fee_currency,json=methodFixedFeeCurrency,proto3" json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"`
+	//@inject_tag: json:"ps_percent_fee" bson:"ps_percent_fee"
+	PsPercentFee float64 `protobuf:"fixed64,7,opt,name=ps_percent_fee,json=psPercentFee,proto3" json:"ps_percent_fee" bson:"ps_percent_fee"`
+	//@inject_tag: json:"ps_fixed_fee" bson:"ps_fixed_fee"
+	PsFixedFee float64 `protobuf:"fixed64,8,opt,name=ps_fixed_fee,json=psFixedFee,proto3" json:"ps_fixed_fee" bson:"ps_fixed_fee"`
+	//@inject_tag: json:"ps_fixed_fee_currency" bson:"ps_fixed_fee_currency"
+	PsFixedFeeCurrency string `protobuf:"bytes,9,opt,name=ps_fixed_fee_currency,json=psFixedFeeCurrency,proto3" json:"ps_fixed_fee_currency" bson:"ps_fixed_fee_currency"`
+	//@inject_tag: json:"merchant_home_region" bson:"merchant_home_region"
+	MerchantHomeRegion string `protobuf:"bytes,10,opt,name=merchant_home_region,json=merchantHomeRegion,proto3" json:"merchant_home_region" bson:"merchant_home_region"`
+	//@inject_tag: json:"payer_region" bson:"payer_region"
+	PayerRegion          string   `protobuf:"bytes,11,opt,name=payer_region,json=payerRegion,proto3" json:"payer_region" bson:"payer_region"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantTariffRatesPayment) Reset()         { *m = MerchantTariffRatesPayment{} }
+func (m *MerchantTariffRatesPayment) String() string { return proto.CompactTextString(m) }
+func (*MerchantTariffRatesPayment) ProtoMessage()    {}
+func (*MerchantTariffRatesPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{121}
+}
+
+func (m *MerchantTariffRatesPayment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantTariffRatesPayment.Unmarshal(m, b)
+}
+func (m *MerchantTariffRatesPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantTariffRatesPayment.Marshal(b, m, deterministic)
+}
+func (m *MerchantTariffRatesPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantTariffRatesPayment.Merge(m, src)
+}
+func (m *MerchantTariffRatesPayment) XXX_Size() int {
+	return xxx_messageInfo_MerchantTariffRatesPayment.Size(m)
+}
+func (m *MerchantTariffRatesPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantTariffRatesPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantTariffRatesPayment proto.InternalMessageInfo
+
+func (m *MerchantTariffRatesPayment) GetMinAmount() float64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesPayment) GetMaxAmount() float64 {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesPayment) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesPayment) GetMethodPercentFee() float64 {
+	if m != nil {
+		return m.MethodPercentFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesPayment) GetMethodFixedFee() float64 {
+	if m != nil {
+		return m.MethodFixedFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesPayment) GetMethodFixedFeeCurrency() string {
+	if m != nil {
+		return m.MethodFixedFeeCurrency
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesPayment) GetPsPercentFee() float64 {
+	if m != nil {
+		return m.PsPercentFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesPayment) GetPsFixedFee() float64 {
+	if m != nil {
+		return m.PsFixedFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesPayment) GetPsFixedFeeCurrency() string {
+	if m != nil {
+		return m.PsFixedFeeCurrency
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesPayment) GetMerchantHomeRegion() string {
+	if m != nil {
+		return m.MerchantHomeRegion
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesPayment) GetPayerRegion() string {
+	if m != nil {
+		return m.PayerRegion
+	}
+	return ""
+}
+
+type MerchantTariffRatesSettingsRefundItem struct {
+	//@inject_tag: json:"method_name" bson:"method_name"
+	MethodName string `protobuf:"bytes,1,opt,name=method_name,json=methodName,proto3" json:"method_name" bson:"method_name"`
+	//@inject_tag: json:"method_percent_fee" bson:"method_percent_fee"
+	MethodPercentFee float64 `protobuf:"fixed64,2,opt,name=method_percent_fee,json=methodPercentFee,proto3" json:"method_percent_fee" bson:"method_percent_fee"`
+	//@inject_tag: json:"method_fixed_fee" bson:"method_fixed_fee"
+	MethodFixedFee float64 `protobuf:"fixed64,3,opt,name=method_fixed_fee,json=methodFixedFee,proto3" json:"method_fixed_fee" bson:"method_fixed_fee"`
+	//@inject_tag: json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"
+	MethodFixedFeeCurrency string `protobuf:"bytes,4,opt,name=method_fixed_fee_currency,json=methodFixedFeeCurrency,proto3" json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"`
+	//@inject_tag: json:"is_paid_by_merchant" bson:"is_paid_by_merchant"
+	IsPaidByMerchant     bool     `protobuf:"varint,5,opt,name=is_paid_by_merchant,json=isPaidByMerchant,proto3" json:"is_paid_by_merchant" bson:"is_paid_by_merchant"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantTariffRatesSettingsRefundItem) Reset()         { *m = MerchantTariffRatesSettingsRefundItem{} }
+func (m *MerchantTariffRatesSettingsRefundItem) String() string { return proto.CompactTextString(m) }
+func (*MerchantTariffRatesSettingsRefundItem) ProtoMessage()    {}
+func (*MerchantTariffRatesSettingsRefundItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{122}
+}
+
+func (m *MerchantTariffRatesSettingsRefundItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Unmarshal(m, b)
+}
+func (m *MerchantTariffRatesSettingsRefundItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Marshal(b, m, deterministic)
+}
+func (m *MerchantTariffRatesSettingsRefundItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Merge(m, src)
+}
+func (m *MerchantTariffRatesSettingsRefundItem) XXX_Size() int {
+	return xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Size(m)
+}
+func (m *MerchantTariffRatesSettingsRefundItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantTariffRatesSettingsRefundItem proto.InternalMessageInfo
+
+func (m *MerchantTariffRatesSettingsRefundItem) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesSettingsRefundItem) GetMethodPercentFee() float64 {
+	if m != nil {
+		return m.MethodPercentFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesSettingsRefundItem) GetMethodFixedFee() float64 {
+	if m != nil {
+		return m.MethodFixedFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesSettingsRefundItem) GetMethodFixedFeeCurrency() string {
+	if m != nil {
+		return m.MethodFixedFeeCurrency
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesSettingsRefundItem) GetIsPaidByMerchant() bool {
+	if m != nil {
+		return m.IsPaidByMerchant
+	}
+	return false
+}
+
+type MerchantTariffRatesSettingsItem struct {
+	//@inject_tag: json:"method_percent_fee" bson:"method_percent_fee"
+	MethodPercentFee float64 `protobuf:"fixed64,1,opt,name=method_percent_fee,json=methodPercentFee,proto3" json:"method_percent_fee" bson:"method_percent_fee"`
+	//@inject_tag: json:"method_fixed_fee" bson:"method_fixed_fee"
+	MethodFixedFee float64 `protobuf:"fixed64,2,opt,name=method_fixed_fee,json=methodFixedFee,proto3" json:"method_fixed_fee" bson:"method_fixed_fee"`
+	//@inject_tag: json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"
+	MethodFixedFeeCurrency string `protobuf:"bytes,3,opt,name=method_fixed_fee_currency,json=methodFixedFeeCurrency,proto3" json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"`
+	//@inject_tag: json:"is_paid_by_merchant" bson:"is_paid_by_merchant"
+	IsPaidByMerchant     bool     `protobuf:"varint,4,opt,name=is_paid_by_merchant,json=isPaidByMerchant,proto3" json:"is_paid_by_merchant" bson:"is_paid_by_merchant"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantTariffRatesSettingsItem) Reset()         { *m = MerchantTariffRatesSettingsItem{} }
+func (m *MerchantTariffRatesSettingsItem) String() string { return proto.CompactTextString(m) }
+func (*MerchantTariffRatesSettingsItem) ProtoMessage()    {}
+func (*MerchantTariffRatesSettingsItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{123}
+}
+
+func (m *MerchantTariffRatesSettingsItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantTariffRatesSettingsItem.Unmarshal(m, b)
+}
+func (m *MerchantTariffRatesSettingsItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantTariffRatesSettingsItem.Marshal(b, m, deterministic)
+}
+func (m *MerchantTariffRatesSettingsItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantTariffRatesSettingsItem.Merge(m, src)
+}
+func (m *MerchantTariffRatesSettingsItem) XXX_Size() int {
+	return xxx_messageInfo_MerchantTariffRatesSettingsItem.Size(m)
+}
+func (m *MerchantTariffRatesSettingsItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantTariffRatesSettingsItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantTariffRatesSettingsItem proto.InternalMessageInfo
+
+func (m *MerchantTariffRatesSettingsItem) GetMethodPercentFee() float64 {
+	if m != nil {
+		return m.MethodPercentFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesSettingsItem) GetMethodFixedFee() float64 {
+	if m != nil {
+		return m.MethodFixedFee
+	}
+	return 0
+}
+
+func (m *MerchantTariffRatesSettingsItem) GetMethodFixedFeeCurrency() string {
+	if m != nil {
+		return m.MethodFixedFeeCurrency
+	}
+	return ""
+}
+
+func (m *MerchantTariffRatesSettingsItem) GetIsPaidByMerchant() bool {
+	if m != nil {
+		return m.IsPaidByMerchant
+	}
+	return false
+}
+
+type MerchantTariffRatesSettings struct {
+	//@inject_tag: json:"refund" bson:"refund"
+	Refund []*MerchantTariffRatesSettingsRefundItem `protobuf:"bytes,1,rep,name=refund,proto3" json:"refund" bson:"refund"`
+	//@inject_tag: json:"chargeback" bson:"chargeback"
+	Chargeback *MerchantTariffRatesSettingsItem `protobuf:"bytes,2,opt,name=chargeback,proto3" json:"chargeback" bson:"chargeback"`
+	//@inject_tag: json:"payout" bson:"payout"
+	Payout               *MerchantTariffRatesSettingsItem `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout" bson:"payout"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                            `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantTariffRatesSettings) Reset()         { *m = MerchantTariffRatesSettings{} }
+func (m *MerchantTariffRatesSettings) String() string { return proto.CompactTextString(m) }
+func (*MerchantTariffRatesSettings) ProtoMessage()    {}
+func (*MerchantTariffRatesSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{124}
+}
+
+func (m *MerchantTariffRatesSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantTariffRatesSettings.Unmarshal(m, b)
+}
+func (m *MerchantTariffRatesSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantTariffRatesSettings.Marshal(b, m, deterministic)
+}
+func (m *MerchantTariffRatesSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantTariffRatesSettings.Merge(m, src)
+}
+func (m *MerchantTariffRatesSettings) XXX_Size() int {
+	return xxx_messageInfo_MerchantTariffRatesSettings.Size(m)
+}
+func (m *MerchantTariffRatesSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantTariffRatesSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantTariffRatesSettings proto.InternalMessageInfo
+
+func (m *MerchantTariffRatesSettings) GetRefund() []*MerchantTariffRatesSettingsRefundItem {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *MerchantTariffRatesSettings) GetChargeback() *MerchantTariffRatesSettingsItem {
+	if m != nil {
+		return m.Chargeback
+	}
+	return nil
+}
+
+func (m *MerchantTariffRatesSettings) GetPayout() *MerchantTariffRatesSettingsItem {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+type Key struct {
+	//@inject_tag: json:"id" bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	//@inject_tag: json:"code" validate:"required,max=50"
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" validate:"required,max=50"`
+	//@inject_tag: validate:"required,hexadecimal,len=24"
+	KeyProductId string `protobuf:"bytes,3,opt,name=key_product_id,json=keyProductId,proto3" json:"key_product_id,omitempty" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: validate:"required,max=255"
+	PlatformId string `protobuf:"bytes,5,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty" validate:"required,max=255"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
+	OrderId   string               `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	//@inject_tag: validate:"omitempty"
+	ReservedTo *timestamp.Timestamp `protobuf:"bytes,8,opt,name=reserved_to,json=reservedTo,proto3" json:"reserved_to,omitempty" validate:"omitempty"`
+	//@inject_tag: validate:"omitempty"
+	RedeemedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty" validate:"omitempty"`
+	// keyed hash of plain key code, used to check codes uniqueness without decryption
+	//@inject_tag: json:"-"
+	CodeHash string `protobuf:"bytes,10,opt,name=code_hash,json=codeHash,proto3" json:"-"`
+	// key code encrypted with data key
+	//@inject_tag: json:"-"
+	EncryptedCode []byte `protobuf:"bytes,11,opt,name=encrypted_code,json=encryptedCode,proto3" json:"-"`
+	// data key encrypted with master key of key encryption provider
+	//@inject_tag: json:"-"
+	EncryptedDataKey []byte `protobuf:"bytes,12,opt,name=encrypted_data_key,json=encryptedDataKey,proto3" json:"-"`
+	// identifier of master key used to encrypt data key
+	//@inject_tag: json:"-"
+	MasterKeyId string `protobuf:"bytes,13,opt,name=master_key_id,json=masterKeyId,proto3" json:"-"`
+	// additional key data from metadata columns of csv keys file
+	//@inject_tag: json:"metadata"
+	Metadata map[string]string `protobuf:"bytes,14,rep,name=metadata,proto3" json:"metadata" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// revoked keys are not available for sale
+	//@inject_tag: json:"revoked_at"
+	RevokedAt            *timestamp.Timestamp `protobuf:"bytes,15,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *Key) Reset()         { *m = Key{} }
+func (m *Key) String() string { return proto.CompactTextString(m) }
+func (*Key) ProtoMessage()    {}
+func (*Key) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{125}
+}
+
+func (m *Key) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Key.Unmarshal(m, b)
+}
+func (m *Key) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Key.Marshal(b, m, deterministic)
+}
+func (m *Key) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Key.Merge(m, src)
+}
+func (m *Key) XXX_Size() int {
+	return xxx_messageInfo_Key.Size(m)
+}
+func (m *Key) XXX_DiscardUnknown() {
+	xxx_messageInfo_Key.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Key proto.InternalMessageInfo
+
+func (m *Key) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Key) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Key) GetKeyProductId() string {
+	if m != nil {
+		return m.KeyProductId
+	}
+	return ""
+}
+
+func (m *Key) GetPlatformId() string {
+	if m != nil {
+		return m.PlatformId
+	}
+	return ""
+}
+
+func (m *Key) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Key) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Key) GetReservedTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReservedTo
+	}
+	return nil
+}
+
+func (m *Key) GetRedeemedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RedeemedAt
+	}
+	return nil
+}
+
+func (m *Key) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *Key) GetEncryptedCode() []byte {
+	if m != nil {
+		return m.EncryptedCode
+	}
+	return nil
+}
+
+func (m *Key) GetEncryptedDataKey() []byte {
+	if m != nil {
+		return m.EncryptedDataKey
+	}
+	return nil
+}
+
+func (m *Key) GetMasterKeyId() string {
+	if m != nil {
+		return m.MasterKeyId
+	}
+	return ""
+}
+
+func (m *Key) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Key) GetRevokedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return nil
+}
+
+type KeyAuditLog struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"key_id"
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id"`
+	//@inject_tag: json:"key_product_id"
+	KeyProductId string `protobuf:"bytes,3,opt,name=key_product_id,json=keyProductId,proto3" json:"key_product_id"`
+	//@inject_tag: json:"platform_id"
+	PlatformId string `protobuf:"bytes,4,opt,name=platform_id,json=platformId,proto3" json:"platform_id"`
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	// action with key, one of: upload, read, reserve, redeem, cancel, expire, rotate, revoke, delete, export
+	//@inject_tag: json:"action"
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *KeyAuditLog) Reset()         { *m = KeyAuditLog{} }
+func (m *KeyAuditLog) String() string { return proto.CompactTextString(m) }
+func (*KeyAuditLog) ProtoMessage()    {}
+func (*KeyAuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{126}
+}
+
+func (m *KeyAuditLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyAuditLog.Unmarshal(m, b)
+}
+func (m *KeyAuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyAuditLog.Marshal(b, m, deterministic)
+}
+func (m *KeyAuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAuditLog.Merge(m, src)
+}
+func (m *KeyAuditLog) XXX_Size() int {
+	return xxx_messageInfo_KeyAuditLog.Size(m)
+}
+func (m *KeyAuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAuditLog proto.InternalMessageInfo
+
+func (m *KeyAuditLog) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *KeyAuditLog) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *KeyAuditLog) GetKeyProductId() string {
+	if m != nil {
+		return m.KeyProductId
+	}
+	return ""
+}
+
+func (m *KeyAuditLog) GetPlatformId() string {
+	if m != nil {
+		return m.PlatformId
+	}
+	return ""
+}
+
+func (m *KeyAuditLog) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *KeyAuditLog) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *KeyAuditLog) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type KeyReservationExpired struct {
+	//@inject_tag: json:"key_id"
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id"`
+	//@inject_tag: json:"key_product_id"
+	KeyProductId string `protobuf:"bytes,2,opt,name=key_product_id,json=keyProductId,proto3" json:"key_product_id"`
+	//@inject_tag: json:"platform_id"
+	PlatformId string `protobuf:"bytes,3,opt,name=platform_id,json=platformId,proto3" json:"platform_id"`
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	//@inject_tag: json:"reserved_to"
+	ReservedTo *timestamp.Timestamp `protobuf:"bytes,5,opt,name=reserved_to,json=reservedTo,proto3" json:"reserved_to"`
+	//@inject_tag: json:"expired_at"
+	ExpiredAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *KeyReservationExpired) Reset()         { *m = KeyReservationExpired{} }
+func (m *KeyReservationExpired) String() string { return proto.CompactTextString(m) }
+func (*KeyReservationExpired) ProtoMessage()    {}
+func (*KeyReservationExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{127}
+}
+
+func (m *KeyReservationExpired) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyReservationExpired.Unmarshal(m, b)
+}
+func (m *KeyReservationExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyReservationExpired.Marshal(b, m, deterministic)
+}
+func (m *KeyReservationExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyReservationExpired.Merge(m, src)
+}
+func (m *KeyReservationExpired) XXX_Size() int {
+	return xxx_messageInfo_KeyReservationExpired.Size(m)
+}
+func (m *KeyReservationExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyReservationExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyReservationExpired proto.InternalMessageInfo
+
+func (m *KeyReservationExpired) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *KeyReservationExpired) GetKeyProductId() string {
+	if m != nil {
+		return m.KeyProductId
+	}
+	return ""
+}
+
+func (m *KeyReservationExpired) GetPlatformId() string {
+	if m != nil {
+		return m.PlatformId
+	}
+	return ""
+}
+
+func (m *KeyReservationExpired) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *KeyReservationExpired) GetReservedTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReservedTo
+	}
+	return nil
+}
+
+func (m *KeyReservationExpired) GetExpiredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiredAt
+	}
+	return nil
+}
+
+type PayoutDocument struct {
+	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"merchant_id" bson:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" bson:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"source_id"
+	SourceId []string `protobuf:"bytes,3,rep,name=source_id,json=sourceId,proto3" json:"source_id"`
+	// @inject_tag: json:"total_fees" validate:"numeric,gte=0"
+	TotalFees float64 `protobuf:"fixed64,4,opt,name=total_fees,json=totalFees,proto3" json:"total_fees" validate:"numeric,gte=0"`
+	// @inject_tag: json:"balance" validate:"numeric,gte=0"
+	Balance float64 `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance" validate:"numeric,gte=0"`
+	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"period_from"
+	PeriodFrom *timestamp.Timestamp `protobuf:"bytes,7,opt,name=period_from,json=periodFrom,proto3" json:"period_from"`
+	// @inject_tag: json:"period_to"
+	PeriodTo *timestamp.Timestamp `protobuf:"bytes,8,opt,name=period_to,json=periodTo,proto3" json:"period_to"`
+	// @inject_tag: json:"total_transactions"
+	TotalTransactions int32 `protobuf:"varint,9,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions"`
+	//@inject_tag: json:"description" validate:"omitempty,max=255"
+	Description string `protobuf:"bytes,10,opt,name=description,proto3" json:"description" validate:"omitempty,max=255"`
+	//@inject_tag: json:"destination" validate:"max=255"
+	Destination *MerchantBanking `protobuf:"bytes,11,opt,name=destination,proto3" json:"destination" validate:"max=255"`
+	//@inject_tag: json:"merchant_agreement_number" validate:"max=255"
+	MerchantAgreementNumber string `protobuf:"bytes,12,opt,name=merchant_agreement_number,json=merchantAgreementNumber,proto3" json:"merchant_agreement_number" validate:"max=255"`
+	// @inject_tag: json:"company"
+	Company *MerchantCompanyInfo `protobuf:"bytes,13,opt,name=company,proto3" json:"company"`
+	//@inject_tag: json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"
+	Status string `protobuf:"bytes,14,opt,name=status,proto3" json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"`
+	//@inject_tag: json:"transaction" validate:"max=255"
+	Transaction string `protobuf:"bytes,15,opt,name=transaction,proto3" json:"transaction" validate:"max=255"`
+	//@inject_tag: json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"
+	FailureCode string `protobuf:"bytes,16,opt,name=failure_code,json=failureCode,proto3" json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"`
+	//@inject_tag: json:"failure_message" validate:"max=255"
+	FailureMessage string `protobuf:"bytes,17,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message" validate:"max=255"`
+	//@inject_tag: json:"failure_transaction" validate:"max=255"
+	FailureTransaction string `protobuf:"bytes,18,opt,name=failure_transaction,json=failureTransaction,proto3" json:"failure_transaction" validate:"max=255"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	//@inject_tag: json:"arrival_date"
+	ArrivalDate *timestamp.Timestamp `protobuf:"bytes,26,opt,name=arrival_date,json=arrivalDate,proto3" json:"arrival_date"`
+	//@inject_tag: json:"paid_at"
+	PaidAt *timestamp.Timestamp `protobuf:"bytes,27,opt,name=paid_at,json=paidAt,proto3" json:"paid_at"`
+	//@inject_tag: json:"batch_id"
+	BatchId              string   `protobuf:"bytes,28,opt,name=batch_id,json=batchId,proto3" json:"batch_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PayoutDocument) Reset()         { *m = PayoutDocument{} }
+func (m *PayoutDocument) String() string { return proto.CompactTextString(m) }
+func (*PayoutDocument) ProtoMessage()    {}
+func (*PayoutDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{128}
+}
+
+func (m *PayoutDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutDocument.Unmarshal(m, b)
+}
+func (m *PayoutDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutDocument.Marshal(b, m, deterministic)
+}
+func (m *PayoutDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutDocument.Merge(m, src)
+}
+func (m *PayoutDocument) XXX_Size() int {
+	return xxx_messageInfo_PayoutDocument.Size(m)
+}
+func (m *PayoutDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutDocument proto.InternalMessageInfo
+
+func (m *PayoutDocument) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetSourceId() []string {
+	if m != nil {
+		return m.SourceId
+	}
+	return nil
+}
+
+func (m *PayoutDocument) GetTotalFees() float64 {
+	if m != nil {
+		return m.TotalFees
+	}
+	return 0
+}
+
+func (m *PayoutDocument) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *PayoutDocument) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetPeriodFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.PeriodFrom
+	}
+	return nil
+}
+
+func (m *PayoutDocument) GetPeriodTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.PeriodTo
+	}
+	return nil
+}
+
+func (m *PayoutDocument) GetTotalTransactions() int32 {
+	if m != nil {
+		return m.TotalTransactions
+	}
+	return 0
+}
+
+func (m *PayoutDocument) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetDestination() *MerchantBanking {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *PayoutDocument) GetMerchantAgreementNumber() string {
+	if m != nil {
+		return m.MerchantAgreementNumber
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetCompany() *MerchantCompanyInfo {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+func (m *PayoutDocument) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetTransaction() string {
+	if m != nil {
+		return m.Transaction
+	}
+	return ""
+}
+
+func (m *PayoutDocument) GetFailureCode() string {
 	if m != nil {
-		return m.Uuid
+		return m.FailureCode
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetTotalPaymentAmount() float64 {
+func (m *PayoutDocument) GetFailureMessage() string {
 	if m != nil {
-		return m.TotalPaymentAmount
+		return m.FailureMessage
 	}
-	return 0
+	return ""
 }
 
-func (m *OrderViewPrivate) GetCurrency() string {
+func (m *PayoutDocument) GetFailureTransaction() string {
 	if m != nil {
-		return m.Currency
+		return m.FailureTransaction
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetProject() *ProjectOrder {
+func (m *PayoutDocument) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Project
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetCreatedAt() *timestamp.Timestamp {
+func (m *PayoutDocument) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.CreatedAt
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetTransaction() string {
+func (m *PayoutDocument) GetArrivalDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Transaction
+		return m.ArrivalDate
 	}
-	return ""
+	return nil
 }
 
-func (m *OrderViewPrivate) GetPaymentMethod() *PaymentMethodOrder {
+func (m *PayoutDocument) GetPaidAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaymentMethod
+		return m.PaidAt
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetCountryCode() string {
+func (m *PayoutDocument) GetBatchId() string {
 	if m != nil {
-		return m.CountryCode
+		return m.BatchId
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetMerchantId() string {
+type PayoutDocumentChanges struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PayoutDocumentId     string               `protobuf:"bytes,2,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty"`
+	Source               string               `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Ip                   string               `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Hash                 string               `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PayoutDocumentChanges) Reset()         { *m = PayoutDocumentChanges{} }
+func (m *PayoutDocumentChanges) String() string { return proto.CompactTextString(m) }
+func (*PayoutDocumentChanges) ProtoMessage()    {}
+func (*PayoutDocumentChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{129}
+}
+
+func (m *PayoutDocumentChanges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutDocumentChanges.Unmarshal(m, b)
+}
+func (m *PayoutDocumentChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutDocumentChanges.Marshal(b, m, deterministic)
+}
+func (m *PayoutDocumentChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutDocumentChanges.Merge(m, src)
+}
+func (m *PayoutDocumentChanges) XXX_Size() int {
+	return xxx_messageInfo_PayoutDocumentChanges.Size(m)
+}
+func (m *PayoutDocumentChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutDocumentChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutDocumentChanges proto.InternalMessageInfo
+
+func (m *PayoutDocumentChanges) GetId() string {
 	if m != nil {
-		return m.MerchantId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetLocale() string {
+func (m *PayoutDocumentChanges) GetPayoutDocumentId() string {
 	if m != nil {
-		return m.Locale
+		return m.PayoutDocumentId
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetStatus() string {
+func (m *PayoutDocumentChanges) GetSource() string {
 	if m != nil {
-		return m.Status
+		return m.Source
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetTransactionDate() *timestamp.Timestamp {
+func (m *PayoutDocumentChanges) GetIp() string {
 	if m != nil {
-		return m.TransactionDate
+		return m.Ip
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetUser() *OrderUser {
+func (m *PayoutDocumentChanges) GetHash() string {
 	if m != nil {
-		return m.User
+		return m.Hash
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetBillingAddress() *OrderBillingAddress {
+func (m *PayoutDocumentChanges) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.BillingAddress
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetType() string {
+type MerchantBalance struct {
+	//@inject_tag: json:"id" validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	//@inject_tag: json:"debit" validate:"required,numeric"
+	Debit float64 `protobuf:"fixed64,4,opt,name=debit,proto3" json:"debit" validate:"required,numeric"`
+	//@inject_tag: json:"credit" validate:"required,numeric"
+	Credit float64 `protobuf:"fixed64,5,opt,name=credit,proto3" json:"credit" validate:"required,numeric"`
+	//@inject_tag: json:"rolling_reserve" validate:"required,numeric"
+	RollingReserve float64 `protobuf:"fixed64,6,opt,name=rolling_reserve,json=rollingReserve,proto3" json:"rolling_reserve" validate:"required,numeric"`
+	//@inject_tag: json:"total" validate:"required,numeric"
+	Total                float64              `protobuf:"fixed64,7,opt,name=total,proto3" json:"total" validate:"required,numeric"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantBalance) Reset()         { *m = MerchantBalance{} }
+func (m *MerchantBalance) String() string { return proto.CompactTextString(m) }
+func (*MerchantBalance) ProtoMessage()    {}
+func (*MerchantBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{130}
+}
+
+func (m *MerchantBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantBalance.Unmarshal(m, b)
+}
+func (m *MerchantBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantBalance.Marshal(b, m, deterministic)
+}
+func (m *MerchantBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantBalance.Merge(m, src)
+}
+func (m *MerchantBalance) XXX_Size() int {
+	return xxx_messageInfo_MerchantBalance.Size(m)
+}
+func (m *MerchantBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantBalance proto.InternalMessageInfo
+
+func (m *MerchantBalance) GetId() string {
 	if m != nil {
-		return m.Type
+		return m.Id
 	}
 	return ""
 }
 
-func (m *OrderViewPrivate) GetIsVatDeduction() bool {
+func (m *MerchantBalance) GetMerchantId() string {
 	if m != nil {
-		return m.IsVatDeduction
+		return m.MerchantId
 	}
-	return false
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentGrossRevenueLocal() *OrderViewMoney {
+func (m *MerchantBalance) GetCurrency() string {
 	if m != nil {
-		return m.PaymentGrossRevenueLocal
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentGrossRevenueOrigin() *OrderViewMoney {
+func (m *MerchantBalance) GetDebit() float64 {
 	if m != nil {
-		return m.PaymentGrossRevenueOrigin
+		return m.Debit
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPrivate) GetPaymentGrossRevenue() *OrderViewMoney {
+func (m *MerchantBalance) GetCredit() float64 {
 	if m != nil {
-		return m.PaymentGrossRevenue
+		return m.Credit
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPrivate) GetPaymentTaxFee() *OrderViewMoney {
+func (m *MerchantBalance) GetRollingReserve() float64 {
 	if m != nil {
-		return m.PaymentTaxFee
+		return m.RollingReserve
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPrivate) GetPaymentTaxFeeLocal() *OrderViewMoney {
+func (m *MerchantBalance) GetTotal() float64 {
 	if m != nil {
-		return m.PaymentTaxFeeLocal
+		return m.Total
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPrivate) GetPaymentTaxFeeOrigin() *OrderViewMoney {
+func (m *MerchantBalance) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaymentTaxFeeOrigin
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetPaymentTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+type MerchantBalanceRollingReserveRelease struct {
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	//@inject_tag: json:"release_date"
+	ReleaseDate          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantBalanceRollingReserveRelease) Reset()         { *m = MerchantBalanceRollingReserveRelease{} }
+func (m *MerchantBalanceRollingReserveRelease) String() string { return proto.CompactTextString(m) }
+func (*MerchantBalanceRollingReserveRelease) ProtoMessage()    {}
+func (*MerchantBalanceRollingReserveRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{131}
+}
+
+func (m *MerchantBalanceRollingReserveRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantBalanceRollingReserveRelease.Unmarshal(m, b)
+}
+func (m *MerchantBalanceRollingReserveRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantBalanceRollingReserveRelease.Marshal(b, m, deterministic)
+}
+func (m *MerchantBalanceRollingReserveRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantBalanceRollingReserveRelease.Merge(m, src)
+}
+func (m *MerchantBalanceRollingReserveRelease) XXX_Size() int {
+	return xxx_messageInfo_MerchantBalanceRollingReserveRelease.Size(m)
+}
+func (m *MerchantBalanceRollingReserveRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantBalanceRollingReserveRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantBalanceRollingReserveRelease proto.InternalMessageInfo
+
+func (m *MerchantBalanceRollingReserveRelease) GetCurrency() string {
 	if m != nil {
-		return m.PaymentTaxFeeCurrencyExchangeFee
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentTaxFeeTotal() *OrderViewMoney {
+func (m *MerchantBalanceRollingReserveRelease) GetAmount() float64 {
 	if m != nil {
-		return m.PaymentTaxFeeTotal
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderViewPrivate) GetPaymentGrossRevenueFx() *OrderViewMoney {
+func (m *MerchantBalanceRollingReserveRelease) GetReleaseDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaymentGrossRevenueFx
+		return m.ReleaseDate
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetPaymentGrossRevenueFxTaxFee() *OrderViewMoney {
-	if m != nil {
-		return m.PaymentGrossRevenueFxTaxFee
-	}
-	return nil
-}
+type OrderReceipt struct {
+	//@inject_tag: json:"total_price"
+	TotalPrice string `protobuf:"bytes,1,opt,name=total_price,json=totalPrice,proto3" json:"total_price"`
+	//@inject_tag: json:"transaction_id"
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id"`
+	//@inject_tag: json:"transaction_date"
+	TransactionDate string `protobuf:"bytes,3,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date"`
+	//@inject_tag: json:"project_name"
+	ProjectName string `protobuf:"bytes,4,opt,name=project_name,json=projectName,proto3" json:"project_name"`
+	//@inject_tag: json:"merchant_name"
+	MerchantName string `protobuf:"bytes,5,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name"`
+	//@inject_tag: json:"items"
+	Items []*OrderReceiptItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items"`
+	//@inject_tag: json:"order_type"
+	OrderType string `protobuf:"bytes,7,opt,name=order_type,json=orderType,proto3" json:"order_type"`
+	//@inject_tag: json:"platform_name"
+	PlatformName string `protobuf:"bytes,8,opt,name=platform_name,json=platformName,proto3" json:"platform_name"`
+	//@inject_tag: json:"total_discount"
+	TotalDiscount string `protobuf:"bytes,9,opt,name=total_discount,json=totalDiscount,proto3" json:"total_discount"`
+	//@inject_tag: json:"promo_code"
+	PromoCode            string   `protobuf:"bytes,10,opt,name=promo_code,json=promoCode,proto3" json:"promo_code"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderReceipt) Reset()         { *m = OrderReceipt{} }
+func (m *OrderReceipt) String() string { return proto.CompactTextString(m) }
+func (*OrderReceipt) ProtoMessage()    {}
+func (*OrderReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{132}
+}
+
+func (m *OrderReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderReceipt.Unmarshal(m, b)
+}
+func (m *OrderReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderReceipt.Marshal(b, m, deterministic)
+}
+func (m *OrderReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReceipt.Merge(m, src)
+}
+func (m *OrderReceipt) XXX_Size() int {
+	return xxx_messageInfo_OrderReceipt.Size(m)
+}
+func (m *OrderReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderReceipt proto.InternalMessageInfo
 
-func (m *OrderViewPrivate) GetPaymentGrossRevenueFxProfit() *OrderViewMoney {
+func (m *OrderReceipt) GetTotalPrice() string {
 	if m != nil {
-		return m.PaymentGrossRevenueFxProfit
+		return m.TotalPrice
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetGrossRevenue() *OrderViewMoney {
+func (m *OrderReceipt) GetTransactionId() string {
 	if m != nil {
-		return m.GrossRevenue
+		return m.TransactionId
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetTaxFee() *OrderViewMoney {
+func (m *OrderReceipt) GetTransactionDate() string {
 	if m != nil {
-		return m.TaxFee
+		return m.TransactionDate
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+func (m *OrderReceipt) GetProjectName() string {
 	if m != nil {
-		return m.TaxFeeCurrencyExchangeFee
+		return m.ProjectName
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetTaxFeeTotal() *OrderViewMoney {
+func (m *OrderReceipt) GetMerchantName() string {
 	if m != nil {
-		return m.TaxFeeTotal
+		return m.MerchantName
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetMethodFeeTotal() *OrderViewMoney {
+func (m *OrderReceipt) GetItems() []*OrderReceiptItem {
 	if m != nil {
-		return m.MethodFeeTotal
+		return m.Items
 	}
 	return nil
 }
 
-func (m *OrderViewPrivate) GetMethodFeeTariff() *OrderViewMoney {
+func (m *OrderReceipt) GetOrderType() string {
 	if m != nil {
-		return m.MethodFeeTariff
+		return m.OrderType
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodFeeTariffSelfCost() *OrderViewMoney {
+func (m *OrderReceipt) GetPlatformName() string {
 	if m != nil {
-		return m.PaysuperMethodFeeTariffSelfCost
+		return m.PlatformName
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodFeeProfit() *OrderViewMoney {
+func (m *OrderReceipt) GetTotalDiscount() string {
 	if m != nil {
-		return m.PaysuperMethodFeeProfit
+		return m.TotalDiscount
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetMethodFixedFeeTariff() *OrderViewMoney {
+func (m *OrderReceipt) GetPromoCode() string {
 	if m != nil {
-		return m.MethodFixedFeeTariff
+		return m.PromoCode
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodFixedFeeTariffFxProfit() *OrderViewMoney {
-	if m != nil {
-		return m.PaysuperMethodFixedFeeTariffFxProfit
-	}
-	return nil
+type OrderReceiptItem struct {
+	//@inject_tag: json:"name"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	//@inject_tag: json:"price"
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	//@inject_tag: json:"discount"
+	Discount             string   `protobuf:"bytes,3,opt,name=discount,proto3" json:"discount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodFixedFeeTariffSelfCost() *OrderViewMoney {
-	if m != nil {
-		return m.PaysuperMethodFixedFeeTariffSelfCost
-	}
-	return nil
+func (m *OrderReceiptItem) Reset()         { *m = OrderReceiptItem{} }
+func (m *OrderReceiptItem) String() string { return proto.CompactTextString(m) }
+func (*OrderReceiptItem) ProtoMessage()    {}
+func (*OrderReceiptItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{133}
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodFixedFeeTariffTotalProfit() *OrderViewMoney {
-	if m != nil {
-		return m.PaysuperMethodFixedFeeTariffTotalProfit
-	}
-	return nil
+func (m *OrderReceiptItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderReceiptItem.Unmarshal(m, b)
+}
+func (m *OrderReceiptItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderReceiptItem.Marshal(b, m, deterministic)
+}
+func (m *OrderReceiptItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReceiptItem.Merge(m, src)
+}
+func (m *OrderReceiptItem) XXX_Size() int {
+	return xxx_messageInfo_OrderReceiptItem.Size(m)
+}
+func (m *OrderReceiptItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReceiptItem.DiscardUnknown(m)
 }
 
-func (m *OrderViewPrivate) GetPaysuperFixedFee() *OrderViewMoney {
+var xxx_messageInfo_OrderReceiptItem proto.InternalMessageInfo
+
+func (m *OrderReceiptItem) GetName() string {
 	if m != nil {
-		return m.PaysuperFixedFee
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperFixedFeeFxProfit() *OrderViewMoney {
+func (m *OrderReceiptItem) GetPrice() string {
 	if m != nil {
-		return m.PaysuperFixedFeeFxProfit
+		return m.Price
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetFeesTotal() *OrderViewMoney {
+func (m *OrderReceiptItem) GetDiscount() string {
 	if m != nil {
-		return m.FeesTotal
+		return m.Discount
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetFeesTotalLocal() *OrderViewMoney {
-	if m != nil {
-		return m.FeesTotalLocal
-	}
-	return nil
+type HasCurrencyItem struct {
+	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	//@inject_tag: json:"region" validate:"required,region_price"
+	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region" validate:"required,region_price"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderViewPrivate) GetNetRevenue() *OrderViewMoney {
-	if m != nil {
-		return m.NetRevenue
-	}
-	return nil
+func (m *HasCurrencyItem) Reset()         { *m = HasCurrencyItem{} }
+func (m *HasCurrencyItem) String() string { return proto.CompactTextString(m) }
+func (*HasCurrencyItem) ProtoMessage()    {}
+func (*HasCurrencyItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{134}
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodTotalProfit() *OrderViewMoney {
-	if m != nil {
-		return m.PaysuperMethodTotalProfit
-	}
-	return nil
+func (m *HasCurrencyItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasCurrencyItem.Unmarshal(m, b)
+}
+func (m *HasCurrencyItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasCurrencyItem.Marshal(b, m, deterministic)
+}
+func (m *HasCurrencyItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasCurrencyItem.Merge(m, src)
+}
+func (m *HasCurrencyItem) XXX_Size() int {
+	return xxx_messageInfo_HasCurrencyItem.Size(m)
+}
+func (m *HasCurrencyItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasCurrencyItem.DiscardUnknown(m)
 }
 
-func (m *OrderViewPrivate) GetPaysuperTotalProfit() *OrderViewMoney {
+var xxx_messageInfo_HasCurrencyItem proto.InternalMessageInfo
+
+func (m *HasCurrencyItem) GetCurrency() string {
 	if m != nil {
-		return m.PaysuperTotalProfit
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentRefundGrossRevenueLocal() *OrderViewMoney {
+func (m *HasCurrencyItem) GetRegion() string {
 	if m != nil {
-		return m.PaymentRefundGrossRevenueLocal
+		return m.Region
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentRefundGrossRevenueOrigin() *OrderViewMoney {
-	if m != nil {
-		return m.PaymentRefundGrossRevenueOrigin
-	}
-	return nil
+type LocalizedUrl struct {
+	//@inject_tag: validate:"omitempty,uri" json:"en"
+	En string `protobuf:"bytes,1,opt,name=en,proto3" json:"en" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"ru"
+	Ru string `protobuf:"bytes,2,opt,name=ru,proto3" json:"ru" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"es"
+	Es string `protobuf:"bytes,3,opt,name=es,proto3" json:"es" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"de"
+	De string `protobuf:"bytes,4,opt,name=de,proto3" json:"de" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"zh"
+	Zh string `protobuf:"bytes,5,opt,name=zh,proto3" json:"zh" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"fr"
+	Fr string `protobuf:"bytes,6,opt,name=fr,proto3" json:"fr" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"ar"
+	Ar string `protobuf:"bytes,7,opt,name=ar,proto3" json:"ar" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"pt"
+	Pt string `protobuf:"bytes,8,opt,name=pt,proto3" json:"pt" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"it"
+	It string `protobuf:"bytes,9,opt,name=it,proto3" json:"it" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"pl"
+	Pl string `protobuf:"bytes,10,opt,name=pl,proto3" json:"pl" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"el"
+	El string `protobuf:"bytes,11,opt,name=el,proto3" json:"el" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"ko"
+	Ko string `protobuf:"bytes,12,opt,name=ko,proto3" json:"ko" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"ja"
+	Ja string `protobuf:"bytes,13,opt,name=ja,proto3" json:"ja" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"vl"
+	Vl string `protobuf:"bytes,14,opt,name=vl,proto3" json:"vl" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"he"
+	He string `protobuf:"bytes,15,opt,name=he,proto3" json:"he" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"th"
+	Th string `protobuf:"bytes,16,opt,name=th,proto3" json:"th" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"cs"
+	Cs string `protobuf:"bytes,17,opt,name=cs,proto3" json:"cs" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"bg"
+	Bg string `protobuf:"bytes,18,opt,name=bg,proto3" json:"bg" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"fi"
+	Fi string `protobuf:"bytes,19,opt,name=fi,proto3" json:"fi" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"sv"
+	Sv string `protobuf:"bytes,20,opt,name=sv,proto3" json:"sv" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"da"
+	Da string `protobuf:"bytes,21,opt,name=da,proto3" json:"da" validate:"omitempty,uri"`
+	//@inject_tag: validate:"omitempty,uri" json:"tr"
+	Tr                   string   `protobuf:"bytes,22,opt,name=tr,proto3" json:"tr" validate:"omitempty,uri"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderViewPrivate) GetPaymentRefundGrossRevenue() *OrderViewMoney {
-	if m != nil {
-		return m.PaymentRefundGrossRevenue
-	}
-	return nil
+func (m *LocalizedUrl) Reset()         { *m = LocalizedUrl{} }
+func (m *LocalizedUrl) String() string { return proto.CompactTextString(m) }
+func (*LocalizedUrl) ProtoMessage()    {}
+func (*LocalizedUrl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{135}
 }
 
-func (m *OrderViewPrivate) GetPaymentRefundTaxFee() *OrderViewMoney {
-	if m != nil {
-		return m.PaymentRefundTaxFee
-	}
-	return nil
+func (m *LocalizedUrl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalizedUrl.Unmarshal(m, b)
+}
+func (m *LocalizedUrl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalizedUrl.Marshal(b, m, deterministic)
+}
+func (m *LocalizedUrl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalizedUrl.Merge(m, src)
+}
+func (m *LocalizedUrl) XXX_Size() int {
+	return xxx_messageInfo_LocalizedUrl.Size(m)
 }
+func (m *LocalizedUrl) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalizedUrl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalizedUrl proto.InternalMessageInfo
 
-func (m *OrderViewPrivate) GetPaymentRefundTaxFeeLocal() *OrderViewMoney {
+func (m *LocalizedUrl) GetEn() string {
 	if m != nil {
-		return m.PaymentRefundTaxFeeLocal
+		return m.En
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentRefundTaxFeeOrigin() *OrderViewMoney {
+func (m *LocalizedUrl) GetRu() string {
 	if m != nil {
-		return m.PaymentRefundTaxFeeOrigin
+		return m.Ru
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaymentRefundFeeTariff() *OrderViewMoney {
+func (m *LocalizedUrl) GetEs() string {
 	if m != nil {
-		return m.PaymentRefundFeeTariff
+		return m.Es
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetMethodRefundFixedFeeTariff() *OrderViewMoney {
+func (m *LocalizedUrl) GetDe() string {
 	if m != nil {
-		return m.MethodRefundFixedFeeTariff
+		return m.De
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundGrossRevenue() *OrderViewMoney {
+func (m *LocalizedUrl) GetZh() string {
 	if m != nil {
-		return m.RefundGrossRevenue
+		return m.Zh
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundGrossRevenueFx() *OrderViewMoney {
+func (m *LocalizedUrl) GetFr() string {
 	if m != nil {
-		return m.RefundGrossRevenueFx
+		return m.Fr
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetMethodRefundFeeTariff() *OrderViewMoney {
+func (m *LocalizedUrl) GetAr() string {
 	if m != nil {
-		return m.MethodRefundFeeTariff
+		return m.Ar
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodRefundFeeTariffProfit() *OrderViewMoney {
+func (m *LocalizedUrl) GetPt() string {
 	if m != nil {
-		return m.PaysuperMethodRefundFeeTariffProfit
+		return m.Pt
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodRefundFixedFeeTariffSelfCost() *OrderViewMoney {
+func (m *LocalizedUrl) GetIt() string {
 	if m != nil {
-		return m.PaysuperMethodRefundFixedFeeTariffSelfCost
+		return m.It
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetMerchantRefundFixedFeeTariff() *OrderViewMoney {
+func (m *LocalizedUrl) GetPl() string {
 	if m != nil {
-		return m.MerchantRefundFixedFeeTariff
+		return m.Pl
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperMethodRefundFixedFeeTariffProfit() *OrderViewMoney {
+func (m *LocalizedUrl) GetEl() string {
 	if m != nil {
-		return m.PaysuperMethodRefundFixedFeeTariffProfit
+		return m.El
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundTaxFee() *OrderViewMoney {
+func (m *LocalizedUrl) GetKo() string {
 	if m != nil {
-		return m.RefundTaxFee
+		return m.Ko
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+func (m *LocalizedUrl) GetJa() string {
 	if m != nil {
-		return m.RefundTaxFeeCurrencyExchangeFee
+		return m.Ja
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperRefundTaxFeeCurrencyExchangeFee() *OrderViewMoney {
+func (m *LocalizedUrl) GetVl() string {
 	if m != nil {
-		return m.PaysuperRefundTaxFeeCurrencyExchangeFee
+		return m.Vl
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundTaxFeeTotal() *OrderViewMoney {
+func (m *LocalizedUrl) GetHe() string {
 	if m != nil {
-		return m.RefundTaxFeeTotal
+		return m.He
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundReverseRevenue() *OrderViewMoney {
+func (m *LocalizedUrl) GetTh() string {
 	if m != nil {
-		return m.RefundReverseRevenue
+		return m.Th
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundFeesTotal() *OrderViewMoney {
+func (m *LocalizedUrl) GetCs() string {
 	if m != nil {
-		return m.RefundFeesTotal
+		return m.Cs
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetRefundFeesTotalLocal() *OrderViewMoney {
+func (m *LocalizedUrl) GetBg() string {
 	if m != nil {
-		return m.RefundFeesTotalLocal
+		return m.Bg
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetPaysuperRefundTotalProfit() *OrderViewMoney {
+func (m *LocalizedUrl) GetFi() string {
 	if m != nil {
-		return m.PaysuperRefundTotalProfit
+		return m.Fi
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetIssuer() *OrderIssuer {
+func (m *LocalizedUrl) GetSv() string {
 	if m != nil {
-		return m.Issuer
+		return m.Sv
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetItems() []*OrderItem {
+func (m *LocalizedUrl) GetDa() string {
 	if m != nil {
-		return m.Items
+		return m.Da
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderViewPrivate) GetMerchantPayoutCurrency() string {
+func (m *LocalizedUrl) GetTr() string {
 	if m != nil {
-		return m.MerchantPayoutCurrency
+		return m.Tr
 	}
 	return ""
 }
 
-type RecommendedPrice struct {
-	// @inject_tag: json:"region"
-	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region"`
-	// @inject_tag: json:"currency"
-	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency"`
-	// @inject_tag: json:"amount"
-	Amount               float64  `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount"`
+type ImageCollection struct {
+	//@inject_tag: json:"images"
+	Images *LocalizedUrl `protobuf:"bytes,1,opt,name=images,proto3" json:"images"`
+	//@inject_tag: json:"use_one_for_all"
+	UseOneForAll         bool     `protobuf:"varint,2,opt,name=use_one_for_all,json=useOneForAll,proto3" json:"use_one_for_all"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *RecommendedPrice) Reset()         { *m = RecommendedPrice{} }
-func (m *RecommendedPrice) String() string { return proto.CompactTextString(m) }
-func (*RecommendedPrice) ProtoMessage()    {}
-func (*RecommendedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{101}
+func (m *ImageCollection) Reset()         { *m = ImageCollection{} }
+func (m *ImageCollection) String() string { return proto.CompactTextString(m) }
+func (*ImageCollection) ProtoMessage()    {}
+func (*ImageCollection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{136}
 }
 
-func (m *RecommendedPrice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RecommendedPrice.Unmarshal(m, b)
+func (m *ImageCollection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageCollection.Unmarshal(m, b)
 }
-func (m *RecommendedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RecommendedPrice.Marshal(b, m, deterministic)
+func (m *ImageCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageCollection.Marshal(b, m, deterministic)
 }
-func (m *RecommendedPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecommendedPrice.Merge(m, src)
+func (m *ImageCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageCollection.Merge(m, src)
 }
-func (m *RecommendedPrice) XXX_Size() int {
-	return xxx_messageInfo_RecommendedPrice.Size(m)
+func (m *ImageCollection) XXX_Size() int {
+	return xxx_messageInfo_ImageCollection.Size(m)
 }
-func (m *RecommendedPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecommendedPrice.DiscardUnknown(m)
+func (m *ImageCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageCollection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecommendedPrice proto.InternalMessageInfo
-
-func (m *RecommendedPrice) GetRegion() string {
-	if m != nil {
-		return m.Region
-	}
-	return ""
-}
+var xxx_messageInfo_ImageCollection proto.InternalMessageInfo
 
-func (m *RecommendedPrice) GetCurrency() string {
+func (m *ImageCollection) GetImages() *LocalizedUrl {
 	if m != nil {
-		return m.Currency
+		return m.Images
 	}
-	return ""
+	return nil
 }
 
-func (m *RecommendedPrice) GetAmount() float64 {
+func (m *ImageCollection) GetUseOneForAll() bool {
 	if m != nil {
-		return m.Amount
+		return m.UseOneForAll
 	}
-	return 0
+	return false
 }
 
-type PriceTable struct {
-	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
-	//@inject_tag: json:"currency" bson:"currency" validate:"required,alpha,len=3"
-	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" bson:"currency" validate:"required,alpha,len=3"`
-	// @inject_tag: json:"ranges"
-	Ranges               []*PriceTableRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
+type ProductPrice struct {
+	// @inject_tag: json:"amount" validate:"required,numeric,gt=0"
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount" validate:"required,numeric,gt=0"`
+	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	//@inject_tag: json:"region" validate:"required,region_price"
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region" validate:"required,region_price"`
+	//@inject_tag: json:"is_virtual_currency"
+	IsVirtualCurrency    bool     `protobuf:"varint,4,opt,name=is_virtual_currency,json=isVirtualCurrency,proto3" json:"is_virtual_currency"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PriceTable) Reset()         { *m = PriceTable{} }
-func (m *PriceTable) String() string { return proto.CompactTextString(m) }
-func (*PriceTable) ProtoMessage()    {}
-func (*PriceTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{102}
+func (m *ProductPrice) Reset()         { *m = ProductPrice{} }
+func (m *ProductPrice) String() string { return proto.CompactTextString(m) }
+func (*ProductPrice) ProtoMessage()    {}
+func (*ProductPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{137}
 }
 
-func (m *PriceTable) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceTable.Unmarshal(m, b)
+func (m *ProductPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProductPrice.Unmarshal(m, b)
 }
-func (m *PriceTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceTable.Marshal(b, m, deterministic)
+func (m *ProductPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProductPrice.Marshal(b, m, deterministic)
 }
-func (m *PriceTable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceTable.Merge(m, src)
+func (m *ProductPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductPrice.Merge(m, src)
 }
-func (m *PriceTable) XXX_Size() int {
-	return xxx_messageInfo_PriceTable.Size(m)
+func (m *ProductPrice) XXX_Size() int {
+	return xxx_messageInfo_ProductPrice.Size(m)
 }
-func (m *PriceTable) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceTable.DiscardUnknown(m)
+func (m *ProductPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductPrice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceTable proto.InternalMessageInfo
+var xxx_messageInfo_ProductPrice proto.InternalMessageInfo
 
-func (m *PriceTable) GetId() string {
+func (m *ProductPrice) GetAmount() float64 {
 	if m != nil {
-		return m.Id
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ProductPrice) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
 	return ""
 }
 
-func (m *PriceTable) GetCurrency() string {
+func (m *ProductPrice) GetRegion() string {
 	if m != nil {
-		return m.Currency
+		return m.Region
 	}
 	return ""
 }
 
-func (m *PriceTable) GetRanges() []*PriceTableRange {
+func (m *ProductPrice) GetIsVirtualCurrency() bool {
 	if m != nil {
-		return m.Ranges
+		return m.IsVirtualCurrency
 	}
-	return nil
+	return false
 }
 
-type PriceTableRange struct {
-	//@inject_tag: json:"position" bson:"position"
-	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position" bson:"position"`
-	// @inject_tag: json:"from"
-	From float64 `protobuf:"fixed64,3,opt,name=from,proto3" json:"from"`
-	// @inject_tag: json:"to"
-	To                   float64  `protobuf:"fixed64,4,opt,name=to,proto3" json:"to"`
+type ProjectVirtualCurrency struct {
+	//@inject_tag: json:"logo" validate:"omitempty,url"
+	Logo string `protobuf:"bytes,1,opt,name=logo,proto3" json:"logo" validate:"omitempty,url"`
+	// @inject_tag: json:"name" validate:"required,min=1"
+	Name map[string]string `protobuf:"bytes,2,rep,name=name,proto3" json:"name" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required,min=1"`
+	// @inject_tag: json:"success_message" validate:"required,min=1"
+	SuccessMessage map[string]string `protobuf:"bytes,3,rep,name=success_message,json=successMessage,proto3" json:"success_message" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required,min=1"`
+	//@inject_tag: json:"prices" validate:"required,min=1,dive"
+	Prices []*ProductPrice `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive"`
+	//@inject_tag: json:"min_purchase_value"
+	MinPurchaseValue float64 `protobuf:"fixed64,5,opt,name=min_purchase_value,json=minPurchaseValue,proto3" json:"min_purchase_value"`
+	//@inject_tag: json:"max_purchase_value"
+	MaxPurchaseValue float64 `protobuf:"fixed64,6,opt,name=max_purchase_value,json=maxPurchaseValue,proto3" json:"max_purchase_value"`
+	//@inject_tag: json:"sell_count_type" validate:"omitempty,oneof=fractional integral"
+	SellCountType        string   `protobuf:"bytes,7,opt,name=sell_count_type,json=sellCountType,proto3" json:"sell_count_type" validate:"omitempty,oneof=fractional integral"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PriceTableRange) Reset()         { *m = PriceTableRange{} }
-func (m *PriceTableRange) String() string { return proto.CompactTextString(m) }
-func (*PriceTableRange) ProtoMessage()    {}
-func (*PriceTableRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{103}
+func (m *ProjectVirtualCurrency) Reset()         { *m = ProjectVirtualCurrency{} }
+func (m *ProjectVirtualCurrency) String() string { return proto.CompactTextString(m) }
+func (*ProjectVirtualCurrency) ProtoMessage()    {}
+func (*ProjectVirtualCurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{138}
 }
 
-func (m *PriceTableRange) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceTableRange.Unmarshal(m, b)
+func (m *ProjectVirtualCurrency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectVirtualCurrency.Unmarshal(m, b)
 }
-func (m *PriceTableRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceTableRange.Marshal(b, m, deterministic)
+func (m *ProjectVirtualCurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectVirtualCurrency.Marshal(b, m, deterministic)
 }
-func (m *PriceTableRange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceTableRange.Merge(m, src)
+func (m *ProjectVirtualCurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectVirtualCurrency.Merge(m, src)
 }
-func (m *PriceTableRange) XXX_Size() int {
-	return xxx_messageInfo_PriceTableRange.Size(m)
+func (m *ProjectVirtualCurrency) XXX_Size() int {
+	return xxx_messageInfo_ProjectVirtualCurrency.Size(m)
 }
-func (m *PriceTableRange) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceTableRange.DiscardUnknown(m)
+func (m *ProjectVirtualCurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectVirtualCurrency.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceTableRange proto.InternalMessageInfo
+var xxx_messageInfo_ProjectVirtualCurrency proto.InternalMessageInfo
 
-func (m *PriceTableRange) GetPosition() int32 {
+func (m *ProjectVirtualCurrency) GetLogo() string {
 	if m != nil {
-		return m.Position
+		return m.Logo
 	}
-	return 0
+	return ""
 }
 
-func (m *PriceTableRange) GetFrom() float64 {
+func (m *ProjectVirtualCurrency) GetName() map[string]string {
 	if m != nil {
-		return m.From
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ProjectVirtualCurrency) GetSuccessMessage() map[string]string {
+	if m != nil {
+		return m.SuccessMessage
+	}
+	return nil
+}
+
+func (m *ProjectVirtualCurrency) GetPrices() []*ProductPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *ProjectVirtualCurrency) GetMinPurchaseValue() float64 {
+	if m != nil {
+		return m.MinPurchaseValue
 	}
 	return 0
 }
 
-func (m *PriceTableRange) GetTo() float64 {
+func (m *ProjectVirtualCurrency) GetMaxPurchaseValue() float64 {
 	if m != nil {
-		return m.To
+		return m.MaxPurchaseValue
 	}
 	return 0
 }
 
-type Id struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *ProjectVirtualCurrency) GetSellCountType() string {
+	if m != nil {
+		return m.SellCountType
+	}
+	return ""
+}
+
+type OrderCreateByPaylink struct {
+	//@inject_tag: json:"id" validate:"required,hexadecimal,len=24"
+	PaylinkId string `protobuf:"bytes,1,opt,name=paylink_id,json=paylinkId,proto3" json:"id" validate:"required,hexadecimal,len=24"`
+	PayerIp   string `protobuf:"bytes,2,opt,name=payer_ip,json=payerIp,proto3" json:"payer_ip,omitempty"`
+	IssuerUrl string `protobuf:"bytes,3,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	// @inject_tag: json:"is_embedded"
+	IsEmbedded bool `protobuf:"varint,4,opt,name=is_embedded,json=isEmbedded,proto3" json:"is_embedded"`
+	//@inject_tag: bson:"utm_source" json:"utm_source"
+	UtmSource string `protobuf:"bytes,5,opt,name=utm_source,json=utmSource,proto3" json:"utm_source" bson:"utm_source"`
+	//@inject_tag: bson:"utm_medium" json:"utm_medium"
+	UtmMedium string `protobuf:"bytes,6,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium" bson:"utm_medium"`
+	//@inject_tag: bson:"utm_campaign" json:"utm_campaign"
+	UtmCampaign string `protobuf:"bytes,7,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign" bson:"utm_campaign"`
+	// signed paylink token issued to buyer
+	//@inject_tag: json:"token"
+	Token                string   `protobuf:"bytes,8,opt,name=token,proto3" json:"token"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *Id) Reset()         { *m = Id{} }
-func (m *Id) String() string { return proto.CompactTextString(m) }
-func (*Id) ProtoMessage()    {}
-func (*Id) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{104}
+func (m *OrderCreateByPaylink) Reset()         { *m = OrderCreateByPaylink{} }
+func (m *OrderCreateByPaylink) String() string { return proto.CompactTextString(m) }
+func (*OrderCreateByPaylink) ProtoMessage()    {}
+func (*OrderCreateByPaylink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{139}
 }
 
-func (m *Id) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Id.Unmarshal(m, b)
+func (m *OrderCreateByPaylink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderCreateByPaylink.Unmarshal(m, b)
 }
-func (m *Id) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Id.Marshal(b, m, deterministic)
+func (m *OrderCreateByPaylink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderCreateByPaylink.Marshal(b, m, deterministic)
 }
-func (m *Id) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Id.Merge(m, src)
+func (m *OrderCreateByPaylink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCreateByPaylink.Merge(m, src)
 }
-func (m *Id) XXX_Size() int {
-	return xxx_messageInfo_Id.Size(m)
+func (m *OrderCreateByPaylink) XXX_Size() int {
+	return xxx_messageInfo_OrderCreateByPaylink.Size(m)
 }
-func (m *Id) XXX_DiscardUnknown() {
-	xxx_messageInfo_Id.DiscardUnknown(m)
+func (m *OrderCreateByPaylink) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCreateByPaylink.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Id proto.InternalMessageInfo
+var xxx_messageInfo_OrderCreateByPaylink proto.InternalMessageInfo
 
-func (m *Id) GetId() string {
+func (m *OrderCreateByPaylink) GetPaylinkId() string {
 	if m != nil {
-		return m.Id
+		return m.PaylinkId
 	}
 	return ""
 }
 
-type RangeInt struct {
-	//@inject_tag: json:"from"
-	From int32 `protobuf:"varint,1,opt,name=from,proto3" json:"from"`
-	//@inject_tag: json:"to"
-	To                   int32    `protobuf:"varint,2,opt,name=to,proto3" json:"to"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *OrderCreateByPaylink) GetPayerIp() string {
+	if m != nil {
+		return m.PayerIp
+	}
+	return ""
 }
 
-func (m *RangeInt) Reset()         { *m = RangeInt{} }
-func (m *RangeInt) String() string { return proto.CompactTextString(m) }
-func (*RangeInt) ProtoMessage()    {}
-func (*RangeInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{105}
+func (m *OrderCreateByPaylink) GetIssuerUrl() string {
+	if m != nil {
+		return m.IssuerUrl
+	}
+	return ""
 }
 
-func (m *RangeInt) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RangeInt.Unmarshal(m, b)
-}
-func (m *RangeInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RangeInt.Marshal(b, m, deterministic)
-}
-func (m *RangeInt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RangeInt.Merge(m, src)
-}
-func (m *RangeInt) XXX_Size() int {
-	return xxx_messageInfo_RangeInt.Size(m)
+func (m *OrderCreateByPaylink) GetIsEmbedded() bool {
+	if m != nil {
+		return m.IsEmbedded
+	}
+	return false
 }
-func (m *RangeInt) XXX_DiscardUnknown() {
-	xxx_messageInfo_RangeInt.DiscardUnknown(m)
+
+func (m *OrderCreateByPaylink) GetUtmSource() string {
+	if m != nil {
+		return m.UtmSource
+	}
+	return ""
 }
 
-var xxx_messageInfo_RangeInt proto.InternalMessageInfo
+func (m *OrderCreateByPaylink) GetUtmMedium() string {
+	if m != nil {
+		return m.UtmMedium
+	}
+	return ""
+}
 
-func (m *RangeInt) GetFrom() int32 {
+func (m *OrderCreateByPaylink) GetUtmCampaign() string {
 	if m != nil {
-		return m.From
+		return m.UtmCampaign
 	}
-	return 0
+	return ""
 }
 
-func (m *RangeInt) GetTo() int32 {
+func (m *OrderCreateByPaylink) GetToken() string {
 	if m != nil {
-		return m.To
+		return m.Token
 	}
-	return 0
+	return ""
 }
 
-type MerchantTariffRatesPayment struct {
-	//@inject_tag: json:"min_amount" bson:"min_amount"
-	MinAmount float64 `protobuf:"fixed64,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount" bson:"min_amount"`
-	//@inject_tag: json:"max_amount" bson:"max_amount"
-	MaxAmount float64 `protobuf:"fixed64,2,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount" bson:"max_amount"`
-	//@inject_tag: json:"method_name" bson:"method_name"
-	MethodName string `protobuf:"bytes,3,opt,name=method_name,json=methodName,proto3" json:"method_name" bson:"method_name"`
-	//@inject_tag: json:"method_percent_fee" bson:"method_percent_fee"
-	MethodPercentFee float64 `protobuf:"fixed64,4,opt,name=method_percent_fee,json=methodPercentFee,proto3" json:"method_percent_fee" bson:"method_percent_fee"`
-	//@inject_tag: json:"method_fixed_fee" bson:"method_fixed_fee"
-	MethodFixedFee float64 `protobuf:"fixed64,5,opt,name=method_fixed_fee,json=methodFixedFee,proto3" json:"method_fixed_fee" bson:"method_fixed_fee"`
-	//@inject_tag: json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"
-	MethodFixedFeeCurrency string `protobuf:"bytes,6,opt,name=method_fixed_fee_currency,json=methodFixedFeeCurrency,proto3" json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"`
-	//@inject_tag: json:"ps_percent_fee" bson:"ps_percent_fee"
-	PsPercentFee float64 `protobuf:"fixed64,7,opt,name=ps_percent_fee,json=psPercentFee,proto3" json:"ps_percent_fee" bson:"ps_percent_fee"`
-	//@inject_tag: json:"ps_fixed_fee" bson:"ps_fixed_fee"
-	PsFixedFee float64 `protobuf:"fixed64,8,opt,name=ps_fixed_fee,json=psFixedFee,proto3" json:"ps_fixed_fee" bson:"ps_fixed_fee"`
-	//@inject_tag: json:"ps_fixed_fee_currency" bson:"ps_fixed_fee_currency"
-	PsFixedFeeCurrency string `protobuf:"bytes,9,opt,name=ps_fixed_fee_currency,json=psFixedFeeCurrency,proto3" json:"ps_fixed_fee_currency" bson:"ps_fixed_fee_currency"`
-	//@inject_tag: json:"merchant_home_region" bson:"merchant_home_region"
-	MerchantHomeRegion string `protobuf:"bytes,10,opt,name=merchant_home_region,json=merchantHomeRegion,proto3" json:"merchant_home_region" bson:"merchant_home_region"`
-	//@inject_tag: json:"payer_region" bson:"payer_region"
-	PayerRegion          string   `protobuf:"bytes,11,opt,name=payer_region,json=payerRegion,proto3" json:"payer_region" bson:"payer_region"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type SubscriptionPlan struct {
+	//@inject_tag: json:"id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"project_id" validate:"required,hexadecimal,len=24"
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"name" validate:"required,min=1"
+	Name map[string]string `protobuf:"bytes,4,rep,name=name,proto3" json:"name" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required,min=1"`
+	//@inject_tag: json:"description"
+	Description map[string]string `protobuf:"bytes,5,rep,name=description,proto3" json:"description" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//@inject_tag: json:"prices" validate:"required,min=1,dive"
+	Prices []*ProductPrice `protobuf:"bytes,6,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive"`
+	//@inject_tag: json:"interval_unit" validate:"required,oneof=day week month year"
+	IntervalUnit string `protobuf:"bytes,7,opt,name=interval_unit,json=intervalUnit,proto3" json:"interval_unit" validate:"required,oneof=day week month year"`
+	//@inject_tag: json:"interval_count" validate:"required,numeric,gte=1"
+	IntervalCount int32 `protobuf:"varint,8,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count" validate:"required,numeric,gte=1"`
+	//@inject_tag: json:"trial_days" validate:"omitempty,numeric,gte=0"
+	TrialDays int32 `protobuf:"varint,9,opt,name=trial_days,json=trialDays,proto3" json:"trial_days" validate:"omitempty,numeric,gte=0"`
+	//@inject_tag: json:"is_active"
+	IsActive bool `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MerchantTariffRatesPayment) Reset()         { *m = MerchantTariffRatesPayment{} }
-func (m *MerchantTariffRatesPayment) String() string { return proto.CompactTextString(m) }
-func (*MerchantTariffRatesPayment) ProtoMessage()    {}
-func (*MerchantTariffRatesPayment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{106}
+func (m *SubscriptionPlan) Reset()         { *m = SubscriptionPlan{} }
+func (m *SubscriptionPlan) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionPlan) ProtoMessage()    {}
+func (*SubscriptionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{140}
 }
 
-func (m *MerchantTariffRatesPayment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantTariffRatesPayment.Unmarshal(m, b)
+func (m *SubscriptionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionPlan.Unmarshal(m, b)
 }
-func (m *MerchantTariffRatesPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantTariffRatesPayment.Marshal(b, m, deterministic)
+func (m *SubscriptionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionPlan.Marshal(b, m, deterministic)
 }
-func (m *MerchantTariffRatesPayment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantTariffRatesPayment.Merge(m, src)
+func (m *SubscriptionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionPlan.Merge(m, src)
 }
-func (m *MerchantTariffRatesPayment) XXX_Size() int {
-	return xxx_messageInfo_MerchantTariffRatesPayment.Size(m)
+func (m *SubscriptionPlan) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionPlan.Size(m)
 }
-func (m *MerchantTariffRatesPayment) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantTariffRatesPayment.DiscardUnknown(m)
+func (m *SubscriptionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MerchantTariffRatesPayment proto.InternalMessageInfo
+var xxx_messageInfo_SubscriptionPlan proto.InternalMessageInfo
 
-func (m *MerchantTariffRatesPayment) GetMinAmount() float64 {
+func (m *SubscriptionPlan) GetId() string {
 	if m != nil {
-		return m.MinAmount
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *MerchantTariffRatesPayment) GetMaxAmount() float64 {
+func (m *SubscriptionPlan) GetMerchantId() string {
 	if m != nil {
-		return m.MaxAmount
+		return m.MerchantId
 	}
-	return 0
+	return ""
 }
 
-func (m *MerchantTariffRatesPayment) GetMethodName() string {
+func (m *SubscriptionPlan) GetProjectId() string {
 	if m != nil {
-		return m.MethodName
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *MerchantTariffRatesPayment) GetMethodPercentFee() float64 {
+func (m *SubscriptionPlan) GetName() map[string]string {
 	if m != nil {
-		return m.MethodPercentFee
+		return m.Name
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantTariffRatesPayment) GetMethodFixedFee() float64 {
+func (m *SubscriptionPlan) GetDescription() map[string]string {
 	if m != nil {
-		return m.MethodFixedFee
+		return m.Description
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantTariffRatesPayment) GetMethodFixedFeeCurrency() string {
+func (m *SubscriptionPlan) GetPrices() []*ProductPrice {
 	if m != nil {
-		return m.MethodFixedFeeCurrency
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *SubscriptionPlan) GetIntervalUnit() string {
+	if m != nil {
+		return m.IntervalUnit
 	}
 	return ""
 }
 
-func (m *MerchantTariffRatesPayment) GetPsPercentFee() float64 {
+func (m *SubscriptionPlan) GetIntervalCount() int32 {
 	if m != nil {
-		return m.PsPercentFee
+		return m.IntervalCount
 	}
 	return 0
 }
 
-func (m *MerchantTariffRatesPayment) GetPsFixedFee() float64 {
+func (m *SubscriptionPlan) GetTrialDays() int32 {
 	if m != nil {
-		return m.PsFixedFee
+		return m.TrialDays
 	}
 	return 0
 }
 
-func (m *MerchantTariffRatesPayment) GetPsFixedFeeCurrency() string {
+func (m *SubscriptionPlan) GetIsActive() bool {
 	if m != nil {
-		return m.PsFixedFeeCurrency
+		return m.IsActive
 	}
-	return ""
+	return false
 }
 
-func (m *MerchantTariffRatesPayment) GetMerchantHomeRegion() string {
+func (m *SubscriptionPlan) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MerchantHomeRegion
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *MerchantTariffRatesPayment) GetPayerRegion() string {
+func (m *SubscriptionPlan) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PayerRegion
+		return m.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type MerchantTariffRatesSettingsRefundItem struct {
-	//@inject_tag: json:"method_name" bson:"method_name"
-	MethodName string `protobuf:"bytes,1,opt,name=method_name,json=methodName,proto3" json:"method_name" bson:"method_name"`
-	//@inject_tag: json:"method_percent_fee" bson:"method_percent_fee"
-	MethodPercentFee float64 `protobuf:"fixed64,2,opt,name=method_percent_fee,json=methodPercentFee,proto3" json:"method_percent_fee" bson:"method_percent_fee"`
-	//@inject_tag: json:"method_fixed_fee" bson:"method_fixed_fee"
-	MethodFixedFee float64 `protobuf:"fixed64,3,opt,name=method_fixed_fee,json=methodFixedFee,proto3" json:"method_fixed_fee" bson:"method_fixed_fee"`
-	//@inject_tag: json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"
-	MethodFixedFeeCurrency string `protobuf:"bytes,4,opt,name=method_fixed_fee_currency,json=methodFixedFeeCurrency,proto3" json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"`
-	//@inject_tag: json:"is_paid_by_merchant" bson:"is_paid_by_merchant"
-	IsPaidByMerchant     bool     `protobuf:"varint,5,opt,name=is_paid_by_merchant,json=isPaidByMerchant,proto3" json:"is_paid_by_merchant" bson:"is_paid_by_merchant"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type Subscription struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"plan_id"
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id"`
+	//@inject_tag: json:"merchant_id"
+	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id"`
+	//@inject_tag: json:"project_id"
+	ProjectId string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id"`
+	//@inject_tag: json:"customer_id"
+	CustomerId string `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id"`
+	//@inject_tag: json:"user"
+	User *OrderUser `protobuf:"bytes,6,opt,name=user,proto3" json:"user"`
+	//@inject_tag: json:"-"
+	PaymentMethodId string `protobuf:"bytes,7,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"-"`
+	//@inject_tag: json:"-"
+	SavedCardId string `protobuf:"bytes,8,opt,name=saved_card_id,json=savedCardId,proto3" json:"-"`
+	//@inject_tag: json:"-"
+	PriceGroupId string `protobuf:"bytes,9,opt,name=price_group_id,json=priceGroupId,proto3" json:"-"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount"`
+	//@inject_tag: json:"status"
+	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status"`
+	//@inject_tag: json:"trial_end_at"
+	TrialEndAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=trial_end_at,json=trialEndAt,proto3" json:"trial_end_at"`
+	//@inject_tag: json:"current_period_start_at"
+	CurrentPeriodStartAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=current_period_start_at,json=currentPeriodStartAt,proto3" json:"current_period_start_at"`
+	//@inject_tag: json:"current_period_end_at"
+	CurrentPeriodEndAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=current_period_end_at,json=currentPeriodEndAt,proto3" json:"current_period_end_at"`
+	//@inject_tag: json:"next_billing_at"
+	NextBillingAt *timestamp.Timestamp `protobuf:"bytes,16,opt,name=next_billing_at,json=nextBillingAt,proto3" json:"next_billing_at"`
+	//@inject_tag: json:"failed_attempts"
+	FailedAttempts int32 `protobuf:"varint,17,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts"`
+	//@inject_tag: json:"initial_order_id"
+	InitialOrderId string `protobuf:"bytes,18,opt,name=initial_order_id,json=initialOrderId,proto3" json:"initial_order_id"`
+	//@inject_tag: json:"last_order_id"
+	LastOrderId string `protobuf:"bytes,19,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id"`
+	//@inject_tag: json:"paused_at"
+	PausedAt *timestamp.Timestamp `protobuf:"bytes,20,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at"`
+	//@inject_tag: json:"canceled_at"
+	CanceledAt *timestamp.Timestamp `protobuf:"bytes,21,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at"`
+	//@inject_tag: json:"cancel_reason"
+	CancelReason string `protobuf:"bytes,22,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,23,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MerchantTariffRatesSettingsRefundItem) Reset()         { *m = MerchantTariffRatesSettingsRefundItem{} }
-func (m *MerchantTariffRatesSettingsRefundItem) String() string { return proto.CompactTextString(m) }
-func (*MerchantTariffRatesSettingsRefundItem) ProtoMessage()    {}
-func (*MerchantTariffRatesSettingsRefundItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{107}
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{141}
 }
 
-func (m *MerchantTariffRatesSettingsRefundItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Unmarshal(m, b)
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
 }
-func (m *MerchantTariffRatesSettingsRefundItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Marshal(b, m, deterministic)
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
 }
-func (m *MerchantTariffRatesSettingsRefundItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Merge(m, src)
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
 }
-func (m *MerchantTariffRatesSettingsRefundItem) XXX_Size() int {
-	return xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.Size(m)
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
 }
-func (m *MerchantTariffRatesSettingsRefundItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantTariffRatesSettingsRefundItem.DiscardUnknown(m)
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MerchantTariffRatesSettingsRefundItem proto.InternalMessageInfo
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
 
-func (m *MerchantTariffRatesSettingsRefundItem) GetMethodName() string {
+func (m *Subscription) GetId() string {
 	if m != nil {
-		return m.MethodName
+		return m.Id
 	}
 	return ""
 }
 
-func (m *MerchantTariffRatesSettingsRefundItem) GetMethodPercentFee() float64 {
+func (m *Subscription) GetPlanId() string {
 	if m != nil {
-		return m.MethodPercentFee
+		return m.PlanId
 	}
-	return 0
+	return ""
 }
 
-func (m *MerchantTariffRatesSettingsRefundItem) GetMethodFixedFee() float64 {
+func (m *Subscription) GetMerchantId() string {
 	if m != nil {
-		return m.MethodFixedFee
+		return m.MerchantId
 	}
-	return 0
+	return ""
 }
 
-func (m *MerchantTariffRatesSettingsRefundItem) GetMethodFixedFeeCurrency() string {
+func (m *Subscription) GetProjectId() string {
 	if m != nil {
-		return m.MethodFixedFeeCurrency
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *MerchantTariffRatesSettingsRefundItem) GetIsPaidByMerchant() bool {
+func (m *Subscription) GetCustomerId() string {
 	if m != nil {
-		return m.IsPaidByMerchant
+		return m.CustomerId
 	}
-	return false
+	return ""
 }
 
-type MerchantTariffRatesSettingsItem struct {
-	//@inject_tag: json:"method_percent_fee" bson:"method_percent_fee"
-	MethodPercentFee float64 `protobuf:"fixed64,1,opt,name=method_percent_fee,json=methodPercentFee,proto3" json:"method_percent_fee" bson:"method_percent_fee"`
-	//@inject_tag: json:"method_fixed_fee" bson:"method_fixed_fee"
-	MethodFixedFee float64 `protobuf:"fixed64,2,opt,name=method_fixed_fee,json=methodFixedFee,proto3" json:"method_fixed_fee" bson:"method_fixed_fee"`
-	//@inject_tag: json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"
-	MethodFixedFeeCurrency string `protobuf:"bytes,3,opt,name=method_fixed_fee_currency,json=methodFixedFeeCurrency,proto3" json:"method_fixed_fee_currency" bson:"method_fixed_fee_currency"`
-	//@inject_tag: json:"is_paid_by_merchant" bson:"is_paid_by_merchant"
-	IsPaidByMerchant     bool     `protobuf:"varint,4,opt,name=is_paid_by_merchant,json=isPaidByMerchant,proto3" json:"is_paid_by_merchant" bson:"is_paid_by_merchant"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Subscription) GetUser() *OrderUser {
+	if m != nil {
+		return m.User
+	}
+	return nil
 }
 
-func (m *MerchantTariffRatesSettingsItem) Reset()         { *m = MerchantTariffRatesSettingsItem{} }
-func (m *MerchantTariffRatesSettingsItem) String() string { return proto.CompactTextString(m) }
-func (*MerchantTariffRatesSettingsItem) ProtoMessage()    {}
-func (*MerchantTariffRatesSettingsItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{108}
+func (m *Subscription) GetPaymentMethodId() string {
+	if m != nil {
+		return m.PaymentMethodId
+	}
+	return ""
 }
 
-func (m *MerchantTariffRatesSettingsItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantTariffRatesSettingsItem.Unmarshal(m, b)
+func (m *Subscription) GetSavedCardId() string {
+	if m != nil {
+		return m.SavedCardId
+	}
+	return ""
 }
-func (m *MerchantTariffRatesSettingsItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantTariffRatesSettingsItem.Marshal(b, m, deterministic)
+
+func (m *Subscription) GetPriceGroupId() string {
+	if m != nil {
+		return m.PriceGroupId
+	}
+	return ""
 }
-func (m *MerchantTariffRatesSettingsItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantTariffRatesSettingsItem.Merge(m, src)
+
+func (m *Subscription) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
-func (m *MerchantTariffRatesSettingsItem) XXX_Size() int {
-	return xxx_messageInfo_MerchantTariffRatesSettingsItem.Size(m)
+
+func (m *Subscription) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
-func (m *MerchantTariffRatesSettingsItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantTariffRatesSettingsItem.DiscardUnknown(m)
+
+func (m *Subscription) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-var xxx_messageInfo_MerchantTariffRatesSettingsItem proto.InternalMessageInfo
+func (m *Subscription) GetTrialEndAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.TrialEndAt
+	}
+	return nil
+}
 
-func (m *MerchantTariffRatesSettingsItem) GetMethodPercentFee() float64 {
+func (m *Subscription) GetCurrentPeriodStartAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MethodPercentFee
+		return m.CurrentPeriodStartAt
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantTariffRatesSettingsItem) GetMethodFixedFee() float64 {
+func (m *Subscription) GetCurrentPeriodEndAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MethodFixedFee
+		return m.CurrentPeriodEndAt
+	}
+	return nil
+}
+
+func (m *Subscription) GetNextBillingAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextBillingAt
+	}
+	return nil
+}
+
+func (m *Subscription) GetFailedAttempts() int32 {
+	if m != nil {
+		return m.FailedAttempts
 	}
 	return 0
 }
 
-func (m *MerchantTariffRatesSettingsItem) GetMethodFixedFeeCurrency() string {
+func (m *Subscription) GetInitialOrderId() string {
 	if m != nil {
-		return m.MethodFixedFeeCurrency
+		return m.InitialOrderId
 	}
 	return ""
 }
 
-func (m *MerchantTariffRatesSettingsItem) GetIsPaidByMerchant() bool {
+func (m *Subscription) GetLastOrderId() string {
 	if m != nil {
-		return m.IsPaidByMerchant
+		return m.LastOrderId
 	}
-	return false
+	return ""
 }
 
-type MerchantTariffRatesSettings struct {
-	//@inject_tag: json:"refund" bson:"refund"
-	Refund []*MerchantTariffRatesSettingsRefundItem `protobuf:"bytes,1,rep,name=refund,proto3" json:"refund" bson:"refund"`
-	//@inject_tag: json:"chargeback" bson:"chargeback"
-	Chargeback *MerchantTariffRatesSettingsItem `protobuf:"bytes,2,opt,name=chargeback,proto3" json:"chargeback" bson:"chargeback"`
-	//@inject_tag: json:"payout" bson:"payout"
-	Payout               *MerchantTariffRatesSettingsItem `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout" bson:"payout"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                            `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Subscription) GetPausedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PausedAt
+	}
+	return nil
 }
 
-func (m *MerchantTariffRatesSettings) Reset()         { *m = MerchantTariffRatesSettings{} }
-func (m *MerchantTariffRatesSettings) String() string { return proto.CompactTextString(m) }
-func (*MerchantTariffRatesSettings) ProtoMessage()    {}
-func (*MerchantTariffRatesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{109}
+func (m *Subscription) GetCanceledAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CanceledAt
+	}
+	return nil
+}
+
+func (m *Subscription) GetCancelReason() string {
+	if m != nil {
+		return m.CancelReason
+	}
+	return ""
+}
+
+func (m *Subscription) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Subscription) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type SubscriptionNotification struct {
+	//@inject_tag: json:"event"
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+	//@inject_tag: json:"subscription"
+	Subscription *Subscription `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription"`
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MerchantTariffRatesSettings) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantTariffRatesSettings.Unmarshal(m, b)
+func (m *SubscriptionNotification) Reset()         { *m = SubscriptionNotification{} }
+func (m *SubscriptionNotification) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionNotification) ProtoMessage()    {}
+func (*SubscriptionNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{142}
 }
-func (m *MerchantTariffRatesSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantTariffRatesSettings.Marshal(b, m, deterministic)
+
+func (m *SubscriptionNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionNotification.Unmarshal(m, b)
 }
-func (m *MerchantTariffRatesSettings) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantTariffRatesSettings.Merge(m, src)
+func (m *SubscriptionNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionNotification.Marshal(b, m, deterministic)
 }
-func (m *MerchantTariffRatesSettings) XXX_Size() int {
-	return xxx_messageInfo_MerchantTariffRatesSettings.Size(m)
+func (m *SubscriptionNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionNotification.Merge(m, src)
 }
-func (m *MerchantTariffRatesSettings) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantTariffRatesSettings.DiscardUnknown(m)
+func (m *SubscriptionNotification) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionNotification.Size(m)
+}
+func (m *SubscriptionNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionNotification.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MerchantTariffRatesSettings proto.InternalMessageInfo
+var xxx_messageInfo_SubscriptionNotification proto.InternalMessageInfo
 
-func (m *MerchantTariffRatesSettings) GetRefund() []*MerchantTariffRatesSettingsRefundItem {
+func (m *SubscriptionNotification) GetEvent() string {
 	if m != nil {
-		return m.Refund
+		return m.Event
 	}
-	return nil
+	return ""
 }
 
-func (m *MerchantTariffRatesSettings) GetChargeback() *MerchantTariffRatesSettingsItem {
+func (m *SubscriptionNotification) GetSubscription() *Subscription {
 	if m != nil {
-		return m.Chargeback
+		return m.Subscription
 	}
 	return nil
 }
 
-func (m *MerchantTariffRatesSettings) GetPayout() *MerchantTariffRatesSettingsItem {
+func (m *SubscriptionNotification) GetOrderId() string {
 	if m != nil {
-		return m.Payout
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *SubscriptionNotification) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type Key struct {
-	//@inject_tag: json:"id" bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
-	//@inject_tag: json:"code" validate:"required,max=50"
-	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code" validate:"required,max=50"`
-	//@inject_tag: validate:"required,hexadecimal,len=24"
-	KeyProductId string `protobuf:"bytes,3,opt,name=key_product_id,json=keyProductId,proto3" json:"key_product_id,omitempty" validate:"required,hexadecimal,len=24"`
-	//@inject_tag: validate:"required,max=255"
-	PlatformId string `protobuf:"bytes,5,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty" validate:"required,max=255"`
-	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
-	OrderId   string               `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	//@inject_tag: validate:"omitempty"
-	ReservedTo *timestamp.Timestamp `protobuf:"bytes,8,opt,name=reserved_to,json=reservedTo,proto3" json:"reserved_to,omitempty" validate:"omitempty"`
-	//@inject_tag: validate:"omitempty"
-	RedeemedAt           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty" validate:"omitempty"`
+type ReconciliationRun struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"payment_system_handler"
+	PaymentSystemHandler string `protobuf:"bytes,2,opt,name=payment_system_handler,json=paymentSystemHandler,proto3" json:"payment_system_handler"`
+	//@inject_tag: json:"file_name"
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	//@inject_tag: json:"date_from"
+	DateFrom *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from"`
+	//@inject_tag: json:"date_to"
+	DateTo *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to"`
+	//@inject_tag: json:"lines_total"
+	LinesTotal int32 `protobuf:"varint,6,opt,name=lines_total,json=linesTotal,proto3" json:"lines_total"`
+	//@inject_tag: json:"matched"
+	Matched int32 `protobuf:"varint,7,opt,name=matched,proto3" json:"matched"`
+	//@inject_tag: json:"mismatched"
+	Mismatched int32 `protobuf:"varint,8,opt,name=mismatched,proto3" json:"mismatched"`
+	//@inject_tag: json:"orphans"
+	Orphans int32 `protobuf:"varint,9,opt,name=orphans,proto3" json:"orphans"`
+	//@inject_tag: json:"missing"
+	Missing int32 `protobuf:"varint,10,opt,name=missing,proto3" json:"missing"`
+	//@inject_tag: json:"unresolved"
+	Unresolved int32 `protobuf:"varint,11,opt,name=unresolved,proto3" json:"unresolved"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *Key) Reset()         { *m = Key{} }
-func (m *Key) String() string { return proto.CompactTextString(m) }
-func (*Key) ProtoMessage()    {}
-func (*Key) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{110}
+func (m *ReconciliationRun) Reset()         { *m = ReconciliationRun{} }
+func (m *ReconciliationRun) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationRun) ProtoMessage()    {}
+func (*ReconciliationRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{143}
 }
 
-func (m *Key) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Key.Unmarshal(m, b)
+func (m *ReconciliationRun) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationRun.Unmarshal(m, b)
 }
-func (m *Key) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Key.Marshal(b, m, deterministic)
+func (m *ReconciliationRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationRun.Marshal(b, m, deterministic)
 }
-func (m *Key) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Key.Merge(m, src)
+func (m *ReconciliationRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationRun.Merge(m, src)
 }
-func (m *Key) XXX_Size() int {
-	return xxx_messageInfo_Key.Size(m)
+func (m *ReconciliationRun) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationRun.Size(m)
 }
-func (m *Key) XXX_DiscardUnknown() {
-	xxx_messageInfo_Key.DiscardUnknown(m)
+func (m *ReconciliationRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationRun.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Key proto.InternalMessageInfo
+var xxx_messageInfo_ReconciliationRun proto.InternalMessageInfo
 
-func (m *Key) GetId() string {
+func (m *ReconciliationRun) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Key) GetCode() string {
+func (m *ReconciliationRun) GetPaymentSystemHandler() string {
 	if m != nil {
-		return m.Code
+		return m.PaymentSystemHandler
 	}
 	return ""
 }
 
-func (m *Key) GetKeyProductId() string {
+func (m *ReconciliationRun) GetFileName() string {
 	if m != nil {
-		return m.KeyProductId
+		return m.FileName
 	}
 	return ""
 }
 
-func (m *Key) GetPlatformId() string {
+func (m *ReconciliationRun) GetDateFrom() *timestamp.Timestamp {
 	if m != nil {
-		return m.PlatformId
+		return m.DateFrom
 	}
-	return ""
+	return nil
 }
 
-func (m *Key) GetOrderId() string {
+func (m *ReconciliationRun) GetDateTo() *timestamp.Timestamp {
 	if m != nil {
-		return m.OrderId
+		return m.DateTo
 	}
-	return ""
+	return nil
 }
 
-func (m *Key) GetCreatedAt() *timestamp.Timestamp {
+func (m *ReconciliationRun) GetLinesTotal() int32 {
 	if m != nil {
-		return m.CreatedAt
+		return m.LinesTotal
 	}
-	return nil
+	return 0
 }
 
-func (m *Key) GetReservedTo() *timestamp.Timestamp {
+func (m *ReconciliationRun) GetMatched() int32 {
 	if m != nil {
-		return m.ReservedTo
+		return m.Matched
 	}
-	return nil
+	return 0
 }
 
-func (m *Key) GetRedeemedAt() *timestamp.Timestamp {
+func (m *ReconciliationRun) GetMismatched() int32 {
 	if m != nil {
-		return m.RedeemedAt
+		return m.Mismatched
+	}
+	return 0
+}
+
+func (m *ReconciliationRun) GetOrphans() int32 {
+	if m != nil {
+		return m.Orphans
+	}
+	return 0
+}
+
+func (m *ReconciliationRun) GetMissing() int32 {
+	if m != nil {
+		return m.Missing
+	}
+	return 0
+}
+
+func (m *ReconciliationRun) GetUnresolved() int32 {
+	if m != nil {
+		return m.Unresolved
+	}
+	return 0
+}
+
+func (m *ReconciliationRun) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type PayoutDocument struct {
-	//@inject_tag: json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"omitempty,hexadecimal,len=24"`
-	//@inject_tag: json:"merchant_id" bson:"merchant_id" validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" bson:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
-	//@inject_tag: json:"source_id"
-	SourceId []string `protobuf:"bytes,3,rep,name=source_id,json=sourceId,proto3" json:"source_id"`
-	// @inject_tag: json:"total_fees" validate:"numeric,gte=0"
-	TotalFees float64 `protobuf:"fixed64,4,opt,name=total_fees,json=totalFees,proto3" json:"total_fees" validate:"numeric,gte=0"`
-	// @inject_tag: json:"balance" validate:"numeric,gte=0"
-	Balance float64 `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance" validate:"numeric,gte=0"`
-	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
-	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
-	// @inject_tag: json:"period_from"
-	PeriodFrom *timestamp.Timestamp `protobuf:"bytes,7,opt,name=period_from,json=periodFrom,proto3" json:"period_from"`
-	// @inject_tag: json:"period_to"
-	PeriodTo *timestamp.Timestamp `protobuf:"bytes,8,opt,name=period_to,json=periodTo,proto3" json:"period_to"`
-	// @inject_tag: json:"total_transactions"
-	TotalTransactions int32 `protobuf:"varint,9,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions"`
-	//@inject_tag: json:"description" validate:"omitempty,max=255"
-	Description string `protobuf:"bytes,10,opt,name=description,proto3" json:"description" validate:"omitempty,max=255"`
-	//@inject_tag: json:"destination" validate:"max=255"
-	Destination *MerchantBanking `protobuf:"bytes,11,opt,name=destination,proto3" json:"destination" validate:"max=255"`
-	//@inject_tag: json:"merchant_agreement_number" validate:"max=255"
-	MerchantAgreementNumber string `protobuf:"bytes,12,opt,name=merchant_agreement_number,json=merchantAgreementNumber,proto3" json:"merchant_agreement_number" validate:"max=255"`
-	// @inject_tag: json:"company"
-	Company *MerchantCompanyInfo `protobuf:"bytes,13,opt,name=company,proto3" json:"company"`
-	//@inject_tag: json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"
-	Status string `protobuf:"bytes,14,opt,name=status,proto3" json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"`
-	//@inject_tag: json:"transaction" validate:"max=255"
-	Transaction string `protobuf:"bytes,15,opt,name=transaction,proto3" json:"transaction" validate:"max=255"`
-	//@inject_tag: json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"
-	FailureCode string `protobuf:"bytes,16,opt,name=failure_code,json=failureCode,proto3" json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"`
-	//@inject_tag: json:"failure_message" validate:"max=255"
-	FailureMessage string `protobuf:"bytes,17,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message" validate:"max=255"`
-	//@inject_tag: json:"failure_transaction" validate:"max=255"
-	FailureTransaction string `protobuf:"bytes,18,opt,name=failure_transaction,json=failureTransaction,proto3" json:"failure_transaction" validate:"max=255"`
+type ReconciliationLine struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"run_id"
+	RunId string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id"`
+	//@inject_tag: json:"line_number"
+	LineNumber int32 `protobuf:"varint,3,opt,name=line_number,json=lineNumber,proto3" json:"line_number"`
+	//@inject_tag: json:"type"
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
+	//@inject_tag: json:"external_id"
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id"`
+	//@inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,8,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	//@inject_tag: json:"refund_id"
+	RefundId string `protobuf:"bytes,9,opt,name=refund_id,json=refundId,proto3" json:"refund_id"`
+	//@inject_tag: json:"expected_amount"
+	ExpectedAmount float64 `protobuf:"fixed64,10,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount"`
+	//@inject_tag: json:"expected_currency"
+	ExpectedCurrency string `protobuf:"bytes,11,opt,name=expected_currency,json=expectedCurrency,proto3" json:"expected_currency"`
+	//@inject_tag: json:"status"
+	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status"`
+	//@inject_tag: json:"is_resolved"
+	IsResolved bool `protobuf:"varint,13,opt,name=is_resolved,json=isResolved,proto3" json:"is_resolved"`
+	//@inject_tag: json:"resolution"
+	Resolution string `protobuf:"bytes,14,opt,name=resolution,proto3" json:"resolution"`
+	//@inject_tag: json:"resolved_at"
+	ResolvedAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at"`
 	//@inject_tag: json:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	//@inject_tag: json:"updated_at"
-	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	//@inject_tag: json:"arrival_date"
-	ArrivalDate *timestamp.Timestamp `protobuf:"bytes,26,opt,name=arrival_date,json=arrivalDate,proto3" json:"arrival_date"`
-	//@inject_tag: json:"paid_at"
-	PaidAt               *timestamp.Timestamp `protobuf:"bytes,27,opt,name=paid_at,json=paidAt,proto3" json:"paid_at"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PayoutDocument) Reset()         { *m = PayoutDocument{} }
-func (m *PayoutDocument) String() string { return proto.CompactTextString(m) }
-func (*PayoutDocument) ProtoMessage()    {}
-func (*PayoutDocument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{111}
+func (m *ReconciliationLine) Reset()         { *m = ReconciliationLine{} }
+func (m *ReconciliationLine) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationLine) ProtoMessage()    {}
+func (*ReconciliationLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{144}
 }
 
-func (m *PayoutDocument) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayoutDocument.Unmarshal(m, b)
+func (m *ReconciliationLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationLine.Unmarshal(m, b)
 }
-func (m *PayoutDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayoutDocument.Marshal(b, m, deterministic)
+func (m *ReconciliationLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationLine.Marshal(b, m, deterministic)
 }
-func (m *PayoutDocument) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayoutDocument.Merge(m, src)
+func (m *ReconciliationLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationLine.Merge(m, src)
 }
-func (m *PayoutDocument) XXX_Size() int {
-	return xxx_messageInfo_PayoutDocument.Size(m)
+func (m *ReconciliationLine) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationLine.Size(m)
 }
-func (m *PayoutDocument) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayoutDocument.DiscardUnknown(m)
+func (m *ReconciliationLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationLine.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayoutDocument proto.InternalMessageInfo
+var xxx_messageInfo_ReconciliationLine proto.InternalMessageInfo
 
-func (m *PayoutDocument) GetId() string {
+func (m *ReconciliationLine) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetMerchantId() string {
+func (m *ReconciliationLine) GetRunId() string {
 	if m != nil {
-		return m.MerchantId
+		return m.RunId
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetSourceId() []string {
-	if m != nil {
-		return m.SourceId
-	}
-	return nil
-}
-
-func (m *PayoutDocument) GetTotalFees() float64 {
+func (m *ReconciliationLine) GetLineNumber() int32 {
 	if m != nil {
-		return m.TotalFees
+		return m.LineNumber
 	}
 	return 0
 }
 
-func (m *PayoutDocument) GetBalance() float64 {
+func (m *ReconciliationLine) GetType() string {
 	if m != nil {
-		return m.Balance
+		return m.Type
 	}
-	return 0
+	return ""
 }
 
-func (m *PayoutDocument) GetCurrency() string {
+func (m *ReconciliationLine) GetExternalId() string {
 	if m != nil {
-		return m.Currency
+		return m.ExternalId
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetPeriodFrom() *timestamp.Timestamp {
+func (m *ReconciliationLine) GetAmount() float64 {
 	if m != nil {
-		return m.PeriodFrom
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *PayoutDocument) GetPeriodTo() *timestamp.Timestamp {
+func (m *ReconciliationLine) GetCurrency() string {
 	if m != nil {
-		return m.PeriodTo
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-func (m *PayoutDocument) GetTotalTransactions() int32 {
+func (m *ReconciliationLine) GetOrderId() string {
 	if m != nil {
-		return m.TotalTransactions
+		return m.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (m *PayoutDocument) GetDescription() string {
+func (m *ReconciliationLine) GetRefundId() string {
 	if m != nil {
-		return m.Description
+		return m.RefundId
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetDestination() *MerchantBanking {
+func (m *ReconciliationLine) GetExpectedAmount() float64 {
 	if m != nil {
-		return m.Destination
+		return m.ExpectedAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *PayoutDocument) GetMerchantAgreementNumber() string {
+func (m *ReconciliationLine) GetExpectedCurrency() string {
 	if m != nil {
-		return m.MerchantAgreementNumber
+		return m.ExpectedCurrency
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetCompany() *MerchantCompanyInfo {
+func (m *ReconciliationLine) GetStatus() string {
 	if m != nil {
-		return m.Company
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-func (m *PayoutDocument) GetStatus() string {
+func (m *ReconciliationLine) GetIsResolved() bool {
 	if m != nil {
-		return m.Status
+		return m.IsResolved
 	}
-	return ""
+	return false
 }
 
-func (m *PayoutDocument) GetTransaction() string {
+func (m *ReconciliationLine) GetResolution() string {
 	if m != nil {
-		return m.Transaction
+		return m.Resolution
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetFailureCode() string {
+func (m *ReconciliationLine) GetResolvedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.FailureCode
+		return m.ResolvedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *PayoutDocument) GetFailureMessage() string {
+func (m *ReconciliationLine) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.FailureMessage
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *PayoutDocument) GetFailureTransaction() string {
+type ChargebackEvidence struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"file_name"
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	//@inject_tag: json:"file_url"
+	FileUrl string `protobuf:"bytes,3,opt,name=file_url,json=fileUrl,proto3" json:"file_url"`
+	//@inject_tag: json:"comment"
+	Comment string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ChargebackEvidence) Reset()         { *m = ChargebackEvidence{} }
+func (m *ChargebackEvidence) String() string { return proto.CompactTextString(m) }
+func (*ChargebackEvidence) ProtoMessage()    {}
+func (*ChargebackEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{145}
+}
+
+func (m *ChargebackEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChargebackEvidence.Unmarshal(m, b)
+}
+func (m *ChargebackEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChargebackEvidence.Marshal(b, m, deterministic)
+}
+func (m *ChargebackEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChargebackEvidence.Merge(m, src)
+}
+func (m *ChargebackEvidence) XXX_Size() int {
+	return xxx_messageInfo_ChargebackEvidence.Size(m)
+}
+func (m *ChargebackEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChargebackEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChargebackEvidence proto.InternalMessageInfo
+
+func (m *ChargebackEvidence) GetId() string {
 	if m != nil {
-		return m.FailureTransaction
+		return m.Id
 	}
 	return ""
 }
 
-func (m *PayoutDocument) GetCreatedAt() *timestamp.Timestamp {
+func (m *ChargebackEvidence) GetFileName() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.FileName
 	}
-	return nil
+	return ""
 }
 
-func (m *PayoutDocument) GetUpdatedAt() *timestamp.Timestamp {
+func (m *ChargebackEvidence) GetFileUrl() string {
 	if m != nil {
-		return m.UpdatedAt
+		return m.FileUrl
 	}
-	return nil
+	return ""
 }
 
-func (m *PayoutDocument) GetArrivalDate() *timestamp.Timestamp {
+func (m *ChargebackEvidence) GetComment() string {
 	if m != nil {
-		return m.ArrivalDate
+		return m.Comment
 	}
-	return nil
+	return ""
 }
 
-func (m *PayoutDocument) GetPaidAt() *timestamp.Timestamp {
+func (m *ChargebackEvidence) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaidAt
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type PayoutDocumentChanges struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PayoutDocumentId     string               `protobuf:"bytes,2,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty"`
-	Source               string               `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Ip                   string               `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
-	Hash                 string               `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+type Chargeback struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	//@inject_tag: json:"order_uuid"
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid"`
+	//@inject_tag: json:"merchant_id"
+	MerchantId string `protobuf:"bytes,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id"`
+	//@inject_tag: json:"project_id"
+	ProjectId string `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id"`
+	//@inject_tag: json:"external_id"
+	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id"`
+	//@inject_tag: json:"reason_code"
+	ReasonCode string `protobuf:"bytes,7,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code"`
+	//@inject_tag: json:"reason"
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason"`
+	//@inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"status"
+	Status string `protobuf:"bytes,11,opt,name=status,proto3" json:"status"`
+	//@inject_tag: json:"evidence_due_date"
+	EvidenceDueDate *timestamp.Timestamp `protobuf:"bytes,12,opt,name=evidence_due_date,json=evidenceDueDate,proto3" json:"evidence_due_date"`
+	//@inject_tag: json:"evidences"
+	Evidences []*ChargebackEvidence `protobuf:"bytes,13,rep,name=evidences,proto3" json:"evidences"`
+	//@inject_tag: json:"representment_at"
+	RepresentmentAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=representment_at,json=representmentAt,proto3" json:"representment_at"`
+	//@inject_tag: json:"closed_at"
+	ClosedAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=closed_at,json=closedAt,proto3" json:"closed_at"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PayoutDocumentChanges) Reset()         { *m = PayoutDocumentChanges{} }
-func (m *PayoutDocumentChanges) String() string { return proto.CompactTextString(m) }
-func (*PayoutDocumentChanges) ProtoMessage()    {}
-func (*PayoutDocumentChanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{112}
+func (m *Chargeback) Reset()         { *m = Chargeback{} }
+func (m *Chargeback) String() string { return proto.CompactTextString(m) }
+func (*Chargeback) ProtoMessage()    {}
+func (*Chargeback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{146}
 }
 
-func (m *PayoutDocumentChanges) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayoutDocumentChanges.Unmarshal(m, b)
+func (m *Chargeback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Chargeback.Unmarshal(m, b)
 }
-func (m *PayoutDocumentChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayoutDocumentChanges.Marshal(b, m, deterministic)
+func (m *Chargeback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Chargeback.Marshal(b, m, deterministic)
 }
-func (m *PayoutDocumentChanges) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayoutDocumentChanges.Merge(m, src)
+func (m *Chargeback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chargeback.Merge(m, src)
 }
-func (m *PayoutDocumentChanges) XXX_Size() int {
-	return xxx_messageInfo_PayoutDocumentChanges.Size(m)
+func (m *Chargeback) XXX_Size() int {
+	return xxx_messageInfo_Chargeback.Size(m)
 }
-func (m *PayoutDocumentChanges) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayoutDocumentChanges.DiscardUnknown(m)
+func (m *Chargeback) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chargeback.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayoutDocumentChanges proto.InternalMessageInfo
+var xxx_messageInfo_Chargeback proto.InternalMessageInfo
 
-func (m *PayoutDocumentChanges) GetId() string {
+func (m *Chargeback) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *PayoutDocumentChanges) GetPayoutDocumentId() string {
+func (m *Chargeback) GetOrderId() string {
 	if m != nil {
-		return m.PayoutDocumentId
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *PayoutDocumentChanges) GetSource() string {
+func (m *Chargeback) GetOrderUuid() string {
 	if m != nil {
-		return m.Source
+		return m.OrderUuid
 	}
 	return ""
 }
 
-func (m *PayoutDocumentChanges) GetIp() string {
+func (m *Chargeback) GetMerchantId() string {
 	if m != nil {
-		return m.Ip
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *PayoutDocumentChanges) GetHash() string {
+func (m *Chargeback) GetProjectId() string {
 	if m != nil {
-		return m.Hash
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *PayoutDocumentChanges) GetCreatedAt() *timestamp.Timestamp {
+func (m *Chargeback) GetExternalId() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.ExternalId
 	}
-	return nil
+	return ""
 }
 
-type MerchantBalance struct {
-	//@inject_tag: json:"id" validate:"required,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
-	//@inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
-	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
-	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
-	//@inject_tag: json:"debit" validate:"required,numeric"
-	Debit float64 `protobuf:"fixed64,4,opt,name=debit,proto3" json:"debit" validate:"required,numeric"`
-	//@inject_tag: json:"credit" validate:"required,numeric"
-	Credit float64 `protobuf:"fixed64,5,opt,name=credit,proto3" json:"credit" validate:"required,numeric"`
-	//@inject_tag: json:"rolling_reserve" validate:"required,numeric"
-	RollingReserve float64 `protobuf:"fixed64,6,opt,name=rolling_reserve,json=rollingReserve,proto3" json:"rolling_reserve" validate:"required,numeric"`
-	//@inject_tag: json:"total" validate:"required,numeric"
-	Total                float64              `protobuf:"fixed64,7,opt,name=total,proto3" json:"total" validate:"required,numeric"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Chargeback) GetReasonCode() string {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ""
 }
 
-func (m *MerchantBalance) Reset()         { *m = MerchantBalance{} }
-func (m *MerchantBalance) String() string { return proto.CompactTextString(m) }
-func (*MerchantBalance) ProtoMessage()    {}
-func (*MerchantBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{113}
+func (m *Chargeback) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MerchantBalance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantBalance.Unmarshal(m, b)
-}
-func (m *MerchantBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantBalance.Marshal(b, m, deterministic)
-}
-func (m *MerchantBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantBalance.Merge(m, src)
-}
-func (m *MerchantBalance) XXX_Size() int {
-	return xxx_messageInfo_MerchantBalance.Size(m)
-}
-func (m *MerchantBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantBalance.DiscardUnknown(m)
+func (m *Chargeback) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-var xxx_messageInfo_MerchantBalance proto.InternalMessageInfo
-
-func (m *MerchantBalance) GetId() string {
+func (m *Chargeback) GetCurrency() string {
 	if m != nil {
-		return m.Id
+		return m.Currency
 	}
 	return ""
 }
 
-func (m *MerchantBalance) GetMerchantId() string {
+func (m *Chargeback) GetStatus() string {
 	if m != nil {
-		return m.MerchantId
+		return m.Status
 	}
 	return ""
 }
 
-func (m *MerchantBalance) GetCurrency() string {
+func (m *Chargeback) GetEvidenceDueDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Currency
+		return m.EvidenceDueDate
 	}
-	return ""
+	return nil
 }
 
-func (m *MerchantBalance) GetDebit() float64 {
+func (m *Chargeback) GetEvidences() []*ChargebackEvidence {
 	if m != nil {
-		return m.Debit
+		return m.Evidences
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantBalance) GetCredit() float64 {
+func (m *Chargeback) GetRepresentmentAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Credit
+		return m.RepresentmentAt
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantBalance) GetRollingReserve() float64 {
+func (m *Chargeback) GetClosedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.RollingReserve
+		return m.ClosedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantBalance) GetTotal() float64 {
+func (m *Chargeback) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Total
+		return m.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *MerchantBalance) GetCreatedAt() *timestamp.Timestamp {
+func (m *Chargeback) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.CreatedAt
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-type OrderReceipt struct {
-	//@inject_tag: json:"total_price"
-	TotalPrice string `protobuf:"bytes,1,opt,name=total_price,json=totalPrice,proto3" json:"total_price"`
-	//@inject_tag: json:"transaction_id"
-	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id"`
-	//@inject_tag: json:"transaction_date"
-	TransactionDate string `protobuf:"bytes,3,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date"`
-	//@inject_tag: json:"project_name"
-	ProjectName string `protobuf:"bytes,4,opt,name=project_name,json=projectName,proto3" json:"project_name"`
-	//@inject_tag: json:"merchant_name"
-	MerchantName string `protobuf:"bytes,5,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name"`
-	//@inject_tag: json:"items"
-	Items []*OrderReceiptItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items"`
-	//@inject_tag: json:"order_type"
-	OrderType string `protobuf:"bytes,7,opt,name=order_type,json=orderType,proto3" json:"order_type"`
-	//@inject_tag: json:"platform_name"
-	PlatformName         string   `protobuf:"bytes,8,opt,name=platform_name,json=platformName,proto3" json:"platform_name"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type FraudRule struct {
+	//@inject_tag: json:"id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"project_id" validate:"required,hexadecimal,len=24"
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"name" validate:"required,min=1"
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name" validate:"required,min=1"`
+	//@inject_tag: json:"type" validate:"required,oneof=velocity country_mismatch amount block_list"
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type" validate:"required,oneof=velocity country_mismatch amount block_list"`
+	//@inject_tag: json:"field" validate:"omitempty,oneof=customer card ip email country bin"
+	Field string `protobuf:"bytes,6,opt,name=field,proto3" json:"field" validate:"omitempty,oneof=customer card ip email country bin"`
+	//@inject_tag: json:"max_count" validate:"omitempty,numeric,gte=1"
+	MaxCount int32 `protobuf:"varint,7,opt,name=max_count,json=maxCount,proto3" json:"max_count" validate:"omitempty,numeric,gte=1"`
+	//@inject_tag: json:"period" validate:"omitempty,numeric,gte=1"
+	Period int64 `protobuf:"varint,8,opt,name=period,proto3" json:"period" validate:"omitempty,numeric,gte=1"`
+	//@inject_tag: json:"amount" validate:"omitempty,numeric,gt=0"
+	Amount float64 `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount" validate:"omitempty,numeric,gt=0"`
+	//@inject_tag: json:"currency" validate:"omitempty,alpha,len=3"
+	Currency string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency" validate:"omitempty,alpha,len=3"`
+	//@inject_tag: json:"values"
+	Values []string `protobuf:"bytes,11,rep,name=values,proto3" json:"values"`
+	//@inject_tag: json:"score" validate:"omitempty,numeric,gte=0"
+	Score int32 `protobuf:"varint,12,opt,name=score,proto3" json:"score" validate:"omitempty,numeric,gte=0"`
+	//@inject_tag: json:"decision" validate:"required,oneof=allow review 3ds decline"
+	Decision string `protobuf:"bytes,13,opt,name=decision,proto3" json:"decision" validate:"required,oneof=allow review 3ds decline"`
+	//@inject_tag: json:"is_active"
+	IsActive bool `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderReceipt) Reset()         { *m = OrderReceipt{} }
-func (m *OrderReceipt) String() string { return proto.CompactTextString(m) }
-func (*OrderReceipt) ProtoMessage()    {}
-func (*OrderReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{114}
+func (m *FraudRule) Reset()         { *m = FraudRule{} }
+func (m *FraudRule) String() string { return proto.CompactTextString(m) }
+func (*FraudRule) ProtoMessage()    {}
+func (*FraudRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{147}
 }
 
-func (m *OrderReceipt) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderReceipt.Unmarshal(m, b)
+func (m *FraudRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FraudRule.Unmarshal(m, b)
 }
-func (m *OrderReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderReceipt.Marshal(b, m, deterministic)
+func (m *FraudRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FraudRule.Marshal(b, m, deterministic)
 }
-func (m *OrderReceipt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderReceipt.Merge(m, src)
+func (m *FraudRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudRule.Merge(m, src)
 }
-func (m *OrderReceipt) XXX_Size() int {
-	return xxx_messageInfo_OrderReceipt.Size(m)
+func (m *FraudRule) XXX_Size() int {
+	return xxx_messageInfo_FraudRule.Size(m)
 }
-func (m *OrderReceipt) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderReceipt.DiscardUnknown(m)
+func (m *FraudRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudRule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderReceipt proto.InternalMessageInfo
+var xxx_messageInfo_FraudRule proto.InternalMessageInfo
 
-func (m *OrderReceipt) GetTotalPrice() string {
+func (m *FraudRule) GetId() string {
 	if m != nil {
-		return m.TotalPrice
+		return m.Id
 	}
 	return ""
 }
 
-func (m *OrderReceipt) GetTransactionId() string {
+func (m *FraudRule) GetMerchantId() string {
 	if m != nil {
-		return m.TransactionId
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *OrderReceipt) GetTransactionDate() string {
+func (m *FraudRule) GetProjectId() string {
 	if m != nil {
-		return m.TransactionDate
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *OrderReceipt) GetProjectName() string {
+func (m *FraudRule) GetName() string {
 	if m != nil {
-		return m.ProjectName
+		return m.Name
 	}
 	return ""
 }
 
-func (m *OrderReceipt) GetMerchantName() string {
+func (m *FraudRule) GetType() string {
 	if m != nil {
-		return m.MerchantName
+		return m.Type
 	}
 	return ""
 }
 
-func (m *OrderReceipt) GetItems() []*OrderReceiptItem {
+func (m *FraudRule) GetField() string {
 	if m != nil {
-		return m.Items
+		return m.Field
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderReceipt) GetOrderType() string {
+func (m *FraudRule) GetMaxCount() int32 {
 	if m != nil {
-		return m.OrderType
+		return m.MaxCount
 	}
-	return ""
+	return 0
 }
 
-func (m *OrderReceipt) GetPlatformName() string {
+func (m *FraudRule) GetPeriod() int64 {
 	if m != nil {
-		return m.PlatformName
+		return m.Period
 	}
-	return ""
+	return 0
 }
 
-type OrderReceiptItem struct {
-	//@inject_tag: json:"name"
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	//@inject_tag: json:"price"
-	Price                string   `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *FraudRule) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *OrderReceiptItem) Reset()         { *m = OrderReceiptItem{} }
-func (m *OrderReceiptItem) String() string { return proto.CompactTextString(m) }
-func (*OrderReceiptItem) ProtoMessage()    {}
-func (*OrderReceiptItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{115}
+func (m *FraudRule) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
 
-func (m *OrderReceiptItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderReceiptItem.Unmarshal(m, b)
-}
-func (m *OrderReceiptItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderReceiptItem.Marshal(b, m, deterministic)
-}
-func (m *OrderReceiptItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderReceiptItem.Merge(m, src)
+func (m *FraudRule) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
-func (m *OrderReceiptItem) XXX_Size() int {
-	return xxx_messageInfo_OrderReceiptItem.Size(m)
+
+func (m *FraudRule) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
-func (m *OrderReceiptItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderReceiptItem.DiscardUnknown(m)
+
+func (m *FraudRule) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
 }
 
-var xxx_messageInfo_OrderReceiptItem proto.InternalMessageInfo
+func (m *FraudRule) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
 
-func (m *OrderReceiptItem) GetName() string {
+func (m *FraudRule) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Name
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *OrderReceiptItem) GetPrice() string {
+func (m *FraudRule) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Price
+		return m.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type HasCurrencyItem struct {
-	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
-	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
-	//@inject_tag: json:"region" validate:"required,region_price"
-	Region               string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region" validate:"required,region_price"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type PromoCode struct {
+	//@inject_tag: json:"id" validate:"omitempty,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"project_id" validate:"omitempty,hexadecimal,len=24"
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: json:"code" validate:"required,alphanum,min=3,max=32"
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code" validate:"required,alphanum,min=3,max=32"`
+	//@inject_tag: json:"type" validate:"required,oneof=percent fixed"
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type" validate:"required,oneof=percent fixed"`
+	//@inject_tag: json:"percent" validate:"omitempty,numeric,gt=0,lte=100"
+	Percent float64 `protobuf:"fixed64,6,opt,name=percent,proto3" json:"percent" validate:"omitempty,numeric,gt=0,lte=100"`
+	//@inject_tag: json:"amounts"
+	Amounts map[string]float64 `protobuf:"bytes,7,rep,name=amounts,proto3" json:"amounts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	//@inject_tag: json:"valid_from"
+	ValidFrom *timestamp.Timestamp `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from"`
+	//@inject_tag: json:"valid_to"
+	ValidTo *timestamp.Timestamp `protobuf:"bytes,9,opt,name=valid_to,json=validTo,proto3" json:"valid_to"`
+	//@inject_tag: json:"max_uses" validate:"omitempty,numeric,gte=0"
+	MaxUses int32 `protobuf:"varint,10,opt,name=max_uses,json=maxUses,proto3" json:"max_uses" validate:"omitempty,numeric,gte=0"`
+	//@inject_tag: json:"max_uses_per_customer" validate:"omitempty,numeric,gte=0"
+	MaxUsesPerCustomer int32 `protobuf:"varint,11,opt,name=max_uses_per_customer,json=maxUsesPerCustomer,proto3" json:"max_uses_per_customer" validate:"omitempty,numeric,gte=0"`
+	//@inject_tag: json:"product_ids" validate:"omitempty,dive,hexadecimal,len=24"
+	ProductIds []string `protobuf:"bytes,12,rep,name=product_ids,json=productIds,proto3" json:"product_ids" validate:"omitempty,dive,hexadecimal,len=24"`
+	//@inject_tag: json:"platform_ids"
+	PlatformIds []string `protobuf:"bytes,13,rep,name=platform_ids,json=platformIds,proto3" json:"platform_ids"`
+	//@inject_tag: json:"is_active"
+	IsActive bool `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	//@inject_tag: json:"uses"
+	Uses int32 `protobuf:"varint,15,opt,name=uses,proto3" json:"uses"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *HasCurrencyItem) Reset()         { *m = HasCurrencyItem{} }
-func (m *HasCurrencyItem) String() string { return proto.CompactTextString(m) }
-func (*HasCurrencyItem) ProtoMessage()    {}
-func (*HasCurrencyItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{116}
+func (m *PromoCode) Reset()         { *m = PromoCode{} }
+func (m *PromoCode) String() string { return proto.CompactTextString(m) }
+func (*PromoCode) ProtoMessage()    {}
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{148}
 }
 
-func (m *HasCurrencyItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasCurrencyItem.Unmarshal(m, b)
+func (m *PromoCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PromoCode.Unmarshal(m, b)
 }
-func (m *HasCurrencyItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasCurrencyItem.Marshal(b, m, deterministic)
+func (m *PromoCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PromoCode.Marshal(b, m, deterministic)
 }
-func (m *HasCurrencyItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasCurrencyItem.Merge(m, src)
+func (m *PromoCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoCode.Merge(m, src)
 }
-func (m *HasCurrencyItem) XXX_Size() int {
-	return xxx_messageInfo_HasCurrencyItem.Size(m)
+func (m *PromoCode) XXX_Size() int {
+	return xxx_messageInfo_PromoCode.Size(m)
 }
-func (m *HasCurrencyItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasCurrencyItem.DiscardUnknown(m)
+func (m *PromoCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoCode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasCurrencyItem proto.InternalMessageInfo
+var xxx_messageInfo_PromoCode proto.InternalMessageInfo
 
-func (m *HasCurrencyItem) GetCurrency() string {
+func (m *PromoCode) GetId() string {
 	if m != nil {
-		return m.Currency
+		return m.Id
 	}
 	return ""
 }
 
-func (m *HasCurrencyItem) GetRegion() string {
+func (m *PromoCode) GetMerchantId() string {
 	if m != nil {
-		return m.Region
+		return m.MerchantId
 	}
 	return ""
 }
 
-type LocalizedUrl struct {
-	//@inject_tag: validate:"omitempty,uri" json:"en"
-	En string `protobuf:"bytes,1,opt,name=en,proto3" json:"en" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"ru"
-	Ru string `protobuf:"bytes,2,opt,name=ru,proto3" json:"ru" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"es"
-	Es string `protobuf:"bytes,3,opt,name=es,proto3" json:"es" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"de"
-	De string `protobuf:"bytes,4,opt,name=de,proto3" json:"de" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"zh"
-	Zh string `protobuf:"bytes,5,opt,name=zh,proto3" json:"zh" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"fr"
-	Fr string `protobuf:"bytes,6,opt,name=fr,proto3" json:"fr" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"ar"
-	Ar string `protobuf:"bytes,7,opt,name=ar,proto3" json:"ar" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"pt"
-	Pt string `protobuf:"bytes,8,opt,name=pt,proto3" json:"pt" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"it"
-	It string `protobuf:"bytes,9,opt,name=it,proto3" json:"it" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"pl"
-	Pl string `protobuf:"bytes,10,opt,name=pl,proto3" json:"pl" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"el"
-	El string `protobuf:"bytes,11,opt,name=el,proto3" json:"el" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"ko"
-	Ko string `protobuf:"bytes,12,opt,name=ko,proto3" json:"ko" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"ja"
-	Ja string `protobuf:"bytes,13,opt,name=ja,proto3" json:"ja" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"vl"
-	Vl string `protobuf:"bytes,14,opt,name=vl,proto3" json:"vl" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"he"
-	He string `protobuf:"bytes,15,opt,name=he,proto3" json:"he" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"th"
-	Th string `protobuf:"bytes,16,opt,name=th,proto3" json:"th" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"cs"
-	Cs string `protobuf:"bytes,17,opt,name=cs,proto3" json:"cs" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"bg"
-	Bg string `protobuf:"bytes,18,opt,name=bg,proto3" json:"bg" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"fi"
-	Fi string `protobuf:"bytes,19,opt,name=fi,proto3" json:"fi" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"sv"
-	Sv string `protobuf:"bytes,20,opt,name=sv,proto3" json:"sv" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"da"
-	Da string `protobuf:"bytes,21,opt,name=da,proto3" json:"da" validate:"omitempty,uri"`
-	//@inject_tag: validate:"omitempty,uri" json:"tr"
-	Tr                   string   `protobuf:"bytes,22,opt,name=tr,proto3" json:"tr" validate:"omitempty,uri"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *PromoCode) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
 }
 
-func (m *LocalizedUrl) Reset()         { *m = LocalizedUrl{} }
-func (m *LocalizedUrl) String() string { return proto.CompactTextString(m) }
-func (*LocalizedUrl) ProtoMessage()    {}
-func (*LocalizedUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{117}
+func (m *PromoCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (m *LocalizedUrl) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LocalizedUrl.Unmarshal(m, b)
-}
-func (m *LocalizedUrl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LocalizedUrl.Marshal(b, m, deterministic)
+func (m *PromoCode) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
-func (m *LocalizedUrl) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalizedUrl.Merge(m, src)
+
+func (m *PromoCode) GetPercent() float64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
 }
-func (m *LocalizedUrl) XXX_Size() int {
-	return xxx_messageInfo_LocalizedUrl.Size(m)
+
+func (m *PromoCode) GetAmounts() map[string]float64 {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
 }
-func (m *LocalizedUrl) XXX_DiscardUnknown() {
-	xxx_messageInfo_LocalizedUrl.DiscardUnknown(m)
+
+func (m *PromoCode) GetValidFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return nil
 }
 
-var xxx_messageInfo_LocalizedUrl proto.InternalMessageInfo
+func (m *PromoCode) GetValidTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidTo
+	}
+	return nil
+}
 
-func (m *LocalizedUrl) GetEn() string {
+func (m *PromoCode) GetMaxUses() int32 {
 	if m != nil {
-		return m.En
+		return m.MaxUses
 	}
-	return ""
+	return 0
 }
 
-func (m *LocalizedUrl) GetRu() string {
+func (m *PromoCode) GetMaxUsesPerCustomer() int32 {
 	if m != nil {
-		return m.Ru
+		return m.MaxUsesPerCustomer
 	}
-	return ""
+	return 0
 }
 
-func (m *LocalizedUrl) GetEs() string {
+func (m *PromoCode) GetProductIds() []string {
 	if m != nil {
-		return m.Es
+		return m.ProductIds
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetDe() string {
+func (m *PromoCode) GetPlatformIds() []string {
 	if m != nil {
-		return m.De
+		return m.PlatformIds
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetZh() string {
+func (m *PromoCode) GetIsActive() bool {
 	if m != nil {
-		return m.Zh
+		return m.IsActive
 	}
-	return ""
+	return false
 }
 
-func (m *LocalizedUrl) GetFr() string {
+func (m *PromoCode) GetUses() int32 {
 	if m != nil {
-		return m.Fr
+		return m.Uses
 	}
-	return ""
+	return 0
 }
 
-func (m *LocalizedUrl) GetAr() string {
+func (m *PromoCode) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Ar
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetPt() string {
+func (m *PromoCode) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Pt
+		return m.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetIt() string {
+type OrderFraudCheckRule struct {
+	//@inject_tag: json:"rule_id" bson:"rule_id"
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id" bson:"rule_id"`
+	//@inject_tag: json:"name" bson:"name"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" bson:"name"`
+	//@inject_tag: json:"type" bson:"type"
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type" bson:"type"`
+	//@inject_tag: json:"score" bson:"score"
+	Score int32 `protobuf:"varint,4,opt,name=score,proto3" json:"score" bson:"score"`
+	//@inject_tag: json:"decision" bson:"decision"
+	Decision             string   `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision" bson:"decision"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderFraudCheckRule) Reset()         { *m = OrderFraudCheckRule{} }
+func (m *OrderFraudCheckRule) String() string { return proto.CompactTextString(m) }
+func (*OrderFraudCheckRule) ProtoMessage()    {}
+func (*OrderFraudCheckRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{149}
+}
+
+func (m *OrderFraudCheckRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderFraudCheckRule.Unmarshal(m, b)
+}
+func (m *OrderFraudCheckRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderFraudCheckRule.Marshal(b, m, deterministic)
+}
+func (m *OrderFraudCheckRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFraudCheckRule.Merge(m, src)
+}
+func (m *OrderFraudCheckRule) XXX_Size() int {
+	return xxx_messageInfo_OrderFraudCheckRule.Size(m)
+}
+func (m *OrderFraudCheckRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFraudCheckRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFraudCheckRule proto.InternalMessageInfo
+
+func (m *OrderFraudCheckRule) GetRuleId() string {
 	if m != nil {
-		return m.It
+		return m.RuleId
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetPl() string {
+func (m *OrderFraudCheckRule) GetName() string {
 	if m != nil {
-		return m.Pl
+		return m.Name
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetEl() string {
+func (m *OrderFraudCheckRule) GetType() string {
 	if m != nil {
-		return m.El
+		return m.Type
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetKo() string {
+func (m *OrderFraudCheckRule) GetScore() int32 {
 	if m != nil {
-		return m.Ko
+		return m.Score
 	}
-	return ""
+	return 0
 }
 
-func (m *LocalizedUrl) GetJa() string {
+func (m *OrderFraudCheckRule) GetDecision() string {
 	if m != nil {
-		return m.Ja
+		return m.Decision
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetVl() string {
+type OrderFraudCheck struct {
+	//@inject_tag: json:"score" bson:"score"
+	Score int32 `protobuf:"varint,1,opt,name=score,proto3" json:"score" bson:"score"`
+	//@inject_tag: json:"decision" bson:"decision"
+	Decision string `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision" bson:"decision"`
+	//@inject_tag: json:"rules" bson:"rules"
+	Rules []*OrderFraudCheckRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules" bson:"rules"`
+	//@inject_tag: json:"checked_at" bson:"checked_at"
+	CheckedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at" bson:"checked_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderFraudCheck) Reset()         { *m = OrderFraudCheck{} }
+func (m *OrderFraudCheck) String() string { return proto.CompactTextString(m) }
+func (*OrderFraudCheck) ProtoMessage()    {}
+func (*OrderFraudCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{150}
+}
+
+func (m *OrderFraudCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderFraudCheck.Unmarshal(m, b)
+}
+func (m *OrderFraudCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderFraudCheck.Marshal(b, m, deterministic)
+}
+func (m *OrderFraudCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFraudCheck.Merge(m, src)
+}
+func (m *OrderFraudCheck) XXX_Size() int {
+	return xxx_messageInfo_OrderFraudCheck.Size(m)
+}
+func (m *OrderFraudCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFraudCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFraudCheck proto.InternalMessageInfo
+
+func (m *OrderFraudCheck) GetScore() int32 {
 	if m != nil {
-		return m.Vl
+		return m.Score
 	}
-	return ""
+	return 0
 }
 
-func (m *LocalizedUrl) GetHe() string {
+func (m *OrderFraudCheck) GetDecision() string {
 	if m != nil {
-		return m.He
+		return m.Decision
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetTh() string {
+func (m *OrderFraudCheck) GetRules() []*OrderFraudCheckRule {
 	if m != nil {
-		return m.Th
+		return m.Rules
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetCs() string {
+func (m *OrderFraudCheck) GetCheckedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Cs
+		return m.CheckedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetBg() string {
+type FraudNotification struct {
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	//@inject_tag: json:"project_id"
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id"`
+	//@inject_tag: json:"url"
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url"`
+	//@inject_tag: json:"fraud_check"
+	FraudCheck *OrderFraudCheck `protobuf:"bytes,4,opt,name=fraud_check,json=fraudCheck,proto3" json:"fraud_check"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *FraudNotification) Reset()         { *m = FraudNotification{} }
+func (m *FraudNotification) String() string { return proto.CompactTextString(m) }
+func (*FraudNotification) ProtoMessage()    {}
+func (*FraudNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{151}
+}
+
+func (m *FraudNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FraudNotification.Unmarshal(m, b)
+}
+func (m *FraudNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FraudNotification.Marshal(b, m, deterministic)
+}
+func (m *FraudNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudNotification.Merge(m, src)
+}
+func (m *FraudNotification) XXX_Size() int {
+	return xxx_messageInfo_FraudNotification.Size(m)
+}
+func (m *FraudNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FraudNotification proto.InternalMessageInfo
+
+func (m *FraudNotification) GetOrderId() string {
 	if m != nil {
-		return m.Bg
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetFi() string {
+func (m *FraudNotification) GetProjectId() string {
 	if m != nil {
-		return m.Fi
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetSv() string {
+func (m *FraudNotification) GetUrl() string {
 	if m != nil {
-		return m.Sv
+		return m.Url
 	}
 	return ""
 }
 
-func (m *LocalizedUrl) GetDa() string {
+func (m *FraudNotification) GetFraudCheck() *OrderFraudCheck {
 	if m != nil {
-		return m.Da
+		return m.FraudCheck
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalizedUrl) GetTr() string {
+func (m *FraudNotification) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Tr
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type ImageCollection struct {
-	//@inject_tag: json:"images"
-	Images *LocalizedUrl `protobuf:"bytes,1,opt,name=images,proto3" json:"images"`
-	//@inject_tag: json:"use_one_for_all"
-	UseOneForAll         bool     `protobuf:"varint,2,opt,name=use_one_for_all,json=useOneForAll,proto3" json:"use_one_for_all"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type WebhookDeliveryAttempt struct {
+	//@inject_tag: json:"http_status" bson:"http_status"
+	HttpStatus int32 `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status" bson:"http_status"`
+	//@inject_tag: json:"response_body" bson:"response_body"
+	ResponseBody string `protobuf:"bytes,2,opt,name=response_body,json=responseBody,proto3" json:"response_body" bson:"response_body"`
+	//@inject_tag: json:"error" bson:"error"
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error" bson:"error"`
+	//@inject_tag: json:"is_manual" bson:"is_manual"
+	IsManual bool `protobuf:"varint,4,opt,name=is_manual,json=isManual,proto3" json:"is_manual" bson:"is_manual"`
+	//@inject_tag: json:"created_at" bson:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ImageCollection) Reset()         { *m = ImageCollection{} }
-func (m *ImageCollection) String() string { return proto.CompactTextString(m) }
-func (*ImageCollection) ProtoMessage()    {}
-func (*ImageCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{118}
+func (m *WebhookDeliveryAttempt) Reset()         { *m = WebhookDeliveryAttempt{} }
+func (m *WebhookDeliveryAttempt) String() string { return proto.CompactTextString(m) }
+func (*WebhookDeliveryAttempt) ProtoMessage()    {}
+func (*WebhookDeliveryAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{152}
 }
 
-func (m *ImageCollection) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImageCollection.Unmarshal(m, b)
+func (m *WebhookDeliveryAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDeliveryAttempt.Unmarshal(m, b)
 }
-func (m *ImageCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImageCollection.Marshal(b, m, deterministic)
+func (m *WebhookDeliveryAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDeliveryAttempt.Marshal(b, m, deterministic)
 }
-func (m *ImageCollection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImageCollection.Merge(m, src)
+func (m *WebhookDeliveryAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeliveryAttempt.Merge(m, src)
 }
-func (m *ImageCollection) XXX_Size() int {
-	return xxx_messageInfo_ImageCollection.Size(m)
+func (m *WebhookDeliveryAttempt) XXX_Size() int {
+	return xxx_messageInfo_WebhookDeliveryAttempt.Size(m)
 }
-func (m *ImageCollection) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImageCollection.DiscardUnknown(m)
+func (m *WebhookDeliveryAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeliveryAttempt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImageCollection proto.InternalMessageInfo
+var xxx_messageInfo_WebhookDeliveryAttempt proto.InternalMessageInfo
 
-func (m *ImageCollection) GetImages() *LocalizedUrl {
+func (m *WebhookDeliveryAttempt) GetHttpStatus() int32 {
 	if m != nil {
-		return m.Images
+		return m.HttpStatus
 	}
-	return nil
+	return 0
 }
 
-func (m *ImageCollection) GetUseOneForAll() bool {
+func (m *WebhookDeliveryAttempt) GetResponseBody() string {
 	if m != nil {
-		return m.UseOneForAll
+		return m.ResponseBody
+	}
+	return ""
+}
+
+func (m *WebhookDeliveryAttempt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *WebhookDeliveryAttempt) GetIsManual() bool {
+	if m != nil {
+		return m.IsManual
 	}
 	return false
 }
 
-type ProductPrice struct {
-	// @inject_tag: json:"amount" validate:"required,numeric,gt=0"
-	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount" validate:"required,numeric,gt=0"`
-	//@inject_tag: json:"currency" validate:"required,alpha,len=3"
-	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
-	//@inject_tag: json:"region" validate:"required,region_price"
-	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region" validate:"required,region_price"`
-	//@inject_tag: json:"is_virtual_currency"
-	IsVirtualCurrency    bool     `protobuf:"varint,4,opt,name=is_virtual_currency,json=isVirtualCurrency,proto3" json:"is_virtual_currency"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *WebhookDeliveryAttempt) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (m *ProductPrice) Reset()         { *m = ProductPrice{} }
-func (m *ProductPrice) String() string { return proto.CompactTextString(m) }
-func (*ProductPrice) ProtoMessage()    {}
-func (*ProductPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{119}
+type WebhookDelivery struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"merchant_id"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id"`
+	//@inject_tag: json:"project_id"
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id"`
+	//@inject_tag: json:"order_id"
+	OrderId string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	//@inject_tag: json:"event"
+	Event string `protobuf:"bytes,5,opt,name=event,proto3" json:"event"`
+	//@inject_tag: json:"url"
+	Url string `protobuf:"bytes,6,opt,name=url,proto3" json:"url"`
+	//@inject_tag: json:"payload"
+	Payload string `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload"`
+	//@inject_tag: json:"status"
+	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
+	//@inject_tag: json:"attempts"
+	Attempts []*WebhookDeliveryAttempt `protobuf:"bytes,9,rep,name=attempts,proto3" json:"attempts"`
+	//@inject_tag: json:"next_attempt_at"
+	NextAttemptAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at"`
+	//@inject_tag: json:"delivered_at"
+	DeliveredAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ProductPrice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProductPrice.Unmarshal(m, b)
+func (m *WebhookDelivery) Reset()         { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()    {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{153}
 }
-func (m *ProductPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProductPrice.Marshal(b, m, deterministic)
+
+func (m *WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDelivery.Unmarshal(m, b)
 }
-func (m *ProductPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProductPrice.Merge(m, src)
+func (m *WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDelivery.Marshal(b, m, deterministic)
 }
-func (m *ProductPrice) XXX_Size() int {
-	return xxx_messageInfo_ProductPrice.Size(m)
+func (m *WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDelivery.Merge(m, src)
 }
-func (m *ProductPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProductPrice.DiscardUnknown(m)
+func (m *WebhookDelivery) XXX_Size() int {
+	return xxx_messageInfo_WebhookDelivery.Size(m)
+}
+func (m *WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDelivery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProductPrice proto.InternalMessageInfo
+var xxx_messageInfo_WebhookDelivery proto.InternalMessageInfo
 
-func (m *ProductPrice) GetAmount() float64 {
+func (m *WebhookDelivery) GetId() string {
 	if m != nil {
-		return m.Amount
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *ProductPrice) GetCurrency() string {
+func (m *WebhookDelivery) GetMerchantId() string {
 	if m != nil {
-		return m.Currency
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *ProductPrice) GetRegion() string {
+func (m *WebhookDelivery) GetProjectId() string {
 	if m != nil {
-		return m.Region
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *ProductPrice) GetIsVirtualCurrency() bool {
+func (m *WebhookDelivery) GetOrderId() string {
 	if m != nil {
-		return m.IsVirtualCurrency
+		return m.OrderId
 	}
-	return false
-}
-
-type ProjectVirtualCurrency struct {
-	//@inject_tag: json:"logo" validate:"omitempty,url"
-	Logo string `protobuf:"bytes,1,opt,name=logo,proto3" json:"logo" validate:"omitempty,url"`
-	// @inject_tag: json:"name" validate:"required,min=1"
-	Name map[string]string `protobuf:"bytes,2,rep,name=name,proto3" json:"name" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required,min=1"`
-	// @inject_tag: json:"success_message" validate:"required,min=1"
-	SuccessMessage map[string]string `protobuf:"bytes,3,rep,name=success_message,json=successMessage,proto3" json:"success_message" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required,min=1"`
-	//@inject_tag: json:"prices" validate:"required,min=1,dive"
-	Prices []*ProductPrice `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive"`
-	//@inject_tag: json:"min_purchase_value"
-	MinPurchaseValue float64 `protobuf:"fixed64,5,opt,name=min_purchase_value,json=minPurchaseValue,proto3" json:"min_purchase_value"`
-	//@inject_tag: json:"max_purchase_value"
-	MaxPurchaseValue float64 `protobuf:"fixed64,6,opt,name=max_purchase_value,json=maxPurchaseValue,proto3" json:"max_purchase_value"`
-	//@inject_tag: json:"sell_count_type" validate:"omitempty,oneof=fractional integral"
-	SellCountType        string   `protobuf:"bytes,7,opt,name=sell_count_type,json=sellCountType,proto3" json:"sell_count_type" validate:"omitempty,oneof=fractional integral"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	return ""
 }
 
-func (m *ProjectVirtualCurrency) Reset()         { *m = ProjectVirtualCurrency{} }
-func (m *ProjectVirtualCurrency) String() string { return proto.CompactTextString(m) }
-func (*ProjectVirtualCurrency) ProtoMessage()    {}
-func (*ProjectVirtualCurrency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{120}
+func (m *WebhookDelivery) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
 }
 
-func (m *ProjectVirtualCurrency) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProjectVirtualCurrency.Unmarshal(m, b)
-}
-func (m *ProjectVirtualCurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProjectVirtualCurrency.Marshal(b, m, deterministic)
-}
-func (m *ProjectVirtualCurrency) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectVirtualCurrency.Merge(m, src)
-}
-func (m *ProjectVirtualCurrency) XXX_Size() int {
-	return xxx_messageInfo_ProjectVirtualCurrency.Size(m)
-}
-func (m *ProjectVirtualCurrency) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectVirtualCurrency.DiscardUnknown(m)
+func (m *WebhookDelivery) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-var xxx_messageInfo_ProjectVirtualCurrency proto.InternalMessageInfo
-
-func (m *ProjectVirtualCurrency) GetLogo() string {
+func (m *WebhookDelivery) GetPayload() string {
 	if m != nil {
-		return m.Logo
+		return m.Payload
 	}
 	return ""
 }
 
-func (m *ProjectVirtualCurrency) GetName() map[string]string {
+func (m *WebhookDelivery) GetStatus() string {
 	if m != nil {
-		return m.Name
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-func (m *ProjectVirtualCurrency) GetSuccessMessage() map[string]string {
+func (m *WebhookDelivery) GetAttempts() []*WebhookDeliveryAttempt {
 	if m != nil {
-		return m.SuccessMessage
+		return m.Attempts
 	}
 	return nil
 }
 
-func (m *ProjectVirtualCurrency) GetPrices() []*ProductPrice {
+func (m *WebhookDelivery) GetNextAttemptAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Prices
+		return m.NextAttemptAt
 	}
 	return nil
 }
 
-func (m *ProjectVirtualCurrency) GetMinPurchaseValue() float64 {
+func (m *WebhookDelivery) GetDeliveredAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MinPurchaseValue
+		return m.DeliveredAt
 	}
-	return 0
+	return nil
 }
 
-func (m *ProjectVirtualCurrency) GetMaxPurchaseValue() float64 {
+func (m *WebhookDelivery) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.MaxPurchaseValue
+		return m.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *ProjectVirtualCurrency) GetSellCountType() string {
+func (m *WebhookDelivery) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.SellCountType
+		return m.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type OrderCreateByPaylink struct {
-	//@inject_tag: json:"id" validate:"required,hexadecimal,len=24"
-	PaylinkId string `protobuf:"bytes,1,opt,name=paylink_id,json=paylinkId,proto3" json:"id" validate:"required,hexadecimal,len=24"`
-	PayerIp   string `protobuf:"bytes,2,opt,name=payer_ip,json=payerIp,proto3" json:"payer_ip,omitempty"`
-	IssuerUrl string `protobuf:"bytes,3,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
-	// @inject_tag: json:"is_embedded"
-	IsEmbedded bool `protobuf:"varint,4,opt,name=is_embedded,json=isEmbedded,proto3" json:"is_embedded"`
-	//@inject_tag: bson:"utm_source" json:"utm_source"
-	UtmSource string `protobuf:"bytes,5,opt,name=utm_source,json=utmSource,proto3" json:"utm_source" bson:"utm_source"`
-	//@inject_tag: bson:"utm_medium" json:"utm_medium"
-	UtmMedium string `protobuf:"bytes,6,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium" bson:"utm_medium"`
-	//@inject_tag: bson:"utm_campaign" json:"utm_campaign"
-	UtmCampaign          string   `protobuf:"bytes,7,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign" bson:"utm_campaign"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type PayoutBatch struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"format"
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format"`
+	//@inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency"`
+	//@inject_tag: json:"payout_document_ids"
+	PayoutDocumentIds []string `protobuf:"bytes,4,rep,name=payout_document_ids,json=payoutDocumentIds,proto3" json:"payout_document_ids"`
+	//@inject_tag: json:"documents_count"
+	DocumentsCount int32 `protobuf:"varint,5,opt,name=documents_count,json=documentsCount,proto3" json:"documents_count"`
+	//@inject_tag: json:"total_amount"
+	TotalAmount float64 `protobuf:"fixed64,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	//@inject_tag: json:"file_name"
+	FileName string `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3" json:"file_name"`
+	//@inject_tag: json:"content"
+	Content []byte `protobuf:"bytes,8,opt,name=content,proto3" json:"content"`
+	//@inject_tag: json:"checksum"
+	Checksum string `protobuf:"bytes,9,opt,name=checksum,proto3" json:"checksum"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderCreateByPaylink) Reset()         { *m = OrderCreateByPaylink{} }
-func (m *OrderCreateByPaylink) String() string { return proto.CompactTextString(m) }
-func (*OrderCreateByPaylink) ProtoMessage()    {}
-func (*OrderCreateByPaylink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_958db8ba491a6b57, []int{121}
+func (m *PayoutBatch) Reset()         { *m = PayoutBatch{} }
+func (m *PayoutBatch) String() string { return proto.CompactTextString(m) }
+func (*PayoutBatch) ProtoMessage()    {}
+func (*PayoutBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_958db8ba491a6b57, []int{154}
 }
 
-func (m *OrderCreateByPaylink) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderCreateByPaylink.Unmarshal(m, b)
+func (m *PayoutBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutBatch.Unmarshal(m, b)
 }
-func (m *OrderCreateByPaylink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderCreateByPaylink.Marshal(b, m, deterministic)
+func (m *PayoutBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutBatch.Marshal(b, m, deterministic)
 }
-func (m *OrderCreateByPaylink) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderCreateByPaylink.Merge(m, src)
+func (m *PayoutBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutBatch.Merge(m, src)
 }
-func (m *OrderCreateByPaylink) XXX_Size() int {
-	return xxx_messageInfo_OrderCreateByPaylink.Size(m)
+func (m *PayoutBatch) XXX_Size() int {
+	return xxx_messageInfo_PayoutBatch.Size(m)
 }
-func (m *OrderCreateByPaylink) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderCreateByPaylink.DiscardUnknown(m)
+func (m *PayoutBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderCreateByPaylink proto.InternalMessageInfo
+var xxx_messageInfo_PayoutBatch proto.InternalMessageInfo
 
-func (m *OrderCreateByPaylink) GetPaylinkId() string {
+func (m *PayoutBatch) GetId() string {
 	if m != nil {
-		return m.PaylinkId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *OrderCreateByPaylink) GetPayerIp() string {
+func (m *PayoutBatch) GetFormat() string {
 	if m != nil {
-		return m.PayerIp
+		return m.Format
 	}
 	return ""
 }
 
-func (m *OrderCreateByPaylink) GetIssuerUrl() string {
+func (m *PayoutBatch) GetCurrency() string {
 	if m != nil {
-		return m.IssuerUrl
+		return m.Currency
 	}
 	return ""
 }
 
-func (m *OrderCreateByPaylink) GetIsEmbedded() bool {
+func (m *PayoutBatch) GetPayoutDocumentIds() []string {
 	if m != nil {
-		return m.IsEmbedded
+		return m.PayoutDocumentIds
 	}
-	return false
+	return nil
 }
 
-func (m *OrderCreateByPaylink) GetUtmSource() string {
+func (m *PayoutBatch) GetDocumentsCount() int32 {
 	if m != nil {
-		return m.UtmSource
+		return m.DocumentsCount
 	}
-	return ""
+	return 0
 }
 
-func (m *OrderCreateByPaylink) GetUtmMedium() string {
+func (m *PayoutBatch) GetTotalAmount() float64 {
 	if m != nil {
-		return m.UtmMedium
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *PayoutBatch) GetFileName() string {
+	if m != nil {
+		return m.FileName
 	}
 	return ""
 }
 
-func (m *OrderCreateByPaylink) GetUtmCampaign() string {
+func (m *PayoutBatch) GetContent() []byte {
 	if m != nil {
-		return m.UtmCampaign
+		return m.Content
+	}
+	return nil
+}
+
+func (m *PayoutBatch) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
 	}
 	return ""
 }
 
+func (m *PayoutBatch) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Name)(nil), "billing.Name")
 	proto.RegisterType((*OrderCreateRequest)(nil), "billing.OrderCreateRequest")
@@ -12590,6 +16570,7 @@ func init() {
 	proto.RegisterType((*MerchantTariff)(nil), "billing.MerchantTariff")
 	proto.RegisterType((*Merchant)(nil), "billing.Merchant")
 	proto.RegisterMapType((map[string]*MerchantPaymentMethod)(nil), "billing.Merchant.PaymentMethodsEntry")
+	proto.RegisterType((*MerchantPayoutCurrencyRule)(nil), "billing.MerchantPayoutCurrencyRule")
 	proto.RegisterType((*SystemNotificationStatuses)(nil), "billing.SystemNotificationStatuses")
 	proto.RegisterType((*Notification)(nil), "billing.Notification")
 	proto.RegisterType((*OrderPlatformFee)(nil), "billing.OrderPlatformFee")
@@ -12607,8 +16588,11 @@ func init() {
 	proto.RegisterType((*CountryRestriction)(nil), "billing.CountryRestriction")
 	proto.RegisterType((*OrderItem)(nil), "billing.OrderItem")
 	proto.RegisterMapType((map[string]string)(nil), "billing.OrderItem.MetadataEntry")
+	proto.RegisterType((*OrderPromoCode)(nil), "billing.OrderPromoCode")
+	proto.RegisterMapType((map[string]float64)(nil), "billing.OrderPromoCode.AmountsEntry")
 	proto.RegisterType((*OrderPaginate)(nil), "billing.OrderPaginate")
 	proto.RegisterType((*PaymentMethodOrder)(nil), "billing.PaymentMethodOrder")
+	proto.RegisterType((*OrderPaymentRouteAttempt)(nil), "billing.OrderPaymentRouteAttempt")
 	proto.RegisterType((*PaymentMethodParams)(nil), "billing.PaymentMethodParams")
 	proto.RegisterType((*PaymentSystem)(nil), "billing.PaymentSystem")
 	proto.RegisterType((*PaymentMethodCard)(nil), "billing.PaymentMethodCard")
@@ -12618,6 +16602,9 @@ func init() {
 	proto.RegisterType((*PaymentMethod)(nil), "billing.PaymentMethod")
 	proto.RegisterMapType((map[string]*PaymentMethodParams)(nil), "billing.PaymentMethod.ProductionSettingsEntry")
 	proto.RegisterMapType((map[string]*PaymentMethodParams)(nil), "billing.PaymentMethod.TestSettingsEntry")
+	proto.RegisterType((*PaymentMethodRoute)(nil), "billing.PaymentMethodRoute")
+	proto.RegisterMapType((map[string]*PaymentMethodParams)(nil), "billing.PaymentMethodRoute.ProductionSettingsEntry")
+	proto.RegisterMapType((map[string]*PaymentMethodParams)(nil), "billing.PaymentMethodRoute.TestSettingsEntry")
 	proto.RegisterType((*Commission)(nil), "billing.Commission")
 	proto.RegisterType((*CardExpire)(nil), "billing.CardExpire")
 	proto.RegisterType((*SavedCard)(nil), "billing.SavedCard")
@@ -12653,6 +16640,7 @@ func init() {
 	proto.RegisterType((*GetCountryRequest)(nil), "billing.GetCountryRequest")
 	proto.RegisterType((*CountryVatThreshold)(nil), "billing.CountryVatThreshold")
 	proto.RegisterType((*Country)(nil), "billing.Country")
+	proto.RegisterType((*CountryVatSettings)(nil), "billing.CountryVatSettings")
 	proto.RegisterType((*CountriesList)(nil), "billing.CountriesList")
 	proto.RegisterType((*GetPriceGroupRequest)(nil), "billing.GetPriceGroupRequest")
 	proto.RegisterType((*PriceGroup)(nil), "billing.PriceGroup")
@@ -12676,14 +16664,24 @@ func init() {
 	proto.RegisterType((*PayoutCostSystem)(nil), "billing.PayoutCostSystem")
 	proto.RegisterType((*AccountingEntrySource)(nil), "billing.AccountingEntrySource")
 	proto.RegisterType((*AccountingEntry)(nil), "billing.AccountingEntry")
+	proto.RegisterType((*LedgerPosting)(nil), "billing.LedgerPosting")
+	proto.RegisterType((*LedgerTrialBalanceAccount)(nil), "billing.LedgerTrialBalanceAccount")
+	proto.RegisterType((*LedgerTrialBalance)(nil), "billing.LedgerTrialBalance")
 	proto.RegisterType((*RoyaltyReportTotals)(nil), "billing.RoyaltyReportTotals")
 	proto.RegisterType((*RoyaltyReportProductSummaryItem)(nil), "billing.RoyaltyReportProductSummaryItem")
 	proto.RegisterType((*RoyaltyReportCorrectionItem)(nil), "billing.RoyaltyReportCorrectionItem")
 	proto.RegisterType((*RoyaltyReportSummary)(nil), "billing.RoyaltyReportSummary")
 	proto.RegisterType((*RoyaltyReport)(nil), "billing.RoyaltyReport")
 	proto.RegisterType((*RoyaltyReportChanges)(nil), "billing.RoyaltyReportChanges")
+	proto.RegisterType((*RoyaltyReportDisputeItem)(nil), "billing.RoyaltyReportDisputeItem")
+	proto.RegisterType((*RoyaltyReportDisputeCorrection)(nil), "billing.RoyaltyReportDisputeCorrection")
+	proto.RegisterType((*RoyaltyReportDispute)(nil), "billing.RoyaltyReportDispute")
+	proto.RegisterType((*RoyaltyReportVersion)(nil), "billing.RoyaltyReportVersion")
 	proto.RegisterType((*VatTransaction)(nil), "billing.VatTransaction")
 	proto.RegisterType((*VatReport)(nil), "billing.VatReport")
+	proto.RegisterType((*VatOssReturnItem)(nil), "billing.VatOssReturnItem")
+	proto.RegisterType((*VatOssReturnFile)(nil), "billing.VatOssReturnFile")
+	proto.RegisterType((*VatOssReturn)(nil), "billing.VatOssReturn")
 	proto.RegisterType((*AnnualTurnover)(nil), "billing.AnnualTurnover")
 	proto.RegisterType((*OrderViewMoney)(nil), "billing.OrderViewMoney")
 	proto.RegisterType((*OrderViewPublic)(nil), "billing.OrderViewPublic")
@@ -12698,9 +16696,13 @@ func init() {
 	proto.RegisterType((*MerchantTariffRatesSettingsItem)(nil), "billing.MerchantTariffRatesSettingsItem")
 	proto.RegisterType((*MerchantTariffRatesSettings)(nil), "billing.MerchantTariffRatesSettings")
 	proto.RegisterType((*Key)(nil), "billing.Key")
+	proto.RegisterMapType((map[string]string)(nil), "billing.Key.MetadataEntry")
+	proto.RegisterType((*KeyAuditLog)(nil), "billing.KeyAuditLog")
+	proto.RegisterType((*KeyReservationExpired)(nil), "billing.KeyReservationExpired")
 	proto.RegisterType((*PayoutDocument)(nil), "billing.PayoutDocument")
 	proto.RegisterType((*PayoutDocumentChanges)(nil), "billing.PayoutDocumentChanges")
 	proto.RegisterType((*MerchantBalance)(nil), "billing.MerchantBalance")
+	proto.RegisterType((*MerchantBalanceRollingReserveRelease)(nil), "billing.MerchantBalanceRollingReserveRelease")
 	proto.RegisterType((*OrderReceipt)(nil), "billing.OrderReceipt")
 	proto.RegisterType((*OrderReceiptItem)(nil), "billing.OrderReceiptItem")
 	proto.RegisterType((*HasCurrencyItem)(nil), "billing.HasCurrencyItem")
@@ -12711,739 +16713,946 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "billing.ProjectVirtualCurrency.NameEntry")
 	proto.RegisterMapType((map[string]string)(nil), "billing.ProjectVirtualCurrency.SuccessMessageEntry")
 	proto.RegisterType((*OrderCreateByPaylink)(nil), "billing.OrderCreateByPaylink")
+	proto.RegisterType((*SubscriptionPlan)(nil), "billing.SubscriptionPlan")
+	proto.RegisterMapType((map[string]string)(nil), "billing.SubscriptionPlan.DescriptionEntry")
+	proto.RegisterMapType((map[string]string)(nil), "billing.SubscriptionPlan.NameEntry")
+	proto.RegisterType((*Subscription)(nil), "billing.Subscription")
+	proto.RegisterType((*SubscriptionNotification)(nil), "billing.SubscriptionNotification")
+	proto.RegisterType((*ReconciliationRun)(nil), "billing.ReconciliationRun")
+	proto.RegisterType((*ReconciliationLine)(nil), "billing.ReconciliationLine")
+	proto.RegisterType((*ChargebackEvidence)(nil), "billing.ChargebackEvidence")
+	proto.RegisterType((*Chargeback)(nil), "billing.Chargeback")
+	proto.RegisterType((*FraudRule)(nil), "billing.FraudRule")
+	proto.RegisterType((*PromoCode)(nil), "billing.PromoCode")
+	proto.RegisterMapType((map[string]float64)(nil), "billing.PromoCode.AmountsEntry")
+	proto.RegisterType((*OrderFraudCheckRule)(nil), "billing.OrderFraudCheckRule")
+	proto.RegisterType((*OrderFraudCheck)(nil), "billing.OrderFraudCheck")
+	proto.RegisterType((*FraudNotification)(nil), "billing.FraudNotification")
+	proto.RegisterType((*WebhookDeliveryAttempt)(nil), "billing.WebhookDeliveryAttempt")
+	proto.RegisterType((*WebhookDelivery)(nil), "billing.WebhookDelivery")
+	proto.RegisterType((*PayoutBatch)(nil), "billing.PayoutBatch")
 }
 
 func init() { proto.RegisterFile("billing.proto", fileDescriptor_958db8ba491a6b57) }
 
 var fileDescriptor_958db8ba491a6b57 = []byte{
-	// 11650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5d, 0x8c, 0x24, 0x49,
-	0x92, 0x10, 0xac, 0xcc, 0xaa, 0xfc, 0xb3, 0xcc, 0xca, 0xcc, 0x8a, 0xfa, 0xcb, 0xaa, 0xfe, 0xcf,
-	0xfe, 0x9d, 0x9e, 0xe9, 0xea, 0x9e, 0xee, 0x9e, 0x9e, 0xff, 0x9b, 0xa9, 0xae, 0xee, 0x9a, 0xae,
-	0x9d, 0xe9, 0x9e, 0xba, 0xa8, 0x9a, 0xbe, 0xdb, 0xdd, 0xbb, 0x4d, 0x45, 0x65, 0x78, 0x55, 0xc5,
-	0x76, 0x66, 0x46, 0x6e, 0x44, 0x64, 0x75, 0xd5, 0x7c, 0xfa, 0x10, 0xe2, 0x61, 0x85, 0x56, 0xe2,
-	0x09, 0x1d, 0xe2, 0x91, 0x93, 0x10, 0x4f, 0x80, 0x04, 0x3a, 0x09, 0x9e, 0x8e, 0x3f, 0x81, 0x84,
-	0x0e, 0x21, 0x01, 0x87, 0x74, 0x4f, 0x80, 0x84, 0x4e, 0x80, 0x74, 0x42, 0x5a, 0xc4, 0x03, 0x6f,
-	0x20, 0x37, 0x37, 0xf7, 0xf0, 0xf8, 0xc9, 0xbf, 0xea, 0xd9, 0x1d, 0x90, 0xee, 0x25, 0x95, 0xee,
-	0x6e, 0x6e, 0x11, 0xee, 0x6e, 0x66, 0x6e, 0x66, 0x6e, 0x6e, 0x01, 0x73, 0xfb, 0x4e, 0xa7, 0xe3,
-	0xf4, 0x0e, 0xd7, 0xfb, 0x9e, 0x1b, 0xb8, 0x46, 0x81, 0x8a, 0x6b, 0x97, 0x0e, 0x5d, 0xf7, 0xb0,
-	0xc3, 0xee, 0x62, 0xf5, 0xfe, 0xe0, 0xe0, 0x6e, 0xe0, 0x74, 0x99, 0x1f, 0x58, 0xdd, 0xbe, 0x80,
-	0x6c, 0xde, 0x80, 0xd9, 0x17, 0x56, 0x97, 0x19, 0x55, 0xc8, 0xb2, 0x5e, 0x23, 0x73, 0x39, 0x73,
-	0xab, 0x64, 0x66, 0x59, 0x8f, 0x97, 0xbd, 0x41, 0x23, 0x2b, 0xca, 0xde, 0xa0, 0xf9, 0xa7, 0x15,
-	0x30, 0xbe, 0xf6, 0x6c, 0xe6, 0x6d, 0x7a, 0xcc, 0x0a, 0x98, 0xc9, 0x7e, 0x36, 0x60, 0x7e, 0x60,
-	0x5c, 0x00, 0xe8, 0x7b, 0xee, 0x4f, 0x59, 0x3b, 0x68, 0x39, 0x36, 0x75, 0x2f, 0x51, 0xcd, 0xb6,
-	0x6d, 0x9c, 0x87, 0x92, 0xef, 0x1c, 0xf6, 0xac, 0x60, 0xe0, 0x31, 0x42, 0x16, 0x56, 0x18, 0xcb,
-	0x90, 0xb7, 0xba, 0xee, 0xa0, 0x17, 0x34, 0x66, 0x2e, 0x67, 0x6e, 0x65, 0x4c, 0x2a, 0x19, 0x6b,
-	0x50, 0x6c, 0x0f, 0x3c, 0x8f, 0xf5, 0xda, 0xa7, 0x8d, 0x59, 0xec, 0xa4, 0xca, 0x46, 0x03, 0x0a,
-	0x56, 0xbb, 0x8d, 0x9d, 0x72, 0xd8, 0x24, 0x8b, 0xc6, 0x2a, 0x14, 0x5d, 0xfe, 0x82, 0xfc, 0x45,
-	0xf2, 0xa2, 0x09, 0xcb, 0xdb, 0xb6, 0x71, 0x19, 0xca, 0x36, 0xf3, 0xdb, 0x9e, 0xd3, 0x0f, 0x1c,
-	0xb7, 0xd7, 0x28, 0x60, 0xab, 0x5e, 0x65, 0x5c, 0x87, 0x6a, 0xdf, 0x3a, 0xed, 0xb2, 0x5e, 0xd0,
-	0xea, 0xb2, 0xe0, 0xc8, 0xb5, 0x1b, 0x45, 0x04, 0x9a, 0xa3, 0xda, 0xe7, 0x58, 0xc9, 0x87, 0x3b,
-	0xf0, 0x3a, 0xad, 0x63, 0xe6, 0x39, 0x07, 0xa7, 0x8d, 0x92, 0x18, 0xd0, 0xc0, 0xeb, 0xbc, 0xc4,
-	0x0a, 0xd9, 0xdc, 0x73, 0x03, 0xde, 0x0c, 0xaa, 0xf9, 0x05, 0x56, 0x18, 0x97, 0xa0, 0xcc, 0x9b,
-	0xfd, 0x41, 0xbb, 0xcd, 0x7c, 0xbf, 0x51, 0xc6, 0x76, 0xde, 0x63, 0x57, 0xd4, 0xf0, 0x21, 0x70,
-	0x80, 0x03, 0xcb, 0xe9, 0x34, 0x2a, 0x62, 0x08, 0x03, 0xaf, 0xb3, 0x65, 0x39, 0x1d, 0xde, 0xb7,
-	0x6f, 0x9d, 0x32, 0xaf, 0xc5, 0xba, 0xbc, 0x75, 0x4e, 0xf4, 0xc5, 0xaa, 0xa7, 0xdd, 0x08, 0x40,
-	0xff, 0xc8, 0xed, 0xb1, 0x46, 0x55, 0x03, 0xd8, 0xe1, 0x35, 0x7c, 0xb6, 0x3d, 0x76, 0xc8, 0xc7,
-	0x5f, 0xc3, 0x36, 0x2a, 0xf1, 0x87, 0x8a, 0x8e, 0x4e, 0xbf, 0x51, 0x17, 0x0f, 0xc5, 0xf2, 0x76,
-	0xdf, 0xf8, 0x04, 0x72, 0x6e, 0x70, 0xc4, 0xbc, 0xc6, 0xfc, 0xe5, 0x99, 0x5b, 0xe5, 0xfb, 0x37,
-	0xd6, 0x25, 0x95, 0x25, 0x29, 0x61, 0xfd, 0x6b, 0x0e, 0xf8, 0xb4, 0x17, 0x78, 0xa7, 0xa6, 0xe8,
-	0x64, 0x6c, 0x03, 0x78, 0xd6, 0xeb, 0x56, 0xdf, 0xf2, 0xac, 0xae, 0xdf, 0x30, 0x10, 0xc5, 0xed,
-	0x51, 0x28, 0x4c, 0xeb, 0xf5, 0x0e, 0x02, 0x0b, 0x34, 0x25, 0x4f, 0x96, 0xf9, 0x3b, 0x72, 0x54,
-	0xfb, 0xae, 0x7d, 0xda, 0x58, 0x10, 0xef, 0xe8, 0x59, 0xaf, 0x1f, 0xbb, 0xf6, 0xa9, 0xb1, 0x02,
-	0x05, 0xc7, 0x6f, 0xfd, 0xd4, 0x77, 0x7b, 0x8d, 0xc5, 0xcb, 0x99, 0x5b, 0x45, 0x33, 0xef, 0xf8,
-	0x3f, 0xf0, 0xdd, 0x1e, 0xa7, 0xa2, 0x8e, 0xd5, 0x3b, 0x1c, 0x58, 0x87, 0xac, 0xb1, 0x24, 0xa8,
-	0x48, 0x96, 0x79, 0x5b, 0xdf, 0x73, 0xed, 0x41, 0x3b, 0xf0, 0x1b, 0xcb, 0x97, 0x67, 0x78, 0x9b,
-	0x2c, 0x1b, 0x4f, 0xa1, 0xd8, 0x65, 0x81, 0x65, 0x5b, 0x81, 0xd5, 0x58, 0xc1, 0x97, 0x7e, 0x6b,
-	0xd4, 0x4b, 0x3f, 0x27, 0x58, 0xf1, 0xce, 0xaa, 0xab, 0xf1, 0x63, 0xa8, 0xf7, 0x3d, 0xe7, 0xd8,
-	0x0a, 0x58, 0x4b, 0xa1, 0x6b, 0x20, 0xba, 0x7b, 0xa3, 0xd0, 0xed, 0x88, 0x3e, 0x51, 0xac, 0xb5,
-	0x7e, 0xb4, 0x96, 0x93, 0xab, 0xc7, 0xda, 0xcc, 0xe9, 0x07, 0xad, 0xde, 0xa0, 0xbb, 0xcf, 0xbc,
-	0xc6, 0xaa, 0x20, 0x57, 0xaa, 0x7d, 0x81, 0x95, 0x9c, 0x26, 0x24, 0xd8, 0xc0, 0xeb, 0x34, 0xd6,
-	0x04, 0x4d, 0x50, 0xd5, 0x37, 0x5e, 0x87, 0x13, 0xac, 0xe3, 0xfb, 0x03, 0xe6, 0x61, 0xfb, 0x39,
-	0x41, 0xb0, 0xa2, 0x86, 0x37, 0x5f, 0x82, 0xb2, 0xe3, 0xb7, 0x58, 0x77, 0x9f, 0xd9, 0x36, 0xb3,
-	0x1b, 0xe7, 0x71, 0x7e, 0xc1, 0xf1, 0x9f, 0x52, 0x8d, 0xb1, 0x08, 0xb9, 0xc0, 0x7d, 0xc5, 0x7a,
-	0x8d, 0x0b, 0xd8, 0x55, 0x14, 0x8c, 0x1b, 0x30, 0x3b, 0xf0, 0x99, 0xd7, 0xb8, 0x78, 0x39, 0x73,
-	0xab, 0x7c, 0xdf, 0x88, 0x0e, 0xf7, 0x1b, 0x9f, 0x79, 0x26, 0xb6, 0x1b, 0xd7, 0xa0, 0xda, 0xf7,
-	0xfb, 0x2d, 0xc1, 0xb5, 0x83, 0x81, 0x63, 0x37, 0x2e, 0x21, 0x9a, 0x4a, 0xdf, 0xef, 0x0b, 0xd8,
-	0x81, 0x63, 0x1b, 0x06, 0xcc, 0x06, 0xa7, 0x7d, 0xd6, 0xb8, 0x8c, 0x6d, 0xf8, 0x1f, 0x89, 0xbd,
-	0x63, 0x05, 0x07, 0xae, 0xd7, 0xe5, 0xec, 0x7e, 0x85, 0x88, 0x9d, 0xaa, 0xb6, 0x6d, 0xe3, 0x2d,
-	0xa8, 0xd3, 0xc0, 0x3c, 0x76, 0xc0, 0xb8, 0xe8, 0x60, 0x8d, 0x26, 0x42, 0xd5, 0x44, 0xbd, 0x29,
-	0xab, 0x8d, 0xfb, 0xb0, 0x14, 0x07, 0x6d, 0xe1, 0x03, 0xaf, 0x22, 0xfc, 0x42, 0x0c, 0x7e, 0x8f,
-	0x3f, 0x9f, 0x33, 0x7a, 0xd0, 0x6d, 0xf9, 0xee, 0xc0, 0x6b, 0xb3, 0xc6, 0x35, 0x62, 0xf4, 0xa0,
-	0xbb, 0x8b, 0x15, 0xb2, 0xb9, 0xcb, 0x6c, 0x67, 0xd0, 0x6d, 0x5c, 0x57, 0xcd, 0xcf, 0xb1, 0xc2,
-	0xb8, 0x02, 0x15, 0xde, 0xdc, 0xb6, 0xba, 0x7d, 0xcb, 0x39, 0xec, 0x35, 0x6e, 0x08, 0x79, 0x34,
-	0x08, 0xba, 0x9b, 0x54, 0xb5, 0xf6, 0x01, 0x40, 0xc8, 0x50, 0x46, 0x1d, 0x66, 0x5e, 0xb1, 0x53,
-	0x12, 0xaf, 0xfc, 0x2f, 0x9f, 0xf8, 0x63, 0xab, 0x33, 0x90, 0x42, 0x55, 0x14, 0x3e, 0xca, 0x7e,
-	0x90, 0x59, 0xfb, 0x04, 0xaa, 0x51, 0x3e, 0x9a, 0xaa, 0xf7, 0xc7, 0x30, 0x17, 0x21, 0xbd, 0xa9,
-	0x3a, 0x3f, 0x86, 0xc5, 0x34, 0xf2, 0x9d, 0x06, 0x47, 0xf3, 0x3f, 0x55, 0xa1, 0xb0, 0x23, 0xf6,
-	0x0f, 0xbe, 0x07, 0xa9, 0x4d, 0x25, 0xeb, 0xd8, 0x7c, 0xd5, 0xbb, 0xcc, 0x6b, 0x1f, 0x59, 0x3d,
-	0xdc, 0x6d, 0x44, 0x5f, 0x90, 0x55, 0xdb, 0xb6, 0xb1, 0x0e, 0xb3, 0x3d, 0xab, 0xcb, 0x1a, 0x33,
-	0xc8, 0x67, 0x6b, 0x8a, 0xf0, 0x08, 0xe1, 0x3a, 0xdf, 0xe9, 0x04, 0x47, 0x21, 0x1c, 0x5f, 0x27,
-	0x8f, 0xf9, 0xcc, 0x3b, 0x66, 0x76, 0xeb, 0x21, 0x6d, 0x35, 0x25, 0x59, 0xf3, 0xd0, 0x78, 0x1b,
-	0xe6, 0xdb, 0x56, 0xa7, 0xb3, 0x6f, 0xb5, 0x5f, 0xb5, 0xd4, 0x86, 0x24, 0x76, 0x9d, 0xba, 0x6c,
-	0xd8, 0x94, 0x1b, 0x93, 0x0e, 0x8c, 0x5b, 0x6b, 0xdb, 0xed, 0xd0, 0x3e, 0xa4, 0x80, 0x77, 0xa8,
-	0xde, 0xf8, 0x10, 0x56, 0xdb, 0xc8, 0xf6, 0x44, 0xfc, 0x56, 0xa7, 0xe3, 0xbe, 0x66, 0x36, 0xe7,
-	0x42, 0xbf, 0x51, 0x40, 0x81, 0xb4, 0x2c, 0x00, 0x90, 0x0f, 0x36, 0x44, 0xf3, 0x37, 0x5e, 0xc7,
-	0xe7, 0x5d, 0x11, 0xba, 0x65, 0x9f, 0xf6, 0xac, 0xae, 0xd3, 0xa6, 0xdd, 0x46, 0x74, 0x2d, 0x22,
-	0x87, 0x2e, 0x23, 0xc0, 0x13, 0xd1, 0x2e, 0xf6, 0x1e, 0xec, 0xfa, 0x29, 0x9c, 0x8b, 0x76, 0xf5,
-	0x98, 0xed, 0x78, 0x7c, 0xef, 0xc6, 0xce, 0x25, 0xec, 0xdc, 0xd0, 0x3b, 0x9b, 0x04, 0x80, 0xdd,
-	0x6f, 0x42, 0xad, 0xe3, 0x74, 0x9d, 0xc0, 0x0f, 0x27, 0x43, 0x6c, 0x71, 0x55, 0x51, 0xad, 0xa6,
-	0xe2, 0x1d, 0x30, 0xba, 0x4e, 0xaf, 0x25, 0x37, 0x54, 0xda, 0xe3, 0xcb, 0xb8, 0xc7, 0xd7, 0xbb,
-	0x4e, 0x6f, 0x47, 0x34, 0x6c, 0x88, 0xdd, 0x9e, 0x43, 0x5b, 0x27, 0x71, 0xe8, 0x0a, 0x41, 0x5b,
-	0x27, 0x51, 0xe8, 0xab, 0x30, 0x47, 0x03, 0xc6, 0x8d, 0xd0, 0x6f, 0xcc, 0xe1, 0x6c, 0x55, 0x44,
-	0x25, 0x6e, 0x85, 0xbe, 0x71, 0x0f, 0x16, 0x1d, 0xbf, 0x25, 0x25, 0x7a, 0xab, 0x7d, 0xc4, 0xda,
-	0xaf, 0xdc, 0x41, 0x80, 0x9b, 0x62, 0xd1, 0x34, 0x1c, 0x7f, 0x87, 0x9a, 0x36, 0xa9, 0x85, 0x53,
-	0x82, 0xcf, 0xda, 0x1e, 0x0b, 0x5a, 0x9c, 0x52, 0x6b, 0xa4, 0xa9, 0x60, 0xcd, 0x97, 0xec, 0xd4,
-	0xb8, 0x03, 0x86, 0x52, 0x5b, 0x5a, 0x1e, 0xfb, 0xd9, 0xc0, 0xf1, 0x98, 0x8d, 0xbb, 0x65, 0xd1,
-	0x9c, 0x57, 0x2d, 0x26, 0x35, 0x18, 0xb7, 0x61, 0xde, 0x67, 0x3d, 0xbb, 0xa5, 0xbf, 0x69, 0x63,
-	0x1e, 0xa1, 0x6b, 0xbc, 0xe1, 0x45, 0xf8, 0xb2, 0x1c, 0x96, 0xef, 0xf9, 0xf8, 0x8e, 0x2d, 0xa9,
-	0xda, 0x18, 0x42, 0x54, 0x0d, 0xbc, 0x0e, 0xbe, 0xe1, 0x06, 0xa9, 0x38, 0xeb, 0xb0, 0xc0, 0x61,
-	0xfb, 0x9e, 0xcb, 0xd5, 0x05, 0x39, 0x65, 0xb4, 0x23, 0x72, 0x34, 0x3b, 0xa2, 0x85, 0xa6, 0x4c,
-	0xe2, 0x56, 0xcb, 0x8c, 0x8a, 0xc5, 0xa2, 0xc2, 0x2d, 0x57, 0x17, 0x15, 0x8c, 0x7b, 0xb0, 0x18,
-	0x81, 0x95, 0x5a, 0x8a, 0xd8, 0x3a, 0x0d, 0x0d, 0x5c, 0x6a, 0x2b, 0xcb, 0x90, 0xf7, 0x03, 0x2b,
-	0x18, 0xf0, 0x2d, 0x34, 0x73, 0x2b, 0x67, 0x52, 0xc9, 0xf8, 0x10, 0x40, 0xd0, 0xae, 0xdd, 0xb2,
-	0x82, 0xc6, 0x0a, 0x6e, 0x02, 0x6b, 0xeb, 0x42, 0x11, 0x5d, 0x97, 0x8a, 0xe8, 0xfa, 0x9e, 0x54,
-	0x44, 0xcd, 0x12, 0x41, 0x6f, 0x04, 0xbc, 0xeb, 0xa0, 0x6f, 0xcb, 0xae, 0x8d, 0xf1, 0x5d, 0x09,
-	0x7a, 0x23, 0x40, 0x0d, 0x4e, 0x2d, 0x38, 0x4e, 0xe2, 0x2a, 0xbe, 0xd5, 0x9c, 0xac, 0xdd, 0xc4,
-	0x29, 0x7c, 0x08, 0xcb, 0x62, 0xba, 0x2d, 0xef, 0x90, 0x09, 0x66, 0xa5, 0x59, 0x14, 0xbb, 0xe3,
-	0x22, 0xce, 0xb9, 0x6c, 0x94, 0x13, 0xf9, 0x0e, 0x18, 0xd8, 0xcb, 0xea, 0xb5, 0x59, 0x47, 0xf5,
-	0x10, 0xfb, 0x65, 0x9d, 0xf7, 0xc0, 0x86, 0xd8, 0xb4, 0x1f, 0x78, 0xd6, 0xc0, 0x56, 0xc0, 0xe7,
-	0xd5, 0xb4, 0x6f, 0xf1, 0xfa, 0x18, 0x66, 0x8f, 0x1d, 0x0c, 0x7a, 0x21, 0xf0, 0x05, 0x85, 0xd9,
-	0xc4, 0x06, 0x09, 0x7d, 0x0d, 0xe6, 0x3a, 0x6e, 0xdb, 0xea, 0x38, 0xdf, 0x5a, 0x5c, 0x6d, 0xf5,
-	0x1b, 0x17, 0x91, 0xfa, 0xa3, 0x95, 0xc6, 0x0e, 0xd4, 0x0f, 0x06, 0x9d, 0x4e, 0x4b, 0xd7, 0x79,
-	0x2f, 0xa1, 0x48, 0xbc, 0x9e, 0x10, 0x89, 0x5b, 0x83, 0x4e, 0xe7, 0x49, 0x08, 0x47, 0xfa, 0xc6,
-	0x41, 0xb4, 0xd6, 0xd8, 0x85, 0x79, 0xff, 0xc8, 0xf5, 0x82, 0x08, 0xca, 0xcb, 0x31, 0xa5, 0x50,
-	0xa2, 0xdc, 0xe5, 0x90, 0x09, 0x9c, 0x75, 0x3f, 0x56, 0x6d, 0x7c, 0x00, 0x40, 0x82, 0xc4, 0x61,
-	0x7e, 0xe3, 0x0a, 0x62, 0x6b, 0x28, 0x6c, 0xcf, 0x2c, 0x25, 0x50, 0xb6, 0x03, 0xd6, 0x35, 0x35,
-	0x58, 0x63, 0x1d, 0x72, 0x6d, 0xf7, 0x98, 0x79, 0xb8, 0xa5, 0xeb, 0x9d, 0xb6, 0xbb, 0xd6, 0x21,
-	0xdb, 0x74, 0x3b, 0x1d, 0xd6, 0xe6, 0x8f, 0x30, 0x05, 0x98, 0xf1, 0x03, 0xa8, 0x1f, 0x3b, 0x5e,
-	0x30, 0xb0, 0x3a, 0xa1, 0xe8, 0xba, 0x8a, 0x5d, 0x2f, 0xc5, 0xdf, 0xfe, 0xa5, 0x80, 0x93, 0x8f,
-	0x36, 0x6b, 0xc7, 0xd1, 0x8a, 0xb5, 0xf7, 0xa1, 0xa4, 0xb6, 0x91, 0x69, 0x77, 0xc7, 0xb4, 0xc9,
-	0x9e, 0x0a, 0xc7, 0x26, 0x2c, 0xa5, 0xce, 0xee, 0x54, 0x5b, 0xec, 0xff, 0xcc, 0x41, 0x85, 0x46,
-	0x8b, 0xbb, 0xcb, 0xf4, 0xfb, 0xec, 0x83, 0xc8, 0x3e, 0x9b, 0x98, 0x43, 0xc4, 0x9a, 0xd8, 0x6c,
-	0x63, 0xd6, 0xcf, 0xec, 0x48, 0xeb, 0x27, 0x17, 0xb5, 0x7e, 0x12, 0x52, 0x3f, 0x9f, 0x22, 0xf5,
-	0xa3, 0x32, 0xbc, 0x10, 0x97, 0xe1, 0xa9, 0x42, 0xb9, 0x38, 0x85, 0x50, 0x2e, 0x4d, 0x25, 0x94,
-	0x61, 0x98, 0x50, 0x4e, 0x55, 0x14, 0xca, 0x43, 0x14, 0x85, 0xe1, 0xe2, 0xaa, 0x32, 0xb5, 0xb8,
-	0x9a, 0x9b, 0x46, 0x5c, 0x55, 0xa7, 0x11, 0x57, 0xb5, 0x21, 0xe2, 0x2a, 0xdc, 0x21, 0xea, 0x91,
-	0x1d, 0xe2, 0x23, 0x58, 0x55, 0x04, 0xe6, 0xb9, 0xa7, 0x56, 0x27, 0x38, 0x0d, 0x19, 0x73, 0x1e,
-	0x91, 0xad, 0x48, 0x00, 0x53, 0xb4, 0xbf, 0x31, 0xff, 0x35, 0xff, 0x5a, 0x06, 0x6a, 0xcf, 0x09,
-	0xe9, 0xa6, 0xdb, 0x0b, 0xac, 0x76, 0x60, 0x3c, 0x06, 0xb0, 0x06, 0xc1, 0x91, 0xeb, 0x39, 0xdf,
-	0x32, 0xc1, 0x01, 0xe5, 0xfb, 0x4d, 0x45, 0xce, 0x31, 0xe8, 0x0d, 0x05, 0x69, 0x6a, 0xbd, 0x8c,
-	0xcf, 0xa0, 0x14, 0xb0, 0xf6, 0x51, 0xcf, 0x69, 0x5b, 0x1d, 0x7c, 0x6a, 0xf9, 0xfe, 0x95, 0x61,
-	0x28, 0xf6, 0x24, 0xa0, 0x19, 0xf6, 0x69, 0xfe, 0x08, 0x1a, 0xc3, 0xc0, 0xb8, 0xf1, 0x83, 0x9c,
-	0x26, 0x46, 0x28, 0x18, 0x69, 0x11, 0x72, 0x82, 0x78, 0x69, 0x88, 0x58, 0xe0, 0xb5, 0xc2, 0xf2,
-	0x9f, 0x11, 0xb5, 0x58, 0x68, 0xbe, 0x86, 0xd5, 0xa1, 0xa3, 0x78, 0x53, 0xe4, 0x68, 0x45, 0xbb,
-	0xbe, 0x83, 0x9b, 0x01, 0xf9, 0x69, 0x64, 0xb9, 0xf9, 0x5f, 0xb4, 0xd9, 0x7e, 0x6c, 0xf5, 0x5e,
-	0x39, 0xbd, 0xc3, 0x88, 0x5f, 0x27, 0x13, 0xf3, 0xeb, 0xc8, 0x77, 0xc9, 0x6a, 0xef, 0xd2, 0x80,
-	0x82, 0x65, 0xdb, 0x1e, 0x97, 0x16, 0x33, 0xe4, 0xeb, 0x11, 0x45, 0xbe, 0xd9, 0x13, 0x57, 0x4a,
-	0xfb, 0x57, 0x3c, 0x7f, 0x8e, 0x6a, 0xc9, 0xfe, 0x5d, 0x84, 0x9c, 0xff, 0xda, 0x39, 0x90, 0xae,
-	0x22, 0x51, 0xe0, 0x68, 0x6d, 0x16, 0x90, 0x18, 0x41, 0xb4, 0x54, 0x34, 0x1e, 0xc0, 0x52, 0xdb,
-	0xf5, 0x3c, 0xe6, 0xf7, 0xdd, 0x9e, 0x8d, 0xca, 0x28, 0xb1, 0xbe, 0x10, 0x26, 0x8b, 0x91, 0x46,
-	0xe2, 0xff, 0xe6, 0xef, 0x80, 0x21, 0x07, 0xfa, 0x95, 0xe5, 0x07, 0x3b, 0xd6, 0x29, 0x57, 0x28,
-	0xd7, 0x61, 0x96, 0x6b, 0x26, 0x44, 0x53, 0xa3, 0x74, 0x18, 0x84, 0xd3, 0x7c, 0x61, 0x59, 0xdd,
-	0x17, 0xd6, 0xfc, 0x93, 0x0c, 0x54, 0x24, 0x7a, 0x6e, 0x3a, 0x27, 0x84, 0x75, 0xfa, 0x82, 0x5d,
-	0x00, 0x38, 0x70, 0x3c, 0x3f, 0x68, 0x91, 0x9c, 0x46, 0x59, 0x88, 0x35, 0xe8, 0xed, 0x3b, 0x07,
-	0xa5, 0x8e, 0x25, 0x5b, 0x67, 0xa5, 0x73, 0x84, 0x1a, 0x85, 0x4f, 0xef, 0xc0, 0xe9, 0x30, 0x2e,
-	0xfd, 0x73, 0xca, 0xa7, 0xc7, 0x6b, 0xb6, 0x6d, 0xe3, 0x0b, 0x98, 0xf7, 0xd8, 0xa1, 0xe3, 0x07,
-	0x1e, 0xaa, 0x1b, 0x2d, 0x1c, 0x66, 0x7e, 0xec, 0x30, 0xeb, 0x7a, 0xa7, 0x27, 0x56, 0xc0, 0x9a,
-	0xff, 0x3e, 0x0b, 0x0b, 0x21, 0x71, 0x76, 0xfb, 0x56, 0xef, 0x74, 0xbb, 0x77, 0xe0, 0xa6, 0x92,
-	0xe5, 0x5b, 0x50, 0xb7, 0x3a, 0x01, 0xf3, 0x7a, 0x56, 0xe0, 0x1c, 0xb3, 0x96, 0x46, 0x2a, 0x35,
-	0xad, 0xfe, 0x05, 0x51, 0xcd, 0x6b, 0xb6, 0xef, 0x3b, 0x81, 0x1c, 0xb7, 0x2c, 0xf2, 0x16, 0x5c,
-	0x32, 0x4f, 0xba, 0x15, 0x65, 0x11, 0x09, 0x25, 0xe0, 0xe3, 0x90, 0x84, 0xc2, 0x0b, 0x5c, 0xba,
-	0x7c, 0xeb, 0xf4, 0x89, 0x48, 0xf8, 0x5f, 0xfe, 0x6a, 0x6d, 0x27, 0x90, 0x9b, 0x0b, 0xfe, 0xd7,
-	0xa9, 0xb4, 0x18, 0xa5, 0xd2, 0x3b, 0x60, 0xd0, 0xdf, 0x96, 0x65, 0xdb, 0xc8, 0x17, 0x56, 0x87,
-	0xb6, 0x91, 0x79, 0x6a, 0xd9, 0x50, 0x0d, 0xc6, 0x5d, 0x58, 0x88, 0x4c, 0x2c, 0x51, 0xb6, 0xd8,
-	0x48, 0x0c, 0xbd, 0x89, 0xc8, 0x7b, 0x09, 0xf2, 0x81, 0x75, 0xc2, 0x17, 0xa9, 0x4c, 0xee, 0x17,
-	0xeb, 0x64, 0xdb, 0x6e, 0xfe, 0xc5, 0x0c, 0x2c, 0xeb, 0xf3, 0xda, 0x61, 0x01, 0xb3, 0x77, 0x03,
-	0xd6, 0xf7, 0xc5, 0x0c, 0xe0, 0x4c, 0xe3, 0xec, 0x16, 0x4d, 0x59, 0x44, 0xde, 0x14, 0x02, 0xc2,
-	0xc7, 0x89, 0x2d, 0x9a, 0xaa, 0xcc, 0x7b, 0xed, 0x0b, 0x16, 0xc6, 0x19, 0x2d, 0x9a, 0xb2, 0xc8,
-	0xa9, 0x36, 0xb0, 0x3c, 0xe7, 0xe0, 0x00, 0x27, 0xb4, 0x68, 0x52, 0xa9, 0xf9, 0xff, 0xc3, 0x75,
-	0xf9, 0x06, 0x1b, 0x87, 0x1e, 0x63, 0x7c, 0x37, 0xd8, 0x95, 0x66, 0xd2, 0x13, 0x2b, 0xb0, 0x78,
-	0xe1, 0x1b, 0xaf, 0xc3, 0xf7, 0x7c, 0x6e, 0x3e, 0xa1, 0xfb, 0x49, 0xac, 0x77, 0xc1, 0xa7, 0xa6,
-	0x0f, 0x01, 0xd8, 0x49, 0xdf, 0xf1, 0x98, 0xcf, 0x6d, 0x81, 0xec, 0x78, 0x5b, 0x80, 0xa0, 0x37,
-	0x82, 0xe6, 0x5f, 0x9f, 0x81, 0x8b, 0xa3, 0x9f, 0xcf, 0xb5, 0x11, 0xe2, 0x7a, 0xed, 0xd9, 0x40,
-	0x55, 0xfc, 0xf1, 0xe7, 0xa0, 0xc4, 0x09, 0x5e, 0x34, 0x0b, 0x52, 0x2b, 0x62, 0x05, 0x6f, 0xbc,
-	0x07, 0x8b, 0x51, 0x7b, 0x90, 0xf9, 0xa8, 0x2a, 0x09, 0x82, 0x33, 0x22, 0x16, 0x21, 0xf3, 0xb9,
-	0xca, 0x74, 0x1f, 0x96, 0xd4, 0x96, 0x17, 0x76, 0x75, 0x6c, 0xa2, 0xc4, 0x05, 0xd9, 0xa8, 0xde,
-	0x72, 0xdb, 0x36, 0x6e, 0x40, 0xad, 0xef, 0x47, 0xa1, 0x73, 0xe4, 0x95, 0xf6, 0x75, 0xb8, 0x1f,
-	0xc1, 0x7c, 0x04, 0x37, 0xbe, 0xb2, 0xe0, 0xc8, 0xf5, 0xc4, 0x4e, 0x34, 0x72, 0x3d, 0xcc, 0x9a,
-	0xfe, 0x1e, 0x7c, 0xa4, 0x2f, 0xa0, 0x4c, 0xef, 0x80, 0x58, 0x0b, 0x67, 0xc2, 0x5a, 0x12, 0xef,
-	0xfb, 0x8d, 0xd7, 0x69, 0xfe, 0xfd, 0x0c, 0x54, 0x65, 0xa7, 0x3d, 0x24, 0x16, 0xe3, 0x53, 0x28,
-	0x48, 0x45, 0x22, 0x83, 0x0a, 0xe5, 0xd5, 0x04, 0x7a, 0x01, 0x69, 0x5a, 0x01, 0x93, 0x6a, 0x94,
-	0x29, 0xfb, 0x18, 0x9f, 0x43, 0xbe, 0x8f, 0x32, 0x97, 0x68, 0xe4, 0xd6, 0xa8, 0xde, 0xbb, 0x2c,
-	0x08, 0x9c, 0xde, 0xa1, 0x8f, 0x26, 0x05, 0xf5, 0xe3, 0xb4, 0x70, 0xe4, 0x76, 0xf9, 0x42, 0xa2,
-	0x7b, 0x5c, 0x2c, 0x22, 0xf0, 0x2a, 0x13, 0x6b, 0x9a, 0x7f, 0x69, 0x1e, 0x8a, 0x12, 0x59, 0x42,
-	0x00, 0xbf, 0x45, 0xde, 0x4e, 0xf1, 0xf4, 0xa5, 0xc4, 0xd3, 0x35, 0x87, 0xe7, 0xa3, 0x90, 0xfd,
-	0x66, 0x10, 0xfa, 0x7c, 0x8a, 0xa2, 0xa0, 0x04, 0x61, 0xc8, 0x9c, 0x0f, 0x35, 0xe6, 0xac, 0xc5,
-	0x4c, 0x9e, 0xd8, 0xf6, 0xae, 0xb1, 0xed, 0xfd, 0x90, 0x6d, 0xeb, 0x43, 0x3a, 0xd1, 0xce, 0x1c,
-	0x61, 0x68, 0xd2, 0xd8, 0xe6, 0x47, 0xd8, 0xf4, 0xc6, 0xd9, 0x6d, 0xfa, 0x85, 0x69, 0x6c, 0xfa,
-	0x27, 0x50, 0x17, 0xbb, 0x98, 0x72, 0x0e, 0x05, 0xe8, 0xbe, 0x18, 0x8d, 0xa0, 0x8a, 0x7d, 0xa4,
-	0xdb, 0x88, 0x1b, 0xcd, 0x55, 0xc7, 0x6f, 0x1d, 0x5b, 0x41, 0x8b, 0xf5, 0xac, 0xfd, 0x0e, 0xb3,
-	0xd1, 0xa7, 0x51, 0x34, 0x2b, 0x8e, 0xff, 0xd2, 0x0a, 0x9e, 0x8a, 0x3a, 0xe3, 0x73, 0xb8, 0xe0,
-	0xf8, 0xad, 0xb6, 0xdb, 0xed, 0x3a, 0xbe, 0xcf, 0xc5, 0x6f, 0xe0, 0xb6, 0xf8, 0xa2, 0xa9, 0x4e,
-	0xcb, 0xd8, 0x69, 0xd5, 0xf1, 0x37, 0x15, 0xcc, 0x9e, 0xcb, 0x17, 0x57, 0x62, 0x78, 0x08, 0xcb,
-	0x47, 0x96, 0xdf, 0x4a, 0xb2, 0x39, 0xfa, 0x40, 0x8a, 0xe6, 0xe2, 0x91, 0xe5, 0x3f, 0x8f, 0xb3,
-	0x39, 0xd7, 0xbe, 0x79, 0xaf, 0xbe, 0xdf, 0xd7, 0x3a, 0x34, 0x84, 0x59, 0x72, 0x64, 0xf9, 0x3b,
-	0x7e, 0x3f, 0x84, 0xfd, 0x04, 0xca, 0xb8, 0x6d, 0x13, 0xbd, 0xaf, 0xe2, 0x54, 0x9c, 0x4b, 0xac,
-	0x6a, 0xa8, 0x86, 0x98, 0xd0, 0x09, 0x55, 0x92, 0x73, 0x50, 0x72, 0x04, 0x2b, 0x33, 0x1b, 0xbd,
-	0x1d, 0x45, 0xb3, 0xe8, 0x20, 0x63, 0x32, 0xdb, 0x78, 0x01, 0xb5, 0xe8, 0x01, 0x98, 0xdf, 0x38,
-	0x1f, 0x73, 0x19, 0x48, 0xf4, 0xeb, 0x3b, 0xfa, 0x99, 0x18, 0x1d, 0xd6, 0x54, 0x23, 0x07, 0x65,
-	0x42, 0x43, 0x93, 0x32, 0x41, 0xb8, 0xd3, 0x2f, 0x08, 0x77, 0x8c, 0xaa, 0x45, 0x47, 0xfa, 0x7b,
-	0xb0, 0x12, 0x82, 0xf9, 0xfc, 0xe7, 0xd8, 0xb1, 0x5a, 0xa8, 0xcf, 0x5c, 0x14, 0x93, 0xa6, 0x9a,
-	0x77, 0x59, 0x2f, 0x78, 0xe9, 0x58, 0xcf, 0xb9, 0x7a, 0x83, 0x3e, 0x43, 0xa7, 0xd3, 0x0a, 0x3c,
-	0xab, 0xcd, 0xe9, 0xb6, 0xd5, 0x71, 0x7a, 0xaf, 0xe8, 0xf4, 0xa0, 0xce, 0x5b, 0xf6, 0xa8, 0xe1,
-	0x2b, 0xa7, 0xf7, 0x0a, 0x2d, 0xbf, 0x07, 0xad, 0xf0, 0x39, 0xa8, 0x3d, 0x88, 0xe3, 0x84, 0x9a,
-	0xff, 0x40, 0x89, 0x2e, 0xd4, 0x1e, 0xde, 0x01, 0x43, 0xcc, 0x6e, 0xab, 0xed, 0xfa, 0xca, 0x1b,
-	0x79, 0x45, 0x78, 0x23, 0x45, 0xcb, 0xa6, 0xeb, 0x4b, 0x6f, 0xe4, 0x3d, 0x58, 0xd4, 0xa1, 0x95,
-	0x76, 0x2b, 0x8e, 0x1a, 0x8c, 0x10, 0x5e, 0xf9, 0x46, 0x6f, 0xc3, 0x3c, 0xf9, 0x46, 0x79, 0x2f,
-	0x42, 0x7f, 0x15, 0xd1, 0xd7, 0x84, 0x6b, 0xd4, 0x1d, 0x48, 0xec, 0x1f, 0xc1, 0xaa, 0xe7, 0xe2,
-	0xdc, 0xb7, 0xc8, 0x29, 0xdd, 0x0a, 0x8e, 0x3c, 0xe6, 0x1f, 0xb9, 0x1d, 0x1b, 0x0f, 0x1d, 0x32,
-	0xe6, 0x0a, 0x01, 0x98, 0xa2, 0x7d, 0x4f, 0x36, 0xf3, 0x37, 0x8b, 0xf7, 0xb5, 0xad, 0x53, 0x1f,
-	0x0f, 0x23, 0x72, 0xa6, 0x11, 0xed, 0xf6, 0xc4, 0x3a, 0xf5, 0x8d, 0x23, 0x78, 0x37, 0xde, 0x43,
-	0x33, 0x3b, 0x03, 0xcf, 0xea, 0xf9, 0x16, 0x7a, 0x55, 0x7c, 0xed, 0x2d, 0x6e, 0xe0, 0x5b, 0xdc,
-	0x89, 0xa2, 0x0b, 0x0d, 0xd2, 0x3d, 0xad, 0x57, 0xf8, 0x6e, 0x77, 0x61, 0xd1, 0x09, 0x58, 0xb7,
-	0xc5, 0x27, 0x42, 0x9f, 0xe5, 0x9b, 0x88, 0x6c, 0x9e, 0xb7, 0x3d, 0x77, 0x7a, 0xda, 0x34, 0x3f,
-	0x80, 0xe5, 0x68, 0x07, 0x35, 0xd1, 0xb7, 0xe8, 0x8c, 0x26, 0xec, 0xa2, 0x66, 0xfa, 0x2d, 0xa8,
-	0xb7, 0x59, 0x2f, 0xf0, 0x9c, 0x83, 0xc1, 0xa1, 0xdb, 0x12, 0xc7, 0x54, 0x6f, 0x89, 0x45, 0x0f,
-	0xeb, 0xf7, 0xf0, 0xc0, 0xca, 0x82, 0x86, 0x46, 0x85, 0x6a, 0xbf, 0xc5, 0x33, 0xbb, 0xb7, 0x91,
-	0xc9, 0x6e, 0x4e, 0xb8, 0xe3, 0x99, 0xcb, 0x56, 0xba, 0xbe, 0xf1, 0x1e, 0xd7, 0x30, 0x59, 0xdf,
-	0x6f, 0xac, 0xc7, 0xfc, 0x4e, 0xe9, 0x9a, 0x9a, 0x29, 0xa0, 0x51, 0x85, 0x0c, 0xd9, 0x88, 0x75,
-	0xfb, 0x1d, 0xae, 0xa5, 0xde, 0x25, 0x15, 0x52, 0xb1, 0x12, 0x35, 0x18, 0x9f, 0x81, 0x38, 0x01,
-	0x3c, 0x66, 0xb6, 0xd0, 0xcb, 0xef, 0x8d, 0x95, 0x96, 0x15, 0xd9, 0x81, 0xeb, 0xe4, 0xc6, 0xd7,
-	0xb0, 0x2c, 0x24, 0x7e, 0x0b, 0x05, 0x8d, 0x26, 0xb8, 0xdf, 0x1d, 0x8b, 0x69, 0x41, 0xf4, 0xe4,
-	0xd2, 0xe7, 0x1b, 0x25, 0xc2, 0xaf, 0x40, 0x05, 0xc5, 0x9b, 0xf0, 0x0c, 0xf9, 0x8d, 0xfb, 0xc8,
-	0xd5, 0x65, 0x2e, 0xd9, 0xa8, 0x0a, 0x75, 0xfb, 0x90, 0x37, 0x85, 0xd2, 0xfb, 0x80, 0x74, 0x7b,
-	0xc5, 0x9b, 0x42, 0xe3, 0xbd, 0x07, 0x8b, 0x5d, 0xa7, 0xe7, 0x74, 0xad, 0x8e, 0xe4, 0x20, 0x3c,
-	0x7a, 0x68, 0x3c, 0xbc, 0x9c, 0xb9, 0x95, 0x35, 0x0d, 0x6a, 0x13, 0x4c, 0xf4, 0x15, 0x6f, 0x31,
-	0xee, 0x2a, 0x0d, 0xf5, 0x3d, 0x1c, 0xc0, 0xca, 0x30, 0xed, 0x80, 0xc0, 0xb8, 0x14, 0xef, 0x5a,
-	0xbd, 0x81, 0x7a, 0x82, 0xaf, 0x36, 0x80, 0x47, 0x42, 0x20, 0x89, 0x56, 0xf1, 0x0c, 0x9f, 0x64,
-	0xff, 0x9a, 0x05, 0x0b, 0x29, 0x52, 0x31, 0xc5, 0x3f, 0xf1, 0x50, 0xf7, 0x4f, 0x94, 0xef, 0x5f,
-	0x4c, 0xbc, 0x4e, 0x04, 0x8d, 0xee, 0xbf, 0xf8, 0x1c, 0xd6, 0x76, 0x4f, 0xfd, 0x80, 0x75, 0xd1,
-	0x51, 0xe5, 0xb4, 0xd1, 0x12, 0xd8, 0xc5, 0x29, 0x67, 0x3e, 0xb7, 0x4c, 0x0e, 0x3c, 0xb7, 0x8b,
-	0x8f, 0xca, 0x99, 0xf8, 0x9f, 0x6b, 0x2a, 0x81, 0x8b, 0x0f, 0xca, 0x99, 0xd9, 0xc0, 0x6d, 0xfe,
-	0xc7, 0x2c, 0x54, 0xf4, 0xce, 0x09, 0x55, 0xa6, 0x01, 0x85, 0x2e, 0xf3, 0x7d, 0xeb, 0x50, 0x99,
-	0x4e, 0x54, 0x8c, 0xbb, 0x04, 0x67, 0x13, 0x2e, 0xc1, 0x15, 0x28, 0xe0, 0x6e, 0xa9, 0x74, 0xd4,
-	0x3c, 0x2f, 0x6e, 0xdb, 0x72, 0xd7, 0xc1, 0x37, 0x47, 0xa5, 0x54, 0xec, 0x3a, 0x58, 0xa6, 0xc3,
-	0x7b, 0x8f, 0x59, 0x36, 0x6a, 0x96, 0x78, 0x78, 0x6f, 0x32, 0xcb, 0x36, 0x3e, 0x83, 0xa2, 0x4f,
-	0x43, 0x43, 0xab, 0x4a, 0x57, 0x0a, 0x87, 0xcf, 0x82, 0xa9, 0x3a, 0xc5, 0x14, 0x96, 0xd2, 0xd9,
-	0x15, 0x16, 0x98, 0x42, 0x61, 0x69, 0x76, 0xa1, 0x8e, 0xce, 0xcf, 0x1d, 0x3a, 0x89, 0xde, 0x62,
-	0xba, 0x65, 0x9f, 0x41, 0x2a, 0x4d, 0x8b, 0x72, 0xc9, 0xc6, 0xbc, 0x21, 0xd7, 0xa1, 0xca, 0x0e,
-	0x0e, 0x58, 0x1b, 0x8d, 0x5d, 0xcf, 0x22, 0x53, 0x36, 0x6b, 0xce, 0xa9, 0x5a, 0xae, 0xcd, 0x36,
-	0x0f, 0xa0, 0x88, 0x8f, 0xdb, 0xb3, 0x4e, 0xd4, 0x31, 0x79, 0x46, 0x3b, 0x26, 0x37, 0x60, 0x16,
-	0x3b, 0x0b, 0x97, 0x02, 0xfe, 0x3f, 0x4b, 0xd0, 0x4d, 0xf3, 0x5b, 0x58, 0xc0, 0xe7, 0x3c, 0x16,
-	0x2b, 0xb0, 0x41, 0xf6, 0xad, 0x66, 0x4f, 0x67, 0xa2, 0xf6, 0xb4, 0xb4, 0x93, 0xb3, 0x9a, 0x9d,
-	0x7c, 0x09, 0xca, 0x7d, 0xd7, 0x0f, 0xac, 0x4e, 0xab, 0xed, 0xda, 0x92, 0xc0, 0x40, 0x54, 0x6d,
-	0xba, 0x36, 0x0b, 0x8d, 0xf0, 0x59, 0xcd, 0x08, 0x6f, 0xfe, 0xa3, 0x59, 0x28, 0xa9, 0xc0, 0x81,
-	0x04, 0xc5, 0x2e, 0x43, 0xde, 0xdd, 0xe7, 0x62, 0x84, 0x1e, 0x45, 0x25, 0xfe, 0x30, 0x76, 0x82,
-	0x7e, 0x81, 0x4e, 0x68, 0x97, 0x81, 0xac, 0xda, 0x0e, 0x7d, 0x5f, 0xb3, 0x69, 0xbe, 0xaf, 0x9c,
-	0xee, 0x4a, 0xe1, 0x6b, 0x81, 0xca, 0x06, 0x46, 0xfd, 0x38, 0xcc, 0x26, 0x2a, 0x9e, 0xc3, 0xda,
-	0x97, 0x54, 0x19, 0xba, 0xc8, 0x0a, 0xba, 0x8b, 0xec, 0x3a, 0x54, 0xf1, 0x4f, 0xd8, 0x59, 0x78,
-	0x9c, 0xe7, 0xb0, 0x56, 0x75, 0xe6, 0xc3, 0xea, 0x93, 0x67, 0x20, 0xeb, 0xf4, 0xf9, 0xb0, 0xf0,
-	0x48, 0x87, 0x91, 0xf5, 0x4f, 0x25, 0x6e, 0x40, 0x48, 0x5f, 0x43, 0x39, 0x66, 0x40, 0xa4, 0x2c,
-	0x50, 0xe8, 0x89, 0xf8, 0x44, 0x8b, 0x69, 0xa9, 0xa0, 0x5a, 0x77, 0x39, 0x19, 0x95, 0x31, 0x34,
-	0x94, 0xe5, 0x02, 0x40, 0xc0, 0xda, 0x47, 0x91, 0xd0, 0x23, 0xf4, 0x5f, 0x2a, 0x67, 0x39, 0xf9,
-	0xd4, 0x7b, 0xec, 0xb5, 0x34, 0xa2, 0xc4, 0x51, 0x6b, 0x4d, 0x34, 0xbc, 0x60, 0xaf, 0x85, 0x25,
-	0xc5, 0xf5, 0xbd, 0x04, 0x2c, 0xe1, 0x15, 0x4e, 0xe4, 0xc5, 0x58, 0x0f, 0x7c, 0xc4, 0x1b, 0x85,
-	0x25, 0x34, 0xff, 0x78, 0x15, 0x72, 0xe9, 0x07, 0x1d, 0x06, 0xcc, 0x62, 0xd8, 0x09, 0x91, 0x29,
-	0xff, 0x6f, 0x5c, 0x86, 0xb2, 0xa6, 0xeb, 0x10, 0xe5, 0xe8, 0x55, 0x1a, 0xcd, 0xcd, 0x46, 0x68,
-	0x2e, 0xb4, 0x9d, 0x48, 0x02, 0x92, 0xed, 0x84, 0x27, 0x93, 0x22, 0x12, 0x88, 0xda, 0xf3, 0xf2,
-	0x64, 0x12, 0x6b, 0x85, 0xf4, 0x9a, 0x20, 0x48, 0x2d, 0x2a, 0xd3, 0x8a, 0x67, 0x97, 0x69, 0xa5,
-	0x69, 0x8c, 0xb0, 0x8f, 0xa1, 0x2c, 0x0e, 0x12, 0x26, 0x95, 0x87, 0x20, 0xc1, 0x37, 0x84, 0x54,
-	0xa1, 0x12, 0x52, 0x6c, 0xd1, 0x54, 0x65, 0xe3, 0x2e, 0x2c, 0x88, 0xff, 0x1d, 0xe1, 0xef, 0xf2,
-	0x98, 0xe5, 0xbb, 0x3d, 0x3a, 0xd8, 0x30, 0xf4, 0x26, 0x13, 0x5b, 0x38, 0x32, 0x71, 0xf0, 0xc0,
-	0x6c, 0xa4, 0xc2, 0xa2, 0xa9, 0xca, 0xfc, 0x2d, 0xe5, 0x7f, 0xfe, 0x96, 0xd5, 0xf1, 0x6f, 0x29,
-	0xc1, 0x37, 0x30, 0xa8, 0x40, 0xc6, 0x49, 0xe9, 0xb4, 0x58, 0xa1, 0x4a, 0x41, 0xe6, 0x1a, 0x90,
-	0x60, 0xf4, 0x7a, 0x04, 0x68, 0x47, 0xf2, 0x7b, 0x2c, 0x30, 0x6b, 0x7e, 0x82, 0xc0, 0x2c, 0x23,
-	0x11, 0x98, 0xf5, 0x36, 0x84, 0xda, 0x1d, 0x97, 0x1d, 0xdc, 0xda, 0xa4, 0x73, 0xfe, 0x50, 0x59,
-	0x7a, 0x29, 0xea, 0xa3, 0x4a, 0xa2, 0xd5, 0x6e, 0xb3, 0x7e, 0xc0, 0x6c, 0x8a, 0x86, 0x0b, 0xd1,
-	0x6c, 0x50, 0x03, 0x7f, 0x38, 0xf1, 0xa0, 0xcf, 0x25, 0x8c, 0x30, 0x86, 0x41, 0x54, 0xed, 0x72,
-	0x29, 0x13, 0x32, 0x34, 0x07, 0xa0, 0x29, 0x59, 0x16, 0x1a, 0x59, 0x08, 0x26, 0x66, 0xe5, 0x1d,
-	0xc8, 0x8b, 0x00, 0x29, 0x3a, 0xe8, 0x5f, 0x8c, 0xca, 0x95, 0x6d, 0x11, 0x3c, 0x45, 0x30, 0x5c,
-	0x7f, 0x0b, 0xdc, 0xc0, 0xea, 0xc4, 0xa3, 0x3d, 0x1a, 0xb8, 0x15, 0x19, 0xd8, 0x16, 0x8d, 0xf7,
-	0xd0, 0xb7, 0xa5, 0xd5, 0xd8, 0x2e, 0x29, 0xe3, 0xcc, 0xd6, 0xc6, 0xc4, 0x99, 0x3d, 0x85, 0x1a,
-	0x35, 0xb5, 0xa4, 0xf4, 0x3c, 0x37, 0x81, 0xf4, 0xac, 0xee, 0x47, 0xb7, 0xbb, 0xab, 0x30, 0x13,
-	0x58, 0x27, 0x78, 0x90, 0x5f, 0xbe, 0x3f, 0x1f, 0xed, 0xba, 0x67, 0x9d, 0x98, 0xbc, 0xd5, 0x78,
-	0x9c, 0x08, 0x24, 0xbd, 0x10, 0xb3, 0xd2, 0x23, 0x0a, 0x1e, 0x76, 0x8e, 0x47, 0x99, 0xde, 0x82,
-	0x1c, 0x37, 0x68, 0xc4, 0xe9, 0x7e, 0x62, 0x60, 0xe8, 0xba, 0x12, 0x00, 0xc6, 0x07, 0x90, 0x17,
-	0x64, 0x8c, 0xb6, 0x6f, 0x42, 0xaa, 0xeb, 0x3a, 0x92, 0x38, 0x99, 0x33, 0x09, 0xde, 0xf8, 0x40,
-	0xdb, 0x11, 0xc4, 0x41, 0x7e, 0x6c, 0x32, 0x86, 0xee, 0x06, 0x2f, 0x52, 0x02, 0x1b, 0xaf, 0xc4,
-	0xfc, 0x76, 0x02, 0xc3, 0x64, 0xb1, 0x8c, 0x77, 0xa1, 0x40, 0xd6, 0x01, 0x1d, 0xe7, 0x2f, 0xa5,
-	0x9e, 0x27, 0x9b, 0x12, 0xca, 0xb8, 0xc5, 0x5f, 0x40, 0xc4, 0x1c, 0xab, 0x80, 0x5f, 0x11, 0xab,
-	0x57, 0xed, 0x6b, 0x1d, 0xb6, 0x6d, 0xe3, 0x26, 0xd4, 0x24, 0xa4, 0x3c, 0xc9, 0xb9, 0x16, 0x01,
-	0x94, 0x67, 0xb8, 0xdf, 0xc0, 0xaa, 0x04, 0x44, 0xbb, 0x87, 0x5c, 0xba, 0x42, 0x96, 0x5c, 0x1f,
-	0x2b, 0x4b, 0x96, 0xa9, 0x33, 0x37, 0x7d, 0x4c, 0xd9, 0x75, 0x23, 0x30, 0x9e, 0x81, 0x7c, 0x90,
-	0x8c, 0x82, 0xbd, 0x81, 0x13, 0x75, 0x25, 0x31, 0x51, 0x08, 0xa4, 0x07, 0xbf, 0xce, 0xf5, 0xf5,
-	0x3a, 0xe3, 0x27, 0x70, 0x31, 0x4a, 0x56, 0x34, 0xf4, 0x76, 0xc7, 0xf5, 0xc5, 0x5b, 0xde, 0x1c,
-	0xfb, 0x96, 0x6b, 0xfd, 0x04, 0xe5, 0x6d, 0x62, 0xf7, 0x8d, 0xc0, 0xb8, 0x01, 0x35, 0x8a, 0xa2,
-	0x95, 0x63, 0x47, 0xd3, 0xba, 0x68, 0xce, 0x89, 0x68, 0x5a, 0x19, 0xef, 0x7d, 0x05, 0x2a, 0x14,
-	0xb1, 0x26, 0x18, 0xf7, 0x2d, 0x64, 0xdc, 0x32, 0xd6, 0x11, 0xc7, 0x7e, 0x06, 0xe7, 0x63, 0xaf,
-	0x2a, 0xc2, 0x8b, 0xe5, 0x0a, 0xdc, 0xc6, 0x15, 0x58, 0x8d, 0xbc, 0xcc, 0x0e, 0x87, 0x90, 0x8b,
-	0xc1, 0x60, 0x35, 0x86, 0x20, 0x38, 0xe9, 0xc9, 0x09, 0x7c, 0x3b, 0x2d, 0x8c, 0x38, 0xca, 0x53,
-	0x7b, 0x27, 0x3d, 0x7d, 0x26, 0x97, 0xfb, 0xa9, 0x8d, 0xc6, 0x1e, 0x7a, 0x7a, 0xf0, 0x31, 0x18,
-	0xd1, 0xe5, 0x3b, 0x01, 0xf3, 0x1b, 0xef, 0xc4, 0x9c, 0x5e, 0x11, 0xfc, 0xa6, 0x82, 0x13, 0xa8,
-	0xe7, 0xfb, 0xf1, 0x7a, 0x63, 0x0f, 0x56, 0xc5, 0x39, 0x04, 0xda, 0xdf, 0xad, 0xc0, 0x6d, 0x89,
-	0x20, 0xd5, 0x5e, 0x7f, 0x10, 0x34, 0xee, 0x8c, 0x5d, 0xa3, 0x25, 0xd1, 0x99, 0xdb, 0xe2, 0x7b,
-	0xee, 0x96, 0xeb, 0x75, 0xb7, 0x79, 0x47, 0xe3, 0x63, 0x58, 0x43, 0xeb, 0x4a, 0x1e, 0x27, 0x71,
-	0xc6, 0x09, 0xe3, 0xd0, 0xd6, 0x71, 0xa5, 0x56, 0x38, 0x04, 0xc9, 0x2a, 0x74, 0x45, 0xc8, 0x68,
-	0x34, 0x3d, 0xd8, 0xf9, 0x6e, 0x2c, 0xd8, 0xf9, 0xc7, 0xb0, 0xe4, 0xf8, 0x22, 0x24, 0x82, 0xe4,
-	0x84, 0x8f, 0x6e, 0xb8, 0xc6, 0x3d, 0x9c, 0x87, 0x9b, 0xb1, 0x79, 0xd8, 0xf6, 0x75, 0x91, 0xe2,
-	0xef, 0xb2, 0x5e, 0x20, 0x66, 0x62, 0xc1, 0x49, 0xb6, 0x18, 0x5f, 0xc1, 0x02, 0x19, 0x04, 0x2d,
-	0x8f, 0xf9, 0x81, 0xe7, 0x08, 0x95, 0xea, 0xdd, 0x98, 0x40, 0xdc, 0x14, 0x30, 0x66, 0x08, 0x62,
-	0x1a, 0xed, 0x44, 0x1d, 0x27, 0x3d, 0x89, 0x0d, 0x0d, 0x88, 0xfb, 0x42, 0x41, 0xa2, 0x3a, 0xb4,
-	0x20, 0xce, 0x41, 0xa9, 0x6f, 0x79, 0x4c, 0xd8, 0xa8, 0x0f, 0xe8, 0x44, 0x1a, 0x2b, 0xb6, 0x6d,
-	0x63, 0x0b, 0xe6, 0xa9, 0x51, 0xf3, 0x26, 0x3f, 0x1c, 0xbb, 0x22, 0x35, 0xd1, 0x29, 0x74, 0x27,
-	0x4b, 0x43, 0xeb, 0x3d, 0xcd, 0xd0, 0xba, 0x05, 0x75, 0x72, 0x31, 0xdb, 0x8c, 0x4f, 0x2c, 0x1f,
-	0xa6, 0x70, 0x17, 0x54, 0xd1, 0xc9, 0xfc, 0x44, 0xd6, 0xf2, 0x51, 0xd0, 0xe4, 0x0b, 0xaf, 0xe8,
-	0x53, 0x31, 0x0a, 0xaa, 0xdb, 0x4b, 0x09, 0x6e, 0xde, 0x4a, 0x04, 0x37, 0x1b, 0x30, 0xfb, 0x8a,
-	0x9d, 0xfa, 0x8d, 0x2f, 0x70, 0x31, 0xf1, 0x3f, 0x57, 0xac, 0x1d, 0xbf, 0xf5, 0x8a, 0x9d, 0xca,
-	0xb0, 0x47, 0x5a, 0x54, 0x66, 0x37, 0x9e, 0x09, 0xbf, 0x85, 0xe3, 0x7f, 0xc9, 0x4e, 0x29, 0xf0,
-	0xf1, 0x05, 0xb5, 0x89, 0x08, 0x58, 0xa1, 0x88, 0x38, 0x76, 0x63, 0x5b, 0x46, 0xc0, 0x62, 0xcd,
-	0xb6, 0xfd, 0xbd, 0x87, 0x03, 0xaf, 0x7d, 0x0e, 0x46, 0x52, 0x38, 0x4e, 0x85, 0x61, 0x1b, 0xce,
-	0x8d, 0x90, 0x0e, 0x53, 0xa1, 0x7a, 0x02, 0xcb, 0xe9, 0x82, 0x60, 0x2a, 0x2c, 0x5b, 0xd0, 0x18,
-	0xc6, 0x46, 0xe3, 0xf0, 0x14, 0x75, 0xb3, 0xe6, 0xe7, 0x19, 0x30, 0x92, 0xac, 0x63, 0x5c, 0x84,
-	0xb2, 0xe3, 0xbb, 0xc8, 0x22, 0x2d, 0xeb, 0xbe, 0xbc, 0x92, 0xe3, 0xf8, 0x2e, 0xe7, 0x90, 0x8d,
-	0xfb, 0xc6, 0x5b, 0x50, 0x27, 0xfa, 0xf7, 0x65, 0xd8, 0x31, 0xe1, 0x96, 0x07, 0x00, 0x3e, 0x85,
-	0x1b, 0x73, 0x65, 0xb6, 0x7d, 0x64, 0xf5, 0x0e, 0x99, 0x02, 0x14, 0xc7, 0xbf, 0x73, 0xa2, 0x96,
-	0xc0, 0x9a, 0xff, 0x7b, 0x86, 0x2c, 0x74, 0xae, 0x99, 0x4c, 0x6c, 0xa1, 0xd7, 0x61, 0xc6, 0x7f,
-	0x35, 0x20, 0xfb, 0x8a, 0xff, 0x4d, 0x35, 0xc9, 0x63, 0x46, 0x51, 0x2e, 0x69, 0x14, 0x85, 0xfe,
-	0x8c, 0xfc, 0x50, 0x7f, 0x46, 0x21, 0xa6, 0x38, 0x2e, 0x43, 0xde, 0xe9, 0x5a, 0x87, 0xe8, 0x5b,
-	0xe2, 0x2c, 0x44, 0x25, 0xfe, 0x4e, 0x5c, 0x1d, 0x17, 0x76, 0x38, 0xff, 0x1b, 0x31, 0x9c, 0x21,
-	0xcd, 0x70, 0xe6, 0x63, 0x1e, 0xaa, 0x2a, 0x45, 0x0d, 0xb6, 0xf2, 0xd9, 0x0d, 0xb6, 0xca, 0x34,
-	0x06, 0x5b, 0x4c, 0x7e, 0xcc, 0xa5, 0xc9, 0x0f, 0x94, 0xa0, 0x55, 0xf2, 0xce, 0xb8, 0x36, 0x7b,
-	0x33, 0x0b, 0xfb, 0x4b, 0x98, 0x13, 0x6e, 0x2f, 0xeb, 0xd0, 0xe9, 0x59, 0x01, 0x7a, 0x52, 0xda,
-	0xca, 0xe5, 0x95, 0x33, 0x45, 0xc1, 0xb8, 0x26, 0xf5, 0xda, 0x2c, 0xce, 0x64, 0x35, 0x3a, 0x93,
-	0xa4, 0xd3, 0x36, 0xff, 0xee, 0x0c, 0x18, 0x49, 0x1d, 0x39, 0xcd, 0x76, 0x4f, 0x04, 0x0c, 0x8d,
-	0xf5, 0xfa, 0x3c, 0x84, 0x3c, 0xe9, 0x11, 0xb3, 0x31, 0x03, 0x60, 0x27, 0xaa, 0x8e, 0x70, 0x18,
-	0x93, 0x60, 0xb9, 0x3d, 0x24, 0xb7, 0x0c, 0xe1, 0xc7, 0x0c, 0xbd, 0x9c, 0x92, 0x67, 0x84, 0x4f,
-	0x72, 0x1b, 0xdd, 0x40, 0x87, 0x9e, 0x3b, 0x90, 0x51, 0x23, 0xa2, 0x80, 0xae, 0x2d, 0xeb, 0x98,
-	0x49, 0x2f, 0xa7, 0x28, 0x18, 0xeb, 0x30, 0xdb, 0xb6, 0x3c, 0x5b, 0x59, 0xf2, 0xa9, 0xef, 0xb2,
-	0x69, 0x79, 0xb6, 0x89, 0x70, 0xfc, 0xed, 0x5f, 0x5b, 0x9d, 0x0e, 0x93, 0x06, 0xfc, 0x90, 0xb7,
-	0xff, 0x2d, 0x84, 0x31, 0x09, 0x96, 0x5b, 0x3f, 0x6d, 0xef, 0xb4, 0x1f, 0xb8, 0xd1, 0xb0, 0xfd,
-	0xa1, 0xdd, 0x37, 0x11, 0xd8, 0xac, 0x8a, 0x4e, 0x9b, 0xda, 0xc5, 0xbb, 0x23, 0xab, 0x67, 0x77,
-	0x98, 0x47, 0xd1, 0x26, 0xb2, 0xd8, 0xfc, 0xdb, 0x99, 0x98, 0xf3, 0x9b, 0x14, 0xac, 0x51, 0xe1,
-	0x5e, 0x97, 0xa0, 0x1c, 0x30, 0xaf, 0xeb, 0xd0, 0x4a, 0x51, 0x88, 0xa9, 0xac, 0xda, 0x46, 0xb1,
-	0x21, 0x42, 0x37, 0x69, 0x15, 0xa9, 0xc4, 0x55, 0x7a, 0x0a, 0xf2, 0x94, 0xb1, 0x92, 0xb4, 0x12,
-	0x55, 0x51, 0xbd, 0x49, 0xb5, 0xc6, 0x0a, 0x14, 0xac, 0xbe, 0xa3, 0x42, 0x21, 0x4a, 0x66, 0xde,
-	0xea, 0x3b, 0xdf, 0x78, 0x9d, 0xe6, 0x9f, 0x64, 0x61, 0x6e, 0x47, 0x5f, 0xb5, 0x89, 0x48, 0xab,
-	0x01, 0x05, 0x12, 0xb6, 0xd2, 0x35, 0x4e, 0x45, 0xe3, 0x2e, 0x2c, 0x90, 0x6a, 0xcb, 0x0d, 0xcc,
-	0x98, 0x0f, 0xd5, 0x08, 0x9b, 0xf4, 0x9b, 0x22, 0x5a, 0x87, 0x3e, 0xf3, 0x1c, 0x57, 0x92, 0x53,
-	0x3d, 0x6c, 0xd8, 0xc1, 0x7a, 0x72, 0x9f, 0x5b, 0xe8, 0xf3, 0x0d, 0xdd, 0xe7, 0x1b, 0x58, 0x8e,
-	0xc9, 0x97, 0xc2, 0xd9, 0xe5, 0x4b, 0x71, 0x1a, 0xf9, 0xa2, 0x51, 0x42, 0x29, 0x4a, 0x09, 0xbf,
-	0xcc, 0xc0, 0x7c, 0x82, 0x78, 0xf9, 0x52, 0xe2, 0x89, 0xfc, 0x23, 0x9a, 0x62, 0x2a, 0x71, 0xa6,
-	0xe0, 0xc6, 0xd6, 0x43, 0x29, 0x4f, 0xb0, 0xc0, 0xa1, 0xbb, 0x96, 0xff, 0x8a, 0x49, 0xf6, 0xa5,
-	0x12, 0x57, 0x9c, 0x50, 0x37, 0x3e, 0x6d, 0x75, 0xdd, 0x5e, 0x70, 0x44, 0xf3, 0x5b, 0x16, 0x75,
-	0xcf, 0x79, 0x95, 0x60, 0x7f, 0x04, 0x39, 0x65, 0x96, 0x47, 0x53, 0x2a, 0x82, 0x88, 0x4e, 0x7f,
-	0xc8, 0x2c, 0x8c, 0x07, 0xdc, 0xf7, 0xac, 0x9e, 0xbc, 0x1f, 0x2a, 0x0a, 0x7c, 0x8f, 0x39, 0x70,
-	0x7a, 0x87, 0xcc, 0xeb, 0x7b, 0x8e, 0x8a, 0xf5, 0xd3, 0xab, 0x38, 0x25, 0xfb, 0xac, 0x3d, 0xf0,
-	0xd8, 0x03, 0xe9, 0xbf, 0x55, 0xe5, 0xe6, 0xd3, 0x18, 0xf1, 0x0b, 0xee, 0x0b, 0x1f, 0x95, 0xd1,
-	0x1f, 0xa5, 0xdd, 0x5e, 0xcd, 0x46, 0x6e, 0xaf, 0x26, 0xd0, 0x08, 0x2e, 0x1c, 0x81, 0x86, 0x1c,
-	0x19, 0xd9, 0x48, 0xc8, 0x59, 0xf3, 0x5f, 0x64, 0xb8, 0xd2, 0x45, 0x0a, 0x93, 0xee, 0x53, 0x88,
-	0xd3, 0xf8, 0x1a, 0x14, 0x25, 0xb7, 0xc9, 0xd3, 0x07, 0x59, 0x46, 0x83, 0xc1, 0xf2, 0xfd, 0xd7,
-	0xae, 0x27, 0x17, 0x41, 0x95, 0xa3, 0xd1, 0xcb, 0x12, 0x68, 0x36, 0x16, 0xbd, 0x2c, 0x81, 0xa3,
-	0xf4, 0x99, 0x9b, 0x82, 0x3e, 0x9b, 0x7f, 0x90, 0x57, 0x5c, 0x3a, 0x64, 0x04, 0x69, 0x5c, 0xaa,
-	0xa4, 0xef, 0x8c, 0x2e, 0x7d, 0x63, 0xdb, 0x42, 0x2e, 0xb1, 0x2d, 0xa4, 0x5f, 0x58, 0x2a, 0x4c,
-	0x75, 0x61, 0xa9, 0x38, 0xe4, 0xc2, 0x92, 0x34, 0x17, 0x4a, 0x9a, 0xb9, 0xa0, 0x85, 0xaf, 0x7a,
-	0xec, 0x90, 0x9d, 0xf4, 0xc9, 0xcd, 0x2f, 0xc3, 0x57, 0x4d, 0xac, 0x8c, 0xf2, 0x7e, 0x39, 0xc6,
-	0xfb, 0xa9, 0x9b, 0x52, 0x25, 0x7d, 0x53, 0x7a, 0x0e, 0x73, 0x01, 0xf3, 0x83, 0x96, 0x4f, 0xd1,
-	0x4f, 0x78, 0x69, 0x4a, 0x8f, 0x94, 0x8a, 0xcc, 0xf4, 0xfa, 0x1e, 0xf3, 0x03, 0x19, 0x28, 0x25,
-	0x54, 0x9a, 0x4a, 0xa0, 0x55, 0x19, 0x2d, 0x58, 0x20, 0x23, 0xc3, 0x71, 0x7b, 0x21, 0xd2, 0x2a,
-	0x22, 0x5d, 0x1f, 0x82, 0x74, 0x47, 0xf5, 0x88, 0xa2, 0x36, 0xfa, 0x89, 0x86, 0x18, 0xdd, 0xd4,
-	0xce, 0x2e, 0xd7, 0xea, 0x53, 0xc8, 0xb5, 0xb5, 0xdf, 0x85, 0xf9, 0xc4, 0xc8, 0x53, 0xd4, 0xa0,
-	0xfb, 0xd1, 0x13, 0xdc, 0xd1, 0x2a, 0x84, 0xa6, 0xf7, 0xb7, 0x61, 0x65, 0xc8, 0x1c, 0x7c, 0x77,
-	0x0f, 0x69, 0xfe, 0xc1, 0x0c, 0x40, 0x18, 0x9f, 0x94, 0xe0, 0x19, 0x8d, 0x68, 0xc8, 0xb5, 0xa2,
-	0x36, 0xdf, 0x5a, 0xc4, 0x4d, 0xb2, 0x6d, 0xc7, 0xae, 0xf6, 0xcf, 0xc4, 0xaf, 0xf6, 0x7f, 0x94,
-	0xf0, 0xd2, 0x84, 0xb1, 0x53, 0x28, 0x10, 0x32, 0xe6, 0x4a, 0x04, 0xa5, 0xf6, 0x5a, 0xd7, 0xc5,
-	0xc5, 0x5f, 0xad, 0x43, 0x0e, 0x3b, 0xcc, 0xf5, 0xfd, 0xbe, 0x06, 0xf6, 0x3e, 0x34, 0x84, 0xb7,
-	0x38, 0x19, 0x95, 0x45, 0xca, 0xfe, 0x12, 0xb6, 0xc7, 0x03, 0xb2, 0x38, 0x11, 0xf8, 0x81, 0xe5,
-	0x05, 0x22, 0x06, 0x62, 0x82, 0x7d, 0x11, 0xa1, 0x31, 0x00, 0xe2, 0x7b, 0x39, 0x63, 0x69, 0x3e,
-	0x02, 0xe0, 0x5b, 0xe5, 0x53, 0x74, 0xfe, 0x70, 0x29, 0x26, 0xf6, 0x38, 0x12, 0xfa, 0x58, 0xe0,
-	0x82, 0x04, 0xb7, 0x35, 0x92, 0x77, 0xfc, 0x7f, 0xf3, 0xff, 0x83, 0xd2, 0x2e, 0x57, 0x25, 0x71,
-	0x9f, 0x8d, 0x2f, 0x76, 0x1d, 0x66, 0xfa, 0x56, 0x8f, 0xe0, 0xf9, 0x5f, 0x2e, 0x08, 0xb9, 0x22,
-	0xd9, 0x3a, 0x72, 0x3b, 0x36, 0xf3, 0xa4, 0x7e, 0xcc, 0xab, 0x9e, 0x61, 0x8d, 0xf1, 0x36, 0xe4,
-	0x85, 0x03, 0x8a, 0xf4, 0xe3, 0x85, 0xd0, 0x49, 0xa3, 0x5e, 0xcf, 0x24, 0x90, 0xe6, 0x9f, 0x65,
-	0xa0, 0x41, 0xe4, 0xb8, 0xe5, 0x7a, 0xdd, 0xe9, 0xa5, 0xb5, 0x14, 0x8d, 0x33, 0x9a, 0x68, 0x54,
-	0x12, 0x7c, 0x56, 0x97, 0xe0, 0x49, 0x81, 0x99, 0x4b, 0x13, 0x98, 0x37, 0xa0, 0x76, 0x64, 0xf9,
-	0x2d, 0xd4, 0xae, 0x5b, 0x7c, 0x58, 0xbe, 0x3c, 0xab, 0x3d, 0xb2, 0x7c, 0x35, 0x51, 0xbe, 0xf1,
-	0x00, 0xca, 0x3a, 0x4c, 0x21, 0xe6, 0x66, 0x57, 0x90, 0x26, 0xf8, 0xaa, 0x53, 0xf3, 0x77, 0xe1,
-	0x4e, 0x6a, 0x8c, 0xc6, 0x0e, 0xf3, 0xb4, 0x30, 0x27, 0x8d, 0x7c, 0xeb, 0x30, 0x73, 0xc0, 0xc4,
-	0x81, 0x7c, 0xc6, 0xe4, 0x7f, 0x47, 0x1d, 0xf9, 0x37, 0x7f, 0x2f, 0x03, 0x97, 0x53, 0xf1, 0x87,
-	0x18, 0xfd, 0x14, 0x94, 0x2d, 0xa8, 0xf5, 0x99, 0xa7, 0x87, 0x67, 0x91, 0xc8, 0x78, 0x34, 0x3a,
-	0xb2, 0x64, 0xd8, 0x5b, 0x9b, 0xd5, 0x7e, 0xa4, 0xa5, 0xf9, 0xaf, 0x87, 0xbd, 0xd7, 0x76, 0x2f,
-	0x60, 0x87, 0x22, 0x1e, 0x3d, 0xae, 0xce, 0x67, 0x12, 0xea, 0xfc, 0xdb, 0x30, 0xaf, 0x00, 0x94,
-	0xda, 0x20, 0xa6, 0xa0, 0x2e, 0x1b, 0x94, 0xda, 0xf0, 0x09, 0xac, 0x29, 0xe0, 0xa4, 0xb2, 0x21,
-	0xa8, 0xa5, 0x21, 0x21, 0x36, 0xe3, 0x4a, 0xc7, 0x45, 0x00, 0x87, 0x5e, 0x8d, 0xd9, 0x14, 0x97,
-	0xae, 0xd5, 0x34, 0xb7, 0xe1, 0x6a, 0xfa, 0x78, 0x6c, 0xd6, 0x1b, 0x11, 0x1b, 0x93, 0x42, 0xc0,
-	0xcd, 0xdf, 0xcf, 0xc2, 0x52, 0x2a, 0x2e, 0x63, 0x37, 0x71, 0x0c, 0x24, 0x02, 0x7e, 0xdf, 0x19,
-	0xbd, 0x2a, 0xd1, 0x77, 0x88, 0x9f, 0x0b, 0x6d, 0x03, 0xc4, 0x64, 0xac, 0x9e, 0x9b, 0x62, 0x1c,
-	0xf1, 0x98, 0x5a, 0x67, 0xe3, 0x4b, 0x28, 0x3b, 0xe1, 0xfa, 0x91, 0x6a, 0x36, 0x06, 0x97, 0xb6,
-	0xe0, 0xa6, 0xde, 0x7b, 0xa4, 0x8d, 0xd2, 0xdc, 0x85, 0x9a, 0xba, 0x14, 0xc6, 0x3c, 0x8c, 0x85,
-	0x1b, 0x1e, 0x37, 0x42, 0x37, 0x2e, 0xb2, 0xe1, 0x8d, 0x0b, 0x15, 0x14, 0x32, 0xa3, 0x07, 0x85,
-	0xbc, 0x0b, 0x65, 0x81, 0x74, 0xe2, 0x73, 0xfd, 0xe6, 0x5f, 0x9d, 0x85, 0xbc, 0xe8, 0x93, 0x00,
-	0xff, 0x18, 0xaa, 0xae, 0xe7, 0x1c, 0x22, 0xbd, 0xe1, 0x49, 0x06, 0xb1, 0x50, 0x78, 0x96, 0xa9,
-	0x3d, 0xcc, 0x9c, 0x93, 0xb0, 0xe2, 0xd9, 0x63, 0x7d, 0x0e, 0xa1, 0x83, 0x6a, 0x36, 0xe2, 0xa0,
-	0x3a, 0x0f, 0x62, 0xef, 0x70, 0xbd, 0x6d, 0x75, 0xcb, 0x46, 0x55, 0x88, 0x6c, 0x2d, 0x78, 0x1e,
-	0x9e, 0x97, 0xd9, 0x5a, 0xe4, 0x19, 0xf8, 0x28, 0xb7, 0x16, 0x05, 0x18, 0x14, 0x47, 0x04, 0x6f,
-	0xff, 0x9a, 0x62, 0xa1, 0x8c, 0xf7, 0x41, 0x64, 0x9f, 0x11, 0x61, 0x94, 0xe5, 0x58, 0x04, 0x7a,
-	0x8c, 0x26, 0xcc, 0x52, 0x5f, 0x91, 0xc7, 0x39, 0x28, 0xf9, 0x56, 0x87, 0xf9, 0xad, 0xc0, 0x3a,
-	0x41, 0x8d, 0x36, 0x6b, 0x16, 0xb1, 0x62, 0xcf, 0x3a, 0x31, 0xae, 0xc2, 0x9c, 0xe3, 0x6b, 0x81,
-	0xa9, 0x14, 0x08, 0x50, 0x71, 0xfc, 0x30, 0xea, 0xd4, 0xb8, 0x05, 0x75, 0x39, 0x60, 0x75, 0x42,
-	0x28, 0x9c, 0x5d, 0x55, 0xaa, 0xa7, 0x13, 0xc2, 0xe6, 0x7f, 0xcb, 0xc0, 0xf9, 0x54, 0x62, 0x7f,
-	0xe6, 0xf8, 0x81, 0xeb, 0x9d, 0x4e, 0x7f, 0x37, 0xf6, 0x09, 0x44, 0xb9, 0x96, 0x18, 0x7f, 0x5c,
-	0xa0, 0x5f, 0x8c, 0xd5, 0xa3, 0x4b, 0x36, 0x3b, 0xcd, 0x92, 0x0d, 0x8b, 0xc4, 0x6b, 0xfe, 0xbb,
-	0x0c, 0xd4, 0x37, 0x07, 0x7e, 0xe0, 0x76, 0x79, 0x91, 0x0b, 0x1a, 0x11, 0x95, 0xa5, 0x8f, 0x27,
-	0x93, 0x18, 0x4f, 0x54, 0x0d, 0xcc, 0xc6, 0xd5, 0xc0, 0x21, 0x7b, 0xb8, 0x50, 0x5e, 0x67, 0x35,
-	0x47, 0x21, 0xa7, 0x5c, 0x15, 0x04, 0x95, 0x13, 0x42, 0x42, 0x96, 0x63, 0xc3, 0xcd, 0x4f, 0x63,
-	0x28, 0xfe, 0x04, 0xe6, 0xd5, 0xa0, 0xfa, 0xfa, 0xaa, 0xf5, 0x71, 0x30, 0x15, 0x8c, 0xa7, 0x8a,
-	0xe2, 0xcf, 0x4e, 0x83, 0xff, 0x1f, 0x66, 0x60, 0x59, 0x3e, 0x80, 0x4e, 0xd7, 0xe4, 0x53, 0x7e,
-	0x1d, 0xf1, 0x6f, 0x6f, 0x62, 0x43, 0x77, 0x61, 0x4d, 0xbe, 0xf9, 0x6e, 0xe0, 0x39, 0xbd, 0xc3,
-	0x97, 0x7c, 0x21, 0xe4, 0xdb, 0xab, 0x55, 0xca, 0xe8, 0xab, 0xf4, 0x06, 0x33, 0xf5, 0x7b, 0x25,
-	0x28, 0xca, 0xe7, 0x25, 0xf8, 0x26, 0x1a, 0x43, 0x96, 0x8d, 0xc7, 0x90, 0x8d, 0x95, 0xa2, 0x2a,
-	0x36, 0x6f, 0x76, 0x74, 0x6c, 0x5e, 0x6e, 0x64, 0x6c, 0x5e, 0x7e, 0x74, 0x6c, 0x5e, 0x21, 0x2d,
-	0x36, 0x4f, 0x6e, 0xfc, 0x45, 0x4d, 0x73, 0x0d, 0xe3, 0xf5, 0x2a, 0x23, 0xe3, 0xf5, 0x6e, 0x42,
-	0x4d, 0xc4, 0xe3, 0xb4, 0x54, 0x2a, 0x2a, 0xe1, 0x3c, 0xad, 0x8a, 0xea, 0xaf, 0x64, 0x42, 0xaa,
-	0x0b, 0x00, 0xe2, 0x80, 0xf7, 0x30, 0xbc, 0xdb, 0x5d, 0xc2, 0x03, 0x5d, 0x5e, 0xa1, 0xc7, 0xfd,
-	0xcd, 0x4d, 0x13, 0xf7, 0xf7, 0x1e, 0x14, 0x1d, 0xe2, 0x74, 0x32, 0xcf, 0x57, 0x43, 0x8d, 0x3e,
-	0x26, 0x0a, 0x4c, 0x05, 0xca, 0x89, 0xc0, 0xe9, 0xb7, 0x8e, 0x04, 0xa1, 0x34, 0x6a, 0xb1, 0x6c,
-	0x3a, 0x09, 0x76, 0x33, 0x4b, 0x8e, 0xe2, 0xbc, 0x67, 0x50, 0x93, 0x87, 0xd4, 0xb2, 0x7f, 0x3d,
-	0x96, 0x25, 0x20, 0x9d, 0x9b, 0xcc, 0xaa, 0x15, 0xe5, 0xae, 0x1f, 0x40, 0x55, 0xcc, 0xa2, 0x42,
-	0x34, 0x1f, 0x8b, 0x32, 0x19, 0x4e, 0xdc, 0x94, 0x11, 0x43, 0xd1, 0xfa, 0x8f, 0x61, 0x25, 0xb6,
-	0x0e, 0x0a, 0xa9, 0x31, 0x39, 0xd2, 0xa5, 0xe8, 0xa2, 0x49, 0xe4, 0x1f, 0x6b, 0x67, 0x44, 0x0b,
-	0x43, 0xc6, 0x3a, 0xe1, 0x11, 0xd1, 0xe2, 0xd9, 0xf7, 0xe6, 0xa5, 0x29, 0x8f, 0x88, 0xf4, 0x10,
-	0xb0, 0xe5, 0xc9, 0x42, 0xc0, 0x56, 0xd2, 0x43, 0xc0, 0x52, 0xe3, 0x3f, 0x1b, 0x53, 0xc7, 0x7f,
-	0xae, 0xfe, 0xaa, 0xe2, 0x3f, 0xbf, 0x80, 0x05, 0xbc, 0xe6, 0x81, 0x77, 0xb5, 0x50, 0x2e, 0xa0,
-	0xa4, 0x4b, 0x97, 0x7f, 0xfa, 0x2e, 0x95, 0x8d, 0xee, 0x52, 0x11, 0x44, 0x18, 0xee, 0x77, 0x56,
-	0x44, 0xb7, 0xa0, 0xae, 0x10, 0x6d, 0xf7, 0x47, 0x60, 0x69, 0xbe, 0x03, 0x8b, 0x0a, 0xf2, 0x2b,
-	0x24, 0xe9, 0x51, 0xd0, 0x37, 0xa0, 0xaa, 0xa0, 0x47, 0xc1, 0xfd, 0x95, 0x59, 0x28, 0x29, 0xc0,
-	0x84, 0xa8, 0xbe, 0xaf, 0xdf, 0x28, 0xd7, 0x45, 0x4d, 0xca, 0x2c, 0x4a, 0x41, 0x7c, 0x5f, 0x4a,
-	0xd8, 0xd9, 0x61, 0x7d, 0xc2, 0x09, 0x93, 0xf2, 0xf7, 0x6d, 0x12, 0xac, 0xf9, 0xd8, 0xc5, 0x8c,
-	0xe8, 0x10, 0xd4, 0x05, 0x70, 0x2e, 0x71, 0x85, 0x2b, 0x67, 0x35, 0x09, 0x4a, 0xb3, 0x88, 0xc2,
-	0xf8, 0x3d, 0x25, 0x8c, 0x85, 0xfb, 0xe6, 0x42, 0x12, 0x5c, 0x9b, 0xca, 0xb4, 0xd8, 0xea, 0xd2,
-	0x59, 0x63, 0xab, 0xe3, 0x47, 0xc4, 0xea, 0x81, 0xa3, 0x62, 0xab, 0x35, 0xc1, 0x5f, 0x8e, 0x0b,
-	0xfe, 0x94, 0x0d, 0xa4, 0x92, 0xb6, 0x81, 0xbc, 0x19, 0x87, 0x6c, 0xc1, 0x32, 0xbe, 0xa9, 0xf4,
-	0x4a, 0x9a, 0x2c, 0x18, 0x78, 0x78, 0xfd, 0xb7, 0x01, 0x05, 0x99, 0xb0, 0x45, 0x5e, 0xcf, 0xa6,
-	0x6c, 0x2d, 0x06, 0xcc, 0x1e, 0x84, 0x5b, 0x39, 0xfe, 0x6f, 0xfe, 0x10, 0xe6, 0x23, 0x78, 0x30,
-	0x20, 0x80, 0x0e, 0xfa, 0x33, 0xe1, 0x41, 0x7f, 0x68, 0x11, 0xe5, 0x26, 0xbe, 0x82, 0xf0, 0x4f,
-	0x67, 0x60, 0x2e, 0x82, 0x7b, 0x9c, 0x62, 0xfa, 0x1b, 0x00, 0x1e, 0x0e, 0x03, 0x8f, 0x00, 0x67,
-	0x62, 0xb7, 0xae, 0xd2, 0x87, 0x6b, 0x96, 0x3c, 0x35, 0xf2, 0x51, 0x49, 0x48, 0x87, 0x0d, 0x20,
-	0x99, 0x45, 0x34, 0x9f, 0x96, 0x45, 0x34, 0x16, 0xd4, 0x50, 0x4c, 0x06, 0x35, 0x84, 0x51, 0x42,
-	0x7e, 0xcb, 0xb1, 0x39, 0x41, 0xce, 0x68, 0x51, 0x42, 0xfe, 0xb6, 0xed, 0x1b, 0x9f, 0x27, 0xc8,
-	0xee, 0x5a, 0xfa, 0xe8, 0x86, 0x92, 0x5e, 0x2c, 0x4e, 0xa0, 0x9c, 0x16, 0x27, 0x80, 0xba, 0x7d,
-	0x25, 0xd4, 0xed, 0xdf, 0x8c, 0xce, 0x7e, 0x9e, 0x85, 0xb2, 0x16, 0x16, 0x2c, 0xe3, 0x2d, 0x32,
-	0x61, 0xbc, 0xc5, 0x1a, 0x14, 0x55, 0xba, 0x49, 0x92, 0x9a, 0xb2, 0xcc, 0x0d, 0xe6, 0x30, 0x99,
-	0xe3, 0x8c, 0x0c, 0x55, 0x92, 0x69, 0x1c, 0x31, 0xf2, 0x3a, 0x92, 0xbf, 0x71, 0x56, 0x46, 0x5e,
-	0x0f, 0xcf, 0xdc, 0x98, 0x1b, 0x9d, 0xb9, 0x31, 0x3f, 0x2e, 0x73, 0x63, 0x21, 0x91, 0xb9, 0x51,
-	0x84, 0x89, 0x1f, 0x30, 0xcf, 0x63, 0x5e, 0xeb, 0xc8, 0xf5, 0x03, 0x5a, 0xde, 0x8a, 0xac, 0x7c,
-	0xe6, 0xfa, 0x41, 0xf3, 0x9f, 0x64, 0x60, 0x65, 0x48, 0x84, 0x6e, 0xec, 0xbe, 0x50, 0x66, 0xa2,
-	0xfb, 0x42, 0xa1, 0xb7, 0x60, 0x26, 0xe2, 0x2d, 0x90, 0x91, 0x1e, 0xb3, 0x61, 0xa4, 0x47, 0x4a,
-	0x88, 0x7a, 0x6e, 0x82, 0x10, 0xf5, 0x7c, 0x3c, 0x44, 0xbd, 0xb9, 0x0e, 0xf3, 0x5f, 0xb0, 0x40,
-	0x45, 0x20, 0x89, 0xf8, 0xd0, 0x55, 0x28, 0xca, 0xe8, 0x23, 0x29, 0x30, 0x28, 0xf4, 0xa8, 0xf9,
-	0x19, 0x2c, 0x10, 0xf0, 0x4b, 0x2b, 0x08, 0x2f, 0x83, 0x4a, 0xb7, 0xb6, 0x18, 0x2c, 0xfe, 0xe7,
-	0x14, 0xf4, 0xda, 0xf5, 0x3a, 0x36, 0x5d, 0x5c, 0x12, 0x85, 0xe6, 0xcf, 0xf3, 0xea, 0x0c, 0x3e,
-	0xb1, 0x67, 0xc5, 0xa2, 0x9e, 0xb2, 0xf1, 0xa8, 0xa7, 0x30, 0xf9, 0xed, 0x4c, 0x24, 0xf9, 0xed,
-	0x28, 0x2e, 0x4f, 0x8b, 0x94, 0xca, 0x4d, 0x1a, 0x29, 0x95, 0x4f, 0x89, 0x94, 0xe2, 0x73, 0xaa,
-	0x5f, 0x43, 0x17, 0xe6, 0x06, 0x1c, 0x87, 0x97, 0xd0, 0xaf, 0x40, 0x85, 0x03, 0xa8, 0x57, 0x22,
-	0xd1, 0x70, 0x6c, 0x85, 0xd7, 0x5a, 0xaf, 0xe1, 0x6d, 0x92, 0x36, 0x6b, 0xa1, 0x67, 0x9c, 0x33,
-	0x6e, 0x89, 0x92, 0xa6, 0xf2, 0xda, 0x2f, 0x78, 0xe5, 0xb6, 0x6d, 0x6c, 0xc0, 0x1c, 0x47, 0x14,
-	0x5e, 0xd4, 0x8d, 0x87, 0x7c, 0xa4, 0x2c, 0x85, 0xc9, 0x9f, 0x1d, 0x2e, 0xcc, 0x2d, 0xa8, 0x73,
-	0x14, 0x22, 0x3e, 0x81, 0x0e, 0xdd, 0xcb, 0xe8, 0x57, 0xaa, 0x1e, 0x5b, 0x81, 0x08, 0x4f, 0x10,
-	0xe7, 0xee, 0xb7, 0x61, 0x5e, 0x84, 0x3e, 0x5a, 0x76, 0xc7, 0xe9, 0xd1, 0x45, 0xe3, 0x0a, 0x82,
-	0xd6, 0x8e, 0xad, 0xe0, 0x09, 0xd5, 0xe3, 0x2d, 0xe3, 0x1b, 0xc0, 0xab, 0x5a, 0x5c, 0x73, 0x66,
-	0x78, 0x4c, 0x2f, 0x2c, 0x9a, 0x9c, 0xc9, 0xdf, 0x77, 0x97, 0xd7, 0xfe, 0x90, 0x57, 0x1a, 0x1f,
-	0xc2, 0xaa, 0x3e, 0x13, 0x78, 0x09, 0xce, 0x6f, 0xf5, 0xdd, 0x8e, 0xd3, 0x3e, 0x25, 0x5f, 0xce,
-	0xb2, 0x36, 0x2d, 0x22, 0x35, 0x04, 0xb6, 0x0e, 0xe9, 0x4a, 0x1c, 0x5f, 0x4b, 0xef, 0x4a, 0xec,
-	0x1f, 0xd5, 0xc6, 0xeb, 0x67, 0xd7, 0xc6, 0xe7, 0xa7, 0xd1, 0xc6, 0xd7, 0x61, 0x41, 0x78, 0xca,
-	0xc4, 0x15, 0x54, 0xa9, 0x42, 0x8b, 0x5b, 0x21, 0xf3, 0xd8, 0x44, 0x97, 0x54, 0x45, 0x3a, 0x8a,
-	0xcf, 0x60, 0x4e, 0x2c, 0x9f, 0xc3, 0xfc, 0xaf, 0x1c, 0x9f, 0x23, 0x28, 0xb5, 0x65, 0x05, 0xe5,
-	0xd0, 0xa8, 0x27, 0xc2, 0x6b, 0x43, 0x90, 0xe6, 0x0d, 0x58, 0xfc, 0x82, 0x05, 0x3b, 0x8a, 0x60,
-	0x24, 0xf7, 0xc6, 0xb8, 0xaa, 0xf9, 0xb7, 0xb2, 0x00, 0x21, 0x54, 0x5a, 0x0c, 0xc1, 0x68, 0x89,
-	0x94, 0xc2, 0x70, 0xd7, 0xa1, 0xea, 0xf4, 0x0e, 0xe4, 0x8d, 0x1f, 0xe9, 0xed, 0xc8, 0x98, 0x73,
-	0xaa, 0x96, 0xaf, 0x07, 0x47, 0x7d, 0xe0, 0xd1, 0x79, 0x86, 0xd8, 0x63, 0x55, 0xf9, 0x0d, 0x9c,
-	0x45, 0xb1, 0x45, 0x2a, 0x4c, 0xb3, 0x48, 0x11, 0x27, 0x77, 0x31, 0xe6, 0xe4, 0x7e, 0x04, 0x95,
-	0x1f, 0x39, 0x7d, 0x2e, 0x6b, 0x76, 0xd1, 0x69, 0x23, 0xe5, 0x6e, 0x46, 0x93, 0xbb, 0x69, 0x07,
-	0x08, 0x7f, 0x2f, 0x03, 0x05, 0xea, 0x28, 0x7d, 0xdf, 0x99, 0xd0, 0xf7, 0xad, 0xf9, 0x97, 0xb2,
-	0xe9, 0xfe, 0xa5, 0x19, 0xcd, 0xbf, 0xf4, 0xb6, 0xee, 0x3e, 0xd2, 0x6f, 0x51, 0xe8, 0x6f, 0xf6,
-	0x1d, 0x78, 0x95, 0xfe, 0x2c, 0xab, 0x8e, 0xfd, 0x36, 0x8f, 0xac, 0x5e, 0x8f, 0x75, 0x36, 0x5d,
-	0x7f, 0x9a, 0x50, 0xaa, 0x61, 0xa4, 0x31, 0x3c, 0x3d, 0x53, 0x03, 0x0a, 0x7d, 0xe6, 0xb5, 0x99,
-	0x52, 0xb8, 0x64, 0x51, 0xe4, 0xb9, 0x3a, 0x69, 0x45, 0x22, 0x40, 0x4b, 0x07, 0xce, 0x09, 0x05,
-	0x5f, 0xac, 0xc3, 0x42, 0xd8, 0xdc, 0x8a, 0x39, 0xce, 0xe7, 0x15, 0x9c, 0x12, 0xae, 0xdf, 0xcf,
-	0x0d, 0xbb, 0x08, 0x69, 0x41, 0x8c, 0xb4, 0x0e, 0xe1, 0xd2, 0xb0, 0xd9, 0x96, 0x6c, 0x9b, 0x96,
-	0x30, 0x2b, 0x9c, 0xe4, 0xec, 0xb0, 0x49, 0x9e, 0x89, 0x4c, 0x72, 0xf3, 0xb7, 0xe0, 0xfc, 0xb0,
-	0x07, 0xa1, 0x90, 0x79, 0x5f, 0x46, 0x6f, 0x66, 0x62, 0x77, 0x58, 0x86, 0xbe, 0x1e, 0x05, 0x74,
-	0xfe, 0x8f, 0x59, 0x58, 0x4b, 0xc2, 0x0c, 0xcd, 0xa7, 0x33, 0xd6, 0xc3, 0x6e, 0xa8, 0xec, 0x93,
-	0xe1, 0x70, 0x6f, 0x62, 0xfa, 0x12, 0x4c, 0xc4, 0x11, 0xdd, 0xce, 0xab, 0x94, 0x83, 0x43, 0xae,
-	0xf0, 0x05, 0x80, 0xae, 0xd3, 0x6b, 0x45, 0xd4, 0xf7, 0x52, 0xd7, 0xe9, 0x11, 0xc1, 0x84, 0xd3,
-	0x96, 0x1f, 0x36, 0x6d, 0x85, 0x28, 0x6d, 0x5e, 0x87, 0xaa, 0xbc, 0xe6, 0x42, 0x24, 0x2a, 0x22,
-	0x81, 0xe6, 0xba, 0xf2, 0x9c, 0xb5, 0x4d, 0x49, 0x16, 0x09, 0x4c, 0xa3, 0xd7, 0x12, 0xe5, 0xfd,
-	0xc0, 0x86, 0x2d, 0x45, 0xb5, 0x1f, 0xc3, 0x5a, 0x02, 0x36, 0x9e, 0x73, 0x79, 0x25, 0xd6, 0x49,
-	0x1f, 0x60, 0xdf, 0x57, 0xef, 0x22, 0x92, 0x2e, 0x97, 0xfa, 0xbe, 0x7c, 0x8f, 0xcb, 0x50, 0xe9,
-	0xfb, 0x1c, 0x2f, 0xb3, 0x5b, 0x07, 0x8c, 0x51, 0x9e, 0x65, 0xe8, 0xfb, 0x5b, 0xbc, 0x6a, 0x8b,
-	0x31, 0xe3, 0x5d, 0x58, 0xd2, 0x21, 0xc2, 0x07, 0xcf, 0x51, 0x52, 0x13, 0x05, 0x3a, 0x84, 0x6d,
-	0xaa, 0x67, 0x67, 0x9b, 0xda, 0x99, 0xd9, 0xa6, 0x1e, 0x63, 0x9b, 0x3f, 0xca, 0xc0, 0x95, 0xe1,
-	0x44, 0x27, 0x39, 0x67, 0xec, 0xe9, 0x47, 0x9a, 0xfc, 0x4a, 0xa1, 0xb5, 0x99, 0x54, 0x5a, 0x1b,
-	0x76, 0xf2, 0x17, 0x12, 0x59, 0x6e, 0x18, 0x91, 0xe5, 0xa3, 0xbc, 0xf9, 0x63, 0xb8, 0x38, 0x7c,
-	0x30, 0xc8, 0x9d, 0x1f, 0x46, 0xb9, 0xf3, 0xea, 0x08, 0xee, 0x54, 0x93, 0x40, 0xfc, 0xf9, 0x0c,
-	0xae, 0x8f, 0x46, 0x3e, 0xe9, 0x6c, 0x35, 0xff, 0xd9, 0x0c, 0x2c, 0x3c, 0x77, 0x7b, 0xec, 0xf4,
-	0xb1, 0xd5, 0x7e, 0x35, 0xe5, 0xae, 0x30, 0xf1, 0xac, 0x5e, 0x82, 0xf2, 0xa0, 0x67, 0xbb, 0xf2,
-	0xba, 0xb0, 0xcc, 0x23, 0xdb, 0xb3, 0x5d, 0xba, 0x26, 0x3c, 0xf5, 0xf4, 0x72, 0x4a, 0xe2, 0x3a,
-	0x6b, 0x0b, 0x33, 0x68, 0x14, 0x50, 0x1d, 0x2d, 0xf2, 0x8a, 0x2d, 0xcf, 0xed, 0x72, 0x83, 0x4e,
-	0x05, 0xda, 0x05, 0xd6, 0x21, 0xa3, 0xc3, 0xd5, 0x8a, 0x0c, 0xb2, 0xe3, 0x75, 0xfa, 0x0e, 0x55,
-	0x1a, 0xb5, 0x43, 0x41, 0x7c, 0x87, 0xfa, 0x7e, 0xae, 0x08, 0x44, 0x58, 0x67, 0x2e, 0xc6, 0x3a,
-	0x7f, 0x9a, 0x81, 0xb5, 0x94, 0x55, 0x1c, 0xb5, 0xdb, 0xa4, 0x2c, 0x5e, 0x76, 0x92, 0xc5, 0x9b,
-	0x19, 0xb1, 0x78, 0xb3, 0xc3, 0x16, 0x2f, 0x97, 0xd0, 0x85, 0xd0, 0xe0, 0x10, 0x97, 0xea, 0xf1,
-	0x7f, 0x72, 0xcd, 0x0a, 0xc9, 0x35, 0x6b, 0x3e, 0x87, 0x95, 0x94, 0x61, 0x22, 0x37, 0xdd, 0x8f,
-	0x72, 0x93, 0x96, 0xa6, 0x2d, 0x65, 0x5e, 0x88, 0x8d, 0xfe, 0xf9, 0x2c, 0x2c, 0x45, 0x9a, 0xbf,
-	0xa7, 0x1d, 0x2e, 0x36, 0xc5, 0xb9, 0x11, 0x53, 0x3c, 0xe9, 0x1e, 0x17, 0xe1, 0x8f, 0xe2, 0x38,
-	0xfe, 0x28, 0x8d, 0xe6, 0x0f, 0x18, 0xc5, 0x1f, 0xe5, 0x09, 0x35, 0xb8, 0xca, 0x30, 0x0d, 0xee,
-	0x0e, 0x2c, 0x38, 0x7e, 0xab, 0x6f, 0x39, 0x76, 0x6b, 0xff, 0x54, 0xe5, 0x62, 0x23, 0x1a, 0xaf,
-	0x3b, 0xfe, 0x8e, 0xe5, 0xd8, 0x8f, 0x4f, 0xd5, 0xd2, 0xfc, 0x5f, 0xb8, 0x73, 0xfd, 0xe5, 0x2c,
-	0x9c, 0x4f, 0xa5, 0xa3, 0x5f, 0xcf, 0xa6, 0xf5, 0x2b, 0x10, 0xaf, 0x92, 0x43, 0x0b, 0xa3, 0x38,
-	0x34, 0x45, 0xaa, 0x36, 0x6f, 0x87, 0x96, 0x86, 0xeb, 0x07, 0x4f, 0x58, 0x87, 0x85, 0x5f, 0x9e,
-	0x8a, 0xdb, 0xaa, 0xbf, 0x09, 0xab, 0xa9, 0xb3, 0x86, 0xfc, 0xfc, 0x30, 0xca, 0xcf, 0x17, 0xd3,
-	0xf9, 0x39, 0xbe, 0x31, 0x6e, 0xc2, 0xe5, 0xa1, 0x28, 0x27, 0xde, 0x13, 0xff, 0x6d, 0x16, 0xea,
-	0x3b, 0x2a, 0x0f, 0xdc, 0x90, 0x0d, 0xf1, 0x3e, 0x2c, 0x39, 0xbd, 0xc0, 0xb3, 0xf6, 0xad, 0xde,
-	0xab, 0x48, 0x4a, 0x34, 0xe1, 0x00, 0x5b, 0x50, 0x8d, 0x5a, 0x52, 0xb4, 0x47, 0xb0, 0x12, 0xeb,
-	0x13, 0x5b, 0xd9, 0xa5, 0x48, 0x2f, 0xb5, 0xc0, 0xe2, 0x59, 0xcc, 0x4b, 0x3c, 0x6b, 0x56, 0x3d,
-	0x4b, 0x34, 0x26, 0x9e, 0xa5, 0xf7, 0x89, 0x7d, 0x0f, 0x65, 0x29, 0xd2, 0x4b, 0x3d, 0xeb, 0x57,
-	0x74, 0x7b, 0xa5, 0xf9, 0x31, 0x2c, 0x6d, 0xa8, 0x9b, 0x32, 0xe8, 0x87, 0x26, 0x07, 0x4e, 0x8a,
-	0xa6, 0x81, 0xbe, 0xe0, 0x6c, 0xe8, 0xc2, 0x6e, 0xfe, 0xe1, 0x2c, 0xd4, 0x62, 0xbd, 0x27, 0xbe,
-	0xb5, 0x98, 0x16, 0xee, 0xf2, 0x08, 0xf2, 0xe4, 0x5c, 0x9a, 0x8d, 0x85, 0xfa, 0xa4, 0xbe, 0xa3,
-	0x49, 0xd0, 0x71, 0xd2, 0xc9, 0x25, 0xf8, 0xf8, 0x8c, 0x57, 0x1b, 0x89, 0x73, 0x8b, 0x11, 0x4f,
-	0x70, 0x18, 0x1b, 0x56, 0x8a, 0x24, 0xa7, 0xd1, 0xb8, 0x16, 0xa2, 0x5c, 0x7b, 0x13, 0x6a, 0x2a,
-	0x2a, 0x2e, 0x22, 0x9d, 0x55, 0xb0, 0x1c, 0x11, 0xc7, 0xdb, 0x30, 0xaf, 0x00, 0x63, 0x02, 0xba,
-	0x2e, 0x1b, 0x14, 0x45, 0x5c, 0x81, 0x0a, 0x9e, 0xb7, 0x49, 0x94, 0x73, 0x22, 0x81, 0x00, 0xd6,
-	0x6d, 0xa8, 0x53, 0x14, 0x01, 0xa2, 0x90, 0x09, 0x7f, 0x9f, 0x38, 0xd3, 0x1f, 0x62, 0x74, 0x4c,
-	0x75, 0x49, 0xe0, 0x53, 0xa8, 0x58, 0xc7, 0x96, 0xd3, 0xb1, 0xf6, 0x3b, 0xac, 0xe5, 0xf6, 0x26,
-	0x70, 0xf4, 0x95, 0x15, 0xfc, 0xd7, 0xbd, 0xe6, 0x2f, 0xb2, 0xb0, 0x40, 0x69, 0xe9, 0x4d, 0xd6,
-	0x77, 0xbd, 0x60, 0xcf, 0x0d, 0xac, 0x0e, 0x26, 0xeb, 0x8b, 0xa4, 0x49, 0x0c, 0x2f, 0xfa, 0xe4,
-	0xcc, 0x79, 0xbd, 0x45, 0x7c, 0x8a, 0x84, 0xef, 0x7c, 0x8c, 0xb5, 0x22, 0xd9, 0xb8, 0x4a, 0x07,
-	0x8c, 0xd1, 0x34, 0x5c, 0x00, 0x38, 0xb6, 0x62, 0xcc, 0x59, 0x3a, 0xb6, 0xb4, 0x0f, 0xe1, 0x44,
-	0x93, 0x48, 0x0a, 0x5b, 0xb6, 0xd2, 0xd7, 0x33, 0x48, 0x3e, 0x84, 0xe5, 0x78, 0x4e, 0xc7, 0x08,
-	0x45, 0x2d, 0x46, 0x13, 0x37, 0x86, 0x0b, 0x8a, 0x99, 0xce, 0xc5, 0xfd, 0x8e, 0xe8, 0x6d, 0x98,
-	0xb0, 0x41, 0x00, 0x37, 0xff, 0xc1, 0x0c, 0x5c, 0x8a, 0x4c, 0x06, 0x5d, 0x72, 0xd8, 0x1d, 0x74,
-	0xbb, 0x96, 0x87, 0xdf, 0xee, 0xc0, 0xdd, 0x5f, 0xd4, 0xca, 0x43, 0x01, 0x2a, 0x0e, 0x75, 0x53,
-	0xf0, 0xa9, 0xc4, 0xd0, 0x7f, 0x7d, 0xda, 0x70, 0x8e, 0xf8, 0x54, 0xf2, 0x16, 0x3d, 0xb5, 0x24,
-	0x67, 0x25, 0x11, 0x32, 0xd8, 0x56, 0x93, 0x95, 0x33, 0x01, 0xab, 0x36, 0xe5, 0x9d, 0x9d, 0x43,
-	0xcf, 0xf5, 0xfd, 0x96, 0x00, 0x8b, 0x4c, 0x59, 0x1d, 0x5b, 0x76, 0x79, 0x43, 0x38, 0xb7, 0xe2,
-	0x20, 0x50, 0x22, 0x14, 0xba, 0x65, 0x85, 0x2a, 0x37, 0x65, 0xee, 0x4f, 0x81, 0x52, 0x82, 0x46,
-	0x26, 0x4a, 0x3c, 0x4e, 0x9c, 0x2c, 0xfa, 0xe1, 0xc5, 0x21, 0xd1, 0x43, 0x0c, 0x2d, 0x7a, 0x71,
-	0x08, 0x5b, 0x90, 0x90, 0xc2, 0xf5, 0x17, 0x70, 0x07, 0x8c, 0xf9, 0x64, 0x55, 0x94, 0xb0, 0x66,
-	0x8b, 0x31, 0x9f, 0x8b, 0x56, 0xd1, 0x7c, 0x6c, 0x49, 0x9d, 0xaa, 0x88, 0x15, 0x2f, 0xad, 0x14,
-	0xe2, 0x28, 0x27, 0x89, 0xa3, 0xf9, 0xc7, 0x19, 0x38, 0x17, 0x59, 0xb9, 0x4d, 0xb5, 0xb6, 0xb8,
-	0x6a, 0xeb, 0x91, 0x8b, 0x8d, 0x0c, 0x33, 0x2f, 0x28, 0x21, 0xa9, 0x5d, 0x61, 0x44, 0xd9, 0x16,
-	0x11, 0x57, 0xd9, 0xa1, 0xe2, 0x6a, 0x66, 0xa8, 0xb8, 0x9a, 0x8d, 0x88, 0xab, 0x0f, 0x01, 0xc4,
-	0x03, 0x6d, 0x99, 0x95, 0x7d, 0x5c, 0xf2, 0x6f, 0x0e, 0x8d, 0x69, 0xe5, 0xff, 0x4d, 0x16, 0x16,
-	0x23, 0xc3, 0x22, 0x4a, 0x34, 0xbe, 0xd6, 0xbe, 0x10, 0xa4, 0x6b, 0x03, 0xe1, 0x35, 0xa8, 0x31,
-	0x74, 0x1c, 0x7e, 0x4b, 0x68, 0x1b, 0xb3, 0xef, 0xe8, 0x08, 0x71, 0xea, 0x13, 0x19, 0xa8, 0x27,
-	0x46, 0x88, 0x0b, 0x6f, 0x6c, 0x41, 0x39, 0xe4, 0x2f, 0x9f, 0x3e, 0xaf, 0x72, 0x2d, 0x1d, 0x5b,
-	0x74, 0xb1, 0x4c, 0xbd, 0xa3, 0xf1, 0x35, 0xd4, 0x63, 0x6c, 0xef, 0x37, 0x66, 0xa7, 0x40, 0x56,
-	0x8b, 0x8a, 0x05, 0xbf, 0xf9, 0x37, 0x0a, 0x30, 0x17, 0xe9, 0x30, 0xbd, 0x4d, 0x13, 0x15, 0xd7,
-	0x33, 0x67, 0xd7, 0xb4, 0x67, 0xa7, 0x4c, 0x5e, 0x46, 0x8c, 0x30, 0x21, 0x21, 0x81, 0x00, 0x7f,
-	0x42, 0xa9, 0x12, 0xb5, 0x84, 0x6d, 0xe1, 0x9e, 0xc9, 0x91, 0x8a, 0x53, 0x31, 0xe5, 0x30, 0x18,
-	0x87, 0x14, 0xc1, 0xd1, 0x5c, 0x7a, 0x1f, 0x4a, 0xd4, 0x39, 0x70, 0x27, 0xf0, 0x30, 0x17, 0x05,
-	0xf0, 0x9e, 0x6b, 0x3c, 0x81, 0x3a, 0x85, 0x82, 0x50, 0x82, 0x99, 0x89, 0xdc, 0xcc, 0x14, 0x27,
-	0x22, 0xae, 0xee, 0x88, 0x09, 0x91, 0x19, 0xc2, 0x26, 0xcc, 0xe6, 0x26, 0xc1, 0x37, 0xf8, 0x76,
-	0x92, 0x47, 0x3a, 0x4f, 0x66, 0x1f, 0x4c, 0xd9, 0x0e, 0x4d, 0x82, 0x8d, 0xf0, 0x7f, 0x25, 0xc6,
-	0xff, 0xef, 0x43, 0xc1, 0x17, 0xfc, 0x40, 0x81, 0x8d, 0x17, 0xd2, 0x51, 0x12, 0xd3, 0x98, 0x12,
-	0x9a, 0x2b, 0x09, 0xb6, 0xe3, 0xf7, 0x07, 0x01, 0x93, 0x96, 0x0a, 0x29, 0x09, 0x54, 0x4b, 0xc6,
-	0xca, 0x33, 0x30, 0x24, 0x18, 0xde, 0xf1, 0x9a, 0x54, 0x59, 0xa8, 0x53, 0xaf, 0x5d, 0xd1, 0x69,
-	0x23, 0x30, 0xb6, 0x60, 0x5e, 0x62, 0x0a, 0x93, 0x2e, 0x8d, 0x57, 0x1c, 0x6a, 0xd4, 0x49, 0x65,
-	0x5a, 0x12, 0xa9, 0x62, 0xac, 0x41, 0xe0, 0x86, 0xa9, 0xda, 0xe6, 0x65, 0xaa, 0x98, 0x8d, 0x41,
-	0xe0, 0xaa, 0x3c, 0x6d, 0x61, 0x2a, 0x6a, 0xdb, 0x6d, 0x0f, 0xba, 0x94, 0xd6, 0x46, 0x9c, 0x0a,
-	0x52, 0x2a, 0xea, 0x27, 0xd4, 0xb0, 0x6d, 0x37, 0xff, 0x65, 0x26, 0x26, 0xf6, 0x36, 0xf1, 0xe8,
-	0xd9, 0x4f, 0xbb, 0x03, 0x28, 0xbf, 0xb9, 0xe3, 0x21, 0xa0, 0x76, 0x07, 0xd0, 0xd3, 0x11, 0xd0,
-	0x2d, 0x7c, 0xa1, 0xda, 0xca, 0x5b, 0xf8, 0xa1, 0x9a, 0x2d, 0xaf, 0x68, 0x65, 0xc5, 0x77, 0x31,
-	0x8e, 0x2c, 0xff, 0x48, 0x7e, 0x17, 0x83, 0xff, 0x7f, 0x83, 0xb3, 0x94, 0xe6, 0xef, 0x17, 0xa0,
-	0xfa, 0xd2, 0x0a, 0xb4, 0x2d, 0x3e, 0x31, 0x0a, 0xfd, 0x6b, 0xbf, 0xd9, 0xe8, 0xd7, 0x7e, 0xaf,
-	0x43, 0x55, 0xd3, 0x1a, 0xc2, 0x70, 0xe2, 0x39, 0xad, 0x56, 0x7c, 0x22, 0x54, 0x07, 0xd3, 0x42,
-	0x46, 0x6a, 0x5a, 0x3d, 0x06, 0x8d, 0x44, 0x15, 0xbb, 0xa8, 0xf6, 0xa0, 0x2b, 0x76, 0xb4, 0x73,
-	0xbf, 0x0b, 0x8b, 0x3a, 0xb8, 0x22, 0x7e, 0x21, 0x45, 0x16, 0xb4, 0x36, 0xdd, 0x6b, 0xaf, 0x29,
-	0x7b, 0x85, 0xb8, 0xb2, 0x37, 0x41, 0x5c, 0xc0, 0x25, 0x28, 0x73, 0x45, 0x21, 0x7a, 0xb4, 0xc0,
-	0x15, 0x4c, 0x4d, 0xa9, 0x41, 0x80, 0xd8, 0x41, 0x42, 0x85, 0x57, 0x2a, 0x2c, 0x1f, 0x40, 0x43,
-	0xe8, 0xde, 0x29, 0xe3, 0x15, 0x3a, 0xc4, 0x32, 0xb6, 0xef, 0x25, 0x06, 0x7d, 0x0b, 0xea, 0xa2,
-	0xa7, 0x36, 0x0e, 0x71, 0xb8, 0x20, 0xb4, 0xf9, 0x97, 0x6a, 0x30, 0xb7, 0x61, 0x5e, 0x40, 0xea,
-	0xef, 0x2b, 0xec, 0x80, 0x1a, 0x36, 0x6c, 0x85, 0x2f, 0x3d, 0xa1, 0x2d, 0xf0, 0x11, 0xac, 0xea,
-	0x56, 0x85, 0xdf, 0xb2, 0xfa, 0x7d, 0xcf, 0x3d, 0x71, 0xba, 0x5c, 0xe8, 0xd7, 0x44, 0x7a, 0x2c,
-	0xcd, 0xc4, 0xf0, 0x37, 0xc2, 0x66, 0x3e, 0xe4, 0x58, 0x76, 0xc0, 0x56, 0xdb, 0x73, 0x02, 0xe6,
-	0x39, 0x16, 0xa5, 0x78, 0x5c, 0x8e, 0x26, 0x02, 0xdc, 0xa4, 0xd6, 0xb4, 0xbc, 0x82, 0xf3, 0x67,
-	0xc8, 0x2b, 0xa8, 0x5d, 0xd8, 0x30, 0x22, 0xa9, 0x93, 0x93, 0xe1, 0x64, 0x0b, 0x69, 0xe1, 0x64,
-	0x57, 0xa0, 0xe2, 0xf8, 0x5a, 0xe2, 0x29, 0x91, 0xf8, 0xb1, 0xec, 0xf8, 0x61, 0xd6, 0x29, 0xcd,
-	0xca, 0x5b, 0x8a, 0x5a, 0x79, 0xef, 0x43, 0x49, 0x24, 0x2a, 0x73, 0xba, 0x22, 0x0e, 0x78, 0xcc,
-	0x76, 0xc4, 0x81, 0x79, 0xb1, 0xf9, 0x5f, 0xf3, 0x50, 0x7a, 0x69, 0x05, 0x43, 0xd4, 0x81, 0xe1,
-	0x47, 0xd7, 0xab, 0x50, 0xe4, 0x14, 0xa2, 0x92, 0x1a, 0x67, 0xcc, 0xc2, 0xb1, 0x15, 0xc8, 0x43,
-	0xff, 0xa1, 0xc1, 0x38, 0xe9, 0xb6, 0x55, 0x6e, 0x98, 0x6d, 0x75, 0x15, 0xe6, 0xa4, 0x72, 0x7e,
-	0xcc, 0x7a, 0x03, 0x46, 0xf6, 0x4e, 0x85, 0xb4, 0x72, 0xac, 0x1b, 0xc7, 0x74, 0x31, 0x8e, 0x2a,
-	0x26, 0x38, 0xea, 0x2d, 0xa8, 0xab, 0x59, 0x8f, 0x1d, 0xe9, 0xa9, 0xfa, 0x51, 0x26, 0x15, 0xa4,
-	0x9b, 0x54, 0x9a, 0xde, 0x51, 0x8e, 0xe8, 0x1d, 0x8f, 0x60, 0x45, 0x66, 0x40, 0xb3, 0x7a, 0x98,
-	0xa2, 0x9c, 0x5b, 0x17, 0xf8, 0x21, 0x44, 0xc1, 0x69, 0x4b, 0xd4, 0xbc, 0x81, 0xad, 0x7b, 0xd4,
-	0x68, 0xdc, 0x87, 0x25, 0x8c, 0xa0, 0x4a, 0xf4, 0x12, 0x4c, 0xb7, 0x80, 0x8d, 0xb1, 0x3e, 0x77,
-	0x61, 0x21, 0x8d, 0x97, 0xe8, 0x0b, 0xaa, 0x56, 0x92, 0x8d, 0x24, 0x21, 0xa1, 0x4a, 0x54, 0x9b,
-	0x8c, 0x90, 0x50, 0x21, 0x7a, 0x00, 0x05, 0x4a, 0x95, 0x37, 0xc1, 0x76, 0x9a, 0x47, 0xfa, 0x73,
-	0x8d, 0xcf, 0x91, 0x35, 0x5a, 0xdc, 0x00, 0xe9, 0x08, 0xd5, 0x6e, 0x7c, 0xc4, 0x0d, 0xb7, 0x7e,
-	0xbe, 0xe1, 0x1d, 0x52, 0x2e, 0x7a, 0xff, 0xba, 0xbe, 0x68, 0xf2, 0x00, 0x0a, 0xe8, 0x9b, 0x9e,
-	0x28, 0xd6, 0x3f, 0xcf, 0x41, 0x37, 0x82, 0xa6, 0x07, 0xd5, 0xd8, 0xea, 0xe8, 0x41, 0x73, 0x39,
-	0x0a, 0x9a, 0x1b, 0xce, 0x72, 0x67, 0x49, 0xf9, 0xfd, 0x04, 0xaa, 0x28, 0xbb, 0x5e, 0x3a, 0xec,
-	0x35, 0x3a, 0x41, 0xcf, 0x12, 0x97, 0xd8, 0xfc, 0x3b, 0x06, 0xd4, 0x14, 0x9a, 0x9d, 0xc1, 0x7e,
-	0xc7, 0x69, 0x4f, 0x94, 0x84, 0x79, 0x58, 0x9e, 0xd8, 0x99, 0x89, 0xf2, 0xc4, 0xc6, 0x65, 0x87,
-	0x96, 0x61, 0x34, 0x37, 0x51, 0x86, 0xd1, 0x37, 0x88, 0x30, 0x8a, 0xa5, 0x8f, 0x2e, 0x24, 0xd3,
-	0x47, 0x27, 0x33, 0xc4, 0x16, 0xa7, 0xce, 0x10, 0x1b, 0xcf, 0x85, 0x58, 0x4a, 0xe6, 0x42, 0x8c,
-	0x19, 0x64, 0x90, 0xe6, 0x5d, 0xa4, 0x30, 0xf9, 0x72, 0xe4, 0xce, 0x52, 0x28, 0x7d, 0x2a, 0x11,
-	0xe9, 0xf3, 0x34, 0xaa, 0x2f, 0x21, 0xd3, 0xcd, 0x8d, 0xd7, 0x7f, 0xb5, 0x3e, 0xc8, 0x77, 0x32,
-	0x69, 0x6f, 0x75, 0xfa, 0xa4, 0xbd, 0xb5, 0x33, 0x6c, 0xae, 0xd2, 0x61, 0x5b, 0x1f, 0x93, 0xad,
-	0x71, 0x3e, 0x35, 0x5b, 0xe3, 0x27, 0xf1, 0x6d, 0xc4, 0x88, 0xdd, 0x55, 0x88, 0xf2, 0x48, 0x6c,
-	0x7f, 0xb9, 0x07, 0x85, 0xc0, 0x3a, 0xc1, 0x30, 0x8b, 0x85, 0xd1, 0xfd, 0xf2, 0x81, 0x75, 0xb2,
-	0xc5, 0x98, 0xf1, 0x43, 0xb8, 0x40, 0x3d, 0xc2, 0xf0, 0x45, 0x76, 0x42, 0xa1, 0xa5, 0x1c, 0xcf,
-	0xe2, 0x68, 0x3c, 0xab, 0x02, 0x8f, 0xd4, 0x8b, 0x9e, 0x52, 0x57, 0x8e, 0xfa, 0x63, 0x98, 0x93,
-	0xa8, 0x85, 0xaf, 0x62, 0x69, 0x34, 0xaa, 0xb2, 0x40, 0x25, 0x1c, 0x13, 0x1b, 0x50, 0x97, 0x11,
-	0x29, 0xaa, 0xff, 0xf2, 0xe8, 0xfe, 0x14, 0x15, 0xa3, 0x50, 0x6c, 0x86, 0x01, 0x30, 0x1c, 0x85,
-	0xf8, 0x26, 0xc7, 0xca, 0x68, 0x1c, 0xb5, 0x10, 0x87, 0xf8, 0x38, 0xc7, 0x0b, 0x58, 0x09, 0x23,
-	0x63, 0x58, 0x04, 0x55, 0x63, 0x34, 0xaa, 0x45, 0x15, 0x2f, 0xc3, 0x34, 0x7c, 0x4f, 0xd1, 0xc4,
-	0xf2, 0x07, 0x7d, 0xe6, 0x69, 0x31, 0x31, 0xab, 0xa3, 0x51, 0xd5, 0x65, 0x17, 0x15, 0x32, 0xf3,
-	0x08, 0x3d, 0xb9, 0xd2, 0x09, 0xb4, 0x36, 0xba, 0x7b, 0x89, 0x83, 0xaa, 0x69, 0x0d, 0xfb, 0xb5,
-	0x90, 0xff, 0x28, 0x33, 0xf5, 0xf0, 0x69, 0x55, 0xbd, 0xf1, 0x0e, 0x8b, 0xf1, 0x01, 0x94, 0x7b,
-	0x2c, 0x50, 0xf4, 0x79, 0x7e, 0x74, 0x6f, 0xe8, 0xb1, 0x40, 0x52, 0xe7, 0x36, 0x2c, 0xd2, 0x67,
-	0x5c, 0xa3, 0x24, 0x7e, 0x61, 0x34, 0x0a, 0x43, 0x74, 0xfa, 0x42, 0x27, 0xf4, 0x1d, 0x68, 0xd0,
-	0xb2, 0x10, 0x46, 0x6d, 0x5d, 0x2e, 0x8e, 0x46, 0xb7, 0x24, 0x3a, 0x8a, 0xd8, 0xf7, 0x70, 0x61,
-	0x5a, 0x70, 0x39, 0xfc, 0x42, 0x2c, 0xe1, 0x8c, 0xaf, 0xf8, 0xa5, 0xd1, 0x98, 0xcf, 0xab, 0x2f,
-	0xc8, 0x0a, 0xdc, 0xd1, 0x95, 0xff, 0x14, 0xaf, 0x0b, 0x70, 0xbc, 0x92, 0x45, 0x2f, 0x8f, 0x61,
-	0x6d, 0x01, 0xbe, 0x27, 0x18, 0xf5, 0x00, 0xae, 0x45, 0xbb, 0x0f, 0xe1, 0xd7, 0x2b, 0xa3, 0x91,
-	0x5e, 0xd2, 0x91, 0xa6, 0x71, 0xed, 0x6b, 0xb8, 0xa3, 0x08, 0x74, 0xa2, 0x07, 0x36, 0x47, 0x3f,
-	0xf0, 0xa6, 0xc4, 0x66, 0x8e, 0x79, 0xf0, 0x73, 0x58, 0xa6, 0xe7, 0x71, 0xba, 0xf0, 0x7c, 0xa6,
-	0xe8, 0xe3, 0xea, 0x18, 0x46, 0x13, 0xdd, 0x4c, 0xd1, 0x4b, 0x52, 0xc8, 0x26, 0xcc, 0x87, 0xa4,
-	0x21, 0x19, 0xe5, 0xda, 0x18, 0xee, 0xf7, 0x24, 0x51, 0x10, 0xbb, 0xbc, 0x80, 0x95, 0x04, 0x12,
-	0xe2, 0x9a, 0xeb, 0x13, 0xbd, 0xd4, 0x56, 0x94, 0x77, 0xc2, 0xdc, 0xf5, 0x37, 0x26, 0xc8, 0x5d,
-	0xaf, 0xb2, 0xb2, 0xdf, 0x1c, 0x9f, 0x95, 0xbd, 0xa1, 0x88, 0x37, 0x7e, 0xe8, 0x2e, 0x3e, 0x58,
-	0xb5, 0xdc, 0x0d, 0x93, 0x00, 0x68, 0x87, 0xef, 0xcd, 0xbf, 0x79, 0x9b, 0x3e, 0x20, 0x83, 0xfa,
-	0x92, 0x48, 0x9e, 0xfb, 0xe7, 0x0a, 0xd3, 0x9f, 0x2b, 0x4c, 0xff, 0xcf, 0x28, 0x4c, 0x2f, 0xe1,
-	0x9c, 0x5c, 0xab, 0xc8, 0xae, 0x42, 0x6c, 0x3a, 0x46, 0x7d, 0x6a, 0x50, 0x5f, 0x7d, 0x73, 0x11,
-	0xac, 0xfa, 0xdb, 0x61, 0x52, 0xf9, 0x28, 0x5e, 0x71, 0xc0, 0x3c, 0x4e, 0xbf, 0x5a, 0x4d, 0x41,
-	0xfc, 0x35, 0xf6, 0x34, 0xbe, 0x84, 0xa5, 0x54, 0xcc, 0xe3, 0x54, 0xad, 0x85, 0x14, 0x94, 0xc6,
-	0x67, 0xe1, 0x57, 0x14, 0xe5, 0xb6, 0x32, 0x46, 0xcd, 0x92, 0x74, 0x4a, 0xfb, 0xca, 0x0f, 0xc2,
-	0xb7, 0x91, 0x72, 0x5e, 0xcc, 0xdc, 0x18, 0x6d, 0xcb, 0x88, 0xa0, 0x11, 0x73, 0xf6, 0x15, 0x2c,
-	0xc7, 0x71, 0xd1, 0x6c, 0xad, 0x4c, 0x36, 0x34, 0x81, 0x8c, 0xe6, 0xe9, 0x08, 0xae, 0xc7, 0xb1,
-	0xa5, 0xef, 0x40, 0x63, 0x14, 0xb1, 0xcb, 0x11, 0xe4, 0x69, 0x5b, 0x4f, 0xca, 0x1c, 0x88, 0xfd,
-	0x62, 0x75, 0x9a, 0x39, 0x10, 0x5b, 0xc6, 0x0e, 0x34, 0xd2, 0xe9, 0xe6, 0xe0, 0x64, 0x9c, 0x9e,
-	0xb6, 0x94, 0xb2, 0xc0, 0x5b, 0x27, 0xc6, 0x4f, 0xe0, 0xf2, 0x30, 0x8c, 0x6a, 0xcd, 0xc7, 0xe8,
-	0x70, 0xe7, 0x52, 0x31, 0x13, 0x05, 0xfc, 0x2e, 0x5c, 0x1a, 0x8a, 0x1f, 0x3f, 0xc2, 0x1d, 0x8c,
-	0x53, 0xf2, 0xd2, 0xd1, 0xef, 0x60, 0xdf, 0xa4, 0x45, 0x73, 0xe1, 0x8c, 0x16, 0xcd, 0xc5, 0xef,
-	0xc8, 0xa2, 0xb9, 0xf4, 0xdd, 0x59, 0x34, 0x97, 0xdf, 0xd0, 0xa2, 0xb9, 0xf2, 0x1d, 0x58, 0x34,
-	0xcd, 0x29, 0x2d, 0x9a, 0x03, 0xb8, 0xa6, 0x14, 0xbc, 0x04, 0xb6, 0x96, 0xcf, 0x3a, 0x07, 0x18,
-	0x6f, 0x35, 0x4e, 0xeb, 0xba, 0x24, 0x91, 0x3c, 0x8f, 0xe2, 0xdf, 0x65, 0x9d, 0x83, 0x4d, 0xd7,
-	0x0f, 0x8c, 0x3d, 0x58, 0x4b, 0x7b, 0x0e, 0x51, 0xd4, 0x18, 0x4d, 0x6c, 0x25, 0x81, 0x9d, 0xa8,
-	0x69, 0x84, 0x3d, 0x76, 0xfd, 0x2c, 0xf6, 0xd8, 0xcf, 0xe0, 0x76, 0xe2, 0x2d, 0x63, 0x88, 0x35,
-	0x3e, 0xb8, 0x31, 0xfa, 0x11, 0xd7, 0x62, 0x6f, 0x1d, 0x79, 0x94, 0x62, 0x88, 0x49, 0x1e, 0x19,
-	0x2e, 0xc3, 0xcd, 0x37, 0x78, 0xa4, 0x5a, 0x0b, 0x5d, 0xa9, 0x1f, 0xf6, 0x48, 0xd2, 0xe6, 0xc4,
-	0x40, 0x6f, 0x4d, 0xa8, 0xd4, 0xa7, 0x3d, 0x15, 0x69, 0x95, 0xc6, 0x9a, 0x6e, 0xee, 0xbe, 0x35,
-	0xad, 0xb9, 0xfb, 0x5b, 0xb8, 0x19, 0xc7, 0xd0, 0x68, 0xeb, 0x72, 0x7b, 0xfc, 0x2e, 0x1f, 0x41,
-	0xa8, 0xd6, 0x22, 0x6a, 0x47, 0xbf, 0xfd, 0x46, 0x76, 0xf4, 0x3b, 0x6f, 0x64, 0x47, 0xdf, 0x99,
-	0xdc, 0x8e, 0xfe, 0x6d, 0x6d, 0x36, 0xe4, 0xf7, 0x6a, 0xf4, 0xc5, 0x5b, 0x1f, 0xaf, 0x9a, 0x68,
-	0x8b, 0xa7, 0x2f, 0x97, 0x50, 0x4d, 0x04, 0xe6, 0x08, 0xca, 0xbb, 0xe3, 0xf7, 0x6f, 0xec, 0xa5,
-	0x23, 0x6b, 0x43, 0x33, 0xfc, 0xdc, 0x4d, 0xd2, 0xec, 0xa7, 0x59, 0xbb, 0x37, 0x1a, 0xf3, 0x45,
-	0xf5, 0xc1, 0x9b, 0xb8, 0x0f, 0x40, 0xcc, 0x22, 0x83, 0xab, 0x23, 0x1f, 0x42, 0xfa, 0xc7, 0xbb,
-	0xe3, 0x85, 0x59, 0xfa, 0x53, 0x48, 0x17, 0xd1, 0xb4, 0xc1, 0x54, 0x17, 0xc6, 0xfd, 0xc9, 0xb4,
-	0xc1, 0x24, 0x7e, 0x5d, 0x67, 0x8a, 0xb9, 0x07, 0x1e, 0x4c, 0xa6, 0x33, 0xe9, 0x76, 0x35, 0x31,
-	0x4a, 0x0a, 0x36, 0x9a, 0xed, 0x87, 0x93, 0xa9, 0xc3, 0x3a, 0x4e, 0x31, 0xcf, 0x3f, 0x84, 0x0b,
-	0x43, 0x10, 0xd3, 0x0c, 0xbf, 0x37, 0xcd, 0x0c, 0x44, 0xf4, 0x3c, 0x33, 0xcc, 0xeb, 0x9b, 0x74,
-	0xe6, 0x3c, 0x1a, 0x8d, 0x76, 0x39, 0x82, 0x36, 0x14, 0xeb, 0x3f, 0x86, 0x8b, 0x31, 0xff, 0x50,
-	0x7c, 0xb7, 0x78, 0x7f, 0x34, 0xe2, 0xb5, 0x88, 0x97, 0x28, 0xba, 0x67, 0x0c, 0xf3, 0x63, 0x7d,
-	0x30, 0xbd, 0x1f, 0x2b, 0x74, 0x30, 0x24, 0x94, 0xc5, 0x0f, 0x27, 0x72, 0x30, 0xc4, 0x74, 0xc5,
-	0x51, 0x7e, 0xb1, 0x8f, 0xce, 0xe4, 0x17, 0xeb, 0xc1, 0xad, 0xb8, 0xb0, 0x49, 0xa0, 0x96, 0x52,
-	0xe2, 0xe3, 0xd1, 0x4f, 0xb8, 0x1a, 0x15, 0x3c, 0xb1, 0x27, 0x91, 0xd4, 0xf8, 0x0b, 0xf0, 0xee,
-	0xb0, 0xe7, 0x0d, 0xdf, 0x24, 0x3f, 0x19, 0xfd, 0xe0, 0xdb, 0xa9, 0x0f, 0x4e, 0xdf, 0x2a, 0x27,
-	0xf1, 0x03, 0x7e, 0xfa, 0x26, 0x7e, 0xc0, 0x53, 0x58, 0x9f, 0x74, 0x80, 0x34, 0xad, 0xbf, 0x31,
-	0xfa, 0x71, 0xb7, 0xc6, 0x8f, 0x8e, 0xe6, 0x36, 0xe9, 0x82, 0xfc, 0xec, 0x57, 0xe1, 0x82, 0xfc,
-	0xfc, 0xd7, 0xed, 0x82, 0xdc, 0xf8, 0x8e, 0x5c, 0x90, 0xcf, 0x14, 0x63, 0x47, 0xd5, 0xfc, 0xc7,
-	0xa3, 0xf1, 0xcf, 0xeb, 0x03, 0x12, 0xfa, 0xc1, 0x70, 0x67, 0xe6, 0xe6, 0x77, 0xe6, 0xcc, 0x7c,
-	0xf2, 0xdd, 0x39, 0x33, 0x9f, 0x9e, 0xc5, 0x99, 0xa9, 0xab, 0x21, 0x72, 0xda, 0x74, 0x9d, 0x61,
-	0x6b, 0x42, 0x35, 0x84, 0x56, 0x45, 0xd3, 0x1c, 0x42, 0x37, 0xe9, 0x17, 0xd3, 0xb8, 0x49, 0x9f,
-	0xbd, 0x89, 0x9b, 0x74, 0x7b, 0xa4, 0x9b, 0xf4, 0x27, 0x50, 0x37, 0x59, 0xdb, 0xed, 0x76, 0x59,
-	0xcf, 0x66, 0x36, 0x66, 0xa8, 0xd0, 0x22, 0xc9, 0x33, 0x43, 0x33, 0xbc, 0x64, 0x87, 0xe6, 0x71,
-	0x8a, 0x1c, 0x8c, 0x37, 0x7f, 0x4a, 0x69, 0x2f, 0xf6, 0xac, 0xfd, 0x0e, 0x9b, 0x2a, 0xed, 0xc5,
-	0x3d, 0xc8, 0x7b, 0x18, 0x76, 0x47, 0xc1, 0xbb, 0x0d, 0xcd, 0x71, 0x2a, 0x11, 0x9a, 0x1c, 0xc0,
-	0x24, 0xb8, 0xe6, 0x6f, 0x42, 0x2d, 0xd6, 0x84, 0xdf, 0xdf, 0x70, 0x7d, 0x27, 0x90, 0x83, 0xc9,
-	0x99, 0xaa, 0xac, 0xbe, 0xf3, 0x2e, 0x5e, 0x58, 0xff, 0xce, 0xbb, 0xb8, 0x21, 0x90, 0x0d, 0xdc,
-	0xe6, 0x22, 0x64, 0xb7, 0x13, 0x59, 0x8e, 0x9b, 0xeb, 0x50, 0x44, 0xf4, 0xdb, 0xe2, 0xa3, 0x14,
-	0x63, 0xbf, 0x16, 0xff, 0x1f, 0x66, 0x60, 0x2d, 0xf6, 0x8d, 0x7c, 0x4c, 0xb2, 0x22, 0xb6, 0xf8,
-	0xd8, 0x45, 0xfa, 0x4c, 0xfc, 0x22, 0x3d, 0x6f, 0xb6, 0x4e, 0xa2, 0xd7, 0x98, 0x4a, 0x5d, 0xeb,
-	0x24, 0x8c, 0xad, 0x21, 0x69, 0xab, 0x5d, 0x74, 0x04, 0x51, 0xf5, 0xc2, 0xea, 0x32, 0xfc, 0xc8,
-	0x46, 0xe4, 0x5a, 0x3d, 0x8a, 0x96, 0x59, 0xfa, 0xc8, 0x86, 0x7e, 0xb5, 0x9e, 0x8b, 0x8a, 0x5b,
-	0xa1, 0x35, 0xaf, 0xcc, 0x1a, 0x11, 0x9e, 0x57, 0x8d, 0xda, 0x99, 0xc6, 0x87, 0xb0, 0x9a, 0x30,
-	0xb9, 0x62, 0x01, 0x7a, 0xcb, 0xd1, 0x2e, 0x91, 0xcc, 0x3b, 0x7e, 0xe4, 0x75, 0x0a, 0x14, 0x55,
-	0xef, 0x6b, 0xaf, 0x12, 0xbf, 0x60, 0x5f, 0x9c, 0xfc, 0x82, 0x7d, 0x69, 0xe8, 0x05, 0xfb, 0x7b,
-	0xb0, 0xa8, 0x58, 0xe5, 0xc8, 0xed, 0x32, 0x99, 0x22, 0x46, 0x38, 0xa9, 0x0d, 0xd9, 0xf6, 0xcc,
-	0xed, 0x32, 0x4a, 0xb4, 0x78, 0x05, 0x2a, 0x22, 0xa7, 0x0c, 0x41, 0x96, 0xe9, 0x3b, 0x83, 0xbc,
-	0x8e, 0xd2, 0xc8, 0xfc, 0x22, 0x0b, 0xd7, 0x53, 0x16, 0x38, 0xcc, 0x8a, 0xc6, 0x05, 0x01, 0xde,
-	0x04, 0x88, 0xad, 0x56, 0x66, 0xc2, 0xd5, 0xca, 0x4e, 0xb1, 0x5a, 0x33, 0xd3, 0xaf, 0xd6, 0xec,
-	0xc8, 0xd5, 0x1a, 0x72, 0x11, 0x34, 0x97, 0x7e, 0x11, 0xb4, 0xf9, 0x9f, 0x33, 0x70, 0x69, 0xc4,
-	0x64, 0xe0, 0x34, 0xa4, 0x8f, 0x32, 0x33, 0xc5, 0x28, 0xb3, 0xd3, 0x8f, 0x72, 0xe6, 0x2c, 0xa3,
-	0x9c, 0x1d, 0x32, 0xca, 0x5f, 0x66, 0xe0, 0xdc, 0x88, 0x51, 0x1a, 0x5b, 0xea, 0x7b, 0xc2, 0x99,
-	0xd8, 0xc7, 0x5c, 0x26, 0x22, 0x14, 0xf5, 0x75, 0xe1, 0x67, 0x00, 0x5a, 0x8a, 0xee, 0xf8, 0xad,
-	0x88, 0x31, 0xf3, 0x6c, 0x6a, 0x7d, 0x8d, 0xcf, 0x21, 0x2f, 0xf6, 0x06, 0xba, 0x32, 0x30, 0x39,
-	0x16, 0xea, 0xd7, 0xfc, 0xc7, 0x59, 0x98, 0xf9, 0x92, 0x9d, 0xa6, 0x1d, 0xa3, 0xe1, 0x51, 0x50,
-	0x56, 0xcb, 0xd1, 0x73, 0x0d, 0xaa, 0xfa, 0x27, 0x34, 0x55, 0xe8, 0x70, 0xe5, 0x95, 0xfa, 0x74,
-	0xe6, 0xb6, 0x1d, 0x4f, 0x9c, 0x97, 0x4b, 0x24, 0xce, 0xd3, 0x83, 0x93, 0xf3, 0xd1, 0xe0, 0xe4,
-	0x37, 0xf8, 0x64, 0x17, 0x7e, 0xe2, 0x1c, 0x2f, 0x64, 0x4c, 0x78, 0x77, 0x00, 0x24, 0xf8, 0x9e,
-	0x2b, 0x3a, 0xdb, 0x8c, 0x75, 0x27, 0xcd, 0x4f, 0x03, 0x12, 0x7c, 0x23, 0x68, 0xfe, 0x51, 0x01,
-	0xaa, 0x3b, 0x91, 0x80, 0xf3, 0xe9, 0xef, 0x7f, 0x9c, 0x83, 0x92, 0x08, 0x1e, 0x17, 0xb3, 0x8a,
-	0x9f, 0xa1, 0x15, 0x15, 0x22, 0xc9, 0xb8, 0x76, 0xd7, 0x69, 0x36, 0x7e, 0xd7, 0xa9, 0x01, 0x85,
-	0x7d, 0xab, 0x63, 0xf5, 0xda, 0x52, 0xaa, 0xcb, 0x62, 0x64, 0x2f, 0xce, 0xc7, 0xf6, 0xe2, 0xef,
-	0xe7, 0x9a, 0x46, 0xfa, 0xcd, 0xb5, 0xd2, 0xb0, 0x9b, 0x6b, 0xb1, 0x5c, 0x90, 0x90, 0xcc, 0x05,
-	0xf9, 0x11, 0x42, 0x04, 0x4e, 0x4f, 0x7c, 0xaa, 0x21, 0x9e, 0x48, 0x5f, 0xb2, 0xc1, 0x63, 0xab,
-	0xf7, 0xca, 0xe9, 0x1d, 0x9a, 0x3a, 0xb0, 0xf1, 0x11, 0x97, 0x2c, 0xb4, 0x2a, 0xe1, 0x27, 0xe2,
-	0x29, 0xed, 0x5f, 0x45, 0x26, 0x92, 0x11, 0x00, 0x1b, 0xb2, 0x9d, 0x12, 0x00, 0x3e, 0x82, 0x42,
-	0xdb, 0xed, 0xf6, 0xad, 0xde, 0x69, 0x22, 0xe7, 0xb4, 0x7c, 0xe6, 0xa6, 0x68, 0xdf, 0xee, 0x1d,
-	0xb8, 0xa6, 0x04, 0xd6, 0x8e, 0x43, 0xab, 0x91, 0xe3, 0xd0, 0xd8, 0x41, 0x6f, 0x2d, 0x79, 0xd0,
-	0x7b, 0x05, 0x2a, 0x07, 0x96, 0xd3, 0x19, 0x78, 0x4c, 0x1c, 0xd2, 0xd6, 0xe9, 0x4b, 0x6c, 0xa2,
-	0x0e, 0x0f, 0x69, 0x6f, 0x42, 0x4d, 0x82, 0x74, 0x99, 0xef, 0x5b, 0x87, 0x8c, 0x3e, 0xb0, 0x5f,
-	0xa5, 0xea, 0xe7, 0xa2, 0xd6, 0xb8, 0x0b, 0x0b, 0x12, 0x50, 0x7f, 0xaa, 0x08, 0xb0, 0x36, 0xa8,
-	0x49, 0xbf, 0x60, 0x10, 0x65, 0xcc, 0xc6, 0xd9, 0xe3, 0x41, 0x57, 0xa7, 0x89, 0x07, 0xfd, 0x14,
-	0x2a, 0x96, 0xe7, 0x39, 0xc7, 0x16, 0x45, 0xb1, 0xae, 0x4d, 0x70, 0x13, 0x55, 0xc0, 0xe3, 0xd9,
-	0xb0, 0x16, 0x4e, 0x7a, 0x6e, 0xe2, 0x70, 0xd2, 0x7f, 0x95, 0x81, 0xa5, 0x28, 0x37, 0x0f, 0xbb,
-	0x2a, 0x92, 0x7e, 0x03, 0x25, 0x9b, 0x7e, 0x03, 0x65, 0xea, 0xcb, 0x22, 0xb9, 0xa1, 0x97, 0x45,
-	0xa6, 0xfa, 0x00, 0xc0, 0xcf, 0xb3, 0x50, 0x0b, 0x99, 0x40, 0x88, 0x85, 0xa9, 0xa5, 0xd3, 0xa8,
-	0x3b, 0x8a, 0x8b, 0x90, 0xb3, 0xd9, 0xbe, 0x23, 0xef, 0xe0, 0x8a, 0x02, 0x1f, 0x6d, 0xdb, 0x63,
-	0xb6, 0xa3, 0x72, 0xc0, 0x8a, 0x12, 0xa7, 0xd0, 0xd8, 0xdd, 0x3b, 0x8a, 0x3d, 0xaf, 0x46, 0x2f,
-	0xd5, 0x71, 0xb4, 0xc2, 0x72, 0x14, 0x5a, 0xa4, 0x28, 0xbc, 0xc9, 0xad, 0x99, 0x3f, 0xcc, 0x42,
-	0x45, 0x04, 0x3f, 0x88, 0x24, 0x9d, 0xf8, 0x55, 0x1e, 0x32, 0x01, 0x9d, 0xb6, 0x52, 0xdb, 0x44,
-	0x2c, 0x08, 0xda, 0x4c, 0xc9, 0x9b, 0x32, 0xd9, 0x09, 0x6e, 0xca, 0xd8, 0xe1, 0xe7, 0x58, 0x12,
-	0xc1, 0x0a, 0x22, 0x71, 0x2d, 0x26, 0xe5, 0xd5, 0xbe, 0xe5, 0x5b, 0xa6, 0x3a, 0xd4, 0x15, 0xaf,
-	0xc2, 0x9c, 0x5a, 0x0b, 0x84, 0x11, 0x74, 0x50, 0x91, 0x95, 0x08, 0x74, 0x57, 0x5a, 0x91, 0xf9,
-	0x58, 0xd6, 0x7a, 0x7d, 0x80, 0xba, 0x31, 0x79, 0x01, 0x40, 0x6c, 0xb9, 0x18, 0xbc, 0x20, 0xa2,
-	0x48, 0x4a, 0x58, 0x83, 0x37, 0x78, 0xae, 0xc2, 0x9c, 0xda, 0xb2, 0xb5, 0x6c, 0xfe, 0x15, 0x59,
-	0xc9, 0x1f, 0xda, 0xfc, 0x84, 0x82, 0x6f, 0x34, 0xf4, 0xa9, 0xd9, 0x6e, 0x16, 0x21, 0x27, 0x66,
-	0x94, 0x12, 0xdc, 0x62, 0xa1, 0xf9, 0x14, 0x6a, 0xcf, 0x2c, 0x75, 0x93, 0x06, 0x3b, 0x8f, 0xfa,
-	0x02, 0xea, 0x90, 0x9b, 0xcf, 0xcd, 0xff, 0x9e, 0x85, 0x0a, 0x5a, 0xf4, 0xce, 0xb7, 0xcc, 0xfe,
-	0xc6, 0xeb, 0x70, 0x5a, 0x66, 0xd2, 0xa8, 0xcd, 0x32, 0xbc, 0x09, 0xe5, 0x0d, 0xa8, 0x53, 0xd6,
-	0x1b, 0x60, 0xbb, 0x4f, 0xeb, 0x91, 0x15, 0x4c, 0xac, 0x32, 0xbe, 0x66, 0x6d, 0xe4, 0x85, 0x6f,
-	0x25, 0xb3, 0x65, 0xbf, 0x3d, 0xe2, 0xe5, 0x03, 0x8f, 0x36, 0xcb, 0xec, 0x01, 0xa6, 0xff, 0xb6,
-	0x3c, 0x9a, 0xb1, 0xac, 0x85, 0xe5, 0xbe, 0xcc, 0x5a, 0x9b, 0xed, 0x8b, 0x9d, 0x3e, 0x20, 0xdb,
-	0x24, 0xeb, 0x60, 0xb9, 0xdf, 0xa1, 0x8d, 0x2a, 0xdb, 0x17, 0xef, 0xd7, 0x21, 0xfb, 0x22, 0xcb,
-	0xb0, 0xfc, 0xca, 0xa5, 0xcd, 0x25, 0xfb, 0xca, 0xe5, 0xe5, 0x9f, 0x5a, 0x94, 0x3c, 0x2c, 0xfb,
-	0x53, 0x8b, 0x97, 0x8f, 0x3b, 0xb4, 0x37, 0x64, 0x8f, 0x11, 0xfe, 0x48, 0xe6, 0xe5, 0xcc, 0x1e,
-	0xe1, 0xfb, 0x06, 0x47, 0x24, 0xfb, 0xb3, 0x01, 0xbe, 0x6f, 0xdb, 0x27, 0x29, 0x9f, 0x6d, 0xe3,
-	0xf8, 0xf6, 0x0f, 0x49, 0x90, 0x67, 0xf7, 0x0f, 0x71, 0x3c, 0x0e, 0xdd, 0x8c, 0xc9, 0x1e, 0x38,
-	0xbc, 0xec, 0x1f, 0x63, 0xf4, 0x46, 0xc9, 0xcc, 0xfa, 0xc7, 0x38, 0x1f, 0x16, 0x5d, 0x7b, 0xc9,
-	0xda, 0xf8, 0xfc, 0xc0, 0xc3, 0x78, 0x0a, 0x8e, 0xdf, 0x6b, 0x1e, 0x42, 0x6d, 0xbb, 0x6b, 0x1d,
-	0xb2, 0x4d, 0xb7, 0xd3, 0x11, 0xd7, 0x30, 0x8c, 0x3b, 0xea, 0xfb, 0xd0, 0x99, 0x58, 0xf8, 0x93,
-	0xbe, 0x32, 0xea, 0xb3, 0xd1, 0xd7, 0xa1, 0x36, 0xf0, 0x59, 0xcb, 0xed, 0xb1, 0xd6, 0x81, 0xeb,
-	0xb5, 0xac, 0x4e, 0x87, 0x72, 0x17, 0x57, 0x06, 0x3e, 0xfb, 0xba, 0xc7, 0xb6, 0x5c, 0x6f, 0xa3,
-	0xd3, 0x69, 0xfe, 0x22, 0x03, 0x15, 0x52, 0x22, 0x95, 0xcb, 0xe2, 0x6c, 0x99, 0x7e, 0x53, 0x92,
-	0x27, 0xae, 0xa3, 0x21, 0x70, 0xec, 0x78, 0xc1, 0x40, 0xbf, 0x2d, 0x25, 0x0c, 0x81, 0x79, 0xc7,
-	0x7f, 0x29, 0x5a, 0x94, 0x0b, 0xe5, 0x7f, 0xcd, 0xc0, 0x32, 0xc5, 0x72, 0xc5, 0x9a, 0x38, 0xc9,
-	0x77, 0xdc, 0x43, 0x57, 0x92, 0x3c, 0xff, 0x6f, 0x7c, 0xaa, 0x52, 0xca, 0xcc, 0x44, 0x3e, 0x14,
-	0x95, 0x8e, 0x62, 0x9d, 0xb3, 0x93, 0x48, 0x37, 0x2d, 0x38, 0xe6, 0x77, 0xa0, 0x46, 0x79, 0xc3,
-	0xd5, 0xb6, 0x2d, 0xfc, 0x23, 0x0f, 0xc6, 0x61, 0xda, 0x15, 0xdd, 0x68, 0x5b, 0x17, 0x38, 0xab,
-	0x7e, 0xa4, 0x92, 0x2f, 0x17, 0xb2, 0xa0, 0xbc, 0xe4, 0x1c, 0x89, 0x56, 0x53, 0xd3, 0x6d, 0x12,
-	0x90, 0xfa, 0xda, 0xe7, 0x80, 0xcb, 0x1b, 0x9f, 0xb5, 0x44, 0xb2, 0xea, 0x5c, 0xf8, 0xb5, 0x4f,
-	0x6a, 0x10, 0x19, 0xf4, 0xe5, 0xd7, 0x3e, 0xa3, 0xd0, 0xf9, 0xf0, 0x6b, 0x9f, 0x11, 0xe8, 0x1b,
-	0x50, 0xf3, 0x59, 0xa7, 0x23, 0xae, 0x27, 0xe9, 0xb2, 0x68, 0x8e, 0x57, 0xe3, 0xdd, 0x24, 0x2e,
-	0x8f, 0xd6, 0xde, 0x87, 0x92, 0x9a, 0xa3, 0xa9, 0xbe, 0x1e, 0xbf, 0x01, 0x0b, 0x29, 0x53, 0x32,
-	0x55, 0x16, 0xee, 0x5f, 0x66, 0x60, 0x11, 0xe5, 0xdc, 0x26, 0x6e, 0x1d, 0x8f, 0x4f, 0x77, 0xac,
-	0xd3, 0x8e, 0xd3, 0x7b, 0x85, 0xa9, 0x03, 0xc5, 0xdf, 0xf0, 0x9e, 0x7f, 0x89, 0x6a, 0x84, 0x55,
-	0x23, 0x5c, 0x0a, 0xea, 0x0b, 0x5d, 0x05, 0x2c, 0x6f, 0xf7, 0x79, 0x4f, 0xe1, 0xfe, 0x53, 0xc9,
-	0xd6, 0x31, 0xfb, 0x32, 0xaf, 0xe1, 0x22, 0xec, 0x12, 0x94, 0x1d, 0xbf, 0xa5, 0x12, 0x7b, 0xcb,
-	0x6f, 0xb2, 0xf9, 0x4f, 0x65, 0x6a, 0xef, 0x5f, 0x75, 0x56, 0xee, 0xc7, 0x9f, 0xfd, 0xe8, 0xd3,
-	0x43, 0x27, 0x38, 0x1a, 0xec, 0xaf, 0xb7, 0xdd, 0xee, 0x5d, 0xe9, 0xeb, 0x54, 0x7f, 0xee, 0x10,
-	0xc1, 0xdc, 0xc1, 0xad, 0xd9, 0xbb, 0xdb, 0x7f, 0x75, 0x78, 0x17, 0x37, 0xdb, 0xbb, 0xd4, 0xb0,
-	0x9f, 0xc7, 0xe2, 0x83, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x7d, 0xcd, 0xe0, 0x69, 0xb3,
-	0x00, 0x00,
+	// 14685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5b, 0x6c, 0x24, 0x49,
+	0x76, 0x18, 0x8a, 0x7a, 0xb2, 0xea, 0x54, 0xb1, 0x8a, 0x4c, 0xbe, 0x8a, 0xec, 0x77, 0x75, 0xf7,
+	0x4c, 0xcf, 0x8b, 0x33, 0xd3, 0xdd, 0xd3, 0xf3, 0xde, 0x19, 0x36, 0xbb, 0x7b, 0x9a, 0x3b, 0xd3,
+	0x33, 0xdc, 0x6c, 0x4e, 0xaf, 0x76, 0x57, 0xda, 0x42, 0x76, 0x55, 0x90, 0xcc, 0xed, 0xaa, 0xca,
+	0xda, 0xcc, 0x2c, 0x36, 0xb9, 0x17, 0xba, 0xb8, 0x17, 0x10, 0xf6, 0x2e, 0x04, 0x09, 0xf7, 0xe3,
+	0x42, 0x17, 0x16, 0xfc, 0xb5, 0x80, 0xe0, 0x2f, 0x5b, 0x80, 0x05, 0xdb, 0xf0, 0x97, 0x04, 0xdb,
+	0xb0, 0x01, 0xc3, 0x82, 0x00, 0xcb, 0x16, 0x20, 0xc3, 0x80, 0x25, 0xc0, 0x96, 0x0d, 0x01, 0x82,
+	0x00, 0x19, 0xb6, 0xe1, 0x4f, 0x23, 0xce, 0x89, 0x88, 0x8c, 0xc8, 0xcc, 0x7a, 0x91, 0xb3, 0x3b,
+	0xb6, 0xa1, 0x9f, 0x42, 0x45, 0xc4, 0x89, 0x93, 0xf1, 0x38, 0xe7, 0xc4, 0x89, 0x13, 0x27, 0x4e,
+	0xc0, 0xfc, 0x53, 0xb7, 0xdb, 0x75, 0xfb, 0x07, 0x9b, 0x03, 0xdf, 0x0b, 0x3d, 0x6b, 0x4e, 0x24,
+	0x37, 0x2e, 0x1d, 0x78, 0xde, 0x41, 0x97, 0xbd, 0x8e, 0xd9, 0x4f, 0x87, 0xfb, 0xaf, 0x87, 0x6e,
+	0x8f, 0x05, 0xa1, 0xd3, 0x1b, 0x10, 0x64, 0xf3, 0x05, 0xc8, 0x7f, 0xee, 0xf4, 0x98, 0x55, 0x83,
+	0x2c, 0xeb, 0x37, 0x32, 0x97, 0x33, 0x37, 0xca, 0x76, 0x96, 0xf5, 0x79, 0xda, 0x1f, 0x36, 0xb2,
+	0x94, 0xf6, 0x87, 0xcd, 0xdf, 0x9c, 0x07, 0xeb, 0x0b, 0xbf, 0xc3, 0xfc, 0x6d, 0x9f, 0x39, 0x21,
+	0xb3, 0xd9, 0x0f, 0x87, 0x2c, 0x08, 0xad, 0x0b, 0x00, 0x03, 0xdf, 0xfb, 0x01, 0x6b, 0x87, 0x2d,
+	0xb7, 0x23, 0xaa, 0x97, 0x45, 0xce, 0x4e, 0xc7, 0x3a, 0x0f, 0xe5, 0xc0, 0x3d, 0xe8, 0x3b, 0xe1,
+	0xd0, 0x67, 0x02, 0x59, 0x94, 0x61, 0xad, 0x42, 0xd1, 0xe9, 0x79, 0xc3, 0x7e, 0xd8, 0xc8, 0x5d,
+	0xce, 0xdc, 0xc8, 0xd8, 0x22, 0x65, 0x6d, 0x40, 0xa9, 0x3d, 0xf4, 0x7d, 0xd6, 0x6f, 0x9f, 0x34,
+	0xf2, 0x58, 0x49, 0xa5, 0xad, 0x06, 0xcc, 0x39, 0xed, 0x36, 0x56, 0x2a, 0x60, 0x91, 0x4c, 0x5a,
+	0xeb, 0x50, 0xf2, 0x78, 0x03, 0x79, 0x43, 0x8a, 0x54, 0x84, 0xe9, 0x9d, 0x8e, 0x75, 0x19, 0x2a,
+	0x1d, 0x16, 0xb4, 0x7d, 0x77, 0x10, 0xba, 0x5e, 0xbf, 0x31, 0x87, 0xa5, 0x7a, 0x96, 0x75, 0x1d,
+	0x6a, 0x03, 0xe7, 0xa4, 0xc7, 0xfa, 0x61, 0xab, 0xc7, 0xc2, 0x43, 0xaf, 0xd3, 0x28, 0x21, 0xd0,
+	0xbc, 0xc8, 0x7d, 0x84, 0x99, 0xbc, 0xbb, 0x43, 0xbf, 0xdb, 0x3a, 0x62, 0xbe, 0xbb, 0x7f, 0xd2,
+	0x28, 0x53, 0x87, 0x86, 0x7e, 0xf7, 0x09, 0x66, 0xc8, 0xe2, 0xbe, 0x17, 0xf2, 0x62, 0x50, 0xc5,
+	0x9f, 0x63, 0x86, 0x75, 0x09, 0x2a, 0xbc, 0x38, 0x18, 0xb6, 0xdb, 0x2c, 0x08, 0x1a, 0x15, 0x2c,
+	0xe7, 0x35, 0x1e, 0x53, 0x0e, 0xef, 0x02, 0x07, 0xd8, 0x77, 0xdc, 0x6e, 0xa3, 0x4a, 0x5d, 0x18,
+	0xfa, 0xdd, 0x07, 0x8e, 0xdb, 0xe5, 0x75, 0x07, 0xce, 0x09, 0xf3, 0x5b, 0xac, 0xc7, 0x4b, 0xe7,
+	0xa9, 0x2e, 0x66, 0xdd, 0xef, 0x19, 0x00, 0x83, 0x43, 0xaf, 0xcf, 0x1a, 0x35, 0x0d, 0x60, 0x97,
+	0xe7, 0xf0, 0xd1, 0xf6, 0xd9, 0x01, 0xef, 0x7f, 0x1d, 0xcb, 0x44, 0x8a, 0x7f, 0x94, 0x2a, 0xba,
+	0x83, 0xc6, 0x02, 0x7d, 0x14, 0xd3, 0x3b, 0x03, 0xeb, 0x03, 0x28, 0x78, 0xe1, 0x21, 0xf3, 0x1b,
+	0x8b, 0x97, 0x73, 0x37, 0x2a, 0x37, 0x5f, 0xd8, 0x94, 0x54, 0x96, 0xa4, 0x84, 0xcd, 0x2f, 0x38,
+	0xe0, 0xfd, 0x7e, 0xe8, 0x9f, 0xd8, 0x54, 0xc9, 0xda, 0x01, 0xf0, 0x9d, 0xe7, 0xad, 0x81, 0xe3,
+	0x3b, 0xbd, 0xa0, 0x61, 0x21, 0x8a, 0x97, 0xc7, 0xa1, 0xb0, 0x9d, 0xe7, 0xbb, 0x08, 0x4c, 0x68,
+	0xca, 0xbe, 0x4c, 0xf3, 0x36, 0x72, 0x54, 0x4f, 0xbd, 0xce, 0x49, 0x63, 0x89, 0xda, 0xe8, 0x3b,
+	0xcf, 0xef, 0x7a, 0x9d, 0x13, 0x6b, 0x0d, 0xe6, 0xdc, 0xa0, 0xf5, 0x83, 0xc0, 0xeb, 0x37, 0x96,
+	0x2f, 0x67, 0x6e, 0x94, 0xec, 0xa2, 0x1b, 0x7c, 0x33, 0xf0, 0xfa, 0x9c, 0x8a, 0xba, 0x4e, 0xff,
+	0x60, 0xe8, 0x1c, 0xb0, 0xc6, 0x0a, 0x51, 0x91, 0x4c, 0xf3, 0xb2, 0x81, 0xef, 0x75, 0x86, 0xed,
+	0x30, 0x68, 0xac, 0x5e, 0xce, 0xf1, 0x32, 0x99, 0xb6, 0xee, 0x43, 0xa9, 0xc7, 0x42, 0xa7, 0xe3,
+	0x84, 0x4e, 0x63, 0x0d, 0x1b, 0xfd, 0xd2, 0xb8, 0x46, 0x3f, 0x12, 0xb0, 0xd4, 0x66, 0x55, 0xd5,
+	0xfa, 0x1e, 0x2c, 0x0c, 0x7c, 0xf7, 0xc8, 0x09, 0x59, 0x4b, 0xa1, 0x6b, 0x20, 0xba, 0x37, 0xc6,
+	0xa1, 0xdb, 0xa5, 0x3a, 0x26, 0xd6, 0xfa, 0xc0, 0xcc, 0xe5, 0xe4, 0xea, 0xb3, 0x36, 0x73, 0x07,
+	0x61, 0xab, 0x3f, 0xec, 0x3d, 0x65, 0x7e, 0x63, 0x9d, 0xc8, 0x55, 0xe4, 0x7e, 0x8e, 0x99, 0x9c,
+	0x26, 0x24, 0xd8, 0xd0, 0xef, 0x36, 0x36, 0x88, 0x26, 0x44, 0xd6, 0x97, 0x7e, 0x97, 0x13, 0xac,
+	0x1b, 0x04, 0x43, 0xe6, 0x63, 0xf9, 0x39, 0x22, 0x58, 0xca, 0xe1, 0xc5, 0x97, 0xa0, 0xe2, 0x06,
+	0x2d, 0xd6, 0x7b, 0xca, 0x3a, 0x1d, 0xd6, 0x69, 0x9c, 0xc7, 0xf1, 0x05, 0x37, 0xb8, 0x2f, 0x72,
+	0xac, 0x65, 0x28, 0x84, 0xde, 0x33, 0xd6, 0x6f, 0x5c, 0xc0, 0xaa, 0x94, 0xb0, 0x5e, 0x80, 0xfc,
+	0x30, 0x60, 0x7e, 0xe3, 0xe2, 0xe5, 0xcc, 0x8d, 0xca, 0x4d, 0xcb, 0xec, 0xee, 0x97, 0x01, 0xf3,
+	0x6d, 0x2c, 0xb7, 0xae, 0x41, 0x6d, 0x10, 0x0c, 0x5a, 0xc4, 0xb5, 0xc3, 0xa1, 0xdb, 0x69, 0x5c,
+	0x42, 0x34, 0xd5, 0x41, 0x30, 0x20, 0xd8, 0xa1, 0xdb, 0xb1, 0x2c, 0xc8, 0x87, 0x27, 0x03, 0xd6,
+	0xb8, 0x8c, 0x65, 0xf8, 0x1f, 0x89, 0xbd, 0xeb, 0x84, 0xfb, 0x9e, 0xdf, 0xe3, 0xec, 0x7e, 0x45,
+	0x10, 0xbb, 0xc8, 0xda, 0xe9, 0x58, 0x2f, 0xc1, 0x82, 0xe8, 0x98, 0xcf, 0xf6, 0x19, 0x17, 0x1d,
+	0xac, 0xd1, 0x44, 0xa8, 0x3a, 0xe5, 0xdb, 0x32, 0xdb, 0xba, 0x09, 0x2b, 0x71, 0xd0, 0x16, 0x7e,
+	0xf0, 0x2a, 0xc2, 0x2f, 0xc5, 0xe0, 0xf7, 0xf8, 0xf7, 0x39, 0xa3, 0x87, 0xbd, 0x56, 0xe0, 0x0d,
+	0xfd, 0x36, 0x6b, 0x5c, 0x13, 0x8c, 0x1e, 0xf6, 0x1e, 0x63, 0x86, 0x2c, 0xee, 0xb1, 0x8e, 0x3b,
+	0xec, 0x35, 0xae, 0xab, 0xe2, 0x47, 0x98, 0x61, 0x5d, 0x81, 0x2a, 0x2f, 0x6e, 0x3b, 0xbd, 0x81,
+	0xe3, 0x1e, 0xf4, 0x1b, 0x2f, 0x90, 0x3c, 0x1a, 0x86, 0xbd, 0x6d, 0x91, 0x45, 0x8d, 0x6a, 0x39,
+	0xc3, 0xf0, 0xd0, 0xf3, 0xdd, 0x1f, 0x39, 0x5c, 0x46, 0xb5, 0xbc, 0x7e, 0xf7, 0xa4, 0xf1, 0x22,
+	0xce, 0xc1, 0x92, 0x1b, 0x6c, 0xe9, 0x65, 0x5f, 0xf4, 0xbb, 0x27, 0x1b, 0xef, 0x00, 0x44, 0x4c,
+	0x68, 0x2d, 0x40, 0xee, 0x19, 0x3b, 0x11, 0x22, 0x99, 0xff, 0xe5, 0x93, 0x75, 0xe4, 0x74, 0x87,
+	0x52, 0x10, 0x53, 0xe2, 0xbd, 0xec, 0x3b, 0x99, 0x8d, 0x0f, 0xa0, 0x66, 0xf2, 0xde, 0x4c, 0xb5,
+	0xdf, 0x87, 0x79, 0x83, 0x5c, 0x67, 0xaa, 0x7c, 0x17, 0x96, 0xd3, 0x48, 0x7e, 0x16, 0x1c, 0xcd,
+	0x3f, 0xad, 0xc1, 0xdc, 0x2e, 0xad, 0x39, 0x7c, 0xdd, 0x52, 0x0b, 0x51, 0xd6, 0xed, 0x70, 0x4a,
+	0xe9, 0x31, 0xbf, 0x7d, 0xe8, 0xf4, 0x71, 0x85, 0xa2, 0xba, 0x20, 0xb3, 0x76, 0x3a, 0xd6, 0x26,
+	0xe4, 0xfb, 0x4e, 0x8f, 0x35, 0x72, 0xc8, 0x9b, 0x1b, 0x8a, 0x58, 0x05, 0xc2, 0x4d, 0xbe, 0x3a,
+	0x12, 0x17, 0x22, 0x1c, 0x9f, 0x5b, 0x9f, 0x05, 0xcc, 0x3f, 0x62, 0x9d, 0xd6, 0x6d, 0xb1, 0x3c,
+	0x95, 0x65, 0xce, 0x6d, 0xeb, 0x15, 0x58, 0x6c, 0x3b, 0xdd, 0xee, 0x53, 0xa7, 0xfd, 0xac, 0xa5,
+	0x16, 0x31, 0x5a, 0xa9, 0x16, 0x64, 0xc1, 0xb6, 0xc8, 0x37, 0x80, 0x71, 0x39, 0x6e, 0x7b, 0xdd,
+	0x46, 0xd1, 0x04, 0xde, 0x15, 0xf9, 0xd6, 0xbb, 0xb0, 0xde, 0x46, 0x51, 0x21, 0x18, 0xc6, 0xe9,
+	0x76, 0xbd, 0xe7, 0xac, 0xc3, 0x39, 0x37, 0x68, 0xcc, 0xa1, 0x10, 0x5b, 0x25, 0x00, 0xe4, 0x9d,
+	0x2d, 0x2a, 0xfe, 0xd2, 0xef, 0x06, 0xbc, 0x2a, 0x42, 0xb7, 0x3a, 0x27, 0x7d, 0xa7, 0xe7, 0xb6,
+	0xc5, 0x0a, 0x45, 0x55, 0x4b, 0x48, 0x51, 0xab, 0x08, 0x70, 0x8f, 0xca, 0x69, 0xbd, 0xc2, 0xaa,
+	0x1f, 0xc2, 0x39, 0xb3, 0xaa, 0xcf, 0x3a, 0xae, 0xcf, 0xd7, 0x7b, 0xac, 0x5c, 0xc6, 0xca, 0x0d,
+	0xbd, 0xb2, 0x2d, 0x00, 0xb0, 0xfa, 0x8b, 0x50, 0xef, 0xba, 0x3d, 0x37, 0x0c, 0xa2, 0xc1, 0xa0,
+	0x65, 0xb1, 0x46, 0xd9, 0x6a, 0x28, 0x5e, 0x05, 0xab, 0xe7, 0xf6, 0x5b, 0x72, 0x11, 0x16, 0x7a,
+	0x41, 0x05, 0xf5, 0x82, 0x85, 0x9e, 0xdb, 0xdf, 0xa5, 0x82, 0x2d, 0xcc, 0x47, 0x68, 0xe7, 0x38,
+	0x0e, 0x5d, 0x15, 0xd0, 0xce, 0xb1, 0x09, 0x7d, 0x15, 0xe6, 0x45, 0x87, 0x71, 0xf1, 0x0c, 0x1a,
+	0xf3, 0x38, 0x5a, 0x55, 0xca, 0xc4, 0xe5, 0x33, 0xb0, 0xde, 0x80, 0x65, 0x37, 0x68, 0xc9, 0x55,
+	0xa0, 0xd5, 0x3e, 0x64, 0xed, 0x67, 0xde, 0x30, 0xc4, 0x85, 0xb4, 0x64, 0x5b, 0x6e, 0xb0, 0x2b,
+	0x8a, 0xb6, 0x45, 0x09, 0xa7, 0x84, 0x80, 0xb5, 0x7d, 0x16, 0xb6, 0x38, 0xa5, 0xd6, 0x85, 0x76,
+	0x83, 0x39, 0x9f, 0xb2, 0x13, 0xeb, 0x35, 0xb0, 0x94, 0xaa, 0xd3, 0xf2, 0xd9, 0x0f, 0x87, 0xae,
+	0xcf, 0x3a, 0xb8, 0xc2, 0x96, 0xec, 0x45, 0x55, 0x62, 0x8b, 0x02, 0xeb, 0x65, 0x58, 0x0c, 0x58,
+	0xbf, 0xd3, 0xd2, 0x5b, 0xda, 0x58, 0x44, 0xe8, 0x3a, 0x2f, 0xf8, 0x3c, 0x6a, 0x2c, 0x87, 0xe5,
+	0x7a, 0x02, 0xb6, 0xb1, 0x25, 0xd5, 0x21, 0x8b, 0xc4, 0xdb, 0xd0, 0xef, 0x62, 0x0b, 0xb7, 0x28,
+	0xdb, 0xda, 0x84, 0x25, 0x0e, 0x3b, 0xf0, 0x3d, 0xae, 0x62, 0xc8, 0x21, 0x13, 0xab, 0x28, 0x47,
+	0xb3, 0x4b, 0x25, 0x62, 0xc8, 0x24, 0x6e, 0x35, 0xcd, 0xa8, 0x8c, 0x2c, 0x2b, 0xdc, 0x72, 0x76,
+	0x51, 0x29, 0x79, 0x03, 0x96, 0x0d, 0x58, 0xa9, 0xd9, 0xd0, 0x72, 0x6b, 0x69, 0xe0, 0x52, 0xc3,
+	0x59, 0x85, 0x62, 0x10, 0x3a, 0xe1, 0x90, 0x2f, 0xbb, 0x99, 0x1b, 0x05, 0x5b, 0xa4, 0xac, 0x77,
+	0x01, 0x88, 0x76, 0x3b, 0x2d, 0x27, 0x6c, 0xac, 0xe1, 0xc2, 0xb1, 0xb1, 0x49, 0xca, 0xeb, 0xa6,
+	0x54, 0x5e, 0x37, 0xf7, 0xa4, 0xf2, 0x6a, 0x97, 0x05, 0xf4, 0x56, 0xc8, 0xab, 0x0e, 0x07, 0x1d,
+	0x59, 0xb5, 0x31, 0xb9, 0xaa, 0x80, 0xde, 0x0a, 0x51, 0xeb, 0x53, 0x13, 0x8e, 0x83, 0xb8, 0x8e,
+	0xad, 0x9a, 0x97, 0xb9, 0xdb, 0x38, 0x84, 0xb7, 0x61, 0x95, 0x86, 0xdb, 0xf1, 0x0f, 0x18, 0x31,
+	0xab, 0x18, 0x45, 0x5a, 0x51, 0x97, 0x71, 0xcc, 0x65, 0xa1, 0x1c, 0xc8, 0x57, 0xc1, 0xc2, 0x5a,
+	0x4e, 0xbf, 0xcd, 0xba, 0xaa, 0x06, 0xad, 0xb1, 0x0b, 0xbc, 0x06, 0x16, 0xc4, 0x86, 0x7d, 0xdf,
+	0x77, 0x86, 0x1d, 0x05, 0x7c, 0x5e, 0x0d, 0xfb, 0x03, 0x9e, 0x1f, 0xc3, 0xec, 0xb3, 0xfd, 0x61,
+	0x3f, 0x02, 0xbe, 0xa0, 0x30, 0xdb, 0x58, 0x20, 0xa1, 0xaf, 0xc1, 0x7c, 0xd7, 0x6b, 0x3b, 0x5d,
+	0xb1, 0x54, 0x04, 0x8d, 0x8b, 0x48, 0xfd, 0x66, 0xa6, 0xb5, 0x0b, 0x0b, 0xfb, 0xc3, 0x6e, 0xb7,
+	0xa5, 0xeb, 0xc9, 0x97, 0x50, 0x24, 0x5e, 0x4f, 0x88, 0xc4, 0x07, 0xc3, 0x6e, 0xf7, 0x5e, 0x04,
+	0x27, 0x74, 0x94, 0x7d, 0x33, 0xd7, 0x7a, 0x0c, 0x8b, 0xc1, 0xa1, 0xe7, 0x87, 0x06, 0xca, 0xcb,
+	0x31, 0x45, 0x52, 0xa2, 0x7c, 0xcc, 0x21, 0x13, 0x38, 0x17, 0x82, 0x58, 0xb6, 0xf5, 0x0e, 0x80,
+	0x10, 0x24, 0x2e, 0x0b, 0x1a, 0x57, 0x10, 0x5b, 0x43, 0x61, 0x7b, 0xe8, 0x28, 0x81, 0xb2, 0x13,
+	0xb2, 0x9e, 0xad, 0xc1, 0x5a, 0x9b, 0x50, 0x68, 0x7b, 0x47, 0xcc, 0x47, 0x35, 0x40, 0xaf, 0xb4,
+	0xd3, 0x73, 0x0e, 0xd8, 0xb6, 0xd7, 0xed, 0xb2, 0x36, 0xff, 0x84, 0x4d, 0x60, 0xd6, 0x37, 0x61,
+	0xe1, 0xc8, 0xf5, 0xc3, 0xa1, 0xd3, 0x8d, 0x44, 0xd7, 0x55, 0xac, 0x7a, 0x29, 0xde, 0xfa, 0x27,
+	0x04, 0x27, 0x3f, 0x6d, 0xd7, 0x8f, 0xcc, 0x8c, 0x8d, 0xb7, 0xa1, 0xac, 0x96, 0x91, 0x59, 0x57,
+	0xc7, 0xb4, 0xc1, 0x9e, 0x09, 0xc7, 0x36, 0xac, 0xa4, 0x8e, 0xee, 0x4c, 0x4b, 0xec, 0x7f, 0x29,
+	0x40, 0x55, 0xf4, 0x16, 0x57, 0x97, 0xd9, 0xd7, 0xd9, 0x5b, 0xc6, 0x3a, 0x9b, 0x18, 0x43, 0xc4,
+	0x9a, 0x58, 0x6c, 0x63, 0x3b, 0xa6, 0xfc, 0xd8, 0x1d, 0x53, 0xc1, 0xdc, 0x31, 0x25, 0xa4, 0x7e,
+	0x31, 0x45, 0xea, 0x9b, 0x32, 0x7c, 0x2e, 0x2e, 0xc3, 0x53, 0x85, 0x72, 0x69, 0x06, 0xa1, 0x5c,
+	0x9e, 0x49, 0x28, 0xc3, 0x28, 0xa1, 0x9c, 0xaa, 0x28, 0x54, 0x46, 0x28, 0x0a, 0xa3, 0xc5, 0x55,
+	0x75, 0x66, 0x71, 0x35, 0x3f, 0x8b, 0xb8, 0xaa, 0xcd, 0x22, 0xae, 0xea, 0x23, 0xc4, 0x55, 0xb4,
+	0x42, 0x2c, 0x18, 0x2b, 0xc4, 0x7b, 0xb0, 0xae, 0x08, 0xcc, 0xf7, 0x4e, 0x9c, 0x6e, 0x78, 0x12,
+	0x31, 0xe6, 0x22, 0x22, 0x5b, 0x93, 0x00, 0x36, 0x95, 0x9f, 0x99, 0xff, 0x9a, 0xff, 0x7f, 0x06,
+	0xea, 0x8f, 0x04, 0xd2, 0x6d, 0xaf, 0x1f, 0x3a, 0xed, 0xd0, 0xba, 0x0b, 0x20, 0xf5, 0x72, 0x46,
+	0x1c, 0x50, 0xb9, 0xd9, 0x54, 0xe4, 0x1c, 0x83, 0xde, 0x52, 0x90, 0xb6, 0x56, 0xcb, 0xfa, 0x08,
+	0xca, 0x21, 0x6b, 0x1f, 0xf6, 0xdd, 0xb6, 0xd3, 0xc5, 0xaf, 0x56, 0x6e, 0x5e, 0x19, 0x85, 0x62,
+	0x4f, 0x02, 0xda, 0x51, 0x9d, 0xe6, 0x77, 0xa1, 0x31, 0x0a, 0x8c, 0x6f, 0x98, 0x90, 0xd3, 0xa8,
+	0x87, 0xf8, 0x9f, 0x77, 0x91, 0x88, 0x57, 0x74, 0x11, 0x13, 0x3c, 0x97, 0xac, 0x05, 0x39, 0xca,
+	0xc5, 0x44, 0xf3, 0x39, 0xac, 0x8f, 0xec, 0xc5, 0x59, 0x91, 0xe3, 0xce, 0xdb, 0x0b, 0x5c, 0x5c,
+	0x0c, 0x84, 0x6d, 0x47, 0xa6, 0x9b, 0xff, 0x49, 0x1b, 0xed, 0xbb, 0x4e, 0xff, 0x99, 0xdb, 0x3f,
+	0x30, 0x6c, 0x41, 0x99, 0x98, 0x2d, 0x48, 0xb6, 0x25, 0xab, 0xb5, 0x85, 0xdb, 0x87, 0x3a, 0x1d,
+	0x9f, 0x4b, 0x8b, 0x9c, 0xb0, 0x0f, 0x51, 0x92, 0x2f, 0xf6, 0x82, 0x2b, 0xe5, 0x9e, 0x99, 0xbe,
+	0x3f, 0x2f, 0x72, 0xc5, 0x9e, 0x79, 0x19, 0x0a, 0xc1, 0x73, 0x77, 0x5f, 0x9a, 0x97, 0x28, 0xc1,
+	0xd1, 0x76, 0x58, 0x28, 0xc4, 0x08, 0xa2, 0x15, 0x49, 0xeb, 0x16, 0xac, 0xb4, 0x3d, 0xdf, 0x67,
+	0xc1, 0xc0, 0xeb, 0x77, 0x50, 0x19, 0x15, 0xac, 0x4f, 0xc2, 0x64, 0xd9, 0x28, 0x14, 0xfc, 0xdf,
+	0xfc, 0x45, 0xb0, 0x64, 0x47, 0x3f, 0x73, 0x82, 0x70, 0xd7, 0x39, 0xe1, 0x0a, 0xe5, 0x26, 0xe4,
+	0x3b, 0x4e, 0xc8, 0x1a, 0x99, 0x89, 0x3a, 0x0c, 0xc2, 0x69, 0xf6, 0xb3, 0xac, 0x6e, 0x3f, 0x6b,
+	0xfe, 0x71, 0x06, 0xaa, 0x12, 0xfd, 0x97, 0x41, 0x8a, 0xb0, 0x4e, 0x9f, 0xb0, 0x0b, 0x00, 0xfb,
+	0xae, 0x1f, 0x84, 0x2d, 0x21, 0xa7, 0x79, 0x51, 0x19, 0x73, 0xd0, 0x42, 0x78, 0x0e, 0xca, 0x5d,
+	0x47, 0x96, 0xe6, 0xa5, 0x41, 0x45, 0x14, 0x92, 0x1d, 0x70, 0xdf, 0xed, 0x32, 0x2e, 0xfd, 0x0b,
+	0xca, 0x0e, 0xc8, 0x73, 0x76, 0x3a, 0xd6, 0x27, 0xb0, 0xc8, 0xad, 0x4d, 0x41, 0xe8, 0xd3, 0x56,
+	0x16, 0xbb, 0x59, 0x9c, 0xd8, 0xcd, 0x05, 0xbd, 0xd2, 0x3d, 0x27, 0x64, 0xcd, 0x3f, 0xca, 0xc2,
+	0x52, 0x44, 0x9c, 0xbd, 0x81, 0xd3, 0x3f, 0xd9, 0xe9, 0xef, 0x7b, 0xa9, 0x64, 0xf9, 0x12, 0x2c,
+	0x38, 0xdd, 0x90, 0xf9, 0x7d, 0x27, 0x74, 0x8f, 0x58, 0x4b, 0x23, 0x95, 0xba, 0x96, 0xff, 0xb9,
+	0xa0, 0x9a, 0xe7, 0xec, 0x69, 0xe0, 0x86, 0xb2, 0xdf, 0x32, 0xc9, 0x4b, 0x70, 0xca, 0x7c, 0x69,
+	0x8a, 0x94, 0x49, 0x24, 0x94, 0x90, 0xf7, 0x43, 0x12, 0x0a, 0x4f, 0x70, 0xe9, 0xf2, 0x23, 0x77,
+	0x20, 0x88, 0x84, 0xff, 0xe5, 0x4d, 0x6b, 0xbb, 0xa1, 0x5c, 0x5c, 0xf0, 0xbf, 0x4e, 0xa5, 0x25,
+	0x93, 0x4a, 0x5f, 0x03, 0x4b, 0xfc, 0x6d, 0x39, 0x9d, 0x0e, 0xf2, 0x85, 0xd3, 0x15, 0xcb, 0xc8,
+	0xa2, 0x28, 0xd9, 0x52, 0x05, 0xd6, 0xeb, 0xb0, 0x64, 0x0c, 0xac, 0xa0, 0x6c, 0x5a, 0x48, 0x2c,
+	0xbd, 0x48, 0x90, 0xf7, 0x0a, 0x14, 0x43, 0xe7, 0x98, 0x4f, 0x12, 0x2d, 0x1f, 0x85, 0xd0, 0x39,
+	0xde, 0xe9, 0x34, 0xff, 0xaf, 0x0c, 0xac, 0xea, 0xe3, 0xda, 0x65, 0x21, 0xeb, 0x3c, 0x0e, 0xd9,
+	0x20, 0xa0, 0x11, 0xc0, 0x91, 0xc6, 0xd1, 0x2d, 0xd9, 0x32, 0x89, 0xbc, 0x49, 0x02, 0x22, 0xc0,
+	0x81, 0x2d, 0xd9, 0x2a, 0xcd, 0x6b, 0x3d, 0x25, 0x16, 0xc6, 0x11, 0x2d, 0xd9, 0x32, 0xc9, 0xa9,
+	0x36, 0x74, 0x7c, 0x77, 0x7f, 0x1f, 0x07, 0xb4, 0x64, 0x8b, 0x54, 0xf3, 0x97, 0xe1, 0xba, 0x6c,
+	0xc1, 0xd6, 0x81, 0xcf, 0x18, 0x5f, 0x0d, 0x1e, 0xcb, 0x6d, 0xd2, 0x3d, 0x27, 0x74, 0x78, 0x82,
+	0x5b, 0xa5, 0xd6, 0xa1, 0xc4, 0xb7, 0x4f, 0x68, 0xb2, 0xa2, 0xf9, 0x9e, 0x0b, 0x44, 0xd1, 0xbb,
+	0x00, 0xec, 0x78, 0xe0, 0xfa, 0x2c, 0xe0, 0x7b, 0x81, 0xec, 0xe4, 0xbd, 0x80, 0x80, 0xde, 0x0a,
+	0x9b, 0x7f, 0x23, 0x07, 0x17, 0xc7, 0x7f, 0x9f, 0x6b, 0x23, 0x82, 0xeb, 0xb5, 0x6f, 0x83, 0xc8,
+	0xe2, 0x9f, 0x3f, 0x07, 0x65, 0x4e, 0xf0, 0x54, 0x4c, 0xa4, 0x56, 0xc2, 0x0c, 0x5e, 0xf8, 0x06,
+	0x2c, 0x9b, 0xfb, 0x41, 0x16, 0xa0, 0xaa, 0x44, 0x04, 0x67, 0x19, 0x3b, 0x42, 0x16, 0x70, 0x95,
+	0xe9, 0x26, 0xac, 0xa8, 0x25, 0x2f, 0xaa, 0xea, 0x76, 0x04, 0x25, 0x2e, 0xc9, 0x42, 0xd5, 0xca,
+	0x9d, 0x8e, 0xf5, 0x02, 0xd4, 0x07, 0x81, 0x09, 0x5d, 0x10, 0x96, 0xec, 0x40, 0x87, 0xfb, 0x2e,
+	0x2c, 0x1a, 0xb8, 0xb1, 0xc9, 0xc4, 0x91, 0x9b, 0x89, 0x95, 0x68, 0xec, 0x7c, 0xd8, 0x75, 0xbd,
+	0x1d, 0xbc, 0xa7, 0x9f, 0x43, 0x65, 0x10, 0x44, 0x58, 0xe7, 0x4e, 0x85, 0xb5, 0x4c, 0xed, 0xfd,
+	0xd2, 0xef, 0x36, 0x7f, 0x27, 0x03, 0x35, 0x59, 0x69, 0x0f, 0x89, 0xc5, 0xfa, 0x10, 0xe6, 0xa4,
+	0x22, 0x91, 0x41, 0x85, 0xf2, 0x6a, 0x02, 0x3d, 0x41, 0xda, 0x4e, 0xc8, 0xa4, 0x1a, 0x65, 0xcb,
+	0x3a, 0xd6, 0xc7, 0x50, 0x1c, 0xa0, 0xcc, 0x15, 0x34, 0x72, 0x63, 0x5c, 0xed, 0xc7, 0x2c, 0x0c,
+	0xdd, 0xfe, 0x41, 0x80, 0x5b, 0x0a, 0x51, 0x8f, 0xd3, 0xc2, 0xa1, 0xd7, 0x63, 0x2d, 0x32, 0xa2,
+	0x8b, 0x49, 0x04, 0x9e, 0x65, 0x63, 0x4e, 0xf3, 0x27, 0x16, 0x94, 0x24, 0xb2, 0x84, 0x00, 0x7e,
+	0x49, 0x58, 0x48, 0xe9, 0xeb, 0x2b, 0x89, 0xaf, 0x6b, 0x46, 0xd2, 0x3b, 0x11, 0xfb, 0xe5, 0x10,
+	0xfa, 0x7c, 0x8a, 0xa2, 0xa0, 0x04, 0x61, 0xc4, 0x9c, 0xb7, 0x35, 0xe6, 0xac, 0xc7, 0xb6, 0x3c,
+	0xb1, 0xe5, 0x5d, 0x63, 0xdb, 0x9b, 0x11, 0xdb, 0x2e, 0x8c, 0xa8, 0x24, 0x56, 0x66, 0x83, 0xa1,
+	0x85, 0xc6, 0xb6, 0x38, 0x66, 0x4f, 0x6f, 0x9d, 0x7e, 0x4f, 0xbf, 0x34, 0xcb, 0x9e, 0xfe, 0x1e,
+	0x2c, 0xd0, 0x2a, 0xa6, 0x8c, 0x43, 0x61, 0x63, 0x79, 0x22, 0x82, 0x1a, 0xd6, 0x91, 0x66, 0x23,
+	0xbe, 0x69, 0xae, 0xb9, 0x41, 0xeb, 0xc8, 0x09, 0x5b, 0xac, 0xef, 0x3c, 0xed, 0xb2, 0x0e, 0xda,
+	0x34, 0x4a, 0x76, 0xd5, 0x0d, 0x9e, 0x38, 0xe1, 0x7d, 0xca, 0xb3, 0x3e, 0x86, 0x0b, 0x2e, 0xb7,
+	0x1c, 0xf4, 0x7a, 0x6e, 0x10, 0x70, 0xf1, 0x1b, 0x7a, 0x2d, 0x3e, 0x69, 0xaa, 0xd2, 0x2a, 0x56,
+	0x5a, 0x77, 0x83, 0x6d, 0x05, 0xb3, 0xe7, 0xf1, 0xc9, 0x95, 0x18, 0x6e, 0xc3, 0xea, 0xa1, 0x13,
+	0xb4, 0x92, 0x6c, 0x8e, 0x36, 0x90, 0x92, 0xbd, 0x7c, 0xe8, 0x04, 0x8f, 0xe2, 0x6c, 0xce, 0xb5,
+	0x6f, 0x5e, 0x8b, 0x1b, 0xcf, 0xa3, 0x0a, 0x0d, 0xda, 0x96, 0x1c, 0x3a, 0xc1, 0x6e, 0x30, 0x88,
+	0x60, 0x3f, 0x80, 0x0a, 0x2e, 0xdb, 0x82, 0xde, 0xd7, 0x71, 0x28, 0xce, 0x25, 0x66, 0x35, 0x52,
+	0x43, 0x6c, 0xe8, 0xaa, 0xff, 0x5c, 0xa2, 0xb9, 0xc4, 0xca, 0xac, 0x83, 0xd6, 0x8e, 0x92, 0x5d,
+	0x72, 0x91, 0x31, 0x59, 0xc7, 0xfa, 0x1c, 0xea, 0xe6, 0xa1, 0x59, 0xd0, 0x38, 0x1f, 0x33, 0x19,
+	0x48, 0xf4, 0x9b, 0xbb, 0xfa, 0x39, 0x9a, 0x38, 0xe0, 0xa9, 0x19, 0x87, 0x6b, 0xa4, 0xa1, 0x49,
+	0x99, 0x40, 0x26, 0xf8, 0x0b, 0x64, 0x8e, 0x51, 0xb9, 0x68, 0x7c, 0x7f, 0x0b, 0xd6, 0x22, 0xb0,
+	0x80, 0xff, 0x1c, 0xb9, 0x4e, 0x0b, 0xf5, 0x99, 0x8b, 0x34, 0x68, 0xaa, 0xf8, 0x31, 0xeb, 0x87,
+	0x4f, 0x5c, 0xe7, 0x11, 0x57, 0x6f, 0xd0, 0x66, 0xe8, 0x76, 0x5b, 0xa1, 0xef, 0xb4, 0x39, 0xdd,
+	0xb6, 0xba, 0x6e, 0xff, 0x99, 0x38, 0x71, 0x58, 0xe0, 0x25, 0x7b, 0xa2, 0xe0, 0x33, 0xb7, 0xff,
+	0x0c, 0x77, 0x7e, 0xb7, 0x5a, 0xd1, 0x77, 0x50, 0x7b, 0xa0, 0x23, 0x88, 0x7a, 0x70, 0x4b, 0x89,
+	0x2e, 0xd4, 0x1e, 0x5e, 0x05, 0x8b, 0x46, 0xb7, 0xd5, 0xf6, 0x02, 0x65, 0x8d, 0xbc, 0x42, 0xd6,
+	0x48, 0x2a, 0xd9, 0xf6, 0x02, 0x69, 0x8d, 0x7c, 0x03, 0x96, 0x75, 0x68, 0xa5, 0xdd, 0xd2, 0xf1,
+	0x84, 0x15, 0xc1, 0x2b, 0xdb, 0xe8, 0xcb, 0xb0, 0x28, 0x6c, 0xa3, 0xde, 0x50, 0xa1, 0xbf, 0x8a,
+	0xe8, 0xeb, 0x64, 0x1a, 0xf5, 0x86, 0x12, 0xfb, 0x7b, 0xb0, 0xee, 0x7b, 0x38, 0xf6, 0x2d, 0x61,
+	0x94, 0x6e, 0x85, 0x87, 0x3e, 0x0b, 0x0e, 0xbd, 0x6e, 0x07, 0x0f, 0x2a, 0x32, 0xf6, 0x9a, 0x00,
+	0xb0, 0xa9, 0x7c, 0x4f, 0x16, 0xf3, 0x96, 0xc5, 0xeb, 0x76, 0x9c, 0x93, 0x00, 0x0f, 0x30, 0x0a,
+	0xb6, 0x65, 0x56, 0xbb, 0xe7, 0x9c, 0x04, 0xd6, 0x21, 0xbc, 0x19, 0xaf, 0xa1, 0x6d, 0x3b, 0x43,
+	0xdf, 0xe9, 0x07, 0x0e, 0x5a, 0x55, 0x02, 0xad, 0x15, 0x2f, 0x60, 0x2b, 0x5e, 0x33, 0xd1, 0x45,
+	0x1b, 0xd2, 0x3d, 0xad, 0x56, 0xd4, 0xb6, 0xd7, 0x61, 0xd9, 0x0d, 0x59, 0xaf, 0xc5, 0x07, 0x42,
+	0x1f, 0xe5, 0x17, 0x11, 0xd9, 0x22, 0x2f, 0x7b, 0xe4, 0xf6, 0xb5, 0x61, 0xbe, 0x05, 0xab, 0x66,
+	0x05, 0x35, 0xd0, 0x37, 0xc4, 0xb9, 0x4e, 0x54, 0x45, 0x8d, 0xf4, 0x4b, 0xb0, 0xd0, 0x66, 0xfd,
+	0xd0, 0x77, 0xf7, 0x87, 0x07, 0x5e, 0x8b, 0x8e, 0xb6, 0x5e, 0xa2, 0x49, 0x8f, 0xf2, 0xf7, 0x78,
+	0xb6, 0xe5, 0x40, 0x43, 0xa3, 0x42, 0xb5, 0xde, 0xe2, 0x39, 0xdf, 0x2b, 0xc8, 0x64, 0x2f, 0x4e,
+	0xb9, 0xe2, 0xd9, 0xab, 0x4e, 0x6a, 0xbe, 0xf5, 0x16, 0xd7, 0x30, 0xd9, 0x20, 0x68, 0x6c, 0xc6,
+	0xec, 0x4e, 0xe9, 0x9a, 0x9a, 0x4d, 0xd0, 0xa8, 0x42, 0x46, 0x6c, 0xc4, 0x7a, 0xfc, 0x58, 0x8c,
+	0x35, 0x5e, 0x17, 0x2a, 0xa4, 0x62, 0x25, 0x51, 0x60, 0x7d, 0x04, 0x74, 0x6a, 0xc8, 0x0f, 0x34,
+	0x50, 0x2f, 0x7f, 0x63, 0xa2, 0xb4, 0xac, 0xca, 0x0a, 0x5c, 0x27, 0xb7, 0xbe, 0x80, 0x55, 0x92,
+	0xf8, 0x2d, 0x14, 0x34, 0x9a, 0xe0, 0x7e, 0x73, 0x22, 0xa6, 0x25, 0xaa, 0xc9, 0xa5, 0xcf, 0x97,
+	0x4a, 0x84, 0x5f, 0x81, 0x2a, 0x8a, 0x37, 0xb2, 0x0c, 0x05, 0x8d, 0x9b, 0xc8, 0xd5, 0x15, 0x2e,
+	0xd9, 0x44, 0x16, 0xea, 0xf6, 0x11, 0x6f, 0x92, 0xd2, 0x7b, 0x4b, 0xe8, 0xf6, 0x8a, 0x37, 0x31,
+	0x9b, 0x53, 0x75, 0xcf, 0xed, 0xbb, 0x3d, 0xa7, 0x2b, 0x39, 0x08, 0x8f, 0x1e, 0x1a, 0xb7, 0x2f,
+	0x67, 0x6e, 0x64, 0x6d, 0x4b, 0x94, 0x11, 0x13, 0x7d, 0xc6, 0x4b, 0xac, 0xd7, 0x95, 0x86, 0xfa,
+	0x16, 0x76, 0x60, 0x6d, 0x94, 0x76, 0x20, 0xc0, 0xb8, 0x14, 0xef, 0x39, 0xfd, 0xa1, 0xfa, 0x42,
+	0xa0, 0x16, 0x80, 0x3b, 0x24, 0x90, 0xa8, 0x94, 0xbe, 0x11, 0x48, 0xd9, 0xff, 0x0a, 0x2c, 0x4a,
+	0x41, 0x10, 0x99, 0x34, 0xdf, 0x46, 0x23, 0x95, 0x94, 0x1a, 0x2a, 0xdf, 0xfa, 0x36, 0xac, 0x98,
+	0xc0, 0x27, 0x2d, 0x7f, 0xd8, 0x65, 0x41, 0xe3, 0x9d, 0x11, 0xea, 0xcf, 0xae, 0x8e, 0xe1, 0xc4,
+	0x1e, 0x76, 0x99, 0xbd, 0x34, 0x48, 0xe4, 0x05, 0x1b, 0x0e, 0x2c, 0xa5, 0xc8, 0xe6, 0x14, 0x2b,
+	0xc9, 0x6d, 0xdd, 0x4a, 0x52, 0xb9, 0x79, 0x31, 0xed, 0x8b, 0x11, 0x1a, 0xdd, 0x8a, 0xf2, 0x7f,
+	0x67, 0x60, 0x63, 0x74, 0xb3, 0xc6, 0x6e, 0xf1, 0xcf, 0x43, 0x99, 0xf6, 0x5b, 0x7c, 0x6c, 0xb2,
+	0x38, 0x36, 0x51, 0x06, 0xa7, 0x02, 0x3a, 0x0b, 0xd3, 0x06, 0x30, 0x87, 0x40, 0x75, 0xcc, 0x8f,
+	0xc6, 0xaf, 0xf9, 0x31, 0x6c, 0x3c, 0x3e, 0x09, 0x42, 0xd6, 0x43, 0x93, 0x9d, 0xdb, 0xc6, 0x3d,
+	0xd1, 0x63, 0x24, 0x3e, 0x16, 0xf0, 0x3d, 0xda, 0xbe, 0xef, 0xf5, 0xf0, 0xf3, 0x05, 0x1b, 0xff,
+	0x73, 0x9d, 0x2d, 0xf4, 0xb0, 0xb3, 0x05, 0x3b, 0x1b, 0x7a, 0xcd, 0x3f, 0xc9, 0x42, 0x55, 0xaf,
+	0x9c, 0x50, 0xea, 0x1a, 0x30, 0xd7, 0x63, 0x41, 0xe0, 0x1c, 0xa8, 0x4d, 0xa4, 0x48, 0xc6, 0x8d,
+	0xa3, 0xf9, 0x84, 0x71, 0x74, 0x0d, 0xe6, 0x50, 0x6f, 0x50, 0xda, 0x7a, 0x91, 0x27, 0x77, 0x3a,
+	0x72, 0xfd, 0xc5, 0x96, 0x37, 0x8a, 0x6a, 0xfd, 0xc5, 0xb4, 0x70, 0x7d, 0xf0, 0x99, 0xd3, 0x69,
+	0xcc, 0x49, 0xd7, 0x07, 0x9b, 0x39, 0xdc, 0xbc, 0x54, 0x0a, 0x44, 0xd7, 0x70, 0x7f, 0xa9, 0xd3,
+	0xc7, 0xe8, 0x51, 0xb0, 0x55, 0xa5, 0x98, 0xea, 0x56, 0x3e, 0xbd, 0xea, 0x06, 0x33, 0xa8, 0x6e,
+	0xcd, 0x1e, 0x2c, 0xa0, 0x19, 0x78, 0x57, 0x9c, 0xe3, 0x3f, 0x60, 0xba, 0x8d, 0x23, 0x83, 0xfc,
+	0x9a, 0xe6, 0x23, 0x94, 0x8d, 0x11, 0xcd, 0x75, 0xa8, 0xb1, 0xfd, 0x7d, 0xd6, 0xc6, 0x6d, 0xbf,
+	0xef, 0x88, 0x4d, 0x7d, 0xd6, 0x9e, 0x57, 0xb9, 0x36, 0xb7, 0x25, 0xec, 0x43, 0x09, 0x3f, 0xb7,
+	0xe7, 0x1c, 0x2b, 0x27, 0x83, 0x8c, 0xe6, 0x64, 0x60, 0x41, 0x1e, 0x2b, 0x93, 0x71, 0x05, 0xff,
+	0x9f, 0xc6, 0x65, 0xa9, 0xf9, 0x23, 0x58, 0xc2, 0xef, 0xdc, 0xa5, 0x19, 0xd8, 0x12, 0x3b, 0x7d,
+	0xcd, 0xb2, 0x90, 0x31, 0x2d, 0x0b, 0xd2, 0x62, 0x90, 0xd5, 0x2c, 0x06, 0xdc, 0xe3, 0xc1, 0x0b,
+	0x42, 0x7e, 0x1a, 0xe1, 0x75, 0x24, 0x81, 0x01, 0x65, 0x6d, 0x7b, 0x1d, 0x16, 0x99, 0x23, 0xf2,
+	0x9a, 0x39, 0xa2, 0xf9, 0x7b, 0x79, 0x28, 0x2b, 0xb7, 0x8b, 0x04, 0xc5, 0xae, 0x42, 0xd1, 0x7b,
+	0xca, 0x05, 0xaa, 0xf8, 0x94, 0x48, 0xf1, 0x8f, 0xb1, 0x63, 0xb4, 0x90, 0x74, 0xa3, 0x1d, 0x2a,
+	0xc8, 0xac, 0x9d, 0xc8, 0x0a, 0x98, 0x4f, 0xb3, 0x02, 0x16, 0x74, 0xa3, 0x12, 0x9f, 0x0b, 0xfe,
+	0x87, 0x7c, 0xa6, 0x5c, 0xd6, 0x11, 0x54, 0x3c, 0x8f, 0xb9, 0x4f, 0x44, 0x66, 0x64, 0x2c, 0x9c,
+	0xd3, 0x8d, 0x85, 0xfc, 0x7c, 0x8e, 0xff, 0x89, 0x2a, 0x93, 0xed, 0x7d, 0x1e, 0x73, 0x55, 0x65,
+	0xde, 0xad, 0x81, 0xb0, 0x91, 0x64, 0xdd, 0x01, 0xef, 0x16, 0x1e, 0x6e, 0x31, 0x61, 0x07, 0x11,
+	0x29, 0xbe, 0x95, 0x92, 0x56, 0x97, 0x4a, 0x6c, 0x2b, 0x95, 0x32, 0x41, 0x91, 0x4d, 0xe6, 0x03,
+	0xcd, 0x23, 0xa8, 0x8a, 0xe2, 0xf6, 0x72, 0xd2, 0xa7, 0x65, 0xa4, 0x23, 0xd0, 0x05, 0x00, 0x6e,
+	0xb7, 0x35, 0x1c, 0xb7, 0xd0, 0x92, 0xab, 0x8e, 0x0d, 0xc4, 0xe9, 0x42, 0x9f, 0x3d, 0x97, 0xdb,
+	0x49, 0x3a, 0x74, 0xae, 0x53, 0xc1, 0xe7, 0xec, 0x39, 0xed, 0x29, 0xb9, 0xe6, 0x9b, 0x80, 0x15,
+	0x78, 0xc9, 0x9c, 0xbe, 0x1c, 0xab, 0x81, 0x9f, 0x38, 0x93, 0x83, 0x46, 0xf3, 0xa7, 0x17, 0xa0,
+	0x90, 0x7e, 0xe4, 0x63, 0x41, 0x1e, 0x9d, 0x76, 0x04, 0x99, 0xf2, 0xff, 0xdc, 0xd3, 0x4e, 0xd3,
+	0xfa, 0x04, 0xe5, 0xe8, 0x59, 0x1a, 0xcd, 0xe5, 0x0d, 0x9a, 0x8b, 0x76, 0x91, 0x42, 0x02, 0x52,
+	0x8a, 0xce, 0x68, 0xc9, 0x8f, 0x4a, 0x94, 0x17, 0xe5, 0x19, 0x2d, 0xe6, 0x92, 0xf4, 0x9a, 0xc2,
+	0xc5, 0xcf, 0x94, 0x69, 0xa5, 0xd3, 0xcb, 0xb4, 0xf2, 0x2c, 0xdb, 0xd1, 0xf7, 0xa1, 0x42, 0x47,
+	0x2a, 0xd3, 0xca, 0x43, 0x90, 0xe0, 0x5b, 0x24, 0x55, 0x44, 0xaa, 0x51, 0x11, 0x06, 0x36, 0x91,
+	0xe6, 0x96, 0x3f, 0xfa, 0xdf, 0x25, 0xcb, 0x9f, 0xcf, 0x1c, 0xee, 0x03, 0x47, 0x47, 0x3c, 0x96,
+	0x5e, 0x64, 0x63, 0x09, 0x47, 0x46, 0x47, 0x30, 0xac, 0x83, 0x54, 0x58, 0xb2, 0x55, 0x9a, 0xb7,
+	0x52, 0xfe, 0xe7, 0xad, 0xac, 0x4d, 0x6e, 0xa5, 0x04, 0xdf, 0x42, 0xf7, 0x0a, 0xe9, 0x65, 0xa6,
+	0xd3, 0x62, 0x55, 0x64, 0x12, 0x99, 0x6b, 0x40, 0xc4, 0xe8, 0x0b, 0x06, 0xd0, 0xae, 0xe4, 0xf7,
+	0x98, 0x5b, 0xdb, 0xe2, 0x14, 0x6e, 0x6d, 0x56, 0xc2, 0xad, 0xed, 0x15, 0x88, 0xf4, 0x5c, 0x2e,
+	0x3b, 0xf8, 0xbe, 0x5b, 0x78, 0x3c, 0x44, 0x6a, 0xe3, 0x13, 0xca, 0x37, 0xd5, 0x65, 0xa7, 0xdd,
+	0x66, 0x83, 0x90, 0x75, 0x84, 0x2f, 0x61, 0x84, 0x66, 0x4b, 0x14, 0xf0, 0x8f, 0x0b, 0x1e, 0x0c,
+	0xb8, 0x84, 0x21, 0xb3, 0x00, 0x50, 0xd6, 0x63, 0x2e, 0x65, 0x22, 0x86, 0xe6, 0x00, 0x62, 0x48,
+	0x56, 0x49, 0x37, 0x8d, 0xc0, 0x68, 0x54, 0x5e, 0x85, 0x22, 0xb9, 0x97, 0x09, 0x97, 0x87, 0x65,
+	0x53, 0xae, 0xec, 0x60, 0x99, 0x2d, 0x60, 0xb8, 0x26, 0x1b, 0x7a, 0xa1, 0xd3, 0x8d, 0xfb, 0xbd,
+	0x34, 0x70, 0x29, 0xb2, 0xb0, 0xcc, 0xf4, 0x7c, 0xd1, 0x97, 0xa5, 0xf5, 0xd8, 0x2a, 0x29, 0xbd,
+	0xf4, 0x36, 0x26, 0x78, 0xe9, 0xdd, 0x87, 0xba, 0x28, 0x6a, 0x49, 0xe9, 0x79, 0x6e, 0x0a, 0xe9,
+	0x59, 0x7b, 0x6a, 0xa4, 0xad, 0xab, 0x90, 0x0b, 0x9d, 0x63, 0x74, 0x69, 0xa8, 0xdc, 0x5c, 0x34,
+	0xab, 0xee, 0x39, 0xc7, 0x36, 0x2f, 0xb5, 0xee, 0x26, 0xdc, 0x70, 0x2f, 0xc4, 0xec, 0x15, 0x86,
+	0x92, 0x89, 0x95, 0xe3, 0x3e, 0xba, 0x37, 0xa0, 0xc0, 0xb7, 0x76, 0xe4, 0xe7, 0x90, 0xe8, 0x18,
+	0x1a, 0xf1, 0x08, 0xc0, 0x7a, 0x07, 0x8a, 0x44, 0xc6, 0x68, 0x05, 0x48, 0x48, 0x75, 0x5d, 0x47,
+	0xa2, 0x33, 0x4a, 0x5b, 0xc0, 0x5b, 0xef, 0x68, 0x2b, 0x02, 0xb9, 0x34, 0xc4, 0x06, 0x63, 0xe4,
+	0x6a, 0xf0, 0x79, 0x8a, 0x5b, 0xe8, 0x95, 0x98, 0x0a, 0x4f, 0x18, 0xa6, 0xf3, 0x04, 0x7d, 0x1d,
+	0xe6, 0xc4, 0x3e, 0xa9, 0xd1, 0x8c, 0x19, 0x13, 0xf5, 0x93, 0x75, 0x5b, 0x42, 0x59, 0x37, 0x60,
+	0x41, 0xfc, 0x6d, 0x29, 0x77, 0x69, 0xf2, 0x74, 0xac, 0x0d, 0xb4, 0x0a, 0x3b, 0x1d, 0xee, 0xbb,
+	0x25, 0x21, 0xe5, 0x99, 0xd6, 0x35, 0x03, 0x50, 0x9e, 0x66, 0x7f, 0x09, 0xeb, 0x12, 0x10, 0x77,
+	0x80, 0xc2, 0xb8, 0x4d, 0xb2, 0xe4, 0xfa, 0x44, 0x59, 0xb2, 0x2a, 0x2a, 0xf3, 0x4d, 0xa0, 0x2d,
+	0xab, 0x6e, 0x85, 0xd6, 0x43, 0x90, 0x1f, 0x92, 0x3e, 0xc4, 0x2f, 0x5c, 0xce, 0x19, 0x27, 0xa5,
+	0x72, 0xa0, 0x10, 0x48, 0x77, 0x1d, 0x9e, 0x1f, 0xe8, 0x79, 0xd6, 0xf7, 0xe1, 0xa2, 0x49, 0x56,
+	0xa2, 0xeb, 0xed, 0xae, 0x17, 0x50, 0x2b, 0x5f, 0x9c, 0xd8, 0xca, 0x8d, 0x41, 0x82, 0xf2, 0xb6,
+	0xb1, 0xfa, 0x56, 0xc8, 0x8d, 0xee, 0xc2, 0x07, 0x59, 0xf6, 0x1d, 0x8d, 0x0c, 0x25, 0x7b, 0x9e,
+	0x7c, 0x91, 0x45, 0xaf, 0xf8, 0xc6, 0x96, 0x3e, 0x2c, 0x18, 0xf7, 0x25, 0x64, 0xdc, 0x0a, 0xe6,
+	0x09, 0x8e, 0xfd, 0x08, 0xce, 0xc7, 0x9a, 0x4a, 0xce, 0xd9, 0x72, 0x06, 0x5e, 0xc6, 0x19, 0x58,
+	0x37, 0x1a, 0xb3, 0xcb, 0x21, 0xe4, 0x64, 0x30, 0x58, 0x8f, 0x21, 0x08, 0x8f, 0xfb, 0x72, 0x00,
+	0x5f, 0x49, 0x73, 0xc2, 0x36, 0x79, 0x6a, 0xef, 0xb8, 0xaf, 0x8f, 0xe4, 0xea, 0x20, 0xb5, 0xd0,
+	0xda, 0x03, 0x4b, 0x94, 0x60, 0x97, 0xdd, 0xc0, 0x0d, 0x59, 0xd0, 0x78, 0x35, 0x66, 0xfe, 0x33,
+	0xf0, 0xdb, 0x0a, 0x8e, 0x50, 0x2f, 0x0e, 0xe2, 0xf9, 0xd6, 0x1e, 0xac, 0xd3, 0x89, 0x0c, 0x5a,
+	0x22, 0xb8, 0x39, 0x95, 0x5c, 0x7c, 0xfb, 0x83, 0x61, 0xd8, 0x78, 0x6d, 0xe2, 0x1c, 0xad, 0x50,
+	0x65, 0x6e, 0x95, 0xd8, 0xf3, 0x1e, 0x70, 0x4f, 0x60, 0x5e, 0xd1, 0x7a, 0x1f, 0x36, 0x70, 0x77,
+	0x25, 0x0f, 0xd6, 0x38, 0xe3, 0x44, 0x1e, 0x79, 0x9b, 0x38, 0x53, 0x6b, 0x1c, 0x42, 0xc8, 0x2a,
+	0x34, 0xca, 0x88, 0x62, 0xc3, 0x55, 0xfc, 0xf5, 0x98, 0xab, 0xf8, 0xf7, 0xd0, 0x4b, 0xb7, 0xaf,
+	0xc9, 0x89, 0x00, 0x0d, 0x92, 0x8d, 0x37, 0x2e, 0xe7, 0x0c, 0x03, 0x10, 0x8d, 0xc3, 0x4e, 0xa0,
+	0x8b, 0x94, 0x80, 0x1b, 0x27, 0x69, 0x24, 0x96, 0xdc, 0x64, 0x89, 0xf5, 0x19, 0x2c, 0x89, 0x0d,
+	0x01, 0xb7, 0xad, 0x85, 0xbe, 0x4b, 0x2a, 0xd5, 0x9b, 0x31, 0x81, 0xb8, 0x4d, 0x30, 0x76, 0x04,
+	0x62, 0x5b, 0xed, 0x44, 0x1e, 0x27, 0x3d, 0x89, 0x0d, 0x37, 0x10, 0x37, 0x49, 0x41, 0x12, 0x79,
+	0xb8, 0x83, 0x38, 0x07, 0xe5, 0x81, 0xe3, 0x33, 0xda, 0xa3, 0xde, 0x12, 0x67, 0xf3, 0x98, 0xb1,
+	0xd3, 0xb1, 0x1e, 0xc0, 0x22, 0xfd, 0xd7, 0xed, 0xea, 0xb7, 0x27, 0xce, 0x48, 0x9d, 0x2a, 0x45,
+	0x86, 0x75, 0xb9, 0xd1, 0x7a, 0x4b, 0xdb, 0x68, 0xdd, 0x80, 0x05, 0x61, 0x6c, 0xef, 0xb0, 0xce,
+	0x90, 0xba, 0x49, 0x86, 0x93, 0x1a, 0x9a, 0xdb, 0xef, 0xc9, 0x5c, 0xde, 0x0b, 0x31, 0xf8, 0x64,
+	0x1f, 0xbe, 0x4f, 0xbd, 0x10, 0x79, 0x7b, 0x29, 0xae, 0xe1, 0x0f, 0x12, 0xae, 0xe1, 0x16, 0xe4,
+	0x9f, 0xb1, 0x93, 0xa0, 0xf1, 0x09, 0x4e, 0x26, 0xfe, 0xe7, 0x8a, 0xb5, 0x1b, 0x70, 0x17, 0x20,
+	0xe9, 0x00, 0x2a, 0x26, 0x95, 0x75, 0x1a, 0x0f, 0xc9, 0x82, 0xe3, 0x06, 0x9f, 0xb2, 0x13, 0xe1,
+	0x02, 0xfa, 0xb9, 0x28, 0x23, 0x5f, 0x60, 0x52, 0x44, 0xdc, 0x4e, 0x63, 0x47, 0xfa, 0x02, 0x63,
+	0xce, 0x4e, 0xc7, 0xba, 0x03, 0x6b, 0x71, 0x17, 0x32, 0xc9, 0xf9, 0xdf, 0x44, 0xce, 0x5f, 0x89,
+	0x39, 0x8a, 0x09, 0x19, 0xf0, 0x6d, 0x58, 0x55, 0xbc, 0xe5, 0x0d, 0x43, 0xd6, 0x72, 0x42, 0x6e,
+	0xc6, 0x0b, 0x83, 0xc6, 0xa7, 0x69, 0x02, 0x50, 0xb2, 0x17, 0x07, 0xdd, 0x22, 0x48, 0x7b, 0x79,
+	0x90, 0xcc, 0x0c, 0x46, 0x7b, 0x95, 0x7f, 0x36, 0xd2, 0xab, 0x9c, 0x9b, 0x07, 0x23, 0xc7, 0x15,
+	0x3e, 0xe9, 0x8f, 0x26, 0x9b, 0x07, 0xa3, 0x0a, 0xa8, 0x3c, 0x57, 0xc8, 0x4d, 0x08, 0x3d, 0xa3,
+	0x1a, 0x9f, 0xc7, 0x8e, 0x95, 0xb0, 0x0b, 0xe8, 0x2f, 0x84, 0x1e, 0x52, 0x36, 0xec, 0xab, 0xff,
+	0xdc, 0xbe, 0xd3, 0x71, 0x03, 0xf2, 0xd9, 0x18, 0x30, 0xbf, 0xcd, 0x59, 0xeb, 0x0b, 0xb2, 0x7b,
+	0xcb, 0xfc, 0x5d, 0xca, 0xb6, 0xee, 0xa0, 0x03, 0x42, 0xcf, 0x23, 0xea, 0xde, 0x8d, 0xd9, 0xed,
+	0x68, 0x9c, 0x78, 0x39, 0xa7, 0x74, 0xf4, 0x4c, 0xa0, 0xbf, 0x7c, 0x91, 0x53, 0x9f, 0x10, 0x73,
+	0xf3, 0x2d, 0xfc, 0x42, 0x4d, 0x66, 0xd3, 0xa4, 0x7c, 0xed, 0x5e, 0xee, 0x1b, 0x1f, 0x83, 0x95,
+	0x5c, 0xe9, 0x66, 0xc2, 0xb0, 0x03, 0xe7, 0xc6, 0x88, 0xfa, 0x99, 0x50, 0xdd, 0x83, 0xd5, 0x74,
+	0xa9, 0x3e, 0x13, 0x96, 0x07, 0xd0, 0x18, 0x25, 0x13, 0x27, 0xe1, 0x29, 0xe9, 0x7b, 0xd4, 0x1f,
+	0x67, 0xc0, 0x4a, 0xca, 0x41, 0xeb, 0x22, 0xbf, 0xbe, 0x42, 0x14, 0xd1, 0x72, 0x6e, 0x0a, 0x54,
+	0x65, 0x37, 0xc0, 0x99, 0xdf, 0xba, 0xc9, 0xc9, 0x4b, 0xb0, 0x49, 0x20, 0xbd, 0xe9, 0x05, 0x6e,
+	0x79, 0xae, 0x15, 0x08, 0x2f, 0x7a, 0xbe, 0x33, 0xe1, 0xb6, 0xba, 0x03, 0xa6, 0x00, 0xc9, 0xab,
+	0x61, 0x9e, 0x72, 0x05, 0x58, 0xf3, 0x6f, 0x4a, 0x73, 0x0b, 0x57, 0x33, 0xa7, 0x36, 0xb7, 0x2c,
+	0x40, 0x2e, 0x78, 0x36, 0x14, 0x9b, 0x65, 0xfe, 0x37, 0xd5, 0xbe, 0x12, 0xdb, 0xe1, 0x16, 0x92,
+	0x3b, 0xdc, 0xc8, 0x38, 0x55, 0x1c, 0x69, 0x9c, 0x9a, 0x8b, 0xed, 0x02, 0x56, 0xa1, 0xe8, 0x72,
+	0x07, 0x58, 0x6e, 0x28, 0xe4, 0xf2, 0x50, 0xa4, 0x78, 0x9b, 0xf8, 0xde, 0x8a, 0x8c, 0x2a, 0xfc,
+	0xaf, 0x61, 0x05, 0x81, 0x34, 0x2b, 0x08, 0xef, 0xf3, 0x48, 0xbd, 0xd7, 0xdc, 0x7d, 0x57, 0x4e,
+	0xbf, 0xfb, 0xae, 0xce, 0xb2, 0xfb, 0x8e, 0x2d, 0x06, 0xf3, 0x69, 0x8b, 0x01, 0x0a, 0x8c, 0x9a,
+	0x30, 0xb5, 0x71, 0x91, 0xb0, 0x01, 0x25, 0xc9, 0xfb, 0xb8, 0x95, 0xcd, 0xd8, 0x2a, 0x7d, 0x36,
+	0x53, 0xca, 0xef, 0x66, 0xa1, 0x66, 0x4a, 0xa2, 0x34, 0x9b, 0x0a, 0xb6, 0x27, 0xab, 0xb5, 0x47,
+	0x2e, 0x99, 0x39, 0x6d, 0xc9, 0x6c, 0xc0, 0x9c, 0x14, 0x88, 0x79, 0x6c, 0xa2, 0x4c, 0x5a, 0xdf,
+	0x80, 0x39, 0x9a, 0x76, 0x6e, 0x48, 0xe1, 0xb3, 0x74, 0x6d, 0x84, 0x14, 0xdc, 0x24, 0xc1, 0x26,
+	0x94, 0x31, 0x59, 0x09, 0x87, 0x4c, 0xac, 0x81, 0x6e, 0x47, 0x3a, 0xcd, 0x82, 0xc8, 0xda, 0xe9,
+	0x04, 0xb8, 0x06, 0x47, 0x63, 0x2a, 0xaf, 0x9e, 0x54, 0xa2, 0x41, 0x0d, 0x8c, 0x11, 0x2c, 0xc5,
+	0x46, 0xf0, 0x3d, 0xa8, 0xea, 0x1f, 0x9e, 0x34, 0x80, 0x19, 0x7d, 0x00, 0x3f, 0x85, 0x79, 0xb1,
+	0xe2, 0x1d, 0xb8, 0x7d, 0x27, 0x44, 0x9b, 0x63, 0x5b, 0x19, 0x87, 0x0b, 0x36, 0x25, 0xac, 0x6b,
+	0x72, 0x07, 0x98, 0xc5, 0x01, 0xa8, 0x99, 0x03, 0x20, 0x76, 0x7f, 0xcd, 0xdf, 0xce, 0x81, 0x95,
+	0xdc, 0x4d, 0xa6, 0xcd, 0x48, 0xc2, 0xc9, 0x70, 0xa2, 0x7d, 0xf4, 0x36, 0xf7, 0x2f, 0x41, 0x8d,
+	0x3b, 0x1f, 0xdb, 0x2a, 0xef, 0x9a, 0x8a, 0x3b, 0x87, 0xb1, 0x05, 0x2c, 0xb7, 0x1c, 0xc8, 0x75,
+	0x9f, 0x2c, 0xfe, 0xd1, 0x79, 0x80, 0x14, 0x48, 0x64, 0xbd, 0xdf, 0x41, 0x83, 0xe9, 0x81, 0xef,
+	0x0d, 0xa5, 0xa7, 0x19, 0x25, 0x78, 0x6e, 0xe0, 0x1c, 0x31, 0x79, 0x1e, 0x40, 0x09, 0xee, 0x57,
+	0xd8, 0x76, 0xfc, 0x8e, 0xb2, 0x79, 0xa5, 0xb6, 0x65, 0xdb, 0xf1, 0x3b, 0x36, 0xc2, 0xf1, 0xd6,
+	0x3f, 0x77, 0xba, 0x5d, 0x26, 0x4d, 0x5d, 0x23, 0x5a, 0xff, 0x6d, 0x84, 0xb1, 0x05, 0x2c, 0xb7,
+	0x13, 0xb4, 0xfd, 0x93, 0x41, 0xe8, 0x99, 0x57, 0x7d, 0x46, 0x56, 0xdf, 0x46, 0x60, 0xbb, 0x46,
+	0x95, 0xb6, 0xb5, 0x0b, 0xbe, 0x87, 0x4e, 0xbf, 0xd3, 0x65, 0xbe, 0xf0, 0x50, 0x93, 0xc9, 0xe6,
+	0x9f, 0x65, 0xa0, 0x31, 0x4a, 0xe1, 0x49, 0x1f, 0xbb, 0x4c, 0xfa, 0xd8, 0x69, 0x9f, 0xc8, 0x1a,
+	0x9f, 0x20, 0x65, 0xdf, 0xf5, 0x7c, 0x6e, 0x7d, 0xcf, 0x21, 0x49, 0xa9, 0xb4, 0x66, 0xa0, 0xcc,
+	0x1b, 0x06, 0x4a, 0x6e, 0xf7, 0xf6, 0x7d, 0xcf, 0x57, 0x76, 0x6f, 0x9e, 0x88, 0xc9, 0xbb, 0xe2,
+	0x0c, 0xf2, 0xae, 0xf9, 0xb7, 0x33, 0xb0, 0x94, 0x42, 0x26, 0x63, 0xcf, 0xc9, 0x2e, 0x41, 0x25,
+	0x64, 0x7e, 0xcf, 0x15, 0x14, 0x49, 0xdd, 0x02, 0x99, 0xb5, 0x83, 0x6b, 0x0f, 0xb9, 0xb5, 0x0b,
+	0x6a, 0x15, 0x29, 0xae, 0xff, 0xd0, 0xbf, 0x96, 0xf4, 0x23, 0x17, 0xfd, 0xa8, 0x51, 0xf6, 0xb6,
+	0xc8, 0xe5, 0x87, 0x4d, 0xce, 0xc0, 0x55, 0x6e, 0x62, 0x65, 0xbb, 0xe8, 0x0c, 0x5c, 0xee, 0x9d,
+	0xf5, 0xc7, 0x59, 0x98, 0xdf, 0xd5, 0x47, 0x78, 0x2a, 0x16, 0x6a, 0xc0, 0x9c, 0x58, 0xb1, 0xe5,
+	0x61, 0x99, 0x48, 0x72, 0xc3, 0xa6, 0xd8, 0xec, 0x72, 0x93, 0x53, 0xec, 0x54, 0xc5, 0x8a, 0x8a,
+	0xf4, 0x5b, 0x74, 0x5a, 0x85, 0x01, 0xf3, 0x5d, 0x4f, 0xb2, 0xcd, 0x42, 0x54, 0xb0, 0x8b, 0xf9,
+	0xe2, 0x40, 0xcd, 0xc1, 0x53, 0xa0, 0xe8, 0x40, 0x6d, 0x0b, 0xd3, 0xb1, 0x49, 0x9b, 0x3b, 0xfd,
+	0x22, 0x55, 0x9a, 0x65, 0x91, 0xd2, 0xc8, 0xb1, 0x6c, 0x52, 0xfc, 0x5f, 0x65, 0x60, 0x31, 0xc1,
+	0xa4, 0x7c, 0x2a, 0xd1, 0x5b, 0xe9, 0x8e, 0x18, 0x62, 0x91, 0xe2, 0x84, 0xd8, 0x75, 0x82, 0xf0,
+	0xb6, 0x5c, 0x78, 0x30, 0xc1, 0xa1, 0x7b, 0x4e, 0xf0, 0x8c, 0x49, 0x31, 0x25, 0x52, 0x5c, 0x8c,
+	0xe3, 0x6e, 0xf9, 0xa4, 0xd5, 0xf3, 0xfa, 0xe1, 0xa1, 0x18, 0xdf, 0x0a, 0xe5, 0x3d, 0xe2, 0x59,
+	0x24, 0xe6, 0x10, 0xe4, 0x84, 0x39, 0x92, 0xbe, 0xc9, 0xc1, 0xf2, 0xe4, 0x3b, 0xcc, 0x41, 0x5f,
+	0xe9, 0xa7, 0xbe, 0xd3, 0x97, 0xf7, 0xed, 0x29, 0xc1, 0x15, 0x95, 0x7d, 0xb7, 0x7f, 0xc0, 0xfc,
+	0x81, 0xef, 0x2a, 0x3f, 0x68, 0x3d, 0x8b, 0x53, 0x72, 0xc0, 0xda, 0x43, 0x9f, 0xdd, 0x92, 0x27,
+	0x3a, 0x2a, 0xdd, 0xbc, 0x0f, 0x4b, 0x29, 0x52, 0x26, 0xfa, 0x54, 0x46, 0xff, 0x94, 0x16, 0x0d,
+	0x20, 0x6b, 0x44, 0x03, 0x48, 0xa0, 0x21, 0x69, 0x33, 0x06, 0x8d, 0x30, 0x6d, 0x66, 0x0d, 0x77,
+	0xdc, 0xe6, 0x3f, 0xcb, 0xc0, 0xb2, 0xd2, 0xba, 0x35, 0x74, 0x09, 0x1a, 0xdf, 0x80, 0x92, 0xe4,
+	0x36, 0x79, 0x1e, 0x29, 0xd3, 0xbc, 0x6c, 0xe0, 0x04, 0xc1, 0x73, 0xcf, 0x97, 0x93, 0xa0, 0xd2,
+	0xe6, 0xcd, 0x0e, 0x09, 0x94, 0x8f, 0xdd, 0xec, 0x90, 0xc0, 0x26, 0x7d, 0x16, 0x66, 0x11, 0x2a,
+	0x7f, 0x5e, 0x84, 0xf9, 0xf1, 0x3d, 0x48, 0xe3, 0x52, 0xb5, 0xca, 0xe4, 0xf4, 0x55, 0x26, 0xb6,
+	0xfc, 0x15, 0x12, 0xcb, 0x5f, 0xfa, 0x65, 0xce, 0xb9, 0x99, 0x2e, 0x73, 0x96, 0x46, 0x5c, 0xe6,
+	0x94, 0xda, 0x50, 0x59, 0xd3, 0x86, 0x34, 0xd7, 0x7e, 0x9f, 0x1d, 0xb0, 0xe3, 0x41, 0x03, 0x0c,
+	0xd7, 0x7e, 0x1b, 0x33, 0x4d, 0xde, 0xaf, 0xc4, 0x78, 0x3f, 0x75, 0x01, 0xa9, 0xa6, 0x2f, 0x20,
+	0x8f, 0x60, 0x3e, 0x64, 0x41, 0xd8, 0x0a, 0x84, 0x67, 0x28, 0x5e, 0x28, 0xd5, 0xbd, 0x48, 0x8d,
+	0x91, 0xde, 0xdc, 0x63, 0x41, 0x28, 0x9d, 0x48, 0x49, 0xdb, 0xaa, 0x86, 0x5a, 0x96, 0xd5, 0x82,
+	0x25, 0xa1, 0x5f, 0xf1, 0x0d, 0xb9, 0x42, 0x5a, 0xbb, 0x9c, 0x33, 0xfc, 0x66, 0x4d, 0xa4, 0xbb,
+	0xaa, 0x86, 0x89, 0xda, 0x1a, 0x24, 0x0a, 0x62, 0x74, 0x53, 0x3f, 0xbd, 0x5c, 0x5b, 0x98, 0x45,
+	0xae, 0xdd, 0x82, 0x22, 0x9a, 0x2f, 0x02, 0x11, 0x3e, 0x62, 0x84, 0x11, 0x1f, 0x97, 0x71, 0x5b,
+	0x80, 0x6e, 0xfc, 0x12, 0x2c, 0x26, 0x86, 0x2b, 0x45, 0x47, 0xbc, 0x69, 0x3a, 0xa3, 0x8c, 0xd7,
+	0xaf, 0xb4, 0x1d, 0x67, 0x1b, 0xd6, 0x46, 0x0c, 0xdc, 0x57, 0xf7, 0x91, 0xe6, 0x5f, 0xe6, 0xc1,
+	0x4a, 0x76, 0x71, 0x26, 0x15, 0x45, 0x57, 0x44, 0xb2, 0x49, 0x45, 0xe4, 0x39, 0x73, 0x0f, 0x0e,
+	0x43, 0xa1, 0xa2, 0x88, 0x94, 0xe9, 0x2b, 0x93, 0x8f, 0xfb, 0xca, 0x5c, 0x34, 0x6e, 0x4e, 0x16,
+	0xb0, 0x58, 0xcb, 0xe1, 0xb6, 0x2c, 0xce, 0xb3, 0xc6, 0x06, 0xb2, 0xdc, 0x73, 0xfb, 0x82, 0xed,
+	0x78, 0xb1, 0x73, 0x6c, 0xb2, 0x72, 0xb9, 0xe7, 0x1c, 0x8b, 0x62, 0x3b, 0xce, 0x11, 0x25, 0x9c,
+	0xf2, 0xd7, 0xc6, 0x4c, 0xf9, 0x44, 0xb6, 0xe8, 0xa4, 0xb3, 0x45, 0x19, 0x31, 0xdf, 0x1a, 0x87,
+	0x79, 0x06, 0xde, 0xf8, 0xdf, 0x82, 0xe0, 0xfe, 0x5e, 0x0e, 0x20, 0xf2, 0x30, 0x4e, 0x48, 0x76,
+	0x8d, 0xf0, 0xc4, 0x91, 0x80, 0x52, 0x11, 0xeb, 0x86, 0x79, 0x7f, 0xa7, 0x13, 0x0b, 0xe8, 0x93,
+	0x8b, 0x07, 0xf4, 0x79, 0x2f, 0x71, 0xba, 0x10, 0x79, 0x3f, 0x8b, 0x9d, 0xe8, 0x9a, 0x81, 0x52,
+	0x6b, 0xd6, 0x75, 0x0a, 0xf7, 0xa1, 0x55, 0x28, 0x60, 0x85, 0xf9, 0x41, 0x30, 0xd0, 0xc0, 0xde,
+	0x86, 0x06, 0x9d, 0x72, 0x26, 0xfd, 0xaa, 0x05, 0x59, 0xae, 0x60, 0x79, 0xdc, 0xa5, 0x9a, 0x8b,
+	0xaa, 0x20, 0x74, 0xfc, 0x90, 0xbc, 0x18, 0xa7, 0xd0, 0xde, 0x10, 0x1a, 0x5d, 0x18, 0xbf, 0x16,
+	0xdf, 0x80, 0xe6, 0x1d, 0x00, 0xae, 0xd0, 0xdd, 0xc7, 0x43, 0x0b, 0xbe, 0xd6, 0x92, 0x26, 0x26,
+	0x54, 0x13, 0x4c, 0xf0, 0xe5, 0x0e, 0x95, 0x2f, 0xb1, 0x2a, 0xf3, 0xff, 0xcd, 0xff, 0x03, 0xca,
+	0x8f, 0xf9, 0xc6, 0x8e, 0x57, 0x4e, 0x4c, 0xf6, 0x02, 0xe4, 0x06, 0x4e, 0x5f, 0xc0, 0xf3, 0xbf,
+	0x7c, 0xb9, 0xe6, 0xdb, 0xba, 0x16, 0xf7, 0xa3, 0x65, 0xbe, 0xdc, 0xad, 0xf2, 0xac, 0x87, 0x98,
+	0x63, 0xbd, 0x02, 0x45, 0x3a, 0x38, 0x11, 0xbb, 0xd5, 0xa5, 0xe8, 0x70, 0x41, 0x35, 0xcf, 0x16,
+	0x20, 0xcd, 0xbf, 0xc8, 0x40, 0x43, 0x90, 0x23, 0x3f, 0x61, 0x99, 0x5d, 0xa7, 0x48, 0x33, 0x67,
+	0x28, 0x3d, 0x23, 0xaf, 0xeb, 0x19, 0xc9, 0x65, 0xbd, 0x90, 0xb6, 0xac, 0xbf, 0x00, 0xdc, 0xe9,
+	0xbd, 0x85, 0x7b, 0xdd, 0x16, 0xef, 0x56, 0x20, 0x7d, 0x8c, 0x0e, 0x9d, 0x40, 0x0d, 0x14, 0xbf,
+	0xa9, 0x57, 0xd1, 0x61, 0xe6, 0x62, 0xc7, 0xc3, 0x0a, 0xd2, 0x86, 0x40, 0x55, 0x6a, 0xfe, 0x12,
+	0xbc, 0x96, 0xea, 0xdf, 0xb8, 0xcb, 0x7c, 0xcd, 0x51, 0x59, 0x23, 0xdf, 0x05, 0xc8, 0xed, 0x33,
+	0x72, 0x24, 0xcb, 0xd8, 0xfc, 0xef, 0x38, 0x57, 0xb5, 0xe6, 0x6f, 0x64, 0xe0, 0x72, 0x2a, 0xfe,
+	0x08, 0x63, 0x90, 0x82, 0xb2, 0x05, 0xf5, 0x01, 0xf3, 0x75, 0x07, 0x6b, 0x21, 0x32, 0xee, 0x8c,
+	0xf7, 0xca, 0x1c, 0xd5, 0x6a, 0xbb, 0x36, 0x30, 0x4a, 0x9a, 0x7f, 0x30, 0xaa, 0x5d, 0x3b, 0xfd,
+	0x90, 0x1d, 0xd0, 0x8d, 0xb2, 0xf8, 0xa6, 0x33, 0x93, 0xd8, 0x74, 0xbe, 0x02, 0x8b, 0x0a, 0x40,
+	0x29, 0xb7, 0x34, 0x04, 0x0b, 0xb2, 0x40, 0x29, 0xb7, 0x1f, 0xc0, 0x86, 0x02, 0x4e, 0xaa, 0xc4,
+	0x44, 0x2d, 0x0d, 0x09, 0xb1, 0x1d, 0x57, 0x8d, 0x2f, 0x02, 0xb8, 0xa2, 0x69, 0xac, 0x23, 0x6e,
+	0x96, 0x69, 0x39, 0xcd, 0x1d, 0xb8, 0x9a, 0xde, 0x9f, 0x0e, 0xeb, 0x8f, 0xf1, 0xe9, 0x4c, 0x21,
+	0xe0, 0xe6, 0x4f, 0xb3, 0xb0, 0x92, 0x8a, 0xcb, 0x7a, 0x9c, 0x70, 0x5f, 0xa0, 0x2b, 0x3b, 0xaf,
+	0x8e, 0x9f, 0x15, 0xb3, 0x0d, 0x71, 0x7f, 0x86, 0x1d, 0x80, 0x98, 0x8c, 0xd5, 0x23, 0x52, 0x4d,
+	0x22, 0x1e, 0x5b, 0xab, 0x6c, 0x7d, 0x0a, 0x15, 0x37, 0x9a, 0xbf, 0x46, 0x61, 0x1a, 0x5c, 0xda,
+	0x84, 0xdb, 0x7a, 0xed, 0xb1, 0x3b, 0xe9, 0xe6, 0x63, 0xa8, 0xab, 0x6b, 0xdd, 0xcc, 0x47, 0x6f,
+	0xf6, 0xd1, 0xfe, 0x8e, 0xe2, 0xce, 0x64, 0x36, 0xba, 0x33, 0xa9, 0x9c, 0x19, 0x73, 0xba, 0x33,
+	0xe3, 0x9b, 0x50, 0x21, 0xa4, 0x53, 0xfb, 0xa3, 0x35, 0xff, 0xbf, 0x3c, 0x14, 0xa9, 0x4e, 0x02,
+	0xfc, 0x7d, 0xa8, 0x79, 0xbe, 0x7b, 0x80, 0xf4, 0x86, 0x27, 0xf0, 0x8d, 0x6c, 0xcc, 0x07, 0x47,
+	0xfb, 0x98, 0x3d, 0x2f, 0x61, 0xe9, 0xdb, 0x13, 0x2d, 0x80, 0x91, 0x2d, 0x3e, 0x6f, 0xd8, 0xe2,
+	0xcf, 0x03, 0xad, 0x1d, 0x9e, 0xbf, 0xa3, 0xee, 0xc9, 0xaa, 0x0c, 0x0b, 0x63, 0xb4, 0xa1, 0x1f,
+	0x57, 0x51, 0xc6, 0x68, 0x93, 0xbe, 0x5b, 0xe3, 0x2c, 0xf8, 0xc2, 0x2e, 0x55, 0x1a, 0x73, 0xfd,
+	0xea, 0xe7, 0xe4, 0xc3, 0x6b, 0xbd, 0x0d, 0x14, 0x73, 0x8e, 0x2e, 0x42, 0x54, 0x62, 0x87, 0x7d,
+	0x31, 0x9a, 0xb0, 0xcb, 0x03, 0xf9, 0x97, 0x93, 0x53, 0xe0, 0xf0, 0xbb, 0x93, 0xdc, 0x4b, 0xa8,
+	0x8a, 0xfe, 0xba, 0x25, 0xcc, 0xe0, 0xee, 0xb9, 0x57, 0x61, 0x9e, 0x5f, 0xb4, 0x52, 0x77, 0x44,
+	0x84, 0x03, 0x5b, 0xd5, 0x0d, 0xa2, 0x7b, 0x23, 0xfc, 0x18, 0x59, 0x76, 0x58, 0x79, 0xb6, 0x90,
+	0x5d, 0xbf, 0x26, 0xf2, 0x85, 0x67, 0x4b, 0xf3, 0xcf, 0x33, 0x70, 0x3e, 0x95, 0xd8, 0x1f, 0xba,
+	0x41, 0xe8, 0xf9, 0x27, 0xb3, 0x47, 0xb7, 0xb8, 0x07, 0x26, 0xd7, 0x0a, 0xc6, 0x9f, 0xe4, 0x24,
+	0x1f, 0x63, 0x75, 0x73, 0xca, 0xf2, 0xb3, 0x4c, 0xd9, 0x28, 0x0f, 0xf2, 0xe6, 0xbf, 0xca, 0xc0,
+	0xc2, 0xf6, 0x30, 0x08, 0xbd, 0x1e, 0xf3, 0x49, 0xd0, 0x90, 0x37, 0xb1, 0xde, 0x9f, 0x4c, 0xa2,
+	0x3f, 0xa6, 0x1a, 0x98, 0x8d, 0xab, 0x81, 0x23, 0xd6, 0x70, 0x52, 0x5e, 0xf3, 0xda, 0xb9, 0x07,
+	0xa7, 0x5c, 0xe5, 0xbc, 0x5b, 0x20, 0x21, 0x21, 0xd3, 0x67, 0xb1, 0x91, 0x7e, 0x1f, 0x16, 0x55,
+	0xa7, 0x06, 0xfa, 0xac, 0x0d, 0xb0, 0x33, 0x55, 0xf4, 0x03, 0x36, 0xf1, 0x67, 0x67, 0xc1, 0xff,
+	0x0f, 0x33, 0xb0, 0x2a, 0x3f, 0x20, 0xbc, 0x42, 0xe4, 0x57, 0x7e, 0x1e, 0x7e, 0xdb, 0x67, 0xb1,
+	0xf4, 0xf4, 0x60, 0x43, 0xb6, 0xfc, 0x71, 0xe8, 0xbb, 0xfd, 0x83, 0x27, 0x7c, 0x22, 0x64, 0xeb,
+	0xd5, 0x2c, 0x65, 0xf4, 0x59, 0x3a, 0xc3, 0x48, 0xfd, 0x46, 0x19, 0x4a, 0xf2, 0x7b, 0x09, 0xbe,
+	0x31, 0x7d, 0x9f, 0xb3, 0x71, 0xdf, 0xe7, 0x89, 0x52, 0x54, 0xf9, 0x94, 0xe7, 0xc7, 0xfb, 0x94,
+	0x17, 0xc6, 0xfa, 0x94, 0x17, 0xc7, 0xfb, 0x94, 0xcf, 0xa5, 0xf9, 0x94, 0xcb, 0x85, 0xbf, 0xa4,
+	0x69, 0xae, 0x91, 0x9f, 0x79, 0x75, 0xac, 0x9f, 0xf9, 0x8b, 0x50, 0x27, 0x3f, 0xd2, 0x96, 0x0a,
+	0x40, 0x49, 0x47, 0x19, 0x35, 0xca, 0xfe, 0x4c, 0xe4, 0xf2, 0xe1, 0x41, 0xa6, 0x75, 0x0e, 0xa2,
+	0xe8, 0x2c, 0x65, 0x9e, 0xb3, 0x75, 0x40, 0x3e, 0x0d, 0xca, 0x2c, 0x39, 0x3f, 0x8b, 0xbf, 0xfa,
+	0x5b, 0x50, 0x72, 0x05, 0xa7, 0x0b, 0x23, 0xd2, 0x7a, 0xa4, 0xd1, 0xc7, 0x44, 0x81, 0xad, 0x40,
+	0x39, 0x11, 0xb8, 0x83, 0xd6, 0x21, 0x11, 0x4a, 0xa3, 0x1e, 0x8b, 0x87, 0x97, 0x60, 0x37, 0xbb,
+	0xec, 0xca, 0xbf, 0xd6, 0x43, 0xa8, 0x8b, 0x8f, 0xab, 0xfa, 0x0b, 0xb1, 0x38, 0x3f, 0xe9, 0xdc,
+	0x64, 0xd7, 0x1c, 0x23, 0x6d, 0x7d, 0x13, 0x6a, 0x34, 0x8a, 0x0a, 0xd1, 0x62, 0xcc, 0x3b, 0x72,
+	0x34, 0x71, 0x8b, 0x98, 0x56, 0x32, 0x69, 0x7d, 0x0f, 0xd6, 0x62, 0xf3, 0xa0, 0x90, 0x5a, 0xd3,
+	0x23, 0x5d, 0x31, 0x27, 0x4d, 0x22, 0x7f, 0x5f, 0x3b, 0x0e, 0x5f, 0x1a, 0xd1, 0xd7, 0x29, 0x4f,
+	0xc3, 0x97, 0x4f, 0xbf, 0x36, 0xaf, 0xcc, 0x78, 0x1a, 0xae, 0xbb, 0x2e, 0xaf, 0x4e, 0xe7, 0xba,
+	0xbc, 0x96, 0xee, 0xba, 0x9c, 0x7a, 0x6f, 0xa1, 0x31, 0xf3, 0xbd, 0x85, 0xf5, 0x9f, 0xd5, 0xbd,
+	0x85, 0x4f, 0x60, 0x09, 0x2f, 0x6a, 0xe2, 0x6d, 0x6b, 0x94, 0x0b, 0xbc, 0x68, 0x84, 0xfc, 0xd3,
+	0x57, 0xa9, 0xac, 0xb9, 0x4a, 0x19, 0x88, 0xd0, 0x4d, 0xfd, 0xb4, 0x88, 0x6e, 0xc0, 0x82, 0x42,
+	0xb4, 0x33, 0x18, 0x83, 0xa5, 0xf9, 0x2a, 0x2c, 0x2b, 0xc8, 0xcf, 0x90, 0xa4, 0xc7, 0x41, 0xbf,
+	0x00, 0x35, 0x05, 0x3d, 0x0e, 0xee, 0xd7, 0xf3, 0x50, 0x56, 0x80, 0x09, 0x51, 0x7d, 0x53, 0x8f,
+	0x09, 0xa3, 0x8b, 0x9a, 0x94, 0x51, 0x94, 0x82, 0xf8, 0xa6, 0x94, 0xb0, 0xf9, 0x51, 0x75, 0xa2,
+	0x01, 0x93, 0xf2, 0xf7, 0x15, 0x21, 0x58, 0x8b, 0x31, 0x17, 0x2d, 0xb3, 0x0b, 0x2a, 0x84, 0x0b,
+	0x97, 0xb8, 0x64, 0xca, 0x59, 0x4f, 0x82, 0x8a, 0x51, 0x44, 0x61, 0xfc, 0x96, 0x12, 0xc6, 0x64,
+	0xbe, 0xb9, 0x90, 0x04, 0xd7, 0x86, 0x32, 0xed, 0x4e, 0x50, 0xf9, 0xb4, 0x77, 0x82, 0xe2, 0xde,
+	0x30, 0xea, 0x83, 0xe3, 0xee, 0x04, 0x69, 0x82, 0xbf, 0x12, 0x17, 0xfc, 0x29, 0x0b, 0x48, 0x35,
+	0x6d, 0x01, 0x39, 0x1b, 0x87, 0x3c, 0x80, 0x55, 0x6c, 0xa9, 0xb4, 0x4a, 0xda, 0x2c, 0x1c, 0xfa,
+	0x18, 0xc0, 0xa3, 0x01, 0x73, 0x32, 0xe4, 0x9a, 0x0c, 0xb0, 0x42, 0x49, 0xbc, 0x28, 0x19, 0x2d,
+	0xe5, 0xf8, 0xbf, 0xf9, 0x1d, 0x58, 0x34, 0xf0, 0xa0, 0xef, 0x93, 0xf0, 0x69, 0xca, 0x44, 0x3e,
+	0x4d, 0xd1, 0x8e, 0xa8, 0x30, 0xf5, 0xd5, 0xb9, 0x7f, 0x9c, 0x83, 0x79, 0x03, 0xf7, 0x24, 0xc5,
+	0xf4, 0x1b, 0x00, 0x3e, 0x76, 0x03, 0x0f, 0xaa, 0x73, 0xb1, 0x7b, 0xd3, 0xe9, 0xdd, 0xb5, 0xcb,
+	0xbe, 0xea, 0xf9, 0x98, 0xc6, 0x8c, 0xec, 0x40, 0x32, 0x76, 0x78, 0x31, 0x2d, 0x76, 0x78, 0xcc,
+	0x7f, 0xab, 0x94, 0xf4, 0xdf, 0x8a, 0xbc, 0x5b, 0x03, 0xf4, 0xac, 0x29, 0x0b, 0xcf, 0x1a, 0x91,
+	0xc7, 0x3d, 0x6b, 0x3e, 0x4e, 0x90, 0xdd, 0xb5, 0xf4, 0xde, 0x8d, 0x24, 0xbd, 0x98, 0x4b, 0x54,
+	0x25, 0xcd, 0x25, 0x0a, 0x75, 0xfb, 0x6a, 0xa4, 0xdb, 0x9f, 0x8d, 0xce, 0x7e, 0x9c, 0x85, 0x8a,
+	0x76, 0x9d, 0x45, 0xba, 0x96, 0x65, 0x22, 0xd7, 0xb2, 0x0d, 0x28, 0xa9, 0x20, 0xd3, 0x42, 0x6a,
+	0xca, 0x34, 0xdf, 0x30, 0x47, 0x21, 0x9c, 0x73, 0xd2, 0xc5, 0x56, 0x64, 0xd0, 0x8d, 0x21, 0x23,
+	0x6a, 0x73, 0x5e, 0xde, 0x18, 0x1a, 0x1d, 0xaf, 0xb9, 0x30, 0x3e, 0x5e, 0x73, 0x71, 0x52, 0xbc,
+	0xe6, 0xb9, 0x64, 0xbc, 0x66, 0xbc, 0xde, 0xb4, 0xcf, 0x7c, 0x9f, 0xf9, 0xad, 0x43, 0x2f, 0x08,
+	0xc5, 0xf4, 0x56, 0x65, 0xe6, 0x43, 0x2f, 0x08, 0x9b, 0xff, 0x28, 0x03, 0x6b, 0x23, 0x6e, 0x96,
+	0xc4, 0xee, 0xb9, 0x66, 0xa6, 0xba, 0xe7, 0x1a, 0x59, 0x0b, 0x72, 0x86, 0xb5, 0x40, 0x3a, 0x91,
+	0xe5, 0x35, 0x27, 0xb2, 0xe4, 0xd5, 0xaa, 0xc2, 0x14, 0x57, 0xab, 0x8a, 0xf1, 0xab, 0x55, 0xcd,
+	0x4d, 0x58, 0xfc, 0x84, 0x85, 0xca, 0xd9, 0x92, 0xee, 0x35, 0xac, 0x43, 0x49, 0x3a, 0x5a, 0x4a,
+	0x81, 0x21, 0xbc, 0x2c, 0x9b, 0x1f, 0xc1, 0x92, 0x00, 0x7e, 0xe2, 0x84, 0x51, 0x38, 0x07, 0x69,
+	0xd6, 0xa6, 0xce, 0xe2, 0x7f, 0x4e, 0x41, 0xcf, 0x3d, 0xbf, 0xdb, 0x91, 0x7e, 0x5f, 0x98, 0x68,
+	0xfe, 0xb4, 0xa8, 0x3c, 0x45, 0x12, 0x6b, 0x56, 0xcc, 0xc1, 0x33, 0x1b, 0x77, 0xf0, 0x8c, 0x42,
+	0xde, 0xe7, 0x8c, 0x90, 0xf7, 0xe3, 0xb8, 0x3c, 0xcd, 0x29, 0xb4, 0x30, 0xad, 0x53, 0x68, 0x31,
+	0xc5, 0x29, 0x94, 0x8f, 0xa9, 0x1e, 0x48, 0x86, 0xb6, 0x1b, 0x70, 0x14, 0x85, 0x91, 0xb9, 0x02,
+	0x55, 0x0e, 0xa0, 0x9a, 0x24, 0x44, 0xc3, 0x91, 0x13, 0x05, 0xa6, 0xb8, 0x86, 0xb7, 0x20, 0xdb,
+	0xac, 0x85, 0x96, 0x71, 0xce, 0xb8, 0x65, 0x11, 0x2a, 0x9d, 0xe7, 0x7e, 0xc2, 0x33, 0x77, 0x3a,
+	0xd6, 0x16, 0xcc, 0x73, 0x44, 0x51, 0xa8, 0x8d, 0xb8, 0x03, 0x56, 0xca, 0x54, 0xd8, 0xd5, 0x23,
+	0x2d, 0xc5, 0x8d, 0x28, 0x1c, 0x05, 0x79, 0xd1, 0x08, 0xd7, 0x90, 0x0a, 0xda, 0x95, 0x6a, 0x47,
+	0x4e, 0x48, 0x4e, 0x34, 0xe4, 0x1d, 0xf2, 0x32, 0x2c, 0x92, 0xcb, 0xbe, 0xd3, 0xe9, 0xba, 0x7d,
+	0x11, 0x2a, 0xa4, 0x8a, 0xa0, 0xf5, 0x23, 0xee, 0xb4, 0x4f, 0xf9, 0x18, 0x27, 0xe4, 0x05, 0xe0,
+	0x59, 0x2d, 0xae, 0x39, 0x33, 0x74, 0x26, 0xa1, 0x1d, 0x4d, 0xc1, 0xe6, 0xed, 0x7d, 0xcc, 0x73,
+	0xb9, 0x3f, 0x09, 0x06, 0xaa, 0xd6, 0x47, 0x02, 0x2f, 0x6f, 0x07, 0xad, 0x81, 0xd7, 0x75, 0xdb,
+	0x27, 0xc2, 0x96, 0xb3, 0xaa, 0x0d, 0x0b, 0x05, 0x77, 0xc2, 0xd2, 0x11, 0x55, 0x05, 0xc7, 0xd7,
+	0xd3, 0xab, 0x0a, 0xf6, 0x37, 0xb5, 0xf1, 0x85, 0xd3, 0x6b, 0xe3, 0x8b, 0xb3, 0x68, 0xe3, 0x9b,
+	0xb0, 0x44, 0x96, 0x32, 0x0a, 0x22, 0x21, 0x55, 0x68, 0xba, 0xcd, 0xb8, 0x88, 0x45, 0x22, 0xcc,
+	0x84, 0x7a, 0xa7, 0x81, 0x77, 0x90, 0xf7, 0x0b, 0xef, 0x32, 0x66, 0xec, 0xb9, 0x23, 0x27, 0xc4,
+	0x9b, 0xec, 0x7f, 0x98, 0x57, 0xfe, 0xcf, 0x4f, 0x9c, 0xe8, 0x00, 0x35, 0x25, 0x40, 0x81, 0xb4,
+	0x5c, 0x64, 0x4d, 0xcb, 0x45, 0x8c, 0x44, 0x73, 0x13, 0x49, 0x34, 0x9f, 0x24, 0x51, 0xbd, 0x7d,
+	0x05, 0xa3, 0x7d, 0x49, 0xba, 0x2c, 0x7e, 0x25, 0x74, 0x39, 0x37, 0x3d, 0x5d, 0x96, 0xa6, 0xa6,
+	0xcb, 0xf2, 0xcc, 0x74, 0x09, 0xa7, 0xa7, 0xcb, 0xca, 0x58, 0xba, 0xdc, 0xd2, 0xe3, 0x18, 0x60,
+	0x7c, 0x8a, 0xc9, 0xce, 0xcf, 0x51, 0x8c, 0x83, 0x07, 0xbe, 0xd7, 0x8b, 0x91, 0xf6, 0xfc, 0x2c,
+	0x86, 0x9d, 0x8f, 0x60, 0x7e, 0x5b, 0x7a, 0x0f, 0x7c, 0xe6, 0x06, 0x9c, 0x60, 0x35, 0xff, 0x02,
+	0x8a, 0xba, 0xb6, 0x10, 0x9f, 0x41, 0xcd, 0xe3, 0xa0, 0xf9, 0x02, 0x2c, 0x7f, 0xc2, 0xc2, 0x5d,
+	0x25, 0xa0, 0xe4, 0x6a, 0x11, 0x23, 0xcb, 0xe6, 0xdf, 0xca, 0x02, 0x44, 0x50, 0x69, 0x9e, 0x55,
+	0xe3, 0x57, 0xc0, 0x14, 0x01, 0x7f, 0x1d, 0x6a, 0x6e, 0x7f, 0x5f, 0xde, 0x8c, 0x96, 0xd6, 0xb5,
+	0x8c, 0x3d, 0xaf, 0x72, 0x91, 0x2e, 0x37, 0xa0, 0xb4, 0xef, 0x8b, 0xf3, 0x33, 0x22, 0x59, 0x95,
+	0x3e, 0x83, 0x71, 0x32, 0x26, 0x14, 0xe6, 0x66, 0x11, 0x0a, 0xc6, 0xa1, 0x4a, 0x29, 0x76, 0xa8,
+	0x72, 0x07, 0xaa, 0xdf, 0x75, 0x07, 0x7c, 0x6d, 0x7b, 0x8c, 0x46, 0x42, 0xb9, 0xce, 0x67, 0x4c,
+	0x67, 0xf1, 0xc4, 0x81, 0xd5, 0xdf, 0xcd, 0xc0, 0x9c, 0xa8, 0x28, 0xcf, 0x5a, 0x32, 0xd1, 0x59,
+	0xcb, 0x68, 0xa9, 0x20, 0xed, 0x99, 0x39, 0xcd, 0x9e, 0xf9, 0x8a, 0x6e, 0xae, 0xd4, 0x6f, 0x9b,
+	0xea, 0x2d, 0xfb, 0x0a, 0xac, 0x98, 0x7f, 0x91, 0x55, 0xc7, 0xcc, 0xdb, 0x87, 0x4e, 0xbf, 0xcf,
+	0xba, 0x3c, 0x50, 0xd3, 0x0c, 0x0e, 0xa6, 0xa3, 0x48, 0x63, 0x74, 0x40, 0x4f, 0xcd, 0xa7, 0xbe,
+	0x60, 0xfa, 0xd4, 0x63, 0x64, 0xd4, 0xe3, 0x98, 0x6f, 0xcc, 0xbe, 0x2b, 0x9d, 0x5f, 0x36, 0x61,
+	0x29, 0x2a, 0x6e, 0xc5, 0x0e, 0x6a, 0x16, 0x15, 0x9c, 0x92, 0x94, 0x5f, 0x4f, 0x24, 0x02, 0x83,
+	0xb4, 0x20, 0x46, 0x5a, 0x07, 0x70, 0x69, 0xd4, 0x68, 0x4b, 0xb6, 0x4d, 0x0b, 0xb1, 0x1a, 0x0d,
+	0x72, 0x76, 0xd4, 0x20, 0xe7, 0x8c, 0x41, 0x6e, 0x7e, 0x1b, 0xce, 0x8f, 0xfa, 0x10, 0x0a, 0x99,
+	0xb7, 0xa5, 0xef, 0x7e, 0x26, 0x76, 0xd5, 0x6d, 0x64, 0xf3, 0x08, 0xbe, 0xf9, 0x9f, 0xf3, 0xb0,
+	0x91, 0x84, 0x19, 0x19, 0x81, 0x71, 0xe2, 0x89, 0x8e, 0xa5, 0xe2, 0x95, 0x47, 0xdd, 0x7d, 0x11,
+	0xea, 0xb1, 0x20, 0x4c, 0x82, 0x86, 0x6a, 0x66, 0x64, 0xa5, 0x98, 0x33, 0x55, 0x21, 0xee, 0x4c,
+	0x15, 0x0d, 0x5b, 0x71, 0xd4, 0xb0, 0xcd, 0x99, 0xb4, 0x79, 0x1d, 0x6a, 0xf2, 0x3a, 0xb0, 0x20,
+	0x51, 0xf2, 0x8f, 0x9c, 0xef, 0xc9, 0x73, 0xfd, 0xb6, 0x08, 0xcb, 0x2d, 0xc0, 0x34, 0x7a, 0x2d,
+	0x8b, 0x48, 0x71, 0x58, 0xf0, 0x40, 0x51, 0xed, 0xfb, 0xb0, 0x91, 0x80, 0x8d, 0xbf, 0xd2, 0xb1,
+	0x16, 0xab, 0xa4, 0x77, 0x70, 0x10, 0xa8, 0xb6, 0xd0, 0x33, 0x1d, 0xe5, 0x41, 0x20, 0xdb, 0x71,
+	0x19, 0xaa, 0x83, 0x80, 0xe3, 0x65, 0x9d, 0x16, 0x77, 0x5d, 0xa0, 0x97, 0x39, 0x60, 0x10, 0x3c,
+	0xe0, 0x59, 0x3c, 0xae, 0xcf, 0x9b, 0xb0, 0xa2, 0x43, 0x44, 0x1f, 0x9e, 0x17, 0x61, 0xf0, 0x14,
+	0xe8, 0x08, 0xb6, 0xa9, 0x9d, 0x9e, 0x6d, 0xea, 0xa7, 0x66, 0x9b, 0x85, 0x18, 0xdb, 0xfc, 0x8b,
+	0x0c, 0x5c, 0x19, 0x4d, 0x74, 0x92, 0x73, 0x26, 0x9e, 0xb6, 0xa5, 0xc9, 0xaf, 0x14, 0x5a, 0xcb,
+	0xa5, 0xd2, 0xda, 0xa8, 0x93, 0xe6, 0x88, 0xc8, 0x0a, 0xa3, 0x88, 0xac, 0x68, 0xf2, 0xe6, 0xf7,
+	0xe0, 0xe2, 0xe8, 0xce, 0x20, 0x77, 0xbe, 0x6b, 0x72, 0xe7, 0xd5, 0x31, 0xdc, 0xa9, 0x06, 0x41,
+	0xf0, 0xe7, 0x43, 0xb8, 0x3e, 0x1e, 0xf9, 0xb4, 0xa3, 0xd5, 0xfc, 0x27, 0x39, 0x58, 0x7a, 0xe4,
+	0xf5, 0xd9, 0xc9, 0x5d, 0xfe, 0x96, 0xce, 0x6c, 0xab, 0xc2, 0xd4, 0xa3, 0xca, 0x5f, 0x1e, 0xe8,
+	0x77, 0x3c, 0x19, 0x56, 0x45, 0xbe, 0x3c, 0xd0, 0xef, 0x78, 0x22, 0x9c, 0xca, 0xcc, 0xc3, 0xcb,
+	0x29, 0x89, 0xeb, 0xa2, 0xa4, 0xc9, 0x91, 0xee, 0x5a, 0xe2, 0x19, 0xa8, 0xa8, 0x5d, 0x55, 0x07,
+	0xc8, 0xad, 0x20, 0xe4, 0x06, 0x3f, 0xd2, 0x58, 0xab, 0x22, 0xf3, 0x31, 0xcf, 0xd3, 0x57, 0xa8,
+	0xf2, 0xb8, 0x15, 0x0a, 0xe2, 0x2b, 0xd4, 0xd7, 0x73, 0xfb, 0xce, 0x60, 0x9d, 0xf9, 0x18, 0xeb,
+	0xfc, 0x19, 0x0f, 0x0a, 0x97, 0x9c, 0xc5, 0x71, 0xab, 0x4d, 0xca, 0xe4, 0x65, 0xa7, 0x99, 0xbc,
+	0xdc, 0x98, 0xc9, 0xcb, 0x8f, 0x9a, 0xbc, 0x42, 0x42, 0x17, 0xc2, 0x8d, 0x04, 0x05, 0x1f, 0xc2,
+	0xff, 0xc9, 0x39, 0x9b, 0x4b, 0xce, 0x59, 0xf3, 0x11, 0xac, 0xa5, 0x74, 0x13, 0xb9, 0xe9, 0xa6,
+	0xc9, 0x4d, 0x5a, 0x60, 0xdf, 0x94, 0x71, 0x11, 0x6c, 0xf4, 0x4f, 0xf3, 0xb0, 0x62, 0x14, 0x7f,
+	0x4d, 0x2b, 0x5c, 0x6c, 0x88, 0x0b, 0x63, 0x86, 0x78, 0xda, 0x35, 0xce, 0xe0, 0x8f, 0xd2, 0x24,
+	0xfe, 0x28, 0x8f, 0xe7, 0x0f, 0x18, 0xc7, 0x1f, 0x95, 0x29, 0x35, 0xb8, 0xea, 0x28, 0x0d, 0xee,
+	0x35, 0x58, 0xe2, 0x8f, 0x45, 0x39, 0x6e, 0xa7, 0xf5, 0xf4, 0x44, 0x45, 0xef, 0x15, 0x34, 0xbe,
+	0xe0, 0x06, 0xbb, 0x8e, 0xdb, 0xb9, 0x7b, 0xa2, 0xa6, 0xe6, 0x7f, 0xc2, 0x95, 0xeb, 0x27, 0x59,
+	0x38, 0x9f, 0x4a, 0x47, 0x3f, 0x9f, 0x45, 0xeb, 0x67, 0x20, 0x5e, 0x25, 0x87, 0xce, 0x8d, 0xe3,
+	0xd0, 0x14, 0xa9, 0xda, 0x7c, 0x39, 0xda, 0x69, 0x78, 0x41, 0x78, 0x8f, 0x75, 0x59, 0xf4, 0xbe,
+	0x69, 0x7c, 0xaf, 0xfa, 0x2d, 0x58, 0x4f, 0x1d, 0x35, 0xe4, 0xe7, 0xdb, 0x26, 0x3f, 0x5f, 0x4c,
+	0xe7, 0xe7, 0xf8, 0xc2, 0xb8, 0x0d, 0x97, 0x47, 0xa2, 0x9c, 0x7a, 0x4d, 0xfc, 0xc3, 0x2c, 0x2c,
+	0xec, 0xaa, 0xc8, 0xc1, 0x23, 0x16, 0x44, 0x1e, 0xfe, 0xa1, 0x1f, 0xfa, 0x0e, 0x0f, 0xdc, 0x6d,
+	0x04, 0xd1, 0x25, 0x83, 0xeb, 0x92, 0x2a, 0xd4, 0xc2, 0xe8, 0xde, 0x81, 0xb5, 0x58, 0x9d, 0xd8,
+	0xcc, 0xae, 0x18, 0xb5, 0xd4, 0x04, 0xd3, 0xb7, 0x98, 0x9f, 0xf8, 0x56, 0x5e, 0x7d, 0x8b, 0xf9,
+	0xb2, 0x96, 0xf1, 0x2d, 0xe6, 0xa7, 0x7c, 0xab, 0xa0, 0xbe, 0xc5, 0xfc, 0xc4, 0xb7, 0x7e, 0x46,
+	0x77, 0xfa, 0x9a, 0xef, 0xc3, 0xca, 0x96, 0xba, 0x3f, 0x88, 0xe7, 0x1e, 0xc2, 0x30, 0x93, 0xa2,
+	0x69, 0xe0, 0xd9, 0x43, 0x36, 0x3a, 0x32, 0x69, 0xfe, 0x6e, 0x1e, 0xea, 0xb1, 0xda, 0x53, 0x07,
+	0x04, 0x48, 0x73, 0xaf, 0xba, 0x03, 0x45, 0x61, 0x34, 0xca, 0xc7, 0x5c, 0xcb, 0x52, 0xdb, 0x68,
+	0x0b, 0xe8, 0x38, 0xe9, 0x14, 0x12, 0x7c, 0x7c, 0xca, 0xa8, 0x01, 0x82, 0x73, 0x4b, 0xc6, 0xc9,
+	0x43, 0xe4, 0x8b, 0x58, 0x36, 0xee, 0xc8, 0x6a, 0x5c, 0x0b, 0x26, 0xd7, 0xbe, 0x08, 0x75, 0xe5,
+	0x85, 0x69, 0x48, 0x67, 0xe5, 0x9c, 0x29, 0x88, 0xe3, 0x15, 0x58, 0x54, 0x80, 0x31, 0x01, 0xbd,
+	0x20, 0x0b, 0x14, 0x45, 0x5c, 0x81, 0x2a, 0x9e, 0xef, 0x4a, 0x94, 0xf3, 0x88, 0xb2, 0x82, 0x79,
+	0x5b, 0xea, 0xd4, 0x8e, 0x40, 0x14, 0x32, 0xb2, 0x2f, 0x93, 0x0f, 0xc9, 0x88, 0x4d, 0xc7, 0x4c,
+	0x57, 0xa7, 0x3e, 0x84, 0xaa, 0x73, 0xe4, 0xb8, 0x5d, 0x6e, 0x41, 0x6d, 0x79, 0xfd, 0x29, 0x0c,
+	0xcb, 0x15, 0x05, 0xff, 0x45, 0xbf, 0xf9, 0xeb, 0x39, 0x98, 0xff, 0x8c, 0x75, 0x0e, 0x98, 0xbf,
+	0xeb, 0x05, 0x7c, 0x76, 0x13, 0xe4, 0x73, 0x1d, 0x6a, 0x9a, 0x07, 0x78, 0xb4, 0xc8, 0xcf, 0x6b,
+	0xb9, 0xf8, 0xc2, 0xa5, 0x7e, 0xa1, 0x96, 0xf1, 0x61, 0x8f, 0xbc, 0xad, 0x16, 0x1d, 0x93, 0x7a,
+	0xc8, 0xf7, 0x8f, 0x80, 0xb4, 0xf3, 0xb4, 0x32, 0xe6, 0xec, 0x99, 0x84, 0x58, 0x38, 0x0b, 0x21,
+	0x16, 0x13, 0x84, 0xa8, 0x5d, 0xe6, 0x9c, 0x33, 0x9f, 0x76, 0x5e, 0x86, 0x42, 0x87, 0x3d, 0x75,
+	0xe5, 0xa6, 0x97, 0x12, 0x9c, 0xd8, 0xda, 0x3e, 0xeb, 0xb8, 0x52, 0x19, 0x16, 0x29, 0x83, 0x70,
+	0x21, 0x46, 0xb8, 0xa7, 0x57, 0x84, 0x9b, 0xbf, 0x0c, 0xeb, 0x34, 0x1d, 0x7b, 0xbe, 0xeb, 0x74,
+	0xef, 0x3a, 0x5d, 0xa7, 0xdf, 0x66, 0xa2, 0xcb, 0x7a, 0xdb, 0x33, 0x23, 0xda, 0x9e, 0x4d, 0x6f,
+	0x7b, 0xce, 0x68, 0x3b, 0x3e, 0x9b, 0x82, 0x98, 0x65, 0x5c, 0x07, 0x91, 0x6c, 0xfe, 0x87, 0x2c,
+	0x58, 0xc9, 0xef, 0x4f, 0x5e, 0xa6, 0xc7, 0x99, 0x4f, 0xdf, 0xe6, 0x1a, 0x57, 0x28, 0x6c, 0xcb,
+	0xb9, 0x89, 0x83, 0x51, 0xe2, 0xc0, 0xa8, 0x8d, 0xdd, 0x82, 0x39, 0xac, 0x18, 0x7a, 0x53, 0x78,
+	0xa9, 0x16, 0x3b, 0x18, 0x8f, 0xcb, 0xfa, 0x06, 0x94, 0xc4, 0xa0, 0xc8, 0xd0, 0x14, 0xd1, 0xb3,
+	0x59, 0x23, 0x47, 0xd6, 0x56, 0x75, 0x78, 0x57, 0xe9, 0x62, 0x10, 0x8d, 0x27, 0x49, 0x2b, 0xc0,
+	0xac, 0x7b, 0x38, 0xa8, 0x57, 0xa0, 0x4a, 0x00, 0x62, 0x68, 0xe9, 0x96, 0x1a, 0x55, 0xda, 0xa6,
+	0xf1, 0xa5, 0x17, 0x8d, 0xc5, 0x98, 0xca, 0xcb, 0xc7, 0xe0, 0x06, 0xe2, 0xa3, 0x9d, 0xe6, 0xaf,
+	0x66, 0x61, 0x49, 0x3c, 0x1f, 0x66, 0xb3, 0x81, 0xe7, 0x87, 0x7b, 0xbc, 0x36, 0x06, 0x55, 0x37,
+	0xc2, 0xd9, 0x47, 0x97, 0x8e, 0x0b, 0xf6, 0xa2, 0x5e, 0xb2, 0x2d, 0xaf, 0xcb, 0x71, 0xab, 0x86,
+	0x11, 0x2b, 0xb8, 0xbc, 0xcf, 0x58, 0x74, 0x9b, 0x8e, 0x1f, 0x0a, 0x18, 0x4b, 0x62, 0xf9, 0xc8,
+	0xd1, 0x1e, 0x2c, 0x35, 0x83, 0xfd, 0x93, 0x05, 0xa9, 0x3a, 0xd0, 0x23, 0xfd, 0xdf, 0x86, 0xd5,
+	0x78, 0xec, 0x7d, 0x43, 0x8e, 0x2f, 0x9b, 0x01, 0xf6, 0x23, 0x31, 0x8a, 0x2f, 0x52, 0x91, 0xc8,
+	0x30, 0x6f, 0xe6, 0x46, 0x05, 0x04, 0xdc, 0xfc, 0xd3, 0x1c, 0x5c, 0x32, 0x06, 0x43, 0x5c, 0x65,
+	0x7b, 0x3c, 0xec, 0xf5, 0x1c, 0x1f, 0xdf, 0x58, 0x44, 0x9d, 0x9b, 0x72, 0x25, 0xe5, 0x8b, 0xe4,
+	0x48, 0xe3, 0x20, 0x1f, 0x4a, 0x9c, 0x26, 0x7d, 0xd8, 0xc4, 0x5d, 0xc6, 0x45, 0x2c, 0xd1, 0x9f,
+	0x00, 0xe0, 0x53, 0x46, 0x8e, 0xe1, 0x6d, 0x35, 0x58, 0x05, 0x1b, 0x30, 0x6b, 0x5b, 0xde, 0x1f,
+	0x3e, 0xf0, 0xbd, 0x20, 0x68, 0x11, 0x98, 0x31, 0x64, 0x0b, 0x58, 0xc2, 0xbd, 0xd6, 0x82, 0x68,
+	0x6c, 0xc9, 0xdd, 0x43, 0x22, 0xa4, 0x1d, 0x5d, 0x55, 0x64, 0x6e, 0xcb, 0x37, 0x1a, 0x08, 0xa5,
+	0x04, 0x35, 0x06, 0x8a, 0x3e, 0x47, 0xfe, 0x23, 0x41, 0x74, 0x89, 0x99, 0x6a, 0x50, 0xd7, 0xcc,
+	0x4b, 0xcc, 0x58, 0x82, 0x84, 0x14, 0xcd, 0x3f, 0xc1, 0xed, 0x33, 0x16, 0x08, 0xf1, 0x55, 0xc6,
+	0x9c, 0x07, 0x8c, 0x05, 0x5c, 0xa1, 0xa1, 0xe2, 0x23, 0x47, 0xee, 0x64, 0x4a, 0x98, 0xf1, 0xc4,
+	0x49, 0x21, 0x8e, 0x4a, 0x0a, 0x71, 0xa4, 0x84, 0xb5, 0xaa, 0xa6, 0x85, 0xb5, 0x6a, 0xfe, 0xeb,
+	0x0c, 0x9c, 0x33, 0xa6, 0x78, 0x5b, 0x11, 0x01, 0x4e, 0xef, 0x88, 0xc5, 0x23, 0x33, 0x6a, 0xf1,
+	0x18, 0xf1, 0x26, 0x99, 0x21, 0x86, 0x72, 0x23, 0xb5, 0x89, 0xbc, 0xa1, 0x4d, 0xbc, 0x2b, 0x17,
+	0xa2, 0x8e, 0x3c, 0x53, 0x9c, 0x20, 0xac, 0x11, 0x1a, 0xdf, 0x09, 0xfb, 0x97, 0x59, 0x58, 0x36,
+	0xba, 0x25, 0x48, 0xd6, 0xfa, 0x42, 0x7b, 0xf2, 0x55, 0x57, 0xd6, 0xa3, 0xbb, 0xdb, 0x13, 0x08,
+	0x3e, 0x7a, 0x1c, 0x96, 0xa7, 0x02, 0x03, 0x21, 0xce, 0x51, 0xe2, 0x49, 0xa1, 0xa9, 0x11, 0x22,
+	0x85, 0x58, 0x0f, 0xa0, 0x12, 0x31, 0x62, 0xd0, 0xc8, 0xc5, 0xbc, 0x7c, 0xc6, 0x4c, 0x96, 0xad,
+	0x57, 0xb4, 0xbe, 0x80, 0x85, 0x98, 0x7c, 0xa0, 0x5b, 0xc1, 0xd3, 0x22, 0xab, 0x9b, 0xf2, 0x23,
+	0x68, 0xfe, 0x9b, 0x39, 0x98, 0x37, 0x2a, 0xcc, 0x6e, 0x72, 0x30, 0x97, 0xdf, 0xdc, 0xe9, 0x37,
+	0xc2, 0xf9, 0x19, 0x63, 0x30, 0x0b, 0x8e, 0x99, 0x92, 0x90, 0x80, 0xc0, 0xef, 0x89, 0x88, 0xef,
+	0x5a, 0xdc, 0xe9, 0x48, 0xa5, 0xe5, 0x48, 0xe9, 0x30, 0x5a, 0xd9, 0xf3, 0x26, 0x21, 0x45, 0x70,
+	0x5c, 0x3f, 0xdf, 0x86, 0xb2, 0xa8, 0x1c, 0x7a, 0x53, 0x1c, 0x00, 0x95, 0x08, 0x78, 0xcf, 0xe3,
+	0xaf, 0x1b, 0x09, 0xcf, 0x40, 0x11, 0x27, 0x73, 0xaa, 0x53, 0x20, 0xe1, 0x36, 0x48, 0x37, 0x39,
+	0x69, 0x40, 0x28, 0x67, 0xea, 0xa0, 0xd4, 0x12, 0x7c, 0x8b, 0xaf, 0x3b, 0x45, 0xa4, 0xf3, 0x64,
+	0x10, 0xf5, 0x94, 0x75, 0xd3, 0x16, 0xb0, 0x06, 0xff, 0x57, 0x13, 0x6a, 0xc8, 0x5c, 0x40, 0xfc,
+	0xd0, 0x98, 0x8f, 0xf9, 0x6e, 0xa6, 0xf1, 0xb0, 0x2d, 0xa1, 0xb9, 0x02, 0xdc, 0x71, 0x83, 0xc1,
+	0x30, 0x64, 0xd2, 0x90, 0x20, 0x74, 0x78, 0x91, 0x2b, 0x6c, 0x09, 0x0f, 0xc1, 0x92, 0x60, 0x78,
+	0xe5, 0x77, 0x5a, 0x5d, 0x7e, 0x41, 0xd4, 0x7a, 0x4c, 0x95, 0xb6, 0x42, 0x1e, 0x05, 0x53, 0x62,
+	0x8a, 0x62, 0xc7, 0x4e, 0xd6, 0xeb, 0xeb, 0xa2, 0x92, 0x0a, 0x18, 0x4b, 0x11, 0x2f, 0x9d, 0x61,
+	0xe8, 0x45, 0x11, 0xa7, 0x17, 0x65, 0xc4, 0xcb, 0xad, 0x61, 0xe8, 0xa9, 0x70, 0xd3, 0xd1, 0xdb,
+	0x42, 0x1d, 0xaf, 0x3d, 0xec, 0x89, 0xe8, 0x9c, 0xe4, 0x24, 0x22, 0x5e, 0x09, 0xb9, 0x27, 0x0a,
+	0x48, 0x85, 0xd6, 0xc3, 0x5d, 0x17, 0x6c, 0x99, 0xe4, 0x2b, 0x8e, 0x6c, 0xb9, 0xdb, 0x11, 0xef,
+	0x79, 0x97, 0x45, 0xce, 0x4e, 0xa7, 0xf9, 0xcf, 0x33, 0x31, 0x79, 0xb9, 0x8d, 0x2e, 0x4c, 0x41,
+	0xda, 0x5d, 0x72, 0xf9, 0xfa, 0xaa, 0x8f, 0x80, 0xda, 0x5d, 0x72, 0x5f, 0x47, 0x20, 0x62, 0x0e,
+	0xd1, 0x4e, 0x41, 0xc6, 0x1c, 0x8a, 0xb6, 0xcf, 0xf2, 0xaa, 0x6f, 0x96, 0x5e, 0x48, 0x3c, 0x74,
+	0x82, 0x43, 0xf9, 0x42, 0x22, 0xff, 0x7f, 0x86, 0x33, 0xd2, 0xe6, 0xef, 0x67, 0xa0, 0x61, 0xf4,
+	0xe5, 0x9e, 0xe8, 0xe6, 0x88, 0x43, 0x82, 0xf8, 0xd6, 0x9d, 0x7b, 0xb2, 0xa8, 0x0b, 0x64, 0xe2,
+	0xf8, 0xd2, 0x13, 0x31, 0x91, 0x35, 0x6d, 0x27, 0x3f, 0x4a, 0xdb, 0x31, 0x0d, 0x56, 0xa3, 0xae,
+	0xf4, 0xbd, 0x08, 0x75, 0x76, 0x3c, 0x60, 0x6d, 0xec, 0xa1, 0xae, 0x5d, 0xd4, 0x64, 0xb6, 0x58,
+	0xa1, 0x7f, 0x25, 0x0b, 0x17, 0xd3, 0xba, 0x13, 0x89, 0xeb, 0x99, 0x17, 0x69, 0xfe, 0x70, 0x88,
+	0x88, 0x3f, 0x21, 0x54, 0x33, 0x97, 0xc2, 0x4e, 0xa4, 0x19, 0x1e, 0x46, 0x9d, 0x2f, 0xe9, 0x1c,
+	0x5d, 0x18, 0xb9, 0xa2, 0x17, 0xe3, 0x2b, 0xfa, 0x69, 0x8d, 0x31, 0x3f, 0xcd, 0xc3, 0x72, 0xda,
+	0x30, 0x9c, 0x89, 0x42, 0x63, 0x0b, 0x56, 0x2e, 0xb1, 0x60, 0xa1, 0xdb, 0x24, 0x22, 0x91, 0x7c,
+	0x45, 0xfa, 0xe7, 0x3c, 0xe5, 0xca, 0x18, 0xf2, 0xa3, 0x1e, 0x2f, 0x18, 0x35, 0x0e, 0xea, 0x94,
+	0x7b, 0x2e, 0x76, 0xca, 0x3d, 0x8a, 0x6e, 0x65, 0xc8, 0xf2, 0x1d, 0x53, 0x39, 0x28, 0xc5, 0xe2,
+	0x0c, 0x8f, 0xa7, 0x13, 0x53, 0x3f, 0xb8, 0x08, 0xe0, 0xb3, 0xc0, 0xeb, 0x0e, 0x79, 0x52, 0xd8,
+	0x6b, 0xb4, 0x9c, 0xd8, 0x5c, 0xc1, 0xe9, 0xd7, 0xea, 0xca, 0x8c, 0x6b, 0x35, 0xb6, 0xe1, 0x68,
+	0xda, 0xf3, 0x26, 0x90, 0xe0, 0x5b, 0x61, 0xf3, 0xbf, 0xc5, 0xa5, 0x98, 0x9c, 0x9f, 0xb3, 0xd0,
+	0x88, 0x26, 0x53, 0x73, 0xe3, 0x64, 0x6a, 0x3e, 0x26, 0x53, 0xad, 0x4d, 0x3e, 0xf9, 0x1c, 0x89,
+	0xd0, 0x38, 0x56, 0xd3, 0xa7, 0xc9, 0x16, 0x50, 0x67, 0xb9, 0x0e, 0xf9, 0xd3, 0x39, 0xa8, 0x71,
+	0xe7, 0x39, 0xed, 0x9d, 0x8d, 0x78, 0x97, 0x75, 0xa1, 0x96, 0x35, 0x85, 0x5a, 0xd2, 0x8e, 0x94,
+	0x4b, 0xb3, 0x23, 0xbd, 0x04, 0x0b, 0x3a, 0x98, 0x66, 0x1d, 0xaa, 0x6b, 0xf9, 0x68, 0x23, 0x32,
+	0x77, 0xcb, 0xe6, 0x96, 0x4c, 0xdf, 0x2d, 0x8b, 0xdd, 0xca, 0x9b, 0xb0, 0xac, 0x83, 0x2b, 0xb1,
+	0x42, 0x4c, 0xb3, 0xa4, 0x95, 0xe9, 0x0e, 0x08, 0xda, 0x0e, 0x7a, 0x2e, 0xbe, 0x83, 0x9e, 0xc2,
+	0xa5, 0xf6, 0x12, 0x54, 0xf8, 0xee, 0xcb, 0xf4, 0x92, 0xe0, 0xbb, 0x76, 0x6d, 0xa7, 0x88, 0x00,
+	0x31, 0x63, 0x52, 0x95, 0x67, 0x2a, 0x2c, 0xef, 0x40, 0x83, 0xcc, 0x88, 0x29, 0xfd, 0xa5, 0x8d,
+	0xd9, 0x2a, 0x96, 0xef, 0x25, 0x3a, 0x7d, 0x03, 0x16, 0xa8, 0xa6, 0xd6, 0x0f, 0xb1, 0x47, 0xc3,
+	0xfc, 0x27, 0xaa, 0x33, 0x2f, 0xc3, 0x22, 0x41, 0xea, 0xed, 0x25, 0x93, 0x66, 0x1d, 0x0b, 0x1e,
+	0x44, 0x8d, 0x9e, 0xd2, 0xac, 0xf9, 0x1e, 0xac, 0xeb, 0x06, 0xd2, 0xa0, 0xe5, 0x0c, 0x06, 0xbe,
+	0x77, 0xec, 0xf6, 0x9c, 0x90, 0xbc, 0x65, 0x4b, 0xf6, 0x9a, 0x66, 0x2d, 0x0d, 0xb6, 0xa2, 0x62,
+	0xde, 0xe5, 0xd8, 0x83, 0x10, 0xad, 0xb6, 0xef, 0x86, 0xcc, 0x77, 0x1d, 0xf1, 0xaa, 0xc7, 0xaa,
+	0xf9, 0xf6, 0xc3, 0xb6, 0x28, 0x4d, 0x7b, 0x4a, 0x62, 0xf1, 0x14, 0x4f, 0x49, 0x68, 0x77, 0x9d,
+	0x2d, 0xe3, 0xb5, 0xac, 0xe4, 0x4d, 0x8c, 0xa5, 0xb4, 0x9b, 0x18, 0x57, 0xa0, 0xea, 0x06, 0x5a,
+	0xac, 0x71, 0x7a, 0xeb, 0xa3, 0xe2, 0x06, 0x51, 0xa0, 0x71, 0xcd, 0x60, 0xbd, 0x62, 0x1a, 0xac,
+	0xa5, 0xcd, 0x2c, 0x74, 0x7b, 0x74, 0x85, 0x6e, 0x0a, 0x9b, 0x19, 0x4f, 0x36, 0x7f, 0x6d, 0x0e,
+	0xca, 0x4f, 0x9c, 0x70, 0xc4, 0xd6, 0x69, 0xb4, 0x17, 0x9e, 0xee, 0x57, 0x9b, 0x33, 0xfd, 0x6a,
+	0xc7, 0xf9, 0xb1, 0xa7, 0x1b, 0xac, 0x0a, 0xa3, 0x0c, 0x56, 0x57, 0x61, 0x5e, 0x5a, 0x3c, 0x8e,
+	0x58, 0x7f, 0xc8, 0x84, 0x11, 0xa9, 0x2a, 0x4c, 0x1d, 0x98, 0x37, 0x89, 0xe9, 0x62, 0x1c, 0x55,
+	0x4a, 0x70, 0x14, 0x8f, 0xe7, 0x2d, 0x87, 0x38, 0xe6, 0x9d, 0xa4, 0xf2, 0xc7, 0xd9, 0xa9, 0x20,
+	0xdd, 0x4e, 0xa5, 0x2d, 0xbf, 0x15, 0x63, 0xf9, 0xbd, 0x03, 0x6b, 0x62, 0x14, 0x5b, 0x4e, 0x1f,
+	0xdf, 0xe7, 0x0b, 0x87, 0x7e, 0xdf, 0x3b, 0x62, 0xbe, 0xe0, 0xb4, 0x15, 0x51, 0xbc, 0x85, 0xa5,
+	0x7b, 0xa2, 0x90, 0x1f, 0x5e, 0xe1, 0xe5, 0x83, 0x44, 0x2d, 0x62, 0xba, 0x25, 0x2c, 0x8c, 0xd5,
+	0xe1, 0x61, 0x2b, 0x53, 0x78, 0x89, 0x5e, 0x72, 0xb2, 0x9c, 0x24, 0x1b, 0x19, 0xc6, 0xd7, 0xfa,
+	0xe9, 0x8c, 0xaf, 0x0b, 0x53, 0x1b, 0x5f, 0x3f, 0x46, 0xd6, 0x68, 0x71, 0x3d, 0xb0, 0x4b, 0xdb,
+	0xe0, 0xc9, 0xce, 0xea, 0xdc, 0xa4, 0xf4, 0x25, 0xaf, 0x90, 0x12, 0x23, 0xe9, 0xe7, 0xf5, 0x9c,
+	0xef, 0x2d, 0xfe, 0xd0, 0xb3, 0x3b, 0xe5, 0x35, 0xd9, 0x22, 0x07, 0xdd, 0x0a, 0xad, 0x26, 0xcc,
+	0x47, 0xc6, 0x3b, 0x2e, 0x26, 0x88, 0x87, 0x2b, 0xca, 0x6a, 0xb7, 0xd3, 0x69, 0xfe, 0x83, 0x2c,
+	0x2c, 0x3c, 0x71, 0xc2, 0x2f, 0x54, 0x96, 0x30, 0x66, 0x8e, 0xb8, 0xdb, 0xaf, 0x73, 0x61, 0xd6,
+	0xe4, 0x42, 0xbe, 0x7c, 0x3a, 0xc7, 0x78, 0xca, 0x63, 0xd8, 0x7b, 0xe7, 0x45, 0xee, 0x74, 0x36,
+	0xdf, 0xa4, 0xe0, 0x2e, 0xa4, 0x09, 0xee, 0x37, 0x60, 0x99, 0xc0, 0x62, 0x9f, 0x24, 0x76, 0xb5,
+	0x68, 0xad, 0x31, 0xbe, 0x9b, 0xb6, 0xce, 0xcc, 0xa5, 0xae, 0x33, 0xd7, 0xa0, 0x86, 0x7d, 0x94,
+	0x1a, 0x91, 0x8c, 0x09, 0x5e, 0x3d, 0x92, 0x62, 0x6b, 0xa7, 0x13, 0x34, 0x7f, 0xd9, 0x1c, 0xb7,
+	0x07, 0x6e, 0x17, 0xf7, 0x08, 0xfc, 0xf6, 0x98, 0x13, 0xaa, 0x90, 0xa4, 0x98, 0x92, 0x0f, 0xa2,
+	0xeb, 0x6f, 0xef, 0xe3, 0x83, 0xe8, 0xf2, 0xd1, 0xfd, 0xb6, 0xd7, 0x0f, 0x99, 0x18, 0xb0, 0xaa,
+	0x2d, 0x93, 0x28, 0xd7, 0xf8, 0x03, 0x00, 0xc1, 0xb0, 0xa7, 0xe4, 0x9a, 0x48, 0x37, 0xff, 0x6b,
+	0x0e, 0xaa, 0xfa, 0xf7, 0xd3, 0x76, 0x74, 0x2a, 0x62, 0x56, 0x41, 0x5c, 0x2d, 0x6a, 0xc0, 0xdc,
+	0x0f, 0x87, 0x8e, 0x1f, 0x8a, 0xf0, 0x57, 0x05, 0x5b, 0x26, 0x4d, 0x2e, 0xcc, 0x9f, 0x8e, 0x0b,
+	0x0b, 0x53, 0x73, 0xa1, 0x2e, 0xb0, 0x8b, 0x31, 0x81, 0xfd, 0xba, 0xb9, 0x27, 0x88, 0xae, 0xbb,
+	0xc6, 0xa9, 0x54, 0xee, 0x05, 0xd4, 0x73, 0x50, 0x31, 0x52, 0x28, 0x69, 0xcf, 0x41, 0x25, 0x48,
+	0x41, 0xd9, 0x95, 0x4d, 0xf9, 0x5b, 0x93, 0xe6, 0xe5, 0x91, 0xa4, 0x00, 0x49, 0x52, 0xe0, 0x4d,
+	0xe6, 0xb3, 0xc9, 0xc5, 0xee, 0xe8, 0x26, 0x73, 0x02, 0xb1, 0x09, 0x2e, 0x26, 0x43, 0xaa, 0xb3,
+	0xa8, 0xb8, 0x3e, 0xd4, 0x62, 0x12, 0x57, 0xbf, 0x43, 0xa6, 0x4d, 0xf4, 0x88, 0x65, 0xf4, 0x34,
+	0x2f, 0x37, 0xde, 0x13, 0xf1, 0xda, 0x9f, 0xb8, 0xec, 0x39, 0xfa, 0x68, 0x9c, 0xe6, 0x9a, 0x5e,
+	0xf3, 0xef, 0x58, 0x50, 0x57, 0x68, 0x76, 0x87, 0x4f, 0xbb, 0x6e, 0x7b, 0xaa, 0xb7, 0xf4, 0x46,
+	0x3d, 0xf7, 0x95, 0x9b, 0xea, 0xb9, 0xaf, 0x7c, 0x82, 0xbc, 0xd4, 0x43, 0x51, 0x85, 0xa9, 0x1e,
+	0x8a, 0x3a, 0xc3, 0x05, 0x88, 0xd8, 0x2b, 0x80, 0x73, 0xc9, 0x57, 0x00, 0x93, 0x0f, 0x7d, 0x95,
+	0x66, 0x7e, 0xe8, 0x2b, 0xfe, 0xa4, 0x4d, 0x39, 0xf9, 0xa4, 0x4d, 0x6c, 0x7f, 0x0f, 0x69, 0xce,
+	0x0f, 0xe2, 0xd6, 0x78, 0xc5, 0x08, 0xe1, 0x11, 0x69, 0x14, 0x55, 0x43, 0xa3, 0xb8, 0x6f, 0xee,
+	0x81, 0x70, 0x21, 0x9d, 0x7c, 0xab, 0x46, 0xdf, 0x1f, 0xe1, 0x5a, 0x2a, 0xdf, 0x5e, 0xab, 0xcd,
+	0xfe, 0xf6, 0x5a, 0xfd, 0x14, 0x0a, 0xb3, 0x34, 0xeb, 0x2c, 0x4c, 0x78, 0x74, 0x67, 0x31, 0xf5,
+	0xd1, 0x9d, 0x0f, 0xe2, 0xaa, 0xa1, 0x95, 0xf6, 0xba, 0x8a, 0xe2, 0x91, 0x98, 0xce, 0xf8, 0x06,
+	0xcc, 0x85, 0xce, 0x31, 0x7a, 0x81, 0x2f, 0x8d, 0xaf, 0x57, 0x0c, 0x9d, 0x63, 0xee, 0x1a, 0xfe,
+	0x1d, 0xb8, 0x20, 0x6a, 0x44, 0xb7, 0xa6, 0xd8, 0xb1, 0xb8, 0x69, 0xc9, 0xf1, 0x2c, 0x8f, 0xc7,
+	0xb3, 0x4e, 0x78, 0xe4, 0x92, 0x79, 0x5f, 0x54, 0xe5, 0xa8, 0xdf, 0x87, 0x79, 0x89, 0x9a, 0xce,
+	0x6a, 0x56, 0xc6, 0xa3, 0xaa, 0x10, 0x2a, 0x3a, 0x98, 0xd9, 0x82, 0x05, 0xe9, 0x30, 0xaf, 0xea,
+	0xaf, 0x8e, 0xaf, 0x2f, 0x9c, 0xf6, 0x15, 0x8a, 0x6d, 0x58, 0xd4, 0x51, 0xd0, 0x23, 0xd3, 0x6b,
+	0xe3, 0x71, 0xd4, 0x23, 0x1c, 0x08, 0x6f, 0x7d, 0x0e, 0x6b, 0x91, 0xe3, 0x3e, 0x33, 0x50, 0x35,
+	0xc6, 0xa3, 0x5a, 0x56, 0xee, 0xfc, 0x4c, 0xc3, 0x77, 0x1f, 0x4d, 0xcc, 0xc1, 0x70, 0xc0, 0xfc,
+	0x08, 0x63, 0x63, 0x7d, 0x3c, 0xaa, 0x05, 0x59, 0x45, 0x22, 0xe3, 0x2f, 0xf0, 0xa0, 0xf6, 0x4f,
+	0x03, 0xb3, 0x31, 0xbe, 0x3a, 0x3f, 0x0b, 0x0f, 0xd4, 0xb0, 0x46, 0xf5, 0x5a, 0xc8, 0x7f, 0x8d,
+	0x73, 0xe3, 0x6b, 0xd7, 0x54, 0x6d, 0x0c, 0xe9, 0x60, 0xbd, 0x03, 0x95, 0x3e, 0x0b, 0x15, 0x7d,
+	0x9e, 0x1f, 0x5f, 0x1b, 0xfa, 0x2c, 0x94, 0xd4, 0xb9, 0x03, 0xcb, 0xf4, 0xa4, 0x5f, 0xcb, 0x24,
+	0xf1, 0x0b, 0xe3, 0x51, 0x58, 0x54, 0xe9, 0x13, 0x9d, 0xd0, 0x77, 0xa1, 0x21, 0xa6, 0x45, 0x60,
+	0xd4, 0xe6, 0xe5, 0xe2, 0x78, 0x74, 0x2b, 0x54, 0x91, 0xae, 0x82, 0x47, 0x13, 0xd3, 0x82, 0xcb,
+	0x4a, 0x7a, 0x49, 0x9c, 0xf1, 0x19, 0xbf, 0x34, 0x1e, 0xf3, 0xf9, 0x9e, 0x72, 0xeb, 0x44, 0xdc,
+	0xe6, 0xcc, 0x7f, 0x08, 0x35, 0x81, 0x57, 0xb2, 0xe8, 0xe5, 0x09, 0xac, 0x4d, 0xe0, 0x7b, 0xc4,
+	0xa8, 0xfb, 0x70, 0xcd, 0xac, 0x3e, 0x82, 0x5f, 0xaf, 0x8c, 0x47, 0x7a, 0x49, 0x47, 0x9a, 0xc6,
+	0xb5, 0xcf, 0xe1, 0x35, 0x45, 0xa0, 0x53, 0x7d, 0xb0, 0x39, 0xfe, 0x83, 0x2f, 0x4a, 0x6c, 0xf6,
+	0x84, 0x0f, 0x3f, 0x82, 0x55, 0xf1, 0x3d, 0x4e, 0x17, 0x7e, 0xc0, 0x14, 0x7d, 0x5c, 0x9d, 0xc0,
+	0x68, 0x54, 0xcd, 0xa6, 0x5a, 0x92, 0x42, 0xb6, 0x61, 0x31, 0x22, 0x0d, 0xc9, 0x28, 0xd7, 0x26,
+	0x70, 0xbf, 0x2f, 0x89, 0x42, 0xb0, 0xcb, 0xe7, 0xb0, 0x96, 0x40, 0x22, 0xb8, 0xe6, 0xfa, 0x54,
+	0x8d, 0x7a, 0x60, 0xf2, 0x4e, 0xf4, 0x04, 0xe9, 0x0b, 0x53, 0x3c, 0x41, 0xaa, 0x1e, 0xd7, 0x7c,
+	0x71, 0xf2, 0xe3, 0x9a, 0x0d, 0x45, 0xbc, 0x71, 0x9f, 0xe0, 0x1b, 0x64, 0x25, 0xea, 0xa5, 0xbe,
+	0x09, 0xdf, 0xfc, 0xad, 0x97, 0x61, 0x41, 0x35, 0x5d, 0x3c, 0x9b, 0xf5, 0xd7, 0x0a, 0xd3, 0x5f,
+	0x2b, 0x4c, 0xff, 0xcb, 0x28, 0x4c, 0x4f, 0xe0, 0x9c, 0x9c, 0x2b, 0x63, 0x55, 0x11, 0x6c, 0x3a,
+	0x41, 0x7d, 0x6a, 0x88, 0xba, 0xfa, 0xe2, 0x42, 0xac, 0xfa, 0x0b, 0x70, 0x3e, 0x1d, 0x2f, 0xf9,
+	0xbf, 0x4e, 0xd2, 0xaf, 0xd6, 0x53, 0x10, 0x7f, 0x81, 0x35, 0xad, 0x4f, 0x61, 0x25, 0x15, 0xf3,
+	0x24, 0x55, 0x6b, 0x29, 0x05, 0xa5, 0xf5, 0x11, 0xc8, 0x48, 0x19, 0x6a, 0x59, 0x99, 0xa0, 0x66,
+	0x49, 0x3a, 0x15, 0xeb, 0xca, 0x37, 0x61, 0x25, 0x86, 0x40, 0x8c, 0xdc, 0x04, 0x6d, 0xcb, 0x32,
+	0xd0, 0xd0, 0x98, 0x7d, 0x06, 0xab, 0x71, 0x5c, 0x62, 0xb4, 0xd6, 0xa6, 0xeb, 0x1a, 0x21, 0x13,
+	0xe3, 0x74, 0x08, 0xd7, 0xe3, 0xd8, 0xd2, 0x57, 0xa0, 0x09, 0x8a, 0xd8, 0x65, 0x03, 0x79, 0xda,
+	0xd2, 0x93, 0x32, 0x06, 0xb4, 0x5e, 0xac, 0xcf, 0x32, 0x06, 0xb4, 0x64, 0xec, 0x42, 0x23, 0x9d,
+	0x6e, 0xf6, 0x8f, 0x27, 0xe9, 0x69, 0x2b, 0x29, 0x13, 0xfc, 0xe0, 0xd8, 0xfa, 0x3e, 0x5c, 0x1e,
+	0x85, 0x51, 0xcd, 0xf9, 0x04, 0x1d, 0xee, 0x5c, 0x2a, 0x66, 0x41, 0x01, 0xbf, 0x04, 0x97, 0x46,
+	0xe2, 0x1f, 0xf8, 0xde, 0xbe, 0x1b, 0x36, 0xce, 0x9f, 0x06, 0xfd, 0x2e, 0xd6, 0x4d, 0xee, 0x68,
+	0x2e, 0x9c, 0x72, 0x47, 0x73, 0xf1, 0x2b, 0xda, 0xd1, 0x5c, 0xfa, 0xea, 0x76, 0x34, 0x97, 0xcf,
+	0xb8, 0xa3, 0xb9, 0xf2, 0x15, 0xec, 0x68, 0x9a, 0x33, 0xee, 0x68, 0xf6, 0xe1, 0x9a, 0x52, 0xf0,
+	0x12, 0xd8, 0x5a, 0x01, 0xeb, 0xee, 0xe3, 0x75, 0x90, 0x49, 0x5a, 0xd7, 0x25, 0x89, 0xe4, 0x91,
+	0x89, 0xff, 0x31, 0xeb, 0xee, 0xf3, 0x0b, 0x23, 0xd6, 0x1e, 0x6c, 0xa4, 0x7d, 0x47, 0x50, 0xd4,
+	0x04, 0x4d, 0x6c, 0x2d, 0x81, 0x5d, 0x50, 0xd3, 0x98, 0xfd, 0xd8, 0xf5, 0xd3, 0xec, 0xc7, 0x7e,
+	0x08, 0x2f, 0x27, 0x5a, 0x19, 0x43, 0xac, 0xf1, 0xc1, 0x0b, 0xe3, 0x3f, 0x71, 0x2d, 0xd6, 0x6a,
+	0xe3, 0x53, 0x8a, 0x21, 0xa6, 0xf9, 0x64, 0x34, 0x0d, 0x2f, 0x9e, 0xe1, 0x93, 0x6a, 0x2e, 0x74,
+	0xa5, 0x7e, 0xd4, 0x27, 0x85, 0x36, 0x47, 0x1d, 0xbd, 0x31, 0xa5, 0x52, 0x9f, 0xf6, 0x55, 0xa4,
+	0x55, 0xd1, 0xd7, 0xf4, 0xed, 0xee, 0x4b, 0xb3, 0x6e, 0x77, 0xbf, 0x0d, 0xe7, 0x65, 0x9e, 0xd6,
+	0xf0, 0x68, 0x5e, 0x5e, 0x9e, 0xbc, 0xca, 0x1b, 0x08, 0xd5, 0x5c, 0x98, 0xfb, 0xe8, 0x57, 0xce,
+	0xb4, 0x8f, 0x7e, 0xf5, 0x4c, 0xfb, 0xe8, 0xd7, 0xa6, 0xdf, 0x47, 0xff, 0x02, 0x9c, 0x8f, 0xcf,
+	0xa6, 0x31, 0x79, 0x9b, 0x93, 0x55, 0x13, 0x6d, 0xf2, 0xf4, 0xe9, 0x22, 0xd5, 0x84, 0x30, 0x1b,
+	0x28, 0x5f, 0x9f, 0xbc, 0x7e, 0x63, 0x2d, 0x1d, 0x59, 0x1b, 0x9a, 0xd1, 0xab, 0xe5, 0xc9, 0x6d,
+	0xbf, 0x18, 0xb5, 0x37, 0xc6, 0x63, 0xbe, 0xa8, 0xde, 0x2d, 0x8f, 0xdb, 0x00, 0x68, 0x14, 0x19,
+	0x5c, 0x1d, 0xfb, 0x11, 0xa1, 0x7f, 0xbc, 0x39, 0x59, 0x98, 0xa5, 0x7f, 0x45, 0xe8, 0x22, 0x9a,
+	0x36, 0x98, 0xf6, 0x99, 0xc6, 0xcd, 0xf1, 0xf8, 0xd7, 0x47, 0xe2, 0xd7, 0x75, 0xa6, 0x98, 0x79,
+	0xe0, 0xd6, 0x74, 0x3a, 0x93, 0xbe, 0xaf, 0x16, 0x8c, 0x92, 0x82, 0x4d, 0x8c, 0xf6, 0xed, 0xe9,
+	0xd4, 0x61, 0x1d, 0x27, 0x8d, 0xf3, 0x77, 0xe0, 0xc2, 0x08, 0xc4, 0x62, 0x84, 0xdf, 0x9a, 0x65,
+	0x04, 0x0c, 0x3d, 0xcf, 0x86, 0xf5, 0x18, 0x6a, 0x4d, 0xa8, 0xdf, 0x19, 0x8f, 0x76, 0xd5, 0x40,
+	0x1b, 0x89, 0xf5, 0xef, 0xc1, 0xc5, 0x98, 0x7d, 0x28, 0xbe, 0x5a, 0xbc, 0x3d, 0x1e, 0xf1, 0x86,
+	0x61, 0x25, 0x32, 0xd7, 0x8c, 0x51, 0x76, 0xac, 0x77, 0x66, 0xb7, 0x63, 0x45, 0x06, 0x86, 0x84,
+	0xb2, 0xf8, 0xee, 0x54, 0x06, 0x86, 0x98, 0xae, 0x38, 0xce, 0x2e, 0xf6, 0xde, 0xa9, 0xec, 0x62,
+	0x7d, 0xb8, 0x11, 0x17, 0x36, 0x09, 0xd4, 0x52, 0x4a, 0xbc, 0x3f, 0xfe, 0x0b, 0x57, 0x4d, 0xc1,
+	0x13, 0xfb, 0x92, 0x90, 0x1a, 0xff, 0x27, 0xbc, 0x39, 0xea, 0x7b, 0xa3, 0x17, 0xc9, 0x0f, 0xc6,
+	0x7f, 0xf8, 0xe5, 0xd4, 0x0f, 0xa7, 0x2f, 0x95, 0xd3, 0xd8, 0x01, 0x3f, 0x3c, 0x8b, 0x1d, 0xf0,
+	0x04, 0x36, 0xa7, 0xed, 0xa0, 0x18, 0xd6, 0x6f, 0x8c, 0xff, 0xdc, 0x8d, 0xc9, 0xbd, 0x13, 0x63,
+	0x9b, 0x34, 0x41, 0x7e, 0xf4, 0xb3, 0x30, 0x41, 0x7e, 0xfc, 0xf3, 0x36, 0x41, 0x6e, 0x7d, 0x45,
+	0x26, 0xc8, 0x87, 0xb0, 0x1c, 0xfb, 0x1e, 0xe9, 0x05, 0x77, 0xc7, 0xe3, 0x5f, 0xd4, 0x3b, 0x44,
+	0xfa, 0xc1, 0x68, 0x63, 0xe6, 0xf6, 0x57, 0x66, 0xcc, 0xbc, 0xf7, 0xd5, 0x19, 0x33, 0xef, 0x9f,
+	0xc6, 0x98, 0xa9, 0xab, 0x21, 0x72, 0xd8, 0x74, 0x9d, 0xe1, 0xc1, 0x94, 0x6a, 0x88, 0x98, 0x15,
+	0x4d, 0x73, 0x88, 0xcc, 0xa4, 0x9f, 0xcc, 0x62, 0x26, 0x7d, 0x78, 0x16, 0x33, 0xe9, 0xce, 0x58,
+	0x33, 0xe9, 0xf7, 0x61, 0xc1, 0x66, 0xfc, 0x71, 0x1f, 0xd6, 0xef, 0xb0, 0x0e, 0x06, 0xd0, 0xd3,
+	0x9c, 0xd0, 0x33, 0x23, 0x03, 0x9e, 0x66, 0x47, 0x86, 0x35, 0x36, 0x0e, 0xc6, 0x9b, 0x3f, 0x10,
+	0x51, 0xf9, 0xf6, 0xb8, 0x03, 0xc1, 0x4c, 0x51, 0xf9, 0xde, 0x80, 0xa2, 0x8f, 0xb7, 0x07, 0xc4,
+	0xe5, 0xa5, 0x86, 0x66, 0x38, 0x95, 0x08, 0x6d, 0x0e, 0x60, 0x0b, 0xb8, 0xe6, 0xb7, 0xa0, 0x1e,
+	0x2b, 0xe2, 0x1f, 0x18, 0x78, 0x81, 0x1b, 0xca, 0xce, 0x14, 0x6c, 0x95, 0xc6, 0x28, 0xd4, 0xf2,
+	0xca, 0x6a, 0xc6, 0xc6, 0xff, 0xbc, 0x81, 0xe2, 0x36, 0x6a, 0xc6, 0xce, 0x86, 0x5e, 0x73, 0x19,
+	0xb2, 0x3b, 0x89, 0x47, 0x7f, 0x9a, 0x9b, 0x50, 0x42, 0xf4, 0x3b, 0xf4, 0x92, 0x28, 0x62, 0x11,
+	0xfe, 0x03, 0x1a, 0x16, 0x72, 0x1d, 0xe1, 0x58, 0xfe, 0x5d, 0x0e, 0x36, 0x64, 0x40, 0x06, 0x11,
+	0x8d, 0x13, 0x63, 0x3b, 0xd2, 0x12, 0x1f, 0x8b, 0xf3, 0x95, 0x19, 0xff, 0x68, 0x62, 0x36, 0xfe,
+	0x68, 0x22, 0x1a, 0x5b, 0x51, 0xda, 0x6a, 0x71, 0x58, 0x80, 0xb2, 0xd0, 0x43, 0x86, 0xbf, 0x8c,
+	0x6a, 0x44, 0xfd, 0x42, 0xd1, 0x92, 0x17, 0x2f, 0xa3, 0xea, 0x91, 0xbf, 0xb8, 0xa8, 0xb8, 0x11,
+	0xed, 0xe6, 0xd5, 0xb6, 0x86, 0x5c, 0x6e, 0x6b, 0xe6, 0x3e, 0x93, 0xc7, 0xa4, 0x8c, 0x43, 0xc6,
+	0x9d, 0x6e, 0x57, 0xcd, 0x2a, 0x46, 0x20, 0xda, 0xc0, 0x68, 0xce, 0x9c, 0xb8, 0x7e, 0x18, 0x68,
+	0x4d, 0x89, 0xc7, 0xff, 0x2a, 0x4d, 0x1f, 0xff, 0xab, 0x3c, 0x32, 0xfe, 0xd7, 0x1b, 0xb0, 0xac,
+	0x58, 0xe5, 0xd0, 0xeb, 0x31, 0x19, 0x31, 0x95, 0x8c, 0xd4, 0x96, 0x2c, 0x7b, 0xe8, 0xf5, 0x98,
+	0x08, 0x99, 0xca, 0x03, 0x6a, 0x63, 0x88, 0x55, 0x01, 0x49, 0x26, 0xeb, 0x0a, 0xe6, 0x11, 0x08,
+	0xbf, 0xef, 0x7b, 0x3d, 0x65, 0x82, 0xa3, 0x20, 0xe1, 0x5c, 0x10, 0xa0, 0x6f, 0x58, 0x6c, 0xb6,
+	0x32, 0x53, 0xce, 0x56, 0x76, 0x86, 0xd9, 0xca, 0xcd, 0x3e, 0x5b, 0xf9, 0xb1, 0xb3, 0x35, 0x22,
+	0x4e, 0x4d, 0x21, 0x3d, 0x4e, 0x4d, 0xf3, 0xdf, 0x67, 0xe0, 0xd2, 0x98, 0xc1, 0xc0, 0x61, 0x48,
+	0xef, 0x65, 0x66, 0x86, 0x5e, 0x66, 0x67, 0xef, 0x65, 0xee, 0x34, 0xbd, 0xcc, 0x8f, 0xe8, 0xe5,
+	0x5f, 0x65, 0xe0, 0xdc, 0x98, 0x5e, 0x5a, 0x0f, 0xb8, 0x10, 0xe5, 0xd3, 0x2e, 0xae, 0x86, 0x6e,
+	0x26, 0x1e, 0x71, 0x1a, 0x4b, 0x28, 0xb6, 0xa8, 0x6d, 0x3d, 0x04, 0xd0, 0x5e, 0xac, 0x8a, 0xdf,
+	0x0a, 0x9d, 0x30, 0xce, 0xb6, 0x56, 0xd7, 0xfa, 0x18, 0x8a, 0xb4, 0x36, 0x34, 0x72, 0x33, 0x62,
+	0x11, 0xf5, 0x9a, 0x3f, 0x2e, 0x40, 0xee, 0x53, 0x76, 0x92, 0x76, 0x8c, 0x86, 0x47, 0x41, 0x59,
+	0x2d, 0x84, 0xe8, 0x35, 0xa8, 0x3d, 0x63, 0x27, 0x2d, 0x71, 0xb1, 0x29, 0xba, 0x0e, 0x50, 0x7d,
+	0xc6, 0x4e, 0xc4, 0xf5, 0x55, 0xba, 0x3a, 0xa3, 0xc7, 0x91, 0x2f, 0x24, 0xe2, 0xc8, 0xeb, 0x17,
+	0x0e, 0x8a, 0xe6, 0x85, 0x83, 0x33, 0xbc, 0xb3, 0x4e, 0xf7, 0x43, 0xf8, 0x85, 0xd4, 0x29, 0xef,
+	0x4e, 0x82, 0x04, 0xdf, 0xf3, 0xa8, 0x72, 0x87, 0xb1, 0xde, 0xb4, 0xe1, 0x33, 0x41, 0x82, 0x53,
+	0x38, 0x25, 0x3e, 0x3c, 0x2d, 0xbc, 0xaa, 0x26, 0xe3, 0x4a, 0x78, 0x1d, 0xf6, 0x90, 0x5f, 0x57,
+	0xe3, 0x4f, 0x12, 0xf5, 0xdb, 0xfe, 0x09, 0x5e, 0xa9, 0xc4, 0x11, 0xad, 0xa0, 0x4b, 0xe3, 0xbc,
+	0xca, 0xc5, 0xe3, 0xb5, 0x57, 0xc1, 0x8a, 0xc0, 0x3a, 0x4e, 0xe8, 0xb4, 0x78, 0x14, 0xfd, 0x2a,
+	0x82, 0x2e, 0xa8, 0x12, 0xfe, 0x1e, 0x1a, 0x9f, 0xac, 0x26, 0xcc, 0xf7, 0x9c, 0x20, 0x64, 0x3e,
+	0x87, 0xe2, 0xc3, 0x48, 0xb1, 0x11, 0x2b, 0x94, 0xf9, 0x29, 0xe3, 0x57, 0xb9, 0xee, 0x68, 0x0f,
+	0x02, 0xd4, 0x62, 0x4f, 0xf6, 0x7c, 0xca, 0x4e, 0xc6, 0xbd, 0x40, 0xe3, 0xb3, 0x23, 0xef, 0xd9,
+	0xd4, 0x71, 0x4d, 0x04, 0xf4, 0x56, 0x78, 0xb6, 0xc7, 0x00, 0xfe, 0x3c, 0x03, 0x95, 0x4f, 0xd9,
+	0xc9, 0xd6, 0xb0, 0xe3, 0x86, 0x9f, 0x79, 0xc9, 0x98, 0x26, 0x2b, 0x50, 0x14, 0x9d, 0x15, 0x55,
+	0x9f, 0x61, 0x37, 0x4f, 0x45, 0x93, 0xf9, 0xb1, 0x34, 0x59, 0x30, 0x69, 0x92, 0xab, 0x41, 0x74,
+	0xa8, 0x27, 0xae, 0x6a, 0xa5, 0xc6, 0x01, 0x9e, 0xe9, 0xca, 0xda, 0x4f, 0xb2, 0xb0, 0xf2, 0x29,
+	0x3b, 0xa1, 0x0b, 0xd4, 0x18, 0x75, 0x98, 0x6e, 0xe0, 0xea, 0xbd, 0xcc, 0x8c, 0xef, 0x65, 0x76,
+	0x72, 0x2f, 0x73, 0x63, 0x7b, 0x99, 0x37, 0x7b, 0x19, 0x63, 0x9f, 0xc2, 0x4c, 0xec, 0xc3, 0xef,
+	0xe3, 0x53, 0x07, 0xa6, 0x3c, 0xe0, 0x16, 0xd0, 0x5b, 0x61, 0xf3, 0x3f, 0xce, 0x41, 0x6d, 0xd7,
+	0xb8, 0xad, 0x3a, 0xfb, 0xe5, 0x71, 0xfe, 0x42, 0x20, 0x5e, 0x20, 0xa5, 0x5e, 0x73, 0x77, 0xd4,
+	0x12, 0x65, 0x50, 0xd0, 0x1a, 0x2d, 0xa2, 0x42, 0x3e, 0x1e, 0x51, 0x41, 0x8b, 0xab, 0x52, 0x30,
+	0xe2, 0xaa, 0x8c, 0x75, 0xc9, 0xfd, 0x7a, 0xee, 0x78, 0xa7, 0xc7, 0xc7, 0x28, 0x8f, 0x8a, 0x8f,
+	0x11, 0x7b, 0x57, 0x04, 0x92, 0xef, 0x8a, 0xbc, 0x87, 0x10, 0xa1, 0xdb, 0x47, 0x1a, 0x4c, 0x3c,
+	0xca, 0x28, 0xd7, 0x90, 0xbb, 0x4e, 0xff, 0x19, 0xbf, 0xef, 0xaf, 0x03, 0xf3, 0x8b, 0x42, 0x6a,
+	0x56, 0x9c, 0x03, 0x9f, 0x0b, 0xc3, 0xbe, 0x7a, 0x42, 0xa2, 0x2a, 0x83, 0xc4, 0x12, 0xc0, 0x96,
+	0x2c, 0x17, 0x8f, 0x49, 0xdc, 0xe1, 0xce, 0xb8, 0xbd, 0x81, 0xd3, 0x3f, 0x49, 0xbc, 0x5f, 0x26,
+	0xbf, 0xb9, 0x4d, 0xe5, 0x3b, 0xfd, 0x7d, 0xcf, 0x96, 0xc0, 0x9a, 0x2f, 0x41, 0xcd, 0xf0, 0x25,
+	0x88, 0x79, 0x49, 0xd4, 0x93, 0x5e, 0x12, 0x57, 0xa0, 0xca, 0x9f, 0xa4, 0x19, 0xfa, 0x8c, 0x84,
+	0x30, 0x9d, 0xdf, 0x57, 0x44, 0x1e, 0x8a, 0xe0, 0x17, 0xa1, 0x2e, 0x41, 0x7a, 0x2c, 0x08, 0x9c,
+	0x03, 0xba, 0x09, 0x51, 0xb6, 0x6b, 0x22, 0xfb, 0x11, 0xe5, 0xf2, 0x0b, 0x1d, 0x12, 0x50, 0xff,
+	0x2a, 0xdd, 0x38, 0xb2, 0x44, 0x91, 0x36, 0x13, 0x31, 0x49, 0xd1, 0x38, 0xfd, 0x05, 0x89, 0xf5,
+	0x59, 0x2e, 0x48, 0xf0, 0x28, 0x53, 0x3e, 0x77, 0x92, 0x11, 0xd7, 0x3a, 0x36, 0xa6, 0x88, 0x32,
+	0x45, 0xf0, 0xe8, 0x58, 0xa1, 0xdd, 0xaf, 0x38, 0x37, 0xf5, 0xfd, 0x8a, 0x75, 0x28, 0x3d, 0x75,
+	0xc2, 0xf6, 0x21, 0x67, 0xc4, 0xf3, 0x24, 0x60, 0x30, 0xbd, 0xd3, 0x69, 0xfe, 0x7e, 0x06, 0x56,
+	0x4c, 0x46, 0x1f, 0x75, 0x93, 0x3c, 0xfd, 0x66, 0x7b, 0x76, 0xc4, 0xcd, 0xf6, 0x59, 0xef, 0x92,
+	0x17, 0x46, 0xde, 0x25, 0x9f, 0xe9, 0x62, 0xe5, 0x8f, 0xb3, 0x50, 0x8f, 0xf8, 0x83, 0x24, 0xc6,
+	0xcc, 0x82, 0x6b, 0x5c, 0xec, 0x13, 0x15, 0x1e, 0x2a, 0x9f, 0x1e, 0x1e, 0xaa, 0x60, 0x84, 0x87,
+	0x7a, 0x11, 0xea, 0xb1, 0x98, 0x1e, 0xe2, 0xe2, 0x47, 0xcd, 0x0c, 0xd6, 0xc1, 0xd1, 0x92, 0x45,
+	0x86, 0x76, 0x67, 0x94, 0x38, 0xcb, 0xa5, 0xfa, 0xdf, 0xcc, 0xc0, 0xb5, 0xd8, 0x40, 0xd8, 0xc6,
+	0x27, 0x6d, 0xd6, 0x65, 0x4e, 0x60, 0xca, 0xd3, 0xcc, 0x48, 0x53, 0x83, 0x19, 0x1c, 0xe6, 0x43,
+	0xa8, 0xfa, 0x54, 0x9d, 0x68, 0x78, 0x72, 0x60, 0x90, 0x8a, 0x80, 0xc7, 0x60, 0x2f, 0xbf, 0x92,
+	0x83, 0x2a, 0x39, 0x3c, 0xd1, 0x3b, 0x35, 0x51, 0xa4, 0x28, 0x7c, 0x1d, 0x45, 0x3d, 0x4c, 0x4d,
+	0xe6, 0x1c, 0x97, 0x9e, 0x0d, 0x9a, 0x26, 0x72, 0xda, 0x4b, 0x29, 0xce, 0x4b, 0xb9, 0xc4, 0x8d,
+	0x57, 0xe4, 0x23, 0x7a, 0xbb, 0x09, 0xdf, 0xa5, 0xc2, 0xed, 0xa1, 0x78, 0x20, 0x43, 0xe4, 0xe1,
+	0xfe, 0xf0, 0x2a, 0xcc, 0x2b, 0x3a, 0x41, 0x18, 0xa2, 0xd1, 0xaa, 0xcc, 0x44, 0x20, 0x75, 0x0b,
+	0xa4, 0x18, 0xbb, 0x52, 0xa1, 0x77, 0x50, 0x37, 0x20, 0x5d, 0x00, 0xa0, 0xc5, 0x1e, 0x1d, 0x96,
+	0xc8, 0x73, 0xac, 0x8c, 0x39, 0x78, 0x13, 0x97, 0x47, 0x0b, 0x92, 0xca, 0x82, 0xf6, 0xa0, 0x65,
+	0x55, 0x66, 0xe2, 0x47, 0xf9, 0x70, 0xe0, 0x78, 0xc9, 0xe0, 0x40, 0x62, 0x17, 0x3e, 0x8f, 0xb9,
+	0xf7, 0x44, 0xa6, 0x78, 0x7b, 0xab, 0x27, 0x1e, 0xfa, 0x01, 0xf5, 0xf6, 0x56, 0x8f, 0x9e, 0xfa,
+	0xf9, 0x05, 0x58, 0x88, 0x37, 0x32, 0x35, 0x8c, 0x2f, 0x7f, 0x97, 0x13, 0xe7, 0x45, 0x68, 0x78,
+	0x98, 0xe0, 0x74, 0xa3, 0xbe, 0x2e, 0x98, 0x44, 0xa6, 0x9b, 0xf7, 0xa1, 0xfe, 0xd0, 0x51, 0x77,
+	0x6e, 0x11, 0xf1, 0x04, 0x32, 0x4b, 0x0b, 0x3c, 0xd5, 0xfc, 0xcb, 0x2c, 0x54, 0xd1, 0x4e, 0xe8,
+	0xfe, 0x88, 0x75, 0xf8, 0x6b, 0x5f, 0x35, 0xc8, 0x32, 0x69, 0x2a, 0xcb, 0x32, 0xbc, 0x4a, 0xe4,
+	0x0f, 0x45, 0xa5, 0xac, 0x3f, 0xc4, 0xf2, 0x40, 0xb4, 0x26, 0x4b, 0x22, 0x4c, 0x3d, 0xab, 0x94,
+	0xed, 0xa0, 0x24, 0xf8, 0x91, 0x14, 0x35, 0xd9, 0x1f, 0x1d, 0xf2, 0xf4, 0xbe, 0x2f, 0xb4, 0x88,
+	0xec, 0x3e, 0xbe, 0xb1, 0xe7, 0xf8, 0x62, 0x4e, 0xb2, 0x0e, 0xa6, 0x07, 0xf2, 0x69, 0xa8, 0xec,
+	0x80, 0x54, 0x20, 0x39, 0xd6, 0x59, 0x17, 0xd3, 0x83, 0xae, 0x18, 0xd8, 0xec, 0x80, 0xda, 0xd7,
+	0x15, 0x56, 0x8b, 0x2c, 0xc3, 0xf4, 0x33, 0x4f, 0xac, 0xba, 0xd9, 0x67, 0x1e, 0x4f, 0xff, 0xc0,
+	0x11, 0xbb, 0x82, 0xec, 0x0f, 0x1c, 0x9e, 0x3e, 0xea, 0x8a, 0x45, 0x33, 0x7b, 0x84, 0xf0, 0x87,
+	0xf2, 0xf1, 0x9b, 0xec, 0x21, 0xb6, 0x37, 0x3c, 0x14, 0x8b, 0x62, 0x36, 0xc4, 0xf6, 0xb6, 0x03,
+	0xb1, 0xfc, 0x65, 0xdb, 0xd8, 0xbf, 0xa7, 0x07, 0x62, 0x85, 0xcb, 0x3e, 0x45, 0xe5, 0x7c, 0xdf,
+	0x15, 0x77, 0x68, 0xb3, 0xfb, 0x2e, 0x4f, 0x07, 0x47, 0x22, 0x78, 0x48, 0x36, 0x38, 0xc2, 0xf1,
+	0x70, 0xc4, 0xe5, 0xba, 0x6c, 0x07, 0xbf, 0x1f, 0xfa, 0x8d, 0x55, 0x81, 0xdf, 0x6f, 0x1e, 0x40,
+	0x7d, 0xa7, 0xe7, 0x1c, 0xb0, 0x6d, 0xaf, 0xdb, 0x15, 0xa1, 0x2a, 0x5e, 0x83, 0xa2, 0xcb, 0xb3,
+	0xe8, 0x65, 0x39, 0xdd, 0xa9, 0x52, 0x9f, 0x19, 0x5b, 0x00, 0x59, 0xd7, 0xa1, 0x3e, 0x0c, 0x58,
+	0xcb, 0xeb, 0xb3, 0xd6, 0xbe, 0xe7, 0xb7, 0x9c, 0x6e, 0x57, 0x3c, 0x10, 0x56, 0x1d, 0x06, 0xec,
+	0x8b, 0x3e, 0x7b, 0xe0, 0xf9, 0x5b, 0xdd, 0x6e, 0xf3, 0x57, 0x33, 0x50, 0x15, 0x0a, 0xb2, 0x32,
+	0x84, 0x9e, 0xee, 0x39, 0xad, 0x94, 0x17, 0x23, 0x36, 0xd1, 0xbc, 0x70, 0xe4, 0xfa, 0xe1, 0x50,
+	0xbf, 0x9e, 0x47, 0xe6, 0x85, 0x45, 0x37, 0x78, 0x42, 0x25, 0xca, 0x30, 0xfb, 0xdf, 0x73, 0xb0,
+	0x2a, 0x3c, 0x44, 0x63, 0x45, 0x9c, 0x1d, 0xba, 0xde, 0x81, 0x27, 0xd9, 0x81, 0xff, 0xb7, 0x3e,
+	0x54, 0x71, 0x74, 0x73, 0xc6, 0x6b, 0xec, 0xe9, 0x28, 0x36, 0x39, 0xc3, 0xd2, 0x66, 0x8e, 0xb8,
+	0xe9, 0x17, 0xa1, 0x2e, 0x1e, 0xe7, 0x53, 0xfa, 0x0c, 0x59, 0x5d, 0x6f, 0x4d, 0xc2, 0xf4, 0x98,
+	0xaa, 0x09, 0x7d, 0x87, 0x70, 0xd6, 0x02, 0x23, 0x93, 0x4f, 0x17, 0xb2, 0xa7, 0x0c, 0x1d, 0x65,
+	0xf8, 0xc0, 0xaa, 0xe1, 0xb6, 0x05, 0x10, 0x1a, 0x87, 0xdc, 0x7e, 0x6b, 0x30, 0xe4, 0x12, 0x2d,
+	0x60, 0x2d, 0xda, 0x04, 0x8a, 0x50, 0x6c, 0x3d, 0xb7, 0xbf, 0x2b, 0x0a, 0xe8, 0x99, 0x4a, 0x0e,
+	0xed, 0x1c, 0xc7, 0xa1, 0x8b, 0x02, 0xda, 0x39, 0x36, 0xa1, 0x5f, 0x80, 0x7a, 0xc0, 0xba, 0x5d,
+	0xba, 0xc8, 0xac, 0x4b, 0xbb, 0x79, 0x9e, 0x8d, 0xb7, 0x98, 0xb9, 0xc4, 0xdb, 0x78, 0x1b, 0xca,
+	0x6a, 0x8c, 0x66, 0xd9, 0x9a, 0x6e, 0x6c, 0xc1, 0x52, 0xca, 0x90, 0xcc, 0xb4, 0xbb, 0xfd, 0x7f,
+	0xb2, 0xb0, 0x8c, 0x32, 0x70, 0x1b, 0x17, 0xce, 0xbb, 0x27, 0xbb, 0xce, 0x49, 0xd7, 0xed, 0x3f,
+	0x43, 0xd1, 0x49, 0x7f, 0xa3, 0x4d, 0x5f, 0x59, 0xe4, 0xd0, 0x8e, 0x8d, 0x0c, 0x95, 0xea, 0x19,
+	0xfc, 0x39, 0x4c, 0xef, 0x0c, 0x78, 0x4d, 0x3a, 0x54, 0x50, 0x2f, 0x1a, 0xe2, 0x13, 0x67, 0x3c,
+	0x87, 0x8b, 0x30, 0x0a, 0x68, 0xa8, 0x5e, 0xcf, 0xcb, 0xcb, 0x80, 0x86, 0xf7, 0x45, 0xce, 0xcf,
+	0xfe, 0xe9, 0x3b, 0x54, 0x35, 0x9e, 0x31, 0x19, 0x0a, 0x96, 0x12, 0xcd, 0xff, 0xb7, 0x00, 0x0b,
+	0x8f, 0x87, 0x4f, 0xd5, 0x26, 0x64, 0xb7, 0xeb, 0xf4, 0x67, 0xd7, 0x9c, 0xcc, 0xd7, 0x1e, 0x73,
+	0xf1, 0xd7, 0x1e, 0xdf, 0x16, 0xac, 0x93, 0x8f, 0xbd, 0x46, 0x10, 0xff, 0x70, 0x82, 0x69, 0x3e,
+	0x33, 0xf7, 0x4c, 0x14, 0x8d, 0xf2, 0xe5, 0xd1, 0xf5, 0xef, 0x45, 0xc0, 0x84, 0x46, 0xaf, 0xae,
+	0x31, 0x49, 0x71, 0x1a, 0x26, 0xe1, 0x8f, 0xd9, 0xf7, 0x43, 0x6e, 0x12, 0xe8, 0xb6, 0x86, 0x7d,
+	0x57, 0x46, 0x3b, 0xad, 0xca, 0xcc, 0x2f, 0xfb, 0x6e, 0x48, 0x2f, 0x18, 0x09, 0xa0, 0xb6, 0xba,
+	0xd6, 0x59, 0xb0, 0x55, 0x55, 0x15, 0x67, 0x32, 0xf4, 0x5d, 0xd4, 0xfd, 0x4f, 0xe4, 0x1e, 0xb1,
+	0x8c, 0x39, 0xf8, 0xbe, 0xd5, 0xb8, 0x37, 0x5f, 0xbe, 0x9e, 0x47, 0x01, 0x4e, 0xcf, 0x9e, 0xdf,
+	0x80, 0x85, 0xf8, 0x34, 0xcc, 0xc4, 0x9b, 0xbf, 0x5d, 0x82, 0xaa, 0x3e, 0xb1, 0x09, 0x6a, 0x5c,
+	0x83, 0xb9, 0x41, 0xd7, 0xd1, 0xb4, 0xc1, 0x22, 0x4f, 0x4e, 0x13, 0x25, 0xc8, 0x24, 0xd3, 0x7c,
+	0x9c, 0x4c, 0x2f, 0x41, 0xa5, 0x2d, 0x5e, 0x84, 0xd6, 0x4c, 0xa5, 0x6d, 0xf5, 0x12, 0xb7, 0xf2,
+	0x6e, 0x2f, 0x4e, 0xf0, 0x6e, 0x7f, 0x19, 0x16, 0xcd, 0x4b, 0x00, 0x1c, 0x1d, 0x51, 0x4f, 0xdd,
+	0x70, 0xf5, 0xdf, 0xe9, 0x70, 0xe3, 0x61, 0xe0, 0x70, 0x33, 0x4f, 0xdb, 0xf1, 0x11, 0x4e, 0x84,
+	0x40, 0xc1, 0xcc, 0x6d, 0xc7, 0xef, 0x90, 0xbd, 0x69, 0x8a, 0x57, 0x05, 0xc7, 0xc5, 0xd3, 0x8d,
+	0xd6, 0xd9, 0x4a, 0xfc, 0xf1, 0x91, 0xd4, 0xeb, 0x00, 0x1f, 0x40, 0x95, 0xe8, 0x95, 0xf5, 0xa7,
+	0x7c, 0x91, 0x8c, 0xe8, 0xfb, 0x7e, 0x9f, 0x93, 0xdd, 0xb7, 0x60, 0x8d, 0xbe, 0xac, 0x1e, 0x82,
+	0xc3, 0x78, 0x6e, 0xd3, 0x05, 0xd5, 0x5f, 0x16, 0x55, 0xe9, 0xad, 0x38, 0x8c, 0xe9, 0xb6, 0x15,
+	0x5a, 0x8f, 0x60, 0x25, 0x86, 0x52, 0xb4, 0x6c, 0xb2, 0x49, 0xd4, 0x32, 0x10, 0x52, 0x0b, 0xef,
+	0x42, 0xbd, 0xcf, 0x8e, 0xc3, 0x96, 0xba, 0x84, 0x30, 0x4d, 0x78, 0xb8, 0x79, 0x5e, 0x45, 0xde,
+	0x48, 0x08, 0xa5, 0x85, 0x02, 0x79, 0x2b, 0x64, 0xbd, 0x41, 0x48, 0x2a, 0x5a, 0x81, 0x2c, 0x14,
+	0x9c, 0x89, 0x28, 0x17, 0x6f, 0x24, 0xf4, 0xdd, 0x90, 0x0f, 0xa7, 0xb2, 0xf7, 0x91, 0xf2, 0x56,
+	0x13, 0xf9, 0x5f, 0x08, 0xb3, 0x5f, 0x13, 0xe6, 0xbb, 0x4e, 0x10, 0x46, 0x60, 0xa4, 0xd3, 0x55,
+	0x78, 0xa6, 0x84, 0xe1, 0xf6, 0x2a, 0x67, 0x18, 0x4c, 0xfb, 0x24, 0x79, 0x89, 0x80, 0xc9, 0x24,
+	0xdf, 0xe6, 0xfb, 0xc1, 0xee, 0xb4, 0x4f, 0x92, 0x83, 0x04, 0xdf, 0xc2, 0xb8, 0x23, 0x94, 0x92,
+	0x11, 0xfc, 0x48, 0x7b, 0xac, 0x52, 0xa6, 0x9d, 0x16, 0x36, 0x6c, 0xed, 0xf4, 0x92, 0xaa, 0x31,
+	0x83, 0xa4, 0xe2, 0xcf, 0xb5, 0x36, 0x74, 0x81, 0xa1, 0xbf, 0xda, 0xca, 0xe5, 0x0c, 0x77, 0x84,
+	0x90, 0x01, 0x0f, 0x28, 0x61, 0xbd, 0x0b, 0xd5, 0x40, 0xab, 0x21, 0x0e, 0x7d, 0x56, 0x52, 0x17,
+	0x16, 0xdb, 0x00, 0x1d, 0x17, 0x74, 0xce, 0xec, 0x7e, 0x7e, 0x96, 0x6d, 0xfb, 0x1f, 0xe4, 0x60,
+	0xd1, 0x66, 0x6d, 0xaf, 0xdf, 0x76, 0xbb, 0x2e, 0xb6, 0xdc, 0x1e, 0x26, 0x25, 0xdf, 0xed, 0xc8,
+	0xe1, 0x2f, 0xc0, 0xa7, 0x03, 0x5a, 0x87, 0x4e, 0xbf, 0xd3, 0x65, 0xbe, 0x10, 0x84, 0xcb, 0xa2,
+	0x94, 0xde, 0x15, 0x78, 0x48, 0x65, 0x66, 0x70, 0x87, 0x5c, 0x2c, 0xb8, 0xc3, 0xcf, 0x37, 0xae,
+	0xc2, 0x25, 0xa8, 0x74, 0xdd, 0xbe, 0x72, 0x43, 0xa1, 0x90, 0xbe, 0x80, 0x59, 0xe4, 0x68, 0xd2,
+	0x80, 0xb9, 0x1e, 0xb7, 0x50, 0x89, 0xf7, 0x57, 0x0b, 0xb6, 0x4c, 0xf2, 0x30, 0x68, 0x3d, 0x37,
+	0x90, 0x85, 0xb4, 0xc8, 0x6a, 0x39, 0xbc, 0xa6, 0xe7, 0x0f, 0x0e, 0x1d, 0x65, 0x82, 0x95, 0x49,
+	0xc4, 0xe9, 0x06, 0x81, 0xdb, 0x3f, 0x68, 0x80, 0xc0, 0x49, 0x49, 0x8e, 0x73, 0xd8, 0x97, 0x71,
+	0xc9, 0xc4, 0xf3, 0xa9, 0x5a, 0xce, 0x59, 0xc2, 0x20, 0xfc, 0x56, 0x1e, 0x2c, 0x73, 0x42, 0x3f,
+	0x73, 0xfb, 0x2c, 0xed, 0x18, 0xc5, 0x1f, 0x6a, 0x4b, 0x59, 0xc1, 0x1f, 0x8a, 0x95, 0x8c, 0x0f,
+	0x8a, 0xb4, 0xdf, 0xe6, 0xa2, 0x71, 0x12, 0x26, 0x5b, 0x79, 0xf1, 0x29, 0xaf, 0x5d, 0x7c, 0xba,
+	0x04, 0x15, 0x76, 0x1c, 0x32, 0xbf, 0xef, 0x74, 0xb5, 0xe5, 0x4b, 0x66, 0x9d, 0xf2, 0x05, 0x01,
+	0x9d, 0xdc, 0x4b, 0x26, 0xb9, 0x9f, 0x83, 0xb2, 0xf0, 0xdd, 0x51, 0x0b, 0x52, 0x89, 0x32, 0x76,
+	0x3a, 0x69, 0x61, 0x13, 0x21, 0x2d, 0x6c, 0x22, 0x0f, 0x20, 0xa4, 0x00, 0x55, 0x2b, 0x68, 0x2b,
+	0xbd, 0x20, 0x0b, 0xf4, 0x37, 0xb4, 0x52, 0x97, 0x2b, 0xd2, 0xae, 0xd5, 0x4c, 0xce, 0x4b, 0xed,
+	0xda, 0x96, 0x33, 0x69, 0x06, 0xd1, 0xab, 0x25, 0x82, 0xe8, 0xc5, 0xc2, 0xd9, 0xd5, 0x67, 0x09,
+	0x67, 0x77, 0x86, 0x77, 0x69, 0xf9, 0x93, 0x8f, 0xd6, 0xb6, 0x3a, 0x40, 0xbe, 0x7f, 0xe4, 0x76,
+	0x58, 0x9a, 0xe9, 0x72, 0x6c, 0x7c, 0x96, 0x75, 0xc0, 0xff, 0xda, 0xbe, 0x63, 0x8e, 0xa7, 0xc5,
+	0x03, 0xf1, 0xe4, 0x79, 0x14, 0x46, 0x8f, 0x28, 0x62, 0xf2, 0x2c, 0x4f, 0x3e, 0xfe, 0xfd, 0x02,
+	0x40, 0xd4, 0xe6, 0x59, 0x02, 0xd8, 0x29, 0x1b, 0x18, 0x5e, 0x09, 0xcd, 0x69, 0x36, 0xb0, 0x2f,
+	0x87, 0xc9, 0x6d, 0x46, 0x7e, 0x82, 0xfe, 0x56, 0x48, 0xd1, 0xdf, 0x74, 0x06, 0x28, 0x26, 0x18,
+	0x00, 0x5f, 0xcd, 0xe6, 0x2b, 0x15, 0x59, 0xc6, 0xe6, 0x24, 0x19, 0xf0, 0x2c, 0x3c, 0x74, 0x18,
+	0xf3, 0x5e, 0x86, 0x11, 0x86, 0x25, 0x8d, 0x73, 0x52, 0x54, 0xae, 0xd4, 0x60, 0x57, 0x0f, 0x60,
+	0x91, 0x89, 0x79, 0x6e, 0x75, 0x86, 0xc2, 0x92, 0x3a, 0x59, 0xb6, 0xd4, 0x65, 0xa5, 0x7b, 0x43,
+	0x26, 0xe2, 0x3c, 0x95, 0x65, 0x16, 0x7f, 0xa5, 0x39, 0x67, 0x5c, 0x32, 0x4d, 0xd2, 0x94, 0x1d,
+	0x41, 0xf3, 0xcb, 0x9e, 0x3e, 0x1b, 0xf8, 0x2c, 0x60, 0xfd, 0x90, 0x2e, 0xe0, 0x4e, 0xa3, 0x98,
+	0xd5, 0x8d, 0x3a, 0x5b, 0xfc, 0x0d, 0xc8, 0x72, 0x14, 0x5d, 0x77, 0x8a, 0xc8, 0x58, 0x6d, 0x19,
+	0x56, 0xf7, 0x6b, 0x79, 0xc8, 0xb9, 0xf9, 0x27, 0x39, 0x28, 0x3f, 0xf0, 0x9d, 0x61, 0xc7, 0x1e,
+	0x76, 0xd9, 0x57, 0xbe, 0xc7, 0xb5, 0xd4, 0x1e, 0x37, 0xb2, 0xa0, 0x4a, 0x29, 0x5d, 0xd0, 0xa4,
+	0xf4, 0x32, 0x8f, 0xc5, 0xc3, 0xba, 0x92, 0x3c, 0x29, 0xc1, 0x19, 0x9c, 0x9b, 0x58, 0xa2, 0x57,
+	0x35, 0x0a, 0x76, 0xa9, 0xe7, 0x1c, 0x6f, 0x4b, 0x25, 0x9d, 0x74, 0x5e, 0xa4, 0xca, 0x9c, 0x2d,
+	0x52, 0xa7, 0xa5, 0x4a, 0xdc, 0x6a, 0x51, 0x2c, 0xa0, 0xb2, 0x2d, 0x52, 0xbc, 0x59, 0x41, 0xdb,
+	0xf3, 0x99, 0x78, 0x19, 0x9c, 0x12, 0x1c, 0x53, 0x87, 0xb5, 0x5d, 0x0c, 0xaa, 0x49, 0xe6, 0x4b,
+	0x95, 0x36, 0xf7, 0xac, 0xb5, 0xb1, 0x7b, 0xd6, 0xfa, 0xe9, 0x67, 0x78, 0x61, 0x96, 0x19, 0xfe,
+	0xbd, 0x02, 0x94, 0x77, 0xa5, 0x9d, 0xfb, 0x67, 0x31, 0xc3, 0x89, 0xe7, 0xfa, 0xd3, 0x66, 0x58,
+	0x7b, 0xdd, 0xac, 0x68, 0xbe, 0x6e, 0xf6, 0x2e, 0xcc, 0xd1, 0x14, 0xc9, 0xe0, 0x51, 0x97, 0x74,
+	0x0b, 0x04, 0x35, 0x7b, 0x53, 0x44, 0x72, 0x24, 0xfb, 0x85, 0x84, 0xe7, 0xa3, 0x72, 0xe4, 0x74,
+	0xdd, 0x4e, 0xf4, 0xea, 0xda, 0x84, 0x51, 0x41, 0x68, 0xd4, 0xd4, 0xde, 0x82, 0x12, 0x55, 0x0d,
+	0xbd, 0x29, 0x5c, 0x69, 0xe6, 0x10, 0x76, 0xcf, 0xe3, 0x72, 0x9c, 0x93, 0xe4, 0x30, 0x60, 0x81,
+	0xd2, 0x9b, 0x9c, 0xe3, 0x2f, 0x03, 0x16, 0x70, 0xa7, 0x40, 0x59, 0xc4, 0x77, 0x63, 0x2d, 0xb9,
+	0x45, 0x16, 0x2a, 0x94, 0x25, 0xe0, 0x76, 0x99, 0xbf, 0x2d, 0x4a, 0xd0, 0x19, 0x42, 0xb9, 0x4b,
+	0xf0, 0xe5, 0x3b, 0x87, 0xce, 0x10, 0xd2, 0x59, 0x22, 0xc0, 0x83, 0x99, 0xc8, 0x5b, 0x82, 0x24,
+	0x1a, 0x3f, 0x98, 0x51, 0xee, 0x12, 0xc1, 0x78, 0x8a, 0xb3, 0x70, 0x6f, 0x4e, 0xd7, 0xc8, 0x0b,
+	0xb8, 0x0f, 0x0f, 0xbe, 0x1e, 0x39, 0xb3, 0xf1, 0x1e, 0x54, 0xf5, 0x39, 0x9c, 0x64, 0xfc, 0xc8,
+	0xe8, 0xc6, 0x8f, 0x9f, 0x64, 0x60, 0x09, 0x37, 0x7a, 0x28, 0xa8, 0xb6, 0x79, 0x30, 0x35, 0x94,
+	0x56, 0x6b, 0x30, 0xe7, 0x0f, 0xbb, 0x2c, 0x32, 0x4a, 0x16, 0x79, 0x72, 0xc4, 0xeb, 0x6e, 0x69,
+	0xd1, 0xa1, 0x15, 0x7f, 0xe7, 0x47, 0xf1, 0x77, 0xc1, 0xe4, 0xef, 0xe6, 0xef, 0x64, 0xa0, 0x1e,
+	0x6b, 0x4a, 0x84, 0x25, 0x33, 0x0a, 0x4b, 0x36, 0x26, 0x25, 0x6e, 0x42, 0x81, 0xb7, 0x54, 0xba,
+	0x18, 0xc7, 0xae, 0xf7, 0x9b, 0xbd, 0xb4, 0x09, 0x14, 0xa7, 0x8d, 0xe7, 0x4d, 0xbf, 0x8f, 0x22,
+	0xe8, 0xad, 0xb0, 0xf9, 0x47, 0x19, 0x58, 0x44, 0xa4, 0xc6, 0x26, 0x50, 0x57, 0x49, 0x32, 0x09,
+	0x95, 0x44, 0xe3, 0xf9, 0x6c, 0x9c, 0xe7, 0x17, 0x20, 0x17, 0xa9, 0x55, 0xfc, 0xaf, 0xf5, 0x2e,
+	0x54, 0xf6, 0xf9, 0x07, 0x5a, 0xf8, 0xd1, 0x46, 0x3e, 0xe6, 0xa4, 0x11, 0xef, 0x16, 0xec, 0xab,
+	0xff, 0x67, 0xd1, 0xb9, 0x7e, 0x3f, 0x03, 0xab, 0xdf, 0x66, 0x4f, 0x0f, 0x3d, 0xef, 0xd9, 0x3d,
+	0xd6, 0x75, 0x8f, 0x98, 0x7f, 0x22, 0xcc, 0x0b, 0x9c, 0xb3, 0x0e, 0xc3, 0x70, 0xd0, 0x12, 0xca,
+	0x06, 0xcd, 0x0c, 0xf0, 0xac, 0xc7, 0x98, 0x43, 0x2f, 0xa9, 0x04, 0x03, 0xaf, 0x1f, 0xb0, 0xd6,
+	0x53, 0xaf, 0x23, 0x0f, 0x54, 0xaa, 0x32, 0xf3, 0xae, 0xd7, 0x41, 0x92, 0x64, 0xbe, 0xef, 0xf9,
+	0xa2, 0xab, 0x94, 0x10, 0x1c, 0xd7, 0x73, 0xfa, 0x43, 0xa7, 0x2b, 0x6c, 0xd6, 0x25, 0x37, 0x78,
+	0x84, 0xe9, 0xb3, 0x74, 0xe7, 0xd7, 0xf2, 0x50, 0x8f, 0x75, 0xe7, 0x2b, 0x17, 0xd7, 0x63, 0xbc,
+	0xab, 0x94, 0x51, 0xa0, 0xa0, 0x1b, 0x05, 0xc4, 0x5c, 0x17, 0xa3, 0xb9, 0xe6, 0x92, 0xdc, 0x39,
+	0xe9, 0x7a, 0x8e, 0xb4, 0xde, 0xc9, 0xa4, 0xa6, 0xdc, 0x95, 0x62, 0xaf, 0x4d, 0x94, 0x94, 0x31,
+	0xa8, 0x1c, 0x13, 0xf1, 0xe9, 0xf3, 0x67, 0xab, 0x0a, 0xca, 0x28, 0x25, 0x32, 0xa6, 0x0b, 0xe7,
+	0x8d, 0x46, 0x29, 0x81, 0x8b, 0xdc, 0x4c, 0x3a, 0xf4, 0x81, 0x69, 0xcd, 0xc5, 0x15, 0x05, 0x9f,
+	0xd0, 0xcc, 0xaa, 0xa7, 0x97, 0x98, 0xf3, 0xb3, 0xac, 0xdb, 0xff, 0x36, 0x0b, 0x15, 0x72, 0x46,
+	0xb9, 0xcb, 0x77, 0xf3, 0x69, 0xef, 0xef, 0x89, 0xb0, 0x95, 0x59, 0x23, 0x6c, 0xe5, 0x38, 0x87,
+	0x8d, 0x4d, 0x58, 0x4a, 0xba, 0xad, 0xd0, 0xf1, 0x58, 0xd9, 0x5e, 0x8c, 0xfb, 0xad, 0x04, 0xf8,
+	0x12, 0x8f, 0x48, 0x9a, 0x41, 0x78, 0x6b, 0x2a, 0x7b, 0x5b, 0x86, 0xac, 0x36, 0xde, 0x0e, 0x2a,
+	0x6a, 0xaf, 0x57, 0x89, 0x3d, 0xad, 0xb1, 0x5d, 0x9b, 0x1b, 0x1d, 0x4e, 0xb3, 0x34, 0x3a, 0x9c,
+	0x66, 0xd9, 0x0c, 0xa7, 0x79, 0x86, 0x28, 0xef, 0x77, 0x3f, 0xfa, 0xee, 0x87, 0x07, 0x6e, 0x78,
+	0x38, 0x7c, 0xba, 0xd9, 0xf6, 0x7a, 0xaf, 0xcb, 0xab, 0x33, 0xea, 0xcf, 0x6b, 0x82, 0x3e, 0x5f,
+	0x43, 0xf7, 0x10, 0xff, 0xf5, 0xc1, 0xb3, 0x83, 0xd7, 0x11, 0xe1, 0xeb, 0xa2, 0xe0, 0x69, 0x11,
+	0x93, 0xb7, 0xfe, 0xc7, 0x00, 0xc1, 0x39, 0x03, 0xd8, 0xbd, 0xe8, 0x00, 0x00,
 }
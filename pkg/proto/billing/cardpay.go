@@ -8,6 +8,7 @@ var (
 		pkg.CardPayPaymentResponseStatusDeclined:   true,
 		pkg.CardPayPaymentResponseStatusCancelled:  true,
 		pkg.CardPayPaymentResponseStatusAuthorized: true,
+		pkg.CardPayPaymentResponseStatusVoided:     true,
 	}
 )
 
@@ -0,0 +1,27 @@
+package billing
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"time"
+)
+
+// IsClosed return true if chargeback dispute finished with won or lost result
+func (m *Chargeback) IsClosed() bool {
+	return m.Status == pkg.ChargebackStatusWon || m.Status == pkg.ChargebackStatusLost
+}
+
+// IsEvidenceDueDateExpired return true if merchant can't upload evidences for chargeback anymore
+func (m *Chargeback) IsEvidenceDueDateExpired() bool {
+	if m.EvidenceDueDate == nil {
+		return false
+	}
+
+	t, err := ptypes.Timestamp(m.EvidenceDueDate)
+
+	if err != nil {
+		return false
+	}
+
+	return time.Now().After(t)
+}
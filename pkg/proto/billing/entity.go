@@ -30,6 +30,8 @@ var (
 		constant.OrderStatusRefund:                      constant.OrderPublicStatusRefunded,
 		constant.OrderStatusChargeback:                  constant.OrderPublicStatusChargeback,
 		constant.OrderStatusItemReplaced:                constant.OrderPublicStatusProcessed,
+		pkg.OrderStatusPaymentSystemAuthorized:          pkg.OrderPublicStatusAuthorized,
+		pkg.OrderStatusPaymentSystemVoided:              constant.OrderPublicStatusCanceled,
 	}
 )
 
@@ -53,6 +55,59 @@ func (m *Merchant) GetPayoutCurrency() string {
 	return m.Banking.Currency
 }
 
+// GetAllPayoutCurrencies returns all payout currencies of merchant, primary payout currency always is the first
+func (m *Merchant) GetAllPayoutCurrencies() []string {
+	var currencies []string
+	exists := make(map[string]bool)
+
+	for _, v := range append([]string{m.GetPayoutCurrency()}, m.PayoutCurrencies...) {
+		if v == "" || exists[v] {
+			continue
+		}
+
+		exists[v] = true
+		currencies = append(currencies, v)
+	}
+
+	return currencies
+}
+
+func (m *Merchant) HasPayoutCurrency(currency string) bool {
+	return containsString(m.GetAllPayoutCurrencies(), currency)
+}
+
+// GetPayoutCurrencyForOrder returns payout currency to which net revenue of order from country and in currency
+// must be routed. First matched rule is used, if no rules matched then primary payout currency will be returned
+func (m *Merchant) GetPayoutCurrencyForOrder(country, currency string) string {
+	for _, rule := range m.PayoutCurrencyRules {
+		if !m.HasPayoutCurrency(rule.Currency) {
+			continue
+		}
+
+		if len(rule.Countries) > 0 && !containsString(rule.Countries, country) {
+			continue
+		}
+
+		if len(rule.OrderCurrencies) > 0 && !containsString(rule.OrderCurrencies, currency) {
+			continue
+		}
+
+		return rule.Currency
+	}
+
+	return m.GetPayoutCurrency()
+}
+
+func containsString(list []string, val string) bool {
+	for _, v := range list {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (m *Merchant) NeedMarkESignAgreementAsSigned() bool {
 	return m.HasMerchantSignature == true && m.HasPspSignature == true &&
 		m.Status != pkg.MerchantStatusAgreementSigned
@@ -162,6 +217,39 @@ func (m *RoyaltyReport) ChangesAvailable(newStatus string) bool {
 	return true
 }
 
+// GetCurrentVersion returns version of royalty report, reports created before versioning are treated as first version
+func (m *RoyaltyReport) GetCurrentVersion() int32 {
+	if m.Version < 1 {
+		return 1
+	}
+
+	return m.Version
+}
+
+func (m *RoyaltyReport) GetSummaryItem(product, region string) *RoyaltyReportProductSummaryItem {
+	if m.Summary == nil {
+		return nil
+	}
+
+	for _, item := range m.Summary.ProductsItems {
+		if item.Product == product && item.Region == region {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func (m *RoyaltyReportDispute) GetItem(id string) *RoyaltyReportDisputeItem {
+	for _, item := range m.Items {
+		if item.Id == id {
+			return item
+		}
+	}
+
+	return nil
+}
+
 func (m *Merchant) IsAgreementSigningStarted() bool {
 	return m.AgreementSignatureData != nil && (!m.HasPspSignature || !m.HasMerchantSignature)
 }
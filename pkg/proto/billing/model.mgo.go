@@ -4,8 +4,8 @@ import (
 	"errors"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"time"
 )
 
@@ -140,6 +140,8 @@ type MgoMerchant struct {
 	AgreementNumber                               string                               `bson:"agreement_number"`
 	MinimalPayoutLimit                            float32                              `bson:"minimal_payout_limit"`
 	ManualPayoutsEnabled                          bool                                 `bson:"manual_payouts_enabled"`
+	PayoutCurrencies                              []string                             `bson:"payout_currencies"`
+	PayoutCurrencyRules                           []*MerchantPayoutCurrencyRule        `bson:"payout_currency_rules"`
 }
 
 type MgoCommission struct {
@@ -209,65 +211,93 @@ type MgoOrderNotificationRefund struct {
 }
 
 type MgoOrder struct {
-	Id                         bson.ObjectId               `bson:"_id"`
-	Uuid                       string                      `bson:"uuid"`
-	Transaction                string                      `bson:"pm_order_id"`
-	Object                     string                      `bson:"object"`
-	Status                     string                      `bson:"status"`
-	PrivateStatus              int32                       `bson:"private_status"`
-	Description                string                      `bson:"description"`
-	CreatedAt                  time.Time                   `bson:"created_at"`
-	UpdatedAt                  time.Time                   `bson:"updated_at"`
-	CanceledAt                 time.Time                   `bson:"canceled_at"`
-	Canceled                   bool                        `bson:"canceled"`
-	CancellationReason         string                      `bson:"cancellation_reason"`
-	Refunded                   bool                        `bson:"refunded"`
-	RefundedAt                 time.Time                   `bson:"refunded_at"`
-	ReceiptEmail               string                      `bson:"receipt_email"`
-	ReceiptPhone               string                      `bson:"receipt_phone"`
-	ReceiptNumber              string                      `bson:"receipt_number"`
-	ReceiptUrl                 string                      `bson:"receipt_url"`
-	AgreementVersion           string                      `bson:"agreement_version"`
-	AgreementAccepted          bool                        `bson:"agreement_accepted"`
-	NotifySale                 bool                        `bson:"notify_sale"`
-	NotifySaleEmail            string                      `bson:"notify_sale_email"`
-	Issuer                     *OrderIssuer                `bson:"issuer"`
-	TotalPaymentAmount         float64                     `bson:"total_payment_amount"`
-	Currency                   string                      `bson:"currency"`
-	User                       *OrderUser                  `bson:"user"`
-	BillingAddress             *OrderBillingAddress        `bson:"billing_address"`
-	Tax                        *OrderTax                   `bson:"tax"`
-	PaymentMethod              *MgoOrderPaymentMethod      `bson:"payment_method"`
-	Items                      []*MgoOrderItem             `bson:"items"`
-	Refund                     *MgoOrderNotificationRefund `bson:"refund"`
-	Metadata                   map[string]string           `bson:"metadata"`
-	PrivateMetadata            map[string]string           `bson:"private_metadata"`
-	Project                    *MgoOrderProject            `bson:"project"`
-	ProjectOrderId             string                      `bson:"project_order_id"`
-	ProjectAccount             string                      `bson:"project_account"`
-	ProjectLastRequestedAt     time.Time                   `bson:"project_last_requested_at"`
-	ProjectParams              map[string]string           `bson:"project_params"`
-	PaymentMethodOrderClosedAt time.Time                   `bson:"pm_order_close_date"`
-	IsJsonRequest              bool                        `bson:"created_by_json"`
-	OrderAmount                float64                     `bson:"private_amount"`
-	PaymentMethodPayerAccount  string                      `bson:"pm_account"`
-	PaymentMethodTxnParams     map[string]string           `bson:"pm_txn_params"`
-	PaymentRequisites          map[string]string           `bson:"payment_requisites"`
-	ExpireDateToFormInput      time.Time                   `bson:"expire_date_to_form_input"`
-	UserAddressDataRequired    bool                        `bson:"user_address_data_required"`
-	Products                   []string                    `bson:"products"`
-	IsNotificationsSent        map[string]bool             `bson:"is_notifications_sent"`
-	CountryRestriction         *CountryRestriction         `bson:"country_restriction"`
-	ParentId                   string                      `bson:"parent_id"`
-	ParentPaymentAt            time.Time                   `bson:"parent_payment_at"`
-	Type                       string                      `bson:"type"`
-	IsVatDeduction             bool                        `bson:"is_vat_deduction"`
-	CountryCode                string                      `bson:"country_code"`
-	PlatformId                 string                      `bson:"platform_id"`
-	ProductType                string                      `bson:"product_type"`
-	Keys                       []string                    `bson:"keys"`
-	IsKeyProductNotified       bool                        `bson:"is_key_product_notified"`
-	ReceiptId                  string                      `bson:"receipt_id"`
+	Id                         bson.ObjectId                  `bson:"_id"`
+	Uuid                       string                         `bson:"uuid"`
+	Transaction                string                         `bson:"pm_order_id"`
+	Object                     string                         `bson:"object"`
+	Status                     string                         `bson:"status"`
+	PrivateStatus              int32                          `bson:"private_status"`
+	Description                string                         `bson:"description"`
+	CreatedAt                  time.Time                      `bson:"created_at"`
+	UpdatedAt                  time.Time                      `bson:"updated_at"`
+	CanceledAt                 time.Time                      `bson:"canceled_at"`
+	Canceled                   bool                           `bson:"canceled"`
+	CancellationReason         string                         `bson:"cancellation_reason"`
+	Refunded                   bool                           `bson:"refunded"`
+	RefundedAt                 time.Time                      `bson:"refunded_at"`
+	ReceiptEmail               string                         `bson:"receipt_email"`
+	ReceiptPhone               string                         `bson:"receipt_phone"`
+	ReceiptNumber              string                         `bson:"receipt_number"`
+	ReceiptUrl                 string                         `bson:"receipt_url"`
+	AgreementVersion           string                         `bson:"agreement_version"`
+	AgreementAccepted          bool                           `bson:"agreement_accepted"`
+	NotifySale                 bool                           `bson:"notify_sale"`
+	NotifySaleEmail            string                         `bson:"notify_sale_email"`
+	Issuer                     *OrderIssuer                   `bson:"issuer"`
+	TotalPaymentAmount         float64                        `bson:"total_payment_amount"`
+	TotalPaymentAmountMinor    int64                          `bson:"total_payment_amount_minor"`
+	Currency                   string                         `bson:"currency"`
+	User                       *OrderUser                     `bson:"user"`
+	BillingAddress             *OrderBillingAddress           `bson:"billing_address"`
+	Tax                        *OrderTax                      `bson:"tax"`
+	TaxAmountMinor             int64                          `bson:"tax_amount_minor"`
+	PaymentMethod              *MgoOrderPaymentMethod         `bson:"payment_method"`
+	Items                      []*MgoOrderItem                `bson:"items"`
+	Refund                     *MgoOrderNotificationRefund    `bson:"refund"`
+	Metadata                   map[string]string              `bson:"metadata"`
+	PrivateMetadata            map[string]string              `bson:"private_metadata"`
+	Project                    *MgoOrderProject               `bson:"project"`
+	ProjectOrderId             string                         `bson:"project_order_id"`
+	ProjectAccount             string                         `bson:"project_account"`
+	ProjectLastRequestedAt     time.Time                      `bson:"project_last_requested_at"`
+	ProjectParams              map[string]string              `bson:"project_params"`
+	PaymentMethodOrderClosedAt time.Time                      `bson:"pm_order_close_date"`
+	IsJsonRequest              bool                           `bson:"created_by_json"`
+	OrderAmount                float64                        `bson:"private_amount"`
+	OrderAmountMinor           int64                          `bson:"private_amount_minor"`
+	PaymentMethodPayerAccount  string                         `bson:"pm_account"`
+	PaymentMethodTxnParams     map[string]string              `bson:"pm_txn_params"`
+	PaymentRequisites          map[string]string              `bson:"payment_requisites"`
+	ExpireDateToFormInput      time.Time                      `bson:"expire_date_to_form_input"`
+	UserAddressDataRequired    bool                           `bson:"user_address_data_required"`
+	Products                   []string                       `bson:"products"`
+	IsNotificationsSent        map[string]bool                `bson:"is_notifications_sent"`
+	CountryRestriction         *CountryRestriction            `bson:"country_restriction"`
+	ParentId                   string                         `bson:"parent_id"`
+	ParentPaymentAt            time.Time                      `bson:"parent_payment_at"`
+	Type                       string                         `bson:"type"`
+	IsVatDeduction             bool                           `bson:"is_vat_deduction"`
+	CountryCode                string                         `bson:"country_code"`
+	PlatformId                 string                         `bson:"platform_id"`
+	ProductType                string                         `bson:"product_type"`
+	Keys                       []string                       `bson:"keys"`
+	IsKeyProductNotified       bool                           `bson:"is_key_product_notified"`
+	ReceiptId                  string                         `bson:"receipt_id"`
+	VirtualCurrencyAmount      float64                        `bson:"virtual_currency_amount"`
+	PaymentRouteAttempts       []*MgoOrderPaymentRouteAttempt `bson:"payment_route_attempts"`
+	IsAuthorizationOnly        bool                           `bson:"is_authorization_only"`
+	AuthorizedAt               time.Time                      `bson:"authorized_at"`
+	FraudCheck                 *MgoOrderFraudCheck            `bson:"fraud_check"`
+	DiscountPercent            float64                        `bson:"discount_percent"`
+	PromoCode                  *OrderPromoCode                `bson:"promo_code"`
+	DiscountAmount             float64                        `bson:"discount_amount"`
+	DiscountAmountMinor        int64                          `bson:"discount_amount_minor"`
+}
+
+type MgoOrderFraudCheck struct {
+	Score     int32                  `bson:"score"`
+	Decision  string                 `bson:"decision"`
+	Rules     []*OrderFraudCheckRule `bson:"rules"`
+	CheckedAt time.Time              `bson:"checked_at"`
+}
+
+type MgoOrderPaymentRouteAttempt struct {
+	PaymentSystemId bson.ObjectId `bson:"payment_system_id"`
+	Handler         string        `bson:"handler"`
+	Priority        int32         `bson:"priority"`
+	Status          string        `bson:"status"`
+	Error           string        `bson:"error"`
+	CreatedAt       time.Time     `bson:"created_at"`
 }
 
 type MgoOrderItem struct {
@@ -285,6 +315,7 @@ type MgoOrderItem struct {
 	CreatedAt   time.Time         `bson:"created_at"`
 	UpdatedAt   time.Time         `bson:"updated_at"`
 	PlatformId  string            `bson:"platform_id"`
+	Discount    float64           `bson:"discount"`
 }
 
 type MgoPaymentSystem struct {
@@ -316,6 +347,19 @@ type MgoPaymentMethod struct {
 	Currencies         []string                 `bson:"currencies"`
 	Type               string                   `bson:"type"`
 	AccountRegexp      string                   `bson:"account_regexp"`
+	Routes             []*MgoPaymentMethodRoute `bson:"routes"`
+}
+
+type MgoPaymentMethodRoute struct {
+	PaymentSystemId    bson.ObjectId            `bson:"payment_system_id"`
+	Priority           int32                    `bson:"priority"`
+	Weight             int32                    `bson:"weight"`
+	Countries          []string                 `bson:"countries"`
+	Currencies         []string                 `bson:"currencies"`
+	MinAmount          float64                  `bson:"min_amount"`
+	MaxAmount          float64                  `bson:"max_amount"`
+	TestSettings       []*MgoPaymentMethodParam `bson:"test_settings"`
+	ProductionSettings []*MgoPaymentMethodParam `bson:"production_settings"`
 }
 
 type MgoPaymentMethodParam struct {
@@ -359,6 +403,186 @@ type MgoRefund struct {
 	Reason         string           `bson:"reason"`
 }
 
+type MgoPayoutBatch struct {
+	Id                bson.ObjectId `bson:"_id"`
+	Format            string        `bson:"format"`
+	Currency          string        `bson:"currency"`
+	PayoutDocumentIds []string      `bson:"payout_document_ids"`
+	DocumentsCount    int32         `bson:"documents_count"`
+	TotalAmount       float64       `bson:"total_amount"`
+	TotalAmountMinor  int64         `bson:"total_amount_minor"`
+	FileName          string        `bson:"file_name"`
+	Content           []byte        `bson:"content"`
+	Checksum          string        `bson:"checksum"`
+	CreatedAt         time.Time     `bson:"created_at"`
+}
+
+type MgoReconciliationRun struct {
+	Id                   bson.ObjectId `bson:"_id"`
+	PaymentSystemHandler string        `bson:"payment_system_handler"`
+	FileName             string        `bson:"file_name"`
+	DateFrom             time.Time     `bson:"date_from"`
+	DateTo               time.Time     `bson:"date_to"`
+	LinesTotal           int32         `bson:"lines_total"`
+	Matched              int32         `bson:"matched"`
+	Mismatched           int32         `bson:"mismatched"`
+	Orphans              int32         `bson:"orphans"`
+	Missing              int32         `bson:"missing"`
+	Unresolved           int32         `bson:"unresolved"`
+	CreatedAt            time.Time     `bson:"created_at"`
+}
+
+type MgoReconciliationLine struct {
+	Id               bson.ObjectId `bson:"_id"`
+	RunId            bson.ObjectId `bson:"run_id"`
+	LineNumber       int32         `bson:"line_number"`
+	Type             string        `bson:"type"`
+	ExternalId       string        `bson:"external_id"`
+	Amount           float64       `bson:"amount"`
+	Currency         string        `bson:"currency"`
+	OrderId          string        `bson:"order_id"`
+	RefundId         string        `bson:"refund_id"`
+	ExpectedAmount   float64       `bson:"expected_amount"`
+	ExpectedCurrency string        `bson:"expected_currency"`
+	Status           string        `bson:"status"`
+	IsResolved       bool          `bson:"is_resolved"`
+	Resolution       string        `bson:"resolution"`
+	ResolvedAt       time.Time     `bson:"resolved_at"`
+	CreatedAt        time.Time     `bson:"created_at"`
+}
+
+type MgoChargebackEvidence struct {
+	Id        bson.ObjectId `bson:"_id"`
+	FileName  string        `bson:"file_name"`
+	FileUrl   string        `bson:"file_url"`
+	Comment   string        `bson:"comment"`
+	CreatedAt time.Time     `bson:"created_at"`
+}
+
+type MgoChargeback struct {
+	Id              bson.ObjectId            `bson:"_id"`
+	OrderId         bson.ObjectId            `bson:"order_id"`
+	OrderUuid       string                   `bson:"order_uuid"`
+	MerchantId      bson.ObjectId            `bson:"merchant_id"`
+	ProjectId       bson.ObjectId            `bson:"project_id"`
+	ExternalId      string                   `bson:"external_id"`
+	ReasonCode      string                   `bson:"reason_code"`
+	Reason          string                   `bson:"reason"`
+	Amount          float64                  `bson:"amount"`
+	Currency        string                   `bson:"currency"`
+	Status          string                   `bson:"status"`
+	EvidenceDueDate time.Time                `bson:"evidence_due_date"`
+	Evidences       []*MgoChargebackEvidence `bson:"evidences"`
+	RepresentmentAt time.Time                `bson:"representment_at"`
+	ClosedAt        time.Time                `bson:"closed_at"`
+	CreatedAt       time.Time                `bson:"created_at"`
+	UpdatedAt       time.Time                `bson:"updated_at"`
+}
+
+type MgoFraudRule struct {
+	Id         bson.ObjectId `bson:"_id"`
+	MerchantId bson.ObjectId `bson:"merchant_id"`
+	ProjectId  bson.ObjectId `bson:"project_id"`
+	Name       string        `bson:"name"`
+	Type       string        `bson:"type"`
+	Field      string        `bson:"field"`
+	MaxCount   int32         `bson:"max_count"`
+	Period     int64         `bson:"period"`
+	Amount     float64       `bson:"amount"`
+	Currency   string        `bson:"currency"`
+	Values     []string      `bson:"values"`
+	Score      int32         `bson:"score"`
+	Decision   string        `bson:"decision"`
+	IsActive   bool          `bson:"is_active"`
+	CreatedAt  time.Time     `bson:"created_at"`
+	UpdatedAt  time.Time     `bson:"updated_at"`
+}
+
+type MgoPromoCode struct {
+	Id                 bson.ObjectId      `bson:"_id"`
+	MerchantId         bson.ObjectId      `bson:"merchant_id"`
+	ProjectId          bson.ObjectId      `bson:"project_id,omitempty"`
+	Code               string             `bson:"code"`
+	Type               string             `bson:"type"`
+	Percent            float64            `bson:"percent"`
+	Amounts            map[string]float64 `bson:"amounts"`
+	ValidFrom          time.Time          `bson:"valid_from"`
+	ValidTo            time.Time          `bson:"valid_to"`
+	MaxUses            int32              `bson:"max_uses"`
+	MaxUsesPerCustomer int32              `bson:"max_uses_per_customer"`
+	ProductIds         []string           `bson:"product_ids"`
+	PlatformIds        []string           `bson:"platform_ids"`
+	IsActive           bool               `bson:"is_active"`
+	CreatedAt          time.Time          `bson:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at"`
+}
+
+type MgoWebhookDeliveryAttempt struct {
+	HttpStatus   int32     `bson:"http_status"`
+	ResponseBody string    `bson:"response_body"`
+	Error        string    `bson:"error"`
+	IsManual     bool      `bson:"is_manual"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+type MgoWebhookDelivery struct {
+	Id            bson.ObjectId                `bson:"_id"`
+	MerchantId    bson.ObjectId                `bson:"merchant_id"`
+	ProjectId     bson.ObjectId                `bson:"project_id"`
+	OrderId       bson.ObjectId                `bson:"order_id,omitempty"`
+	Event         string                       `bson:"event"`
+	Url           string                       `bson:"url"`
+	Payload       string                       `bson:"payload"`
+	Status        string                       `bson:"status"`
+	Attempts      []*MgoWebhookDeliveryAttempt `bson:"attempts"`
+	NextAttemptAt time.Time                    `bson:"next_attempt_at"`
+	DeliveredAt   time.Time                    `bson:"delivered_at"`
+	CreatedAt     time.Time                    `bson:"created_at"`
+	UpdatedAt     time.Time                    `bson:"updated_at"`
+}
+
+type MgoSubscriptionPlan struct {
+	Id            bson.ObjectId   `bson:"_id"`
+	MerchantId    bson.ObjectId   `bson:"merchant_id"`
+	ProjectId     bson.ObjectId   `bson:"project_id"`
+	Name          []*MgoMultiLang `bson:"name"`
+	Description   []*MgoMultiLang `bson:"description"`
+	Prices        []*ProductPrice `bson:"prices"`
+	IntervalUnit  string          `bson:"interval_unit"`
+	IntervalCount int32           `bson:"interval_count"`
+	TrialDays     int32           `bson:"trial_days"`
+	IsActive      bool            `bson:"is_active"`
+	CreatedAt     time.Time       `bson:"created_at"`
+	UpdatedAt     time.Time       `bson:"updated_at"`
+}
+
+type MgoSubscription struct {
+	Id                   bson.ObjectId `bson:"_id"`
+	PlanId               bson.ObjectId `bson:"plan_id"`
+	MerchantId           bson.ObjectId `bson:"merchant_id"`
+	ProjectId            bson.ObjectId `bson:"project_id"`
+	CustomerId           string        `bson:"customer_id"`
+	User                 *OrderUser    `bson:"user"`
+	PaymentMethodId      string        `bson:"payment_method_id"`
+	SavedCardId          string        `bson:"saved_card_id"`
+	PriceGroupId         string        `bson:"price_group_id"`
+	Currency             string        `bson:"currency"`
+	Amount               float64       `bson:"amount"`
+	Status               string        `bson:"status"`
+	TrialEndAt           time.Time     `bson:"trial_end_at"`
+	CurrentPeriodStartAt time.Time     `bson:"current_period_start_at"`
+	CurrentPeriodEndAt   time.Time     `bson:"current_period_end_at"`
+	NextBillingAt        time.Time     `bson:"next_billing_at"`
+	FailedAttempts       int32         `bson:"failed_attempts"`
+	InitialOrderId       string        `bson:"initial_order_id"`
+	LastOrderId          string        `bson:"last_order_id"`
+	PausedAt             time.Time     `bson:"paused_at"`
+	CanceledAt           time.Time     `bson:"canceled_at"`
+	CancelReason         string        `bson:"cancel_reason"`
+	CreatedAt            time.Time     `bson:"created_at"`
+	UpdatedAt            time.Time     `bson:"updated_at"`
+}
+
 type MgoMerchantPaymentMethodHistory struct {
 	Id            bson.ObjectId             `bson:"_id"`
 	MerchantId    bson.ObjectId             `bson:"merchant_id"`
@@ -450,10 +674,27 @@ type MgoCountry struct {
 	VatCurrencyRatesPolicy string               `bson:"vat_currency_rates_policy"`
 	VatCurrencyRatesSource string               `bson:"vat_currency_rates_source"`
 	PayerTariffRegion      string               `bson:"payer_tariff_region"`
+	VatRate                float64              `bson:"vat_rate"`
 	CreatedAt              time.Time            `bson:"created_at"`
 	UpdatedAt              time.Time            `bson:"updated_at"`
 }
 
+type MgoCountryVatSettings struct {
+	Id                     bson.ObjectId        `bson:"_id"`
+	Country                string               `bson:"country"`
+	VatEnabled             bool                 `bson:"vat_enabled"`
+	VatCurrency            string               `bson:"vat_currency"`
+	VatRate                float64              `bson:"vat_rate"`
+	VatThreshold           *CountryVatThreshold `bson:"vat_threshold"`
+	VatPeriodMonth         int32                `bson:"vat_period_month"`
+	VatDeadlineDays        int32                `bson:"vat_deadline_days"`
+	VatStoreYears          int32                `bson:"vat_store_years"`
+	VatCurrencyRatesPolicy string               `bson:"vat_currency_rates_policy"`
+	VatCurrencyRatesSource string               `bson:"vat_currency_rates_source"`
+	EffectiveFrom          time.Time            `bson:"effective_from"`
+	CreatedAt              time.Time            `bson:"created_at"`
+}
+
 type MgoPayoutCostSystem struct {
 	Id                    bson.ObjectId `bson:"_id"`
 	IntrabankCostAmount   float64       `bson:"intrabank_cost_amount"`
@@ -557,43 +798,78 @@ type MgoAccountingEntrySource struct {
 }
 
 type MgoAccountingEntry struct {
-	Id               bson.ObjectId             `bson:"_id"`
-	Object           string                    `bson:"object"`
-	Type             string                    `bson:"type"`
-	Source           *MgoAccountingEntrySource `bson:"source"`
-	MerchantId       bson.ObjectId             `bson:"merchant_id"`
-	Amount           float64                   `bson:"amount"`
-	Currency         string                    `bson:"currency"`
-	Reason           string                    `bson:"reason"`
-	Status           string                    `bson:"status"`
-	Country          string                    `bson:"country"`
-	OriginalAmount   float64                   `bson:"original_amount"`
-	OriginalCurrency string                    `bson:"original_currency"`
-	LocalAmount      float64                   `bson:"local_amount"`
-	LocalCurrency    string                    `bson:"local_currency"`
-	CreatedAt        time.Time                 `bson:"created_at"`
-	AvailableOn      time.Time                 `bson:"available_on"`
+	Id                  bson.ObjectId             `bson:"_id"`
+	Object              string                    `bson:"object"`
+	Type                string                    `bson:"type"`
+	Source              *MgoAccountingEntrySource `bson:"source"`
+	MerchantId          bson.ObjectId             `bson:"merchant_id"`
+	Amount              float64                   `bson:"amount"`
+	AmountMinor         int64                     `bson:"amount_minor"`
+	Currency            string                    `bson:"currency"`
+	Reason              string                    `bson:"reason"`
+	Status              string                    `bson:"status"`
+	Country             string                    `bson:"country"`
+	OriginalAmount      float64                   `bson:"original_amount"`
+	OriginalAmountMinor int64                     `bson:"original_amount_minor"`
+	OriginalCurrency    string                    `bson:"original_currency"`
+	LocalAmount         float64                   `bson:"local_amount"`
+	LocalAmountMinor    int64                     `bson:"local_amount_minor"`
+	LocalCurrency       string                    `bson:"local_currency"`
+	CreatedAt           time.Time                 `bson:"created_at"`
+	AvailableOn         time.Time                 `bson:"available_on"`
+}
+
+type MgoLedgerPosting struct {
+	Id                bson.ObjectId             `bson:"_id"`
+	TransactionId     bson.ObjectId             `bson:"transaction_id"`
+	AccountingEntryId bson.ObjectId             `bson:"accounting_entry_id,omitempty"`
+	EntryType         string                    `bson:"entry_type"`
+	Source            *MgoAccountingEntrySource `bson:"source"`
+	MerchantId        bson.ObjectId             `bson:"merchant_id"`
+	Account           string                    `bson:"account"`
+	Debit             float64                   `bson:"debit"`
+	DebitMinor        int64                     `bson:"debit_minor"`
+	Credit            float64                   `bson:"credit"`
+	CreditMinor       int64                     `bson:"credit_minor"`
+	Currency          string                    `bson:"currency"`
+	CreatedAt         time.Time                 `bson:"created_at"`
 }
 
 type MgoRoyaltyReport struct {
-	Id               bson.ObjectId         `bson:"_id"`
-	MerchantId       bson.ObjectId         `bson:"merchant_id"`
-	CreatedAt        time.Time             `bson:"created_at"`
-	UpdatedAt        time.Time             `bson:"updated_at"`
-	PayoutDate       time.Time             `bson:"payout_date"`
-	Status           string                `bson:"status"`
-	PeriodFrom       time.Time             `bson:"period_from"`
-	PeriodTo         time.Time             `bson:"period_to"`
-	AcceptExpireAt   time.Time             `bson:"accept_expire_at"`
-	AcceptedAt       time.Time             `bson:"accepted_at"`
-	Totals           *RoyaltyReportTotals  `bson:"totals"`
-	Currency         string                `bson:"currency"`
-	Summary          *RoyaltyReportSummary `bson:"summary"`
-	DisputeReason    string                `bson:"dispute_reason"`
-	DisputeStartedAt time.Time             `bson:"dispute_started_at"`
-	DisputeClosedAt  time.Time             `bson:"dispute_closed_at"`
-	IsAutoAccepted   bool                  `bson:"is_auto_accepted"`
-	PayoutDocumentId string                `bson:"payout_document_id"`
+	Id               bson.ObjectId           `bson:"_id"`
+	MerchantId       bson.ObjectId           `bson:"merchant_id"`
+	CreatedAt        time.Time               `bson:"created_at"`
+	UpdatedAt        time.Time               `bson:"updated_at"`
+	PayoutDate       time.Time               `bson:"payout_date"`
+	Status           string                  `bson:"status"`
+	PeriodFrom       time.Time               `bson:"period_from"`
+	PeriodTo         time.Time               `bson:"period_to"`
+	AcceptExpireAt   time.Time               `bson:"accept_expire_at"`
+	AcceptedAt       time.Time               `bson:"accepted_at"`
+	Totals           *MgoRoyaltyReportTotals `bson:"totals"`
+	Currency         string                  `bson:"currency"`
+	Summary          *RoyaltyReportSummary   `bson:"summary"`
+	DisputeReason    string                  `bson:"dispute_reason"`
+	DisputeStartedAt time.Time               `bson:"dispute_started_at"`
+	DisputeClosedAt  time.Time               `bson:"dispute_closed_at"`
+	IsAutoAccepted   bool                    `bson:"is_auto_accepted"`
+	PayoutDocumentId string                  `bson:"payout_document_id"`
+	Version          int32                   `bson:"version"`
+	DisputeId        string                  `bson:"dispute_id"`
+}
+
+type MgoRoyaltyReportTotals struct {
+	TransactionsCount         int32   `bson:"transactions_count"`
+	FeeAmount                 float64 `bson:"fee_amount"`
+	FeeAmountMinor            int64   `bson:"fee_amount_minor"`
+	VatAmount                 float64 `bson:"vat_amount"`
+	VatAmountMinor            int64   `bson:"vat_amount_minor"`
+	PayoutAmount              float64 `bson:"payout_amount"`
+	PayoutAmountMinor         int64   `bson:"payout_amount_minor"`
+	RollingReserveAmount      float64 `bson:"rolling_reserve_total_amount"`
+	RollingReserveAmountMinor int64   `bson:"rolling_reserve_total_amount_minor"`
+	CorrectionAmount          float64 `bson:"correction_total_amount"`
+	CorrectionAmountMinor     int64   `bson:"correction_total_amount_minor"`
 }
 
 type MgoRoyaltyReportCorrectionItem struct {
@@ -604,6 +880,40 @@ type MgoRoyaltyReportCorrectionItem struct {
 	EntryDate         time.Time     `bson:"entry_date"`
 }
 
+type MgoRoyaltyReportDisputeCorrection struct {
+	AccountingEntryId bson.ObjectId `bson:"accounting_entry_id"`
+	ItemId            string        `bson:"item_id"`
+	Type              string        `bson:"type"`
+	Amount            float64       `bson:"amount"`
+	Currency          string        `bson:"currency"`
+	Reason            string        `bson:"reason"`
+	CreatedAt         time.Time     `bson:"created_at"`
+}
+
+type MgoRoyaltyReportDispute struct {
+	Id              bson.ObjectId                        `bson:"_id"`
+	RoyaltyReportId bson.ObjectId                        `bson:"royalty_report_id"`
+	MerchantId      bson.ObjectId                        `bson:"merchant_id"`
+	ReportVersion   int32                                `bson:"report_version"`
+	Status          string                               `bson:"status"`
+	Reason          string                               `bson:"reason"`
+	Items           []*RoyaltyReportDisputeItem          `bson:"items"`
+	Corrections     []*MgoRoyaltyReportDisputeCorrection `bson:"corrections"`
+	Resolution      string                               `bson:"resolution"`
+	CreatedAt       time.Time                            `bson:"created_at"`
+	UpdatedAt       time.Time                            `bson:"updated_at"`
+	ResolvedAt      time.Time                            `bson:"resolved_at"`
+}
+
+type MgoRoyaltyReportVersion struct {
+	Id              bson.ObjectId  `bson:"_id"`
+	RoyaltyReportId bson.ObjectId  `bson:"royalty_report_id"`
+	Version         int32          `bson:"version"`
+	DisputeId       string         `bson:"dispute_id"`
+	Report          *RoyaltyReport `bson:"report"`
+	CreatedAt       time.Time      `bson:"created_at"`
+}
+
 type MgoRoyaltyReportChanges struct {
 	Id              bson.ObjectId `bson:"_id"`
 	RoyaltyReportId bson.ObjectId `bson:"royalty_report_id"`
@@ -620,10 +930,15 @@ type MgoVatReport struct {
 	Currency              string        `bson:"currency"`
 	TransactionsCount     int32         `bson:"transactions_count"`
 	GrossRevenue          float64       `bson:"gross_revenue"`
+	GrossRevenueMinor     int64         `bson:"gross_revenue_minor"`
 	VatAmount             float64       `bson:"vat_amount"`
+	VatAmountMinor        int64         `bson:"vat_amount_minor"`
 	FeesAmount            float64       `bson:"fees_amount"`
+	FeesAmountMinor       int64         `bson:"fees_amount_minor"`
 	DeductionAmount       float64       `bson:"deduction_amount"`
+	DeductionAmountMinor  int64         `bson:"deduction_amount_minor"`
 	CorrectionAmount      float64       `bson:"correction_amount"`
+	CorrectionAmountMinor int64         `bson:"correction_amount_minor"`
 	Status                string        `bson:"status"`
 	CountryAnnualTurnover float64       `bson:"country_annual_turnover"`
 	WorldAnnualTurnover   float64       `bson:"world_annual_turnover"`
@@ -634,6 +949,22 @@ type MgoVatReport struct {
 	CreatedAt             time.Time     `bson:"created_at"`
 	UpdatedAt             time.Time     `bson:"updated_at"`
 	PaidAt                time.Time     `bson:"paid_at"`
+	OssReturnId           string        `bson:"oss_return_id"`
+}
+
+type MgoVatOssReturn struct {
+	Id                 bson.ObjectId       `bson:"_id"`
+	Year               int32               `bson:"year"`
+	Quarter            int32               `bson:"quarter"`
+	DateFrom           time.Time           `bson:"date_from"`
+	DateTo             time.Time           `bson:"date_to"`
+	Currency           string              `bson:"currency"`
+	Items              []*VatOssReturnItem `bson:"items"`
+	TotalTaxableAmount float64             `bson:"total_taxable_amount"`
+	TotalVatAmount     float64             `bson:"total_vat_amount"`
+	VatReportIds       []string            `bson:"vat_report_ids"`
+	Files              []*VatOssReturnFile `bson:"files"`
+	CreatedAt          time.Time           `bson:"created_at"`
 }
 
 type MgoOrderViewPrivate struct {
@@ -767,14 +1098,30 @@ type MgoOrderViewPublic struct {
 }*/
 
 type MgoKey struct {
-	Id           bson.ObjectId  `bson:"_id"`
-	Code         string         `bson:"code"`
-	KeyProductId bson.ObjectId  `bson:"key_product_id"`
-	PlatformId   string         `bson:"platform_id"`
-	OrderId      *bson.ObjectId `bson:"order_id"`
-	CreatedAt    time.Time      `bson:"created_at"`
-	ReservedTo   time.Time      `bson:"reserved_to"`
-	RedeemedAt   time.Time      `bson:"redeemed_at"`
+	Id               bson.ObjectId     `bson:"_id"`
+	Code             string            `bson:"code,omitempty"`
+	KeyProductId     bson.ObjectId     `bson:"key_product_id"`
+	PlatformId       string            `bson:"platform_id"`
+	OrderId          *bson.ObjectId    `bson:"order_id"`
+	CreatedAt        time.Time         `bson:"created_at"`
+	ReservedTo       time.Time         `bson:"reserved_to"`
+	RedeemedAt       time.Time         `bson:"redeemed_at"`
+	CodeHash         string            `bson:"code_hash,omitempty"`
+	EncryptedCode    []byte            `bson:"encrypted_code,omitempty"`
+	EncryptedDataKey []byte            `bson:"encrypted_data_key,omitempty"`
+	MasterKeyId      string            `bson:"master_key_id,omitempty"`
+	Metadata         map[string]string `bson:"metadata,omitempty"`
+	RevokedAt        *time.Time        `bson:"revoked_at"`
+}
+
+type MgoKeyAuditLog struct {
+	Id           bson.ObjectId `bson:"_id"`
+	KeyId        bson.ObjectId `bson:"key_id"`
+	KeyProductId string        `bson:"key_product_id"`
+	PlatformId   string        `bson:"platform_id"`
+	OrderId      string        `bson:"order_id"`
+	Action       string        `bson:"action"`
+	CreatedAt    time.Time     `bson:"created_at"`
 }
 
 type MgoPayoutDocument struct {
@@ -782,7 +1129,9 @@ type MgoPayoutDocument struct {
 	MerchantId              bson.ObjectId        `bson:"merchant_id"`
 	SourceId                []string             `bson:"source_id"`
 	TotalFees               float64              `bson:"total_fees"`
+	TotalFeesMinor          int64                `bson:"total_fees_minor"`
 	Balance                 float64              `bson:"balance"`
+	BalanceMinor            int64                `bson:"balance_minor"`
 	Currency                string               `bson:"currency"`
 	PeriodFrom              time.Time            `bson:"period_from"`
 	PeriodTo                time.Time            `bson:"period_to"`
@@ -800,6 +1149,7 @@ type MgoPayoutDocument struct {
 	UpdatedAt               time.Time            `bson:"updated_at"`
 	ArrivalDate             time.Time            `bson:"arrival_date"`
 	PaidAt                  time.Time            `bson:"paid_at"`
+	BatchId                 string               `bson:"batch_id"`
 }
 
 type MgoPayoutDocumentChanges struct {
@@ -811,21 +1161,27 @@ type MgoPayoutDocumentChanges struct {
 }
 
 type MgoMerchantBalance struct {
-	Id             bson.ObjectId `bson:"_id"`
-	MerchantId     bson.ObjectId `bson:"merchant_id"`
-	Currency       string        `bson:"currency"`
-	Debit          float64       `bson:"debit"`
-	Credit         float64       `bson:"credit"`
-	RollingReserve float64       `bson:"rolling_reserve"`
-	Total          float64       `bson:"total"`
-	CreatedAt      time.Time     `bson:"created_at"`
+	Id                  bson.ObjectId `bson:"_id"`
+	MerchantId          bson.ObjectId `bson:"merchant_id"`
+	Currency            string        `bson:"currency"`
+	Debit               float64       `bson:"debit"`
+	DebitMinor          int64         `bson:"debit_minor"`
+	Credit              float64       `bson:"credit"`
+	CreditMinor         int64         `bson:"credit_minor"`
+	RollingReserve      float64       `bson:"rolling_reserve"`
+	RollingReserveMinor int64         `bson:"rolling_reserve_minor"`
+	Total               float64       `bson:"total"`
+	TotalMinor          int64         `bson:"total_minor"`
+	CreatedAt           time.Time     `bson:"created_at"`
 }
 
 func (m *PayoutDocument) GetBSON() (interface{}, error) {
 	st := &MgoPayoutDocument{
 		SourceId:                m.SourceId,
-		TotalFees:               m.TotalFees,
-		Balance:                 m.Balance,
+		TotalFees:               money.Round(m.TotalFees, m.Currency),
+		TotalFeesMinor:          money.ToMinor(m.TotalFees, m.Currency),
+		Balance:                 money.Round(m.Balance, m.Currency),
+		BalanceMinor:            money.ToMinor(m.Balance, m.Currency),
 		Currency:                m.Currency,
 		TotalTransactions:       m.TotalTransactions,
 		Description:             m.Description,
@@ -837,6 +1193,7 @@ func (m *PayoutDocument) GetBSON() (interface{}, error) {
 		FailureTransaction:      m.FailureTransaction,
 		Destination:             m.Destination,
 		Company:                 m.Company,
+		BatchId:                 m.BatchId,
 	}
 	if len(m.Id) <= 0 {
 		st.Id = bson.NewObjectId()
@@ -933,8 +1290,8 @@ func (m *PayoutDocument) SetBSON(raw bson.Raw) error {
 	m.Id = decoded.Id.Hex()
 	m.MerchantId = decoded.MerchantId.Hex()
 	m.SourceId = decoded.SourceId
-	m.TotalFees = decoded.TotalFees
-	m.Balance = decoded.Balance
+	m.TotalFees = getMoneyAmount(decoded.TotalFeesMinor, decoded.TotalFees, decoded.Currency)
+	m.Balance = getMoneyAmount(decoded.BalanceMinor, decoded.Balance, decoded.Currency)
 	m.Currency = decoded.Currency
 	m.TotalTransactions = decoded.TotalTransactions
 	m.Description = decoded.Description
@@ -946,6 +1303,7 @@ func (m *PayoutDocument) SetBSON(raw bson.Raw) error {
 	m.FailureTransaction = decoded.FailureTransaction
 	m.Destination = decoded.Destination
 	m.Company = decoded.Company
+	m.BatchId = decoded.BatchId
 
 	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 	if err != nil {
@@ -1037,11 +1395,15 @@ func (m *PayoutDocumentChanges) SetBSON(raw bson.Raw) error {
 
 func (m *MerchantBalance) GetBSON() (interface{}, error) {
 	st := &MgoMerchantBalance{
-		Currency:       m.Currency,
-		Debit:          m.Debit,
-		Credit:         m.Credit,
-		RollingReserve: m.RollingReserve,
-		Total:          m.Total,
+		Currency:            m.Currency,
+		Debit:               money.Round(m.Debit, m.Currency),
+		DebitMinor:          money.ToMinor(m.Debit, m.Currency),
+		Credit:              money.Round(m.Credit, m.Currency),
+		CreditMinor:         money.ToMinor(m.Credit, m.Currency),
+		RollingReserve:      money.Round(m.RollingReserve, m.Currency),
+		RollingReserveMinor: money.ToMinor(m.RollingReserve, m.Currency),
+		Total:               money.Round(m.Total, m.Currency),
+		TotalMinor:          money.ToMinor(m.Total, m.Currency),
 	}
 	if len(m.Id) <= 0 {
 		st.Id = bson.NewObjectId()
@@ -1085,10 +1447,10 @@ func (m *MerchantBalance) SetBSON(raw bson.Raw) error {
 	m.Id = decoded.Id.Hex()
 	m.MerchantId = decoded.MerchantId.Hex()
 	m.Currency = decoded.Currency
-	m.Debit = decoded.Debit
-	m.Credit = decoded.Credit
-	m.RollingReserve = decoded.RollingReserve
-	m.Total = decoded.Total
+	m.Debit = getMoneyAmount(decoded.DebitMinor, decoded.Debit, decoded.Currency)
+	m.Credit = getMoneyAmount(decoded.CreditMinor, decoded.Credit, decoded.Currency)
+	m.RollingReserve = getMoneyAmount(decoded.RollingReserveMinor, decoded.RollingReserve, decoded.Currency)
+	m.Total = getMoneyAmount(decoded.TotalMinor, decoded.Total, decoded.Currency)
 
 	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 	if err != nil {
@@ -1110,10 +1472,12 @@ func (m *Country) GetBSON() (interface{}, error) {
 		VatCurrency:            m.VatCurrency,
 		VatThreshold:           m.VatThreshold,
 		VatPeriodMonth:         m.VatPeriodMonth,
+		VatDeadlineDays:        m.VatDeadlineDays,
 		VatStoreYears:          m.VatStoreYears,
 		VatCurrencyRatesPolicy: m.VatCurrencyRatesPolicy,
 		VatCurrencyRatesSource: m.VatCurrencyRatesSource,
 		PayerTariffRegion:      m.PayerTariffRegion,
+		VatRate:                m.VatRate,
 	}
 	if len(m.Id) <= 0 {
 		st.Id = bson.NewObjectId()
@@ -1176,6 +1540,7 @@ func (m *Country) SetBSON(raw bson.Raw) error {
 	m.VatCurrencyRatesPolicy = decoded.VatCurrencyRatesPolicy
 	m.VatCurrencyRatesSource = decoded.VatCurrencyRatesSource
 	m.PayerTariffRegion = decoded.PayerTariffRegion
+	m.VatRate = decoded.VatRate
 
 	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 
@@ -1192,6 +1557,82 @@ func (m *Country) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
+func (m *CountryVatSettings) GetBSON() (interface{}, error) {
+	st := &MgoCountryVatSettings{
+		Country:                m.Country,
+		VatEnabled:             m.VatEnabled,
+		VatCurrency:            m.VatCurrency,
+		VatRate:                m.VatRate,
+		VatThreshold:           m.VatThreshold,
+		VatPeriodMonth:         m.VatPeriodMonth,
+		VatDeadlineDays:        m.VatDeadlineDays,
+		VatStoreYears:          m.VatStoreYears,
+		VatCurrencyRatesPolicy: m.VatCurrencyRatesPolicy,
+		VatCurrencyRatesSource: m.VatCurrencyRatesSource,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	var err error
+
+	st.EffectiveFrom, err = ptypes.Timestamp(m.EffectiveFrom)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if m.CreatedAt != nil {
+		st.CreatedAt, err = ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *CountryVatSettings) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoCountryVatSettings)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.Country = decoded.Country
+	m.VatEnabled = decoded.VatEnabled
+	m.VatCurrency = decoded.VatCurrency
+	m.VatRate = decoded.VatRate
+	m.VatThreshold = decoded.VatThreshold
+	m.VatPeriodMonth = decoded.VatPeriodMonth
+	m.VatDeadlineDays = decoded.VatDeadlineDays
+	m.VatStoreYears = decoded.VatStoreYears
+	m.VatCurrencyRatesPolicy = decoded.VatCurrencyRatesPolicy
+	m.VatCurrencyRatesSource = decoded.VatCurrencyRatesSource
+
+	m.EffectiveFrom, err = ptypes.TimestampProto(decoded.EffectiveFrom)
+
+	if err != nil {
+		return err
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	return err
+}
+
 func (m *PriceGroup) GetBSON() (interface{}, error) {
 	st := &MgoPriceGroup{
 		Region:        m.Region,
@@ -1501,32 +1942,33 @@ func (m *Commission) SetBSON(raw bson.Raw) error {
 
 func (m *Order) GetBSON() (interface{}, error) {
 	st := &MgoOrder{
-		Uuid:               m.Uuid,
-		Transaction:        m.Transaction,
-		Object:             "order",
-		Status:             m.GetPublicStatus(),
-		PrivateStatus:      m.PrivateStatus,
-		Description:        m.Description,
-		Canceled:           m.PrivateStatus == constant.OrderStatusPaymentSystemCanceled,
-		CancellationReason: m.CancellationReason,
-		Refunded:           m.PrivateStatus == constant.OrderStatusRefund,
-		ReceiptEmail:       m.GetReceiptUserEmail(),
-		ReceiptPhone:       m.GetReceiptUserPhone(),
-		ReceiptNumber:      m.ReceiptNumber,
-		ReceiptUrl:         m.ReceiptUrl,
-		AgreementVersion:   m.AgreementVersion,
-		AgreementAccepted:  m.AgreementAccepted,
-		NotifySale:         m.NotifySale,
-		NotifySaleEmail:    m.NotifySaleEmail,
-		Issuer:             m.Issuer,
-		TotalPaymentAmount: m.TotalPaymentAmount,
-		Currency:           m.Currency,
-		User:               m.User,
-		BillingAddress:     m.BillingAddress,
-		Tax:                m.Tax,
-		Items:              []*MgoOrderItem{},
-		Metadata:           m.Metadata,
-		PrivateMetadata:    m.PrivateMetadata,
+		Uuid:                    m.Uuid,
+		Transaction:             m.Transaction,
+		Object:                  "order",
+		Status:                  m.GetPublicStatus(),
+		PrivateStatus:           m.PrivateStatus,
+		Description:             m.Description,
+		Canceled:                m.PrivateStatus == constant.OrderStatusPaymentSystemCanceled,
+		CancellationReason:      m.CancellationReason,
+		Refunded:                m.PrivateStatus == constant.OrderStatusRefund,
+		ReceiptEmail:            m.GetReceiptUserEmail(),
+		ReceiptPhone:            m.GetReceiptUserPhone(),
+		ReceiptNumber:           m.ReceiptNumber,
+		ReceiptUrl:              m.ReceiptUrl,
+		AgreementVersion:        m.AgreementVersion,
+		AgreementAccepted:       m.AgreementAccepted,
+		NotifySale:              m.NotifySale,
+		NotifySaleEmail:         m.NotifySaleEmail,
+		Issuer:                  m.Issuer,
+		TotalPaymentAmount:      money.Round(m.TotalPaymentAmount, m.Currency),
+		TotalPaymentAmountMinor: money.ToMinor(m.TotalPaymentAmount, m.Currency),
+		Currency:                m.Currency,
+		User:                    m.User,
+		BillingAddress:          m.BillingAddress,
+		Tax:                     m.Tax,
+		Items:                   []*MgoOrderItem{},
+		Metadata:                m.Metadata,
+		PrivateMetadata:         m.PrivateMetadata,
 		Project: &MgoOrderProject{
 			Id:                      bson.ObjectIdHex(m.Project.Id),
 			MerchantId:              bson.ObjectIdHex(m.Project.MerchantId),
@@ -1549,7 +1991,8 @@ func (m *Order) GetBSON() (interface{}, error) {
 		ProjectAccount:            m.ProjectAccount,
 		ProjectParams:             m.ProjectParams,
 		IsJsonRequest:             m.IsJsonRequest,
-		OrderAmount:               m.OrderAmount,
+		OrderAmount:               money.Round(m.OrderAmount, m.Currency),
+		OrderAmountMinor:          money.ToMinor(m.OrderAmount, m.Currency),
 		PaymentMethodPayerAccount: m.PaymentMethodPayerAccount,
 		PaymentMethodTxnParams:    m.PaymentMethodTxnParams,
 		PaymentRequisites:         m.PaymentRequisites,
@@ -1566,6 +2009,16 @@ func (m *Order) GetBSON() (interface{}, error) {
 		Keys:                      m.Keys,
 		IsKeyProductNotified:      m.IsKeyProductNotified,
 		ReceiptId:                 m.ReceiptId,
+		VirtualCurrencyAmount:     m.VirtualCurrencyAmount,
+		IsAuthorizationOnly:       m.IsAuthorizationOnly,
+		DiscountPercent:           m.DiscountPercent,
+		PromoCode:                 m.PromoCode,
+		DiscountAmount:            money.Round(m.DiscountAmount, m.Currency),
+		DiscountAmountMinor:       money.ToMinor(m.DiscountAmount, m.Currency),
+	}
+
+	if m.Tax != nil {
+		st.TaxAmountMinor = money.ToMinor(m.Tax.Amount, m.Tax.Currency)
 	}
 
 	if m.Refund != nil {
@@ -1592,6 +2045,7 @@ func (m *Order) GetBSON() (interface{}, error) {
 			Metadata:    v.Metadata,
 			Code:        v.Code,
 			PlatformId:  v.PlatformId,
+			Discount:    v.Discount,
 		}
 
 		if len(v.Id) <= 0 {
@@ -1631,6 +2085,32 @@ func (m *Order) GetBSON() (interface{}, error) {
 		}
 	}
 
+	for _, v := range m.PaymentRouteAttempts {
+		if bson.IsObjectIdHex(v.PaymentSystemId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		attempt := &MgoOrderPaymentRouteAttempt{
+			PaymentSystemId: bson.ObjectIdHex(v.PaymentSystemId),
+			Handler:         v.Handler,
+			Priority:        v.Priority,
+			Status:          v.Status,
+			Error:           v.Error,
+		}
+
+		if v.CreatedAt != nil {
+			t, err := ptypes.Timestamp(v.CreatedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attempt.CreatedAt = t
+		}
+
+		st.PaymentRouteAttempts = append(st.PaymentRouteAttempts, attempt)
+	}
+
 	if len(m.Id) <= 0 {
 		st.Id = bson.NewObjectId()
 	} else {
@@ -1733,6 +2213,34 @@ func (m *Order) GetBSON() (interface{}, error) {
 		st.ParentPaymentAt = t
 	}
 
+	if m.AuthorizedAt != nil {
+		t, err := ptypes.Timestamp(m.AuthorizedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.AuthorizedAt = t
+	}
+
+	if m.FraudCheck != nil {
+		st.FraudCheck = &MgoOrderFraudCheck{
+			Score:    m.FraudCheck.Score,
+			Decision: m.FraudCheck.Decision,
+			Rules:    m.FraudCheck.Rules,
+		}
+
+		if m.FraudCheck.CheckedAt != nil {
+			t, err := ptypes.Timestamp(m.FraudCheck.CheckedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			st.FraudCheck.CheckedAt = t
+		}
+	}
+
 	return st, nil
 }
 
@@ -1763,11 +2271,16 @@ func (m *Order) SetBSON(raw bson.Raw) error {
 	m.NotifySale = decoded.NotifySale
 	m.NotifySaleEmail = decoded.NotifySaleEmail
 	m.Issuer = decoded.Issuer
-	m.TotalPaymentAmount = decoded.TotalPaymentAmount
+	m.TotalPaymentAmount = getMoneyAmount(decoded.TotalPaymentAmountMinor, decoded.TotalPaymentAmount, decoded.Currency)
 	m.Currency = decoded.Currency
 	m.User = decoded.User
 	m.BillingAddress = decoded.BillingAddress
 	m.Tax = decoded.Tax
+
+	if m.Tax != nil {
+		m.Tax.Amount = getMoneyAmount(decoded.TaxAmountMinor, m.Tax.Amount, m.Tax.Currency)
+	}
+
 	m.PaymentMethod = getPaymentMethodOrder(decoded.PaymentMethod)
 	m.Items = []*OrderItem{}
 	m.PlatformId = decoded.PlatformId
@@ -1775,6 +2288,11 @@ func (m *Order) SetBSON(raw bson.Raw) error {
 	m.Keys = decoded.Keys
 	m.IsKeyProductNotified = decoded.IsKeyProductNotified
 	m.ReceiptId = decoded.ReceiptId
+	m.VirtualCurrencyAmount = decoded.VirtualCurrencyAmount
+	m.IsAuthorizationOnly = decoded.IsAuthorizationOnly
+	m.DiscountPercent = decoded.DiscountPercent
+	m.PromoCode = decoded.PromoCode
+	m.DiscountAmount = getMoneyAmount(decoded.DiscountAmountMinor, decoded.DiscountAmount, decoded.Currency)
 
 	if decoded.Refund != nil {
 		m.Refund = &OrderNotificationRefund{
@@ -1804,12 +2322,31 @@ func (m *Order) SetBSON(raw bson.Raw) error {
 			Metadata:    v.Metadata,
 			Code:        v.Code,
 			PlatformId:  v.PlatformId,
+			Discount:    v.Discount,
 		}
 		item.CreatedAt, _ = ptypes.TimestampProto(v.CreatedAt)
 		item.UpdatedAt, _ = ptypes.TimestampProto(v.UpdatedAt)
 		m.Items = append(m.Items, item)
 	}
 
+	for _, v := range decoded.PaymentRouteAttempts {
+		attempt := &OrderPaymentRouteAttempt{
+			PaymentSystemId: v.PaymentSystemId.Hex(),
+			Handler:         v.Handler,
+			Priority:        v.Priority,
+			Status:          v.Status,
+			Error:           v.Error,
+		}
+
+		attempt.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+
+		m.PaymentRouteAttempts = append(m.PaymentRouteAttempts, attempt)
+	}
+
 	if decoded.Project != nil {
 		nameLen := len(decoded.Project.Name)
 		if nameLen > 0 {
@@ -1825,7 +2362,7 @@ func (m *Order) SetBSON(raw bson.Raw) error {
 	m.ProjectAccount = decoded.ProjectAccount
 	m.ProjectParams = decoded.ProjectParams
 	m.IsJsonRequest = decoded.IsJsonRequest
-	m.OrderAmount = decoded.OrderAmount
+	m.OrderAmount = getMoneyAmount(decoded.OrderAmountMinor, decoded.OrderAmount, decoded.Currency)
 	m.PaymentMethodPayerAccount = decoded.PaymentMethodPayerAccount
 	m.PaymentMethodTxnParams = decoded.PaymentMethodTxnParams
 	m.PaymentRequisites = decoded.PaymentRequisites
@@ -1878,6 +2415,28 @@ func (m *Order) SetBSON(raw bson.Raw) error {
 		return err
 	}
 
+	if !decoded.AuthorizedAt.IsZero() {
+		m.AuthorizedAt, err = ptypes.TimestampProto(decoded.AuthorizedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if decoded.FraudCheck != nil {
+		m.FraudCheck = &OrderFraudCheck{
+			Score:    decoded.FraudCheck.Score,
+			Decision: decoded.FraudCheck.Decision,
+			Rules:    decoded.FraudCheck.Rules,
+		}
+
+		m.FraudCheck.CheckedAt, err = ptypes.TimestampProto(decoded.FraudCheck.CheckedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1957,6 +2516,24 @@ func (m *PaymentMethod) GetBSON() (interface{}, error) {
 		st.PaymentSystemId = bson.ObjectIdHex(m.PaymentSystemId)
 	}
 
+	for _, v := range m.Routes {
+		if bson.IsObjectIdHex(v.PaymentSystemId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Routes = append(st.Routes, &MgoPaymentMethodRoute{
+			PaymentSystemId:    bson.ObjectIdHex(v.PaymentSystemId),
+			Priority:           v.Priority,
+			Weight:             v.Weight,
+			Countries:          v.Countries,
+			Currencies:         v.Currencies,
+			MinAmount:          v.MinAmount,
+			MaxAmount:          v.MaxAmount,
+			TestSettings:       getMgoPaymentMethodParams(v.TestSettings),
+			ProductionSettings: getMgoPaymentMethodParams(v.ProductionSettings),
+		})
+	}
+
 	return st, nil
 }
 
@@ -2005,6 +2582,20 @@ func (m *PaymentMethod) SetBSON(raw bson.Raw) error {
 		m.ProductionSettings = pmp
 	}
 
+	for _, v := range decoded.Routes {
+		m.Routes = append(m.Routes, &PaymentMethodRoute{
+			PaymentSystemId:    v.PaymentSystemId.Hex(),
+			Priority:           v.Priority,
+			Weight:             v.Weight,
+			Countries:          v.Countries,
+			Currencies:         v.Currencies,
+			MinAmount:          v.MinAmount,
+			MaxAmount:          v.MaxAmount,
+			TestSettings:       getPaymentMethodParams(v.TestSettings),
+			ProductionSettings: getPaymentMethodParams(v.ProductionSettings),
+		})
+	}
+
 	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 
 	if err != nil {
@@ -2127,6 +2718,8 @@ func (m *Merchant) GetBSON() (interface{}, error) {
 		AgreementNumber:      m.AgreementNumber,
 		MinimalPayoutLimit:   m.MinimalPayoutLimit,
 		ManualPayoutsEnabled: m.ManualPayoutsEnabled,
+		PayoutCurrencies:     m.PayoutCurrencies,
+		PayoutCurrencyRules:  m.PayoutCurrencyRules,
 	}
 
 	if len(m.Id) <= 0 {
@@ -2320,6 +2913,8 @@ func (m *Merchant) SetBSON(raw bson.Raw) error {
 	m.AgreementNumber = decoded.AgreementNumber
 	m.MinimalPayoutLimit = decoded.MinimalPayoutLimit
 	m.ManualPayoutsEnabled = decoded.ManualPayoutsEnabled
+	m.PayoutCurrencies = decoded.PayoutCurrencies
+	m.PayoutCurrencyRules = decoded.PayoutCurrencyRules
 
 	if decoded.User != nil {
 		m.User = &MerchantUser{
@@ -2625,45 +3220,1129 @@ func (m *Refund) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
-func (m *PaymentFormPaymentMethod) IsBankCard() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBankCard
-}
-
-func (m *PaymentMethod) IsBankCard() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBankCard
-}
-
-func (m *PaymentMethodOrder) IsBankCard() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBankCard
-}
+func (m *SubscriptionPlan) GetBSON() (interface{}, error) {
+	st := &MgoSubscriptionPlan{
+		MerchantId:    bson.ObjectIdHex(m.MerchantId),
+		ProjectId:     bson.ObjectIdHex(m.ProjectId),
+		Prices:        m.Prices,
+		IntervalUnit:  m.IntervalUnit,
+		IntervalCount: m.IntervalCount,
+		TrialDays:     m.TrialDays,
+		IsActive:      m.IsActive,
+	}
 
-func (m *PaymentMethodOrder) IsCryptoCurrency() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBitcoin
-}
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
 
-func (m *MerchantPaymentMethodHistory) SetBSON(raw bson.Raw) error {
-	decoded := new(MgoMerchantPaymentMethodHistory)
-	err := raw.Unmarshal(decoded)
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
 
-	if err != nil {
-		return err
+	for k, v := range m.Name {
+		st.Name = append(st.Name, &MgoMultiLang{Lang: k, Value: v})
 	}
 
-	m.Id = decoded.Id.Hex()
-	m.MerchantId = decoded.MerchantId.Hex()
-	m.UserId = decoded.UserId.Hex()
-	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
-	if err != nil {
-		return err
+	for k, v := range m.Description {
+		st.Description = append(st.Description, &MgoMultiLang{Lang: k, Value: v})
 	}
 
-	m.PaymentMethod = &MerchantPaymentMethod{
-		PaymentMethod: &MerchantPaymentMethodIdentification{
-			Id:   bson.ObjectId(decoded.PaymentMethod.PaymentMethod.Id).Hex(),
-			Name: decoded.PaymentMethod.PaymentMethod.Name,
-		},
-		Commission:  decoded.PaymentMethod.Commission,
-		Integration: decoded.PaymentMethod.Integration,
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *SubscriptionPlan) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoSubscriptionPlan)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.Prices = decoded.Prices
+	m.IntervalUnit = decoded.IntervalUnit
+	m.IntervalCount = decoded.IntervalCount
+	m.TrialDays = decoded.TrialDays
+	m.IsActive = decoded.IsActive
+
+	m.Name = map[string]string{}
+	m.Description = map[string]string{}
+
+	for _, v := range decoded.Name {
+		m.Name[v.Lang] = v.Value
+	}
+
+	for _, v := range decoded.Description {
+		m.Description[v.Lang] = v.Value
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Subscription) GetBSON() (interface{}, error) {
+	st := &MgoSubscription{
+		PlanId:          bson.ObjectIdHex(m.PlanId),
+		MerchantId:      bson.ObjectIdHex(m.MerchantId),
+		ProjectId:       bson.ObjectIdHex(m.ProjectId),
+		CustomerId:      m.CustomerId,
+		User:            m.User,
+		PaymentMethodId: m.PaymentMethodId,
+		SavedCardId:     m.SavedCardId,
+		PriceGroupId:    m.PriceGroupId,
+		Currency:        m.Currency,
+		Amount:          m.Amount,
+		Status:          m.Status,
+		FailedAttempts:  m.FailedAttempts,
+		InitialOrderId:  m.InitialOrderId,
+		LastOrderId:     m.LastOrderId,
+		CancelReason:    m.CancelReason,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if m.TrialEndAt != nil {
+		t, err := ptypes.Timestamp(m.TrialEndAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.TrialEndAt = t
+	}
+
+	if m.CurrentPeriodStartAt != nil {
+		t, err := ptypes.Timestamp(m.CurrentPeriodStartAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CurrentPeriodStartAt = t
+	}
+
+	if m.CurrentPeriodEndAt != nil {
+		t, err := ptypes.Timestamp(m.CurrentPeriodEndAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CurrentPeriodEndAt = t
+	}
+
+	if m.NextBillingAt != nil {
+		t, err := ptypes.Timestamp(m.NextBillingAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.NextBillingAt = t
+	}
+
+	if m.PausedAt != nil {
+		t, err := ptypes.Timestamp(m.PausedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.PausedAt = t
+	}
+
+	if m.CanceledAt != nil {
+		t, err := ptypes.Timestamp(m.CanceledAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CanceledAt = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *Subscription) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoSubscription)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.PlanId = decoded.PlanId.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.CustomerId = decoded.CustomerId
+	m.User = decoded.User
+	m.PaymentMethodId = decoded.PaymentMethodId
+	m.SavedCardId = decoded.SavedCardId
+	m.PriceGroupId = decoded.PriceGroupId
+	m.Currency = decoded.Currency
+	m.Amount = decoded.Amount
+	m.Status = decoded.Status
+	m.FailedAttempts = decoded.FailedAttempts
+	m.InitialOrderId = decoded.InitialOrderId
+	m.LastOrderId = decoded.LastOrderId
+	m.CancelReason = decoded.CancelReason
+
+	if !decoded.TrialEndAt.IsZero() {
+		m.TrialEndAt, err = ptypes.TimestampProto(decoded.TrialEndAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.CurrentPeriodStartAt.IsZero() {
+		m.CurrentPeriodStartAt, err = ptypes.TimestampProto(decoded.CurrentPeriodStartAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.CurrentPeriodEndAt.IsZero() {
+		m.CurrentPeriodEndAt, err = ptypes.TimestampProto(decoded.CurrentPeriodEndAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.NextBillingAt.IsZero() {
+		m.NextBillingAt, err = ptypes.TimestampProto(decoded.NextBillingAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.PausedAt.IsZero() {
+		m.PausedAt, err = ptypes.TimestampProto(decoded.PausedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.CanceledAt.IsZero() {
+		m.CanceledAt, err = ptypes.TimestampProto(decoded.CanceledAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PayoutBatch) GetBSON() (interface{}, error) {
+	st := &MgoPayoutBatch{
+		Id:                bson.ObjectIdHex(m.Id),
+		Format:            m.Format,
+		Currency:          m.Currency,
+		PayoutDocumentIds: m.PayoutDocumentIds,
+		DocumentsCount:    m.DocumentsCount,
+		TotalAmount:       money.Round(m.TotalAmount, m.Currency),
+		TotalAmountMinor:  money.ToMinor(m.TotalAmount, m.Currency),
+		FileName:          m.FileName,
+		Content:           m.Content,
+		Checksum:          m.Checksum,
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *PayoutBatch) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoPayoutBatch)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.Format = decoded.Format
+	m.Currency = decoded.Currency
+	m.PayoutDocumentIds = decoded.PayoutDocumentIds
+	m.DocumentsCount = decoded.DocumentsCount
+	m.TotalAmount = money.FromMinor(decoded.TotalAmountMinor, decoded.Currency)
+	m.FileName = decoded.FileName
+	m.Content = decoded.Content
+	m.Checksum = decoded.Checksum
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	return err
+}
+
+func (m *ReconciliationRun) GetBSON() (interface{}, error) {
+	st := &MgoReconciliationRun{
+		Id:                   bson.ObjectIdHex(m.Id),
+		PaymentSystemHandler: m.PaymentSystemHandler,
+		FileName:             m.FileName,
+		LinesTotal:           m.LinesTotal,
+		Matched:              m.Matched,
+		Mismatched:           m.Mismatched,
+		Orphans:              m.Orphans,
+		Missing:              m.Missing,
+		Unresolved:           m.Unresolved,
+	}
+
+	if m.DateFrom != nil {
+		t, err := ptypes.Timestamp(m.DateFrom)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.DateFrom = t
+	}
+
+	if m.DateTo != nil {
+		t, err := ptypes.Timestamp(m.DateTo)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.DateTo = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *ReconciliationRun) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoReconciliationRun)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.PaymentSystemHandler = decoded.PaymentSystemHandler
+	m.FileName = decoded.FileName
+	m.LinesTotal = decoded.LinesTotal
+	m.Matched = decoded.Matched
+	m.Mismatched = decoded.Mismatched
+	m.Orphans = decoded.Orphans
+	m.Missing = decoded.Missing
+	m.Unresolved = decoded.Unresolved
+
+	if !decoded.DateFrom.IsZero() {
+		m.DateFrom, err = ptypes.TimestampProto(decoded.DateFrom)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.DateTo.IsZero() {
+		m.DateTo, err = ptypes.TimestampProto(decoded.DateTo)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReconciliationLine) GetBSON() (interface{}, error) {
+	st := &MgoReconciliationLine{
+		Id:               bson.ObjectIdHex(m.Id),
+		RunId:            bson.ObjectIdHex(m.RunId),
+		LineNumber:       m.LineNumber,
+		Type:             m.Type,
+		ExternalId:       m.ExternalId,
+		Amount:           m.Amount,
+		Currency:         m.Currency,
+		OrderId:          m.OrderId,
+		RefundId:         m.RefundId,
+		ExpectedAmount:   m.ExpectedAmount,
+		ExpectedCurrency: m.ExpectedCurrency,
+		Status:           m.Status,
+		IsResolved:       m.IsResolved,
+		Resolution:       m.Resolution,
+	}
+
+	if m.ResolvedAt != nil {
+		t, err := ptypes.Timestamp(m.ResolvedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ResolvedAt = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *ReconciliationLine) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoReconciliationLine)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.RunId = decoded.RunId.Hex()
+	m.LineNumber = decoded.LineNumber
+	m.Type = decoded.Type
+	m.ExternalId = decoded.ExternalId
+	m.Amount = decoded.Amount
+	m.Currency = decoded.Currency
+	m.OrderId = decoded.OrderId
+	m.RefundId = decoded.RefundId
+	m.ExpectedAmount = decoded.ExpectedAmount
+	m.ExpectedCurrency = decoded.ExpectedCurrency
+	m.Status = decoded.Status
+	m.IsResolved = decoded.IsResolved
+	m.Resolution = decoded.Resolution
+
+	if !decoded.ResolvedAt.IsZero() {
+		m.ResolvedAt, err = ptypes.TimestampProto(decoded.ResolvedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Chargeback) GetBSON() (interface{}, error) {
+	st := &MgoChargeback{
+		Id:         bson.ObjectIdHex(m.Id),
+		OrderId:    bson.ObjectIdHex(m.OrderId),
+		OrderUuid:  m.OrderUuid,
+		MerchantId: bson.ObjectIdHex(m.MerchantId),
+		ProjectId:  bson.ObjectIdHex(m.ProjectId),
+		ExternalId: m.ExternalId,
+		ReasonCode: m.ReasonCode,
+		Reason:     m.Reason,
+		Amount:     m.Amount,
+		Currency:   m.Currency,
+		Status:     m.Status,
+		Evidences:  []*MgoChargebackEvidence{},
+	}
+
+	for _, v := range m.Evidences {
+		evidence := &MgoChargebackEvidence{
+			Id:       bson.ObjectIdHex(v.Id),
+			FileName: v.FileName,
+			FileUrl:  v.FileUrl,
+			Comment:  v.Comment,
+		}
+
+		if v.CreatedAt != nil {
+			t, err := ptypes.Timestamp(v.CreatedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			evidence.CreatedAt = t
+		} else {
+			evidence.CreatedAt = time.Now()
+		}
+
+		st.Evidences = append(st.Evidences, evidence)
+	}
+
+	if m.EvidenceDueDate != nil {
+		t, err := ptypes.Timestamp(m.EvidenceDueDate)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.EvidenceDueDate = t
+	}
+
+	if m.RepresentmentAt != nil {
+		t, err := ptypes.Timestamp(m.RepresentmentAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.RepresentmentAt = t
+	}
+
+	if m.ClosedAt != nil {
+		t, err := ptypes.Timestamp(m.ClosedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ClosedAt = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *Chargeback) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoChargeback)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.OrderId = decoded.OrderId.Hex()
+	m.OrderUuid = decoded.OrderUuid
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.ExternalId = decoded.ExternalId
+	m.ReasonCode = decoded.ReasonCode
+	m.Reason = decoded.Reason
+	m.Amount = decoded.Amount
+	m.Currency = decoded.Currency
+	m.Status = decoded.Status
+
+	for _, v := range decoded.Evidences {
+		evidence := &ChargebackEvidence{
+			Id:       v.Id.Hex(),
+			FileName: v.FileName,
+			FileUrl:  v.FileUrl,
+			Comment:  v.Comment,
+		}
+
+		evidence.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+
+		m.Evidences = append(m.Evidences, evidence)
+	}
+
+	if !decoded.EvidenceDueDate.IsZero() {
+		m.EvidenceDueDate, err = ptypes.TimestampProto(decoded.EvidenceDueDate)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.RepresentmentAt.IsZero() {
+		m.RepresentmentAt, err = ptypes.TimestampProto(decoded.RepresentmentAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.ClosedAt.IsZero() {
+		m.ClosedAt, err = ptypes.TimestampProto(decoded.ClosedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FraudRule) GetBSON() (interface{}, error) {
+	st := &MgoFraudRule{
+		MerchantId: bson.ObjectIdHex(m.MerchantId),
+		ProjectId:  bson.ObjectIdHex(m.ProjectId),
+		Name:       m.Name,
+		Type:       m.Type,
+		Field:      m.Field,
+		MaxCount:   m.MaxCount,
+		Period:     m.Period,
+		Amount:     m.Amount,
+		Currency:   m.Currency,
+		Values:     m.Values,
+		Score:      m.Score,
+		Decision:   m.Decision,
+		IsActive:   m.IsActive,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *FraudRule) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoFraudRule)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.Name = decoded.Name
+	m.Type = decoded.Type
+	m.Field = decoded.Field
+	m.MaxCount = decoded.MaxCount
+	m.Period = decoded.Period
+	m.Amount = decoded.Amount
+	m.Currency = decoded.Currency
+	m.Values = decoded.Values
+	m.Score = decoded.Score
+	m.Decision = decoded.Decision
+	m.IsActive = decoded.IsActive
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PromoCode) GetBSON() (interface{}, error) {
+	st := &MgoPromoCode{
+		MerchantId:         bson.ObjectIdHex(m.MerchantId),
+		Code:               m.Code,
+		Type:               m.Type,
+		Percent:            m.Percent,
+		Amounts:            m.Amounts,
+		MaxUses:            m.MaxUses,
+		MaxUsesPerCustomer: m.MaxUsesPerCustomer,
+		ProductIds:         m.ProductIds,
+		PlatformIds:        m.PlatformIds,
+		IsActive:           m.IsActive,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if m.ProjectId != "" {
+		if bson.IsObjectIdHex(m.ProjectId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.ProjectId = bson.ObjectIdHex(m.ProjectId)
+	}
+
+	if m.ValidFrom != nil {
+		t, err := ptypes.Timestamp(m.ValidFrom)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ValidFrom = t
+	}
+
+	if m.ValidTo != nil {
+		t, err := ptypes.Timestamp(m.ValidTo)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ValidTo = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *PromoCode) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoPromoCode)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.Code = decoded.Code
+	m.Type = decoded.Type
+	m.Percent = decoded.Percent
+	m.Amounts = decoded.Amounts
+	m.MaxUses = decoded.MaxUses
+	m.MaxUsesPerCustomer = decoded.MaxUsesPerCustomer
+	m.ProductIds = decoded.ProductIds
+	m.PlatformIds = decoded.PlatformIds
+	m.IsActive = decoded.IsActive
+
+	if decoded.ProjectId != "" {
+		m.ProjectId = decoded.ProjectId.Hex()
+	}
+
+	if !decoded.ValidFrom.IsZero() {
+		m.ValidFrom, err = ptypes.TimestampProto(decoded.ValidFrom)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.ValidTo.IsZero() {
+		m.ValidTo, err = ptypes.TimestampProto(decoded.ValidTo)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) GetBSON() (interface{}, error) {
+	st := &MgoWebhookDelivery{
+		MerchantId: bson.ObjectIdHex(m.MerchantId),
+		ProjectId:  bson.ObjectIdHex(m.ProjectId),
+		Event:      m.Event,
+		Url:        m.Url,
+		Payload:    m.Payload,
+		Status:     m.Status,
+	}
+
+	if m.OrderId != "" {
+		st.OrderId = bson.ObjectIdHex(m.OrderId)
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	for _, v := range m.Attempts {
+		attempt := &MgoWebhookDeliveryAttempt{
+			HttpStatus:   v.HttpStatus,
+			ResponseBody: v.ResponseBody,
+			Error:        v.Error,
+			IsManual:     v.IsManual,
+		}
+
+		if v.CreatedAt != nil {
+			t, err := ptypes.Timestamp(v.CreatedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attempt.CreatedAt = t
+		}
+
+		st.Attempts = append(st.Attempts, attempt)
+	}
+
+	if m.NextAttemptAt != nil {
+		t, err := ptypes.Timestamp(m.NextAttemptAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.NextAttemptAt = t
+	}
+
+	if m.DeliveredAt != nil {
+		t, err := ptypes.Timestamp(m.DeliveredAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.DeliveredAt = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *WebhookDelivery) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoWebhookDelivery)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.OrderId = decoded.OrderId.Hex()
+	m.Event = decoded.Event
+	m.Url = decoded.Url
+	m.Payload = decoded.Payload
+	m.Status = decoded.Status
+
+	for _, v := range decoded.Attempts {
+		attempt := &WebhookDeliveryAttempt{
+			HttpStatus:   v.HttpStatus,
+			ResponseBody: v.ResponseBody,
+			Error:        v.Error,
+			IsManual:     v.IsManual,
+		}
+
+		attempt.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+
+		m.Attempts = append(m.Attempts, attempt)
+	}
+
+	if !decoded.NextAttemptAt.IsZero() {
+		m.NextAttemptAt, err = ptypes.TimestampProto(decoded.NextAttemptAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.DeliveredAt.IsZero() {
+		m.DeliveredAt, err = ptypes.TimestampProto(decoded.DeliveredAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PaymentFormPaymentMethod) IsBankCard() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBankCard
+}
+
+func (m *PaymentMethod) IsBankCard() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBankCard
+}
+
+func (m *PaymentMethodOrder) IsBankCard() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBankCard
+}
+
+func (m *PaymentMethodOrder) IsCryptoCurrency() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBitcoin
+}
+
+func (m *MerchantPaymentMethodHistory) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoMerchantPaymentMethodHistory)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.UserId = decoded.UserId.Hex()
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	m.PaymentMethod = &MerchantPaymentMethod{
+		PaymentMethod: &MerchantPaymentMethodIdentification{
+			Id:   bson.ObjectId(decoded.PaymentMethod.PaymentMethod.Id).Hex(),
+			Name: decoded.PaymentMethod.PaymentMethod.Name,
+		},
+		Commission:  decoded.PaymentMethod.Commission,
+		Integration: decoded.PaymentMethod.Integration,
 		IsActive:    decoded.PaymentMethod.IsActive,
 	}
 
@@ -3365,15 +5044,21 @@ func (m *VatReport) GetBSON() (interface{}, error) {
 		VatRate:               m.VatRate,
 		Currency:              m.Currency,
 		TransactionsCount:     m.TransactionsCount,
-		GrossRevenue:          m.GrossRevenue,
-		VatAmount:             m.VatAmount,
-		FeesAmount:            m.FeesAmount,
-		DeductionAmount:       m.DeductionAmount,
-		CorrectionAmount:      m.CorrectionAmount,
+		GrossRevenue:          money.Round(m.GrossRevenue, m.Currency),
+		GrossRevenueMinor:     money.ToMinor(m.GrossRevenue, m.Currency),
+		VatAmount:             money.Round(m.VatAmount, m.Currency),
+		VatAmountMinor:        money.ToMinor(m.VatAmount, m.Currency),
+		FeesAmount:            money.Round(m.FeesAmount, m.Currency),
+		FeesAmountMinor:       money.ToMinor(m.FeesAmount, m.Currency),
+		DeductionAmount:       money.Round(m.DeductionAmount, m.Currency),
+		DeductionAmountMinor:  money.ToMinor(m.DeductionAmount, m.Currency),
+		CorrectionAmount:      money.Round(m.CorrectionAmount, m.Currency),
+		CorrectionAmountMinor: money.ToMinor(m.CorrectionAmount, m.Currency),
 		CountryAnnualTurnover: m.CountryAnnualTurnover,
 		WorldAnnualTurnover:   m.WorldAnnualTurnover,
 		AmountsApproximate:    m.AmountsApproximate,
 		Status:                m.Status,
+		OssReturnId:           m.OssReturnId,
 	}
 
 	if len(m.Id) <= 0 {
@@ -3434,15 +5119,16 @@ func (m *VatReport) SetBSON(raw bson.Raw) error {
 	m.VatRate = decoded.VatRate
 	m.Currency = decoded.Currency
 	m.TransactionsCount = decoded.TransactionsCount
-	m.GrossRevenue = decoded.GrossRevenue
-	m.VatAmount = decoded.VatAmount
-	m.FeesAmount = decoded.FeesAmount
-	m.DeductionAmount = decoded.DeductionAmount
-	m.CorrectionAmount = decoded.CorrectionAmount
+	m.GrossRevenue = getMoneyAmount(decoded.GrossRevenueMinor, decoded.GrossRevenue, decoded.Currency)
+	m.VatAmount = getMoneyAmount(decoded.VatAmountMinor, decoded.VatAmount, decoded.Currency)
+	m.FeesAmount = getMoneyAmount(decoded.FeesAmountMinor, decoded.FeesAmount, decoded.Currency)
+	m.DeductionAmount = getMoneyAmount(decoded.DeductionAmountMinor, decoded.DeductionAmount, decoded.Currency)
+	m.CorrectionAmount = getMoneyAmount(decoded.CorrectionAmountMinor, decoded.CorrectionAmount, decoded.Currency)
 	m.CountryAnnualTurnover = decoded.CountryAnnualTurnover
 	m.WorldAnnualTurnover = decoded.WorldAnnualTurnover
 	m.AmountsApproximate = decoded.AmountsApproximate
 	m.Status = decoded.Status
+	m.OssReturnId = decoded.OssReturnId
 
 	m.DateFrom, err = ptypes.TimestampProto(decoded.DateFrom)
 	if err != nil {
@@ -3477,6 +5163,76 @@ func (m *VatReport) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
+func (m *VatOssReturn) GetBSON() (interface{}, error) {
+	st := &MgoVatOssReturn{
+		Id:                 bson.ObjectIdHex(m.Id),
+		Year:               m.Year,
+		Quarter:            m.Quarter,
+		Currency:           m.Currency,
+		Items:              m.Items,
+		TotalTaxableAmount: money.Round(m.TotalTaxableAmount, m.Currency),
+		TotalVatAmount:     money.Round(m.TotalVatAmount, m.Currency),
+		VatReportIds:       m.VatReportIds,
+		Files:              m.Files,
+	}
+
+	var err error
+
+	st.DateFrom, err = ptypes.Timestamp(m.DateFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	st.DateTo, err = ptypes.Timestamp(m.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.CreatedAt != nil {
+		st.CreatedAt, err = ptypes.Timestamp(m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *VatOssReturn) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoVatOssReturn)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.Year = decoded.Year
+	m.Quarter = decoded.Quarter
+	m.Currency = decoded.Currency
+	m.Items = decoded.Items
+	m.TotalTaxableAmount = decoded.TotalTaxableAmount
+	m.TotalVatAmount = decoded.TotalVatAmount
+	m.VatReportIds = decoded.VatReportIds
+	m.Files = decoded.Files
+
+	m.DateFrom, err = ptypes.TimestampProto(decoded.DateFrom)
+	if err != nil {
+		return err
+	}
+
+	m.DateTo, err = ptypes.TimestampProto(decoded.DateTo)
+	if err != nil {
+		return err
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	return err
+}
+
 func (m *AccountingEntry) GetBSON() (interface{}, error) {
 	st := &MgoAccountingEntry{
 		Id:     bson.ObjectIdHex(m.Id),
@@ -3486,16 +5242,19 @@ func (m *AccountingEntry) GetBSON() (interface{}, error) {
 			Id:   bson.ObjectIdHex(m.Source.Id),
 			Type: m.Source.Type,
 		},
-		MerchantId:       bson.ObjectIdHex(m.MerchantId),
-		Amount:           m.Amount,
-		Currency:         m.Currency,
-		OriginalAmount:   m.OriginalAmount,
-		OriginalCurrency: m.OriginalCurrency,
-		LocalAmount:      m.LocalAmount,
-		LocalCurrency:    m.LocalCurrency,
-		Country:          m.Country,
-		Reason:           m.Reason,
-		Status:           m.Status,
+		MerchantId:          bson.ObjectIdHex(m.MerchantId),
+		Amount:              money.Round(m.Amount, m.Currency),
+		AmountMinor:         money.ToMinor(m.Amount, m.Currency),
+		Currency:            m.Currency,
+		OriginalAmount:      money.Round(m.OriginalAmount, m.OriginalCurrency),
+		OriginalAmountMinor: money.ToMinor(m.OriginalAmount, m.OriginalCurrency),
+		OriginalCurrency:    m.OriginalCurrency,
+		LocalAmount:         money.Round(m.LocalAmount, m.LocalCurrency),
+		LocalAmountMinor:    money.ToMinor(m.LocalAmount, m.LocalCurrency),
+		LocalCurrency:       m.LocalCurrency,
+		Country:             m.Country,
+		Reason:              m.Reason,
+		Status:              m.Status,
 	}
 
 	if m.CreatedAt != nil {
@@ -3539,11 +5298,11 @@ func (m *AccountingEntry) SetBSON(raw bson.Raw) error {
 		Type: decoded.Source.Type,
 	}
 	m.MerchantId = decoded.MerchantId.Hex()
-	m.Amount = decoded.Amount
+	m.Amount = getMoneyAmount(decoded.AmountMinor, decoded.Amount, decoded.Currency)
 	m.Currency = decoded.Currency
-	m.OriginalAmount = decoded.OriginalAmount
+	m.OriginalAmount = getMoneyAmount(decoded.OriginalAmountMinor, decoded.OriginalAmount, decoded.OriginalCurrency)
 	m.OriginalCurrency = decoded.OriginalCurrency
-	m.LocalAmount = decoded.LocalAmount
+	m.LocalAmount = getMoneyAmount(decoded.LocalAmountMinor, decoded.LocalAmount, decoded.LocalCurrency)
 	m.LocalCurrency = decoded.LocalCurrency
 	m.Country = decoded.Country
 	m.Reason = decoded.Reason
@@ -3564,17 +5323,87 @@ func (m *AccountingEntry) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
+func (m *LedgerPosting) GetBSON() (interface{}, error) {
+	st := &MgoLedgerPosting{
+		Id:            bson.ObjectIdHex(m.Id),
+		TransactionId: bson.ObjectIdHex(m.TransactionId),
+		EntryType:     m.EntryType,
+		Source: &MgoAccountingEntrySource{
+			Id:   bson.ObjectIdHex(m.Source.Id),
+			Type: m.Source.Type,
+		},
+		MerchantId:  bson.ObjectIdHex(m.MerchantId),
+		Account:     m.Account,
+		Debit:       money.Round(m.Debit, m.Currency),
+		DebitMinor:  money.ToMinor(m.Debit, m.Currency),
+		Credit:      money.Round(m.Credit, m.Currency),
+		CreditMinor: money.ToMinor(m.Credit, m.Currency),
+		Currency:    m.Currency,
+	}
+
+	if m.AccountingEntryId != "" {
+		st.AccountingEntryId = bson.ObjectIdHex(m.AccountingEntryId)
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *LedgerPosting) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoLedgerPosting)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.TransactionId = decoded.TransactionId.Hex()
+	m.AccountingEntryId = decoded.AccountingEntryId.Hex()
+	m.EntryType = decoded.EntryType
+	m.Source = &AccountingEntrySource{
+		Id:   decoded.Source.Id.Hex(),
+		Type: decoded.Source.Type,
+	}
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.Account = decoded.Account
+	m.Debit = getMoneyAmount(decoded.DebitMinor, decoded.Debit, decoded.Currency)
+	m.Credit = getMoneyAmount(decoded.CreditMinor, decoded.Credit, decoded.Currency)
+	m.Currency = decoded.Currency
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RoyaltyReport) GetBSON() (interface{}, error) {
 	st := &MgoRoyaltyReport{
 		Id:               bson.ObjectIdHex(m.Id),
 		MerchantId:       bson.ObjectIdHex(m.MerchantId),
 		Status:           m.Status,
-		Totals:           m.Totals,
+		Totals:           getMgoRoyaltyReportTotals(m.Totals, m.Currency),
 		Currency:         m.Currency,
 		Summary:          m.Summary,
 		DisputeReason:    m.DisputeReason,
 		IsAutoAccepted:   m.IsAutoAccepted,
 		PayoutDocumentId: m.PayoutDocumentId,
+		Version:          m.Version,
+		DisputeId:        m.DisputeId,
 	}
 
 	if m.PayoutDate != nil {
@@ -3677,12 +5506,14 @@ func (m *RoyaltyReport) SetBSON(raw bson.Raw) error {
 	m.Id = decoded.Id.Hex()
 	m.MerchantId = decoded.MerchantId.Hex()
 	m.Status = decoded.Status
-	m.Totals = decoded.Totals
+	m.Totals = getRoyaltyReportTotals(decoded.Totals, decoded.Currency)
 	m.Currency = decoded.Currency
 	m.Summary = decoded.Summary
 	m.DisputeReason = decoded.DisputeReason
 	m.IsAutoAccepted = decoded.IsAutoAccepted
 	m.PayoutDocumentId = decoded.PayoutDocumentId
+	m.Version = decoded.Version
+	m.DisputeId = decoded.DisputeId
 
 	m.PayoutDate, err = ptypes.TimestampProto(decoded.PayoutDate)
 	if err != nil {
@@ -3732,6 +5563,181 @@ func (m *RoyaltyReport) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
+func (m *RoyaltyReportDispute) GetBSON() (interface{}, error) {
+	st := &MgoRoyaltyReportDispute{
+		Id:              bson.ObjectIdHex(m.Id),
+		RoyaltyReportId: bson.ObjectIdHex(m.RoyaltyReportId),
+		MerchantId:      bson.ObjectIdHex(m.MerchantId),
+		ReportVersion:   m.ReportVersion,
+		Status:          m.Status,
+		Reason:          m.Reason,
+		Items:           m.Items,
+		Resolution:      m.Resolution,
+	}
+
+	for _, v := range m.Corrections {
+		correction := &MgoRoyaltyReportDisputeCorrection{
+			AccountingEntryId: bson.ObjectIdHex(v.AccountingEntryId),
+			ItemId:            v.ItemId,
+			Type:              v.Type,
+			Amount:            v.Amount,
+			Currency:          v.Currency,
+			Reason:            v.Reason,
+		}
+
+		if v.CreatedAt != nil {
+			t, err := ptypes.Timestamp(v.CreatedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			correction.CreatedAt = t
+		} else {
+			correction.CreatedAt = time.Now()
+		}
+
+		st.Corrections = append(st.Corrections, correction)
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	if m.ResolvedAt != nil {
+		t, err := ptypes.Timestamp(m.ResolvedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ResolvedAt = t
+	}
+
+	return st, nil
+}
+
+func (m *RoyaltyReportDispute) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoRoyaltyReportDispute)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.RoyaltyReportId = decoded.RoyaltyReportId.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ReportVersion = decoded.ReportVersion
+	m.Status = decoded.Status
+	m.Reason = decoded.Reason
+	m.Items = decoded.Items
+	m.Resolution = decoded.Resolution
+
+	for _, v := range decoded.Corrections {
+		correction := &RoyaltyReportDisputeCorrection{
+			AccountingEntryId: v.AccountingEntryId.Hex(),
+			ItemId:            v.ItemId,
+			Type:              v.Type,
+			Amount:            v.Amount,
+			Currency:          v.Currency,
+			Reason:            v.Reason,
+		}
+
+		correction.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		m.Corrections = append(m.Corrections, correction)
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if !decoded.ResolvedAt.IsZero() {
+		m.ResolvedAt, err = ptypes.TimestampProto(decoded.ResolvedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoyaltyReportVersion) GetBSON() (interface{}, error) {
+	st := &MgoRoyaltyReportVersion{
+		Id:              bson.ObjectIdHex(m.Id),
+		RoyaltyReportId: bson.ObjectIdHex(m.RoyaltyReportId),
+		Version:         m.Version,
+		DisputeId:       m.DisputeId,
+		Report:          m.Report,
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *RoyaltyReportVersion) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoRoyaltyReportVersion)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.RoyaltyReportId = decoded.RoyaltyReportId.Hex()
+	m.Version = decoded.Version
+	m.DisputeId = decoded.DisputeId
+	m.Report = decoded.Report
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RoyaltyReportChanges) GetBSON() (interface{}, error) {
 	st := &MgoRoyaltyReportChanges{
 		Id:              bson.ObjectIdHex(m.Id),
@@ -4033,13 +6039,92 @@ func getOrderProject(in *MgoOrderProject) *ProjectOrder {
 	return project
 }
 
+func getMgoPaymentMethodParams(in map[string]*PaymentMethodParams) []*MgoPaymentMethodParam {
+	var params []*MgoPaymentMethodParam
+
+	for key, value := range in {
+		params = append(params, &MgoPaymentMethodParam{
+			Currency:       key,
+			TerminalId:     value.TerminalId,
+			Secret:         value.Secret,
+			SecretCallback: value.SecretCallback,
+		})
+	}
+
+	return params
+}
+
+func getPaymentMethodParams(in []*MgoPaymentMethodParam) map[string]*PaymentMethodParams {
+	if in == nil {
+		return nil
+	}
+
+	params := make(map[string]*PaymentMethodParams, len(in))
+
+	for _, value := range in {
+		params[value.Currency] = &PaymentMethodParams{
+			Currency:       value.Currency,
+			TerminalId:     value.TerminalId,
+			Secret:         value.Secret,
+			SecretCallback: value.SecretCallback,
+		}
+	}
+
+	return params
+}
+
+// getMoneyAmount return amount stored in minor units of currency. Documents saved before amounts
+// were stored in minor units have amount in major units only, so it's used when minor units are empty
+func getMoneyAmount(minor int64, amount float64, currency string) float64 {
+	if minor == 0 && amount != 0 {
+		return money.Round(amount, currency)
+	}
+
+	return money.FromMinor(minor, currency)
+}
+
+func getMgoRoyaltyReportTotals(in *RoyaltyReportTotals, currency string) *MgoRoyaltyReportTotals {
+	if in == nil {
+		return nil
+	}
+
+	return &MgoRoyaltyReportTotals{
+		TransactionsCount:         in.TransactionsCount,
+		FeeAmount:                 money.Round(in.FeeAmount, currency),
+		FeeAmountMinor:            money.ToMinor(in.FeeAmount, currency),
+		VatAmount:                 money.Round(in.VatAmount, currency),
+		VatAmountMinor:            money.ToMinor(in.VatAmount, currency),
+		PayoutAmount:              money.Round(in.PayoutAmount, currency),
+		PayoutAmountMinor:         money.ToMinor(in.PayoutAmount, currency),
+		RollingReserveAmount:      money.Round(in.RollingReserveAmount, currency),
+		RollingReserveAmountMinor: money.ToMinor(in.RollingReserveAmount, currency),
+		CorrectionAmount:          money.Round(in.CorrectionAmount, currency),
+		CorrectionAmountMinor:     money.ToMinor(in.CorrectionAmount, currency),
+	}
+}
+
+func getRoyaltyReportTotals(in *MgoRoyaltyReportTotals, currency string) *RoyaltyReportTotals {
+	if in == nil {
+		return nil
+	}
+
+	return &RoyaltyReportTotals{
+		TransactionsCount:    in.TransactionsCount,
+		FeeAmount:            getMoneyAmount(in.FeeAmountMinor, in.FeeAmount, currency),
+		VatAmount:            getMoneyAmount(in.VatAmountMinor, in.VatAmount, currency),
+		PayoutAmount:         getMoneyAmount(in.PayoutAmountMinor, in.PayoutAmount, currency),
+		RollingReserveAmount: getMoneyAmount(in.RollingReserveAmountMinor, in.RollingReserveAmount, currency),
+		CorrectionAmount:     getMoneyAmount(in.CorrectionAmountMinor, in.CorrectionAmount, currency),
+	}
+}
+
 func getOrderViewMoney(in *OrderViewMoney) *OrderViewMoney {
 	if in == nil {
 		return &OrderViewMoney{}
 	}
 
 	return &OrderViewMoney{
-		Amount:   tools.ToPrecise(in.Amount),
+		Amount:   money.Round(in.Amount, in.Currency),
 		Currency: in.Currency,
 	}
 }
@@ -4065,6 +6150,7 @@ func getOrderViewItems(in []*MgoOrderItem) []*OrderItem {
 			Metadata:    v.Metadata,
 			Code:        v.Code,
 			PlatformId:  v.PlatformId,
+			Discount:    v.Discount,
 		}
 
 		item.CreatedAt, _ = ptypes.TimestampProto(v.CreatedAt)
@@ -4126,6 +6212,11 @@ func (k *Key) SetBSON(raw bson.Raw) error {
 	k.Code = decoded.Code
 	k.KeyProductId = decoded.KeyProductId.Hex()
 	k.PlatformId = decoded.PlatformId
+	k.CodeHash = decoded.CodeHash
+	k.EncryptedCode = decoded.EncryptedCode
+	k.EncryptedDataKey = decoded.EncryptedDataKey
+	k.MasterKeyId = decoded.MasterKeyId
+	k.Metadata = decoded.Metadata
 
 	if decoded.OrderId != nil {
 		k.OrderId = decoded.OrderId.Hex()
@@ -4143,15 +6234,26 @@ func (k *Key) SetBSON(raw bson.Raw) error {
 		return err
 	}
 
+	if decoded.RevokedAt != nil {
+		if k.RevokedAt, err = ptypes.TimestampProto(*decoded.RevokedAt); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (m *Key) GetBSON() (interface{}, error) {
 	st := &MgoKey{
-		Id:           bson.ObjectIdHex(m.Id),
-		PlatformId:   m.PlatformId,
-		KeyProductId: bson.ObjectIdHex(m.KeyProductId),
-		Code:         m.Code,
+		Id:               bson.ObjectIdHex(m.Id),
+		PlatformId:       m.PlatformId,
+		KeyProductId:     bson.ObjectIdHex(m.KeyProductId),
+		Code:             m.Code,
+		CodeHash:         m.CodeHash,
+		EncryptedCode:    m.EncryptedCode,
+		EncryptedDataKey: m.EncryptedDataKey,
+		MasterKeyId:      m.MasterKeyId,
+		Metadata:         m.Metadata,
 	}
 
 	var err error
@@ -4177,6 +6279,16 @@ func (m *Key) GetBSON() (interface{}, error) {
 		st.ReservedTo = time.Time{}
 	}
 
+	if m.RevokedAt != nil {
+		t, err := ptypes.Timestamp(m.RevokedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.RevokedAt = &t
+	}
+
 	if m.CreatedAt != nil {
 		if st.CreatedAt, err = ptypes.Timestamp(m.CreatedAt); err != nil {
 			return nil, err
@@ -4187,3 +6299,47 @@ func (m *Key) GetBSON() (interface{}, error) {
 
 	return st, nil
 }
+
+func (m *KeyAuditLog) GetBSON() (interface{}, error) {
+	st := &MgoKeyAuditLog{
+		Id:           bson.ObjectIdHex(m.Id),
+		KeyId:        bson.ObjectIdHex(m.KeyId),
+		KeyProductId: m.KeyProductId,
+		PlatformId:   m.PlatformId,
+		OrderId:      m.OrderId,
+		Action:       m.Action,
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *KeyAuditLog) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoKeyAuditLog)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.KeyId = decoded.KeyId.Hex()
+	m.KeyProductId = decoded.KeyProductId
+	m.PlatformId = decoded.PlatformId
+	m.OrderId = decoded.OrderId
+	m.Action = decoded.Action
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	return err
+}
@@ -25,6 +25,14 @@ func (m *Order) IsDeclined() bool {
 	return m.PrivateStatus == constant.OrderStatusPaymentSystemDeclined || m.PrivateStatus == constant.OrderStatusPaymentSystemCanceled
 }
 
+func (m *Order) IsAuthorized() bool {
+	return m.PrivateStatus == pkg.OrderStatusPaymentSystemAuthorized
+}
+
+func (m *Order) IsFraudCheck3dsRequired() bool {
+	return m.FraudCheck != nil && m.FraudCheck.Decision == pkg.FraudDecision3ds
+}
+
 func (m *Order) GetDeclineReason() string {
 	reason, ok := m.PaymentMethodTxnParams[pkg.TxnParamsFieldDeclineReason]
 
@@ -105,7 +113,7 @@ func (m *Order) GetPostalCode() string {
 func (m *Order) HasEndedStatus() bool {
 	return m.PrivateStatus == constant.OrderStatusPaymentSystemReject || m.PrivateStatus == constant.OrderStatusProjectComplete ||
 		m.PrivateStatus == constant.OrderStatusProjectReject || m.PrivateStatus == constant.OrderStatusRefund ||
-		m.PrivateStatus == constant.OrderStatusChargeback
+		m.PrivateStatus == constant.OrderStatusChargeback || m.PrivateStatus == pkg.OrderStatusPaymentSystemVoided
 }
 
 func (m *Order) RefundAllowed() bool {
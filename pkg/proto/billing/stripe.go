@@ -0,0 +1,91 @@
+package billing
+
+import "github.com/paysuper/paysuper-billing-server/pkg"
+
+var (
+	stripePaymentCallbackAllowedEvents = map[string]bool{
+		pkg.StripeEventTypePaymentIntentSucceeded:     true,
+		pkg.StripeEventTypePaymentIntentPaymentFailed: true,
+		pkg.StripeEventTypePaymentIntentCanceled:      true,
+		pkg.StripeEventTypePaymentIntentProcessing:    true,
+		pkg.StripeEventTypePaymentIntentCapturable:    true,
+	}
+
+	stripeRefundCallbackAllowedEvents = map[string]bool{
+		pkg.StripeEventTypeChargeRefundUpdated: true,
+		pkg.StripeEventTypeChargeRefunded:      true,
+	}
+)
+
+func (m *StripePaymentCallback) IsPaymentAllowedEvent() bool {
+	v, ok := stripePaymentCallbackAllowedEvents[m.Type]
+
+	return ok && v == true && m.GetPaymentIntent() != nil
+}
+
+func (m *StripeRefundCallback) IsRefundAllowedEvent() bool {
+	v, ok := stripeRefundCallbackAllowedEvents[m.Type]
+
+	return ok && v == true && m.GetRefund() != nil
+}
+
+func (m *StripePaymentCallback) GetPaymentIntent() *StripeCallbackPaymentIntent {
+	if m.Data == nil {
+		return nil
+	}
+
+	return m.Data.Object
+}
+
+func (m *StripeRefundCallback) GetRefund() *StripeCallbackRefund {
+	if m.Data == nil {
+		return nil
+	}
+
+	return m.Data.Object
+}
+
+func (m *StripePaymentCallback) GetCard() *StripeCallbackCard {
+	intent := m.GetPaymentIntent()
+
+	if intent == nil || intent.Charges == nil || len(intent.Charges.Data) <= 0 {
+		return nil
+	}
+
+	charge := intent.Charges.Data[len(intent.Charges.Data)-1]
+
+	if charge.PaymentMethodDetails == nil {
+		return nil
+	}
+
+	return charge.PaymentMethodDetails.Card
+}
+
+func (m *StripePaymentCallback) GetBankCardTxnParams() map[string]string {
+	params := make(map[string]string)
+	intent := m.GetPaymentIntent()
+
+	params[pkg.TxnParamsFieldBankCardToken] = intent.PaymentMethod
+
+	if card := m.GetCard(); card != nil {
+		params[pkg.TxnParamsFieldBankCardEmissionCountry] = card.Country
+	}
+
+	if intent.LastPaymentError != nil {
+		params[pkg.TxnParamsFieldDeclineCode] = intent.LastPaymentError.DeclineCode
+		params[pkg.TxnParamsFieldDeclineReason] = intent.LastPaymentError.Message
+
+		if params[pkg.TxnParamsFieldDeclineCode] == "" {
+			params[pkg.TxnParamsFieldDeclineCode] = intent.LastPaymentError.Code
+		}
+	}
+
+	return params
+}
+
+func (m *StripePaymentCallback) IsRecurring() bool {
+	intent := m.GetPaymentIntent()
+
+	return intent != nil && intent.SetupFutureUsage == pkg.StripeSetupFutureUsageOffSession &&
+		intent.PaymentMethod != ""
+}
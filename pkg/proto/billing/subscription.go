@@ -0,0 +1,52 @@
+package billing
+
+import (
+	"errors"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"time"
+)
+
+const (
+	subscriptionPlanPriceNotFound = "subscription plan hasn't price for price group"
+)
+
+// GetPriceInPriceGroup return plan price for price group. Price for group without
+// region stored with currency as region like products prices
+func (m *SubscriptionPlan) GetPriceInPriceGroup(group *PriceGroup) (float64, error) {
+	for _, price := range m.Prices {
+		if group.Region != "" && price.Region == group.Region {
+			return price.Amount, nil
+		}
+
+		if group.Region == "" && price.Region == group.Currency {
+			return price.Amount, nil
+		}
+	}
+
+	return 0, errors.New(subscriptionPlanPriceNotFound)
+}
+
+// AddInterval return time of billing period end which started at specified time
+func (m *SubscriptionPlan) AddInterval(t time.Time) time.Time {
+	count := int(m.IntervalCount)
+
+	if count <= 0 {
+		count = 1
+	}
+
+	switch m.IntervalUnit {
+	case pkg.SubscriptionIntervalDay:
+		return t.AddDate(0, 0, count)
+	case pkg.SubscriptionIntervalWeek:
+		return t.AddDate(0, 0, 7*count)
+	case pkg.SubscriptionIntervalYear:
+		return t.AddDate(count, 0, 0)
+	default:
+		return t.AddDate(0, count, 0)
+	}
+}
+
+func (m *Subscription) IsRenewable() bool {
+	return m.Status == pkg.SubscriptionStatusTrialing || m.Status == pkg.SubscriptionStatusActive ||
+		m.Status == pkg.SubscriptionStatusPastDue
+}
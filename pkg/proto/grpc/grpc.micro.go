@@ -20,6 +20,11 @@ It has these top-level messages:
 	PlatformKeyReserveResponse
 	PlatformKeyReserveRequest
 	PlatformKeysFileResponse
+	PlatformKeysFileLineResult
+	ManagePlatformKeysRequest
+	ManagePlatformKeysResponse
+	ExportPlatformKeysResponse
+	RotateKeysEncryptionKeyResponse
 	PlatformKeysFileRequest
 	GetPlatformKeyCountRequest
 	RemovePlatformRequest
@@ -133,6 +138,10 @@ It has these top-level messages:
 	GetRoyaltyReportResponse
 	ChangeRoyaltyReportRequest
 	MerchantReviewRoyaltyReportRequest
+	AddRoyaltyReportDisputeCorrectionRequest
+	ResolveRoyaltyReportDisputeRequest
+	RoyaltyReportDisputeResponse
+	ListRoyaltyReportVersionsResponse
 	PaymentChannelCostSystemListResponse
 	PaymentChannelCostSystemResponse
 	PaymentChannelCostMerchantListResponse
@@ -172,6 +181,10 @@ It has these top-level messages:
 	VatReportsResponse
 	ProcessVatReportsRequest
 	UpdateVatReportStatusRequest
+	CountryVatSettingsResponse
+	CountryVatSettingsHistoryResponse
+	VatOssReturnRequest
+	VatOssReturnResponse
 	GetMerchantOnboardingCompleteDataResponseItem
 	GetMerchantOnboardingCompleteDataResponse
 	GetMerchantAgreementSignUrlRequest
@@ -219,6 +232,11 @@ It has these top-level messages:
 	GetMerchantBalanceResponse
 	PayoutDocumentPdfUploadedRequest
 	PayoutDocumentPdfUploadedResponse
+	CreatePayoutBatchRequest
+	GetPayoutBatchRequest
+	PayoutBatchResponse
+	ImportPayoutStatusReportRequest
+	ImportPayoutStatusReportResponse
 	OrderReceiptRequest
 	OrderReceiptResponse
 	GetProductResponse
@@ -226,6 +244,8 @@ It has these top-level messages:
 	GetPriceGroupByRegionRequest
 	ChangeMerchantManualPayoutsRequest
 	ChangeMerchantManualPayoutsResponse
+	ChangeMerchantPayoutCurrenciesRequest
+	ChangeMerchantPayoutCurrenciesResponse
 	GetPaylinksRequest
 	PaylinksPaginate
 	GetPaylinksResponse
@@ -237,9 +257,53 @@ It has these top-level messages:
 	GetPaylinkStatCommonRequest
 	GetPaylinkStatCommonResponse
 	GetPaylinkStatCommonGroupResponse
+	CreatePaylinkTokenResponse
 	RoyaltyReportPdfUploadedRequest
 	RoyaltyReportPdfUploadedResponse
 	DeleteSavedCardRequest
+	OrderAuthorizationRequest
+	OrderAuthorizationResponse
+	SubscriptionPlanResponse
+	GetSubscriptionPlanRequest
+	ListSubscriptionPlansRequest
+	ListSubscriptionPlansResponse
+	CreateSubscriptionRequest
+	SubscriptionRequest
+	SubscriptionResponse
+	LedgerTrialBalanceRequest
+	LedgerTrialBalanceResponse
+	ImportReconciliationReportRequest
+	ReconciliationRunResponse
+	ListReconciliationRunsRequest
+	ListReconciliationRunsResponse
+	ListReconciliationLinesRequest
+	ListReconciliationLinesResponse
+	ResolveReconciliationLineRequest
+	ReconciliationLineResponse
+	CreateChargebackRequest
+	ChargebackRequest
+	ChargebackResponse
+	ListChargebacksRequest
+	ListChargebacksResponse
+	UploadChargebackEvidenceRequest
+	CloseChargebackRequest
+	FraudRuleRequest
+	FraudRuleResponse
+	ListFraudRulesRequest
+	ListFraudRulesResponse
+	PromoCodeResponse
+	ListPromoCodesRequest
+	ListPromoCodesResponse
+	PaymentFormApplyPromoCodeRequest
+	ListWebhookDeliveriesRequest
+	ListWebhookDeliveriesResponse
+	WebhookDeliveryRequest
+	WebhookDeliveryResponse
+	ExportOrdersRequest
+	ExportOrdersChunk
+	OrdersExportJob
+	OrdersExportJobRequest
+	OrdersExportJobResponse
 */
 package grpc
 
@@ -294,6 +358,7 @@ type BillingService interface {
 	GetMerchantTariffRates(ctx context.Context, in *GetMerchantTariffRatesRequest, opts ...client.CallOption) (*GetMerchantTariffRatesResponse, error)
 	SetMerchantTariffRates(ctx context.Context, in *SetMerchantTariffRatesRequest, opts ...client.CallOption) (*CheckProjectRequestSignatureResponse, error)
 	ChangeMerchantManualPayouts(ctx context.Context, in *ChangeMerchantManualPayoutsRequest, opts ...client.CallOption) (*ChangeMerchantManualPayoutsResponse, error)
+	ChangeMerchantPayoutCurrencies(ctx context.Context, in *ChangeMerchantPayoutCurrenciesRequest, opts ...client.CallOption) (*ChangeMerchantPayoutCurrenciesResponse, error)
 	CreateNotification(ctx context.Context, in *NotificationRequest, opts ...client.CallOption) (*CreateNotificationResponse, error)
 	GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...client.CallOption) (*billing.Notification, error)
 	ListNotifications(ctx context.Context, in *ListingNotificationRequest, opts ...client.CallOption) (*Notifications, error)
@@ -305,9 +370,33 @@ type BillingService interface {
 	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...client.CallOption) (*ListRefundsResponse, error)
 	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...client.CallOption) (*CreateRefundResponse, error)
 	ProcessRefundCallback(ctx context.Context, in *CallbackRequest, opts ...client.CallOption) (*PaymentNotifyResponse, error)
+	CaptureOrder(ctx context.Context, in *OrderAuthorizationRequest, opts ...client.CallOption) (*OrderAuthorizationResponse, error)
+	VoidOrder(ctx context.Context, in *OrderAuthorizationRequest, opts ...client.CallOption) (*OrderAuthorizationResponse, error)
+	CreateChargeback(ctx context.Context, in *CreateChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error)
+	GetChargeback(ctx context.Context, in *ChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error)
+	ListChargebacks(ctx context.Context, in *ListChargebacksRequest, opts ...client.CallOption) (*ListChargebacksResponse, error)
+	UploadChargebackEvidence(ctx context.Context, in *UploadChargebackEvidenceRequest, opts ...client.CallOption) (*ChargebackResponse, error)
+	SubmitChargebackRepresentment(ctx context.Context, in *ChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error)
+	CloseChargeback(ctx context.Context, in *CloseChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error)
+	CreateOrUpdateSubscriptionPlan(ctx context.Context, in *billing.SubscriptionPlan, opts ...client.CallOption) (*SubscriptionPlanResponse, error)
+	GetSubscriptionPlan(ctx context.Context, in *GetSubscriptionPlanRequest, opts ...client.CallOption) (*SubscriptionPlanResponse, error)
+	ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...client.CallOption) (*ListSubscriptionPlansResponse, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	GetSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	PauseSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	ResumeSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	CreateOrUpdateFraudRule(ctx context.Context, in *billing.FraudRule, opts ...client.CallOption) (*FraudRuleResponse, error)
+	ListFraudRules(ctx context.Context, in *ListFraudRulesRequest, opts ...client.CallOption) (*ListFraudRulesResponse, error)
+	DeleteFraudRule(ctx context.Context, in *FraudRuleRequest, opts ...client.CallOption) (*FraudRuleResponse, error)
+	CreateOrUpdatePromoCode(ctx context.Context, in *billing.PromoCode, opts ...client.CallOption) (*PromoCodeResponse, error)
+	ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...client.CallOption) (*ListPromoCodesResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...client.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ResendWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequest, opts ...client.CallOption) (*WebhookDeliveryResponse, error)
 	PaymentFormLanguageChanged(ctx context.Context, in *PaymentFormUserChangeLangRequest, opts ...client.CallOption) (*PaymentFormDataChangeResponse, error)
 	PaymentFormPaymentAccountChanged(ctx context.Context, in *PaymentFormUserChangePaymentAccountRequest, opts ...client.CallOption) (*PaymentFormDataChangeResponse, error)
 	ProcessBillingAddress(ctx context.Context, in *ProcessBillingAddressRequest, opts ...client.CallOption) (*ProcessBillingAddressResponse, error)
+	PaymentFormApplyPromoCode(ctx context.Context, in *PaymentFormApplyPromoCodeRequest, opts ...client.CallOption) (*ProcessBillingAddressResponse, error)
 	CreateOrUpdateProduct(ctx context.Context, in *Product, opts ...client.CallOption) (*Product, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...client.CallOption) (*ListProductsResponse, error)
 	GetProduct(ctx context.Context, in *RequestProduct, opts ...client.CallOption) (*GetProductResponse, error)
@@ -324,11 +413,17 @@ type BillingService interface {
 	GetCountriesList(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*billing.CountriesList, error)
 	GetCountry(ctx context.Context, in *billing.GetCountryRequest, opts ...client.CallOption) (*billing.Country, error)
 	UpdateCountry(ctx context.Context, in *billing.Country, opts ...client.CallOption) (*billing.Country, error)
+	ScheduleCountryVatSettings(ctx context.Context, in *billing.CountryVatSettings, opts ...client.CallOption) (*CountryVatSettingsResponse, error)
+	GetCountryVatSettingsHistory(ctx context.Context, in *billing.GetCountryRequest, opts ...client.CallOption) (*CountryVatSettingsHistoryResponse, error)
 	GetOrderPublic(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderPublicResponse, error)
 	GetOrderPrivate(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderPrivateResponse, error)
 	FindAllOrdersPublic(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersPublicResponse, error)
 	FindAllOrdersPrivate(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersPrivateResponse, error)
 	FindAllOrders(ctx context.Context, in *ListOrdersRequest, opts ...client.CallOption) (*ListOrdersResponse, error)
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...client.CallOption) (BillingService_ExportOrdersService, error)
+	CreateOrdersExportJob(ctx context.Context, in *ExportOrdersRequest, opts ...client.CallOption) (*OrdersExportJobResponse, error)
+	GetOrdersExportJob(ctx context.Context, in *OrdersExportJobRequest, opts ...client.CallOption) (*OrdersExportJobResponse, error)
+	DownloadOrdersExportJob(ctx context.Context, in *OrdersExportJobRequest, opts ...client.CallOption) (BillingService_DownloadOrdersExportJobService, error)
 	IsOrderCanBePaying(ctx context.Context, in *IsOrderCanBePayingRequest, opts ...client.CallOption) (*IsOrderCanBePayingResponse, error)
 	GetPriceGroup(ctx context.Context, in *billing.GetPriceGroupRequest, opts ...client.CallOption) (*billing.PriceGroup, error)
 	UpdatePriceGroup(ctx context.Context, in *billing.PriceGroup, opts ...client.CallOption) (*billing.PriceGroup, error)
@@ -366,6 +461,11 @@ type BillingService interface {
 	SetMoneyBackCostMerchant(ctx context.Context, in *billing.MoneyBackCostMerchant, opts ...client.CallOption) (*MoneyBackCostMerchantResponse, error)
 	DeleteMoneyBackCostMerchant(ctx context.Context, in *billing.PaymentCostDeleteRequest, opts ...client.CallOption) (*ResponseError, error)
 	CreateAccountingEntry(ctx context.Context, in *CreateAccountingEntryRequest, opts ...client.CallOption) (*CreateAccountingEntryResponse, error)
+	GetLedgerTrialBalance(ctx context.Context, in *LedgerTrialBalanceRequest, opts ...client.CallOption) (*LedgerTrialBalanceResponse, error)
+	ImportReconciliationReport(ctx context.Context, in *ImportReconciliationReportRequest, opts ...client.CallOption) (*ReconciliationRunResponse, error)
+	ListReconciliationRuns(ctx context.Context, in *ListReconciliationRunsRequest, opts ...client.CallOption) (*ListReconciliationRunsResponse, error)
+	ListReconciliationLines(ctx context.Context, in *ListReconciliationLinesRequest, opts ...client.CallOption) (*ListReconciliationLinesResponse, error)
+	ResolveReconciliationLine(ctx context.Context, in *ResolveReconciliationLineRequest, opts ...client.CallOption) (*ReconciliationLineResponse, error)
 	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...client.CallOption) (*GetUserProfileResponse, error)
 	CreateOrUpdateUserProfile(ctx context.Context, in *UserProfile, opts ...client.CallOption) (*GetUserProfileResponse, error)
 	ConfirmUserEmail(ctx context.Context, in *ConfirmUserEmailRequest, opts ...client.CallOption) (*CheckProjectRequestSignatureResponse, error)
@@ -378,11 +478,17 @@ type BillingService interface {
 	MerchantReviewRoyaltyReport(ctx context.Context, in *MerchantReviewRoyaltyReportRequest, opts ...client.CallOption) (*ResponseError, error)
 	AutoAcceptRoyaltyReports(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyResponse, error)
 	RoyaltyReportPdfUploaded(ctx context.Context, in *RoyaltyReportPdfUploadedRequest, opts ...client.CallOption) (*RoyaltyReportPdfUploadedResponse, error)
+	GetRoyaltyReportDispute(ctx context.Context, in *GetRoyaltyReportRequest, opts ...client.CallOption) (*RoyaltyReportDisputeResponse, error)
+	AddRoyaltyReportDisputeCorrection(ctx context.Context, in *AddRoyaltyReportDisputeCorrectionRequest, opts ...client.CallOption) (*RoyaltyReportDisputeResponse, error)
+	ResolveRoyaltyReportDispute(ctx context.Context, in *ResolveRoyaltyReportDisputeRequest, opts ...client.CallOption) (*RoyaltyReportDisputeResponse, error)
+	ListRoyaltyReportVersions(ctx context.Context, in *GetRoyaltyReportRequest, opts ...client.CallOption) (*ListRoyaltyReportVersionsResponse, error)
 	GetVatReportsDashboard(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*VatReportsResponse, error)
 	GetVatReportsForCountry(ctx context.Context, in *VatReportsRequest, opts ...client.CallOption) (*VatReportsResponse, error)
 	GetVatReportTransactions(ctx context.Context, in *VatTransactionsRequest, opts ...client.CallOption) (*TransactionsResponse, error)
 	ProcessVatReports(ctx context.Context, in *ProcessVatReportsRequest, opts ...client.CallOption) (*EmptyResponse, error)
 	UpdateVatReportStatus(ctx context.Context, in *UpdateVatReportStatusRequest, opts ...client.CallOption) (*ResponseError, error)
+	CreateVatOssReturn(ctx context.Context, in *VatOssReturnRequest, opts ...client.CallOption) (*VatOssReturnResponse, error)
+	GetVatOssReturn(ctx context.Context, in *VatOssReturnRequest, opts ...client.CallOption) (*VatOssReturnResponse, error)
 	CalcAnnualTurnovers(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyResponse, error)
 	GetMerchantAgreementSignUrl(ctx context.Context, in *GetMerchantAgreementSignUrlRequest, opts ...client.CallOption) (*GetMerchantAgreementSignUrlResponse, error)
 	GetMerchantOnboardingCompleteData(ctx context.Context, in *SetMerchantS3AgreementRequest, opts ...client.CallOption) (*GetMerchantOnboardingCompleteDataResponse, error)
@@ -401,6 +507,10 @@ type BillingService interface {
 	ReserveKeyForOrder(ctx context.Context, in *PlatformKeyReserveRequest, opts ...client.CallOption) (*PlatformKeyReserveResponse, error)
 	FinishRedeemKeyForOrder(ctx context.Context, in *KeyForOrderRequest, opts ...client.CallOption) (*GetKeyForOrderRequestResponse, error)
 	CancelRedeemKeyForOrder(ctx context.Context, in *KeyForOrderRequest, opts ...client.CallOption) (*EmptyResponseWithStatus, error)
+	RotateKeysEncryptionKey(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*RotateKeysEncryptionKeyResponse, error)
+	RevokePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, opts ...client.CallOption) (*ManagePlatformKeysResponse, error)
+	DeletePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, opts ...client.CallOption) (*ManagePlatformKeysResponse, error)
+	ExportPlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, opts ...client.CallOption) (*ExportPlatformKeysResponse, error)
 	ChangeCodeInOrder(ctx context.Context, in *ChangeCodeInOrderRequest, opts ...client.CallOption) (*ChangeCodeInOrderResponse, error)
 	GetDashboardMainReport(ctx context.Context, in *GetDashboardMainRequest, opts ...client.CallOption) (*GetDashboardMainResponse, error)
 	GetDashboardRevenueDynamicsReport(ctx context.Context, in *GetDashboardMainRequest, opts ...client.CallOption) (*GetDashboardRevenueDynamicsReportResponse, error)
@@ -412,6 +522,9 @@ type BillingService interface {
 	GetPayoutDocumentRoyaltyReports(ctx context.Context, in *GetPayoutDocumentRequest, opts ...client.CallOption) (*ListRoyaltyReportsResponse, error)
 	PayoutDocumentPdfUploaded(ctx context.Context, in *PayoutDocumentPdfUploadedRequest, opts ...client.CallOption) (*PayoutDocumentPdfUploadedResponse, error)
 	AutoCreatePayoutDocuments(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyResponse, error)
+	CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...client.CallOption) (*PayoutBatchResponse, error)
+	GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, opts ...client.CallOption) (*PayoutBatchResponse, error)
+	ImportPayoutStatusReport(ctx context.Context, in *ImportPayoutStatusReportRequest, opts ...client.CallOption) (*ImportPayoutStatusReportResponse, error)
 	GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, opts ...client.CallOption) (*GetMerchantBalanceResponse, error)
 	PaymentFormPlatformChanged(ctx context.Context, in *PaymentFormUserChangePlatformRequest, opts ...client.CallOption) (*EmptyResponseWithStatus, error)
 	CheckSkuAndKeyProject(ctx context.Context, in *CheckSkuAndKeyProjectRequest, opts ...client.CallOption) (*EmptyResponseWithStatus, error)
@@ -426,6 +539,7 @@ type BillingService interface {
 	GetPaylinkStatByReferrer(ctx context.Context, in *GetPaylinkStatCommonRequest, opts ...client.CallOption) (*GetPaylinkStatCommonGroupResponse, error)
 	GetPaylinkStatByDate(ctx context.Context, in *GetPaylinkStatCommonRequest, opts ...client.CallOption) (*GetPaylinkStatCommonGroupResponse, error)
 	GetPaylinkStatByUtm(ctx context.Context, in *GetPaylinkStatCommonRequest, opts ...client.CallOption) (*GetPaylinkStatCommonGroupResponse, error)
+	CreatePaylinkToken(ctx context.Context, in *paylink.CreatePaylinkTokenRequest, opts ...client.CallOption) (*CreatePaylinkTokenResponse, error)
 	DeleteSavedCard(ctx context.Context, in *DeleteSavedCardRequest, opts ...client.CallOption) (*EmptyResponseWithStatus, error)
 }
 
@@ -617,6 +731,16 @@ func (c *billingService) ChangeMerchantManualPayouts(ctx context.Context, in *Ch
 	return out, nil
 }
 
+func (c *billingService) ChangeMerchantPayoutCurrencies(ctx context.Context, in *ChangeMerchantPayoutCurrenciesRequest, opts ...client.CallOption) (*ChangeMerchantPayoutCurrenciesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ChangeMerchantPayoutCurrencies", in)
+	out := new(ChangeMerchantPayoutCurrenciesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) CreateNotification(ctx context.Context, in *NotificationRequest, opts ...client.CallOption) (*CreateNotificationResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.CreateNotification", in)
 	out := new(CreateNotificationResponse)
@@ -727,6 +851,236 @@ func (c *billingService) ProcessRefundCallback(ctx context.Context, in *Callback
 	return out, nil
 }
 
+func (c *billingService) CaptureOrder(ctx context.Context, in *OrderAuthorizationRequest, opts ...client.CallOption) (*OrderAuthorizationResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CaptureOrder", in)
+	out := new(OrderAuthorizationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) VoidOrder(ctx context.Context, in *OrderAuthorizationRequest, opts ...client.CallOption) (*OrderAuthorizationResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.VoidOrder", in)
+	out := new(OrderAuthorizationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CreateChargeback(ctx context.Context, in *CreateChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateChargeback", in)
+	out := new(ChargebackResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetChargeback(ctx context.Context, in *ChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetChargeback", in)
+	out := new(ChargebackResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListChargebacks(ctx context.Context, in *ListChargebacksRequest, opts ...client.CallOption) (*ListChargebacksResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListChargebacks", in)
+	out := new(ListChargebacksResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) UploadChargebackEvidence(ctx context.Context, in *UploadChargebackEvidenceRequest, opts ...client.CallOption) (*ChargebackResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.UploadChargebackEvidence", in)
+	out := new(ChargebackResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) SubmitChargebackRepresentment(ctx context.Context, in *ChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.SubmitChargebackRepresentment", in)
+	out := new(ChargebackResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CloseChargeback(ctx context.Context, in *CloseChargebackRequest, opts ...client.CallOption) (*ChargebackResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CloseChargeback", in)
+	out := new(ChargebackResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CreateOrUpdateSubscriptionPlan(ctx context.Context, in *billing.SubscriptionPlan, opts ...client.CallOption) (*SubscriptionPlanResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateOrUpdateSubscriptionPlan", in)
+	out := new(SubscriptionPlanResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetSubscriptionPlan(ctx context.Context, in *GetSubscriptionPlanRequest, opts ...client.CallOption) (*SubscriptionPlanResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetSubscriptionPlan", in)
+	out := new(SubscriptionPlanResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...client.CallOption) (*ListSubscriptionPlansResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListSubscriptionPlans", in)
+	out := new(ListSubscriptionPlansResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) PauseSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.PauseSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ResumeSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ResumeSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CancelSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CancelSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CreateOrUpdateFraudRule(ctx context.Context, in *billing.FraudRule, opts ...client.CallOption) (*FraudRuleResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateOrUpdateFraudRule", in)
+	out := new(FraudRuleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListFraudRules(ctx context.Context, in *ListFraudRulesRequest, opts ...client.CallOption) (*ListFraudRulesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListFraudRules", in)
+	out := new(ListFraudRulesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) DeleteFraudRule(ctx context.Context, in *FraudRuleRequest, opts ...client.CallOption) (*FraudRuleResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.DeleteFraudRule", in)
+	out := new(FraudRuleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CreateOrUpdatePromoCode(ctx context.Context, in *billing.PromoCode, opts ...client.CallOption) (*PromoCodeResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateOrUpdatePromoCode", in)
+	out := new(PromoCodeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...client.CallOption) (*ListPromoCodesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListPromoCodes", in)
+	out := new(ListPromoCodesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...client.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListWebhookDeliveries", in)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ResendWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequest, opts ...client.CallOption) (*WebhookDeliveryResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ResendWebhookDelivery", in)
+	out := new(WebhookDeliveryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) PaymentFormLanguageChanged(ctx context.Context, in *PaymentFormUserChangeLangRequest, opts ...client.CallOption) (*PaymentFormDataChangeResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.PaymentFormLanguageChanged", in)
 	out := new(PaymentFormDataChangeResponse)
@@ -757,6 +1111,16 @@ func (c *billingService) ProcessBillingAddress(ctx context.Context, in *ProcessB
 	return out, nil
 }
 
+func (c *billingService) PaymentFormApplyPromoCode(ctx context.Context, in *PaymentFormApplyPromoCodeRequest, opts ...client.CallOption) (*ProcessBillingAddressResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.PaymentFormApplyPromoCode", in)
+	out := new(ProcessBillingAddressResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) CreateOrUpdateProduct(ctx context.Context, in *Product, opts ...client.CallOption) (*Product, error) {
 	req := c.c.NewRequest(c.name, "BillingService.CreateOrUpdateProduct", in)
 	out := new(Product)
@@ -917,6 +1281,26 @@ func (c *billingService) UpdateCountry(ctx context.Context, in *billing.Country,
 	return out, nil
 }
 
+func (c *billingService) ScheduleCountryVatSettings(ctx context.Context, in *billing.CountryVatSettings, opts ...client.CallOption) (*CountryVatSettingsResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ScheduleCountryVatSettings", in)
+	out := new(CountryVatSettingsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetCountryVatSettingsHistory(ctx context.Context, in *billing.GetCountryRequest, opts ...client.CallOption) (*CountryVatSettingsHistoryResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetCountryVatSettingsHistory", in)
+	out := new(CountryVatSettingsHistoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) GetOrderPublic(ctx context.Context, in *GetOrderRequest, opts ...client.CallOption) (*GetOrderPublicResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.GetOrderPublic", in)
 	out := new(GetOrderPublicResponse)
@@ -967,6 +1351,114 @@ func (c *billingService) FindAllOrders(ctx context.Context, in *ListOrdersReques
 	return out, nil
 }
 
+func (c *billingService) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...client.CallOption) (BillingService_ExportOrdersService, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ExportOrders", &ExportOrdersRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &billingServiceExportOrders{stream}, nil
+}
+
+type BillingService_ExportOrdersService interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*ExportOrdersChunk, error)
+}
+
+type billingServiceExportOrders struct {
+	stream client.Stream
+}
+
+func (x *billingServiceExportOrders) Close() error {
+	return x.stream.Close()
+}
+
+func (x *billingServiceExportOrders) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *billingServiceExportOrders) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *billingServiceExportOrders) Recv() (*ExportOrdersChunk, error) {
+	m := new(ExportOrdersChunk)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *billingService) CreateOrdersExportJob(ctx context.Context, in *ExportOrdersRequest, opts ...client.CallOption) (*OrdersExportJobResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateOrdersExportJob", in)
+	out := new(OrdersExportJobResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetOrdersExportJob(ctx context.Context, in *OrdersExportJobRequest, opts ...client.CallOption) (*OrdersExportJobResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetOrdersExportJob", in)
+	out := new(OrdersExportJobResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) DownloadOrdersExportJob(ctx context.Context, in *OrdersExportJobRequest, opts ...client.CallOption) (BillingService_DownloadOrdersExportJobService, error) {
+	req := c.c.NewRequest(c.name, "BillingService.DownloadOrdersExportJob", &OrdersExportJobRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &billingServiceDownloadOrdersExportJob{stream}, nil
+}
+
+type BillingService_DownloadOrdersExportJobService interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*ExportOrdersChunk, error)
+}
+
+type billingServiceDownloadOrdersExportJob struct {
+	stream client.Stream
+}
+
+func (x *billingServiceDownloadOrdersExportJob) Close() error {
+	return x.stream.Close()
+}
+
+func (x *billingServiceDownloadOrdersExportJob) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *billingServiceDownloadOrdersExportJob) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *billingServiceDownloadOrdersExportJob) Recv() (*ExportOrdersChunk, error) {
+	m := new(ExportOrdersChunk)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *billingService) IsOrderCanBePaying(ctx context.Context, in *IsOrderCanBePayingRequest, opts ...client.CallOption) (*IsOrderCanBePayingResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.IsOrderCanBePaying", in)
 	out := new(IsOrderCanBePayingResponse)
@@ -1337,6 +1829,56 @@ func (c *billingService) CreateAccountingEntry(ctx context.Context, in *CreateAc
 	return out, nil
 }
 
+func (c *billingService) GetLedgerTrialBalance(ctx context.Context, in *LedgerTrialBalanceRequest, opts ...client.CallOption) (*LedgerTrialBalanceResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetLedgerTrialBalance", in)
+	out := new(LedgerTrialBalanceResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ImportReconciliationReport(ctx context.Context, in *ImportReconciliationReportRequest, opts ...client.CallOption) (*ReconciliationRunResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ImportReconciliationReport", in)
+	out := new(ReconciliationRunResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListReconciliationRuns(ctx context.Context, in *ListReconciliationRunsRequest, opts ...client.CallOption) (*ListReconciliationRunsResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListReconciliationRuns", in)
+	out := new(ListReconciliationRunsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListReconciliationLines(ctx context.Context, in *ListReconciliationLinesRequest, opts ...client.CallOption) (*ListReconciliationLinesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListReconciliationLines", in)
+	out := new(ListReconciliationLinesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ResolveReconciliationLine(ctx context.Context, in *ResolveReconciliationLineRequest, opts ...client.CallOption) (*ReconciliationLineResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ResolveReconciliationLine", in)
+	out := new(ReconciliationLineResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...client.CallOption) (*GetUserProfileResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.GetUserProfile", in)
 	out := new(GetUserProfileResponse)
@@ -1457,6 +1999,46 @@ func (c *billingService) RoyaltyReportPdfUploaded(ctx context.Context, in *Royal
 	return out, nil
 }
 
+func (c *billingService) GetRoyaltyReportDispute(ctx context.Context, in *GetRoyaltyReportRequest, opts ...client.CallOption) (*RoyaltyReportDisputeResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetRoyaltyReportDispute", in)
+	out := new(RoyaltyReportDisputeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) AddRoyaltyReportDisputeCorrection(ctx context.Context, in *AddRoyaltyReportDisputeCorrectionRequest, opts ...client.CallOption) (*RoyaltyReportDisputeResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.AddRoyaltyReportDisputeCorrection", in)
+	out := new(RoyaltyReportDisputeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ResolveRoyaltyReportDispute(ctx context.Context, in *ResolveRoyaltyReportDisputeRequest, opts ...client.CallOption) (*RoyaltyReportDisputeResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ResolveRoyaltyReportDispute", in)
+	out := new(RoyaltyReportDisputeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListRoyaltyReportVersions(ctx context.Context, in *GetRoyaltyReportRequest, opts ...client.CallOption) (*ListRoyaltyReportVersionsResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListRoyaltyReportVersions", in)
+	out := new(ListRoyaltyReportVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) GetVatReportsDashboard(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*VatReportsResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.GetVatReportsDashboard", in)
 	out := new(VatReportsResponse)
@@ -1507,6 +2089,26 @@ func (c *billingService) UpdateVatReportStatus(ctx context.Context, in *UpdateVa
 	return out, nil
 }
 
+func (c *billingService) CreateVatOssReturn(ctx context.Context, in *VatOssReturnRequest, opts ...client.CallOption) (*VatOssReturnResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateVatOssReturn", in)
+	out := new(VatOssReturnResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetVatOssReturn(ctx context.Context, in *VatOssReturnRequest, opts ...client.CallOption) (*VatOssReturnResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetVatOssReturn", in)
+	out := new(VatOssReturnResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) CalcAnnualTurnovers(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.CalcAnnualTurnovers", in)
 	out := new(EmptyResponse)
@@ -1687,6 +2289,46 @@ func (c *billingService) CancelRedeemKeyForOrder(ctx context.Context, in *KeyFor
 	return out, nil
 }
 
+func (c *billingService) RotateKeysEncryptionKey(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*RotateKeysEncryptionKeyResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.RotateKeysEncryptionKey", in)
+	out := new(RotateKeysEncryptionKeyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) RevokePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, opts ...client.CallOption) (*ManagePlatformKeysResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.RevokePlatformKeys", in)
+	out := new(ManagePlatformKeysResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) DeletePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, opts ...client.CallOption) (*ManagePlatformKeysResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.DeletePlatformKeys", in)
+	out := new(ManagePlatformKeysResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ExportPlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, opts ...client.CallOption) (*ExportPlatformKeysResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ExportPlatformKeys", in)
+	out := new(ExportPlatformKeysResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) ChangeCodeInOrder(ctx context.Context, in *ChangeCodeInOrderRequest, opts ...client.CallOption) (*ChangeCodeInOrderResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.ChangeCodeInOrder", in)
 	out := new(ChangeCodeInOrderResponse)
@@ -1797,6 +2439,36 @@ func (c *billingService) AutoCreatePayoutDocuments(ctx context.Context, in *Empt
 	return out, nil
 }
 
+func (c *billingService) CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...client.CallOption) (*PayoutBatchResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreatePayoutBatch", in)
+	out := new(PayoutBatchResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, opts ...client.CallOption) (*PayoutBatchResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetPayoutBatch", in)
+	out := new(PayoutBatchResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ImportPayoutStatusReport(ctx context.Context, in *ImportPayoutStatusReportRequest, opts ...client.CallOption) (*ImportPayoutStatusReportResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ImportPayoutStatusReport", in)
+	out := new(ImportPayoutStatusReportResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, opts ...client.CallOption) (*GetMerchantBalanceResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.GetMerchantBalance", in)
 	out := new(GetMerchantBalanceResponse)
@@ -1937,6 +2609,16 @@ func (c *billingService) GetPaylinkStatByUtm(ctx context.Context, in *GetPaylink
 	return out, nil
 }
 
+func (c *billingService) CreatePaylinkToken(ctx context.Context, in *paylink.CreatePaylinkTokenRequest, opts ...client.CallOption) (*CreatePaylinkTokenResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreatePaylinkToken", in)
+	out := new(CreatePaylinkTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) DeleteSavedCard(ctx context.Context, in *DeleteSavedCardRequest, opts ...client.CallOption) (*EmptyResponseWithStatus, error) {
 	req := c.c.NewRequest(c.name, "BillingService.DeleteSavedCard", in)
 	out := new(EmptyResponseWithStatus)
@@ -1967,6 +2649,7 @@ type BillingServiceHandler interface {
 	GetMerchantTariffRates(context.Context, *GetMerchantTariffRatesRequest, *GetMerchantTariffRatesResponse) error
 	SetMerchantTariffRates(context.Context, *SetMerchantTariffRatesRequest, *CheckProjectRequestSignatureResponse) error
 	ChangeMerchantManualPayouts(context.Context, *ChangeMerchantManualPayoutsRequest, *ChangeMerchantManualPayoutsResponse) error
+	ChangeMerchantPayoutCurrencies(context.Context, *ChangeMerchantPayoutCurrenciesRequest, *ChangeMerchantPayoutCurrenciesResponse) error
 	CreateNotification(context.Context, *NotificationRequest, *CreateNotificationResponse) error
 	GetNotification(context.Context, *GetNotificationRequest, *billing.Notification) error
 	ListNotifications(context.Context, *ListingNotificationRequest, *Notifications) error
@@ -1978,9 +2661,33 @@ type BillingServiceHandler interface {
 	ListRefunds(context.Context, *ListRefundsRequest, *ListRefundsResponse) error
 	GetRefund(context.Context, *GetRefundRequest, *CreateRefundResponse) error
 	ProcessRefundCallback(context.Context, *CallbackRequest, *PaymentNotifyResponse) error
+	CaptureOrder(context.Context, *OrderAuthorizationRequest, *OrderAuthorizationResponse) error
+	VoidOrder(context.Context, *OrderAuthorizationRequest, *OrderAuthorizationResponse) error
+	CreateChargeback(context.Context, *CreateChargebackRequest, *ChargebackResponse) error
+	GetChargeback(context.Context, *ChargebackRequest, *ChargebackResponse) error
+	ListChargebacks(context.Context, *ListChargebacksRequest, *ListChargebacksResponse) error
+	UploadChargebackEvidence(context.Context, *UploadChargebackEvidenceRequest, *ChargebackResponse) error
+	SubmitChargebackRepresentment(context.Context, *ChargebackRequest, *ChargebackResponse) error
+	CloseChargeback(context.Context, *CloseChargebackRequest, *ChargebackResponse) error
+	CreateOrUpdateSubscriptionPlan(context.Context, *billing.SubscriptionPlan, *SubscriptionPlanResponse) error
+	GetSubscriptionPlan(context.Context, *GetSubscriptionPlanRequest, *SubscriptionPlanResponse) error
+	ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest, *ListSubscriptionPlansResponse) error
+	CreateSubscription(context.Context, *CreateSubscriptionRequest, *SubscriptionResponse) error
+	GetSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	PauseSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	ResumeSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	CancelSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	CreateOrUpdateFraudRule(context.Context, *billing.FraudRule, *FraudRuleResponse) error
+	ListFraudRules(context.Context, *ListFraudRulesRequest, *ListFraudRulesResponse) error
+	DeleteFraudRule(context.Context, *FraudRuleRequest, *FraudRuleResponse) error
+	CreateOrUpdatePromoCode(context.Context, *billing.PromoCode, *PromoCodeResponse) error
+	ListPromoCodes(context.Context, *ListPromoCodesRequest, *ListPromoCodesResponse) error
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest, *ListWebhookDeliveriesResponse) error
+	ResendWebhookDelivery(context.Context, *WebhookDeliveryRequest, *WebhookDeliveryResponse) error
 	PaymentFormLanguageChanged(context.Context, *PaymentFormUserChangeLangRequest, *PaymentFormDataChangeResponse) error
 	PaymentFormPaymentAccountChanged(context.Context, *PaymentFormUserChangePaymentAccountRequest, *PaymentFormDataChangeResponse) error
 	ProcessBillingAddress(context.Context, *ProcessBillingAddressRequest, *ProcessBillingAddressResponse) error
+	PaymentFormApplyPromoCode(context.Context, *PaymentFormApplyPromoCodeRequest, *ProcessBillingAddressResponse) error
 	CreateOrUpdateProduct(context.Context, *Product, *Product) error
 	ListProducts(context.Context, *ListProductsRequest, *ListProductsResponse) error
 	GetProduct(context.Context, *RequestProduct, *GetProductResponse) error
@@ -1997,11 +2704,17 @@ type BillingServiceHandler interface {
 	GetCountriesList(context.Context, *EmptyRequest, *billing.CountriesList) error
 	GetCountry(context.Context, *billing.GetCountryRequest, *billing.Country) error
 	UpdateCountry(context.Context, *billing.Country, *billing.Country) error
+	ScheduleCountryVatSettings(context.Context, *billing.CountryVatSettings, *CountryVatSettingsResponse) error
+	GetCountryVatSettingsHistory(context.Context, *billing.GetCountryRequest, *CountryVatSettingsHistoryResponse) error
 	GetOrderPublic(context.Context, *GetOrderRequest, *GetOrderPublicResponse) error
 	GetOrderPrivate(context.Context, *GetOrderRequest, *GetOrderPrivateResponse) error
 	FindAllOrdersPublic(context.Context, *ListOrdersRequest, *ListOrdersPublicResponse) error
 	FindAllOrdersPrivate(context.Context, *ListOrdersRequest, *ListOrdersPrivateResponse) error
 	FindAllOrders(context.Context, *ListOrdersRequest, *ListOrdersResponse) error
+	ExportOrders(context.Context, *ExportOrdersRequest, BillingService_ExportOrdersStream) error
+	CreateOrdersExportJob(context.Context, *ExportOrdersRequest, *OrdersExportJobResponse) error
+	GetOrdersExportJob(context.Context, *OrdersExportJobRequest, *OrdersExportJobResponse) error
+	DownloadOrdersExportJob(context.Context, *OrdersExportJobRequest, BillingService_DownloadOrdersExportJobStream) error
 	IsOrderCanBePaying(context.Context, *IsOrderCanBePayingRequest, *IsOrderCanBePayingResponse) error
 	GetPriceGroup(context.Context, *billing.GetPriceGroupRequest, *billing.PriceGroup) error
 	UpdatePriceGroup(context.Context, *billing.PriceGroup, *billing.PriceGroup) error
@@ -2039,6 +2752,11 @@ type BillingServiceHandler interface {
 	SetMoneyBackCostMerchant(context.Context, *billing.MoneyBackCostMerchant, *MoneyBackCostMerchantResponse) error
 	DeleteMoneyBackCostMerchant(context.Context, *billing.PaymentCostDeleteRequest, *ResponseError) error
 	CreateAccountingEntry(context.Context, *CreateAccountingEntryRequest, *CreateAccountingEntryResponse) error
+	GetLedgerTrialBalance(context.Context, *LedgerTrialBalanceRequest, *LedgerTrialBalanceResponse) error
+	ImportReconciliationReport(context.Context, *ImportReconciliationReportRequest, *ReconciliationRunResponse) error
+	ListReconciliationRuns(context.Context, *ListReconciliationRunsRequest, *ListReconciliationRunsResponse) error
+	ListReconciliationLines(context.Context, *ListReconciliationLinesRequest, *ListReconciliationLinesResponse) error
+	ResolveReconciliationLine(context.Context, *ResolveReconciliationLineRequest, *ReconciliationLineResponse) error
 	GetUserProfile(context.Context, *GetUserProfileRequest, *GetUserProfileResponse) error
 	CreateOrUpdateUserProfile(context.Context, *UserProfile, *GetUserProfileResponse) error
 	ConfirmUserEmail(context.Context, *ConfirmUserEmailRequest, *CheckProjectRequestSignatureResponse) error
@@ -2051,11 +2769,17 @@ type BillingServiceHandler interface {
 	MerchantReviewRoyaltyReport(context.Context, *MerchantReviewRoyaltyReportRequest, *ResponseError) error
 	AutoAcceptRoyaltyReports(context.Context, *EmptyRequest, *EmptyResponse) error
 	RoyaltyReportPdfUploaded(context.Context, *RoyaltyReportPdfUploadedRequest, *RoyaltyReportPdfUploadedResponse) error
+	GetRoyaltyReportDispute(context.Context, *GetRoyaltyReportRequest, *RoyaltyReportDisputeResponse) error
+	AddRoyaltyReportDisputeCorrection(context.Context, *AddRoyaltyReportDisputeCorrectionRequest, *RoyaltyReportDisputeResponse) error
+	ResolveRoyaltyReportDispute(context.Context, *ResolveRoyaltyReportDisputeRequest, *RoyaltyReportDisputeResponse) error
+	ListRoyaltyReportVersions(context.Context, *GetRoyaltyReportRequest, *ListRoyaltyReportVersionsResponse) error
 	GetVatReportsDashboard(context.Context, *EmptyRequest, *VatReportsResponse) error
 	GetVatReportsForCountry(context.Context, *VatReportsRequest, *VatReportsResponse) error
 	GetVatReportTransactions(context.Context, *VatTransactionsRequest, *TransactionsResponse) error
 	ProcessVatReports(context.Context, *ProcessVatReportsRequest, *EmptyResponse) error
 	UpdateVatReportStatus(context.Context, *UpdateVatReportStatusRequest, *ResponseError) error
+	CreateVatOssReturn(context.Context, *VatOssReturnRequest, *VatOssReturnResponse) error
+	GetVatOssReturn(context.Context, *VatOssReturnRequest, *VatOssReturnResponse) error
 	CalcAnnualTurnovers(context.Context, *EmptyRequest, *EmptyResponse) error
 	GetMerchantAgreementSignUrl(context.Context, *GetMerchantAgreementSignUrlRequest, *GetMerchantAgreementSignUrlResponse) error
 	GetMerchantOnboardingCompleteData(context.Context, *SetMerchantS3AgreementRequest, *GetMerchantOnboardingCompleteDataResponse) error
@@ -2074,6 +2798,10 @@ type BillingServiceHandler interface {
 	ReserveKeyForOrder(context.Context, *PlatformKeyReserveRequest, *PlatformKeyReserveResponse) error
 	FinishRedeemKeyForOrder(context.Context, *KeyForOrderRequest, *GetKeyForOrderRequestResponse) error
 	CancelRedeemKeyForOrder(context.Context, *KeyForOrderRequest, *EmptyResponseWithStatus) error
+	RotateKeysEncryptionKey(context.Context, *EmptyRequest, *RotateKeysEncryptionKeyResponse) error
+	RevokePlatformKeys(context.Context, *ManagePlatformKeysRequest, *ManagePlatformKeysResponse) error
+	DeletePlatformKeys(context.Context, *ManagePlatformKeysRequest, *ManagePlatformKeysResponse) error
+	ExportPlatformKeys(context.Context, *ManagePlatformKeysRequest, *ExportPlatformKeysResponse) error
 	ChangeCodeInOrder(context.Context, *ChangeCodeInOrderRequest, *ChangeCodeInOrderResponse) error
 	GetDashboardMainReport(context.Context, *GetDashboardMainRequest, *GetDashboardMainResponse) error
 	GetDashboardRevenueDynamicsReport(context.Context, *GetDashboardMainRequest, *GetDashboardRevenueDynamicsReportResponse) error
@@ -2085,6 +2813,9 @@ type BillingServiceHandler interface {
 	GetPayoutDocumentRoyaltyReports(context.Context, *GetPayoutDocumentRequest, *ListRoyaltyReportsResponse) error
 	PayoutDocumentPdfUploaded(context.Context, *PayoutDocumentPdfUploadedRequest, *PayoutDocumentPdfUploadedResponse) error
 	AutoCreatePayoutDocuments(context.Context, *EmptyRequest, *EmptyResponse) error
+	CreatePayoutBatch(context.Context, *CreatePayoutBatchRequest, *PayoutBatchResponse) error
+	GetPayoutBatch(context.Context, *GetPayoutBatchRequest, *PayoutBatchResponse) error
+	ImportPayoutStatusReport(context.Context, *ImportPayoutStatusReportRequest, *ImportPayoutStatusReportResponse) error
 	GetMerchantBalance(context.Context, *GetMerchantBalanceRequest, *GetMerchantBalanceResponse) error
 	PaymentFormPlatformChanged(context.Context, *PaymentFormUserChangePlatformRequest, *EmptyResponseWithStatus) error
 	CheckSkuAndKeyProject(context.Context, *CheckSkuAndKeyProjectRequest, *EmptyResponseWithStatus) error
@@ -2099,6 +2830,7 @@ type BillingServiceHandler interface {
 	GetPaylinkStatByReferrer(context.Context, *GetPaylinkStatCommonRequest, *GetPaylinkStatCommonGroupResponse) error
 	GetPaylinkStatByDate(context.Context, *GetPaylinkStatCommonRequest, *GetPaylinkStatCommonGroupResponse) error
 	GetPaylinkStatByUtm(context.Context, *GetPaylinkStatCommonRequest, *GetPaylinkStatCommonGroupResponse) error
+	CreatePaylinkToken(context.Context, *paylink.CreatePaylinkTokenRequest, *CreatePaylinkTokenResponse) error
 	DeleteSavedCard(context.Context, *DeleteSavedCardRequest, *EmptyResponseWithStatus) error
 }
 
@@ -2121,6 +2853,7 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		GetMerchantTariffRates(ctx context.Context, in *GetMerchantTariffRatesRequest, out *GetMerchantTariffRatesResponse) error
 		SetMerchantTariffRates(ctx context.Context, in *SetMerchantTariffRatesRequest, out *CheckProjectRequestSignatureResponse) error
 		ChangeMerchantManualPayouts(ctx context.Context, in *ChangeMerchantManualPayoutsRequest, out *ChangeMerchantManualPayoutsResponse) error
+		ChangeMerchantPayoutCurrencies(ctx context.Context, in *ChangeMerchantPayoutCurrenciesRequest, out *ChangeMerchantPayoutCurrenciesResponse) error
 		CreateNotification(ctx context.Context, in *NotificationRequest, out *CreateNotificationResponse) error
 		GetNotification(ctx context.Context, in *GetNotificationRequest, out *billing.Notification) error
 		ListNotifications(ctx context.Context, in *ListingNotificationRequest, out *Notifications) error
@@ -2132,9 +2865,33 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		ListRefunds(ctx context.Context, in *ListRefundsRequest, out *ListRefundsResponse) error
 		GetRefund(ctx context.Context, in *GetRefundRequest, out *CreateRefundResponse) error
 		ProcessRefundCallback(ctx context.Context, in *CallbackRequest, out *PaymentNotifyResponse) error
+		CaptureOrder(ctx context.Context, in *OrderAuthorizationRequest, out *OrderAuthorizationResponse) error
+		VoidOrder(ctx context.Context, in *OrderAuthorizationRequest, out *OrderAuthorizationResponse) error
+		CreateChargeback(ctx context.Context, in *CreateChargebackRequest, out *ChargebackResponse) error
+		GetChargeback(ctx context.Context, in *ChargebackRequest, out *ChargebackResponse) error
+		ListChargebacks(ctx context.Context, in *ListChargebacksRequest, out *ListChargebacksResponse) error
+		UploadChargebackEvidence(ctx context.Context, in *UploadChargebackEvidenceRequest, out *ChargebackResponse) error
+		SubmitChargebackRepresentment(ctx context.Context, in *ChargebackRequest, out *ChargebackResponse) error
+		CloseChargeback(ctx context.Context, in *CloseChargebackRequest, out *ChargebackResponse) error
+		CreateOrUpdateSubscriptionPlan(ctx context.Context, in *billing.SubscriptionPlan, out *SubscriptionPlanResponse) error
+		GetSubscriptionPlan(ctx context.Context, in *GetSubscriptionPlanRequest, out *SubscriptionPlanResponse) error
+		ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, out *ListSubscriptionPlansResponse) error
+		CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, out *SubscriptionResponse) error
+		GetSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		PauseSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		ResumeSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		CancelSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		CreateOrUpdateFraudRule(ctx context.Context, in *billing.FraudRule, out *FraudRuleResponse) error
+		ListFraudRules(ctx context.Context, in *ListFraudRulesRequest, out *ListFraudRulesResponse) error
+		DeleteFraudRule(ctx context.Context, in *FraudRuleRequest, out *FraudRuleResponse) error
+		CreateOrUpdatePromoCode(ctx context.Context, in *billing.PromoCode, out *PromoCodeResponse) error
+		ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, out *ListPromoCodesResponse) error
+		ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, out *ListWebhookDeliveriesResponse) error
+		ResendWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequest, out *WebhookDeliveryResponse) error
 		PaymentFormLanguageChanged(ctx context.Context, in *PaymentFormUserChangeLangRequest, out *PaymentFormDataChangeResponse) error
 		PaymentFormPaymentAccountChanged(ctx context.Context, in *PaymentFormUserChangePaymentAccountRequest, out *PaymentFormDataChangeResponse) error
 		ProcessBillingAddress(ctx context.Context, in *ProcessBillingAddressRequest, out *ProcessBillingAddressResponse) error
+		PaymentFormApplyPromoCode(ctx context.Context, in *PaymentFormApplyPromoCodeRequest, out *ProcessBillingAddressResponse) error
 		CreateOrUpdateProduct(ctx context.Context, in *Product, out *Product) error
 		ListProducts(ctx context.Context, in *ListProductsRequest, out *ListProductsResponse) error
 		GetProduct(ctx context.Context, in *RequestProduct, out *GetProductResponse) error
@@ -2151,11 +2908,17 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		GetCountriesList(ctx context.Context, in *EmptyRequest, out *billing.CountriesList) error
 		GetCountry(ctx context.Context, in *billing.GetCountryRequest, out *billing.Country) error
 		UpdateCountry(ctx context.Context, in *billing.Country, out *billing.Country) error
+		ScheduleCountryVatSettings(ctx context.Context, in *billing.CountryVatSettings, out *CountryVatSettingsResponse) error
+		GetCountryVatSettingsHistory(ctx context.Context, in *billing.GetCountryRequest, out *CountryVatSettingsHistoryResponse) error
 		GetOrderPublic(ctx context.Context, in *GetOrderRequest, out *GetOrderPublicResponse) error
 		GetOrderPrivate(ctx context.Context, in *GetOrderRequest, out *GetOrderPrivateResponse) error
 		FindAllOrdersPublic(ctx context.Context, in *ListOrdersRequest, out *ListOrdersPublicResponse) error
 		FindAllOrdersPrivate(ctx context.Context, in *ListOrdersRequest, out *ListOrdersPrivateResponse) error
 		FindAllOrders(ctx context.Context, in *ListOrdersRequest, out *ListOrdersResponse) error
+		ExportOrders(ctx context.Context, stream server.Stream) error
+		CreateOrdersExportJob(ctx context.Context, in *ExportOrdersRequest, out *OrdersExportJobResponse) error
+		GetOrdersExportJob(ctx context.Context, in *OrdersExportJobRequest, out *OrdersExportJobResponse) error
+		DownloadOrdersExportJob(ctx context.Context, stream server.Stream) error
 		IsOrderCanBePaying(ctx context.Context, in *IsOrderCanBePayingRequest, out *IsOrderCanBePayingResponse) error
 		GetPriceGroup(ctx context.Context, in *billing.GetPriceGroupRequest, out *billing.PriceGroup) error
 		UpdatePriceGroup(ctx context.Context, in *billing.PriceGroup, out *billing.PriceGroup) error
@@ -2193,6 +2956,11 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		SetMoneyBackCostMerchant(ctx context.Context, in *billing.MoneyBackCostMerchant, out *MoneyBackCostMerchantResponse) error
 		DeleteMoneyBackCostMerchant(ctx context.Context, in *billing.PaymentCostDeleteRequest, out *ResponseError) error
 		CreateAccountingEntry(ctx context.Context, in *CreateAccountingEntryRequest, out *CreateAccountingEntryResponse) error
+		GetLedgerTrialBalance(ctx context.Context, in *LedgerTrialBalanceRequest, out *LedgerTrialBalanceResponse) error
+		ImportReconciliationReport(ctx context.Context, in *ImportReconciliationReportRequest, out *ReconciliationRunResponse) error
+		ListReconciliationRuns(ctx context.Context, in *ListReconciliationRunsRequest, out *ListReconciliationRunsResponse) error
+		ListReconciliationLines(ctx context.Context, in *ListReconciliationLinesRequest, out *ListReconciliationLinesResponse) error
+		ResolveReconciliationLine(ctx context.Context, in *ResolveReconciliationLineRequest, out *ReconciliationLineResponse) error
 		GetUserProfile(ctx context.Context, in *GetUserProfileRequest, out *GetUserProfileResponse) error
 		CreateOrUpdateUserProfile(ctx context.Context, in *UserProfile, out *GetUserProfileResponse) error
 		ConfirmUserEmail(ctx context.Context, in *ConfirmUserEmailRequest, out *CheckProjectRequestSignatureResponse) error
@@ -2205,11 +2973,17 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		MerchantReviewRoyaltyReport(ctx context.Context, in *MerchantReviewRoyaltyReportRequest, out *ResponseError) error
 		AutoAcceptRoyaltyReports(ctx context.Context, in *EmptyRequest, out *EmptyResponse) error
 		RoyaltyReportPdfUploaded(ctx context.Context, in *RoyaltyReportPdfUploadedRequest, out *RoyaltyReportPdfUploadedResponse) error
+		GetRoyaltyReportDispute(ctx context.Context, in *GetRoyaltyReportRequest, out *RoyaltyReportDisputeResponse) error
+		AddRoyaltyReportDisputeCorrection(ctx context.Context, in *AddRoyaltyReportDisputeCorrectionRequest, out *RoyaltyReportDisputeResponse) error
+		ResolveRoyaltyReportDispute(ctx context.Context, in *ResolveRoyaltyReportDisputeRequest, out *RoyaltyReportDisputeResponse) error
+		ListRoyaltyReportVersions(ctx context.Context, in *GetRoyaltyReportRequest, out *ListRoyaltyReportVersionsResponse) error
 		GetVatReportsDashboard(ctx context.Context, in *EmptyRequest, out *VatReportsResponse) error
 		GetVatReportsForCountry(ctx context.Context, in *VatReportsRequest, out *VatReportsResponse) error
 		GetVatReportTransactions(ctx context.Context, in *VatTransactionsRequest, out *TransactionsResponse) error
 		ProcessVatReports(ctx context.Context, in *ProcessVatReportsRequest, out *EmptyResponse) error
 		UpdateVatReportStatus(ctx context.Context, in *UpdateVatReportStatusRequest, out *ResponseError) error
+		CreateVatOssReturn(ctx context.Context, in *VatOssReturnRequest, out *VatOssReturnResponse) error
+		GetVatOssReturn(ctx context.Context, in *VatOssReturnRequest, out *VatOssReturnResponse) error
 		CalcAnnualTurnovers(ctx context.Context, in *EmptyRequest, out *EmptyResponse) error
 		GetMerchantAgreementSignUrl(ctx context.Context, in *GetMerchantAgreementSignUrlRequest, out *GetMerchantAgreementSignUrlResponse) error
 		GetMerchantOnboardingCompleteData(ctx context.Context, in *SetMerchantS3AgreementRequest, out *GetMerchantOnboardingCompleteDataResponse) error
@@ -2228,6 +3002,10 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		ReserveKeyForOrder(ctx context.Context, in *PlatformKeyReserveRequest, out *PlatformKeyReserveResponse) error
 		FinishRedeemKeyForOrder(ctx context.Context, in *KeyForOrderRequest, out *GetKeyForOrderRequestResponse) error
 		CancelRedeemKeyForOrder(ctx context.Context, in *KeyForOrderRequest, out *EmptyResponseWithStatus) error
+		RotateKeysEncryptionKey(ctx context.Context, in *EmptyRequest, out *RotateKeysEncryptionKeyResponse) error
+		RevokePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, out *ManagePlatformKeysResponse) error
+		DeletePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, out *ManagePlatformKeysResponse) error
+		ExportPlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, out *ExportPlatformKeysResponse) error
 		ChangeCodeInOrder(ctx context.Context, in *ChangeCodeInOrderRequest, out *ChangeCodeInOrderResponse) error
 		GetDashboardMainReport(ctx context.Context, in *GetDashboardMainRequest, out *GetDashboardMainResponse) error
 		GetDashboardRevenueDynamicsReport(ctx context.Context, in *GetDashboardMainRequest, out *GetDashboardRevenueDynamicsReportResponse) error
@@ -2239,6 +3017,9 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		GetPayoutDocumentRoyaltyReports(ctx context.Context, in *GetPayoutDocumentRequest, out *ListRoyaltyReportsResponse) error
 		PayoutDocumentPdfUploaded(ctx context.Context, in *PayoutDocumentPdfUploadedRequest, out *PayoutDocumentPdfUploadedResponse) error
 		AutoCreatePayoutDocuments(ctx context.Context, in *EmptyRequest, out *EmptyResponse) error
+		CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, out *PayoutBatchResponse) error
+		GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, out *PayoutBatchResponse) error
+		ImportPayoutStatusReport(ctx context.Context, in *ImportPayoutStatusReportRequest, out *ImportPayoutStatusReportResponse) error
 		GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, out *GetMerchantBalanceResponse) error
 		PaymentFormPlatformChanged(ctx context.Context, in *PaymentFormUserChangePlatformRequest, out *EmptyResponseWithStatus) error
 		CheckSkuAndKeyProject(ctx context.Context, in *CheckSkuAndKeyProjectRequest, out *EmptyResponseWithStatus) error
@@ -2253,6 +3034,7 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		GetPaylinkStatByReferrer(ctx context.Context, in *GetPaylinkStatCommonRequest, out *GetPaylinkStatCommonGroupResponse) error
 		GetPaylinkStatByDate(ctx context.Context, in *GetPaylinkStatCommonRequest, out *GetPaylinkStatCommonGroupResponse) error
 		GetPaylinkStatByUtm(ctx context.Context, in *GetPaylinkStatCommonRequest, out *GetPaylinkStatCommonGroupResponse) error
+		CreatePaylinkToken(ctx context.Context, in *paylink.CreatePaylinkTokenRequest, out *CreatePaylinkTokenResponse) error
 		DeleteSavedCard(ctx context.Context, in *DeleteSavedCardRequest, out *EmptyResponseWithStatus) error
 	}
 	type BillingService struct {
@@ -2334,6 +3116,10 @@ func (h *billingServiceHandler) ChangeMerchantManualPayouts(ctx context.Context,
 	return h.BillingServiceHandler.ChangeMerchantManualPayouts(ctx, in, out)
 }
 
+func (h *billingServiceHandler) ChangeMerchantPayoutCurrencies(ctx context.Context, in *ChangeMerchantPayoutCurrenciesRequest, out *ChangeMerchantPayoutCurrenciesResponse) error {
+	return h.BillingServiceHandler.ChangeMerchantPayoutCurrencies(ctx, in, out)
+}
+
 func (h *billingServiceHandler) CreateNotification(ctx context.Context, in *NotificationRequest, out *CreateNotificationResponse) error {
 	return h.BillingServiceHandler.CreateNotification(ctx, in, out)
 }
@@ -2378,6 +3164,98 @@ func (h *billingServiceHandler) ProcessRefundCallback(ctx context.Context, in *C
 	return h.BillingServiceHandler.ProcessRefundCallback(ctx, in, out)
 }
 
+func (h *billingServiceHandler) CaptureOrder(ctx context.Context, in *OrderAuthorizationRequest, out *OrderAuthorizationResponse) error {
+	return h.BillingServiceHandler.CaptureOrder(ctx, in, out)
+}
+
+func (h *billingServiceHandler) VoidOrder(ctx context.Context, in *OrderAuthorizationRequest, out *OrderAuthorizationResponse) error {
+	return h.BillingServiceHandler.VoidOrder(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CreateChargeback(ctx context.Context, in *CreateChargebackRequest, out *ChargebackResponse) error {
+	return h.BillingServiceHandler.CreateChargeback(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetChargeback(ctx context.Context, in *ChargebackRequest, out *ChargebackResponse) error {
+	return h.BillingServiceHandler.GetChargeback(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListChargebacks(ctx context.Context, in *ListChargebacksRequest, out *ListChargebacksResponse) error {
+	return h.BillingServiceHandler.ListChargebacks(ctx, in, out)
+}
+
+func (h *billingServiceHandler) UploadChargebackEvidence(ctx context.Context, in *UploadChargebackEvidenceRequest, out *ChargebackResponse) error {
+	return h.BillingServiceHandler.UploadChargebackEvidence(ctx, in, out)
+}
+
+func (h *billingServiceHandler) SubmitChargebackRepresentment(ctx context.Context, in *ChargebackRequest, out *ChargebackResponse) error {
+	return h.BillingServiceHandler.SubmitChargebackRepresentment(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CloseChargeback(ctx context.Context, in *CloseChargebackRequest, out *ChargebackResponse) error {
+	return h.BillingServiceHandler.CloseChargeback(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CreateOrUpdateSubscriptionPlan(ctx context.Context, in *billing.SubscriptionPlan, out *SubscriptionPlanResponse) error {
+	return h.BillingServiceHandler.CreateOrUpdateSubscriptionPlan(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetSubscriptionPlan(ctx context.Context, in *GetSubscriptionPlanRequest, out *SubscriptionPlanResponse) error {
+	return h.BillingServiceHandler.GetSubscriptionPlan(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, out *ListSubscriptionPlansResponse) error {
+	return h.BillingServiceHandler.ListSubscriptionPlans(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.CreateSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.GetSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) PauseSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.PauseSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ResumeSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.ResumeSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CancelSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.CancelSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CreateOrUpdateFraudRule(ctx context.Context, in *billing.FraudRule, out *FraudRuleResponse) error {
+	return h.BillingServiceHandler.CreateOrUpdateFraudRule(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListFraudRules(ctx context.Context, in *ListFraudRulesRequest, out *ListFraudRulesResponse) error {
+	return h.BillingServiceHandler.ListFraudRules(ctx, in, out)
+}
+
+func (h *billingServiceHandler) DeleteFraudRule(ctx context.Context, in *FraudRuleRequest, out *FraudRuleResponse) error {
+	return h.BillingServiceHandler.DeleteFraudRule(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CreateOrUpdatePromoCode(ctx context.Context, in *billing.PromoCode, out *PromoCodeResponse) error {
+	return h.BillingServiceHandler.CreateOrUpdatePromoCode(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, out *ListPromoCodesResponse) error {
+	return h.BillingServiceHandler.ListPromoCodes(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, out *ListWebhookDeliveriesResponse) error {
+	return h.BillingServiceHandler.ListWebhookDeliveries(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ResendWebhookDelivery(ctx context.Context, in *WebhookDeliveryRequest, out *WebhookDeliveryResponse) error {
+	return h.BillingServiceHandler.ResendWebhookDelivery(ctx, in, out)
+}
+
 func (h *billingServiceHandler) PaymentFormLanguageChanged(ctx context.Context, in *PaymentFormUserChangeLangRequest, out *PaymentFormDataChangeResponse) error {
 	return h.BillingServiceHandler.PaymentFormLanguageChanged(ctx, in, out)
 }
@@ -2390,6 +3268,10 @@ func (h *billingServiceHandler) ProcessBillingAddress(ctx context.Context, in *P
 	return h.BillingServiceHandler.ProcessBillingAddress(ctx, in, out)
 }
 
+func (h *billingServiceHandler) PaymentFormApplyPromoCode(ctx context.Context, in *PaymentFormApplyPromoCodeRequest, out *ProcessBillingAddressResponse) error {
+	return h.BillingServiceHandler.PaymentFormApplyPromoCode(ctx, in, out)
+}
+
 func (h *billingServiceHandler) CreateOrUpdateProduct(ctx context.Context, in *Product, out *Product) error {
 	return h.BillingServiceHandler.CreateOrUpdateProduct(ctx, in, out)
 }
@@ -2454,6 +3336,14 @@ func (h *billingServiceHandler) UpdateCountry(ctx context.Context, in *billing.C
 	return h.BillingServiceHandler.UpdateCountry(ctx, in, out)
 }
 
+func (h *billingServiceHandler) ScheduleCountryVatSettings(ctx context.Context, in *billing.CountryVatSettings, out *CountryVatSettingsResponse) error {
+	return h.BillingServiceHandler.ScheduleCountryVatSettings(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetCountryVatSettingsHistory(ctx context.Context, in *billing.GetCountryRequest, out *CountryVatSettingsHistoryResponse) error {
+	return h.BillingServiceHandler.GetCountryVatSettingsHistory(ctx, in, out)
+}
+
 func (h *billingServiceHandler) GetOrderPublic(ctx context.Context, in *GetOrderRequest, out *GetOrderPublicResponse) error {
 	return h.BillingServiceHandler.GetOrderPublic(ctx, in, out)
 }
@@ -2474,6 +3364,84 @@ func (h *billingServiceHandler) FindAllOrders(ctx context.Context, in *ListOrder
 	return h.BillingServiceHandler.FindAllOrders(ctx, in, out)
 }
 
+func (h *billingServiceHandler) ExportOrders(ctx context.Context, stream server.Stream) error {
+	m := new(ExportOrdersRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.BillingServiceHandler.ExportOrders(ctx, m, &billingServiceExportOrdersStream{stream})
+}
+
+type BillingService_ExportOrdersStream interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*ExportOrdersChunk) error
+}
+
+type billingServiceExportOrdersStream struct {
+	stream server.Stream
+}
+
+func (x *billingServiceExportOrdersStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *billingServiceExportOrdersStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *billingServiceExportOrdersStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *billingServiceExportOrdersStream) Send(m *ExportOrdersChunk) error {
+	return x.stream.Send(m)
+}
+
+func (h *billingServiceHandler) CreateOrdersExportJob(ctx context.Context, in *ExportOrdersRequest, out *OrdersExportJobResponse) error {
+	return h.BillingServiceHandler.CreateOrdersExportJob(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetOrdersExportJob(ctx context.Context, in *OrdersExportJobRequest, out *OrdersExportJobResponse) error {
+	return h.BillingServiceHandler.GetOrdersExportJob(ctx, in, out)
+}
+
+func (h *billingServiceHandler) DownloadOrdersExportJob(ctx context.Context, stream server.Stream) error {
+	m := new(OrdersExportJobRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.BillingServiceHandler.DownloadOrdersExportJob(ctx, m, &billingServiceDownloadOrdersExportJobStream{stream})
+}
+
+type BillingService_DownloadOrdersExportJobStream interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*ExportOrdersChunk) error
+}
+
+type billingServiceDownloadOrdersExportJobStream struct {
+	stream server.Stream
+}
+
+func (x *billingServiceDownloadOrdersExportJobStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *billingServiceDownloadOrdersExportJobStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *billingServiceDownloadOrdersExportJobStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *billingServiceDownloadOrdersExportJobStream) Send(m *ExportOrdersChunk) error {
+	return x.stream.Send(m)
+}
+
 func (h *billingServiceHandler) IsOrderCanBePaying(ctx context.Context, in *IsOrderCanBePayingRequest, out *IsOrderCanBePayingResponse) error {
 	return h.BillingServiceHandler.IsOrderCanBePaying(ctx, in, out)
 }
@@ -2622,6 +3590,26 @@ func (h *billingServiceHandler) CreateAccountingEntry(ctx context.Context, in *C
 	return h.BillingServiceHandler.CreateAccountingEntry(ctx, in, out)
 }
 
+func (h *billingServiceHandler) GetLedgerTrialBalance(ctx context.Context, in *LedgerTrialBalanceRequest, out *LedgerTrialBalanceResponse) error {
+	return h.BillingServiceHandler.GetLedgerTrialBalance(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ImportReconciliationReport(ctx context.Context, in *ImportReconciliationReportRequest, out *ReconciliationRunResponse) error {
+	return h.BillingServiceHandler.ImportReconciliationReport(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListReconciliationRuns(ctx context.Context, in *ListReconciliationRunsRequest, out *ListReconciliationRunsResponse) error {
+	return h.BillingServiceHandler.ListReconciliationRuns(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListReconciliationLines(ctx context.Context, in *ListReconciliationLinesRequest, out *ListReconciliationLinesResponse) error {
+	return h.BillingServiceHandler.ListReconciliationLines(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ResolveReconciliationLine(ctx context.Context, in *ResolveReconciliationLineRequest, out *ReconciliationLineResponse) error {
+	return h.BillingServiceHandler.ResolveReconciliationLine(ctx, in, out)
+}
+
 func (h *billingServiceHandler) GetUserProfile(ctx context.Context, in *GetUserProfileRequest, out *GetUserProfileResponse) error {
 	return h.BillingServiceHandler.GetUserProfile(ctx, in, out)
 }
@@ -2670,6 +3658,22 @@ func (h *billingServiceHandler) RoyaltyReportPdfUploaded(ctx context.Context, in
 	return h.BillingServiceHandler.RoyaltyReportPdfUploaded(ctx, in, out)
 }
 
+func (h *billingServiceHandler) GetRoyaltyReportDispute(ctx context.Context, in *GetRoyaltyReportRequest, out *RoyaltyReportDisputeResponse) error {
+	return h.BillingServiceHandler.GetRoyaltyReportDispute(ctx, in, out)
+}
+
+func (h *billingServiceHandler) AddRoyaltyReportDisputeCorrection(ctx context.Context, in *AddRoyaltyReportDisputeCorrectionRequest, out *RoyaltyReportDisputeResponse) error {
+	return h.BillingServiceHandler.AddRoyaltyReportDisputeCorrection(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ResolveRoyaltyReportDispute(ctx context.Context, in *ResolveRoyaltyReportDisputeRequest, out *RoyaltyReportDisputeResponse) error {
+	return h.BillingServiceHandler.ResolveRoyaltyReportDispute(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListRoyaltyReportVersions(ctx context.Context, in *GetRoyaltyReportRequest, out *ListRoyaltyReportVersionsResponse) error {
+	return h.BillingServiceHandler.ListRoyaltyReportVersions(ctx, in, out)
+}
+
 func (h *billingServiceHandler) GetVatReportsDashboard(ctx context.Context, in *EmptyRequest, out *VatReportsResponse) error {
 	return h.BillingServiceHandler.GetVatReportsDashboard(ctx, in, out)
 }
@@ -2690,6 +3694,14 @@ func (h *billingServiceHandler) UpdateVatReportStatus(ctx context.Context, in *U
 	return h.BillingServiceHandler.UpdateVatReportStatus(ctx, in, out)
 }
 
+func (h *billingServiceHandler) CreateVatOssReturn(ctx context.Context, in *VatOssReturnRequest, out *VatOssReturnResponse) error {
+	return h.BillingServiceHandler.CreateVatOssReturn(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetVatOssReturn(ctx context.Context, in *VatOssReturnRequest, out *VatOssReturnResponse) error {
+	return h.BillingServiceHandler.GetVatOssReturn(ctx, in, out)
+}
+
 func (h *billingServiceHandler) CalcAnnualTurnovers(ctx context.Context, in *EmptyRequest, out *EmptyResponse) error {
 	return h.BillingServiceHandler.CalcAnnualTurnovers(ctx, in, out)
 }
@@ -2762,6 +3774,22 @@ func (h *billingServiceHandler) CancelRedeemKeyForOrder(ctx context.Context, in
 	return h.BillingServiceHandler.CancelRedeemKeyForOrder(ctx, in, out)
 }
 
+func (h *billingServiceHandler) RotateKeysEncryptionKey(ctx context.Context, in *EmptyRequest, out *RotateKeysEncryptionKeyResponse) error {
+	return h.BillingServiceHandler.RotateKeysEncryptionKey(ctx, in, out)
+}
+
+func (h *billingServiceHandler) RevokePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, out *ManagePlatformKeysResponse) error {
+	return h.BillingServiceHandler.RevokePlatformKeys(ctx, in, out)
+}
+
+func (h *billingServiceHandler) DeletePlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, out *ManagePlatformKeysResponse) error {
+	return h.BillingServiceHandler.DeletePlatformKeys(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ExportPlatformKeys(ctx context.Context, in *ManagePlatformKeysRequest, out *ExportPlatformKeysResponse) error {
+	return h.BillingServiceHandler.ExportPlatformKeys(ctx, in, out)
+}
+
 func (h *billingServiceHandler) ChangeCodeInOrder(ctx context.Context, in *ChangeCodeInOrderRequest, out *ChangeCodeInOrderResponse) error {
 	return h.BillingServiceHandler.ChangeCodeInOrder(ctx, in, out)
 }
@@ -2806,6 +3834,18 @@ func (h *billingServiceHandler) AutoCreatePayoutDocuments(ctx context.Context, i
 	return h.BillingServiceHandler.AutoCreatePayoutDocuments(ctx, in, out)
 }
 
+func (h *billingServiceHandler) CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, out *PayoutBatchResponse) error {
+	return h.BillingServiceHandler.CreatePayoutBatch(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, out *PayoutBatchResponse) error {
+	return h.BillingServiceHandler.GetPayoutBatch(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ImportPayoutStatusReport(ctx context.Context, in *ImportPayoutStatusReportRequest, out *ImportPayoutStatusReportResponse) error {
+	return h.BillingServiceHandler.ImportPayoutStatusReport(ctx, in, out)
+}
+
 func (h *billingServiceHandler) GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, out *GetMerchantBalanceResponse) error {
 	return h.BillingServiceHandler.GetMerchantBalance(ctx, in, out)
 }
@@ -2862,6 +3902,10 @@ func (h *billingServiceHandler) GetPaylinkStatByUtm(ctx context.Context, in *Get
 	return h.BillingServiceHandler.GetPaylinkStatByUtm(ctx, in, out)
 }
 
+func (h *billingServiceHandler) CreatePaylinkToken(ctx context.Context, in *paylink.CreatePaylinkTokenRequest, out *CreatePaylinkTokenResponse) error {
+	return h.BillingServiceHandler.CreatePaylinkToken(ctx, in, out)
+}
+
 func (h *billingServiceHandler) DeleteSavedCard(ctx context.Context, in *DeleteSavedCardRequest, out *EmptyResponseWithStatus) error {
 	return h.BillingServiceHandler.DeleteSavedCard(ctx, in, out)
 }
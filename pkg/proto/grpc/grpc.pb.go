@@ -557,13 +557,18 @@ func (m *PlatformKeyReserveRequest) GetOrderId() string {
 }
 
 type PlatformKeysFileResponse struct {
-	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	KeysProcessed        int32                 `protobuf:"varint,3,opt,name=keys_processed,json=keysProcessed,proto3" json:"keys_processed,omitempty"`
-	TotalCount           int32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	Status        int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	KeysProcessed int32                 `protobuf:"varint,3,opt,name=keys_processed,json=keysProcessed,proto3" json:"keys_processed,omitempty"`
+	TotalCount    int32                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// import result for each line of keys file, header line of csv file is not included
+	// @inject_tag: json:"lines"
+	Lines []*PlatformKeysFileLineResult `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines"`
+	// @inject_tag: json:"errors_count"
+	ErrorsCount          int32    `protobuf:"varint,6,opt,name=errors_count,json=errorsCount,proto3" json:"errors_count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *PlatformKeysFileResponse) Reset()         { *m = PlatformKeysFileResponse{} }
@@ -618,6 +623,320 @@ func (m *PlatformKeysFileResponse) GetTotalCount() int32 {
 	return 0
 }
 
+func (m *PlatformKeysFileResponse) GetLines() []*PlatformKeysFileLineResult {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *PlatformKeysFileResponse) GetErrorsCount() int32 {
+	if m != nil {
+		return m.ErrorsCount
+	}
+	return 0
+}
+
+type PlatformKeysFileLineResult struct {
+	// @inject_tag: json:"line"
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line"`
+	// one of: ok, invalid, duplicate_in_file, duplicate, error
+	// @inject_tag: json:"status"
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PlatformKeysFileLineResult) Reset()         { *m = PlatformKeysFileLineResult{} }
+func (m *PlatformKeysFileLineResult) String() string { return proto.CompactTextString(m) }
+func (*PlatformKeysFileLineResult) ProtoMessage()    {}
+func (*PlatformKeysFileLineResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{12}
+}
+func (m *PlatformKeysFileLineResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlatformKeysFileLineResult.Unmarshal(m, b)
+}
+func (m *PlatformKeysFileLineResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlatformKeysFileLineResult.Marshal(b, m, deterministic)
+}
+func (dst *PlatformKeysFileLineResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlatformKeysFileLineResult.Merge(dst, src)
+}
+func (m *PlatformKeysFileLineResult) XXX_Size() int {
+	return xxx_messageInfo_PlatformKeysFileLineResult.Size(m)
+}
+func (m *PlatformKeysFileLineResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlatformKeysFileLineResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlatformKeysFileLineResult proto.InternalMessageInfo
+
+func (m *PlatformKeysFileLineResult) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *PlatformKeysFileLineResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PlatformKeysFileLineResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ManagePlatformKeysRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24" json:"key_product_id"
+	KeyProductId string `protobuf:"bytes,1,opt,name=key_product_id,json=keyProductId,proto3" json:"key_product_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24" json:"merchant_id"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,max=255" json:"platform_id"
+	PlatformId string `protobuf:"bytes,3,opt,name=platform_id,json=platformId,proto3" json:"platform_id" validate:"required,max=255"`
+	// identifiers of keys to process, all unsold keys of platform will be processed if not set
+	// @inject_tag: validate:"omitempty,dive,hexadecimal,len=24" json:"key_ids"
+	KeyIds               []string `protobuf:"bytes,4,rep,name=key_ids,json=keyIds,proto3" json:"key_ids" validate:"omitempty,dive,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ManagePlatformKeysRequest) Reset()         { *m = ManagePlatformKeysRequest{} }
+func (m *ManagePlatformKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ManagePlatformKeysRequest) ProtoMessage()    {}
+func (*ManagePlatformKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{13}
+}
+func (m *ManagePlatformKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManagePlatformKeysRequest.Unmarshal(m, b)
+}
+func (m *ManagePlatformKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManagePlatformKeysRequest.Marshal(b, m, deterministic)
+}
+func (dst *ManagePlatformKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagePlatformKeysRequest.Merge(dst, src)
+}
+func (m *ManagePlatformKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ManagePlatformKeysRequest.Size(m)
+}
+func (m *ManagePlatformKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagePlatformKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagePlatformKeysRequest proto.InternalMessageInfo
+
+func (m *ManagePlatformKeysRequest) GetKeyProductId() string {
+	if m != nil {
+		return m.KeyProductId
+	}
+	return ""
+}
+
+func (m *ManagePlatformKeysRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ManagePlatformKeysRequest) GetPlatformId() string {
+	if m != nil {
+		return m.PlatformId
+	}
+	return ""
+}
+
+func (m *ManagePlatformKeysRequest) GetKeyIds() []string {
+	if m != nil {
+		return m.KeyIds
+	}
+	return nil
+}
+
+type ManagePlatformKeysResponse struct {
+	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"keys_processed"
+	KeysProcessed        int32    `protobuf:"varint,3,opt,name=keys_processed,json=keysProcessed,proto3" json:"keys_processed"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ManagePlatformKeysResponse) Reset()         { *m = ManagePlatformKeysResponse{} }
+func (m *ManagePlatformKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ManagePlatformKeysResponse) ProtoMessage()    {}
+func (*ManagePlatformKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{14}
+}
+func (m *ManagePlatformKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManagePlatformKeysResponse.Unmarshal(m, b)
+}
+func (m *ManagePlatformKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManagePlatformKeysResponse.Marshal(b, m, deterministic)
+}
+func (dst *ManagePlatformKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagePlatformKeysResponse.Merge(dst, src)
+}
+func (m *ManagePlatformKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ManagePlatformKeysResponse.Size(m)
+}
+func (m *ManagePlatformKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagePlatformKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagePlatformKeysResponse proto.InternalMessageInfo
+
+func (m *ManagePlatformKeysResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ManagePlatformKeysResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ManagePlatformKeysResponse) GetKeysProcessed() int32 {
+	if m != nil {
+		return m.KeysProcessed
+	}
+	return 0
+}
+
+type ExportPlatformKeysResponse struct {
+	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// csv file with codes and metadata of unsold keys
+	// @inject_tag: json:"file"
+	File []byte `protobuf:"bytes,3,opt,name=file,proto3" json:"file"`
+	// @inject_tag: json:"keys_processed"
+	KeysProcessed        int32    `protobuf:"varint,4,opt,name=keys_processed,json=keysProcessed,proto3" json:"keys_processed"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ExportPlatformKeysResponse) Reset()         { *m = ExportPlatformKeysResponse{} }
+func (m *ExportPlatformKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportPlatformKeysResponse) ProtoMessage()    {}
+func (*ExportPlatformKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{15}
+}
+func (m *ExportPlatformKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPlatformKeysResponse.Unmarshal(m, b)
+}
+func (m *ExportPlatformKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPlatformKeysResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportPlatformKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPlatformKeysResponse.Merge(dst, src)
+}
+func (m *ExportPlatformKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportPlatformKeysResponse.Size(m)
+}
+func (m *ExportPlatformKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPlatformKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPlatformKeysResponse proto.InternalMessageInfo
+
+func (m *ExportPlatformKeysResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ExportPlatformKeysResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ExportPlatformKeysResponse) GetFile() []byte {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *ExportPlatformKeysResponse) GetKeysProcessed() int32 {
+	if m != nil {
+		return m.KeysProcessed
+	}
+	return 0
+}
+
+type RotateKeysEncryptionKeyResponse struct {
+	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"keys_processed"
+	KeysProcessed        int32    `protobuf:"varint,3,opt,name=keys_processed,json=keysProcessed,proto3" json:"keys_processed"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RotateKeysEncryptionKeyResponse) Reset()         { *m = RotateKeysEncryptionKeyResponse{} }
+func (m *RotateKeysEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateKeysEncryptionKeyResponse) ProtoMessage()    {}
+func (*RotateKeysEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{16}
+}
+func (m *RotateKeysEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateKeysEncryptionKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateKeysEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateKeysEncryptionKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RotateKeysEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeysEncryptionKeyResponse.Merge(dst, src)
+}
+func (m *RotateKeysEncryptionKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateKeysEncryptionKeyResponse.Size(m)
+}
+func (m *RotateKeysEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeysEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKeysEncryptionKeyResponse proto.InternalMessageInfo
+
+func (m *RotateKeysEncryptionKeyResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *RotateKeysEncryptionKeyResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *RotateKeysEncryptionKeyResponse) GetKeysProcessed() int32 {
+	if m != nil {
+		return m.KeysProcessed
+	}
+	return 0
+}
+
 type PlatformKeysFileRequest struct {
 	// @inject_tag: validate:"required,max=10485760"
 	File []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty" validate:"required,max=10485760"`
@@ -626,7 +945,13 @@ type PlatformKeysFileRequest struct {
 	// @inject_tag: validate:"hexadecimal,len=24" json:"merchant_id"
 	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"hexadecimal,len=24"`
 	// @inject_tag: validate:"required,max=255"
-	PlatformId           string   `protobuf:"bytes,4,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty" validate:"required,max=255"`
+	PlatformId string `protobuf:"bytes,4,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty" validate:"required,max=255"`
+	// check keys file and return result for each line without import of keys
+	// @inject_tag: json:"dry_run"
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	// format of keys file: text - one key code per line, csv - file with header, "code" column and metadata columns
+	// @inject_tag: validate:"omitempty,oneof=text csv" json:"format"
+	Format               string   `protobuf:"bytes,6,opt,name=format,proto3" json:"format" validate:"omitempty,oneof=text csv"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -636,7 +961,7 @@ func (m *PlatformKeysFileRequest) Reset()         { *m = PlatformKeysFileRequest
 func (m *PlatformKeysFileRequest) String() string { return proto.CompactTextString(m) }
 func (*PlatformKeysFileRequest) ProtoMessage()    {}
 func (*PlatformKeysFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{12}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{17}
 }
 func (m *PlatformKeysFileRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlatformKeysFileRequest.Unmarshal(m, b)
@@ -684,6 +1009,20 @@ func (m *PlatformKeysFileRequest) GetPlatformId() string {
 	return ""
 }
 
+func (m *PlatformKeysFileRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PlatformKeysFileRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 type GetPlatformKeyCountRequest struct {
 	// @inject_tag: query:"key_product_id" validate:"required,hexadecimal,len=24"
 	KeyProductId string `protobuf:"bytes,1,opt,name=key_product_id,json=keyProductId,proto3" json:"key_product_id,omitempty" query:"key_product_id" validate:"required,hexadecimal,len=24"`
@@ -700,7 +1039,7 @@ func (m *GetPlatformKeyCountRequest) Reset()         { *m = GetPlatformKeyCountR
 func (m *GetPlatformKeyCountRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPlatformKeyCountRequest) ProtoMessage()    {}
 func (*GetPlatformKeyCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{13}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{18}
 }
 func (m *GetPlatformKeyCountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPlatformKeyCountRequest.Unmarshal(m, b)
@@ -757,7 +1096,7 @@ func (m *RemovePlatformRequest) Reset()         { *m = RemovePlatformRequest{} }
 func (m *RemovePlatformRequest) String() string { return proto.CompactTextString(m) }
 func (*RemovePlatformRequest) ProtoMessage()    {}
 func (*RemovePlatformRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{14}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{19}
 }
 func (m *RemovePlatformRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemovePlatformRequest.Unmarshal(m, b)
@@ -812,7 +1151,7 @@ func (m *KeyProductResponse) Reset()         { *m = KeyProductResponse{} }
 func (m *KeyProductResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyProductResponse) ProtoMessage()    {}
 func (*KeyProductResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{15}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{20}
 }
 func (m *KeyProductResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyProductResponse.Unmarshal(m, b)
@@ -893,7 +1232,7 @@ func (m *CreateOrUpdateKeyProductRequest) Reset()         { *m = CreateOrUpdateK
 func (m *CreateOrUpdateKeyProductRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateOrUpdateKeyProductRequest) ProtoMessage()    {}
 func (*CreateOrUpdateKeyProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{16}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{21}
 }
 func (m *CreateOrUpdateKeyProductRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateOrUpdateKeyProductRequest.Unmarshal(m, b)
@@ -1030,7 +1369,7 @@ func (m *UnPublishKeyProductRequest) Reset()         { *m = UnPublishKeyProductR
 func (m *UnPublishKeyProductRequest) String() string { return proto.CompactTextString(m) }
 func (*UnPublishKeyProductRequest) ProtoMessage()    {}
 func (*UnPublishKeyProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{17}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{22}
 }
 func (m *UnPublishKeyProductRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnPublishKeyProductRequest.Unmarshal(m, b)
@@ -1071,7 +1410,7 @@ func (m *PublishKeyProductRequest) Reset()         { *m = PublishKeyProductReque
 func (m *PublishKeyProductRequest) String() string { return proto.CompactTextString(m) }
 func (*PublishKeyProductRequest) ProtoMessage()    {}
 func (*PublishKeyProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{18}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{23}
 }
 func (m *PublishKeyProductRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishKeyProductRequest.Unmarshal(m, b)
@@ -1119,7 +1458,7 @@ func (m *ListPlatformsRequest) Reset()         { *m = ListPlatformsRequest{} }
 func (m *ListPlatformsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPlatformsRequest) ProtoMessage()    {}
 func (*ListPlatformsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{19}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{24}
 }
 func (m *ListPlatformsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPlatformsRequest.Unmarshal(m, b)
@@ -1173,7 +1512,7 @@ func (m *ListPlatformsResponse) Reset()         { *m = ListPlatformsResponse{} }
 func (m *ListPlatformsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPlatformsResponse) ProtoMessage()    {}
 func (*ListPlatformsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{20}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{25}
 }
 func (m *ListPlatformsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPlatformsResponse.Unmarshal(m, b)
@@ -1255,7 +1594,7 @@ func (m *ListKeyProductsResponse) Reset()         { *m = ListKeyProductsResponse
 func (m *ListKeyProductsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListKeyProductsResponse) ProtoMessage()    {}
 func (*ListKeyProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{21}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{26}
 }
 func (m *ListKeyProductsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListKeyProductsResponse.Unmarshal(m, b)
@@ -1341,7 +1680,7 @@ func (m *ListKeyProductsRequest) Reset()         { *m = ListKeyProductsRequest{}
 func (m *ListKeyProductsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListKeyProductsRequest) ProtoMessage()    {}
 func (*ListKeyProductsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{22}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{27}
 }
 func (m *ListKeyProductsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListKeyProductsRequest.Unmarshal(m, b)
@@ -1424,7 +1763,7 @@ func (m *PaymentCreateRequest) Reset()         { *m = PaymentCreateRequest{} }
 func (m *PaymentCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentCreateRequest) ProtoMessage()    {}
 func (*PaymentCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{23}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{28}
 }
 func (m *PaymentCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentCreateRequest.Unmarshal(m, b)
@@ -1486,7 +1825,7 @@ func (m *PaymentCreateResponse) Reset()         { *m = PaymentCreateResponse{} }
 func (m *PaymentCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentCreateResponse) ProtoMessage()    {}
 func (*PaymentCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{24}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{29}
 }
 func (m *PaymentCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentCreateResponse.Unmarshal(m, b)
@@ -1558,7 +1897,7 @@ func (m *PaymentFormJsonDataRequest) Reset()         { *m = PaymentFormJsonDataR
 func (m *PaymentFormJsonDataRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonDataRequest) ProtoMessage()    {}
 func (*PaymentFormJsonDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{25}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{30}
 }
 func (m *PaymentFormJsonDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonDataRequest.Unmarshal(m, b)
@@ -1692,7 +2031,7 @@ func (m *PaymentFormJsonDataProject) Reset()         { *m = PaymentFormJsonDataP
 func (m *PaymentFormJsonDataProject) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonDataProject) ProtoMessage()    {}
 func (*PaymentFormJsonDataProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{26}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{31}
 }
 func (m *PaymentFormJsonDataProject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonDataProject.Unmarshal(m, b)
@@ -1764,17 +2103,21 @@ type PaymentFormJsonData struct {
 	// @inject_tag: json:"country_change_allowed"
 	CountryChangeAllowed bool `protobuf:"varint,19,opt,name=country_change_allowed,json=countryChangeAllowed,proto3" json:"country_change_allowed"`
 	// @inject_tag: json:"platforms"
-	Platforms            []*Platform `protobuf:"bytes,20,rep,name=platforms,proto3" json:"platforms"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32       `json:"-" bson:"-" structure:"-" validate:"-"`
+	Platforms []*Platform `protobuf:"bytes,20,rep,name=platforms,proto3" json:"platforms"`
+	// @inject_tag: json:"discount"
+	Discount float64 `protobuf:"fixed64,21,opt,name=discount,proto3" json:"discount"`
+	// @inject_tag: json:"promo_code"
+	PromoCode            *billing.OrderPromoCode `protobuf:"bytes,22,opt,name=promo_code,json=promoCode,proto3" json:"promo_code"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *PaymentFormJsonData) Reset()         { *m = PaymentFormJsonData{} }
 func (m *PaymentFormJsonData) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonData) ProtoMessage()    {}
 func (*PaymentFormJsonData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{27}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{32}
 }
 func (m *PaymentFormJsonData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonData.Unmarshal(m, b)
@@ -1927,6 +2270,20 @@ func (m *PaymentFormJsonData) GetPlatforms() []*Platform {
 	return nil
 }
 
+func (m *PaymentFormJsonData) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *PaymentFormJsonData) GetPromoCode() *billing.OrderPromoCode {
+	if m != nil {
+		return m.PromoCode
+	}
+	return nil
+}
+
 type PaymentFormJsonDataResponse struct {
 	// @inject_tag: json:"status"
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
@@ -1943,7 +2300,7 @@ func (m *PaymentFormJsonDataResponse) Reset()         { *m = PaymentFormJsonData
 func (m *PaymentFormJsonDataResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonDataResponse) ProtoMessage()    {}
 func (*PaymentFormJsonDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{28}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{33}
 }
 func (m *PaymentFormJsonDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonDataResponse.Unmarshal(m, b)
@@ -1997,7 +2354,7 @@ func (m *PaymentNotifyRequest) Reset()         { *m = PaymentNotifyRequest{} }
 func (m *PaymentNotifyRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentNotifyRequest) ProtoMessage()    {}
 func (*PaymentNotifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{29}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{34}
 }
 func (m *PaymentNotifyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentNotifyRequest.Unmarshal(m, b)
@@ -2050,7 +2407,7 @@ func (m *PaymentNotifyResponse) Reset()         { *m = PaymentNotifyResponse{} }
 func (m *PaymentNotifyResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentNotifyResponse) ProtoMessage()    {}
 func (*PaymentNotifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{30}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{35}
 }
 func (m *PaymentNotifyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentNotifyResponse.Unmarshal(m, b)
@@ -2101,7 +2458,7 @@ func (m *OnboardingRequest) Reset()         { *m = OnboardingRequest{} }
 func (m *OnboardingRequest) String() string { return proto.CompactTextString(m) }
 func (*OnboardingRequest) ProtoMessage()    {}
 func (*OnboardingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{31}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{36}
 }
 func (m *OnboardingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OnboardingRequest.Unmarshal(m, b)
@@ -2167,7 +2524,7 @@ func (m *FindByIdRequest) Reset()         { *m = FindByIdRequest{} }
 func (m *FindByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*FindByIdRequest) ProtoMessage()    {}
 func (*FindByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{32}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{37}
 }
 func (m *FindByIdRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindByIdRequest.Unmarshal(m, b)
@@ -2231,7 +2588,7 @@ func (m *MerchantListingRequest) Reset()         { *m = MerchantListingRequest{}
 func (m *MerchantListingRequest) String() string { return proto.CompactTextString(m) }
 func (*MerchantListingRequest) ProtoMessage()    {}
 func (*MerchantListingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{33}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{38}
 }
 func (m *MerchantListingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantListingRequest.Unmarshal(m, b)
@@ -2363,7 +2720,7 @@ func (m *MerchantListingResponse) Reset()         { *m = MerchantListingResponse
 func (m *MerchantListingResponse) String() string { return proto.CompactTextString(m) }
 func (*MerchantListingResponse) ProtoMessage()    {}
 func (*MerchantListingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{34}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{39}
 }
 func (m *MerchantListingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantListingResponse.Unmarshal(m, b)
@@ -2413,7 +2770,7 @@ func (m *MerchantChangeStatusRequest) Reset()         { *m = MerchantChangeStatu
 func (m *MerchantChangeStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*MerchantChangeStatusRequest) ProtoMessage()    {}
 func (*MerchantChangeStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{35}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{40}
 }
 func (m *MerchantChangeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantChangeStatusRequest.Unmarshal(m, b)
@@ -2478,7 +2835,7 @@ func (m *NotificationRequest) Reset()         { *m = NotificationRequest{} }
 func (m *NotificationRequest) String() string { return proto.CompactTextString(m) }
 func (*NotificationRequest) ProtoMessage()    {}
 func (*NotificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{36}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{41}
 }
 func (m *NotificationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotificationRequest.Unmarshal(m, b)
@@ -2538,7 +2895,7 @@ func (m *Notifications) Reset()         { *m = Notifications{} }
 func (m *Notifications) String() string { return proto.CompactTextString(m) }
 func (*Notifications) ProtoMessage()    {}
 func (*Notifications) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{37}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{42}
 }
 func (m *Notifications) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Notifications.Unmarshal(m, b)
@@ -2594,7 +2951,7 @@ func (m *ListingNotificationRequest) Reset()         { *m = ListingNotificationR
 func (m *ListingNotificationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListingNotificationRequest) ProtoMessage()    {}
 func (*ListingNotificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{38}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{43}
 }
 func (m *ListingNotificationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListingNotificationRequest.Unmarshal(m, b)
@@ -2667,7 +3024,7 @@ func (m *ListingMerchantPaymentMethod) Reset()         { *m = ListingMerchantPay
 func (m *ListingMerchantPaymentMethod) String() string { return proto.CompactTextString(m) }
 func (*ListingMerchantPaymentMethod) ProtoMessage()    {}
 func (*ListingMerchantPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{39}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{44}
 }
 func (m *ListingMerchantPaymentMethod) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListingMerchantPaymentMethod.Unmarshal(m, b)
@@ -2708,7 +3065,7 @@ func (m *GetMerchantPaymentMethodRequest) Reset()         { *m = GetMerchantPaym
 func (m *GetMerchantPaymentMethodRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantPaymentMethodRequest) ProtoMessage()    {}
 func (*GetMerchantPaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{40}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{45}
 }
 func (m *GetMerchantPaymentMethodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantPaymentMethodRequest.Unmarshal(m, b)
@@ -2755,7 +3112,7 @@ func (m *GetMerchantPaymentMethodResponse) Reset()         { *m = GetMerchantPay
 func (m *GetMerchantPaymentMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantPaymentMethodResponse) ProtoMessage()    {}
 func (*GetMerchantPaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{41}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{46}
 }
 func (m *GetMerchantPaymentMethodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantPaymentMethodResponse.Unmarshal(m, b)
@@ -2812,7 +3169,7 @@ func (m *ListMerchantPaymentMethodsRequest) Reset()         { *m = ListMerchantP
 func (m *ListMerchantPaymentMethodsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMerchantPaymentMethodsRequest) ProtoMessage()    {}
 func (*ListMerchantPaymentMethodsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{42}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{47}
 }
 func (m *ListMerchantPaymentMethodsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListMerchantPaymentMethodsRequest.Unmarshal(m, b)
@@ -2875,7 +3232,7 @@ func (m *MerchantPaymentMethodRequest) Reset()         { *m = MerchantPaymentMet
 func (m *MerchantPaymentMethodRequest) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodRequest) ProtoMessage()    {}
 func (*MerchantPaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{43}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{48}
 }
 func (m *MerchantPaymentMethodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantPaymentMethodRequest.Unmarshal(m, b)
@@ -2950,7 +3307,7 @@ func (m *MerchantPaymentMethodResponse) Reset()         { *m = MerchantPaymentMe
 func (m *MerchantPaymentMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodResponse) ProtoMessage()    {}
 func (*MerchantPaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{44}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{49}
 }
 func (m *MerchantPaymentMethodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantPaymentMethodResponse.Unmarshal(m, b)
@@ -3004,7 +3361,7 @@ func (m *GetMerchantResponse) Reset()         { *m = GetMerchantResponse{} }
 func (m *GetMerchantResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantResponse) ProtoMessage()    {}
 func (*GetMerchantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{45}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{50}
 }
 func (m *GetMerchantResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantResponse.Unmarshal(m, b)
@@ -3057,7 +3414,7 @@ func (m *GetNotificationRequest) Reset()         { *m = GetNotificationRequest{}
 func (m *GetNotificationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNotificationRequest) ProtoMessage()    {}
 func (*GetNotificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{46}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{51}
 }
 func (m *GetNotificationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNotificationRequest.Unmarshal(m, b)
@@ -3108,7 +3465,7 @@ func (m *CreateRefundRequest) Reset()         { *m = CreateRefundRequest{} }
 func (m *CreateRefundRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRefundRequest) ProtoMessage()    {}
 func (*CreateRefundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{47}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{52}
 }
 func (m *CreateRefundRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRefundRequest.Unmarshal(m, b)
@@ -3176,7 +3533,7 @@ func (m *CreateRefundResponse) Reset()         { *m = CreateRefundResponse{} }
 func (m *CreateRefundResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateRefundResponse) ProtoMessage()    {}
 func (*CreateRefundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{48}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{53}
 }
 func (m *CreateRefundResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRefundResponse.Unmarshal(m, b)
@@ -3233,7 +3590,7 @@ func (m *ListRefundsRequest) Reset()         { *m = ListRefundsRequest{} }
 func (m *ListRefundsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefundsRequest) ProtoMessage()    {}
 func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{49}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{54}
 }
 func (m *ListRefundsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRefundsRequest.Unmarshal(m, b)
@@ -3286,7 +3643,7 @@ func (m *ListRefundsResponse) Reset()         { *m = ListRefundsResponse{} }
 func (m *ListRefundsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRefundsResponse) ProtoMessage()    {}
 func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{50}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{55}
 }
 func (m *ListRefundsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRefundsResponse.Unmarshal(m, b)
@@ -3334,7 +3691,7 @@ func (m *GetRefundRequest) Reset()         { *m = GetRefundRequest{} }
 func (m *GetRefundRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRefundRequest) ProtoMessage()    {}
 func (*GetRefundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{51}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{56}
 }
 func (m *GetRefundRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRefundRequest.Unmarshal(m, b)
@@ -3381,7 +3738,7 @@ func (m *CallbackRequest) Reset()         { *m = CallbackRequest{} }
 func (m *CallbackRequest) String() string { return proto.CompactTextString(m) }
 func (*CallbackRequest) ProtoMessage()    {}
 func (*CallbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{52}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{57}
 }
 func (m *CallbackRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallbackRequest.Unmarshal(m, b)
@@ -3435,7 +3792,7 @@ func (m *PaymentFormDataChangedRequest) Reset()         { *m = PaymentFormDataCh
 func (m *PaymentFormDataChangedRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormDataChangedRequest) ProtoMessage()    {}
 func (*PaymentFormDataChangedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{53}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{58}
 }
 func (m *PaymentFormDataChangedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormDataChangedRequest.Unmarshal(m, b)
@@ -3490,7 +3847,7 @@ func (m *PaymentFormUserChangePlatformRequest) Reset()         { *m = PaymentFor
 func (m *PaymentFormUserChangePlatformRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormUserChangePlatformRequest) ProtoMessage()    {}
 func (*PaymentFormUserChangePlatformRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{54}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{59}
 }
 func (m *PaymentFormUserChangePlatformRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormUserChangePlatformRequest.Unmarshal(m, b)
@@ -3541,7 +3898,7 @@ func (m *PaymentFormUserChangeLangRequest) Reset()         { *m = PaymentFormUse
 func (m *PaymentFormUserChangeLangRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormUserChangeLangRequest) ProtoMessage()    {}
 func (*PaymentFormUserChangeLangRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{55}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{60}
 }
 func (m *PaymentFormUserChangeLangRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormUserChangeLangRequest.Unmarshal(m, b)
@@ -3619,7 +3976,7 @@ func (m *PaymentFormUserChangePaymentAccountRequest) String() string {
 }
 func (*PaymentFormUserChangePaymentAccountRequest) ProtoMessage() {}
 func (*PaymentFormUserChangePaymentAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{56}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{61}
 }
 func (m *PaymentFormUserChangePaymentAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormUserChangePaymentAccountRequest.Unmarshal(m, b)
@@ -3697,7 +4054,7 @@ func (m *UserIpData) Reset()         { *m = UserIpData{} }
 func (m *UserIpData) String() string { return proto.CompactTextString(m) }
 func (*UserIpData) ProtoMessage()    {}
 func (*UserIpData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{57}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{62}
 }
 func (m *UserIpData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserIpData.Unmarshal(m, b)
@@ -3757,7 +4114,7 @@ func (m *PaymentFormDataChangeResponseItem) Reset()         { *m = PaymentFormDa
 func (m *PaymentFormDataChangeResponseItem) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormDataChangeResponseItem) ProtoMessage()    {}
 func (*PaymentFormDataChangeResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{58}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{63}
 }
 func (m *PaymentFormDataChangeResponseItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormDataChangeResponseItem.Unmarshal(m, b)
@@ -3825,7 +4182,7 @@ func (m *PaymentFormDataChangeResponse) Reset()         { *m = PaymentFormDataCh
 func (m *PaymentFormDataChangeResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormDataChangeResponse) ProtoMessage()    {}
 func (*PaymentFormDataChangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{59}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{64}
 }
 func (m *PaymentFormDataChangeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormDataChangeResponse.Unmarshal(m, b)
@@ -3882,7 +4239,7 @@ func (m *ProcessBillingAddressRequest) Reset()         { *m = ProcessBillingAddr
 func (m *ProcessBillingAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*ProcessBillingAddressRequest) ProtoMessage()    {}
 func (*ProcessBillingAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{60}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{65}
 }
 func (m *ProcessBillingAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessBillingAddressRequest.Unmarshal(m, b)
@@ -3935,17 +4292,21 @@ type ProcessBillingAddressResponseItem struct {
 	// @inject_tag: json:"currency"
 	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency"`
 	// @inject_tag: json:"items"
-	Items                []*billing.OrderItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+	Items []*billing.OrderItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items"`
+	// @inject_tag: json:"discount"
+	Discount float64 `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount"`
+	// @inject_tag: json:"promo_code"
+	PromoCode            *billing.OrderPromoCode `protobuf:"bytes,9,opt,name=promo_code,json=promoCode,proto3" json:"promo_code"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *ProcessBillingAddressResponseItem) Reset()         { *m = ProcessBillingAddressResponseItem{} }
 func (m *ProcessBillingAddressResponseItem) String() string { return proto.CompactTextString(m) }
 func (*ProcessBillingAddressResponseItem) ProtoMessage()    {}
 func (*ProcessBillingAddressResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{61}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{66}
 }
 func (m *ProcessBillingAddressResponseItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessBillingAddressResponseItem.Unmarshal(m, b)
@@ -4007,6 +4368,20 @@ func (m *ProcessBillingAddressResponseItem) GetItems() []*billing.OrderItem {
 	return nil
 }
 
+func (m *ProcessBillingAddressResponseItem) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *ProcessBillingAddressResponseItem) GetPromoCode() *billing.OrderPromoCode {
+	if m != nil {
+		return m.PromoCode
+	}
+	return nil
+}
+
 type ProcessBillingAddressResponse struct {
 	Status               int32                              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              *ResponseErrorMessage              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -4020,7 +4395,7 @@ func (m *ProcessBillingAddressResponse) Reset()         { *m = ProcessBillingAdd
 func (m *ProcessBillingAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*ProcessBillingAddressResponse) ProtoMessage()    {}
 func (*ProcessBillingAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{62}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{67}
 }
 func (m *ProcessBillingAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessBillingAddressResponse.Unmarshal(m, b)
@@ -4073,7 +4448,7 @@ func (m *GetMerchantByRequest) Reset()         { *m = GetMerchantByRequest{} }
 func (m *GetMerchantByRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantByRequest) ProtoMessage()    {}
 func (*GetMerchantByRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{63}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{68}
 }
 func (m *GetMerchantByRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantByRequest.Unmarshal(m, b)
@@ -4121,7 +4496,7 @@ func (m *ChangeMerchantDataRequest) Reset()         { *m = ChangeMerchantDataReq
 func (m *ChangeMerchantDataRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeMerchantDataRequest) ProtoMessage()    {}
 func (*ChangeMerchantDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{64}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{69}
 }
 func (m *ChangeMerchantDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeMerchantDataRequest.Unmarshal(m, b)
@@ -4175,7 +4550,7 @@ func (m *ChangeMerchantDataResponse) Reset()         { *m = ChangeMerchantDataRe
 func (m *ChangeMerchantDataResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeMerchantDataResponse) ProtoMessage()    {}
 func (*ChangeMerchantDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{65}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{70}
 }
 func (m *ChangeMerchantDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeMerchantDataResponse.Unmarshal(m, b)
@@ -4229,7 +4604,7 @@ func (m *ChangeMerchantResponse) Reset()         { *m = ChangeMerchantResponse{}
 func (m *ChangeMerchantResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeMerchantResponse) ProtoMessage()    {}
 func (*ChangeMerchantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{66}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{71}
 }
 func (m *ChangeMerchantResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeMerchantResponse.Unmarshal(m, b)
@@ -4283,7 +4658,7 @@ func (m *ChangeMerchantStatusResponse) Reset()         { *m = ChangeMerchantStat
 func (m *ChangeMerchantStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeMerchantStatusResponse) ProtoMessage()    {}
 func (*ChangeMerchantStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{67}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{72}
 }
 func (m *ChangeMerchantStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeMerchantStatusResponse.Unmarshal(m, b)
@@ -4337,7 +4712,7 @@ func (m *CreateNotificationResponse) Reset()         { *m = CreateNotificationRe
 func (m *CreateNotificationResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateNotificationResponse) ProtoMessage()    {}
 func (*CreateNotificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{68}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{73}
 }
 func (m *CreateNotificationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNotificationResponse.Unmarshal(m, b)
@@ -4391,7 +4766,7 @@ func (m *OrderCreateProcessResponse) Reset()         { *m = OrderCreateProcessRe
 func (m *OrderCreateProcessResponse) String() string { return proto.CompactTextString(m) }
 func (*OrderCreateProcessResponse) ProtoMessage()    {}
 func (*OrderCreateProcessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{69}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{74}
 }
 func (m *OrderCreateProcessResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrderCreateProcessResponse.Unmarshal(m, b)
@@ -4446,7 +4821,7 @@ func (m *SetMerchantS3AgreementRequest) Reset()         { *m = SetMerchantS3Agre
 func (m *SetMerchantS3AgreementRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMerchantS3AgreementRequest) ProtoMessage()    {}
 func (*SetMerchantS3AgreementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{70}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{75}
 }
 func (m *SetMerchantS3AgreementRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMerchantS3AgreementRequest.Unmarshal(m, b)
@@ -4497,7 +4872,7 @@ func (m *KeyProductInfo) Reset()         { *m = KeyProductInfo{} }
 func (m *KeyProductInfo) String() string { return proto.CompactTextString(m) }
 func (*KeyProductInfo) ProtoMessage()    {}
 func (*KeyProductInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{71}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{76}
 }
 func (m *KeyProductInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyProductInfo.Unmarshal(m, b)
@@ -4579,7 +4954,7 @@ func (m *PlatformPriceInfo) Reset()         { *m = PlatformPriceInfo{} }
 func (m *PlatformPriceInfo) String() string { return proto.CompactTextString(m) }
 func (*PlatformPriceInfo) ProtoMessage()    {}
 func (*PlatformPriceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{72}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{77}
 }
 func (m *PlatformPriceInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlatformPriceInfo.Unmarshal(m, b)
@@ -4634,7 +5009,7 @@ func (m *ProductPriceInfo) Reset()         { *m = ProductPriceInfo{} }
 func (m *ProductPriceInfo) String() string { return proto.CompactTextString(m) }
 func (*ProductPriceInfo) ProtoMessage()    {}
 func (*ProductPriceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{73}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{78}
 }
 func (m *ProductPriceInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProductPriceInfo.Unmarshal(m, b)
@@ -4732,7 +5107,7 @@ func (m *KeyProduct) Reset()         { *m = KeyProduct{} }
 func (m *KeyProduct) String() string { return proto.CompactTextString(m) }
 func (*KeyProduct) ProtoMessage()    {}
 func (*KeyProduct) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{74}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{79}
 }
 func (m *KeyProduct) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyProduct.Unmarshal(m, b)
@@ -4905,7 +5280,10 @@ type PlatformPrice struct {
 	ActivationUrl string `protobuf:"bytes,5,opt,name=activation_url,json=activationUrl,proto3" json:"activation_url" validate:"omitempty,url"`
 	// count is used only in listing of key products for merchant. in all other keys will be empty always
 	// @inject_tag: validate:"omitempty" json:"count"
-	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count" validate:"omitempty"`
+	Count int32 `protobuf:"varint,6,opt,name=count,proto3" json:"count" validate:"omitempty"`
+	// merchant will be notified when count of available keys for platform falls to this value, 0 to disable
+	// @inject_tag: validate:"omitempty,gte=0" json:"low_stock_threshold"
+	LowStockThreshold    int32    `protobuf:"varint,7,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold" validate:"omitempty,gte=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -4915,7 +5293,7 @@ func (m *PlatformPrice) Reset()         { *m = PlatformPrice{} }
 func (m *PlatformPrice) String() string { return proto.CompactTextString(m) }
 func (*PlatformPrice) ProtoMessage()    {}
 func (*PlatformPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{75}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{80}
 }
 func (m *PlatformPrice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlatformPrice.Unmarshal(m, b)
@@ -4977,6 +5355,13 @@ func (m *PlatformPrice) GetCount() int32 {
 	return 0
 }
 
+func (m *PlatformPrice) GetLowStockThreshold() int32 {
+	if m != nil {
+		return m.LowStockThreshold
+	}
+	return 0
+}
+
 type Platform struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
@@ -4995,7 +5380,7 @@ func (m *Platform) Reset()         { *m = Platform{} }
 func (m *Platform) String() string { return proto.CompactTextString(m) }
 func (*Platform) ProtoMessage()    {}
 func (*Platform) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{76}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{81}
 }
 func (m *Platform) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Platform.Unmarshal(m, b)
@@ -5093,7 +5478,7 @@ func (m *Product) Reset()         { *m = Product{} }
 func (m *Product) String() string { return proto.CompactTextString(m) }
 func (*Product) ProtoMessage()    {}
 func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{77}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{82}
 }
 func (m *Product) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Product.Unmarshal(m, b)
@@ -5277,7 +5662,7 @@ func (m *ListProductsRequest) Reset()         { *m = ListProductsRequest{} }
 func (m *ListProductsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProductsRequest) ProtoMessage()    {}
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{78}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{83}
 }
 func (m *ListProductsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProductsRequest.Unmarshal(m, b)
@@ -5360,7 +5745,7 @@ func (m *GetProductsForOrderRequest) Reset()         { *m = GetProductsForOrderR
 func (m *GetProductsForOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProductsForOrderRequest) ProtoMessage()    {}
 func (*GetProductsForOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{79}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{84}
 }
 func (m *GetProductsForOrderRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProductsForOrderRequest.Unmarshal(m, b)
@@ -5412,7 +5797,7 @@ func (m *GetKeyProductInfoRequest) Reset()         { *m = GetKeyProductInfoReque
 func (m *GetKeyProductInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKeyProductInfoRequest) ProtoMessage()    {}
 func (*GetKeyProductInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{80}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{85}
 }
 func (m *GetKeyProductInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetKeyProductInfoRequest.Unmarshal(m, b)
@@ -5473,7 +5858,7 @@ func (m *GetKeyProductInfoResponse) Reset()         { *m = GetKeyProductInfoResp
 func (m *GetKeyProductInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKeyProductInfoResponse) ProtoMessage()    {}
 func (*GetKeyProductInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{81}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{86}
 }
 func (m *GetKeyProductInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetKeyProductInfoResponse.Unmarshal(m, b)
@@ -5528,7 +5913,7 @@ func (m *GetKeyProductsForOrderRequest) Reset()         { *m = GetKeyProductsFor
 func (m *GetKeyProductsForOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKeyProductsForOrderRequest) ProtoMessage()    {}
 func (*GetKeyProductsForOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{82}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{87}
 }
 func (m *GetKeyProductsForOrderRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetKeyProductsForOrderRequest.Unmarshal(m, b)
@@ -5580,7 +5965,7 @@ func (m *ListProductsResponse) Reset()         { *m = ListProductsResponse{} }
 func (m *ListProductsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListProductsResponse) ProtoMessage()    {}
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{83}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{88}
 }
 func (m *ListProductsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProductsResponse.Unmarshal(m, b)
@@ -5642,7 +6027,7 @@ func (m *RequestProduct) Reset()         { *m = RequestProduct{} }
 func (m *RequestProduct) String() string { return proto.CompactTextString(m) }
 func (*RequestProduct) ProtoMessage()    {}
 func (*RequestProduct) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{84}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{89}
 }
 func (m *RequestProduct) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RequestProduct.Unmarshal(m, b)
@@ -5690,7 +6075,7 @@ func (m *RequestKeyProductMerchant) Reset()         { *m = RequestKeyProductMerc
 func (m *RequestKeyProductMerchant) String() string { return proto.CompactTextString(m) }
 func (*RequestKeyProductMerchant) ProtoMessage()    {}
 func (*RequestKeyProductMerchant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{85}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{90}
 }
 func (m *RequestKeyProductMerchant) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RequestKeyProductMerchant.Unmarshal(m, b)
@@ -5736,7 +6121,7 @@ func (m *RequestKeyProduct) Reset()         { *m = RequestKeyProduct{} }
 func (m *RequestKeyProduct) String() string { return proto.CompactTextString(m) }
 func (*RequestKeyProduct) ProtoMessage()    {}
 func (*RequestKeyProduct) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{86}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{91}
 }
 func (m *RequestKeyProduct) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RequestKeyProduct.Unmarshal(m, b)
@@ -5777,7 +6162,7 @@ func (m *I18NTextSearchable) Reset()         { *m = I18NTextSearchable{} }
 func (m *I18NTextSearchable) String() string { return proto.CompactTextString(m) }
 func (*I18NTextSearchable) ProtoMessage()    {}
 func (*I18NTextSearchable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{87}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{92}
 }
 func (m *I18NTextSearchable) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_I18NTextSearchable.Unmarshal(m, b)
@@ -5824,7 +6209,7 @@ func (m *ChangeProjectResponse) Reset()         { *m = ChangeProjectResponse{} }
 func (m *ChangeProjectResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeProjectResponse) ProtoMessage()    {}
 func (*ChangeProjectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{88}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{93}
 }
 func (m *ChangeProjectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeProjectResponse.Unmarshal(m, b)
@@ -5879,7 +6264,7 @@ func (m *GetProjectRequest) Reset()         { *m = GetProjectRequest{} }
 func (m *GetProjectRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProjectRequest) ProtoMessage()    {}
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{89}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{94}
 }
 func (m *GetProjectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectRequest.Unmarshal(m, b)
@@ -5935,7 +6320,7 @@ func (m *ListProjectsRequest) Reset()         { *m = ListProjectsRequest{} }
 func (m *ListProjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsRequest) ProtoMessage()    {}
 func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{90}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{95}
 }
 func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsRequest.Unmarshal(m, b)
@@ -6011,7 +6396,7 @@ func (m *ListProjectsResponse) Reset()         { *m = ListProjectsResponse{} }
 func (m *ListProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsResponse) ProtoMessage()    {}
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{91}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{96}
 }
 func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsResponse.Unmarshal(m, b)
@@ -6059,7 +6444,7 @@ func (m *TokenRequest) Reset()         { *m = TokenRequest{} }
 func (m *TokenRequest) String() string { return proto.CompactTextString(m) }
 func (*TokenRequest) ProtoMessage()    {}
 func (*TokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{92}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{97}
 }
 func (m *TokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenRequest.Unmarshal(m, b)
@@ -6106,7 +6491,7 @@ func (m *TokenResponse) Reset()         { *m = TokenResponse{} }
 func (m *TokenResponse) String() string { return proto.CompactTextString(m) }
 func (*TokenResponse) ProtoMessage()    {}
 func (*TokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{93}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{98}
 }
 func (m *TokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenResponse.Unmarshal(m, b)
@@ -6160,7 +6545,7 @@ func (m *CheckProjectRequestSignatureRequest) Reset()         { *m = CheckProjec
 func (m *CheckProjectRequestSignatureRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckProjectRequestSignatureRequest) ProtoMessage()    {}
 func (*CheckProjectRequestSignatureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{94}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{99}
 }
 func (m *CheckProjectRequestSignatureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckProjectRequestSignatureRequest.Unmarshal(m, b)
@@ -6213,7 +6598,7 @@ func (m *CheckProjectRequestSignatureResponse) Reset()         { *m = CheckProje
 func (m *CheckProjectRequestSignatureResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckProjectRequestSignatureResponse) ProtoMessage()    {}
 func (*CheckProjectRequestSignatureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{95}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{100}
 }
 func (m *CheckProjectRequestSignatureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckProjectRequestSignatureResponse.Unmarshal(m, b)
@@ -6291,7 +6676,7 @@ func (m *ListOrdersRequest) Reset()         { *m = ListOrdersRequest{} }
 func (m *ListOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListOrdersRequest) ProtoMessage()    {}
 func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{96}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{101}
 }
 func (m *ListOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListOrdersRequest.Unmarshal(m, b)
@@ -6444,7 +6829,7 @@ func (m *GetOrderRequest) Reset()         { *m = GetOrderRequest{} }
 func (m *GetOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderRequest) ProtoMessage()    {}
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{97}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{102}
 }
 func (m *GetOrderRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOrderRequest.Unmarshal(m, b)
@@ -6490,7 +6875,7 @@ func (m *IsOrderCanBePayingRequest) Reset()         { *m = IsOrderCanBePayingReq
 func (m *IsOrderCanBePayingRequest) String() string { return proto.CompactTextString(m) }
 func (*IsOrderCanBePayingRequest) ProtoMessage()    {}
 func (*IsOrderCanBePayingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{98}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{103}
 }
 func (m *IsOrderCanBePayingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsOrderCanBePayingRequest.Unmarshal(m, b)
@@ -6537,7 +6922,7 @@ func (m *IsOrderCanBePayingResponse) Reset()         { *m = IsOrderCanBePayingRe
 func (m *IsOrderCanBePayingResponse) String() string { return proto.CompactTextString(m) }
 func (*IsOrderCanBePayingResponse) ProtoMessage()    {}
 func (*IsOrderCanBePayingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{99}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{104}
 }
 func (m *IsOrderCanBePayingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsOrderCanBePayingResponse.Unmarshal(m, b)
@@ -6594,7 +6979,7 @@ func (m *SetUserNotifyRequest) Reset()         { *m = SetUserNotifyRequest{} }
 func (m *SetUserNotifyRequest) String() string { return proto.CompactTextString(m) }
 func (*SetUserNotifyRequest) ProtoMessage()    {}
 func (*SetUserNotifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{100}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{105}
 }
 func (m *SetUserNotifyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetUserNotifyRequest.Unmarshal(m, b)
@@ -6653,7 +7038,7 @@ func (m *NotifyUserSales) Reset()         { *m = NotifyUserSales{} }
 func (m *NotifyUserSales) String() string { return proto.CompactTextString(m) }
 func (*NotifyUserSales) ProtoMessage()    {}
 func (*NotifyUserSales) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{101}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{106}
 }
 func (m *NotifyUserSales) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyUserSales.Unmarshal(m, b)
@@ -6721,7 +7106,7 @@ func (m *NotifyUserNewRegion) Reset()         { *m = NotifyUserNewRegion{} }
 func (m *NotifyUserNewRegion) String() string { return proto.CompactTextString(m) }
 func (*NotifyUserNewRegion) ProtoMessage()    {}
 func (*NotifyUserNewRegion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{102}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{107}
 }
 func (m *NotifyUserNewRegion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyUserNewRegion.Unmarshal(m, b)
@@ -6790,7 +7175,7 @@ func (m *GetPaymentMethodSettingsRequest) Reset()         { *m = GetPaymentMetho
 func (m *GetPaymentMethodSettingsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPaymentMethodSettingsRequest) ProtoMessage()    {}
 func (*GetPaymentMethodSettingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{103}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{108}
 }
 func (m *GetPaymentMethodSettingsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPaymentMethodSettingsRequest.Unmarshal(m, b)
@@ -6836,7 +7221,7 @@ func (m *GetPaymentMethodSettingsResponse) Reset()         { *m = GetPaymentMeth
 func (m *GetPaymentMethodSettingsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPaymentMethodSettingsResponse) ProtoMessage()    {}
 func (*GetPaymentMethodSettingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{104}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{109}
 }
 func (m *GetPaymentMethodSettingsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPaymentMethodSettingsResponse.Unmarshal(m, b)
@@ -6877,7 +7262,7 @@ func (m *ChangePaymentMethodRequest) Reset()         { *m = ChangePaymentMethodR
 func (m *ChangePaymentMethodRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePaymentMethodRequest) ProtoMessage()    {}
 func (*ChangePaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{105}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{110}
 }
 func (m *ChangePaymentMethodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePaymentMethodRequest.Unmarshal(m, b)
@@ -6925,7 +7310,7 @@ func (m *ChangePaymentMethodParamsRequest) Reset()         { *m = ChangePaymentM
 func (m *ChangePaymentMethodParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePaymentMethodParamsRequest) ProtoMessage()    {}
 func (*ChangePaymentMethodParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{106}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{111}
 }
 func (m *ChangePaymentMethodParamsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePaymentMethodParamsRequest.Unmarshal(m, b)
@@ -6971,7 +7356,7 @@ func (m *ChangePaymentMethodResponse) Reset()         { *m = ChangePaymentMethod
 func (m *ChangePaymentMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePaymentMethodResponse) ProtoMessage()    {}
 func (*ChangePaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{107}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{112}
 }
 func (m *ChangePaymentMethodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePaymentMethodResponse.Unmarshal(m, b)
@@ -7017,7 +7402,7 @@ func (m *ChangePaymentMethodParamsResponse) Reset()         { *m = ChangePayment
 func (m *ChangePaymentMethodParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePaymentMethodParamsResponse) ProtoMessage()    {}
 func (*ChangePaymentMethodParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{108}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{113}
 }
 func (m *ChangePaymentMethodParamsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePaymentMethodParamsResponse.Unmarshal(m, b)
@@ -7069,7 +7454,7 @@ func (m *FindByZipCodeRequest) Reset()         { *m = FindByZipCodeRequest{} }
 func (m *FindByZipCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*FindByZipCodeRequest) ProtoMessage()    {}
 func (*FindByZipCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{109}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{114}
 }
 func (m *FindByZipCodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindByZipCodeRequest.Unmarshal(m, b)
@@ -7129,7 +7514,7 @@ func (m *FindByZipCodeResponse) Reset()         { *m = FindByZipCodeResponse{} }
 func (m *FindByZipCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*FindByZipCodeResponse) ProtoMessage()    {}
 func (*FindByZipCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{110}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{115}
 }
 func (m *FindByZipCodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindByZipCodeResponse.Unmarshal(m, b)
@@ -7177,7 +7562,7 @@ func (m *ResponseError) Reset()         { *m = ResponseError{} }
 func (m *ResponseError) String() string { return proto.CompactTextString(m) }
 func (*ResponseError) ProtoMessage()    {}
 func (*ResponseError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{111}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{116}
 }
 func (m *ResponseError) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseError.Unmarshal(m, b)
@@ -7227,7 +7612,7 @@ func (m *ResponseErrorMessage) Reset()         { *m = ResponseErrorMessage{} }
 func (m *ResponseErrorMessage) String() string { return proto.CompactTextString(m) }
 func (*ResponseErrorMessage) ProtoMessage()    {}
 func (*ResponseErrorMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{112}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{117}
 }
 func (m *ResponseErrorMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseErrorMessage.Unmarshal(m, b)
@@ -7298,7 +7683,7 @@ func (m *CreateAccountingEntryRequest) Reset()         { *m = CreateAccountingEn
 func (m *CreateAccountingEntryRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountingEntryRequest) ProtoMessage()    {}
 func (*CreateAccountingEntryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{113}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{118}
 }
 func (m *CreateAccountingEntryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateAccountingEntryRequest.Unmarshal(m, b)
@@ -7401,7 +7786,7 @@ func (m *CreateAccountingEntryResponse) Reset()         { *m = CreateAccountingE
 func (m *CreateAccountingEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountingEntryResponse) ProtoMessage()    {}
 func (*CreateAccountingEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{114}
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{119}
 }
 func (m *CreateAccountingEntryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateAccountingEntryResponse.Unmarshal(m, b)
@@ -7442,6589 +7827,10161 @@ func (m *CreateAccountingEntryResponse) GetItem() *billing.AccountingEntry {
 	return nil
 }
 
-type CreateRoyaltyReportRequest struct {
-	Merchants            []string `protobuf:"bytes,1,rep,name=merchants,proto3" json:"merchants,omitempty"`
+type LedgerTrialBalanceRequest struct {
+	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"date_from" validate:"omitempty,numeric,gt=0"
+	DateFrom int64 `protobuf:"varint,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: json:"date_to" validate:"omitempty,numeric,gt=0"
+	DateTo               int64    `protobuf:"varint,4,opt,name=date_to,json=dateTo,proto3" json:"date_to" validate:"omitempty,numeric,gt=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *CreateRoyaltyReportRequest) Reset()         { *m = CreateRoyaltyReportRequest{} }
-func (m *CreateRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateRoyaltyReportRequest) ProtoMessage()    {}
-func (*CreateRoyaltyReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{115}
+func (m *LedgerTrialBalanceRequest) Reset()         { *m = LedgerTrialBalanceRequest{} }
+func (m *LedgerTrialBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*LedgerTrialBalanceRequest) ProtoMessage()    {}
+func (*LedgerTrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{120}
 }
-func (m *CreateRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateRoyaltyReportRequest.Unmarshal(m, b)
+func (m *LedgerTrialBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerTrialBalanceRequest.Unmarshal(m, b)
 }
-func (m *CreateRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateRoyaltyReportRequest.Marshal(b, m, deterministic)
+func (m *LedgerTrialBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerTrialBalanceRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateRoyaltyReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateRoyaltyReportRequest.Merge(dst, src)
+func (dst *LedgerTrialBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerTrialBalanceRequest.Merge(dst, src)
 }
-func (m *CreateRoyaltyReportRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateRoyaltyReportRequest.Size(m)
+func (m *LedgerTrialBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_LedgerTrialBalanceRequest.Size(m)
 }
-func (m *CreateRoyaltyReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateRoyaltyReportRequest.DiscardUnknown(m)
+func (m *LedgerTrialBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerTrialBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateRoyaltyReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_LedgerTrialBalanceRequest proto.InternalMessageInfo
 
-func (m *CreateRoyaltyReportRequest) GetMerchants() []string {
+func (m *LedgerTrialBalanceRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Merchants
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-type ListRoyaltyReportsRequest struct {
-	// @inject_tag: query:"period_from" validate:"omitempty,numeric,gt=0"
-	PeriodFrom int64 `protobuf:"varint,1,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty" query:"period_from" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"period_to" validate:"omitempty,numeric,gt=0"
-	PeriodTo int64 `protobuf:"varint,2,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty" query:"period_to" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"merchant_id" validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: query:"status[]" validate:"omitempty,dive,oneof=pending accepted canceled dispute waiting_payment paid"
-	Status []string `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,oneof=pending accepted canceled dispute waiting_payment paid"`
-	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
-	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
-	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *LedgerTrialBalanceRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
 
-func (m *ListRoyaltyReportsRequest) Reset()         { *m = ListRoyaltyReportsRequest{} }
-func (m *ListRoyaltyReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListRoyaltyReportsRequest) ProtoMessage()    {}
-func (*ListRoyaltyReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{116}
+func (m *LedgerTrialBalanceRequest) GetDateFrom() int64 {
+	if m != nil {
+		return m.DateFrom
+	}
+	return 0
 }
-func (m *ListRoyaltyReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListRoyaltyReportsRequest.Unmarshal(m, b)
+
+func (m *LedgerTrialBalanceRequest) GetDateTo() int64 {
+	if m != nil {
+		return m.DateTo
+	}
+	return 0
 }
-func (m *ListRoyaltyReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListRoyaltyReportsRequest.Marshal(b, m, deterministic)
+
+type LedgerTrialBalanceResponse struct {
+	Status               int32                       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.LedgerTrialBalance `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (dst *ListRoyaltyReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRoyaltyReportsRequest.Merge(dst, src)
+
+func (m *LedgerTrialBalanceResponse) Reset()         { *m = LedgerTrialBalanceResponse{} }
+func (m *LedgerTrialBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*LedgerTrialBalanceResponse) ProtoMessage()    {}
+func (*LedgerTrialBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{121}
 }
-func (m *ListRoyaltyReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListRoyaltyReportsRequest.Size(m)
+func (m *LedgerTrialBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerTrialBalanceResponse.Unmarshal(m, b)
 }
-func (m *ListRoyaltyReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListRoyaltyReportsRequest.DiscardUnknown(m)
+func (m *LedgerTrialBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerTrialBalanceResponse.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_ListRoyaltyReportsRequest proto.InternalMessageInfo
-
-func (m *ListRoyaltyReportsRequest) GetPeriodFrom() int64 {
-	if m != nil {
-		return m.PeriodFrom
-	}
-	return 0
+func (dst *LedgerTrialBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerTrialBalanceResponse.Merge(dst, src)
+}
+func (m *LedgerTrialBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_LedgerTrialBalanceResponse.Size(m)
+}
+func (m *LedgerTrialBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerTrialBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *ListRoyaltyReportsRequest) GetPeriodTo() int64 {
+var xxx_messageInfo_LedgerTrialBalanceResponse proto.InternalMessageInfo
+
+func (m *LedgerTrialBalanceResponse) GetStatus() int32 {
 	if m != nil {
-		return m.PeriodTo
+		return m.Status
 	}
 	return 0
 }
 
-func (m *ListRoyaltyReportsRequest) GetMerchantId() string {
+func (m *LedgerTrialBalanceResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.MerchantId
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *ListRoyaltyReportsRequest) GetStatus() []string {
+func (m *LedgerTrialBalanceResponse) GetItem() *billing.LedgerTrialBalance {
 	if m != nil {
-		return m.Status
+		return m.Item
 	}
 	return nil
 }
 
-func (m *ListRoyaltyReportsRequest) GetLimit() int32 {
-	if m != nil {
-		return m.Limit
-	}
-	return 0
+type ImportReconciliationReportRequest struct {
+	// @inject_tag: json:"payment_system_handler" validate:"required"
+	PaymentSystemHandler string `protobuf:"bytes,1,opt,name=payment_system_handler,json=paymentSystemHandler,proto3" json:"payment_system_handler" validate:"required"`
+	// @inject_tag: json:"file_name" validate:"required"
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name" validate:"required"`
+	// @inject_tag: json:"content" validate:"required"
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content" validate:"required"`
+	// @inject_tag: json:"date_from" validate:"omitempty,numeric,gt=0"
+	DateFrom int64 `protobuf:"varint,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: json:"date_to" validate:"omitempty,numeric,gt=0"
+	DateTo               int64    `protobuf:"varint,5,opt,name=date_to,json=dateTo,proto3" json:"date_to" validate:"omitempty,numeric,gt=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListRoyaltyReportsRequest) GetOffset() int32 {
-	if m != nil {
-		return m.Offset
-	}
-	return 0
+func (m *ImportReconciliationReportRequest) Reset()         { *m = ImportReconciliationReportRequest{} }
+func (m *ImportReconciliationReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportReconciliationReportRequest) ProtoMessage()    {}
+func (*ImportReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{122}
 }
-
-type RoyaltyReportsPaginate struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*billing.RoyaltyReport `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ImportReconciliationReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportReconciliationReportRequest.Unmarshal(m, b)
 }
-
-func (m *RoyaltyReportsPaginate) Reset()         { *m = RoyaltyReportsPaginate{} }
-func (m *RoyaltyReportsPaginate) String() string { return proto.CompactTextString(m) }
-func (*RoyaltyReportsPaginate) ProtoMessage()    {}
-func (*RoyaltyReportsPaginate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{117}
+func (m *ImportReconciliationReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportReconciliationReportRequest.Marshal(b, m, deterministic)
 }
-func (m *RoyaltyReportsPaginate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoyaltyReportsPaginate.Unmarshal(m, b)
+func (dst *ImportReconciliationReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportReconciliationReportRequest.Merge(dst, src)
 }
-func (m *RoyaltyReportsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoyaltyReportsPaginate.Marshal(b, m, deterministic)
+func (m *ImportReconciliationReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportReconciliationReportRequest.Size(m)
 }
-func (dst *RoyaltyReportsPaginate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoyaltyReportsPaginate.Merge(dst, src)
+func (m *ImportReconciliationReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportReconciliationReportRequest.DiscardUnknown(m)
 }
-func (m *RoyaltyReportsPaginate) XXX_Size() int {
-	return xxx_messageInfo_RoyaltyReportsPaginate.Size(m)
+
+var xxx_messageInfo_ImportReconciliationReportRequest proto.InternalMessageInfo
+
+func (m *ImportReconciliationReportRequest) GetPaymentSystemHandler() string {
+	if m != nil {
+		return m.PaymentSystemHandler
+	}
+	return ""
 }
-func (m *RoyaltyReportsPaginate) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoyaltyReportsPaginate.DiscardUnknown(m)
+
+func (m *ImportReconciliationReportRequest) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
 }
 
-var xxx_messageInfo_RoyaltyReportsPaginate proto.InternalMessageInfo
+func (m *ImportReconciliationReportRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
 
-func (m *RoyaltyReportsPaginate) GetCount() int32 {
+func (m *ImportReconciliationReportRequest) GetDateFrom() int64 {
 	if m != nil {
-		return m.Count
+		return m.DateFrom
 	}
 	return 0
 }
 
-func (m *RoyaltyReportsPaginate) GetItems() []*billing.RoyaltyReport {
+func (m *ImportReconciliationReportRequest) GetDateTo() int64 {
 	if m != nil {
-		return m.Items
+		return m.DateTo
 	}
-	return nil
+	return 0
 }
 
-type ListRoyaltyReportsResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"data"
-	Data                 *RoyaltyReportsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+type ReconciliationRunResponse struct {
+	Status               int32                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.ReconciliationRun `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListRoyaltyReportsResponse) Reset()         { *m = ListRoyaltyReportsResponse{} }
-func (m *ListRoyaltyReportsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListRoyaltyReportsResponse) ProtoMessage()    {}
-func (*ListRoyaltyReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{118}
+func (m *ReconciliationRunResponse) Reset()         { *m = ReconciliationRunResponse{} }
+func (m *ReconciliationRunResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationRunResponse) ProtoMessage()    {}
+func (*ReconciliationRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{123}
 }
-func (m *ListRoyaltyReportsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListRoyaltyReportsResponse.Unmarshal(m, b)
+func (m *ReconciliationRunResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationRunResponse.Unmarshal(m, b)
 }
-func (m *ListRoyaltyReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListRoyaltyReportsResponse.Marshal(b, m, deterministic)
+func (m *ReconciliationRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationRunResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListRoyaltyReportsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRoyaltyReportsResponse.Merge(dst, src)
+func (dst *ReconciliationRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationRunResponse.Merge(dst, src)
 }
-func (m *ListRoyaltyReportsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListRoyaltyReportsResponse.Size(m)
+func (m *ReconciliationRunResponse) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationRunResponse.Size(m)
 }
-func (m *ListRoyaltyReportsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListRoyaltyReportsResponse.DiscardUnknown(m)
+func (m *ReconciliationRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationRunResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListRoyaltyReportsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReconciliationRunResponse proto.InternalMessageInfo
 
-func (m *ListRoyaltyReportsResponse) GetStatus() int32 {
+func (m *ReconciliationRunResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *ListRoyaltyReportsResponse) GetMessage() *ResponseErrorMessage {
+func (m *ReconciliationRunResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *ListRoyaltyReportsResponse) GetData() *RoyaltyReportsPaginate {
+func (m *ReconciliationRunResponse) GetItem() *billing.ReconciliationRun {
 	if m != nil {
-		return m.Data
+		return m.Item
 	}
 	return nil
 }
 
-type ListRoyaltyReportOrdersRequest struct {
-	// @inject_tag: query:"report_id" validate:"required,hexadecimal,len=24"
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty" query:"report_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
-	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
-	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+type ListReconciliationRunsRequest struct {
+	// @inject_tag: json:"payment_system_handler"
+	PaymentSystemHandler string `protobuf:"bytes,1,opt,name=payment_system_handler,json=paymentSystemHandler,proto3" json:"payment_system_handler"`
+	// @inject_tag: json:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: json:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset" validate:"omitempty,numeric,gte=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListRoyaltyReportOrdersRequest) Reset()         { *m = ListRoyaltyReportOrdersRequest{} }
-func (m *ListRoyaltyReportOrdersRequest) String() string { return proto.CompactTextString(m) }
-func (*ListRoyaltyReportOrdersRequest) ProtoMessage()    {}
-func (*ListRoyaltyReportOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{119}
+func (m *ListReconciliationRunsRequest) Reset()         { *m = ListReconciliationRunsRequest{} }
+func (m *ListReconciliationRunsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReconciliationRunsRequest) ProtoMessage()    {}
+func (*ListReconciliationRunsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{124}
 }
-func (m *ListRoyaltyReportOrdersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListRoyaltyReportOrdersRequest.Unmarshal(m, b)
+func (m *ListReconciliationRunsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReconciliationRunsRequest.Unmarshal(m, b)
 }
-func (m *ListRoyaltyReportOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListRoyaltyReportOrdersRequest.Marshal(b, m, deterministic)
+func (m *ListReconciliationRunsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReconciliationRunsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListRoyaltyReportOrdersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRoyaltyReportOrdersRequest.Merge(dst, src)
+func (dst *ListReconciliationRunsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReconciliationRunsRequest.Merge(dst, src)
 }
-func (m *ListRoyaltyReportOrdersRequest) XXX_Size() int {
-	return xxx_messageInfo_ListRoyaltyReportOrdersRequest.Size(m)
+func (m *ListReconciliationRunsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReconciliationRunsRequest.Size(m)
 }
-func (m *ListRoyaltyReportOrdersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListRoyaltyReportOrdersRequest.DiscardUnknown(m)
+func (m *ListReconciliationRunsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReconciliationRunsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListRoyaltyReportOrdersRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListReconciliationRunsRequest proto.InternalMessageInfo
 
-func (m *ListRoyaltyReportOrdersRequest) GetReportId() string {
+func (m *ListReconciliationRunsRequest) GetPaymentSystemHandler() string {
 	if m != nil {
-		return m.ReportId
+		return m.PaymentSystemHandler
 	}
 	return ""
 }
 
-func (m *ListRoyaltyReportOrdersRequest) GetLimit() int32 {
+func (m *ListReconciliationRunsRequest) GetLimit() int32 {
 	if m != nil {
 		return m.Limit
 	}
 	return 0
 }
 
-func (m *ListRoyaltyReportOrdersRequest) GetOffset() int32 {
+func (m *ListReconciliationRunsRequest) GetOffset() int32 {
 	if m != nil {
 		return m.Offset
 	}
 	return 0
 }
 
-type ChangeRoyaltyReportCorrection struct {
-	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListReconciliationRunsResponse struct {
+	Status               int32                        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                        `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.ReconciliationRun `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                        `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ChangeRoyaltyReportCorrection) Reset()         { *m = ChangeRoyaltyReportCorrection{} }
-func (m *ChangeRoyaltyReportCorrection) String() string { return proto.CompactTextString(m) }
-func (*ChangeRoyaltyReportCorrection) ProtoMessage()    {}
-func (*ChangeRoyaltyReportCorrection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{120}
+func (m *ListReconciliationRunsResponse) Reset()         { *m = ListReconciliationRunsResponse{} }
+func (m *ListReconciliationRunsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReconciliationRunsResponse) ProtoMessage()    {}
+func (*ListReconciliationRunsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{125}
 }
-func (m *ChangeRoyaltyReportCorrection) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangeRoyaltyReportCorrection.Unmarshal(m, b)
+func (m *ListReconciliationRunsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReconciliationRunsResponse.Unmarshal(m, b)
 }
-func (m *ChangeRoyaltyReportCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangeRoyaltyReportCorrection.Marshal(b, m, deterministic)
+func (m *ListReconciliationRunsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReconciliationRunsResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChangeRoyaltyReportCorrection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeRoyaltyReportCorrection.Merge(dst, src)
+func (dst *ListReconciliationRunsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReconciliationRunsResponse.Merge(dst, src)
 }
-func (m *ChangeRoyaltyReportCorrection) XXX_Size() int {
-	return xxx_messageInfo_ChangeRoyaltyReportCorrection.Size(m)
+func (m *ListReconciliationRunsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReconciliationRunsResponse.Size(m)
 }
-func (m *ChangeRoyaltyReportCorrection) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeRoyaltyReportCorrection.DiscardUnknown(m)
+func (m *ListReconciliationRunsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReconciliationRunsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeRoyaltyReportCorrection proto.InternalMessageInfo
+var xxx_messageInfo_ListReconciliationRunsResponse proto.InternalMessageInfo
 
-func (m *ChangeRoyaltyReportCorrection) GetAmount() float64 {
+func (m *ListReconciliationRunsResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Amount
+		return m.Status
 	}
 	return 0
 }
 
-func (m *ChangeRoyaltyReportCorrection) GetReason() string {
+func (m *ListReconciliationRunsResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Reason
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-type GetRoyaltyReportRequest struct {
-	// @inject_tag: json:"report_id" validate:"required,hexadecimal,len=24"
-	ReportId             string   `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id" validate:"required,hexadecimal,len=24"`
+func (m *ListReconciliationRunsResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListReconciliationRunsResponse) GetItems() []*billing.ReconciliationRun {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListReconciliationLinesRequest struct {
+	// @inject_tag: json:"run_id" validate:"required,hexadecimal,len=24"
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"status"
+	Status []string `protobuf:"bytes,2,rep,name=status,proto3" json:"status"`
+	// @inject_tag: json:"unresolved_only"
+	UnresolvedOnly bool `protobuf:"varint,3,opt,name=unresolved_only,json=unresolvedOnly,proto3" json:"unresolved_only"`
+	// @inject_tag: json:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: json:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset" validate:"omitempty,numeric,gte=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetRoyaltyReportRequest) Reset()         { *m = GetRoyaltyReportRequest{} }
-func (m *GetRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRoyaltyReportRequest) ProtoMessage()    {}
-func (*GetRoyaltyReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{121}
+func (m *ListReconciliationLinesRequest) Reset()         { *m = ListReconciliationLinesRequest{} }
+func (m *ListReconciliationLinesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReconciliationLinesRequest) ProtoMessage()    {}
+func (*ListReconciliationLinesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{126}
 }
-func (m *GetRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRoyaltyReportRequest.Unmarshal(m, b)
+func (m *ListReconciliationLinesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReconciliationLinesRequest.Unmarshal(m, b)
 }
-func (m *GetRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRoyaltyReportRequest.Marshal(b, m, deterministic)
+func (m *ListReconciliationLinesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReconciliationLinesRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetRoyaltyReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRoyaltyReportRequest.Merge(dst, src)
+func (dst *ListReconciliationLinesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReconciliationLinesRequest.Merge(dst, src)
 }
-func (m *GetRoyaltyReportRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRoyaltyReportRequest.Size(m)
+func (m *ListReconciliationLinesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReconciliationLinesRequest.Size(m)
 }
-func (m *GetRoyaltyReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRoyaltyReportRequest.DiscardUnknown(m)
+func (m *ListReconciliationLinesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReconciliationLinesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRoyaltyReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListReconciliationLinesRequest proto.InternalMessageInfo
 
-func (m *GetRoyaltyReportRequest) GetReportId() string {
+func (m *ListReconciliationLinesRequest) GetRunId() string {
 	if m != nil {
-		return m.ReportId
+		return m.RunId
 	}
 	return ""
 }
 
-type GetRoyaltyReportResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.RoyaltyReport `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ListReconciliationLinesRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
 
-func (m *GetRoyaltyReportResponse) Reset()         { *m = GetRoyaltyReportResponse{} }
-func (m *GetRoyaltyReportResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRoyaltyReportResponse) ProtoMessage()    {}
-func (*GetRoyaltyReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{122}
+func (m *ListReconciliationLinesRequest) GetUnresolvedOnly() bool {
+	if m != nil {
+		return m.UnresolvedOnly
+	}
+	return false
 }
-func (m *GetRoyaltyReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRoyaltyReportResponse.Unmarshal(m, b)
+
+func (m *ListReconciliationLinesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
-func (m *GetRoyaltyReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRoyaltyReportResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetRoyaltyReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRoyaltyReportResponse.Merge(dst, src)
-}
-func (m *GetRoyaltyReportResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRoyaltyReportResponse.Size(m)
-}
-func (m *GetRoyaltyReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRoyaltyReportResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetRoyaltyReportResponse proto.InternalMessageInfo
 
-func (m *GetRoyaltyReportResponse) GetStatus() int32 {
+func (m *ListReconciliationLinesRequest) GetOffset() int32 {
 	if m != nil {
-		return m.Status
+		return m.Offset
 	}
 	return 0
 }
 
-func (m *GetRoyaltyReportResponse) GetMessage() *ResponseErrorMessage {
-	if m != nil {
-		return m.Message
-	}
-	return nil
-}
-
-func (m *GetRoyaltyReportResponse) GetItem() *billing.RoyaltyReport {
-	if m != nil {
-		return m.Item
-	}
-	return nil
-}
-
-type ChangeRoyaltyReportRequest struct {
-	// @inject_tag: json:"report_id" validate:"required,hexadecimal,len=24"
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"status" validate:"omitempty,oneof=pending skipped accepted canceled dispute ready_for_invoice payment_in_progress payment_done payment_error"
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status" validate:"omitempty,oneof=pending skipped accepted canceled dispute ready_for_invoice payment_in_progress payment_done payment_error"`
-	// @inject_tag: json:"correction"
-	Correction           *ChangeRoyaltyReportCorrection `protobuf:"bytes,3,opt,name=correction,proto3" json:"correction"`
-	Ip                   string                         `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListReconciliationLinesResponse struct {
+	Status               int32                         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                         `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.ReconciliationLine `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ChangeRoyaltyReportRequest) Reset()         { *m = ChangeRoyaltyReportRequest{} }
-func (m *ChangeRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangeRoyaltyReportRequest) ProtoMessage()    {}
-func (*ChangeRoyaltyReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{123}
+func (m *ListReconciliationLinesResponse) Reset()         { *m = ListReconciliationLinesResponse{} }
+func (m *ListReconciliationLinesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReconciliationLinesResponse) ProtoMessage()    {}
+func (*ListReconciliationLinesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{127}
 }
-func (m *ChangeRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangeRoyaltyReportRequest.Unmarshal(m, b)
+func (m *ListReconciliationLinesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReconciliationLinesResponse.Unmarshal(m, b)
 }
-func (m *ChangeRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangeRoyaltyReportRequest.Marshal(b, m, deterministic)
+func (m *ListReconciliationLinesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReconciliationLinesResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChangeRoyaltyReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeRoyaltyReportRequest.Merge(dst, src)
+func (dst *ListReconciliationLinesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReconciliationLinesResponse.Merge(dst, src)
 }
-func (m *ChangeRoyaltyReportRequest) XXX_Size() int {
-	return xxx_messageInfo_ChangeRoyaltyReportRequest.Size(m)
+func (m *ListReconciliationLinesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReconciliationLinesResponse.Size(m)
 }
-func (m *ChangeRoyaltyReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeRoyaltyReportRequest.DiscardUnknown(m)
+func (m *ListReconciliationLinesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReconciliationLinesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeRoyaltyReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListReconciliationLinesResponse proto.InternalMessageInfo
 
-func (m *ChangeRoyaltyReportRequest) GetReportId() string {
+func (m *ListReconciliationLinesResponse) GetStatus() int32 {
 	if m != nil {
-		return m.ReportId
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *ChangeRoyaltyReportRequest) GetStatus() string {
+func (m *ListReconciliationLinesResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Status
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *ChangeRoyaltyReportRequest) GetCorrection() *ChangeRoyaltyReportCorrection {
+func (m *ListReconciliationLinesResponse) GetCount() int32 {
 	if m != nil {
-		return m.Correction
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-func (m *ChangeRoyaltyReportRequest) GetIp() string {
+func (m *ListReconciliationLinesResponse) GetItems() []*billing.ReconciliationLine {
 	if m != nil {
-		return m.Ip
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-type MerchantReviewRoyaltyReportRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"is_accepted"
-	IsAccepted bool   `protobuf:"varint,2,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted"`
-	Ip         string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
-	// @inject_tag: json:"dispute_reason"
-	DisputeReason        string   `protobuf:"bytes,4,opt,name=dispute_reason,json=disputeReason,proto3" json:"dispute_reason"`
+type ResolveReconciliationLineRequest struct {
+	// @inject_tag: json:"line_id" validate:"required,hexadecimal,len=24"
+	LineId string `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"resolution" validate:"required"
+	Resolution           string   `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution" validate:"required"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MerchantReviewRoyaltyReportRequest) Reset()         { *m = MerchantReviewRoyaltyReportRequest{} }
-func (m *MerchantReviewRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
-func (*MerchantReviewRoyaltyReportRequest) ProtoMessage()    {}
-func (*MerchantReviewRoyaltyReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{124}
-}
-func (m *MerchantReviewRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Unmarshal(m, b)
+func (m *ResolveReconciliationLineRequest) Reset()         { *m = ResolveReconciliationLineRequest{} }
+func (m *ResolveReconciliationLineRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveReconciliationLineRequest) ProtoMessage()    {}
+func (*ResolveReconciliationLineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{128}
 }
-func (m *MerchantReviewRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Marshal(b, m, deterministic)
+func (m *ResolveReconciliationLineRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveReconciliationLineRequest.Unmarshal(m, b)
 }
-func (dst *MerchantReviewRoyaltyReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Merge(dst, src)
+func (m *ResolveReconciliationLineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveReconciliationLineRequest.Marshal(b, m, deterministic)
 }
-func (m *MerchantReviewRoyaltyReportRequest) XXX_Size() int {
-	return xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Size(m)
+func (dst *ResolveReconciliationLineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveReconciliationLineRequest.Merge(dst, src)
 }
-func (m *MerchantReviewRoyaltyReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantReviewRoyaltyReportRequest.DiscardUnknown(m)
+func (m *ResolveReconciliationLineRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveReconciliationLineRequest.Size(m)
 }
-
-var xxx_messageInfo_MerchantReviewRoyaltyReportRequest proto.InternalMessageInfo
-
-func (m *MerchantReviewRoyaltyReportRequest) GetReportId() string {
-	if m != nil {
-		return m.ReportId
-	}
-	return ""
+func (m *ResolveReconciliationLineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveReconciliationLineRequest.DiscardUnknown(m)
 }
 
-func (m *MerchantReviewRoyaltyReportRequest) GetIsAccepted() bool {
-	if m != nil {
-		return m.IsAccepted
-	}
-	return false
-}
+var xxx_messageInfo_ResolveReconciliationLineRequest proto.InternalMessageInfo
 
-func (m *MerchantReviewRoyaltyReportRequest) GetIp() string {
+func (m *ResolveReconciliationLineRequest) GetLineId() string {
 	if m != nil {
-		return m.Ip
+		return m.LineId
 	}
 	return ""
 }
 
-func (m *MerchantReviewRoyaltyReportRequest) GetDisputeReason() string {
+func (m *ResolveReconciliationLineRequest) GetResolution() string {
 	if m != nil {
-		return m.DisputeReason
+		return m.Resolution
 	}
 	return ""
 }
 
-type PaymentChannelCostSystemListResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.PaymentChannelCostSystemList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                 `json:"-" bson:"-" structure:"-" validate:"-"`
+type ReconciliationLineResponse struct {
+	Status               int32                       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.ReconciliationLine `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaymentChannelCostSystemListResponse) Reset()         { *m = PaymentChannelCostSystemListResponse{} }
-func (m *PaymentChannelCostSystemListResponse) String() string { return proto.CompactTextString(m) }
-func (*PaymentChannelCostSystemListResponse) ProtoMessage()    {}
-func (*PaymentChannelCostSystemListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{125}
+func (m *ReconciliationLineResponse) Reset()         { *m = ReconciliationLineResponse{} }
+func (m *ReconciliationLineResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationLineResponse) ProtoMessage()    {}
+func (*ReconciliationLineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{129}
 }
-func (m *PaymentChannelCostSystemListResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentChannelCostSystemListResponse.Unmarshal(m, b)
+func (m *ReconciliationLineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationLineResponse.Unmarshal(m, b)
 }
-func (m *PaymentChannelCostSystemListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentChannelCostSystemListResponse.Marshal(b, m, deterministic)
+func (m *ReconciliationLineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationLineResponse.Marshal(b, m, deterministic)
 }
-func (dst *PaymentChannelCostSystemListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentChannelCostSystemListResponse.Merge(dst, src)
+func (dst *ReconciliationLineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationLineResponse.Merge(dst, src)
 }
-func (m *PaymentChannelCostSystemListResponse) XXX_Size() int {
-	return xxx_messageInfo_PaymentChannelCostSystemListResponse.Size(m)
+func (m *ReconciliationLineResponse) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationLineResponse.Size(m)
 }
-func (m *PaymentChannelCostSystemListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentChannelCostSystemListResponse.DiscardUnknown(m)
+func (m *ReconciliationLineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationLineResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentChannelCostSystemListResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReconciliationLineResponse proto.InternalMessageInfo
 
-func (m *PaymentChannelCostSystemListResponse) GetStatus() int32 {
+func (m *ReconciliationLineResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *PaymentChannelCostSystemListResponse) GetMessage() *ResponseErrorMessage {
+func (m *ReconciliationLineResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *PaymentChannelCostSystemListResponse) GetItem() *billing.PaymentChannelCostSystemList {
+func (m *ReconciliationLineResponse) GetItem() *billing.ReconciliationLine {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type PaymentChannelCostSystemResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.PaymentChannelCostSystem `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                             `json:"-" bson:"-" structure:"-" validate:"-"`
+type CreateRoyaltyReportRequest struct {
+	Merchants            []string `protobuf:"bytes,1,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaymentChannelCostSystemResponse) Reset()         { *m = PaymentChannelCostSystemResponse{} }
-func (m *PaymentChannelCostSystemResponse) String() string { return proto.CompactTextString(m) }
-func (*PaymentChannelCostSystemResponse) ProtoMessage()    {}
-func (*PaymentChannelCostSystemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{126}
-}
-func (m *PaymentChannelCostSystemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentChannelCostSystemResponse.Unmarshal(m, b)
+func (m *CreateRoyaltyReportRequest) Reset()         { *m = CreateRoyaltyReportRequest{} }
+func (m *CreateRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoyaltyReportRequest) ProtoMessage()    {}
+func (*CreateRoyaltyReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{130}
 }
-func (m *PaymentChannelCostSystemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentChannelCostSystemResponse.Marshal(b, m, deterministic)
+func (m *CreateRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoyaltyReportRequest.Unmarshal(m, b)
 }
-func (dst *PaymentChannelCostSystemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentChannelCostSystemResponse.Merge(dst, src)
+func (m *CreateRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoyaltyReportRequest.Marshal(b, m, deterministic)
 }
-func (m *PaymentChannelCostSystemResponse) XXX_Size() int {
-	return xxx_messageInfo_PaymentChannelCostSystemResponse.Size(m)
+func (dst *CreateRoyaltyReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoyaltyReportRequest.Merge(dst, src)
 }
-func (m *PaymentChannelCostSystemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentChannelCostSystemResponse.DiscardUnknown(m)
+func (m *CreateRoyaltyReportRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoyaltyReportRequest.Size(m)
 }
-
-var xxx_messageInfo_PaymentChannelCostSystemResponse proto.InternalMessageInfo
-
-func (m *PaymentChannelCostSystemResponse) GetStatus() int32 {
-	if m != nil {
-		return m.Status
-	}
-	return 0
+func (m *CreateRoyaltyReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoyaltyReportRequest.DiscardUnknown(m)
 }
 
-func (m *PaymentChannelCostSystemResponse) GetMessage() *ResponseErrorMessage {
-	if m != nil {
-		return m.Message
-	}
-	return nil
-}
+var xxx_messageInfo_CreateRoyaltyReportRequest proto.InternalMessageInfo
 
-func (m *PaymentChannelCostSystemResponse) GetItem() *billing.PaymentChannelCostSystem {
+func (m *CreateRoyaltyReportRequest) GetMerchants() []string {
 	if m != nil {
-		return m.Item
+		return m.Merchants
 	}
 	return nil
 }
 
-type PaymentChannelCostMerchantListResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.PaymentChannelCostMerchantList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                   `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListRoyaltyReportsRequest struct {
+	// @inject_tag: query:"period_from" validate:"omitempty,numeric,gt=0"
+	PeriodFrom int64 `protobuf:"varint,1,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty" query:"period_from" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"period_to" validate:"omitempty,numeric,gt=0"
+	PeriodTo int64 `protobuf:"varint,2,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty" query:"period_to" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"status[]" validate:"omitempty,dive,oneof=pending accepted canceled dispute waiting_payment paid"
+	Status []string `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,oneof=pending accepted canceled dispute waiting_payment paid"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaymentChannelCostMerchantListResponse) Reset() {
-	*m = PaymentChannelCostMerchantListResponse{}
+func (m *ListRoyaltyReportsRequest) Reset()         { *m = ListRoyaltyReportsRequest{} }
+func (m *ListRoyaltyReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoyaltyReportsRequest) ProtoMessage()    {}
+func (*ListRoyaltyReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{131}
 }
-func (m *PaymentChannelCostMerchantListResponse) String() string { return proto.CompactTextString(m) }
-func (*PaymentChannelCostMerchantListResponse) ProtoMessage()    {}
-func (*PaymentChannelCostMerchantListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{127}
-}
-func (m *PaymentChannelCostMerchantListResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentChannelCostMerchantListResponse.Unmarshal(m, b)
+func (m *ListRoyaltyReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoyaltyReportsRequest.Unmarshal(m, b)
 }
-func (m *PaymentChannelCostMerchantListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentChannelCostMerchantListResponse.Marshal(b, m, deterministic)
+func (m *ListRoyaltyReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoyaltyReportsRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentChannelCostMerchantListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentChannelCostMerchantListResponse.Merge(dst, src)
+func (dst *ListRoyaltyReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoyaltyReportsRequest.Merge(dst, src)
 }
-func (m *PaymentChannelCostMerchantListResponse) XXX_Size() int {
-	return xxx_messageInfo_PaymentChannelCostMerchantListResponse.Size(m)
+func (m *ListRoyaltyReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRoyaltyReportsRequest.Size(m)
 }
-func (m *PaymentChannelCostMerchantListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentChannelCostMerchantListResponse.DiscardUnknown(m)
+func (m *ListRoyaltyReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoyaltyReportsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentChannelCostMerchantListResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListRoyaltyReportsRequest proto.InternalMessageInfo
 
-func (m *PaymentChannelCostMerchantListResponse) GetStatus() int32 {
+func (m *ListRoyaltyReportsRequest) GetPeriodFrom() int64 {
 	if m != nil {
-		return m.Status
+		return m.PeriodFrom
 	}
 	return 0
 }
 
-func (m *PaymentChannelCostMerchantListResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListRoyaltyReportsRequest) GetPeriodTo() int64 {
 	if m != nil {
-		return m.Message
+		return m.PeriodTo
 	}
-	return nil
+	return 0
 }
 
-func (m *PaymentChannelCostMerchantListResponse) GetItem() *billing.PaymentChannelCostMerchantList {
+func (m *ListRoyaltyReportsRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Item
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListRoyaltyReportsRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-type PaymentChannelCostMerchantResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.PaymentChannelCostMerchant `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                               `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ListRoyaltyReportsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *PaymentChannelCostMerchantResponse) Reset()         { *m = PaymentChannelCostMerchantResponse{} }
-func (m *PaymentChannelCostMerchantResponse) String() string { return proto.CompactTextString(m) }
-func (*PaymentChannelCostMerchantResponse) ProtoMessage()    {}
-func (*PaymentChannelCostMerchantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{128}
+func (m *ListRoyaltyReportsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
-func (m *PaymentChannelCostMerchantResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentChannelCostMerchantResponse.Unmarshal(m, b)
+
+type RoyaltyReportsPaginate struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*billing.RoyaltyReport `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *PaymentChannelCostMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentChannelCostMerchantResponse.Marshal(b, m, deterministic)
+
+func (m *RoyaltyReportsPaginate) Reset()         { *m = RoyaltyReportsPaginate{} }
+func (m *RoyaltyReportsPaginate) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportsPaginate) ProtoMessage()    {}
+func (*RoyaltyReportsPaginate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{132}
 }
-func (dst *PaymentChannelCostMerchantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentChannelCostMerchantResponse.Merge(dst, src)
+func (m *RoyaltyReportsPaginate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportsPaginate.Unmarshal(m, b)
 }
-func (m *PaymentChannelCostMerchantResponse) XXX_Size() int {
-	return xxx_messageInfo_PaymentChannelCostMerchantResponse.Size(m)
+func (m *RoyaltyReportsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportsPaginate.Marshal(b, m, deterministic)
 }
-func (m *PaymentChannelCostMerchantResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentChannelCostMerchantResponse.DiscardUnknown(m)
+func (dst *RoyaltyReportsPaginate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportsPaginate.Merge(dst, src)
+}
+func (m *RoyaltyReportsPaginate) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportsPaginate.Size(m)
+}
+func (m *RoyaltyReportsPaginate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportsPaginate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentChannelCostMerchantResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoyaltyReportsPaginate proto.InternalMessageInfo
 
-func (m *PaymentChannelCostMerchantResponse) GetStatus() int32 {
+func (m *RoyaltyReportsPaginate) GetCount() int32 {
 	if m != nil {
-		return m.Status
+		return m.Count
 	}
 	return 0
 }
 
-func (m *PaymentChannelCostMerchantResponse) GetMessage() *ResponseErrorMessage {
-	if m != nil {
-		return m.Message
-	}
-	return nil
-}
-
-func (m *PaymentChannelCostMerchantResponse) GetItem() *billing.PaymentChannelCostMerchant {
+func (m *RoyaltyReportsPaginate) GetItems() []*billing.RoyaltyReport {
 	if m != nil {
-		return m.Item
+		return m.Items
 	}
 	return nil
 }
 
-type MoneyBackCostSystemListResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.MoneyBackCostSystemList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                            `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListRoyaltyReportsResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"data"
+	Data                 *RoyaltyReportsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MoneyBackCostSystemListResponse) Reset()         { *m = MoneyBackCostSystemListResponse{} }
-func (m *MoneyBackCostSystemListResponse) String() string { return proto.CompactTextString(m) }
-func (*MoneyBackCostSystemListResponse) ProtoMessage()    {}
-func (*MoneyBackCostSystemListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{129}
+func (m *ListRoyaltyReportsResponse) Reset()         { *m = ListRoyaltyReportsResponse{} }
+func (m *ListRoyaltyReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRoyaltyReportsResponse) ProtoMessage()    {}
+func (*ListRoyaltyReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{133}
 }
-func (m *MoneyBackCostSystemListResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MoneyBackCostSystemListResponse.Unmarshal(m, b)
+func (m *ListRoyaltyReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoyaltyReportsResponse.Unmarshal(m, b)
 }
-func (m *MoneyBackCostSystemListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MoneyBackCostSystemListResponse.Marshal(b, m, deterministic)
+func (m *ListRoyaltyReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoyaltyReportsResponse.Marshal(b, m, deterministic)
 }
-func (dst *MoneyBackCostSystemListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MoneyBackCostSystemListResponse.Merge(dst, src)
+func (dst *ListRoyaltyReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoyaltyReportsResponse.Merge(dst, src)
 }
-func (m *MoneyBackCostSystemListResponse) XXX_Size() int {
-	return xxx_messageInfo_MoneyBackCostSystemListResponse.Size(m)
+func (m *ListRoyaltyReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRoyaltyReportsResponse.Size(m)
 }
-func (m *MoneyBackCostSystemListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MoneyBackCostSystemListResponse.DiscardUnknown(m)
+func (m *ListRoyaltyReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoyaltyReportsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MoneyBackCostSystemListResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListRoyaltyReportsResponse proto.InternalMessageInfo
 
-func (m *MoneyBackCostSystemListResponse) GetStatus() int32 {
+func (m *ListRoyaltyReportsResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *MoneyBackCostSystemListResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListRoyaltyReportsResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *MoneyBackCostSystemListResponse) GetItem() *billing.MoneyBackCostSystemList {
+func (m *ListRoyaltyReportsResponse) GetData() *RoyaltyReportsPaginate {
 	if m != nil {
-		return m.Item
+		return m.Data
 	}
 	return nil
 }
 
-type MoneyBackCostSystemResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.MoneyBackCostSystem `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                        `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListRoyaltyReportOrdersRequest struct {
+	// @inject_tag: query:"report_id" validate:"required,hexadecimal,len=24"
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty" query:"report_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MoneyBackCostSystemResponse) Reset()         { *m = MoneyBackCostSystemResponse{} }
-func (m *MoneyBackCostSystemResponse) String() string { return proto.CompactTextString(m) }
-func (*MoneyBackCostSystemResponse) ProtoMessage()    {}
-func (*MoneyBackCostSystemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{130}
+func (m *ListRoyaltyReportOrdersRequest) Reset()         { *m = ListRoyaltyReportOrdersRequest{} }
+func (m *ListRoyaltyReportOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoyaltyReportOrdersRequest) ProtoMessage()    {}
+func (*ListRoyaltyReportOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{134}
 }
-func (m *MoneyBackCostSystemResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MoneyBackCostSystemResponse.Unmarshal(m, b)
+func (m *ListRoyaltyReportOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoyaltyReportOrdersRequest.Unmarshal(m, b)
 }
-func (m *MoneyBackCostSystemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MoneyBackCostSystemResponse.Marshal(b, m, deterministic)
+func (m *ListRoyaltyReportOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoyaltyReportOrdersRequest.Marshal(b, m, deterministic)
 }
-func (dst *MoneyBackCostSystemResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MoneyBackCostSystemResponse.Merge(dst, src)
+func (dst *ListRoyaltyReportOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoyaltyReportOrdersRequest.Merge(dst, src)
 }
-func (m *MoneyBackCostSystemResponse) XXX_Size() int {
-	return xxx_messageInfo_MoneyBackCostSystemResponse.Size(m)
+func (m *ListRoyaltyReportOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRoyaltyReportOrdersRequest.Size(m)
 }
-func (m *MoneyBackCostSystemResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MoneyBackCostSystemResponse.DiscardUnknown(m)
+func (m *ListRoyaltyReportOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoyaltyReportOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MoneyBackCostSystemResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListRoyaltyReportOrdersRequest proto.InternalMessageInfo
 
-func (m *MoneyBackCostSystemResponse) GetStatus() int32 {
+func (m *ListRoyaltyReportOrdersRequest) GetReportId() string {
 	if m != nil {
-		return m.Status
+		return m.ReportId
 	}
-	return 0
+	return ""
 }
 
-func (m *MoneyBackCostSystemResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListRoyaltyReportOrdersRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Message
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *MoneyBackCostSystemResponse) GetItem() *billing.MoneyBackCostSystem {
+func (m *ListRoyaltyReportOrdersRequest) GetOffset() int32 {
 	if m != nil {
-		return m.Item
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-type MoneyBackCostMerchantListResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.MoneyBackCostMerchantList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                              `json:"-" bson:"-" structure:"-" validate:"-"`
+type ChangeRoyaltyReportCorrection struct {
+	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MoneyBackCostMerchantListResponse) Reset()         { *m = MoneyBackCostMerchantListResponse{} }
-func (m *MoneyBackCostMerchantListResponse) String() string { return proto.CompactTextString(m) }
-func (*MoneyBackCostMerchantListResponse) ProtoMessage()    {}
-func (*MoneyBackCostMerchantListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{131}
+func (m *ChangeRoyaltyReportCorrection) Reset()         { *m = ChangeRoyaltyReportCorrection{} }
+func (m *ChangeRoyaltyReportCorrection) String() string { return proto.CompactTextString(m) }
+func (*ChangeRoyaltyReportCorrection) ProtoMessage()    {}
+func (*ChangeRoyaltyReportCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{135}
 }
-func (m *MoneyBackCostMerchantListResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MoneyBackCostMerchantListResponse.Unmarshal(m, b)
+func (m *ChangeRoyaltyReportCorrection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeRoyaltyReportCorrection.Unmarshal(m, b)
 }
-func (m *MoneyBackCostMerchantListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MoneyBackCostMerchantListResponse.Marshal(b, m, deterministic)
+func (m *ChangeRoyaltyReportCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeRoyaltyReportCorrection.Marshal(b, m, deterministic)
 }
-func (dst *MoneyBackCostMerchantListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MoneyBackCostMerchantListResponse.Merge(dst, src)
+func (dst *ChangeRoyaltyReportCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeRoyaltyReportCorrection.Merge(dst, src)
 }
-func (m *MoneyBackCostMerchantListResponse) XXX_Size() int {
-	return xxx_messageInfo_MoneyBackCostMerchantListResponse.Size(m)
+func (m *ChangeRoyaltyReportCorrection) XXX_Size() int {
+	return xxx_messageInfo_ChangeRoyaltyReportCorrection.Size(m)
 }
-func (m *MoneyBackCostMerchantListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MoneyBackCostMerchantListResponse.DiscardUnknown(m)
+func (m *ChangeRoyaltyReportCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeRoyaltyReportCorrection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MoneyBackCostMerchantListResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChangeRoyaltyReportCorrection proto.InternalMessageInfo
 
-func (m *MoneyBackCostMerchantListResponse) GetStatus() int32 {
+func (m *ChangeRoyaltyReportCorrection) GetAmount() float64 {
 	if m != nil {
-		return m.Status
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *MoneyBackCostMerchantListResponse) GetMessage() *ResponseErrorMessage {
+func (m *ChangeRoyaltyReportCorrection) GetReason() string {
 	if m != nil {
-		return m.Message
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-func (m *MoneyBackCostMerchantListResponse) GetItem() *billing.MoneyBackCostMerchantList {
+type GetRoyaltyReportRequest struct {
+	// @inject_tag: json:"report_id" validate:"required,hexadecimal,len=24"
+	ReportId             string   `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetRoyaltyReportRequest) Reset()         { *m = GetRoyaltyReportRequest{} }
+func (m *GetRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoyaltyReportRequest) ProtoMessage()    {}
+func (*GetRoyaltyReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{136}
+}
+func (m *GetRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoyaltyReportRequest.Unmarshal(m, b)
+}
+func (m *GetRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoyaltyReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRoyaltyReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoyaltyReportRequest.Merge(dst, src)
+}
+func (m *GetRoyaltyReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRoyaltyReportRequest.Size(m)
+}
+func (m *GetRoyaltyReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoyaltyReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRoyaltyReportRequest proto.InternalMessageInfo
+
+func (m *GetRoyaltyReportRequest) GetReportId() string {
 	if m != nil {
-		return m.Item
+		return m.ReportId
 	}
-	return nil
+	return ""
 }
 
-type MoneyBackCostMerchantResponse struct {
+type GetRoyaltyReportResponse struct {
 	// @inject_tag: json:"status"
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	// @inject_tag: json:"message"
 	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
 	// @inject_tag: json:"item"
-	Item                 *billing.MoneyBackCostMerchant `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+	Item                 *billing.RoyaltyReport `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MoneyBackCostMerchantResponse) Reset()         { *m = MoneyBackCostMerchantResponse{} }
-func (m *MoneyBackCostMerchantResponse) String() string { return proto.CompactTextString(m) }
-func (*MoneyBackCostMerchantResponse) ProtoMessage()    {}
-func (*MoneyBackCostMerchantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{132}
+func (m *GetRoyaltyReportResponse) Reset()         { *m = GetRoyaltyReportResponse{} }
+func (m *GetRoyaltyReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoyaltyReportResponse) ProtoMessage()    {}
+func (*GetRoyaltyReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{137}
 }
-func (m *MoneyBackCostMerchantResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MoneyBackCostMerchantResponse.Unmarshal(m, b)
+func (m *GetRoyaltyReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoyaltyReportResponse.Unmarshal(m, b)
 }
-func (m *MoneyBackCostMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MoneyBackCostMerchantResponse.Marshal(b, m, deterministic)
+func (m *GetRoyaltyReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoyaltyReportResponse.Marshal(b, m, deterministic)
 }
-func (dst *MoneyBackCostMerchantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MoneyBackCostMerchantResponse.Merge(dst, src)
+func (dst *GetRoyaltyReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoyaltyReportResponse.Merge(dst, src)
 }
-func (m *MoneyBackCostMerchantResponse) XXX_Size() int {
-	return xxx_messageInfo_MoneyBackCostMerchantResponse.Size(m)
+func (m *GetRoyaltyReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRoyaltyReportResponse.Size(m)
 }
-func (m *MoneyBackCostMerchantResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MoneyBackCostMerchantResponse.DiscardUnknown(m)
+func (m *GetRoyaltyReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoyaltyReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MoneyBackCostMerchantResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRoyaltyReportResponse proto.InternalMessageInfo
 
-func (m *MoneyBackCostMerchantResponse) GetStatus() int32 {
+func (m *GetRoyaltyReportResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *MoneyBackCostMerchantResponse) GetMessage() *ResponseErrorMessage {
+func (m *GetRoyaltyReportResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *MoneyBackCostMerchantResponse) GetItem() *billing.MoneyBackCostMerchant {
+func (m *GetRoyaltyReportResponse) GetItem() *billing.RoyaltyReport {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type PriceGroupByCountryRequest struct {
-	// @inject_tag: validate:"required,alpha,len=2"
-	Country              string   `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty" validate:"required,alpha,len=2"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type ChangeRoyaltyReportRequest struct {
+	// @inject_tag: json:"report_id" validate:"required,hexadecimal,len=24"
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"status" validate:"omitempty,oneof=pending skipped accepted canceled dispute ready_for_invoice payment_in_progress payment_done payment_error"
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status" validate:"omitempty,oneof=pending skipped accepted canceled dispute ready_for_invoice payment_in_progress payment_done payment_error"`
+	// @inject_tag: json:"correction"
+	Correction           *ChangeRoyaltyReportCorrection `protobuf:"bytes,3,opt,name=correction,proto3" json:"correction"`
+	Ip                   string                         `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PriceGroupByCountryRequest) Reset()         { *m = PriceGroupByCountryRequest{} }
-func (m *PriceGroupByCountryRequest) String() string { return proto.CompactTextString(m) }
-func (*PriceGroupByCountryRequest) ProtoMessage()    {}
-func (*PriceGroupByCountryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{133}
+func (m *ChangeRoyaltyReportRequest) Reset()         { *m = ChangeRoyaltyReportRequest{} }
+func (m *ChangeRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeRoyaltyReportRequest) ProtoMessage()    {}
+func (*ChangeRoyaltyReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{138}
 }
-func (m *PriceGroupByCountryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceGroupByCountryRequest.Unmarshal(m, b)
+func (m *ChangeRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeRoyaltyReportRequest.Unmarshal(m, b)
 }
-func (m *PriceGroupByCountryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceGroupByCountryRequest.Marshal(b, m, deterministic)
+func (m *ChangeRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeRoyaltyReportRequest.Marshal(b, m, deterministic)
 }
-func (dst *PriceGroupByCountryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceGroupByCountryRequest.Merge(dst, src)
+func (dst *ChangeRoyaltyReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeRoyaltyReportRequest.Merge(dst, src)
 }
-func (m *PriceGroupByCountryRequest) XXX_Size() int {
-	return xxx_messageInfo_PriceGroupByCountryRequest.Size(m)
+func (m *ChangeRoyaltyReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeRoyaltyReportRequest.Size(m)
 }
-func (m *PriceGroupByCountryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceGroupByCountryRequest.DiscardUnknown(m)
+func (m *ChangeRoyaltyReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeRoyaltyReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceGroupByCountryRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangeRoyaltyReportRequest proto.InternalMessageInfo
 
-func (m *PriceGroupByCountryRequest) GetCountry() string {
+func (m *ChangeRoyaltyReportRequest) GetReportId() string {
 	if m != nil {
-		return m.Country
+		return m.ReportId
 	}
 	return ""
 }
 
-type PriceGroupCurrenciesResponse struct {
-	// @inject_tag: json:"regions"
-	Region               []*PriceGroupRegions `protobuf:"bytes,1,rep,name=region,proto3" json:"regions"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *PriceGroupCurrenciesResponse) Reset()         { *m = PriceGroupCurrenciesResponse{} }
-func (m *PriceGroupCurrenciesResponse) String() string { return proto.CompactTextString(m) }
-func (*PriceGroupCurrenciesResponse) ProtoMessage()    {}
-func (*PriceGroupCurrenciesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{134}
-}
-func (m *PriceGroupCurrenciesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceGroupCurrenciesResponse.Unmarshal(m, b)
-}
-func (m *PriceGroupCurrenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceGroupCurrenciesResponse.Marshal(b, m, deterministic)
-}
-func (dst *PriceGroupCurrenciesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceGroupCurrenciesResponse.Merge(dst, src)
-}
-func (m *PriceGroupCurrenciesResponse) XXX_Size() int {
-	return xxx_messageInfo_PriceGroupCurrenciesResponse.Size(m)
-}
-func (m *PriceGroupCurrenciesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceGroupCurrenciesResponse.DiscardUnknown(m)
+func (m *ChangeRoyaltyReportRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-var xxx_messageInfo_PriceGroupCurrenciesResponse proto.InternalMessageInfo
-
-func (m *PriceGroupCurrenciesResponse) GetRegion() []*PriceGroupRegions {
+func (m *ChangeRoyaltyReportRequest) GetCorrection() *ChangeRoyaltyReportCorrection {
 	if m != nil {
-		return m.Region
+		return m.Correction
 	}
 	return nil
 }
 
-type PriceGroupRegions struct {
-	// @inject_tag: json:"currency"
-	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
-	// @inject_tag: json:"regions"
-	Regions              []*PriceGroupRegion `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ChangeRoyaltyReportRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
 }
 
-func (m *PriceGroupRegions) Reset()         { *m = PriceGroupRegions{} }
-func (m *PriceGroupRegions) String() string { return proto.CompactTextString(m) }
-func (*PriceGroupRegions) ProtoMessage()    {}
-func (*PriceGroupRegions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{135}
-}
-func (m *PriceGroupRegions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceGroupRegions.Unmarshal(m, b)
+type MerchantReviewRoyaltyReportRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"is_accepted"
+	IsAccepted bool   `protobuf:"varint,2,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted"`
+	Ip         string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	// @inject_tag: json:"dispute_reason"
+	DisputeReason string `protobuf:"bytes,4,opt,name=dispute_reason,json=disputeReason,proto3" json:"dispute_reason"`
+	// @inject_tag: json:"dispute_items" validate:"omitempty,dive"
+	DisputeItems         []*billing.RoyaltyReportDisputeItem `protobuf:"bytes,5,rep,name=dispute_items,json=disputeItems,proto3" json:"dispute_items" validate:"omitempty,dive"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                               `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *PriceGroupRegions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceGroupRegions.Marshal(b, m, deterministic)
+
+func (m *MerchantReviewRoyaltyReportRequest) Reset()         { *m = MerchantReviewRoyaltyReportRequest{} }
+func (m *MerchantReviewRoyaltyReportRequest) String() string { return proto.CompactTextString(m) }
+func (*MerchantReviewRoyaltyReportRequest) ProtoMessage()    {}
+func (*MerchantReviewRoyaltyReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{139}
 }
-func (dst *PriceGroupRegions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceGroupRegions.Merge(dst, src)
+func (m *MerchantReviewRoyaltyReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Unmarshal(m, b)
 }
-func (m *PriceGroupRegions) XXX_Size() int {
-	return xxx_messageInfo_PriceGroupRegions.Size(m)
+func (m *MerchantReviewRoyaltyReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Marshal(b, m, deterministic)
 }
-func (m *PriceGroupRegions) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceGroupRegions.DiscardUnknown(m)
+func (dst *MerchantReviewRoyaltyReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Merge(dst, src)
+}
+func (m *MerchantReviewRoyaltyReportRequest) XXX_Size() int {
+	return xxx_messageInfo_MerchantReviewRoyaltyReportRequest.Size(m)
+}
+func (m *MerchantReviewRoyaltyReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantReviewRoyaltyReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceGroupRegions proto.InternalMessageInfo
+var xxx_messageInfo_MerchantReviewRoyaltyReportRequest proto.InternalMessageInfo
 
-func (m *PriceGroupRegions) GetCurrency() string {
+func (m *MerchantReviewRoyaltyReportRequest) GetReportId() string {
 	if m != nil {
-		return m.Currency
+		return m.ReportId
 	}
 	return ""
 }
 
-func (m *PriceGroupRegions) GetRegions() []*PriceGroupRegion {
+func (m *MerchantReviewRoyaltyReportRequest) GetIsAccepted() bool {
 	if m != nil {
-		return m.Regions
+		return m.IsAccepted
+	}
+	return false
+}
+
+func (m *MerchantReviewRoyaltyReportRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *MerchantReviewRoyaltyReportRequest) GetDisputeReason() string {
+	if m != nil {
+		return m.DisputeReason
+	}
+	return ""
+}
+
+func (m *MerchantReviewRoyaltyReportRequest) GetDisputeItems() []*billing.RoyaltyReportDisputeItem {
+	if m != nil {
+		return m.DisputeItems
 	}
 	return nil
 }
 
-type PriceGroupRegion struct {
-	// @inject_tag: json:"region"
-	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region"`
-	// @inject_tag: json:"country"
-	Country              []string `protobuf:"bytes,2,rep,name=country,proto3" json:"country"`
+type AddRoyaltyReportDisputeCorrectionRequest struct {
+	// @inject_tag: json:"report_id" validate:"required,hexadecimal,len=24"
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"item_id" validate:"omitempty,hexadecimal,len=24"
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: json:"type" validate:"required,oneof=fee vat refund chargeback exchange_rate other"
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type" validate:"required,oneof=fee vat refund chargeback exchange_rate other"`
+	// @inject_tag: json:"amount" validate:"required"
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount" validate:"required"`
+	// @inject_tag: json:"reason" validate:"required"
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason" validate:"required"`
+	Ip                   string   `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PriceGroupRegion) Reset()         { *m = PriceGroupRegion{} }
-func (m *PriceGroupRegion) String() string { return proto.CompactTextString(m) }
-func (*PriceGroupRegion) ProtoMessage()    {}
-func (*PriceGroupRegion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{136}
+func (m *AddRoyaltyReportDisputeCorrectionRequest) Reset() {
+	*m = AddRoyaltyReportDisputeCorrectionRequest{}
 }
-func (m *PriceGroupRegion) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceGroupRegion.Unmarshal(m, b)
+func (m *AddRoyaltyReportDisputeCorrectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRoyaltyReportDisputeCorrectionRequest) ProtoMessage()    {}
+func (*AddRoyaltyReportDisputeCorrectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{140}
 }
-func (m *PriceGroupRegion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceGroupRegion.Marshal(b, m, deterministic)
+func (m *AddRoyaltyReportDisputeCorrectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddRoyaltyReportDisputeCorrectionRequest.Unmarshal(m, b)
 }
-func (dst *PriceGroupRegion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceGroupRegion.Merge(dst, src)
+func (m *AddRoyaltyReportDisputeCorrectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddRoyaltyReportDisputeCorrectionRequest.Marshal(b, m, deterministic)
 }
-func (m *PriceGroupRegion) XXX_Size() int {
-	return xxx_messageInfo_PriceGroupRegion.Size(m)
+func (dst *AddRoyaltyReportDisputeCorrectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRoyaltyReportDisputeCorrectionRequest.Merge(dst, src)
 }
-func (m *PriceGroupRegion) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceGroupRegion.DiscardUnknown(m)
+func (m *AddRoyaltyReportDisputeCorrectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AddRoyaltyReportDisputeCorrectionRequest.Size(m)
+}
+func (m *AddRoyaltyReportDisputeCorrectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRoyaltyReportDisputeCorrectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PriceGroupRegion proto.InternalMessageInfo
+var xxx_messageInfo_AddRoyaltyReportDisputeCorrectionRequest proto.InternalMessageInfo
 
-func (m *PriceGroupRegion) GetRegion() string {
+func (m *AddRoyaltyReportDisputeCorrectionRequest) GetReportId() string {
 	if m != nil {
-		return m.Region
+		return m.ReportId
 	}
 	return ""
 }
 
-func (m *PriceGroupRegion) GetCountry() []string {
+func (m *AddRoyaltyReportDisputeCorrectionRequest) GetItemId() string {
 	if m != nil {
-		return m.Country
+		return m.ItemId
 	}
-	return nil
+	return ""
 }
 
-type PriceGroupByRegionRequest struct {
-	// @inject_tag: validate:"required,alpha"
-	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty" validate:"required,alpha"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *AddRoyaltyReportDisputeCorrectionRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *PriceGroupByRegionRequest) Reset()         { *m = PriceGroupByRegionRequest{} }
-func (m *PriceGroupByRegionRequest) String() string { return proto.CompactTextString(m) }
-func (*PriceGroupByRegionRequest) ProtoMessage()    {}
-func (*PriceGroupByRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{137}
-}
-func (m *PriceGroupByRegionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PriceGroupByRegionRequest.Unmarshal(m, b)
-}
-func (m *PriceGroupByRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PriceGroupByRegionRequest.Marshal(b, m, deterministic)
-}
-func (dst *PriceGroupByRegionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PriceGroupByRegionRequest.Merge(dst, src)
-}
-func (m *PriceGroupByRegionRequest) XXX_Size() int {
-	return xxx_messageInfo_PriceGroupByRegionRequest.Size(m)
-}
-func (m *PriceGroupByRegionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PriceGroupByRegionRequest.DiscardUnknown(m)
+func (m *AddRoyaltyReportDisputeCorrectionRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-var xxx_messageInfo_PriceGroupByRegionRequest proto.InternalMessageInfo
+func (m *AddRoyaltyReportDisputeCorrectionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
 
-func (m *PriceGroupByRegionRequest) GetRegion() string {
+func (m *AddRoyaltyReportDisputeCorrectionRequest) GetIp() string {
 	if m != nil {
-		return m.Region
+		return m.Ip
 	}
 	return ""
 }
 
-type RecommendedPriceRequest struct {
-	// @inject_tag: validate:"required,numeric,gte=0"
-	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty" validate:"required,numeric,gte=0"`
-	// @inject_tag: validate:"required,alpha,len=3"
-	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty" validate:"required,alpha,len=3"`
+type ResolveRoyaltyReportDisputeRequest struct {
+	// @inject_tag: json:"report_id" validate:"required,hexadecimal,len=24"
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"resolution"
+	Resolution           string   `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *RecommendedPriceRequest) Reset()         { *m = RecommendedPriceRequest{} }
-func (m *RecommendedPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*RecommendedPriceRequest) ProtoMessage()    {}
-func (*RecommendedPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{138}
+func (m *ResolveRoyaltyReportDisputeRequest) Reset()         { *m = ResolveRoyaltyReportDisputeRequest{} }
+func (m *ResolveRoyaltyReportDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveRoyaltyReportDisputeRequest) ProtoMessage()    {}
+func (*ResolveRoyaltyReportDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{141}
 }
-func (m *RecommendedPriceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RecommendedPriceRequest.Unmarshal(m, b)
+func (m *ResolveRoyaltyReportDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveRoyaltyReportDisputeRequest.Unmarshal(m, b)
 }
-func (m *RecommendedPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RecommendedPriceRequest.Marshal(b, m, deterministic)
+func (m *ResolveRoyaltyReportDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveRoyaltyReportDisputeRequest.Marshal(b, m, deterministic)
 }
-func (dst *RecommendedPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecommendedPriceRequest.Merge(dst, src)
+func (dst *ResolveRoyaltyReportDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveRoyaltyReportDisputeRequest.Merge(dst, src)
 }
-func (m *RecommendedPriceRequest) XXX_Size() int {
-	return xxx_messageInfo_RecommendedPriceRequest.Size(m)
+func (m *ResolveRoyaltyReportDisputeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveRoyaltyReportDisputeRequest.Size(m)
 }
-func (m *RecommendedPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecommendedPriceRequest.DiscardUnknown(m)
+func (m *ResolveRoyaltyReportDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveRoyaltyReportDisputeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecommendedPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResolveRoyaltyReportDisputeRequest proto.InternalMessageInfo
 
-func (m *RecommendedPriceRequest) GetAmount() float64 {
+func (m *ResolveRoyaltyReportDisputeRequest) GetReportId() string {
 	if m != nil {
-		return m.Amount
+		return m.ReportId
 	}
-	return 0
+	return ""
 }
 
-func (m *RecommendedPriceRequest) GetCurrency() string {
+func (m *ResolveRoyaltyReportDisputeRequest) GetResolution() string {
 	if m != nil {
-		return m.Currency
+		return m.Resolution
 	}
 	return ""
 }
 
-type RecommendedPriceResponse struct {
-	// @inject_tag: json:"recommended_price"
-	RecommendedPrice     []*billing.RecommendedPrice `protobuf:"bytes,1,rep,name=recommended_price,json=recommendedPrice,proto3" json:"recommended_price"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ResolveRoyaltyReportDisputeRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
 }
 
-func (m *RecommendedPriceResponse) Reset()         { *m = RecommendedPriceResponse{} }
-func (m *RecommendedPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*RecommendedPriceResponse) ProtoMessage()    {}
-func (*RecommendedPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{139}
+type RoyaltyReportDisputeResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.RoyaltyReportDispute `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *RecommendedPriceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RecommendedPriceResponse.Unmarshal(m, b)
+
+func (m *RoyaltyReportDisputeResponse) Reset()         { *m = RoyaltyReportDisputeResponse{} }
+func (m *RoyaltyReportDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportDisputeResponse) ProtoMessage()    {}
+func (*RoyaltyReportDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{142}
 }
-func (m *RecommendedPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RecommendedPriceResponse.Marshal(b, m, deterministic)
+func (m *RoyaltyReportDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportDisputeResponse.Unmarshal(m, b)
 }
-func (dst *RecommendedPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecommendedPriceResponse.Merge(dst, src)
+func (m *RoyaltyReportDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportDisputeResponse.Marshal(b, m, deterministic)
 }
-func (m *RecommendedPriceResponse) XXX_Size() int {
-	return xxx_messageInfo_RecommendedPriceResponse.Size(m)
+func (dst *RoyaltyReportDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportDisputeResponse.Merge(dst, src)
 }
-func (m *RecommendedPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecommendedPriceResponse.DiscardUnknown(m)
+func (m *RoyaltyReportDisputeResponse) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportDisputeResponse.Size(m)
+}
+func (m *RoyaltyReportDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportDisputeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecommendedPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoyaltyReportDisputeResponse proto.InternalMessageInfo
 
-func (m *RecommendedPriceResponse) GetRecommendedPrice() []*billing.RecommendedPrice {
+func (m *RoyaltyReportDisputeResponse) GetStatus() int32 {
 	if m != nil {
-		return m.RecommendedPrice
+		return m.Status
+	}
+	return 0
+}
+
+func (m *RoyaltyReportDisputeResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-type RecommendedPriceTableRequest struct {
-	// @inject_tag: validate:"required,alpha,len=3"
-	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty" validate:"required,alpha,len=3"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *RoyaltyReportDisputeResponse) GetItem() *billing.RoyaltyReportDispute {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *RecommendedPriceTableRequest) Reset()         { *m = RecommendedPriceTableRequest{} }
-func (m *RecommendedPriceTableRequest) String() string { return proto.CompactTextString(m) }
-func (*RecommendedPriceTableRequest) ProtoMessage()    {}
-func (*RecommendedPriceTableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{140}
+type ListRoyaltyReportVersionsResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"items"
+	Items                []*billing.RoyaltyReportVersion `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                           `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *RecommendedPriceTableRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RecommendedPriceTableRequest.Unmarshal(m, b)
+
+func (m *ListRoyaltyReportVersionsResponse) Reset()         { *m = ListRoyaltyReportVersionsResponse{} }
+func (m *ListRoyaltyReportVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRoyaltyReportVersionsResponse) ProtoMessage()    {}
+func (*ListRoyaltyReportVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{143}
 }
-func (m *RecommendedPriceTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RecommendedPriceTableRequest.Marshal(b, m, deterministic)
+func (m *ListRoyaltyReportVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoyaltyReportVersionsResponse.Unmarshal(m, b)
 }
-func (dst *RecommendedPriceTableRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecommendedPriceTableRequest.Merge(dst, src)
+func (m *ListRoyaltyReportVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoyaltyReportVersionsResponse.Marshal(b, m, deterministic)
 }
-func (m *RecommendedPriceTableRequest) XXX_Size() int {
-	return xxx_messageInfo_RecommendedPriceTableRequest.Size(m)
+func (dst *ListRoyaltyReportVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoyaltyReportVersionsResponse.Merge(dst, src)
 }
-func (m *RecommendedPriceTableRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecommendedPriceTableRequest.DiscardUnknown(m)
+func (m *ListRoyaltyReportVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRoyaltyReportVersionsResponse.Size(m)
+}
+func (m *ListRoyaltyReportVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoyaltyReportVersionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecommendedPriceTableRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListRoyaltyReportVersionsResponse proto.InternalMessageInfo
 
-func (m *RecommendedPriceTableRequest) GetCurrency() string {
+func (m *ListRoyaltyReportVersionsResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Currency
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListRoyaltyReportVersionsResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ListRoyaltyReportVersionsResponse) GetItems() []*billing.RoyaltyReportVersion {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type PaymentChannelCostSystemListResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.PaymentChannelCostSystemList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentChannelCostSystemListResponse) Reset()         { *m = PaymentChannelCostSystemListResponse{} }
+func (m *PaymentChannelCostSystemListResponse) String() string { return proto.CompactTextString(m) }
+func (*PaymentChannelCostSystemListResponse) ProtoMessage()    {}
+func (*PaymentChannelCostSystemListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{144}
+}
+func (m *PaymentChannelCostSystemListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentChannelCostSystemListResponse.Unmarshal(m, b)
+}
+func (m *PaymentChannelCostSystemListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentChannelCostSystemListResponse.Marshal(b, m, deterministic)
+}
+func (dst *PaymentChannelCostSystemListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentChannelCostSystemListResponse.Merge(dst, src)
+}
+func (m *PaymentChannelCostSystemListResponse) XXX_Size() int {
+	return xxx_messageInfo_PaymentChannelCostSystemListResponse.Size(m)
+}
+func (m *PaymentChannelCostSystemListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentChannelCostSystemListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentChannelCostSystemListResponse proto.InternalMessageInfo
+
+func (m *PaymentChannelCostSystemListResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PaymentChannelCostSystemListResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PaymentChannelCostSystemListResponse) GetItem() *billing.PaymentChannelCostSystemList {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type PaymentChannelCostSystemResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.PaymentChannelCostSystem `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                             `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentChannelCostSystemResponse) Reset()         { *m = PaymentChannelCostSystemResponse{} }
+func (m *PaymentChannelCostSystemResponse) String() string { return proto.CompactTextString(m) }
+func (*PaymentChannelCostSystemResponse) ProtoMessage()    {}
+func (*PaymentChannelCostSystemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{145}
+}
+func (m *PaymentChannelCostSystemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentChannelCostSystemResponse.Unmarshal(m, b)
+}
+func (m *PaymentChannelCostSystemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentChannelCostSystemResponse.Marshal(b, m, deterministic)
+}
+func (dst *PaymentChannelCostSystemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentChannelCostSystemResponse.Merge(dst, src)
+}
+func (m *PaymentChannelCostSystemResponse) XXX_Size() int {
+	return xxx_messageInfo_PaymentChannelCostSystemResponse.Size(m)
+}
+func (m *PaymentChannelCostSystemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentChannelCostSystemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentChannelCostSystemResponse proto.InternalMessageInfo
+
+func (m *PaymentChannelCostSystemResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PaymentChannelCostSystemResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PaymentChannelCostSystemResponse) GetItem() *billing.PaymentChannelCostSystem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type PaymentChannelCostMerchantListResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.PaymentChannelCostMerchantList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentChannelCostMerchantListResponse) Reset() {
+	*m = PaymentChannelCostMerchantListResponse{}
+}
+func (m *PaymentChannelCostMerchantListResponse) String() string { return proto.CompactTextString(m) }
+func (*PaymentChannelCostMerchantListResponse) ProtoMessage()    {}
+func (*PaymentChannelCostMerchantListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{146}
+}
+func (m *PaymentChannelCostMerchantListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentChannelCostMerchantListResponse.Unmarshal(m, b)
+}
+func (m *PaymentChannelCostMerchantListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentChannelCostMerchantListResponse.Marshal(b, m, deterministic)
+}
+func (dst *PaymentChannelCostMerchantListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentChannelCostMerchantListResponse.Merge(dst, src)
+}
+func (m *PaymentChannelCostMerchantListResponse) XXX_Size() int {
+	return xxx_messageInfo_PaymentChannelCostMerchantListResponse.Size(m)
+}
+func (m *PaymentChannelCostMerchantListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentChannelCostMerchantListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentChannelCostMerchantListResponse proto.InternalMessageInfo
+
+func (m *PaymentChannelCostMerchantListResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PaymentChannelCostMerchantListResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PaymentChannelCostMerchantListResponse) GetItem() *billing.PaymentChannelCostMerchantList {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type PaymentChannelCostMerchantResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.PaymentChannelCostMerchant `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                               `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentChannelCostMerchantResponse) Reset()         { *m = PaymentChannelCostMerchantResponse{} }
+func (m *PaymentChannelCostMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*PaymentChannelCostMerchantResponse) ProtoMessage()    {}
+func (*PaymentChannelCostMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{147}
+}
+func (m *PaymentChannelCostMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentChannelCostMerchantResponse.Unmarshal(m, b)
+}
+func (m *PaymentChannelCostMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentChannelCostMerchantResponse.Marshal(b, m, deterministic)
+}
+func (dst *PaymentChannelCostMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentChannelCostMerchantResponse.Merge(dst, src)
+}
+func (m *PaymentChannelCostMerchantResponse) XXX_Size() int {
+	return xxx_messageInfo_PaymentChannelCostMerchantResponse.Size(m)
+}
+func (m *PaymentChannelCostMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentChannelCostMerchantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentChannelCostMerchantResponse proto.InternalMessageInfo
+
+func (m *PaymentChannelCostMerchantResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PaymentChannelCostMerchantResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PaymentChannelCostMerchantResponse) GetItem() *billing.PaymentChannelCostMerchant {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MoneyBackCostSystemListResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.MoneyBackCostSystemList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                            `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MoneyBackCostSystemListResponse) Reset()         { *m = MoneyBackCostSystemListResponse{} }
+func (m *MoneyBackCostSystemListResponse) String() string { return proto.CompactTextString(m) }
+func (*MoneyBackCostSystemListResponse) ProtoMessage()    {}
+func (*MoneyBackCostSystemListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{148}
+}
+func (m *MoneyBackCostSystemListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoneyBackCostSystemListResponse.Unmarshal(m, b)
+}
+func (m *MoneyBackCostSystemListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoneyBackCostSystemListResponse.Marshal(b, m, deterministic)
+}
+func (dst *MoneyBackCostSystemListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoneyBackCostSystemListResponse.Merge(dst, src)
+}
+func (m *MoneyBackCostSystemListResponse) XXX_Size() int {
+	return xxx_messageInfo_MoneyBackCostSystemListResponse.Size(m)
+}
+func (m *MoneyBackCostSystemListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoneyBackCostSystemListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoneyBackCostSystemListResponse proto.InternalMessageInfo
+
+func (m *MoneyBackCostSystemListResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *MoneyBackCostSystemListResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *MoneyBackCostSystemListResponse) GetItem() *billing.MoneyBackCostSystemList {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MoneyBackCostSystemResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.MoneyBackCostSystem `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                        `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MoneyBackCostSystemResponse) Reset()         { *m = MoneyBackCostSystemResponse{} }
+func (m *MoneyBackCostSystemResponse) String() string { return proto.CompactTextString(m) }
+func (*MoneyBackCostSystemResponse) ProtoMessage()    {}
+func (*MoneyBackCostSystemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{149}
+}
+func (m *MoneyBackCostSystemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoneyBackCostSystemResponse.Unmarshal(m, b)
+}
+func (m *MoneyBackCostSystemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoneyBackCostSystemResponse.Marshal(b, m, deterministic)
+}
+func (dst *MoneyBackCostSystemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoneyBackCostSystemResponse.Merge(dst, src)
+}
+func (m *MoneyBackCostSystemResponse) XXX_Size() int {
+	return xxx_messageInfo_MoneyBackCostSystemResponse.Size(m)
+}
+func (m *MoneyBackCostSystemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoneyBackCostSystemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoneyBackCostSystemResponse proto.InternalMessageInfo
+
+func (m *MoneyBackCostSystemResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *MoneyBackCostSystemResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *MoneyBackCostSystemResponse) GetItem() *billing.MoneyBackCostSystem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MoneyBackCostMerchantListResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.MoneyBackCostMerchantList `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                              `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MoneyBackCostMerchantListResponse) Reset()         { *m = MoneyBackCostMerchantListResponse{} }
+func (m *MoneyBackCostMerchantListResponse) String() string { return proto.CompactTextString(m) }
+func (*MoneyBackCostMerchantListResponse) ProtoMessage()    {}
+func (*MoneyBackCostMerchantListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{150}
+}
+func (m *MoneyBackCostMerchantListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoneyBackCostMerchantListResponse.Unmarshal(m, b)
+}
+func (m *MoneyBackCostMerchantListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoneyBackCostMerchantListResponse.Marshal(b, m, deterministic)
+}
+func (dst *MoneyBackCostMerchantListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoneyBackCostMerchantListResponse.Merge(dst, src)
+}
+func (m *MoneyBackCostMerchantListResponse) XXX_Size() int {
+	return xxx_messageInfo_MoneyBackCostMerchantListResponse.Size(m)
+}
+func (m *MoneyBackCostMerchantListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoneyBackCostMerchantListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoneyBackCostMerchantListResponse proto.InternalMessageInfo
+
+func (m *MoneyBackCostMerchantListResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *MoneyBackCostMerchantListResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *MoneyBackCostMerchantListResponse) GetItem() *billing.MoneyBackCostMerchantList {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MoneyBackCostMerchantResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.MoneyBackCostMerchant `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MoneyBackCostMerchantResponse) Reset()         { *m = MoneyBackCostMerchantResponse{} }
+func (m *MoneyBackCostMerchantResponse) String() string { return proto.CompactTextString(m) }
+func (*MoneyBackCostMerchantResponse) ProtoMessage()    {}
+func (*MoneyBackCostMerchantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{151}
+}
+func (m *MoneyBackCostMerchantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoneyBackCostMerchantResponse.Unmarshal(m, b)
+}
+func (m *MoneyBackCostMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoneyBackCostMerchantResponse.Marshal(b, m, deterministic)
+}
+func (dst *MoneyBackCostMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoneyBackCostMerchantResponse.Merge(dst, src)
+}
+func (m *MoneyBackCostMerchantResponse) XXX_Size() int {
+	return xxx_messageInfo_MoneyBackCostMerchantResponse.Size(m)
+}
+func (m *MoneyBackCostMerchantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoneyBackCostMerchantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoneyBackCostMerchantResponse proto.InternalMessageInfo
+
+func (m *MoneyBackCostMerchantResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *MoneyBackCostMerchantResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *MoneyBackCostMerchantResponse) GetItem() *billing.MoneyBackCostMerchant {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type PriceGroupByCountryRequest struct {
+	// @inject_tag: validate:"required,alpha,len=2"
+	Country              string   `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty" validate:"required,alpha,len=2"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceGroupByCountryRequest) Reset()         { *m = PriceGroupByCountryRequest{} }
+func (m *PriceGroupByCountryRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceGroupByCountryRequest) ProtoMessage()    {}
+func (*PriceGroupByCountryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{152}
+}
+func (m *PriceGroupByCountryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceGroupByCountryRequest.Unmarshal(m, b)
+}
+func (m *PriceGroupByCountryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceGroupByCountryRequest.Marshal(b, m, deterministic)
+}
+func (dst *PriceGroupByCountryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGroupByCountryRequest.Merge(dst, src)
+}
+func (m *PriceGroupByCountryRequest) XXX_Size() int {
+	return xxx_messageInfo_PriceGroupByCountryRequest.Size(m)
+}
+func (m *PriceGroupByCountryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGroupByCountryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGroupByCountryRequest proto.InternalMessageInfo
+
+func (m *PriceGroupByCountryRequest) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+type PriceGroupCurrenciesResponse struct {
+	// @inject_tag: json:"regions"
+	Region               []*PriceGroupRegions `protobuf:"bytes,1,rep,name=region,proto3" json:"regions"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceGroupCurrenciesResponse) Reset()         { *m = PriceGroupCurrenciesResponse{} }
+func (m *PriceGroupCurrenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*PriceGroupCurrenciesResponse) ProtoMessage()    {}
+func (*PriceGroupCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{153}
+}
+func (m *PriceGroupCurrenciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceGroupCurrenciesResponse.Unmarshal(m, b)
+}
+func (m *PriceGroupCurrenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceGroupCurrenciesResponse.Marshal(b, m, deterministic)
+}
+func (dst *PriceGroupCurrenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGroupCurrenciesResponse.Merge(dst, src)
+}
+func (m *PriceGroupCurrenciesResponse) XXX_Size() int {
+	return xxx_messageInfo_PriceGroupCurrenciesResponse.Size(m)
+}
+func (m *PriceGroupCurrenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGroupCurrenciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGroupCurrenciesResponse proto.InternalMessageInfo
+
+func (m *PriceGroupCurrenciesResponse) GetRegion() []*PriceGroupRegions {
+	if m != nil {
+		return m.Region
+	}
+	return nil
+}
+
+type PriceGroupRegions struct {
+	// @inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
+	// @inject_tag: json:"regions"
+	Regions              []*PriceGroupRegion `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceGroupRegions) Reset()         { *m = PriceGroupRegions{} }
+func (m *PriceGroupRegions) String() string { return proto.CompactTextString(m) }
+func (*PriceGroupRegions) ProtoMessage()    {}
+func (*PriceGroupRegions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{154}
+}
+func (m *PriceGroupRegions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceGroupRegions.Unmarshal(m, b)
+}
+func (m *PriceGroupRegions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceGroupRegions.Marshal(b, m, deterministic)
+}
+func (dst *PriceGroupRegions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGroupRegions.Merge(dst, src)
+}
+func (m *PriceGroupRegions) XXX_Size() int {
+	return xxx_messageInfo_PriceGroupRegions.Size(m)
+}
+func (m *PriceGroupRegions) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGroupRegions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGroupRegions proto.InternalMessageInfo
+
+func (m *PriceGroupRegions) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *PriceGroupRegions) GetRegions() []*PriceGroupRegion {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+type PriceGroupRegion struct {
+	// @inject_tag: json:"region"
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region"`
+	// @inject_tag: json:"country"
+	Country              []string `protobuf:"bytes,2,rep,name=country,proto3" json:"country"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceGroupRegion) Reset()         { *m = PriceGroupRegion{} }
+func (m *PriceGroupRegion) String() string { return proto.CompactTextString(m) }
+func (*PriceGroupRegion) ProtoMessage()    {}
+func (*PriceGroupRegion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{155}
+}
+func (m *PriceGroupRegion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceGroupRegion.Unmarshal(m, b)
+}
+func (m *PriceGroupRegion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceGroupRegion.Marshal(b, m, deterministic)
+}
+func (dst *PriceGroupRegion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGroupRegion.Merge(dst, src)
+}
+func (m *PriceGroupRegion) XXX_Size() int {
+	return xxx_messageInfo_PriceGroupRegion.Size(m)
+}
+func (m *PriceGroupRegion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGroupRegion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGroupRegion proto.InternalMessageInfo
+
+func (m *PriceGroupRegion) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *PriceGroupRegion) GetCountry() []string {
+	if m != nil {
+		return m.Country
+	}
+	return nil
+}
+
+type PriceGroupByRegionRequest struct {
+	// @inject_tag: validate:"required,alpha"
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty" validate:"required,alpha"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PriceGroupByRegionRequest) Reset()         { *m = PriceGroupByRegionRequest{} }
+func (m *PriceGroupByRegionRequest) String() string { return proto.CompactTextString(m) }
+func (*PriceGroupByRegionRequest) ProtoMessage()    {}
+func (*PriceGroupByRegionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{156}
+}
+func (m *PriceGroupByRegionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceGroupByRegionRequest.Unmarshal(m, b)
+}
+func (m *PriceGroupByRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceGroupByRegionRequest.Marshal(b, m, deterministic)
+}
+func (dst *PriceGroupByRegionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceGroupByRegionRequest.Merge(dst, src)
+}
+func (m *PriceGroupByRegionRequest) XXX_Size() int {
+	return xxx_messageInfo_PriceGroupByRegionRequest.Size(m)
+}
+func (m *PriceGroupByRegionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceGroupByRegionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceGroupByRegionRequest proto.InternalMessageInfo
+
+func (m *PriceGroupByRegionRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type RecommendedPriceRequest struct {
+	// @inject_tag: validate:"required,numeric,gte=0"
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty" validate:"required,numeric,gte=0"`
+	// @inject_tag: validate:"required,alpha,len=3"
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty" validate:"required,alpha,len=3"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RecommendedPriceRequest) Reset()         { *m = RecommendedPriceRequest{} }
+func (m *RecommendedPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*RecommendedPriceRequest) ProtoMessage()    {}
+func (*RecommendedPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{157}
+}
+func (m *RecommendedPriceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendedPriceRequest.Unmarshal(m, b)
+}
+func (m *RecommendedPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendedPriceRequest.Marshal(b, m, deterministic)
+}
+func (dst *RecommendedPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendedPriceRequest.Merge(dst, src)
+}
+func (m *RecommendedPriceRequest) XXX_Size() int {
+	return xxx_messageInfo_RecommendedPriceRequest.Size(m)
+}
+func (m *RecommendedPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendedPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendedPriceRequest proto.InternalMessageInfo
+
+func (m *RecommendedPriceRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RecommendedPriceRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type RecommendedPriceResponse struct {
+	// @inject_tag: json:"recommended_price"
+	RecommendedPrice     []*billing.RecommendedPrice `protobuf:"bytes,1,rep,name=recommended_price,json=recommendedPrice,proto3" json:"recommended_price"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RecommendedPriceResponse) Reset()         { *m = RecommendedPriceResponse{} }
+func (m *RecommendedPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*RecommendedPriceResponse) ProtoMessage()    {}
+func (*RecommendedPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{158}
+}
+func (m *RecommendedPriceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendedPriceResponse.Unmarshal(m, b)
+}
+func (m *RecommendedPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendedPriceResponse.Marshal(b, m, deterministic)
+}
+func (dst *RecommendedPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendedPriceResponse.Merge(dst, src)
+}
+func (m *RecommendedPriceResponse) XXX_Size() int {
+	return xxx_messageInfo_RecommendedPriceResponse.Size(m)
+}
+func (m *RecommendedPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendedPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendedPriceResponse proto.InternalMessageInfo
+
+func (m *RecommendedPriceResponse) GetRecommendedPrice() []*billing.RecommendedPrice {
+	if m != nil {
+		return m.RecommendedPrice
+	}
+	return nil
+}
+
+type RecommendedPriceTableRequest struct {
+	// @inject_tag: validate:"required,alpha,len=3"
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty" validate:"required,alpha,len=3"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RecommendedPriceTableRequest) Reset()         { *m = RecommendedPriceTableRequest{} }
+func (m *RecommendedPriceTableRequest) String() string { return proto.CompactTextString(m) }
+func (*RecommendedPriceTableRequest) ProtoMessage()    {}
+func (*RecommendedPriceTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{159}
+}
+func (m *RecommendedPriceTableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendedPriceTableRequest.Unmarshal(m, b)
+}
+func (m *RecommendedPriceTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendedPriceTableRequest.Marshal(b, m, deterministic)
+}
+func (dst *RecommendedPriceTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendedPriceTableRequest.Merge(dst, src)
+}
+func (m *RecommendedPriceTableRequest) XXX_Size() int {
+	return xxx_messageInfo_RecommendedPriceTableRequest.Size(m)
+}
+func (m *RecommendedPriceTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendedPriceTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendedPriceTableRequest proto.InternalMessageInfo
+
+func (m *RecommendedPriceTableRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type RecommendedPriceTableResponse struct {
+	// @inject_tag: json:"ranges"
+	Ranges               []*billing.PriceTableRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RecommendedPriceTableResponse) Reset()         { *m = RecommendedPriceTableResponse{} }
+func (m *RecommendedPriceTableResponse) String() string { return proto.CompactTextString(m) }
+func (*RecommendedPriceTableResponse) ProtoMessage()    {}
+func (*RecommendedPriceTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{160}
+}
+func (m *RecommendedPriceTableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecommendedPriceTableResponse.Unmarshal(m, b)
+}
+func (m *RecommendedPriceTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecommendedPriceTableResponse.Marshal(b, m, deterministic)
+}
+func (dst *RecommendedPriceTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendedPriceTableResponse.Merge(dst, src)
+}
+func (m *RecommendedPriceTableResponse) XXX_Size() int {
+	return xxx_messageInfo_RecommendedPriceTableResponse.Size(m)
+}
+func (m *RecommendedPriceTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendedPriceTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecommendedPriceTableResponse proto.InternalMessageInfo
+
+func (m *RecommendedPriceTableResponse) GetRanges() []*billing.PriceTableRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+type ProductPricesResponse struct {
+	// @inject_tag: json:"product_price"
+	ProductPrice         []*billing.ProductPrice `protobuf:"bytes,1,rep,name=product_price,json=productPrice,proto3" json:"product_price"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ProductPricesResponse) Reset()         { *m = ProductPricesResponse{} }
+func (m *ProductPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*ProductPricesResponse) ProtoMessage()    {}
+func (*ProductPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{161}
+}
+func (m *ProductPricesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProductPricesResponse.Unmarshal(m, b)
+}
+func (m *ProductPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProductPricesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ProductPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductPricesResponse.Merge(dst, src)
+}
+func (m *ProductPricesResponse) XXX_Size() int {
+	return xxx_messageInfo_ProductPricesResponse.Size(m)
+}
+func (m *ProductPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductPricesResponse proto.InternalMessageInfo
+
+func (m *ProductPricesResponse) GetProductPrice() []*billing.ProductPrice {
+	if m != nil {
+		return m.ProductPrice
+	}
+	return nil
+}
+
+type UpdateProductPricesRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24" json:"product_id"
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,min=1,dive" json:"prices"
+	Prices               []*billing.ProductPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UpdateProductPricesRequest) Reset()         { *m = UpdateProductPricesRequest{} }
+func (m *UpdateProductPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProductPricesRequest) ProtoMessage()    {}
+func (*UpdateProductPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{162}
+}
+func (m *UpdateProductPricesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProductPricesRequest.Unmarshal(m, b)
+}
+func (m *UpdateProductPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProductPricesRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateProductPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProductPricesRequest.Merge(dst, src)
+}
+func (m *UpdateProductPricesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProductPricesRequest.Size(m)
+}
+func (m *UpdateProductPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProductPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProductPricesRequest proto.InternalMessageInfo
+
+func (m *UpdateProductPricesRequest) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *UpdateProductPricesRequest) GetPrices() []*billing.ProductPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+type UserProfilePersonal struct {
+	// @inject_tag: json:"first_name" bson:"first_name" validate:"required,name,max=30"
+	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name" bson:"first_name" validate:"required,name,max=30"`
+	// @inject_tag: json:"last_name" bson:"last_name" validate:"required,name,max=30"
+	LastName string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name" bson:"last_name" validate:"required,name,max=30"`
+	// @inject_tag: json:"position" bson:"position" validate:"required,position"
+	Position             string   `protobuf:"bytes,3,opt,name=position,proto3" json:"position" bson:"position" validate:"required,position"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfilePersonal) Reset()         { *m = UserProfilePersonal{} }
+func (m *UserProfilePersonal) String() string { return proto.CompactTextString(m) }
+func (*UserProfilePersonal) ProtoMessage()    {}
+func (*UserProfilePersonal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{163}
+}
+func (m *UserProfilePersonal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfilePersonal.Unmarshal(m, b)
+}
+func (m *UserProfilePersonal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfilePersonal.Marshal(b, m, deterministic)
+}
+func (dst *UserProfilePersonal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfilePersonal.Merge(dst, src)
+}
+func (m *UserProfilePersonal) XXX_Size() int {
+	return xxx_messageInfo_UserProfilePersonal.Size(m)
+}
+func (m *UserProfilePersonal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfilePersonal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfilePersonal proto.InternalMessageInfo
+
+func (m *UserProfilePersonal) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *UserProfilePersonal) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *UserProfilePersonal) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+type UserProfileHelp struct {
+	// @inject_tag: json:"product_promotion_and_development" bson:"product_promotion_and_development"
+	ProductPromotionAndDevelopment bool `protobuf:"varint,1,opt,name=product_promotion_and_development,json=productPromotionAndDevelopment,proto3" json:"product_promotion_and_development" bson:"product_promotion_and_development"`
+	// @inject_tag: json:"released_game_promotion" bson:"released_game_promotion"
+	ReleasedGamePromotion bool `protobuf:"varint,2,opt,name=released_game_promotion,json=releasedGamePromotion,proto3" json:"released_game_promotion" bson:"released_game_promotion"`
+	// @inject_tag: json:"international_sales" bson:"international_sales"
+	InternationalSales bool `protobuf:"varint,3,opt,name=international_sales,json=internationalSales,proto3" json:"international_sales" bson:"international_sales"`
+	// @inject_tag: json:"other" bson:"other"
+	Other                bool     `protobuf:"varint,4,opt,name=other,proto3" json:"other" bson:"other"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfileHelp) Reset()         { *m = UserProfileHelp{} }
+func (m *UserProfileHelp) String() string { return proto.CompactTextString(m) }
+func (*UserProfileHelp) ProtoMessage()    {}
+func (*UserProfileHelp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{164}
+}
+func (m *UserProfileHelp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfileHelp.Unmarshal(m, b)
+}
+func (m *UserProfileHelp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfileHelp.Marshal(b, m, deterministic)
+}
+func (dst *UserProfileHelp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfileHelp.Merge(dst, src)
+}
+func (m *UserProfileHelp) XXX_Size() int {
+	return xxx_messageInfo_UserProfileHelp.Size(m)
+}
+func (m *UserProfileHelp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfileHelp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfileHelp proto.InternalMessageInfo
+
+func (m *UserProfileHelp) GetProductPromotionAndDevelopment() bool {
+	if m != nil {
+		return m.ProductPromotionAndDevelopment
+	}
+	return false
+}
+
+func (m *UserProfileHelp) GetReleasedGamePromotion() bool {
+	if m != nil {
+		return m.ReleasedGamePromotion
+	}
+	return false
+}
+
+func (m *UserProfileHelp) GetInternationalSales() bool {
+	if m != nil {
+		return m.InternationalSales
+	}
+	return false
+}
+
+func (m *UserProfileHelp) GetOther() bool {
+	if m != nil {
+		return m.Other
+	}
+	return false
+}
+
+type UserProfileCompanyMonetization struct {
+	// @inject_tag: json:"paid_subscription" bson:"paid_subscription"
+	PaidSubscription bool `protobuf:"varint,1,opt,name=paid_subscription,json=paidSubscription,proto3" json:"paid_subscription" bson:"paid_subscription"`
+	// @inject_tag: json:"in_game_advertising" bson:"in_game_advertising"
+	InGameAdvertising bool `protobuf:"varint,2,opt,name=in_game_advertising,json=inGameAdvertising,proto3" json:"in_game_advertising" bson:"in_game_advertising"`
+	// @inject_tag: json:"in_game_purchases" bson:"in_game_purchases"
+	InGamePurchases bool `protobuf:"varint,3,opt,name=in_game_purchases,json=inGamePurchases,proto3" json:"in_game_purchases" bson:"in_game_purchases"`
+	// @inject_tag: json:"premium_access" bson:"premium_access"
+	PremiumAccess bool `protobuf:"varint,4,opt,name=premium_access,json=premiumAccess,proto3" json:"premium_access" bson:"premium_access"`
+	// @inject_tag: json:"other" bson:"other"
+	Other                bool     `protobuf:"varint,5,opt,name=other,proto3" json:"other" bson:"other"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfileCompanyMonetization) Reset()         { *m = UserProfileCompanyMonetization{} }
+func (m *UserProfileCompanyMonetization) String() string { return proto.CompactTextString(m) }
+func (*UserProfileCompanyMonetization) ProtoMessage()    {}
+func (*UserProfileCompanyMonetization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{165}
+}
+func (m *UserProfileCompanyMonetization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfileCompanyMonetization.Unmarshal(m, b)
+}
+func (m *UserProfileCompanyMonetization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfileCompanyMonetization.Marshal(b, m, deterministic)
+}
+func (dst *UserProfileCompanyMonetization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfileCompanyMonetization.Merge(dst, src)
+}
+func (m *UserProfileCompanyMonetization) XXX_Size() int {
+	return xxx_messageInfo_UserProfileCompanyMonetization.Size(m)
+}
+func (m *UserProfileCompanyMonetization) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfileCompanyMonetization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfileCompanyMonetization proto.InternalMessageInfo
+
+func (m *UserProfileCompanyMonetization) GetPaidSubscription() bool {
+	if m != nil {
+		return m.PaidSubscription
+	}
+	return false
+}
+
+func (m *UserProfileCompanyMonetization) GetInGameAdvertising() bool {
+	if m != nil {
+		return m.InGameAdvertising
+	}
+	return false
+}
+
+func (m *UserProfileCompanyMonetization) GetInGamePurchases() bool {
+	if m != nil {
+		return m.InGamePurchases
+	}
+	return false
+}
+
+func (m *UserProfileCompanyMonetization) GetPremiumAccess() bool {
+	if m != nil {
+		return m.PremiumAccess
+	}
+	return false
+}
+
+func (m *UserProfileCompanyMonetization) GetOther() bool {
+	if m != nil {
+		return m.Other
+	}
+	return false
+}
+
+type UserProfileCompanyPlatforms struct {
+	// @inject_tag: json:"pc_mac" bson:"pc_mac"
+	PcMac bool `protobuf:"varint,1,opt,name=pc_mac,json=pcMac,proto3" json:"pc_mac" bson:"pc_mac"`
+	// @inject_tag: json:"game_console" bson:"game_console"
+	GameConsole bool `protobuf:"varint,2,opt,name=game_console,json=gameConsole,proto3" json:"game_console" bson:"game_console"`
+	// @inject_tag: json:"mobile_device" bson:"mobile_device"
+	MobileDevice bool `protobuf:"varint,3,opt,name=mobile_device,json=mobileDevice,proto3" json:"mobile_device" bson:"mobile_device"`
+	// @inject_tag: json:"web_browser" bson:"web_browser"
+	WebBrowser bool `protobuf:"varint,4,opt,name=web_browser,json=webBrowser,proto3" json:"web_browser" bson:"web_browser"`
+	// @inject_tag: json:"other" bson:"other"
+	Other                bool     `protobuf:"varint,5,opt,name=other,proto3" json:"other" bson:"other"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfileCompanyPlatforms) Reset()         { *m = UserProfileCompanyPlatforms{} }
+func (m *UserProfileCompanyPlatforms) String() string { return proto.CompactTextString(m) }
+func (*UserProfileCompanyPlatforms) ProtoMessage()    {}
+func (*UserProfileCompanyPlatforms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{166}
+}
+func (m *UserProfileCompanyPlatforms) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfileCompanyPlatforms.Unmarshal(m, b)
+}
+func (m *UserProfileCompanyPlatforms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfileCompanyPlatforms.Marshal(b, m, deterministic)
+}
+func (dst *UserProfileCompanyPlatforms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfileCompanyPlatforms.Merge(dst, src)
+}
+func (m *UserProfileCompanyPlatforms) XXX_Size() int {
+	return xxx_messageInfo_UserProfileCompanyPlatforms.Size(m)
+}
+func (m *UserProfileCompanyPlatforms) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfileCompanyPlatforms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfileCompanyPlatforms proto.InternalMessageInfo
+
+func (m *UserProfileCompanyPlatforms) GetPcMac() bool {
+	if m != nil {
+		return m.PcMac
+	}
+	return false
+}
+
+func (m *UserProfileCompanyPlatforms) GetGameConsole() bool {
+	if m != nil {
+		return m.GameConsole
+	}
+	return false
+}
+
+func (m *UserProfileCompanyPlatforms) GetMobileDevice() bool {
+	if m != nil {
+		return m.MobileDevice
+	}
+	return false
+}
+
+func (m *UserProfileCompanyPlatforms) GetWebBrowser() bool {
+	if m != nil {
+		return m.WebBrowser
+	}
+	return false
+}
+
+func (m *UserProfileCompanyPlatforms) GetOther() bool {
+	if m != nil {
+		return m.Other
+	}
+	return false
+}
+
+type UserProfileCompany struct {
+	// @inject_tag: json:"company_name" bson:"company_name" validate:"required,company_name,max=60"
+	CompanyName string `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name" bson:"company_name" validate:"required,company_name,max=60"`
+	// @inject_tag: json:"website" validate:"required,url" bson:"website"
+	Website string `protobuf:"bytes,2,opt,name=website,proto3" json:"website" validate:"required,url" bson:"website"`
+	// @inject_tag: json:"annual_income" bson:"annual_income" validate:"required"
+	AnnualIncome *billing.RangeInt `protobuf:"bytes,3,opt,name=annual_income,json=annualIncome,proto3" json:"annual_income" bson:"annual_income" validate:"required"`
+	// @inject_tag: json:"number_of_employees" bson:"number_of_employees" validate:"required"
+	NumberOfEmployees *billing.RangeInt `protobuf:"bytes,4,opt,name=number_of_employees,json=numberOfEmployees,proto3" json:"number_of_employees" bson:"number_of_employees" validate:"required"`
+	// @inject_tag: json:"kind_of_activity" validate:"omitempty,oneof=develop_and_publish_your_games publish_games_of_other_companies publish_your_games_through_other_publishers other" bson:"kind_of_activity"
+	KindOfActivity string `protobuf:"bytes,5,opt,name=kind_of_activity,json=kindOfActivity,proto3" json:"kind_of_activity" validate:"omitempty,oneof=develop_and_publish_your_games publish_games_of_other_companies publish_your_games_through_other_publishers other" bson:"kind_of_activity"`
+	// @inject_tag: json:"monetization" bson:"monetization"
+	Monetization *UserProfileCompanyMonetization `protobuf:"bytes,6,opt,name=monetization,proto3" json:"monetization" bson:"monetization"`
+	// @inject_tag: json:"platforms" bson:"platforms"
+	Platforms            *UserProfileCompanyPlatforms `protobuf:"bytes,7,opt,name=platforms,proto3" json:"platforms" bson:"platforms"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                        `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfileCompany) Reset()         { *m = UserProfileCompany{} }
+func (m *UserProfileCompany) String() string { return proto.CompactTextString(m) }
+func (*UserProfileCompany) ProtoMessage()    {}
+func (*UserProfileCompany) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{167}
+}
+func (m *UserProfileCompany) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfileCompany.Unmarshal(m, b)
+}
+func (m *UserProfileCompany) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfileCompany.Marshal(b, m, deterministic)
+}
+func (dst *UserProfileCompany) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfileCompany.Merge(dst, src)
+}
+func (m *UserProfileCompany) XXX_Size() int {
+	return xxx_messageInfo_UserProfileCompany.Size(m)
+}
+func (m *UserProfileCompany) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfileCompany.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfileCompany proto.InternalMessageInfo
+
+func (m *UserProfileCompany) GetCompanyName() string {
+	if m != nil {
+		return m.CompanyName
+	}
+	return ""
+}
+
+func (m *UserProfileCompany) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *UserProfileCompany) GetAnnualIncome() *billing.RangeInt {
+	if m != nil {
+		return m.AnnualIncome
+	}
+	return nil
+}
+
+func (m *UserProfileCompany) GetNumberOfEmployees() *billing.RangeInt {
+	if m != nil {
+		return m.NumberOfEmployees
+	}
+	return nil
+}
+
+func (m *UserProfileCompany) GetKindOfActivity() string {
+	if m != nil {
+		return m.KindOfActivity
+	}
+	return ""
+}
+
+func (m *UserProfileCompany) GetMonetization() *UserProfileCompanyMonetization {
+	if m != nil {
+		return m.Monetization
+	}
+	return nil
+}
+
+func (m *UserProfileCompany) GetPlatforms() *UserProfileCompanyPlatforms {
+	if m != nil {
+		return m.Platforms
+	}
+	return nil
+}
+
+type UserProfileEmail struct {
+	// @inject_tag: json:"email" validate:"required,email"
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email" validate:"required,email"`
+	// @inject_tag: json:"confirmed"
+	Confirmed bool `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed"`
+	// @inject_tag: json:"confirmed_at"
+	ConfirmedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at"`
+	// @inject_tag: json:"-" bson:"-"
+	ConfirmationUrl string `protobuf:"bytes,4,opt,name=confirmation_url,json=confirmationUrl,proto3" json:"-" bson:"-"`
+	// @inject_tag: json:"-" bson:"is_confirmation_email_sent"
+	IsConfirmationEmailSent bool     `protobuf:"varint,5,opt,name=is_confirmation_email_sent,json=isConfirmationEmailSent,proto3" json:"-" bson:"is_confirmation_email_sent"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized        []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache           int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfileEmail) Reset()         { *m = UserProfileEmail{} }
+func (m *UserProfileEmail) String() string { return proto.CompactTextString(m) }
+func (*UserProfileEmail) ProtoMessage()    {}
+func (*UserProfileEmail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{168}
+}
+func (m *UserProfileEmail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfileEmail.Unmarshal(m, b)
+}
+func (m *UserProfileEmail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfileEmail.Marshal(b, m, deterministic)
+}
+func (dst *UserProfileEmail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfileEmail.Merge(dst, src)
+}
+func (m *UserProfileEmail) XXX_Size() int {
+	return xxx_messageInfo_UserProfileEmail.Size(m)
+}
+func (m *UserProfileEmail) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfileEmail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfileEmail proto.InternalMessageInfo
+
+func (m *UserProfileEmail) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *UserProfileEmail) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *UserProfileEmail) GetConfirmedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ConfirmedAt
+	}
+	return nil
+}
+
+func (m *UserProfileEmail) GetConfirmationUrl() string {
+	if m != nil {
+		return m.ConfirmationUrl
+	}
+	return ""
+}
+
+func (m *UserProfileEmail) GetIsConfirmationEmailSent() bool {
+	if m != nil {
+		return m.IsConfirmationEmailSent
+	}
+	return false
+}
+
+type UserProfile struct {
+	// @inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// @inject_tag: json:"-" validate:"required,hexadecimal,len=24"
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"-" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"email"
+	Email *UserProfileEmail `protobuf:"bytes,3,opt,name=email,proto3" json:"email"`
+	// @inject_tag: json:"personal"
+	Personal *UserProfilePersonal `protobuf:"bytes,4,opt,name=personal,proto3" json:"personal"`
+	// @inject_tag: json:"help"
+	Help *UserProfileHelp `protobuf:"bytes,5,opt,name=help,proto3" json:"help"`
+	// @inject_tag: json:"company"
+	Company *UserProfileCompany `protobuf:"bytes,6,opt,name=company,proto3" json:"company"`
+	// @inject_tag: json:"last_step" bson:"last_step"
+	LastStep string `protobuf:"bytes,7,opt,name=last_step,json=lastStep,proto3" json:"last_step" bson:"last_step"`
+	// @inject_tag: json:"centrifugo_token" bson:"centrifugo_token"
+	CentrifugoToken string `protobuf:"bytes,8,opt,name=centrifugo_token,json=centrifugoToken,proto3" json:"centrifugo_token" bson:"centrifugo_token"`
+	// @inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// @inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UserProfile) Reset()         { *m = UserProfile{} }
+func (m *UserProfile) String() string { return proto.CompactTextString(m) }
+func (*UserProfile) ProtoMessage()    {}
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{169}
+}
+func (m *UserProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserProfile.Unmarshal(m, b)
+}
+func (m *UserProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserProfile.Marshal(b, m, deterministic)
+}
+func (dst *UserProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserProfile.Merge(dst, src)
+}
+func (m *UserProfile) XXX_Size() int {
+	return xxx_messageInfo_UserProfile.Size(m)
+}
+func (m *UserProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserProfile proto.InternalMessageInfo
+
+func (m *UserProfile) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UserProfile) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UserProfile) GetEmail() *UserProfileEmail {
+	if m != nil {
+		return m.Email
+	}
+	return nil
+}
+
+func (m *UserProfile) GetPersonal() *UserProfilePersonal {
+	if m != nil {
+		return m.Personal
+	}
+	return nil
+}
+
+func (m *UserProfile) GetHelp() *UserProfileHelp {
+	if m != nil {
+		return m.Help
+	}
+	return nil
+}
+
+func (m *UserProfile) GetCompany() *UserProfileCompany {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+func (m *UserProfile) GetLastStep() string {
+	if m != nil {
+		return m.LastStep
+	}
+	return ""
+}
+
+func (m *UserProfile) GetCentrifugoToken() string {
+	if m != nil {
+		return m.CentrifugoToken
+	}
+	return ""
+}
+
+func (m *UserProfile) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *UserProfile) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type GetUserProfileRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	ProfileId            string   `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetUserProfileRequest) Reset()         { *m = GetUserProfileRequest{} }
+func (m *GetUserProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserProfileRequest) ProtoMessage()    {}
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{170}
+}
+func (m *GetUserProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserProfileRequest.Unmarshal(m, b)
+}
+func (m *GetUserProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserProfileRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetUserProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserProfileRequest.Merge(dst, src)
+}
+func (m *GetUserProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserProfileRequest.Size(m)
+}
+func (m *GetUserProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserProfileRequest proto.InternalMessageInfo
+
+func (m *GetUserProfileRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *GetUserProfileRequest) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+type GetUserProfileResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *UserProfile          `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetUserProfileResponse) Reset()         { *m = GetUserProfileResponse{} }
+func (m *GetUserProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserProfileResponse) ProtoMessage()    {}
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{171}
+}
+func (m *GetUserProfileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserProfileResponse.Unmarshal(m, b)
+}
+func (m *GetUserProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserProfileResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetUserProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserProfileResponse.Merge(dst, src)
+}
+func (m *GetUserProfileResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUserProfileResponse.Size(m)
+}
+func (m *GetUserProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserProfileResponse proto.InternalMessageInfo
+
+func (m *GetUserProfileResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetUserProfileResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GetUserProfileResponse) GetItem() *UserProfile {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type PageReview struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// @inject_tag: json:"user_id" bson:"user_id"
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id"`
+	Review string `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	Url    string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	IsRead bool   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	// @inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// @inject_tag: json:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PageReview) Reset()         { *m = PageReview{} }
+func (m *PageReview) String() string { return proto.CompactTextString(m) }
+func (*PageReview) ProtoMessage()    {}
+func (*PageReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{172}
+}
+func (m *PageReview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageReview.Unmarshal(m, b)
+}
+func (m *PageReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageReview.Marshal(b, m, deterministic)
+}
+func (dst *PageReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageReview.Merge(dst, src)
+}
+func (m *PageReview) XXX_Size() int {
+	return xxx_messageInfo_PageReview.Size(m)
+}
+func (m *PageReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageReview proto.InternalMessageInfo
+
+func (m *PageReview) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PageReview) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *PageReview) GetReview() string {
+	if m != nil {
+		return m.Review
+	}
+	return ""
+}
+
+func (m *PageReview) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PageReview) GetIsRead() bool {
+	if m != nil {
+		return m.IsRead
+	}
+	return false
+}
+
+func (m *PageReview) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PageReview) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePageReviewRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,max=500"
+	Review string `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty" validate:"required,max=500"`
+	// @inject_tag: validate:"required"
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CreatePageReviewRequest) Reset()         { *m = CreatePageReviewRequest{} }
+func (m *CreatePageReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePageReviewRequest) ProtoMessage()    {}
+func (*CreatePageReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{173}
+}
+func (m *CreatePageReviewRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePageReviewRequest.Unmarshal(m, b)
+}
+func (m *CreatePageReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePageReviewRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreatePageReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePageReviewRequest.Merge(dst, src)
+}
+func (m *CreatePageReviewRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePageReviewRequest.Size(m)
+}
+func (m *CreatePageReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePageReviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePageReviewRequest proto.InternalMessageInfo
+
+func (m *CreatePageReviewRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CreatePageReviewRequest) GetReview() string {
+	if m != nil {
+		return m.Review
+	}
+	return ""
+}
+
+func (m *CreatePageReviewRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type ConfirmUserEmailRequest struct {
+	// @inject_tag: validate:"required"
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ConfirmUserEmailRequest) Reset()         { *m = ConfirmUserEmailRequest{} }
+func (m *ConfirmUserEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmUserEmailRequest) ProtoMessage()    {}
+func (*ConfirmUserEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{174}
+}
+func (m *ConfirmUserEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmUserEmailRequest.Unmarshal(m, b)
+}
+func (m *ConfirmUserEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmUserEmailRequest.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmUserEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmUserEmailRequest.Merge(dst, src)
+}
+func (m *ConfirmUserEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmUserEmailRequest.Size(m)
+}
+func (m *ConfirmUserEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmUserEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmUserEmailRequest proto.InternalMessageInfo
+
+func (m *ConfirmUserEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type VatTransactionsRequest struct {
+	// @inject_tag: json:"vat_report_id" validate:"required,hexadecimal,len=24"
+	VatReportId string `protobuf:"bytes,1,opt,name=vat_report_id,json=vatReportId,proto3" json:"vat_report_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: query:"sort[]"
+	Sort                 []string `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty" query:"sort[]"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatTransactionsRequest) Reset()         { *m = VatTransactionsRequest{} }
+func (m *VatTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*VatTransactionsRequest) ProtoMessage()    {}
+func (*VatTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{175}
+}
+func (m *VatTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatTransactionsRequest.Unmarshal(m, b)
+}
+func (m *VatTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *VatTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatTransactionsRequest.Merge(dst, src)
+}
+func (m *VatTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_VatTransactionsRequest.Size(m)
+}
+func (m *VatTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatTransactionsRequest proto.InternalMessageInfo
+
+func (m *VatTransactionsRequest) GetVatReportId() string {
+	if m != nil {
+		return m.VatReportId
+	}
+	return ""
+}
+
+func (m *VatTransactionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *VatTransactionsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *VatTransactionsRequest) GetSort() []string {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+type TransactionsPaginate struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*billing.OrderViewPublic `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *TransactionsPaginate) Reset()         { *m = TransactionsPaginate{} }
+func (m *TransactionsPaginate) String() string { return proto.CompactTextString(m) }
+func (*TransactionsPaginate) ProtoMessage()    {}
+func (*TransactionsPaginate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{176}
+}
+func (m *TransactionsPaginate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionsPaginate.Unmarshal(m, b)
+}
+func (m *TransactionsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionsPaginate.Marshal(b, m, deterministic)
+}
+func (dst *TransactionsPaginate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionsPaginate.Merge(dst, src)
+}
+func (m *TransactionsPaginate) XXX_Size() int {
+	return xxx_messageInfo_TransactionsPaginate.Size(m)
+}
+func (m *TransactionsPaginate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionsPaginate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionsPaginate proto.InternalMessageInfo
+
+func (m *TransactionsPaginate) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TransactionsPaginate) GetItems() []*billing.OrderViewPublic {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type TransactionsResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"data"
+	Data                 *TransactionsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *TransactionsResponse) Reset()         { *m = TransactionsResponse{} }
+func (m *TransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionsResponse) ProtoMessage()    {}
+func (*TransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{177}
+}
+func (m *TransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionsResponse.Unmarshal(m, b)
+}
+func (m *TransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionsResponse.Merge(dst, src)
+}
+func (m *TransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_TransactionsResponse.Size(m)
+}
+func (m *TransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionsResponse proto.InternalMessageInfo
+
+func (m *TransactionsResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *TransactionsResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *TransactionsResponse) GetData() *TransactionsPaginate {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type VatReportsRequest struct {
+	// @inject_tag: json:"country" query:"country" validate:"required,alpha,len=2"
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country" query:"country" validate:"required,alpha,len=2"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: query:"sort[]"
+	Sort                 []string `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty" query:"sort[]"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatReportsRequest) Reset()         { *m = VatReportsRequest{} }
+func (m *VatReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*VatReportsRequest) ProtoMessage()    {}
+func (*VatReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{178}
+}
+func (m *VatReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatReportsRequest.Unmarshal(m, b)
+}
+func (m *VatReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *VatReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatReportsRequest.Merge(dst, src)
+}
+func (m *VatReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_VatReportsRequest.Size(m)
+}
+func (m *VatReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatReportsRequest proto.InternalMessageInfo
+
+func (m *VatReportsRequest) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *VatReportsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *VatReportsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *VatReportsRequest) GetSort() []string {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+type VatReportsPaginate struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*billing.VatReport `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatReportsPaginate) Reset()         { *m = VatReportsPaginate{} }
+func (m *VatReportsPaginate) String() string { return proto.CompactTextString(m) }
+func (*VatReportsPaginate) ProtoMessage()    {}
+func (*VatReportsPaginate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{179}
+}
+func (m *VatReportsPaginate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatReportsPaginate.Unmarshal(m, b)
+}
+func (m *VatReportsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatReportsPaginate.Marshal(b, m, deterministic)
+}
+func (dst *VatReportsPaginate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatReportsPaginate.Merge(dst, src)
+}
+func (m *VatReportsPaginate) XXX_Size() int {
+	return xxx_messageInfo_VatReportsPaginate.Size(m)
+}
+func (m *VatReportsPaginate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatReportsPaginate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatReportsPaginate proto.InternalMessageInfo
+
+func (m *VatReportsPaginate) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *VatReportsPaginate) GetItems() []*billing.VatReport {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type VatReportsResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"data"
+	Data                 *VatReportsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatReportsResponse) Reset()         { *m = VatReportsResponse{} }
+func (m *VatReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*VatReportsResponse) ProtoMessage()    {}
+func (*VatReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{180}
+}
+func (m *VatReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatReportsResponse.Unmarshal(m, b)
+}
+func (m *VatReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatReportsResponse.Marshal(b, m, deterministic)
+}
+func (dst *VatReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatReportsResponse.Merge(dst, src)
+}
+func (m *VatReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_VatReportsResponse.Size(m)
+}
+func (m *VatReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatReportsResponse proto.InternalMessageInfo
+
+func (m *VatReportsResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *VatReportsResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *VatReportsResponse) GetData() *VatReportsPaginate {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ProcessVatReportsRequest struct {
+	Date                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ProcessVatReportsRequest) Reset()         { *m = ProcessVatReportsRequest{} }
+func (m *ProcessVatReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ProcessVatReportsRequest) ProtoMessage()    {}
+func (*ProcessVatReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{181}
+}
+func (m *ProcessVatReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessVatReportsRequest.Unmarshal(m, b)
+}
+func (m *ProcessVatReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessVatReportsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProcessVatReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessVatReportsRequest.Merge(dst, src)
+}
+func (m *ProcessVatReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ProcessVatReportsRequest.Size(m)
+}
+func (m *ProcessVatReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessVatReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessVatReportsRequest proto.InternalMessageInfo
+
+func (m *ProcessVatReportsRequest) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type UpdateVatReportStatusRequest struct {
+	// @inject_tag: json:"id" bson:"_id" validate:"hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"hexadecimal,len=24"`
+	// @inject_tag: json:"status" bson:"status" validate:"required,alpha,oneof=paid canceled"
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status" bson:"status" validate:"required,alpha,oneof=paid canceled"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UpdateVatReportStatusRequest) Reset()         { *m = UpdateVatReportStatusRequest{} }
+func (m *UpdateVatReportStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateVatReportStatusRequest) ProtoMessage()    {}
+func (*UpdateVatReportStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{182}
+}
+func (m *UpdateVatReportStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateVatReportStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateVatReportStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateVatReportStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateVatReportStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVatReportStatusRequest.Merge(dst, src)
+}
+func (m *UpdateVatReportStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateVatReportStatusRequest.Size(m)
+}
+func (m *UpdateVatReportStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVatReportStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVatReportStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateVatReportStatusRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateVatReportStatusRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type CountryVatSettingsResponse struct {
+	Status               int32                       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.CountryVatSettings `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CountryVatSettingsResponse) Reset()         { *m = CountryVatSettingsResponse{} }
+func (m *CountryVatSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*CountryVatSettingsResponse) ProtoMessage()    {}
+func (*CountryVatSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{183}
+}
+func (m *CountryVatSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountryVatSettingsResponse.Unmarshal(m, b)
+}
+func (m *CountryVatSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountryVatSettingsResponse.Marshal(b, m, deterministic)
+}
+func (dst *CountryVatSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountryVatSettingsResponse.Merge(dst, src)
+}
+func (m *CountryVatSettingsResponse) XXX_Size() int {
+	return xxx_messageInfo_CountryVatSettingsResponse.Size(m)
+}
+func (m *CountryVatSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountryVatSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountryVatSettingsResponse proto.InternalMessageInfo
+
+func (m *CountryVatSettingsResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *CountryVatSettingsResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *CountryVatSettingsResponse) GetItem() *billing.CountryVatSettings {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type CountryVatSettingsHistoryResponse struct {
+	Status               int32                         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items                []*billing.CountryVatSettings `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CountryVatSettingsHistoryResponse) Reset()         { *m = CountryVatSettingsHistoryResponse{} }
+func (m *CountryVatSettingsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*CountryVatSettingsHistoryResponse) ProtoMessage()    {}
+func (*CountryVatSettingsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{184}
+}
+func (m *CountryVatSettingsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountryVatSettingsHistoryResponse.Unmarshal(m, b)
+}
+func (m *CountryVatSettingsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountryVatSettingsHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *CountryVatSettingsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountryVatSettingsHistoryResponse.Merge(dst, src)
+}
+func (m *CountryVatSettingsHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_CountryVatSettingsHistoryResponse.Size(m)
+}
+func (m *CountryVatSettingsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountryVatSettingsHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountryVatSettingsHistoryResponse proto.InternalMessageInfo
+
+func (m *CountryVatSettingsHistoryResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *CountryVatSettingsHistoryResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *CountryVatSettingsHistoryResponse) GetItems() []*billing.CountryVatSettings {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type VatOssReturnRequest struct {
+	// @inject_tag: json:"year" validate:"required,numeric,gte=2019"
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year" validate:"required,numeric,gte=2019"`
+	// @inject_tag: json:"quarter" validate:"required,numeric,gte=1,lte=4"
+	Quarter              int32    `protobuf:"varint,2,opt,name=quarter,proto3" json:"quarter" validate:"required,numeric,gte=1,lte=4"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatOssReturnRequest) Reset()         { *m = VatOssReturnRequest{} }
+func (m *VatOssReturnRequest) String() string { return proto.CompactTextString(m) }
+func (*VatOssReturnRequest) ProtoMessage()    {}
+func (*VatOssReturnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{185}
+}
+func (m *VatOssReturnRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatOssReturnRequest.Unmarshal(m, b)
+}
+func (m *VatOssReturnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatOssReturnRequest.Marshal(b, m, deterministic)
+}
+func (dst *VatOssReturnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatOssReturnRequest.Merge(dst, src)
+}
+func (m *VatOssReturnRequest) XXX_Size() int {
+	return xxx_messageInfo_VatOssReturnRequest.Size(m)
+}
+func (m *VatOssReturnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatOssReturnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatOssReturnRequest proto.InternalMessageInfo
+
+func (m *VatOssReturnRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *VatOssReturnRequest) GetQuarter() int32 {
+	if m != nil {
+		return m.Quarter
+	}
+	return 0
+}
+
+type VatOssReturnResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.VatOssReturn `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VatOssReturnResponse) Reset()         { *m = VatOssReturnResponse{} }
+func (m *VatOssReturnResponse) String() string { return proto.CompactTextString(m) }
+func (*VatOssReturnResponse) ProtoMessage()    {}
+func (*VatOssReturnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{186}
+}
+func (m *VatOssReturnResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VatOssReturnResponse.Unmarshal(m, b)
+}
+func (m *VatOssReturnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VatOssReturnResponse.Marshal(b, m, deterministic)
+}
+func (dst *VatOssReturnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VatOssReturnResponse.Merge(dst, src)
+}
+func (m *VatOssReturnResponse) XXX_Size() int {
+	return xxx_messageInfo_VatOssReturnResponse.Size(m)
+}
+func (m *VatOssReturnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VatOssReturnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VatOssReturnResponse proto.InternalMessageInfo
+
+func (m *VatOssReturnResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *VatOssReturnResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *VatOssReturnResponse) GetItem() *billing.VatOssReturn {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetMerchantOnboardingCompleteDataResponseItem struct {
+	// @inject_tag: json:"status"
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"steps"
+	Steps *billing.MerchantCompletedSteps `protobuf:"bytes,2,opt,name=steps,proto3" json:"steps"`
+	// @inject_tag: json:"complete_steps_count"
+	CompleteStepsCount   int32    `protobuf:"varint,3,opt,name=complete_steps_count,json=completeStepsCount,proto3" json:"complete_steps_count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantOnboardingCompleteDataResponseItem) Reset() {
+	*m = GetMerchantOnboardingCompleteDataResponseItem{}
+}
+func (m *GetMerchantOnboardingCompleteDataResponseItem) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetMerchantOnboardingCompleteDataResponseItem) ProtoMessage() {}
+func (*GetMerchantOnboardingCompleteDataResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{187}
+}
+func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Unmarshal(m, b)
+}
+func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantOnboardingCompleteDataResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Merge(dst, src)
+}
+func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Size(m)
+}
+func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem proto.InternalMessageInfo
+
+func (m *GetMerchantOnboardingCompleteDataResponseItem) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetMerchantOnboardingCompleteDataResponseItem) GetSteps() *billing.MerchantCompletedSteps {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *GetMerchantOnboardingCompleteDataResponseItem) GetCompleteStepsCount() int32 {
+	if m != nil {
+		return m.CompleteStepsCount
+	}
+	return 0
+}
+
+type GetMerchantOnboardingCompleteDataResponse struct {
+	Status               int32                                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *GetMerchantOnboardingCompleteDataResponseItem `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantOnboardingCompleteDataResponse) Reset() {
+	*m = GetMerchantOnboardingCompleteDataResponse{}
+}
+func (m *GetMerchantOnboardingCompleteDataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantOnboardingCompleteDataResponse) ProtoMessage()    {}
+func (*GetMerchantOnboardingCompleteDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{188}
+}
+func (m *GetMerchantOnboardingCompleteDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Unmarshal(m, b)
+}
+func (m *GetMerchantOnboardingCompleteDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantOnboardingCompleteDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Merge(dst, src)
+}
+func (m *GetMerchantOnboardingCompleteDataResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Size(m)
+}
+func (m *GetMerchantOnboardingCompleteDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse proto.InternalMessageInfo
+
+func (m *GetMerchantOnboardingCompleteDataResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetMerchantOnboardingCompleteDataResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GetMerchantOnboardingCompleteDataResponse) GetItem() *GetMerchantOnboardingCompleteDataResponseItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetMerchantAgreementSignUrlRequest struct {
+	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"signer_type" validate:"oneof=0 1"
+	SignerType           int32    `protobuf:"varint,2,opt,name=signer_type,json=signerType,proto3" json:"signer_type" validate:"oneof=0 1"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantAgreementSignUrlRequest) Reset()         { *m = GetMerchantAgreementSignUrlRequest{} }
+func (m *GetMerchantAgreementSignUrlRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantAgreementSignUrlRequest) ProtoMessage()    {}
+func (*GetMerchantAgreementSignUrlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{189}
+}
+func (m *GetMerchantAgreementSignUrlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Unmarshal(m, b)
+}
+func (m *GetMerchantAgreementSignUrlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantAgreementSignUrlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Merge(dst, src)
+}
+func (m *GetMerchantAgreementSignUrlRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Size(m)
+}
+func (m *GetMerchantAgreementSignUrlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantAgreementSignUrlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantAgreementSignUrlRequest proto.InternalMessageInfo
+
+func (m *GetMerchantAgreementSignUrlRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *GetMerchantAgreementSignUrlRequest) GetSignerType() int32 {
+	if m != nil {
+		return m.SignerType
+	}
+	return 0
+}
+
+type GetMerchantAgreementSignUrlResponse struct {
+	Status               int32                                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.MerchantAgreementSignatureDataSignUrl `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantAgreementSignUrlResponse) Reset()         { *m = GetMerchantAgreementSignUrlResponse{} }
+func (m *GetMerchantAgreementSignUrlResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantAgreementSignUrlResponse) ProtoMessage()    {}
+func (*GetMerchantAgreementSignUrlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{190}
+}
+func (m *GetMerchantAgreementSignUrlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Unmarshal(m, b)
+}
+func (m *GetMerchantAgreementSignUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantAgreementSignUrlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Merge(dst, src)
+}
+func (m *GetMerchantAgreementSignUrlResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Size(m)
+}
+func (m *GetMerchantAgreementSignUrlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantAgreementSignUrlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantAgreementSignUrlResponse proto.InternalMessageInfo
+
+func (m *GetMerchantAgreementSignUrlResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetMerchantAgreementSignUrlResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GetMerchantAgreementSignUrlResponse) GetItem() *billing.MerchantAgreementSignatureDataSignUrl {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetMerchantTariffRatesRequest struct {
+	// @inject_tag: query:"region" validate:"required,tariff_region"
+	HomeRegion string `protobuf:"bytes,1,opt,name=home_region,json=homeRegion,proto3" json:"home_region,omitempty" query:"region" validate:"required,tariff_region"`
+	// @inject_tag: query:"payer_region" validate:"omitempty,tariff_region"
+	PayerRegion string `protobuf:"bytes,2,opt,name=payer_region,json=payerRegion,proto3" json:"payer_region,omitempty" query:"payer_region" validate:"omitempty,tariff_region"`
+	// @inject_tag: query:"min_amount" validate:"omitempty,numeric,gte=0"
+	MinAmount float64 `protobuf:"fixed64,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty" query:"min_amount" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: query:"max_amount" validate:"omitempty,numeric,gt=0"
+	MaxAmount            float64  `protobuf:"fixed64,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty" query:"max_amount" validate:"omitempty,numeric,gt=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantTariffRatesRequest) Reset()         { *m = GetMerchantTariffRatesRequest{} }
+func (m *GetMerchantTariffRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantTariffRatesRequest) ProtoMessage()    {}
+func (*GetMerchantTariffRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{191}
+}
+func (m *GetMerchantTariffRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantTariffRatesRequest.Unmarshal(m, b)
+}
+func (m *GetMerchantTariffRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantTariffRatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantTariffRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantTariffRatesRequest.Merge(dst, src)
+}
+func (m *GetMerchantTariffRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantTariffRatesRequest.Size(m)
+}
+func (m *GetMerchantTariffRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantTariffRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantTariffRatesRequest proto.InternalMessageInfo
+
+func (m *GetMerchantTariffRatesRequest) GetHomeRegion() string {
+	if m != nil {
+		return m.HomeRegion
+	}
+	return ""
+}
+
+func (m *GetMerchantTariffRatesRequest) GetPayerRegion() string {
+	if m != nil {
+		return m.PayerRegion
+	}
+	return ""
+}
+
+func (m *GetMerchantTariffRatesRequest) GetMinAmount() float64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *GetMerchantTariffRatesRequest) GetMaxAmount() float64 {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return 0
+}
+
+type GetMerchantTariffRatesResponseItems struct {
+	Payment []*billing.MerchantTariffRatesPayment `protobuf:"bytes,1,rep,name=payment,proto3" json:"payment,omitempty"`
+	// @inject_tag: json:"-"
+	Refund []*billing.MerchantTariffRatesSettingsRefundItem `protobuf:"bytes,2,rep,name=refund,proto3" json:"-"`
+	// @inject_tag: json:"chargeback"
+	Chargeback *billing.MerchantTariffRatesSettingsItem `protobuf:"bytes,3,opt,name=chargeback,proto3" json:"chargeback"`
+	// @inject_tag: json:"payout"
+	Payout               *billing.MerchantTariffRatesSettingsItem `protobuf:"bytes,4,opt,name=payout,proto3" json:"payout"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantTariffRatesResponseItems) Reset()         { *m = GetMerchantTariffRatesResponseItems{} }
+func (m *GetMerchantTariffRatesResponseItems) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantTariffRatesResponseItems) ProtoMessage()    {}
+func (*GetMerchantTariffRatesResponseItems) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{192}
+}
+func (m *GetMerchantTariffRatesResponseItems) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantTariffRatesResponseItems.Unmarshal(m, b)
+}
+func (m *GetMerchantTariffRatesResponseItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantTariffRatesResponseItems.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantTariffRatesResponseItems) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantTariffRatesResponseItems.Merge(dst, src)
+}
+func (m *GetMerchantTariffRatesResponseItems) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantTariffRatesResponseItems.Size(m)
+}
+func (m *GetMerchantTariffRatesResponseItems) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantTariffRatesResponseItems.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantTariffRatesResponseItems proto.InternalMessageInfo
+
+func (m *GetMerchantTariffRatesResponseItems) GetPayment() []*billing.MerchantTariffRatesPayment {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *GetMerchantTariffRatesResponseItems) GetRefund() []*billing.MerchantTariffRatesSettingsRefundItem {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *GetMerchantTariffRatesResponseItems) GetChargeback() *billing.MerchantTariffRatesSettingsItem {
+	if m != nil {
+		return m.Chargeback
+	}
+	return nil
+}
+
+func (m *GetMerchantTariffRatesResponseItems) GetPayout() *billing.MerchantTariffRatesSettingsItem {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+type GetMerchantTariffRatesResponse struct {
+	Status               int32                                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items                *GetMerchantTariffRatesResponseItems `protobuf:"bytes,3,opt,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantTariffRatesResponse) Reset()         { *m = GetMerchantTariffRatesResponse{} }
+func (m *GetMerchantTariffRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantTariffRatesResponse) ProtoMessage()    {}
+func (*GetMerchantTariffRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{193}
+}
+func (m *GetMerchantTariffRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantTariffRatesResponse.Unmarshal(m, b)
+}
+func (m *GetMerchantTariffRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantTariffRatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMerchantTariffRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantTariffRatesResponse.Merge(dst, src)
+}
+func (m *GetMerchantTariffRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantTariffRatesResponse.Size(m)
+}
+func (m *GetMerchantTariffRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantTariffRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantTariffRatesResponse proto.InternalMessageInfo
+
+func (m *GetMerchantTariffRatesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetMerchantTariffRatesResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GetMerchantTariffRatesResponse) GetItems() *GetMerchantTariffRatesResponseItems {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type SetMerchantTariffRatesRequest struct {
+	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"home_region" validate:"required,tariff_region"
+	HomeRegion           string   `protobuf:"bytes,2,opt,name=home_region,json=homeRegion,proto3" json:"home_region" validate:"required,tariff_region"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *SetMerchantTariffRatesRequest) Reset()         { *m = SetMerchantTariffRatesRequest{} }
+func (m *SetMerchantTariffRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMerchantTariffRatesRequest) ProtoMessage()    {}
+func (*SetMerchantTariffRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{194}
+}
+func (m *SetMerchantTariffRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMerchantTariffRatesRequest.Unmarshal(m, b)
+}
+func (m *SetMerchantTariffRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMerchantTariffRatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetMerchantTariffRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMerchantTariffRatesRequest.Merge(dst, src)
+}
+func (m *SetMerchantTariffRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMerchantTariffRatesRequest.Size(m)
+}
+func (m *SetMerchantTariffRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMerchantTariffRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMerchantTariffRatesRequest proto.InternalMessageInfo
+
+func (m *SetMerchantTariffRatesRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *SetMerchantTariffRatesRequest) GetHomeRegion() string {
+	if m != nil {
+		return m.HomeRegion
+	}
+	return ""
+}
+
+type ListOrdersPublicResponseItem struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*billing.OrderViewPublic `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOrdersPublicResponseItem) Reset()         { *m = ListOrdersPublicResponseItem{} }
+func (m *ListOrdersPublicResponseItem) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersPublicResponseItem) ProtoMessage()    {}
+func (*ListOrdersPublicResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{195}
+}
+func (m *ListOrdersPublicResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersPublicResponseItem.Unmarshal(m, b)
+}
+func (m *ListOrdersPublicResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersPublicResponseItem.Marshal(b, m, deterministic)
+}
+func (dst *ListOrdersPublicResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersPublicResponseItem.Merge(dst, src)
+}
+func (m *ListOrdersPublicResponseItem) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersPublicResponseItem.Size(m)
+}
+func (m *ListOrdersPublicResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersPublicResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersPublicResponseItem proto.InternalMessageInfo
+
+func (m *ListOrdersPublicResponseItem) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListOrdersPublicResponseItem) GetItems() []*billing.OrderViewPublic {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListOrdersPrivateResponseItem struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*billing.OrderViewPrivate `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOrdersPrivateResponseItem) Reset()         { *m = ListOrdersPrivateResponseItem{} }
+func (m *ListOrdersPrivateResponseItem) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersPrivateResponseItem) ProtoMessage()    {}
+func (*ListOrdersPrivateResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{196}
+}
+func (m *ListOrdersPrivateResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersPrivateResponseItem.Unmarshal(m, b)
+}
+func (m *ListOrdersPrivateResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersPrivateResponseItem.Marshal(b, m, deterministic)
+}
+func (dst *ListOrdersPrivateResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersPrivateResponseItem.Merge(dst, src)
+}
+func (m *ListOrdersPrivateResponseItem) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersPrivateResponseItem.Size(m)
+}
+func (m *ListOrdersPrivateResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersPrivateResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersPrivateResponseItem proto.InternalMessageInfo
+
+func (m *ListOrdersPrivateResponseItem) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListOrdersPrivateResponseItem) GetItems() []*billing.OrderViewPrivate {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListOrdersPublicResponse struct {
+	Status               int32                         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *ListOrdersPublicResponseItem `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOrdersPublicResponse) Reset()         { *m = ListOrdersPublicResponse{} }
+func (m *ListOrdersPublicResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersPublicResponse) ProtoMessage()    {}
+func (*ListOrdersPublicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{197}
+}
+func (m *ListOrdersPublicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersPublicResponse.Unmarshal(m, b)
+}
+func (m *ListOrdersPublicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersPublicResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListOrdersPublicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersPublicResponse.Merge(dst, src)
+}
+func (m *ListOrdersPublicResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersPublicResponse.Size(m)
+}
+func (m *ListOrdersPublicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersPublicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersPublicResponse proto.InternalMessageInfo
+
+func (m *ListOrdersPublicResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListOrdersPublicResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ListOrdersPublicResponse) GetItem() *ListOrdersPublicResponseItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListOrdersPrivateResponse struct {
+	Status               int32                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *ListOrdersPrivateResponseItem `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOrdersPrivateResponse) Reset()         { *m = ListOrdersPrivateResponse{} }
+func (m *ListOrdersPrivateResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersPrivateResponse) ProtoMessage()    {}
+func (*ListOrdersPrivateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{198}
+}
+func (m *ListOrdersPrivateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersPrivateResponse.Unmarshal(m, b)
+}
+func (m *ListOrdersPrivateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersPrivateResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListOrdersPrivateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersPrivateResponse.Merge(dst, src)
+}
+func (m *ListOrdersPrivateResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersPrivateResponse.Size(m)
+}
+func (m *ListOrdersPrivateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersPrivateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersPrivateResponse proto.InternalMessageInfo
+
+func (m *ListOrdersPrivateResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListOrdersPrivateResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ListOrdersPrivateResponse) GetItem() *ListOrdersPrivateResponseItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListOrdersResponseItem struct {
+	Count                int32            `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.Order `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32            `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOrdersResponseItem) Reset()         { *m = ListOrdersResponseItem{} }
+func (m *ListOrdersResponseItem) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersResponseItem) ProtoMessage()    {}
+func (*ListOrdersResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{199}
+}
+func (m *ListOrdersResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersResponseItem.Unmarshal(m, b)
+}
+func (m *ListOrdersResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersResponseItem.Marshal(b, m, deterministic)
+}
+func (dst *ListOrdersResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersResponseItem.Merge(dst, src)
+}
+func (m *ListOrdersResponseItem) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersResponseItem.Size(m)
+}
+func (m *ListOrdersResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersResponseItem proto.InternalMessageInfo
+
+func (m *ListOrdersResponseItem) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListOrdersResponseItem) GetItems() []*billing.Order {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListOrdersResponse struct {
+	Status               int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *ListOrdersResponseItem `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOrdersResponse) Reset()         { *m = ListOrdersResponse{} }
+func (m *ListOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersResponse) ProtoMessage()    {}
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{200}
+}
+func (m *ListOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersResponse.Unmarshal(m, b)
+}
+func (m *ListOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersResponse.Merge(dst, src)
+}
+func (m *ListOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersResponse.Size(m)
+}
+func (m *ListOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersResponse proto.InternalMessageInfo
+
+func (m *ListOrdersResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListOrdersResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ListOrdersResponse) GetItem() *ListOrdersResponseItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetOrderPublicResponse struct {
+	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.OrderViewPublic `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetOrderPublicResponse) Reset()         { *m = GetOrderPublicResponse{} }
+func (m *GetOrderPublicResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderPublicResponse) ProtoMessage()    {}
+func (*GetOrderPublicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{201}
+}
+func (m *GetOrderPublicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderPublicResponse.Unmarshal(m, b)
+}
+func (m *GetOrderPublicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderPublicResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrderPublicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderPublicResponse.Merge(dst, src)
+}
+func (m *GetOrderPublicResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderPublicResponse.Size(m)
+}
+func (m *GetOrderPublicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderPublicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderPublicResponse proto.InternalMessageInfo
+
+func (m *GetOrderPublicResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetOrderPublicResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GetOrderPublicResponse) GetItem() *billing.OrderViewPublic {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetOrderPrivateResponse struct {
+	Status               int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.OrderViewPrivate `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetOrderPrivateResponse) Reset()         { *m = GetOrderPrivateResponse{} }
+func (m *GetOrderPrivateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderPrivateResponse) ProtoMessage()    {}
+func (*GetOrderPrivateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{202}
+}
+func (m *GetOrderPrivateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderPrivateResponse.Unmarshal(m, b)
+}
+func (m *GetOrderPrivateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderPrivateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrderPrivateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderPrivateResponse.Merge(dst, src)
+}
+func (m *GetOrderPrivateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderPrivateResponse.Size(m)
+}
+func (m *GetOrderPrivateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderPrivateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderPrivateResponse proto.InternalMessageInfo
+
+func (m *GetOrderPrivateResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetOrderPrivateResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GetOrderPrivateResponse) GetItem() *billing.OrderViewPrivate {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetDashboardMainRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"period" validate:"required,oneof=current_month previous_month current_quarter previous_quarter current_year previous_year"
+	Period               string   `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty" query:"period" validate:"required,oneof=current_month previous_month current_quarter previous_quarter current_year previous_year"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetDashboardMainRequest) Reset()         { *m = GetDashboardMainRequest{} }
+func (m *GetDashboardMainRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDashboardMainRequest) ProtoMessage()    {}
+func (*GetDashboardMainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{203}
+}
+func (m *GetDashboardMainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDashboardMainRequest.Unmarshal(m, b)
+}
+func (m *GetDashboardMainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDashboardMainRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDashboardMainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDashboardMainRequest.Merge(dst, src)
+}
+func (m *GetDashboardMainRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDashboardMainRequest.Size(m)
+}
+func (m *GetDashboardMainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDashboardMainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDashboardMainRequest proto.InternalMessageInfo
+
+func (m *GetDashboardMainRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
 	return ""
 }
 
-type RecommendedPriceTableResponse struct {
-	// @inject_tag: json:"ranges"
-	Ranges               []*billing.PriceTableRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetDashboardMainRequest) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
 }
 
-func (m *RecommendedPriceTableResponse) Reset()         { *m = RecommendedPriceTableResponse{} }
-func (m *RecommendedPriceTableResponse) String() string { return proto.CompactTextString(m) }
-func (*RecommendedPriceTableResponse) ProtoMessage()    {}
-func (*RecommendedPriceTableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{141}
+type GetDashboardMainResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *DashboardMainReport  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *RecommendedPriceTableResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RecommendedPriceTableResponse.Unmarshal(m, b)
+
+func (m *GetDashboardMainResponse) Reset()         { *m = GetDashboardMainResponse{} }
+func (m *GetDashboardMainResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDashboardMainResponse) ProtoMessage()    {}
+func (*GetDashboardMainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{204}
 }
-func (m *RecommendedPriceTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RecommendedPriceTableResponse.Marshal(b, m, deterministic)
+func (m *GetDashboardMainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDashboardMainResponse.Unmarshal(m, b)
 }
-func (dst *RecommendedPriceTableResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RecommendedPriceTableResponse.Merge(dst, src)
+func (m *GetDashboardMainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDashboardMainResponse.Marshal(b, m, deterministic)
 }
-func (m *RecommendedPriceTableResponse) XXX_Size() int {
-	return xxx_messageInfo_RecommendedPriceTableResponse.Size(m)
+func (dst *GetDashboardMainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDashboardMainResponse.Merge(dst, src)
 }
-func (m *RecommendedPriceTableResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RecommendedPriceTableResponse.DiscardUnknown(m)
+func (m *GetDashboardMainResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDashboardMainResponse.Size(m)
+}
+func (m *GetDashboardMainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDashboardMainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RecommendedPriceTableResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDashboardMainResponse proto.InternalMessageInfo
 
-func (m *RecommendedPriceTableResponse) GetRanges() []*billing.PriceTableRange {
+func (m *GetDashboardMainResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Ranges
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetDashboardMainResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-type ProductPricesResponse struct {
-	// @inject_tag: json:"product_price"
-	ProductPrice         []*billing.ProductPrice `protobuf:"bytes,1,rep,name=product_price,json=productPrice,proto3" json:"product_price"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetDashboardMainResponse) GetItem() *DashboardMainReport {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *ProductPricesResponse) Reset()         { *m = ProductPricesResponse{} }
-func (m *ProductPricesResponse) String() string { return proto.CompactTextString(m) }
-func (*ProductPricesResponse) ProtoMessage()    {}
-func (*ProductPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{142}
+type GetDashboardBaseReportRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"period" validate:"required,oneof=current_day previous_day current_week previous_week current_month previous_month current_quarter previous_quarter current_year previous_year"
+	Period               string   `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty" query:"period" validate:"required,oneof=current_day previous_day current_week previous_week current_month previous_month current_quarter previous_quarter current_year previous_year"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *ProductPricesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProductPricesResponse.Unmarshal(m, b)
+
+func (m *GetDashboardBaseReportRequest) Reset()         { *m = GetDashboardBaseReportRequest{} }
+func (m *GetDashboardBaseReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDashboardBaseReportRequest) ProtoMessage()    {}
+func (*GetDashboardBaseReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{205}
 }
-func (m *ProductPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProductPricesResponse.Marshal(b, m, deterministic)
+func (m *GetDashboardBaseReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDashboardBaseReportRequest.Unmarshal(m, b)
 }
-func (dst *ProductPricesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProductPricesResponse.Merge(dst, src)
+func (m *GetDashboardBaseReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDashboardBaseReportRequest.Marshal(b, m, deterministic)
 }
-func (m *ProductPricesResponse) XXX_Size() int {
-	return xxx_messageInfo_ProductPricesResponse.Size(m)
+func (dst *GetDashboardBaseReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDashboardBaseReportRequest.Merge(dst, src)
 }
-func (m *ProductPricesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProductPricesResponse.DiscardUnknown(m)
+func (m *GetDashboardBaseReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDashboardBaseReportRequest.Size(m)
+}
+func (m *GetDashboardBaseReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDashboardBaseReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProductPricesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDashboardBaseReportRequest proto.InternalMessageInfo
 
-func (m *ProductPricesResponse) GetProductPrice() []*billing.ProductPrice {
+func (m *GetDashboardBaseReportRequest) GetMerchantId() string {
 	if m != nil {
-		return m.ProductPrice
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-type UpdateProductPricesRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"product_id"
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required,min=1,dive" json:"prices"
-	Prices               []*billing.ProductPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetDashboardBaseReportRequest) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
 }
 
-func (m *UpdateProductPricesRequest) Reset()         { *m = UpdateProductPricesRequest{} }
-func (m *UpdateProductPricesRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateProductPricesRequest) ProtoMessage()    {}
-func (*UpdateProductPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{143}
+type GetDashboardBaseReportResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *DashboardBaseReports `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *UpdateProductPricesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateProductPricesRequest.Unmarshal(m, b)
+
+func (m *GetDashboardBaseReportResponse) Reset()         { *m = GetDashboardBaseReportResponse{} }
+func (m *GetDashboardBaseReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDashboardBaseReportResponse) ProtoMessage()    {}
+func (*GetDashboardBaseReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{206}
 }
-func (m *UpdateProductPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateProductPricesRequest.Marshal(b, m, deterministic)
+func (m *GetDashboardBaseReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDashboardBaseReportResponse.Unmarshal(m, b)
 }
-func (dst *UpdateProductPricesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateProductPricesRequest.Merge(dst, src)
+func (m *GetDashboardBaseReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDashboardBaseReportResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateProductPricesRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateProductPricesRequest.Size(m)
+func (dst *GetDashboardBaseReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDashboardBaseReportResponse.Merge(dst, src)
 }
-func (m *UpdateProductPricesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateProductPricesRequest.DiscardUnknown(m)
+func (m *GetDashboardBaseReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDashboardBaseReportResponse.Size(m)
+}
+func (m *GetDashboardBaseReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDashboardBaseReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateProductPricesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDashboardBaseReportResponse proto.InternalMessageInfo
 
-func (m *UpdateProductPricesRequest) GetProductId() string {
+func (m *GetDashboardBaseReportResponse) GetStatus() int32 {
 	if m != nil {
-		return m.ProductId
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *UpdateProductPricesRequest) GetPrices() []*billing.ProductPrice {
+func (m *GetDashboardBaseReportResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Prices
+		return m.Message
 	}
 	return nil
 }
 
-type UserProfilePersonal struct {
-	// @inject_tag: json:"first_name" bson:"first_name" validate:"required,name,max=30"
-	FirstName string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name" bson:"first_name" validate:"required,name,max=30"`
-	// @inject_tag: json:"last_name" bson:"last_name" validate:"required,name,max=30"
-	LastName string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name" bson:"last_name" validate:"required,name,max=30"`
-	// @inject_tag: json:"position" bson:"position" validate:"required,position"
-	Position             string   `protobuf:"bytes,3,opt,name=position,proto3" json:"position" bson:"position" validate:"required,position"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetDashboardBaseReportResponse) GetItem() *DashboardBaseReports {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *UserProfilePersonal) Reset()         { *m = UserProfilePersonal{} }
-func (m *UserProfilePersonal) String() string { return proto.CompactTextString(m) }
-func (*UserProfilePersonal) ProtoMessage()    {}
-func (*UserProfilePersonal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{144}
+type GetDashboardRevenueDynamicsReportResponse struct {
+	Status               int32                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *DashboardRevenueDynamicReport `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *UserProfilePersonal) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfilePersonal.Unmarshal(m, b)
+
+func (m *GetDashboardRevenueDynamicsReportResponse) Reset() {
+	*m = GetDashboardRevenueDynamicsReportResponse{}
 }
-func (m *UserProfilePersonal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfilePersonal.Marshal(b, m, deterministic)
+func (m *GetDashboardRevenueDynamicsReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDashboardRevenueDynamicsReportResponse) ProtoMessage()    {}
+func (*GetDashboardRevenueDynamicsReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{207}
 }
-func (dst *UserProfilePersonal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfilePersonal.Merge(dst, src)
+func (m *GetDashboardRevenueDynamicsReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Unmarshal(m, b)
 }
-func (m *UserProfilePersonal) XXX_Size() int {
-	return xxx_messageInfo_UserProfilePersonal.Size(m)
+func (m *GetDashboardRevenueDynamicsReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Marshal(b, m, deterministic)
 }
-func (m *UserProfilePersonal) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfilePersonal.DiscardUnknown(m)
+func (dst *GetDashboardRevenueDynamicsReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Merge(dst, src)
+}
+func (m *GetDashboardRevenueDynamicsReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Size(m)
+}
+func (m *GetDashboardRevenueDynamicsReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserProfilePersonal proto.InternalMessageInfo
+var xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse proto.InternalMessageInfo
 
-func (m *UserProfilePersonal) GetFirstName() string {
+func (m *GetDashboardRevenueDynamicsReportResponse) GetStatus() int32 {
 	if m != nil {
-		return m.FirstName
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *UserProfilePersonal) GetLastName() string {
+func (m *GetDashboardRevenueDynamicsReportResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.LastName
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *UserProfilePersonal) GetPosition() string {
+func (m *GetDashboardRevenueDynamicsReportResponse) GetItem() *DashboardRevenueDynamicReport {
 	if m != nil {
-		return m.Position
+		return m.Item
 	}
-	return ""
+	return nil
 }
 
-type UserProfileHelp struct {
-	// @inject_tag: json:"product_promotion_and_development" bson:"product_promotion_and_development"
-	ProductPromotionAndDevelopment bool `protobuf:"varint,1,opt,name=product_promotion_and_development,json=productPromotionAndDevelopment,proto3" json:"product_promotion_and_development" bson:"product_promotion_and_development"`
-	// @inject_tag: json:"released_game_promotion" bson:"released_game_promotion"
-	ReleasedGamePromotion bool `protobuf:"varint,2,opt,name=released_game_promotion,json=releasedGamePromotion,proto3" json:"released_game_promotion" bson:"released_game_promotion"`
-	// @inject_tag: json:"international_sales" bson:"international_sales"
-	InternationalSales bool `protobuf:"varint,3,opt,name=international_sales,json=internationalSales,proto3" json:"international_sales" bson:"international_sales"`
-	// @inject_tag: json:"other" bson:"other"
-	Other                bool     `protobuf:"varint,4,opt,name=other,proto3" json:"other" bson:"other"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardAmountItemWithChart struct {
+	// @inject_tag: json:"amount_current"
+	AmountCurrent float64 `protobuf:"fixed64,1,opt,name=amount_current,json=amountCurrent,proto3" json:"amount_current"`
+	// @inject_tag: json:"amount_previous"
+	AmountPrevious float64 `protobuf:"fixed64,2,opt,name=amount_previous,json=amountPrevious,proto3" json:"amount_previous"`
+	// @inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency"`
+	// @inject_tag: json:"chart"
+	Chart                []*DashboardChartItemFloat `protobuf:"bytes,4,rep,name=chart,proto3" json:"chart"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfileHelp) Reset()         { *m = UserProfileHelp{} }
-func (m *UserProfileHelp) String() string { return proto.CompactTextString(m) }
-func (*UserProfileHelp) ProtoMessage()    {}
-func (*UserProfileHelp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{145}
+func (m *DashboardAmountItemWithChart) Reset()         { *m = DashboardAmountItemWithChart{} }
+func (m *DashboardAmountItemWithChart) String() string { return proto.CompactTextString(m) }
+func (*DashboardAmountItemWithChart) ProtoMessage()    {}
+func (*DashboardAmountItemWithChart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{208}
 }
-func (m *UserProfileHelp) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfileHelp.Unmarshal(m, b)
+func (m *DashboardAmountItemWithChart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardAmountItemWithChart.Unmarshal(m, b)
 }
-func (m *UserProfileHelp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfileHelp.Marshal(b, m, deterministic)
+func (m *DashboardAmountItemWithChart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardAmountItemWithChart.Marshal(b, m, deterministic)
 }
-func (dst *UserProfileHelp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfileHelp.Merge(dst, src)
+func (dst *DashboardAmountItemWithChart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardAmountItemWithChart.Merge(dst, src)
 }
-func (m *UserProfileHelp) XXX_Size() int {
-	return xxx_messageInfo_UserProfileHelp.Size(m)
+func (m *DashboardAmountItemWithChart) XXX_Size() int {
+	return xxx_messageInfo_DashboardAmountItemWithChart.Size(m)
 }
-func (m *UserProfileHelp) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfileHelp.DiscardUnknown(m)
+func (m *DashboardAmountItemWithChart) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardAmountItemWithChart.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserProfileHelp proto.InternalMessageInfo
+var xxx_messageInfo_DashboardAmountItemWithChart proto.InternalMessageInfo
 
-func (m *UserProfileHelp) GetProductPromotionAndDevelopment() bool {
+func (m *DashboardAmountItemWithChart) GetAmountCurrent() float64 {
 	if m != nil {
-		return m.ProductPromotionAndDevelopment
+		return m.AmountCurrent
 	}
-	return false
+	return 0
 }
 
-func (m *UserProfileHelp) GetReleasedGamePromotion() bool {
+func (m *DashboardAmountItemWithChart) GetAmountPrevious() float64 {
 	if m != nil {
-		return m.ReleasedGamePromotion
+		return m.AmountPrevious
 	}
-	return false
+	return 0
 }
 
-func (m *UserProfileHelp) GetInternationalSales() bool {
+func (m *DashboardAmountItemWithChart) GetCurrency() string {
 	if m != nil {
-		return m.InternationalSales
+		return m.Currency
 	}
-	return false
+	return ""
 }
 
-func (m *UserProfileHelp) GetOther() bool {
+func (m *DashboardAmountItemWithChart) GetChart() []*DashboardChartItemFloat {
 	if m != nil {
-		return m.Other
+		return m.Chart
 	}
-	return false
+	return nil
 }
 
-type UserProfileCompanyMonetization struct {
-	// @inject_tag: json:"paid_subscription" bson:"paid_subscription"
-	PaidSubscription bool `protobuf:"varint,1,opt,name=paid_subscription,json=paidSubscription,proto3" json:"paid_subscription" bson:"paid_subscription"`
-	// @inject_tag: json:"in_game_advertising" bson:"in_game_advertising"
-	InGameAdvertising bool `protobuf:"varint,2,opt,name=in_game_advertising,json=inGameAdvertising,proto3" json:"in_game_advertising" bson:"in_game_advertising"`
-	// @inject_tag: json:"in_game_purchases" bson:"in_game_purchases"
-	InGamePurchases bool `protobuf:"varint,3,opt,name=in_game_purchases,json=inGamePurchases,proto3" json:"in_game_purchases" bson:"in_game_purchases"`
-	// @inject_tag: json:"premium_access" bson:"premium_access"
-	PremiumAccess bool `protobuf:"varint,4,opt,name=premium_access,json=premiumAccess,proto3" json:"premium_access" bson:"premium_access"`
-	// @inject_tag: json:"other" bson:"other"
-	Other                bool     `protobuf:"varint,5,opt,name=other,proto3" json:"other" bson:"other"`
+type DashboardChartItemFloat struct {
+	// @inject_tag: json:"label" bson:"label"
+	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label" bson:"label"`
+	// @inject_tag: json:"value" bson:"value"
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value" bson:"value"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfileCompanyMonetization) Reset()         { *m = UserProfileCompanyMonetization{} }
-func (m *UserProfileCompanyMonetization) String() string { return proto.CompactTextString(m) }
-func (*UserProfileCompanyMonetization) ProtoMessage()    {}
-func (*UserProfileCompanyMonetization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{146}
-}
-func (m *UserProfileCompanyMonetization) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfileCompanyMonetization.Unmarshal(m, b)
-}
-func (m *UserProfileCompanyMonetization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfileCompanyMonetization.Marshal(b, m, deterministic)
+func (m *DashboardChartItemFloat) Reset()         { *m = DashboardChartItemFloat{} }
+func (m *DashboardChartItemFloat) String() string { return proto.CompactTextString(m) }
+func (*DashboardChartItemFloat) ProtoMessage()    {}
+func (*DashboardChartItemFloat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{209}
 }
-func (dst *UserProfileCompanyMonetization) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfileCompanyMonetization.Merge(dst, src)
+func (m *DashboardChartItemFloat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardChartItemFloat.Unmarshal(m, b)
 }
-func (m *UserProfileCompanyMonetization) XXX_Size() int {
-	return xxx_messageInfo_UserProfileCompanyMonetization.Size(m)
+func (m *DashboardChartItemFloat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardChartItemFloat.Marshal(b, m, deterministic)
 }
-func (m *UserProfileCompanyMonetization) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfileCompanyMonetization.DiscardUnknown(m)
+func (dst *DashboardChartItemFloat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardChartItemFloat.Merge(dst, src)
 }
-
-var xxx_messageInfo_UserProfileCompanyMonetization proto.InternalMessageInfo
-
-func (m *UserProfileCompanyMonetization) GetPaidSubscription() bool {
-	if m != nil {
-		return m.PaidSubscription
-	}
-	return false
+func (m *DashboardChartItemFloat) XXX_Size() int {
+	return xxx_messageInfo_DashboardChartItemFloat.Size(m)
 }
-
-func (m *UserProfileCompanyMonetization) GetInGameAdvertising() bool {
-	if m != nil {
-		return m.InGameAdvertising
-	}
-	return false
+func (m *DashboardChartItemFloat) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardChartItemFloat.DiscardUnknown(m)
 }
 
-func (m *UserProfileCompanyMonetization) GetInGamePurchases() bool {
-	if m != nil {
-		return m.InGamePurchases
-	}
-	return false
-}
+var xxx_messageInfo_DashboardChartItemFloat proto.InternalMessageInfo
 
-func (m *UserProfileCompanyMonetization) GetPremiumAccess() bool {
+func (m *DashboardChartItemFloat) GetLabel() int64 {
 	if m != nil {
-		return m.PremiumAccess
+		return m.Label
 	}
-	return false
+	return 0
 }
 
-func (m *UserProfileCompanyMonetization) GetOther() bool {
+func (m *DashboardChartItemFloat) GetValue() float64 {
 	if m != nil {
-		return m.Other
+		return m.Value
 	}
-	return false
+	return 0
 }
 
-type UserProfileCompanyPlatforms struct {
-	// @inject_tag: json:"pc_mac" bson:"pc_mac"
-	PcMac bool `protobuf:"varint,1,opt,name=pc_mac,json=pcMac,proto3" json:"pc_mac" bson:"pc_mac"`
-	// @inject_tag: json:"game_console" bson:"game_console"
-	GameConsole bool `protobuf:"varint,2,opt,name=game_console,json=gameConsole,proto3" json:"game_console" bson:"game_console"`
-	// @inject_tag: json:"mobile_device" bson:"mobile_device"
-	MobileDevice bool `protobuf:"varint,3,opt,name=mobile_device,json=mobileDevice,proto3" json:"mobile_device" bson:"mobile_device"`
-	// @inject_tag: json:"web_browser" bson:"web_browser"
-	WebBrowser bool `protobuf:"varint,4,opt,name=web_browser,json=webBrowser,proto3" json:"web_browser" bson:"web_browser"`
-	// @inject_tag: json:"other" bson:"other"
-	Other                bool     `protobuf:"varint,5,opt,name=other,proto3" json:"other" bson:"other"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardMainReportTotalTransactions struct {
+	// @inject_tag: json:"count_current" bson:"count"
+	CountCurrent int64 `protobuf:"varint,1,opt,name=count_current,json=countCurrent,proto3" json:"count_current" bson:"count"`
+	// @inject_tag: json:"count_previous"
+	CountPrevious int64 `protobuf:"varint,2,opt,name=count_previous,json=countPrevious,proto3" json:"count_previous"`
+	// @inject_tag: json:"chart"
+	Chart                []*DashboardChartItemFloat `protobuf:"bytes,3,rep,name=chart,proto3" json:"chart"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfileCompanyPlatforms) Reset()         { *m = UserProfileCompanyPlatforms{} }
-func (m *UserProfileCompanyPlatforms) String() string { return proto.CompactTextString(m) }
-func (*UserProfileCompanyPlatforms) ProtoMessage()    {}
-func (*UserProfileCompanyPlatforms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{147}
-}
-func (m *UserProfileCompanyPlatforms) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfileCompanyPlatforms.Unmarshal(m, b)
+func (m *DashboardMainReportTotalTransactions) Reset()         { *m = DashboardMainReportTotalTransactions{} }
+func (m *DashboardMainReportTotalTransactions) String() string { return proto.CompactTextString(m) }
+func (*DashboardMainReportTotalTransactions) ProtoMessage()    {}
+func (*DashboardMainReportTotalTransactions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{210}
 }
-func (m *UserProfileCompanyPlatforms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfileCompanyPlatforms.Marshal(b, m, deterministic)
+func (m *DashboardMainReportTotalTransactions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardMainReportTotalTransactions.Unmarshal(m, b)
 }
-func (dst *UserProfileCompanyPlatforms) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfileCompanyPlatforms.Merge(dst, src)
+func (m *DashboardMainReportTotalTransactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardMainReportTotalTransactions.Marshal(b, m, deterministic)
 }
-func (m *UserProfileCompanyPlatforms) XXX_Size() int {
-	return xxx_messageInfo_UserProfileCompanyPlatforms.Size(m)
+func (dst *DashboardMainReportTotalTransactions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardMainReportTotalTransactions.Merge(dst, src)
 }
-func (m *UserProfileCompanyPlatforms) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfileCompanyPlatforms.DiscardUnknown(m)
+func (m *DashboardMainReportTotalTransactions) XXX_Size() int {
+	return xxx_messageInfo_DashboardMainReportTotalTransactions.Size(m)
 }
-
-var xxx_messageInfo_UserProfileCompanyPlatforms proto.InternalMessageInfo
-
-func (m *UserProfileCompanyPlatforms) GetPcMac() bool {
-	if m != nil {
-		return m.PcMac
-	}
-	return false
+func (m *DashboardMainReportTotalTransactions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardMainReportTotalTransactions.DiscardUnknown(m)
 }
 
-func (m *UserProfileCompanyPlatforms) GetGameConsole() bool {
-	if m != nil {
-		return m.GameConsole
-	}
-	return false
-}
+var xxx_messageInfo_DashboardMainReportTotalTransactions proto.InternalMessageInfo
 
-func (m *UserProfileCompanyPlatforms) GetMobileDevice() bool {
+func (m *DashboardMainReportTotalTransactions) GetCountCurrent() int64 {
 	if m != nil {
-		return m.MobileDevice
+		return m.CountCurrent
 	}
-	return false
+	return 0
 }
 
-func (m *UserProfileCompanyPlatforms) GetWebBrowser() bool {
+func (m *DashboardMainReportTotalTransactions) GetCountPrevious() int64 {
 	if m != nil {
-		return m.WebBrowser
+		return m.CountPrevious
 	}
-	return false
+	return 0
 }
 
-func (m *UserProfileCompanyPlatforms) GetOther() bool {
+func (m *DashboardMainReportTotalTransactions) GetChart() []*DashboardChartItemFloat {
 	if m != nil {
-		return m.Other
+		return m.Chart
 	}
-	return false
+	return nil
 }
 
-type UserProfileCompany struct {
-	// @inject_tag: json:"company_name" bson:"company_name" validate:"required,company_name,max=60"
-	CompanyName string `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name" bson:"company_name" validate:"required,company_name,max=60"`
-	// @inject_tag: json:"website" validate:"required,url" bson:"website"
-	Website string `protobuf:"bytes,2,opt,name=website,proto3" json:"website" validate:"required,url" bson:"website"`
-	// @inject_tag: json:"annual_income" bson:"annual_income" validate:"required"
-	AnnualIncome *billing.RangeInt `protobuf:"bytes,3,opt,name=annual_income,json=annualIncome,proto3" json:"annual_income" bson:"annual_income" validate:"required"`
-	// @inject_tag: json:"number_of_employees" bson:"number_of_employees" validate:"required"
-	NumberOfEmployees *billing.RangeInt `protobuf:"bytes,4,opt,name=number_of_employees,json=numberOfEmployees,proto3" json:"number_of_employees" bson:"number_of_employees" validate:"required"`
-	// @inject_tag: json:"kind_of_activity" validate:"omitempty,oneof=develop_and_publish_your_games publish_games_of_other_companies publish_your_games_through_other_publishers other" bson:"kind_of_activity"
-	KindOfActivity string `protobuf:"bytes,5,opt,name=kind_of_activity,json=kindOfActivity,proto3" json:"kind_of_activity" validate:"omitempty,oneof=develop_and_publish_your_games publish_games_of_other_companies publish_your_games_through_other_publishers other" bson:"kind_of_activity"`
-	// @inject_tag: json:"monetization" bson:"monetization"
-	Monetization *UserProfileCompanyMonetization `protobuf:"bytes,6,opt,name=monetization,proto3" json:"monetization" bson:"monetization"`
-	// @inject_tag: json:"platforms" bson:"platforms"
-	Platforms            *UserProfileCompanyPlatforms `protobuf:"bytes,7,opt,name=platforms,proto3" json:"platforms" bson:"platforms"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                        `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardMainReport struct {
+	// @inject_tag: json:"gross_revenue" bson:"gross_revenue"
+	GrossRevenue *DashboardAmountItemWithChart `protobuf:"bytes,1,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue"`
+	// @inject_tag: json:"vat" bson:"vat"
+	Vat *DashboardAmountItemWithChart `protobuf:"bytes,2,opt,name=vat,proto3" json:"vat" bson:"vat"`
+	// @inject_tag: json:"total_transactions" bson:"total_transactions"
+	TotalTransactions *DashboardMainReportTotalTransactions `protobuf:"bytes,3,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions" bson:"total_transactions"`
+	// @inject_tag: json:"arpu" bson:"arpu"
+	Arpu                 *DashboardAmountItemWithChart `protobuf:"bytes,4,opt,name=arpu,proto3" json:"arpu" bson:"arpu"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfileCompany) Reset()         { *m = UserProfileCompany{} }
-func (m *UserProfileCompany) String() string { return proto.CompactTextString(m) }
-func (*UserProfileCompany) ProtoMessage()    {}
-func (*UserProfileCompany) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{148}
+func (m *DashboardMainReport) Reset()         { *m = DashboardMainReport{} }
+func (m *DashboardMainReport) String() string { return proto.CompactTextString(m) }
+func (*DashboardMainReport) ProtoMessage()    {}
+func (*DashboardMainReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{211}
 }
-func (m *UserProfileCompany) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfileCompany.Unmarshal(m, b)
+func (m *DashboardMainReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardMainReport.Unmarshal(m, b)
 }
-func (m *UserProfileCompany) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfileCompany.Marshal(b, m, deterministic)
+func (m *DashboardMainReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardMainReport.Marshal(b, m, deterministic)
 }
-func (dst *UserProfileCompany) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfileCompany.Merge(dst, src)
+func (dst *DashboardMainReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardMainReport.Merge(dst, src)
 }
-func (m *UserProfileCompany) XXX_Size() int {
-	return xxx_messageInfo_UserProfileCompany.Size(m)
+func (m *DashboardMainReport) XXX_Size() int {
+	return xxx_messageInfo_DashboardMainReport.Size(m)
 }
-func (m *UserProfileCompany) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfileCompany.DiscardUnknown(m)
+func (m *DashboardMainReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardMainReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserProfileCompany proto.InternalMessageInfo
+var xxx_messageInfo_DashboardMainReport proto.InternalMessageInfo
 
-func (m *UserProfileCompany) GetCompanyName() string {
+func (m *DashboardMainReport) GetGrossRevenue() *DashboardAmountItemWithChart {
 	if m != nil {
-		return m.CompanyName
+		return m.GrossRevenue
 	}
-	return ""
+	return nil
 }
 
-func (m *UserProfileCompany) GetWebsite() string {
+func (m *DashboardMainReport) GetVat() *DashboardAmountItemWithChart {
 	if m != nil {
-		return m.Website
+		return m.Vat
 	}
-	return ""
+	return nil
 }
 
-func (m *UserProfileCompany) GetAnnualIncome() *billing.RangeInt {
+func (m *DashboardMainReport) GetTotalTransactions() *DashboardMainReportTotalTransactions {
 	if m != nil {
-		return m.AnnualIncome
+		return m.TotalTransactions
 	}
 	return nil
 }
 
-func (m *UserProfileCompany) GetNumberOfEmployees() *billing.RangeInt {
+func (m *DashboardMainReport) GetArpu() *DashboardAmountItemWithChart {
 	if m != nil {
-		return m.NumberOfEmployees
+		return m.Arpu
 	}
 	return nil
 }
 
-func (m *UserProfileCompany) GetKindOfActivity() string {
-	if m != nil {
-		return m.KindOfActivity
-	}
-	return ""
+type DashboardRevenueDynamicReport struct {
+	// @inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
+	// @inject_tag: json:"items"
+	Items                []*DashboardRevenueDynamicReportItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfileCompany) GetMonetization() *UserProfileCompanyMonetization {
+func (m *DashboardRevenueDynamicReport) Reset()         { *m = DashboardRevenueDynamicReport{} }
+func (m *DashboardRevenueDynamicReport) String() string { return proto.CompactTextString(m) }
+func (*DashboardRevenueDynamicReport) ProtoMessage()    {}
+func (*DashboardRevenueDynamicReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{212}
+}
+func (m *DashboardRevenueDynamicReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardRevenueDynamicReport.Unmarshal(m, b)
+}
+func (m *DashboardRevenueDynamicReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardRevenueDynamicReport.Marshal(b, m, deterministic)
+}
+func (dst *DashboardRevenueDynamicReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardRevenueDynamicReport.Merge(dst, src)
+}
+func (m *DashboardRevenueDynamicReport) XXX_Size() int {
+	return xxx_messageInfo_DashboardRevenueDynamicReport.Size(m)
+}
+func (m *DashboardRevenueDynamicReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardRevenueDynamicReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardRevenueDynamicReport proto.InternalMessageInfo
+
+func (m *DashboardRevenueDynamicReport) GetCurrency() string {
 	if m != nil {
-		return m.Monetization
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-func (m *UserProfileCompany) GetPlatforms() *UserProfileCompanyPlatforms {
+func (m *DashboardRevenueDynamicReport) GetItems() []*DashboardRevenueDynamicReportItem {
 	if m != nil {
-		return m.Platforms
+		return m.Items
 	}
 	return nil
 }
 
-type UserProfileEmail struct {
-	// @inject_tag: json:"email" validate:"required,email"
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email" validate:"required,email"`
-	// @inject_tag: json:"confirmed"
-	Confirmed bool `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed"`
-	// @inject_tag: json:"confirmed_at"
-	ConfirmedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at"`
-	// @inject_tag: json:"-" bson:"-"
-	ConfirmationUrl string `protobuf:"bytes,4,opt,name=confirmation_url,json=confirmationUrl,proto3" json:"-" bson:"-"`
-	// @inject_tag: json:"-" bson:"is_confirmation_email_sent"
-	IsConfirmationEmailSent bool     `protobuf:"varint,5,opt,name=is_confirmation_email_sent,json=isConfirmationEmailSent,proto3" json:"-" bson:"is_confirmation_email_sent"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized        []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache           int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardRevenueDynamicReportItem struct {
+	// @inject_tag: json:"label"
+	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label"`
+	// @inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	// @inject_tag: json:"-"
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"-"`
+	// @inject_tag: json:"count"
+	Count                int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfileEmail) Reset()         { *m = UserProfileEmail{} }
-func (m *UserProfileEmail) String() string { return proto.CompactTextString(m) }
-func (*UserProfileEmail) ProtoMessage()    {}
-func (*UserProfileEmail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{149}
+func (m *DashboardRevenueDynamicReportItem) Reset()         { *m = DashboardRevenueDynamicReportItem{} }
+func (m *DashboardRevenueDynamicReportItem) String() string { return proto.CompactTextString(m) }
+func (*DashboardRevenueDynamicReportItem) ProtoMessage()    {}
+func (*DashboardRevenueDynamicReportItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{213}
 }
-func (m *UserProfileEmail) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfileEmail.Unmarshal(m, b)
+func (m *DashboardRevenueDynamicReportItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardRevenueDynamicReportItem.Unmarshal(m, b)
 }
-func (m *UserProfileEmail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfileEmail.Marshal(b, m, deterministic)
+func (m *DashboardRevenueDynamicReportItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardRevenueDynamicReportItem.Marshal(b, m, deterministic)
 }
-func (dst *UserProfileEmail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfileEmail.Merge(dst, src)
+func (dst *DashboardRevenueDynamicReportItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardRevenueDynamicReportItem.Merge(dst, src)
 }
-func (m *UserProfileEmail) XXX_Size() int {
-	return xxx_messageInfo_UserProfileEmail.Size(m)
+func (m *DashboardRevenueDynamicReportItem) XXX_Size() int {
+	return xxx_messageInfo_DashboardRevenueDynamicReportItem.Size(m)
 }
-func (m *UserProfileEmail) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfileEmail.DiscardUnknown(m)
+func (m *DashboardRevenueDynamicReportItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardRevenueDynamicReportItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserProfileEmail proto.InternalMessageInfo
+var xxx_messageInfo_DashboardRevenueDynamicReportItem proto.InternalMessageInfo
 
-func (m *UserProfileEmail) GetEmail() string {
+func (m *DashboardRevenueDynamicReportItem) GetLabel() int64 {
 	if m != nil {
-		return m.Email
+		return m.Label
 	}
-	return ""
+	return 0
 }
 
-func (m *UserProfileEmail) GetConfirmed() bool {
+func (m *DashboardRevenueDynamicReportItem) GetAmount() float64 {
 	if m != nil {
-		return m.Confirmed
+		return m.Amount
 	}
-	return false
+	return 0
 }
 
-func (m *UserProfileEmail) GetConfirmedAt() *timestamp.Timestamp {
+func (m *DashboardRevenueDynamicReportItem) GetCurrency() string {
 	if m != nil {
-		return m.ConfirmedAt
+		return m.Currency
 	}
-	return nil
+	return ""
+}
+
+func (m *DashboardRevenueDynamicReportItem) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DashboardRevenueByCountryReportTop struct {
+	// @inject_tag: json:"country"
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country"`
+	// @inject_tag: json:"amount"
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *DashboardRevenueByCountryReportTop) Reset()         { *m = DashboardRevenueByCountryReportTop{} }
+func (m *DashboardRevenueByCountryReportTop) String() string { return proto.CompactTextString(m) }
+func (*DashboardRevenueByCountryReportTop) ProtoMessage()    {}
+func (*DashboardRevenueByCountryReportTop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{214}
+}
+func (m *DashboardRevenueByCountryReportTop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardRevenueByCountryReportTop.Unmarshal(m, b)
+}
+func (m *DashboardRevenueByCountryReportTop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardRevenueByCountryReportTop.Marshal(b, m, deterministic)
+}
+func (dst *DashboardRevenueByCountryReportTop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardRevenueByCountryReportTop.Merge(dst, src)
+}
+func (m *DashboardRevenueByCountryReportTop) XXX_Size() int {
+	return xxx_messageInfo_DashboardRevenueByCountryReportTop.Size(m)
+}
+func (m *DashboardRevenueByCountryReportTop) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardRevenueByCountryReportTop.DiscardUnknown(m)
 }
 
-func (m *UserProfileEmail) GetConfirmationUrl() string {
+var xxx_messageInfo_DashboardRevenueByCountryReportTop proto.InternalMessageInfo
+
+func (m *DashboardRevenueByCountryReportTop) GetCountry() string {
 	if m != nil {
-		return m.ConfirmationUrl
+		return m.Country
 	}
 	return ""
 }
 
-func (m *UserProfileEmail) GetIsConfirmationEmailSent() bool {
+func (m *DashboardRevenueByCountryReportTop) GetAmount() float64 {
 	if m != nil {
-		return m.IsConfirmationEmailSent
+		return m.Amount
 	}
-	return false
+	return 0
 }
 
-type UserProfile struct {
-	// @inject_tag: json:"id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	// @inject_tag: json:"-" validate:"required,hexadecimal,len=24"
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"-" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"email"
-	Email *UserProfileEmail `protobuf:"bytes,3,opt,name=email,proto3" json:"email"`
-	// @inject_tag: json:"personal"
-	Personal *UserProfilePersonal `protobuf:"bytes,4,opt,name=personal,proto3" json:"personal"`
-	// @inject_tag: json:"help"
-	Help *UserProfileHelp `protobuf:"bytes,5,opt,name=help,proto3" json:"help"`
-	// @inject_tag: json:"company"
-	Company *UserProfileCompany `protobuf:"bytes,6,opt,name=company,proto3" json:"company"`
-	// @inject_tag: json:"last_step" bson:"last_step"
-	LastStep string `protobuf:"bytes,7,opt,name=last_step,json=lastStep,proto3" json:"last_step" bson:"last_step"`
-	// @inject_tag: json:"centrifugo_token" bson:"centrifugo_token"
-	CentrifugoToken string `protobuf:"bytes,8,opt,name=centrifugo_token,json=centrifugoToken,proto3" json:"centrifugo_token" bson:"centrifugo_token"`
-	// @inject_tag: json:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	// @inject_tag: json:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardRevenueByCountryReportChartItem struct {
+	// @inject_tag: json:"label"
+	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label"`
+	// @inject_tag: json:"amount"
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfile) Reset()         { *m = UserProfile{} }
-func (m *UserProfile) String() string { return proto.CompactTextString(m) }
-func (*UserProfile) ProtoMessage()    {}
-func (*UserProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{150}
+func (m *DashboardRevenueByCountryReportChartItem) Reset() {
+	*m = DashboardRevenueByCountryReportChartItem{}
 }
-func (m *UserProfile) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserProfile.Unmarshal(m, b)
+func (m *DashboardRevenueByCountryReportChartItem) String() string { return proto.CompactTextString(m) }
+func (*DashboardRevenueByCountryReportChartItem) ProtoMessage()    {}
+func (*DashboardRevenueByCountryReportChartItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{215}
 }
-func (m *UserProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserProfile.Marshal(b, m, deterministic)
+func (m *DashboardRevenueByCountryReportChartItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Unmarshal(m, b)
 }
-func (dst *UserProfile) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserProfile.Merge(dst, src)
+func (m *DashboardRevenueByCountryReportChartItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Marshal(b, m, deterministic)
 }
-func (m *UserProfile) XXX_Size() int {
-	return xxx_messageInfo_UserProfile.Size(m)
+func (dst *DashboardRevenueByCountryReportChartItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Merge(dst, src)
 }
-func (m *UserProfile) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserProfile.DiscardUnknown(m)
+func (m *DashboardRevenueByCountryReportChartItem) XXX_Size() int {
+	return xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Size(m)
+}
+func (m *DashboardRevenueByCountryReportChartItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardRevenueByCountryReportChartItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserProfile proto.InternalMessageInfo
+var xxx_messageInfo_DashboardRevenueByCountryReportChartItem proto.InternalMessageInfo
 
-func (m *UserProfile) GetId() string {
+func (m *DashboardRevenueByCountryReportChartItem) GetLabel() int64 {
 	if m != nil {
-		return m.Id
+		return m.Label
 	}
-	return ""
+	return 0
 }
 
-func (m *UserProfile) GetUserId() string {
+func (m *DashboardRevenueByCountryReportChartItem) GetAmount() float64 {
 	if m != nil {
-		return m.UserId
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *UserProfile) GetEmail() *UserProfileEmail {
-	if m != nil {
-		return m.Email
-	}
-	return nil
+type DashboardRevenueByCountryReport struct {
+	// @inject_tag: json:"currency"
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
+	// @inject_tag: json:"top" bson:"top"
+	Top []*DashboardRevenueByCountryReportTop `protobuf:"bytes,2,rep,name=top,proto3" json:"top" bson:"top"`
+	// @inject_tag: json:"total_current" bson:"total"
+	TotalCurrent float64 `protobuf:"fixed64,3,opt,name=total_current,json=totalCurrent,proto3" json:"total_current" bson:"total"`
+	// @inject_tag: json:"total_previous"
+	TotalPrevious float64 `protobuf:"fixed64,4,opt,name=total_previous,json=totalPrevious,proto3" json:"total_previous"`
+	// @inject_tag: json:"chart" bson:"chart"
+	Chart                []*DashboardRevenueByCountryReportChartItem `protobuf:"bytes,5,rep,name=chart,proto3" json:"chart" bson:"chart"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                       `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UserProfile) GetPersonal() *UserProfilePersonal {
-	if m != nil {
-		return m.Personal
-	}
-	return nil
+func (m *DashboardRevenueByCountryReport) Reset()         { *m = DashboardRevenueByCountryReport{} }
+func (m *DashboardRevenueByCountryReport) String() string { return proto.CompactTextString(m) }
+func (*DashboardRevenueByCountryReport) ProtoMessage()    {}
+func (*DashboardRevenueByCountryReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{216}
 }
-
-func (m *UserProfile) GetHelp() *UserProfileHelp {
-	if m != nil {
-		return m.Help
-	}
-	return nil
+func (m *DashboardRevenueByCountryReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardRevenueByCountryReport.Unmarshal(m, b)
+}
+func (m *DashboardRevenueByCountryReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardRevenueByCountryReport.Marshal(b, m, deterministic)
+}
+func (dst *DashboardRevenueByCountryReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardRevenueByCountryReport.Merge(dst, src)
+}
+func (m *DashboardRevenueByCountryReport) XXX_Size() int {
+	return xxx_messageInfo_DashboardRevenueByCountryReport.Size(m)
+}
+func (m *DashboardRevenueByCountryReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardRevenueByCountryReport.DiscardUnknown(m)
 }
 
-func (m *UserProfile) GetCompany() *UserProfileCompany {
+var xxx_messageInfo_DashboardRevenueByCountryReport proto.InternalMessageInfo
+
+func (m *DashboardRevenueByCountryReport) GetCurrency() string {
 	if m != nil {
-		return m.Company
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-func (m *UserProfile) GetLastStep() string {
+func (m *DashboardRevenueByCountryReport) GetTop() []*DashboardRevenueByCountryReportTop {
 	if m != nil {
-		return m.LastStep
+		return m.Top
 	}
-	return ""
+	return nil
 }
 
-func (m *UserProfile) GetCentrifugoToken() string {
+func (m *DashboardRevenueByCountryReport) GetTotalCurrent() float64 {
 	if m != nil {
-		return m.CentrifugoToken
+		return m.TotalCurrent
 	}
-	return ""
+	return 0
 }
 
-func (m *UserProfile) GetCreatedAt() *timestamp.Timestamp {
+func (m *DashboardRevenueByCountryReport) GetTotalPrevious() float64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.TotalPrevious
 	}
-	return nil
+	return 0
 }
 
-func (m *UserProfile) GetUpdatedAt() *timestamp.Timestamp {
+func (m *DashboardRevenueByCountryReport) GetChart() []*DashboardRevenueByCountryReportChartItem {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Chart
 	}
 	return nil
 }
 
-type GetUserProfileRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
-	ProfileId            string   `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+type DashboardSalesTodayReportTop struct {
+	// @inject_tag: json:"name" bson:"name"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bson:"name"`
+	// @inject_tag: json:"count" bson:"count"
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count" bson:"count"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetUserProfileRequest) Reset()         { *m = GetUserProfileRequest{} }
-func (m *GetUserProfileRequest) String() string { return proto.CompactTextString(m) }
-func (*GetUserProfileRequest) ProtoMessage()    {}
-func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{151}
+func (m *DashboardSalesTodayReportTop) Reset()         { *m = DashboardSalesTodayReportTop{} }
+func (m *DashboardSalesTodayReportTop) String() string { return proto.CompactTextString(m) }
+func (*DashboardSalesTodayReportTop) ProtoMessage()    {}
+func (*DashboardSalesTodayReportTop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{217}
 }
-func (m *GetUserProfileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserProfileRequest.Unmarshal(m, b)
+func (m *DashboardSalesTodayReportTop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardSalesTodayReportTop.Unmarshal(m, b)
 }
-func (m *GetUserProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserProfileRequest.Marshal(b, m, deterministic)
+func (m *DashboardSalesTodayReportTop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardSalesTodayReportTop.Marshal(b, m, deterministic)
 }
-func (dst *GetUserProfileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserProfileRequest.Merge(dst, src)
+func (dst *DashboardSalesTodayReportTop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardSalesTodayReportTop.Merge(dst, src)
 }
-func (m *GetUserProfileRequest) XXX_Size() int {
-	return xxx_messageInfo_GetUserProfileRequest.Size(m)
+func (m *DashboardSalesTodayReportTop) XXX_Size() int {
+	return xxx_messageInfo_DashboardSalesTodayReportTop.Size(m)
 }
-func (m *GetUserProfileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserProfileRequest.DiscardUnknown(m)
+func (m *DashboardSalesTodayReportTop) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardSalesTodayReportTop.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserProfileRequest proto.InternalMessageInfo
+var xxx_messageInfo_DashboardSalesTodayReportTop proto.InternalMessageInfo
 
-func (m *GetUserProfileRequest) GetUserId() string {
+func (m *DashboardSalesTodayReportTop) GetName() string {
 	if m != nil {
-		return m.UserId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *GetUserProfileRequest) GetProfileId() string {
+func (m *DashboardSalesTodayReportTop) GetCount() int64 {
 	if m != nil {
-		return m.ProfileId
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-type GetUserProfileResponse struct {
-	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *UserProfile          `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardChartItemInt struct {
+	// @inject_tag: json:"label" bson:"label"
+	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label" bson:"label"`
+	// @inject_tag: json:"value" bson:"value"
+	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value" bson:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetUserProfileResponse) Reset()         { *m = GetUserProfileResponse{} }
-func (m *GetUserProfileResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUserProfileResponse) ProtoMessage()    {}
-func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{152}
+func (m *DashboardChartItemInt) Reset()         { *m = DashboardChartItemInt{} }
+func (m *DashboardChartItemInt) String() string { return proto.CompactTextString(m) }
+func (*DashboardChartItemInt) ProtoMessage()    {}
+func (*DashboardChartItemInt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{218}
 }
-func (m *GetUserProfileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserProfileResponse.Unmarshal(m, b)
+func (m *DashboardChartItemInt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardChartItemInt.Unmarshal(m, b)
 }
-func (m *GetUserProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserProfileResponse.Marshal(b, m, deterministic)
+func (m *DashboardChartItemInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardChartItemInt.Marshal(b, m, deterministic)
 }
-func (dst *GetUserProfileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserProfileResponse.Merge(dst, src)
+func (dst *DashboardChartItemInt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardChartItemInt.Merge(dst, src)
 }
-func (m *GetUserProfileResponse) XXX_Size() int {
-	return xxx_messageInfo_GetUserProfileResponse.Size(m)
+func (m *DashboardChartItemInt) XXX_Size() int {
+	return xxx_messageInfo_DashboardChartItemInt.Size(m)
 }
-func (m *GetUserProfileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserProfileResponse.DiscardUnknown(m)
+func (m *DashboardChartItemInt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardChartItemInt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserProfileResponse proto.InternalMessageInfo
+var xxx_messageInfo_DashboardChartItemInt proto.InternalMessageInfo
 
-func (m *GetUserProfileResponse) GetStatus() int32 {
+func (m *DashboardChartItemInt) GetLabel() int64 {
 	if m != nil {
-		return m.Status
+		return m.Label
 	}
 	return 0
 }
 
-func (m *GetUserProfileResponse) GetMessage() *ResponseErrorMessage {
-	if m != nil {
-		return m.Message
-	}
-	return nil
-}
-
-func (m *GetUserProfileResponse) GetItem() *UserProfile {
+func (m *DashboardChartItemInt) GetValue() int64 {
 	if m != nil {
-		return m.Item
+		return m.Value
 	}
-	return nil
+	return 0
 }
 
-type PageReview struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// @inject_tag: json:"user_id" bson:"user_id"
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" bson:"user_id"`
-	Review string `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
-	Url    string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	IsRead bool   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	// @inject_tag: json:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	// @inject_tag: json:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardSalesTodayReport struct {
+	// @inject_tag: json:"top" bson:"top"
+	Top []*DashboardSalesTodayReportTop `protobuf:"bytes,1,rep,name=top,proto3" json:"top" bson:"top"`
+	// @inject_tag: json:"total_current" bson:"total"
+	TotalCurrent int64 `protobuf:"varint,2,opt,name=total_current,json=totalCurrent,proto3" json:"total_current" bson:"total"`
+	// @inject_tag: json:"total_previous"
+	TotalPrevious int64 `protobuf:"varint,3,opt,name=total_previous,json=totalPrevious,proto3" json:"total_previous"`
+	// @inject_tag: json:"chart" bson:"chart"
+	Chart                []*DashboardChartItemInt `protobuf:"bytes,4,rep,name=chart,proto3" json:"chart" bson:"chart"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PageReview) Reset()         { *m = PageReview{} }
-func (m *PageReview) String() string { return proto.CompactTextString(m) }
-func (*PageReview) ProtoMessage()    {}
-func (*PageReview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{153}
-}
-func (m *PageReview) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PageReview.Unmarshal(m, b)
-}
-func (m *PageReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PageReview.Marshal(b, m, deterministic)
+func (m *DashboardSalesTodayReport) Reset()         { *m = DashboardSalesTodayReport{} }
+func (m *DashboardSalesTodayReport) String() string { return proto.CompactTextString(m) }
+func (*DashboardSalesTodayReport) ProtoMessage()    {}
+func (*DashboardSalesTodayReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{219}
 }
-func (dst *PageReview) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PageReview.Merge(dst, src)
+func (m *DashboardSalesTodayReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardSalesTodayReport.Unmarshal(m, b)
 }
-func (m *PageReview) XXX_Size() int {
-	return xxx_messageInfo_PageReview.Size(m)
+func (m *DashboardSalesTodayReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardSalesTodayReport.Marshal(b, m, deterministic)
 }
-func (m *PageReview) XXX_DiscardUnknown() {
-	xxx_messageInfo_PageReview.DiscardUnknown(m)
+func (dst *DashboardSalesTodayReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardSalesTodayReport.Merge(dst, src)
 }
-
-var xxx_messageInfo_PageReview proto.InternalMessageInfo
-
-func (m *PageReview) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *DashboardSalesTodayReport) XXX_Size() int {
+	return xxx_messageInfo_DashboardSalesTodayReport.Size(m)
 }
-
-func (m *PageReview) GetUserId() string {
-	if m != nil {
-		return m.UserId
-	}
-	return ""
+func (m *DashboardSalesTodayReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardSalesTodayReport.DiscardUnknown(m)
 }
 
-func (m *PageReview) GetReview() string {
-	if m != nil {
-		return m.Review
-	}
-	return ""
-}
+var xxx_messageInfo_DashboardSalesTodayReport proto.InternalMessageInfo
 
-func (m *PageReview) GetUrl() string {
+func (m *DashboardSalesTodayReport) GetTop() []*DashboardSalesTodayReportTop {
 	if m != nil {
-		return m.Url
+		return m.Top
 	}
-	return ""
+	return nil
 }
 
-func (m *PageReview) GetIsRead() bool {
+func (m *DashboardSalesTodayReport) GetTotalCurrent() int64 {
 	if m != nil {
-		return m.IsRead
+		return m.TotalCurrent
 	}
-	return false
+	return 0
 }
 
-func (m *PageReview) GetCreatedAt() *timestamp.Timestamp {
+func (m *DashboardSalesTodayReport) GetTotalPrevious() int64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.TotalPrevious
 	}
-	return nil
+	return 0
 }
 
-func (m *PageReview) GetUpdatedAt() *timestamp.Timestamp {
+func (m *DashboardSalesTodayReport) GetChart() []*DashboardChartItemInt {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Chart
 	}
 	return nil
 }
 
-type CreatePageReviewRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required,max=500"
-	Review string `protobuf:"bytes,2,opt,name=review,proto3" json:"review,omitempty" validate:"required,max=500"`
-	// @inject_tag: validate:"required"
-	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty" validate:"required"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type DashboardSourcesReport struct {
+	// @inject_tag: json:"top" bson:"top"
+	Top []*DashboardSalesTodayReportTop `protobuf:"bytes,1,rep,name=top,proto3" json:"top" bson:"top"`
+	// @inject_tag: json:"total_current" bson:"total"
+	TotalCurrent int64 `protobuf:"varint,2,opt,name=total_current,json=totalCurrent,proto3" json:"total_current" bson:"total"`
+	// @inject_tag: json:"total_previous"
+	TotalPrevious int64 `protobuf:"varint,3,opt,name=total_previous,json=totalPrevious,proto3" json:"total_previous"`
+	// @inject_tag: json:"chart" bson:"chart"
+	Chart                []*DashboardChartItemInt `protobuf:"bytes,4,rep,name=chart,proto3" json:"chart" bson:"chart"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *CreatePageReviewRequest) Reset()         { *m = CreatePageReviewRequest{} }
-func (m *CreatePageReviewRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePageReviewRequest) ProtoMessage()    {}
-func (*CreatePageReviewRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{154}
+func (m *DashboardSourcesReport) Reset()         { *m = DashboardSourcesReport{} }
+func (m *DashboardSourcesReport) String() string { return proto.CompactTextString(m) }
+func (*DashboardSourcesReport) ProtoMessage()    {}
+func (*DashboardSourcesReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{220}
 }
-func (m *CreatePageReviewRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePageReviewRequest.Unmarshal(m, b)
+func (m *DashboardSourcesReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardSourcesReport.Unmarshal(m, b)
 }
-func (m *CreatePageReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePageReviewRequest.Marshal(b, m, deterministic)
+func (m *DashboardSourcesReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardSourcesReport.Marshal(b, m, deterministic)
 }
-func (dst *CreatePageReviewRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePageReviewRequest.Merge(dst, src)
+func (dst *DashboardSourcesReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardSourcesReport.Merge(dst, src)
 }
-func (m *CreatePageReviewRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePageReviewRequest.Size(m)
+func (m *DashboardSourcesReport) XXX_Size() int {
+	return xxx_messageInfo_DashboardSourcesReport.Size(m)
 }
-func (m *CreatePageReviewRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePageReviewRequest.DiscardUnknown(m)
+func (m *DashboardSourcesReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardSourcesReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePageReviewRequest proto.InternalMessageInfo
+var xxx_messageInfo_DashboardSourcesReport proto.InternalMessageInfo
 
-func (m *CreatePageReviewRequest) GetUserId() string {
+func (m *DashboardSourcesReport) GetTop() []*DashboardSalesTodayReportTop {
 	if m != nil {
-		return m.UserId
+		return m.Top
 	}
-	return ""
+	return nil
 }
 
-func (m *CreatePageReviewRequest) GetReview() string {
+func (m *DashboardSourcesReport) GetTotalCurrent() int64 {
 	if m != nil {
-		return m.Review
+		return m.TotalCurrent
 	}
-	return ""
+	return 0
 }
 
-func (m *CreatePageReviewRequest) GetUrl() string {
+func (m *DashboardSourcesReport) GetTotalPrevious() int64 {
 	if m != nil {
-		return m.Url
+		return m.TotalPrevious
 	}
-	return ""
+	return 0
 }
 
-type ConfirmUserEmailRequest struct {
-	// @inject_tag: validate:"required"
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty" validate:"required"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *DashboardSourcesReport) GetChart() []*DashboardChartItemInt {
+	if m != nil {
+		return m.Chart
+	}
+	return nil
 }
 
-func (m *ConfirmUserEmailRequest) Reset()         { *m = ConfirmUserEmailRequest{} }
-func (m *ConfirmUserEmailRequest) String() string { return proto.CompactTextString(m) }
-func (*ConfirmUserEmailRequest) ProtoMessage()    {}
-func (*ConfirmUserEmailRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{155}
+type DashboardBaseReports struct {
+	// @inject_tag: json:"revenue_by_country"
+	RevenueByCountry *DashboardRevenueByCountryReport `protobuf:"bytes,1,opt,name=revenue_by_country,json=revenueByCountry,proto3" json:"revenue_by_country"`
+	// @inject_tag: json:"sales_today"
+	SalesToday *DashboardSalesTodayReport `protobuf:"bytes,2,opt,name=sales_today,json=salesToday,proto3" json:"sales_today"`
+	// @inject_tag: json:"sources"
+	Sources              *DashboardSourcesReport `protobuf:"bytes,4,opt,name=sources,proto3" json:"sources"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *ConfirmUserEmailRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConfirmUserEmailRequest.Unmarshal(m, b)
+
+func (m *DashboardBaseReports) Reset()         { *m = DashboardBaseReports{} }
+func (m *DashboardBaseReports) String() string { return proto.CompactTextString(m) }
+func (*DashboardBaseReports) ProtoMessage()    {}
+func (*DashboardBaseReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{221}
 }
-func (m *ConfirmUserEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConfirmUserEmailRequest.Marshal(b, m, deterministic)
+func (m *DashboardBaseReports) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardBaseReports.Unmarshal(m, b)
 }
-func (dst *ConfirmUserEmailRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfirmUserEmailRequest.Merge(dst, src)
+func (m *DashboardBaseReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardBaseReports.Marshal(b, m, deterministic)
 }
-func (m *ConfirmUserEmailRequest) XXX_Size() int {
-	return xxx_messageInfo_ConfirmUserEmailRequest.Size(m)
+func (dst *DashboardBaseReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardBaseReports.Merge(dst, src)
+}
+func (m *DashboardBaseReports) XXX_Size() int {
+	return xxx_messageInfo_DashboardBaseReports.Size(m)
+}
+func (m *DashboardBaseReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardBaseReports.DiscardUnknown(m)
 }
-func (m *ConfirmUserEmailRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfirmUserEmailRequest.DiscardUnknown(m)
+
+var xxx_messageInfo_DashboardBaseReports proto.InternalMessageInfo
+
+func (m *DashboardBaseReports) GetRevenueByCountry() *DashboardRevenueByCountryReport {
+	if m != nil {
+		return m.RevenueByCountry
+	}
+	return nil
 }
 
-var xxx_messageInfo_ConfirmUserEmailRequest proto.InternalMessageInfo
+func (m *DashboardBaseReports) GetSalesToday() *DashboardSalesTodayReport {
+	if m != nil {
+		return m.SalesToday
+	}
+	return nil
+}
 
-func (m *ConfirmUserEmailRequest) GetToken() string {
+func (m *DashboardBaseReports) GetSources() *DashboardSourcesReport {
 	if m != nil {
-		return m.Token
+		return m.Sources
 	}
-	return ""
+	return nil
 }
 
-type VatTransactionsRequest struct {
-	// @inject_tag: json:"vat_report_id" validate:"required,hexadecimal,len=24"
-	VatReportId string `protobuf:"bytes,1,opt,name=vat_report_id,json=vatReportId,proto3" json:"vat_report_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
-	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: query:"sort[]"
-	Sort                 []string `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty" query:"sort[]"`
+type CreatePayoutDocumentRequest struct {
+	// @inject_tag: json:"description" validate:"max=255"
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description" validate:"max=255"`
+	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"ip" validate:"required"
+	Ip string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip" validate:"required"`
+	// @inject_tag: json:"-" validate:"required"
+	Initiator string `protobuf:"bytes,4,opt,name=initiator,proto3" json:"-" validate:"required"`
+	// @inject_tag: json:"-"
+	IsAutoGeneration bool `protobuf:"varint,5,opt,name=is_auto_generation,json=isAutoGeneration,proto3" json:"-"`
+	// @inject_tag: json:"currency" validate:"omitempty,alpha,len=3"
+	Currency             string   `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency" validate:"omitempty,alpha,len=3"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatTransactionsRequest) Reset()         { *m = VatTransactionsRequest{} }
-func (m *VatTransactionsRequest) String() string { return proto.CompactTextString(m) }
-func (*VatTransactionsRequest) ProtoMessage()    {}
-func (*VatTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{156}
+func (m *CreatePayoutDocumentRequest) Reset()         { *m = CreatePayoutDocumentRequest{} }
+func (m *CreatePayoutDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePayoutDocumentRequest) ProtoMessage()    {}
+func (*CreatePayoutDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{222}
 }
-func (m *VatTransactionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VatTransactionsRequest.Unmarshal(m, b)
+func (m *CreatePayoutDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePayoutDocumentRequest.Unmarshal(m, b)
 }
-func (m *VatTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VatTransactionsRequest.Marshal(b, m, deterministic)
+func (m *CreatePayoutDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePayoutDocumentRequest.Marshal(b, m, deterministic)
 }
-func (dst *VatTransactionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VatTransactionsRequest.Merge(dst, src)
+func (dst *CreatePayoutDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePayoutDocumentRequest.Merge(dst, src)
 }
-func (m *VatTransactionsRequest) XXX_Size() int {
-	return xxx_messageInfo_VatTransactionsRequest.Size(m)
+func (m *CreatePayoutDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePayoutDocumentRequest.Size(m)
 }
-func (m *VatTransactionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_VatTransactionsRequest.DiscardUnknown(m)
+func (m *CreatePayoutDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePayoutDocumentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VatTransactionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePayoutDocumentRequest proto.InternalMessageInfo
 
-func (m *VatTransactionsRequest) GetVatReportId() string {
+func (m *CreatePayoutDocumentRequest) GetDescription() string {
 	if m != nil {
-		return m.VatReportId
+		return m.Description
 	}
 	return ""
 }
 
-func (m *VatTransactionsRequest) GetLimit() int32 {
+func (m *CreatePayoutDocumentRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Limit
+		return m.MerchantId
 	}
-	return 0
+	return ""
 }
 
-func (m *VatTransactionsRequest) GetOffset() int32 {
+func (m *CreatePayoutDocumentRequest) GetIp() string {
 	if m != nil {
-		return m.Offset
+		return m.Ip
 	}
-	return 0
+	return ""
 }
 
-func (m *VatTransactionsRequest) GetSort() []string {
+func (m *CreatePayoutDocumentRequest) GetInitiator() string {
 	if m != nil {
-		return m.Sort
+		return m.Initiator
 	}
-	return nil
-}
-
-type TransactionsPaginate struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*billing.OrderViewPublic `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *TransactionsPaginate) Reset()         { *m = TransactionsPaginate{} }
-func (m *TransactionsPaginate) String() string { return proto.CompactTextString(m) }
-func (*TransactionsPaginate) ProtoMessage()    {}
-func (*TransactionsPaginate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{157}
-}
-func (m *TransactionsPaginate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionsPaginate.Unmarshal(m, b)
-}
-func (m *TransactionsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionsPaginate.Marshal(b, m, deterministic)
-}
-func (dst *TransactionsPaginate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionsPaginate.Merge(dst, src)
-}
-func (m *TransactionsPaginate) XXX_Size() int {
-	return xxx_messageInfo_TransactionsPaginate.Size(m)
-}
-func (m *TransactionsPaginate) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionsPaginate.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_TransactionsPaginate proto.InternalMessageInfo
-
-func (m *TransactionsPaginate) GetCount() int32 {
+func (m *CreatePayoutDocumentRequest) GetIsAutoGeneration() bool {
 	if m != nil {
-		return m.Count
+		return m.IsAutoGeneration
 	}
-	return 0
+	return false
 }
 
-func (m *TransactionsPaginate) GetItems() []*billing.OrderViewPublic {
+func (m *CreatePayoutDocumentRequest) GetCurrency() string {
 	if m != nil {
-		return m.Items
+		return m.Currency
 	}
-	return nil
+	return ""
 }
 
-type TransactionsResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"data"
-	Data                 *TransactionsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+type PayoutDocumentResponse struct {
+	// @inject_tag: json:"status"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// @inject_tag: json:"message"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// @inject_tag: json:"item"
+	Item                 *billing.PayoutDocument `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *TransactionsResponse) Reset()         { *m = TransactionsResponse{} }
-func (m *TransactionsResponse) String() string { return proto.CompactTextString(m) }
-func (*TransactionsResponse) ProtoMessage()    {}
-func (*TransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{158}
+func (m *PayoutDocumentResponse) Reset()         { *m = PayoutDocumentResponse{} }
+func (m *PayoutDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*PayoutDocumentResponse) ProtoMessage()    {}
+func (*PayoutDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{223}
 }
-func (m *TransactionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionsResponse.Unmarshal(m, b)
+func (m *PayoutDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutDocumentResponse.Unmarshal(m, b)
 }
-func (m *TransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionsResponse.Marshal(b, m, deterministic)
+func (m *PayoutDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutDocumentResponse.Marshal(b, m, deterministic)
 }
-func (dst *TransactionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionsResponse.Merge(dst, src)
+func (dst *PayoutDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutDocumentResponse.Merge(dst, src)
 }
-func (m *TransactionsResponse) XXX_Size() int {
-	return xxx_messageInfo_TransactionsResponse.Size(m)
+func (m *PayoutDocumentResponse) XXX_Size() int {
+	return xxx_messageInfo_PayoutDocumentResponse.Size(m)
 }
-func (m *TransactionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionsResponse.DiscardUnknown(m)
+func (m *PayoutDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutDocumentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransactionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PayoutDocumentResponse proto.InternalMessageInfo
 
-func (m *TransactionsResponse) GetStatus() int32 {
+func (m *PayoutDocumentResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *TransactionsResponse) GetMessage() *ResponseErrorMessage {
+func (m *PayoutDocumentResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *TransactionsResponse) GetData() *TransactionsPaginate {
+func (m *PayoutDocumentResponse) GetItem() *billing.PayoutDocument {
 	if m != nil {
-		return m.Data
+		return m.Item
 	}
 	return nil
 }
 
-type VatReportsRequest struct {
-	// @inject_tag: json:"country" query:"country" validate:"required,alpha,len=2"
-	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country" query:"country" validate:"required,alpha,len=2"`
-	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
-	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: query:"sort[]"
-	Sort                 []string `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty" query:"sort[]"`
+type UpdatePayoutDocumentRequest struct {
+	// @inject_tag: json:"payout_document_id" validate:"required,hexadecimal,len=24"
+	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"transaction" validate:"omitempty,max=255"
+	Transaction string `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction" validate:"omitempty,max=255"`
+	// @inject_tag: json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"`
+	// @inject_tag: json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"
+	FailureCode string `protobuf:"bytes,4,opt,name=failure_code,json=failureCode,proto3" json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"`
+	// @inject_tag: json:"failure_message" validate:"omitempty,max=255"
+	FailureMessage string `protobuf:"bytes,5,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message" validate:"omitempty,max=255"`
+	// @inject_tag: json:"failure_transaction" validate:"omitempty,max=255"
+	FailureTransaction string `protobuf:"bytes,6,opt,name=failure_transaction,json=failureTransaction,proto3" json:"failure_transaction" validate:"omitempty,max=255"`
+	// @inject_tag: json:"ip"
+	Ip                   string   `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatReportsRequest) Reset()         { *m = VatReportsRequest{} }
-func (m *VatReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*VatReportsRequest) ProtoMessage()    {}
-func (*VatReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{159}
+func (m *UpdatePayoutDocumentRequest) Reset()         { *m = UpdatePayoutDocumentRequest{} }
+func (m *UpdatePayoutDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePayoutDocumentRequest) ProtoMessage()    {}
+func (*UpdatePayoutDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{224}
 }
-func (m *VatReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VatReportsRequest.Unmarshal(m, b)
+func (m *UpdatePayoutDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePayoutDocumentRequest.Unmarshal(m, b)
 }
-func (m *VatReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VatReportsRequest.Marshal(b, m, deterministic)
+func (m *UpdatePayoutDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePayoutDocumentRequest.Marshal(b, m, deterministic)
 }
-func (dst *VatReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VatReportsRequest.Merge(dst, src)
+func (dst *UpdatePayoutDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePayoutDocumentRequest.Merge(dst, src)
 }
-func (m *VatReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_VatReportsRequest.Size(m)
+func (m *UpdatePayoutDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePayoutDocumentRequest.Size(m)
+}
+func (m *UpdatePayoutDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePayoutDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePayoutDocumentRequest proto.InternalMessageInfo
+
+func (m *UpdatePayoutDocumentRequest) GetPayoutDocumentId() string {
+	if m != nil {
+		return m.PayoutDocumentId
+	}
+	return ""
 }
-func (m *VatReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_VatReportsRequest.DiscardUnknown(m)
+
+func (m *UpdatePayoutDocumentRequest) GetTransaction() string {
+	if m != nil {
+		return m.Transaction
+	}
+	return ""
 }
 
-var xxx_messageInfo_VatReportsRequest proto.InternalMessageInfo
+func (m *UpdatePayoutDocumentRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
 
-func (m *VatReportsRequest) GetCountry() string {
+func (m *UpdatePayoutDocumentRequest) GetFailureCode() string {
 	if m != nil {
-		return m.Country
+		return m.FailureCode
 	}
 	return ""
 }
 
-func (m *VatReportsRequest) GetLimit() int32 {
+func (m *UpdatePayoutDocumentRequest) GetFailureMessage() string {
 	if m != nil {
-		return m.Limit
+		return m.FailureMessage
 	}
-	return 0
+	return ""
 }
 
-func (m *VatReportsRequest) GetOffset() int32 {
+func (m *UpdatePayoutDocumentRequest) GetFailureTransaction() string {
 	if m != nil {
-		return m.Offset
+		return m.FailureTransaction
 	}
-	return 0
+	return ""
 }
 
-func (m *VatReportsRequest) GetSort() []string {
+func (m *UpdatePayoutDocumentRequest) GetIp() string {
 	if m != nil {
-		return m.Sort
+		return m.Ip
 	}
-	return nil
+	return ""
 }
 
-type VatReportsPaginate struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*billing.VatReport `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPayoutDocumentRequest struct {
+	// @inject_tag: query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"
+	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty" query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatReportsPaginate) Reset()         { *m = VatReportsPaginate{} }
-func (m *VatReportsPaginate) String() string { return proto.CompactTextString(m) }
-func (*VatReportsPaginate) ProtoMessage()    {}
-func (*VatReportsPaginate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{160}
+func (m *GetPayoutDocumentRequest) Reset()         { *m = GetPayoutDocumentRequest{} }
+func (m *GetPayoutDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutDocumentRequest) ProtoMessage()    {}
+func (*GetPayoutDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{225}
 }
-func (m *VatReportsPaginate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VatReportsPaginate.Unmarshal(m, b)
+func (m *GetPayoutDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutDocumentRequest.Unmarshal(m, b)
 }
-func (m *VatReportsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VatReportsPaginate.Marshal(b, m, deterministic)
+func (m *GetPayoutDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutDocumentRequest.Marshal(b, m, deterministic)
 }
-func (dst *VatReportsPaginate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VatReportsPaginate.Merge(dst, src)
+func (dst *GetPayoutDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutDocumentRequest.Merge(dst, src)
 }
-func (m *VatReportsPaginate) XXX_Size() int {
-	return xxx_messageInfo_VatReportsPaginate.Size(m)
+func (m *GetPayoutDocumentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutDocumentRequest.Size(m)
 }
-func (m *VatReportsPaginate) XXX_DiscardUnknown() {
-	xxx_messageInfo_VatReportsPaginate.DiscardUnknown(m)
+func (m *GetPayoutDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutDocumentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VatReportsPaginate proto.InternalMessageInfo
+var xxx_messageInfo_GetPayoutDocumentRequest proto.InternalMessageInfo
 
-func (m *VatReportsPaginate) GetCount() int32 {
+func (m *GetPayoutDocumentRequest) GetPayoutDocumentId() string {
 	if m != nil {
-		return m.Count
+		return m.PayoutDocumentId
 	}
-	return 0
+	return ""
 }
 
-func (m *VatReportsPaginate) GetItems() []*billing.VatReport {
+func (m *GetPayoutDocumentRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Items
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-type VatReportsResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"data"
-	Data                 *VatReportsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPayoutDocumentsRequest struct {
+	// @inject_tag: query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"
+	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty" query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"status[]" validate:"omitempty,dive,oneof=skip pending in_progress paid canceled failed"
+	Status []string `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,oneof=skip pending in_progress paid canceled failed"`
+	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"period_from" validate:"omitempty,numeric,gt=0"
+	PeriodFrom int64 `protobuf:"varint,4,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty" query:"period_from" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"period_to" validate:"omitempty,numeric,gt=0"
+	PeriodTo int64 `protobuf:"varint,5,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty" query:"period_to" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *VatReportsResponse) Reset()         { *m = VatReportsResponse{} }
-func (m *VatReportsResponse) String() string { return proto.CompactTextString(m) }
-func (*VatReportsResponse) ProtoMessage()    {}
-func (*VatReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{161}
+func (m *GetPayoutDocumentsRequest) Reset()         { *m = GetPayoutDocumentsRequest{} }
+func (m *GetPayoutDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutDocumentsRequest) ProtoMessage()    {}
+func (*GetPayoutDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{226}
 }
-func (m *VatReportsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VatReportsResponse.Unmarshal(m, b)
+func (m *GetPayoutDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutDocumentsRequest.Unmarshal(m, b)
 }
-func (m *VatReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VatReportsResponse.Marshal(b, m, deterministic)
+func (m *GetPayoutDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutDocumentsRequest.Marshal(b, m, deterministic)
 }
-func (dst *VatReportsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VatReportsResponse.Merge(dst, src)
+func (dst *GetPayoutDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutDocumentsRequest.Merge(dst, src)
 }
-func (m *VatReportsResponse) XXX_Size() int {
-	return xxx_messageInfo_VatReportsResponse.Size(m)
+func (m *GetPayoutDocumentsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutDocumentsRequest.Size(m)
 }
-func (m *VatReportsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VatReportsResponse.DiscardUnknown(m)
+func (m *GetPayoutDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutDocumentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VatReportsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPayoutDocumentsRequest proto.InternalMessageInfo
 
-func (m *VatReportsResponse) GetStatus() int32 {
+func (m *GetPayoutDocumentsRequest) GetPayoutDocumentId() string {
 	if m != nil {
-		return m.Status
+		return m.PayoutDocumentId
 	}
-	return 0
+	return ""
 }
 
-func (m *VatReportsResponse) GetMessage() *ResponseErrorMessage {
+func (m *GetPayoutDocumentsRequest) GetStatus() []string {
 	if m != nil {
-		return m.Message
+		return m.Status
 	}
 	return nil
 }
 
-func (m *VatReportsResponse) GetData() *VatReportsPaginate {
+func (m *GetPayoutDocumentsRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Data
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-type ProcessVatReportsRequest struct {
-	Date                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetPayoutDocumentsRequest) GetPeriodFrom() int64 {
+	if m != nil {
+		return m.PeriodFrom
+	}
+	return 0
 }
 
-func (m *ProcessVatReportsRequest) Reset()         { *m = ProcessVatReportsRequest{} }
-func (m *ProcessVatReportsRequest) String() string { return proto.CompactTextString(m) }
-func (*ProcessVatReportsRequest) ProtoMessage()    {}
-func (*ProcessVatReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{162}
-}
-func (m *ProcessVatReportsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProcessVatReportsRequest.Unmarshal(m, b)
-}
-func (m *ProcessVatReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProcessVatReportsRequest.Marshal(b, m, deterministic)
-}
-func (dst *ProcessVatReportsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcessVatReportsRequest.Merge(dst, src)
-}
-func (m *ProcessVatReportsRequest) XXX_Size() int {
-	return xxx_messageInfo_ProcessVatReportsRequest.Size(m)
-}
-func (m *ProcessVatReportsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProcessVatReportsRequest.DiscardUnknown(m)
+func (m *GetPayoutDocumentsRequest) GetPeriodTo() int64 {
+	if m != nil {
+		return m.PeriodTo
+	}
+	return 0
 }
 
-var xxx_messageInfo_ProcessVatReportsRequest proto.InternalMessageInfo
+func (m *GetPayoutDocumentsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
 
-func (m *ProcessVatReportsRequest) GetDate() *timestamp.Timestamp {
+func (m *GetPayoutDocumentsRequest) GetOffset() int32 {
 	if m != nil {
-		return m.Date
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-type UpdateVatReportStatusRequest struct {
-	// @inject_tag: json:"id" bson:"_id" validate:"hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id" validate:"hexadecimal,len=24"`
-	// @inject_tag: json:"status" bson:"status" validate:"required,alpha,oneof=paid canceled"
-	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status" bson:"status" validate:"required,alpha,oneof=paid canceled"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type PayoutDocumentsPaginate struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*billing.PayoutDocument `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UpdateVatReportStatusRequest) Reset()         { *m = UpdateVatReportStatusRequest{} }
-func (m *UpdateVatReportStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateVatReportStatusRequest) ProtoMessage()    {}
-func (*UpdateVatReportStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{163}
+func (m *PayoutDocumentsPaginate) Reset()         { *m = PayoutDocumentsPaginate{} }
+func (m *PayoutDocumentsPaginate) String() string { return proto.CompactTextString(m) }
+func (*PayoutDocumentsPaginate) ProtoMessage()    {}
+func (*PayoutDocumentsPaginate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{227}
 }
-func (m *UpdateVatReportStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateVatReportStatusRequest.Unmarshal(m, b)
+func (m *PayoutDocumentsPaginate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutDocumentsPaginate.Unmarshal(m, b)
 }
-func (m *UpdateVatReportStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateVatReportStatusRequest.Marshal(b, m, deterministic)
+func (m *PayoutDocumentsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutDocumentsPaginate.Marshal(b, m, deterministic)
 }
-func (dst *UpdateVatReportStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateVatReportStatusRequest.Merge(dst, src)
+func (dst *PayoutDocumentsPaginate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutDocumentsPaginate.Merge(dst, src)
 }
-func (m *UpdateVatReportStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateVatReportStatusRequest.Size(m)
+func (m *PayoutDocumentsPaginate) XXX_Size() int {
+	return xxx_messageInfo_PayoutDocumentsPaginate.Size(m)
 }
-func (m *UpdateVatReportStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateVatReportStatusRequest.DiscardUnknown(m)
+func (m *PayoutDocumentsPaginate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutDocumentsPaginate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateVatReportStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_PayoutDocumentsPaginate proto.InternalMessageInfo
 
-func (m *UpdateVatReportStatusRequest) GetId() string {
+func (m *PayoutDocumentsPaginate) GetCount() int32 {
 	if m != nil {
-		return m.Id
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-func (m *UpdateVatReportStatusRequest) GetStatus() string {
+func (m *PayoutDocumentsPaginate) GetItems() []*billing.PayoutDocument {
 	if m != nil {
-		return m.Status
+		return m.Items
 	}
-	return ""
+	return nil
 }
 
-type GetMerchantOnboardingCompleteDataResponseItem struct {
-	// @inject_tag: json:"status"
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"steps"
-	Steps *billing.MerchantCompletedSteps `protobuf:"bytes,2,opt,name=steps,proto3" json:"steps"`
-	// @inject_tag: json:"complete_steps_count"
-	CompleteStepsCount   int32    `protobuf:"varint,3,opt,name=complete_steps_count,json=completeStepsCount,proto3" json:"complete_steps_count"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPayoutDocumentsResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"data"
+	Data                 *PayoutDocumentsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantOnboardingCompleteDataResponseItem) Reset() {
-	*m = GetMerchantOnboardingCompleteDataResponseItem{}
-}
-func (m *GetMerchantOnboardingCompleteDataResponseItem) String() string {
-	return proto.CompactTextString(m)
-}
-func (*GetMerchantOnboardingCompleteDataResponseItem) ProtoMessage() {}
-func (*GetMerchantOnboardingCompleteDataResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{164}
+func (m *GetPayoutDocumentsResponse) Reset()         { *m = GetPayoutDocumentsResponse{} }
+func (m *GetPayoutDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutDocumentsResponse) ProtoMessage()    {}
+func (*GetPayoutDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{228}
 }
-func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Unmarshal(m, b)
+func (m *GetPayoutDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutDocumentsResponse.Unmarshal(m, b)
 }
-func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Marshal(b, m, deterministic)
+func (m *GetPayoutDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutDocumentsResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantOnboardingCompleteDataResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Merge(dst, src)
+func (dst *GetPayoutDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutDocumentsResponse.Merge(dst, src)
 }
-func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.Size(m)
+func (m *GetPayoutDocumentsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutDocumentsResponse.Size(m)
 }
-func (m *GetMerchantOnboardingCompleteDataResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem.DiscardUnknown(m)
+func (m *GetPayoutDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutDocumentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantOnboardingCompleteDataResponseItem proto.InternalMessageInfo
+var xxx_messageInfo_GetPayoutDocumentsResponse proto.InternalMessageInfo
 
-func (m *GetMerchantOnboardingCompleteDataResponseItem) GetStatus() string {
+func (m *GetPayoutDocumentsResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *GetMerchantOnboardingCompleteDataResponseItem) GetSteps() *billing.MerchantCompletedSteps {
+func (m *GetPayoutDocumentsResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Steps
+		return m.Message
 	}
 	return nil
 }
 
-func (m *GetMerchantOnboardingCompleteDataResponseItem) GetCompleteStepsCount() int32 {
+func (m *GetPayoutDocumentsResponse) GetData() *PayoutDocumentsPaginate {
 	if m != nil {
-		return m.CompleteStepsCount
+		return m.Data
 	}
-	return 0
+	return nil
 }
 
-type GetMerchantOnboardingCompleteDataResponse struct {
-	Status               int32                                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *GetMerchantOnboardingCompleteDataResponseItem `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                          `json:"-" bson:"-" structure:"-" validate:"-"`
+type UpdatePayoutDocumentSignaturesRequest struct {
+	// @inject_tag: query:"payout_document_id" validate:"required,hexadecimal,len=24"
+	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty" query:"payout_document_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"has_merchant_signature"
+	HasMerchantSignature bool `protobuf:"varint,2,opt,name=has_merchant_signature,json=hasMerchantSignature,proto3" json:"has_merchant_signature"`
+	// @inject_tag: json:"has_psp_signature"
+	HasPspSignature bool `protobuf:"varint,3,opt,name=has_psp_signature,json=hasPspSignature,proto3" json:"has_psp_signature"`
+	// @inject_tag: json:"signed_document_file_url"
+	SignedDocumentFileUrl string   `protobuf:"bytes,4,opt,name=signed_document_file_url,json=signedDocumentFileUrl,proto3" json:"signed_document_file_url"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized      []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache         int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantOnboardingCompleteDataResponse) Reset() {
-	*m = GetMerchantOnboardingCompleteDataResponse{}
-}
-func (m *GetMerchantOnboardingCompleteDataResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantOnboardingCompleteDataResponse) ProtoMessage()    {}
-func (*GetMerchantOnboardingCompleteDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{165}
+func (m *UpdatePayoutDocumentSignaturesRequest) Reset()         { *m = UpdatePayoutDocumentSignaturesRequest{} }
+func (m *UpdatePayoutDocumentSignaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePayoutDocumentSignaturesRequest) ProtoMessage()    {}
+func (*UpdatePayoutDocumentSignaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{229}
 }
-func (m *GetMerchantOnboardingCompleteDataResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Unmarshal(m, b)
+func (m *UpdatePayoutDocumentSignaturesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Unmarshal(m, b)
 }
-func (m *GetMerchantOnboardingCompleteDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Marshal(b, m, deterministic)
+func (m *UpdatePayoutDocumentSignaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantOnboardingCompleteDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Merge(dst, src)
+func (dst *UpdatePayoutDocumentSignaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Merge(dst, src)
 }
-func (m *GetMerchantOnboardingCompleteDataResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.Size(m)
+func (m *UpdatePayoutDocumentSignaturesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Size(m)
 }
-func (m *GetMerchantOnboardingCompleteDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse.DiscardUnknown(m)
+func (m *UpdatePayoutDocumentSignaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantOnboardingCompleteDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest proto.InternalMessageInfo
 
-func (m *GetMerchantOnboardingCompleteDataResponse) GetStatus() int32 {
+func (m *UpdatePayoutDocumentSignaturesRequest) GetPayoutDocumentId() string {
 	if m != nil {
-		return m.Status
+		return m.PayoutDocumentId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetMerchantOnboardingCompleteDataResponse) GetMessage() *ResponseErrorMessage {
+func (m *UpdatePayoutDocumentSignaturesRequest) GetHasMerchantSignature() bool {
 	if m != nil {
-		return m.Message
+		return m.HasMerchantSignature
 	}
-	return nil
+	return false
 }
 
-func (m *GetMerchantOnboardingCompleteDataResponse) GetItem() *GetMerchantOnboardingCompleteDataResponseItem {
+func (m *UpdatePayoutDocumentSignaturesRequest) GetHasPspSignature() bool {
 	if m != nil {
-		return m.Item
+		return m.HasPspSignature
 	}
-	return nil
+	return false
 }
 
-type GetMerchantAgreementSignUrlRequest struct {
-	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"signer_type" validate:"oneof=0 1"
-	SignerType           int32    `protobuf:"varint,2,opt,name=signer_type,json=signerType,proto3" json:"signer_type" validate:"oneof=0 1"`
+func (m *UpdatePayoutDocumentSignaturesRequest) GetSignedDocumentFileUrl() string {
+	if m != nil {
+		return m.SignedDocumentFileUrl
+	}
+	return ""
+}
+
+type GetMerchantBalanceRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,alpha,len=3"
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty" validate:"omitempty,alpha,len=3"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantAgreementSignUrlRequest) Reset()         { *m = GetMerchantAgreementSignUrlRequest{} }
-func (m *GetMerchantAgreementSignUrlRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantAgreementSignUrlRequest) ProtoMessage()    {}
-func (*GetMerchantAgreementSignUrlRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{166}
+func (m *GetMerchantBalanceRequest) Reset()         { *m = GetMerchantBalanceRequest{} }
+func (m *GetMerchantBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantBalanceRequest) ProtoMessage()    {}
+func (*GetMerchantBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{230}
 }
-func (m *GetMerchantAgreementSignUrlRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Unmarshal(m, b)
+func (m *GetMerchantBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantBalanceRequest.Unmarshal(m, b)
 }
-func (m *GetMerchantAgreementSignUrlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Marshal(b, m, deterministic)
+func (m *GetMerchantBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantBalanceRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantAgreementSignUrlRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Merge(dst, src)
+func (dst *GetMerchantBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantBalanceRequest.Merge(dst, src)
 }
-func (m *GetMerchantAgreementSignUrlRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantAgreementSignUrlRequest.Size(m)
+func (m *GetMerchantBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantBalanceRequest.Size(m)
 }
-func (m *GetMerchantAgreementSignUrlRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantAgreementSignUrlRequest.DiscardUnknown(m)
+func (m *GetMerchantBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantAgreementSignUrlRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetMerchantBalanceRequest proto.InternalMessageInfo
 
-func (m *GetMerchantAgreementSignUrlRequest) GetMerchantId() string {
+func (m *GetMerchantBalanceRequest) GetMerchantId() string {
 	if m != nil {
 		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *GetMerchantAgreementSignUrlRequest) GetSignerType() int32 {
+func (m *GetMerchantBalanceRequest) GetCurrency() string {
 	if m != nil {
-		return m.SignerType
+		return m.Currency
 	}
-	return 0
+	return ""
 }
 
-type GetMerchantAgreementSignUrlResponse struct {
-	Status               int32                                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *billing.MerchantAgreementSignatureDataSignUrl `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                          `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetMerchantBalanceResponse struct {
+	Status                 int32                                           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message                *ResponseErrorMessage                           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                   *billing.MerchantBalance                        `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Items                  []*billing.MerchantBalance                      `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	RollingReserveReleases []*billing.MerchantBalanceRollingReserveRelease `protobuf:"bytes,5,rep,name=rolling_reserve_releases,json=rollingReserveReleases,proto3" json:"rolling_reserve_releases,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                                        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized       []byte                                          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache          int32                                           `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantAgreementSignUrlResponse) Reset()         { *m = GetMerchantAgreementSignUrlResponse{} }
-func (m *GetMerchantAgreementSignUrlResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantAgreementSignUrlResponse) ProtoMessage()    {}
-func (*GetMerchantAgreementSignUrlResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{167}
+func (m *GetMerchantBalanceResponse) Reset()         { *m = GetMerchantBalanceResponse{} }
+func (m *GetMerchantBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantBalanceResponse) ProtoMessage()    {}
+func (*GetMerchantBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{231}
 }
-func (m *GetMerchantAgreementSignUrlResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Unmarshal(m, b)
+func (m *GetMerchantBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantBalanceResponse.Unmarshal(m, b)
 }
-func (m *GetMerchantAgreementSignUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Marshal(b, m, deterministic)
+func (m *GetMerchantBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantBalanceResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantAgreementSignUrlResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Merge(dst, src)
+func (dst *GetMerchantBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantBalanceResponse.Merge(dst, src)
 }
-func (m *GetMerchantAgreementSignUrlResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantAgreementSignUrlResponse.Size(m)
+func (m *GetMerchantBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantBalanceResponse.Size(m)
 }
-func (m *GetMerchantAgreementSignUrlResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantAgreementSignUrlResponse.DiscardUnknown(m)
+func (m *GetMerchantBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantBalanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantAgreementSignUrlResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMerchantBalanceResponse proto.InternalMessageInfo
 
-func (m *GetMerchantAgreementSignUrlResponse) GetStatus() int32 {
+func (m *GetMerchantBalanceResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetMerchantAgreementSignUrlResponse) GetMessage() *ResponseErrorMessage {
+func (m *GetMerchantBalanceResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetMerchantAgreementSignUrlResponse) GetItem() *billing.MerchantAgreementSignatureDataSignUrl {
+func (m *GetMerchantBalanceResponse) GetItem() *billing.MerchantBalance {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type GetMerchantTariffRatesRequest struct {
-	// @inject_tag: query:"region" validate:"required,tariff_region"
-	HomeRegion string `protobuf:"bytes,1,opt,name=home_region,json=homeRegion,proto3" json:"home_region,omitempty" query:"region" validate:"required,tariff_region"`
-	// @inject_tag: query:"payer_region" validate:"omitempty,tariff_region"
-	PayerRegion string `protobuf:"bytes,2,opt,name=payer_region,json=payerRegion,proto3" json:"payer_region,omitempty" query:"payer_region" validate:"omitempty,tariff_region"`
-	// @inject_tag: query:"min_amount" validate:"omitempty,numeric,gte=0"
-	MinAmount float64 `protobuf:"fixed64,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty" query:"min_amount" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: query:"max_amount" validate:"omitempty,numeric,gt=0"
-	MaxAmount            float64  `protobuf:"fixed64,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty" query:"max_amount" validate:"omitempty,numeric,gt=0"`
+func (m *GetMerchantBalanceResponse) GetItems() []*billing.MerchantBalance {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *GetMerchantBalanceResponse) GetRollingReserveReleases() []*billing.MerchantBalanceRollingReserveRelease {
+	if m != nil {
+		return m.RollingReserveReleases
+	}
+	return nil
+}
+
+type PayoutDocumentPdfUploadedRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required"
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,numeric,gte=0"
+	RetentionTime int32 `protobuf:"varint,3,opt,name=retention_time,json=retentionTime,proto3" json:"retention_time,omitempty" validate:"required,numeric,gte=0"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PayoutId             string   `protobuf:"bytes,4,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty" validate:"required,hexadecimal,len=24"`
+	Content              []byte   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantTariffRatesRequest) Reset()         { *m = GetMerchantTariffRatesRequest{} }
-func (m *GetMerchantTariffRatesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantTariffRatesRequest) ProtoMessage()    {}
-func (*GetMerchantTariffRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{168}
+func (m *PayoutDocumentPdfUploadedRequest) Reset()         { *m = PayoutDocumentPdfUploadedRequest{} }
+func (m *PayoutDocumentPdfUploadedRequest) String() string { return proto.CompactTextString(m) }
+func (*PayoutDocumentPdfUploadedRequest) ProtoMessage()    {}
+func (*PayoutDocumentPdfUploadedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{232}
 }
-func (m *GetMerchantTariffRatesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantTariffRatesRequest.Unmarshal(m, b)
+func (m *PayoutDocumentPdfUploadedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Unmarshal(m, b)
 }
-func (m *GetMerchantTariffRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantTariffRatesRequest.Marshal(b, m, deterministic)
+func (m *PayoutDocumentPdfUploadedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantTariffRatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantTariffRatesRequest.Merge(dst, src)
+func (dst *PayoutDocumentPdfUploadedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Merge(dst, src)
 }
-func (m *GetMerchantTariffRatesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantTariffRatesRequest.Size(m)
+func (m *PayoutDocumentPdfUploadedRequest) XXX_Size() int {
+	return xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Size(m)
 }
-func (m *GetMerchantTariffRatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantTariffRatesRequest.DiscardUnknown(m)
+func (m *PayoutDocumentPdfUploadedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutDocumentPdfUploadedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantTariffRatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_PayoutDocumentPdfUploadedRequest proto.InternalMessageInfo
 
-func (m *GetMerchantTariffRatesRequest) GetHomeRegion() string {
+func (m *PayoutDocumentPdfUploadedRequest) GetId() string {
 	if m != nil {
-		return m.HomeRegion
+		return m.Id
 	}
 	return ""
 }
 
-func (m *GetMerchantTariffRatesRequest) GetPayerRegion() string {
+func (m *PayoutDocumentPdfUploadedRequest) GetFilename() string {
 	if m != nil {
-		return m.PayerRegion
+		return m.Filename
 	}
 	return ""
 }
 
-func (m *GetMerchantTariffRatesRequest) GetMinAmount() float64 {
-	if m != nil {
-		return m.MinAmount
-	}
-	return 0
-}
-
-func (m *GetMerchantTariffRatesRequest) GetMaxAmount() float64 {
+func (m *PayoutDocumentPdfUploadedRequest) GetRetentionTime() int32 {
 	if m != nil {
-		return m.MaxAmount
+		return m.RetentionTime
 	}
 	return 0
 }
 
-type GetMerchantTariffRatesResponseItems struct {
-	Payment []*billing.MerchantTariffRatesPayment `protobuf:"bytes,1,rep,name=payment,proto3" json:"payment,omitempty"`
-	// @inject_tag: json:"-"
-	Refund []*billing.MerchantTariffRatesSettingsRefundItem `protobuf:"bytes,2,rep,name=refund,proto3" json:"-"`
-	// @inject_tag: json:"chargeback"
-	Chargeback *billing.MerchantTariffRatesSettingsItem `protobuf:"bytes,3,opt,name=chargeback,proto3" json:"chargeback"`
-	// @inject_tag: json:"payout"
-	Payout               *billing.MerchantTariffRatesSettingsItem `protobuf:"bytes,4,opt,name=payout,proto3" json:"payout"`
-	XXX_NoUnkeyedLiteral struct{}                                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *GetMerchantTariffRatesResponseItems) Reset()         { *m = GetMerchantTariffRatesResponseItems{} }
-func (m *GetMerchantTariffRatesResponseItems) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantTariffRatesResponseItems) ProtoMessage()    {}
-func (*GetMerchantTariffRatesResponseItems) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{169}
-}
-func (m *GetMerchantTariffRatesResponseItems) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantTariffRatesResponseItems.Unmarshal(m, b)
-}
-func (m *GetMerchantTariffRatesResponseItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantTariffRatesResponseItems.Marshal(b, m, deterministic)
-}
-func (dst *GetMerchantTariffRatesResponseItems) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantTariffRatesResponseItems.Merge(dst, src)
-}
-func (m *GetMerchantTariffRatesResponseItems) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantTariffRatesResponseItems.Size(m)
-}
-func (m *GetMerchantTariffRatesResponseItems) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantTariffRatesResponseItems.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetMerchantTariffRatesResponseItems proto.InternalMessageInfo
-
-func (m *GetMerchantTariffRatesResponseItems) GetPayment() []*billing.MerchantTariffRatesPayment {
-	if m != nil {
-		return m.Payment
-	}
-	return nil
-}
-
-func (m *GetMerchantTariffRatesResponseItems) GetRefund() []*billing.MerchantTariffRatesSettingsRefundItem {
-	if m != nil {
-		return m.Refund
-	}
-	return nil
-}
-
-func (m *GetMerchantTariffRatesResponseItems) GetChargeback() *billing.MerchantTariffRatesSettingsItem {
+func (m *PayoutDocumentPdfUploadedRequest) GetPayoutId() string {
 	if m != nil {
-		return m.Chargeback
+		return m.PayoutId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetMerchantTariffRatesResponseItems) GetPayout() *billing.MerchantTariffRatesSettingsItem {
+func (m *PayoutDocumentPdfUploadedRequest) GetContent() []byte {
 	if m != nil {
-		return m.Payout
+		return m.Content
 	}
 	return nil
 }
 
-type GetMerchantTariffRatesResponse struct {
-	Status               int32                                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Items                *GetMerchantTariffRatesResponseItems `protobuf:"bytes,3,opt,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                `json:"-" bson:"-" structure:"-" validate:"-"`
+type PayoutDocumentPdfUploadedResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantTariffRatesResponse) Reset()         { *m = GetMerchantTariffRatesResponse{} }
-func (m *GetMerchantTariffRatesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantTariffRatesResponse) ProtoMessage()    {}
-func (*GetMerchantTariffRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{170}
+func (m *PayoutDocumentPdfUploadedResponse) Reset()         { *m = PayoutDocumentPdfUploadedResponse{} }
+func (m *PayoutDocumentPdfUploadedResponse) String() string { return proto.CompactTextString(m) }
+func (*PayoutDocumentPdfUploadedResponse) ProtoMessage()    {}
+func (*PayoutDocumentPdfUploadedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{233}
 }
-func (m *GetMerchantTariffRatesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantTariffRatesResponse.Unmarshal(m, b)
+func (m *PayoutDocumentPdfUploadedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Unmarshal(m, b)
 }
-func (m *GetMerchantTariffRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantTariffRatesResponse.Marshal(b, m, deterministic)
+func (m *PayoutDocumentPdfUploadedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantTariffRatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantTariffRatesResponse.Merge(dst, src)
+func (dst *PayoutDocumentPdfUploadedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Merge(dst, src)
 }
-func (m *GetMerchantTariffRatesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantTariffRatesResponse.Size(m)
+func (m *PayoutDocumentPdfUploadedResponse) XXX_Size() int {
+	return xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Size(m)
 }
-func (m *GetMerchantTariffRatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantTariffRatesResponse.DiscardUnknown(m)
+func (m *PayoutDocumentPdfUploadedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutDocumentPdfUploadedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantTariffRatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_PayoutDocumentPdfUploadedResponse proto.InternalMessageInfo
 
-func (m *GetMerchantTariffRatesResponse) GetStatus() int32 {
+func (m *PayoutDocumentPdfUploadedResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetMerchantTariffRatesResponse) GetMessage() *ResponseErrorMessage {
+func (m *PayoutDocumentPdfUploadedResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetMerchantTariffRatesResponse) GetItems() *GetMerchantTariffRatesResponseItems {
-	if m != nil {
-		return m.Items
-	}
-	return nil
-}
-
-type SetMerchantTariffRatesRequest struct {
-	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"home_region" validate:"required,tariff_region"
-	HomeRegion           string   `protobuf:"bytes,2,opt,name=home_region,json=homeRegion,proto3" json:"home_region" validate:"required,tariff_region"`
+type CreatePayoutBatchRequest struct {
+	// @inject_tag: json:"format" validate:"required,oneof=sepa csv"
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format" validate:"required,oneof=sepa csv"`
+	// @inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"payout_document_ids" validate:"omitempty,dive,hexadecimal,len=24"
+	PayoutDocumentIds []string `protobuf:"bytes,3,rep,name=payout_document_ids,json=payoutDocumentIds,proto3" json:"payout_document_ids" validate:"omitempty,dive,hexadecimal,len=24"`
+	// @inject_tag: json:"ip"
+	Ip                   string   `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *SetMerchantTariffRatesRequest) Reset()         { *m = SetMerchantTariffRatesRequest{} }
-func (m *SetMerchantTariffRatesRequest) String() string { return proto.CompactTextString(m) }
-func (*SetMerchantTariffRatesRequest) ProtoMessage()    {}
-func (*SetMerchantTariffRatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{171}
+func (m *CreatePayoutBatchRequest) Reset()         { *m = CreatePayoutBatchRequest{} }
+func (m *CreatePayoutBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePayoutBatchRequest) ProtoMessage()    {}
+func (*CreatePayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{234}
 }
-func (m *SetMerchantTariffRatesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetMerchantTariffRatesRequest.Unmarshal(m, b)
+func (m *CreatePayoutBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePayoutBatchRequest.Unmarshal(m, b)
 }
-func (m *SetMerchantTariffRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetMerchantTariffRatesRequest.Marshal(b, m, deterministic)
+func (m *CreatePayoutBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePayoutBatchRequest.Marshal(b, m, deterministic)
 }
-func (dst *SetMerchantTariffRatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetMerchantTariffRatesRequest.Merge(dst, src)
+func (dst *CreatePayoutBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePayoutBatchRequest.Merge(dst, src)
 }
-func (m *SetMerchantTariffRatesRequest) XXX_Size() int {
-	return xxx_messageInfo_SetMerchantTariffRatesRequest.Size(m)
+func (m *CreatePayoutBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePayoutBatchRequest.Size(m)
 }
-func (m *SetMerchantTariffRatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetMerchantTariffRatesRequest.DiscardUnknown(m)
+func (m *CreatePayoutBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePayoutBatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetMerchantTariffRatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePayoutBatchRequest proto.InternalMessageInfo
 
-func (m *SetMerchantTariffRatesRequest) GetMerchantId() string {
+func (m *CreatePayoutBatchRequest) GetFormat() string {
 	if m != nil {
-		return m.MerchantId
+		return m.Format
 	}
 	return ""
 }
 
-func (m *SetMerchantTariffRatesRequest) GetHomeRegion() string {
+func (m *CreatePayoutBatchRequest) GetCurrency() string {
 	if m != nil {
-		return m.HomeRegion
+		return m.Currency
 	}
 	return ""
 }
 
-type ListOrdersPublicResponseItem struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*billing.OrderViewPublic `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *CreatePayoutBatchRequest) GetPayoutDocumentIds() []string {
+	if m != nil {
+		return m.PayoutDocumentIds
+	}
+	return nil
+}
+
+func (m *CreatePayoutBatchRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type GetPayoutBatchRequest struct {
+	// @inject_tag: json:"batch_id" validate:"required,hexadecimal,len=24"
+	BatchId              string   `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListOrdersPublicResponseItem) Reset()         { *m = ListOrdersPublicResponseItem{} }
-func (m *ListOrdersPublicResponseItem) String() string { return proto.CompactTextString(m) }
-func (*ListOrdersPublicResponseItem) ProtoMessage()    {}
-func (*ListOrdersPublicResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{172}
+func (m *GetPayoutBatchRequest) Reset()         { *m = GetPayoutBatchRequest{} }
+func (m *GetPayoutBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutBatchRequest) ProtoMessage()    {}
+func (*GetPayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{235}
 }
-func (m *ListOrdersPublicResponseItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOrdersPublicResponseItem.Unmarshal(m, b)
+func (m *GetPayoutBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutBatchRequest.Unmarshal(m, b)
 }
-func (m *ListOrdersPublicResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOrdersPublicResponseItem.Marshal(b, m, deterministic)
+func (m *GetPayoutBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutBatchRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListOrdersPublicResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOrdersPublicResponseItem.Merge(dst, src)
+func (dst *GetPayoutBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutBatchRequest.Merge(dst, src)
 }
-func (m *ListOrdersPublicResponseItem) XXX_Size() int {
-	return xxx_messageInfo_ListOrdersPublicResponseItem.Size(m)
+func (m *GetPayoutBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutBatchRequest.Size(m)
 }
-func (m *ListOrdersPublicResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOrdersPublicResponseItem.DiscardUnknown(m)
+func (m *GetPayoutBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutBatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOrdersPublicResponseItem proto.InternalMessageInfo
-
-func (m *ListOrdersPublicResponseItem) GetCount() int32 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
-}
+var xxx_messageInfo_GetPayoutBatchRequest proto.InternalMessageInfo
 
-func (m *ListOrdersPublicResponseItem) GetItems() []*billing.OrderViewPublic {
+func (m *GetPayoutBatchRequest) GetBatchId() string {
 	if m != nil {
-		return m.Items
+		return m.BatchId
 	}
-	return nil
+	return ""
 }
 
-type ListOrdersPrivateResponseItem struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*billing.OrderViewPrivate `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
+type PayoutBatchResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.PayoutBatch  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListOrdersPrivateResponseItem) Reset()         { *m = ListOrdersPrivateResponseItem{} }
-func (m *ListOrdersPrivateResponseItem) String() string { return proto.CompactTextString(m) }
-func (*ListOrdersPrivateResponseItem) ProtoMessage()    {}
-func (*ListOrdersPrivateResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{173}
+func (m *PayoutBatchResponse) Reset()         { *m = PayoutBatchResponse{} }
+func (m *PayoutBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*PayoutBatchResponse) ProtoMessage()    {}
+func (*PayoutBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{236}
 }
-func (m *ListOrdersPrivateResponseItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOrdersPrivateResponseItem.Unmarshal(m, b)
+func (m *PayoutBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutBatchResponse.Unmarshal(m, b)
 }
-func (m *ListOrdersPrivateResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOrdersPrivateResponseItem.Marshal(b, m, deterministic)
+func (m *PayoutBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutBatchResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListOrdersPrivateResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOrdersPrivateResponseItem.Merge(dst, src)
+func (dst *PayoutBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutBatchResponse.Merge(dst, src)
 }
-func (m *ListOrdersPrivateResponseItem) XXX_Size() int {
-	return xxx_messageInfo_ListOrdersPrivateResponseItem.Size(m)
+func (m *PayoutBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_PayoutBatchResponse.Size(m)
 }
-func (m *ListOrdersPrivateResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOrdersPrivateResponseItem.DiscardUnknown(m)
+func (m *PayoutBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOrdersPrivateResponseItem proto.InternalMessageInfo
+var xxx_messageInfo_PayoutBatchResponse proto.InternalMessageInfo
 
-func (m *ListOrdersPrivateResponseItem) GetCount() int32 {
+func (m *PayoutBatchResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Count
+		return m.Status
 	}
 	return 0
 }
 
-func (m *ListOrdersPrivateResponseItem) GetItems() []*billing.OrderViewPrivate {
+func (m *PayoutBatchResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Items
+		return m.Message
 	}
 	return nil
 }
 
-type ListOrdersPublicResponse struct {
-	Status               int32                         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *ListOrdersPublicResponseItem `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *PayoutBatchResponse) GetItem() *billing.PayoutBatch {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *ListOrdersPublicResponse) Reset()         { *m = ListOrdersPublicResponse{} }
-func (m *ListOrdersPublicResponse) String() string { return proto.CompactTextString(m) }
-func (*ListOrdersPublicResponse) ProtoMessage()    {}
-func (*ListOrdersPublicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{174}
+type ImportPayoutStatusReportRequest struct {
+	// @inject_tag: json:"file_name" validate:"required"
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name" validate:"required"`
+	// @inject_tag: json:"content" validate:"required"
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content" validate:"required"`
+	// @inject_tag: json:"ip"
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *ListOrdersPublicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOrdersPublicResponse.Unmarshal(m, b)
+
+func (m *ImportPayoutStatusReportRequest) Reset()         { *m = ImportPayoutStatusReportRequest{} }
+func (m *ImportPayoutStatusReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportPayoutStatusReportRequest) ProtoMessage()    {}
+func (*ImportPayoutStatusReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{237}
 }
-func (m *ListOrdersPublicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOrdersPublicResponse.Marshal(b, m, deterministic)
+func (m *ImportPayoutStatusReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPayoutStatusReportRequest.Unmarshal(m, b)
 }
-func (dst *ListOrdersPublicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOrdersPublicResponse.Merge(dst, src)
+func (m *ImportPayoutStatusReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPayoutStatusReportRequest.Marshal(b, m, deterministic)
 }
-func (m *ListOrdersPublicResponse) XXX_Size() int {
-	return xxx_messageInfo_ListOrdersPublicResponse.Size(m)
+func (dst *ImportPayoutStatusReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPayoutStatusReportRequest.Merge(dst, src)
 }
-func (m *ListOrdersPublicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOrdersPublicResponse.DiscardUnknown(m)
+func (m *ImportPayoutStatusReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportPayoutStatusReportRequest.Size(m)
+}
+func (m *ImportPayoutStatusReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPayoutStatusReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOrdersPublicResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImportPayoutStatusReportRequest proto.InternalMessageInfo
 
-func (m *ListOrdersPublicResponse) GetStatus() int32 {
+func (m *ImportPayoutStatusReportRequest) GetFileName() string {
 	if m != nil {
-		return m.Status
+		return m.FileName
 	}
-	return 0
+	return ""
 }
 
-func (m *ListOrdersPublicResponse) GetMessage() *ResponseErrorMessage {
+func (m *ImportPayoutStatusReportRequest) GetContent() []byte {
 	if m != nil {
-		return m.Message
+		return m.Content
 	}
 	return nil
 }
 
-func (m *ListOrdersPublicResponse) GetItem() *ListOrdersPublicResponseItem {
+func (m *ImportPayoutStatusReportRequest) GetIp() string {
 	if m != nil {
-		return m.Item
+		return m.Ip
 	}
-	return nil
+	return ""
 }
 
-type ListOrdersPrivateResponse struct {
-	Status               int32                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *ListOrdersPrivateResponseItem `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+type ImportPayoutStatusReportResponse struct {
+	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"paid"
+	Paid int32 `protobuf:"varint,3,opt,name=paid,proto3" json:"paid"`
+	// @inject_tag: json:"failed"
+	Failed int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed"`
+	// @inject_tag: json:"skipped"
+	Skipped int32 `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped"`
+	// @inject_tag: json:"unmatched"
+	Unmatched            []string `protobuf:"bytes,6,rep,name=unmatched,proto3" json:"unmatched"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListOrdersPrivateResponse) Reset()         { *m = ListOrdersPrivateResponse{} }
-func (m *ListOrdersPrivateResponse) String() string { return proto.CompactTextString(m) }
-func (*ListOrdersPrivateResponse) ProtoMessage()    {}
-func (*ListOrdersPrivateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{175}
+func (m *ImportPayoutStatusReportResponse) Reset()         { *m = ImportPayoutStatusReportResponse{} }
+func (m *ImportPayoutStatusReportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportPayoutStatusReportResponse) ProtoMessage()    {}
+func (*ImportPayoutStatusReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{238}
 }
-func (m *ListOrdersPrivateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOrdersPrivateResponse.Unmarshal(m, b)
+func (m *ImportPayoutStatusReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPayoutStatusReportResponse.Unmarshal(m, b)
 }
-func (m *ListOrdersPrivateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOrdersPrivateResponse.Marshal(b, m, deterministic)
+func (m *ImportPayoutStatusReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPayoutStatusReportResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListOrdersPrivateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOrdersPrivateResponse.Merge(dst, src)
+func (dst *ImportPayoutStatusReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPayoutStatusReportResponse.Merge(dst, src)
 }
-func (m *ListOrdersPrivateResponse) XXX_Size() int {
-	return xxx_messageInfo_ListOrdersPrivateResponse.Size(m)
+func (m *ImportPayoutStatusReportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportPayoutStatusReportResponse.Size(m)
 }
-func (m *ListOrdersPrivateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOrdersPrivateResponse.DiscardUnknown(m)
+func (m *ImportPayoutStatusReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPayoutStatusReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOrdersPrivateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImportPayoutStatusReportResponse proto.InternalMessageInfo
 
-func (m *ListOrdersPrivateResponse) GetStatus() int32 {
+func (m *ImportPayoutStatusReportResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *ListOrdersPrivateResponse) GetMessage() *ResponseErrorMessage {
+func (m *ImportPayoutStatusReportResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *ListOrdersPrivateResponse) GetItem() *ListOrdersPrivateResponseItem {
+func (m *ImportPayoutStatusReportResponse) GetPaid() int32 {
 	if m != nil {
-		return m.Item
+		return m.Paid
+	}
+	return 0
+}
+
+func (m *ImportPayoutStatusReportResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportPayoutStatusReportResponse) GetSkipped() int32 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *ImportPayoutStatusReportResponse) GetUnmatched() []string {
+	if m != nil {
+		return m.Unmatched
 	}
 	return nil
 }
 
-type ListOrdersResponseItem struct {
-	Count                int32            `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Items                []*billing.Order `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32            `json:"-" bson:"-" structure:"-" validate:"-"`
+type OrderReceiptRequest struct {
+	// @inject_tag: validate:"required,uuid"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	// @inject_tag: validate:"required,uuid"
+	ReceiptId            string   `protobuf:"bytes,2,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty" validate:"required,uuid"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListOrdersResponseItem) Reset()         { *m = ListOrdersResponseItem{} }
-func (m *ListOrdersResponseItem) String() string { return proto.CompactTextString(m) }
-func (*ListOrdersResponseItem) ProtoMessage()    {}
-func (*ListOrdersResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{176}
+func (m *OrderReceiptRequest) Reset()         { *m = OrderReceiptRequest{} }
+func (m *OrderReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderReceiptRequest) ProtoMessage()    {}
+func (*OrderReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{239}
 }
-func (m *ListOrdersResponseItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOrdersResponseItem.Unmarshal(m, b)
+func (m *OrderReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderReceiptRequest.Unmarshal(m, b)
 }
-func (m *ListOrdersResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOrdersResponseItem.Marshal(b, m, deterministic)
+func (m *OrderReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderReceiptRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListOrdersResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOrdersResponseItem.Merge(dst, src)
+func (dst *OrderReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReceiptRequest.Merge(dst, src)
 }
-func (m *ListOrdersResponseItem) XXX_Size() int {
-	return xxx_messageInfo_ListOrdersResponseItem.Size(m)
+func (m *OrderReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderReceiptRequest.Size(m)
 }
-func (m *ListOrdersResponseItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOrdersResponseItem.DiscardUnknown(m)
+func (m *OrderReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReceiptRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOrdersResponseItem proto.InternalMessageInfo
+var xxx_messageInfo_OrderReceiptRequest proto.InternalMessageInfo
 
-func (m *ListOrdersResponseItem) GetCount() int32 {
+func (m *OrderReceiptRequest) GetOrderId() string {
 	if m != nil {
-		return m.Count
+		return m.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (m *ListOrdersResponseItem) GetItems() []*billing.Order {
+func (m *OrderReceiptRequest) GetReceiptId() string {
 	if m != nil {
-		return m.Items
+		return m.ReceiptId
 	}
-	return nil
+	return ""
 }
 
-type ListOrdersResponse struct {
-	Status               int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *ListOrdersResponseItem `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+type OrderReceiptResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Receipt              *billing.OrderReceipt `protobuf:"bytes,3,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ListOrdersResponse) Reset()         { *m = ListOrdersResponse{} }
-func (m *ListOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*ListOrdersResponse) ProtoMessage()    {}
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{177}
+func (m *OrderReceiptResponse) Reset()         { *m = OrderReceiptResponse{} }
+func (m *OrderReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderReceiptResponse) ProtoMessage()    {}
+func (*OrderReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{240}
 }
-func (m *ListOrdersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListOrdersResponse.Unmarshal(m, b)
+func (m *OrderReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderReceiptResponse.Unmarshal(m, b)
 }
-func (m *ListOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListOrdersResponse.Marshal(b, m, deterministic)
+func (m *OrderReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderReceiptResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListOrdersResponse.Merge(dst, src)
+func (dst *OrderReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReceiptResponse.Merge(dst, src)
 }
-func (m *ListOrdersResponse) XXX_Size() int {
-	return xxx_messageInfo_ListOrdersResponse.Size(m)
+func (m *OrderReceiptResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderReceiptResponse.Size(m)
 }
-func (m *ListOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListOrdersResponse.DiscardUnknown(m)
+func (m *OrderReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReceiptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_OrderReceiptResponse proto.InternalMessageInfo
 
-func (m *ListOrdersResponse) GetStatus() int32 {
+func (m *OrderReceiptResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *ListOrdersResponse) GetMessage() *ResponseErrorMessage {
+func (m *OrderReceiptResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *ListOrdersResponse) GetItem() *ListOrdersResponseItem {
+func (m *OrderReceiptResponse) GetReceipt() *billing.OrderReceipt {
 	if m != nil {
-		return m.Item
+		return m.Receipt
 	}
 	return nil
 }
 
-type GetOrderPublicResponse struct {
-	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *billing.OrderViewPublic `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetProductResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *Product              `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetOrderPublicResponse) Reset()         { *m = GetOrderPublicResponse{} }
-func (m *GetOrderPublicResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOrderPublicResponse) ProtoMessage()    {}
-func (*GetOrderPublicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{178}
+func (m *GetProductResponse) Reset()         { *m = GetProductResponse{} }
+func (m *GetProductResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProductResponse) ProtoMessage()    {}
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{241}
 }
-func (m *GetOrderPublicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOrderPublicResponse.Unmarshal(m, b)
+func (m *GetProductResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProductResponse.Unmarshal(m, b)
 }
-func (m *GetOrderPublicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOrderPublicResponse.Marshal(b, m, deterministic)
+func (m *GetProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProductResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetOrderPublicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOrderPublicResponse.Merge(dst, src)
+func (dst *GetProductResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProductResponse.Merge(dst, src)
 }
-func (m *GetOrderPublicResponse) XXX_Size() int {
-	return xxx_messageInfo_GetOrderPublicResponse.Size(m)
+func (m *GetProductResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProductResponse.Size(m)
 }
-func (m *GetOrderPublicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOrderPublicResponse.DiscardUnknown(m)
+func (m *GetProductResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProductResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOrderPublicResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetProductResponse proto.InternalMessageInfo
 
-func (m *GetOrderPublicResponse) GetStatus() int32 {
+func (m *GetProductResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetOrderPublicResponse) GetMessage() *ResponseErrorMessage {
+func (m *GetProductResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetOrderPublicResponse) GetItem() *billing.OrderViewPublic {
+func (m *GetProductResponse) GetItem() *Product {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type GetOrderPrivateResponse struct {
-	Status               int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *billing.OrderViewPrivate `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPriceGroupByRegionResponse struct {
+	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"group"
+	Group                *billing.PriceGroup `protobuf:"bytes,3,opt,name=Group,proto3" json:"group"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetOrderPrivateResponse) Reset()         { *m = GetOrderPrivateResponse{} }
-func (m *GetOrderPrivateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOrderPrivateResponse) ProtoMessage()    {}
-func (*GetOrderPrivateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{179}
+func (m *GetPriceGroupByRegionResponse) Reset()         { *m = GetPriceGroupByRegionResponse{} }
+func (m *GetPriceGroupByRegionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceGroupByRegionResponse) ProtoMessage()    {}
+func (*GetPriceGroupByRegionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{242}
 }
-func (m *GetOrderPrivateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOrderPrivateResponse.Unmarshal(m, b)
+func (m *GetPriceGroupByRegionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceGroupByRegionResponse.Unmarshal(m, b)
 }
-func (m *GetOrderPrivateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOrderPrivateResponse.Marshal(b, m, deterministic)
+func (m *GetPriceGroupByRegionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceGroupByRegionResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetOrderPrivateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOrderPrivateResponse.Merge(dst, src)
+func (dst *GetPriceGroupByRegionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceGroupByRegionResponse.Merge(dst, src)
 }
-func (m *GetOrderPrivateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetOrderPrivateResponse.Size(m)
+func (m *GetPriceGroupByRegionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPriceGroupByRegionResponse.Size(m)
 }
-func (m *GetOrderPrivateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOrderPrivateResponse.DiscardUnknown(m)
+func (m *GetPriceGroupByRegionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceGroupByRegionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOrderPrivateResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPriceGroupByRegionResponse proto.InternalMessageInfo
 
-func (m *GetOrderPrivateResponse) GetStatus() int32 {
+func (m *GetPriceGroupByRegionResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetOrderPrivateResponse) GetMessage() *ResponseErrorMessage {
+func (m *GetPriceGroupByRegionResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetOrderPrivateResponse) GetItem() *billing.OrderViewPrivate {
+func (m *GetPriceGroupByRegionResponse) GetGroup() *billing.PriceGroup {
 	if m != nil {
-		return m.Item
+		return m.Group
 	}
 	return nil
 }
 
-type GetDashboardMainRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: query:"period" validate:"required,oneof=current_month previous_month current_quarter previous_quarter current_year previous_year"
-	Period               string   `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty" query:"period" validate:"required,oneof=current_month previous_month current_quarter previous_quarter current_year previous_year"`
+type GetPriceGroupByRegionRequest struct {
+	// @inject_tag: json:"region" validate:"required"
+	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region" validate:"required"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetDashboardMainRequest) Reset()         { *m = GetDashboardMainRequest{} }
-func (m *GetDashboardMainRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDashboardMainRequest) ProtoMessage()    {}
-func (*GetDashboardMainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{180}
+func (m *GetPriceGroupByRegionRequest) Reset()         { *m = GetPriceGroupByRegionRequest{} }
+func (m *GetPriceGroupByRegionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceGroupByRegionRequest) ProtoMessage()    {}
+func (*GetPriceGroupByRegionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{243}
 }
-func (m *GetDashboardMainRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDashboardMainRequest.Unmarshal(m, b)
+func (m *GetPriceGroupByRegionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceGroupByRegionRequest.Unmarshal(m, b)
 }
-func (m *GetDashboardMainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDashboardMainRequest.Marshal(b, m, deterministic)
+func (m *GetPriceGroupByRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceGroupByRegionRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetDashboardMainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDashboardMainRequest.Merge(dst, src)
+func (dst *GetPriceGroupByRegionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceGroupByRegionRequest.Merge(dst, src)
 }
-func (m *GetDashboardMainRequest) XXX_Size() int {
-	return xxx_messageInfo_GetDashboardMainRequest.Size(m)
+func (m *GetPriceGroupByRegionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPriceGroupByRegionRequest.Size(m)
 }
-func (m *GetDashboardMainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDashboardMainRequest.DiscardUnknown(m)
+func (m *GetPriceGroupByRegionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceGroupByRegionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDashboardMainRequest proto.InternalMessageInfo
-
-func (m *GetDashboardMainRequest) GetMerchantId() string {
-	if m != nil {
-		return m.MerchantId
-	}
-	return ""
-}
+var xxx_messageInfo_GetPriceGroupByRegionRequest proto.InternalMessageInfo
 
-func (m *GetDashboardMainRequest) GetPeriod() string {
+func (m *GetPriceGroupByRegionRequest) GetRegion() string {
 	if m != nil {
-		return m.Period
+		return m.Region
 	}
 	return ""
 }
 
-type GetDashboardMainResponse struct {
-	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *DashboardMainReport  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+type ChangeMerchantManualPayoutsRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	ManualPayoutsEnabled bool     `protobuf:"varint,2,opt,name=manual_payouts_enabled,json=manualPayoutsEnabled,proto3" json:"manual_payouts_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetDashboardMainResponse) Reset()         { *m = GetDashboardMainResponse{} }
-func (m *GetDashboardMainResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDashboardMainResponse) ProtoMessage()    {}
-func (*GetDashboardMainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{181}
+func (m *ChangeMerchantManualPayoutsRequest) Reset()         { *m = ChangeMerchantManualPayoutsRequest{} }
+func (m *ChangeMerchantManualPayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeMerchantManualPayoutsRequest) ProtoMessage()    {}
+func (*ChangeMerchantManualPayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{244}
 }
-func (m *GetDashboardMainResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDashboardMainResponse.Unmarshal(m, b)
+func (m *ChangeMerchantManualPayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Unmarshal(m, b)
 }
-func (m *GetDashboardMainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDashboardMainResponse.Marshal(b, m, deterministic)
+func (m *ChangeMerchantManualPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetDashboardMainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDashboardMainResponse.Merge(dst, src)
+func (dst *ChangeMerchantManualPayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Merge(dst, src)
 }
-func (m *GetDashboardMainResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDashboardMainResponse.Size(m)
+func (m *ChangeMerchantManualPayoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Size(m)
 }
-func (m *GetDashboardMainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDashboardMainResponse.DiscardUnknown(m)
+func (m *ChangeMerchantManualPayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeMerchantManualPayoutsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDashboardMainResponse proto.InternalMessageInfo
-
-func (m *GetDashboardMainResponse) GetStatus() int32 {
-	if m != nil {
-		return m.Status
-	}
-	return 0
-}
+var xxx_messageInfo_ChangeMerchantManualPayoutsRequest proto.InternalMessageInfo
 
-func (m *GetDashboardMainResponse) GetMessage() *ResponseErrorMessage {
+func (m *ChangeMerchantManualPayoutsRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Message
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetDashboardMainResponse) GetItem() *DashboardMainReport {
+func (m *ChangeMerchantManualPayoutsRequest) GetManualPayoutsEnabled() bool {
 	if m != nil {
-		return m.Item
+		return m.ManualPayoutsEnabled
 	}
-	return nil
+	return false
 }
 
-type GetDashboardBaseReportRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: query:"period" validate:"required,oneof=current_day previous_day current_week previous_week current_month previous_month current_quarter previous_quarter current_year previous_year"
-	Period               string   `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty" query:"period" validate:"required,oneof=current_day previous_day current_week previous_week current_month previous_month current_quarter previous_quarter current_year previous_year"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type ChangeMerchantManualPayoutsResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Merchant     `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetDashboardBaseReportRequest) Reset()         { *m = GetDashboardBaseReportRequest{} }
-func (m *GetDashboardBaseReportRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDashboardBaseReportRequest) ProtoMessage()    {}
-func (*GetDashboardBaseReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{182}
+func (m *ChangeMerchantManualPayoutsResponse) Reset()         { *m = ChangeMerchantManualPayoutsResponse{} }
+func (m *ChangeMerchantManualPayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeMerchantManualPayoutsResponse) ProtoMessage()    {}
+func (*ChangeMerchantManualPayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{245}
 }
-func (m *GetDashboardBaseReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDashboardBaseReportRequest.Unmarshal(m, b)
+func (m *ChangeMerchantManualPayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Unmarshal(m, b)
 }
-func (m *GetDashboardBaseReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDashboardBaseReportRequest.Marshal(b, m, deterministic)
+func (m *ChangeMerchantManualPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetDashboardBaseReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDashboardBaseReportRequest.Merge(dst, src)
+func (dst *ChangeMerchantManualPayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Merge(dst, src)
 }
-func (m *GetDashboardBaseReportRequest) XXX_Size() int {
-	return xxx_messageInfo_GetDashboardBaseReportRequest.Size(m)
+func (m *ChangeMerchantManualPayoutsResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Size(m)
 }
-func (m *GetDashboardBaseReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDashboardBaseReportRequest.DiscardUnknown(m)
+func (m *ChangeMerchantManualPayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeMerchantManualPayoutsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDashboardBaseReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangeMerchantManualPayoutsResponse proto.InternalMessageInfo
 
-func (m *GetDashboardBaseReportRequest) GetMerchantId() string {
+func (m *ChangeMerchantManualPayoutsResponse) GetStatus() int32 {
 	if m != nil {
-		return m.MerchantId
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *GetDashboardBaseReportRequest) GetPeriod() string {
+func (m *ChangeMerchantManualPayoutsResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Period
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-type GetDashboardBaseReportResponse struct {
-	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *DashboardBaseReports `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ChangeMerchantManualPayoutsResponse) GetItem() *billing.Merchant {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *GetDashboardBaseReportResponse) Reset()         { *m = GetDashboardBaseReportResponse{} }
-func (m *GetDashboardBaseReportResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDashboardBaseReportResponse) ProtoMessage()    {}
-func (*GetDashboardBaseReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{183}
+type ChangeMerchantPayoutCurrenciesRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,dive,alpha,len=3"
+	PayoutCurrencies []string `protobuf:"bytes,2,rep,name=payout_currencies,json=payoutCurrencies,proto3" json:"payout_currencies,omitempty" validate:"omitempty,dive,alpha,len=3"`
+	// @inject_tag: validate:"omitempty,dive"
+	PayoutCurrencyRules  []*billing.MerchantPayoutCurrencyRule `protobuf:"bytes,3,rep,name=payout_currency_rules,json=payoutCurrencyRules,proto3" json:"payout_currency_rules,omitempty" validate:"omitempty,dive"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *GetDashboardBaseReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDashboardBaseReportResponse.Unmarshal(m, b)
+
+func (m *ChangeMerchantPayoutCurrenciesRequest) Reset()         { *m = ChangeMerchantPayoutCurrenciesRequest{} }
+func (m *ChangeMerchantPayoutCurrenciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeMerchantPayoutCurrenciesRequest) ProtoMessage()    {}
+func (*ChangeMerchantPayoutCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{246}
 }
-func (m *GetDashboardBaseReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDashboardBaseReportResponse.Marshal(b, m, deterministic)
+func (m *ChangeMerchantPayoutCurrenciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeMerchantPayoutCurrenciesRequest.Unmarshal(m, b)
 }
-func (dst *GetDashboardBaseReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDashboardBaseReportResponse.Merge(dst, src)
+func (m *ChangeMerchantPayoutCurrenciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeMerchantPayoutCurrenciesRequest.Marshal(b, m, deterministic)
 }
-func (m *GetDashboardBaseReportResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDashboardBaseReportResponse.Size(m)
+func (dst *ChangeMerchantPayoutCurrenciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMerchantPayoutCurrenciesRequest.Merge(dst, src)
 }
-func (m *GetDashboardBaseReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDashboardBaseReportResponse.DiscardUnknown(m)
+func (m *ChangeMerchantPayoutCurrenciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeMerchantPayoutCurrenciesRequest.Size(m)
+}
+func (m *ChangeMerchantPayoutCurrenciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeMerchantPayoutCurrenciesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDashboardBaseReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChangeMerchantPayoutCurrenciesRequest proto.InternalMessageInfo
 
-func (m *GetDashboardBaseReportResponse) GetStatus() int32 {
+func (m *ChangeMerchantPayoutCurrenciesRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Status
+		return m.MerchantId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetDashboardBaseReportResponse) GetMessage() *ResponseErrorMessage {
+func (m *ChangeMerchantPayoutCurrenciesRequest) GetPayoutCurrencies() []string {
 	if m != nil {
-		return m.Message
+		return m.PayoutCurrencies
 	}
 	return nil
 }
 
-func (m *GetDashboardBaseReportResponse) GetItem() *DashboardBaseReports {
+func (m *ChangeMerchantPayoutCurrenciesRequest) GetPayoutCurrencyRules() []*billing.MerchantPayoutCurrencyRule {
 	if m != nil {
-		return m.Item
+		return m.PayoutCurrencyRules
 	}
 	return nil
 }
 
-type GetDashboardRevenueDynamicsReportResponse struct {
-	Status               int32                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *DashboardRevenueDynamicReport `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+type ChangeMerchantPayoutCurrenciesResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Merchant     `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetDashboardRevenueDynamicsReportResponse) Reset() {
-	*m = GetDashboardRevenueDynamicsReportResponse{}
+func (m *ChangeMerchantPayoutCurrenciesResponse) Reset() {
+	*m = ChangeMerchantPayoutCurrenciesResponse{}
 }
-func (m *GetDashboardRevenueDynamicsReportResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDashboardRevenueDynamicsReportResponse) ProtoMessage()    {}
-func (*GetDashboardRevenueDynamicsReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{184}
+func (m *ChangeMerchantPayoutCurrenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeMerchantPayoutCurrenciesResponse) ProtoMessage()    {}
+func (*ChangeMerchantPayoutCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{247}
 }
-func (m *GetDashboardRevenueDynamicsReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Unmarshal(m, b)
+func (m *ChangeMerchantPayoutCurrenciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeMerchantPayoutCurrenciesResponse.Unmarshal(m, b)
 }
-func (m *GetDashboardRevenueDynamicsReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Marshal(b, m, deterministic)
+func (m *ChangeMerchantPayoutCurrenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeMerchantPayoutCurrenciesResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetDashboardRevenueDynamicsReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Merge(dst, src)
+func (dst *ChangeMerchantPayoutCurrenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMerchantPayoutCurrenciesResponse.Merge(dst, src)
 }
-func (m *GetDashboardRevenueDynamicsReportResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.Size(m)
+func (m *ChangeMerchantPayoutCurrenciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangeMerchantPayoutCurrenciesResponse.Size(m)
 }
-func (m *GetDashboardRevenueDynamicsReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse.DiscardUnknown(m)
+func (m *ChangeMerchantPayoutCurrenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeMerchantPayoutCurrenciesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDashboardRevenueDynamicsReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChangeMerchantPayoutCurrenciesResponse proto.InternalMessageInfo
 
-func (m *GetDashboardRevenueDynamicsReportResponse) GetStatus() int32 {
+func (m *ChangeMerchantPayoutCurrenciesResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetDashboardRevenueDynamicsReportResponse) GetMessage() *ResponseErrorMessage {
+func (m *ChangeMerchantPayoutCurrenciesResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetDashboardRevenueDynamicsReportResponse) GetItem() *DashboardRevenueDynamicReport {
+func (m *ChangeMerchantPayoutCurrenciesResponse) GetItem() *billing.Merchant {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type DashboardAmountItemWithChart struct {
-	// @inject_tag: json:"amount_current"
-	AmountCurrent float64 `protobuf:"fixed64,1,opt,name=amount_current,json=amountCurrent,proto3" json:"amount_current"`
-	// @inject_tag: json:"amount_previous"
-	AmountPrevious float64 `protobuf:"fixed64,2,opt,name=amount_previous,json=amountPrevious,proto3" json:"amount_previous"`
-	// @inject_tag: json:"currency"
-	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency"`
-	// @inject_tag: json:"chart"
-	Chart                []*DashboardChartItemFloat `protobuf:"bytes,4,rep,name=chart,proto3" json:"chart"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPaylinksRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,numeric,gte=1"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" validate:"required,numeric,gte=1"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardAmountItemWithChart) Reset()         { *m = DashboardAmountItemWithChart{} }
-func (m *DashboardAmountItemWithChart) String() string { return proto.CompactTextString(m) }
-func (*DashboardAmountItemWithChart) ProtoMessage()    {}
-func (*DashboardAmountItemWithChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{185}
+func (m *GetPaylinksRequest) Reset()         { *m = GetPaylinksRequest{} }
+func (m *GetPaylinksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinksRequest) ProtoMessage()    {}
+func (*GetPaylinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{248}
 }
-func (m *DashboardAmountItemWithChart) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardAmountItemWithChart.Unmarshal(m, b)
+func (m *GetPaylinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinksRequest.Unmarshal(m, b)
 }
-func (m *DashboardAmountItemWithChart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardAmountItemWithChart.Marshal(b, m, deterministic)
+func (m *GetPaylinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinksRequest.Marshal(b, m, deterministic)
 }
-func (dst *DashboardAmountItemWithChart) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardAmountItemWithChart.Merge(dst, src)
+func (dst *GetPaylinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinksRequest.Merge(dst, src)
 }
-func (m *DashboardAmountItemWithChart) XXX_Size() int {
-	return xxx_messageInfo_DashboardAmountItemWithChart.Size(m)
+func (m *GetPaylinksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinksRequest.Size(m)
 }
-func (m *DashboardAmountItemWithChart) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardAmountItemWithChart.DiscardUnknown(m)
+func (m *GetPaylinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardAmountItemWithChart proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinksRequest proto.InternalMessageInfo
 
-func (m *DashboardAmountItemWithChart) GetAmountCurrent() float64 {
+func (m *GetPaylinksRequest) GetMerchantId() string {
 	if m != nil {
-		return m.AmountCurrent
+		return m.MerchantId
 	}
-	return 0
+	return ""
 }
 
-func (m *DashboardAmountItemWithChart) GetAmountPrevious() float64 {
+func (m *GetPaylinksRequest) GetProjectId() string {
 	if m != nil {
-		return m.AmountPrevious
+		return m.ProjectId
 	}
-	return 0
+	return ""
 }
 
-func (m *DashboardAmountItemWithChart) GetCurrency() string {
+func (m *GetPaylinksRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Currency
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-func (m *DashboardAmountItemWithChart) GetChart() []*DashboardChartItemFloat {
+func (m *GetPaylinksRequest) GetOffset() int32 {
 	if m != nil {
-		return m.Chart
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-type DashboardChartItemFloat struct {
-	// @inject_tag: json:"label" bson:"label"
-	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label" bson:"label"`
-	// @inject_tag: json:"value" bson:"value"
-	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value" bson:"value"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type PaylinksPaginate struct {
+	// @inject_tag: json:"count"
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	// @inject_tag: json:"items"
+	Items                []*paylink.Paylink `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardChartItemFloat) Reset()         { *m = DashboardChartItemFloat{} }
-func (m *DashboardChartItemFloat) String() string { return proto.CompactTextString(m) }
-func (*DashboardChartItemFloat) ProtoMessage()    {}
-func (*DashboardChartItemFloat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{186}
+func (m *PaylinksPaginate) Reset()         { *m = PaylinksPaginate{} }
+func (m *PaylinksPaginate) String() string { return proto.CompactTextString(m) }
+func (*PaylinksPaginate) ProtoMessage()    {}
+func (*PaylinksPaginate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{249}
 }
-func (m *DashboardChartItemFloat) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardChartItemFloat.Unmarshal(m, b)
+func (m *PaylinksPaginate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaylinksPaginate.Unmarshal(m, b)
 }
-func (m *DashboardChartItemFloat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardChartItemFloat.Marshal(b, m, deterministic)
+func (m *PaylinksPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaylinksPaginate.Marshal(b, m, deterministic)
 }
-func (dst *DashboardChartItemFloat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardChartItemFloat.Merge(dst, src)
+func (dst *PaylinksPaginate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaylinksPaginate.Merge(dst, src)
 }
-func (m *DashboardChartItemFloat) XXX_Size() int {
-	return xxx_messageInfo_DashboardChartItemFloat.Size(m)
+func (m *PaylinksPaginate) XXX_Size() int {
+	return xxx_messageInfo_PaylinksPaginate.Size(m)
 }
-func (m *DashboardChartItemFloat) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardChartItemFloat.DiscardUnknown(m)
+func (m *PaylinksPaginate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaylinksPaginate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardChartItemFloat proto.InternalMessageInfo
+var xxx_messageInfo_PaylinksPaginate proto.InternalMessageInfo
 
-func (m *DashboardChartItemFloat) GetLabel() int64 {
+func (m *PaylinksPaginate) GetCount() int32 {
 	if m != nil {
-		return m.Label
+		return m.Count
 	}
 	return 0
 }
 
-func (m *DashboardChartItemFloat) GetValue() float64 {
+func (m *PaylinksPaginate) GetItems() []*paylink.Paylink {
 	if m != nil {
-		return m.Value
+		return m.Items
 	}
-	return 0
+	return nil
 }
-
-type DashboardMainReportTotalTransactions struct {
-	// @inject_tag: json:"count_current" bson:"count"
-	CountCurrent int64 `protobuf:"varint,1,opt,name=count_current,json=countCurrent,proto3" json:"count_current" bson:"count"`
-	// @inject_tag: json:"count_previous"
-	CountPrevious int64 `protobuf:"varint,2,opt,name=count_previous,json=countPrevious,proto3" json:"count_previous"`
-	// @inject_tag: json:"chart"
-	Chart                []*DashboardChartItemFloat `protobuf:"bytes,3,rep,name=chart,proto3" json:"chart"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+
+type GetPaylinksResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"data"
+	Data                 *PaylinksPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardMainReportTotalTransactions) Reset()         { *m = DashboardMainReportTotalTransactions{} }
-func (m *DashboardMainReportTotalTransactions) String() string { return proto.CompactTextString(m) }
-func (*DashboardMainReportTotalTransactions) ProtoMessage()    {}
-func (*DashboardMainReportTotalTransactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{187}
+func (m *GetPaylinksResponse) Reset()         { *m = GetPaylinksResponse{} }
+func (m *GetPaylinksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinksResponse) ProtoMessage()    {}
+func (*GetPaylinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{250}
 }
-func (m *DashboardMainReportTotalTransactions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardMainReportTotalTransactions.Unmarshal(m, b)
+func (m *GetPaylinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinksResponse.Unmarshal(m, b)
 }
-func (m *DashboardMainReportTotalTransactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardMainReportTotalTransactions.Marshal(b, m, deterministic)
+func (m *GetPaylinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinksResponse.Marshal(b, m, deterministic)
 }
-func (dst *DashboardMainReportTotalTransactions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardMainReportTotalTransactions.Merge(dst, src)
+func (dst *GetPaylinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinksResponse.Merge(dst, src)
 }
-func (m *DashboardMainReportTotalTransactions) XXX_Size() int {
-	return xxx_messageInfo_DashboardMainReportTotalTransactions.Size(m)
+func (m *GetPaylinksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinksResponse.Size(m)
 }
-func (m *DashboardMainReportTotalTransactions) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardMainReportTotalTransactions.DiscardUnknown(m)
+func (m *GetPaylinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardMainReportTotalTransactions proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinksResponse proto.InternalMessageInfo
 
-func (m *DashboardMainReportTotalTransactions) GetCountCurrent() int64 {
+func (m *GetPaylinksResponse) GetStatus() int32 {
 	if m != nil {
-		return m.CountCurrent
+		return m.Status
 	}
 	return 0
 }
 
-func (m *DashboardMainReportTotalTransactions) GetCountPrevious() int64 {
+func (m *GetPaylinksResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.CountPrevious
+		return m.Message
 	}
-	return 0
+	return nil
 }
 
-func (m *DashboardMainReportTotalTransactions) GetChart() []*DashboardChartItemFloat {
+func (m *GetPaylinksResponse) GetData() *PaylinksPaginate {
 	if m != nil {
-		return m.Chart
+		return m.Data
 	}
 	return nil
 }
 
-type DashboardMainReport struct {
-	// @inject_tag: json:"gross_revenue" bson:"gross_revenue"
-	GrossRevenue *DashboardAmountItemWithChart `protobuf:"bytes,1,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue" bson:"gross_revenue"`
-	// @inject_tag: json:"vat" bson:"vat"
-	Vat *DashboardAmountItemWithChart `protobuf:"bytes,2,opt,name=vat,proto3" json:"vat" bson:"vat"`
-	// @inject_tag: json:"total_transactions" bson:"total_transactions"
-	TotalTransactions *DashboardMainReportTotalTransactions `protobuf:"bytes,3,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions" bson:"total_transactions"`
-	// @inject_tag: json:"arpu" bson:"arpu"
-	Arpu                 *DashboardAmountItemWithChart `protobuf:"bytes,4,opt,name=arpu,proto3" json:"arpu" bson:"arpu"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                        `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                         `json:"-" bson:"-" structure:"-" validate:"-"`
+type PaylinkRequestById struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardMainReport) Reset()         { *m = DashboardMainReport{} }
-func (m *DashboardMainReport) String() string { return proto.CompactTextString(m) }
-func (*DashboardMainReport) ProtoMessage()    {}
-func (*DashboardMainReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{188}
+func (m *PaylinkRequestById) Reset()         { *m = PaylinkRequestById{} }
+func (m *PaylinkRequestById) String() string { return proto.CompactTextString(m) }
+func (*PaylinkRequestById) ProtoMessage()    {}
+func (*PaylinkRequestById) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{251}
 }
-func (m *DashboardMainReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardMainReport.Unmarshal(m, b)
+func (m *PaylinkRequestById) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaylinkRequestById.Unmarshal(m, b)
 }
-func (m *DashboardMainReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardMainReport.Marshal(b, m, deterministic)
+func (m *PaylinkRequestById) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaylinkRequestById.Marshal(b, m, deterministic)
 }
-func (dst *DashboardMainReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardMainReport.Merge(dst, src)
+func (dst *PaylinkRequestById) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaylinkRequestById.Merge(dst, src)
 }
-func (m *DashboardMainReport) XXX_Size() int {
-	return xxx_messageInfo_DashboardMainReport.Size(m)
+func (m *PaylinkRequestById) XXX_Size() int {
+	return xxx_messageInfo_PaylinkRequestById.Size(m)
 }
-func (m *DashboardMainReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardMainReport.DiscardUnknown(m)
+func (m *PaylinkRequestById) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaylinkRequestById.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardMainReport proto.InternalMessageInfo
+var xxx_messageInfo_PaylinkRequestById proto.InternalMessageInfo
 
-func (m *DashboardMainReport) GetGrossRevenue() *DashboardAmountItemWithChart {
+func (m *PaylinkRequestById) GetId() string {
 	if m != nil {
-		return m.GrossRevenue
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *DashboardMainReport) GetVat() *DashboardAmountItemWithChart {
-	if m != nil {
-		return m.Vat
-	}
-	return nil
+type PaylinkRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardMainReport) GetTotalTransactions() *DashboardMainReportTotalTransactions {
+func (m *PaylinkRequest) Reset()         { *m = PaylinkRequest{} }
+func (m *PaylinkRequest) String() string { return proto.CompactTextString(m) }
+func (*PaylinkRequest) ProtoMessage()    {}
+func (*PaylinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{252}
+}
+func (m *PaylinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaylinkRequest.Unmarshal(m, b)
+}
+func (m *PaylinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaylinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *PaylinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaylinkRequest.Merge(dst, src)
+}
+func (m *PaylinkRequest) XXX_Size() int {
+	return xxx_messageInfo_PaylinkRequest.Size(m)
+}
+func (m *PaylinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaylinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaylinkRequest proto.InternalMessageInfo
+
+func (m *PaylinkRequest) GetId() string {
 	if m != nil {
-		return m.TotalTransactions
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *DashboardMainReport) GetArpu() *DashboardAmountItemWithChart {
+func (m *PaylinkRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Arpu
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-type DashboardRevenueDynamicReport struct {
-	// @inject_tag: json:"currency"
-	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
-	// @inject_tag: json:"items"
-	Items                []*DashboardRevenueDynamicReportItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPaylinkResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"item"
+	Item                 *paylink.Paylink `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32            `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardRevenueDynamicReport) Reset()         { *m = DashboardRevenueDynamicReport{} }
-func (m *DashboardRevenueDynamicReport) String() string { return proto.CompactTextString(m) }
-func (*DashboardRevenueDynamicReport) ProtoMessage()    {}
-func (*DashboardRevenueDynamicReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{189}
+func (m *GetPaylinkResponse) Reset()         { *m = GetPaylinkResponse{} }
+func (m *GetPaylinkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinkResponse) ProtoMessage()    {}
+func (*GetPaylinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{253}
 }
-func (m *DashboardRevenueDynamicReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardRevenueDynamicReport.Unmarshal(m, b)
+func (m *GetPaylinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinkResponse.Unmarshal(m, b)
 }
-func (m *DashboardRevenueDynamicReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardRevenueDynamicReport.Marshal(b, m, deterministic)
+func (m *GetPaylinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinkResponse.Marshal(b, m, deterministic)
 }
-func (dst *DashboardRevenueDynamicReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardRevenueDynamicReport.Merge(dst, src)
+func (dst *GetPaylinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinkResponse.Merge(dst, src)
 }
-func (m *DashboardRevenueDynamicReport) XXX_Size() int {
-	return xxx_messageInfo_DashboardRevenueDynamicReport.Size(m)
+func (m *GetPaylinkResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinkResponse.Size(m)
 }
-func (m *DashboardRevenueDynamicReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardRevenueDynamicReport.DiscardUnknown(m)
+func (m *GetPaylinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardRevenueDynamicReport proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinkResponse proto.InternalMessageInfo
 
-func (m *DashboardRevenueDynamicReport) GetCurrency() string {
+func (m *GetPaylinkResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Currency
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *DashboardRevenueDynamicReport) GetItems() []*DashboardRevenueDynamicReportItem {
+func (m *GetPaylinkResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Items
+		return m.Message
 	}
 	return nil
 }
 
-type DashboardRevenueDynamicReportItem struct {
-	// @inject_tag: json:"label"
-	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label"`
-	// @inject_tag: json:"amount"
-	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
-	// @inject_tag: json:"-"
-	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"-"`
-	// @inject_tag: json:"count"
-	Count                int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count"`
+func (m *GetPaylinkResponse) GetItem() *paylink.Paylink {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetPaylinkURLRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	UrlMask    string `protobuf:"bytes,3,opt,name=url_mask,json=urlMask,proto3" json:"url_mask,omitempty"`
+	// @inject_tag: query:"utm_source"
+	UtmSource string `protobuf:"bytes,4,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty" query:"utm_source"`
+	// @inject_tag: query:"utm_medium"
+	UtmMedium string `protobuf:"bytes,5,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty" query:"utm_medium"`
+	// @inject_tag: query:"utm_campaign"
+	UtmCampaign string `protobuf:"bytes,6,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty" query:"utm_campaign"`
+	// signed paylink token issued to buyer
+	// @inject_tag: query:"token"
+	Token                string   `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty" query:"token"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardRevenueDynamicReportItem) Reset()         { *m = DashboardRevenueDynamicReportItem{} }
-func (m *DashboardRevenueDynamicReportItem) String() string { return proto.CompactTextString(m) }
-func (*DashboardRevenueDynamicReportItem) ProtoMessage()    {}
-func (*DashboardRevenueDynamicReportItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{190}
+func (m *GetPaylinkURLRequest) Reset()         { *m = GetPaylinkURLRequest{} }
+func (m *GetPaylinkURLRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinkURLRequest) ProtoMessage()    {}
+func (*GetPaylinkURLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{254}
 }
-func (m *DashboardRevenueDynamicReportItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardRevenueDynamicReportItem.Unmarshal(m, b)
+func (m *GetPaylinkURLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinkURLRequest.Unmarshal(m, b)
 }
-func (m *DashboardRevenueDynamicReportItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardRevenueDynamicReportItem.Marshal(b, m, deterministic)
+func (m *GetPaylinkURLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinkURLRequest.Marshal(b, m, deterministic)
 }
-func (dst *DashboardRevenueDynamicReportItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardRevenueDynamicReportItem.Merge(dst, src)
+func (dst *GetPaylinkURLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinkURLRequest.Merge(dst, src)
 }
-func (m *DashboardRevenueDynamicReportItem) XXX_Size() int {
-	return xxx_messageInfo_DashboardRevenueDynamicReportItem.Size(m)
+func (m *GetPaylinkURLRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinkURLRequest.Size(m)
 }
-func (m *DashboardRevenueDynamicReportItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardRevenueDynamicReportItem.DiscardUnknown(m)
+func (m *GetPaylinkURLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinkURLRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardRevenueDynamicReportItem proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinkURLRequest proto.InternalMessageInfo
 
-func (m *DashboardRevenueDynamicReportItem) GetLabel() int64 {
+func (m *GetPaylinkURLRequest) GetId() string {
 	if m != nil {
-		return m.Label
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *DashboardRevenueDynamicReportItem) GetAmount() float64 {
+func (m *GetPaylinkURLRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Amount
+		return m.MerchantId
 	}
-	return 0
+	return ""
 }
 
-func (m *DashboardRevenueDynamicReportItem) GetCurrency() string {
+func (m *GetPaylinkURLRequest) GetUrlMask() string {
 	if m != nil {
-		return m.Currency
+		return m.UrlMask
 	}
 	return ""
 }
 
-func (m *DashboardRevenueDynamicReportItem) GetCount() int64 {
+func (m *GetPaylinkURLRequest) GetUtmSource() string {
 	if m != nil {
-		return m.Count
+		return m.UtmSource
 	}
-	return 0
-}
-
-type DashboardRevenueByCountryReportTop struct {
-	// @inject_tag: json:"country"
-	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country"`
-	// @inject_tag: json:"amount"
-	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	return ""
 }
 
-func (m *DashboardRevenueByCountryReportTop) Reset()         { *m = DashboardRevenueByCountryReportTop{} }
-func (m *DashboardRevenueByCountryReportTop) String() string { return proto.CompactTextString(m) }
-func (*DashboardRevenueByCountryReportTop) ProtoMessage()    {}
-func (*DashboardRevenueByCountryReportTop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{191}
-}
-func (m *DashboardRevenueByCountryReportTop) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardRevenueByCountryReportTop.Unmarshal(m, b)
-}
-func (m *DashboardRevenueByCountryReportTop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardRevenueByCountryReportTop.Marshal(b, m, deterministic)
-}
-func (dst *DashboardRevenueByCountryReportTop) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardRevenueByCountryReportTop.Merge(dst, src)
-}
-func (m *DashboardRevenueByCountryReportTop) XXX_Size() int {
-	return xxx_messageInfo_DashboardRevenueByCountryReportTop.Size(m)
-}
-func (m *DashboardRevenueByCountryReportTop) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardRevenueByCountryReportTop.DiscardUnknown(m)
+func (m *GetPaylinkURLRequest) GetUtmMedium() string {
+	if m != nil {
+		return m.UtmMedium
+	}
+	return ""
 }
 
-var xxx_messageInfo_DashboardRevenueByCountryReportTop proto.InternalMessageInfo
-
-func (m *DashboardRevenueByCountryReportTop) GetCountry() string {
+func (m *GetPaylinkURLRequest) GetUtmCampaign() string {
 	if m != nil {
-		return m.Country
+		return m.UtmCampaign
 	}
 	return ""
 }
 
-func (m *DashboardRevenueByCountryReportTop) GetAmount() float64 {
+func (m *GetPaylinkURLRequest) GetToken() string {
 	if m != nil {
-		return m.Amount
+		return m.Token
 	}
-	return 0
+	return ""
 }
 
-type DashboardRevenueByCountryReportChartItem struct {
-	// @inject_tag: json:"label"
-	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label"`
-	// @inject_tag: json:"amount"
-	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+type GetPaylinkUrlResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"url"
+	Url                  string   `protobuf:"bytes,4,opt,name=url,proto3" json:"url"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardRevenueByCountryReportChartItem) Reset() {
-	*m = DashboardRevenueByCountryReportChartItem{}
-}
-func (m *DashboardRevenueByCountryReportChartItem) String() string { return proto.CompactTextString(m) }
-func (*DashboardRevenueByCountryReportChartItem) ProtoMessage()    {}
-func (*DashboardRevenueByCountryReportChartItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{192}
+func (m *GetPaylinkUrlResponse) Reset()         { *m = GetPaylinkUrlResponse{} }
+func (m *GetPaylinkUrlResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinkUrlResponse) ProtoMessage()    {}
+func (*GetPaylinkUrlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{255}
 }
-func (m *DashboardRevenueByCountryReportChartItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Unmarshal(m, b)
+func (m *GetPaylinkUrlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinkUrlResponse.Unmarshal(m, b)
 }
-func (m *DashboardRevenueByCountryReportChartItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Marshal(b, m, deterministic)
+func (m *GetPaylinkUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinkUrlResponse.Marshal(b, m, deterministic)
 }
-func (dst *DashboardRevenueByCountryReportChartItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Merge(dst, src)
+func (dst *GetPaylinkUrlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinkUrlResponse.Merge(dst, src)
 }
-func (m *DashboardRevenueByCountryReportChartItem) XXX_Size() int {
-	return xxx_messageInfo_DashboardRevenueByCountryReportChartItem.Size(m)
+func (m *GetPaylinkUrlResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinkUrlResponse.Size(m)
 }
-func (m *DashboardRevenueByCountryReportChartItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardRevenueByCountryReportChartItem.DiscardUnknown(m)
+func (m *GetPaylinkUrlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinkUrlResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardRevenueByCountryReportChartItem proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinkUrlResponse proto.InternalMessageInfo
 
-func (m *DashboardRevenueByCountryReportChartItem) GetLabel() int64 {
+func (m *GetPaylinkUrlResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Label
+		return m.Status
 	}
 	return 0
 }
 
-func (m *DashboardRevenueByCountryReportChartItem) GetAmount() float64 {
+func (m *GetPaylinkUrlResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Amount
+		return m.Message
 	}
-	return 0
+	return nil
 }
 
-type DashboardRevenueByCountryReport struct {
-	// @inject_tag: json:"currency"
-	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
-	// @inject_tag: json:"top" bson:"top"
-	Top []*DashboardRevenueByCountryReportTop `protobuf:"bytes,2,rep,name=top,proto3" json:"top" bson:"top"`
-	// @inject_tag: json:"total_current" bson:"total"
-	TotalCurrent float64 `protobuf:"fixed64,3,opt,name=total_current,json=totalCurrent,proto3" json:"total_current" bson:"total"`
-	// @inject_tag: json:"total_previous"
-	TotalPrevious float64 `protobuf:"fixed64,4,opt,name=total_previous,json=totalPrevious,proto3" json:"total_previous"`
-	// @inject_tag: json:"chart" bson:"chart"
-	Chart                []*DashboardRevenueByCountryReportChartItem `protobuf:"bytes,5,rep,name=chart,proto3" json:"chart" bson:"chart"`
-	XXX_NoUnkeyedLiteral struct{}                                    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                       `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetPaylinkUrlResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-func (m *DashboardRevenueByCountryReport) Reset()         { *m = DashboardRevenueByCountryReport{} }
-func (m *DashboardRevenueByCountryReport) String() string { return proto.CompactTextString(m) }
-func (*DashboardRevenueByCountryReport) ProtoMessage()    {}
-func (*DashboardRevenueByCountryReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{193}
+type GetPaylinkStatCommonRequest struct {
+	// @inject_tag: validate:"required,hexadecimal"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"period_from" validate:"omitempty,numeric,gte=0"
+	PeriodFrom int64 `protobuf:"varint,3,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty" query:"period_from" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: query:"period_to" validate:"omitempty,numeric,gte=0"
+	PeriodTo             int64    `protobuf:"varint,4,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty" query:"period_to" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *DashboardRevenueByCountryReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardRevenueByCountryReport.Unmarshal(m, b)
+
+func (m *GetPaylinkStatCommonRequest) Reset()         { *m = GetPaylinkStatCommonRequest{} }
+func (m *GetPaylinkStatCommonRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinkStatCommonRequest) ProtoMessage()    {}
+func (*GetPaylinkStatCommonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{256}
 }
-func (m *DashboardRevenueByCountryReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardRevenueByCountryReport.Marshal(b, m, deterministic)
+func (m *GetPaylinkStatCommonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinkStatCommonRequest.Unmarshal(m, b)
 }
-func (dst *DashboardRevenueByCountryReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardRevenueByCountryReport.Merge(dst, src)
+func (m *GetPaylinkStatCommonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinkStatCommonRequest.Marshal(b, m, deterministic)
 }
-func (m *DashboardRevenueByCountryReport) XXX_Size() int {
-	return xxx_messageInfo_DashboardRevenueByCountryReport.Size(m)
+func (dst *GetPaylinkStatCommonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinkStatCommonRequest.Merge(dst, src)
 }
-func (m *DashboardRevenueByCountryReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardRevenueByCountryReport.DiscardUnknown(m)
+func (m *GetPaylinkStatCommonRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinkStatCommonRequest.Size(m)
+}
+func (m *GetPaylinkStatCommonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinkStatCommonRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardRevenueByCountryReport proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinkStatCommonRequest proto.InternalMessageInfo
 
-func (m *DashboardRevenueByCountryReport) GetCurrency() string {
+func (m *GetPaylinkStatCommonRequest) GetId() string {
 	if m != nil {
-		return m.Currency
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DashboardRevenueByCountryReport) GetTop() []*DashboardRevenueByCountryReportTop {
+func (m *GetPaylinkStatCommonRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Top
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-func (m *DashboardRevenueByCountryReport) GetTotalCurrent() float64 {
+func (m *GetPaylinkStatCommonRequest) GetPeriodFrom() int64 {
 	if m != nil {
-		return m.TotalCurrent
+		return m.PeriodFrom
+	}
+	return 0
+}
+
+func (m *GetPaylinkStatCommonRequest) GetPeriodTo() int64 {
+	if m != nil {
+		return m.PeriodTo
 	}
 	return 0
 }
 
-func (m *DashboardRevenueByCountryReport) GetTotalPrevious() float64 {
+type GetPaylinkStatCommonResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"item"
+	Item *paylink.StatCommon `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	// signed tokens of paylink with successful purchases against purchases cap
+	// @inject_tag: json:"tokens"
+	Tokens               []*paylink.PaylinkToken `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetPaylinkStatCommonResponse) Reset()         { *m = GetPaylinkStatCommonResponse{} }
+func (m *GetPaylinkStatCommonResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinkStatCommonResponse) ProtoMessage()    {}
+func (*GetPaylinkStatCommonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{257}
+}
+func (m *GetPaylinkStatCommonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinkStatCommonResponse.Unmarshal(m, b)
+}
+func (m *GetPaylinkStatCommonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinkStatCommonResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPaylinkStatCommonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinkStatCommonResponse.Merge(dst, src)
+}
+func (m *GetPaylinkStatCommonResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinkStatCommonResponse.Size(m)
+}
+func (m *GetPaylinkStatCommonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinkStatCommonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaylinkStatCommonResponse proto.InternalMessageInfo
+
+func (m *GetPaylinkStatCommonResponse) GetStatus() int32 {
 	if m != nil {
-		return m.TotalPrevious
+		return m.Status
 	}
 	return 0
 }
 
-func (m *DashboardRevenueByCountryReport) GetChart() []*DashboardRevenueByCountryReportChartItem {
+func (m *GetPaylinkStatCommonResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Chart
+		return m.Message
 	}
 	return nil
 }
 
-type DashboardSalesTodayReportTop struct {
-	// @inject_tag: json:"name" bson:"name"
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bson:"name"`
-	// @inject_tag: json:"count" bson:"count"
-	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count" bson:"count"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *DashboardSalesTodayReportTop) Reset()         { *m = DashboardSalesTodayReportTop{} }
-func (m *DashboardSalesTodayReportTop) String() string { return proto.CompactTextString(m) }
-func (*DashboardSalesTodayReportTop) ProtoMessage()    {}
-func (*DashboardSalesTodayReportTop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{194}
-}
-func (m *DashboardSalesTodayReportTop) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardSalesTodayReportTop.Unmarshal(m, b)
-}
-func (m *DashboardSalesTodayReportTop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardSalesTodayReportTop.Marshal(b, m, deterministic)
-}
-func (dst *DashboardSalesTodayReportTop) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardSalesTodayReportTop.Merge(dst, src)
-}
-func (m *DashboardSalesTodayReportTop) XXX_Size() int {
-	return xxx_messageInfo_DashboardSalesTodayReportTop.Size(m)
-}
-func (m *DashboardSalesTodayReportTop) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardSalesTodayReportTop.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DashboardSalesTodayReportTop proto.InternalMessageInfo
-
-func (m *DashboardSalesTodayReportTop) GetName() string {
+func (m *GetPaylinkStatCommonResponse) GetItem() *paylink.StatCommon {
 	if m != nil {
-		return m.Name
+		return m.Item
 	}
-	return ""
+	return nil
 }
 
-func (m *DashboardSalesTodayReportTop) GetCount() int64 {
+func (m *GetPaylinkStatCommonResponse) GetTokens() []*paylink.PaylinkToken {
 	if m != nil {
-		return m.Count
+		return m.Tokens
 	}
-	return 0
+	return nil
 }
 
-type DashboardChartItemInt struct {
-	// @inject_tag: json:"label" bson:"label"
-	Label int64 `protobuf:"varint,1,opt,name=label,proto3" json:"label" bson:"label"`
-	// @inject_tag: json:"value" bson:"value"
-	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value" bson:"value"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type GetPaylinkStatCommonGroupResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"item"
+	Item                 *paylink.GroupStatCommon `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardChartItemInt) Reset()         { *m = DashboardChartItemInt{} }
-func (m *DashboardChartItemInt) String() string { return proto.CompactTextString(m) }
-func (*DashboardChartItemInt) ProtoMessage()    {}
-func (*DashboardChartItemInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{195}
+func (m *GetPaylinkStatCommonGroupResponse) Reset()         { *m = GetPaylinkStatCommonGroupResponse{} }
+func (m *GetPaylinkStatCommonGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaylinkStatCommonGroupResponse) ProtoMessage()    {}
+func (*GetPaylinkStatCommonGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{258}
 }
-func (m *DashboardChartItemInt) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardChartItemInt.Unmarshal(m, b)
+func (m *GetPaylinkStatCommonGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Unmarshal(m, b)
 }
-func (m *DashboardChartItemInt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardChartItemInt.Marshal(b, m, deterministic)
+func (m *GetPaylinkStatCommonGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Marshal(b, m, deterministic)
 }
-func (dst *DashboardChartItemInt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardChartItemInt.Merge(dst, src)
+func (dst *GetPaylinkStatCommonGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Merge(dst, src)
 }
-func (m *DashboardChartItemInt) XXX_Size() int {
-	return xxx_messageInfo_DashboardChartItemInt.Size(m)
+func (m *GetPaylinkStatCommonGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Size(m)
 }
-func (m *DashboardChartItemInt) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardChartItemInt.DiscardUnknown(m)
+func (m *GetPaylinkStatCommonGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaylinkStatCommonGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardChartItemInt proto.InternalMessageInfo
+var xxx_messageInfo_GetPaylinkStatCommonGroupResponse proto.InternalMessageInfo
 
-func (m *DashboardChartItemInt) GetLabel() int64 {
+func (m *GetPaylinkStatCommonGroupResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Label
+		return m.Status
 	}
 	return 0
 }
 
-func (m *DashboardChartItemInt) GetValue() int64 {
+func (m *GetPaylinkStatCommonGroupResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Value
+		return m.Message
 	}
-	return 0
+	return nil
 }
 
-type DashboardSalesTodayReport struct {
-	// @inject_tag: json:"top" bson:"top"
-	Top []*DashboardSalesTodayReportTop `protobuf:"bytes,1,rep,name=top,proto3" json:"top" bson:"top"`
-	// @inject_tag: json:"total_current" bson:"total"
-	TotalCurrent int64 `protobuf:"varint,2,opt,name=total_current,json=totalCurrent,proto3" json:"total_current" bson:"total"`
-	// @inject_tag: json:"total_previous"
-	TotalPrevious int64 `protobuf:"varint,3,opt,name=total_previous,json=totalPrevious,proto3" json:"total_previous"`
-	// @inject_tag: json:"chart" bson:"chart"
-	Chart                []*DashboardChartItemInt `protobuf:"bytes,4,rep,name=chart,proto3" json:"chart" bson:"chart"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *GetPaylinkStatCommonGroupResponse) GetItem() *paylink.GroupStatCommon {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *DashboardSalesTodayReport) Reset()         { *m = DashboardSalesTodayReport{} }
-func (m *DashboardSalesTodayReport) String() string { return proto.CompactTextString(m) }
-func (*DashboardSalesTodayReport) ProtoMessage()    {}
-func (*DashboardSalesTodayReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{196}
+type CreatePaylinkTokenResponse struct {
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// @inject_tag: json:"message,omitempty"
+	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// @inject_tag: json:"item,omitempty"
+	Item *paylink.PaylinkToken `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	// @inject_tag: json:"token,omitempty"
+	Token                string   `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *DashboardSalesTodayReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardSalesTodayReport.Unmarshal(m, b)
+
+func (m *CreatePaylinkTokenResponse) Reset()         { *m = CreatePaylinkTokenResponse{} }
+func (m *CreatePaylinkTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePaylinkTokenResponse) ProtoMessage()    {}
+func (*CreatePaylinkTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{259}
 }
-func (m *DashboardSalesTodayReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardSalesTodayReport.Marshal(b, m, deterministic)
+func (m *CreatePaylinkTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePaylinkTokenResponse.Unmarshal(m, b)
 }
-func (dst *DashboardSalesTodayReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardSalesTodayReport.Merge(dst, src)
+func (m *CreatePaylinkTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePaylinkTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *DashboardSalesTodayReport) XXX_Size() int {
-	return xxx_messageInfo_DashboardSalesTodayReport.Size(m)
+func (dst *CreatePaylinkTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePaylinkTokenResponse.Merge(dst, src)
 }
-func (m *DashboardSalesTodayReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardSalesTodayReport.DiscardUnknown(m)
+func (m *CreatePaylinkTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_CreatePaylinkTokenResponse.Size(m)
+}
+func (m *CreatePaylinkTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePaylinkTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardSalesTodayReport proto.InternalMessageInfo
+var xxx_messageInfo_CreatePaylinkTokenResponse proto.InternalMessageInfo
 
-func (m *DashboardSalesTodayReport) GetTop() []*DashboardSalesTodayReportTop {
+func (m *CreatePaylinkTokenResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Top
+		return m.Status
 	}
-	return nil
+	return 0
 }
 
-func (m *DashboardSalesTodayReport) GetTotalCurrent() int64 {
+func (m *CreatePaylinkTokenResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.TotalCurrent
+		return m.Message
 	}
-	return 0
+	return nil
 }
 
-func (m *DashboardSalesTodayReport) GetTotalPrevious() int64 {
+func (m *CreatePaylinkTokenResponse) GetItem() *paylink.PaylinkToken {
 	if m != nil {
-		return m.TotalPrevious
+		return m.Item
 	}
-	return 0
+	return nil
 }
 
-func (m *DashboardSalesTodayReport) GetChart() []*DashboardChartItemInt {
+func (m *CreatePaylinkTokenResponse) GetToken() string {
 	if m != nil {
-		return m.Chart
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-type DashboardSourcesReport struct {
-	// @inject_tag: json:"top" bson:"top"
-	Top []*DashboardSalesTodayReportTop `protobuf:"bytes,1,rep,name=top,proto3" json:"top" bson:"top"`
-	// @inject_tag: json:"total_current" bson:"total"
-	TotalCurrent int64 `protobuf:"varint,2,opt,name=total_current,json=totalCurrent,proto3" json:"total_current" bson:"total"`
-	// @inject_tag: json:"total_previous"
-	TotalPrevious int64 `protobuf:"varint,3,opt,name=total_previous,json=totalPrevious,proto3" json:"total_previous"`
-	// @inject_tag: json:"chart" bson:"chart"
-	Chart                []*DashboardChartItemInt `protobuf:"bytes,4,rep,name=chart,proto3" json:"chart" bson:"chart"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+type RoyaltyReportPdfUploadedRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required"
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,numeric,gte=0"
+	RetentionTime int32 `protobuf:"varint,3,opt,name=retention_time,json=retentionTime,proto3" json:"retention_time,omitempty" validate:"required,numeric,gte=0"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	RoyaltyReportId      string   `protobuf:"bytes,4,opt,name=royalty_report_id,json=royaltyReportId,proto3" json:"royalty_report_id,omitempty" validate:"required,hexadecimal,len=24"`
+	Content              []byte   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardSourcesReport) Reset()         { *m = DashboardSourcesReport{} }
-func (m *DashboardSourcesReport) String() string { return proto.CompactTextString(m) }
-func (*DashboardSourcesReport) ProtoMessage()    {}
-func (*DashboardSourcesReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{197}
+func (m *RoyaltyReportPdfUploadedRequest) Reset()         { *m = RoyaltyReportPdfUploadedRequest{} }
+func (m *RoyaltyReportPdfUploadedRequest) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportPdfUploadedRequest) ProtoMessage()    {}
+func (*RoyaltyReportPdfUploadedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{260}
 }
-func (m *DashboardSourcesReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardSourcesReport.Unmarshal(m, b)
+func (m *RoyaltyReportPdfUploadedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Unmarshal(m, b)
 }
-func (m *DashboardSourcesReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardSourcesReport.Marshal(b, m, deterministic)
+func (m *RoyaltyReportPdfUploadedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Marshal(b, m, deterministic)
 }
-func (dst *DashboardSourcesReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardSourcesReport.Merge(dst, src)
+func (dst *RoyaltyReportPdfUploadedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Merge(dst, src)
 }
-func (m *DashboardSourcesReport) XXX_Size() int {
-	return xxx_messageInfo_DashboardSourcesReport.Size(m)
+func (m *RoyaltyReportPdfUploadedRequest) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Size(m)
 }
-func (m *DashboardSourcesReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardSourcesReport.DiscardUnknown(m)
+func (m *RoyaltyReportPdfUploadedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportPdfUploadedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardSourcesReport proto.InternalMessageInfo
+var xxx_messageInfo_RoyaltyReportPdfUploadedRequest proto.InternalMessageInfo
 
-func (m *DashboardSourcesReport) GetTop() []*DashboardSalesTodayReportTop {
+func (m *RoyaltyReportPdfUploadedRequest) GetId() string {
 	if m != nil {
-		return m.Top
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *DashboardSourcesReport) GetTotalCurrent() int64 {
+func (m *RoyaltyReportPdfUploadedRequest) GetFilename() string {
 	if m != nil {
-		return m.TotalCurrent
+		return m.Filename
 	}
-	return 0
+	return ""
 }
 
-func (m *DashboardSourcesReport) GetTotalPrevious() int64 {
+func (m *RoyaltyReportPdfUploadedRequest) GetRetentionTime() int32 {
 	if m != nil {
-		return m.TotalPrevious
+		return m.RetentionTime
 	}
 	return 0
 }
 
-func (m *DashboardSourcesReport) GetChart() []*DashboardChartItemInt {
+func (m *RoyaltyReportPdfUploadedRequest) GetRoyaltyReportId() string {
 	if m != nil {
-		return m.Chart
+		return m.RoyaltyReportId
+	}
+	return ""
+}
+
+func (m *RoyaltyReportPdfUploadedRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
 	}
 	return nil
 }
 
-type DashboardBaseReports struct {
-	// @inject_tag: json:"revenue_by_country"
-	RevenueByCountry *DashboardRevenueByCountryReport `protobuf:"bytes,1,opt,name=revenue_by_country,json=revenueByCountry,proto3" json:"revenue_by_country"`
-	// @inject_tag: json:"sales_today"
-	SalesToday *DashboardSalesTodayReport `protobuf:"bytes,2,opt,name=sales_today,json=salesToday,proto3" json:"sales_today"`
-	// @inject_tag: json:"sources"
-	Sources              *DashboardSourcesReport `protobuf:"bytes,4,opt,name=sources,proto3" json:"sources"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+type RoyaltyReportPdfUploadedResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DashboardBaseReports) Reset()         { *m = DashboardBaseReports{} }
-func (m *DashboardBaseReports) String() string { return proto.CompactTextString(m) }
-func (*DashboardBaseReports) ProtoMessage()    {}
-func (*DashboardBaseReports) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{198}
+func (m *RoyaltyReportPdfUploadedResponse) Reset()         { *m = RoyaltyReportPdfUploadedResponse{} }
+func (m *RoyaltyReportPdfUploadedResponse) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyReportPdfUploadedResponse) ProtoMessage()    {}
+func (*RoyaltyReportPdfUploadedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{261}
 }
-func (m *DashboardBaseReports) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardBaseReports.Unmarshal(m, b)
+func (m *RoyaltyReportPdfUploadedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Unmarshal(m, b)
 }
-func (m *DashboardBaseReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardBaseReports.Marshal(b, m, deterministic)
+func (m *RoyaltyReportPdfUploadedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Marshal(b, m, deterministic)
 }
-func (dst *DashboardBaseReports) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardBaseReports.Merge(dst, src)
+func (dst *RoyaltyReportPdfUploadedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Merge(dst, src)
 }
-func (m *DashboardBaseReports) XXX_Size() int {
-	return xxx_messageInfo_DashboardBaseReports.Size(m)
+func (m *RoyaltyReportPdfUploadedResponse) XXX_Size() int {
+	return xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Size(m)
 }
-func (m *DashboardBaseReports) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardBaseReports.DiscardUnknown(m)
+func (m *RoyaltyReportPdfUploadedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyReportPdfUploadedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardBaseReports proto.InternalMessageInfo
-
-func (m *DashboardBaseReports) GetRevenueByCountry() *DashboardRevenueByCountryReport {
-	if m != nil {
-		return m.RevenueByCountry
-	}
-	return nil
-}
+var xxx_messageInfo_RoyaltyReportPdfUploadedResponse proto.InternalMessageInfo
 
-func (m *DashboardBaseReports) GetSalesToday() *DashboardSalesTodayReport {
+func (m *RoyaltyReportPdfUploadedResponse) GetStatus() int32 {
 	if m != nil {
-		return m.SalesToday
+		return m.Status
 	}
-	return nil
+	return 0
 }
 
-func (m *DashboardBaseReports) GetSources() *DashboardSourcesReport {
+func (m *RoyaltyReportPdfUploadedResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Sources
+		return m.Message
 	}
 	return nil
 }
 
-type CreatePayoutDocumentRequest struct {
-	// @inject_tag: json:"description" validate:"max=255"
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description" validate:"max=255"`
-	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"ip" validate:"required"
-	Ip string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip" validate:"required"`
-	// @inject_tag: json:"-" validate:"required"
-	Initiator string `protobuf:"bytes,4,opt,name=initiator,proto3" json:"-" validate:"required"`
-	// @inject_tag: json:"-"
-	IsAutoGeneration     bool     `protobuf:"varint,5,opt,name=is_auto_generation,json=isAutoGeneration,proto3" json:"-"`
+type DeleteSavedCardRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required"
+	Cookie               string   `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty" validate:"required"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *CreatePayoutDocumentRequest) Reset()         { *m = CreatePayoutDocumentRequest{} }
-func (m *CreatePayoutDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePayoutDocumentRequest) ProtoMessage()    {}
-func (*CreatePayoutDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{199}
+func (m *DeleteSavedCardRequest) Reset()         { *m = DeleteSavedCardRequest{} }
+func (m *DeleteSavedCardRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSavedCardRequest) ProtoMessage()    {}
+func (*DeleteSavedCardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{262}
 }
-func (m *CreatePayoutDocumentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePayoutDocumentRequest.Unmarshal(m, b)
+func (m *DeleteSavedCardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSavedCardRequest.Unmarshal(m, b)
 }
-func (m *CreatePayoutDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePayoutDocumentRequest.Marshal(b, m, deterministic)
+func (m *DeleteSavedCardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSavedCardRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreatePayoutDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePayoutDocumentRequest.Merge(dst, src)
+func (dst *DeleteSavedCardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSavedCardRequest.Merge(dst, src)
 }
-func (m *CreatePayoutDocumentRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePayoutDocumentRequest.Size(m)
+func (m *DeleteSavedCardRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSavedCardRequest.Size(m)
 }
-func (m *CreatePayoutDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePayoutDocumentRequest.DiscardUnknown(m)
+func (m *DeleteSavedCardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSavedCardRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePayoutDocumentRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteSavedCardRequest proto.InternalMessageInfo
 
-func (m *CreatePayoutDocumentRequest) GetDescription() string {
+func (m *DeleteSavedCardRequest) GetId() string {
 	if m != nil {
-		return m.Description
+		return m.Id
 	}
 	return ""
 }
 
-func (m *CreatePayoutDocumentRequest) GetMerchantId() string {
+func (m *DeleteSavedCardRequest) GetCookie() string {
 	if m != nil {
-		return m.MerchantId
+		return m.Cookie
 	}
 	return ""
 }
 
-func (m *CreatePayoutDocumentRequest) GetIp() string {
-	if m != nil {
-		return m.Ip
-	}
-	return ""
+type OrderAuthorizationRequest struct {
+	// @inject_tag: validate:"required,uuid"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *CreatePayoutDocumentRequest) GetInitiator() string {
+func (m *OrderAuthorizationRequest) Reset()         { *m = OrderAuthorizationRequest{} }
+func (m *OrderAuthorizationRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderAuthorizationRequest) ProtoMessage()    {}
+func (*OrderAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{263}
+}
+func (m *OrderAuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderAuthorizationRequest.Unmarshal(m, b)
+}
+func (m *OrderAuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderAuthorizationRequest.Marshal(b, m, deterministic)
+}
+func (dst *OrderAuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAuthorizationRequest.Merge(dst, src)
+}
+func (m *OrderAuthorizationRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderAuthorizationRequest.Size(m)
+}
+func (m *OrderAuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAuthorizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderAuthorizationRequest proto.InternalMessageInfo
+
+func (m *OrderAuthorizationRequest) GetOrderId() string {
 	if m != nil {
-		return m.Initiator
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *CreatePayoutDocumentRequest) GetIsAutoGeneration() bool {
+func (m *OrderAuthorizationRequest) GetMerchantId() string {
 	if m != nil {
-		return m.IsAutoGeneration
+		return m.MerchantId
 	}
-	return false
+	return ""
 }
 
-type PayoutDocumentResponse struct {
-	// @inject_tag: json:"status"
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	// @inject_tag: json:"message"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
-	// @inject_tag: json:"item"
-	Item                 *billing.PayoutDocument `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+type OrderAuthorizationResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Order        `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PayoutDocumentResponse) Reset()         { *m = PayoutDocumentResponse{} }
-func (m *PayoutDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*PayoutDocumentResponse) ProtoMessage()    {}
-func (*PayoutDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{200}
+func (m *OrderAuthorizationResponse) Reset()         { *m = OrderAuthorizationResponse{} }
+func (m *OrderAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderAuthorizationResponse) ProtoMessage()    {}
+func (*OrderAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{264}
 }
-func (m *PayoutDocumentResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayoutDocumentResponse.Unmarshal(m, b)
+func (m *OrderAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderAuthorizationResponse.Unmarshal(m, b)
 }
-func (m *PayoutDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayoutDocumentResponse.Marshal(b, m, deterministic)
+func (m *OrderAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderAuthorizationResponse.Marshal(b, m, deterministic)
 }
-func (dst *PayoutDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayoutDocumentResponse.Merge(dst, src)
+func (dst *OrderAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAuthorizationResponse.Merge(dst, src)
 }
-func (m *PayoutDocumentResponse) XXX_Size() int {
-	return xxx_messageInfo_PayoutDocumentResponse.Size(m)
+func (m *OrderAuthorizationResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderAuthorizationResponse.Size(m)
 }
-func (m *PayoutDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayoutDocumentResponse.DiscardUnknown(m)
+func (m *OrderAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAuthorizationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayoutDocumentResponse proto.InternalMessageInfo
+var xxx_messageInfo_OrderAuthorizationResponse proto.InternalMessageInfo
 
-func (m *PayoutDocumentResponse) GetStatus() int32 {
+func (m *OrderAuthorizationResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *PayoutDocumentResponse) GetMessage() *ResponseErrorMessage {
+func (m *OrderAuthorizationResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *PayoutDocumentResponse) GetItem() *billing.PayoutDocument {
+func (m *OrderAuthorizationResponse) GetItem() *billing.Order {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type UpdatePayoutDocumentRequest struct {
-	// @inject_tag: json:"payout_document_id" validate:"required,hexadecimal,len=24"
-	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"transaction" validate:"omitempty,max=255"
-	Transaction string `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction" validate:"omitempty,max=255"`
-	// @inject_tag: json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status" validate:"required,oneof=skip pending in_progress paid canceled failed"`
-	// @inject_tag: json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"
-	FailureCode string `protobuf:"bytes,4,opt,name=failure_code,json=failureCode,proto3" json:"failure_code" validate:"omitempty,oneof=account_closed account_frozen account_restricted destination_bank_invalid could_not_process declined insufficient_funds invalid_account_number incorrect_account_holder_name invalid_currency"`
-	// @inject_tag: json:"failure_message" validate:"omitempty,max=255"
-	FailureMessage string `protobuf:"bytes,5,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message" validate:"omitempty,max=255"`
-	// @inject_tag: json:"failure_transaction" validate:"omitempty,max=255"
-	FailureTransaction string `protobuf:"bytes,6,opt,name=failure_transaction,json=failureTransaction,proto3" json:"failure_transaction" validate:"omitempty,max=255"`
-	// @inject_tag: json:"ip"
-	Ip                   string   `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type SubscriptionPlanResponse struct {
+	Status               int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.SubscriptionPlan `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UpdatePayoutDocumentRequest) Reset()         { *m = UpdatePayoutDocumentRequest{} }
-func (m *UpdatePayoutDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdatePayoutDocumentRequest) ProtoMessage()    {}
-func (*UpdatePayoutDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{201}
-}
-func (m *UpdatePayoutDocumentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdatePayoutDocumentRequest.Unmarshal(m, b)
+func (m *SubscriptionPlanResponse) Reset()         { *m = SubscriptionPlanResponse{} }
+func (m *SubscriptionPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionPlanResponse) ProtoMessage()    {}
+func (*SubscriptionPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{265}
 }
-func (m *UpdatePayoutDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdatePayoutDocumentRequest.Marshal(b, m, deterministic)
+func (m *SubscriptionPlanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionPlanResponse.Unmarshal(m, b)
 }
-func (dst *UpdatePayoutDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePayoutDocumentRequest.Merge(dst, src)
+func (m *SubscriptionPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionPlanResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdatePayoutDocumentRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdatePayoutDocumentRequest.Size(m)
+func (dst *SubscriptionPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionPlanResponse.Merge(dst, src)
 }
-func (m *UpdatePayoutDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePayoutDocumentRequest.DiscardUnknown(m)
+func (m *SubscriptionPlanResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionPlanResponse.Size(m)
 }
-
-var xxx_messageInfo_UpdatePayoutDocumentRequest proto.InternalMessageInfo
-
-func (m *UpdatePayoutDocumentRequest) GetPayoutDocumentId() string {
-	if m != nil {
-		return m.PayoutDocumentId
-	}
-	return ""
+func (m *SubscriptionPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionPlanResponse.DiscardUnknown(m)
 }
 
-func (m *UpdatePayoutDocumentRequest) GetTransaction() string {
-	if m != nil {
-		return m.Transaction
-	}
-	return ""
-}
+var xxx_messageInfo_SubscriptionPlanResponse proto.InternalMessageInfo
 
-func (m *UpdatePayoutDocumentRequest) GetStatus() string {
+func (m *SubscriptionPlanResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
-	return ""
-}
-
-func (m *UpdatePayoutDocumentRequest) GetFailureCode() string {
-	if m != nil {
-		return m.FailureCode
-	}
-	return ""
-}
-
-func (m *UpdatePayoutDocumentRequest) GetFailureMessage() string {
-	if m != nil {
-		return m.FailureMessage
-	}
-	return ""
+	return 0
 }
 
-func (m *UpdatePayoutDocumentRequest) GetFailureTransaction() string {
+func (m *SubscriptionPlanResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.FailureTransaction
+		return m.Message
 	}
-	return ""
+	return nil
 }
 
-func (m *UpdatePayoutDocumentRequest) GetIp() string {
+func (m *SubscriptionPlanResponse) GetItem() *billing.SubscriptionPlan {
 	if m != nil {
-		return m.Ip
+		return m.Item
 	}
-	return ""
+	return nil
 }
 
-type GetPayoutDocumentRequest struct {
-	// @inject_tag: query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"
-	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty" query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"`
-	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
-	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+type GetSubscriptionPlanRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPayoutDocumentRequest) Reset()         { *m = GetPayoutDocumentRequest{} }
-func (m *GetPayoutDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPayoutDocumentRequest) ProtoMessage()    {}
-func (*GetPayoutDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{202}
+func (m *GetSubscriptionPlanRequest) Reset()         { *m = GetSubscriptionPlanRequest{} }
+func (m *GetSubscriptionPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSubscriptionPlanRequest) ProtoMessage()    {}
+func (*GetSubscriptionPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{266}
 }
-func (m *GetPayoutDocumentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPayoutDocumentRequest.Unmarshal(m, b)
+func (m *GetSubscriptionPlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSubscriptionPlanRequest.Unmarshal(m, b)
 }
-func (m *GetPayoutDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPayoutDocumentRequest.Marshal(b, m, deterministic)
+func (m *GetSubscriptionPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSubscriptionPlanRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPayoutDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPayoutDocumentRequest.Merge(dst, src)
+func (dst *GetSubscriptionPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubscriptionPlanRequest.Merge(dst, src)
 }
-func (m *GetPayoutDocumentRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPayoutDocumentRequest.Size(m)
+func (m *GetSubscriptionPlanRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSubscriptionPlanRequest.Size(m)
 }
-func (m *GetPayoutDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPayoutDocumentRequest.DiscardUnknown(m)
+func (m *GetSubscriptionPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubscriptionPlanRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPayoutDocumentRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetSubscriptionPlanRequest proto.InternalMessageInfo
 
-func (m *GetPayoutDocumentRequest) GetPayoutDocumentId() string {
+func (m *GetSubscriptionPlanRequest) GetPlanId() string {
 	if m != nil {
-		return m.PayoutDocumentId
+		return m.PlanId
 	}
 	return ""
 }
 
-func (m *GetPayoutDocumentRequest) GetMerchantId() string {
+func (m *GetSubscriptionPlanRequest) GetMerchantId() string {
 	if m != nil {
 		return m.MerchantId
 	}
 	return ""
 }
 
-type GetPayoutDocumentsRequest struct {
-	// @inject_tag: query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"
-	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty" query:"payout_document_id" validate:"omitempty,hexadecimal,len=24"`
-	// @inject_tag: query:"status[]" validate:"omitempty,dive,oneof=skip pending in_progress paid canceled failed"
-	Status []string `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,oneof=skip pending in_progress paid canceled failed"`
-	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
-	// @inject_tag: query:"period_from" validate:"omitempty,numeric,gt=0"
-	PeriodFrom int64 `protobuf:"varint,4,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty" query:"period_from" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"period_to" validate:"omitempty,numeric,gt=0"
-	PeriodTo int64 `protobuf:"varint,5,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty" query:"period_to" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
-	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
-	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
-	Offset               int32    `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+type ListSubscriptionPlansRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty" validate:"omitempty,numeric,gte=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPayoutDocumentsRequest) Reset()         { *m = GetPayoutDocumentsRequest{} }
-func (m *GetPayoutDocumentsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPayoutDocumentsRequest) ProtoMessage()    {}
-func (*GetPayoutDocumentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{203}
-}
-func (m *GetPayoutDocumentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPayoutDocumentsRequest.Unmarshal(m, b)
+func (m *ListSubscriptionPlansRequest) Reset()         { *m = ListSubscriptionPlansRequest{} }
+func (m *ListSubscriptionPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionPlansRequest) ProtoMessage()    {}
+func (*ListSubscriptionPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{267}
 }
-func (m *GetPayoutDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPayoutDocumentsRequest.Marshal(b, m, deterministic)
+func (m *ListSubscriptionPlansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionPlansRequest.Unmarshal(m, b)
 }
-func (dst *GetPayoutDocumentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPayoutDocumentsRequest.Merge(dst, src)
+func (m *ListSubscriptionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionPlansRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPayoutDocumentsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPayoutDocumentsRequest.Size(m)
+func (dst *ListSubscriptionPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionPlansRequest.Merge(dst, src)
 }
-func (m *GetPayoutDocumentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPayoutDocumentsRequest.DiscardUnknown(m)
+func (m *ListSubscriptionPlansRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionPlansRequest.Size(m)
 }
-
-var xxx_messageInfo_GetPayoutDocumentsRequest proto.InternalMessageInfo
-
-func (m *GetPayoutDocumentsRequest) GetPayoutDocumentId() string {
-	if m != nil {
-		return m.PayoutDocumentId
-	}
-	return ""
+func (m *ListSubscriptionPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionPlansRequest.DiscardUnknown(m)
 }
 
-func (m *GetPayoutDocumentsRequest) GetStatus() []string {
-	if m != nil {
-		return m.Status
-	}
-	return nil
-}
+var xxx_messageInfo_ListSubscriptionPlansRequest proto.InternalMessageInfo
 
-func (m *GetPayoutDocumentsRequest) GetMerchantId() string {
+func (m *ListSubscriptionPlansRequest) GetMerchantId() string {
 	if m != nil {
 		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *GetPayoutDocumentsRequest) GetPeriodFrom() int64 {
-	if m != nil {
-		return m.PeriodFrom
-	}
-	return 0
-}
-
-func (m *GetPayoutDocumentsRequest) GetPeriodTo() int64 {
+func (m *ListSubscriptionPlansRequest) GetProjectId() string {
 	if m != nil {
-		return m.PeriodTo
+		return m.ProjectId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetPayoutDocumentsRequest) GetLimit() int32 {
+func (m *ListSubscriptionPlansRequest) GetLimit() int32 {
 	if m != nil {
 		return m.Limit
 	}
 	return 0
 }
 
-func (m *GetPayoutDocumentsRequest) GetOffset() int32 {
+func (m *ListSubscriptionPlansRequest) GetOffset() int32 {
 	if m != nil {
 		return m.Offset
 	}
 	return 0
 }
 
-type PayoutDocumentsPaginate struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*billing.PayoutDocument `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListSubscriptionPlansResponse struct {
+	Status               int32                       `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                       `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.SubscriptionPlan `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PayoutDocumentsPaginate) Reset()         { *m = PayoutDocumentsPaginate{} }
-func (m *PayoutDocumentsPaginate) String() string { return proto.CompactTextString(m) }
-func (*PayoutDocumentsPaginate) ProtoMessage()    {}
-func (*PayoutDocumentsPaginate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{204}
+func (m *ListSubscriptionPlansResponse) Reset()         { *m = ListSubscriptionPlansResponse{} }
+func (m *ListSubscriptionPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionPlansResponse) ProtoMessage()    {}
+func (*ListSubscriptionPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{268}
 }
-func (m *PayoutDocumentsPaginate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayoutDocumentsPaginate.Unmarshal(m, b)
+func (m *ListSubscriptionPlansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionPlansResponse.Unmarshal(m, b)
 }
-func (m *PayoutDocumentsPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayoutDocumentsPaginate.Marshal(b, m, deterministic)
+func (m *ListSubscriptionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionPlansResponse.Marshal(b, m, deterministic)
 }
-func (dst *PayoutDocumentsPaginate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayoutDocumentsPaginate.Merge(dst, src)
+func (dst *ListSubscriptionPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionPlansResponse.Merge(dst, src)
 }
-func (m *PayoutDocumentsPaginate) XXX_Size() int {
-	return xxx_messageInfo_PayoutDocumentsPaginate.Size(m)
+func (m *ListSubscriptionPlansResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionPlansResponse.Size(m)
 }
-func (m *PayoutDocumentsPaginate) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayoutDocumentsPaginate.DiscardUnknown(m)
+func (m *ListSubscriptionPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionPlansResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayoutDocumentsPaginate proto.InternalMessageInfo
+var xxx_messageInfo_ListSubscriptionPlansResponse proto.InternalMessageInfo
 
-func (m *PayoutDocumentsPaginate) GetCount() int32 {
+func (m *ListSubscriptionPlansResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Count
+		return m.Status
 	}
 	return 0
 }
 
-func (m *PayoutDocumentsPaginate) GetItems() []*billing.PayoutDocument {
+func (m *ListSubscriptionPlansResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.Items
+		return m.Message
 	}
 	return nil
 }
 
-type GetPayoutDocumentsResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"data"
-	Data                 *PayoutDocumentsPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *GetPayoutDocumentsResponse) Reset()         { *m = GetPayoutDocumentsResponse{} }
-func (m *GetPayoutDocumentsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPayoutDocumentsResponse) ProtoMessage()    {}
-func (*GetPayoutDocumentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{205}
-}
-func (m *GetPayoutDocumentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPayoutDocumentsResponse.Unmarshal(m, b)
-}
-func (m *GetPayoutDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPayoutDocumentsResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetPayoutDocumentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPayoutDocumentsResponse.Merge(dst, src)
-}
-func (m *GetPayoutDocumentsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPayoutDocumentsResponse.Size(m)
-}
-func (m *GetPayoutDocumentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPayoutDocumentsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetPayoutDocumentsResponse proto.InternalMessageInfo
-
-func (m *GetPayoutDocumentsResponse) GetStatus() int32 {
+func (m *ListSubscriptionPlansResponse) GetCount() int32 {
 	if m != nil {
-		return m.Status
+		return m.Count
 	}
 	return 0
 }
 
-func (m *GetPayoutDocumentsResponse) GetMessage() *ResponseErrorMessage {
-	if m != nil {
-		return m.Message
-	}
-	return nil
-}
-
-func (m *GetPayoutDocumentsResponse) GetData() *PayoutDocumentsPaginate {
+func (m *ListSubscriptionPlansResponse) GetItems() []*billing.SubscriptionPlan {
 	if m != nil {
-		return m.Data
+		return m.Items
 	}
 	return nil
 }
 
-type UpdatePayoutDocumentSignaturesRequest struct {
-	// @inject_tag: query:"payout_document_id" validate:"required,hexadecimal,len=24"
-	PayoutDocumentId string `protobuf:"bytes,1,opt,name=payout_document_id,json=payoutDocumentId,proto3" json:"payout_document_id,omitempty" query:"payout_document_id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: json:"has_merchant_signature"
-	HasMerchantSignature bool `protobuf:"varint,2,opt,name=has_merchant_signature,json=hasMerchantSignature,proto3" json:"has_merchant_signature"`
-	// @inject_tag: json:"has_psp_signature"
-	HasPspSignature bool `protobuf:"varint,3,opt,name=has_psp_signature,json=hasPspSignature,proto3" json:"has_psp_signature"`
-	// @inject_tag: json:"signed_document_file_url"
-	SignedDocumentFileUrl string   `protobuf:"bytes,4,opt,name=signed_document_file_url,json=signedDocumentFileUrl,proto3" json:"signed_document_file_url"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized      []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache         int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type CreateSubscriptionRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,uuid"
+	OrderId              string   `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *UpdatePayoutDocumentSignaturesRequest) Reset()         { *m = UpdatePayoutDocumentSignaturesRequest{} }
-func (m *UpdatePayoutDocumentSignaturesRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdatePayoutDocumentSignaturesRequest) ProtoMessage()    {}
-func (*UpdatePayoutDocumentSignaturesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{206}
+func (m *CreateSubscriptionRequest) Reset()         { *m = CreateSubscriptionRequest{} }
+func (m *CreateSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSubscriptionRequest) ProtoMessage()    {}
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{269}
 }
-func (m *UpdatePayoutDocumentSignaturesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Unmarshal(m, b)
+func (m *CreateSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSubscriptionRequest.Unmarshal(m, b)
 }
-func (m *UpdatePayoutDocumentSignaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Marshal(b, m, deterministic)
+func (m *CreateSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSubscriptionRequest.Marshal(b, m, deterministic)
 }
-func (dst *UpdatePayoutDocumentSignaturesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Merge(dst, src)
+func (dst *CreateSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSubscriptionRequest.Merge(dst, src)
 }
-func (m *UpdatePayoutDocumentSignaturesRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.Size(m)
+func (m *CreateSubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSubscriptionRequest.Size(m)
 }
-func (m *UpdatePayoutDocumentSignaturesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest.DiscardUnknown(m)
+func (m *CreateSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSubscriptionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePayoutDocumentSignaturesRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateSubscriptionRequest proto.InternalMessageInfo
 
-func (m *UpdatePayoutDocumentSignaturesRequest) GetPayoutDocumentId() string {
+func (m *CreateSubscriptionRequest) GetMerchantId() string {
 	if m != nil {
-		return m.PayoutDocumentId
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *UpdatePayoutDocumentSignaturesRequest) GetHasMerchantSignature() bool {
-	if m != nil {
-		return m.HasMerchantSignature
-	}
-	return false
-}
-
-func (m *UpdatePayoutDocumentSignaturesRequest) GetHasPspSignature() bool {
+func (m *CreateSubscriptionRequest) GetPlanId() string {
 	if m != nil {
-		return m.HasPspSignature
+		return m.PlanId
 	}
-	return false
+	return ""
 }
 
-func (m *UpdatePayoutDocumentSignaturesRequest) GetSignedDocumentFileUrl() string {
+func (m *CreateSubscriptionRequest) GetOrderId() string {
 	if m != nil {
-		return m.SignedDocumentFileUrl
+		return m.OrderId
 	}
 	return ""
 }
 
-type GetMerchantBalanceRequest struct {
+type SubscriptionRequest struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId           string   `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,max=255"
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" validate:"omitempty,max=255"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetMerchantBalanceRequest) Reset()         { *m = GetMerchantBalanceRequest{} }
-func (m *GetMerchantBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantBalanceRequest) ProtoMessage()    {}
-func (*GetMerchantBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{207}
+func (m *SubscriptionRequest) Reset()         { *m = SubscriptionRequest{} }
+func (m *SubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRequest) ProtoMessage()    {}
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{270}
 }
-func (m *GetMerchantBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantBalanceRequest.Unmarshal(m, b)
+func (m *SubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionRequest.Unmarshal(m, b)
 }
-func (m *GetMerchantBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantBalanceRequest.Marshal(b, m, deterministic)
+func (m *SubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantBalanceRequest.Merge(dst, src)
+func (dst *SubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRequest.Merge(dst, src)
 }
-func (m *GetMerchantBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantBalanceRequest.Size(m)
+func (m *SubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionRequest.Size(m)
 }
-func (m *GetMerchantBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantBalanceRequest.DiscardUnknown(m)
+func (m *SubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubscriptionRequest proto.InternalMessageInfo
+
+func (m *SubscriptionRequest) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
 
-func (m *GetMerchantBalanceRequest) GetMerchantId() string {
+func (m *SubscriptionRequest) GetMerchantId() string {
 	if m != nil {
 		return m.MerchantId
 	}
 	return ""
 }
 
-type GetMerchantBalanceResponse struct {
-	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *billing.MerchantBalance `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *SubscriptionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *GetMerchantBalanceResponse) Reset()         { *m = GetMerchantBalanceResponse{} }
-func (m *GetMerchantBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMerchantBalanceResponse) ProtoMessage()    {}
-func (*GetMerchantBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{208}
+type SubscriptionResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Subscription `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *GetMerchantBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMerchantBalanceResponse.Unmarshal(m, b)
+
+func (m *SubscriptionResponse) Reset()         { *m = SubscriptionResponse{} }
+func (m *SubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionResponse) ProtoMessage()    {}
+func (*SubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{271}
 }
-func (m *GetMerchantBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMerchantBalanceResponse.Marshal(b, m, deterministic)
+func (m *SubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionResponse.Unmarshal(m, b)
 }
-func (dst *GetMerchantBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantBalanceResponse.Merge(dst, src)
+func (m *SubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionResponse.Marshal(b, m, deterministic)
 }
-func (m *GetMerchantBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMerchantBalanceResponse.Size(m)
+func (dst *SubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionResponse.Merge(dst, src)
 }
-func (m *GetMerchantBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMerchantBalanceResponse.DiscardUnknown(m)
+func (m *SubscriptionResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionResponse.Size(m)
+}
+func (m *SubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMerchantBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubscriptionResponse proto.InternalMessageInfo
 
-func (m *GetMerchantBalanceResponse) GetStatus() int32 {
+func (m *SubscriptionResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetMerchantBalanceResponse) GetMessage() *ResponseErrorMessage {
+func (m *SubscriptionResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetMerchantBalanceResponse) GetItem() *billing.MerchantBalance {
+func (m *SubscriptionResponse) GetItem() *billing.Subscription {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type PayoutDocumentPdfUploadedRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required"
-	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty" validate:"required"`
-	// @inject_tag: validate:"required,numeric,gte=0"
-	RetentionTime int32 `protobuf:"varint,3,opt,name=retention_time,json=retentionTime,proto3" json:"retention_time,omitempty" validate:"required,numeric,gte=0"`
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	PayoutId             string   `protobuf:"bytes,4,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty" validate:"required,hexadecimal,len=24"`
-	Content              []byte   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+type CreateChargebackRequest struct {
+	// @inject_tag: json:"order_id" validate:"required,uuid"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id" validate:"required,uuid"`
+	// @inject_tag: json:"external_id" validate:"required"
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id" validate:"required"`
+	// @inject_tag: json:"amount" validate:"omitempty,numeric,gt=0"
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: json:"reason_code"
+	ReasonCode string `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code"`
+	// @inject_tag: json:"reason"
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason"`
+	// @inject_tag: json:"evidence_due_date" validate:"omitempty,numeric,gt=0"
+	EvidenceDueDate      int64    `protobuf:"varint,6,opt,name=evidence_due_date,json=evidenceDueDate,proto3" json:"evidence_due_date" validate:"omitempty,numeric,gt=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PayoutDocumentPdfUploadedRequest) Reset()         { *m = PayoutDocumentPdfUploadedRequest{} }
-func (m *PayoutDocumentPdfUploadedRequest) String() string { return proto.CompactTextString(m) }
-func (*PayoutDocumentPdfUploadedRequest) ProtoMessage()    {}
-func (*PayoutDocumentPdfUploadedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{209}
+func (m *CreateChargebackRequest) Reset()         { *m = CreateChargebackRequest{} }
+func (m *CreateChargebackRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateChargebackRequest) ProtoMessage()    {}
+func (*CreateChargebackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{272}
 }
-func (m *PayoutDocumentPdfUploadedRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Unmarshal(m, b)
+func (m *CreateChargebackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateChargebackRequest.Unmarshal(m, b)
 }
-func (m *PayoutDocumentPdfUploadedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Marshal(b, m, deterministic)
+func (m *CreateChargebackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateChargebackRequest.Marshal(b, m, deterministic)
 }
-func (dst *PayoutDocumentPdfUploadedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Merge(dst, src)
+func (dst *CreateChargebackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateChargebackRequest.Merge(dst, src)
 }
-func (m *PayoutDocumentPdfUploadedRequest) XXX_Size() int {
-	return xxx_messageInfo_PayoutDocumentPdfUploadedRequest.Size(m)
+func (m *CreateChargebackRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateChargebackRequest.Size(m)
 }
-func (m *PayoutDocumentPdfUploadedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayoutDocumentPdfUploadedRequest.DiscardUnknown(m)
+func (m *CreateChargebackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateChargebackRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayoutDocumentPdfUploadedRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateChargebackRequest proto.InternalMessageInfo
 
-func (m *PayoutDocumentPdfUploadedRequest) GetId() string {
+func (m *CreateChargebackRequest) GetOrderId() string {
 	if m != nil {
-		return m.Id
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *PayoutDocumentPdfUploadedRequest) GetFilename() string {
+func (m *CreateChargebackRequest) GetExternalId() string {
 	if m != nil {
-		return m.Filename
+		return m.ExternalId
 	}
 	return ""
 }
 
-func (m *PayoutDocumentPdfUploadedRequest) GetRetentionTime() int32 {
+func (m *CreateChargebackRequest) GetAmount() float64 {
 	if m != nil {
-		return m.RetentionTime
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *PayoutDocumentPdfUploadedRequest) GetPayoutId() string {
+func (m *CreateChargebackRequest) GetReasonCode() string {
 	if m != nil {
-		return m.PayoutId
+		return m.ReasonCode
 	}
 	return ""
 }
 
-func (m *PayoutDocumentPdfUploadedRequest) GetContent() []byte {
+func (m *CreateChargebackRequest) GetReason() string {
 	if m != nil {
-		return m.Content
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-type PayoutDocumentPdfUploadedResponse struct {
+func (m *CreateChargebackRequest) GetEvidenceDueDate() int64 {
+	if m != nil {
+		return m.EvidenceDueDate
+	}
+	return 0
+}
+
+type ChargebackRequest struct {
+	// @inject_tag: json:"chargeback_id" validate:"required,hexadecimal,len=24"
+	ChargebackId string `protobuf:"bytes,1,opt,name=chargeback_id,json=chargebackId,proto3" json:"chargeback_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ChargebackRequest) Reset()         { *m = ChargebackRequest{} }
+func (m *ChargebackRequest) String() string { return proto.CompactTextString(m) }
+func (*ChargebackRequest) ProtoMessage()    {}
+func (*ChargebackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{273}
+}
+func (m *ChargebackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChargebackRequest.Unmarshal(m, b)
+}
+func (m *ChargebackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChargebackRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChargebackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChargebackRequest.Merge(dst, src)
+}
+func (m *ChargebackRequest) XXX_Size() int {
+	return xxx_messageInfo_ChargebackRequest.Size(m)
+}
+func (m *ChargebackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChargebackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChargebackRequest proto.InternalMessageInfo
+
+func (m *ChargebackRequest) GetChargebackId() string {
+	if m != nil {
+		return m.ChargebackId
+	}
+	return ""
+}
+
+func (m *ChargebackRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+type ChargebackResponse struct {
 	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Chargeback   `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PayoutDocumentPdfUploadedResponse) Reset()         { *m = PayoutDocumentPdfUploadedResponse{} }
-func (m *PayoutDocumentPdfUploadedResponse) String() string { return proto.CompactTextString(m) }
-func (*PayoutDocumentPdfUploadedResponse) ProtoMessage()    {}
-func (*PayoutDocumentPdfUploadedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{210}
+func (m *ChargebackResponse) Reset()         { *m = ChargebackResponse{} }
+func (m *ChargebackResponse) String() string { return proto.CompactTextString(m) }
+func (*ChargebackResponse) ProtoMessage()    {}
+func (*ChargebackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{274}
 }
-func (m *PayoutDocumentPdfUploadedResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Unmarshal(m, b)
+func (m *ChargebackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChargebackResponse.Unmarshal(m, b)
 }
-func (m *PayoutDocumentPdfUploadedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Marshal(b, m, deterministic)
+func (m *ChargebackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChargebackResponse.Marshal(b, m, deterministic)
 }
-func (dst *PayoutDocumentPdfUploadedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Merge(dst, src)
+func (dst *ChargebackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChargebackResponse.Merge(dst, src)
 }
-func (m *PayoutDocumentPdfUploadedResponse) XXX_Size() int {
-	return xxx_messageInfo_PayoutDocumentPdfUploadedResponse.Size(m)
+func (m *ChargebackResponse) XXX_Size() int {
+	return xxx_messageInfo_ChargebackResponse.Size(m)
 }
-func (m *PayoutDocumentPdfUploadedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayoutDocumentPdfUploadedResponse.DiscardUnknown(m)
+func (m *ChargebackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChargebackResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayoutDocumentPdfUploadedResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChargebackResponse proto.InternalMessageInfo
 
-func (m *PayoutDocumentPdfUploadedResponse) GetStatus() int32 {
+func (m *ChargebackResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *PayoutDocumentPdfUploadedResponse) GetMessage() *ResponseErrorMessage {
+func (m *ChargebackResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-type OrderReceiptRequest struct {
-	// @inject_tag: validate:"required,uuid"
-	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
-	// @inject_tag: validate:"required,uuid"
-	ReceiptId            string   `protobuf:"bytes,2,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty" validate:"required,uuid"`
+func (m *ChargebackResponse) GetItem() *billing.Chargeback {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListChargebacksRequest struct {
+	// @inject_tag: json:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: json:"order_id" validate:"omitempty,uuid"
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id" validate:"omitempty,uuid"`
+	// @inject_tag: json:"status"
+	Status []string `protobuf:"bytes,3,rep,name=status,proto3" json:"status"`
+	// @inject_tag: json:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: json:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset" validate:"omitempty,numeric,gte=0"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderReceiptRequest) Reset()         { *m = OrderReceiptRequest{} }
-func (m *OrderReceiptRequest) String() string { return proto.CompactTextString(m) }
-func (*OrderReceiptRequest) ProtoMessage()    {}
-func (*OrderReceiptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{211}
+func (m *ListChargebacksRequest) Reset()         { *m = ListChargebacksRequest{} }
+func (m *ListChargebacksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChargebacksRequest) ProtoMessage()    {}
+func (*ListChargebacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{275}
 }
-func (m *OrderReceiptRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderReceiptRequest.Unmarshal(m, b)
+func (m *ListChargebacksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChargebacksRequest.Unmarshal(m, b)
 }
-func (m *OrderReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderReceiptRequest.Marshal(b, m, deterministic)
+func (m *ListChargebacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChargebacksRequest.Marshal(b, m, deterministic)
 }
-func (dst *OrderReceiptRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderReceiptRequest.Merge(dst, src)
+func (dst *ListChargebacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChargebacksRequest.Merge(dst, src)
 }
-func (m *OrderReceiptRequest) XXX_Size() int {
-	return xxx_messageInfo_OrderReceiptRequest.Size(m)
+func (m *ListChargebacksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChargebacksRequest.Size(m)
 }
-func (m *OrderReceiptRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderReceiptRequest.DiscardUnknown(m)
+func (m *ListChargebacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChargebacksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderReceiptRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListChargebacksRequest proto.InternalMessageInfo
 
-func (m *OrderReceiptRequest) GetOrderId() string {
+func (m *ListChargebacksRequest) GetMerchantId() string {
 	if m != nil {
-		return m.OrderId
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *OrderReceiptRequest) GetReceiptId() string {
+func (m *ListChargebacksRequest) GetOrderId() string {
 	if m != nil {
-		return m.ReceiptId
+		return m.OrderId
 	}
 	return ""
 }
 
-type OrderReceiptResponse struct {
-	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Receipt              *billing.OrderReceipt `protobuf:"bytes,3,opt,name=receipt,proto3" json:"receipt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *OrderReceiptResponse) Reset()         { *m = OrderReceiptResponse{} }
-func (m *OrderReceiptResponse) String() string { return proto.CompactTextString(m) }
-func (*OrderReceiptResponse) ProtoMessage()    {}
-func (*OrderReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{212}
-}
-func (m *OrderReceiptResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderReceiptResponse.Unmarshal(m, b)
-}
-func (m *OrderReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderReceiptResponse.Marshal(b, m, deterministic)
-}
-func (dst *OrderReceiptResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderReceiptResponse.Merge(dst, src)
-}
-func (m *OrderReceiptResponse) XXX_Size() int {
-	return xxx_messageInfo_OrderReceiptResponse.Size(m)
-}
-func (m *OrderReceiptResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderReceiptResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OrderReceiptResponse proto.InternalMessageInfo
-
-func (m *OrderReceiptResponse) GetStatus() int32 {
+func (m *ListChargebacksRequest) GetStatus() []string {
 	if m != nil {
 		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *OrderReceiptResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListChargebacksRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Message
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *OrderReceiptResponse) GetReceipt() *billing.OrderReceipt {
+func (m *ListChargebacksRequest) GetOffset() int32 {
 	if m != nil {
-		return m.Receipt
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-type GetProductResponse struct {
+type ListChargebacksResponse struct {
 	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *Product              `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Count                int32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.Chargeback `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetProductResponse) Reset()         { *m = GetProductResponse{} }
-func (m *GetProductResponse) String() string { return proto.CompactTextString(m) }
-func (*GetProductResponse) ProtoMessage()    {}
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{213}
+func (m *ListChargebacksResponse) Reset()         { *m = ListChargebacksResponse{} }
+func (m *ListChargebacksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChargebacksResponse) ProtoMessage()    {}
+func (*ListChargebacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{276}
 }
-func (m *GetProductResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProductResponse.Unmarshal(m, b)
+func (m *ListChargebacksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChargebacksResponse.Unmarshal(m, b)
 }
-func (m *GetProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProductResponse.Marshal(b, m, deterministic)
+func (m *ListChargebacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChargebacksResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetProductResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProductResponse.Merge(dst, src)
+func (dst *ListChargebacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChargebacksResponse.Merge(dst, src)
 }
-func (m *GetProductResponse) XXX_Size() int {
-	return xxx_messageInfo_GetProductResponse.Size(m)
+func (m *ListChargebacksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChargebacksResponse.Size(m)
 }
-func (m *GetProductResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProductResponse.DiscardUnknown(m)
+func (m *ListChargebacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChargebacksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProductResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListChargebacksResponse proto.InternalMessageInfo
 
-func (m *GetProductResponse) GetStatus() int32 {
+func (m *ListChargebacksResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetProductResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListChargebacksResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetProductResponse) GetItem() *Product {
+func (m *ListChargebacksResponse) GetCount() int32 {
 	if m != nil {
-		return m.Item
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-type GetPriceGroupByRegionResponse struct {
-	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"group"
-	Group                *billing.PriceGroup `protobuf:"bytes,3,opt,name=Group,proto3" json:"group"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ListChargebacksResponse) GetItems() []*billing.Chargeback {
+	if m != nil {
+		return m.Items
+	}
+	return nil
 }
 
-func (m *GetPriceGroupByRegionResponse) Reset()         { *m = GetPriceGroupByRegionResponse{} }
-func (m *GetPriceGroupByRegionResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPriceGroupByRegionResponse) ProtoMessage()    {}
-func (*GetPriceGroupByRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{214}
+type UploadChargebackEvidenceRequest struct {
+	// @inject_tag: json:"chargeback_id" validate:"required,hexadecimal,len=24"
+	ChargebackId string `protobuf:"bytes,1,opt,name=chargeback_id,json=chargebackId,proto3" json:"chargeback_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"file_name" validate:"required"
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name" validate:"required"`
+	// @inject_tag: json:"file_url" validate:"required,url"
+	FileUrl string `protobuf:"bytes,4,opt,name=file_url,json=fileUrl,proto3" json:"file_url" validate:"required,url"`
+	// @inject_tag: json:"comment"
+	Comment              string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *UploadChargebackEvidenceRequest) Reset()         { *m = UploadChargebackEvidenceRequest{} }
+func (m *UploadChargebackEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadChargebackEvidenceRequest) ProtoMessage()    {}
+func (*UploadChargebackEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{277}
 }
-func (m *GetPriceGroupByRegionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPriceGroupByRegionResponse.Unmarshal(m, b)
+func (m *UploadChargebackEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadChargebackEvidenceRequest.Unmarshal(m, b)
 }
-func (m *GetPriceGroupByRegionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPriceGroupByRegionResponse.Marshal(b, m, deterministic)
+func (m *UploadChargebackEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadChargebackEvidenceRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPriceGroupByRegionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPriceGroupByRegionResponse.Merge(dst, src)
+func (dst *UploadChargebackEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChargebackEvidenceRequest.Merge(dst, src)
 }
-func (m *GetPriceGroupByRegionResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPriceGroupByRegionResponse.Size(m)
+func (m *UploadChargebackEvidenceRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadChargebackEvidenceRequest.Size(m)
 }
-func (m *GetPriceGroupByRegionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPriceGroupByRegionResponse.DiscardUnknown(m)
+func (m *UploadChargebackEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChargebackEvidenceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPriceGroupByRegionResponse proto.InternalMessageInfo
+var xxx_messageInfo_UploadChargebackEvidenceRequest proto.InternalMessageInfo
 
-func (m *GetPriceGroupByRegionResponse) GetStatus() int32 {
+func (m *UploadChargebackEvidenceRequest) GetChargebackId() string {
 	if m != nil {
-		return m.Status
+		return m.ChargebackId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetPriceGroupByRegionResponse) GetMessage() *ResponseErrorMessage {
+func (m *UploadChargebackEvidenceRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Message
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetPriceGroupByRegionResponse) GetGroup() *billing.PriceGroup {
+func (m *UploadChargebackEvidenceRequest) GetFileName() string {
 	if m != nil {
-		return m.Group
+		return m.FileName
 	}
-	return nil
+	return ""
 }
 
-type GetPriceGroupByRegionRequest struct {
-	// @inject_tag: json:"region" validate:"required"
-	Region               string   `protobuf:"bytes,1,opt,name=region,proto3" json:"region" validate:"required"`
+func (m *UploadChargebackEvidenceRequest) GetFileUrl() string {
+	if m != nil {
+		return m.FileUrl
+	}
+	return ""
+}
+
+func (m *UploadChargebackEvidenceRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type CloseChargebackRequest struct {
+	// @inject_tag: json:"chargeback_id" validate:"required,hexadecimal,len=24"
+	ChargebackId string `protobuf:"bytes,1,opt,name=chargeback_id,json=chargebackId,proto3" json:"chargeback_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"is_won"
+	IsWon                bool     `protobuf:"varint,2,opt,name=is_won,json=isWon,proto3" json:"is_won"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPriceGroupByRegionRequest) Reset()         { *m = GetPriceGroupByRegionRequest{} }
-func (m *GetPriceGroupByRegionRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPriceGroupByRegionRequest) ProtoMessage()    {}
-func (*GetPriceGroupByRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{215}
+func (m *CloseChargebackRequest) Reset()         { *m = CloseChargebackRequest{} }
+func (m *CloseChargebackRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseChargebackRequest) ProtoMessage()    {}
+func (*CloseChargebackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{278}
 }
-func (m *GetPriceGroupByRegionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPriceGroupByRegionRequest.Unmarshal(m, b)
+func (m *CloseChargebackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseChargebackRequest.Unmarshal(m, b)
 }
-func (m *GetPriceGroupByRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPriceGroupByRegionRequest.Marshal(b, m, deterministic)
+func (m *CloseChargebackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseChargebackRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPriceGroupByRegionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPriceGroupByRegionRequest.Merge(dst, src)
+func (dst *CloseChargebackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseChargebackRequest.Merge(dst, src)
 }
-func (m *GetPriceGroupByRegionRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPriceGroupByRegionRequest.Size(m)
+func (m *CloseChargebackRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseChargebackRequest.Size(m)
 }
-func (m *GetPriceGroupByRegionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPriceGroupByRegionRequest.DiscardUnknown(m)
+func (m *CloseChargebackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseChargebackRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPriceGroupByRegionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CloseChargebackRequest proto.InternalMessageInfo
 
-func (m *GetPriceGroupByRegionRequest) GetRegion() string {
+func (m *CloseChargebackRequest) GetChargebackId() string {
 	if m != nil {
-		return m.Region
+		return m.ChargebackId
 	}
 	return ""
 }
 
-type ChangeMerchantManualPayoutsRequest struct {
+func (m *CloseChargebackRequest) GetIsWon() bool {
+	if m != nil {
+		return m.IsWon
+	}
+	return false
+}
+
+type FraudRuleRequest struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId           string   `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
-	ManualPayoutsEnabled bool     `protobuf:"varint,2,opt,name=manual_payouts_enabled,json=manualPayoutsEnabled,proto3" json:"manual_payouts_enabled,omitempty"`
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ChangeMerchantManualPayoutsRequest) Reset()         { *m = ChangeMerchantManualPayoutsRequest{} }
-func (m *ChangeMerchantManualPayoutsRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangeMerchantManualPayoutsRequest) ProtoMessage()    {}
-func (*ChangeMerchantManualPayoutsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{216}
+func (m *FraudRuleRequest) Reset()         { *m = FraudRuleRequest{} }
+func (m *FraudRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*FraudRuleRequest) ProtoMessage()    {}
+func (*FraudRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{279}
 }
-func (m *ChangeMerchantManualPayoutsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Unmarshal(m, b)
+func (m *FraudRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FraudRuleRequest.Unmarshal(m, b)
 }
-func (m *ChangeMerchantManualPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Marshal(b, m, deterministic)
+func (m *FraudRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FraudRuleRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChangeMerchantManualPayoutsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Merge(dst, src)
+func (dst *FraudRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudRuleRequest.Merge(dst, src)
 }
-func (m *ChangeMerchantManualPayoutsRequest) XXX_Size() int {
-	return xxx_messageInfo_ChangeMerchantManualPayoutsRequest.Size(m)
+func (m *FraudRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_FraudRuleRequest.Size(m)
 }
-func (m *ChangeMerchantManualPayoutsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeMerchantManualPayoutsRequest.DiscardUnknown(m)
+func (m *FraudRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudRuleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeMerchantManualPayoutsRequest proto.InternalMessageInfo
+var xxx_messageInfo_FraudRuleRequest proto.InternalMessageInfo
 
-func (m *ChangeMerchantManualPayoutsRequest) GetMerchantId() string {
+func (m *FraudRuleRequest) GetRuleId() string {
 	if m != nil {
-		return m.MerchantId
+		return m.RuleId
 	}
 	return ""
 }
 
-func (m *ChangeMerchantManualPayoutsRequest) GetManualPayoutsEnabled() bool {
+func (m *FraudRuleRequest) GetMerchantId() string {
 	if m != nil {
-		return m.ManualPayoutsEnabled
+		return m.MerchantId
 	}
-	return false
+	return ""
 }
 
-type ChangeMerchantManualPayoutsResponse struct {
+type FraudRuleResponse struct {
 	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Item                 *billing.Merchant     `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Item                 *billing.FraudRule    `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ChangeMerchantManualPayoutsResponse) Reset()         { *m = ChangeMerchantManualPayoutsResponse{} }
-func (m *ChangeMerchantManualPayoutsResponse) String() string { return proto.CompactTextString(m) }
-func (*ChangeMerchantManualPayoutsResponse) ProtoMessage()    {}
-func (*ChangeMerchantManualPayoutsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{217}
+func (m *FraudRuleResponse) Reset()         { *m = FraudRuleResponse{} }
+func (m *FraudRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*FraudRuleResponse) ProtoMessage()    {}
+func (*FraudRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{280}
 }
-func (m *ChangeMerchantManualPayoutsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Unmarshal(m, b)
+func (m *FraudRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FraudRuleResponse.Unmarshal(m, b)
 }
-func (m *ChangeMerchantManualPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Marshal(b, m, deterministic)
+func (m *FraudRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FraudRuleResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChangeMerchantManualPayoutsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Merge(dst, src)
+func (dst *FraudRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudRuleResponse.Merge(dst, src)
 }
-func (m *ChangeMerchantManualPayoutsResponse) XXX_Size() int {
-	return xxx_messageInfo_ChangeMerchantManualPayoutsResponse.Size(m)
+func (m *FraudRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_FraudRuleResponse.Size(m)
 }
-func (m *ChangeMerchantManualPayoutsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeMerchantManualPayoutsResponse.DiscardUnknown(m)
+func (m *FraudRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudRuleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeMerchantManualPayoutsResponse proto.InternalMessageInfo
+var xxx_messageInfo_FraudRuleResponse proto.InternalMessageInfo
 
-func (m *ChangeMerchantManualPayoutsResponse) GetStatus() int32 {
+func (m *FraudRuleResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *ChangeMerchantManualPayoutsResponse) GetMessage() *ResponseErrorMessage {
+func (m *FraudRuleResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *ChangeMerchantManualPayoutsResponse) GetItem() *billing.Merchant {
+func (m *FraudRuleResponse) GetItem() *billing.FraudRule {
 	if m != nil {
 		return m.Item
 	}
 	return nil
 }
 
-type GetPaylinksRequest struct {
+type ListFraudRulesRequest struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
 	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
-	// @inject_tag: validate:"required,numeric,gte=1"
-	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" validate:"required,numeric,gte=1"`
-	// @inject_tag: validate:"omitempty,numeric,gte=0"
-	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	ProjectId            string   `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"required,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinksRequest) Reset()         { *m = GetPaylinksRequest{} }
-func (m *GetPaylinksRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinksRequest) ProtoMessage()    {}
-func (*GetPaylinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{218}
+func (m *ListFraudRulesRequest) Reset()         { *m = ListFraudRulesRequest{} }
+func (m *ListFraudRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFraudRulesRequest) ProtoMessage()    {}
+func (*ListFraudRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{281}
 }
-func (m *GetPaylinksRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinksRequest.Unmarshal(m, b)
+func (m *ListFraudRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFraudRulesRequest.Unmarshal(m, b)
 }
-func (m *GetPaylinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinksRequest.Marshal(b, m, deterministic)
+func (m *ListFraudRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFraudRulesRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinksRequest.Merge(dst, src)
+func (dst *ListFraudRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFraudRulesRequest.Merge(dst, src)
 }
-func (m *GetPaylinksRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinksRequest.Size(m)
+func (m *ListFraudRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFraudRulesRequest.Size(m)
 }
-func (m *GetPaylinksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinksRequest.DiscardUnknown(m)
+func (m *ListFraudRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFraudRulesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinksRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListFraudRulesRequest proto.InternalMessageInfo
 
-func (m *GetPaylinksRequest) GetMerchantId() string {
+func (m *ListFraudRulesRequest) GetMerchantId() string {
 	if m != nil {
 		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *GetPaylinksRequest) GetProjectId() string {
+func (m *ListFraudRulesRequest) GetProjectId() string {
 	if m != nil {
 		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *GetPaylinksRequest) GetLimit() int32 {
-	if m != nil {
-		return m.Limit
-	}
-	return 0
-}
-
-func (m *GetPaylinksRequest) GetOffset() int32 {
-	if m != nil {
-		return m.Offset
-	}
-	return 0
-}
-
-type PaylinksPaginate struct {
-	// @inject_tag: json:"count"
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
-	Items                []*paylink.Paylink `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *PaylinksPaginate) Reset()         { *m = PaylinksPaginate{} }
-func (m *PaylinksPaginate) String() string { return proto.CompactTextString(m) }
-func (*PaylinksPaginate) ProtoMessage()    {}
-func (*PaylinksPaginate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{219}
-}
-func (m *PaylinksPaginate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaylinksPaginate.Unmarshal(m, b)
-}
-func (m *PaylinksPaginate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaylinksPaginate.Marshal(b, m, deterministic)
-}
-func (dst *PaylinksPaginate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaylinksPaginate.Merge(dst, src)
-}
-func (m *PaylinksPaginate) XXX_Size() int {
-	return xxx_messageInfo_PaylinksPaginate.Size(m)
-}
-func (m *PaylinksPaginate) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaylinksPaginate.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PaylinksPaginate proto.InternalMessageInfo
-
-func (m *PaylinksPaginate) GetCount() int32 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
-}
-
-func (m *PaylinksPaginate) GetItems() []*paylink.Paylink {
-	if m != nil {
-		return m.Items
-	}
-	return nil
-}
-
-type GetPaylinksResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"data"
-	Data                 *PaylinksPaginate `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListFraudRulesResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items                []*billing.FraudRule  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinksResponse) Reset()         { *m = GetPaylinksResponse{} }
-func (m *GetPaylinksResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinksResponse) ProtoMessage()    {}
-func (*GetPaylinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{220}
+func (m *ListFraudRulesResponse) Reset()         { *m = ListFraudRulesResponse{} }
+func (m *ListFraudRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFraudRulesResponse) ProtoMessage()    {}
+func (*ListFraudRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{282}
 }
-func (m *GetPaylinksResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinksResponse.Unmarshal(m, b)
+func (m *ListFraudRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFraudRulesResponse.Unmarshal(m, b)
 }
-func (m *GetPaylinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinksResponse.Marshal(b, m, deterministic)
+func (m *ListFraudRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFraudRulesResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinksResponse.Merge(dst, src)
+func (dst *ListFraudRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFraudRulesResponse.Merge(dst, src)
 }
-func (m *GetPaylinksResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinksResponse.Size(m)
+func (m *ListFraudRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListFraudRulesResponse.Size(m)
 }
-func (m *GetPaylinksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinksResponse.DiscardUnknown(m)
+func (m *ListFraudRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFraudRulesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinksResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListFraudRulesResponse proto.InternalMessageInfo
 
-func (m *GetPaylinksResponse) GetStatus() int32 {
+func (m *ListFraudRulesResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetPaylinksResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListFraudRulesResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetPaylinksResponse) GetData() *PaylinksPaginate {
+func (m *ListFraudRulesResponse) GetItems() []*billing.FraudRule {
 	if m != nil {
-		return m.Data
+		return m.Items
 	}
 	return nil
 }
 
-type PaylinkRequestById struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type PromoCodeResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.PromoCode    `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaylinkRequestById) Reset()         { *m = PaylinkRequestById{} }
-func (m *PaylinkRequestById) String() string { return proto.CompactTextString(m) }
-func (*PaylinkRequestById) ProtoMessage()    {}
-func (*PaylinkRequestById) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{221}
+func (m *PromoCodeResponse) Reset()         { *m = PromoCodeResponse{} }
+func (m *PromoCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*PromoCodeResponse) ProtoMessage()    {}
+func (*PromoCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{283}
 }
-func (m *PaylinkRequestById) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaylinkRequestById.Unmarshal(m, b)
+func (m *PromoCodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PromoCodeResponse.Unmarshal(m, b)
 }
-func (m *PaylinkRequestById) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaylinkRequestById.Marshal(b, m, deterministic)
+func (m *PromoCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PromoCodeResponse.Marshal(b, m, deterministic)
 }
-func (dst *PaylinkRequestById) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaylinkRequestById.Merge(dst, src)
+func (dst *PromoCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoCodeResponse.Merge(dst, src)
 }
-func (m *PaylinkRequestById) XXX_Size() int {
-	return xxx_messageInfo_PaylinkRequestById.Size(m)
+func (m *PromoCodeResponse) XXX_Size() int {
+	return xxx_messageInfo_PromoCodeResponse.Size(m)
 }
-func (m *PaylinkRequestById) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaylinkRequestById.DiscardUnknown(m)
+func (m *PromoCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoCodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaylinkRequestById proto.InternalMessageInfo
+var xxx_messageInfo_PromoCodeResponse proto.InternalMessageInfo
 
-func (m *PaylinkRequestById) GetId() string {
+func (m *PromoCodeResponse) GetStatus() int32 {
 	if m != nil {
-		return m.Id
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-type PaylinkRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+func (m *PromoCodeResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PromoCodeResponse) GetItem() *billing.PromoCode {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListPromoCodesRequest struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	ProjectId            string   `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaylinkRequest) Reset()         { *m = PaylinkRequest{} }
-func (m *PaylinkRequest) String() string { return proto.CompactTextString(m) }
-func (*PaylinkRequest) ProtoMessage()    {}
-func (*PaylinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{222}
+func (m *ListPromoCodesRequest) Reset()         { *m = ListPromoCodesRequest{} }
+func (m *ListPromoCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPromoCodesRequest) ProtoMessage()    {}
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{284}
 }
-func (m *PaylinkRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaylinkRequest.Unmarshal(m, b)
+func (m *ListPromoCodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPromoCodesRequest.Unmarshal(m, b)
 }
-func (m *PaylinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaylinkRequest.Marshal(b, m, deterministic)
+func (m *ListPromoCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPromoCodesRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaylinkRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaylinkRequest.Merge(dst, src)
+func (dst *ListPromoCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPromoCodesRequest.Merge(dst, src)
 }
-func (m *PaylinkRequest) XXX_Size() int {
-	return xxx_messageInfo_PaylinkRequest.Size(m)
+func (m *ListPromoCodesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPromoCodesRequest.Size(m)
 }
-func (m *PaylinkRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaylinkRequest.DiscardUnknown(m)
+func (m *ListPromoCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPromoCodesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaylinkRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListPromoCodesRequest proto.InternalMessageInfo
 
-func (m *PaylinkRequest) GetId() string {
+func (m *ListPromoCodesRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Id
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *PaylinkRequest) GetMerchantId() string {
+func (m *ListPromoCodesRequest) GetProjectId() string {
 	if m != nil {
-		return m.MerchantId
+		return m.ProjectId
 	}
 	return ""
 }
 
-type GetPaylinkResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"item"
-	Item                 *paylink.Paylink `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte           `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32            `json:"-" bson:"-" structure:"-" validate:"-"`
+type ListPromoCodesResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items                []*billing.PromoCode  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinkResponse) Reset()         { *m = GetPaylinkResponse{} }
-func (m *GetPaylinkResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinkResponse) ProtoMessage()    {}
-func (*GetPaylinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{223}
+func (m *ListPromoCodesResponse) Reset()         { *m = ListPromoCodesResponse{} }
+func (m *ListPromoCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPromoCodesResponse) ProtoMessage()    {}
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{285}
 }
-func (m *GetPaylinkResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinkResponse.Unmarshal(m, b)
+func (m *ListPromoCodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPromoCodesResponse.Unmarshal(m, b)
 }
-func (m *GetPaylinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinkResponse.Marshal(b, m, deterministic)
+func (m *ListPromoCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPromoCodesResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinkResponse.Merge(dst, src)
+func (dst *ListPromoCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPromoCodesResponse.Merge(dst, src)
 }
-func (m *GetPaylinkResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinkResponse.Size(m)
+func (m *ListPromoCodesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPromoCodesResponse.Size(m)
 }
-func (m *GetPaylinkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinkResponse.DiscardUnknown(m)
+func (m *ListPromoCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPromoCodesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinkResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListPromoCodesResponse proto.InternalMessageInfo
 
-func (m *GetPaylinkResponse) GetStatus() int32 {
+func (m *ListPromoCodesResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetPaylinkResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListPromoCodesResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetPaylinkResponse) GetItem() *paylink.Paylink {
+func (m *ListPromoCodesResponse) GetItems() []*billing.PromoCode {
 	if m != nil {
-		return m.Item
+		return m.Items
 	}
 	return nil
 }
 
-type GetPaylinkURLRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
-	UrlMask    string `protobuf:"bytes,3,opt,name=url_mask,json=urlMask,proto3" json:"url_mask,omitempty"`
-	// @inject_tag: query:"utm_source"
-	UtmSource string `protobuf:"bytes,4,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty" query:"utm_source"`
-	// @inject_tag: query:"utm_medium"
-	UtmMedium string `protobuf:"bytes,5,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty" query:"utm_medium"`
-	// @inject_tag: query:"utm_campaign"
-	UtmCampaign          string   `protobuf:"bytes,6,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty" query:"utm_campaign"`
+type PaymentFormApplyPromoCodeRequest struct {
+	// @inject_tag: validate:"required,uuid"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	// @inject_tag: validate:"required,alphanum,min=3,max=32"
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty" validate:"required,alphanum,min=3,max=32"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinkURLRequest) Reset()         { *m = GetPaylinkURLRequest{} }
-func (m *GetPaylinkURLRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinkURLRequest) ProtoMessage()    {}
-func (*GetPaylinkURLRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{224}
+func (m *PaymentFormApplyPromoCodeRequest) Reset()         { *m = PaymentFormApplyPromoCodeRequest{} }
+func (m *PaymentFormApplyPromoCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*PaymentFormApplyPromoCodeRequest) ProtoMessage()    {}
+func (*PaymentFormApplyPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{286}
 }
-func (m *GetPaylinkURLRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinkURLRequest.Unmarshal(m, b)
+func (m *PaymentFormApplyPromoCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentFormApplyPromoCodeRequest.Unmarshal(m, b)
 }
-func (m *GetPaylinkURLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinkURLRequest.Marshal(b, m, deterministic)
+func (m *PaymentFormApplyPromoCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentFormApplyPromoCodeRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinkURLRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinkURLRequest.Merge(dst, src)
+func (dst *PaymentFormApplyPromoCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormApplyPromoCodeRequest.Merge(dst, src)
 }
-func (m *GetPaylinkURLRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinkURLRequest.Size(m)
+func (m *PaymentFormApplyPromoCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_PaymentFormApplyPromoCodeRequest.Size(m)
 }
-func (m *GetPaylinkURLRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinkURLRequest.DiscardUnknown(m)
+func (m *PaymentFormApplyPromoCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentFormApplyPromoCodeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinkURLRequest proto.InternalMessageInfo
+var xxx_messageInfo_PaymentFormApplyPromoCodeRequest proto.InternalMessageInfo
 
-func (m *GetPaylinkURLRequest) GetId() string {
+func (m *PaymentFormApplyPromoCodeRequest) GetOrderId() string {
 	if m != nil {
-		return m.Id
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *GetPaylinkURLRequest) GetMerchantId() string {
+func (m *PaymentFormApplyPromoCodeRequest) GetCode() string {
 	if m != nil {
-		return m.MerchantId
+		return m.Code
 	}
 	return ""
 }
 
-func (m *GetPaylinkURLRequest) GetUrlMask() string {
+type ListWebhookDeliveriesRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	OrderId string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,dive,oneof=pending delivered failed"
+	Status []string `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty" validate:"omitempty,dive,oneof=pending delivered failed"`
+	// @inject_tag: validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListWebhookDeliveriesRequest) Reset()         { *m = ListWebhookDeliveriesRequest{} }
+func (m *ListWebhookDeliveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookDeliveriesRequest) ProtoMessage()    {}
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{287}
+}
+func (m *ListWebhookDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookDeliveriesRequest.Unmarshal(m, b)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookDeliveriesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListWebhookDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookDeliveriesRequest.Merge(dst, src)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookDeliveriesRequest.Size(m)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ListWebhookDeliveriesRequest) GetMerchantId() string {
 	if m != nil {
-		return m.UrlMask
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *GetPaylinkURLRequest) GetUtmSource() string {
+func (m *ListWebhookDeliveriesRequest) GetProjectId() string {
 	if m != nil {
-		return m.UtmSource
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *GetPaylinkURLRequest) GetUtmMedium() string {
+func (m *ListWebhookDeliveriesRequest) GetOrderId() string {
 	if m != nil {
-		return m.UtmMedium
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *GetPaylinkURLRequest) GetUtmCampaign() string {
+func (m *ListWebhookDeliveriesRequest) GetStatus() []string {
 	if m != nil {
-		return m.UtmCampaign
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-type GetPaylinkUrlResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"url"
-	Url                  string   `protobuf:"bytes,4,opt,name=url,proto3" json:"url"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *GetPaylinkUrlResponse) Reset()         { *m = GetPaylinkUrlResponse{} }
-func (m *GetPaylinkUrlResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinkUrlResponse) ProtoMessage()    {}
-func (*GetPaylinkUrlResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{225}
+func (m *ListWebhookDeliveriesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
-func (m *GetPaylinkUrlResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinkUrlResponse.Unmarshal(m, b)
+
+type ListWebhookDeliveriesResponse struct {
+	Status               int32                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                      `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.WebhookDelivery `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *GetPaylinkUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinkUrlResponse.Marshal(b, m, deterministic)
+
+func (m *ListWebhookDeliveriesResponse) Reset()         { *m = ListWebhookDeliveriesResponse{} }
+func (m *ListWebhookDeliveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookDeliveriesResponse) ProtoMessage()    {}
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{288}
 }
-func (dst *GetPaylinkUrlResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinkUrlResponse.Merge(dst, src)
+func (m *ListWebhookDeliveriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookDeliveriesResponse.Unmarshal(m, b)
 }
-func (m *GetPaylinkUrlResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinkUrlResponse.Size(m)
+func (m *ListWebhookDeliveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookDeliveriesResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPaylinkUrlResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinkUrlResponse.DiscardUnknown(m)
+func (dst *ListWebhookDeliveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookDeliveriesResponse.Merge(dst, src)
+}
+func (m *ListWebhookDeliveriesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookDeliveriesResponse.Size(m)
+}
+func (m *ListWebhookDeliveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookDeliveriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinkUrlResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListWebhookDeliveriesResponse proto.InternalMessageInfo
 
-func (m *GetPaylinkUrlResponse) GetStatus() int32 {
+func (m *ListWebhookDeliveriesResponse) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetPaylinkUrlResponse) GetMessage() *ResponseErrorMessage {
+func (m *ListWebhookDeliveriesResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetPaylinkUrlResponse) GetUrl() string {
+func (m *ListWebhookDeliveriesResponse) GetCount() int32 {
 	if m != nil {
-		return m.Url
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-type GetPaylinkStatCommonRequest struct {
-	// @inject_tag: validate:"required,hexadecimal"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal"`
+func (m *ListWebhookDeliveriesResponse) GetItems() []*billing.WebhookDelivery {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type WebhookDeliveryRequest struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: query:"period_from" validate:"omitempty,numeric,gte=0"
-	PeriodFrom int64 `protobuf:"varint,3,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty" query:"period_from" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: query:"period_to" validate:"omitempty,numeric,gte=0"
-	PeriodTo             int64    `protobuf:"varint,4,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty" query:"period_to" validate:"omitempty,numeric,gte=0"`
+	DeliveryId string `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinkStatCommonRequest) Reset()         { *m = GetPaylinkStatCommonRequest{} }
-func (m *GetPaylinkStatCommonRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinkStatCommonRequest) ProtoMessage()    {}
-func (*GetPaylinkStatCommonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{226}
+func (m *WebhookDeliveryRequest) Reset()         { *m = WebhookDeliveryRequest{} }
+func (m *WebhookDeliveryRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhookDeliveryRequest) ProtoMessage()    {}
+func (*WebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{289}
 }
-func (m *GetPaylinkStatCommonRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinkStatCommonRequest.Unmarshal(m, b)
+func (m *WebhookDeliveryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDeliveryRequest.Unmarshal(m, b)
 }
-func (m *GetPaylinkStatCommonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinkStatCommonRequest.Marshal(b, m, deterministic)
+func (m *WebhookDeliveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDeliveryRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinkStatCommonRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinkStatCommonRequest.Merge(dst, src)
+func (dst *WebhookDeliveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeliveryRequest.Merge(dst, src)
 }
-func (m *GetPaylinkStatCommonRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinkStatCommonRequest.Size(m)
+func (m *WebhookDeliveryRequest) XXX_Size() int {
+	return xxx_messageInfo_WebhookDeliveryRequest.Size(m)
 }
-func (m *GetPaylinkStatCommonRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinkStatCommonRequest.DiscardUnknown(m)
+func (m *WebhookDeliveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeliveryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinkStatCommonRequest proto.InternalMessageInfo
+var xxx_messageInfo_WebhookDeliveryRequest proto.InternalMessageInfo
 
-func (m *GetPaylinkStatCommonRequest) GetId() string {
+func (m *WebhookDeliveryRequest) GetDeliveryId() string {
 	if m != nil {
-		return m.Id
+		return m.DeliveryId
 	}
 	return ""
 }
 
-func (m *GetPaylinkStatCommonRequest) GetMerchantId() string {
+func (m *WebhookDeliveryRequest) GetMerchantId() string {
 	if m != nil {
 		return m.MerchantId
 	}
-	return ""
+	return ""
+}
+
+type WebhookDeliveryResponse struct {
+	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.WebhookDelivery `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *WebhookDeliveryResponse) Reset()         { *m = WebhookDeliveryResponse{} }
+func (m *WebhookDeliveryResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhookDeliveryResponse) ProtoMessage()    {}
+func (*WebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{290}
+}
+func (m *WebhookDeliveryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDeliveryResponse.Unmarshal(m, b)
+}
+func (m *WebhookDeliveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDeliveryResponse.Marshal(b, m, deterministic)
+}
+func (dst *WebhookDeliveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeliveryResponse.Merge(dst, src)
+}
+func (m *WebhookDeliveryResponse) XXX_Size() int {
+	return xxx_messageInfo_WebhookDeliveryResponse.Size(m)
+}
+func (m *WebhookDeliveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeliveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDeliveryResponse proto.InternalMessageInfo
+
+func (m *WebhookDeliveryResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
 }
 
-func (m *GetPaylinkStatCommonRequest) GetPeriodFrom() int64 {
+func (m *WebhookDeliveryResponse) GetMessage() *ResponseErrorMessage {
 	if m != nil {
-		return m.PeriodFrom
+		return m.Message
 	}
-	return 0
+	return nil
 }
 
-func (m *GetPaylinkStatCommonRequest) GetPeriodTo() int64 {
+func (m *WebhookDeliveryResponse) GetItem() *billing.WebhookDelivery {
 	if m != nil {
-		return m.PeriodTo
+		return m.Item
 	}
-	return 0
+	return nil
 }
 
-type GetPaylinkStatCommonResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
-	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"item"
-	Item                 *paylink.StatCommon `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+type ExportOrdersRequest struct {
+	// @inject_tag: validate:"required"
+	Filters *ListOrdersRequest `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,oneof=csv xlsx"
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty" validate:"required,oneof=csv xlsx"`
+	// columns of file in order of output, default columns are exported if empty
+	Columns []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// locale of column titles and dates
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	// identifier of the last exported order, export continues from the next order
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	Cursor               string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinkStatCommonResponse) Reset()         { *m = GetPaylinkStatCommonResponse{} }
-func (m *GetPaylinkStatCommonResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinkStatCommonResponse) ProtoMessage()    {}
-func (*GetPaylinkStatCommonResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{227}
+func (m *ExportOrdersRequest) Reset()         { *m = ExportOrdersRequest{} }
+func (m *ExportOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportOrdersRequest) ProtoMessage()    {}
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{291}
 }
-func (m *GetPaylinkStatCommonResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinkStatCommonResponse.Unmarshal(m, b)
+func (m *ExportOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportOrdersRequest.Unmarshal(m, b)
 }
-func (m *GetPaylinkStatCommonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinkStatCommonResponse.Marshal(b, m, deterministic)
+func (m *ExportOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportOrdersRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinkStatCommonResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinkStatCommonResponse.Merge(dst, src)
+func (dst *ExportOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportOrdersRequest.Merge(dst, src)
 }
-func (m *GetPaylinkStatCommonResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinkStatCommonResponse.Size(m)
+func (m *ExportOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportOrdersRequest.Size(m)
 }
-func (m *GetPaylinkStatCommonResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinkStatCommonResponse.DiscardUnknown(m)
+func (m *ExportOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinkStatCommonResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportOrdersRequest proto.InternalMessageInfo
 
-func (m *GetPaylinkStatCommonResponse) GetStatus() int32 {
+func (m *ExportOrdersRequest) GetFilters() *ListOrdersRequest {
 	if m != nil {
-		return m.Status
+		return m.Filters
 	}
-	return 0
+	return nil
 }
 
-func (m *GetPaylinkStatCommonResponse) GetMessage() *ResponseErrorMessage {
+func (m *ExportOrdersRequest) GetFormat() string {
 	if m != nil {
-		return m.Message
+		return m.Format
 	}
-	return nil
+	return ""
 }
 
-func (m *GetPaylinkStatCommonResponse) GetItem() *paylink.StatCommon {
+func (m *ExportOrdersRequest) GetColumns() []string {
 	if m != nil {
-		return m.Item
+		return m.Columns
 	}
 	return nil
 }
 
-type GetPaylinkStatCommonGroupResponse struct {
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	// @inject_tag: json:"message,omitempty"
+func (m *ExportOrdersRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *ExportOrdersRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ExportOrdersChunk struct {
+	Status  int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// @inject_tag: json:"item"
-	Item                 *paylink.GroupStatCommon `protobuf:"bytes,3,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	Content []byte                `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// identifier of the last order written to content
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Rows                 int32    `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *GetPaylinkStatCommonGroupResponse) Reset()         { *m = GetPaylinkStatCommonGroupResponse{} }
-func (m *GetPaylinkStatCommonGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPaylinkStatCommonGroupResponse) ProtoMessage()    {}
-func (*GetPaylinkStatCommonGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{228}
+func (m *ExportOrdersChunk) Reset()         { *m = ExportOrdersChunk{} }
+func (m *ExportOrdersChunk) String() string { return proto.CompactTextString(m) }
+func (*ExportOrdersChunk) ProtoMessage()    {}
+func (*ExportOrdersChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{292}
 }
-func (m *GetPaylinkStatCommonGroupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Unmarshal(m, b)
+func (m *ExportOrdersChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportOrdersChunk.Unmarshal(m, b)
 }
-func (m *GetPaylinkStatCommonGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Marshal(b, m, deterministic)
+func (m *ExportOrdersChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportOrdersChunk.Marshal(b, m, deterministic)
 }
-func (dst *GetPaylinkStatCommonGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Merge(dst, src)
+func (dst *ExportOrdersChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportOrdersChunk.Merge(dst, src)
 }
-func (m *GetPaylinkStatCommonGroupResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPaylinkStatCommonGroupResponse.Size(m)
+func (m *ExportOrdersChunk) XXX_Size() int {
+	return xxx_messageInfo_ExportOrdersChunk.Size(m)
 }
-func (m *GetPaylinkStatCommonGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPaylinkStatCommonGroupResponse.DiscardUnknown(m)
+func (m *ExportOrdersChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportOrdersChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPaylinkStatCommonGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportOrdersChunk proto.InternalMessageInfo
 
-func (m *GetPaylinkStatCommonGroupResponse) GetStatus() int32 {
+func (m *ExportOrdersChunk) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *GetPaylinkStatCommonGroupResponse) GetMessage() *ResponseErrorMessage {
+func (m *ExportOrdersChunk) GetMessage() *ResponseErrorMessage {
 	if m != nil {
 		return m.Message
 	}
 	return nil
 }
 
-func (m *GetPaylinkStatCommonGroupResponse) GetItem() *paylink.GroupStatCommon {
+func (m *ExportOrdersChunk) GetContent() []byte {
 	if m != nil {
-		return m.Item
+		return m.Content
 	}
 	return nil
 }
 
-type RoyaltyReportPdfUploadedRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required"
-	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty" validate:"required"`
-	// @inject_tag: validate:"required,numeric,gte=0"
-	RetentionTime int32 `protobuf:"varint,3,opt,name=retention_time,json=retentionTime,proto3" json:"retention_time,omitempty" validate:"required,numeric,gte=0"`
-	// @inject_tag: validate:"required,hexadecimal,len=24"
-	RoyaltyReportId      string   `protobuf:"bytes,4,opt,name=royalty_report_id,json=royaltyReportId,proto3" json:"royalty_report_id,omitempty" validate:"required,hexadecimal,len=24"`
-	Content              []byte   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *ExportOrdersChunk) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
 }
 
-func (m *RoyaltyReportPdfUploadedRequest) Reset()         { *m = RoyaltyReportPdfUploadedRequest{} }
-func (m *RoyaltyReportPdfUploadedRequest) String() string { return proto.CompactTextString(m) }
-func (*RoyaltyReportPdfUploadedRequest) ProtoMessage()    {}
-func (*RoyaltyReportPdfUploadedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{229}
+func (m *ExportOrdersChunk) GetRows() int32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
 }
-func (m *RoyaltyReportPdfUploadedRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Unmarshal(m, b)
+
+type OrdersExportJob struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId           string               `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Format               string               `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Status               string               `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	FileName             string               `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize             int64                `protobuf:"varint,6,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Rows                 int32                `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`
+	Error                string               `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt           *timestamp.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (m *RoyaltyReportPdfUploadedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Marshal(b, m, deterministic)
+
+func (m *OrdersExportJob) Reset()         { *m = OrdersExportJob{} }
+func (m *OrdersExportJob) String() string { return proto.CompactTextString(m) }
+func (*OrdersExportJob) ProtoMessage()    {}
+func (*OrdersExportJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{293}
 }
-func (dst *RoyaltyReportPdfUploadedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Merge(dst, src)
+func (m *OrdersExportJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrdersExportJob.Unmarshal(m, b)
 }
-func (m *RoyaltyReportPdfUploadedRequest) XXX_Size() int {
-	return xxx_messageInfo_RoyaltyReportPdfUploadedRequest.Size(m)
+func (m *OrdersExportJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrdersExportJob.Marshal(b, m, deterministic)
 }
-func (m *RoyaltyReportPdfUploadedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoyaltyReportPdfUploadedRequest.DiscardUnknown(m)
+func (dst *OrdersExportJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdersExportJob.Merge(dst, src)
+}
+func (m *OrdersExportJob) XXX_Size() int {
+	return xxx_messageInfo_OrdersExportJob.Size(m)
+}
+func (m *OrdersExportJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdersExportJob.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RoyaltyReportPdfUploadedRequest proto.InternalMessageInfo
+var xxx_messageInfo_OrdersExportJob proto.InternalMessageInfo
 
-func (m *RoyaltyReportPdfUploadedRequest) GetId() string {
+func (m *OrdersExportJob) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *RoyaltyReportPdfUploadedRequest) GetFilename() string {
+func (m *OrdersExportJob) GetMerchantId() string {
 	if m != nil {
-		return m.Filename
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *RoyaltyReportPdfUploadedRequest) GetRetentionTime() int32 {
+func (m *OrdersExportJob) GetFormat() string {
 	if m != nil {
-		return m.RetentionTime
+		return m.Format
 	}
-	return 0
+	return ""
 }
 
-func (m *RoyaltyReportPdfUploadedRequest) GetRoyaltyReportId() string {
+func (m *OrdersExportJob) GetStatus() string {
 	if m != nil {
-		return m.RoyaltyReportId
+		return m.Status
 	}
 	return ""
 }
 
-func (m *RoyaltyReportPdfUploadedRequest) GetContent() []byte {
+func (m *OrdersExportJob) GetFileName() string {
 	if m != nil {
-		return m.Content
+		return m.FileName
 	}
-	return nil
+	return ""
 }
 
-type RoyaltyReportPdfUploadedResponse struct {
-	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *OrdersExportJob) GetFileSize() int64 {
+	if m != nil {
+		return m.FileSize
+	}
+	return 0
 }
 
-func (m *RoyaltyReportPdfUploadedResponse) Reset()         { *m = RoyaltyReportPdfUploadedResponse{} }
-func (m *RoyaltyReportPdfUploadedResponse) String() string { return proto.CompactTextString(m) }
-func (*RoyaltyReportPdfUploadedResponse) ProtoMessage()    {}
-func (*RoyaltyReportPdfUploadedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{230}
-}
-func (m *RoyaltyReportPdfUploadedResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Unmarshal(m, b)
-}
-func (m *RoyaltyReportPdfUploadedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Marshal(b, m, deterministic)
-}
-func (dst *RoyaltyReportPdfUploadedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Merge(dst, src)
-}
-func (m *RoyaltyReportPdfUploadedResponse) XXX_Size() int {
-	return xxx_messageInfo_RoyaltyReportPdfUploadedResponse.Size(m)
-}
-func (m *RoyaltyReportPdfUploadedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoyaltyReportPdfUploadedResponse.DiscardUnknown(m)
+func (m *OrdersExportJob) GetRows() int32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
 }
 
-var xxx_messageInfo_RoyaltyReportPdfUploadedResponse proto.InternalMessageInfo
+func (m *OrdersExportJob) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
 
-func (m *RoyaltyReportPdfUploadedResponse) GetStatus() int32 {
+func (m *OrdersExportJob) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Status
+		return m.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *RoyaltyReportPdfUploadedResponse) GetMessage() *ResponseErrorMessage {
+func (m *OrdersExportJob) GetFinishedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Message
+		return m.FinishedAt
 	}
 	return nil
 }
 
-type DeleteSavedCardRequest struct {
+type OrdersExportJobRequest struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required"
-	Cookie               string   `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty" validate:"required"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *DeleteSavedCardRequest) Reset()         { *m = DeleteSavedCardRequest{} }
-func (m *DeleteSavedCardRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteSavedCardRequest) ProtoMessage()    {}
-func (*DeleteSavedCardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{231}
+func (m *OrdersExportJobRequest) Reset()         { *m = OrdersExportJobRequest{} }
+func (m *OrdersExportJobRequest) String() string { return proto.CompactTextString(m) }
+func (*OrdersExportJobRequest) ProtoMessage()    {}
+func (*OrdersExportJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{294}
 }
-func (m *DeleteSavedCardRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteSavedCardRequest.Unmarshal(m, b)
+func (m *OrdersExportJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrdersExportJobRequest.Unmarshal(m, b)
 }
-func (m *DeleteSavedCardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteSavedCardRequest.Marshal(b, m, deterministic)
+func (m *OrdersExportJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrdersExportJobRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteSavedCardRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteSavedCardRequest.Merge(dst, src)
+func (dst *OrdersExportJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdersExportJobRequest.Merge(dst, src)
 }
-func (m *DeleteSavedCardRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteSavedCardRequest.Size(m)
+func (m *OrdersExportJobRequest) XXX_Size() int {
+	return xxx_messageInfo_OrdersExportJobRequest.Size(m)
 }
-func (m *DeleteSavedCardRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteSavedCardRequest.DiscardUnknown(m)
+func (m *OrdersExportJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdersExportJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteSavedCardRequest proto.InternalMessageInfo
+var xxx_messageInfo_OrdersExportJobRequest proto.InternalMessageInfo
 
-func (m *DeleteSavedCardRequest) GetId() string {
+func (m *OrdersExportJobRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *DeleteSavedCardRequest) GetCookie() string {
+func (m *OrdersExportJobRequest) GetMerchantId() string {
 	if m != nil {
-		return m.Cookie
+		return m.MerchantId
 	}
 	return ""
 }
 
+type OrdersExportJobResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              *ResponseErrorMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *OrdersExportJob      `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrdersExportJobResponse) Reset()         { *m = OrdersExportJobResponse{} }
+func (m *OrdersExportJobResponse) String() string { return proto.CompactTextString(m) }
+func (*OrdersExportJobResponse) ProtoMessage()    {}
+func (*OrdersExportJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_grpc_c4ac89fa76d2fc52, []int{295}
+}
+func (m *OrdersExportJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrdersExportJobResponse.Unmarshal(m, b)
+}
+func (m *OrdersExportJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrdersExportJobResponse.Marshal(b, m, deterministic)
+}
+func (dst *OrdersExportJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdersExportJobResponse.Merge(dst, src)
+}
+func (m *OrdersExportJobResponse) XXX_Size() int {
+	return xxx_messageInfo_OrdersExportJobResponse.Size(m)
+}
+func (m *OrdersExportJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdersExportJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrdersExportJobResponse proto.InternalMessageInfo
+
+func (m *OrdersExportJobResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *OrdersExportJobResponse) GetMessage() *ResponseErrorMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *OrdersExportJobResponse) GetItem() *OrdersExportJob {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EmptyRequest)(nil), "grpc.EmptyRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "grpc.EmptyResponse")
@@ -14038,6 +17995,11 @@ func init() {
 	proto.RegisterType((*PlatformKeyReserveResponse)(nil), "grpc.PlatformKeyReserveResponse")
 	proto.RegisterType((*PlatformKeyReserveRequest)(nil), "grpc.PlatformKeyReserveRequest")
 	proto.RegisterType((*PlatformKeysFileResponse)(nil), "grpc.PlatformKeysFileResponse")
+	proto.RegisterType((*PlatformKeysFileLineResult)(nil), "grpc.PlatformKeysFileLineResult")
+	proto.RegisterType((*ManagePlatformKeysRequest)(nil), "grpc.ManagePlatformKeysRequest")
+	proto.RegisterType((*ManagePlatformKeysResponse)(nil), "grpc.ManagePlatformKeysResponse")
+	proto.RegisterType((*ExportPlatformKeysResponse)(nil), "grpc.ExportPlatformKeysResponse")
+	proto.RegisterType((*RotateKeysEncryptionKeyResponse)(nil), "grpc.RotateKeysEncryptionKeyResponse")
 	proto.RegisterType((*PlatformKeysFileRequest)(nil), "grpc.PlatformKeysFileRequest")
 	proto.RegisterType((*GetPlatformKeyCountRequest)(nil), "grpc.GetPlatformKeyCountRequest")
 	proto.RegisterType((*RemovePlatformRequest)(nil), "grpc.RemovePlatformRequest")
@@ -14154,6 +18116,16 @@ func init() {
 	proto.RegisterType((*ResponseErrorMessage)(nil), "grpc.ResponseErrorMessage")
 	proto.RegisterType((*CreateAccountingEntryRequest)(nil), "grpc.CreateAccountingEntryRequest")
 	proto.RegisterType((*CreateAccountingEntryResponse)(nil), "grpc.CreateAccountingEntryResponse")
+	proto.RegisterType((*LedgerTrialBalanceRequest)(nil), "grpc.LedgerTrialBalanceRequest")
+	proto.RegisterType((*LedgerTrialBalanceResponse)(nil), "grpc.LedgerTrialBalanceResponse")
+	proto.RegisterType((*ImportReconciliationReportRequest)(nil), "grpc.ImportReconciliationReportRequest")
+	proto.RegisterType((*ReconciliationRunResponse)(nil), "grpc.ReconciliationRunResponse")
+	proto.RegisterType((*ListReconciliationRunsRequest)(nil), "grpc.ListReconciliationRunsRequest")
+	proto.RegisterType((*ListReconciliationRunsResponse)(nil), "grpc.ListReconciliationRunsResponse")
+	proto.RegisterType((*ListReconciliationLinesRequest)(nil), "grpc.ListReconciliationLinesRequest")
+	proto.RegisterType((*ListReconciliationLinesResponse)(nil), "grpc.ListReconciliationLinesResponse")
+	proto.RegisterType((*ResolveReconciliationLineRequest)(nil), "grpc.ResolveReconciliationLineRequest")
+	proto.RegisterType((*ReconciliationLineResponse)(nil), "grpc.ReconciliationLineResponse")
 	proto.RegisterType((*CreateRoyaltyReportRequest)(nil), "grpc.CreateRoyaltyReportRequest")
 	proto.RegisterType((*ListRoyaltyReportsRequest)(nil), "grpc.ListRoyaltyReportsRequest")
 	proto.RegisterType((*RoyaltyReportsPaginate)(nil), "grpc.RoyaltyReportsPaginate")
@@ -14164,6 +18136,10 @@ func init() {
 	proto.RegisterType((*GetRoyaltyReportResponse)(nil), "grpc.GetRoyaltyReportResponse")
 	proto.RegisterType((*ChangeRoyaltyReportRequest)(nil), "grpc.ChangeRoyaltyReportRequest")
 	proto.RegisterType((*MerchantReviewRoyaltyReportRequest)(nil), "grpc.MerchantReviewRoyaltyReportRequest")
+	proto.RegisterType((*AddRoyaltyReportDisputeCorrectionRequest)(nil), "grpc.AddRoyaltyReportDisputeCorrectionRequest")
+	proto.RegisterType((*ResolveRoyaltyReportDisputeRequest)(nil), "grpc.ResolveRoyaltyReportDisputeRequest")
+	proto.RegisterType((*RoyaltyReportDisputeResponse)(nil), "grpc.RoyaltyReportDisputeResponse")
+	proto.RegisterType((*ListRoyaltyReportVersionsResponse)(nil), "grpc.ListRoyaltyReportVersionsResponse")
 	proto.RegisterType((*PaymentChannelCostSystemListResponse)(nil), "grpc.PaymentChannelCostSystemListResponse")
 	proto.RegisterType((*PaymentChannelCostSystemResponse)(nil), "grpc.PaymentChannelCostSystemResponse")
 	proto.RegisterType((*PaymentChannelCostMerchantListResponse)(nil), "grpc.PaymentChannelCostMerchantListResponse")
@@ -14203,6 +18179,10 @@ func init() {
 	proto.RegisterType((*VatReportsResponse)(nil), "grpc.VatReportsResponse")
 	proto.RegisterType((*ProcessVatReportsRequest)(nil), "grpc.ProcessVatReportsRequest")
 	proto.RegisterType((*UpdateVatReportStatusRequest)(nil), "grpc.UpdateVatReportStatusRequest")
+	proto.RegisterType((*CountryVatSettingsResponse)(nil), "grpc.CountryVatSettingsResponse")
+	proto.RegisterType((*CountryVatSettingsHistoryResponse)(nil), "grpc.CountryVatSettingsHistoryResponse")
+	proto.RegisterType((*VatOssReturnRequest)(nil), "grpc.VatOssReturnRequest")
+	proto.RegisterType((*VatOssReturnResponse)(nil), "grpc.VatOssReturnResponse")
 	proto.RegisterType((*GetMerchantOnboardingCompleteDataResponseItem)(nil), "grpc.GetMerchantOnboardingCompleteDataResponseItem")
 	proto.RegisterType((*GetMerchantOnboardingCompleteDataResponse)(nil), "grpc.GetMerchantOnboardingCompleteDataResponse")
 	proto.RegisterType((*GetMerchantAgreementSignUrlRequest)(nil), "grpc.GetMerchantAgreementSignUrlRequest")
@@ -14250,6 +18230,11 @@ func init() {
 	proto.RegisterType((*GetMerchantBalanceResponse)(nil), "grpc.GetMerchantBalanceResponse")
 	proto.RegisterType((*PayoutDocumentPdfUploadedRequest)(nil), "grpc.PayoutDocumentPdfUploadedRequest")
 	proto.RegisterType((*PayoutDocumentPdfUploadedResponse)(nil), "grpc.PayoutDocumentPdfUploadedResponse")
+	proto.RegisterType((*CreatePayoutBatchRequest)(nil), "grpc.CreatePayoutBatchRequest")
+	proto.RegisterType((*GetPayoutBatchRequest)(nil), "grpc.GetPayoutBatchRequest")
+	proto.RegisterType((*PayoutBatchResponse)(nil), "grpc.PayoutBatchResponse")
+	proto.RegisterType((*ImportPayoutStatusReportRequest)(nil), "grpc.ImportPayoutStatusReportRequest")
+	proto.RegisterType((*ImportPayoutStatusReportResponse)(nil), "grpc.ImportPayoutStatusReportResponse")
 	proto.RegisterType((*OrderReceiptRequest)(nil), "grpc.OrderReceiptRequest")
 	proto.RegisterType((*OrderReceiptResponse)(nil), "grpc.OrderReceiptResponse")
 	proto.RegisterType((*GetProductResponse)(nil), "grpc.GetProductResponse")
@@ -14257,6 +18242,8 @@ func init() {
 	proto.RegisterType((*GetPriceGroupByRegionRequest)(nil), "grpc.GetPriceGroupByRegionRequest")
 	proto.RegisterType((*ChangeMerchantManualPayoutsRequest)(nil), "grpc.ChangeMerchantManualPayoutsRequest")
 	proto.RegisterType((*ChangeMerchantManualPayoutsResponse)(nil), "grpc.ChangeMerchantManualPayoutsResponse")
+	proto.RegisterType((*ChangeMerchantPayoutCurrenciesRequest)(nil), "grpc.ChangeMerchantPayoutCurrenciesRequest")
+	proto.RegisterType((*ChangeMerchantPayoutCurrenciesResponse)(nil), "grpc.ChangeMerchantPayoutCurrenciesResponse")
 	proto.RegisterType((*GetPaylinksRequest)(nil), "grpc.GetPaylinksRequest")
 	proto.RegisterType((*PaylinksPaginate)(nil), "grpc.PaylinksPaginate")
 	proto.RegisterType((*GetPaylinksResponse)(nil), "grpc.GetPaylinksResponse")
@@ -14268,687 +18255,886 @@ func init() {
 	proto.RegisterType((*GetPaylinkStatCommonRequest)(nil), "grpc.GetPaylinkStatCommonRequest")
 	proto.RegisterType((*GetPaylinkStatCommonResponse)(nil), "grpc.GetPaylinkStatCommonResponse")
 	proto.RegisterType((*GetPaylinkStatCommonGroupResponse)(nil), "grpc.GetPaylinkStatCommonGroupResponse")
+	proto.RegisterType((*CreatePaylinkTokenResponse)(nil), "grpc.CreatePaylinkTokenResponse")
 	proto.RegisterType((*RoyaltyReportPdfUploadedRequest)(nil), "grpc.RoyaltyReportPdfUploadedRequest")
 	proto.RegisterType((*RoyaltyReportPdfUploadedResponse)(nil), "grpc.RoyaltyReportPdfUploadedResponse")
 	proto.RegisterType((*DeleteSavedCardRequest)(nil), "grpc.DeleteSavedCardRequest")
+	proto.RegisterType((*OrderAuthorizationRequest)(nil), "grpc.OrderAuthorizationRequest")
+	proto.RegisterType((*OrderAuthorizationResponse)(nil), "grpc.OrderAuthorizationResponse")
+	proto.RegisterType((*SubscriptionPlanResponse)(nil), "grpc.SubscriptionPlanResponse")
+	proto.RegisterType((*GetSubscriptionPlanRequest)(nil), "grpc.GetSubscriptionPlanRequest")
+	proto.RegisterType((*ListSubscriptionPlansRequest)(nil), "grpc.ListSubscriptionPlansRequest")
+	proto.RegisterType((*ListSubscriptionPlansResponse)(nil), "grpc.ListSubscriptionPlansResponse")
+	proto.RegisterType((*CreateSubscriptionRequest)(nil), "grpc.CreateSubscriptionRequest")
+	proto.RegisterType((*SubscriptionRequest)(nil), "grpc.SubscriptionRequest")
+	proto.RegisterType((*SubscriptionResponse)(nil), "grpc.SubscriptionResponse")
+	proto.RegisterType((*CreateChargebackRequest)(nil), "grpc.CreateChargebackRequest")
+	proto.RegisterType((*ChargebackRequest)(nil), "grpc.ChargebackRequest")
+	proto.RegisterType((*ChargebackResponse)(nil), "grpc.ChargebackResponse")
+	proto.RegisterType((*ListChargebacksRequest)(nil), "grpc.ListChargebacksRequest")
+	proto.RegisterType((*ListChargebacksResponse)(nil), "grpc.ListChargebacksResponse")
+	proto.RegisterType((*UploadChargebackEvidenceRequest)(nil), "grpc.UploadChargebackEvidenceRequest")
+	proto.RegisterType((*CloseChargebackRequest)(nil), "grpc.CloseChargebackRequest")
+	proto.RegisterType((*FraudRuleRequest)(nil), "grpc.FraudRuleRequest")
+	proto.RegisterType((*FraudRuleResponse)(nil), "grpc.FraudRuleResponse")
+	proto.RegisterType((*ListFraudRulesRequest)(nil), "grpc.ListFraudRulesRequest")
+	proto.RegisterType((*ListFraudRulesResponse)(nil), "grpc.ListFraudRulesResponse")
+	proto.RegisterType((*PromoCodeResponse)(nil), "grpc.PromoCodeResponse")
+	proto.RegisterType((*ListPromoCodesRequest)(nil), "grpc.ListPromoCodesRequest")
+	proto.RegisterType((*ListPromoCodesResponse)(nil), "grpc.ListPromoCodesResponse")
+	proto.RegisterType((*PaymentFormApplyPromoCodeRequest)(nil), "grpc.PaymentFormApplyPromoCodeRequest")
+	proto.RegisterType((*ListWebhookDeliveriesRequest)(nil), "grpc.ListWebhookDeliveriesRequest")
+	proto.RegisterType((*ListWebhookDeliveriesResponse)(nil), "grpc.ListWebhookDeliveriesResponse")
+	proto.RegisterType((*WebhookDeliveryRequest)(nil), "grpc.WebhookDeliveryRequest")
+	proto.RegisterType((*WebhookDeliveryResponse)(nil), "grpc.WebhookDeliveryResponse")
+	proto.RegisterType((*ExportOrdersRequest)(nil), "grpc.ExportOrdersRequest")
+	proto.RegisterType((*ExportOrdersChunk)(nil), "grpc.ExportOrdersChunk")
+	proto.RegisterType((*OrdersExportJob)(nil), "grpc.OrdersExportJob")
+	proto.RegisterType((*OrdersExportJobRequest)(nil), "grpc.OrdersExportJobRequest")
+	proto.RegisterType((*OrdersExportJobResponse)(nil), "grpc.OrdersExportJobResponse")
 }
 
 func init() { proto.RegisterFile("grpc/grpc.proto", fileDescriptor_grpc_c4ac89fa76d2fc52) }
 
 var fileDescriptor_grpc_c4ac89fa76d2fc52 = []byte{
-	// 10778 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0xcb,
-	0x75, 0x18, 0x9b, 0xc3, 0xe7, 0xe1, 0xbb, 0xf8, 0x9a, 0x1d, 0x92, 0xbb, 0xcb, 0xde, 0xdd, 0xbb,
-	0x0f, 0xed, 0xdd, 0xbd, 0xda, 0x7b, 0xb5, 0x92, 0x57, 0x96, 0x7c, 0xb9, 0xdc, 0xe5, 0x8a, 0xf7,
-	0xde, 0xbd, 0x4b, 0x35, 0xb9, 0x57, 0x57, 0xcf, 0x41, 0x73, 0xa6, 0x48, 0xb6, 0xd8, 0xd3, 0x3d,
-	0xea, 0xee, 0xe1, 0x6a, 0x64, 0x23, 0x82, 0x15, 0x1b, 0x70, 0xe2, 0x58, 0x4e, 0x6c, 0x29, 0x71,
-	0x94, 0x48, 0x71, 0x8c, 0x24, 0x82, 0xf3, 0x80, 0x8d, 0x18, 0x81, 0x0d, 0x23, 0x41, 0x5e, 0x40,
-	0x00, 0x7f, 0x04, 0xc9, 0x57, 0x82, 0x20, 0x5f, 0x09, 0x90, 0x20, 0x3f, 0x71, 0x0c, 0xe4, 0x33,
-	0x3f, 0x41, 0xbd, 0xba, 0xab, 0xba, 0xab, 0xbb, 0x67, 0x78, 0x77, 0x56, 0x36, 0xe0, 0x1f, 0x72,
-	0xba, 0x1e, 0xa7, 0x4e, 0x9d, 0x3a, 0x75, 0xea, 0xd4, 0xa9, 0x53, 0xa7, 0x60, 0xee, 0x38, 0x68,
-	0x37, 0xee, 0x92, 0x3f, 0x77, 0xda, 0x81, 0x1f, 0xf9, 0x68, 0x84, 0xfc, 0xae, 0x5d, 0x3a, 0xf6,
-	0xfd, 0x63, 0x17, 0xdf, 0xa5, 0x69, 0x87, 0x9d, 0xa3, 0xbb, 0x91, 0xd3, 0xc2, 0x61, 0x64, 0xb7,
-	0xda, 0xac, 0x58, 0x6d, 0xf9, 0xd0, 0x71, 0x5d, 0xc7, 0x3b, 0xbe, 0xcb, 0xff, 0x8b, 0xe4, 0xb6,
-	0xdd, 0x75, 0x1d, 0xef, 0xf4, 0x2e, 0xff, 0xcf, 0x92, 0xcd, 0x59, 0x98, 0x7e, 0xdc, 0x6a, 0x47,
-	0x5d, 0x0b, 0x7f, 0xa3, 0x83, 0xc3, 0xc8, 0x9c, 0x83, 0x19, 0xfe, 0x1d, 0xb6, 0x7d, 0x2f, 0xc4,
-	0xe6, 0x31, 0xac, 0x2a, 0x09, 0x5f, 0x70, 0xa2, 0x93, 0xfd, 0xc8, 0x8e, 0x3a, 0x21, 0x5a, 0x81,
-	0xb1, 0x90, 0xfe, 0xaa, 0x1a, 0x97, 0x8d, 0x1b, 0xa3, 0x16, 0xff, 0x42, 0x6f, 0xc1, 0x78, 0x0b,
-	0x87, 0xa1, 0x7d, 0x8c, 0xab, 0xc3, 0x97, 0x8d, 0x1b, 0x53, 0xf7, 0x6a, 0x77, 0x68, 0x37, 0x04,
-	0x88, 0xc7, 0x41, 0xe0, 0x07, 0x4f, 0x59, 0x09, 0x4b, 0x14, 0x35, 0x9f, 0xc1, 0xfa, 0xf6, 0x09,
-	0x6e, 0x9c, 0xee, 0x9f, 0x76, 0xb6, 0xbc, 0xe6, 0xbb, 0xb8, 0xbb, 0x17, 0xf8, 0x5f, 0xc7, 0x8d,
-	0x88, 0x63, 0x86, 0xe6, 0xa1, 0x12, 0x9e, 0x76, 0x68, 0x53, 0x93, 0x16, 0xf9, 0x89, 0x36, 0x00,
-	0xda, 0xac, 0x4c, 0xdd, 0x69, 0xd2, 0xa6, 0x26, 0xad, 0x49, 0x9e, 0xb2, 0xdb, 0x34, 0xbf, 0x0c,
-	0xd5, 0xed, 0x13, 0xdb, 0x3b, 0xc6, 0xdb, 0x7e, 0x13, 0xef, 0x7a, 0xcf, 0x82, 0x26, 0x0e, 0x04,
-	0xb0, 0x0b, 0x30, 0xe1, 0x93, 0x6f, 0x52, 0x91, 0x41, 0x1c, 0xa7, 0xdf, 0xbb, 0x4d, 0x74, 0x15,
-	0x66, 0x4f, 0x71, 0xb7, 0xde, 0x0e, 0xfc, 0x66, 0x47, 0x86, 0x3c, 0x7d, 0x4a, 0x51, 0x22, 0x89,
-	0xbb, 0x4d, 0xf3, 0x57, 0x0d, 0xb8, 0xa0, 0x81, 0xce, 0x3a, 0xf8, 0x72, 0x29, 0x83, 0xae, 0xc2,
-	0x28, 0x45, 0xae, 0x5a, 0xa1, 0x75, 0x66, 0xef, 0x88, 0x91, 0x65, 0x8d, 0xb2, 0x4c, 0xf3, 0xe7,
-	0x0d, 0x58, 0x7b, 0x82, 0xa3, 0x3d, 0xd7, 0x8e, 0x8e, 0xfc, 0xa0, 0xf5, 0x2e, 0xee, 0x6e, 0xfb,
-	0x1d, 0x2f, 0x1a, 0x10, 0x4e, 0x4b, 0x30, 0xda, 0x20, 0xe0, 0x29, 0x4e, 0xa3, 0x16, 0xfb, 0x30,
-	0x3f, 0x06, 0xe8, 0x5d, 0xdc, 0xdd, 0xf1, 0x03, 0x85, 0xd8, 0xcb, 0x30, 0x46, 0x28, 0x1a, 0x93,
-	0x7a, 0xf4, 0x14, 0x77, 0x77, 0x9b, 0xe6, 0xaf, 0x18, 0xb0, 0xf1, 0x04, 0x47, 0xd9, 0x0a, 0x03,
-	0x42, 0xf9, 0x22, 0x54, 0x4e, 0x71, 0x97, 0x13, 0x71, 0x3a, 0x26, 0xe2, 0xbb, 0xb8, 0x6b, 0x91,
-	0x0c, 0x42, 0xc0, 0x9a, 0x44, 0x3d, 0x0b, 0x87, 0x38, 0x38, 0xc3, 0x03, 0x42, 0x26, 0xa1, 0x49,
-	0x45, 0xa6, 0xc9, 0xef, 0x1a, 0x70, 0x41, 0x87, 0x03, 0x23, 0x64, 0x4f, 0xac, 0x89, 0x2e, 0xc1,
-	0x54, 0x0b, 0x07, 0x8d, 0x13, 0xdb, 0x8b, 0x12, 0xf8, 0x20, 0x92, 0x58, 0x81, 0x36, 0x6f, 0x83,
-	0x14, 0x18, 0x61, 0x05, 0x44, 0xd2, 0x6e, 0x93, 0x4c, 0xb5, 0x28, 0x72, 0xab, 0xa3, 0xb4, 0x9f,
-	0xe4, 0xa7, 0x32, 0x5f, 0xc6, 0x94, 0xf9, 0x42, 0x50, 0xae, 0x4a, 0x28, 0x87, 0x3b, 0x8e, 0x3b,
-	0x28, 0xa2, 0x5d, 0xa3, 0xfd, 0x0f, 0x09, 0x01, 0x1a, 0x38, 0x0c, 0x71, 0x93, 0x73, 0xdf, 0x0c,
-	0x49, 0xdd, 0x13, 0x89, 0xa4, 0x7f, 0x91, 0x1f, 0xd9, 0x6e, 0x9d, 0x71, 0xe8, 0x08, 0x2d, 0x03,
-	0x34, 0x89, 0x4e, 0x09, 0xf3, 0xfb, 0x06, 0xac, 0x66, 0x51, 0x66, 0x34, 0x46, 0x30, 0x72, 0xe4,
-	0xb8, 0x98, 0xe2, 0x3b, 0x6d, 0xd1, 0xdf, 0xaf, 0x8a, 0xee, 0xe6, 0x2f, 0x18, 0x50, 0xd3, 0x4e,
-	0xe1, 0xbc, 0xe1, 0x37, 0xca, 0xd1, 0x18, 0x2e, 0x43, 0xa3, 0x92, 0x41, 0xe3, 0xdb, 0xb0, 0x6c,
-	0xe1, 0x96, 0x7f, 0x86, 0x05, 0x22, 0xaf, 0x1a, 0x81, 0xef, 0x1a, 0x54, 0x8e, 0x70, 0x90, 0x03,
-	0x62, 0xa6, 0x5b, 0x30, 0xce, 0x3b, 0xc2, 0x45, 0xc2, 0x3c, 0xab, 0x25, 0x35, 0x2c, 0x0a, 0x98,
-	0x7f, 0x34, 0x0e, 0x97, 0xb6, 0x03, 0x6c, 0x47, 0xf8, 0x59, 0xf0, 0xbc, 0xdd, 0xb4, 0x23, 0x2c,
-	0xa3, 0xc7, 0x88, 0xb3, 0x0d, 0x23, 0x9e, 0xdd, 0x22, 0x8c, 0x53, 0xb9, 0x31, 0x75, 0xef, 0x2e,
-	0x03, 0x56, 0x52, 0xe9, 0xce, 0xfb, 0x76, 0x0b, 0x3f, 0xf6, 0xa2, 0xa0, 0x6b, 0xd1, 0xca, 0x25,
-	0x4b, 0x1a, 0xfa, 0x10, 0xa6, 0x9a, 0x38, 0x6c, 0x04, 0x4e, 0x3b, 0x72, 0x7c, 0xaf, 0x5a, 0xa1,
-	0x4d, 0xdd, 0xef, 0xad, 0xa9, 0x47, 0x49, 0x45, 0xd6, 0xa2, 0x0c, 0x0a, 0x61, 0x98, 0x77, 0x7d,
-	0xef, 0xb8, 0x2e, 0x83, 0x1f, 0xa1, 0xe0, 0x1f, 0xf4, 0x06, 0xfe, 0x3d, 0xdf, 0x3b, 0xce, 0x34,
-	0x31, 0xe7, 0xaa, 0xa9, 0x62, 0x11, 0x1f, 0x4d, 0x16, 0xf1, 0x3b, 0x64, 0x21, 0x39, 0xc3, 0x01,
-	0x15, 0x2b, 0x53, 0xf7, 0xaa, 0xb1, 0x5c, 0xde, 0x6d, 0xd9, 0x64, 0x71, 0x75, 0x5d, 0xdc, 0x20,
-	0x55, 0x2d, 0x56, 0x8c, 0x40, 0xe8, 0x04, 0x6e, 0x75, 0x9c, 0x41, 0xe8, 0x04, 0x2e, 0xba, 0x09,
-	0xf3, 0x4d, 0x7c, 0x64, 0x77, 0xdc, 0xa8, 0xde, 0xe8, 0x04, 0x01, 0xf6, 0x1a, 0xdd, 0xea, 0x04,
-	0xcd, 0x9e, 0xe3, 0xe9, 0xdb, 0x3c, 0x39, 0xcd, 0x9a, 0x93, 0x19, 0xd6, 0x9c, 0x85, 0x61, 0xa7,
-	0x59, 0x05, 0x9a, 0x3e, 0xec, 0x34, 0x09, 0xcb, 0xf9, 0x87, 0x84, 0xf8, 0xd5, 0x29, 0x9a, 0xc6,
-	0xbf, 0xd0, 0x33, 0x98, 0x68, 0xe1, 0xc8, 0x6e, 0xda, 0x91, 0x5d, 0x9d, 0xa6, 0x64, 0x7a, 0xb3,
-	0x37, 0x32, 0x3d, 0xe5, 0xb5, 0x18, 0x7d, 0x62, 0x20, 0xe8, 0xe3, 0x30, 0x29, 0x26, 0x40, 0x58,
-	0x9d, 0xa1, 0x10, 0x17, 0x19, 0x44, 0x31, 0x09, 0xf7, 0x02, 0xa7, 0x81, 0xad, 0xa4, 0x14, 0xaa,
-	0x12, 0x06, 0x76, 0x1a, 0x8e, 0x77, 0x5c, 0x9d, 0x65, 0x22, 0x99, 0x7f, 0xa2, 0x4d, 0x98, 0xe6,
-	0x54, 0xac, 0x47, 0xdd, 0x36, 0xae, 0xce, 0xd1, 0xec, 0x29, 0x9e, 0x76, 0xd0, 0x6d, 0xe3, 0xda,
-	0x27, 0x61, 0x32, 0xe6, 0x3d, 0x42, 0x53, 0xb2, 0x32, 0x72, 0xd5, 0xea, 0x14, 0x77, 0xc9, 0xf2,
-	0x7e, 0x66, 0xbb, 0x1d, 0xcc, 0x59, 0x90, 0x7d, 0x3c, 0x18, 0xfe, 0x94, 0x51, 0xfb, 0x2c, 0xcc,
-	0xa7, 0x87, 0xb9, 0xaf, 0xfa, 0x0f, 0x61, 0x49, 0xc7, 0x2a, 0x7d, 0xc1, 0xf8, 0x34, 0xcc, 0x28,
-	0x74, 0xec, 0xa7, 0xb2, 0xf9, 0x10, 0x6a, 0xcf, 0xbd, 0xbd, 0xce, 0xa1, 0xeb, 0x84, 0x27, 0xd9,
-	0x59, 0xdc, 0x93, 0x88, 0x33, 0x6d, 0xa8, 0x7e, 0x34, 0x08, 0xa5, 0x42, 0xd2, 0x7c, 0x04, 0x4b,
-	0xef, 0x39, 0x61, 0xbc, 0x16, 0x84, 0x02, 0xfc, 0x12, 0x8c, 0xba, 0x4e, 0xcb, 0x89, 0xb8, 0x0c,
-	0x64, 0x1f, 0x94, 0x4f, 0x8f, 0x8e, 0x42, 0x1c, 0x51, 0x48, 0xa3, 0x16, 0xff, 0x32, 0xff, 0xb3,
-	0x01, 0xcb, 0x29, 0x30, 0x83, 0x52, 0x07, 0x19, 0x56, 0x15, 0x3d, 0x56, 0x23, 0x32, 0x56, 0x89,
-	0xf2, 0x38, 0x2a, 0x29, 0x8f, 0xe8, 0xb6, 0x3c, 0x05, 0xc6, 0xe8, 0x14, 0x98, 0x55, 0xa7, 0x80,
-	0xc4, 0xfd, 0xe6, 0x7f, 0x31, 0x60, 0x95, 0xf4, 0x2c, 0x19, 0x80, 0x3f, 0xdd, 0x7d, 0x9b, 0xe0,
-	0x0c, 0x21, 0xba, 0x96, 0x5d, 0x6d, 0xe2, 0x12, 0xe6, 0xbf, 0x33, 0x60, 0x25, 0xd3, 0xb7, 0x58,
-	0x3d, 0xe1, 0xab, 0x0c, 0x61, 0x18, 0xb6, 0x68, 0x70, 0xa1, 0x3a, 0x9c, 0x08, 0xd5, 0xfe, 0x50,
-	0x4e, 0xf1, 0xe2, 0x68, 0x46, 0x2a, 0xaa, 0xab, 0xd2, 0x58, 0x7a, 0x55, 0xaa, 0xc2, 0x38, 0xf6,
-	0xec, 0x43, 0x17, 0x37, 0xb9, 0x58, 0x16, 0x9f, 0xe6, 0x7f, 0x33, 0x60, 0x69, 0xcf, 0xee, 0xb6,
-	0xb0, 0x17, 0x31, 0xc1, 0x28, 0xba, 0xf1, 0x29, 0x18, 0xa1, 0xb2, 0x93, 0x2d, 0x96, 0x57, 0xf9,
-	0x30, 0x6b, 0x4a, 0xde, 0x79, 0x14, 0x0b, 0x4b, 0x5a, 0x83, 0x4a, 0xe8, 0x36, 0xd7, 0x19, 0x86,
-	0x9d, 0x36, 0xba, 0x0e, 0x73, 0x76, 0xa3, 0x81, 0xdb, 0x51, 0xdd, 0xb5, 0xbd, 0xe3, 0x0e, 0x19,
-	0x5b, 0xa6, 0x58, 0xcd, 0xb2, 0xe4, 0xf7, 0x78, 0x2a, 0xe9, 0x44, 0x27, 0xc4, 0x41, 0xdd, 0x3e,
-	0xc6, 0x7c, 0x74, 0x26, 0xad, 0x49, 0x92, 0xb2, 0x45, 0x12, 0x88, 0x40, 0x7c, 0x74, 0x2e, 0x79,
-	0xf2, 0x4f, 0x0c, 0x58, 0x4e, 0x61, 0x3e, 0x10, 0x36, 0xdc, 0x84, 0xe9, 0x00, 0x37, 0x9d, 0x80,
-	0x8c, 0x02, 0x59, 0x01, 0x19, 0x09, 0xa6, 0x44, 0xda, 0xf3, 0xc0, 0x45, 0x57, 0x60, 0xc6, 0xc3,
-	0xb8, 0x59, 0x17, 0x69, 0x94, 0x12, 0x13, 0xd6, 0x34, 0x49, 0xb4, 0x78, 0x9a, 0xf9, 0x2f, 0x2a,
-	0x50, 0xe3, 0xf8, 0xee, 0xf8, 0x41, 0xeb, 0x9d, 0xd0, 0xf7, 0x48, 0xc7, 0x7b, 0xd8, 0x19, 0x93,
-	0xfe, 0x34, 0x4e, 0x70, 0x4b, 0x10, 0x81, 0x7f, 0x11, 0x9e, 0x3c, 0xf1, 0xc3, 0x88, 0x63, 0x44,
-	0x7f, 0x93, 0xb2, 0xae, 0xdf, 0xb0, 0x5d, 0x31, 0x1a, 0xfc, 0x8b, 0x0f, 0xdf, 0x68, 0x3c, 0x7c,
-	0xea, 0xa8, 0x8c, 0xa5, 0x46, 0x85, 0x80, 0x69, 0xf8, 0xfe, 0xa9, 0x83, 0x39, 0x67, 0xf1, 0x2f,
-	0xc2, 0x72, 0x01, 0x3e, 0xc2, 0x01, 0x0e, 0xf8, 0x52, 0x2f, 0x3e, 0x09, 0x33, 0x3b, 0x61, 0x1d,
-	0xb7, 0x0e, 0x71, 0xb3, 0x89, 0xd9, 0x12, 0x3f, 0x61, 0x81, 0x13, 0x3e, 0xe6, 0x29, 0x44, 0x5d,
-	0x70, 0xc2, 0xb0, 0x83, 0x83, 0x3a, 0xab, 0xe2, 0x35, 0x30, 0x5f, 0xf0, 0xe7, 0x58, 0xba, 0x25,
-	0x92, 0xd1, 0x3d, 0x58, 0x4e, 0x17, 0x65, 0x0b, 0x2a, 0x53, 0x06, 0x16, 0x53, 0xe5, 0xc9, 0xc2,
-	0x4a, 0x3b, 0x14, 0xb5, 0xea, 0xa1, 0xdf, 0x09, 0x1a, 0xb8, 0x3a, 0xcd, 0x3b, 0x14, 0xb5, 0xf6,
-	0x69, 0x82, 0xc8, 0x6e, 0xe1, 0xa6, 0xd3, 0x69, 0x55, 0x67, 0xe2, 0xec, 0xa7, 0x34, 0x81, 0x0c,
-	0x32, 0xc9, 0x6e, 0xd8, 0xad, 0xb6, 0xed, 0x1c, 0x7b, 0x7c, 0x61, 0x9f, 0xea, 0x44, 0xad, 0x6d,
-	0x9e, 0x64, 0xba, 0xda, 0xe1, 0xe3, 0xc6, 0x12, 0xad, 0x7c, 0xb8, 0x04, 0x53, 0x9d, 0xc0, 0xad,
-	0x87, 0x9d, 0x06, 0xd9, 0x20, 0x89, 0xb5, 0xa6, 0x13, 0xb8, 0xfb, 0x2c, 0x85, 0x8c, 0x39, 0x29,
-	0x70, 0x64, 0x3b, 0x82, 0xad, 0xc6, 0x3b, 0x81, 0xbb, 0x63, 0x3b, 0xae, 0xf9, 0xbf, 0x46, 0x61,
-	0x51, 0xd3, 0x1c, 0x57, 0x94, 0x8c, 0x58, 0x51, 0xaa, 0xc2, 0xb8, 0xdd, 0x60, 0x82, 0x8f, 0xc1,
-	0x17, 0x9f, 0x68, 0x15, 0xc6, 0x4f, 0xec, 0xb0, 0x7e, 0x66, 0x33, 0x06, 0x99, 0xb0, 0xc6, 0x4e,
-	0xec, 0xf0, 0x03, 0x9b, 0x1a, 0x74, 0x48, 0x22, 0xe1, 0x0f, 0xc3, 0x22, 0x3f, 0xc9, 0x68, 0xdb,
-	0xad, 0x58, 0x78, 0x1a, 0x16, 0xff, 0x22, 0x54, 0x61, 0x1b, 0x3a, 0x9e, 0x3b, 0x46, 0x73, 0xd9,
-	0x26, 0x6f, 0x8b, 0x15, 0xa9, 0xc1, 0x44, 0xac, 0xfc, 0x31, 0x56, 0x89, 0xbf, 0xd1, 0x03, 0xaa,
-	0xe9, 0x53, 0x2d, 0x6e, 0x82, 0xce, 0xb7, 0xcb, 0x8a, 0xbc, 0xd1, 0x90, 0xd1, 0x12, 0x15, 0xd0,
-	0x3b, 0x30, 0xd7, 0x66, 0xc5, 0xea, 0x2d, 0x1c, 0x9d, 0xf8, 0xcd, 0xb0, 0x3a, 0x49, 0x65, 0xd6,
-	0x66, 0xac, 0xa8, 0x4a, 0x60, 0xf8, 0xcf, 0xa7, 0xb4, 0xa4, 0x35, 0xdb, 0x96, 0x3f, 0x43, 0x22,
-	0x43, 0x22, 0xff, 0x14, 0x7b, 0x9c, 0x7d, 0xd8, 0x07, 0xfa, 0x34, 0xd4, 0xd8, 0x0c, 0x68, 0x36,
-	0x03, 0x1c, 0x86, 0x75, 0x22, 0xe5, 0xea, 0x01, 0xfe, 0x46, 0xc7, 0x09, 0x70, 0x93, 0x32, 0xd0,
-	0x84, 0xb5, 0x4a, 0x67, 0x04, 0x2b, 0x20, 0x26, 0x2b, 0xc9, 0x46, 0xf7, 0x60, 0x9a, 0x56, 0x76,
-	0xda, 0xb4, 0x1e, 0x65, 0xa8, 0x78, 0x6d, 0x79, 0x1e, 0xe2, 0x60, 0xb7, 0x4d, 0xcb, 0xd3, 0x49,
-	0xc6, 0x7e, 0xa3, 0x1b, 0x30, 0xea, 0x44, 0xb8, 0x15, 0x56, 0x67, 0x69, 0x47, 0x90, 0x6a, 0x4e,
-	0xda, 0x8d, 0x70, 0xcb, 0x62, 0x05, 0xa4, 0xd9, 0x37, 0xa7, 0xcc, 0xbe, 0x25, 0x18, 0xc5, 0x2d,
-	0xc2, 0x2c, 0xf3, 0xac, 0x23, 0xf4, 0x03, 0x5d, 0x56, 0x37, 0x27, 0x0b, 0x8c, 0x75, 0xe5, 0x4d,
-	0xc6, 0xa7, 0xa0, 0x4a, 0x79, 0x22, 0xe8, 0xd6, 0x39, 0x69, 0xc2, 0xba, 0xed, 0xba, 0xfe, 0x0b,
-	0xdc, 0xac, 0x22, 0xda, 0xd1, 0x15, 0x9e, 0xcf, 0x09, 0x19, 0x6e, 0xb1, 0x5c, 0xf4, 0x16, 0x88,
-	0x9c, 0x7a, 0x83, 0x5a, 0xdd, 0xe2, 0x7a, 0x8b, 0xb4, 0xde, 0x12, 0xcf, 0x65, 0x26, 0x39, 0x51,
-	0x4b, 0xd1, 0x28, 0x96, 0xca, 0x34, 0x8a, 0x1f, 0x18, 0xb0, 0xa6, 0x15, 0x8c, 0x03, 0x11, 0xe7,
-	0xaf, 0xc3, 0x08, 0x21, 0x32, 0xdf, 0x7b, 0x5e, 0xc8, 0xe5, 0x48, 0x8b, 0x16, 0x33, 0x9d, 0x78,
-	0x21, 0x7d, 0xdf, 0x8f, 0x9c, 0xa3, 0x6e, 0x0f, 0xe2, 0x9a, 0xca, 0x48, 0x5a, 0x8a, 0xe2, 0x35,
-	0x6d, 0x89, 0x4f, 0xb4, 0x0e, 0x93, 0xa1, 0x73, 0xec, 0xd9, 0x51, 0x27, 0xc0, 0x7c, 0xc2, 0x27,
-	0x09, 0xe6, 0xe3, 0x78, 0x3d, 0x13, 0x4d, 0x95, 0x10, 0x80, 0xb0, 0x03, 0xe9, 0xa3, 0x58, 0x1b,
-	0xe9, 0x87, 0xf9, 0x27, 0x06, 0x2c, 0x3c, 0xf3, 0x0e, 0x7d, 0x3b, 0x68, 0x3a, 0xde, 0xb1, 0xc0,
-	0x37, 0x2d, 0x37, 0x6e, 0xc2, 0x08, 0x61, 0x4d, 0x4e, 0xb9, 0xe5, 0x98, 0x17, 0x9f, 0x72, 0xed,
-	0x83, 0xf0, 0xb0, 0x45, 0x8b, 0xa0, 0xfb, 0x30, 0xde, 0xf0, 0x5b, 0x6d, 0xdb, 0x13, 0x36, 0xbc,
-	0xf5, 0x4c, 0xe9, 0x6d, 0x96, 0xbf, 0xeb, 0x1d, 0xf9, 0x96, 0x28, 0x8c, 0xde, 0x82, 0x89, 0x86,
-	0xef, 0x45, 0x36, 0xd1, 0xbd, 0x66, 0x53, 0x9b, 0xcc, 0xa4, 0x22, 0x2d, 0x60, 0xc5, 0x25, 0xd1,
-	0x3d, 0x18, 0x3f, 0xb4, 0xbd, 0x53, 0xb2, 0xbb, 0x9a, 0xcb, 0xa9, 0xf4, 0x90, 0xe5, 0x5b, 0xa2,
-	0xa0, 0xb9, 0x09, 0x73, 0x3b, 0x8e, 0xd7, 0x7c, 0xd8, 0xdd, 0x6d, 0xe6, 0xf4, 0xd7, 0xfc, 0xc1,
-	0x08, 0xac, 0x88, 0xfa, 0x44, 0xc5, 0x93, 0x48, 0xa3, 0x13, 0xdd, 0x6b, 0x30, 0xe9, 0x84, 0x75,
-	0x32, 0x36, 0xb8, 0xc9, 0x55, 0xfb, 0x09, 0x27, 0xdc, 0xa7, 0xdf, 0xe8, 0xe3, 0xb0, 0xec, 0xda,
-	0x61, 0x44, 0xe6, 0x92, 0xdf, 0x89, 0x88, 0x00, 0xc0, 0xf5, 0xa3, 0xc0, 0x67, 0x3c, 0x55, 0xb1,
-	0x10, 0xc9, 0xdc, 0xa3, 0x79, 0x8f, 0xec, 0x08, 0xef, 0x04, 0x7e, 0x0b, 0xbd, 0x0e, 0x8b, 0x99,
-	0x2a, 0x91, 0x4f, 0x65, 0x70, 0xc5, 0x9a, 0x57, 0x2b, 0x1c, 0xf8, 0xe8, 0x36, 0x20, 0xb9, 0xb8,
-	0x22, 0x9c, 0xa5, 0xd2, 0x5c, 0x06, 0x23, 0x18, 0x09, 0xfd, 0x20, 0xa2, 0x0a, 0xee, 0xa4, 0x45,
-	0x7f, 0x27, 0x9a, 0xe8, 0xb8, 0x5e, 0x13, 0x9d, 0x50, 0x34, 0xd1, 0x4d, 0x98, 0xfe, 0x46, 0xc7,
-	0x69, 0x9c, 0xd6, 0x43, 0x6c, 0x07, 0x8d, 0x13, 0xbe, 0x41, 0x9f, 0xa2, 0x69, 0xfb, 0x34, 0x89,
-	0x08, 0x7a, 0xc6, 0x76, 0x38, 0xac, 0xc2, 0xe5, 0x0a, 0x21, 0x88, 0xf8, 0x26, 0x52, 0x22, 0xc0,
-	0xc7, 0x4e, 0x18, 0x05, 0x36, 0x91, 0x37, 0x12, 0x45, 0xa6, 0x68, 0x07, 0x97, 0xe4, 0xdc, 0x98,
-	0x26, 0x6f, 0xc0, 0x52, 0xb6, 0x56, 0xe4, 0x53, 0xd1, 0x5b, 0xb1, 0x50, 0xba, 0x0e, 0x23, 0x4b,
-	0x80, 0x1b, 0xd8, 0x39, 0xc3, 0x4d, 0xa9, 0x8d, 0x19, 0x46, 0x44, 0x91, 0x13, 0xc3, 0xbf, 0x01,
-	0xf3, 0x6a, 0xe9, 0xc8, 0xa7, 0x7c, 0x58, 0xb1, 0x66, 0xe5, 0xb2, 0x07, 0xbe, 0xf9, 0x21, 0xac,
-	0x66, 0x78, 0x83, 0xcf, 0xbd, 0x78, 0x5b, 0x61, 0xc8, 0xdb, 0x8a, 0xeb, 0x42, 0x94, 0x0f, 0x53,
-	0xe1, 0xb6, 0x90, 0x61, 0x51, 0x2e, 0xc9, 0xcd, 0xbf, 0x64, 0xc0, 0x5a, 0xcc, 0xeb, 0x54, 0x46,
-	0xb2, 0x33, 0x1c, 0xc1, 0x7b, 0xa9, 0x2d, 0x80, 0x91, 0xd9, 0x02, 0xac, 0xc2, 0x38, 0x5b, 0x68,
-	0xc4, 0x5e, 0x75, 0x8c, 0xae, 0x28, 0x4d, 0x49, 0x28, 0x54, 0x14, 0xa1, 0x50, 0x4d, 0xa4, 0x22,
-	0xd3, 0x00, 0xe3, 0x83, 0x9e, 0x6f, 0xc3, 0x22, 0x15, 0x2c, 0x4e, 0x83, 0xd2, 0xf4, 0xa3, 0xa3,
-	0x40, 0xd6, 0x55, 0x27, 0x72, 0x85, 0x28, 0x63, 0x1f, 0x05, 0x08, 0x58, 0x30, 0x23, 0x23, 0x10,
-	0xe6, 0x10, 0xf7, 0x63, 0x2a, 0x71, 0x13, 0xd9, 0xa4, 0x60, 0xcf, 0x09, 0xfc, 0xcf, 0x0c, 0xa8,
-	0xf1, 0x31, 0x7b, 0xb9, 0x9d, 0xe3, 0x12, 0xa0, 0x1b, 0x8a, 0xc5, 0x82, 0x49, 0x00, 0xfa, 0x9d,
-	0xcc, 0xae, 0x11, 0xfd, 0xec, 0x1a, 0x55, 0x66, 0x97, 0x66, 0x7e, 0x9a, 0xc7, 0xb0, 0xce, 0xd1,
-	0x16, 0xec, 0xa1, 0xe8, 0x30, 0xe8, 0x49, 0x56, 0xff, 0x61, 0x7b, 0xb6, 0x8b, 0x19, 0x5e, 0x2b,
-	0x54, 0x7e, 0x4c, 0x0f, 0x2e, 0x3d, 0xc1, 0x91, 0xbe, 0x6c, 0xaf, 0x44, 0xba, 0x05, 0x0b, 0x2a,
-	0x32, 0x09, 0xb9, 0xe6, 0x94, 0xe6, 0x76, 0x9b, 0xe6, 0xdf, 0x33, 0xe0, 0x72, 0x7e, 0x83, 0x03,
-	0x59, 0xd2, 0xef, 0x29, 0x4b, 0x7a, 0x19, 0x81, 0xd8, 0xba, 0xfe, 0x4b, 0x06, 0x6c, 0x92, 0x01,
-	0xd0, 0x96, 0xe9, 0x7d, 0x7a, 0xde, 0x81, 0xc5, 0x14, 0x65, 0xe8, 0x52, 0xc2, 0x68, 0xb3, 0xa0,
-	0xd0, 0xe6, 0x7d, 0x9b, 0x6d, 0xd9, 0x28, 0x2b, 0x54, 0x24, 0x56, 0xf8, 0xef, 0xc3, 0xb0, 0xde,
-	0xcf, 0xf8, 0x64, 0x0d, 0xfb, 0xfb, 0x30, 0xab, 0x62, 0xc1, 0x49, 0x71, 0xbb, 0x98, 0x14, 0xbb,
-	0x4d, 0xec, 0x49, 0x53, 0x66, 0x46, 0x41, 0x17, 0xed, 0x02, 0x34, 0xfc, 0x56, 0xcb, 0x09, 0x43,
-	0x66, 0x93, 0x26, 0x00, 0x6f, 0x16, 0x03, 0xdc, 0x8e, 0xcb, 0x87, 0x96, 0x54, 0x19, 0xbd, 0x0b,
-	0x53, 0x8e, 0x17, 0xe1, 0x63, 0x26, 0xcc, 0xe9, 0xec, 0x28, 0x85, 0xb5, 0x9b, 0x54, 0xb0, 0xe4,
-	0xda, 0x7c, 0x62, 0xda, 0x8d, 0xc8, 0x39, 0xc3, 0x74, 0x47, 0x32, 0x41, 0x26, 0xe6, 0x16, 0xfd,
-	0x96, 0xa7, 0xf3, 0xb8, 0x3c, 0x9d, 0xcd, 0xbf, 0x6b, 0xc0, 0xc6, 0x9f, 0x76, 0x9e, 0xfc, 0xcb,
-	0x06, 0x2c, 0x4a, 0x53, 0x67, 0x60, 0x87, 0x79, 0x32, 0x66, 0x9a, 0xa5, 0x8b, 0x21, 0x73, 0x08,
-	0x2b, 0x4f, 0x70, 0x74, 0x2e, 0x99, 0x7a, 0x1d, 0xe6, 0x3c, 0xa9, 0x5e, 0xc2, 0xb3, 0xb3, 0x72,
-	0x32, 0x93, 0x15, 0x8b, 0xc2, 0x76, 0x73, 0xd4, 0xf1, 0x9a, 0xbd, 0xd9, 0x42, 0xb8, 0x36, 0x34,
-	0xac, 0x6c, 0x55, 0x37, 0x00, 0x1a, 0x04, 0x92, 0x1f, 0x24, 0x47, 0x5b, 0x93, 0x3c, 0x85, 0x55,
-	0x0b, 0xb0, 0x1d, 0x72, 0x46, 0x9e, 0xb4, 0xf8, 0x17, 0xba, 0x02, 0x33, 0x4e, 0x48, 0xb6, 0x36,
-	0xc1, 0x31, 0x3e, 0xb4, 0x1b, 0xa7, 0x94, 0x37, 0x27, 0xac, 0x69, 0x27, 0xdc, 0x8e, 0xd3, 0xc8,
-	0x22, 0xbe, 0xa4, 0xa2, 0x39, 0x90, 0x81, 0xb9, 0xa2, 0x0c, 0xcc, 0x5c, 0x3c, 0x30, 0xbc, 0x51,
-	0x36, 0x2c, 0x5f, 0x05, 0x44, 0xc4, 0x16, 0x4b, 0x0b, 0x7b, 0x20, 0x58, 0x5f, 0x8a, 0xa0, 0x69,
-	0xc1, 0xa2, 0x02, 0xbe, 0x50, 0x0b, 0xba, 0xa6, 0x2e, 0xd4, 0x19, 0x8c, 0xf9, 0x12, 0xfd, 0x0e,
-	0xcc, 0x3f, 0xc1, 0x51, 0xcf, 0x23, 0xbc, 0x06, 0x93, 0x01, 0x2d, 0x9b, 0xf0, 0xcd, 0x04, 0x4b,
-	0xd8, 0x6d, 0x9a, 0x5f, 0x85, 0xb9, 0x6d, 0xdb, 0x75, 0xc9, 0xb0, 0x08, 0x50, 0x55, 0x18, 0x3f,
-	0xb1, 0xbd, 0xa6, 0x8b, 0x03, 0x01, 0x89, 0x7f, 0x12, 0x61, 0x7b, 0xe8, 0x37, 0xbb, 0x7c, 0x17,
-	0x46, 0x7f, 0x97, 0x6c, 0xc1, 0x4e, 0x60, 0x43, 0xda, 0x0a, 0x92, 0x6d, 0x20, 0x53, 0xda, 0x7a,
-	0xc1, 0x1b, 0xc1, 0x88, 0x6b, 0x7b, 0xc7, 0x1c, 0x65, 0xfa, 0x5b, 0xb6, 0xce, 0x54, 0x14, 0xeb,
-	0x8c, 0xf9, 0x55, 0xb8, 0x2a, 0xb5, 0x44, 0x76, 0x5b, 0xac, 0xa5, 0xf4, 0xd1, 0x6f, 0x41, 0x83,
-	0x35, 0x98, 0x10, 0x9b, 0x68, 0x41, 0x27, 0xf1, 0x6d, 0xfe, 0xb6, 0x01, 0x97, 0xb5, 0xf0, 0xdf,
-	0xb3, 0x93, 0x8d, 0x4f, 0x9f, 0x9d, 0x19, 0x90, 0x05, 0xd8, 0xfc, 0x4f, 0x06, 0xdc, 0xd2, 0xd3,
-	0x82, 0x25, 0x6e, 0x31, 0x92, 0xf5, 0xc6, 0x3a, 0x69, 0xfd, 0x64, 0xa2, 0xc5, 0x97, 0xba, 0xfc,
-	0xb1, 0xe0, 0x1d, 0x1b, 0x29, 0xea, 0xd8, 0x68, 0x0f, 0x1d, 0x4b, 0x1b, 0x51, 0xcd, 0xf7, 0x00,
-	0x12, 0x53, 0x10, 0x69, 0x9f, 0x1b, 0x4b, 0x04, 0xda, 0xfc, 0x93, 0x10, 0xbb, 0xe1, 0x44, 0x5d,
-	0x41, 0x6c, 0xf2, 0x1b, 0xcd, 0x43, 0xe5, 0x5b, 0x31, 0xb5, 0xc9, 0x4f, 0xf3, 0x07, 0xc3, 0xb0,
-	0xa9, 0x65, 0x4e, 0x31, 0x4b, 0x77, 0x89, 0x66, 0x5a, 0x6c, 0xd5, 0x32, 0xfa, 0xb3, 0x6a, 0x0d,
-	0xf7, 0x60, 0xd5, 0x5a, 0x82, 0xd1, 0xc3, 0xc0, 0xf6, 0x62, 0x7f, 0x1a, 0xfa, 0xf1, 0xaa, 0x2d,
-	0x4e, 0xe6, 0x3f, 0x34, 0x72, 0x66, 0xee, 0x80, 0x64, 0xf5, 0xa7, 0x15, 0x59, 0x7d, 0x3d, 0x63,
-	0x45, 0xd2, 0x8f, 0x0e, 0x97, 0xe1, 0x18, 0xd6, 0xb9, 0xd3, 0xcc, 0x43, 0x26, 0x30, 0xf9, 0x58,
-	0xf4, 0x66, 0x5b, 0x12, 0x4c, 0x34, 0xac, 0x32, 0x11, 0x67, 0x98, 0x91, 0x84, 0x61, 0xfe, 0x83,
-	0x01, 0x9b, 0x39, 0xed, 0x48, 0x0c, 0x23, 0x99, 0x89, 0x87, 0x75, 0x66, 0xe2, 0x8a, 0xce, 0x4c,
-	0x3c, 0x52, 0x68, 0x26, 0x1e, 0x2d, 0x36, 0x13, 0x8f, 0xa5, 0xcc, 0xc4, 0xb1, 0x5d, 0x74, 0xbc,
-	0xc4, 0x2e, 0xca, 0x46, 0xb9, 0xa8, 0x47, 0xaf, 0x64, 0x94, 0xcb, 0x48, 0xca, 0x47, 0x79, 0x0f,
-	0x96, 0x24, 0x65, 0xee, 0x61, 0xf7, 0x23, 0x6f, 0x49, 0xcd, 0x1f, 0xc5, 0xbe, 0x8f, 0x02, 0xaa,
-	0x7c, 0x80, 0x54, 0x0a, 0xf7, 0x2d, 0x58, 0x21, 0x23, 0x1d, 0x17, 0x52, 0xd7, 0xc1, 0x09, 0x6b,
-	0xe9, 0xc4, 0x0e, 0x05, 0xe0, 0x7d, 0x91, 0x47, 0xf6, 0x7e, 0xa4, 0x56, 0x3b, 0x6c, 0x4b, 0x15,
-	0xd8, 0xf9, 0xd6, 0xdc, 0x89, 0x1d, 0xee, 0x85, 0xed, 0xb8, 0xac, 0xf9, 0xd7, 0x0c, 0xa8, 0xe9,
-	0x10, 0xfc, 0x49, 0xea, 0xb1, 0xbf, 0x62, 0xc0, 0x8a, 0x8a, 0xd3, 0x4f, 0x16, 0x9f, 0x5f, 0x37,
-	0x60, 0x5d, 0xc5, 0x47, 0x58, 0x84, 0x7e, 0x92, 0x58, 0x7d, 0x9f, 0x8c, 0x1c, 0x55, 0x71, 0x55,
-	0x8d, 0x7f, 0x20, 0x38, 0xdd, 0x54, 0x70, 0xca, 0xb1, 0xef, 0x30, 0xbc, 0xbe, 0x6b, 0x40, 0x8d,
-	0x8a, 0x01, 0x86, 0x1c, 0x9f, 0x7b, 0x03, 0xc2, 0xcb, 0x54, 0xf0, 0x4a, 0xbb, 0xfb, 0x32, 0x84,
-	0x5c, 0xd8, 0xd8, 0x4f, 0x66, 0xf5, 0xfe, 0x9b, 0x5b, 0xc7, 0x01, 0xc6, 0x44, 0xea, 0xf7, 0x63,
-	0x4c, 0x09, 0xdf, 0xac, 0xdb, 0xa2, 0x9e, 0x6c, 0x30, 0x98, 0x0b, 0x13, 0x78, 0xef, 0xdb, 0x2d,
-	0x6c, 0xfe, 0x1f, 0x03, 0x66, 0x13, 0x67, 0x84, 0x5d, 0xef, 0xc8, 0xcf, 0x18, 0xf2, 0x4b, 0x3c,
-	0xd7, 0x84, 0x71, 0xbb, 0x22, 0x19, 0xb7, 0x53, 0x07, 0x46, 0x23, 0xd9, 0x03, 0xa3, 0x9b, 0x1a,
-	0xaf, 0x34, 0xa6, 0x02, 0x65, 0x3c, 0xcb, 0x56, 0x60, 0xcc, 0x69, 0xd9, 0xc7, 0x38, 0xe4, 0xe6,
-	0x2d, 0xfe, 0x85, 0x3e, 0x21, 0x9f, 0x01, 0x31, 0xc9, 0xbe, 0xaa, 0x71, 0xac, 0xa2, 0x47, 0x06,
-	0xd2, 0x61, 0x10, 0x86, 0x85, 0x4c, 0x7e, 0xa6, 0xcf, 0xa2, 0x53, 0xc3, 0x52, 0xa7, 0x6e, 0xc3,
-	0x68, 0x9b, 0x54, 0xe0, 0xa3, 0xb7, 0x12, 0x0b, 0x6b, 0x42, 0xb9, 0xa4, 0x29, 0x56, 0xc8, 0xfc,
-	0x36, 0xcc, 0xa7, 0xb3, 0xa4, 0xe5, 0xcd, 0x50, 0x96, 0x37, 0x79, 0xed, 0x1a, 0x4e, 0xad, 0x5d,
-	0x74, 0x5f, 0x79, 0xcc, 0x7c, 0x02, 0xf9, 0xbe, 0x92, 0x7c, 0xf1, 0xd3, 0xf0, 0x23, 0xbe, 0x55,
-	0xe1, 0xf2, 0x12, 0x9c, 0x70, 0x87, 0xa7, 0x98, 0x7f, 0x34, 0x01, 0x90, 0x8c, 0x6c, 0xa6, 0x87,
-	0xa5, 0x26, 0x1f, 0x75, 0xd8, 0x2b, 0xe9, 0x61, 0x4f, 0xfc, 0xe7, 0x46, 0x14, 0xff, 0x39, 0x9d,
-	0x1f, 0x20, 0xa3, 0x25, 0xf3, 0x8e, 0xa9, 0xa5, 0xbd, 0x63, 0x32, 0x9e, 0x92, 0x3a, 0xaf, 0xbf,
-	0x71, 0xbd, 0xd7, 0x9f, 0xe4, 0x9f, 0x32, 0x41, 0x09, 0x20, 0x3e, 0x55, 0xaf, 0xbb, 0xc9, 0x9e,
-	0xbc, 0xee, 0xb6, 0x55, 0xa6, 0x05, 0x7e, 0x18, 0x9c, 0x46, 0xb7, 0xd8, 0xdb, 0x72, 0x4f, 0xc3,
-	0xd7, 0x53, 0x14, 0xd2, 0xb5, 0x0c, 0xa4, 0xde, 0x1c, 0x2b, 0x7f, 0x8a, 0xdb, 0x1d, 0x70, 0xb3,
-	0x6e, 0x47, 0xf4, 0xe8, 0x82, 0x12, 0x91, 0xde, 0x15, 0xb9, 0x23, 0xee, 0x8a, 0xdc, 0x39, 0x10,
-	0x77, 0x45, 0xb8, 0x4d, 0x02, 0x37, 0xb7, 0x22, 0x52, 0xb5, 0x43, 0xbd, 0x15, 0x69, 0xd5, 0x99,
-	0xf2, 0xaa, 0xbc, 0xf4, 0x56, 0x94, 0x38, 0x6f, 0xce, 0xf6, 0xe5, 0xbc, 0x39, 0x97, 0x38, 0x6f,
-	0x3e, 0x90, 0x1c, 0x29, 0xe7, 0xb9, 0x61, 0x39, 0x4d, 0x81, 0x3c, 0x9f, 0xc9, 0x2a, 0x8c, 0x37,
-	0xb1, 0x8b, 0x23, 0xdc, 0xa4, 0x87, 0xcd, 0x13, 0x96, 0xf8, 0x44, 0x9f, 0x81, 0xe9, 0x36, 0xf3,
-	0xcf, 0x63, 0x9d, 0x42, 0xa5, 0x9d, 0x9a, 0x8a, 0xcb, 0x6f, 0x45, 0xb2, 0x67, 0xe5, 0x62, 0xb1,
-	0x67, 0xe5, 0xd2, 0x9f, 0x7b, 0x56, 0x26, 0x9e, 0x50, 0x7f, 0x68, 0xc0, 0x8c, 0x32, 0x6f, 0x7a,
-	0x12, 0x98, 0xaf, 0xc3, 0x18, 0x95, 0x85, 0x21, 0x77, 0x67, 0x4e, 0xd6, 0x61, 0x59, 0x32, 0x5a,
-	0xbc, 0x10, 0xd9, 0x94, 0xe0, 0x8e, 0x6b, 0x53, 0x17, 0x28, 0x7e, 0xae, 0x43, 0xbe, 0x9f, 0x07,
-	0x2e, 0xba, 0x06, 0xb3, 0xd4, 0x1c, 0xcb, 0xac, 0x7d, 0xa4, 0x00, 0x93, 0x2f, 0x33, 0x49, 0x2a,
-	0x29, 0x16, 0x1b, 0x91, 0xc6, 0xe4, 0xab, 0x2b, 0x1f, 0xc2, 0x84, 0xc0, 0xbd, 0x27, 0xb4, 0x11,
-	0x8c, 0x38, 0x8d, 0x58, 0xde, 0xd2, 0xdf, 0x04, 0x32, 0xbb, 0xa8, 0xc3, 0x8f, 0x63, 0xd8, 0xc5,
-	0x9c, 0xff, 0x3b, 0x0e, 0xe3, 0xaf, 0x5a, 0xbe, 0x22, 0x18, 0xa1, 0xfc, 0xc9, 0x08, 0x40, 0x7f,
-	0x0b, 0x99, 0x3b, 0x96, 0xc8, 0xdc, 0x8f, 0xf1, 0x7e, 0xa9, 0xcb, 0x62, 0x1f, 0x02, 0x77, 0xa2,
-	0x54, 0xe0, 0x4e, 0xaa, 0x02, 0x37, 0x19, 0x6c, 0xe8, 0x65, 0xb0, 0xdf, 0x56, 0x85, 0xed, 0x94,
-	0x2c, 0x20, 0x7a, 0x93, 0xb4, 0x4f, 0x35, 0x92, 0x96, 0x39, 0x6c, 0x9b, 0x2a, 0x98, 0xf3, 0x88,
-	0xd9, 0x99, 0xf3, 0x8b, 0xd9, 0xd9, 0x7e, 0xc4, 0x6c, 0xa2, 0xdb, 0xcc, 0x29, 0xba, 0x0d, 0x17,
-	0xa7, 0xf3, 0x89, 0x38, 0xfd, 0xa4, 0x24, 0x4e, 0x17, 0x68, 0x37, 0xd7, 0xd4, 0x6e, 0xf6, 0x20,
-	0x4b, 0x91, 0x2a, 0x4b, 0xff, 0x5c, 0x18, 0x9e, 0x4b, 0x18, 0xfe, 0x6b, 0x83, 0x99, 0xb0, 0xff,
-	0x2c, 0x38, 0xf0, 0xae, 0xc0, 0x18, 0x9b, 0xa0, 0xdc, 0x38, 0xcf, 0xbf, 0xcc, 0xa7, 0xec, 0x3a,
-	0x12, 0xef, 0x41, 0xfa, 0x5a, 0x9f, 0x0a, 0xd4, 0x48, 0x03, 0x9d, 0x87, 0x8a, 0xd3, 0x64, 0x36,
-	0xf9, 0x49, 0x8b, 0xfc, 0x34, 0x7f, 0xcd, 0x80, 0x2a, 0xbb, 0xf0, 0x27, 0x6d, 0x24, 0x24, 0xf3,
-	0x79, 0x8e, 0x59, 0xb2, 0xb7, 0xdb, 0x57, 0x35, 0x98, 0x88, 0xad, 0xa4, 0x4c, 0x4c, 0xc6, 0xdf,
-	0x8a, 0xe6, 0x3c, 0xa2, 0x6a, 0xce, 0xe6, 0x6f, 0x1a, 0x70, 0x41, 0x83, 0xd4, 0x40, 0x36, 0x76,
-	0x9f, 0x80, 0x29, 0xa9, 0x27, 0x7c, 0x87, 0xb0, 0x94, 0xd6, 0x77, 0x28, 0x02, 0x90, 0x74, 0xce,
-	0xdc, 0x13, 0xf7, 0x24, 0x5f, 0xda, 0x48, 0xfc, 0xa2, 0xc1, 0x6f, 0x17, 0xa4, 0x3d, 0xe7, 0xfb,
-	0xba, 0x5d, 0xc0, 0x1c, 0x1a, 0x23, 0xdb, 0x15, 0x5c, 0x4a, 0x3f, 0xd0, 0x4d, 0xc9, 0xd7, 0x7d,
-	0x94, 0xca, 0xa0, 0x19, 0x45, 0x06, 0x49, 0x8e, 0xee, 0x5b, 0x30, 0xcb, 0xfb, 0x70, 0xde, 0x05,
-	0xd2, 0x7c, 0x0f, 0x2e, 0x70, 0x10, 0x09, 0x81, 0xc4, 0xb6, 0xb8, 0x7f, 0x68, 0x57, 0x60, 0x21,
-	0x03, 0x2d, 0xe3, 0xc3, 0xf5, 0x59, 0x40, 0xbb, 0x1f, 0xff, 0xd4, 0xfb, 0x07, 0xf8, 0x9b, 0x11,
-	0x73, 0x4a, 0x22, 0x93, 0x25, 0x3e, 0xaa, 0x30, 0xa4, 0xa3, 0x0a, 0xad, 0x78, 0x30, 0x7f, 0xd9,
-	0x80, 0x65, 0x7e, 0xb2, 0x20, 0xae, 0x38, 0x0f, 0xe8, 0xde, 0xb0, 0x6c, 0x47, 0x98, 0x97, 0x97,
-	0x5a, 0xda, 0x2a, 0xb3, 0x24, 0xec, 0xc3, 0x02, 0x9b, 0xe4, 0xf2, 0x65, 0xeb, 0x52, 0xeb, 0x41,
-	0xc9, 0xdd, 0xeb, 0xdf, 0x4f, 0xa4, 0x1f, 0x49, 0xe9, 0xdd, 0x91, 0x21, 0x66, 0xc0, 0x61, 0x3d,
-	0x03, 0x56, 0x0a, 0xfd, 0xc5, 0x46, 0x8a, 0xfd, 0xc5, 0x46, 0x53, 0xfe, 0x62, 0x3a, 0x87, 0x98,
-	0x83, 0x78, 0x66, 0x70, 0xc4, 0x0b, 0x8f, 0x1e, 0x5f, 0x53, 0x8f, 0x1e, 0xb3, 0x34, 0xe6, 0x16,
-	0xe3, 0xaf, 0xc3, 0xf4, 0x81, 0x7f, 0x8a, 0xe3, 0xb3, 0xeb, 0xd7, 0xb8, 0xdb, 0xa3, 0x41, 0x87,
-	0x26, 0x31, 0x35, 0xd3, 0x42, 0x92, 0xcf, 0xe3, 0x3d, 0x98, 0x08, 0x71, 0x14, 0x39, 0xde, 0x71,
-	0xc8, 0x47, 0x7e, 0x45, 0x2d, 0xbb, 0xcf, 0x73, 0xad, 0xb8, 0x9c, 0x19, 0xc2, 0x0c, 0x6f, 0x6b,
-	0x50, 0xd7, 0x61, 0x98, 0x17, 0x73, 0x45, 0xf2, 0x62, 0x36, 0xcf, 0xe0, 0x0a, 0xbd, 0xbd, 0xaf,
-	0xf2, 0x51, 0x6c, 0x92, 0x95, 0x56, 0x3f, 0x7a, 0x14, 0xca, 0x27, 0x09, 0x3d, 0x0a, 0x2d, 0xb1,
-	0x1c, 0x15, 0x9f, 0x94, 0x46, 0x70, 0xb5, 0xb8, 0xdd, 0x41, 0xd0, 0xc0, 0xfc, 0xd1, 0x08, 0x2c,
-	0x10, 0x2e, 0xa1, 0x52, 0x38, 0xcc, 0xf3, 0x6d, 0xad, 0x26, 0x7e, 0xe7, 0x4c, 0xf6, 0xc6, 0x5e,
-	0xe5, 0xd7, 0x34, 0x8e, 0x32, 0xa4, 0x40, 0xca, 0xf5, 0x45, 0x5a, 0x13, 0x47, 0x18, 0x00, 0xb1,
-	0x26, 0x26, 0xdd, 0x19, 0x65, 0x1a, 0x61, 0xe2, 0x75, 0x27, 0x0e, 0x17, 0xc7, 0xd4, 0xc3, 0xc5,
-	0xcb, 0x30, 0xdd, 0x6e, 0x49, 0xde, 0x8a, 0xe3, 0xd4, 0x03, 0x11, 0xda, 0xad, 0xd8, 0x4f, 0x71,
-	0x1d, 0x40, 0x94, 0x88, 0x7c, 0xaa, 0xda, 0x57, 0xac, 0x09, 0x96, 0x7f, 0xe0, 0x53, 0xdf, 0x2b,
-	0x3e, 0x4a, 0x09, 0x90, 0x49, 0x5a, 0x68, 0x8e, 0x67, 0xc4, 0x90, 0x5e, 0x83, 0x39, 0xa5, 0x6c,
-	0xe4, 0xd3, 0x1b, 0x16, 0x15, 0x6b, 0x46, 0x2a, 0x79, 0xe0, 0x67, 0xa6, 0xef, 0x54, 0x76, 0xfa,
-	0xc6, 0xf2, 0x60, 0x5a, 0x2f, 0x0f, 0x66, 0xb4, 0x1e, 0x6e, 0xb3, 0x92, 0x07, 0x6a, 0x8d, 0xa8,
-	0xc4, 0x4c, 0xbe, 0x70, 0xf5, 0x39, 0xfe, 0x46, 0xdb, 0x70, 0x91, 0x11, 0xae, 0xae, 0x38, 0x8a,
-	0x1c, 0xd9, 0x8e, 0x8b, 0x9b, 0xf5, 0x23, 0x3f, 0xe0, 0xba, 0xf5, 0x1a, 0x2b, 0x25, 0x1b, 0x86,
-	0x77, 0x68, 0x99, 0x1d, 0x3f, 0x88, 0xf7, 0x5a, 0x0b, 0xc9, 0x5e, 0xcb, 0xfc, 0x0c, 0xcc, 0x3d,
-	0xc1, 0x91, 0xb2, 0x48, 0xa7, 0xb9, 0x43, 0xc6, 0x2b, 0x3e, 0x23, 0x66, 0xdf, 0xe6, 0x73, 0xb8,
-	0xb0, 0x1b, 0x32, 0x7b, 0xb3, 0xed, 0x3d, 0xc4, 0x7b, 0x76, 0x57, 0xf2, 0x13, 0x2e, 0x59, 0xed,
-	0xe5, 0x53, 0xbb, 0x61, 0xf5, 0xaa, 0xfe, 0x77, 0x0d, 0xa8, 0xe9, 0xe0, 0xfe, 0xc4, 0xac, 0xd8,
-	0x3f, 0x07, 0x4b, 0xfb, 0x98, 0xfa, 0x78, 0xab, 0x5e, 0xed, 0x1b, 0x00, 0xac, 0x0f, 0x9d, 0x4e,
-	0xd2, 0x45, 0x9a, 0xf2, 0xbc, 0xe3, 0x34, 0xd1, 0x5d, 0x58, 0x64, 0x1a, 0xaa, 0x32, 0x6c, 0xfc,
-	0xdc, 0x10, 0xb1, 0x2c, 0x79, 0xac, 0x92, 0x0b, 0x0b, 0x15, 0xe9, 0xc2, 0x82, 0xe9, 0xc3, 0x1c,
-	0x6b, 0x96, 0x20, 0xb0, 0x6f, 0xbb, 0x38, 0x4c, 0x0a, 0x1a, 0xf2, 0xcd, 0x86, 0x7c, 0x92, 0xca,
-	0x87, 0x64, 0x15, 0xc5, 0x6f, 0x13, 0xd1, 0x1b, 0x6e, 0xc2, 0x15, 0x81, 0xfe, 0x36, 0x7f, 0xcb,
-	0xe0, 0xae, 0xaf, 0xb4, 0xc5, 0xf7, 0xf1, 0x0b, 0x8b, 0x59, 0x69, 0x5f, 0x87, 0x45, 0x71, 0xd6,
-	0xec, 0x84, 0x7e, 0xbd, 0xe1, 0x37, 0x71, 0xdd, 0xbe, 0xc7, 0x71, 0x98, 0xe7, 0x59, 0xbb, 0xa1,
-	0xbf, 0xed, 0x37, 0xf1, 0xd6, 0xbd, 0x04, 0xc9, 0xe1, 0x3c, 0x24, 0x2b, 0xb9, 0x48, 0x8e, 0x68,
-	0x91, 0x1c, 0x95, 0x90, 0x64, 0x8e, 0x9a, 0x8a, 0x5f, 0x58, 0xbc, 0xc8, 0xf0, 0xe1, 0xd1, 0xfa,
-	0x61, 0x1a, 0x5a, 0x3f, 0x4c, 0xb2, 0xe2, 0x0b, 0x5d, 0xbb, 0x6e, 0xbf, 0x29, 0x54, 0x2e, 0x91,
-	0xb4, 0xf5, 0xa6, 0xf9, 0x21, 0xf5, 0xd3, 0xcc, 0x69, 0x8f, 0x73, 0xe6, 0x5b, 0x30, 0xd6, 0xb6,
-	0x03, 0xbb, 0x25, 0x9c, 0x4f, 0xd7, 0xd3, 0x97, 0x6f, 0x58, 0xbd, 0x3d, 0x5a, 0xc6, 0xe2, 0x65,
-	0xcd, 0x6f, 0x8a, 0x53, 0x40, 0xad, 0x37, 0x63, 0x3f, 0x9d, 0xb8, 0x13, 0xb7, 0x9f, 0x5e, 0x84,
-	0x55, 0xd0, 0xa2, 0xe5, 0x5f, 0x30, 0xe0, 0xb2, 0xa6, 0x69, 0x8e, 0xdf, 0x39, 0x10, 0x78, 0x2b,
-	0x85, 0x40, 0x6f, 0x04, 0x78, 0x06, 0x6b, 0x5a, 0x02, 0x94, 0xcc, 0xf7, 0xaa, 0x3a, 0xdf, 0x25,
-	0xcf, 0xe9, 0xe7, 0xb0, 0x59, 0xd0, 0xad, 0x73, 0x83, 0x6d, 0xc3, 0x12, 0xbb, 0x37, 0xf1, 0x25,
-	0xa7, 0x4d, 0x78, 0xbc, 0x7c, 0x4f, 0xc8, 0xbd, 0x0c, 0x86, 0x63, 0x2f, 0x83, 0xfe, 0x76, 0xcc,
-	0xe6, 0x73, 0x58, 0x4e, 0xb5, 0x78, 0x3e, 0x35, 0x4f, 0x54, 0xe7, 0x6a, 0xde, 0x57, 0x61, 0x46,
-	0x11, 0x8a, 0x2f, 0x59, 0xed, 0xf8, 0x1a, 0x2c, 0xe9, 0x0a, 0x50, 0xc7, 0x1d, 0xbf, 0x19, 0xdb,
-	0x14, 0xc8, 0xef, 0x7c, 0x6a, 0x33, 0x53, 0x4f, 0x64, 0x3b, 0x6e, 0x28, 0xe4, 0x04, 0xff, 0x34,
-	0x7f, 0x38, 0x0c, 0xeb, 0xec, 0x80, 0x93, 0xfb, 0x3a, 0x39, 0xde, 0x31, 0x33, 0x14, 0x25, 0xea,
-	0x1b, 0x5d, 0xea, 0x0c, 0xc9, 0xac, 0x58, 0x20, 0x1c, 0x15, 0x17, 0xba, 0x8a, 0xea, 0x42, 0x97,
-	0xde, 0x0a, 0x8c, 0x64, 0xb6, 0x02, 0x79, 0xb7, 0x01, 0x8b, 0x7c, 0x38, 0x12, 0x7a, 0x73, 0xb7,
-	0x5b, 0x4e, 0x6f, 0x21, 0xe8, 0x98, 0x56, 0x43, 0x7f, 0xcb, 0xdc, 0x35, 0xa9, 0x72, 0x57, 0xe2,
-	0xa5, 0x09, 0xb2, 0x97, 0xa6, 0xf9, 0xb7, 0x0c, 0xd8, 0xc8, 0xa1, 0xcf, 0x40, 0x96, 0xd0, 0xdb,
-	0xca, 0x12, 0x9a, 0x9c, 0xae, 0xa4, 0x5b, 0x67, 0x8b, 0xe9, 0x03, 0x71, 0x74, 0x6e, 0xf9, 0x5d,
-	0xdb, 0x8d, 0xba, 0x16, 0x6e, 0xfb, 0x41, 0xbc, 0xa3, 0x5b, 0x87, 0x49, 0x41, 0x5b, 0x26, 0x45,
-	0x27, 0xad, 0x24, 0xc1, 0xfc, 0x57, 0x06, 0x5c, 0xa0, 0x0e, 0x97, 0x72, 0x55, 0x79, 0xd7, 0xd6,
-	0xc6, 0x81, 0xe3, 0x37, 0x99, 0xd6, 0x67, 0x70, 0xd5, 0x91, 0x26, 0x51, 0x85, 0x6f, 0x0d, 0x26,
-	0x79, 0x81, 0xc8, 0xa7, 0x1d, 0x24, 0x9a, 0x23, 0x4d, 0x38, 0xf0, 0xcb, 0xe3, 0xe2, 0x24, 0x44,
-	0x1b, 0x51, 0x94, 0xd9, 0x78, 0x4a, 0x8f, 0xea, 0xa7, 0xf4, 0x98, 0x32, 0xa5, 0xbf, 0x02, 0x2b,
-	0x2a, 0xf6, 0x7b, 0xf6, 0xb1, 0xe3, 0x91, 0x81, 0xd6, 0xcf, 0xe9, 0xdb, 0xea, 0x9c, 0x4e, 0x44,
-	0xba, 0x4a, 0x3e, 0x3e, 0xb3, 0xff, 0x36, 0xbf, 0xdf, 0x91, 0x26, 0xd0, 0x40, 0xc6, 0xfd, 0x0d,
-	0x7e, 0x3b, 0x5e, 0x5c, 0x73, 0x63, 0x55, 0xb4, 0x9d, 0x63, 0xb7, 0xe2, 0xcd, 0x53, 0xb8, 0x98,
-	0xc1, 0x4e, 0xdd, 0x9c, 0xd0, 0xb9, 0x48, 0x92, 0x93, 0x55, 0x66, 0x82, 0x25, 0xc8, 0xbb, 0xee,
-	0x9e, 0x28, 0xfd, 0x0c, 0x36, 0xb8, 0x4f, 0x99, 0xdc, 0xdc, 0xb6, 0x1f, 0x04, 0xec, 0xb4, 0x2f,
-	0xf7, 0x04, 0x3b, 0x99, 0x57, 0xc3, 0xca, 0xbc, 0xba, 0x0f, 0xab, 0x4f, 0x70, 0xa4, 0x65, 0xdb,
-	0x22, 0xb4, 0xcd, 0xef, 0x31, 0x83, 0x62, 0xaa, 0xe2, 0x80, 0xa2, 0x05, 0xc9, 0x53, 0x31, 0x8f,
-	0x59, 0xd8, 0x44, 0xfc, 0x07, 0xb1, 0xfb, 0x51, 0xdf, 0x5d, 0x92, 0xb0, 0x1e, 0x56, 0x04, 0xd8,
-	0x36, 0x40, 0x23, 0x26, 0x30, 0xc7, 0xe2, 0x0a, 0x0f, 0x39, 0x53, 0x34, 0x16, 0x96, 0x54, 0x2d,
-	0x7d, 0xf9, 0xde, 0xfc, 0x9b, 0x06, 0x98, 0x89, 0x37, 0xd2, 0x99, 0x83, 0x5f, 0xf4, 0x8f, 0x30,
-	0xf3, 0x30, 0x60, 0x0e, 0xa9, 0xfc, 0x8e, 0x22, 0xf5, 0x30, 0xd8, 0xe2, 0x29, 0x19, 0x77, 0xdd,
-	0x6b, 0x30, 0xdb, 0x74, 0xc2, 0x76, 0x27, 0xc2, 0x75, 0xc5, 0x15, 0x7e, 0x86, 0xa7, 0x5a, 0x8c,
-	0x27, 0x7e, 0xc7, 0x88, 0x3d, 0x93, 0x49, 0x07, 0x3d, 0xec, 0x6e, 0xfb, 0x61, 0xc4, 0xee, 0x3d,
-	0x31, 0xd7, 0xf0, 0x81, 0x8c, 0xf3, 0x4f, 0x29, 0xe3, 0x7c, 0x2d, 0xad, 0x66, 0xe9, 0x51, 0x61,
-	0xc3, 0xfe, 0xe3, 0xc4, 0xd7, 0x39, 0x53, 0x6c, 0x60, 0x06, 0x65, 0x19, 0xdb, 0xcd, 0x52, 0x6c,
-	0x39, 0xa6, 0xbf, 0x67, 0xc0, 0x6b, 0xd9, 0x22, 0xf2, 0xd5, 0xc3, 0x57, 0xe4, 0xc8, 0x98, 0x8f,
-	0xaf, 0x82, 0x0c, 0xc3, 0xfa, 0x1f, 0x19, 0x60, 0xe6, 0x17, 0x1c, 0x10, 0xc6, 0x9f, 0x54, 0x30,
-	0xbe, 0xd2, 0x03, 0xc6, 0x1c, 0xdb, 0xbf, 0x6f, 0xc0, 0xa5, 0xa7, 0xbe, 0x87, 0xbb, 0x0f, 0xed,
-	0xc6, 0xe9, 0x2b, 0x61, 0xdd, 0xb7, 0x14, 0x54, 0x2f, 0x27, 0x2e, 0x76, 0x39, 0x58, 0x30, 0x3c,
-	0x7f, 0x68, 0xc0, 0x9a, 0xa6, 0xc4, 0xe0, 0x56, 0x36, 0x09, 0xc7, 0xf5, 0x22, 0x1c, 0x39, 0x7e,
-	0xbf, 0x6d, 0xc0, 0xa6, 0x92, 0xfb, 0x0a, 0xd8, 0xf4, 0xbe, 0x82, 0xa5, 0xa9, 0xc7, 0x52, 0xc3,
-	0xa1, 0xf4, 0x72, 0x97, 0xae, 0xcc, 0xab, 0xbe, 0xdc, 0xa5, 0xc5, 0x81, 0xe1, 0x78, 0x1f, 0x6a,
-	0xf4, 0x8c, 0xfd, 0x49, 0xe0, 0x77, 0xda, 0x0f, 0x59, 0x80, 0xc1, 0x44, 0xc1, 0xcf, 0xdd, 0x71,
-	0x99, 0xcf, 0x60, 0x3d, 0xa9, 0xc7, 0x4f, 0xfa, 0x1d, 0x9c, 0x68, 0x40, 0x77, 0x63, 0x0f, 0x34,
-	0x43, 0xf5, 0x26, 0x10, 0x75, 0x98, 0xad, 0x23, 0x14, 0xae, 0x69, 0xa6, 0x0d, 0x0b, 0x99, 0x4c,
-	0x45, 0xb7, 0x37, 0x52, 0xba, 0xfd, 0x1b, 0x30, 0xce, 0xaa, 0x26, 0x2a, 0x9b, 0xb6, 0x09, 0x4b,
-	0x14, 0x33, 0x1f, 0xc1, 0x7c, 0x3a, 0x53, 0xf2, 0x94, 0x33, 0x14, 0x4f, 0x39, 0xc5, 0xa3, 0x5d,
-	0xb6, 0xb5, 0x9a, 0x6f, 0xc2, 0x05, 0x99, 0x62, 0xbc, 0x11, 0x4e, 0xb0, 0x1c, 0x70, 0xe6, 0x53,
-	0x58, 0xb5, 0x70, 0xc3, 0x6f, 0xb5, 0xb0, 0xd7, 0xc4, 0x4d, 0xe6, 0xd5, 0x90, 0x54, 0xe9, 0xd7,
-	0xbf, 0xcf, 0x3c, 0x84, 0x6a, 0x16, 0x1c, 0xa7, 0xfc, 0x0e, 0x2c, 0x04, 0x49, 0x5e, 0x9d, 0x79,
-	0x1f, 0xb2, 0x41, 0xb8, 0x20, 0x5d, 0x85, 0x4a, 0xd5, 0x9e, 0x0f, 0x52, 0x29, 0xe6, 0x03, 0x58,
-	0x4f, 0x97, 0x3a, 0xb0, 0x0f, 0x93, 0xc8, 0x98, 0x05, 0x63, 0x63, 0x7e, 0x1e, 0x36, 0x72, 0xea,
-	0x72, 0x24, 0xdf, 0x80, 0xb1, 0x80, 0xa8, 0x29, 0xc2, 0x82, 0x53, 0x95, 0x4e, 0x4a, 0xe2, 0xc2,
-	0x54, 0x8f, 0xe1, 0xe5, 0xcc, 0x7d, 0x58, 0x96, 0x7d, 0x42, 0x12, 0x4e, 0x7b, 0x00, 0x33, 0xe2,
-	0x2c, 0x58, 0xee, 0x6b, 0x8e, 0x2b, 0xc9, 0x74, 0x5b, 0xfa, 0x32, 0xbf, 0x0e, 0x35, 0x16, 0x99,
-	0x2f, 0x05, 0x5a, 0xb6, 0xac, 0xaa, 0x61, 0xdb, 0x26, 0xdb, 0xf1, 0x11, 0x73, 0xe2, 0xbc, 0x32,
-	0xdc, 0x83, 0xf3, 0x8a, 0xd9, 0x82, 0xc5, 0xe7, 0x21, 0x0e, 0xf6, 0x02, 0xff, 0xc8, 0x71, 0xf1,
-	0x1e, 0x0e, 0x42, 0xdf, 0xb3, 0x5d, 0xd2, 0xc8, 0x91, 0x13, 0x84, 0xdc, 0xe1, 0x96, 0x37, 0x42,
-	0x53, 0xde, 0xe7, 0x11, 0x1f, 0x68, 0xc8, 0x05, 0xc9, 0xe1, 0x68, 0x82, 0x24, 0xd0, 0xcc, 0x1a,
-	0x4c, 0xb4, 0xfd, 0xd0, 0x89, 0x12, 0x47, 0xcf, 0xf8, 0xdb, 0xfc, 0xaf, 0x06, 0xcc, 0x49, 0xed,
-	0x7d, 0x0e, 0xbb, 0x6d, 0xb4, 0x0b, 0x9b, 0x09, 0xa9, 0xfc, 0x96, 0x4f, 0xad, 0xdb, 0xb6, 0xd7,
-	0xac, 0x37, 0xf1, 0x19, 0x76, 0xfd, 0x36, 0x59, 0xc2, 0xf8, 0x65, 0x9c, 0x8b, 0x31, 0x9d, 0x78,
-	0xb9, 0x2d, 0xaf, 0xf9, 0x28, 0x29, 0x85, 0xee, 0xc3, 0x6a, 0x80, 0x5d, 0x6c, 0x87, 0xb8, 0x59,
-	0x3f, 0xb6, 0x5b, 0x38, 0x01, 0xc8, 0x75, 0xbe, 0x65, 0x91, 0xfd, 0xc4, 0x6e, 0xe1, 0x18, 0x0a,
-	0xba, 0x0b, 0x8b, 0x8e, 0x17, 0xe1, 0xc0, 0xa3, 0x96, 0x58, 0xdb, 0xad, 0x87, 0xb6, 0x8b, 0x43,
-	0xee, 0xea, 0x8f, 0x94, 0xac, 0xd8, 0x04, 0xeb, 0x47, 0x27, 0xdc, 0x89, 0x6a, 0xc2, 0x62, 0x1f,
-	0xe6, 0xff, 0x34, 0xe0, 0xa2, 0xd4, 0x3b, 0x1e, 0xe8, 0x83, 0x08, 0xba, 0xc8, 0xf9, 0x16, 0x33,
-	0xf2, 0x7e, 0x0c, 0x16, 0xda, 0xb6, 0xd3, 0xac, 0x87, 0x9d, 0xc3, 0xc4, 0xd7, 0x87, 0x75, 0x6e,
-	0x9e, 0x64, 0xec, 0x4b, 0xe9, 0xe8, 0x0e, 0x41, 0x8b, 0x75, 0xc4, 0x6e, 0x9e, 0xe1, 0x20, 0x72,
-	0x42, 0x87, 0xdf, 0x33, 0x9b, 0xb0, 0x16, 0x1c, 0x8f, 0x74, 0x62, 0x2b, 0xc9, 0x40, 0xb7, 0x60,
-	0x41, 0x94, 0x6f, 0x77, 0x88, 0x40, 0x0d, 0xe3, 0x4e, 0xcc, 0xb1, 0xd2, 0x7b, 0x22, 0x99, 0x9e,
-	0xee, 0x04, 0xb8, 0xe5, 0x74, 0x5a, 0x54, 0x2f, 0x0e, 0x43, 0xde, 0x95, 0x19, 0x9e, 0xba, 0xc5,
-	0xa2, 0x2e, 0xc5, 0x1d, 0x1d, 0x95, 0x3b, 0xfa, 0x7b, 0x06, 0xac, 0x65, 0x3b, 0x1a, 0xc7, 0xef,
-	0x43, 0xcb, 0x30, 0xd6, 0x6e, 0xd4, 0x5b, 0x76, 0x83, 0x77, 0x6d, 0xb4, 0xdd, 0x78, 0x6a, 0x37,
-	0xd0, 0x26, 0x4c, 0x53, 0xe4, 0x1a, 0xbe, 0x17, 0xfa, 0x2e, 0xe6, 0x1d, 0x99, 0x22, 0x69, 0xdb,
-	0x2c, 0x09, 0x5d, 0x81, 0x99, 0x96, 0x7f, 0xe8, 0xb8, 0x98, 0x8c, 0xbe, 0xf0, 0x50, 0x9e, 0xb0,
-	0xa6, 0x59, 0xe2, 0x23, 0x9a, 0x46, 0xd4, 0xf9, 0x17, 0xf8, 0xb0, 0x7e, 0x18, 0xf8, 0x2f, 0xc2,
-	0x78, 0x0c, 0xe0, 0x05, 0x3e, 0x7c, 0xc8, 0x52, 0x72, 0xb0, 0xfe, 0xf9, 0x0a, 0xa0, 0x2c, 0xd6,
-	0x04, 0x2b, 0x1e, 0x85, 0x45, 0xe6, 0xf6, 0x29, 0x9e, 0x46, 0x59, 0xba, 0x0a, 0xe3, 0x2f, 0xf0,
-	0x61, 0xe8, 0x44, 0xb1, 0x9d, 0x8a, 0x7f, 0xa2, 0xfb, 0x30, 0x63, 0x7b, 0x5e, 0xc7, 0x76, 0xeb,
-	0x8e, 0xd7, 0xf0, 0x5b, 0x38, 0x73, 0x77, 0x80, 0xca, 0x8b, 0x5d, 0x2f, 0xb2, 0xa6, 0x59, 0xb9,
-	0x5d, 0x5a, 0x0c, 0x6d, 0xc1, 0xa2, 0xd7, 0x69, 0x1d, 0xe2, 0xa0, 0xee, 0x1f, 0xd5, 0x71, 0xab,
-	0xed, 0xfa, 0x5d, 0x8c, 0x43, 0x7e, 0x73, 0x5c, 0x53, 0x7b, 0x81, 0x95, 0x7e, 0x76, 0xf4, 0x58,
-	0x94, 0x45, 0x37, 0x60, 0xfe, 0xd4, 0xf1, 0x9a, 0x04, 0x00, 0xf5, 0x1d, 0x74, 0xa2, 0xae, 0xb8,
-	0x7a, 0x47, 0xd2, 0x9f, 0x1d, 0x6d, 0xf1, 0x54, 0xf4, 0x39, 0x98, 0x6e, 0x49, 0x4c, 0xc8, 0xa3,
-	0x98, 0x5e, 0x4d, 0xae, 0xaa, 0xe5, 0x33, 0xac, 0xa5, 0xd4, 0x44, 0x3f, 0xa3, 0x3a, 0xaa, 0x1b,
-	0x89, 0x5b, 0x71, 0x01, 0x3b, 0xc8, 0x2e, 0xeb, 0xff, 0xdb, 0x80, 0x79, 0xa9, 0xe8, 0x63, 0x7a,
-	0x2a, 0xa0, 0x3f, 0xd0, 0x58, 0x87, 0xc9, 0x86, 0xef, 0x1d, 0x39, 0x41, 0x2b, 0xde, 0xb2, 0x25,
-	0x09, 0xe8, 0x33, 0x64, 0xd4, 0xf8, 0x47, 0xdd, 0x16, 0x7e, 0x2a, 0x85, 0xde, 0xb3, 0x71, 0xf9,
-	0xad, 0x08, 0xdd, 0x84, 0x79, 0xfe, 0x99, 0x38, 0x62, 0xb2, 0x2d, 0xde, 0x9c, 0x9c, 0xfe, 0x3c,
-	0x70, 0xd1, 0xa7, 0xa1, 0xe6, 0x84, 0x75, 0xa5, 0x34, 0x45, 0xb0, 0x1e, 0x8a, 0x1b, 0x9a, 0x13,
-	0xd6, 0xaa, 0x13, 0x6e, 0x4b, 0x05, 0x68, 0xb7, 0xf6, 0xb1, 0x17, 0x99, 0x7f, 0x50, 0x81, 0x29,
-	0xa9, 0xbf, 0x99, 0x03, 0xb6, 0xdc, 0x90, 0x1a, 0xb7, 0xe5, 0xd3, 0xa0, 0x58, 0x8d, 0x48, 0x93,
-	0x4e, 0xd0, 0xea, 0x13, 0x30, 0xd1, 0xe6, 0xb2, 0x9b, 0xf3, 0xd0, 0x85, 0x4c, 0x05, 0x21, 0xdc,
-	0xad, 0xb8, 0x28, 0xba, 0x09, 0x23, 0x27, 0xd8, 0x6d, 0xf3, 0x20, 0x03, 0xcb, 0x99, 0x2a, 0x44,
-	0x3e, 0x5b, 0xb4, 0x08, 0xba, 0x97, 0x04, 0x36, 0x12, 0x41, 0x70, 0x73, 0xc6, 0x3d, 0x09, 0x6a,
-	0x24, 0x96, 0x89, 0x30, 0xc2, 0x6d, 0x11, 0xf0, 0x8c, 0x24, 0xec, 0x47, 0xb8, 0x4d, 0x47, 0x00,
-	0x7b, 0x51, 0xe0, 0x1c, 0x75, 0x8e, 0xfd, 0x3a, 0x3b, 0xad, 0xe7, 0xae, 0x9a, 0x49, 0x3a, 0xf5,
-	0x11, 0x48, 0x39, 0x34, 0x4e, 0x9e, 0xdf, 0xa1, 0x11, 0xfa, 0x70, 0x68, 0x34, 0x9f, 0xc1, 0xf2,
-	0x13, 0x76, 0xee, 0xc7, 0xfb, 0x27, 0x56, 0x60, 0x69, 0xcc, 0x0c, 0x65, 0xcc, 0xd8, 0xd2, 0x4c,
-	0x8a, 0xaa, 0x4e, 0x02, 0x24, 0x85, 0xc5, 0x92, 0x5f, 0x49, 0x43, 0x7c, 0x25, 0xf7, 0x98, 0xd2,
-	0x03, 0xc5, 0xb5, 0xec, 0x3f, 0x36, 0x00, 0xf6, 0x48, 0x45, 0x6a, 0x55, 0xe9, 0x9d, 0x35, 0xa9,
-	0x3a, 0x49, 0xaa, 0x24, 0xf7, 0x38, 0x28, 0x00, 0xee, 0xe9, 0x39, 0x92, 0x78, 0x7a, 0xae, 0xc2,
-	0xb8, 0x13, 0xd6, 0x03, 0x6c, 0x37, 0xf9, 0x3c, 0x19, 0x73, 0x42, 0x0b, 0xdb, 0xcd, 0xd4, 0x88,
-	0x8e, 0x9d, 0x7f, 0x44, 0xc7, 0xfb, 0x19, 0xd1, 0xaf, 0xc0, 0x2a, 0xbf, 0x1a, 0x15, 0xf7, 0xba,
-	0x74, 0x4c, 0x93, 0xce, 0x0e, 0xeb, 0x3a, 0x5b, 0x89, 0x3b, 0x6b, 0xde, 0x85, 0x55, 0x2e, 0x03,
-	0x08, 0xa9, 0xd9, 0xf4, 0x4c, 0xe2, 0xe1, 0x32, 0x06, 0x37, 0x64, 0x77, 0x94, 0xbf, 0x00, 0x2b,
-	0x1f, 0xd8, 0xd1, 0x41, 0x60, 0x7b, 0xa1, 0x4d, 0x6d, 0x5f, 0xb1, 0x8e, 0x67, 0xc2, 0xcc, 0x99,
-	0x1d, 0xd5, 0xd3, 0x06, 0xad, 0xa9, 0x33, 0x3b, 0xb2, 0x32, 0xe6, 0xd0, 0x9e, 0x9c, 0x90, 0x84,
-	0xd3, 0xc1, 0x88, 0xe4, 0x45, 0xf4, 0x15, 0x58, 0x92, 0x1b, 0x2f, 0x31, 0x45, 0xdf, 0x51, 0x4d,
-	0xd1, 0x55, 0xf5, 0xac, 0xfc, 0x03, 0x07, 0xbf, 0xa0, 0xd1, 0x86, 0x1b, 0xc2, 0x18, 0xfd, 0x3d,
-	0x43, 0x05, 0x3f, 0x20, 0x5e, 0xbf, 0xa3, 0x98, 0xa1, 0x79, 0x15, 0x5d, 0xb7, 0xb8, 0x11, 0xda,
-	0x87, 0x85, 0x0f, 0x04, 0x15, 0xc3, 0xf2, 0x33, 0xbc, 0x8f, 0x4e, 0xe5, 0x03, 0x40, 0x49, 0x83,
-	0x25, 0x34, 0xbe, 0xa1, 0xd2, 0x38, 0x71, 0xb9, 0x8a, 0x21, 0x08, 0xea, 0xfe, 0x55, 0x43, 0x06,
-	0x3b, 0xb8, 0xa3, 0x1d, 0x89, 0xb6, 0x5c, 0xe0, 0x67, 0x3b, 0xc3, 0x29, 0xfb, 0x0e, 0x54, 0xf9,
-	0x95, 0xc3, 0x2c, 0x81, 0xef, 0xf0, 0xa3, 0x2d, 0xa3, 0x74, 0xba, 0xb2, 0xf3, 0xfd, 0x1d, 0x58,
-	0x67, 0x5b, 0xa0, 0x18, 0x94, 0x1a, 0x0a, 0x2c, 0x2d, 0xab, 0x72, 0x2c, 0xd2, 0xe6, 0xef, 0x1a,
-	0xf0, 0xba, 0x74, 0xb1, 0x38, 0x09, 0xf5, 0x47, 0xd6, 0x2a, 0x17, 0x47, 0x58, 0xbe, 0x73, 0x4b,
-	0xaf, 0x78, 0xab, 0x14, 0x4c, 0x6c, 0xdb, 0x9f, 0x80, 0x51, 0xb2, 0x8c, 0x89, 0xb3, 0xed, 0x4b,
-	0xda, 0xb0, 0x7e, 0xd4, 0x19, 0x9d, 0xac, 0x6e, 0xa1, 0xc5, 0x4a, 0xa3, 0x37, 0x60, 0xa9, 0xc1,
-	0x33, 0xe8, 0x32, 0x18, 0xd6, 0xe5, 0x17, 0x49, 0x90, 0xc8, 0xa3, 0x75, 0xd8, 0xbb, 0x0f, 0xff,
-	0xc6, 0x80, 0x9b, 0x3d, 0xa3, 0xfc, 0x92, 0x07, 0xfc, 0x89, 0xb2, 0x70, 0xf0, 0x68, 0xf1, 0x7d,
-	0xd1, 0x8f, 0x2f, 0x2d, 0x47, 0x60, 0x4a, 0xd5, 0xe2, 0x7b, 0x9a, 0xfb, 0xce, 0x31, 0x51, 0xa9,
-	0x7a, 0x76, 0xb4, 0xbc, 0x04, 0x53, 0x34, 0xac, 0x60, 0xc0, 0x7c, 0xf7, 0xd9, 0x1c, 0x04, 0x96,
-	0x74, 0xd0, 0x6d, 0x63, 0xf3, 0xf7, 0x0d, 0xb8, 0x52, 0xd8, 0xd0, 0x40, 0xc8, 0xf4, 0x50, 0x21,
-	0xd3, 0x9d, 0x0c, 0x2b, 0x28, 0x68, 0x50, 0x17, 0x3f, 0x42, 0x26, 0x81, 0x53, 0x62, 0x86, 0x93,
-	0x30, 0x3f, 0xb0, 0x03, 0xe7, 0xe8, 0xc8, 0xb2, 0x23, 0x2c, 0x1f, 0x68, 0x9e, 0xf8, 0x2d, 0x5c,
-	0x57, 0x4c, 0x37, 0x40, 0x92, 0xb8, 0x95, 0x68, 0x13, 0xa6, 0xdb, 0x76, 0x97, 0x06, 0xfe, 0x3d,
-	0x76, 0xe2, 0xf3, 0xaa, 0x29, 0x9a, 0xc6, 0x8b, 0x6c, 0x00, 0xb4, 0x1c, 0x4f, 0xc4, 0x1a, 0x60,
-	0xe1, 0x09, 0x26, 0x5b, 0x8e, 0xb7, 0x15, 0x07, 0x02, 0x6a, 0xd9, 0xdf, 0xac, 0x2b, 0x81, 0x0a,
-	0x26, 0x5b, 0xf6, 0x37, 0x59, 0xb6, 0xf9, 0x07, 0xc3, 0x0a, 0x75, 0x15, 0x1c, 0x93, 0x21, 0x0f,
-	0xd1, 0x67, 0x60, 0x9c, 0xfb, 0x82, 0x70, 0x33, 0xc7, 0x95, 0x0c, 0x49, 0xa4, 0xba, 0xdc, 0x2a,
-	0x6d, 0x89, 0x3a, 0x68, 0x87, 0x2c, 0xb1, 0x47, 0x1d, 0xaf, 0xc9, 0xc5, 0xde, 0x9d, 0xa2, 0xda,
-	0x89, 0xdb, 0x0d, 0x3d, 0xa2, 0x27, 0x2c, 0xc7, 0x6b, 0xa3, 0xcf, 0x01, 0x48, 0xc1, 0x89, 0xd8,
-	0xe0, 0xdc, 0xe8, 0x05, 0x16, 0x85, 0x22, 0xd5, 0x45, 0x6f, 0xc3, 0x18, 0x8b, 0x26, 0xc9, 0x95,
-	0xe9, 0xde, 0xa1, 0xf0, 0x7a, 0xe6, 0xef, 0x18, 0x70, 0xb1, 0x98, 0x74, 0x2f, 0x99, 0x27, 0x7f,
-	0x46, 0x2c, 0x1d, 0x15, 0x1e, 0x30, 0x2c, 0x3d, 0x77, 0xf3, 0x46, 0x4f, 0xac, 0x28, 0xb6, 0x72,
-	0x59, 0x5b, 0xcf, 0x8f, 0xa5, 0xb3, 0x55, 0x66, 0xd8, 0xe1, 0x34, 0xc3, 0x9a, 0x4d, 0x16, 0xc7,
-	0x8f, 0x9d, 0xf9, 0x72, 0x6d, 0x41, 0x96, 0xbd, 0x2f, 0x47, 0xf1, 0x38, 0x82, 0x0d, 0xa9, 0x95,
-	0xc0, 0x39, 0x93, 0xc2, 0x9d, 0x17, 0x34, 0x73, 0x57, 0x6d, 0xe6, 0x82, 0xa6, 0x19, 0x0e, 0x8b,
-	0xb7, 0xf3, 0x9b, 0x06, 0x54, 0xf3, 0xba, 0xf3, 0xf2, 0x6d, 0xfd, 0xbb, 0xb2, 0xad, 0x9f, 0x56,
-	0x29, 0x22, 0xa5, 0x45, 0xcb, 0x9b, 0xbf, 0xc5, 0x3d, 0x26, 0xb4, 0xb4, 0x78, 0xf9, 0x87, 0x50,
-	0xbb, 0xf2, 0x21, 0x54, 0x1a, 0xc7, 0xec, 0x40, 0x70, 0x24, 0x0f, 0xd8, 0x3b, 0x02, 0xc2, 0x13,
-	0xa0, 0x74, 0xa0, 0xae, 0xaa, 0x03, 0x95, 0x79, 0x69, 0x2c, 0x56, 0x3f, 0x51, 0x16, 0xec, 0xcb,
-	0x3f, 0x29, 0xda, 0x95, 0x4f, 0x8a, 0x52, 0x7d, 0xd6, 0x74, 0xf6, 0x7b, 0x6c, 0x0f, 0x48, 0xf3,
-	0x07, 0xca, 0x32, 0x79, 0x6e, 0x39, 0xe9, 0x49, 0xc3, 0x56, 0xa3, 0xbf, 0x6e, 0x50, 0xef, 0x06,
-	0x86, 0xd6, 0x40, 0xd9, 0x24, 0x1d, 0x52, 0x3a, 0x77, 0x96, 0x31, 0xc4, 0x2c, 0x8a, 0xd7, 0x23,
-	0x3b, 0x3c, 0xa1, 0x9a, 0xc7, 0x53, 0xdb, 0xe9, 0x3d, 0xb4, 0xde, 0x0a, 0x8c, 0x31, 0xff, 0x1e,
-	0xa1, 0x14, 0xb2, 0x2f, 0xf3, 0x6f, 0x30, 0x8f, 0x8c, 0x14, 0xd0, 0x57, 0x17, 0x40, 0x3b, 0xd5,
-	0xb0, 0xe4, 0x94, 0xf1, 0x21, 0xd5, 0x09, 0xe2, 0xfc, 0x87, 0x76, 0x88, 0x55, 0x2f, 0x87, 0x73,
-	0xf7, 0xf9, 0x47, 0x6c, 0x3d, 0xd2, 0x82, 0x1e, 0xd4, 0xbe, 0x4c, 0xea, 0x79, 0x2d, 0xd5, 0xf3,
-	0xa4, 0xf9, 0x90, 0x77, 0xfd, 0x9f, 0x32, 0xb5, 0x37, 0x2e, 0x61, 0xe1, 0x33, 0xec, 0x75, 0xf0,
-	0xa3, 0xae, 0x67, 0xb7, 0x9c, 0x46, 0x38, 0x50, 0x5c, 0xd3, 0xe7, 0xe7, 0x2a, 0xae, 0x2a, 0x26,
-	0xca, 0x78, 0xfd, 0x4b, 0x03, 0xd6, 0xe3, 0x72, 0x4c, 0x69, 0x22, 0xb3, 0xfc, 0x0b, 0x4e, 0x74,
-	0xb2, 0x7d, 0x62, 0x07, 0xf4, 0x0e, 0x05, 0x53, 0xae, 0xf8, 0x75, 0x64, 0x71, 0x9c, 0x36, 0xc3,
-	0x52, 0xd9, 0x11, 0x65, 0x44, 0x83, 0xa8, 0xb1, 0x62, 0xed, 0x00, 0x9f, 0x39, 0x3e, 0xdf, 0xc7,
-	0x18, 0x16, 0xaf, 0xbd, 0xc7, 0x53, 0x95, 0xe3, 0xad, 0x4a, 0xea, 0xe8, 0xf1, 0x4d, 0x18, 0x25,
-	0x2a, 0x4c, 0xc4, 0x9f, 0xc4, 0xda, 0x48, 0x75, 0x83, 0x22, 0x44, 0xb0, 0xdb, 0x71, 0x7d, 0x3b,
-	0xb2, 0x58, 0x59, 0xf3, 0x31, 0xac, 0xe6, 0x94, 0xa0, 0x5b, 0x5f, 0xfb, 0x10, 0xbb, 0xdc, 0x95,
-	0x8e, 0x7d, 0xa8, 0xb7, 0xc5, 0x0c, 0x71, 0x5b, 0xec, 0xc7, 0x06, 0x5c, 0xd5, 0xb0, 0xf5, 0x81,
-	0x1f, 0xd9, 0xae, 0xbc, 0x19, 0x47, 0x57, 0x60, 0xa6, 0x91, 0xa1, 0x47, 0xc5, 0x9a, 0x6e, 0xc8,
-	0xe4, 0xb8, 0x06, 0xb3, 0x8d, 0x2c, 0x35, 0x2a, 0x16, 0xab, 0x1a, 0x13, 0x23, 0xee, 0x70, 0xa5,
-	0x8f, 0x0e, 0xff, 0x78, 0x18, 0x16, 0x35, 0x98, 0xa2, 0x27, 0x30, 0x73, 0x1c, 0xf8, 0x61, 0x58,
-	0x0f, 0xd8, 0x70, 0xf3, 0xad, 0xaa, 0x99, 0x02, 0xaa, 0x19, 0x64, 0x6b, 0x9a, 0x56, 0xe4, 0x6c,
-	0x82, 0xde, 0x62, 0xa1, 0xc0, 0x86, 0x7b, 0xae, 0x4e, 0xc3, 0x85, 0x7d, 0x11, 0x10, 0x0b, 0x0b,
-	0x16, 0x49, 0xd4, 0xe2, 0x0c, 0x79, 0x2b, 0x57, 0x6c, 0x64, 0xe8, 0x6b, 0x2d, 0x44, 0x19, 0x92,
-	0xdf, 0x87, 0x11, 0x3b, 0x68, 0x77, 0xb8, 0x2a, 0xdb, 0x0b, 0x46, 0xb4, 0xbc, 0xf9, 0x2d, 0xd8,
-	0x28, 0x9c, 0x03, 0x85, 0xe7, 0xe0, 0x9f, 0x51, 0x17, 0xe9, 0xeb, 0x3d, 0xcc, 0x29, 0x39, 0x78,
-	0xd9, 0x5f, 0x34, 0x60, 0xb3, 0xb4, 0x70, 0x0e, 0x87, 0xe6, 0x45, 0x3d, 0x2d, 0x9a, 0x3b, 0xb1,
-	0xa6, 0xc1, 0x82, 0xcc, 0xf3, 0x70, 0x0b, 0x1f, 0x80, 0x99, 0x46, 0x42, 0x72, 0x46, 0x60, 0x03,
-	0xd0, 0x2e, 0x30, 0x1e, 0xe5, 0x60, 0x62, 0x7e, 0x08, 0x37, 0x4a, 0xe0, 0xc6, 0xbc, 0xdb, 0x5f,
-	0x1f, 0xcd, 0x5f, 0x1e, 0x86, 0x4b, 0x25, 0xa0, 0x0b, 0x87, 0xed, 0x01, 0x54, 0x22, 0xbf, 0xcd,
-	0x07, 0xed, 0x86, 0x7e, 0xd0, 0xb2, 0x24, 0xb0, 0x48, 0x25, 0x32, 0xb5, 0xf9, 0x8b, 0x96, 0x7c,
-	0x6a, 0xb3, 0xed, 0x26, 0x0b, 0x77, 0x27, 0x4d, 0x6d, 0x56, 0x28, 0x9e, 0xda, 0x6c, 0xd7, 0xc9,
-	0xaa, 0xc6, 0x53, 0xfb, 0x91, 0x98, 0xda, 0xa3, 0x7c, 0x47, 0xd8, 0x0b, 0x26, 0x31, 0xd1, 0xc4,
-	0x5c, 0xff, 0x9c, 0x24, 0x9d, 0xe9, 0xb9, 0xed, 0x81, 0xdf, 0xb4, 0xa5, 0x91, 0xd3, 0x5d, 0x73,
-	0x8f, 0x39, 0x61, 0x58, 0xe6, 0x84, 0x6d, 0x58, 0xce, 0xca, 0x95, 0x5d, 0xaf, 0x27, 0x21, 0x59,
-	0x11, 0x42, 0xf2, 0x3f, 0x1a, 0x70, 0x21, 0x17, 0x1f, 0x22, 0x37, 0x08, 0xe9, 0x0d, 0x39, 0xee,
-	0x43, 0x11, 0xf6, 0x39, 0x44, 0x67, 0x2d, 0x96, 0x11, 0x9d, 0x3d, 0xbe, 0x90, 0x22, 0xfa, 0xc7,
-	0xd5, 0x05, 0x64, 0x2d, 0x4f, 0x9e, 0xee, 0x7a, 0xb1, 0x34, 0xfd, 0xf7, 0x06, 0xac, 0x24, 0x48,
-	0xd2, 0xd7, 0x83, 0xc2, 0x3f, 0xcb, 0xfd, 0xf9, 0x1f, 0x06, 0x2c, 0xe9, 0x94, 0x14, 0xb4, 0x0f,
-	0x88, 0x2f, 0x0c, 0xf5, 0xc3, 0x6e, 0x5d, 0x9e, 0xef, 0x71, 0x38, 0xa4, 0x12, 0xee, 0xb4, 0xe6,
-	0x83, 0x54, 0x3a, 0x7a, 0x1b, 0xa6, 0xa8, 0xa3, 0x41, 0x3d, 0x22, 0x94, 0x88, 0x2d, 0x8b, 0xc5,
-	0xa4, 0xb2, 0x20, 0x8c, 0x53, 0xd0, 0x7d, 0x18, 0x67, 0x8f, 0x38, 0x89, 0xa3, 0xe3, 0xf5, 0x74,
-	0x6d, 0x79, 0x4c, 0x2c, 0x51, 0xd8, 0xfc, 0xe7, 0x06, 0xac, 0x89, 0xa3, 0x10, 0xfa, 0x94, 0x86,
-	0xdf, 0xe8, 0xc8, 0x81, 0xd9, 0x52, 0x51, 0xcf, 0x8c, 0x6c, 0xd4, 0xb3, 0xd2, 0xa0, 0x30, 0x69,
-	0x97, 0xda, 0x75, 0x98, 0x74, 0x3c, 0x27, 0x72, 0xec, 0xc8, 0x0f, 0xf8, 0x19, 0x51, 0x92, 0x80,
-	0x6e, 0x03, 0x72, 0xc2, 0xba, 0xdd, 0x89, 0xfc, 0xfa, 0x31, 0xf6, 0xb0, 0x14, 0xfc, 0x7c, 0xc2,
-	0x9a, 0x77, 0xc2, 0xad, 0x4e, 0xe4, 0x3f, 0x89, 0xd3, 0xcd, 0x5f, 0x37, 0x60, 0x25, 0x8d, 0xf8,
-	0x40, 0x34, 0xc3, 0x8f, 0x29, 0x9a, 0xe1, 0xaa, 0xec, 0x59, 0x29, 0x37, 0xce, 0xb4, 0xc1, 0x5f,
-	0x1b, 0x86, 0x35, 0xee, 0xb8, 0xa3, 0x25, 0xea, 0x6d, 0x40, 0xe2, 0x49, 0x13, 0x9e, 0x93, 0xe8,
-	0xf0, 0xf3, 0x6d, 0xa5, 0xca, 0x6e, 0x93, 0x0c, 0x81, 0xa4, 0x0b, 0x08, 0xe3, 0x9e, 0x94, 0x94,
-	0x7a, 0xd5, 0x22, 0x31, 0x55, 0x6f, 0xc2, 0xf4, 0x91, 0xed, 0xb8, 0x9d, 0x00, 0xd3, 0x5b, 0x7a,
-	0xe2, 0xc2, 0x39, 0x4f, 0xdb, 0xf6, 0x9b, 0x98, 0x28, 0x9c, 0xa2, 0x88, 0xa0, 0x0a, 0x77, 0x1d,
-	0xe0, 0xc9, 0xe2, 0xd6, 0xce, 0x5d, 0x58, 0x14, 0x05, 0x65, 0x6c, 0xd8, 0x95, 0x16, 0xc4, 0xb3,
-	0x24, 0x75, 0x83, 0x0f, 0xfb, 0x78, 0xec, 0xbe, 0xed, 0xd0, 0xbd, 0xd6, 0xcb, 0x20, 0x48, 0x69,
-	0x5c, 0x84, 0x3f, 0x66, 0x51, 0x32, 0xd4, 0xb6, 0xc2, 0xf3, 0x35, 0x26, 0x1f, 0x28, 0xc8, 0xd7,
-	0x3d, 0x7a, 0x7a, 0x3f, 0x59, 0xba, 0x86, 0x32, 0x52, 0x7c, 0x0d, 0x65, 0x34, 0x75, 0x0d, 0x25,
-	0x3e, 0x6e, 0x1a, 0xd3, 0x1f, 0x37, 0x8d, 0x2b, 0x77, 0x1c, 0xbe, 0x06, 0xab, 0xa9, 0xce, 0x96,
-	0x9c, 0x2f, 0xbd, 0xae, 0x6a, 0x65, 0xb9, 0xfc, 0xcc, 0xb5, 0xb0, 0x1f, 0xf2, 0xa7, 0x9e, 0xd3,
-	0x04, 0x1d, 0xc8, 0x54, 0xfb, 0xb8, 0x72, 0xd8, 0xb4, 0x11, 0x47, 0x09, 0xd6, 0x75, 0x8f, 0x9f,
-	0x38, 0xfd, 0x89, 0x01, 0xd7, 0x74, 0x13, 0x2e, 0x36, 0xbb, 0x9f, 0x73, 0xf0, 0xf3, 0x83, 0xbf,
-	0x0e, 0xf7, 0x1b, 0xfc, 0xb5, 0xa2, 0x0d, 0xfe, 0x8a, 0x3e, 0x09, 0x55, 0xf6, 0x5e, 0x52, 0x82,
-	0x0f, 0x75, 0x1b, 0x48, 0xce, 0xcf, 0x97, 0x59, 0xbe, 0xc0, 0x6a, 0xc7, 0x71, 0xf1, 0xf3, 0xc0,
-	0x35, 0x7f, 0x9a, 0xb2, 0x78, 0xf2, 0xb8, 0x93, 0x6b, 0x7b, 0x89, 0xd3, 0x66, 0x99, 0x75, 0xc0,
-	0xfc, 0x0d, 0x36, 0xa0, 0x99, 0xea, 0xaf, 0xd4, 0x02, 0x95, 0x6e, 0x9d, 0x09, 0xcf, 0x7f, 0xcc,
-	0x2e, 0x26, 0x48, 0x03, 0xb1, 0xd7, 0x3c, 0x7a, 0xde, 0x76, 0x7d, 0xbb, 0x89, 0x9b, 0x05, 0xd7,
-	0xd3, 0x09, 0xd9, 0x64, 0x37, 0x44, 0xf1, 0x4d, 0x94, 0x84, 0x00, 0x47, 0xd8, 0xa3, 0x0e, 0x3b,
-	0x91, 0xc3, 0x5d, 0xb3, 0x46, 0xad, 0x99, 0x38, 0xf5, 0xc0, 0x61, 0xae, 0x8c, 0x9c, 0x33, 0xe2,
-	0xfb, 0x7d, 0x13, 0x2c, 0x41, 0x44, 0x90, 0xf6, 0x22, 0xe1, 0xe7, 0x33, 0x6d, 0x89, 0x4f, 0xf3,
-	0x1b, 0x34, 0xbe, 0x78, 0x1e, 0xb6, 0x03, 0x89, 0xe7, 0xf0, 0x0c, 0x16, 0xf9, 0x5d, 0xfd, 0x06,
-	0x76, 0xda, 0xbd, 0x84, 0x78, 0xdf, 0x00, 0x08, 0x58, 0x61, 0xc9, 0x1f, 0x85, 0xa7, 0xec, 0x36,
-	0xcd, 0xef, 0x1b, 0xb0, 0xa4, 0x42, 0x1c, 0x08, 0x1f, 0xdc, 0x85, 0x71, 0xde, 0x66, 0x26, 0x88,
-	0xad, 0xd2, 0xba, 0x28, 0x65, 0xfe, 0xa2, 0x01, 0x28, 0x09, 0xe9, 0x34, 0xb0, 0x97, 0x4a, 0x65,
-	0xee, 0x4c, 0x05, 0xff, 0x61, 0x2c, 0xf9, 0x1b, 0xec, 0x88, 0x4e, 0xe7, 0x57, 0x3d, 0xa0, 0x50,
-	0xbf, 0xa3, 0xb4, 0x19, 0x8e, 0xd3, 0xa2, 0xea, 0x7d, 0xcc, 0xfc, 0xc3, 0x59, 0x09, 0xf3, 0x3e,
-	0xac, 0xe7, 0x60, 0x56, 0xec, 0xf1, 0xfd, 0xb3, 0x60, 0xaa, 0xf1, 0x94, 0x9f, 0xda, 0x5e, 0xc7,
-	0x76, 0x19, 0x2b, 0x87, 0xfd, 0x44, 0xc7, 0x6e, 0xd1, 0x8a, 0xfc, 0xd1, 0xb5, 0xb0, 0x2e, 0x42,
-	0xe9, 0x71, 0x01, 0xd9, 0x92, 0xc1, 0x3e, 0xe6, 0x0f, 0xed, 0xfe, 0xc0, 0x80, 0x2b, 0x85, 0xad,
-	0xff, 0x24, 0x83, 0x3a, 0x7f, 0x87, 0x33, 0x9d, 0xdd, 0x75, 0x1d, 0xef, 0x34, 0x7c, 0x49, 0x31,
-	0x86, 0xfa, 0xbc, 0xf1, 0xbd, 0x07, 0xf3, 0x02, 0x81, 0x92, 0x95, 0x3c, 0x73, 0xd9, 0xbb, 0xcd,
-	0xea, 0xdd, 0xe1, 0xf5, 0xc5, 0x12, 0xfe, 0xab, 0xec, 0x99, 0x9c, 0xa4, 0x5b, 0x83, 0xba, 0x78,
-	0x28, 0xad, 0xdd, 0x2b, 0xf1, 0xda, 0xad, 0xf4, 0x84, 0x2f, 0xda, 0x57, 0x01, 0x09, 0x1c, 0x19,
-	0x8d, 0x1f, 0x76, 0xe3, 0x37, 0xed, 0x93, 0xf0, 0x55, 0x5b, 0x30, 0xab, 0x96, 0xea, 0x3f, 0x4c,
-	0xd6, 0x2f, 0x29, 0x23, 0xfa, 0x8a, 0xc2, 0x57, 0xa5, 0x87, 0x81, 0x31, 0xd7, 0xbf, 0x35, 0x68,
-	0x7c, 0x7b, 0x9e, 0xf8, 0xdc, 0x7a, 0xef, 0xbc, 0x9d, 0x12, 0xaf, 0xe0, 0xb6, 0xec, 0xf0, 0x54,
-	0x7a, 0x05, 0xf7, 0xa9, 0x1d, 0x9e, 0xa6, 0x1e, 0xf5, 0x1d, 0x29, 0x7e, 0xd4, 0x77, 0xb4, 0xec,
-	0x51, 0xdf, 0xb1, 0xec, 0xa3, 0xbe, 0x2f, 0xa8, 0x43, 0xa4, 0xe8, 0xc4, 0xc0, 0xbc, 0x2b, 0x32,
-	0x6e, 0x84, 0xe6, 0x5f, 0x31, 0x60, 0x2d, 0x69, 0x79, 0x3f, 0xb2, 0xa3, 0x6d, 0xbf, 0xd5, 0x4a,
-	0xa4, 0x5d, 0xdf, 0x54, 0x4c, 0x29, 0xe9, 0x95, 0x62, 0x25, 0x7d, 0x44, 0x55, 0xd2, 0xc9, 0xba,
-	0xb9, 0xae, 0x47, 0x67, 0x20, 0xf4, 0xb8, 0xae, 0xb0, 0xd8, 0x62, 0xcc, 0x62, 0x52, 0xc3, 0x8c,
-	0xcb, 0xfe, 0x8e, 0x01, 0x9b, 0x3a, 0xbc, 0xf8, 0xcd, 0xa2, 0x57, 0xa2, 0xe4, 0x09, 0xe4, 0x68,
-	0x9b, 0x19, 0x0c, 0xff, 0xd0, 0x80, 0x4b, 0xca, 0xed, 0xdd, 0x57, 0xa3, 0xe3, 0xdd, 0x82, 0x85,
-	0x80, 0xb5, 0x2a, 0xb9, 0x54, 0x72, 0x6f, 0xef, 0x40, 0x46, 0xa7, 0x50, 0xe5, 0x6b, 0xc3, 0xe5,
-	0x7c, 0xdc, 0x07, 0xa2, 0xf1, 0xbd, 0x0d, 0x2b, 0x8f, 0x68, 0x80, 0xd3, 0x7d, 0xfb, 0x0c, 0x37,
-	0xb7, 0xa9, 0x69, 0x29, 0xd7, 0xff, 0x8d, 0x3f, 0x82, 0x3c, 0x2c, 0x3f, 0x82, 0x7c, 0xef, 0xff,
-	0x9d, 0xc0, 0x2c, 0x7f, 0x7c, 0x63, 0x1f, 0x07, 0xf4, 0xf2, 0xc4, 0x17, 0xb9, 0xd2, 0xc7, 0xcc,
-	0x3f, 0x0f, 0xbb, 0x9c, 0x5d, 0xd0, 0x86, 0xaa, 0x95, 0xa5, 0xb2, 0x6b, 0xfc, 0x39, 0xea, 0xfc,
-	0xf7, 0x05, 0xcc, 0x21, 0xf4, 0x1c, 0x50, 0x36, 0x1f, 0xad, 0xe9, 0x00, 0xf3, 0x8e, 0xf4, 0x04,
-	0xb6, 0x91, 0xf7, 0x98, 0x38, 0x05, 0x9f, 0xff, 0x4e, 0xb6, 0x68, 0x63, 0xb3, 0xa0, 0x44, 0xdc,
-	0xc8, 0xe7, 0xe3, 0xb7, 0x8b, 0x55, 0xec, 0x6b, 0xf9, 0xcf, 0xfe, 0xd7, 0xd6, 0xb4, 0x79, 0x31,
-	0xc8, 0x7d, 0x6a, 0xa4, 0xa2, 0x59, 0x3c, 0x92, 0xbd, 0x1e, 0xa8, 0x12, 0x56, 0x2a, 0x05, 0x54,
-	0x7d, 0xdd, 0xd8, 0x1c, 0x42, 0x4f, 0x60, 0x5a, 0xd6, 0x9a, 0xd1, 0x05, 0x89, 0x80, 0xea, 0xce,
-	0xa0, 0x56, 0xd3, 0x65, 0xc5, 0x80, 0xde, 0x82, 0x29, 0xb6, 0x77, 0xa6, 0xf9, 0x28, 0xe5, 0x46,
-	0x51, 0xe3, 0x21, 0xe6, 0x1f, 0xb7, 0xda, 0x91, 0xdc, 0xfc, 0x03, 0x98, 0x65, 0xb5, 0xe2, 0xf0,
-	0x95, 0x59, 0x8d, 0x2a, 0xaf, 0xee, 0xe7, 0x60, 0x46, 0x79, 0xe4, 0x45, 0x90, 0x41, 0xf7, 0xf2,
-	0x4b, 0xed, 0x42, 0x26, 0x4f, 0x82, 0xf4, 0x3e, 0xcc, 0xc8, 0xef, 0x51, 0x86, 0x88, 0x9b, 0x3d,
-	0xf5, 0x8f, 0x16, 0xd7, 0x36, 0x72, 0x72, 0x63, 0x78, 0xbb, 0x30, 0xab, 0x2a, 0xa6, 0x88, 0xdf,
-	0x0c, 0xcd, 0xbc, 0x0d, 0x5d, 0x5b, 0x97, 0xe3, 0x19, 0x68, 0x50, 0xab, 0xc3, 0x92, 0xee, 0xc5,
-	0x12, 0xb4, 0xa9, 0xe2, 0xa0, 0x79, 0xdf, 0xb6, 0x66, 0xea, 0x40, 0xab, 0x0f, 0x9e, 0x98, 0x43,
-	0xe8, 0x8b, 0x80, 0xb2, 0xcf, 0xc6, 0xa0, 0x4b, 0xba, 0xba, 0xf2, 0x24, 0xb8, 0x9c, 0x5f, 0x20,
-	0x06, 0x6d, 0xc3, 0x8a, 0xfe, 0xbd, 0x0e, 0xc4, 0xcf, 0xc4, 0x0b, 0x5f, 0xf3, 0xe8, 0xa9, 0x09,
-	0x4c, 0xdd, 0x5f, 0x34, 0x5e, 0x66, 0xa2, 0x89, 0x42, 0x9f, 0xc8, 0xda, 0xd5, 0x5e, 0xdc, 0xda,
-	0xcc, 0x21, 0x74, 0xaa, 0xf4, 0x44, 0xd3, 0x4c, 0xa1, 0xab, 0x5b, 0xed, 0x96, 0xe8, 0x49, 0x79,
-	0xd0, 0x46, 0x73, 0x08, 0x45, 0x22, 0x82, 0x95, 0x76, 0x5b, 0x83, 0x6e, 0xe8, 0xc8, 0xa2, 0xdb,
-	0x77, 0xd5, 0x6e, 0xf6, 0x50, 0x52, 0x92, 0x2e, 0x28, 0xfb, 0x08, 0x8d, 0x10, 0x07, 0x9a, 0xa7,
-	0x28, 0xe3, 0xe1, 0xc9, 0x7d, 0xb9, 0x86, 0x4e, 0xd1, 0xb9, 0xd4, 0x43, 0x96, 0x62, 0x6a, 0xe9,
-	0xdf, 0xb7, 0xac, 0xe9, 0x1f, 0xa4, 0x31, 0x87, 0xd0, 0x7b, 0x2c, 0xf8, 0xa4, 0xfa, 0x86, 0xf1,
-	0xe5, 0xc4, 0x43, 0x4a, 0xff, 0x06, 0xb1, 0x10, 0x1d, 0x4a, 0x35, 0x73, 0x08, 0x3d, 0x83, 0x95,
-	0xa7, 0x76, 0x70, 0x2a, 0x27, 0x6f, 0xb1, 0x9b, 0x24, 0xe7, 0x44, 0xef, 0x94, 0x45, 0xca, 0xd1,
-	0xbf, 0x68, 0x8b, 0xae, 0x27, 0x78, 0x16, 0xbe, 0x79, 0x5b, 0x33, 0x95, 0x0e, 0x69, 0xcb, 0xd2,
-	0xc6, 0xaa, 0x79, 0xaf, 0xfc, 0xa2, 0x6b, 0x19, 0x8e, 0xd6, 0x05, 0x82, 0xab, 0xbd, 0x56, 0x56,
-	0x2c, 0x1e, 0xc2, 0x93, 0x34, 0x37, 0xaa, 0xed, 0x99, 0xaa, 0x1c, 0xd2, 0x36, 0x76, 0xa5, 0xb0,
-	0x8c, 0xbc, 0x14, 0xc9, 0x2f, 0x7d, 0x0a, 0xde, 0xd3, 0x3c, 0x52, 0x2a, 0x96, 0x22, 0xdd, 0xc3,
-	0xa0, 0xe6, 0x10, 0x7a, 0x04, 0x53, 0xd2, 0x43, 0x9a, 0xa8, 0x9a, 0x10, 0x55, 0x7d, 0xba, 0x53,
-	0x2c, 0x0a, 0x9a, 0x57, 0x37, 0xcd, 0x21, 0xb4, 0x05, 0x93, 0xf1, 0xd3, 0x99, 0x68, 0x25, 0xa6,
-	0x57, 0x3f, 0x88, 0x3c, 0xa5, 0xd7, 0xb9, 0x99, 0xfa, 0x41, 0xb2, 0xc4, 0xba, 0x8d, 0xf8, 0xd5,
-	0xb8, 0xd4, 0x73, 0x9a, 0x65, 0x6b, 0xf5, 0xa9, 0xa2, 0xb8, 0x88, 0x97, 0x10, 0xf9, 0x2b, 0x99,
-	0xe8, 0xb5, 0x8c, 0x5a, 0xa2, 0x7d, 0x79, 0x52, 0x8c, 0x46, 0xe1, 0x83, 0x79, 0xe6, 0x10, 0xfa,
-	0x59, 0xe5, 0x11, 0x4b, 0xf5, 0x39, 0x48, 0xd1, 0xe4, 0x1b, 0x05, 0x4d, 0x6a, 0x1f, 0x90, 0xec,
-	0xb5, 0xf1, 0xc3, 0x98, 0x70, 0xea, 0x53, 0x6f, 0xc8, 0x2c, 0x7c, 0x07, 0x4e, 0x6d, 0xa3, 0xe8,
-	0xad, 0x38, 0x73, 0x08, 0x7d, 0x12, 0x96, 0xd9, 0xb0, 0x3d, 0x0b, 0x94, 0xeb, 0xf1, 0x48, 0x35,
-	0xde, 0xd5, 0xd4, 0x4f, 0xc6, 0xa7, 0x72, 0x1c, 0x71, 0x24, 0x71, 0x51, 0x2a, 0xf0, 0xbd, 0x60,
-	0x0f, 0x5d, 0xd8, 0x71, 0x73, 0x08, 0x7d, 0x16, 0x20, 0xb1, 0x4b, 0xa2, 0x25, 0xa1, 0xc2, 0xcb,
-	0xb1, 0xc1, 0x6b, 0xd5, 0x98, 0xf1, 0x52, 0xf6, 0x4b, 0x73, 0x08, 0xfd, 0x34, 0xcc, 0x30, 0x7d,
-	0xbe, 0x18, 0x44, 0x8e, 0xfa, 0xf4, 0x9c, 0x59, 0x72, 0x52, 0xe1, 0xd5, 0x85, 0x4c, 0xcd, 0x8f,
-	0x81, 0x5f, 0xd2, 0xa9, 0x27, 0xf4, 0xc5, 0x59, 0x25, 0xd4, 0x40, 0x0e, 0x5e, 0x6b, 0xd9, 0x67,
-	0xa2, 0x42, 0x45, 0x29, 0x5b, 0xd4, 0x84, 0x2d, 0x10, 0xf8, 0xe5, 0x47, 0x34, 0x10, 0xfd, 0x55,
-	0xf6, 0x42, 0xe6, 0x10, 0x7a, 0x1b, 0x66, 0x94, 0x00, 0xe4, 0x28, 0x13, 0xb8, 0x5a, 0x60, 0xa4,
-	0x8d, 0x53, 0x4e, 0xe5, 0x0a, 0x24, 0x51, 0xc3, 0x85, 0x4a, 0x97, 0x89, 0x23, 0x5e, 0x06, 0x25,
-	0x61, 0x1f, 0x1a, 0x6c, 0x3b, 0xc5, 0x3e, 0x72, 0xe4, 0xf0, 0x14, 0xa5, 0x95, 0xd8, 0xdc, 0x14,
-	0x50, 0x32, 0xfc, 0x1f, 0x09, 0xa3, 0x4f, 0xc1, 0x14, 0x9b, 0x09, 0xec, 0x7a, 0x2c, 0xe2, 0x97,
-	0xde, 0xa4, 0xd8, 0xdd, 0x82, 0xa6, 0x4a, 0x8c, 0x6d, 0x73, 0x08, 0xbd, 0x80, 0xf5, 0x22, 0xa5,
-	0x06, 0xdd, 0xec, 0x45, 0xf1, 0x39, 0x8f, 0x8e, 0xf4, 0x36, 0xe5, 0x32, 0xe6, 0xf8, 0xe0, 0xe0,
-	0x90, 0x50, 0x48, 0xe0, 0xcd, 0xf9, 0x9c, 0x41, 0x4d, 0x82, 0x96, 0x29, 0x65, 0xe9, 0xe4, 0x81,
-	0x18, 0x02, 0xd9, 0x3a, 0x88, 0x72, 0x49, 0xa2, 0x80, 0x31, 0x9f, 0x82, 0xd1, 0x35, 0x87, 0xd0,
-	0x27, 0x60, 0x86, 0x71, 0xa0, 0x00, 0x90, 0x29, 0xa4, 0xad, 0xf6, 0x04, 0x66, 0x55, 0x6f, 0x6d,
-	0xb1, 0x12, 0xa4, 0x02, 0x27, 0xd7, 0xd6, 0xd5, 0x64, 0xd5, 0xb5, 0x9b, 0xee, 0x30, 0xe6, 0x52,
-	0xfe, 0xd5, 0x79, 0x90, 0x36, 0x52, 0x90, 0x54, 0x6f, 0x6c, 0x73, 0x08, 0xed, 0xc1, 0xe2, 0x8e,
-	0xe3, 0x35, 0xb7, 0x5c, 0x57, 0xf6, 0xfe, 0x17, 0xcc, 0x94, 0x89, 0xf8, 0x5d, 0xbb, 0x58, 0x7c,
-	0x5d, 0xc0, 0x1c, 0x42, 0x16, 0x0b, 0x6d, 0x9a, 0x40, 0xe4, 0x18, 0xe6, 0x82, 0xbc, 0x54, 0xe2,
-	0xdd, 0x4f, 0xe7, 0xde, 0x8c, 0x02, 0x33, 0x1f, 0x58, 0x35, 0xcf, 0x6d, 0x9e, 0x6d, 0x76, 0xb2,
-	0xb1, 0xa0, 0xc5, 0x66, 0x27, 0x37, 0xfa, 0xb4, 0x50, 0x75, 0xf3, 0xc3, 0x48, 0x9b, 0x43, 0xe8,
-	0x31, 0xdd, 0x8d, 0x26, 0x47, 0x28, 0x92, 0x01, 0x44, 0x49, 0x4f, 0x66, 0x54, 0xf6, 0x38, 0x86,
-	0xae, 0x09, 0xf3, 0x42, 0xb4, 0xc5, 0x90, 0x74, 0x45, 0xf3, 0xea, 0x7f, 0x9e, 0x6e, 0x88, 0x34,
-	0xd1, 0x8e, 0x62, 0xc3, 0x46, 0x6e, 0x20, 0xa4, 0x3c, 0x90, 0xfb, 0xd4, 0x67, 0x5e, 0x17, 0x08,
-	0x49, 0x3b, 0xe5, 0xcc, 0x74, 0x3b, 0xd9, 0xc0, 0x49, 0xe6, 0x10, 0x3a, 0x4a, 0x9d, 0x85, 0x89,
-	0x77, 0x94, 0xc4, 0xc9, 0x93, 0x18, 0x94, 0xdc, 0x33, 0xa9, 0x1e, 0xdb, 0x39, 0xa4, 0x91, 0x9d,
-	0xd3, 0x71, 0x7a, 0x1e, 0x76, 0x95, 0x81, 0xe2, 0xeb, 0x85, 0x36, 0x74, 0x91, 0xe0, 0xf7, 0xbc,
-	0x50, 0x44, 0x05, 0x6d, 0x6c, 0xfb, 0xde, 0x19, 0x0e, 0x42, 0x7a, 0x71, 0xee, 0xa3, 0xb6, 0x81,
-	0x59, 0xd4, 0x47, 0x5d, 0xbc, 0x21, 0xa1, 0x14, 0x15, 0x05, 0x32, 0x12, 0x4a, 0x51, 0x61, 0xc0,
-	0x22, 0xa6, 0x78, 0x69, 0x0f, 0x02, 0x45, 0x1b, 0x45, 0xa7, 0x84, 0xb5, 0x2b, 0x85, 0x65, 0xa4,
-	0x75, 0x0b, 0x29, 0x01, 0xd0, 0x59, 0x08, 0x9c, 0x5a, 0xbc, 0x91, 0xce, 0x84, 0x46, 0xcf, 0xd3,
-	0x60, 0xde, 0xa5, 0xbb, 0xf2, 0xa4, 0x78, 0x12, 0x5c, 0xfc, 0x1c, 0xc0, 0xbe, 0x24, 0x3c, 0xd8,
-	0x62, 0x75, 0x50, 0xd9, 0xe7, 0xe4, 0x44, 0xbf, 0x16, 0xc6, 0xc0, 0x82, 0x90, 0xd3, 0xe6, 0x10,
-	0xfa, 0x8e, 0x01, 0xaf, 0x17, 0x00, 0xe7, 0x5a, 0x8c, 0xe3, 0xc7, 0x2f, 0x5b, 0x08, 0x65, 0xbe,
-	0x2c, 0x9e, 0x76, 0xed, 0x7a, 0x69, 0xb9, 0x18, 0x89, 0x0e, 0xbd, 0x43, 0x5b, 0xd6, 0x70, 0xb2,
-	0x7f, 0x2c, 0x8a, 0x86, 0x2e, 0xed, 0x1f, 0x0b, 0x83, 0x98, 0x9b, 0x43, 0xa8, 0x0b, 0xd7, 0xb8,
-	0x96, 0xf2, 0x72, 0x5a, 0xee, 0xa3, 0xc7, 0x3f, 0x07, 0xd7, 0x0b, 0xa8, 0x7e, 0x40, 0x54, 0x8b,
-	0x01, 0xd2, 0xdb, 0x17, 0xa7, 0x3a, 0x39, 0x4d, 0xbe, 0x74, 0x4a, 0x87, 0x70, 0x49, 0x43, 0xe9,
-	0xf3, 0xb4, 0xd9, 0x47, 0x2f, 0xdf, 0x61, 0xeb, 0x72, 0x1c, 0x54, 0x5c, 0x4c, 0x3d, 0x5d, 0x6c,
-	0x73, 0xa1, 0x87, 0x6a, 0xa3, 0x90, 0x53, 0xe1, 0x73, 0xf1, 0x09, 0x8e, 0xb6, 0x5c, 0x37, 0x2f,
-	0x94, 0xa7, 0x76, 0xbd, 0xb9, 0xa5, 0x5a, 0xcd, 0x8b, 0xe2, 0xa6, 0x9a, 0x43, 0xe8, 0x1b, 0xe2,
-	0xe8, 0x4f, 0xdf, 0xc0, 0x8d, 0xf2, 0x70, 0xa2, 0xea, 0xb8, 0x94, 0x05, 0x3f, 0x35, 0x87, 0xd0,
-	0xd7, 0x61, 0x6d, 0xbf, 0xa0, 0xc9, 0xf2, 0x08, 0xa6, 0x7d, 0xb4, 0xf5, 0x25, 0xb8, 0xa8, 0xf0,
-	0x40, 0x2f, 0xcd, 0xf9, 0x61, 0xc4, 0xea, 0x94, 0x6c, 0xa0, 0xbe, 0x63, 0xd0, 0x57, 0x0b, 0xb4,
-	0xe3, 0x13, 0x1b, 0xba, 0xef, 0xf4, 0x1a, 0xdf, 0x94, 0xb7, 0x75, 0x3b, 0xaf, 0x6b, 0xba, 0x90,
-	0x97, 0x74, 0xc7, 0xb1, 0xa1, 0x1d, 0xbf, 0x18, 0x81, 0x5b, 0xbd, 0x84, 0x2b, 0xe5, 0x8d, 0xdf,
-	0x28, 0x6b, 0x5c, 0x6a, 0xb8, 0x4d, 0xef, 0x33, 0x17, 0x34, 0xdc, 0x4b, 0x9c, 0xd4, 0xbe, 0x5a,
-	0xfc, 0x0a, 0x5c, 0xce, 0x1b, 0xcb, 0xb8, 0xd1, 0xf3, 0x8f, 0xe6, 0x87, 0xd4, 0xf3, 0x6f, 0xcb,
-	0x75, 0x35, 0x61, 0x48, 0xb5, 0xf3, 0x8c, 0xcb, 0x8e, 0x92, 0xf8, 0xae, 0x74, 0x1a, 0x53, 0x9b,
-	0xbc, 0x06, 0xec, 0x95, 0xc2, 0xd8, 0xa7, 0xea, 0xf1, 0x5a, 0x41, 0x7c, 0x56, 0x73, 0x08, 0x7d,
-	0x99, 0x19, 0xe4, 0x35, 0x6d, 0x14, 0xc6, 0x57, 0xed, 0x0d, 0xf8, 0x73, 0xb8, 0xc0, 0x48, 0xa8,
-	0x83, 0x7f, 0x7e, 0x8a, 0x87, 0x54, 0xf4, 0xa4, 0x29, 0x1e, 0x0f, 0xe5, 0xcd, 0x1e, 0x42, 0xae,
-	0xaa, 0xf2, 0xb9, 0x34, 0x44, 0x2c, 0x35, 0xdf, 0x56, 0xd3, 0x83, 0x11, 0xb7, 0x78, 0xad, 0x24,
-	0x78, 0x6a, 0xca, 0x7c, 0x5b, 0x14, 0xe4, 0x95, 0x9e, 0xf6, 0x54, 0xf7, 0xf3, 0x5a, 0x2a, 0x09,
-	0xd3, 0xda, 0x6b, 0x13, 0x5f, 0x80, 0x35, 0xcd, 0xc0, 0xbc, 0x84, 0xc9, 0x70, 0x28, 0x6c, 0x81,
-	0xa9, 0x57, 0x06, 0x84, 0xda, 0x5b, 0xf4, 0x40, 0x84, 0x40, 0xbe, 0xf0, 0x91, 0x04, 0x6a, 0x0c,
-	0x9e, 0x55, 0xa3, 0x75, 0xa1, 0xb5, 0x78, 0x35, 0xce, 0x46, 0x05, 0x93, 0x2c, 0x00, 0x9a, 0x00,
-	0x5f, 0xd4, 0x3c, 0x76, 0x41, 0x55, 0x6e, 0x64, 0xc8, 0xd9, 0x18, 0x5d, 0xa5, 0xf0, 0xea, 0x30,
-	0x9f, 0x0e, 0x37, 0x25, 0x76, 0x2d, 0x39, 0x61, 0xa8, 0xfa, 0x34, 0xd9, 0x90, 0x06, 0x52, 0xd1,
-	0xb2, 0xe2, 0x06, 0xf4, 0x51, 0xb4, 0xfa, 0x6c, 0xe0, 0xcb, 0xb0, 0xa8, 0x79, 0x0b, 0x02, 0x29,
-	0xe7, 0x54, 0xba, 0x58, 0xef, 0xb5, 0xd2, 0x12, 0xcc, 0x72, 0x90, 0x7d, 0x0a, 0x01, 0x49, 0x86,
-	0x0b, 0xed, 0x2b, 0x12, 0xb5, 0xcb, 0xf9, 0x05, 0xa4, 0x93, 0xb7, 0xf9, 0x74, 0x40, 0x7f, 0x94,
-	0x58, 0x6d, 0xb4, 0x18, 0x5f, 0xcc, 0xcb, 0x96, 0xad, 0xa7, 0x9a, 0x18, 0xf9, 0xe8, 0x72, 0x6e,
-	0xf8, 0xfc, 0x92, 0x19, 0xf2, 0x55, 0x58, 0xcd, 0x79, 0x6c, 0x01, 0x5d, 0xcd, 0xe9, 0xa3, 0x6a,
-	0x96, 0xd1, 0x84, 0xd2, 0x92, 0xd0, 0xfd, 0x1a, 0xac, 0x15, 0x04, 0xe5, 0x17, 0x67, 0x9e, 0xe5,
-	0x71, 0xfb, 0xf3, 0xd0, 0x7f, 0x0c, 0xd5, 0xad, 0x4e, 0xe4, 0xb3, 0x00, 0xfd, 0xa9, 0x41, 0xd4,
-	0x2d, 0x76, 0x39, 0x9b, 0xc4, 0x53, 0xa8, 0xe6, 0xf9, 0xec, 0x08, 0xdd, 0xba, 0xc4, 0x1f, 0x49,
-	0xe8, 0x72, 0x65, 0xae, 0x3f, 0xf4, 0xf0, 0x94, 0xac, 0xa3, 0x49, 0xf0, 0xab, 0xf8, 0x12, 0x96,
-	0x16, 0xe3, 0x4c, 0x38, 0x2d, 0x85, 0x19, 0x56, 0x15, 0x48, 0x3b, 0x7e, 0x20, 0xac, 0x42, 0xab,
-	0xd9, 0x6a, 0xe5, 0xf0, 0x3e, 0xa0, 0x8b, 0x4a, 0x9c, 0xa5, 0x5c, 0x0f, 0x5e, 0x8f, 0xeb, 0x69,
-	0x22, 0xd1, 0x95, 0x70, 0xc1, 0x3b, 0xb0, 0x90, 0x09, 0xf9, 0x85, 0x2e, 0x2a, 0xc7, 0x39, 0x59,
-	0x44, 0x73, 0x86, 0xca, 0x82, 0x65, 0x6d, 0xc8, 0x2f, 0x21, 0xd2, 0x8b, 0xe2, 0x81, 0xe5, 0x1f,
-	0x21, 0x2c, 0x6e, 0xdb, 0x6e, 0x63, 0x8b, 0x46, 0x5e, 0x3d, 0xe8, 0x04, 0x9e, 0x7f, 0x46, 0x26,
-	0x40, 0x1f, 0x0c, 0x14, 0x51, 0x1d, 0x20, 0x2f, 0xbe, 0x94, 0xe0, 0xf3, 0xf2, 0x58, 0x57, 0xb5,
-	0x9b, 0x3d, 0x94, 0x94, 0xce, 0xf2, 0x36, 0x4b, 0xa3, 0x6e, 0xf5, 0xe6, 0x93, 0x71, 0xb7, 0xcf,
-	0x18, 0x5e, 0x54, 0x72, 0x54, 0xd5, 0x85, 0x4a, 0x7a, 0x65, 0xf6, 0x9a, 0x2c, 0x79, 0xb3, 0xf9,
-	0x29, 0x5e, 0x94, 0x33, 0x62, 0xf0, 0xcf, 0xe8, 0xb2, 0x2a, 0x3d, 0x14, 0x8c, 0xa4, 0xb8, 0x29,
-	0x52, 0x72, 0xca, 0x1e, 0x9e, 0xc9, 0x8d, 0x01, 0xbe, 0x47, 0x0d, 0xb9, 0x12, 0x92, 0x97, 0x94,
-	0xd3, 0xab, 0xec, 0x8b, 0xbb, 0x85, 0xe8, 0x1d, 0xc0, 0x3c, 0xd3, 0x40, 0xfa, 0x01, 0xb8, 0xa1,
-	0x61, 0x9e, 0x2f, 0x38, 0xd1, 0x09, 0x63, 0x52, 0xda, 0xe9, 0x05, 0x6a, 0x75, 0x0f, 0x4f, 0x24,
-	0xb0, 0x62, 0xa2, 0xa4, 0x33, 0x7a, 0xa1, 0xe2, 0x3e, 0x2c, 0x3e, 0xf7, 0xb2, 0x20, 0xc5, 0x61,
-	0x9b, 0x77, 0x2e, 0xa0, 0x5f, 0xa3, 0x02, 0x4c, 0xf3, 0x86, 0xb3, 0xe4, 0x9c, 0x93, 0xff, 0xc2,
-	0x73, 0xf9, 0x48, 0x7d, 0x40, 0x5f, 0xf1, 0x55, 0x1f, 0x91, 0x46, 0x17, 0x35, 0xa0, 0xa5, 0x37,
-	0xb7, 0xc5, 0x59, 0x43, 0xee, 0xf3, 0xd7, 0xf4, 0x70, 0x65, 0x9a, 0xec, 0x31, 0xe3, 0xf0, 0xd3,
-	0xf2, 0x31, 0x5c, 0x1c, 0x84, 0x58, 0x35, 0x69, 0xa4, 0xf2, 0x24, 0x12, 0x2c, 0x13, 0x9d, 0xff,
-	0xcc, 0x76, 0x5c, 0xfb, 0xd0, 0x25, 0x4c, 0xc0, 0xc2, 0xf2, 0xc9, 0xc7, 0xac, 0xbc, 0xda, 0xbb,
-	0x98, 0xd9, 0xe3, 0x53, 0xfb, 0x20, 0x6d, 0x09, 0xc9, 0xcd, 0x70, 0x96, 0xad, 0x1c, 0x04, 0xf2,
-	0x0e, 0x51, 0xfd, 0xc4, 0x4d, 0xb7, 0xa4, 0x0e, 0x4d, 0x4f, 0x69, 0x0e, 0xd9, 0x6c, 0x49, 0x4f,
-	0x05, 0x46, 0x9c, 0x87, 0xdd, 0xdd, 0x47, 0x28, 0x19, 0xdf, 0xf4, 0xf0, 0x28, 0x63, 0x98, 0xca,
-	0x54, 0x8f, 0x5c, 0x2c, 0x1c, 0xe2, 0xe0, 0x0c, 0x4b, 0xc5, 0x62, 0xeb, 0x7e, 0x82, 0x06, 0x2f,
-	0x94, 0x52, 0x9c, 0x74, 0x05, 0x24, 0x63, 0xc7, 0xea, 0x8e, 0xe3, 0x39, 0xe1, 0x89, 0x85, 0x9b,
-	0x18, 0xb7, 0x64, 0xf8, 0x1f, 0x19, 0xed, 0x03, 0x58, 0xdd, 0xb6, 0xbd, 0x06, 0x76, 0xfb, 0x81,
-	0x5d, 0x3a, 0x6f, 0x3f, 0x80, 0x05, 0xa6, 0x7a, 0x6d, 0xfb, 0x4d, 0xbc, 0xeb, 0x31, 0x78, 0x17,
-	0x65, 0x9d, 0x4c, 0xca, 0x48, 0x71, 0xac, 0x26, 0x5f, 0x22, 0xf2, 0x4a, 0x36, 0x02, 0x51, 0x4a,
-	0x91, 0xd4, 0x05, 0x3d, 0x92, 0x14, 0x49, 0x6d, 0xf8, 0x22, 0x6a, 0x36, 0xde, 0x2c, 0x8d, 0xa3,
-	0x53, 0xd6, 0xca, 0xdd, 0x6c, 0x76, 0x61, 0x3c, 0x9e, 0xd8, 0xb1, 0x4f, 0x73, 0x77, 0x5e, 0x92,
-	0x1d, 0xf9, 0x81, 0x8d, 0x24, 0xc7, 0xbe, 0x82, 0x10, 0x45, 0x94, 0x70, 0x4b, 0xba, 0x7b, 0xeb,
-	0xc2, 0xbd, 0xb2, 0xe0, 0x4e, 0xbb, 0xd8, 0x50, 0xe9, 0xef, 0x8d, 0x33, 0xd0, 0xba, 0xcb, 0xa4,
-	0x02, 0x74, 0xc1, 0xcd, 0xee, 0x1e, 0x40, 0xa3, 0xec, 0x3d, 0x5a, 0x74, 0x49, 0x36, 0xee, 0x6a,
-	0xae, 0x2c, 0xd7, 0x2e, 0xe7, 0x17, 0x90, 0x16, 0x82, 0x85, 0x4c, 0xbe, 0x24, 0x53, 0xcf, 0x87,
-	0x2f, 0x16, 0xcf, 0xab, 0xca, 0xd9, 0xaa, 0x12, 0x5e, 0xd6, 0x44, 0x2f, 0x1b, 0x29, 0x0f, 0x2e,
-	0xe4, 0x5e, 0xa2, 0x94, 0xdc, 0xa3, 0x0a, 0xef, 0x84, 0x0a, 0xdb, 0x4a, 0xe9, 0x6d, 0x4c, 0x73,
-	0x08, 0xed, 0xc0, 0x05, 0xb2, 0xa9, 0xd0, 0x31, 0x49, 0x5f, 0x4a, 0x21, 0x1b, 0xce, 0xd4, 0x3d,
-	0x56, 0x69, 0x38, 0xf5, 0xd7, 0x73, 0xa5, 0xe1, 0xcc, 0xb9, 0x80, 0x6b, 0x0e, 0x21, 0x47, 0x71,
-	0x19, 0x13, 0xd2, 0x54, 0xf8, 0x6f, 0xdd, 0x2a, 0xf2, 0xdf, 0xe2, 0x65, 0x7b, 0x96, 0x6d, 0x5f,
-	0x81, 0x65, 0xba, 0x51, 0xdf, 0x3f, 0xed, 0x6c, 0x79, 0x4d, 0xb6, 0xb8, 0x52, 0xb7, 0x14, 0x53,
-	0xda, 0xc5, 0xa7, 0x33, 0x7b, 0x86, 0xfe, 0x08, 0xa6, 0xa4, 0x7b, 0x67, 0xa8, 0x2a, 0xb3, 0x8b,
-	0x7c, 0xc3, 0x4e, 0x72, 0xf4, 0x4e, 0x5f, 0x52, 0x4b, 0x3c, 0xae, 0xf8, 0x15, 0x85, 0x25, 0xe5,
-	0x62, 0x59, 0x4a, 0xa3, 0xc9, 0x5e, 0xf5, 0xa2, 0x58, 0x2c, 0xec, 0x7a, 0x8d, 0x80, 0x67, 0x7c,
-	0xe0, 0x84, 0x4e, 0x14, 0xe3, 0x92, 0xbd, 0x85, 0x96, 0x37, 0xde, 0xef, 0x30, 0x57, 0x81, 0xf8,
-	0xf6, 0x96, 0xe4, 0xb8, 0x9e, 0xb9, 0xd2, 0x55, 0x5b, 0xcb, 0xe4, 0x29, 0xaa, 0xbd, 0x95, 0xf1,
-	0x62, 0x8b, 0xef, 0x5f, 0x88, 0xbb, 0x33, 0x31, 0x7f, 0xf6, 0xda, 0xcb, 0x9d, 0xd8, 0xb1, 0xa8,
-	0x90, 0x50, 0xa5, 0x63, 0xf6, 0x35, 0xf9, 0xae, 0x20, 0x49, 0xa5, 0xf1, 0xa5, 0xd0, 0x66, 0xba,
-	0xe9, 0xcc, 0x0d, 0xac, 0x9a, 0x59, 0x54, 0x24, 0xc6, 0xf3, 0x98, 0x39, 0x26, 0x24, 0x25, 0x12,
-	0x67, 0x87, 0x1e, 0xda, 0xb8, 0x9e, 0x5f, 0x44, 0xb9, 0xe1, 0x14, 0x1b, 0x51, 0x95, 0x86, 0x2c,
-	0x7c, 0x84, 0x83, 0x00, 0x07, 0x2f, 0xb9, 0xa5, 0xa6, 0x7c, 0xb1, 0x8f, 0xb5, 0xf4, 0xc8, 0x8e,
-	0xf0, 0x4b, 0x6e, 0xa5, 0x91, 0x1e, 0x98, 0x87, 0xdd, 0xe7, 0x51, 0xeb, 0x25, 0x37, 0xb2, 0x07,
-	0x73, 0xa9, 0xdb, 0x46, 0x62, 0x67, 0xa6, 0xbf, 0x84, 0x54, 0xca, 0x4f, 0x0f, 0x7f, 0xfa, 0x4b,
-	0x0f, 0x8e, 0x9d, 0xe8, 0xa4, 0x73, 0x78, 0xa7, 0xe1, 0xb7, 0xee, 0xb6, 0xed, 0x6e, 0xd8, 0x69,
-	0xe3, 0x20, 0xfe, 0xf1, 0x3a, 0xb7, 0xfe, 0xbe, 0x4e, 0xf5, 0xc4, 0xe0, 0x6e, 0xfb, 0xf4, 0xf8,
-	0x2e, 0x8d, 0x08, 0x7e, 0x97, 0xc0, 0x3d, 0x1c, 0xa3, 0xbf, 0xdf, 0xfc, 0xff, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xbf, 0xbf, 0xff, 0xbf, 0x64, 0xc2, 0x00, 0x00,
+	// 13411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x6f, 0x24, 0x49,
+	0x76, 0x58, 0x27, 0x8b, 0xe7, 0xe3, 0x9d, 0xbc, 0x8a, 0x45, 0xb2, 0x9b, 0xcc, 0x9e, 0x99, 0xee,
+	0x99, 0x9d, 0xe9, 0x9e, 0x6b, 0x67, 0x57, 0xb3, 0x5a, 0x69, 0xd9, 0xec, 0x63, 0x38, 0xd3, 0x3d,
+	0xcd, 0x4d, 0xb2, 0x7b, 0x66, 0x8f, 0xd9, 0x42, 0xb2, 0x32, 0x48, 0xe6, 0x30, 0x2b, 0xb3, 0x26,
+	0x33, 0xab, 0x7b, 0x6a, 0x25, 0x5b, 0xd0, 0x61, 0x40, 0xf2, 0xea, 0xb2, 0x2c, 0xc9, 0xf6, 0xea,
+	0xb0, 0x2c, 0xeb, 0xb0, 0x64, 0x1b, 0x96, 0x2d, 0xd9, 0x5e, 0x1b, 0x36, 0x7c, 0xc0, 0x10, 0xa0,
+	0x0f, 0x16, 0x0c, 0xc3, 0xb0, 0x2d, 0xf8, 0x93, 0x0d, 0xd8, 0x1f, 0x0c, 0x58, 0x16, 0xe0, 0x3f,
+	0x60, 0xc4, 0x99, 0x11, 0x99, 0x91, 0x59, 0x55, 0x1c, 0x16, 0x47, 0x02, 0xf4, 0x85, 0xac, 0x8c,
+	0x78, 0xf1, 0xe2, 0xc5, 0x8b, 0x17, 0x2f, 0x22, 0x5e, 0xbc, 0x78, 0x01, 0xb3, 0xc7, 0x51, 0xab,
+	0x71, 0x13, 0xff, 0xb9, 0xd1, 0x8a, 0xc2, 0x24, 0x34, 0x87, 0xf1, 0xef, 0xda, 0x95, 0xe3, 0x30,
+	0x3c, 0xf6, 0xd1, 0x4d, 0x92, 0x76, 0xd8, 0x3e, 0xba, 0x99, 0x78, 0x4d, 0x14, 0x27, 0x4e, 0xb3,
+	0x45, 0xc1, 0x6a, 0x4b, 0x87, 0x9e, 0xef, 0x7b, 0xc1, 0xf1, 0x4d, 0xf6, 0x9f, 0x27, 0xb7, 0x9c,
+	0x8e, 0xef, 0x05, 0xa7, 0x37, 0xd9, 0x7f, 0x9a, 0x6c, 0xcd, 0xc0, 0xd4, 0x9d, 0x66, 0x2b, 0xe9,
+	0xd8, 0xe8, 0xa3, 0x36, 0x8a, 0x13, 0x6b, 0x16, 0xa6, 0xd9, 0x77, 0xdc, 0x0a, 0x83, 0x18, 0x59,
+	0xc7, 0xb0, 0xa2, 0x24, 0xbc, 0xe7, 0x25, 0x27, 0xfb, 0x89, 0x93, 0xb4, 0x63, 0x73, 0x19, 0x46,
+	0x63, 0xf2, 0xab, 0x6a, 0x6c, 0x1a, 0xd7, 0x47, 0x6c, 0xf6, 0x65, 0xbe, 0x0e, 0x63, 0x4d, 0x14,
+	0xc7, 0xce, 0x31, 0xaa, 0x0e, 0x6d, 0x1a, 0xd7, 0x27, 0x5f, 0xad, 0xdd, 0x20, 0xcd, 0xe0, 0x28,
+	0xee, 0x44, 0x51, 0x18, 0x3d, 0xa0, 0x10, 0x36, 0x07, 0xb5, 0x1e, 0xc2, 0xfa, 0xce, 0x09, 0x6a,
+	0x9c, 0xee, 0x9f, 0xb6, 0xb7, 0x03, 0xf7, 0x1d, 0xd4, 0xd9, 0x8b, 0xc2, 0x0f, 0x51, 0x23, 0x61,
+	0x94, 0x99, 0x73, 0x50, 0x89, 0x4f, 0xdb, 0xa4, 0xaa, 0x09, 0x1b, 0xff, 0x34, 0x37, 0x00, 0x5a,
+	0x14, 0xa6, 0xee, 0xb9, 0xa4, 0xaa, 0x09, 0x7b, 0x82, 0xa5, 0xec, 0xba, 0xd6, 0xd7, 0xa0, 0xba,
+	0x73, 0xe2, 0x04, 0xc7, 0x68, 0x27, 0x74, 0xd1, 0x6e, 0xf0, 0x30, 0x72, 0x51, 0xc4, 0x91, 0xad,
+	0xc2, 0x78, 0x88, 0xbf, 0x71, 0x41, 0x8a, 0x71, 0x8c, 0x7c, 0xef, 0xba, 0xe6, 0x33, 0x30, 0x73,
+	0x8a, 0x3a, 0xf5, 0x56, 0x14, 0xba, 0x6d, 0x19, 0xf3, 0xd4, 0x29, 0x21, 0x09, 0x27, 0xee, 0xba,
+	0xd6, 0x4f, 0x19, 0xb0, 0xaa, 0xc1, 0x4e, 0x1b, 0x78, 0xbe, 0x9c, 0x31, 0x9f, 0x81, 0x11, 0x42,
+	0x5c, 0xb5, 0x42, 0xca, 0xcc, 0xdc, 0xe0, 0x3d, 0x4b, 0x2b, 0xa5, 0x99, 0xd6, 0x0f, 0x1a, 0xb0,
+	0x76, 0x0f, 0x25, 0x7b, 0xbe, 0x93, 0x1c, 0x85, 0x51, 0xf3, 0x1d, 0xd4, 0xd9, 0x09, 0xdb, 0x41,
+	0x32, 0x20, 0x9a, 0x16, 0x61, 0xa4, 0x81, 0xd1, 0x13, 0x9a, 0x46, 0x6c, 0xfa, 0x61, 0x7d, 0x06,
+	0xcc, 0x77, 0x50, 0xe7, 0x6e, 0x18, 0x29, 0xcc, 0x5e, 0x82, 0x51, 0xcc, 0x51, 0xc1, 0xea, 0x91,
+	0x53, 0xd4, 0xd9, 0x75, 0xad, 0x9f, 0x30, 0x60, 0xe3, 0x1e, 0x4a, 0xf2, 0x05, 0x06, 0x44, 0xf2,
+	0x65, 0xa8, 0x9c, 0xa2, 0x0e, 0x63, 0xe2, 0x94, 0x60, 0xe2, 0x3b, 0xa8, 0x63, 0xe3, 0x0c, 0xcc,
+	0xc0, 0x9a, 0xc4, 0x3d, 0x1b, 0xc5, 0x28, 0x7a, 0x82, 0x06, 0x44, 0x4c, 0xca, 0x93, 0x8a, 0xcc,
+	0x93, 0xdf, 0x31, 0x60, 0x55, 0x47, 0x03, 0x65, 0x64, 0x4f, 0xa2, 0x69, 0x5e, 0x81, 0xc9, 0x26,
+	0x8a, 0x1a, 0x27, 0x4e, 0x90, 0xa4, 0xf8, 0x81, 0x27, 0x51, 0x80, 0x16, 0xab, 0x03, 0x03, 0x0c,
+	0x53, 0x00, 0x9e, 0xb4, 0xeb, 0xe2, 0xa1, 0x96, 0x24, 0x7e, 0x75, 0x84, 0xb4, 0x13, 0xff, 0x54,
+	0xc6, 0xcb, 0xa8, 0x32, 0x5e, 0xac, 0x6f, 0x0d, 0x41, 0x55, 0x22, 0x39, 0xbe, 0xeb, 0xf9, 0x83,
+	0x62, 0xda, 0xb3, 0xa4, 0xfd, 0x31, 0x66, 0x40, 0x03, 0xc5, 0x31, 0x72, 0x99, 0xf4, 0x4d, 0xe3,
+	0xd4, 0x3d, 0x9e, 0x88, 0xdb, 0x97, 0x84, 0x89, 0xe3, 0xd7, 0xa9, 0x84, 0x0e, 0x13, 0x18, 0x20,
+	0x49, 0x64, 0x48, 0x98, 0x6f, 0xc0, 0x88, 0xef, 0x05, 0x28, 0xae, 0x8e, 0x6c, 0x56, 0xae, 0x4f,
+	0xbe, 0xba, 0x49, 0xeb, 0xce, 0x36, 0xe2, 0xbe, 0x17, 0xe0, 0x86, 0xb4, 0xfd, 0xc4, 0xa6, 0xe0,
+	0xe6, 0x16, 0x4c, 0x21, 0x4c, 0x58, 0xcc, 0x30, 0x8f, 0x12, 0xcc, 0x93, 0x34, 0x8d, 0xa0, 0xb6,
+	0x0e, 0xa1, 0x56, 0x8c, 0xc7, 0x34, 0x61, 0x18, 0x63, 0x62, 0xcc, 0x20, 0xbf, 0x25, 0x16, 0xd1,
+	0xce, 0x64, 0x5f, 0x66, 0x35, 0x65, 0x11, 0xed, 0x42, 0xfe, 0x69, 0xfd, 0xa2, 0x01, 0xab, 0x0f,
+	0x9c, 0xc0, 0x39, 0x46, 0x72, 0x55, 0xc5, 0x42, 0x62, 0x74, 0x17, 0x92, 0xa1, 0x6e, 0x42, 0x52,
+	0xc9, 0x09, 0xc9, 0x0a, 0x8c, 0x51, 0x09, 0x8e, 0xab, 0xc3, 0x9b, 0x15, 0x4c, 0x38, 0x11, 0xe1,
+	0xd8, 0xfa, 0x2b, 0x06, 0xd4, 0x74, 0xe4, 0x7d, 0x8a, 0x22, 0x61, 0xfd, 0xba, 0x01, 0xb5, 0x3b,
+	0x1f, 0xb7, 0xc2, 0x28, 0xb9, 0x00, 0x9a, 0x4c, 0x18, 0x3e, 0xf2, 0x7c, 0xda, 0x6d, 0x53, 0x36,
+	0xf9, 0xad, 0xa1, 0x73, 0x58, 0x47, 0xe7, 0xcf, 0x1b, 0x70, 0xc5, 0x0e, 0x13, 0x27, 0x41, 0x98,
+	0xbe, 0x3b, 0x41, 0x23, 0xea, 0xb4, 0x12, 0x2f, 0x0c, 0xa8, 0x2e, 0xf8, 0x14, 0x19, 0xf8, 0xfb,
+	0x06, 0xac, 0xe4, 0x47, 0x39, 0x95, 0x38, 0xde, 0x5e, 0x43, 0x6a, 0xef, 0x45, 0xa9, 0xaa, 0x15,
+	0x18, 0x73, 0xa3, 0x4e, 0x3d, 0x6a, 0x07, 0x44, 0x5d, 0x8d, 0xdb, 0xa3, 0x6e, 0xd4, 0xb1, 0xdb,
+	0x01, 0xe6, 0x12, 0x06, 0x71, 0x12, 0xa6, 0xaf, 0xd8, 0x97, 0xf5, 0x23, 0x06, 0xd4, 0xb4, 0xd3,
+	0xe4, 0x85, 0x8e, 0x1e, 0xeb, 0x07, 0x60, 0xc9, 0x46, 0xcd, 0xf0, 0x89, 0x18, 0x23, 0x17, 0x4d,
+	0xc0, 0x4f, 0x1a, 0x64, 0xae, 0x66, 0x28, 0x07, 0x24, 0x5c, 0x2f, 0xc0, 0x18, 0x6b, 0x08, 0x9b,
+	0x76, 0xe7, 0x68, 0x29, 0xa9, 0x62, 0x0e, 0x60, 0xfd, 0xc1, 0x18, 0x5c, 0xd9, 0x89, 0x90, 0x93,
+	0xa0, 0x87, 0xd1, 0xa3, 0x96, 0x4b, 0x87, 0x80, 0x20, 0x8f, 0x32, 0x67, 0x07, 0x86, 0x03, 0xa7,
+	0x89, 0x25, 0x0d, 0xeb, 0xed, 0x9b, 0x14, 0x59, 0x97, 0x42, 0x37, 0xde, 0x75, 0x9a, 0xe8, 0x4e,
+	0x90, 0x44, 0x1d, 0x9b, 0x14, 0xee, 0xb2, 0x6c, 0x34, 0xdf, 0x87, 0x49, 0x17, 0xc5, 0x8d, 0xc8,
+	0x23, 0x03, 0xaf, 0x5a, 0x21, 0x55, 0xbd, 0xd1, 0x5b, 0x55, 0xb7, 0xd3, 0x82, 0xb4, 0x46, 0x19,
+	0x95, 0x89, 0x60, 0xce, 0x0f, 0x83, 0xe3, 0xba, 0x8c, 0x7e, 0x98, 0xa0, 0x7f, 0xb3, 0x37, 0xf4,
+	0xf7, 0xc3, 0xe0, 0x38, 0x57, 0xc5, 0xac, 0xaf, 0xa6, 0xf2, 0x85, 0xf2, 0x48, 0xba, 0x50, 0xbe,
+	0x81, 0x17, 0x6b, 0x4f, 0x50, 0x44, 0x86, 0xc2, 0xe4, 0xab, 0x55, 0xb1, 0xf6, 0xd9, 0x6d, 0x3a,
+	0x78, 0x01, 0xeb, 0xfb, 0xa8, 0x81, 0x8b, 0xda, 0x14, 0x0c, 0x63, 0x68, 0x47, 0x7e, 0x75, 0x8c,
+	0x62, 0x68, 0x47, 0xbe, 0xf9, 0x3c, 0xcc, 0xb9, 0xe8, 0xc8, 0x69, 0xfb, 0x49, 0xbd, 0xd1, 0x8e,
+	0x22, 0x14, 0x34, 0x3a, 0xd5, 0x71, 0x92, 0x3d, 0xcb, 0xd2, 0x77, 0x58, 0x72, 0x56, 0x34, 0x27,
+	0x72, 0xa2, 0x39, 0x03, 0x43, 0x9e, 0x5b, 0x05, 0x92, 0x3e, 0xe4, 0xb9, 0x58, 0xe4, 0xc2, 0x43,
+	0xcc, 0xfc, 0xea, 0x24, 0x1d, 0xa9, 0xf4, 0xcb, 0x7c, 0x08, 0xe3, 0x4d, 0x94, 0x38, 0xae, 0x93,
+	0x38, 0xd5, 0x29, 0xc2, 0xa6, 0xd7, 0x7a, 0x63, 0xd3, 0x03, 0x56, 0x8a, 0xf2, 0x47, 0x20, 0x31,
+	0x5f, 0x81, 0x09, 0x3e, 0x00, 0xe2, 0xea, 0x34, 0xc1, 0xb8, 0xa0, 0x4e, 0xfd, 0x7b, 0x91, 0xd7,
+	0x40, 0x76, 0x0a, 0x85, 0x27, 0xe1, 0x56, 0xe4, 0x35, 0xbc, 0xe0, 0xb8, 0x3a, 0x43, 0x27, 0x61,
+	0xf6, 0x89, 0xd7, 0x02, 0x8c, 0x8b, 0xf5, 0xa4, 0xd3, 0x42, 0xd5, 0x59, 0x92, 0x3d, 0xc9, 0xd2,
+	0x0e, 0x3a, 0x2d, 0x54, 0xfb, 0x1c, 0x4c, 0x08, 0xd9, 0x33, 0xe7, 0xe8, 0xea, 0x93, 0x6d, 0x5f,
+	0x4e, 0x51, 0x07, 0x2f, 0xa1, 0x9f, 0x38, 0x7e, 0x1b, 0x31, 0x11, 0xa4, 0x1f, 0x6f, 0x0e, 0x7d,
+	0xde, 0xa8, 0x7d, 0x0f, 0xcc, 0x65, 0xbb, 0xb9, 0xaf, 0xf2, 0xb7, 0x60, 0x51, 0x27, 0x2a, 0x7d,
+	0xe1, 0xf8, 0x02, 0x4c, 0x2b, 0x7c, 0xec, 0xa7, 0xb0, 0x75, 0x0b, 0x6a, 0x8f, 0x82, 0xbd, 0xf6,
+	0xa1, 0xef, 0xc5, 0x27, 0xf9, 0x51, 0xdc, 0x93, 0x8a, 0xb3, 0x1c, 0xa8, 0x7e, 0x32, 0x0c, 0x5d,
+	0x95, 0xa4, 0x75, 0x1b, 0x16, 0xef, 0x7b, 0xb1, 0x98, 0x0b, 0xc4, 0x12, 0x6a, 0x11, 0xaf, 0x0f,
+	0x9b, 0x5e, 0xc2, 0x74, 0x20, 0xfd, 0x20, 0x72, 0x7a, 0x74, 0x14, 0xa3, 0x84, 0x60, 0x1a, 0xb1,
+	0xd9, 0x97, 0xf5, 0x5f, 0x0c, 0x58, 0xca, 0xa0, 0x19, 0xd4, 0x96, 0x8b, 0x52, 0x55, 0xd1, 0x53,
+	0x35, 0x2c, 0x53, 0x95, 0x6e, 0xd0, 0x46, 0xa4, 0x0d, 0x9a, 0xf9, 0xa2, 0x3c, 0x04, 0x46, 0xc9,
+	0x10, 0x98, 0x51, 0x87, 0x80, 0x24, 0xfd, 0xd6, 0x1f, 0x19, 0xb0, 0x82, 0x5b, 0x96, 0x76, 0xc0,
+	0x9f, 0xee, 0xb6, 0x8d, 0x33, 0x81, 0xe0, 0x4d, 0xcb, 0xcf, 0x36, 0x02, 0x02, 0x2f, 0x68, 0x96,
+	0x73, 0x6d, 0x13, 0xeb, 0x19, 0x36, 0xcb, 0x60, 0x81, 0x21, 0xbf, 0xb9, 0x52, 0x1d, 0x4a, 0x95,
+	0x6a, 0x7f, 0x24, 0x67, 0x64, 0x71, 0x24, 0xa7, 0x15, 0xd5, 0x59, 0x69, 0x34, 0x3b, 0x2b, 0x55,
+	0x61, 0x0c, 0x05, 0xce, 0xa1, 0x8f, 0x5c, 0xa6, 0x96, 0xf9, 0xa7, 0xf5, 0xdf, 0x0d, 0x58, 0xdc,
+	0x73, 0x3a, 0x4d, 0x14, 0x24, 0x54, 0x31, 0xf2, 0x66, 0x7c, 0x1e, 0x86, 0x89, 0xee, 0xa4, 0x93,
+	0xe5, 0x33, 0xac, 0x9b, 0x35, 0x90, 0x37, 0x6e, 0x0b, 0x65, 0x49, 0x4a, 0x10, 0x0d, 0xdd, 0x62,
+	0x6b, 0x86, 0x21, 0xaf, 0x65, 0x5e, 0x83, 0x59, 0xa7, 0xd1, 0x40, 0xad, 0xa4, 0xee, 0x3b, 0xc1,
+	0x71, 0x1b, 0xf7, 0x2d, 0x5d, 0x89, 0xcd, 0xd0, 0xe4, 0xfb, 0x2c, 0x15, 0x37, 0xa2, 0x1d, 0xa3,
+	0xa8, 0xee, 0x1c, 0x23, 0xd6, 0x3b, 0x13, 0xf6, 0x04, 0x4e, 0xd9, 0xc6, 0x09, 0x58, 0x21, 0xde,
+	0x3e, 0x93, 0x3e, 0xf9, 0xfb, 0x06, 0x2c, 0x65, 0x28, 0x1f, 0x88, 0x18, 0x6e, 0xc1, 0x54, 0x84,
+	0x5c, 0x2f, 0xc2, 0xbd, 0x80, 0x67, 0x40, 0xca, 0x82, 0x49, 0x9e, 0xf6, 0x28, 0xf2, 0xcd, 0xab,
+	0x30, 0x1d, 0x20, 0xe4, 0xd6, 0x79, 0x1a, 0xe1, 0xc4, 0xb8, 0x3d, 0x85, 0x13, 0x6d, 0x96, 0x66,
+	0xfd, 0xcb, 0x0a, 0xd4, 0x18, 0xbd, 0x77, 0xc3, 0xa8, 0xf9, 0x76, 0x1c, 0x06, 0xb8, 0xe1, 0x3d,
+	0x58, 0x9f, 0x70, 0x7b, 0x1a, 0x27, 0xa8, 0x89, 0xc4, 0x6e, 0x90, 0x7c, 0x61, 0x99, 0x3c, 0x09,
+	0xe3, 0x84, 0x51, 0x44, 0x7e, 0x63, 0x58, 0x3f, 0x6c, 0x38, 0x3e, 0xef, 0x0d, 0xf6, 0xc5, 0xba,
+	0x6f, 0x44, 0x74, 0x9f, 0xda, 0x2b, 0xa3, 0x99, 0x5e, 0xc1, 0x68, 0x1a, 0x61, 0x78, 0xea, 0x21,
+	0x26, 0x59, 0xec, 0x0b, 0x8b, 0x5c, 0x84, 0x8e, 0x50, 0x84, 0x22, 0x36, 0xd5, 0xf3, 0x4f, 0x2c,
+	0xcc, 0x5e, 0x5c, 0x47, 0xcd, 0x43, 0xe4, 0xba, 0x88, 0x4e, 0xf1, 0xe3, 0x36, 0x78, 0xf1, 0x1d,
+	0x96, 0x82, 0x97, 0x0b, 0x5e, 0x1c, 0xb7, 0x51, 0x54, 0xa7, 0x45, 0x82, 0x06, 0x62, 0x13, 0xfe,
+	0x2c, 0x4d, 0xb7, 0x79, 0xb2, 0xf9, 0x2a, 0x2c, 0x65, 0x41, 0xe9, 0x84, 0x4a, 0x17, 0x03, 0x0b,
+	0x19, 0x78, 0x3c, 0xb1, 0x92, 0x06, 0x25, 0xcd, 0x7a, 0x1c, 0xb6, 0xa3, 0x06, 0xaa, 0x4e, 0xb1,
+	0x06, 0x25, 0xcd, 0x7d, 0x92, 0xc0, 0xb3, 0x9b, 0xc8, 0xf5, 0xda, 0xcd, 0xea, 0xb4, 0xc8, 0x7e,
+	0x40, 0x12, 0x70, 0x27, 0xe3, 0xec, 0x86, 0xd3, 0x6c, 0x39, 0xde, 0x71, 0xc0, 0x26, 0xf6, 0xc9,
+	0x76, 0xd2, 0xdc, 0x61, 0x49, 0x96, 0xaf, 0xed, 0x3e, 0x66, 0x90, 0xd4, 0xea, 0x87, 0x2b, 0x30,
+	0xd9, 0x8e, 0xfc, 0x7a, 0xdc, 0x6e, 0x34, 0x50, 0xcc, 0xb7, 0xf2, 0xd0, 0x8e, 0xfc, 0x7d, 0x9a,
+	0x82, 0xfb, 0x1c, 0x03, 0x1c, 0x39, 0x1e, 0x17, 0xab, 0xb1, 0x76, 0xe4, 0xdf, 0x75, 0x3c, 0xdf,
+	0xfa, 0x17, 0xa3, 0xb0, 0xa0, 0xa9, 0x8e, 0x2d, 0x94, 0x0c, 0xb1, 0x50, 0xaa, 0xc2, 0x98, 0xd3,
+	0xa0, 0x8a, 0x8f, 0xe2, 0xe7, 0x9f, 0x78, 0x17, 0x74, 0xe2, 0xc4, 0xf5, 0x27, 0x0e, 0x15, 0x90,
+	0x71, 0x7b, 0xf4, 0xc4, 0x89, 0x1f, 0x3b, 0xc4, 0x68, 0x8a, 0x13, 0xb1, 0x7c, 0x18, 0x36, 0xfe,
+	0x89, 0x7b, 0xdb, 0x69, 0x0a, 0xe5, 0x69, 0xd8, 0xec, 0x0b, 0x73, 0x85, 0x1a, 0x4d, 0x58, 0xee,
+	0x28, 0xc9, 0xa5, 0x86, 0x94, 0x6d, 0x0a, 0x52, 0x83, 0x71, 0xb1, 0xf8, 0xa3, 0xa2, 0x22, 0xbe,
+	0xcd, 0x37, 0xc9, 0x4a, 0x9f, 0xac, 0xe2, 0xc6, 0x37, 0x0d, 0xc9, 0xa8, 0x52, 0xc8, 0x46, 0x9b,
+	0x17, 0x30, 0xdf, 0x86, 0xd9, 0x16, 0x05, 0xab, 0x37, 0x51, 0x72, 0x12, 0xba, 0x71, 0x75, 0x82,
+	0xe8, 0xac, 0x2d, 0xb1, 0x50, 0x95, 0xd0, 0xb0, 0x9f, 0x0f, 0x08, 0xa4, 0x3d, 0xd3, 0x92, 0x3f,
+	0x63, 0xac, 0x43, 0x92, 0xf0, 0x14, 0x05, 0x4c, 0x7c, 0xe8, 0x87, 0xf9, 0x05, 0xa8, 0xd1, 0x11,
+	0xe0, 0xba, 0x11, 0x8a, 0xe3, 0x3a, 0xd6, 0x72, 0xf5, 0x08, 0x7d, 0xd4, 0xf6, 0x22, 0xe4, 0x12,
+	0x01, 0x1a, 0xb7, 0x57, 0xc8, 0x88, 0xa0, 0x00, 0x7c, 0xb0, 0xe2, 0x6c, 0xf3, 0x55, 0x98, 0x22,
+	0x85, 0xbd, 0x16, 0x29, 0x47, 0x04, 0x4a, 0xcc, 0x2d, 0x8f, 0x62, 0x14, 0xed, 0xb6, 0x08, 0x3c,
+	0xb4, 0xc5, 0x6f, 0xf3, 0x3a, 0x8c, 0x78, 0x09, 0x6a, 0xc6, 0xd5, 0x19, 0xd2, 0x10, 0x53, 0x35,
+	0xd9, 0xee, 0x26, 0xa8, 0x69, 0x53, 0x00, 0x69, 0xf4, 0xcd, 0x2a, 0xa3, 0x6f, 0x11, 0x46, 0x50,
+	0x13, 0x0b, 0xcb, 0x1c, 0x6d, 0x08, 0xf9, 0x30, 0x37, 0xd5, 0xcd, 0xc9, 0x3c, 0x15, 0x5d, 0x29,
+	0xc9, 0xfc, 0x3c, 0x54, 0x89, 0x4c, 0x44, 0x9d, 0x3a, 0x63, 0x4d, 0x5c, 0x77, 0x7c, 0x3f, 0x7c,
+	0x8a, 0xdc, 0xaa, 0x49, 0x1a, 0xba, 0xcc, 0xf2, 0x19, 0x23, 0xe3, 0x6d, 0x9a, 0x6b, 0xbe, 0x0e,
+	0x3c, 0xa7, 0xde, 0x20, 0x96, 0x6d, 0x51, 0x6e, 0x81, 0x94, 0x5b, 0x64, 0xb9, 0xd4, 0xec, 0xcd,
+	0x4b, 0x29, 0x2b, 0x8a, 0xc5, 0x2e, 0x2b, 0x0a, 0x2c, 0x42, 0xae, 0x17, 0x53, 0x19, 0x5e, 0x22,
+	0x12, 0x26, 0xbe, 0xcd, 0x37, 0xc8, 0x0c, 0xd8, 0x0c, 0xeb, 0x8d, 0xd0, 0x45, 0xd5, 0x65, 0xc2,
+	0xe5, 0x15, 0x95, 0x71, 0x7b, 0x38, 0x1f, 0x1b, 0xdc, 0xc9, 0xd4, 0x48, 0x7f, 0x5a, 0xdf, 0x36,
+	0x60, 0x4d, 0xab, 0x6c, 0x07, 0x32, 0x45, 0xbc, 0x04, 0xc3, 0xb8, 0xe3, 0xd8, 0x7e, 0x76, 0xb5,
+	0x50, 0xca, 0x6d, 0x02, 0x66, 0x79, 0x62, 0x72, 0x7e, 0x37, 0x4c, 0xbc, 0xa3, 0x4e, 0x0f, 0x53,
+	0x00, 0xd1, 0xbb, 0x04, 0x8a, 0xd0, 0x35, 0x65, 0xf3, 0x4f, 0x73, 0x1d, 0x26, 0x62, 0xef, 0x38,
+	0x70, 0x92, 0x76, 0xc4, 0x8d, 0x82, 0x69, 0x82, 0x75, 0x07, 0x96, 0x32, 0x55, 0x75, 0x61, 0x00,
+	0x16, 0x31, 0xdc, 0x46, 0x3e, 0xdf, 0x92, 0x0f, 0xeb, 0x4f, 0x0c, 0x98, 0x7f, 0x18, 0x1c, 0x86,
+	0x4e, 0xe4, 0x7a, 0xc1, 0x31, 0xa7, 0x37, 0xab, 0x8b, 0x9e, 0x87, 0x61, 0x2c, 0xee, 0x8c, 0x73,
+	0x4b, 0xa2, 0x9b, 0x1e, 0xb0, 0x15, 0x0d, 0x1e, 0x17, 0x36, 0x01, 0x31, 0xdf, 0x80, 0xb1, 0x46,
+	0xd8, 0x6c, 0x39, 0x01, 0xb7, 0xbd, 0xaf, 0xe7, 0xa0, 0x77, 0x68, 0xfe, 0x6e, 0x70, 0x14, 0xda,
+	0x1c, 0xd8, 0x7c, 0x1d, 0xc6, 0x1b, 0x61, 0x90, 0x38, 0x78, 0x3d, 0x37, 0x93, 0xd9, 0xb8, 0xa6,
+	0x05, 0x09, 0x80, 0x2d, 0x20, 0xcd, 0x57, 0x61, 0xec, 0xd0, 0x09, 0x4e, 0xf1, 0x8e, 0x6d, 0xb6,
+	0xa0, 0xd0, 0x2d, 0x9a, 0x6f, 0x73, 0x40, 0x6b, 0x0b, 0x66, 0xef, 0x7a, 0x81, 0x7b, 0xab, 0xb3,
+	0xeb, 0x16, 0xb4, 0xd7, 0xfa, 0xf6, 0x30, 0x2c, 0xf3, 0xf2, 0x78, 0xd9, 0x28, 0xb1, 0x46, 0x37,
+	0x1d, 0xac, 0xc1, 0x84, 0x17, 0xd7, 0x71, 0xdf, 0x20, 0x97, 0x6d, 0x17, 0xc6, 0xbd, 0x78, 0x9f,
+	0x7c, 0x9b, 0xaf, 0xc0, 0x92, 0xef, 0xc4, 0x09, 0x1e, 0x9f, 0x61, 0x3b, 0xc1, 0x4a, 0x05, 0xd5,
+	0x8f, 0xa2, 0x90, 0xca, 0x54, 0xc5, 0x36, 0x71, 0xe6, 0x1e, 0xc9, 0xbb, 0xed, 0x24, 0xe8, 0x6e,
+	0x14, 0x36, 0xcd, 0x97, 0x60, 0x21, 0x57, 0x24, 0x09, 0x89, 0x5e, 0xaf, 0xd8, 0x73, 0x6a, 0x81,
+	0x83, 0xd0, 0x7c, 0x11, 0x4c, 0x19, 0x5c, 0x51, 0xf8, 0x12, 0x34, 0xd3, 0xeb, 0x26, 0x0c, 0xc7,
+	0x61, 0x94, 0x90, 0x45, 0xf3, 0x84, 0x4d, 0x7e, 0xa7, 0xab, 0xdb, 0x31, 0xfd, 0xea, 0x76, 0x5c,
+	0x59, 0xdd, 0x6e, 0xc1, 0xd4, 0x47, 0x6d, 0xaf, 0x71, 0x5a, 0x8f, 0x91, 0x13, 0x35, 0x4e, 0xd8,
+	0xa6, 0x7f, 0x92, 0xa4, 0xed, 0x93, 0x24, 0x3c, 0xf2, 0xa9, 0xd8, 0xa1, 0xb8, 0x0a, 0x9b, 0x15,
+	0xcc, 0x10, 0xfe, 0x8d, 0x35, 0x4f, 0x84, 0x8e, 0xbd, 0x38, 0x89, 0x1c, 0xac, 0xc3, 0x24, 0x8e,
+	0x4c, 0x92, 0x06, 0x2e, 0xca, 0xb9, 0x82, 0x27, 0x2f, 0xc3, 0x62, 0xbe, 0x54, 0x12, 0x12, 0x75,
+	0x5e, 0xb1, 0xcd, 0x6c, 0x19, 0xca, 0x96, 0x08, 0x35, 0x90, 0xf7, 0x04, 0xb9, 0x52, 0x1d, 0xd3,
+	0x94, 0x89, 0x3c, 0x47, 0xe0, 0xbf, 0x0e, 0x73, 0x2a, 0x74, 0x12, 0x12, 0x39, 0xac, 0xd8, 0x33,
+	0x32, 0xec, 0x41, 0x68, 0xbd, 0x0f, 0x2b, 0x39, 0xd9, 0x60, 0x63, 0x4f, 0x6c, 0x55, 0x0c, 0x79,
+	0xab, 0x72, 0x8d, 0x4f, 0x0f, 0x43, 0x44, 0x61, 0xce, 0xe7, 0x44, 0x94, 0xcd, 0x0e, 0xd6, 0x8f,
+	0x19, 0xb0, 0x26, 0x64, 0x9d, 0xe8, 0x5d, 0x7a, 0xf6, 0xca, 0x65, 0x2f, 0xb3, 0xad, 0x30, 0x72,
+	0xdb, 0x8a, 0x15, 0x18, 0xa3, 0x93, 0x17, 0xdf, 0xff, 0x8e, 0xb6, 0x63, 0xb1, 0xd0, 0xa4, 0x4a,
+	0xa1, 0xa2, 0x28, 0x05, 0xe9, 0xd8, 0x61, 0x58, 0x3d, 0x76, 0xf8, 0x01, 0x58, 0x20, 0x8a, 0xc5,
+	0x6b, 0x10, 0x9e, 0x7e, 0x72, 0x12, 0xf0, 0x5c, 0xed, 0x25, 0x3e, 0x57, 0x65, 0xf4, 0xa3, 0x84,
+	0x00, 0x1b, 0xa6, 0x65, 0x02, 0xe2, 0x02, 0xe6, 0x7e, 0x46, 0x65, 0x6e, 0xaa, 0x9b, 0x14, 0xea,
+	0x19, 0x83, 0xff, 0xb1, 0x01, 0x35, 0xd6, 0x67, 0xe7, 0xdb, 0x38, 0xa6, 0x01, 0x3a, 0x31, 0x9f,
+	0x2c, 0xa8, 0x06, 0x20, 0xdf, 0xe9, 0xe8, 0x1a, 0xd6, 0x8f, 0xae, 0x11, 0x65, 0x74, 0x69, 0xc6,
+	0xa7, 0x75, 0x0c, 0xeb, 0x8c, 0x6c, 0x2e, 0x1e, 0xca, 0xba, 0xc8, 0xbc, 0x97, 0x5f, 0x53, 0xd1,
+	0x7d, 0xe0, 0xe5, 0x9c, 0xac, 0x95, 0x2e, 0xa8, 0xac, 0x00, 0xae, 0xdc, 0x43, 0x89, 0x1e, 0xb6,
+	0x57, 0x26, 0xbd, 0x00, 0xf3, 0x2a, 0x31, 0x29, 0xbb, 0x66, 0x95, 0xea, 0x76, 0x5d, 0xeb, 0xd7,
+	0x0c, 0xd8, 0x2c, 0xae, 0x70, 0x20, 0x53, 0xfa, 0xab, 0xca, 0x94, 0xde, 0x8d, 0x41, 0x74, 0x5e,
+	0xff, 0x51, 0x03, 0xb6, 0x70, 0x07, 0x68, 0x61, 0x7a, 0x1f, 0x9e, 0x37, 0x60, 0x21, 0xc3, 0x19,
+	0x32, 0x95, 0x50, 0xde, 0xcc, 0x2b, 0xbc, 0xc1, 0xa6, 0x44, 0x21, 0x0a, 0x15, 0x49, 0x14, 0xfe,
+	0xc7, 0x10, 0xac, 0xf7, 0xd3, 0x3f, 0xf9, 0xc3, 0x82, 0x7d, 0x98, 0x51, 0xa9, 0x60, 0xac, 0x78,
+	0xb1, 0x9c, 0x15, 0xbb, 0x2e, 0x0a, 0xa4, 0x21, 0x33, 0xad, 0x90, 0x6b, 0xee, 0x02, 0x34, 0xc2,
+	0x66, 0xd3, 0x8b, 0x63, 0x6a, 0xe7, 0xc6, 0x08, 0x9f, 0x2f, 0x47, 0xb8, 0x23, 0xe0, 0x63, 0x5b,
+	0x2a, 0x6c, 0xbe, 0x03, 0x93, 0x5e, 0x90, 0xa0, 0x63, 0xaa, 0xcc, 0xab, 0x23, 0xbd, 0xe0, 0xda,
+	0x4d, 0x0b, 0xd8, 0x72, 0x69, 0x36, 0x30, 0x9d, 0x46, 0xe2, 0x3d, 0x41, 0x64, 0x97, 0x33, 0x8e,
+	0x07, 0xe6, 0x36, 0xf9, 0x96, 0x87, 0xf3, 0x98, 0x3c, 0x9c, 0xad, 0xbf, 0x65, 0xc0, 0xc6, 0x9f,
+	0x76, 0x99, 0xfc, 0xcb, 0x06, 0x2c, 0x48, 0x43, 0x67, 0x60, 0x07, 0x86, 0x32, 0x65, 0x9a, 0xa9,
+	0x8b, 0x12, 0x73, 0x08, 0xcb, 0xf7, 0x50, 0x72, 0x26, 0x9d, 0x7a, 0x0d, 0x66, 0x03, 0xa9, 0x5c,
+	0x2a, 0xb3, 0x33, 0x72, 0x32, 0xd5, 0x15, 0x0b, 0xdc, 0x1e, 0x74, 0xd4, 0x0e, 0xdc, 0xde, 0xec,
+	0x2b, 0x6c, 0x35, 0x34, 0xa4, 0x6c, 0x7f, 0x37, 0x00, 0x1a, 0x18, 0x53, 0x18, 0xa5, 0xc7, 0x65,
+	0x13, 0x2c, 0x85, 0x16, 0x8b, 0x90, 0x13, 0x33, 0x41, 0x9e, 0xb0, 0xd9, 0x17, 0xb6, 0x06, 0x79,
+	0x31, 0xde, 0x2e, 0x45, 0xc7, 0xe8, 0xd0, 0x69, 0x9c, 0xb2, 0x43, 0xc8, 0x29, 0x2f, 0xde, 0x11,
+	0x69, 0x78, 0x12, 0x5f, 0x54, 0xc9, 0x1c, 0x48, 0xc7, 0x5c, 0x55, 0x3a, 0x66, 0x56, 0x74, 0x0c,
+	0xab, 0x94, 0x76, 0xcb, 0x07, 0x60, 0x62, 0xb5, 0x45, 0xd3, 0xe2, 0x1e, 0x18, 0xd6, 0xd7, 0x42,
+	0xd0, 0xb2, 0x61, 0x41, 0x41, 0x5f, 0xba, 0x0a, 0x7a, 0x56, 0x9d, 0xa8, 0x73, 0x14, 0xb3, 0x29,
+	0xfa, 0x6d, 0x98, 0xbb, 0x87, 0x92, 0x9e, 0x7b, 0x78, 0x0d, 0x26, 0x22, 0x02, 0x9b, 0xca, 0xcd,
+	0x38, 0x4d, 0xd8, 0x75, 0xad, 0x0f, 0x60, 0x76, 0xc7, 0xf1, 0x7d, 0xdc, 0x2d, 0x1c, 0x55, 0x15,
+	0xdb, 0x4e, 0x02, 0xd7, 0x47, 0x11, 0xc7, 0xc4, 0x3e, 0xb1, 0xb2, 0x3d, 0x0c, 0xdd, 0x0e, 0xdb,
+	0x85, 0x91, 0xdf, 0x5d, 0xb6, 0x60, 0x27, 0xb0, 0x21, 0x6d, 0x05, 0xf1, 0x36, 0x90, 0x2e, 0xda,
+	0x7a, 0xa1, 0x1b, 0xfb, 0x86, 0x38, 0xc1, 0x31, 0x23, 0x99, 0xfc, 0x96, 0x2d, 0x3e, 0x15, 0xc5,
+	0xe2, 0x63, 0x7d, 0x00, 0xcf, 0x48, 0x35, 0xe1, 0xdd, 0x16, 0xad, 0x29, 0x7b, 0x9c, 0x5c, 0x52,
+	0x61, 0x0d, 0xc6, 0xf9, 0xc6, 0x9c, 0xf3, 0x89, 0x7f, 0x5b, 0xbf, 0x65, 0xc0, 0xa6, 0x16, 0x3f,
+	0x36, 0xf5, 0x9e, 0xb1, 0x31, 0x03, 0xb2, 0x2a, 0x5b, 0xff, 0xd9, 0x80, 0x17, 0xf4, 0xbc, 0xa0,
+	0x89, 0xdb, 0x94, 0x65, 0xbd, 0x89, 0x4e, 0x76, 0x7d, 0x32, 0xde, 0x64, 0x53, 0x5d, 0x71, 0x5f,
+	0xb0, 0x86, 0x0d, 0x97, 0x35, 0x6c, 0xa4, 0x87, 0x86, 0x65, 0x0d, 0xb3, 0xd6, 0x7d, 0x80, 0xd4,
+	0xbc, 0x84, 0xeb, 0x67, 0x06, 0x18, 0x4e, 0x36, 0xfb, 0xc4, 0xcc, 0x6e, 0x78, 0x49, 0x87, 0x33,
+	0x1b, 0xff, 0xc6, 0x86, 0xbf, 0x6f, 0x0a, 0x6e, 0xe3, 0x9f, 0xd6, 0xb7, 0x87, 0x60, 0x4b, 0x2b,
+	0x9c, 0x7c, 0x94, 0x62, 0xab, 0x54, 0x17, 0x4b, 0x99, 0xd1, 0x9f, 0xa5, 0x6c, 0xa8, 0x07, 0x4b,
+	0xd9, 0x22, 0x8c, 0x1c, 0x46, 0x4e, 0x20, 0xfc, 0xe0, 0xc8, 0xc7, 0x45, 0x5b, 0xb1, 0xac, 0xdf,
+	0x36, 0x60, 0xa3, 0x94, 0x39, 0xe7, 0xac, 0xab, 0xbf, 0xa0, 0xe8, 0xea, 0x6b, 0x39, 0x2b, 0x92,
+	0xbe, 0x77, 0x98, 0x0e, 0x47, 0xb0, 0xce, 0x1c, 0x73, 0x6e, 0x51, 0x85, 0xc9, 0xfa, 0xa2, 0x37,
+	0xdb, 0x12, 0x17, 0xa2, 0x21, 0x55, 0x88, 0x98, 0xc0, 0x0c, 0xa7, 0x02, 0xf3, 0xb7, 0xb1, 0xc0,
+	0xe8, 0xeb, 0x91, 0x04, 0x46, 0x32, 0x3d, 0x0f, 0xe9, 0x4c, 0xcf, 0x15, 0x9d, 0xe9, 0x79, 0xb8,
+	0xd4, 0xf4, 0x3c, 0x52, 0x6e, 0x7a, 0x1e, 0xcd, 0x98, 0x9e, 0x85, 0xad, 0x75, 0xac, 0x9b, 0xad,
+	0x55, 0xb6, 0x3e, 0x8e, 0x97, 0x5a, 0x1f, 0x27, 0x7a, 0xb6, 0x3e, 0x12, 0xc9, 0x29, 0xe3, 0xd2,
+	0x85, 0x48, 0x4e, 0xb7, 0x6e, 0x62, 0x92, 0xb3, 0x07, 0x8b, 0xd2, 0x02, 0xf1, 0x56, 0xe7, 0x13,
+	0x6f, 0x73, 0xad, 0x5f, 0x16, 0x7e, 0xd0, 0x1c, 0xab, 0x7c, 0xd0, 0xd5, 0x15, 0xef, 0xeb, 0xb0,
+	0x8c, 0xa5, 0x47, 0x00, 0xa9, 0x73, 0xeb, 0xb8, 0xbd, 0x78, 0xe2, 0xc4, 0x1c, 0xf1, 0x3e, 0xcf,
+	0xc3, 0xfb, 0x49, 0x5c, 0xaa, 0x15, 0xb7, 0xa4, 0x02, 0xf4, 0x1c, 0x6e, 0xf6, 0xc4, 0x89, 0xf7,
+	0xe2, 0x96, 0x80, 0x25, 0xde, 0x88, 0x3a, 0x02, 0x3f, 0xcd, 0xb5, 0xf1, 0x4f, 0x18, 0xb0, 0xac,
+	0xd2, 0xf4, 0xe9, 0xd2, 0xf3, 0x57, 0x0d, 0x58, 0x57, 0xe9, 0xe1, 0x56, 0xa6, 0x4f, 0x93, 0xaa,
+	0x9f, 0xc3, 0x3d, 0x47, 0x96, 0xcd, 0xea, 0x2e, 0x62, 0x20, 0x34, 0x3d, 0xaf, 0xd0, 0x54, 0x60,
+	0x33, 0xa2, 0x74, 0xfd, 0xa4, 0x01, 0x35, 0xa2, 0x0f, 0x28, 0x71, 0x6c, 0xec, 0x0d, 0x88, 0x2e,
+	0x4b, 0xa1, 0x2b, 0xeb, 0xfa, 0x4f, 0x09, 0xf2, 0x61, 0x63, 0x3f, 0x1d, 0xd5, 0xfb, 0xaf, 0x6d,
+	0x1f, 0x47, 0x08, 0xe1, 0x99, 0xa4, 0x1f, 0x03, 0x4d, 0xfc, 0x5a, 0xdd, 0xe1, 0xe5, 0x64, 0x23,
+	0xc4, 0x6c, 0x9c, 0xe2, 0xc3, 0x26, 0x08, 0xeb, 0xff, 0x1a, 0x30, 0x93, 0x3a, 0x4d, 0x60, 0x93,
+	0x7d, 0xee, 0x70, 0xa0, 0x8b, 0x87, 0x1d, 0x37, 0x98, 0x57, 0x24, 0x83, 0x79, 0xe6, 0x60, 0x6b,
+	0x38, 0x7f, 0xb0, 0xf5, 0xbc, 0xc6, 0x7b, 0x8e, 0x2e, 0xab, 0x72, 0x1e, 0x70, 0xcb, 0x30, 0xea,
+	0x61, 0xcf, 0xb6, 0x98, 0x99, 0xcc, 0xd8, 0x97, 0xf9, 0x59, 0xf9, 0xac, 0x8a, 0xce, 0x16, 0x2b,
+	0x1a, 0x07, 0x30, 0xdc, 0x26, 0xd9, 0x0d, 0x06, 0xc1, 0x7c, 0x2e, 0x3f, 0xd7, 0x66, 0xde, 0xa8,
+	0x21, 0xa9, 0x51, 0x2f, 0xc2, 0x08, 0x76, 0x17, 0x43, 0xac, 0xf7, 0x96, 0x85, 0xb2, 0xc6, 0x9c,
+	0x4b, 0xab, 0xa2, 0x40, 0xd6, 0x0f, 0xc0, 0x5c, 0x36, 0x4b, 0x9a, 0x32, 0x0d, 0x65, 0xca, 0x94,
+	0xe7, 0xc3, 0xa1, 0xcc, 0x7c, 0x48, 0xf6, 0xaa, 0xc7, 0xd4, 0x77, 0x91, 0xed, 0x55, 0xf1, 0x17,
+	0x3b, 0xb5, 0x3f, 0x62, 0xdb, 0x1f, 0xa6, 0x2f, 0xc1, 0x8b, 0xef, 0xb2, 0x14, 0xeb, 0x0f, 0xc6,
+	0x01, 0xd2, 0x9e, 0xcd, 0xb5, 0xb0, 0xab, 0x19, 0x49, 0xed, 0xf6, 0x4a, 0xb6, 0xdb, 0x53, 0x3f,
+	0xbf, 0x61, 0xc5, 0xcf, 0x4f, 0xe7, 0xaf, 0x48, 0x79, 0x49, 0xbd, 0x78, 0x6a, 0x59, 0x2f, 0x9e,
+	0x9c, 0x47, 0xa7, 0xce, 0x3b, 0x71, 0x4c, 0xef, 0x9d, 0x28, 0xf9, 0xd1, 0x8c, 0x13, 0x06, 0xf0,
+	0x4f, 0xd5, 0x3b, 0x70, 0xa2, 0x27, 0xef, 0xc0, 0x1d, 0x55, 0x68, 0x81, 0x1d, 0x5a, 0x67, 0xc9,
+	0x2d, 0xf7, 0x0a, 0xdd, 0xd3, 0xc8, 0xf5, 0x24, 0xc1, 0xf4, 0x6c, 0x0e, 0x53, 0x6f, 0x0e, 0xa0,
+	0xdf, 0xc5, 0x6c, 0x19, 0xc8, 0xad, 0x3b, 0x09, 0x39, 0x0e, 0x21, 0x4c, 0x24, 0xf7, 0xc6, 0x6e,
+	0xf0, 0x7b, 0x63, 0x37, 0x0e, 0xf8, 0xbd, 0x31, 0x66, 0xe7, 0x40, 0xee, 0x76, 0x82, 0x8b, 0xb6,
+	0x5b, 0x2e, 0x2f, 0x3a, 0xdd, 0xbd, 0x28, 0x83, 0xde, 0x4e, 0x52, 0x27, 0xd3, 0x99, 0xbe, 0x9c,
+	0x4c, 0x67, 0x53, 0x27, 0xd3, 0x37, 0x25, 0x87, 0xcf, 0x39, 0x66, 0xac, 0xce, 0x72, 0xa0, 0xc8,
+	0xb7, 0xb3, 0x0a, 0x63, 0x2e, 0xf2, 0x51, 0x82, 0x5c, 0x72, 0x28, 0x3e, 0x6e, 0xf3, 0x4f, 0xf3,
+	0x8b, 0x30, 0xd5, 0xa2, 0x7e, 0x84, 0xb4, 0x51, 0x66, 0xd7, 0x46, 0x4d, 0x0a, 0xf8, 0xed, 0x44,
+	0xf6, 0x00, 0x5d, 0x28, 0xf7, 0x00, 0x5d, 0xfc, 0x73, 0x0f, 0x50, 0x51, 0xd8, 0xfa, 0xdf, 0x06,
+	0x4c, 0x2b, 0xe3, 0xa6, 0x27, 0x85, 0xf9, 0x12, 0x8c, 0x12, 0x5d, 0x18, 0x33, 0xb7, 0xeb, 0x74,
+	0x1e, 0x96, 0x35, 0xa3, 0xcd, 0x80, 0xf0, 0x46, 0x07, 0xb5, 0x7d, 0x87, 0xb8, 0x6a, 0xb1, 0xb3,
+	0x22, 0xfc, 0x8d, 0xdd, 0xb4, 0x9e, 0x85, 0x19, 0x62, 0xe2, 0xa5, 0x16, 0x44, 0x0c, 0x40, 0xf5,
+	0xcb, 0x74, 0x9a, 0x8a, 0xc1, 0x84, 0x61, 0x6a, 0x54, 0x36, 0x4c, 0xdd, 0x80, 0x05, 0x3f, 0x7c,
+	0x5a, 0x8f, 0x93, 0xb0, 0x71, 0x5a, 0x4f, 0x4e, 0x22, 0x14, 0x9f, 0x84, 0xbe, 0xcb, 0x2c, 0x60,
+	0xf3, 0x7e, 0xf8, 0x74, 0x1f, 0xe7, 0x1c, 0xf0, 0x0c, 0xeb, 0x7d, 0x18, 0xe7, 0x6d, 0xed, 0xa9,
+	0x99, 0x26, 0x0c, 0x7b, 0x0d, 0xa1, 0x9f, 0xc9, 0x6f, 0x4c, 0x09, 0xbd, 0xe4, 0xc7, 0x8e, 0x84,
+	0xc8, 0x87, 0xf5, 0xff, 0xc6, 0x60, 0xec, 0xa2, 0xf5, 0xb1, 0x09, 0xc3, 0x44, 0x9e, 0x29, 0xc3,
+	0xc8, 0x6f, 0xae, 0xa3, 0x47, 0x53, 0x1d, 0xfd, 0x19, 0xd6, 0x2e, 0x75, 0x1a, 0xed, 0x43, 0x41,
+	0x8f, 0x77, 0x55, 0xd0, 0x13, 0xaa, 0x82, 0x4e, 0x85, 0x03, 0x7a, 0x11, 0x8e, 0x2f, 0xc1, 0x64,
+	0x5e, 0xa5, 0x5e, 0x56, 0xe9, 0x2c, 0xd7, 0xcc, 0x0f, 0x34, 0x9a, 0x99, 0x3a, 0xa2, 0x5b, 0x2a,
+	0x9a, 0xb3, 0xa8, 0xe5, 0xe9, 0xb3, 0xab, 0xe5, 0x99, 0x7e, 0xd4, 0x72, 0xba, 0x16, 0x9a, 0x55,
+	0xd6, 0x42, 0x4c, 0xfd, 0xce, 0xa5, 0xea, 0xf7, 0x73, 0x92, 0xfa, 0x9d, 0x27, 0xcd, 0x5c, 0x53,
+	0x9b, 0xd9, 0x83, 0xee, 0x35, 0x55, 0xdd, 0xfb, 0xe7, 0xca, 0xf3, 0x4c, 0xca, 0xf3, 0xdf, 0x18,
+	0xd4, 0x8c, 0xfe, 0x67, 0xc1, 0x31, 0x79, 0x19, 0x46, 0xe9, 0x00, 0x65, 0xea, 0x91, 0x7d, 0x59,
+	0x0f, 0xe8, 0x35, 0x2b, 0xd6, 0x82, 0xec, 0x95, 0x60, 0x15, 0xa9, 0x91, 0x45, 0x3a, 0x07, 0x15,
+	0xcf, 0xa5, 0xe7, 0x02, 0x13, 0x36, 0xfe, 0x69, 0xfd, 0x8c, 0x01, 0x55, 0x7a, 0x59, 0x58, 0xda,
+	0x78, 0x48, 0x26, 0xfc, 0x02, 0xd3, 0x68, 0x6f, 0xd7, 0xd0, 0x6a, 0x30, 0x2e, 0x2c, 0xb5, 0x54,
+	0x4d, 0x8a, 0x6f, 0x65, 0xa5, 0x3d, 0xac, 0xae, 0xb4, 0xad, 0x5f, 0x31, 0x60, 0x55, 0x43, 0xd4,
+	0x40, 0x36, 0x82, 0x9f, 0x85, 0x49, 0xa9, 0x25, 0x6c, 0x47, 0xb1, 0x98, 0x5d, 0x1f, 0x11, 0x02,
+	0x20, 0x6d, 0x9c, 0xb5, 0xc7, 0xef, 0x58, 0x9f, 0x5b, 0x4f, 0xfc, 0x25, 0x83, 0xdd, 0x9a, 0xc8,
+	0xde, 0x08, 0xe8, 0xeb, 0xd6, 0x04, 0x75, 0xd4, 0x4c, 0x1c, 0x9f, 0x4b, 0x29, 0xf9, 0x30, 0x9f,
+	0x97, 0x7c, 0xf8, 0xe9, 0xe5, 0xdc, 0x69, 0x45, 0x07, 0x49, 0x0e, 0xfc, 0xdb, 0x30, 0xc3, 0xda,
+	0x70, 0xd6, 0x09, 0xd2, 0xba, 0x0f, 0xab, 0x0c, 0x45, 0xca, 0x20, 0xbe, 0x8d, 0xee, 0x1f, 0xdb,
+	0x55, 0x98, 0xcf, 0x61, 0xcb, 0x62, 0xb1, 0xbe, 0x07, 0xcc, 0xdd, 0x57, 0x3e, 0xff, 0xee, 0x01,
+	0xfa, 0x38, 0xa1, 0x8e, 0x51, 0x78, 0xb0, 0x88, 0xe3, 0x12, 0x43, 0x3a, 0x2e, 0xd1, 0xaa, 0x07,
+	0xeb, 0x5b, 0x06, 0x2c, 0xb1, 0xd3, 0x0d, 0x1e, 0x1e, 0x61, 0x40, 0x31, 0x07, 0x64, 0xbb, 0xc3,
+	0x9c, 0x3c, 0xd5, 0x92, 0x5a, 0x49, 0xae, 0xb5, 0x0f, 0xf3, 0x74, 0x90, 0xcb, 0x81, 0x1a, 0xba,
+	0x5a, 0x1b, 0xba, 0xc4, 0x6d, 0xf8, 0xa7, 0xa9, 0xf6, 0xfb, 0x10, 0x49, 0xda, 0xaf, 0x2b, 0x5e,
+	0x21, 0x80, 0x43, 0x7a, 0x01, 0xac, 0x94, 0xfa, 0xac, 0x0d, 0x97, 0xfb, 0xac, 0x8d, 0x64, 0x7c,
+	0xd6, 0x74, 0x4e, 0x39, 0x07, 0xb0, 0xa8, 0x12, 0x5e, 0x7a, 0xfc, 0xf9, 0x9c, 0x7a, 0xfc, 0x99,
+	0xe7, 0x31, 0xcd, 0xb6, 0x3e, 0x84, 0xa9, 0x03, 0xec, 0xc5, 0xcc, 0xf9, 0xf0, 0x1c, 0x73, 0xbd,
+	0x34, 0x36, 0x0d, 0xc5, 0xdc, 0x4d, 0x80, 0x24, 0xbf, 0xcb, 0x57, 0x61, 0x3c, 0x46, 0x09, 0x76,
+	0x11, 0x8a, 0x59, 0xcf, 0x2f, 0xab, 0xb0, 0xfb, 0x2c, 0xd7, 0x16, 0x70, 0x56, 0x0c, 0xd3, 0xac,
+	0xae, 0x41, 0x5d, 0xf3, 0xa1, 0xde, 0xd9, 0x15, 0xc9, 0x3b, 0xdb, 0x7a, 0x02, 0x57, 0x49, 0xe4,
+	0x0f, 0x55, 0x8e, 0x84, 0x09, 0x57, 0x9a, 0xfd, 0xc8, 0x71, 0x2c, 0x1b, 0x24, 0xf8, 0x77, 0x37,
+	0x4b, 0x53, 0xf9, 0x69, 0x6d, 0x02, 0xcf, 0x94, 0xd7, 0x3b, 0x08, 0x1e, 0x58, 0xbf, 0x3c, 0x0c,
+	0xf3, 0x58, 0x4a, 0x88, 0x16, 0x8e, 0x8b, 0xfc, 0x6b, 0xab, 0xa9, 0x3f, 0x3d, 0xd5, 0xbd, 0xfc,
+	0x13, 0xef, 0x6c, 0x72, 0xce, 0x3a, 0x18, 0x20, 0xe3, 0x7e, 0x23, 0xcd, 0x89, 0xf4, 0x7a, 0x3e,
+	0xff, 0x94, 0x9a, 0x33, 0x42, 0x32, 0x24, 0xcf, 0x3f, 0x7e, 0xc0, 0x39, 0xaa, 0x1e, 0x70, 0x6e,
+	0xc2, 0x54, 0xab, 0x29, 0x79, 0x4c, 0x8e, 0x11, 0x2f, 0x48, 0x68, 0x35, 0x85, 0xaf, 0xe4, 0x3a,
+	0x00, 0x87, 0x48, 0x42, 0xb2, 0xb4, 0xaf, 0xd8, 0xe3, 0x34, 0xff, 0x20, 0x24, 0xfe, 0x5f, 0xac,
+	0x97, 0x52, 0x24, 0x13, 0x04, 0x68, 0x96, 0x65, 0x08, 0x4c, 0xcf, 0xc1, 0xac, 0x02, 0x9b, 0x84,
+	0xe4, 0xe6, 0x48, 0xc5, 0x9e, 0x96, 0x20, 0x0f, 0xc2, 0xdc, 0xf0, 0x9d, 0xcc, 0x0f, 0x5f, 0xa1,
+	0x0f, 0xa6, 0xf4, 0xfa, 0x60, 0x5a, 0xeb, 0x65, 0x37, 0x93, 0x0e, 0x68, 0xac, 0x00, 0xb8, 0x7e,
+	0x61, 0xcb, 0x67, 0xf1, 0x6d, 0xee, 0xc0, 0x65, 0xca, 0xb8, 0xba, 0xe2, 0xac, 0x82, 0x2f, 0x78,
+	0x20, 0xb7, 0x7e, 0x14, 0x46, 0x6c, 0x6d, 0xbd, 0x46, 0xa1, 0x64, 0x43, 0xf2, 0x5d, 0x02, 0x73,
+	0x37, 0x8c, 0xc4, 0x5e, 0x6b, 0x3e, 0xdd, 0x6b, 0x59, 0x5f, 0x84, 0xd9, 0x7b, 0x28, 0x51, 0x26,
+	0xe9, 0xac, 0x74, 0xc8, 0x74, 0x89, 0x73, 0x6a, 0xfa, 0x6d, 0x3d, 0x82, 0xd5, 0xdd, 0x98, 0xda,
+	0xa7, 0x9d, 0xe0, 0x16, 0x3e, 0x04, 0x97, 0x7c, 0x95, 0xbb, 0xcc, 0xf6, 0xf2, 0xc9, 0xe1, 0x90,
+	0x1a, 0xe6, 0x03, 0x5b, 0xbd, 0x75, 0x78, 0x3f, 0x35, 0xab, 0xf7, 0xf7, 0xc3, 0xe2, 0x3e, 0x22,
+	0x7e, 0xe6, 0xaa, 0x67, 0xfd, 0x06, 0x00, 0x6d, 0x43, 0xbb, 0x9d, 0x36, 0x91, 0xa4, 0x3c, 0x6a,
+	0x7b, 0xae, 0x79, 0x13, 0x16, 0xe8, 0x0a, 0x55, 0xe9, 0x36, 0x76, 0x76, 0x69, 0xd2, 0x2c, 0xb9,
+	0xaf, 0xd2, 0x8b, 0x18, 0x15, 0xe9, 0x22, 0x86, 0x15, 0xc2, 0x2c, 0xad, 0x16, 0x13, 0xb0, 0xef,
+	0xf8, 0x28, 0x4e, 0x01, 0x0d, 0x09, 0xb0, 0x84, 0xa5, 0xf2, 0xa1, 0x5a, 0x45, 0xf1, 0x1d, 0x35,
+	0xc9, 0xcd, 0x3d, 0xee, 0x0e, 0x41, 0x7e, 0x5b, 0xbf, 0x6a, 0xc0, 0x42, 0x5a, 0xe3, 0xbb, 0xe8,
+	0xa9, 0x4d, 0xad, 0xba, 0x2f, 0xc1, 0x02, 0x3f, 0xef, 0xf6, 0x62, 0x7a, 0x7a, 0x59, 0x77, 0x5e,
+	0x65, 0x34, 0xcc, 0xb1, 0xac, 0xdd, 0x98, 0x1c, 0x56, 0x6e, 0xbf, 0x9a, 0x12, 0x39, 0x54, 0x44,
+	0x64, 0xa5, 0x90, 0xc8, 0x61, 0x2d, 0x91, 0x23, 0x12, 0x91, 0xd4, 0x59, 0x54, 0xf1, 0x4d, 0x13,
+	0x93, 0x0c, 0xeb, 0x1e, 0xad, 0x2f, 0xa8, 0xa1, 0xf5, 0x05, 0xc5, 0x33, 0x3e, 0x5f, 0x6b, 0xd7,
+	0x9d, 0xd7, 0xf8, 0x92, 0x8b, 0x27, 0x6d, 0xbf, 0x66, 0xbd, 0x0f, 0x9b, 0xc5, 0xf5, 0x31, 0xc9,
+	0x7c, 0x1d, 0x46, 0x5b, 0x4e, 0xe4, 0x34, 0xb9, 0x03, 0xec, 0x7a, 0xf6, 0x52, 0x11, 0x2d, 0xb7,
+	0x47, 0x60, 0x6c, 0x06, 0x6b, 0x7d, 0xcc, 0x4f, 0x0d, 0xb5, 0x1e, 0x95, 0xfd, 0x34, 0xe2, 0x86,
+	0xa8, 0x3f, 0x3b, 0x09, 0xab, 0xa8, 0x79, 0xcd, 0x3f, 0x62, 0xc0, 0xa6, 0xa6, 0x6a, 0x46, 0xdf,
+	0x19, 0x08, 0x78, 0x3d, 0x43, 0x40, 0x6f, 0x0c, 0x78, 0x08, 0x6b, 0x5a, 0x06, 0x74, 0x19, 0xef,
+	0x55, 0x75, 0xbc, 0x4b, 0xde, 0xdb, 0x8f, 0x60, 0xab, 0xa4, 0x59, 0x67, 0x46, 0xdb, 0x82, 0x45,
+	0x7a, 0x77, 0xe3, 0xab, 0x5e, 0x8b, 0x9c, 0xcd, 0x77, 0xdd, 0x13, 0x32, 0x4f, 0x87, 0x21, 0xe1,
+	0xe9, 0xd0, 0xdf, 0x8e, 0xd9, 0x7a, 0x04, 0x4b, 0x99, 0x1a, 0xcf, 0xb6, 0xcc, 0xe3, 0xc5, 0x69,
+	0xb6, 0xf5, 0x01, 0x4c, 0x2b, 0x4a, 0xf1, 0x9c, 0x97, 0x1d, 0xdf, 0x80, 0x45, 0x1d, 0x00, 0x71,
+	0x1e, 0xc2, 0x1e, 0x0f, 0x6c, 0x55, 0x85, 0x7f, 0x17, 0x73, 0x9b, 0x9a, 0x7a, 0x12, 0xc7, 0xf3,
+	0x63, 0xae, 0x27, 0xd8, 0xa7, 0xf5, 0x4b, 0x43, 0xb0, 0x4e, 0x0f, 0x44, 0x99, 0xbf, 0x95, 0x17,
+	0x1c, 0x53, 0x43, 0x51, 0xba, 0x7c, 0x23, 0x53, 0x9d, 0x21, 0x99, 0x15, 0x4b, 0x94, 0xa3, 0xe2,
+	0xc6, 0x57, 0x51, 0xdd, 0xf8, 0xb2, 0x5b, 0x81, 0xe1, 0xdc, 0x56, 0xa0, 0xe8, 0x96, 0x63, 0x99,
+	0x1f, 0x49, 0xca, 0xef, 0x31, 0x25, 0x10, 0x13, 0x57, 0x74, 0x74, 0x55, 0x43, 0x7e, 0xcb, 0xd2,
+	0x35, 0xa1, 0x4a, 0x57, 0xea, 0x29, 0x0a, 0xb2, 0xa7, 0xa8, 0xf5, 0x0b, 0x06, 0x6c, 0x14, 0xf0,
+	0x67, 0x20, 0x53, 0xe8, 0x8b, 0xca, 0x14, 0x9a, 0x9e, 0xc6, 0x64, 0x6b, 0x17, 0x67, 0xda, 0xab,
+	0xf7, 0x91, 0x7b, 0x8c, 0xa2, 0x83, 0xc8, 0x73, 0xfc, 0x5b, 0x8e, 0xef, 0x04, 0x0d, 0xd4, 0xf3,
+	0xce, 0xab, 0xec, 0x38, 0x72, 0x0d, 0x26, 0xb2, 0x37, 0x9c, 0xc6, 0x5d, 0xbe, 0xda, 0xc3, 0xe1,
+	0x7b, 0x94, 0xbb, 0x4c, 0xa3, 0x2e, 0xbd, 0x52, 0xf3, 0x0b, 0xf8, 0x5e, 0x86, 0x86, 0xa0, 0x81,
+	0xf0, 0xea, 0xa6, 0xc2, 0xab, 0x35, 0xc1, 0x2b, 0x0d, 0x01, 0x94, 0x5d, 0xbf, 0x6f, 0xc0, 0xd6,
+	0x6e, 0xb3, 0x15, 0x46, 0x89, 0x8d, 0x1a, 0x61, 0xd0, 0xf0, 0x7c, 0x8f, 0x39, 0x27, 0xd0, 0x34,
+	0xca, 0xb6, 0xd7, 0x61, 0x99, 0x2b, 0x69, 0x7a, 0x0f, 0xa4, 0xae, 0x3a, 0x9a, 0x2e, 0xb2, 0x5c,
+	0x7a, 0x29, 0xe4, 0x2d, 0x9a, 0x87, 0xf9, 0x85, 0x23, 0x28, 0xc9, 0x67, 0xf0, 0xe3, 0x38, 0x81,
+	0xf8, 0xff, 0x13, 0xb9, 0x0b, 0x12, 0xc4, 0x9c, 0x10, 0xa7, 0x6c, 0xfe, 0xa9, 0xb2, 0x79, 0xb8,
+	0x98, 0xcd, 0x23, 0x0a, 0x9b, 0xff, 0x86, 0x01, 0xab, 0x99, 0x26, 0xb4, 0x07, 0xb5, 0xf9, 0xbb,
+	0xa1, 0x70, 0xb9, 0x26, 0x79, 0xfb, 0x66, 0xeb, 0xa7, 0x4c, 0xfe, 0x61, 0x03, 0x36, 0xa8, 0x33,
+	0x71, 0x26, 0x3f, 0xfe, 0x64, 0x0c, 0xee, 0xcb, 0x4c, 0x60, 0xfd, 0x13, 0x03, 0x2e, 0x17, 0x51,
+	0x71, 0x71, 0x91, 0x15, 0xcd, 0x97, 0xf9, 0x2c, 0x32, 0xbc, 0x59, 0xe9, 0xc2, 0x3d, 0x36, 0x9f,
+	0xfc, 0x86, 0x96, 0x70, 0x1c, 0x8c, 0x2e, 0x96, 0x02, 0x33, 0x46, 0xed, 0x40, 0x0a, 0xcc, 0x18,
+	0xb5, 0x03, 0xe5, 0x6a, 0xd8, 0x90, 0xb2, 0x41, 0xbc, 0x06, 0xb3, 0xed, 0x20, 0x42, 0x71, 0xe8,
+	0xe3, 0x2b, 0x71, 0x61, 0xe0, 0x77, 0x98, 0x97, 0xd6, 0x4c, 0x9a, 0xfc, 0x30, 0xf0, 0x3b, 0xfd,
+	0x5d, 0x6f, 0xb2, 0xbe, 0x63, 0xc0, 0x95, 0x42, 0x42, 0x2f, 0x90, 0xc5, 0xaf, 0xa8, 0x2c, 0x5e,
+	0x2b, 0x60, 0x31, 0x26, 0x8c, 0xf3, 0xf8, 0x6b, 0xb0, 0x69, 0xd3, 0x86, 0x6b, 0x60, 0x18, 0x93,
+	0x57, 0x60, 0xcc, 0xf7, 0x02, 0x94, 0x72, 0x79, 0x14, 0x7f, 0xee, 0xba, 0xe6, 0x65, 0x00, 0xc2,
+	0xb5, 0xb6, 0xd8, 0x80, 0x4c, 0xd8, 0x52, 0x0a, 0x51, 0x81, 0x3a, 0xb4, 0x17, 0xaa, 0x02, 0x35,
+	0x04, 0xd0, 0xd1, 0xf9, 0x26, 0x77, 0xce, 0xb2, 0xc3, 0x8e, 0xe3, 0x27, 0x1d, 0x55, 0xf5, 0xad,
+	0xc3, 0x04, 0x9f, 0x1e, 0xe8, 0xba, 0x7b, 0xc2, 0x4e, 0x13, 0xac, 0x7f, 0x8d, 0x67, 0x1b, 0xdc,
+	0xe3, 0x72, 0x51, 0xd9, 0xce, 0xd7, 0x42, 0x91, 0x17, 0xba, 0x54, 0x97, 0x19, 0xcc, 0xd8, 0x40,
+	0x92, 0x88, 0x36, 0x5b, 0x83, 0x09, 0x06, 0x90, 0x84, 0xd5, 0x21, 0x66, 0x6b, 0x20, 0x09, 0x07,
+	0x61, 0xf7, 0x90, 0x72, 0x29, 0xdf, 0x86, 0x15, 0xe9, 0x16, 0x42, 0x3b, 0xa2, 0x17, 0xda, 0x51,
+	0x45, 0x68, 0xbf, 0x0e, 0xcb, 0x2a, 0xf5, 0x7b, 0xce, 0xb1, 0x17, 0x38, 0x89, 0x24, 0x5c, 0x86,
+	0x1a, 0x9c, 0x46, 0x59, 0x05, 0xa6, 0x9b, 0x00, 0x95, 0x7d, 0x4c, 0xae, 0x7e, 0x91, 0xdd, 0x4a,
+	0xcc, 0x32, 0x68, 0x20, 0x5d, 0xff, 0x32, 0x8b, 0x13, 0xc3, 0x2f, 0x67, 0xd3, 0x22, 0xda, 0xc6,
+	0xd1, 0xf8, 0x30, 0xd6, 0x29, 0xd3, 0x2c, 0x32, 0x8c, 0x6a, 0xce, 0x22, 0xab, 0x37, 0x9c, 0x9c,
+	0x8a, 0xfd, 0x38, 0x4d, 0xd8, 0x75, 0xfb, 0xe4, 0xf4, 0x43, 0xd8, 0x60, 0x9e, 0xd0, 0x72, 0x75,
+	0x3b, 0x61, 0x14, 0x51, 0x7f, 0x92, 0x42, 0x1f, 0xa9, 0x74, 0x25, 0x36, 0xa4, 0xac, 0xc4, 0xde,
+	0x80, 0x15, 0x7c, 0x9f, 0x44, 0x27, 0xb6, 0x65, 0x64, 0x5b, 0x3f, 0x4b, 0x8f, 0xa0, 0x32, 0x05,
+	0x07, 0x14, 0x37, 0x4f, 0x1e, 0x8d, 0x45, 0xc2, 0x42, 0x07, 0xe2, 0x6f, 0x08, 0x07, 0xd7, 0xbe,
+	0x9b, 0x54, 0x18, 0x7b, 0x74, 0x07, 0xdf, 0xdd, 0xe3, 0x0c, 0x66, 0x54, 0x5c, 0x65, 0xc1, 0xd7,
+	0xca, 0xfa, 0xc2, 0x96, 0x8a, 0x65, 0xc3, 0xd0, 0xe0, 0x40, 0x45, 0x56, 0xea, 0xef, 0xfa, 0xc4,
+	0x43, 0x4f, 0xfb, 0x27, 0x98, 0xfa, 0xb0, 0xd1, 0x6b, 0x14, 0xec, 0x66, 0x3d, 0xf1, 0x61, 0xdb,
+	0x66, 0x29, 0xb9, 0x4b, 0x26, 0xcf, 0xc2, 0x8c, 0xeb, 0xc5, 0xad, 0x76, 0x82, 0xea, 0xca, 0x05,
+	0xae, 0x69, 0x96, 0x6a, 0x93, 0x44, 0xf3, 0x2e, 0xf0, 0x84, 0x3a, 0x1d, 0xa6, 0x23, 0x99, 0x00,
+	0x24, 0x0a, 0xa9, 0xb7, 0x29, 0x28, 0x71, 0xac, 0x9e, 0x72, 0xd3, 0x8f, 0xd8, 0xfa, 0x3d, 0x03,
+	0xae, 0x6f, 0xbb, 0xae, 0x0e, 0x5a, 0xe2, 0x52, 0x2f, 0x2d, 0x5d, 0x81, 0x31, 0x4c, 0x89, 0xe4,
+	0x71, 0x8d, 0x3f, 0xa9, 0xdd, 0x85, 0xec, 0xa3, 0x2a, 0xd2, 0x3e, 0xaa, 0xc8, 0xb3, 0x3e, 0x1d,
+	0x02, 0x23, 0xca, 0xb5, 0x35, 0xca, 0xa5, 0x51, 0xd1, 0x35, 0x1f, 0x81, 0xc5, 0xe7, 0x31, 0x0d,
+	0xe5, 0x3d, 0xd1, 0xdb, 0x65, 0x36, 0xcb, 0x76, 0x8c, 0xf5, 0x37, 0x0d, 0x58, 0xd7, 0x57, 0x36,
+	0x90, 0x11, 0xf5, 0x8a, 0x32, 0xa2, 0x36, 0x4a, 0xfb, 0x35, 0x1d, 0x58, 0x5b, 0x39, 0x35, 0xf7,
+	0x18, 0x45, 0xf4, 0x7e, 0xea, 0x60, 0xc8, 0x7c, 0x8d, 0x4f, 0x13, 0xd4, 0xff, 0xa9, 0x80, 0x4e,
+	0x46, 0x04, 0x9f, 0x2d, 0xfe, 0x81, 0x21, 0x2e, 0x83, 0xe1, 0xd1, 0x19, 0x20, 0x7f, 0x27, 0x8c,
+	0xd9, 0xa2, 0x97, 0x34, 0x60, 0x30, 0xb4, 0x7e, 0x97, 0xc2, 0xd2, 0x67, 0xb3, 0x56, 0x25, 0x3d,
+	0x29, 0x94, 0xb5, 0xbf, 0x99, 0x5e, 0x2f, 0xcb, 0x81, 0x0d, 0xec, 0xfc, 0x5c, 0xa6, 0x76, 0xab,
+	0x2b, 0xb5, 0x8c, 0xd2, 0xdf, 0x35, 0xe0, 0xb9, 0x3c, 0x88, 0x1c, 0xed, 0xe1, 0x82, 0xee, 0x79,
+	0x14, 0xd3, 0xab, 0x10, 0x43, 0xa9, 0xfe, 0xbb, 0x06, 0x58, 0xc5, 0x80, 0x03, 0xa2, 0xf8, 0x73,
+	0x0a, 0xc5, 0x57, 0x7b, 0xa0, 0x98, 0x51, 0xfb, 0xeb, 0x06, 0x5c, 0x79, 0x10, 0x06, 0xa8, 0x73,
+	0xcb, 0x69, 0x9c, 0x5e, 0x88, 0xe8, 0xbe, 0xae, 0x90, 0xba, 0x99, 0xde, 0x40, 0x28, 0xa0, 0x82,
+	0xd2, 0xf9, 0x4b, 0x38, 0x18, 0x47, 0x1e, 0x62, 0x70, 0xcb, 0x32, 0x89, 0xc6, 0xf5, 0x32, 0x1a,
+	0x19, 0x7d, 0xbf, 0x65, 0xc0, 0x96, 0x92, 0x7b, 0x01, 0x62, 0xfa, 0x86, 0x42, 0xa5, 0xa5, 0xa7,
+	0x52, 0x23, 0xa1, 0xe4, 0x3e, 0xbd, 0x0e, 0xe6, 0xa2, 0xef, 0xd3, 0x6b, 0x69, 0xa0, 0x34, 0xbe,
+	0x01, 0x35, 0xe2, 0x52, 0x78, 0x2f, 0x0a, 0xdb, 0xad, 0x5b, 0x34, 0x4e, 0x74, 0x6a, 0xcf, 0x2c,
+	0x34, 0x30, 0xe3, 0x97, 0x2c, 0xd2, 0x72, 0xcc, 0xb1, 0xd1, 0x93, 0x36, 0xb3, 0x37, 0x85, 0x83,
+	0xbe, 0xa1, 0x3a, 0x4f, 0xf2, 0x32, 0xf4, 0x68, 0x27, 0xe6, 0x9e, 0xfb, 0x96, 0x03, 0xf3, 0xb9,
+	0x4c, 0xc5, 0xe6, 0x66, 0x64, 0x6c, 0x6e, 0x2f, 0xc3, 0x18, 0x2d, 0x9a, 0xee, 0x37, 0xb4, 0x55,
+	0xd8, 0x1c, 0xcc, 0xba, 0x0d, 0x73, 0xd9, 0x4c, 0xe9, 0x22, 0x81, 0xa1, 0x5c, 0x24, 0x50, 0x2e,
+	0x11, 0xca, 0x47, 0xcb, 0xd6, 0x6b, 0xb0, 0x2a, 0x73, 0x8c, 0x55, 0xc2, 0x18, 0x56, 0x80, 0xce,
+	0x7a, 0x00, 0x2b, 0x78, 0x97, 0xd9, 0x6c, 0xa2, 0xc0, 0x45, 0x2e, 0x29, 0x2f, 0x15, 0xe9, 0xf7,
+	0xfa, 0x83, 0x75, 0x08, 0xd5, 0x3c, 0x3a, 0xc6, 0xf9, 0xbb, 0x30, 0x1f, 0xa5, 0x79, 0x75, 0x7a,
+	0x39, 0x83, 0x76, 0xc2, 0xaa, 0xb2, 0xe5, 0x55, 0x4a, 0xcf, 0x45, 0x99, 0x14, 0xeb, 0x4d, 0x58,
+	0xcf, 0x42, 0x1d, 0xe0, 0x83, 0x43, 0x4e, 0x77, 0x49, 0xdf, 0x58, 0x5f, 0x86, 0x8d, 0x82, 0xb2,
+	0x8c, 0xc8, 0x97, 0x61, 0x34, 0xc2, 0x6b, 0x6c, 0x7e, 0x60, 0x95, 0xda, 0x6e, 0x25, 0x60, 0x0c,
+	0x60, 0x33, 0x38, 0x6b, 0x1f, 0x96, 0x64, 0x17, 0xd8, 0x54, 0xd2, 0xde, 0x84, 0x69, 0xee, 0xfa,
+	0x26, 0xb7, 0xb5, 0xc0, 0x73, 0x76, 0xaa, 0x25, 0x7d, 0x59, 0x1f, 0x42, 0x8d, 0x06, 0x58, 0xce,
+	0xa0, 0x96, 0x0f, 0x92, 0xdd, 0x76, 0xf6, 0x20, 0x99, 0x79, 0xd4, 0xa5, 0xbe, 0xba, 0x43, 0x3d,
+	0xf8, 0xea, 0x5a, 0x4d, 0x58, 0xc0, 0xa7, 0x9a, 0x7b, 0x51, 0x88, 0x2d, 0x9d, 0x7b, 0x28, 0x8a,
+	0xc3, 0xc0, 0xf1, 0x71, 0x25, 0x47, 0x5e, 0x14, 0xb3, 0xfb, 0x48, 0xac, 0x12, 0x92, 0xf2, 0x2e,
+	0x0b, 0xb2, 0xe5, 0x3b, 0x3c, 0x77, 0x88, 0xfb, 0xed, 0xb1, 0x4c, 0x7c, 0xbb, 0x3d, 0x8c, 0xbd,
+	0x24, 0xbd, 0x07, 0x23, 0xbe, 0xad, 0xff, 0x66, 0xc0, 0xac, 0x54, 0xdf, 0x5b, 0xc8, 0x6f, 0x99,
+	0xbb, 0xb0, 0x95, 0xb2, 0x2a, 0x6c, 0x86, 0x18, 0xb0, 0xee, 0x04, 0x6e, 0xdd, 0x45, 0x4f, 0x90,
+	0x1f, 0xb6, 0xf0, 0x14, 0xc6, 0xee, 0x3f, 0x5f, 0x16, 0x7c, 0x62, 0x70, 0xdb, 0x81, 0x7b, 0x3b,
+	0x85, 0x32, 0xdf, 0x80, 0x95, 0x08, 0xf9, 0xc8, 0x89, 0x91, 0x5b, 0x3f, 0x76, 0x9a, 0x28, 0x45,
+	0xc8, 0x36, 0x2c, 0x4b, 0x3c, 0xfb, 0x9e, 0xd3, 0x44, 0x02, 0x0b, 0x3e, 0x9a, 0xf6, 0x82, 0x04,
+	0x45, 0x01, 0xb1, 0xb5, 0x38, 0x7e, 0x3d, 0xc6, 0xe7, 0xca, 0xcc, 0xc6, 0x66, 0x2a, 0x59, 0xe2,
+	0xc4, 0x39, 0x4c, 0x4e, 0x98, 0xcf, 0xf8, 0xb8, 0x4d, 0x3f, 0xac, 0xff, 0x65, 0xc0, 0x65, 0xa9,
+	0x75, 0x2c, 0xb6, 0x1a, 0x56, 0x74, 0x89, 0xf7, 0x4d, 0x52, 0xde, 0xfc, 0x0c, 0x3e, 0x3e, 0xf4,
+	0xdc, 0x7a, 0xdc, 0x3e, 0x4c, 0x5d, 0x9b, 0x69, 0xe3, 0xe6, 0x70, 0xc6, 0xbe, 0x94, 0x8e, 0xbd,
+	0xe1, 0xbd, 0x80, 0x36, 0xc4, 0x71, 0x9f, 0xa0, 0x28, 0xf1, 0x62, 0x8f, 0x5d, 0xed, 0x1f, 0xb7,
+	0xe7, 0xbd, 0x00, 0x37, 0x62, 0x3b, 0xcd, 0xc0, 0x67, 0x93, 0x1c, 0xbe, 0xd5, 0xc6, 0x0a, 0x35,
+	0x16, 0x8d, 0x98, 0xa5, 0xd0, 0x7b, 0x3c, 0x99, 0x38, 0xb3, 0x44, 0xa8, 0xe9, 0xb5, 0x9b, 0x64,
+	0x53, 0x17, 0xc7, 0xac, 0x29, 0xd3, 0x2c, 0x75, 0x9b, 0x24, 0xa6, 0x0d, 0x1d, 0x91, 0x1b, 0xfa,
+	0xbb, 0x06, 0xac, 0xe5, 0x1b, 0x2a, 0xc2, 0x30, 0x63, 0xf3, 0x66, 0xab, 0x51, 0x6f, 0x3a, 0x0d,
+	0xd6, 0xb4, 0x91, 0x56, 0xe3, 0x81, 0xd3, 0xc0, 0x9e, 0x23, 0x84, 0xb8, 0x46, 0x18, 0xc4, 0xa1,
+	0x8f, 0x58, 0x43, 0x26, 0x71, 0xda, 0x0e, 0x4d, 0xc2, 0x61, 0x3d, 0x9a, 0xe1, 0x21, 0xb6, 0xc2,
+	0xbb, 0xe8, 0x09, 0xbf, 0xc0, 0x35, 0x6e, 0x4f, 0xd1, 0xc4, 0xdb, 0x24, 0x0d, 0xef, 0x45, 0x9f,
+	0xa2, 0xc3, 0xfa, 0x61, 0x14, 0x3e, 0x8d, 0x45, 0x1f, 0xc0, 0x53, 0x74, 0x78, 0x8b, 0xa6, 0x14,
+	0x50, 0xfd, 0x83, 0x15, 0x30, 0xf3, 0x54, 0x63, 0xaa, 0x58, 0xe0, 0x3b, 0x59, 0xda, 0x27, 0x59,
+	0x1a, 0x37, 0xfe, 0x3f, 0x45, 0x87, 0xb1, 0x97, 0x88, 0x63, 0x39, 0xf6, 0x69, 0xbe, 0x01, 0xd3,
+	0x4e, 0x10, 0xb4, 0x1d, 0xbf, 0xee, 0x05, 0x8d, 0xb0, 0x89, 0x72, 0x57, 0x2b, 0x89, 0xbe, 0xd8,
+	0x0d, 0x12, 0x7b, 0x8a, 0xc2, 0xed, 0x12, 0x30, 0x73, 0x1b, 0x16, 0x82, 0x76, 0xf3, 0x10, 0x45,
+	0xf5, 0xf0, 0xa8, 0x8e, 0x9a, 0x2d, 0x3f, 0xec, 0x20, 0x14, 0x57, 0x87, 0x8b, 0x4a, 0xcf, 0x53,
+	0xe8, 0x87, 0x47, 0x77, 0x38, 0x2c, 0x8e, 0x92, 0x76, 0xea, 0x05, 0x2e, 0x46, 0x40, 0xae, 0x56,
+	0x78, 0x49, 0x87, 0x47, 0x3b, 0xc0, 0xe9, 0x0f, 0x8f, 0xb6, 0x59, 0xaa, 0xf9, 0x16, 0x4c, 0x35,
+	0x25, 0x21, 0x64, 0xc1, 0xe8, 0x9f, 0x49, 0xa3, 0x03, 0x14, 0x0b, 0xac, 0xad, 0x94, 0x34, 0xbf,
+	0x57, 0xbd, 0xc7, 0x67, 0xa4, 0xb7, 0xae, 0x4a, 0xc4, 0x41, 0xbe, 0xd1, 0xf7, 0x7f, 0x0c, 0x98,
+	0x93, 0x40, 0xef, 0x10, 0x27, 0x08, 0xbd, 0xff, 0xc6, 0x3a, 0x4c, 0x34, 0xc2, 0xe0, 0xc8, 0x8b,
+	0x9a, 0xc2, 0xde, 0x90, 0x26, 0xe0, 0xcb, 0x45, 0xe2, 0xa3, 0xee, 0x24, 0xd5, 0x4a, 0x57, 0xd7,
+	0xfc, 0x49, 0x01, 0xbf, 0x9d, 0xe0, 0x7b, 0x11, 0xec, 0x33, 0xbd, 0xa7, 0x42, 0xed, 0x13, 0xb3,
+	0x72, 0x3a, 0xbe, 0xa9, 0xf2, 0x05, 0xa8, 0xe1, 0x48, 0x33, 0x32, 0x34, 0x21, 0xb0, 0x1e, 0xf3,
+	0xa0, 0x18, 0xe3, 0xf6, 0x8a, 0x17, 0xef, 0x48, 0x00, 0xa4, 0x59, 0xfb, 0x28, 0x48, 0xac, 0xef,
+	0x54, 0x60, 0x52, 0x6a, 0x6f, 0xce, 0x9f, 0xa8, 0x30, 0x8a, 0xd9, 0x8b, 0xb2, 0xf3, 0x8b, 0x58,
+	0x46, 0x64, 0x59, 0xc7, 0x79, 0xf5, 0x59, 0x18, 0x6f, 0x31, 0xdd, 0x5d, 0x1d, 0x96, 0xe3, 0x63,
+	0x6a, 0x94, 0xbb, 0x2d, 0x40, 0xf1, 0xdd, 0xdb, 0x13, 0xe4, 0xb7, 0x58, 0x5c, 0xa7, 0xa5, 0x5c,
+	0x11, 0xac, 0x9f, 0x6d, 0x02, 0x82, 0xa3, 0x3b, 0xb2, 0x11, 0x21, 0xde, 0x32, 0x28, 0xe8, 0xf7,
+	0x34, 0x8e, 0x24, 0x9f, 0x26, 0xe2, 0x04, 0xb5, 0x78, 0xdc, 0x5a, 0x9c, 0xb0, 0x9f, 0xa0, 0x16,
+	0xe9, 0x01, 0x14, 0x24, 0x91, 0x77, 0xd4, 0x3e, 0x0e, 0xeb, 0xd4, 0x39, 0x91, 0xdd, 0x4c, 0x49,
+	0xd3, 0x89, 0x4b, 0x64, 0xe6, 0xfe, 0xc6, 0xc4, 0xd9, 0xef, 0x6f, 0x40, 0x1f, 0xf7, 0x37, 0xac,
+	0x87, 0xb0, 0x74, 0x0f, 0x25, 0x52, 0xfb, 0xa4, 0x73, 0x05, 0xde, 0x67, 0x86, 0xd2, 0x67, 0x74,
+	0x6a, 0xc6, 0xa0, 0xaa, 0x4f, 0x24, 0x4e, 0xa1, 0xcf, 0x6e, 0x2d, 0x67, 0x31, 0x5e, 0xc8, 0x35,
+	0xef, 0x6c, 0x47, 0xb1, 0x55, 0xf6, 0x1f, 0x1b, 0x00, 0x7b, 0xb8, 0x20, 0x31, 0x09, 0xf6, 0x2e,
+	0x9a, 0x64, 0x39, 0x89, 0x8b, 0xa4, 0xd7, 0x5c, 0x09, 0x02, 0x76, 0xb1, 0x65, 0x38, 0xbd, 0xd8,
+	0x82, 0x4d, 0x69, 0x31, 0x36, 0xff, 0xb9, 0xfc, 0x8d, 0x18, 0x2f, 0xb6, 0x91, 0xe3, 0x66, 0x7a,
+	0x74, 0xf4, 0xec, 0x3d, 0x3a, 0xd6, 0x4f, 0x8f, 0x7e, 0x1d, 0x56, 0xd8, 0xcd, 0x71, 0xd1, 0xea,
+	0xae, 0x7d, 0x9a, 0x36, 0x76, 0x48, 0xd7, 0xd8, 0x8a, 0x68, 0xac, 0x75, 0x13, 0x56, 0x98, 0x0e,
+	0xc0, 0xac, 0xa6, 0xc3, 0x33, 0x7d, 0xd6, 0x80, 0x0a, 0xb8, 0x21, 0x7b, 0xdf, 0xfe, 0x45, 0x58,
+	0x7e, 0xec, 0x24, 0x07, 0x91, 0x13, 0xc4, 0x0e, 0x31, 0x4f, 0x8a, 0x35, 0x9e, 0x05, 0xd3, 0x4f,
+	0x9c, 0xa4, 0x9e, 0xb5, 0xf9, 0x4d, 0x3e, 0x71, 0x12, 0x3b, 0x67, 0xcb, 0xef, 0xc9, 0xe7, 0x9a,
+	0xfb, 0x58, 0x0e, 0x4b, 0x4e, 0xd3, 0x5f, 0x87, 0x45, 0xb9, 0xf2, 0x2e, 0xe7, 0x28, 0x37, 0xd4,
+	0x73, 0x94, 0xaa, 0xea, 0x1a, 0xf8, 0xd8, 0x43, 0x4f, 0xc9, 0xa3, 0x11, 0x0d, 0x6e, 0x1b, 0xfb,
+	0x59, 0x43, 0x45, 0x3f, 0xb8, 0xb3, 0x6d, 0xe9, 0x0c, 0x85, 0x15, 0xd1, 0x35, 0x8b, 0x9d, 0xa0,
+	0x84, 0x30, 0xff, 0x98, 0x73, 0x31, 0xee, 0xee, 0xb2, 0xf4, 0xc9, 0xb9, 0x7c, 0x00, 0x66, 0x5a,
+	0x61, 0x17, 0x1e, 0x5f, 0x57, 0x79, 0x9c, 0x7a, 0x98, 0x0b, 0x0c, 0x9c, 0xbb, 0x3f, 0x6d, 0xc8,
+	0x68, 0x07, 0xe7, 0xc9, 0x22, 0xf1, 0x96, 0x29, 0xfc, 0x7c, 0x63, 0x18, 0x67, 0xdf, 0x86, 0x2a,
+	0x8b, 0xc8, 0x90, 0x67, 0xf0, 0x0d, 0xe6, 0xc9, 0x63, 0x74, 0x1d, 0xae, 0x04, 0xce, 0xba, 0x0b,
+	0xeb, 0x74, 0x0b, 0x24, 0x50, 0xa9, 0xd1, 0x57, 0xb3, 0xba, 0xaa, 0xe0, 0x38, 0x85, 0x9c, 0xe4,
+	0x32, 0xeb, 0xc1, 0x63, 0x27, 0xc9, 0x79, 0x28, 0x5e, 0xcc, 0x49, 0xae, 0x86, 0x00, 0xaa, 0x80,
+	0x7f, 0xcd, 0x80, 0xad, 0x7c, 0xe6, 0x5b, 0x5e, 0x9c, 0x84, 0x03, 0xf3, 0x4e, 0x7a, 0x45, 0xb5,
+	0x73, 0x97, 0x52, 0xc9, 0x64, 0x6d, 0x07, 0x16, 0x1e, 0x3b, 0xc9, 0xc3, 0x38, 0xb6, 0x51, 0xd2,
+	0x8e, 0x02, 0xc9, 0xad, 0xac, 0x83, 0x9c, 0x88, 0x51, 0x45, 0x7e, 0xe3, 0x81, 0xf4, 0x51, 0xdb,
+	0x89, 0x12, 0x16, 0x9f, 0x7a, 0xc4, 0xe6, 0x9f, 0xf8, 0xd9, 0xce, 0x45, 0x15, 0xcb, 0x85, 0x46,
+	0x13, 0x51, 0xaa, 0xa6, 0xdc, 0xff, 0x1d, 0x03, 0x5e, 0x92, 0x62, 0xf2, 0xa4, 0x91, 0xb7, 0xf1,
+	0x3a, 0xc6, 0x47, 0x09, 0x92, 0xc3, 0xd5, 0x90, 0x88, 0x4b, 0x2a, 0xa9, 0xe9, 0xa1, 0xdd, 0x67,
+	0x61, 0x04, 0x2f, 0x71, 0xb8, 0x9b, 0xe7, 0x15, 0x6d, 0x94, 0x6d, 0x8c, 0xd1, 0xc5, 0x2b, 0x9f,
+	0xd8, 0xa6, 0xd0, 0x38, 0x8c, 0x72, 0x83, 0x65, 0x90, 0x25, 0x12, 0x7f, 0xdc, 0x90, 0xea, 0x14,
+	0x93, 0xe7, 0x91, 0x32, 0xf4, 0x8d, 0xc3, 0x7f, 0x6b, 0xc0, 0xf3, 0x3d, 0x93, 0x7c, 0xce, 0x9c,
+	0xbd, 0xa7, 0x70, 0x96, 0x3d, 0x08, 0xd5, 0x17, 0xff, 0x18, 0xdf, 0x8f, 0xc0, 0x92, 0x8a, 0x89,
+	0x10, 0x27, 0xf8, 0xf6, 0xc7, 0xa3, 0xc8, 0xef, 0xd9, 0xf3, 0xed, 0x0a, 0x4c, 0x92, 0x28, 0xdf,
+	0x11, 0xbd, 0xc6, 0x4a, 0xc5, 0x0d, 0x68, 0x12, 0xbe, 0xc5, 0x8a, 0x6f, 0x33, 0x5d, 0x2d, 0xad,
+	0x68, 0x20, 0x6c, 0xba, 0xa5, 0xb0, 0xe9, 0x46, 0x4e, 0x14, 0x14, 0x32, 0xc8, 0x6d, 0x17, 0xcc,
+	0x26, 0x4e, 0x53, 0x6a, 0xa2, 0x95, 0x28, 0x3f, 0x70, 0x22, 0xef, 0xe8, 0xc8, 0x76, 0x12, 0x24,
+	0x7b, 0x6a, 0x9c, 0x84, 0x4d, 0x54, 0xa7, 0x86, 0x3c, 0xce, 0x1d, 0x9c, 0xc4, 0x2c, 0x88, 0x5b,
+	0x30, 0xd5, 0x72, 0x3a, 0xe4, 0x6d, 0x8f, 0xe3, 0xf4, 0x58, 0x70, 0x92, 0xa4, 0x31, 0x90, 0x0d,
+	0x80, 0xa6, 0x17, 0xf0, 0xd0, 0x5f, 0x34, 0x5a, 0xd8, 0x44, 0xd3, 0x0b, 0xb6, 0x45, 0x5c, 0xce,
+	0xa6, 0xf3, 0x71, 0x5d, 0x39, 0xdd, 0x9c, 0x68, 0x3a, 0x1f, 0xd3, 0x6c, 0xeb, 0x3b, 0x43, 0x70,
+	0xb5, 0x88, 0xc6, 0xb4, 0xcb, 0x63, 0xf3, 0x8b, 0x30, 0xc6, 0x7c, 0xc1, 0x98, 0x09, 0xec, 0x6a,
+	0x8e, 0x25, 0x52, 0x59, 0x76, 0x62, 0x61, 0xf3, 0x32, 0xe6, 0x5d, 0x18, 0xa5, 0xbe, 0xa7, 0x6c,
+	0x4a, 0xbc, 0x51, 0x56, 0x3a, 0xd5, 0xef, 0xb8, 0x04, 0x11, 0x39, 0x56, 0xda, 0x7c, 0x0b, 0x40,
+	0x8a, 0x15, 0x4a, 0x3b, 0xe7, 0x7a, 0x2f, 0xb8, 0x08, 0x16, 0xa9, 0xac, 0xf9, 0x25, 0xec, 0xd4,
+	0xdd, 0x09, 0xdb, 0x49, 0x75, 0xb8, 0x4f, 0x2c, 0xac, 0x1c, 0x3e, 0x35, 0xbc, 0x5c, 0xce, 0xba,
+	0x73, 0x96, 0xc9, 0xef, 0x4d, 0x75, 0x3e, 0x8d, 0xdf, 0x9b, 0x1d, 0xbb, 0x45, 0xbd, 0xc7, 0x67,
+	0x00, 0x47, 0x89, 0x73, 0xa4, 0x97, 0xc7, 0xae, 0xa3, 0x55, 0x16, 0xd8, 0xa1, 0xac, 0xc0, 0x5a,
+	0x2e, 0x0d, 0xab, 0x4d, 0x9d, 0x59, 0xd8, 0x4a, 0x52, 0xd6, 0xbd, 0xe7, 0xb3, 0x28, 0x3d, 0xa2,
+	0x8e, 0x8d, 0xac, 0x96, 0x08, 0xc7, 0xa3, 0x40, 0x3d, 0x54, 0x73, 0x53, 0xad, 0x66, 0x55, 0x53,
+	0x0d, 0xc3, 0xc5, 0xea, 0xf9, 0x15, 0x03, 0xaa, 0x45, 0xcd, 0x39, 0xff, 0x73, 0xa0, 0x5d, 0xf9,
+	0x1c, 0x88, 0x14, 0x29, 0x63, 0xa5, 0x4d, 0xe0, 0xad, 0x5f, 0x65, 0xae, 0x60, 0x5a, 0x5e, 0x9c,
+	0xff, 0x01, 0xe5, 0xae, 0x7c, 0x40, 0x99, 0xa5, 0x31, 0xdf, 0x11, 0x8c, 0xc8, 0x03, 0x58, 0x4e,
+	0xc1, 0x7a, 0xe8, 0xa8, 0x67, 0xd4, 0x8e, 0xca, 0x3d, 0xd8, 0x2d, 0xb6, 0x26, 0x66, 0x1e, 0xed,
+	0xf9, 0x9f, 0x22, 0xee, 0xca, 0xa7, 0x88, 0x99, 0x36, 0x6b, 0x1a, 0xfb, 0xb3, 0xd4, 0x3e, 0x40,
+	0xf2, 0x07, 0x2a, 0x32, 0x45, 0x1e, 0xea, 0xd9, 0x41, 0x43, 0x67, 0xa3, 0x9f, 0x37, 0x88, 0xdb,
+	0x16, 0x25, 0x6b, 0xa0, 0x62, 0x92, 0x7d, 0xe1, 0xa5, 0x70, 0x94, 0x51, 0xc2, 0x6c, 0x42, 0xd7,
+	0x6d, 0x27, 0x3e, 0x21, 0x2b, 0x8f, 0x07, 0x8e, 0xd7, 0x7b, 0xa4, 0xeb, 0x65, 0x18, 0xa5, 0x8e,
+	0x8b, 0x7c, 0xc3, 0x40, 0xbf, 0xac, 0xbf, 0x46, 0x5d, 0xcd, 0x32, 0x48, 0x2f, 0xee, 0x3d, 0x9b,
+	0x4c, 0xc5, 0x92, 0xb7, 0xd9, 0xfb, 0x64, 0x4d, 0x20, 0xf2, 0x6f, 0x39, 0x31, 0x52, 0xdd, 0xb7,
+	0xce, 0xdc, 0xe6, 0x5f, 0xa6, 0xf3, 0x91, 0x16, 0xf5, 0x85, 0xf8, 0xa3, 0xab, 0x2d, 0x4f, 0xab,
+	0xe7, 0xfb, 0xa4, 0x7f, 0x44, 0x97, 0xbd, 0x02, 0xc2, 0x46, 0x4f, 0x50, 0xd0, 0x46, 0xb7, 0x3b,
+	0x81, 0xd3, 0xf4, 0x1a, 0xf1, 0x40, 0x69, 0xcd, 0xfa, 0x56, 0xa8, 0xb4, 0xaa, 0x94, 0x28, 0xfd,
+	0xf5, 0xaf, 0x0c, 0x58, 0x17, 0x70, 0x74, 0xd1, 0x84, 0x47, 0xf9, 0x7b, 0x5e, 0x72, 0x82, 0x23,
+	0x94, 0x93, 0xeb, 0xc4, 0x74, 0x71, 0xc5, 0x22, 0xf3, 0xf0, 0xa3, 0xd6, 0x69, 0x9a, 0x4a, 0x8f,
+	0xaf, 0x13, 0x12, 0xd3, 0x98, 0x82, 0xb5, 0xb0, 0xe9, 0x29, 0x64, 0x7b, 0x5c, 0xc3, 0x66, 0xa5,
+	0xf7, 0x58, 0xaa, 0x72, 0xf4, 0x59, 0xc9, 0x1c, 0x4b, 0xbf, 0x06, 0x23, 0x78, 0x09, 0x93, 0x30,
+	0x0f, 0xeb, 0x8d, 0x4c, 0x33, 0x08, 0x41, 0x98, 0xba, 0xbb, 0x7e, 0xe8, 0x24, 0x36, 0x85, 0xb5,
+	0xee, 0xc0, 0x4a, 0x01, 0x04, 0x31, 0x8b, 0x38, 0x87, 0xc8, 0x67, 0x3e, 0xc2, 0xf4, 0x43, 0x0d,
+	0x9c, 0x60, 0xf0, 0xc0, 0x09, 0xbf, 0x69, 0xc0, 0x33, 0x1a, 0xb1, 0x3e, 0x08, 0x13, 0xc7, 0x97,
+	0x0d, 0x35, 0xf8, 0xec, 0xa7, 0x91, 0xe3, 0x47, 0xc5, 0x9e, 0x6a, 0xc8, 0xec, 0x78, 0x16, 0x66,
+	0x1a, 0x79, 0x6e, 0x54, 0xec, 0xe9, 0x86, 0xc2, 0x0c, 0xd1, 0xe0, 0x4a, 0x1f, 0x0d, 0xfe, 0xcd,
+	0x21, 0x58, 0xd0, 0x50, 0x6a, 0xde, 0x83, 0xe9, 0xe3, 0x28, 0x8c, 0xb1, 0x25, 0x93, 0x74, 0x77,
+	0xd5, 0x90, 0xe7, 0xda, 0xb2, 0x4e, 0xb6, 0xa7, 0x48, 0x41, 0x26, 0x26, 0xe6, 0xeb, 0x34, 0x32,
+	0xef, 0x50, 0xcf, 0xc5, 0x31, 0xb8, 0xf9, 0x15, 0x30, 0x69, 0x94, 0xde, 0x44, 0xe2, 0x16, 0x13,
+	0xc8, 0x17, 0x0a, 0xd5, 0x46, 0x8e, 0xbf, 0xf6, 0x7c, 0x92, 0x63, 0xf9, 0x1b, 0x30, 0xec, 0x44,
+	0xad, 0x76, 0x75, 0xb8, 0x67, 0x8a, 0x08, 0xbc, 0xf5, 0x4d, 0xd8, 0x28, 0x1d, 0x03, 0xa5, 0x3e,
+	0x12, 0x5f, 0x54, 0x27, 0xe9, 0x6b, 0x3d, 0x8c, 0x29, 0x29, 0x96, 0x30, 0xbe, 0x9d, 0xb2, 0xd5,
+	0x15, 0xb8, 0x40, 0x42, 0x8b, 0x1e, 0x21, 0x28, 0x1b, 0x3b, 0x62, 0xa5, 0x41, 0xef, 0xf6, 0xd0,
+	0x0f, 0xeb, 0x31, 0x58, 0x59, 0x22, 0x24, 0x47, 0x15, 0xda, 0x01, 0xad, 0x12, 0xc3, 0x62, 0x01,
+	0x25, 0xd6, 0xfb, 0x70, 0xbd, 0x0b, 0x5e, 0x21, 0xbb, 0xfd, 0xb5, 0xd1, 0xfa, 0xd6, 0x10, 0x5c,
+	0xe9, 0x82, 0xba, 0xb4, 0xdb, 0xde, 0x84, 0x4a, 0x12, 0xb6, 0x58, 0xa7, 0x5d, 0xd7, 0x77, 0x5a,
+	0x9e, 0x05, 0x36, 0x2e, 0x84, 0x87, 0x36, 0x15, 0x61, 0x3e, 0xb4, 0xe9, 0x76, 0x93, 0x46, 0x9f,
+	0x96, 0x86, 0x36, 0x05, 0x12, 0x43, 0x9b, 0xee, 0x3a, 0x69, 0x51, 0x31, 0xb4, 0x6f, 0xf3, 0xa1,
+	0x3d, 0xc2, 0x76, 0x84, 0xbd, 0x50, 0x22, 0x98, 0xc6, 0xc7, 0xfa, 0x5b, 0x92, 0x76, 0x26, 0x67,
+	0xfa, 0x07, 0xa1, 0xeb, 0x48, 0x3d, 0xa7, 0x8b, 0xf8, 0x24, 0x24, 0x61, 0x48, 0x96, 0x84, 0x1d,
+	0x58, 0xca, 0xeb, 0x95, 0xdd, 0xa0, 0x27, 0x25, 0x59, 0xe1, 0x4a, 0xf2, 0x3f, 0x18, 0xb0, 0x5a,
+	0x48, 0x8f, 0xf9, 0x3a, 0x65, 0xbd, 0x21, 0x87, 0x40, 0x2b, 0xa3, 0xbe, 0x80, 0xe9, 0xb4, 0xc6,
+	0x6e, 0x4c, 0xa7, 0x37, 0x05, 0x33, 0x4c, 0x7f, 0x45, 0x9d, 0x40, 0xd6, 0x8a, 0xf4, 0x29, 0x3e,
+	0xab, 0x66, 0x1c, 0xfe, 0xf7, 0x06, 0x2c, 0xa7, 0x44, 0x92, 0x07, 0x42, 0xe3, 0x3f, 0xcb, 0xed,
+	0xf9, 0x9f, 0x06, 0x2c, 0xea, 0x16, 0x29, 0xe6, 0x3e, 0x98, 0x6c, 0x62, 0xa8, 0x1f, 0x76, 0xea,
+	0xf2, 0x78, 0x17, 0x91, 0x44, 0xbb, 0x48, 0x27, 0x76, 0x74, 0x52, 0xd3, 0x71, 0x10, 0x3d, 0xe2,
+	0x84, 0x52, 0x4f, 0x30, 0x27, 0x84, 0x65, 0xb1, 0x9c, 0x55, 0x36, 0xc4, 0x22, 0x05, 0xbf, 0xfe,
+	0x47, 0xdf, 0x69, 0xe5, 0x6e, 0x05, 0xeb, 0xd9, 0xd2, 0x72, 0x9f, 0xd8, 0x1c, 0xd8, 0xfa, 0xaf,
+	0x06, 0xac, 0xf1, 0x63, 0x32, 0xf2, 0xb2, 0x5d, 0xd8, 0x68, 0xcb, 0x31, 0x8d, 0x33, 0x01, 0x83,
+	0x8d, 0x7c, 0xc0, 0xe0, 0xae, 0xf1, 0x11, 0xb3, 0x77, 0x05, 0xd6, 0x61, 0xc2, 0x0b, 0xbc, 0xc4,
+	0x73, 0x92, 0x30, 0x62, 0xe7, 0x87, 0x69, 0x02, 0x7e, 0x3c, 0x0e, 0x5f, 0x3d, 0x68, 0x27, 0x61,
+	0xfd, 0x18, 0x05, 0x48, 0x7a, 0x8b, 0x68, 0xdc, 0x9e, 0xf3, 0xe2, 0xed, 0x76, 0x12, 0xde, 0x13,
+	0xe9, 0x65, 0x17, 0x8d, 0x71, 0xb8, 0xed, 0xe5, 0x6c, 0xa3, 0x06, 0xb2, 0x6a, 0xfc, 0x8c, 0xb2,
+	0x6a, 0x5c, 0x91, 0x3d, 0x72, 0xe5, 0xca, 0xe9, 0x4a, 0xf1, 0x67, 0x86, 0x60, 0x8d, 0x39, 0x7c,
+	0x69, 0x19, 0xfe, 0x22, 0x98, 0xfc, 0xf5, 0x41, 0x96, 0x93, 0xae, 0xef, 0xe7, 0x5a, 0x4a, 0x91,
+	0x5d, 0x17, 0x77, 0x8f, 0xb4, 0x4e, 0xe0, 0x86, 0x3f, 0x29, 0x29, 0xf3, 0x00, 0x5d, 0x6a, 0xc6,
+	0xde, 0x82, 0x29, 0x1c, 0x43, 0xa5, 0x1d, 0x21, 0x1a, 0x8a, 0x9f, 0xc5, 0x65, 0x62, 0x69, 0xf8,
+	0xc6, 0x3c, 0x5e, 0x8c, 0x72, 0x10, 0xce, 0x15, 0xe6, 0x72, 0xc2, 0x92, 0x1f, 0x88, 0xb3, 0x90,
+	0x05, 0x0e, 0x28, 0x53, 0x43, 0x3b, 0xc4, 0x64, 0x59, 0xd2, 0x52, 0x84, 0x89, 0xc4, 0x98, 0xb8,
+	0xa5, 0xe0, 0x91, 0x7d, 0xd8, 0x79, 0x30, 0xa4, 0x6b, 0xf8, 0xb0, 0x3f, 0xa6, 0xc1, 0xe4, 0xd4,
+	0xba, 0xe2, 0xb3, 0x55, 0x56, 0x74, 0x83, 0xb3, 0xeb, 0xe5, 0xb8, 0xcc, 0xdd, 0xbb, 0xe1, 0xf2,
+	0xbb, 0x77, 0x23, 0x99, 0xbb, 0x77, 0xe2, 0x98, 0x72, 0x54, 0x7f, 0x4c, 0x39, 0xa6, 0x5c, 0xec,
+	0xfa, 0x06, 0xac, 0x64, 0x1a, 0xdb, 0xe5, 0x5c, 0xf2, 0x25, 0x75, 0xc5, 0x56, 0x28, 0xcf, 0x6c,
+	0x85, 0xf6, 0x4b, 0x06, 0x0d, 0x41, 0x98, 0x65, 0xe8, 0xa0, 0xee, 0x97, 0x48, 0x87, 0x94, 0x1b,
+	0xe2, 0x41, 0x0f, 0x5d, 0xf3, 0xd8, 0x49, 0xe5, 0x9f, 0x18, 0xf0, 0xac, 0x6e, 0xc0, 0x09, 0x93,
+	0xfc, 0x19, 0x3b, 0xbf, 0xf8, 0x4d, 0x85, 0xa1, 0x7e, 0xdf, 0x54, 0xa8, 0x68, 0xdf, 0x54, 0x30,
+	0x3f, 0x07, 0x55, 0xfa, 0xb4, 0x69, 0x4a, 0x0f, 0x71, 0x37, 0x49, 0xfd, 0x2e, 0x96, 0x68, 0x3e,
+	0xa7, 0xea, 0xae, 0xe7, 0xa3, 0x47, 0x91, 0x6f, 0xbd, 0x4f, 0x44, 0x3c, 0x7d, 0x87, 0xf5, 0xdc,
+	0xa2, 0x0c, 0x58, 0xff, 0x70, 0x08, 0x6a, 0x3a, 0xd4, 0x17, 0x6a, 0xb9, 0xca, 0xd6, 0x4e, 0xa0,
+	0x52, 0xeb, 0xf0, 0xf0, 0x66, 0xa5, 0x14, 0x9c, 0x82, 0x99, 0xc7, 0x50, 0x8d, 0x42, 0x02, 0x51,
+	0x8f, 0x50, 0x8c, 0xa2, 0x27, 0xa8, 0xce, 0xfc, 0x45, 0xf9, 0xb5, 0xb4, 0x97, 0x0a, 0x51, 0xd0,
+	0x72, 0x36, 0x2d, 0x66, 0xd3, 0x52, 0xf6, 0x72, 0xa4, 0x4b, 0x8e, 0xad, 0xbf, 0x47, 0x6f, 0xe1,
+	0x48, 0xd2, 0xb3, 0xe7, 0x1e, 0x3d, 0x6a, 0xf9, 0xa1, 0xe3, 0x22, 0xb7, 0x24, 0xf4, 0x14, 0xee,
+	0xeb, 0x6c, 0x74, 0x02, 0xfc, 0x8d, 0x57, 0x3d, 0x11, 0x4a, 0x50, 0x40, 0xbc, 0xd3, 0x12, 0x8f,
+	0xf9, 0x21, 0x8e, 0xd8, 0xd3, 0x22, 0x15, 0x9f, 0xb0, 0x13, 0x1d, 0x42, 0xc5, 0x59, 0xc4, 0xee,
+	0x18, 0xa7, 0x09, 0xfc, 0x85, 0x1a, 0x1a, 0xe1, 0x60, 0x44, 0x89, 0x70, 0x60, 0x7d, 0x04, 0x5b,
+	0x25, 0xd4, 0x0e, 0x24, 0x56, 0xdb, 0x4f, 0x1a, 0x50, 0x95, 0x17, 0x21, 0xb7, 0x9c, 0xa4, 0x71,
+	0x22, 0x39, 0xa7, 0x63, 0x6f, 0x42, 0x27, 0xe1, 0xe7, 0xb0, 0xf4, 0xab, 0x4c, 0x4c, 0xd9, 0x7b,
+	0x8f, 0x99, 0x91, 0x1c, 0xb3, 0x08, 0x6e, 0xf3, 0xd9, 0xa1, 0x1c, 0x67, 0x9f, 0x96, 0xb2, 0x5e,
+	0x25, 0xde, 0x60, 0x1a, 0x62, 0x56, 0x61, 0xfc, 0x10, 0x7f, 0x4b, 0x6f, 0xfe, 0x90, 0x6f, 0xea,
+	0xf0, 0xb5, 0xa0, 0x94, 0x18, 0xc8, 0x98, 0xb8, 0xae, 0x8c, 0x89, 0xc5, 0x8c, 0x6e, 0xa6, 0x35,
+	0xd3, 0x85, 0xc6, 0x09, 0x5c, 0xa1, 0xb1, 0x33, 0x68, 0x16, 0x77, 0xa9, 0xc8, 0xdc, 0x01, 0x4d,
+	0x63, 0x60, 0x18, 0xc5, 0x31, 0x30, 0x86, 0x14, 0x09, 0xc9, 0xdd, 0x31, 0xfc, 0x4f, 0x06, 0x6c,
+	0x16, 0x57, 0x35, 0x10, 0x36, 0x98, 0x30, 0x8c, 0x1d, 0xa8, 0x99, 0xe0, 0x93, 0xdf, 0x44, 0x50,
+	0x48, 0xc4, 0x37, 0x1e, 0x48, 0x88, 0x7e, 0xe1, 0x86, 0xc4, 0xa7, 0x5e, 0xab, 0x85, 0x5c, 0x76,
+	0x13, 0x9a, 0x7f, 0xe2, 0x95, 0x68, 0x3b, 0x68, 0x62, 0x9e, 0x21, 0x97, 0x45, 0x98, 0x4c, 0x13,
+	0xac, 0x87, 0xb0, 0xc0, 0xa2, 0xc3, 0x35, 0x90, 0xd7, 0xea, 0xe5, 0x61, 0xb3, 0x0d, 0x80, 0x88,
+	0x02, 0x4b, 0x2e, 0x81, 0x2c, 0x65, 0xd7, 0xc5, 0x2f, 0xad, 0x2c, 0xaa, 0x18, 0x07, 0xe4, 0x99,
+	0x32, 0xc6, 0xea, 0xcc, 0x39, 0x46, 0x28, 0xb5, 0x73, 0x28, 0x1c, 0x6a, 0xd6, 0x4c, 0x83, 0x08,
+	0x0f, 0x88, 0xaa, 0x2d, 0x45, 0x70, 0x33, 0xe1, 0x66, 0xa9, 0xc4, 0xfe, 0x75, 0x7a, 0x12, 0xae,
+	0xbb, 0xda, 0x32, 0x20, 0xf7, 0x91, 0x11, 0x52, 0x0d, 0xa3, 0x69, 0x41, 0xbd, 0x00, 0x42, 0xb2,
+	0x6c, 0x0a, 0x61, 0xbd, 0x01, 0xeb, 0x05, 0x94, 0x95, 0x5f, 0xba, 0xf9, 0x3e, 0xb0, 0xd4, 0x17,
+	0x7f, 0x1e, 0x38, 0xd8, 0x2f, 0x9c, 0x8e, 0x93, 0xb8, 0x9f, 0xf7, 0x9b, 0x9a, 0xa4, 0x20, 0x7b,
+	0x6a, 0x3c, 0xae, 0xf3, 0xe0, 0xed, 0x6c, 0xad, 0xd1, 0x94, 0xd1, 0xde, 0xa1, 0x79, 0xf8, 0xc5,
+	0xfe, 0xab, 0xa5, 0xb5, 0x7f, 0x9a, 0xcf, 0x0e, 0xfd, 0xa1, 0x01, 0xcf, 0xaa, 0xc4, 0x51, 0xb2,
+	0xe4, 0x8b, 0x5c, 0x3d, 0x72, 0x87, 0x5c, 0xb3, 0x20, 0xda, 0xbe, 0x21, 0x0a, 0xb3, 0x15, 0xf9,
+	0x5c, 0x2b, 0x83, 0xd4, 0x7c, 0x0f, 0x96, 0x54, 0xe0, 0x4e, 0x3d, 0x6a, 0xfb, 0xe2, 0x29, 0x84,
+	0xab, 0xba, 0x27, 0x60, 0x53, 0x0c, 0x1d, 0xbb, 0xed, 0x23, 0x7b, 0xa1, 0x95, 0x4b, 0x23, 0xeb,
+	0xe0, 0xe7, 0xba, 0x35, 0xe8, 0xd3, 0x64, 0xf8, 0x0f, 0xb1, 0x51, 0xee, 0x74, 0x7c, 0x2f, 0x38,
+	0x8d, 0xcf, 0x29, 0x8c, 0x70, 0x9f, 0x41, 0xdd, 0xf6, 0x60, 0x8e, 0x13, 0xd0, 0x65, 0x17, 0x92,
+	0x8b, 0xe7, 0xd6, 0xa2, 0xe5, 0x6e, 0xb0, 0xf2, 0x7c, 0xfb, 0xf1, 0x53, 0xf4, 0x35, 0xde, 0xb4,
+	0x59, 0x83, 0x8a, 0x14, 0x21, 0xed, 0x3b, 0x96, 0xc5, 0xbe, 0x43, 0x69, 0x09, 0xdb, 0x70, 0x3c,
+	0x03, 0x26, 0xa7, 0x91, 0xf2, 0xf8, 0x56, 0x87, 0x59, 0x41, 0xe4, 0x08, 0xd5, 0xdb, 0x30, 0xa3,
+	0x42, 0xf5, 0x1f, 0x09, 0xfb, 0x47, 0x95, 0x1e, 0xbd, 0xa0, 0x08, 0xd5, 0xd9, 0x6e, 0xa0, 0xc2,
+	0xf5, 0x47, 0x06, 0x79, 0xf2, 0x8e, 0x25, 0x3e, 0xb2, 0xef, 0x9f, 0xb5, 0x51, 0x78, 0x7a, 0x6d,
+	0x47, 0x7e, 0xbd, 0xe9, 0xc4, 0xa7, 0x3c, 0xf6, 0x5d, 0x3b, 0xf2, 0x1f, 0x38, 0xf1, 0x29, 0x96,
+	0xc4, 0x76, 0xd2, 0xac, 0x53, 0xd3, 0x15, 0xb7, 0x1c, 0xb5, 0x93, 0x26, 0x35, 0x6d, 0xf1, 0xec,
+	0x26, 0x72, 0xbd, 0x76, 0x53, 0xbc, 0x5f, 0x9a, 0x34, 0x1f, 0x90, 0x04, 0x6c, 0xf0, 0xc0, 0xd9,
+	0x0d, 0xa7, 0xd9, 0x72, 0xbc, 0x63, 0x6e, 0x9d, 0x98, 0x6c, 0x27, 0xcd, 0x1d, 0x96, 0x94, 0x7a,
+	0x6e, 0x8f, 0xc9, 0x9e, 0xdb, 0x4f, 0x61, 0x49, 0x6a, 0xda, 0xc0, 0x7c, 0xc9, 0x72, 0x0e, 0xf5,
+	0xd6, 0x8f, 0x1b, 0xb0, 0x96, 0xd6, 0x8c, 0x57, 0x55, 0xf8, 0xe5, 0xee, 0x30, 0x38, 0x33, 0x6f,
+	0x33, 0x66, 0x87, 0x4a, 0xb9, 0xd9, 0x61, 0x58, 0x35, 0x3b, 0x58, 0xff, 0xce, 0x80, 0x75, 0x3d,
+	0x39, 0x03, 0xe1, 0xc7, 0x35, 0x45, 0xf0, 0x16, 0x84, 0xe0, 0x49, 0x15, 0x13, 0x00, 0x7c, 0x09,
+	0x92, 0x74, 0x14, 0xdf, 0xf9, 0x2d, 0x65, 0x65, 0x94, 0x86, 0xda, 0x66, 0x40, 0x38, 0x22, 0xc6,
+	0x96, 0xae, 0x19, 0xec, 0x4a, 0xee, 0x85, 0xec, 0x64, 0x39, 0x81, 0xa4, 0xce, 0x6c, 0x83, 0x70,
+	0x58, 0x81, 0x9a, 0xd8, 0x0e, 0xa5, 0x0d, 0xb8, 0x18, 0x1f, 0x5a, 0x2d, 0xef, 0x28, 0xa3, 0xc5,
+	0xf0, 0x18, 0x96, 0x87, 0xc7, 0x3f, 0x37, 0xe0, 0x8a, 0x12, 0x36, 0xe3, 0x62, 0x76, 0xb7, 0x2f,
+	0xc0, 0x7c, 0x44, 0x6b, 0x95, 0x6e, 0x4e, 0xb0, 0x4b, 0x5d, 0x91, 0x4c, 0x4e, 0xe9, 0x66, 0xb7,
+	0x05, 0x9b, 0xc5, 0xb4, 0x0f, 0x64, 0xaf, 0xfb, 0x25, 0x58, 0xbe, 0x4d, 0x9e, 0x6d, 0xd9, 0x77,
+	0x9e, 0x20, 0x77, 0xc7, 0x89, 0x0a, 0x99, 0xb4, 0x0c, 0xa3, 0x8d, 0x30, 0x3c, 0xf5, 0x38, 0x8b,
+	0xd8, 0x97, 0xf5, 0x1e, 0xac, 0x92, 0x75, 0xfc, 0x76, 0x3b, 0x39, 0x09, 0x23, 0x7e, 0x75, 0xaf,
+	0xfb, 0xee, 0xa4, 0xeb, 0x7c, 0x22, 0x5e, 0x5c, 0xcc, 0x60, 0xfe, 0xd4, 0x62, 0x4f, 0x63, 0xff,
+	0x1c, 0xf9, 0x8e, 0xec, 0x9e, 0xef, 0x04, 0x17, 0xec, 0x8d, 0x94, 0xab, 0x9e, 0x52, 0xf6, 0x98,
+	0x98, 0xc1, 0xf2, 0xb4, 0x89, 0xfb, 0x45, 0x2d, 0xdf, 0x91, 0x22, 0xfe, 0x8d, 0xe2, 0xcf, 0x5e,
+	0xba, 0xe0, 0xc7, 0x0d, 0xea, 0x19, 0x99, 0xc5, 0xfc, 0x29, 0x2d, 0xd7, 0x7e, 0x8f, 0xc5, 0x86,
+	0xd4, 0x90, 0x73, 0x81, 0x11, 0x03, 0x6f, 0xaa, 0x96, 0xbd, 0x92, 0xce, 0x61, 0x6b, 0xc2, 0x16,
+	0xac, 0x52, 0xfd, 0x29, 0x03, 0xf4, 0xf3, 0x08, 0x2f, 0xef, 0xbd, 0x21, 0xa5, 0xf7, 0x8a, 0xc3,
+	0x77, 0x5b, 0x4f, 0x61, 0x41, 0x57, 0xd7, 0x35, 0x98, 0x95, 0xef, 0x7e, 0xa7, 0xf5, 0xcd, 0xc8,
+	0xc9, 0x3d, 0x08, 0x86, 0x14, 0x62, 0xaa, 0xa2, 0x44, 0x59, 0xc3, 0x37, 0x2d, 0xd4, 0x9a, 0x2f,
+	0xf4, 0xa6, 0x85, 0x52, 0x35, 0x1d, 0x1a, 0xff, 0xd1, 0xe0, 0x17, 0xef, 0x76, 0x84, 0x1f, 0x75,
+	0x6f, 0xda, 0x09, 0x7d, 0x4c, 0xae, 0xde, 0xfb, 0x12, 0x07, 0x78, 0x92, 0x12, 0x6b, 0xb8, 0xa2,
+	0x78, 0x73, 0x5c, 0x81, 0x49, 0xca, 0x0b, 0xf9, 0x1c, 0x0b, 0x68, 0x12, 0x39, 0xc6, 0x2a, 0x8a,
+	0xce, 0xf5, 0x02, 0xcc, 0xa3, 0x27, 0x9e, 0x8b, 0x82, 0x06, 0xaa, 0xbb, 0x6d, 0x44, 0xde, 0x41,
+	0x20, 0xab, 0xc2, 0x8a, 0x3d, 0xcb, 0x33, 0x6e, 0xb7, 0xb1, 0xc7, 0x3e, 0xb2, 0xbe, 0x02, 0xf3,
+	0xf9, 0xd6, 0x60, 0x17, 0x26, 0x91, 0x98, 0x36, 0x69, 0x2a, 0x4d, 0xec, 0x65, 0xc8, 0x7f, 0xcb,
+	0x00, 0x53, 0xc6, 0x7d, 0x21, 0x8b, 0x29, 0x71, 0x0f, 0x28, 0xad, 0x98, 0xf6, 0xde, 0xb7, 0x0d,
+	0xea, 0x84, 0x9b, 0x66, 0xf4, 0xae, 0x7a, 0x4a, 0xc2, 0x4c, 0xcb, 0xa7, 0x90, 0xda, 0x68, 0x90,
+	0x3d, 0x85, 0x30, 0xfd, 0x3b, 0x06, 0xac, 0xe4, 0x88, 0xbb, 0x40, 0x45, 0xf4, 0xbc, 0xaa, 0x88,
+	0xb4, 0x6c, 0x64, 0x2a, 0xe8, 0x9f, 0x19, 0x70, 0x85, 0xae, 0x24, 0xd2, 0xbc, 0x3b, 0x4c, 0xa8,
+	0xce, 0x55, 0x7e, 0x54, 0x23, 0x6e, 0x25, 0x63, 0xc4, 0x5d, 0x85, 0xf1, 0xcc, 0x91, 0xd1, 0xd8,
+	0x11, 0x3d, 0x24, 0xa2, 0x8b, 0xa2, 0x66, 0x93, 0x2f, 0x8a, 0x26, 0x6c, 0xfe, 0x89, 0xfd, 0xb0,
+	0x77, 0xfc, 0x30, 0x46, 0x67, 0x94, 0xf8, 0x25, 0x18, 0xf5, 0xe2, 0xfa, 0x53, 0x11, 0x86, 0x63,
+	0xc4, 0x8b, 0xdf, 0x0b, 0x03, 0xeb, 0x3e, 0xcc, 0xdd, 0x8d, 0x9c, 0xb6, 0x4b, 0x2c, 0x28, 0xe9,
+	0x44, 0x89, 0x8d, 0x2f, 0xd2, 0x44, 0x89, 0x3f, 0x7b, 0x19, 0x35, 0x3f, 0x66, 0xc0, 0xbc, 0x84,
+	0x6e, 0x20, 0x42, 0xf0, 0x9c, 0x32, 0x68, 0xd2, 0xfb, 0x99, 0x69, 0xbd, 0x74, 0xcc, 0xbc, 0x07,
+	0x4b, 0x58, 0x2a, 0x45, 0xf2, 0x79, 0x4d, 0xd6, 0xd6, 0x4f, 0xb3, 0xc1, 0x28, 0x63, 0x1e, 0xd0,
+	0xa9, 0x82, 0x72, 0x4f, 0x50, 0xd7, 0x54, 0x26, 0xd7, 0x98, 0xef, 0xe9, 0x03, 0xfd, 0x17, 0xcb,
+	0xf7, 0xb4, 0x5e, 0x85, 0xef, 0x22, 0xf9, 0xdc, 0xf9, 0x2e, 0x63, 0xbe, 0x58, 0xbe, 0xa7, 0x4d,
+	0x65, 0x7c, 0xff, 0xb2, 0x88, 0xe4, 0x77, 0x37, 0x8c, 0x9a, 0xdb, 0xad, 0x96, 0xdf, 0x49, 0x61,
+	0xba, 0xcf, 0xae, 0xfc, 0xf9, 0x81, 0xa1, 0xf4, 0xf9, 0x01, 0xe2, 0xb3, 0x8c, 0x5b, 0xf9, 0x1e,
+	0x3a, 0x3c, 0x09, 0xc3, 0xd3, 0xdb, 0xc8, 0xf7, 0x9e, 0xa0, 0xc8, 0x3b, 0x37, 0x36, 0x96, 0x3d,
+	0x77, 0x72, 0x3e, 0xd1, 0x81, 0x7f, 0x97, 0x2d, 0x4f, 0x35, 0x2d, 0xb8, 0xc0, 0x59, 0xa1, 0xf0,
+	0xe0, 0x59, 0x25, 0xab, 0xc3, 0xbb, 0xf2, 0xab, 0xb0, 0x9c, 0xcd, 0x49, 0x19, 0xee, 0xb2, 0x24,
+	0x89, 0xe1, 0x3c, 0xa9, 0x17, 0xb5, 0xf8, 0x73, 0x06, 0xac, 0xe4, 0x90, 0x5f, 0xe8, 0xe1, 0x7c,
+	0xb6, 0x76, 0x3a, 0x54, 0x7f, 0xdb, 0x80, 0x85, 0x3b, 0x1f, 0xe7, 0x03, 0x18, 0xbf, 0x02, 0x78,
+	0x3e, 0x4a, 0x50, 0x14, 0x33, 0x17, 0xba, 0x95, 0xfc, 0xd5, 0x19, 0x02, 0x69, 0x73, 0x38, 0xe9,
+	0x3c, 0x78, 0x48, 0x39, 0x0f, 0x26, 0xf3, 0x99, 0xdf, 0x6e, 0x06, 0x7c, 0x8d, 0xc1, 0x3f, 0x71,
+	0x09, 0x3f, 0x6c, 0x38, 0x3e, 0x5f, 0x1c, 0xb2, 0x2f, 0x9c, 0xde, 0x68, 0x47, 0x71, 0x18, 0xf1,
+	0x85, 0x21, 0xfd, 0xc2, 0x3e, 0xec, 0xf3, 0x32, 0xb1, 0x3b, 0x27, 0xed, 0xe0, 0xf4, 0x9c, 0xd9,
+	0x57, 0xfc, 0xc2, 0x40, 0x4a, 0xd5, 0xb0, 0x4c, 0x15, 0x1e, 0xc2, 0x38, 0xe0, 0x11, 0x1b, 0x19,
+	0xe4, 0xb7, 0xf5, 0x87, 0x43, 0x30, 0x4b, 0x69, 0xa4, 0xf4, 0xbe, 0x1d, 0x1e, 0xf6, 0x6f, 0x15,
+	0x4c, 0x19, 0x5a, 0x51, 0x18, 0x2a, 0x8f, 0x51, 0xd9, 0x73, 0x4c, 0x59, 0x70, 0x8c, 0x64, 0x16,
+	0x1c, 0x3c, 0x33, 0xf6, 0xbe, 0xc9, 0x17, 0xd3, 0x24, 0x73, 0xdf, 0xfb, 0x26, 0x12, 0x4d, 0x18,
+	0x4b, 0x9b, 0x80, 0xc7, 0x14, 0xc2, 0x1c, 0x62, 0xe1, 0x60, 0xe8, 0xc7, 0x27, 0x09, 0x02, 0xf3,
+	0x05, 0x98, 0x3c, 0xf2, 0x02, 0xf1, 0x0e, 0x79, 0xf7, 0x28, 0x30, 0xc0, 0xc1, 0xb7, 0x13, 0x6b,
+	0x17, 0x96, 0x33, 0xfc, 0x3c, 0xb3, 0x75, 0xfe, 0x67, 0x0c, 0x58, 0xc9, 0xe1, 0xba, 0x90, 0xcd,
+	0x19, 0x29, 0x92, 0xad, 0x9a, 0x80, 0xbc, 0xfa, 0xc3, 0x3f, 0x6e, 0xc0, 0xcc, 0x2d, 0x3a, 0x54,
+	0xf7, 0x51, 0x44, 0x02, 0x6c, 0x7d, 0x85, 0x9d, 0x4a, 0xd3, 0x4d, 0xdb, 0xad, 0x0e, 0xb3, 0xfc,
+	0x99, 0x1b, 0xaa, 0x4d, 0x26, 0x93, 0x5d, 0xdb, 0x94, 0xea, 0xa1, 0x79, 0x2c, 0x20, 0x04, 0x27,
+	0xd6, 0xba, 0x64, 0x3e, 0x02, 0x33, 0x9f, 0x6f, 0xae, 0xe9, 0x10, 0x33, 0x3e, 0xf7, 0x84, 0xb6,
+	0x01, 0x35, 0x69, 0x32, 0x7c, 0x3b, 0x0e, 0x03, 0x7c, 0xad, 0x9a, 0xa3, 0xdf, 0x14, 0xa7, 0x33,
+	0x59, 0x08, 0x5e, 0xc7, 0x56, 0x09, 0x84, 0xa8, 0xe4, 0xcb, 0xb0, 0xc8, 0x00, 0x54, 0xea, 0x6b,
+	0x4a, 0x61, 0x95, 0xf8, 0x35, 0x6d, 0x9e, 0x40, 0xb9, 0x0f, 0xcb, 0x3c, 0xcb, 0xf1, 0x7d, 0xbc,
+	0x5c, 0xd6, 0x23, 0x55, 0x5e, 0x5a, 0xab, 0xad, 0x69, 0xf3, 0x04, 0xd2, 0x7b, 0x30, 0x25, 0x1f,
+	0xeb, 0x9b, 0xab, 0x12, 0x03, 0x55, 0xd7, 0x85, 0x5a, 0x4d, 0x97, 0x25, 0x10, 0xbd, 0x0e, 0x93,
+	0xd4, 0x4f, 0x8e, 0xe4, 0x9b, 0x19, 0x93, 0x5c, 0x6d, 0x81, 0x16, 0xbe, 0xd3, 0x6c, 0x25, 0x72,
+	0xf5, 0x6f, 0xc2, 0x0c, 0x2d, 0x25, 0x5e, 0x74, 0xcd, 0x9f, 0x40, 0x16, 0x95, 0x7d, 0x0b, 0xa6,
+	0x65, 0x67, 0xb2, 0x0e, 0x67, 0x83, 0x92, 0xc8, 0x89, 0x5f, 0xcd, 0xe5, 0x49, 0x98, 0xde, 0x85,
+	0x69, 0x3c, 0x65, 0xf0, 0x9c, 0xd8, 0x64, 0xee, 0xcf, 0x72, 0x48, 0xd4, 0xf4, 0x7d, 0xbe, 0xda,
+	0x46, 0x41, 0xae, 0xc0, 0xb7, 0x0b, 0x33, 0xea, 0x59, 0xae, 0xc9, 0x26, 0xa6, 0x34, 0xf0, 0x01,
+	0xc7, 0xb5, 0x2e, 0x07, 0x6c, 0xd7, 0x90, 0x56, 0x87, 0x45, 0x35, 0x6f, 0x9f, 0x39, 0xe0, 0xaa,
+	0x34, 0x50, 0x18, 0x25, 0xf0, 0x49, 0xcd, 0xd2, 0xa1, 0xe6, 0x20, 0xa2, 0x82, 0xaf, 0x80, 0xa9,
+	0x42, 0x60, 0x41, 0x36, 0xaf, 0xe8, 0xca, 0xca, 0x83, 0x60, 0xb3, 0x18, 0x40, 0xa0, 0x76, 0x60,
+	0x59, 0xba, 0x0a, 0xbe, 0xff, 0x9a, 0x88, 0x67, 0x60, 0xb2, 0xbb, 0x71, 0xfa, 0xdc, 0x7e, 0xaa,
+	0x40, 0xe4, 0x1a, 0xac, 0xe6, 0xb6, 0x39, 0xaf, 0xa2, 0x34, 0x36, 0x42, 0xed, 0x99, 0x5e, 0xae,
+	0xb7, 0x5b, 0x97, 0xcc, 0x53, 0xa5, 0x25, 0x9a, 0x6a, 0x4a, 0xaf, 0xbc, 0xd7, 0x5e, 0xe0, 0x2d,
+	0xe9, 0xfe, 0x8e, 0xa9, 0x75, 0xc9, 0x4c, 0xf8, 0xa3, 0x6e, 0x5a, 0xbf, 0x0b, 0xf3, 0xba, 0x8e,
+	0x2d, 0x3a, 0xc7, 0x90, 0xda, 0xf3, 0x3d, 0x40, 0x8a, 0x5a, 0xff, 0x02, 0x5c, 0x2e, 0xf7, 0x3f,
+	0x30, 0x3f, 0xa3, 0x43, 0x57, 0xe0, 0x76, 0x51, 0x7b, 0xb1, 0x37, 0x60, 0x49, 0xb9, 0x99, 0x54,
+	0xe1, 0x29, 0xcf, 0x3a, 0xb2, 0x51, 0x2b, 0xa7, 0x65, 0xa5, 0x23, 0x57, 0x48, 0xd1, 0x10, 0xf8,
+	0x91, 0x4e, 0x05, 0xe3, 0xba, 0xe8, 0x71, 0x1d, 0xd2, 0xd4, 0x34, 0x29, 0xe7, 0x5a, 0x97, 0xcc,
+	0xfb, 0xf4, 0x39, 0x58, 0x39, 0x55, 0x4c, 0x15, 0x6c, 0xfc, 0xeb, 0xf0, 0x2d, 0xe4, 0xe9, 0x8f,
+	0xad, 0x4b, 0xe6, 0x43, 0x58, 0x7e, 0xe0, 0x44, 0xa7, 0x72, 0xf2, 0x36, 0x0d, 0x76, 0x76, 0x46,
+	0xf2, 0x4e, 0xe9, 0x4b, 0x24, 0x12, 0x9f, 0xd3, 0xc7, 0xfb, 0x62, 0xf3, 0x5a, 0x4a, 0xa7, 0x1e,
+	0x22, 0xa3, 0x31, 0x58, 0x83, 0xb4, 0xb0, 0xa4, 0xb2, 0xaa, 0x34, 0x60, 0x94, 0x5c, 0xf3, 0xd9,
+	0xdc, 0x80, 0xd2, 0x3d, 0xcd, 0x58, 0x7b, 0xae, 0x1b, 0x98, 0xe8, 0xc2, 0x93, 0xec, 0x60, 0x50,
+	0xeb, 0xb3, 0x54, 0x35, 0xa8, 0xad, 0xec, 0x6a, 0x29, 0x8c, 0x3c, 0x13, 0xf2, 0x29, 0x97, 0x84,
+	0x01, 0x59, 0x95, 0x05, 0x8c, 0xa6, 0x65, 0x66, 0x42, 0x35, 0x4b, 0x20, 0xba, 0x0d, 0x93, 0x34,
+	0x96, 0x37, 0x4e, 0x8f, 0xcd, 0x6a, 0xca, 0x54, 0x96, 0x94, 0x99, 0x93, 0x94, 0x1c, 0x81, 0x65,
+	0x1b, 0x26, 0xee, 0x21, 0x96, 0x6e, 0x2e, 0x0b, 0x7e, 0xf5, 0x43, 0xc8, 0x03, 0x58, 0x62, 0x2b,
+	0x04, 0x9a, 0xc5, 0x97, 0x0d, 0x26, 0x5b, 0xe4, 0xf1, 0xef, 0x1e, 0x97, 0x0a, 0xfb, 0x30, 0xb5,
+	0xe3, 0xb4, 0xb0, 0xb2, 0xa2, 0x53, 0xfc, 0x15, 0x69, 0x3d, 0xa0, 0x3b, 0x4d, 0xac, 0x6d, 0x16,
+	0x03, 0x08, 0xa4, 0x7b, 0x30, 0xf1, 0x38, 0xf4, 0xdc, 0x73, 0xc4, 0xf8, 0x00, 0xe6, 0xb2, 0x07,
+	0x08, 0xe6, 0x86, 0xcc, 0xa7, 0x9c, 0x61, 0xb2, 0x56, 0x15, 0xca, 0x2a, 0x63, 0x47, 0x27, 0xbd,
+	0x89, 0x57, 0x19, 0x12, 0xae, 0x95, 0x3c, 0x70, 0x77, 0x2c, 0x7b, 0x30, 0x9b, 0x31, 0x3d, 0x9b,
+	0x52, 0x98, 0x87, 0xbc, 0xb9, 0xbc, 0xb6, 0x51, 0x90, 0x2b, 0x30, 0x7e, 0x00, 0xd5, 0x22, 0x0b,
+	0x31, 0x1f, 0x85, 0x5d, 0x2c, 0xc8, 0xa5, 0x04, 0x1f, 0xc0, 0xc6, 0x7e, 0xfb, 0xb0, 0xe9, 0x25,
+	0x72, 0x6e, 0x2b, 0x42, 0x31, 0x0a, 0x12, 0x32, 0x85, 0x9f, 0x89, 0x0d, 0xef, 0xc0, 0x6c, 0xc6,
+	0x36, 0xcc, 0xd9, 0xa0, 0x37, 0x19, 0x97, 0x22, 0xfb, 0x00, 0x2e, 0xd3, 0x0e, 0x7d, 0x18, 0xd1,
+	0x35, 0x64, 0xf6, 0x40, 0xcf, 0x2c, 0x3e, 0xeb, 0xab, 0x5d, 0x66, 0xf3, 0x76, 0xc1, 0xf9, 0x30,
+	0x59, 0x18, 0x2d, 0x68, 0x0e, 0x69, 0xb9, 0xd2, 0x2f, 0x3e, 0xbf, 0xed, 0x01, 0xf5, 0x21, 0x35,
+	0x3d, 0x66, 0x21, 0x62, 0x53, 0x52, 0xc0, 0x45, 0x67, 0xb8, 0xb5, 0xab, 0xa5, 0x30, 0xf9, 0x09,
+	0x55, 0x06, 0x12, 0xeb, 0xba, 0xa2, 0xf3, 0x4d, 0xae, 0x51, 0x74, 0x87, 0x82, 0xd6, 0x25, 0xf3,
+	0x6d, 0x32, 0xa1, 0x2a, 0x18, 0x57, 0x75, 0x05, 0x7a, 0xc1, 0x75, 0x1f, 0xe6, 0xf7, 0x9c, 0x76,
+	0x8c, 0xce, 0x07, 0xdb, 0x03, 0x30, 0x6d, 0x14, 0xb7, 0x9b, 0xe7, 0x87, 0x6e, 0xc7, 0x09, 0x1a,
+	0xc8, 0x3f, 0x1f, 0x74, 0x6f, 0xc1, 0x8a, 0x2a, 0xaa, 0xc2, 0x32, 0x6e, 0x6a, 0xac, 0xe5, 0x35,
+	0x36, 0xb6, 0x72, 0x27, 0x14, 0x84, 0xb0, 0x19, 0xd5, 0xa6, 0x6f, 0xae, 0xa5, 0xf2, 0x90, 0x3b,
+	0x43, 0xa8, 0xad, 0xeb, 0x33, 0x25, 0xed, 0x36, 0x4b, 0xfd, 0x49, 0x52, 0x82, 0x96, 0x73, 0x95,
+	0x53, 0x54, 0x25, 0x44, 0xe5, 0x9a, 0x27, 0x8c, 0xcb, 0xa6, 0xc6, 0x28, 0x5d, 0x13, 0x4f, 0x29,
+	0x64, 0x0e, 0x02, 0xd2, 0xe6, 0x89, 0x2c, 0xa5, 0x79, 0x39, 0x53, 0x7d, 0x6d, 0x5d, 0x9f, 0x99,
+	0x1d, 0x68, 0x39, 0x0b, 0xaf, 0x3c, 0xd0, 0x8a, 0x0c, 0xd8, 0xb5, 0xab, 0xa5, 0x30, 0xa2, 0x8e,
+	0xc7, 0xb0, 0x64, 0x63, 0xb5, 0xe8, 0xaa, 0x40, 0x1d, 0xae, 0xd9, 0xf4, 0xd6, 0xda, 0xda, 0x46,
+	0x41, 0xae, 0xb4, 0xe7, 0x90, 0xcd, 0x14, 0xf7, 0x9d, 0xe0, 0xb8, 0xed, 0x1c, 0x23, 0xba, 0x18,
+	0x72, 0xcd, 0xe7, 0x72, 0x46, 0x08, 0x1c, 0x49, 0x96, 0xe6, 0x62, 0xd8, 0x4c, 0x23, 0x24, 0x38,
+	0xbc, 0x83, 0xa2, 0x70, 0x52, 0x65, 0xdf, 0xa7, 0x1c, 0x10, 0xb0, 0x9f, 0xec, 0x15, 0x5a, 0x5e,
+	0xe5, 0xcb, 0x25, 0x55, 0xaa, 0x25, 0xfa, 0xac, 0xfc, 0x50, 0xac, 0x53, 0x98, 0x6d, 0x69, 0xdb,
+	0x75, 0x23, 0x14, 0x8b, 0x5e, 0xd2, 0x66, 0x66, 0xeb, 0xd0, 0xc3, 0x88, 0x3a, 0x3e, 0x84, 0xd5,
+	0xc2, 0x13, 0x10, 0x0d, 0x33, 0xb5, 0x47, 0x24, 0xbd, 0xd6, 0xf5, 0x39, 0x58, 0xca, 0x0d, 0x07,
+	0x7c, 0x51, 0xc1, 0x54, 0xef, 0x2d, 0xd4, 0xd4, 0x4f, 0xba, 0x04, 0x65, 0xa2, 0x8c, 0x13, 0x62,
+	0x73, 0x55, 0x11, 0x6f, 0x92, 0x96, 0xd1, 0x37, 0x6a, 0x96, 0xa0, 0xe0, 0x7b, 0x00, 0xd2, 0x2b,
+	0x19, 0xe6, 0x22, 0x37, 0x03, 0x92, 0xa2, 0xbc, 0xf6, 0xaa, 0x98, 0xc8, 0x32, 0x57, 0x37, 0xac,
+	0x4b, 0xe6, 0x77, 0xc3, 0x34, 0x55, 0x0b, 0xe5, 0x28, 0x0a, 0x0c, 0x33, 0x8f, 0xa8, 0x4f, 0x35,
+	0x23, 0xeb, 0x6e, 0x18, 0xd1, 0xd5, 0xdd, 0x66, 0xb6, 0x42, 0x91, 0xd5, 0x5b, 0xa3, 0xee, 0xc1,
+	0x5c, 0x5a, 0x96, 0x3e, 0x74, 0x51, 0x40, 0xd7, 0x9a, 0xc2, 0x58, 0xf5, 0xb9, 0x0d, 0x62, 0xee,
+	0x59, 0xd0, 0x3c, 0x9a, 0xc1, 0xe9, 0x2b, 0x7e, 0x4f, 0x83, 0xb7, 0x57, 0xb1, 0xa7, 0x5a, 0x97,
+	0xcc, 0x2f, 0xc1, 0x34, 0x1b, 0x05, 0x74, 0x6f, 0x6f, 0xce, 0xc9, 0x4a, 0xef, 0x43, 0x94, 0x52,
+	0xa4, 0x80, 0x29, 0x6a, 0x98, 0xf5, 0x17, 0x29, 0xbe, 0x22, 0x33, 0x4a, 0x32, 0x16, 0x74, 0xc3,
+	0x92, 0x8a, 0xcf, 0x87, 0x28, 0x2f, 0x3e, 0x24, 0x4d, 0xcf, 0x69, 0x96, 0x25, 0x21, 0x4a, 0xbb,
+	0xff, 0x13, 0x51, 0xf4, 0x79, 0x98, 0xa4, 0x23, 0x81, 0x06, 0x67, 0x37, 0x29, 0x34, 0xf9, 0xc8,
+	0xf0, 0x54, 0x71, 0x52, 0xb5, 0x2e, 0x99, 0x4f, 0x61, 0xbd, 0xcc, 0x5c, 0x62, 0x3e, 0xdf, 0x8b,
+	0x49, 0xe5, 0x2c, 0xd6, 0x97, 0x2f, 0x11, 0x29, 0xa3, 0xa1, 0x15, 0x3c, 0x14, 0x63, 0x0e, 0x99,
+	0xa6, 0x22, 0xe7, 0x14, 0xeb, 0x72, 0x26, 0x1a, 0x2e, 0x83, 0x25, 0x83, 0x07, 0x04, 0x06, 0x6c,
+	0x94, 0xe4, 0x70, 0x69, 0x22, 0xc7, 0x31, 0x97, 0xc1, 0xd1, 0xb1, 0x2e, 0x99, 0x9f, 0x85, 0x69,
+	0x2a, 0x81, 0x1c, 0x41, 0x0e, 0x48, 0x5b, 0xec, 0x03, 0xa8, 0xed, 0xe3, 0x7b, 0x67, 0x6d, 0x1f,
+	0xe5, 0xa3, 0xf3, 0x9a, 0x65, 0xa1, 0x7b, 0x85, 0x25, 0xa5, 0x30, 0xf6, 0x31, 0xb1, 0xb3, 0xad,
+	0xa7, 0xe4, 0xe7, 0x03, 0x10, 0x97, 0xb6, 0xf2, 0x5a, 0x11, 0xfe, 0x4c, 0xf4, 0x62, 0x22, 0x78,
+	0x33, 0x6a, 0x54, 0x3b, 0xbe, 0x55, 0xe5, 0xa9, 0x99, 0x89, 0x5f, 0x1f, 0x02, 0x8f, 0x58, 0x60,
+	0x67, 0x33, 0x71, 0xe8, 0x8a, 0x30, 0x6d, 0x64, 0x30, 0xa9, 0x51, 0xeb, 0xc8, 0xd6, 0x6d, 0xe1,
+	0xae, 0x17, 0xb8, 0xdb, 0xbe, 0x2f, 0x47, 0x49, 0x34, 0x8b, 0x8e, 0x1a, 0x6b, 0x97, 0xb3, 0x19,
+	0x39, 0xe2, 0x6c, 0x58, 0x54, 0x31, 0x32, 0x0a, 0x0b, 0x51, 0x5e, 0xe9, 0x12, 0x05, 0x91, 0x6e,
+	0x53, 0x15, 0x9c, 0xc5, 0xc8, 0xaa, 0x45, 0xe1, 0x05, 0xad, 0x4b, 0xe6, 0x5d, 0x98, 0x92, 0x8f,
+	0x2e, 0xb9, 0x06, 0xd1, 0x9c, 0xbd, 0xd6, 0x56, 0xf2, 0x59, 0xe4, 0xa4, 0xd3, 0xba, 0xf4, 0xb2,
+	0x61, 0xee, 0xa7, 0x33, 0xa0, 0x7a, 0xbc, 0x58, 0x82, 0x70, 0x43, 0x7f, 0xf2, 0xa4, 0xec, 0x68,
+	0x78, 0x2f, 0x49, 0x18, 0xd7, 0x0b, 0x8a, 0xf5, 0x88, 0xf4, 0x00, 0x56, 0x6e, 0x87, 0x4f, 0x03,
+	0xbc, 0x51, 0xee, 0x0f, 0x73, 0x69, 0xfb, 0xbf, 0x02, 0xe6, 0x6e, 0x4c, 0x12, 0x77, 0x9c, 0xe0,
+	0x16, 0x5e, 0x1d, 0xe1, 0x37, 0x77, 0x58, 0x37, 0xe6, 0x73, 0x32, 0xe6, 0x0d, 0x1d, 0x80, 0x20,
+	0xf8, 0x0e, 0xb1, 0x47, 0xa4, 0x77, 0x09, 0xa5, 0x83, 0x36, 0x25, 0x3d, 0xd5, 0xaf, 0xf9, 0x7b,
+	0x89, 0x64, 0x85, 0x30, 0xc7, 0x27, 0x3a, 0x81, 0x49, 0x07, 0x5a, 0x54, 0xfe, 0xcb, 0xc4, 0xf0,
+	0xae, 0x79, 0x79, 0x4d, 0x1c, 0xa0, 0x15, 0x3e, 0xca, 0x56, 0x84, 0x72, 0x9f, 0xc4, 0x68, 0xd4,
+	0x3d, 0xca, 0xa6, 0x55, 0xc0, 0x56, 0xb6, 0x1e, 0xad, 0x5d, 0xf9, 0x28, 0x73, 0x29, 0x94, 0x01,
+	0x75, 0xf8, 0x15, 0x4c, 0xde, 0x29, 0x85, 0x97, 0x33, 0x7b, 0xac, 0xe7, 0x10, 0xae, 0x10, 0xeb,
+	0x9c, 0xfa, 0x66, 0xd8, 0xad, 0x8e, 0xd2, 0x51, 0x6c, 0xf5, 0xa0, 0x7d, 0x46, 0xad, 0x76, 0xb9,
+	0x28, 0xbb, 0x6b, 0x1d, 0x3b, 0x61, 0xf0, 0x84, 0x3e, 0x36, 0xfa, 0xc9, 0xeb, 0x40, 0xf4, 0xf9,
+	0x64, 0xdd, 0xdb, 0x67, 0x7c, 0x39, 0x5e, 0xf6, 0xa8, 0x5a, 0xed, 0x6a, 0x29, 0x8c, 0xbc, 0xe4,
+	0xd7, 0xde, 0x88, 0xe5, 0x75, 0x94, 0x5d, 0x97, 0xad, 0x5d, 0x2d, 0x85, 0x91, 0x26, 0x13, 0x73,
+	0x9f, 0x3e, 0xa1, 0x42, 0x4d, 0x99, 0xf4, 0x39, 0xae, 0x9a, 0x38, 0xb0, 0x49, 0x73, 0x32, 0x6b,
+	0x91, 0xec, 0x7a, 0xf6, 0x1d, 0x58, 0x56, 0xc0, 0xdf, 0x45, 0x4f, 0x69, 0x65, 0x67, 0x41, 0xf6,
+	0x55, 0x58, 0xcb, 0x6c, 0x0e, 0x14, 0x83, 0xf6, 0x72, 0xf6, 0x45, 0x4e, 0x9a, 0x5e, 0xdb, 0x52,
+	0x16, 0x5a, 0x05, 0x26, 0xec, 0x1f, 0x32, 0xe0, 0xa5, 0x12, 0xe4, 0x6c, 0x4d, 0xeb, 0x85, 0x81,
+	0x58, 0x18, 0x3c, 0x57, 0x88, 0x76, 0xcf, 0x89, 0x9c, 0x66, 0x9c, 0x9d, 0xc3, 0x8b, 0xe1, 0x04,
+	0x11, 0x6d, 0x12, 0xb3, 0xbd, 0x5b, 0xc5, 0xe9, 0x41, 0x81, 0x02, 0x99, 0x2e, 0x3d, 0xb2, 0x07,
+	0x05, 0x05, 0x60, 0xa2, 0xda, 0x0e, 0x3c, 0xcb, 0xd6, 0xac, 0xe7, 0x53, 0x73, 0x1f, 0x2d, 0xfe,
+	0x7e, 0xb8, 0x56, 0xc2, 0xf5, 0x03, 0xbc, 0xd0, 0x1c, 0x20, 0xbf, 0x43, 0x7e, 0xaf, 0xae, 0xa0,
+	0xca, 0x73, 0xe7, 0x74, 0x0c, 0x57, 0x34, 0x9c, 0x3e, 0x4b, 0x9d, 0x7d, 0xb4, 0xf2, 0x6d, 0xba,
+	0xbe, 0xb9, 0xd5, 0xf9, 0xaa, 0xd7, 0x22, 0x7b, 0x76, 0x36, 0xf4, 0x94, 0xc4, 0xcc, 0xae, 0x24,
+	0x93, 0x27, 0x29, 0x1f, 0x1c, 0xc3, 0x76, 0xdb, 0xf7, 0x8b, 0x9e, 0x15, 0xd6, 0xce, 0x37, 0x2f,
+	0xa8, 0xde, 0x19, 0x65, 0x6f, 0x38, 0x5b, 0x97, 0xcc, 0x8f, 0xf8, 0xe5, 0x4b, 0x7d, 0x05, 0xd7,
+	0xbb, 0x3f, 0x6d, 0xac, 0xf6, 0x4b, 0xb7, 0x87, 0x98, 0x89, 0x89, 0x63, 0x6d, 0xbf, 0xa4, 0xca,
+	0xee, 0xaf, 0x29, 0xf7, 0x51, 0xd7, 0x57, 0xe1, 0xb2, 0x22, 0x03, 0xbd, 0x54, 0x17, 0xc6, 0x09,
+	0x2d, 0xd3, 0x65, 0x3b, 0xfd, 0x43, 0x06, 0x6c, 0x16, 0xf5, 0x8f, 0x70, 0xa8, 0xb8, 0xd1, 0xeb,
+	0x5b, 0xcb, 0xea, 0x79, 0x74, 0x6f, 0xaf, 0x44, 0x93, 0xfd, 0xe7, 0x86, 0xb6, 0xff, 0x04, 0x01,
+	0x2f, 0xf4, 0x40, 0x00, 0xaf, 0xfc, 0x7a, 0xb7, 0xca, 0xa5, 0x8a, 0x5b, 0x24, 0x7e, 0x7e, 0x49,
+	0xc5, 0xbd, 0xbc, 0xd9, 0xdc, 0x57, 0x8d, 0x5f, 0x87, 0xcd, 0xa2, 0xbe, 0x14, 0x95, 0x9e, 0xbd,
+	0x37, 0x69, 0x34, 0xa9, 0x6d, 0xdf, 0xd7, 0x3c, 0x89, 0xac, 0x1d, 0x67, 0x4c, 0x77, 0x74, 0x79,
+	0x6b, 0x9a, 0x0c, 0x63, 0xe2, 0xfb, 0xa1, 0x41, 0x7b, 0xb5, 0xf4, 0x1d, 0x66, 0xd5, 0x8d, 0xab,
+	0xe4, 0xad, 0x68, 0xeb, 0x92, 0xf9, 0x35, 0xea, 0xf8, 0xa1, 0xa9, 0xa3, 0xf4, 0xad, 0xe7, 0xde,
+	0x90, 0x3f, 0x82, 0x55, 0xca, 0x42, 0x1d, 0xfe, 0xb3, 0x73, 0x3c, 0x86, 0x35, 0x0d, 0xc7, 0x45,
+	0x57, 0x3e, 0xdf, 0xc3, 0xf3, 0xcf, 0xaa, 0x7e, 0xee, 0xfa, 0x5c, 0x35, 0x39, 0xa7, 0xaf, 0x66,
+	0x3b, 0x43, 0xd4, 0xf8, 0x6c, 0x79, 0x8d, 0xd9, 0x73, 0xfa, 0xb2, 0x07, 0xa7, 0x89, 0x57, 0x51,
+	0x75, 0xbf, 0xa8, 0xa6, 0x2e, 0x4f, 0x46, 0xf7, 0x5a, 0xc5, 0x7b, 0xb0, 0xa6, 0xe9, 0x98, 0x73,
+	0x18, 0x0c, 0x87, 0x7c, 0x5f, 0xcc, 0x0c, 0xe5, 0x5e, 0x70, 0x7c, 0x87, 0x6c, 0x9a, 0x2c, 0xf9,
+	0x5c, 0x2e, 0x93, 0x99, 0xe1, 0x4f, 0x01, 0x8c, 0x34, 0x9c, 0xf1, 0xd2, 0xfa, 0x3e, 0x72, 0x8f,
+	0x51, 0x74, 0x10, 0x79, 0x8e, 0xcf, 0xc2, 0x8e, 0xf1, 0x9d, 0x4e, 0x3e, 0x27, 0xb3, 0xfd, 0xd4,
+	0x01, 0x48, 0xfd, 0x5c, 0xa3, 0xc1, 0x9a, 0xf0, 0x0a, 0x3f, 0x68, 0x78, 0xbe, 0xc7, 0xce, 0xdf,
+	0x71, 0x1a, 0xf7, 0x34, 0x29, 0x86, 0xc8, 0x58, 0x34, 0x32, 0x20, 0xed, 0x40, 0x75, 0xed, 0xa2,
+	0x32, 0x96, 0x01, 0x11, 0x3e, 0x57, 0xfa, 0xdc, 0x8c, 0x6b, 0x57, 0x11, 0x90, 0xd4, 0xa0, 0x95,
+	0x3c, 0xcc, 0x7d, 0x2f, 0x40, 0xb1, 0x59, 0x88, 0x82, 0x64, 0x67, 0xf4, 0x55, 0x21, 0x94, 0xa8,
+	0xe9, 0x18, 0x56, 0x6d, 0x14, 0x87, 0xfe, 0x13, 0x94, 0x87, 0xe3, 0x0b, 0xc3, 0x42, 0x80, 0x4c,
+	0x1f, 0xe9, 0x00, 0xe4, 0x43, 0x34, 0xf5, 0xed, 0x48, 0x7e, 0x88, 0xa6, 0x7d, 0xa3, 0xb2, 0xb6,
+	0xae, 0xcf, 0x94, 0xcc, 0xe5, 0xab, 0xea, 0xf2, 0x56, 0xc6, 0x9c, 0x7f, 0x31, 0xb2, 0x2b, 0xbe,
+	0x3a, 0xcc, 0x65, 0x1f, 0x3f, 0x14, 0x0e, 0x1a, 0xfa, 0x47, 0x11, 0xfb, 0x34, 0xe1, 0xd6, 0x61,
+	0x2e, 0xfb, 0x76, 0xa3, 0xea, 0x01, 0x92, 0x7b, 0xd3, 0xb1, 0xcf, 0x0a, 0xbe, 0x06, 0x0b, 0x14,
+	0x91, 0x72, 0xfb, 0xdf, 0x54, 0x5c, 0xd2, 0x94, 0x2c, 0xad, 0xd3, 0x9a, 0x0e, 0x82, 0x3a, 0x64,
+	0x12, 0x59, 0x92, 0x73, 0x63, 0x53, 0x32, 0x01, 0xaa, 0x39, 0xd9, 0xc1, 0xab, 0x01, 0x90, 0x2c,
+	0x68, 0xd8, 0xb6, 0xad, 0x12, 0x9d, 0xda, 0x3f, 0xb5, 0x14, 0x5f, 0x2e, 0xca, 0x96, 0x4f, 0x53,
+	0xd8, 0x89, 0x9e, 0x96, 0x19, 0xf9, 0xac, 0x2e, 0x3a, 0xf2, 0x03, 0x36, 0x20, 0xe5, 0x22, 0xcc,
+	0x1c, 0xf9, 0x4c, 0x41, 0x1b, 0x55, 0x43, 0xa2, 0xe6, 0x61, 0x47, 0x89, 0xdc, 0x6f, 0xc0, 0x5a,
+	0xaa, 0xf1, 0x89, 0x08, 0x28, 0x64, 0x5f, 0x57, 0x9d, 0xc5, 0x34, 0x20, 0x5d, 0xc8, 0xbf, 0x03,
+	0x55, 0x1c, 0x65, 0x18, 0xbf, 0x89, 0xdd, 0xca, 0x76, 0xa2, 0x6e, 0xb9, 0x53, 0x60, 0x26, 0x38,
+	0x85, 0x6a, 0x51, 0x68, 0x09, 0xbe, 0xbb, 0xea, 0x12, 0x36, 0xa3, 0xf6, 0x5c, 0x37, 0x30, 0x89,
+	0x27, 0x2b, 0xd9, 0x0e, 0xbe, 0xed, 0xc5, 0xad, 0x76, 0x82, 0xba, 0x89, 0x87, 0xa5, 0xa9, 0x83,
+	0x15, 0x55, 0xf6, 0xe6, 0x5b, 0xdb, 0xae, 0xab, 0x03, 0xda, 0x09, 0xa3, 0x08, 0x35, 0xe8, 0x0b,
+	0xe6, 0x14, 0x55, 0x57, 0xc0, 0xfe, 0xaa, 0x6e, 0xc2, 0x1a, 0xd7, 0xa9, 0xba, 0xe6, 0x5d, 0x57,
+	0xd5, 0xae, 0x16, 0x57, 0x3f, 0xd5, 0x21, 0xfa, 0x50, 0x92, 0x02, 0xf5, 0x98, 0xda, 0xe6, 0xe2,
+	0x6e, 0xbc, 0xbc, 0x56, 0x20, 0xdd, 0xbc, 0xbc, 0xe2, 0x70, 0x81, 0x97, 0xbe, 0xe9, 0xdb, 0x99,
+	0x22, 0x4e, 0xb7, 0x56, 0xc4, 0x72, 0xaf, 0x71, 0x2a, 0xa3, 0x77, 0x45, 0xc1, 0x74, 0x37, 0x8c,
+	0xb8, 0x21, 0x77, 0x25, 0x5f, 0xac, 0x3b, 0xbe, 0xc7, 0x64, 0x1d, 0x28, 0xb2, 0xe4, 0x41, 0xc8,
+	0x0d, 0xea, 0xfa, 0x87, 0x6c, 0xbb, 0x0c, 0xdb, 0xb7, 0x61, 0x9e, 0x1d, 0xbb, 0xa7, 0xd5, 0x9a,
+	0x97, 0x95, 0xf3, 0xf8, 0x3c, 0xa1, 0x05, 0x63, 0xcb, 0x86, 0x25, 0xed, 0x8b, 0xa1, 0x7c, 0x15,
+	0x56, 0xf6, 0x9c, 0x68, 0xd1, 0xb0, 0x7f, 0xc0, 0xdd, 0xad, 0xe4, 0xd7, 0x23, 0xf9, 0x71, 0x87,
+	0xe6, 0x49, 0xcc, 0x5a, 0x4d, 0x97, 0x95, 0x71, 0xb4, 0x3a, 0x1f, 0x5c, 0x5f, 0x82, 0x85, 0x1d,
+	0xc7, 0x6f, 0x6c, 0x93, 0x37, 0xe5, 0x0f, 0xda, 0x51, 0x10, 0x62, 0x6b, 0x71, 0x3f, 0xca, 0x28,
+	0x21, 0x3b, 0x8a, 0xa2, 0xd7, 0x11, 0xf9, 0x20, 0xea, 0xfe, 0x52, 0x63, 0xed, 0xf9, 0x1e, 0x20,
+	0x45, 0xad, 0xdf, 0x07, 0x5b, 0x12, 0xa0, 0xfe, 0xcd, 0xc8, 0xde, 0x6e, 0x12, 0xdc, 0xec, 0xf3,
+	0x05, 0x4a, 0xea, 0x5e, 0xa9, 0x2e, 0x7a, 0xde, 0x41, 0x1d, 0xee, 0x0c, 0xf1, 0xac, 0x3c, 0x8b,
+	0xe7, 0xf3, 0x33, 0xc3, 0x44, 0xce, 0x10, 0xe8, 0x1f, 0x92, 0x25, 0x5a, 0x9a, 0xa5, 0xb8, 0x83,
+	0x4a, 0xc9, 0x1a, 0x77, 0x50, 0x25, 0x57, 0xf2, 0xa6, 0x9b, 0x56, 0x10, 0x9a, 0x57, 0x14, 0xcf,
+	0x88, 0x34, 0x43, 0xec, 0x85, 0xca, 0xc8, 0x3b, 0x80, 0x39, 0xba, 0x9f, 0xe9, 0x07, 0xe1, 0x86,
+	0x46, 0x78, 0xf0, 0x63, 0x30, 0x74, 0xfc, 0x90, 0x46, 0xcf, 0x93, 0xb3, 0xd0, 0xf8, 0x44, 0x42,
+	0xcb, 0xc7, 0x70, 0x36, 0xa3, 0x17, 0x2e, 0xee, 0xc3, 0xc2, 0xa3, 0x20, 0x8f, 0x92, 0x3b, 0x72,
+	0x04, 0x67, 0x42, 0xfa, 0x0d, 0xa2, 0x5b, 0xd3, 0xac, 0xd4, 0x81, 0x25, 0x3d, 0x77, 0xd0, 0xe4,
+	0xf6, 0xdc, 0x53, 0x8f, 0x61, 0x5e, 0xc1, 0xb0, 0x1b, 0x1c, 0x85, 0xe6, 0x65, 0x0d, 0x6a, 0x9c,
+	0x91, 0xd9, 0x2f, 0x69, 0xf2, 0xa5, 0x23, 0xef, 0x29, 0x6c, 0xb1, 0xf2, 0x9d, 0x04, 0xdf, 0xc0,
+	0x14, 0x07, 0x1d, 0xc4, 0xc5, 0x83, 0x27, 0x66, 0x0c, 0xa4, 0x99, 0x3c, 0x89, 0x05, 0x78, 0x0b,
+	0xb9, 0xfd, 0xc4, 0xf1, 0x7c, 0x7c, 0x6e, 0xf3, 0x0e, 0xea, 0xd0, 0x47, 0x65, 0x65, 0x17, 0x1e,
+	0x56, 0xec, 0x1d, 0x44, 0x4f, 0xf7, 0x32, 0x56, 0x15, 0x2d, 0x84, 0x74, 0x39, 0x6e, 0x86, 0xae,
+	0x42, 0x30, 0x66, 0x1c, 0x76, 0x9c, 0x4f, 0x8d, 0x52, 0x19, 0x92, 0x9e, 0x59, 0x85, 0xe6, 0xb3,
+	0xa5, 0x3d, 0x0f, 0x50, 0xe6, 0xdc, 0xea, 0xec, 0xde, 0x36, 0xd3, 0xfe, 0xcd, 0x76, 0x8f, 0xd2,
+	0x87, 0x99, 0x4c, 0xf5, 0x56, 0x14, 0x0b, 0xc4, 0x2d, 0x81, 0x99, 0x57, 0x72, 0x64, 0x30, 0xa0,
+	0xcc, 0x22, 0x5c, 0x07, 0x20, 0x99, 0x4e, 0x57, 0xee, 0x92, 0x2b, 0xa3, 0x36, 0x72, 0x11, 0x6a,
+	0xca, 0xf8, 0x3f, 0x31, 0xd9, 0x07, 0xb0, 0x42, 0xdd, 0x56, 0xfb, 0xc1, 0xdd, 0x75, 0xdc, 0x3e,
+	0x86, 0x15, 0x3b, 0x4c, 0xa8, 0x8e, 0x8b, 0xef, 0x04, 0x8d, 0xa8, 0x43, 0xfc, 0x5b, 0xdf, 0x41,
+	0x9d, 0x32, 0x03, 0x5e, 0x41, 0x91, 0x2c, 0x93, 0x9f, 0x84, 0xa7, 0x48, 0xee, 0x57, 0xce, 0xe4,
+	0x07, 0x4e, 0xe0, 0x1c, 0x2b, 0x39, 0x19, 0x26, 0xeb, 0x00, 0x64, 0xd4, 0xcc, 0xa6, 0x39, 0x08,
+	0xd4, 0xf4, 0xd0, 0xff, 0x4c, 0xa8, 0xf3, 0x45, 0x55, 0xd5, 0x40, 0xf7, 0x4b, 0xf8, 0xc0, 0x62,
+	0x37, 0xa0, 0x1d, 0x77, 0x59, 0xde, 0x48, 0x49, 0x19, 0x19, 0xd5, 0xa0, 0xc9, 0x97, 0x48, 0x5e,
+	0xce, 0x3f, 0x54, 0x98, 0xd9, 0xfd, 0xe9, 0xde, 0x46, 0xac, 0x5d, 0x2e, 0xca, 0x96, 0x4e, 0xfb,
+	0xb6, 0xba, 0x3e, 0xb7, 0xd7, 0xad, 0x96, 0x9b, 0xf9, 0xec, 0xd2, 0x67, 0xfb, 0xc4, 0xbd, 0x3f,
+	0xcd, 0x13, 0x3b, 0x92, 0x92, 0x2e, 0x7e, 0xff, 0xb0, 0xf6, 0x4c, 0x39, 0x90, 0xc4, 0xb8, 0x45,
+	0xdd, 0xf3, 0x36, 0xfc, 0xf6, 0x65, 0xc9, 0xd3, 0x37, 0xdc, 0x0a, 0xa2, 0x7f, 0x42, 0x86, 0xa2,
+	0xd6, 0xbd, 0x2b, 0xc1, 0x51, 0x97, 0x3c, 0xf2, 0xd2, 0x03, 0x6a, 0x33, 0xff, 0xa4, 0x86, 0x79,
+	0x45, 0x3e, 0x93, 0xd3, 0xbc, 0x5e, 0x52, 0xdb, 0x2c, 0x06, 0x90, 0x66, 0xdc, 0xf9, 0x5c, 0xbe,
+	0x34, 0x79, 0x9d, 0x8d, 0x5e, 0x44, 0xfc, 0x1a, 0x32, 0xd9, 0xea, 0xce, 0xb9, 0x5b, 0x15, 0xbd,
+	0x58, 0x3f, 0x02, 0x58, 0x55, 0x0b, 0xcb, 0x7b, 0xea, 0xe7, 0x74, 0x34, 0x6a, 0x36, 0xd5, 0xd7,
+	0xba, 0xc2, 0x49, 0xce, 0x54, 0xab, 0xd8, 0x12, 0xa0, 0x13, 0x92, 0xbe, 0x56, 0xdf, 0x7b, 0x30,
+	0x9f, 0x7b, 0xde, 0x40, 0x68, 0x85, 0x82, 0x77, 0x0f, 0xf8, 0xdd, 0x32, 0xcd, 0x93, 0x02, 0x64,
+	0x77, 0x31, 0xa3, 0x3e, 0x50, 0x20, 0x19, 0x08, 0xfb, 0xc5, 0x75, 0x0a, 0xd5, 0xa2, 0xe8, 0xfd,
+	0x7c, 0xa1, 0xdc, 0xe5, 0x21, 0x81, 0xda, 0x73, 0xdd, 0xc0, 0x32, 0x92, 0x9d, 0x79, 0x4f, 0x43,
+	0x92, 0x6c, 0xfd, 0xa3, 0x25, 0xb5, 0xcd, 0x62, 0x00, 0x81, 0xda, 0x53, 0xdc, 0xed, 0xb9, 0x82,
+	0xe6, 0xbe, 0xef, 0x2f, 0x94, 0xf9, 0xbe, 0x33, 0xd8, 0x9e, 0xe7, 0xd3, 0xaf, 0xc3, 0x12, 0x31,
+	0x34, 0xee, 0x9f, 0xb6, 0xb7, 0x03, 0x97, 0x2e, 0xe8, 0x88, 0x9b, 0xad, 0x25, 0x59, 0x21, 0xb3,
+	0x99, 0x3d, 0x63, 0xbf, 0x0d, 0x93, 0x52, 0x44, 0x6b, 0xb3, 0x2a, 0xf7, 0xac, 0x1c, 0xbb, 0xbb,
+	0xb6, 0xaa, 0xc9, 0xc9, 0x7a, 0x90, 0xd3, 0x0c, 0xee, 0x66, 0xad, 0x86, 0x9c, 0xae, 0xe5, 0x50,
+	0x2b, 0xfe, 0x88, 0xf3, 0xbb, 0x41, 0x23, 0x62, 0x19, 0x8f, 0xbd, 0xd8, 0x4b, 0x04, 0x2d, 0xf9,
+	0xf8, 0xd6, 0x45, 0xa2, 0xff, 0x36, 0x75, 0x76, 0x13, 0x71, 0xa1, 0xa5, 0x2b, 0xfe, 0xb9, 0x60,
+	0xd1, 0xb5, 0xb5, 0x5c, 0x9e, 0xb2, 0x9d, 0xb4, 0x73, 0x5e, 0xf9, 0x22, 0x52, 0x05, 0x8f, 0x5a,
+	0xab, 0x84, 0xcd, 0xed, 0xa5, 0x95, 0x77, 0x85, 0xa3, 0x74, 0x29, 0xa3, 0xba, 0xf6, 0xd9, 0x37,
+	0xe4, 0x28, 0xe4, 0x38, 0x95, 0xbc, 0xc8, 0x69, 0x6e, 0x65, 0xab, 0xce, 0x45, 0x71, 0xae, 0x59,
+	0x65, 0x20, 0xd2, 0xd1, 0xc3, 0x8a, 0x0a, 0x91, 0xba, 0xeb, 0xf5, 0x50, 0xc7, 0xb5, 0x62, 0x10,
+	0x25, 0xec, 0xb1, 0x38, 0x06, 0x54, 0x2a, 0xb2, 0xd1, 0x11, 0x8a, 0x22, 0x14, 0x9d, 0x73, 0x4d,
+	0x2e, 0x2c, 0xaa, 0x60, 0xb7, 0x3a, 0x38, 0xd6, 0xe2, 0x39, 0xd7, 0xd2, 0xc8, 0x76, 0xcc, 0xad,
+	0xce, 0xa3, 0xa4, 0x79, 0xce, 0x95, 0x7c, 0x9d, 0xdb, 0x8e, 0xe4, 0xa8, 0xc9, 0xa6, 0xa5, 0x17,
+	0x4b, 0xc5, 0x79, 0x7e, 0x33, 0x33, 0x0b, 0xe4, 0xc2, 0x3d, 0xd3, 0xab, 0xa7, 0x99, 0x90, 0xc1,
+	0xdc, 0xd6, 0xa0, 0x8f, 0x24, 0xdc, 0x55, 0x5a, 0x6f, 0x7d, 0xf7, 0x57, 0xdf, 0x3c, 0xf6, 0x92,
+	0x93, 0xf6, 0xe1, 0x8d, 0x46, 0xd8, 0xbc, 0xd9, 0x72, 0x3a, 0x71, 0xbb, 0x85, 0x22, 0xf1, 0xe3,
+	0x25, 0x76, 0x3a, 0xfa, 0x12, 0xd9, 0xf9, 0x44, 0x37, 0x5b, 0xa7, 0xc7, 0x37, 0x49, 0xe0, 0x9c,
+	0x9b, 0x18, 0xef, 0xe1, 0x28, 0xf9, 0xfd, 0xda, 0xff, 0x1f, 0x00, 0x91, 0xb4, 0x8f, 0x0e, 0x3b,
+	0xfb, 0x00, 0x00,
 }
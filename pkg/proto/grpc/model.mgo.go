@@ -36,11 +36,12 @@ type MgoKeyProduct struct {
 }
 
 type MgoPlatformPrice struct {
-	Prices        []*billing.ProductPrice `bson:"prices" json:"prices"`
-	Id            string                  `bson:"id" json:"id"`
-	Name          string                  `bson:"name" json:"name"`
-	EulaUrl       string                  `bson:"eula_url" json:"eula_url"`
-	ActivationUrl string                  `bson:"activation_url" json:"activation_url"`
+	Prices            []*billing.ProductPrice `bson:"prices" json:"prices"`
+	Id                string                  `bson:"id" json:"id"`
+	Name              string                  `bson:"name" json:"name"`
+	EulaUrl           string                  `bson:"eula_url" json:"eula_url"`
+	ActivationUrl     string                  `bson:"activation_url" json:"activation_url"`
+	LowStockThreshold int32                   `bson:"low_stock_threshold" json:"low_stock_threshold"`
 }
 
 type MgoProduct struct {
@@ -442,11 +443,12 @@ func (p *KeyProduct) SetBSON(raw bson.Raw) error {
 	platforms := make([]*PlatformPrice, len(decoded.Platforms))
 	for i, pl := range decoded.Platforms {
 		platforms[i] = &PlatformPrice{
-			Id:            pl.Id,
-			Prices:        pl.Prices,
-			EulaUrl:       pl.EulaUrl,
-			Name:          pl.Name,
-			ActivationUrl: pl.ActivationUrl,
+			Id:                pl.Id,
+			Prices:            pl.Prices,
+			EulaUrl:           pl.EulaUrl,
+			Name:              pl.Name,
+			ActivationUrl:     pl.ActivationUrl,
+			LowStockThreshold: pl.LowStockThreshold,
 		}
 	}
 
@@ -576,11 +578,12 @@ func (p *KeyProduct) GetBSON() (interface{}, error) {
 			}
 		}
 		st.Platforms[i] = &MgoPlatformPrice{
-			Prices:        prices,
-			Id:            pl.Id,
-			Name:          pl.Name,
-			EulaUrl:       pl.EulaUrl,
-			ActivationUrl: pl.ActivationUrl,
+			Prices:            prices,
+			Id:                pl.Id,
+			Name:              pl.Name,
+			EulaUrl:           pl.EulaUrl,
+			ActivationUrl:     pl.ActivationUrl,
+			LowStockThreshold: pl.LowStockThreshold,
 		}
 	}
 
@@ -34,6 +34,19 @@ type mgoPaylink struct {
 	GrossTotalAmount     float64       `bson:"gross_total_amount"`
 	TransactionsCurrency string        `bson:"transactions_currency"`
 	Deleted              bool          `bson:"deleted"`
+	TokenRequired        bool          `bson:"token_required"`
+}
+
+type mgoPaylinkToken struct {
+	Id              bson.ObjectId `bson:"_id"`
+	PaylinkId       bson.ObjectId `bson:"paylink_id"`
+	MerchantId      bson.ObjectId `bson:"merchant_id"`
+	ExpiresAt       time.Time     `bson:"expires_at"`
+	MaxPurchases    int32         `bson:"max_purchases"`
+	CustomerEmail   string        `bson:"customer_email"`
+	CustomerCountry string        `bson:"customer_country"`
+	DiscountPercent float64       `bson:"discount_percent"`
+	CreatedAt       time.Time     `bson:"created_at"`
 }
 
 // RateData.SetBSON
@@ -63,6 +76,7 @@ func (p *Paylink) SetBSON(raw bson.Raw) error {
 	p.GrossTotalAmount = decoded.GrossTotalAmount
 	p.TransactionsCurrency = decoded.TransactionsCurrency
 	p.Visits = decoded.Visits
+	p.TokenRequired = decoded.TokenRequired
 
 	p.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 	if err != nil {
@@ -102,6 +116,7 @@ func (p *Paylink) GetBSON() (interface{}, error) {
 		GrossTotalAmount:     p.GrossTotalAmount,
 		TransactionsCurrency: p.TransactionsCurrency,
 		Visits:               p.Visits,
+		TokenRequired:        p.TokenRequired,
 	}
 
 	if len(p.Id) <= 0 {
@@ -146,3 +161,74 @@ func (p *Paylink) GetBSON() (interface{}, error) {
 
 	return st, nil
 }
+
+func (p *PaylinkToken) SetBSON(raw bson.Raw) error {
+	decoded := new(mgoPaylinkToken)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	p.Id = decoded.Id.Hex()
+	p.PaylinkId = decoded.PaylinkId.Hex()
+	p.MerchantId = decoded.MerchantId.Hex()
+	p.MaxPurchases = decoded.MaxPurchases
+	p.CustomerEmail = decoded.CustomerEmail
+	p.CustomerCountry = decoded.CustomerCountry
+	p.DiscountPercent = decoded.DiscountPercent
+
+	p.ExpiresAt, err = ptypes.TimestampProto(decoded.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	p.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PaylinkToken) GetBSON() (interface{}, error) {
+	st := &mgoPaylinkToken{
+		MaxPurchases:    p.MaxPurchases,
+		CustomerEmail:   p.CustomerEmail,
+		CustomerCountry: p.CustomerCountry,
+		DiscountPercent: p.DiscountPercent,
+	}
+
+	if len(p.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(p.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+		st.Id = bson.ObjectIdHex(p.Id)
+	}
+
+	if bson.IsObjectIdHex(p.PaylinkId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+	st.PaylinkId = bson.ObjectIdHex(p.PaylinkId)
+
+	if bson.IsObjectIdHex(p.MerchantId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+	st.MerchantId = bson.ObjectIdHex(p.MerchantId)
+
+	var err error
+
+	st.ExpiresAt, err = ptypes.Timestamp(p.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	st.CreatedAt, err = ptypes.Timestamp(p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
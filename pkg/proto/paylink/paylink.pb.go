@@ -37,7 +37,10 @@ type CreatePaylinkRequest struct {
 	// @inject_tag: json:"no_expiry_date"
 	NoExpiryDate bool `protobuf:"varint,7,opt,name=no_expiry_date,json=noExpiryDate,proto3" json:"no_expiry_date"`
 	// @inject_tag: json:"products_type" validate="required,oneof=product key"
-	ProductsType         string   `protobuf:"bytes,8,opt,name=products_type,json=productsType,proto3" json:"products_type"`
+	ProductsType string `protobuf:"bytes,8,opt,name=products_type,json=productsType,proto3" json:"products_type"`
+	// paylink can be used only with signed token issued to buyer
+	// @inject_tag: json:"token_required"
+	TokenRequired        bool     `protobuf:"varint,9,opt,name=token_required,json=tokenRequired,proto3" json:"token_required"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -124,6 +127,13 @@ func (m *CreatePaylinkRequest) GetProductsType() string {
 	return ""
 }
 
+func (m *CreatePaylinkRequest) GetTokenRequired() bool {
+	if m != nil {
+		return m.TokenRequired
+	}
+	return false
+}
+
 type Paylink struct {
 	// @inject_tag: json:"id"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
@@ -168,7 +178,9 @@ type Paylink struct {
 	// @inject_tag: json:"gross_total_amount"
 	GrossTotalAmount float64 `protobuf:"fixed64,21,opt,name=gross_total_amount,json=grossTotalAmount,proto3" json:"gross_total_amount"`
 	// @inject_tag: json:"transactions_currency"
-	TransactionsCurrency string   `protobuf:"bytes,22,opt,name=transactions_currency,json=transactionsCurrency,proto3" json:"transactions_currency"`
+	TransactionsCurrency string `protobuf:"bytes,22,opt,name=transactions_currency,json=transactionsCurrency,proto3" json:"transactions_currency"`
+	// @inject_tag: json:"token_required"
+	TokenRequired        bool     `protobuf:"varint,23,opt,name=token_required,json=tokenRequired,proto3" json:"token_required"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -353,6 +365,231 @@ func (m *Paylink) GetTransactionsCurrency() string {
 	return ""
 }
 
+func (m *Paylink) GetTokenRequired() bool {
+	if m != nil {
+		return m.TokenRequired
+	}
+	return false
+}
+
+type CreatePaylinkTokenRequest struct {
+	//@inject_tag: json:"paylink_id" validate:"required,hexadecimal,len=24"
+	PaylinkId string `protobuf:"bytes,1,opt,name=paylink_id,json=paylinkId,proto3" json:"paylink_id" validate:"required,hexadecimal,len=24"`
+	//@inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"expires_at" validate:"required,numeric,gt=0"
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at" validate:"required,numeric,gt=0"`
+	// maximum number of successful purchases by token, zero means unlimited
+	// @inject_tag: json:"max_purchases" validate:"omitempty,numeric,gte=0"
+	MaxPurchases int32 `protobuf:"varint,4,opt,name=max_purchases,json=maxPurchases,proto3" json:"max_purchases" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"customer_email" validate:"omitempty,email"
+	CustomerEmail string `protobuf:"bytes,5,opt,name=customer_email,json=customerEmail,proto3" json:"customer_email" validate:"omitempty,email"`
+	// @inject_tag: json:"customer_country" validate:"omitempty,alpha,len=2"
+	CustomerCountry string `protobuf:"bytes,6,opt,name=customer_country,json=customerCountry,proto3" json:"customer_country" validate:"omitempty,alpha,len=2"`
+	// fixed discount in percent applied to order amount
+	// @inject_tag: json:"discount_percent" validate:"omitempty,numeric,gte=0,lt=100"
+	DiscountPercent      float64  `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent" validate:"omitempty,numeric,gte=0,lt=100"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CreatePaylinkTokenRequest) Reset()         { *m = CreatePaylinkTokenRequest{} }
+func (m *CreatePaylinkTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePaylinkTokenRequest) ProtoMessage()    {}
+func (*CreatePaylinkTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4195a7eea98304b, []int{2}
+}
+
+func (m *CreatePaylinkTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePaylinkTokenRequest.Unmarshal(m, b)
+}
+func (m *CreatePaylinkTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePaylinkTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePaylinkTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePaylinkTokenRequest.Merge(m, src)
+}
+func (m *CreatePaylinkTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePaylinkTokenRequest.Size(m)
+}
+func (m *CreatePaylinkTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePaylinkTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePaylinkTokenRequest proto.InternalMessageInfo
+
+func (m *CreatePaylinkTokenRequest) GetPaylinkId() string {
+	if m != nil {
+		return m.PaylinkId
+	}
+	return ""
+}
+
+func (m *CreatePaylinkTokenRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *CreatePaylinkTokenRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CreatePaylinkTokenRequest) GetMaxPurchases() int32 {
+	if m != nil {
+		return m.MaxPurchases
+	}
+	return 0
+}
+
+func (m *CreatePaylinkTokenRequest) GetCustomerEmail() string {
+	if m != nil {
+		return m.CustomerEmail
+	}
+	return ""
+}
+
+func (m *CreatePaylinkTokenRequest) GetCustomerCountry() string {
+	if m != nil {
+		return m.CustomerCountry
+	}
+	return ""
+}
+
+func (m *CreatePaylinkTokenRequest) GetDiscountPercent() float64 {
+	if m != nil {
+		return m.DiscountPercent
+	}
+	return 0
+}
+
+type PaylinkToken struct {
+	// @inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// @inject_tag: json:"paylink_id"
+	PaylinkId string `protobuf:"bytes,2,opt,name=paylink_id,json=paylinkId,proto3" json:"paylink_id"`
+	// @inject_tag: json:"merchant_id"
+	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id"`
+	// @inject_tag: json:"expires_at"
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	// @inject_tag: json:"max_purchases"
+	MaxPurchases int32 `protobuf:"varint,5,opt,name=max_purchases,json=maxPurchases,proto3" json:"max_purchases"`
+	// @inject_tag: json:"customer_email"
+	CustomerEmail string `protobuf:"bytes,6,opt,name=customer_email,json=customerEmail,proto3" json:"customer_email"`
+	// @inject_tag: json:"customer_country"
+	CustomerCountry string `protobuf:"bytes,7,opt,name=customer_country,json=customerCountry,proto3" json:"customer_country"`
+	// @inject_tag: json:"discount_percent"
+	DiscountPercent float64 `protobuf:"fixed64,8,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent"`
+	// @inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// number of successful purchases by token, calculated on request
+	// @inject_tag: json:"purchases"
+	Purchases            int32    `protobuf:"varint,10,opt,name=purchases,proto3" json:"purchases"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaylinkToken) Reset()         { *m = PaylinkToken{} }
+func (m *PaylinkToken) String() string { return proto.CompactTextString(m) }
+func (*PaylinkToken) ProtoMessage()    {}
+func (*PaylinkToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4195a7eea98304b, []int{3}
+}
+
+func (m *PaylinkToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaylinkToken.Unmarshal(m, b)
+}
+func (m *PaylinkToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaylinkToken.Marshal(b, m, deterministic)
+}
+func (m *PaylinkToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaylinkToken.Merge(m, src)
+}
+func (m *PaylinkToken) XXX_Size() int {
+	return xxx_messageInfo_PaylinkToken.Size(m)
+}
+func (m *PaylinkToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaylinkToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaylinkToken proto.InternalMessageInfo
+
+func (m *PaylinkToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaylinkToken) GetPaylinkId() string {
+	if m != nil {
+		return m.PaylinkId
+	}
+	return ""
+}
+
+func (m *PaylinkToken) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *PaylinkToken) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *PaylinkToken) GetMaxPurchases() int32 {
+	if m != nil {
+		return m.MaxPurchases
+	}
+	return 0
+}
+
+func (m *PaylinkToken) GetCustomerEmail() string {
+	if m != nil {
+		return m.CustomerEmail
+	}
+	return ""
+}
+
+func (m *PaylinkToken) GetCustomerCountry() string {
+	if m != nil {
+		return m.CustomerCountry
+	}
+	return ""
+}
+
+func (m *PaylinkToken) GetDiscountPercent() float64 {
+	if m != nil {
+		return m.DiscountPercent
+	}
+	return 0
+}
+
+func (m *PaylinkToken) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PaylinkToken) GetPurchases() int32 {
+	if m != nil {
+		return m.Purchases
+	}
+	return 0
+}
+
 type StatCommon struct {
 	// @inject_tag: json:"-" bson:"_id"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"-" bson:"_id"`
@@ -393,7 +630,7 @@ func (m *StatCommon) Reset()         { *m = StatCommon{} }
 func (m *StatCommon) String() string { return proto.CompactTextString(m) }
 func (*StatCommon) ProtoMessage()    {}
 func (*StatCommon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4195a7eea98304b, []int{2}
+	return fileDescriptor_e4195a7eea98304b, []int{4}
 }
 
 func (m *StatCommon) XXX_Unmarshal(b []byte) error {
@@ -535,7 +772,7 @@ func (m *Utm) Reset()         { *m = Utm{} }
 func (m *Utm) String() string { return proto.CompactTextString(m) }
 func (*Utm) ProtoMessage()    {}
 func (*Utm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4195a7eea98304b, []int{3}
+	return fileDescriptor_e4195a7eea98304b, []int{5}
 }
 
 func (m *Utm) XXX_Unmarshal(b []byte) error {
@@ -591,7 +828,7 @@ func (m *GroupStatCommon) Reset()         { *m = GroupStatCommon{} }
 func (m *GroupStatCommon) String() string { return proto.CompactTextString(m) }
 func (*GroupStatCommon) ProtoMessage()    {}
 func (*GroupStatCommon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4195a7eea98304b, []int{4}
+	return fileDescriptor_e4195a7eea98304b, []int{6}
 }
 
 func (m *GroupStatCommon) XXX_Unmarshal(b []byte) error {
@@ -629,6 +866,8 @@ func (m *GroupStatCommon) GetTotal() *StatCommon {
 func init() {
 	proto.RegisterType((*CreatePaylinkRequest)(nil), "paylink.CreatePaylinkRequest")
 	proto.RegisterType((*Paylink)(nil), "paylink.Paylink")
+	proto.RegisterType((*CreatePaylinkTokenRequest)(nil), "paylink.CreatePaylinkTokenRequest")
+	proto.RegisterType((*PaylinkToken)(nil), "paylink.PaylinkToken")
 	proto.RegisterType((*StatCommon)(nil), "paylink.StatCommon")
 	proto.RegisterType((*Utm)(nil), "paylink.Utm")
 	proto.RegisterType((*GroupStatCommon)(nil), "paylink.GroupStatCommon")
@@ -637,56 +876,67 @@ func init() {
 func init() { proto.RegisterFile("paylink/paylink.proto", fileDescriptor_e4195a7eea98304b) }
 
 var fileDescriptor_e4195a7eea98304b = []byte{
-	// 807 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0x1b, 0x37,
-	0x10, 0x85, 0x24, 0xeb, 0x63, 0x67, 0x15, 0x27, 0x61, 0xec, 0x60, 0x61, 0x20, 0x8d, 0xaa, 0xb6,
-	0x80, 0x0a, 0xd4, 0x72, 0xe1, 0x9c, 0x72, 0x28, 0x0a, 0x57, 0x0d, 0x5a, 0x1f, 0x0a, 0x14, 0x6b,
-	0xe7, 0xd2, 0xcb, 0x62, 0xb5, 0x4b, 0xcb, 0x6c, 0xc4, 0xe5, 0x96, 0x1c, 0x1a, 0xd5, 0xad, 0xff,
-	0xa6, 0xff, 0xa4, 0xbf, 0xab, 0xe0, 0x90, 0xeb, 0x6e, 0x62, 0xb9, 0xb2, 0x4f, 0x5a, 0xbe, 0xf7,
-	0x66, 0xc8, 0x99, 0x37, 0x14, 0xe1, 0xb0, 0xce, 0x37, 0x6b, 0x51, 0x7d, 0x38, 0x09, 0xbf, 0xf3,
-	0x5a, 0x2b, 0x54, 0x6c, 0x18, 0x96, 0x47, 0xaf, 0x57, 0x4a, 0xad, 0xd6, 0xfc, 0x84, 0xe0, 0xa5,
-	0xbd, 0x3a, 0x41, 0x21, 0xb9, 0xc1, 0x5c, 0xd6, 0x5e, 0x39, 0xfd, 0xab, 0x0b, 0x07, 0x0b, 0xcd,
-	0x73, 0xe4, 0xbf, 0xfa, 0x90, 0x94, 0xff, 0x61, 0xb9, 0x41, 0xb6, 0x0f, 0x5d, 0x51, 0x26, 0x9d,
-	0x49, 0x67, 0x16, 0xa5, 0x5d, 0x51, 0xb2, 0x57, 0x00, 0xfc, 0xcf, 0x5a, 0x68, 0x6e, 0xb2, 0x1c,
-	0x93, 0xee, 0xa4, 0x33, 0xeb, 0xa5, 0x51, 0x40, 0xce, 0x90, 0x1d, 0xc1, 0xa8, 0xd6, 0xaa, 0xb4,
-	0x05, 0x9a, 0xa4, 0x37, 0xe9, 0xcd, 0xa2, 0xf4, 0x76, 0xcd, 0x5e, 0x43, 0x2c, 0xb9, 0x2e, 0xae,
-	0xf3, 0x0a, 0x33, 0x51, 0x26, 0x7b, 0x94, 0x13, 0x1a, 0xe8, 0x9c, 0x72, 0xd7, 0x5a, 0xfd, 0xce,
-	0x0b, 0xe2, 0xfb, 0xc4, 0x47, 0x01, 0x39, 0x2f, 0x19, 0x83, 0xbd, 0x2a, 0x97, 0x3c, 0x19, 0x10,
-	0x41, 0xdf, 0xec, 0x4b, 0xd8, 0xaf, 0x54, 0x46, 0xfb, 0x6f, 0xb2, 0x32, 0x47, 0x9e, 0x0c, 0x27,
-	0x9d, 0xd9, 0x28, 0x1d, 0x57, 0xea, 0x1d, 0x81, 0x3f, 0xe6, 0xc8, 0xd9, 0x17, 0xf0, 0xa4, 0x39,
-	0x45, 0x86, 0x9b, 0x9a, 0x27, 0x23, 0x4a, 0x31, 0x6e, 0xc0, 0xcb, 0x4d, 0xcd, 0xa7, 0xff, 0x0c,
-	0x60, 0x18, 0x8a, 0xbf, 0x53, 0xf5, 0x4b, 0x18, 0xa8, 0xa5, 0x3b, 0x06, 0x55, 0x1c, 0xa5, 0x61,
-	0xf5, 0xbf, 0xe5, 0xbe, 0xfd, 0xa8, 0x53, 0xae, 0xda, 0xf8, 0xf4, 0x68, 0xee, 0x8d, 0x98, 0x37,
-	0x46, 0xcc, 0x2f, 0x1b, 0x23, 0xda, 0x5d, 0x7c, 0x0b, 0x50, 0x90, 0x19, 0xa5, 0x0b, 0xed, 0xef,
-	0x0e, 0x0d, 0x6a, 0x1f, 0x6a, 0xeb, 0xb2, 0x09, 0x1d, 0xec, 0x0e, 0x0d, 0xea, 0x33, 0xfc, 0xd4,
-	0x9f, 0xe1, 0x0e, 0x7f, 0x46, 0xf7, 0xf9, 0x13, 0xb5, 0xfc, 0x79, 0x05, 0x20, 0x8c, 0xf7, 0x87,
-	0x97, 0x09, 0x90, 0x37, 0x91, 0x30, 0xef, 0x3c, 0xe0, 0xfa, 0x7a, 0x23, 0x8c, 0x40, 0x93, 0xc4,
-	0x93, 0xce, 0xac, 0x9f, 0x86, 0xd5, 0x16, 0x5b, 0xc7, 0x0f, 0xb1, 0xf5, 0xc9, 0x5d, 0x5b, 0x59,
-	0x02, 0xc3, 0x92, 0xaf, 0x39, 0xf2, 0x32, 0xd9, 0xa7, 0x1c, 0xcd, 0x92, 0x1d, 0x03, 0x43, 0x85,
-	0xf9, 0x3a, 0x43, 0x9d, 0x57, 0x26, 0x2f, 0x50, 0xa8, 0xca, 0x24, 0x4f, 0xe9, 0x20, 0xcf, 0x89,
-	0xb9, 0x6c, 0x11, 0xae, 0x3d, 0x26, 0x5f, 0x73, 0x93, 0x15, 0xca, 0x56, 0x98, 0x3c, 0x23, 0x1d,
-	0x10, 0xb4, 0x70, 0x88, 0x3b, 0x8e, 0xe6, 0x68, 0x75, 0xd5, 0x48, 0x9e, 0x93, 0x64, 0x1c, 0x40,
-	0x2f, 0xfa, 0x0c, 0xa0, 0x50, 0xd5, 0x0d, 0xd7, 0x46, 0xa8, 0x2a, 0x61, 0x93, 0xce, 0xac, 0x93,
-	0xb6, 0x10, 0xf6, 0x0d, 0xb0, 0x95, 0x56, 0xc6, 0x64, 0x7e, 0xaf, 0x5c, 0x52, 0xa6, 0x17, 0xa4,
-	0x7b, 0x46, 0xcc, 0x85, 0x23, 0xce, 0x08, 0x67, 0xdf, 0xc2, 0x81, 0x57, 0x37, 0x1b, 0x07, 0xfd,
-	0x01, 0xe9, 0x7d, 0xa6, 0xd4, 0x53, 0x21, 0xe2, 0x36, 0xbf, 0x2f, 0x3d, 0xe8, 0x0f, 0x5b, 0xf9,
-	0x2f, 0x1d, 0x11, 0xd4, 0x6f, 0xe0, 0xb0, 0xdd, 0x9c, 0xac, 0xb0, 0x5a, 0xf3, 0xaa, 0xd8, 0x24,
-	0x2f, 0xa9, 0xd3, 0x07, 0x6d, 0x72, 0x11, 0xb8, 0xe9, 0xdf, 0x7b, 0x00, 0x17, 0x98, 0xe3, 0x42,
-	0x49, 0xa9, 0xaa, 0x6d, 0xff, 0x20, 0xe1, 0x6f, 0xc9, 0x4d, 0x51, 0x37, 0x4c, 0x91, 0x47, 0xce,
-	0xdb, 0x23, 0xd1, 0xfb, 0x68, 0x24, 0xb6, 0xbb, 0xb5, 0xf7, 0x40, 0xb7, 0xfa, 0xbb, 0xdd, 0x1a,
-	0x6c, 0x71, 0x6b, 0xbb, 0x1b, 0xc3, 0x47, 0xba, 0x31, 0x7a, 0xa4, 0x1b, 0xd1, 0x63, 0xdd, 0x80,
-	0xfb, 0xdd, 0xf8, 0x64, 0xe0, 0xe2, 0x3b, 0x03, 0xf7, 0x39, 0x8c, 0xa9, 0x7e, 0xbd, 0xc9, 0x0a,
-	0x55, 0xfa, 0x8b, 0x16, 0xa5, 0x71, 0xc0, 0x16, 0xaa, 0xe4, 0xee, 0x62, 0xd3, 0x1d, 0xf4, 0xd7,
-	0x8b, 0xbe, 0x7d, 0xfb, 0xae, 0xb8, 0xd6, 0x5c, 0x67, 0xd7, 0xca, 0x20, 0x5d, 0xae, 0xc8, 0xb5,
-	0xcf, 0x83, 0x3f, 0x2b, 0xe3, 0x86, 0xbd, 0x67, 0x51, 0xd2, 0x95, 0x8a, 0x4f, 0xc7, 0xf3, 0xe6,
-	0x71, 0x7a, 0x8f, 0x32, 0x75, 0xc4, 0xf4, 0x0a, 0x7a, 0xef, 0x51, 0xba, 0x89, 0xb0, 0x28, 0x33,
-	0xa3, 0xac, 0x2e, 0x78, 0x98, 0x94, 0xc8, 0xa2, 0xbc, 0x20, 0xa0, 0xa1, 0x25, 0x2f, 0x85, 0x95,
-	0xcd, 0xc0, 0x58, 0x94, 0xbf, 0x10, 0xe0, 0x0a, 0x70, 0x74, 0x91, 0xcb, 0x3a, 0x17, 0xab, 0x8a,
-	0xc6, 0x26, 0x4a, 0x63, 0x8b, 0x72, 0x11, 0xa0, 0x69, 0x01, 0x4f, 0x7f, 0xd2, 0xca, 0xd6, 0xad,
-	0xa9, 0xfc, 0x0a, 0x7a, 0xa8, 0xea, 0xa4, 0x33, 0xe9, 0xcd, 0xe2, 0xd3, 0x17, 0xb7, 0x47, 0xfb,
-	0x4f, 0x91, 0x3a, 0x9e, 0x7d, 0x0d, 0x7d, 0xb2, 0x86, 0xb6, 0xbd, 0x47, 0xe8, 0x15, 0x3f, 0x7c,
-	0xff, 0xdb, 0x77, 0x2b, 0x81, 0xd7, 0x76, 0x39, 0x2f, 0x94, 0x74, 0x0f, 0xb1, 0xb1, 0x35, 0xd7,
-	0xb7, 0x1f, 0xc7, 0x4b, 0xb1, 0x5e, 0x8b, 0x6a, 0x75, 0x6c, 0xb8, 0xbe, 0x71, 0xf8, 0x87, 0x95,
-	0x7f, 0x8e, 0x9b, 0x37, 0x7b, 0x39, 0xa0, 0xe5, 0x9b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb6,
-	0x03, 0xf4, 0x43, 0xcd, 0x07, 0x00, 0x00,
+	// 986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0x37,
+	0x10, 0x85, 0xb4, 0xd6, 0xc7, 0x8e, 0xe4, 0x8f, 0x6c, 0xec, 0x74, 0x6b, 0x34, 0x8d, 0xaa, 0x34,
+	0x80, 0x02, 0xd4, 0x72, 0xe1, 0x9c, 0x72, 0x28, 0x0a, 0x57, 0x35, 0x5a, 0x1f, 0x0a, 0x18, 0x6b,
+	0xe7, 0xd2, 0xcb, 0x62, 0xbd, 0x4b, 0xcb, 0xac, 0xc5, 0xe5, 0x86, 0x1f, 0x86, 0xf5, 0x3f, 0x7a,
+	0xec, 0x3d, 0xbf, 0xae, 0xff, 0xa1, 0xe0, 0x90, 0x94, 0xd7, 0xb6, 0x12, 0x5b, 0xe8, 0x49, 0xe2,
+	0x9b, 0x37, 0xb3, 0xe4, 0x1b, 0xf2, 0x91, 0xb0, 0x53, 0x65, 0xf3, 0x19, 0x2d, 0xaf, 0xf6, 0xdd,
+	0xef, 0xb8, 0x12, 0x5c, 0xf1, 0xa8, 0xe3, 0x86, 0xbb, 0xaf, 0xa6, 0x9c, 0x4f, 0x67, 0x64, 0x1f,
+	0xe1, 0x73, 0x7d, 0xb1, 0xaf, 0x28, 0x23, 0x52, 0x65, 0xac, 0xb2, 0xcc, 0xe1, 0xa7, 0x26, 0x6c,
+	0x4f, 0x04, 0xc9, 0x14, 0x39, 0xb1, 0x29, 0x09, 0xf9, 0xa8, 0x89, 0x54, 0xd1, 0x06, 0x34, 0x69,
+	0x11, 0x37, 0x06, 0x8d, 0x51, 0x98, 0x34, 0x69, 0x11, 0xbd, 0x04, 0x20, 0x37, 0x15, 0x15, 0x44,
+	0xa6, 0x99, 0x8a, 0x9b, 0x83, 0xc6, 0x28, 0x48, 0x42, 0x87, 0x1c, 0xaa, 0x68, 0x17, 0xba, 0x95,
+	0xe0, 0x85, 0xce, 0x95, 0x8c, 0x83, 0x41, 0x30, 0x0a, 0x93, 0xc5, 0x38, 0x7a, 0x05, 0x3d, 0x46,
+	0x44, 0x7e, 0x99, 0x95, 0x2a, 0xa5, 0x45, 0xbc, 0x86, 0x35, 0xc1, 0x43, 0xc7, 0x58, 0xbb, 0x12,
+	0xfc, 0x2f, 0x92, 0x63, 0xbc, 0x85, 0xf1, 0xd0, 0x21, 0xc7, 0x45, 0x14, 0xc1, 0x5a, 0x99, 0x31,
+	0x12, 0xb7, 0x31, 0x80, 0xff, 0xa3, 0xef, 0x61, 0xa3, 0xe4, 0x29, 0x7e, 0x7f, 0x9e, 0x16, 0x99,
+	0x22, 0x71, 0x67, 0xd0, 0x18, 0x75, 0x93, 0x7e, 0xc9, 0x8f, 0x10, 0xfc, 0x35, 0x53, 0x24, 0x7a,
+	0x0d, 0xeb, 0x7e, 0x16, 0xa9, 0x9a, 0x57, 0x24, 0xee, 0x62, 0x89, 0xbe, 0x07, 0xcf, 0xe6, 0x15,
+	0x89, 0xde, 0xc0, 0x86, 0xe2, 0x57, 0xa4, 0x4c, 0x05, 0xf9, 0xa8, 0xa9, 0x20, 0x45, 0x1c, 0x62,
+	0xa9, 0x75, 0x44, 0x13, 0x07, 0x0e, 0xff, 0x6d, 0x43, 0xc7, 0x69, 0xf4, 0x40, 0x9c, 0x17, 0xd0,
+	0xe6, 0xe7, 0x66, 0xb6, 0x28, 0x4c, 0x98, 0xb8, 0xd1, 0x17, 0x55, 0x79, 0x7f, 0x47, 0x50, 0x23,
+	0x4a, 0xef, 0x60, 0x77, 0x6c, 0xfb, 0x35, 0xf6, 0xfd, 0x1a, 0x9f, 0xf9, 0x7e, 0xd5, 0xc5, 0x7e,
+	0x0f, 0x90, 0x63, 0xcf, 0x0a, 0x93, 0xda, 0x7a, 0x3c, 0xd5, 0xb1, 0x6d, 0xaa, 0xae, 0x0a, 0x9f,
+	0xda, 0x7e, 0x3c, 0xd5, 0xb1, 0x0f, 0xd5, 0xfd, 0x36, 0x76, 0x1e, 0x69, 0x63, 0xf7, 0x73, 0x6d,
+	0x0c, 0x6b, 0x6d, 0x7c, 0x09, 0x40, 0xa5, 0x6d, 0x23, 0x29, 0x62, 0x40, 0xdd, 0x43, 0x2a, 0x8f,
+	0x2c, 0x60, 0x74, 0xbd, 0xa6, 0x92, 0x2a, 0x19, 0xf7, 0x06, 0x8d, 0x51, 0x2b, 0x71, 0xa3, 0x25,
+	0xdd, 0xef, 0x3f, 0xa5, 0xfb, 0xeb, 0x4b, 0xba, 0x1f, 0x43, 0xa7, 0x20, 0x33, 0xa2, 0x48, 0x11,
+	0x6f, 0x60, 0x0d, 0x3f, 0x8c, 0xf6, 0x20, 0x52, 0x5c, 0x65, 0xb3, 0x54, 0x89, 0xac, 0x94, 0x59,
+	0xae, 0x28, 0x2f, 0x65, 0xbc, 0x89, 0x13, 0x79, 0x86, 0x91, 0xb3, 0x5a, 0xc0, 0xc8, 0x23, 0xb3,
+	0x19, 0x91, 0x69, 0xce, 0x75, 0xa9, 0xe2, 0x2d, 0xe4, 0x01, 0x42, 0x13, 0x83, 0x98, 0xe9, 0x08,
+	0xa2, 0xb4, 0x28, 0x3d, 0xe5, 0x19, 0x52, 0xfa, 0x0e, 0xb4, 0xa4, 0x6f, 0x01, 0x72, 0x5e, 0x5e,
+	0x13, 0x21, 0x29, 0x2f, 0xe3, 0x68, 0xd0, 0x18, 0x35, 0x92, 0x1a, 0x12, 0xfd, 0x00, 0xd1, 0x54,
+	0x70, 0x29, 0x53, 0xfb, 0xad, 0x8c, 0x61, 0xa5, 0xe7, 0xc8, 0xdb, 0xc2, 0xc8, 0xa9, 0x09, 0x1c,
+	0x22, 0x1e, 0xfd, 0x08, 0xdb, 0x96, 0xed, 0x3f, 0xec, 0xf8, 0xdb, 0xc8, 0xb7, 0x95, 0x12, 0x1b,
+	0x72, 0x19, 0x8b, 0xfa, 0x76, 0xe9, 0x8e, 0xbf, 0x53, 0xab, 0x7f, 0x66, 0x02, 0x8e, 0xfd, 0x0e,
+	0x76, 0xea, 0xe2, 0xa4, 0xb9, 0x16, 0x82, 0x94, 0xf9, 0x3c, 0x7e, 0x81, 0x4a, 0x6f, 0xd7, 0x83,
+	0x13, 0x17, 0x5b, 0x72, 0xde, 0xbe, 0x5a, 0x76, 0xde, 0xfe, 0x69, 0xc2, 0xd7, 0x77, 0x9c, 0xe9,
+	0xcc, 0x87, 0x8d, 0x3d, 0x99, 0xbd, 0x66, 0xe1, 0x74, 0x71, 0x12, 0x43, 0x87, 0x1c, 0x17, 0xf7,
+	0xf7, 0x6a, 0x73, 0xd9, 0x5e, 0xad, 0x9d, 0xbe, 0xe0, 0xbe, 0x9d, 0xbd, 0x86, 0x75, 0x96, 0xdd,
+	0xa4, 0x95, 0x36, 0x09, 0x92, 0x48, 0x3c, 0x9f, 0xad, 0xa4, 0xcf, 0xb2, 0x9b, 0x13, 0x8f, 0x99,
+	0x85, 0xe4, 0x5a, 0x2a, 0xce, 0x88, 0x48, 0x09, 0xcb, 0xe8, 0xcc, 0x59, 0xd7, 0xba, 0x47, 0x8f,
+	0x0c, 0x18, 0xbd, 0x85, 0xad, 0x05, 0x0d, 0x1b, 0x2f, 0xe6, 0xce, 0xca, 0x36, 0x3d, 0x3e, 0xb1,
+	0xb0, 0xa1, 0x16, 0x54, 0x22, 0x29, 0xad, 0x88, 0xc8, 0x49, 0xa9, 0xf0, 0x9c, 0x35, 0x92, 0x4d,
+	0x8f, 0x9f, 0x58, 0x78, 0xf8, 0x77, 0x00, 0xfd, 0xba, 0x30, 0xcb, 0x0c, 0xbb, 0xa6, 0x50, 0xf3,
+	0x11, 0x85, 0x82, 0x07, 0x0a, 0xfd, 0x0f, 0x7f, 0x7a, 0xa0, 0x5e, 0xeb, 0x49, 0xea, 0xb5, 0x9f,
+	0xaa, 0x5e, 0xe7, 0xe9, 0xea, 0x75, 0x97, 0xaa, 0x77, 0xcf, 0x41, 0xc3, 0x55, 0x1c, 0xf4, 0x1b,
+	0x08, 0x6f, 0x17, 0x06, 0xb8, 0xb0, 0x5b, 0x60, 0xf8, 0x69, 0x0d, 0xe0, 0x54, 0x65, 0x6a, 0xc2,
+	0x19, 0xe3, 0x2b, 0x37, 0xe5, 0xd6, 0xef, 0x82, 0x3b, 0x7e, 0xb7, 0xdc, 0x8a, 0xd6, 0x9e, 0x68,
+	0x45, 0xad, 0xc7, 0xad, 0xa8, 0xbd, 0xc4, 0x8a, 0x96, 0x5b, 0x4d, 0x67, 0x45, 0xab, 0xe9, 0xae,
+	0x68, 0x35, 0xe1, 0xaa, 0x56, 0x03, 0x5f, 0xb0, 0x9a, 0xbb, 0x6e, 0xda, 0x7b, 0xe0, 0xa6, 0xdf,
+	0x41, 0xdf, 0xed, 0xa9, 0x34, 0xe7, 0x85, 0xbd, 0x45, 0xc2, 0xa4, 0xe7, 0xb0, 0x09, 0x2f, 0x88,
+	0xb9, 0xb5, 0xf0, 0x82, 0xb1, 0x77, 0x07, 0xfe, 0xb7, 0xf2, 0x5d, 0x10, 0x21, 0x88, 0x48, 0x2f,
+	0xb9, 0x54, 0x78, 0x73, 0x84, 0x49, 0xdf, 0x83, 0xbf, 0x73, 0x69, 0x9c, 0x3c, 0xd0, 0x8a, 0xe1,
+	0x7d, 0xd1, 0x3b, 0xe8, 0x8f, 0xfd, 0x03, 0xed, 0x83, 0x62, 0x89, 0x09, 0x0c, 0x2f, 0x20, 0xf8,
+	0xa0, 0x98, 0xd9, 0x11, 0x5a, 0xb1, 0x54, 0x72, 0x2d, 0x72, 0xe2, 0x8d, 0x4c, 0x2b, 0x76, 0x8a,
+	0x80, 0x0f, 0x33, 0x52, 0x50, 0xcd, 0xfc, 0x86, 0xd1, 0x8a, 0xfd, 0x81, 0x80, 0x59, 0x80, 0x09,
+	0xe7, 0x19, 0xab, 0x32, 0x3a, 0x2d, 0xdd, 0x31, 0xee, 0x69, 0xc5, 0x26, 0x0e, 0x1a, 0xe6, 0xb0,
+	0xf9, 0x9b, 0xe0, 0xba, 0xaa, 0xed, 0xca, 0x37, 0x10, 0x28, 0x5e, 0xc5, 0x8d, 0x41, 0x30, 0xea,
+	0x1d, 0x3c, 0x5f, 0x4c, 0xed, 0x96, 0x91, 0x98, 0x78, 0xf4, 0x16, 0x5a, 0xd8, 0x1a, 0xfc, 0xec,
+	0x67, 0x88, 0x96, 0xf1, 0xcb, 0xcf, 0x7f, 0xfe, 0x34, 0xa5, 0xea, 0x52, 0x9f, 0x8f, 0x73, 0xce,
+	0xcc, 0x63, 0x54, 0xea, 0x8a, 0x88, 0xc5, 0x9f, 0xbd, 0x73, 0x3a, 0x9b, 0xd1, 0x72, 0xba, 0x27,
+	0x89, 0xb8, 0x36, 0xf8, 0xd5, 0xd4, 0x3e, 0x49, 0xfd, 0xbb, 0xf5, 0xbc, 0x8d, 0xc3, 0x77, 0xff,
+	0x0d, 0x00, 0x7f, 0x79, 0x6d, 0xa2, 0xd1, 0x0a, 0x00, 0x00,
 }